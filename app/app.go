@@ -202,6 +202,11 @@ func GetGovProposalHandlers() []govclient.ProposalHandler {
 		lendclient.AddMultipleLendPairsHandler,
 		lendclient.AddPoolPairsHandler,
 		lendclient.AddAssetRatesPoolPairsHandler,
+		lendclient.SetEModeCategoryHandler,
+		lendclient.SetBadDebtWaterfallHandler,
+		lendclient.AddFixedTermMarketHandler,
+		lendclient.UpdateAssetRatesParamsHandler,
+		lendclient.UpdatePoolPairsHandler,
 		paramsclient.ProposalHandler,
 		distrclient.ProposalHandler,
 		upgradeclient.ProposalHandler,
@@ -555,6 +560,10 @@ func New(
 		&app.Rewardskeeper,
 		&app.VaultKeeper,
 		&app.BandoracleKeeper,
+		&app.MarketKeeper,
+		&app.LiquidityKeeper,
+		app.BankKeeper,
+		&app.IbcTransferKeeper,
 	)
 
 	app.LendKeeper = lendkeeper.NewKeeper(
@@ -594,6 +603,8 @@ func New(
 		&app.EsmKeeper,
 		&app.TokenmintKeeper,
 		&app.Rewardskeeper,
+		&app.LiquidationKeeper,
+		&app.LiquidityKeeper,
 	)
 
 	app.TokenmintKeeper = tokenmintkeeper.NewKeeper(
@@ -631,6 +642,7 @@ func New(
 		scopedIBCOracleKeeper,
 		app.AssetKeeper,
 		&app.BandoracleKeeper,
+		&app.LiquidityKeeper,
 	)
 
 	app.LiquidationKeeper = liquidationkeeper.NewKeeper(
@@ -647,6 +659,7 @@ func New(
 		&app.EsmKeeper,
 		&app.Rewardskeeper,
 		&app.LendKeeper,
+		&app.LiquidityKeeper,
 	)
 
 	app.AuctionKeeper = auctionkeeper.NewKeeper(
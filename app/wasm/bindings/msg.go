@@ -134,6 +134,9 @@ type MsgAddAuctionParams struct {
 	DebtID                 uint64  `json:"debt_id"`
 	DutchID                uint64  `json:"dutch_id"`
 	BidDurationSeconds     uint64  `json:"bid_duration_seconds"`
+	SealedBid              bool    `json:"sealed_bid,omitempty"`
+	CommitDurationSeconds  uint64  `json:"commit_duration_seconds,omitempty"`
+	RevealDurationSeconds  uint64  `json:"reveal_duration_seconds,omitempty"`
 }
 
 type MsgBurnGovTokensForApp struct {
@@ -17,6 +17,7 @@ import (
 
 	"github.com/comdex-official/comdex/app/wasm/bindings"
 	assetkeeper "github.com/comdex-official/comdex/x/asset/keeper"
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
 	collectorkeeper "github.com/comdex-official/comdex/x/collector/keeper"
 	liquidityKeeper "github.com/comdex-official/comdex/x/liquidity/keeper"
 	lockerkeeper "github.com/comdex-official/comdex/x/locker/keeper"
@@ -70,6 +71,11 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if err := json.Unmarshal(msg.Custom, &comdexMsg); err != nil {
 			return nil, nil, sdkerrors.Wrap(err, "comdex msg error")
 		}
+		if appID, role, ok := appRole(comdexMsg); ok {
+			if err := m.assetKeeper.CheckAppRoleIfSet(ctx, appID, contractAddr.String(), role); err != nil {
+				return nil, nil, err
+			}
+		}
 		if comdexMsg.MsgWhiteListAssetLocker != nil {
 			return m.whitelistAssetLocker(ctx, contractAddr, comdexMsg.MsgWhiteListAssetLocker)
 		}
@@ -134,6 +140,55 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
 
+// appRole returns the app a binding message acts on and the role the calling
+// contract must hold for it.
+func appRole(comdexMsg bindings.ComdexMessages) (uint64, assettypes.AppRole, bool) {
+	switch {
+	case comdexMsg.MsgWhiteListAssetLocker != nil:
+		return comdexMsg.MsgWhiteListAssetLocker.AppID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgWhitelistAppIDLockerRewards != nil:
+		return comdexMsg.MsgWhitelistAppIDLockerRewards.AppID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgWhitelistAppIDVaultInterest != nil:
+		return comdexMsg.MsgWhitelistAppIDVaultInterest.AppID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgAddExtendedPairsVault != nil:
+		return comdexMsg.MsgAddExtendedPairsVault.AppID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgSetCollectorLookupTable != nil:
+		return comdexMsg.MsgSetCollectorLookupTable.AppID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgSetAuctionMappingForApp != nil:
+		return comdexMsg.MsgSetAuctionMappingForApp.AppID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgUpdatePairsVault != nil:
+		return comdexMsg.MsgUpdatePairsVault.AppID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgUpdateCollectorLookupTable != nil:
+		return comdexMsg.MsgUpdateCollectorLookupTable.AppID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgRemoveWhitelistAssetLocker != nil:
+		return comdexMsg.MsgRemoveWhitelistAssetLocker.AppID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgRemoveWhitelistAppIDVaultInterest != nil:
+		return comdexMsg.MsgRemoveWhitelistAppIDVaultInterest.AppMappingID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgWhitelistAppIDLiquidation != nil:
+		return comdexMsg.MsgWhitelistAppIDLiquidation.AppID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgRemoveWhitelistAppIDLiquidation != nil:
+		return comdexMsg.MsgRemoveWhitelistAppIDLiquidation.AppID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgAddAuctionParams != nil:
+		return comdexMsg.MsgAddAuctionParams.AppID, assettypes.AppRoleRiskAdmin, true
+	case comdexMsg.MsgAddESMTriggerParams != nil:
+		return comdexMsg.MsgAddESMTriggerParams.AppID, assettypes.AppRoleEmergencyAdmin, true
+	case comdexMsg.MsgBurnGovTokensForApp != nil:
+		return comdexMsg.MsgBurnGovTokensForApp.AppID, assettypes.AppRoleTreasury, true
+	case comdexMsg.MsgEmissionRewards != nil:
+		return comdexMsg.MsgEmissionRewards.AppID, assettypes.AppRoleTreasury, true
+	case comdexMsg.MsgFoundationEmission != nil:
+		return comdexMsg.MsgFoundationEmission.AppID, assettypes.AppRoleTreasury, true
+	case comdexMsg.MsgRebaseMint != nil:
+		return comdexMsg.MsgRebaseMint.AppID, assettypes.AppRoleTreasury, true
+	case comdexMsg.MsgGetSurplusFund != nil:
+		return comdexMsg.MsgGetSurplusFund.AppID, assettypes.AppRoleTreasury, true
+	case comdexMsg.MsgEmissionPoolRewards != nil:
+		return comdexMsg.MsgEmissionPoolRewards.AppID, assettypes.AppRoleTreasury, true
+	default:
+		return 0, 0, false
+	}
+}
+
 func (m *CustomMessenger) whitelistAssetLocker(ctx sdk.Context, contractAddr sdk.AccAddress, whiteListAsset *bindings.MsgWhiteListAssetLocker) ([]sdk.Event, [][]byte, error) {
 	err := WhiteListAsset(m.lockerKeeper, ctx, contractAddr.String(), whiteListAsset)
 	if err != nil {
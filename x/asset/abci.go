@@ -6,10 +6,20 @@ import (
 
 	utils "github.com/comdex-official/comdex/types"
 	"github.com/comdex-official/comdex/x/asset/keeper"
+	"github.com/comdex-official/comdex/x/asset/types"
 )
 
 func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k keeper.Keeper) {
 	_ = utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		k.UpdateStabilityFees(ctx)
 		return nil
 	})
+
+	k.ActivatePendingChanges(ctx, types.ParamClassExtendedPairVault, func(ctx sdk.Context, change types.RiskParameter) error {
+		update, ok := change.(*types.ExtendedPairVault)
+		if !ok {
+			return types.ErrorInvalidPendingChange
+		}
+		return k.UpdatePairsVault(ctx, *update)
+	})
 }
@@ -28,6 +28,12 @@ func GetQueryCmd() *cobra.Command {
 		queryGovTokenByApp(),
 		queryAllExtendedPairStableVaultsByApp(),
 		queryExtendedPairStableVaultsByAppWithoutStable(),
+		queryStabilityFeeController(),
+		queryStabilityFeeAdjustments(),
+		queryVaultCollateralAssets(),
+		queryDelistings(),
+		queryPendingChanges(),
+		queryTimelockDelays(),
 	)
 
 	return cmd
@@ -42,7 +48,9 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand()
+	cmd.AddCommand(
+		txSetAppRoles(),
+	)
 
 	return cmd
 }
@@ -512,3 +512,217 @@ func queryExtendedPairStableVaultsByAppWithoutStable() *cobra.Command {
 
 	return cmd
 }
+
+func queryStabilityFeeController() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stability_fee_controller [extended_pair_vault_id]",
+		Short: "Query the stability fee controller of an extended pair vault",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryStabilityFeeController(
+				context.Background(),
+				&types.QueryStabilityFeeControllerRequest{
+					ExtendedPairVaultId: id,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryStabilityFeeAdjustments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stability_fee_adjustments [extended_pair_vault_id]",
+		Short: "Query the stability fee adjustments of an extended pair vault",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryStabilityFeeAdjustments(
+				context.Background(),
+				&types.QueryStabilityFeeAdjustmentsRequest{
+					ExtendedPairVaultId: id,
+					Pagination:          pagination,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stability_fee_adjustments")
+
+	return cmd
+}
+
+func queryVaultCollateralAssets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault_collateral_assets [extended_pair_vault_id]",
+		Short: "Query the basket collateral assets whitelisted for an extended pair vault",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryVaultCollateralAssets(
+				context.Background(),
+				&types.QueryVaultCollateralAssetsRequest{
+					ExtendedPairVaultId: id,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryDelistings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delistings",
+		Short: "Query the assets and pairs being delisted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryDelistings(
+				context.Background(),
+				&types.QueryDelistingsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryPendingChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-changes [param_class]",
+		Short: "Query the scheduled risk parameter changes, optionally of a param class",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var paramClass string
+			if len(args) > 0 {
+				paramClass = args[0]
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryPendingChanges(
+				context.Background(),
+				&types.QueryPendingChangesRequest{
+					ParamClass: paramClass,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryTimelockDelays() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timelock-delays",
+		Short: "Query the timelock delay of every risk parameter class",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryTimelockDelays(
+				context.Background(),
+				&types.QueryTimelockDelaysRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -18,4 +18,13 @@ var AddAssetsHandler = []govclient.ProposalHandler{
 	govclient.NewProposalHandler(cli.NewCmdSubmitAddMultipleAssetsProposal, rest.AddNewAssetsProposalRESTHandler),
 	govclient.NewProposalHandler(cli.NewCmdSubmitAddMultiplePairsProposal, rest.AddNewPairsProposalRESTHandler),
 	govclient.NewProposalHandler(cli.NewCmdSubmitAddMultipleAssetsPairsProposal, rest.AddNewAssetsPairsProposalRESTHandler),
+	govclient.NewProposalHandler(cli.NewCmdSubmitSetStabilityFeeControllerProposal, rest.SetStabilityFeeControllerProposalRESTHandler),
+	govclient.NewProposalHandler(cli.NewCmdSubmitRemoveStabilityFeeControllerProposal, rest.RemoveStabilityFeeControllerProposalRESTHandler),
+	govclient.NewProposalHandler(cli.NewCmdSubmitSetVaultCollateralAssetProposal, rest.SetVaultCollateralAssetProposalRESTHandler),
+	govclient.NewProposalHandler(cli.NewCmdSubmitRemoveVaultCollateralAssetProposal, rest.RemoveVaultCollateralAssetProposalRESTHandler),
+	govclient.NewProposalHandler(cli.NewCmdSubmitDelistAssetProposal, rest.DelistAssetProposalRESTHandler),
+	govclient.NewProposalHandler(cli.NewCmdSubmitDelistPairProposal, rest.DelistPairProposalRESTHandler),
+	govclient.NewProposalHandler(cli.NewCmdSubmitCancelPendingChangeProposal, rest.CancelPendingChangeProposalRESTHandler),
+	govclient.NewProposalHandler(cli.NewCmdSubmitSetTimelockDelayProposal, rest.SetTimelockDelayProposalRESTHandler),
+	govclient.NewProposalHandler(cli.NewCmdSubmitSetAppRolesProposal, rest.SetAppRolesProposalRESTHandler),
 }
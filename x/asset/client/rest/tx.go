@@ -137,3 +137,66 @@ func AddExtendedPairsVaultProposalRESTHandler(clientCtx client.Context) govrest.
 		Handler:  AddNewAssetsRESTHandler(clientCtx),
 	}
 }
+
+func SetStabilityFeeControllerProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-stability-fee-controller",
+		Handler:  AddNewAssetsRESTHandler(clientCtx),
+	}
+}
+
+func RemoveStabilityFeeControllerProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove-stability-fee-controller",
+		Handler:  AddNewAssetsRESTHandler(clientCtx),
+	}
+}
+
+func SetVaultCollateralAssetProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-vault-collateral-asset",
+		Handler:  AddNewAssetsRESTHandler(clientCtx),
+	}
+}
+
+func RemoveVaultCollateralAssetProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove-vault-collateral-asset",
+		Handler:  AddNewAssetsRESTHandler(clientCtx),
+	}
+}
+
+func DelistAssetProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "delist-asset",
+		Handler:  AddNewAssetsRESTHandler(clientCtx),
+	}
+}
+
+func DelistPairProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "delist-pair",
+		Handler:  AddNewAssetsRESTHandler(clientCtx),
+	}
+}
+
+func CancelPendingChangeProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel-pending-change",
+		Handler:  AddNewAssetsRESTHandler(clientCtx),
+	}
+}
+
+func SetTimelockDelayProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-timelock-delay",
+		Handler:  AddNewAssetsRESTHandler(clientCtx),
+	}
+}
+
+func SetAppRolesProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-app-roles",
+		Handler:  AddNewAssetsRESTHandler(clientCtx),
+	}
+}
@@ -2,7 +2,13 @@ package expected
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
+	liquiditytypes "github.com/comdex-official/comdex/x/liquidity/types"
+	markettypes "github.com/comdex-official/comdex/x/market/types"
 	rewardstypes "github.com/comdex-official/comdex/x/rewards/types"
 	vaulttypes "github.com/comdex-official/comdex/x/vault/types"
 )
@@ -10,6 +16,7 @@ import (
 type RewardsKeeper interface {
 	GetAppIDByApp(ctx sdk.Context, appID uint64) (uint64, bool)
 	CalculationOfRewards(ctx sdk.Context, amount sdk.Int, lsr sdk.Dec, bTime int64) (sdk.Dec, error)
+	CalculationOfVaultInterest(ctx sdk.Context, extPair assettypes.ExtendedPairVault, amount sdk.Int, bTime int64) (sdk.Dec, error)
 	GetVaultInterestTracker(ctx sdk.Context, id, appID uint64) (vault rewardstypes.VaultInterestTracker, found bool)
 	SetVaultInterestTracker(ctx sdk.Context, vault rewardstypes.VaultInterestTracker)
 }
@@ -23,3 +30,20 @@ type VaultKeeper interface {
 type Bandoraclekeeper interface {
 	SetCheckFlag(ctx sdk.Context, flag bool)
 }
+
+type MarketKeeper interface {
+	GetTwa(ctx sdk.Context, id uint64) (twa markettypes.TimeWeightedAverage, found bool)
+}
+
+type LiquidityKeeper interface {
+	GetPair(ctx sdk.Context, appID, id uint64) (pair liquiditytypes.Pair, found bool)
+}
+
+type BankKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+}
+
+type TransferKeeper interface {
+	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool)
+}
@@ -51,10 +51,58 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, state *types.GenesisState) {
 		k.SetPairsVault(ctx, item)
 	}
 
+	for _, item := range state.StabilityFeeControllers {
+		k.SetStabilityFeeController(ctx, item)
+	}
+
+	for _, item := range state.StabilityFeeControllerStates {
+		k.SetStabilityFeeControllerState(ctx, item)
+	}
+
+	var adjustmentID uint64
+	for _, item := range state.StabilityFeeAdjustments {
+		if item.Id > adjustmentID {
+			adjustmentID = item.Id
+		}
+
+		k.SetStabilityFeeAdjustment(ctx, item)
+	}
+
+	for _, item := range state.VaultCollateralAssets {
+		k.SetVaultCollateralAsset(ctx, item)
+	}
+
+	for _, item := range state.AssetDelistings {
+		k.SetAssetDelisting(ctx, item)
+	}
+
+	for _, item := range state.PairDelistings {
+		k.SetPairDelisting(ctx, item)
+	}
+
+	var pendingChangeID uint64
+	for _, item := range state.PendingChanges {
+		if item.Id > pendingChangeID {
+			pendingChangeID = item.Id
+		}
+
+		k.SetPendingChange(ctx, item)
+	}
+
+	for _, item := range state.TimelockDelays {
+		k.SetTimelockDelay(ctx, item)
+	}
+
+	for _, item := range state.DenomTraces {
+		k.SetAssetDenomTrace(ctx, item)
+	}
+
 	k.SetAssetID(ctx, assetID)
 	k.SetPairID(ctx, pairID)
 	k.SetAppID(ctx, appID)
 	k.SetPairsVaultID(ctx, extendedPairID)
+	k.SetStabilityFeeAdjustmentID(ctx, adjustmentID)
+	k.SetPendingChangeID(ctx, pendingChangeID)
 }
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
@@ -66,5 +114,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		apps,
 		pairVaults,
 		k.GetParams(),
+		k.GetStabilityFeeControllers(ctx),
+		k.GetStabilityFeeControllerStates(ctx),
+		k.GetStabilityFeeAdjustments(ctx),
+		k.GetAllVaultCollateralAssets(ctx),
+		k.GetAssetDelistings(ctx),
+		k.GetPairDelistings(ctx),
+		k.GetPendingChanges(ctx, ""),
+		k.GetTimelockDelays(ctx),
+		k.GetAssetDenomTraces(ctx),
 	)
 }
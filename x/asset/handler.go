@@ -16,8 +16,12 @@ import (
 func NewHandler(k keeper.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		_ = ctx.WithEventManager(sdk.NewEventManager())
+		server := keeper.NewMsgServer(k)
 
 		switch msg := msg.(type) {
+		case *types.MsgSetAppRoles:
+			res, err := server.SetAppRoles(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -48,6 +52,24 @@ func NewUpdateAssetProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return handleAddAssetInAppProposal(ctx, k, c)
 		case *types.AddMultipleAssetsPairsProposal:
 			return handleMultipleAssetsPairsProposal(ctx, k, c)
+		case *types.SetStabilityFeeControllerProposal:
+			return handleSetStabilityFeeControllerProposal(ctx, k, c)
+		case *types.RemoveStabilityFeeControllerProposal:
+			return handleRemoveStabilityFeeControllerProposal(ctx, k, c)
+		case *types.SetVaultCollateralAssetProposal:
+			return handleSetVaultCollateralAssetProposal(ctx, k, c)
+		case *types.RemoveVaultCollateralAssetProposal:
+			return handleRemoveVaultCollateralAssetProposal(ctx, k, c)
+		case *types.DelistAssetProposal:
+			return handleDelistAssetProposal(ctx, k, c)
+		case *types.DelistPairProposal:
+			return handleDelistPairProposal(ctx, k, c)
+		case *types.CancelPendingChangeProposal:
+			return handleCancelPendingChangeProposal(ctx, k, c)
+		case *types.SetTimelockDelayProposal:
+			return handleSetTimelockDelayProposal(ctx, k, c)
+		case *types.SetAppRolesProposal:
+			return handleSetAppRolesProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(types.ErrorUnknownProposalType, "%T", c)
@@ -94,3 +116,39 @@ func handleAddAssetInAppProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddA
 func handleMultipleAssetsPairsProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddMultipleAssetsPairsProposal) error {
 	return k.HandleProposalAddMultipleAssetPair(ctx, p)
 }
+
+func handleSetStabilityFeeControllerProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetStabilityFeeControllerProposal) error {
+	return k.HandleProposalSetStabilityFeeController(ctx, p)
+}
+
+func handleRemoveStabilityFeeControllerProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveStabilityFeeControllerProposal) error {
+	return k.HandleProposalRemoveStabilityFeeController(ctx, p)
+}
+
+func handleSetVaultCollateralAssetProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetVaultCollateralAssetProposal) error {
+	return k.HandleProposalSetVaultCollateralAsset(ctx, p)
+}
+
+func handleRemoveVaultCollateralAssetProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveVaultCollateralAssetProposal) error {
+	return k.HandleProposalRemoveVaultCollateralAsset(ctx, p)
+}
+
+func handleDelistAssetProposal(ctx sdk.Context, k keeper.Keeper, p *types.DelistAssetProposal) error {
+	return k.HandleProposalDelistAsset(ctx, p)
+}
+
+func handleDelistPairProposal(ctx sdk.Context, k keeper.Keeper, p *types.DelistPairProposal) error {
+	return k.HandleProposalDelistPair(ctx, p)
+}
+
+func handleCancelPendingChangeProposal(ctx sdk.Context, k keeper.Keeper, p *types.CancelPendingChangeProposal) error {
+	return k.HandleProposalCancelPendingChange(ctx, p)
+}
+
+func handleSetTimelockDelayProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetTimelockDelayProposal) error {
+	return k.HandleProposalSetTimelockDelay(ctx, p)
+}
+
+func handleSetAppRolesProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetAppRolesProposal) error {
+	return k.HandleProposalSetAppRoles(ctx, p)
+}
@@ -239,6 +239,9 @@ func (k Keeper) AddAppRecords(ctx sdk.Context, msg types.AppData) error {
 	if msg.MinGovDeposit.LT(sdk.ZeroInt()) {
 		return types.ErrorValueCantBeNegative
 	}
+	if err := msg.Roles.Validate(); err != nil {
+		return err
+	}
 
 	var (
 		id  = k.GetAppID(ctx)
@@ -249,6 +252,7 @@ func (k Keeper) AddAppRecords(ctx sdk.Context, msg types.AppData) error {
 			MinGovDeposit:    msg.MinGovDeposit,
 			GovTimeInSeconds: msg.GovTimeInSeconds,
 			GenesisToken:     msg.GenesisToken,
+			Roles:            msg.Roles,
 		}
 	)
 
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/comdex-official/comdex/x/asset/types"
+)
+
+// SetAppRoles replaces the administration roles of an app.
+func (k Keeper) SetAppRoles(ctx sdk.Context, appID uint64, roles types.AppRoles) error {
+	app, found := k.GetApp(ctx, appID)
+	if !found {
+		return types.AppIdsDoesntExist
+	}
+	if err := roles.Validate(); err != nil {
+		return err
+	}
+
+	app.Roles = roles
+	k.SetApp(ctx, app)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAppRoles,
+			sdk.NewAttribute(types.AttributeKeyAppID, strconv.FormatUint(appID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAdmin, roles.Admin),
+			sdk.NewAttribute(types.AttributeKeyRiskAdmin, roles.RiskAdmin),
+			sdk.NewAttribute(types.AttributeKeyEmergencyAdmin, roles.EmergencyAdmin),
+			sdk.NewAttribute(types.AttributeKeyTreasury, roles.Treasury),
+		),
+	)
+	return nil
+}
+
+// HasAppRole reports whether address holds role for the app.
+func (k Keeper) HasAppRole(ctx sdk.Context, appID uint64, address string, role types.AppRole) bool {
+	app, found := k.GetApp(ctx, appID)
+	if !found {
+		return false
+	}
+	return app.Roles.Holds(address, role)
+}
+
+// CheckAppRole returns an error unless address holds role for the app.
+func (k Keeper) CheckAppRole(ctx sdk.Context, appID uint64, address string, role types.AppRole) error {
+	if !k.HasAppRole(ctx, appID, address, role) {
+		return sdkerrors.Wrapf(types.ErrorUnauthorizedAppRole, "%s is not %s of app %d", address, role, appID)
+	}
+	return nil
+}
+
+// CheckAppRoleIfSet is CheckAppRole for the wasm bindings and the app-scoped
+// msgs that were open before roles existed. While role itself is not set,
+// its callers keep that access, whatever other roles of the app are set.
+func (k Keeper) CheckAppRoleIfSet(ctx sdk.Context, appID uint64, address string, role types.AppRole) error {
+	if app, found := k.GetApp(ctx, appID); found && app.Roles.Address(role) == "" {
+		return nil
+	}
+	return k.CheckAppRole(ctx, appID, address, role)
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/asset/keeper"
+	assetTypes "github.com/comdex-official/comdex/x/asset/types"
+)
+
+func (s *KeeperTestSuite) TestAppRoles() {
+	assetKeeper := &s.assetKeeper
+	var (
+		admin     = sdk.AccAddress("admin_______________").String()
+		riskAdmin = sdk.AccAddress("risk_admin__________").String()
+		treasury  = sdk.AccAddress("treasury____________").String()
+		stranger  = sdk.AccAddress("stranger____________").String()
+	)
+
+	s.Require().ErrorIs(assetKeeper.AddAppRecords(s.ctx, assetTypes.AppData{
+		Name: "cswap", ShortName: "cswap", MinGovDeposit: sdk.ZeroInt(),
+		Roles: assetTypes.AppRoles{Admin: "invalid"},
+	}), assetTypes.ErrorInvalidAppRoles)
+	s.Require().NoError(assetKeeper.AddAppRecords(s.ctx, assetTypes.AppData{
+		Name: "cswap", ShortName: "cswap", MinGovDeposit: sdk.ZeroInt(),
+	}))
+
+	// Apps without roles deny everyone.
+	s.Require().False(assetKeeper.HasAppRole(s.ctx, 1, "", assetTypes.AppRoleAdmin))
+	s.Require().ErrorIs(assetKeeper.CheckAppRole(s.ctx, 1, stranger, assetTypes.AppRoleRiskAdmin), assetTypes.ErrorUnauthorizedAppRole)
+	_, err := keeper.NewMsgServer(*assetKeeper).SetAppRoles(sdk.WrapSDKContext(s.ctx), &assetTypes.MsgSetAppRoles{From: stranger, AppId: 1, Roles: assetTypes.AppRoles{Admin: stranger}})
+	s.Require().ErrorIs(err, assetTypes.ErrorUnauthorizedAppRole)
+
+	// Contracts of an app without roles keep calling the bindings.
+	s.Require().NoError(assetKeeper.CheckAppRoleIfSet(s.ctx, 1, stranger, assetTypes.AppRoleTreasury))
+
+	// Governance assigns the roles.
+	s.Require().ErrorIs(assetKeeper.HandleProposalSetAppRoles(s.ctx, &assetTypes.SetAppRolesProposal{AppId: 2, Roles: assetTypes.AppRoles{Admin: admin}}), assetTypes.AppIdsDoesntExist)
+	s.Require().NoError(assetKeeper.HandleProposalSetAppRoles(s.ctx, &assetTypes.SetAppRolesProposal{
+		AppId: 1,
+		Roles: assetTypes.AppRoles{Admin: admin, RiskAdmin: riskAdmin},
+	}))
+	s.Require().True(assetKeeper.HasAppRole(s.ctx, 1, admin, assetTypes.AppRoleTreasury))
+	s.Require().True(assetKeeper.HasAppRole(s.ctx, 1, riskAdmin, assetTypes.AppRoleRiskAdmin))
+	s.Require().False(assetKeeper.HasAppRole(s.ctx, 1, riskAdmin, assetTypes.AppRoleEmergencyAdmin))
+	s.Require().False(assetKeeper.HasAppRole(s.ctx, 1, riskAdmin, assetTypes.AppRoleAdmin))
+
+	// A role left unset stays open to its callers, set ones are checked.
+	s.Require().NoError(assetKeeper.CheckAppRoleIfSet(s.ctx, 1, stranger, assetTypes.AppRoleTreasury))
+	s.Require().ErrorIs(assetKeeper.CheckAppRoleIfSet(s.ctx, 1, stranger, assetTypes.AppRoleRiskAdmin), assetTypes.ErrorUnauthorizedAppRole)
+	s.Require().NoError(assetKeeper.CheckAppRoleIfSet(s.ctx, 1, admin, assetTypes.AppRoleRiskAdmin))
+
+	// Only the admin rotates the roles.
+	server := keeper.NewMsgServer(*assetKeeper)
+	roles := assetTypes.AppRoles{Admin: admin, RiskAdmin: riskAdmin, Treasury: treasury}
+	_, err = server.SetAppRoles(sdk.WrapSDKContext(s.ctx), &assetTypes.MsgSetAppRoles{From: riskAdmin, AppId: 1, Roles: roles})
+	s.Require().ErrorIs(err, assetTypes.ErrorUnauthorizedAppRole)
+	_, err = server.SetAppRoles(sdk.WrapSDKContext(s.ctx), &assetTypes.MsgSetAppRoles{From: admin, AppId: 1, Roles: roles})
+	s.Require().NoError(err)
+
+	app, found := assetKeeper.GetApp(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(roles, app.Roles)
+	s.Require().NoError(assetKeeper.CheckAppRole(s.ctx, 1, treasury, assetTypes.AppRoleTreasury))
+	s.Require().ErrorIs(assetKeeper.CheckAppRoleIfSet(s.ctx, 1, stranger, assetTypes.AppRoleTreasury), assetTypes.ErrorUnauthorizedAppRole)
+}
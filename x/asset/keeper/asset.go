@@ -195,6 +195,14 @@ func (k Keeper) AddAssetRecords(ctx sdk.Context, msg types.Asset) error {
 	if !msg.IsOnChain && msg.IsCdpMintable {
 		return types.ErrorOffChainAssetCannotBeMintable
 	}
+	trace, err := k.validateAssetDenom(ctx, types.Asset{
+		Denom:     msg.Denom,
+		Decimals:  msg.Decimals,
+		IsOnChain: msg.IsOnChain,
+	})
+	if err != nil {
+		return err
+	}
 
 	var (
 		id    = k.GetAssetID(ctx)
@@ -216,6 +224,7 @@ func (k Keeper) AddAssetRecords(ctx sdk.Context, msg types.Asset) error {
 	k.SetAsset(ctx, asset)
 	k.SetAssetForDenom(ctx, asset.Denom, asset.Id)
 	k.SetAssetForName(ctx, asset.Name, asset.Id)
+	k.syncAssetDenom(ctx, asset, trace)
 
 	return nil
 }
@@ -252,6 +261,19 @@ func (k Keeper) UpdateAssetRecords(ctx sdk.Context, msg types.Asset) error {
 		return types.ErrorDuplicateAsset
 	}
 
+	decimals := asset.Decimals
+	if msg.Decimals.GT(sdk.ZeroInt()) {
+		decimals = msg.Decimals
+	}
+	trace, err := k.validateAssetDenom(ctx, types.Asset{
+		Denom:     msg.Denom,
+		Decimals:  decimals,
+		IsOnChain: asset.IsOnChain,
+	})
+	if err != nil {
+		return err
+	}
+
 	k.DeleteAssetForDenom(ctx, asset.Denom)
 	asset.Denom = msg.Denom
 	k.SetAssetForDenom(ctx, asset.Denom, asset.Id)
@@ -265,6 +287,7 @@ func (k Keeper) UpdateAssetRecords(ctx sdk.Context, msg types.Asset) error {
 	}
 
 	k.SetAsset(ctx, asset)
+	k.syncAssetDenom(ctx, asset, trace)
 	return nil
 }
 
@@ -291,6 +314,14 @@ func (k Keeper) AddAssetPairRecords(ctx sdk.Context, msg types.AssetPair) error
 	if !msg.IsOnChain && msg.IsCdpMintable {
 		return types.ErrorOffChainAssetCannotBeMintable
 	}
+	trace, err := k.validateAssetDenom(ctx, types.Asset{
+		Denom:     msg.Denom,
+		Decimals:  msg.Decimals,
+		IsOnChain: msg.IsOnChain,
+	})
+	if err != nil {
+		return err
+	}
 
 	var (
 		assetID = k.GetAssetID(ctx)
@@ -312,6 +343,7 @@ func (k Keeper) AddAssetPairRecords(ctx sdk.Context, msg types.AssetPair) error
 	k.SetAsset(ctx, asset)
 	k.SetAssetForDenom(ctx, asset.Denom, asset.Id)
 	k.SetAssetForName(ctx, asset.Name, asset.Id)
+	k.syncAssetDenom(ctx, asset, trace)
 
 	if !k.HasAsset(ctx, asset.Id) {
 		return types.ErrorAssetDoesNotExist
@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/comdex-official/comdex/app/wasm/bindings"
+	"github.com/comdex-official/comdex/x/asset"
 	"github.com/comdex-official/comdex/x/asset/keeper"
 	assetTypes "github.com/comdex-official/comdex/x/asset/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -872,6 +875,18 @@ func (s *KeeperTestSuite) TestWasmUpdatePairsVault() {
 				s.Require().EqualError(err, tc.ExpErr.Error())
 			} else {
 				s.Require().NoError(err)
+				pending, found := assetKeeper.GetPairsVault(*ctx, tc.extendedPairVault.ExtPairID)
+				s.Require().True(found)
+				s.Require().NotEqual(pending.MinCr, tc.extendedPairVault.MinCr)
+
+				// the update only takes effect once the timelock delay has passed
+				s.advanceseconds(int64(assetTypes.DefaultTimelockDelay.Seconds()))
+				asset.BeginBlocker(*ctx, abci.RequestBeginBlock{}, *assetKeeper)
+				updated, found := assetKeeper.GetPairsVault(*ctx, tc.extendedPairVault.ExtPairID)
+				s.Require().True(found)
+				s.Require().Equal(tc.extendedPairVault.MinCr, updated.MinCr)
+				s.Require().Equal(tc.extendedPairVault.StabilityFee, updated.StabilityFee)
+
 				afterVault, err := server.QueryExtendedPairVault(sdk.WrapSDKContext(*ctx), &assetTypes.QueryExtendedPairVaultRequest{Id: tc.vaultID})
 				s.Require().NoError(err)
 				s.Require().Equal(afterVault.PairVault.AppId, tc.extendedPairVault.AppID)
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/comdex-official/comdex/x/asset/types"
+)
+
+func (k Keeper) SetAssetDelisting(ctx sdk.Context, delisting types.Delisting) {
+	var (
+		store = k.Store(ctx)
+		key   = types.AssetDelistingKey(delisting.Id)
+		value = k.cdc.MustMarshal(&delisting)
+	)
+
+	store.Set(key, value)
+}
+
+func (k Keeper) GetAssetDelisting(ctx sdk.Context, assetID uint64) (delisting types.Delisting, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.AssetDelistingKey(assetID)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return delisting, false
+	}
+
+	k.cdc.MustUnmarshal(value, &delisting)
+	return delisting, true
+}
+
+func (k Keeper) GetAssetDelistings(ctx sdk.Context) []types.Delisting {
+	return k.getDelistings(ctx, types.AssetDelistingKeyPrefix)
+}
+
+func (k Keeper) SetPairDelisting(ctx sdk.Context, delisting types.Delisting) {
+	var (
+		store = k.Store(ctx)
+		key   = types.PairDelistingKey(delisting.Id)
+		value = k.cdc.MustMarshal(&delisting)
+	)
+
+	store.Set(key, value)
+}
+
+func (k Keeper) GetPairDelisting(ctx sdk.Context, pairID uint64) (delisting types.Delisting, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.PairDelistingKey(pairID)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return delisting, false
+	}
+
+	k.cdc.MustUnmarshal(value, &delisting)
+	return delisting, true
+}
+
+func (k Keeper) GetPairDelistings(ctx sdk.Context) []types.Delisting {
+	return k.getDelistings(ctx, types.PairDelistingKeyPrefix)
+}
+
+func (k Keeper) getDelistings(ctx sdk.Context, prefix []byte) (delistings []types.Delisting) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, prefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var delisting types.Delisting
+		k.cdc.MustUnmarshal(iter.Value(), &delisting)
+		delistings = append(delistings, delisting)
+	}
+
+	return delistings
+}
+
+// DelistAsset starts the delisting of an asset. It is frozen right away, the
+// ltv of positions in it ramps down after freezeDuration and they are closed
+// out rampDuration later.
+func (k Keeper) DelistAsset(ctx sdk.Context, assetID uint64, freezeDuration, rampDuration time.Duration) error {
+	if !k.HasAsset(ctx, assetID) {
+		return types.ErrorAssetDoesNotExist
+	}
+	if _, found := k.GetAssetDelisting(ctx, assetID); found {
+		return sdkerrors.Wrapf(types.ErrorAlreadyDelisting, "asset %d", assetID)
+	}
+
+	k.SetAssetDelisting(ctx, types.NewDelisting(assetID, ctx.BlockTime(), freezeDuration, rampDuration))
+	return nil
+}
+
+// DelistPair starts the delisting of a pair and with it of the vaults of its
+// extended pairs, the assets of the pair stay listed.
+func (k Keeper) DelistPair(ctx sdk.Context, pairID uint64, freezeDuration, rampDuration time.Duration) error {
+	if _, found := k.GetPair(ctx, pairID); !found {
+		return types.ErrorPairDoesNotExist
+	}
+	if _, found := k.GetPairDelisting(ctx, pairID); found {
+		return sdkerrors.Wrapf(types.ErrorAlreadyDelisting, "pair %d", pairID)
+	}
+
+	k.SetPairDelisting(ctx, types.NewDelisting(pairID, ctx.BlockTime(), freezeDuration, rampDuration))
+	return nil
+}
+
+// IsAssetDelisting reports whether new positions in the asset are frozen.
+func (k Keeper) IsAssetDelisting(ctx sdk.Context, assetID uint64) bool {
+	_, found := k.GetAssetDelisting(ctx, assetID)
+	return found
+}
+
+// IsPairDelisting reports whether new positions in the pair are frozen,
+// either for the pair itself or for one of its assets.
+func (k Keeper) IsPairDelisting(ctx sdk.Context, pairID uint64) bool {
+	if _, found := k.GetPairDelisting(ctx, pairID); found {
+		return true
+	}
+	pair, found := k.GetPair(ctx, pairID)
+	if !found {
+		return false
+	}
+	return k.IsAssetDelisting(ctx, pair.AssetIn) || k.IsAssetDelisting(ctx, pair.AssetOut)
+}
+
+// AssetDelistingLtvFactor is the share of their ltv that positions in the
+// asset keep, one unless the asset is being delisted.
+func (k Keeper) AssetDelistingLtvFactor(ctx sdk.Context, assetID uint64) sdk.Dec {
+	delisting, found := k.GetAssetDelisting(ctx, assetID)
+	if !found {
+		return sdk.OneDec()
+	}
+	return delisting.LtvFactor(ctx.BlockTime())
+}
+
+// PairDelistingLtvFactor is the lowest ltv factor of the pair and its assets.
+func (k Keeper) PairDelistingLtvFactor(ctx sdk.Context, pairID uint64) sdk.Dec {
+	factor := sdk.OneDec()
+	if delisting, found := k.GetPairDelisting(ctx, pairID); found {
+		factor = delisting.LtvFactor(ctx.BlockTime())
+	}
+	if pair, found := k.GetPair(ctx, pairID); found {
+		factor = sdk.MinDec(factor, k.AssetDelistingLtvFactor(ctx, pair.AssetIn))
+		factor = sdk.MinDec(factor, k.AssetDelistingLtvFactor(ctx, pair.AssetOut))
+	}
+	return factor
+}
+
+// DelistingMinCr is the min collateralization ratio of the vaults of an
+// extended pair, raised as the ltv of a delisted pair ramps down. closeOut
+// is set once the vaults are to be liquidated whatever their ratio.
+func (k Keeper) DelistingMinCr(ctx sdk.Context, extendedPairVault types.ExtendedPairVault) (minCr sdk.Dec, closeOut bool) {
+	factor := k.PairDelistingLtvFactor(ctx, extendedPairVault.PairId)
+	if !factor.IsPositive() {
+		return extendedPairVault.MinCr, true
+	}
+	return extendedPairVault.MinCr.Quo(factor), false
+}
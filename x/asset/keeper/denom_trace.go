@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+
+	"github.com/comdex-official/comdex/x/asset/types"
+)
+
+func (k Keeper) SetAssetDenomTrace(ctx sdk.Context, trace types.AssetDenomTrace) {
+	var (
+		store = k.Store(ctx)
+		key   = types.AssetDenomTraceKey(trace.AssetId)
+		value = k.cdc.MustMarshal(&trace)
+	)
+
+	store.Set(key, value)
+}
+
+func (k Keeper) GetAssetDenomTrace(ctx sdk.Context, assetID uint64) (trace types.AssetDenomTrace, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.AssetDenomTraceKey(assetID)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return trace, false
+	}
+
+	k.cdc.MustUnmarshal(value, &trace)
+	return trace, true
+}
+
+func (k Keeper) DeleteAssetDenomTrace(ctx sdk.Context, assetID uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.AssetDenomTraceKey(assetID)
+	)
+
+	store.Delete(key)
+}
+
+func (k Keeper) GetAssetDenomTraces(ctx sdk.Context) (traces []types.AssetDenomTrace) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.AssetDenomTraceKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var trace types.AssetDenomTrace
+		k.cdc.MustUnmarshal(iter.Value(), &trace)
+		traces = append(traces, trace)
+	}
+
+	return traces
+}
+
+// validateAssetDenom checks the decimals of the asset against the denom
+// metadata of its denom, and resolves ibc denoms to their denom trace.
+func (k Keeper) validateAssetDenom(ctx sdk.Context, asset types.Asset) (*types.AssetDenomTrace, error) {
+	exponent, ok := types.DecimalsExponent(asset.Decimals)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrorInvalidDecimals, "decimals %s must be a power of ten", asset.Decimals)
+	}
+	if metadata, found := k.bank.GetDenomMetaData(ctx, asset.Denom); found {
+		if metadataExponent, found := types.MetadataExponent(metadata); found && metadataExponent != exponent {
+			return nil, sdkerrors.Wrapf(types.ErrorDecimalsMismatch, "%s has %d decimal places, not %d", asset.Denom, metadataExponent, exponent)
+		}
+	}
+
+	if !types.IsIBCDenom(asset.Denom) {
+		return nil, nil
+	}
+	if asset.IsOnChain {
+		return nil, types.ErrorIBCAssetOnChain
+	}
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(asset.Denom, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrorInvalidIBCDenom, err.Error())
+	}
+	denomTrace, found := k.transfer.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrorDenomTraceNotFound, "denom %s", asset.Denom)
+	}
+	trace := types.NewAssetDenomTrace(asset.Id, asset.Denom, denomTrace)
+	return &trace, nil
+}
+
+// syncAssetDenom records the denom trace of an ibc asset, and keeps the denom
+// metadata of assets minted on chain in line with the asset.
+func (k Keeper) syncAssetDenom(ctx sdk.Context, asset types.Asset, trace *types.AssetDenomTrace) {
+	k.DeleteAssetDenomTrace(ctx, asset.Id)
+	if trace != nil {
+		trace.AssetId = asset.Id
+		k.SetAssetDenomTrace(ctx, *trace)
+	}
+
+	if !asset.IsOnChain {
+		return
+	}
+	exponent, _ := types.DecimalsExponent(asset.Decimals)
+	metadata, _ := k.bank.GetDenomMetaData(ctx, asset.Denom)
+	k.bank.SetDenomMetaData(ctx, types.AssetMetadata(metadata, asset, exponent))
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+
+	assetTypes "github.com/comdex-official/comdex/x/asset/types"
+)
+
+func (s *KeeperTestSuite) TestAssetDenomMetadata() {
+	assetKeeper := &s.assetKeeper
+
+	// Assets minted on chain get their bank metadata.
+	s.Require().NoError(assetKeeper.AddAssetRecords(s.ctx, assetTypes.Asset{Name: "CMST", Denom: "ucmst", Decimals: sdk.NewInt(1000000), IsOnChain: true}))
+	metadata, found := s.app.BankKeeper.GetDenomMetaData(s.ctx, "ucmst")
+	s.Require().True(found)
+	s.Require().NoError(metadata.Validate())
+	s.Require().Equal("cmst", metadata.Display)
+	s.Require().Equal("CMST", metadata.Symbol)
+	exponent, found := assetTypes.MetadataExponent(metadata)
+	s.Require().True(found)
+	s.Require().Equal(uint32(6), exponent)
+
+	// Decimals must be a power of ten agreeing with existing metadata.
+	s.Require().ErrorIs(assetKeeper.AddAssetRecords(s.ctx, assetTypes.Asset{Name: "HARBOR", Denom: "uharbor", Decimals: sdk.NewInt(1500000)}), assetTypes.ErrorInvalidDecimals)
+	s.app.BankKeeper.SetDenomMetaData(s.ctx, banktypes.Metadata{
+		Base:       "uatom",
+		Display:    "atom",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}},
+	})
+	s.Require().ErrorIs(assetKeeper.AddAssetRecords(s.ctx, assetTypes.Asset{Name: "ATOM", Denom: "uatom", Decimals: sdk.NewInt(100)}), assetTypes.ErrorDecimalsMismatch)
+	s.Require().NoError(assetKeeper.AddAssetRecords(s.ctx, assetTypes.Asset{Name: "ATOM", Denom: "uatom", Decimals: sdk.NewInt(1000000)}))
+	s.Require().ErrorIs(assetKeeper.UpdateAssetRecords(s.ctx, assetTypes.Asset{Id: 2, Name: "ATOM", Denom: "uatom", Decimals: sdk.NewInt(100)}), assetTypes.ErrorDecimalsMismatch)
+}
+
+func (s *KeeperTestSuite) TestAssetDenomTrace() {
+	assetKeeper := &s.assetKeeper
+
+	denomTrace := ibctransfertypes.DenomTrace{Path: "transfer/channel-1/transfer/channel-7", BaseDenom: "uosmo"}
+	osmo := assetTypes.Asset{Name: "OSMO", Denom: denomTrace.IBCDenom(), Decimals: sdk.NewInt(1000000), IsOraclePriceRequired: true}
+
+	invalid := osmo
+	invalid.Denom = "ibc/XYZ"
+	s.Require().ErrorIs(assetKeeper.AddAssetRecords(s.ctx, invalid), assetTypes.ErrorInvalidIBCDenom)
+	s.Require().ErrorIs(assetKeeper.AddAssetRecords(s.ctx, osmo), assetTypes.ErrorDenomTraceNotFound)
+
+	s.app.IbcTransferKeeper.SetDenomTrace(s.ctx, denomTrace)
+	invalid = osmo
+	invalid.IsOnChain = true
+	s.Require().ErrorIs(assetKeeper.AddAssetRecords(s.ctx, invalid), assetTypes.ErrorIBCAssetOnChain)
+	s.Require().NoError(assetKeeper.AddAssetRecords(s.ctx, osmo))
+
+	res, err := s.querier.QueryAsset(sdk.WrapSDKContext(s.ctx), &assetTypes.QueryAssetRequest{Id: 1})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.DenomTrace)
+	s.Require().Equal(assetTypes.AssetDenomTrace{
+		AssetId:   1,
+		Denom:     osmo.Denom,
+		Path:      "transfer/channel-1/transfer/channel-7",
+		BaseDenom: "uosmo",
+		ChannelId: "channel-1",
+	}, *res.DenomTrace)
+
+	// The trace goes away once the asset is no longer an ibc asset.
+	s.Require().NoError(assetKeeper.UpdateAssetRecords(s.ctx, assetTypes.Asset{Id: 1, Name: "OSMO", Denom: "uosmo", Decimals: sdk.NewInt(1000000)}))
+	res, err = s.querier.QueryAsset(sdk.WrapSDKContext(s.ctx), &assetTypes.QueryAssetRequest{Id: 1})
+	s.Require().NoError(err)
+	s.Require().Nil(res.DenomTrace)
+}
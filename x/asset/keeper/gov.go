@@ -45,3 +45,43 @@ func (k Keeper) HandleAddAssetInAppRecords(ctx sdk.Context, p *types.AddAssetInA
 func (k Keeper) HandleProposalAddMultipleAssetPair(ctx sdk.Context, p *types.AddMultipleAssetsPairsProposal) error {
 	return k.AddMultipleAssetPairRecords(ctx, p.AssetsPair...)
 }
+
+func (k Keeper) HandleProposalSetStabilityFeeController(ctx sdk.Context, p *types.SetStabilityFeeControllerProposal) error {
+	return k.AddStabilityFeeController(ctx, p.Controller)
+}
+
+func (k Keeper) HandleProposalRemoveStabilityFeeController(ctx sdk.Context, p *types.RemoveStabilityFeeControllerProposal) error {
+	return k.RemoveStabilityFeeController(ctx, p.ExtendedPairVaultId)
+}
+
+func (k Keeper) HandleProposalSetVaultCollateralAsset(ctx sdk.Context, p *types.SetVaultCollateralAssetProposal) error {
+	return k.AddVaultCollateralAsset(ctx, p.Collateral)
+}
+
+func (k Keeper) HandleProposalRemoveVaultCollateralAsset(ctx sdk.Context, p *types.RemoveVaultCollateralAssetProposal) error {
+	return k.RemoveVaultCollateralAsset(ctx, p.ExtendedPairVaultId, p.AssetId, p.FreezeDuration, p.RampDuration)
+}
+
+func (k Keeper) HandleProposalDelistAsset(ctx sdk.Context, p *types.DelistAssetProposal) error {
+	return k.DelistAsset(ctx, p.AssetId, p.FreezeDuration, p.RampDuration)
+}
+
+func (k Keeper) HandleProposalDelistPair(ctx sdk.Context, p *types.DelistPairProposal) error {
+	return k.DelistPair(ctx, p.PairId, p.FreezeDuration, p.RampDuration)
+}
+
+func (k Keeper) HandleProposalCancelPendingChange(ctx sdk.Context, p *types.CancelPendingChangeProposal) error {
+	return k.CancelPendingChange(ctx, p.Id)
+}
+
+func (k Keeper) HandleProposalSetTimelockDelay(ctx sdk.Context, p *types.SetTimelockDelayProposal) error {
+	if err := p.Delay.Validate(); err != nil {
+		return err
+	}
+	k.SetTimelockDelay(ctx, p.Delay)
+	return nil
+}
+
+func (k Keeper) HandleProposalSetAppRoles(ctx sdk.Context, p *types.SetAppRolesProposal) error {
+	return k.SetAppRoles(ctx, p.AppId, p.Roles)
+}
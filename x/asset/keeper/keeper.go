@@ -17,9 +17,13 @@ type Keeper struct {
 	rewards    expected.RewardsKeeper
 	vault      expected.VaultKeeper
 	bandoracle expected.Bandoraclekeeper
+	market     expected.MarketKeeper
+	liquidity  expected.LiquidityKeeper
+	bank       expected.BankKeeper
+	transfer   expected.TransferKeeper
 }
 
-func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, params paramstypes.Subspace, rewards expected.RewardsKeeper, vault expected.VaultKeeper, bandoracle expected.Bandoraclekeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, params paramstypes.Subspace, rewards expected.RewardsKeeper, vault expected.VaultKeeper, bandoracle expected.Bandoraclekeeper, market expected.MarketKeeper, liquidity expected.LiquidityKeeper, bank expected.BankKeeper, transfer expected.TransferKeeper) Keeper {
 	if !params.HasKeyTable() {
 		params = params.WithKeyTable(assettypes.ParamKeyTable())
 	}
@@ -31,6 +35,10 @@ func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, params paramstypes.Subsp
 		rewards:    rewards,
 		vault:      vault,
 		bandoracle: bandoracle,
+		market:     market,
+		liquidity:  liquidity,
+		bank:       bank,
+		transfer:   transfer,
 	}
 }
 
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/asset/types"
+)
+
+var (
+	_ types.MsgServer = (*msgServer)(nil)
+)
+
+type msgServer struct {
+	keeper Keeper
+}
+
+func NewMsgServer(keeper Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+func (m msgServer) SetAppRoles(goCtx context.Context, msg *types.MsgSetAppRoles) (*types.MsgSetAppRolesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.keeper.CheckAppRole(ctx, msg.AppId, msg.From, types.AppRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SetAppRoles(ctx, msg.AppId, msg.Roles); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAppRolesResponse{}, nil
+}
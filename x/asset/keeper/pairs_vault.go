@@ -237,41 +237,66 @@ func (k Keeper) WasmAddExtendedPairsVaultRecordsQuery(ctx sdk.Context, appID, pa
 	return true, ""
 }
 
+// WasmUpdatePairsVault schedules the update of the extended pair vault, which
+// takes effect once the timelock delay of the class has passed.
 func (k Keeper) WasmUpdatePairsVault(ctx sdk.Context, updatePairVault *bindings.MsgUpdatePairsVault) error {
 	ExtPairVaultData, found := k.GetPairsVault(ctx, updatePairVault.ExtPairID)
 	if !found {
 		return types.ErrorPairDoesNotExist
 	}
-	_, found1 := k.rewards.GetAppIDByApp(ctx, updatePairVault.AppID)
+	ExtPairVaultData.StabilityFee = updatePairVault.StabilityFee
+	ExtPairVaultData.ClosingFee = updatePairVault.ClosingFee
+	ExtPairVaultData.LiquidationPenalty = updatePairVault.LiquidationPenalty
+	ExtPairVaultData.DrawDownFee = updatePairVault.DrawDownFee
+	ExtPairVaultData.IsVaultActive = updatePairVault.IsVaultActive
+	ExtPairVaultData.DebtCeiling = updatePairVault.DebtCeiling
+	ExtPairVaultData.DebtFloor = updatePairVault.DebtFloor
+	ExtPairVaultData.MinCr = updatePairVault.MinCr
+	ExtPairVaultData.MinUsdValueLeft = updatePairVault.MinUsdValueLeft
+
+	_, err := k.ScheduleChange(ctx, types.ParamClassExtendedPairVault, &ExtPairVaultData)
+	return err
+}
+
+// UpdatePairsVault applies a scheduled update of an extended pair vault.
+func (k Keeper) UpdatePairsVault(ctx sdk.Context, update types.ExtendedPairVault) error {
+	ExtPairVaultData, found := k.GetPairsVault(ctx, update.Id)
+	if !found {
+		return types.ErrorPairDoesNotExist
+	}
+	_, found1 := k.rewards.GetAppIDByApp(ctx, ExtPairVaultData.AppId)
 	if found1 {
-		if ExtPairVaultData.StabilityFee != updatePairVault.StabilityFee && !ExtPairVaultData.IsStableMintVault {
-			if updatePairVault.StabilityFee.IsZero() {
+		if ExtPairVaultData.StabilityFee != update.StabilityFee && !ExtPairVaultData.IsStableMintVault {
+			if update.StabilityFee.IsZero() {
 				// run script to distrubyte reward
-				k.VaultIterateRewards(ctx, ExtPairVaultData.StabilityFee, ExtPairVaultData.BlockHeight, ExtPairVaultData.BlockTime.Unix(), updatePairVault.AppID, ExtPairVaultData.Id, false)
+				k.VaultIterateRewards(ctx, ExtPairVaultData, false)
 				ExtPairVaultData.BlockTime = ctx.BlockTime()
 				ExtPairVaultData.BlockHeight = 0
 			} else if ExtPairVaultData.StabilityFee.IsZero() {
 				// do nothing
 				ExtPairVaultData.BlockHeight = ctx.BlockHeight()
 				ExtPairVaultData.BlockTime = ctx.BlockTime()
-			} else if ExtPairVaultData.StabilityFee.GT(sdk.ZeroDec()) && updatePairVault.StabilityFee.GT(sdk.ZeroDec()) {
+			} else if ExtPairVaultData.StabilityFee.GT(sdk.ZeroDec()) && update.StabilityFee.GT(sdk.ZeroDec()) {
 				// run script to distribute
-				k.VaultIterateRewards(ctx, ExtPairVaultData.StabilityFee, ExtPairVaultData.BlockHeight, ExtPairVaultData.BlockTime.Unix(), updatePairVault.AppID, ExtPairVaultData.Id, true)
+				k.VaultIterateRewards(ctx, ExtPairVaultData, true)
 				ExtPairVaultData.BlockHeight = ctx.BlockHeight()
 				ExtPairVaultData.BlockTime = ctx.BlockTime()
 			}
 		}
 	}
 
-	ExtPairVaultData.StabilityFee = updatePairVault.StabilityFee
-	ExtPairVaultData.ClosingFee = updatePairVault.ClosingFee
-	ExtPairVaultData.LiquidationPenalty = updatePairVault.LiquidationPenalty
-	ExtPairVaultData.DrawDownFee = updatePairVault.DrawDownFee
-	ExtPairVaultData.IsVaultActive = updatePairVault.IsVaultActive
-	ExtPairVaultData.DebtCeiling = updatePairVault.DebtCeiling
-	ExtPairVaultData.DebtFloor = updatePairVault.DebtFloor
-	ExtPairVaultData.MinCr = updatePairVault.MinCr
-	ExtPairVaultData.MinUsdValueLeft = updatePairVault.MinUsdValueLeft
+	if !ExtPairVaultData.StabilityFee.Equal(update.StabilityFee) {
+		k.recordStabilityFeeAdjustment(ctx, ExtPairVaultData, update.StabilityFee, sdk.ZeroDec())
+	}
+	ExtPairVaultData.StabilityFee = update.StabilityFee
+	ExtPairVaultData.ClosingFee = update.ClosingFee
+	ExtPairVaultData.LiquidationPenalty = update.LiquidationPenalty
+	ExtPairVaultData.DrawDownFee = update.DrawDownFee
+	ExtPairVaultData.IsVaultActive = update.IsVaultActive
+	ExtPairVaultData.DebtCeiling = update.DebtCeiling
+	ExtPairVaultData.DebtFloor = update.DebtFloor
+	ExtPairVaultData.MinCr = update.MinCr
+	ExtPairVaultData.MinUsdValueLeft = update.MinUsdValueLeft
 
 	k.SetPairsVault(ctx, ExtPairVaultData)
 
@@ -300,8 +325,11 @@ func (k Keeper) WasmCheckWhitelistedAssetQuery(ctx sdk.Context, denom string) (f
 	return found
 }
 
-func (k Keeper) VaultIterateRewards(ctx sdk.Context, collectorLsr sdk.Dec, collectorBh, collectorBt int64, appID, pairVaultID uint64, changeTypes bool) {
-	extPairVault, found := k.vault.GetAppExtendedPairVaultMappingData(ctx, appID, pairVaultID)
+// VaultIterateRewards settles the interest of the vaults of the extended pair
+// vault up to now, before its stability fee is changed.
+func (k Keeper) VaultIterateRewards(ctx sdk.Context, extPair types.ExtendedPairVault, changeTypes bool) {
+	appID := extPair.AppId
+	extPairVault, found := k.vault.GetAppExtendedPairVaultMappingData(ctx, appID, extPair.Id)
 	if found {
 		for _, valID := range extPairVault.VaultIds {
 			vaultData, found := k.vault.GetVault(ctx, valID)
@@ -311,12 +339,12 @@ func (k Keeper) VaultIterateRewards(ctx sdk.Context, collectorLsr sdk.Dec, colle
 			var interest sdk.Dec
 			var err error
 			if vaultData.BlockHeight == 0 {
-				interest, err = k.rewards.CalculationOfRewards(ctx, vaultData.AmountOut, collectorLsr, collectorBt)
+				interest, err = k.rewards.CalculationOfVaultInterest(ctx, extPair, vaultData.AmountOut, extPair.BlockTime.Unix())
 				if err != nil {
 					return
 				}
 			} else {
-				interest, err = k.rewards.CalculationOfRewards(ctx, vaultData.AmountOut, collectorLsr, vaultData.BlockTime.Unix())
+				interest, err = k.rewards.CalculationOfVaultInterest(ctx, extPair, vaultData.AmountOut, vaultData.BlockTime.Unix())
 				if err != nil {
 					return
 				}
@@ -72,8 +72,14 @@ func (q QueryServer) QueryAsset(c context.Context, req *types.QueryAssetRequest)
 		return nil, status.Errorf(codes.NotFound, "asset does not exist for id %d", req.Id)
 	}
 
+	var denomTrace *types.AssetDenomTrace
+	if trace, found := q.GetAssetDenomTrace(ctx, item.Id); found {
+		denomTrace = &trace
+	}
+
 	return &types.QueryAssetResponse{
-		Asset: item,
+		Asset:      item,
+		DenomTrace: denomTrace,
 	}, nil
 }
 
@@ -405,3 +411,107 @@ func (q QueryServer) QueryExtendedPairVaultsByAppWithoutStable(c context.Context
 		Pagination:   pagination,
 	}, nil
 }
+
+func (q QueryServer) QueryStabilityFeeController(c context.Context, req *types.QueryStabilityFeeControllerRequest) (*types.QueryStabilityFeeControllerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	controller, found := q.GetStabilityFeeController(ctx, req.ExtendedPairVaultId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "stability fee controller does not exist for extended pair vault %d", req.ExtendedPairVaultId)
+	}
+	state, _ := q.GetStabilityFeeControllerState(ctx, req.ExtendedPairVaultId)
+
+	return &types.QueryStabilityFeeControllerResponse{
+		Controller: controller,
+		State:      state,
+	}, nil
+}
+
+func (q QueryServer) QueryStabilityFeeAdjustments(c context.Context, req *types.QueryStabilityFeeAdjustmentsRequest) (*types.QueryStabilityFeeAdjustmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	var (
+		items []types.StabilityFeeAdjustment
+		ctx   = sdk.UnwrapSDKContext(c)
+	)
+
+	pagination, err := query.FilteredPaginate(
+		prefix.NewStore(q.Store(ctx), types.StabilityFeeAdjustmentsKey(req.ExtendedPairVaultId)),
+		req.Pagination,
+		func(_, value []byte, accumulate bool) (bool, error) {
+			var item types.StabilityFeeAdjustment
+			if err := q.cdc.Unmarshal(value, &item); err != nil {
+				return false, err
+			}
+
+			if accumulate {
+				items = append(items, item)
+			}
+
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStabilityFeeAdjustmentsResponse{
+		Adjustments: items,
+		Pagination:  pagination,
+	}, nil
+}
+
+func (q QueryServer) QueryVaultCollateralAssets(c context.Context, req *types.QueryVaultCollateralAssetsRequest) (*types.QueryVaultCollateralAssetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryVaultCollateralAssetsResponse{
+		Collaterals: q.GetVaultCollateralAssets(ctx, req.ExtendedPairVaultId),
+	}, nil
+}
+
+func (q QueryServer) QueryDelistings(c context.Context, req *types.QueryDelistingsRequest) (*types.QueryDelistingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDelistingsResponse{
+		AssetDelistings: q.GetAssetDelistings(ctx),
+		PairDelistings:  q.GetPairDelistings(ctx),
+	}, nil
+}
+
+func (q QueryServer) QueryPendingChanges(c context.Context, req *types.QueryPendingChangesRequest) (*types.QueryPendingChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPendingChangesResponse{
+		PendingChanges: q.GetPendingChanges(ctx, req.ParamClass),
+	}, nil
+}
+
+func (q QueryServer) QueryTimelockDelays(c context.Context, req *types.QueryTimelockDelaysRequest) (*types.QueryTimelockDelaysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTimelockDelaysResponse{
+		Delays: q.GetTimelockDelays(ctx),
+	}, nil
+}
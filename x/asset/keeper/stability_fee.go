@@ -0,0 +1,429 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	protobuftypes "github.com/gogo/protobuf/types"
+
+	"github.com/comdex-official/comdex/x/asset/types"
+)
+
+func (k Keeper) SetStabilityFeeController(ctx sdk.Context, controller types.StabilityFeeController) {
+	var (
+		store = k.Store(ctx)
+		key   = types.StabilityFeeControllerKey(controller.ExtendedPairVaultId)
+		value = k.cdc.MustMarshal(&controller)
+	)
+
+	store.Set(key, value)
+}
+
+func (k Keeper) GetStabilityFeeController(ctx sdk.Context, extendedPairVaultID uint64) (controller types.StabilityFeeController, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.StabilityFeeControllerKey(extendedPairVaultID)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return controller, false
+	}
+
+	k.cdc.MustUnmarshal(value, &controller)
+	return controller, true
+}
+
+func (k Keeper) DeleteStabilityFeeController(ctx sdk.Context, extendedPairVaultID uint64) {
+	store := k.Store(ctx)
+	store.Delete(types.StabilityFeeControllerKey(extendedPairVaultID))
+}
+
+func (k Keeper) GetStabilityFeeControllers(ctx sdk.Context) (controllers []types.StabilityFeeController) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.StabilityFeeControllerKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var controller types.StabilityFeeController
+		k.cdc.MustUnmarshal(iter.Value(), &controller)
+		controllers = append(controllers, controller)
+	}
+
+	return controllers
+}
+
+func (k Keeper) SetStabilityFeeControllerState(ctx sdk.Context, state types.StabilityFeeControllerState) {
+	var (
+		store = k.Store(ctx)
+		key   = types.StabilityFeeControllerStateKey(state.ExtendedPairVaultId)
+		value = k.cdc.MustMarshal(&state)
+	)
+
+	store.Set(key, value)
+}
+
+func (k Keeper) GetStabilityFeeControllerState(ctx sdk.Context, extendedPairVaultID uint64) (state types.StabilityFeeControllerState, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.StabilityFeeControllerStateKey(extendedPairVaultID)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return state, false
+	}
+
+	k.cdc.MustUnmarshal(value, &state)
+	return state, true
+}
+
+func (k Keeper) DeleteStabilityFeeControllerState(ctx sdk.Context, extendedPairVaultID uint64) {
+	store := k.Store(ctx)
+	store.Delete(types.StabilityFeeControllerStateKey(extendedPairVaultID))
+}
+
+func (k Keeper) GetStabilityFeeControllerStates(ctx sdk.Context) (states []types.StabilityFeeControllerState) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.StabilityFeeControllerStateKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var state types.StabilityFeeControllerState
+		k.cdc.MustUnmarshal(iter.Value(), &state)
+		states = append(states, state)
+	}
+
+	return states
+}
+
+func (k Keeper) GetStabilityFeeAdjustmentID(ctx sdk.Context) uint64 {
+	var (
+		store = k.Store(ctx)
+		key   = types.StabilityFeeAdjustmentIDKey
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return 0
+	}
+
+	var id protobuftypes.UInt64Value
+	k.cdc.MustUnmarshal(value, &id)
+
+	return id.GetValue()
+}
+
+func (k Keeper) SetStabilityFeeAdjustmentID(ctx sdk.Context, id uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.StabilityFeeAdjustmentIDKey
+		value = k.cdc.MustMarshal(
+			&protobuftypes.UInt64Value{
+				Value: id,
+			},
+		)
+	)
+
+	store.Set(key, value)
+}
+
+func (k Keeper) SetStabilityFeeAdjustment(ctx sdk.Context, adjustment types.StabilityFeeAdjustment) {
+	var (
+		store = k.Store(ctx)
+		key   = types.StabilityFeeAdjustmentKey(adjustment.ExtendedPairVaultId, adjustment.Id)
+		value = k.cdc.MustMarshal(&adjustment)
+	)
+
+	store.Set(key, value)
+}
+
+func (k Keeper) GetStabilityFeeAdjustments(ctx sdk.Context) (adjustments []types.StabilityFeeAdjustment) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.StabilityFeeAdjustmentKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var adjustment types.StabilityFeeAdjustment
+		k.cdc.MustUnmarshal(iter.Value(), &adjustment)
+		adjustments = append(adjustments, adjustment)
+	}
+
+	return adjustments
+}
+
+// GetStabilityFeeAdjustmentsSince returns the stability fee adjustments of the
+// extended pair vault made after since, oldest first.
+func (k Keeper) GetStabilityFeeAdjustmentsSince(ctx sdk.Context, extendedPairVaultID uint64, since time.Time) (adjustments []types.StabilityFeeAdjustment) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStoreReversePrefixIterator(store, types.StabilityFeeAdjustmentsKey(extendedPairVaultID))
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var adjustment types.StabilityFeeAdjustment
+		k.cdc.MustUnmarshal(iter.Value(), &adjustment)
+		if !adjustment.Time.After(since) {
+			break
+		}
+		adjustments = append(adjustments, adjustment)
+	}
+
+	for i, j := 0, len(adjustments)-1; i < j; i, j = i+1, j-1 {
+		adjustments[i], adjustments[j] = adjustments[j], adjustments[i]
+	}
+	return adjustments
+}
+
+// recordStabilityFeeAdjustment stores the change of the extended pair vault's
+// stability fee to fee, so that interest can later be computed with the fee in
+// force over each period.
+func (k Keeper) recordStabilityFeeAdjustment(ctx sdk.Context, extPair types.ExtendedPairVault, fee, price sdk.Dec) {
+	id := k.GetStabilityFeeAdjustmentID(ctx) + 1
+	k.SetStabilityFeeAdjustmentID(ctx, id)
+	k.SetStabilityFeeAdjustment(ctx, types.StabilityFeeAdjustment{
+		Id:                  id,
+		AppId:               extPair.AppId,
+		ExtendedPairVaultId: extPair.Id,
+		PreviousFee:         extPair.StabilityFee,
+		StabilityFee:        fee,
+		Price:               price,
+		Time:                ctx.BlockTime(),
+		Height:              ctx.BlockHeight(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStabilityFeeAdjusted,
+			sdk.NewAttribute(types.AttributeKeyAppID, strconv.FormatUint(extPair.AppId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExtendedPairVaultID, strconv.FormatUint(extPair.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPreviousFee, extPair.StabilityFee.String()),
+			sdk.NewAttribute(types.AttributeKeyStabilityFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+		),
+	)
+}
+
+// adjustStabilityFee sets the stability fee of the extended pair vault to fee
+// on behalf of its controller.
+func (k Keeper) adjustStabilityFee(ctx sdk.Context, extPair types.ExtendedPairVault, fee, price sdk.Dec) {
+	if extPair.StabilityFee.Equal(fee) {
+		return
+	}
+	k.recordStabilityFeeAdjustment(ctx, extPair, fee, price)
+	if extPair.StabilityFee.IsZero() {
+		// Interest of the vaults starts accruing from now on.
+		extPair.BlockHeight = ctx.BlockHeight()
+		extPair.BlockTime = ctx.BlockTime()
+	}
+	extPair.StabilityFee = fee
+	k.SetPairsVault(ctx, extPair)
+}
+
+// stablecoinDenom returns the denom of the asset minted by the extended pair
+// vault.
+func (k Keeper) stablecoinDenom(ctx sdk.Context, extPair types.ExtendedPairVault) (string, bool) {
+	pair, found := k.GetPair(ctx, extPair.PairId)
+	if !found {
+		return "", false
+	}
+	asset, found := k.GetAsset(ctx, pair.AssetOut)
+	if !found {
+		return "", false
+	}
+	return asset.Denom, true
+}
+
+func (k Keeper) AddStabilityFeeController(ctx sdk.Context, controller types.StabilityFeeController) error {
+	if err := controller.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrorInvalidStabilityFeeController, err.Error())
+	}
+	extPair, found := k.GetPairsVault(ctx, controller.ExtendedPairVaultId)
+	if !found {
+		return types.ErrorPairDoesNotExist
+	}
+	if extPair.AppId != controller.AppId {
+		return types.ErrorExtendedPairDoesNotExistForTheApp
+	}
+	if extPair.IsStableMintVault {
+		return sdkerrors.Wrap(types.ErrorInvalidStabilityFeeController, "stable mint vaults charge no stability fee")
+	}
+	if controller.LiquidityPairId != 0 {
+		denom, found := k.stablecoinDenom(ctx, extPair)
+		if !found {
+			return types.ErrorAssetDoesNotExist
+		}
+		pair, found := k.liquidity.GetPair(ctx, controller.AppId, controller.LiquidityPairId)
+		if !found {
+			return types.ErrorPairDoesNotExist
+		}
+		if pair.BaseCoinDenom != denom && pair.QuoteCoinDenom != denom {
+			return sdkerrors.Wrapf(types.ErrorInvalidStabilityFeeController, "liquidity pair %d does not trade %s", pair.Id, denom)
+		}
+	}
+
+	k.SetStabilityFeeController(ctx, controller)
+	k.SetStabilityFeeControllerState(ctx, types.StabilityFeeControllerState{
+		ExtendedPairVaultId: controller.ExtendedPairVaultId,
+		EpochStartTime:      ctx.BlockTime(),
+		LastSampleTime:      ctx.BlockTime(),
+		CumulativePrice:     sdk.ZeroDec(),
+	})
+
+	// The fee is brought within the controller's bounds right away.
+	fee := sdk.MinDec(sdk.MaxDec(extPair.StabilityFee, controller.MinFee), controller.MaxFee)
+	k.adjustStabilityFee(ctx, extPair, fee, sdk.ZeroDec())
+	return nil
+}
+
+func (k Keeper) RemoveStabilityFeeController(ctx sdk.Context, extendedPairVaultID uint64) error {
+	if _, found := k.GetStabilityFeeController(ctx, extendedPairVaultID); !found {
+		return types.ErrorStabilityFeeControllerNotFound
+	}
+	k.DeleteStabilityFeeController(ctx, extendedPairVaultID)
+	k.DeleteStabilityFeeControllerState(ctx, extendedPairVaultID)
+	return nil
+}
+
+// liquidityPairPrice returns the last price of the stablecoin on the
+// controller's liquidity pair, in units of the other asset of the pair.
+func (k Keeper) liquidityPairPrice(ctx sdk.Context, controller types.StabilityFeeController, extPair types.ExtendedPairVault) (sdk.Dec, bool) {
+	denom, found := k.stablecoinDenom(ctx, extPair)
+	if !found {
+		return sdk.Dec{}, false
+	}
+	pair, found := k.liquidity.GetPair(ctx, controller.AppId, controller.LiquidityPairId)
+	if !found || pair.LastPrice == nil || !pair.LastPrice.IsPositive() {
+		return sdk.Dec{}, false
+	}
+	base, found := k.GetAssetForDenom(ctx, pair.BaseCoinDenom)
+	if !found {
+		return sdk.Dec{}, false
+	}
+	quote, found := k.GetAssetForDenom(ctx, pair.QuoteCoinDenom)
+	if !found {
+		return sdk.Dec{}, false
+	}
+	// The pair's price is in quote coin units per base coin unit.
+	price := pair.LastPrice.MulInt(base.Decimals).QuoInt(quote.Decimals)
+	switch denom {
+	case pair.BaseCoinDenom:
+		return price, true
+	case pair.QuoteCoinDenom:
+		return sdk.OneDec().Quo(price), true
+	}
+	return sdk.Dec{}, false
+}
+
+// oraclePrice returns the oracle price of the stablecoin in USD.
+func (k Keeper) oraclePrice(ctx sdk.Context, extPair types.ExtendedPairVault) (sdk.Dec, bool) {
+	pair, found := k.GetPair(ctx, extPair.PairId)
+	if !found {
+		return sdk.Dec{}, false
+	}
+	twa, found := k.market.GetTwa(ctx, pair.AssetOut)
+	if !found || !twa.IsPriceActive || twa.Twa == 0 {
+		return sdk.Dec{}, false
+	}
+	return sdk.NewDec(int64(twa.Twa)).QuoInt64(1000000), true
+}
+
+// UpdateStabilityFees samples the stablecoin price of every stability fee
+// controller and, at the end of a controller's epoch, moves the stability fee
+// of its extended pair vault one step toward the target price: up when the
+// stablecoin trades below the target, down when it trades above.
+func (k Keeper) UpdateStabilityFees(ctx sdk.Context) {
+	now := ctx.BlockTime()
+	for _, controller := range k.GetStabilityFeeControllers(ctx) {
+		extPair, found := k.GetPairsVault(ctx, controller.ExtendedPairVaultId)
+		if !found {
+			continue
+		}
+		state, found := k.GetStabilityFeeControllerState(ctx, controller.ExtendedPairVaultId)
+		if !found {
+			state = types.StabilityFeeControllerState{
+				ExtendedPairVaultId: controller.ExtendedPairVaultId,
+				EpochStartTime:      now,
+				LastSampleTime:      now,
+				CumulativePrice:     sdk.ZeroDec(),
+			}
+		}
+
+		// The pair's last price held since the last sample.
+		if controller.LiquidityPairId != 0 && now.After(state.LastSampleTime) {
+			if price, ok := k.liquidityPairPrice(ctx, controller, extPair); ok {
+				elapsed := int64(now.Sub(state.LastSampleTime) / time.Second)
+				state.CumulativePrice = state.CumulativePrice.Add(price.MulInt64(elapsed))
+				state.LastSampleTime = state.LastSampleTime.Add(time.Duration(elapsed) * time.Second)
+			}
+		}
+
+		if now.Before(state.EpochStartTime.Add(controller.EpochDuration)) {
+			k.SetStabilityFeeControllerState(ctx, state)
+			continue
+		}
+
+		var (
+			price sdk.Dec
+			ok    bool
+		)
+		if controller.LiquidityPairId != 0 {
+			if elapsed := int64(state.LastSampleTime.Sub(state.EpochStartTime) / time.Second); elapsed > 0 {
+				price, ok = state.CumulativePrice.QuoInt64(elapsed), true
+			}
+		} else {
+			price, ok = k.oraclePrice(ctx, extPair)
+		}
+
+		if ok {
+			fee := extPair.StabilityFee
+			if price.LT(controller.TargetPrice.Sub(controller.DeadBand)) {
+				fee = fee.Add(controller.Step)
+			} else if price.GT(controller.TargetPrice.Add(controller.DeadBand)) {
+				fee = fee.Sub(controller.Step)
+			}
+			fee = sdk.MinDec(sdk.MaxDec(fee, controller.MinFee), controller.MaxFee)
+			k.adjustStabilityFee(ctx, extPair, fee, price)
+		}
+
+		k.SetStabilityFeeControllerState(ctx, types.StabilityFeeControllerState{
+			ExtendedPairVaultId: controller.ExtendedPairVaultId,
+			EpochStartTime:      now,
+			LastSampleTime:      now,
+			CumulativePrice:     sdk.ZeroDec(),
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/comdex-official/comdex/x/asset"
+	assetTypes "github.com/comdex-official/comdex/x/asset/types"
+	markettypes "github.com/comdex-official/comdex/x/market/types"
+	rewardstypes "github.com/comdex-official/comdex/x/rewards/types"
+)
+
+func (s *KeeperTestSuite) setStablecoinPrice(price uint64) {
+	pair, found := s.assetKeeper.GetPair(s.ctx, 1)
+	s.Require().True(found)
+	s.app.MarketKeeper.SetTwa(s.ctx, markettypes.TimeWeightedAverage{
+		AssetID:       pair.AssetOut,
+		Twa:           price,
+		IsPriceActive: true,
+		PriceValue:    []uint64{price},
+	})
+}
+
+func (s *KeeperTestSuite) TestStabilityFeeController() {
+	s.ctx = s.ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.TestAddExtendedPairVault()
+	assetKeeper := &s.assetKeeper
+
+	controller := assetTypes.StabilityFeeController{
+		AppId:               1,
+		ExtendedPairVaultId: 1,
+		TargetPrice:         sdk.OneDec(),
+		DeadBand:            sdk.MustNewDecFromStr("0.01"),
+		MinFee:              sdk.MustNewDecFromStr("0.02"),
+		MaxFee:              sdk.MustNewDecFromStr("0.05"),
+		Step:                sdk.MustNewDecFromStr("0.01"),
+		EpochDuration:       time.Hour,
+	}
+
+	invalid := controller
+	invalid.MaxFee = sdk.MustNewDecFromStr("0.01")
+	s.Require().ErrorIs(assetKeeper.AddStabilityFeeController(s.ctx, invalid), assetTypes.ErrorInvalidStabilityFeeController)
+	invalid = controller
+	invalid.AppId = 2
+	s.Require().ErrorIs(assetKeeper.AddStabilityFeeController(s.ctx, invalid), assetTypes.ErrorExtendedPairDoesNotExistForTheApp)
+
+	// The fee of 0.01 is raised to the controller's minimum fee right away.
+	s.Require().NoError(assetKeeper.AddStabilityFeeController(s.ctx, controller))
+	extPair, _ := assetKeeper.GetPairsVault(s.ctx, 1)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.02"), extPair.StabilityFee)
+	start := s.ctx.BlockTime()
+
+	s.setStablecoinPrice(950000)
+	for _, fee := range []string{"0.02", "0.03", "0.04", "0.05", "0.05"} {
+		// Nothing changes within an epoch.
+		s.advanceseconds(1800)
+		asset.BeginBlocker(s.ctx, abci.RequestBeginBlock{}, *assetKeeper)
+		extPair, _ = assetKeeper.GetPairsVault(s.ctx, 1)
+		s.Require().Equal(sdk.MustNewDecFromStr(fee), extPair.StabilityFee)
+
+		s.advanceseconds(1800)
+		asset.BeginBlocker(s.ctx, abci.RequestBeginBlock{}, *assetKeeper)
+	}
+	extPair, _ = assetKeeper.GetPairsVault(s.ctx, 1)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.05"), extPair.StabilityFee)
+
+	// Within the dead band the fee is left alone.
+	s.setStablecoinPrice(1005000)
+	s.advanceseconds(3600)
+	asset.BeginBlocker(s.ctx, abci.RequestBeginBlock{}, *assetKeeper)
+	extPair, _ = assetKeeper.GetPairsVault(s.ctx, 1)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.05"), extPair.StabilityFee)
+
+	s.setStablecoinPrice(1050000)
+	s.advanceseconds(3600)
+	asset.BeginBlocker(s.ctx, abci.RequestBeginBlock{}, *assetKeeper)
+	extPair, _ = assetKeeper.GetPairsVault(s.ctx, 1)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.04"), extPair.StabilityFee)
+
+	res, err := s.querier.QueryStabilityFeeAdjustments(sdk.WrapSDKContext(s.ctx), &assetTypes.QueryStabilityFeeAdjustmentsRequest{ExtendedPairVaultId: 1})
+	s.Require().NoError(err)
+	s.Require().Len(res.Adjustments, 5)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.01"), res.Adjustments[0].PreviousFee)
+	s.Require().True(res.Adjustments[0].Price.IsZero())
+	s.Require().Equal(sdk.MustNewDecFromStr("0.95"), res.Adjustments[1].Price)
+	s.Require().Equal(sdk.MustNewDecFromStr("1.05"), res.Adjustments[4].Price)
+
+	// Interest compounds each fee over the period it was in force.
+	since := start.Add(90 * time.Minute)
+	adjustments := assetKeeper.GetStabilityFeeAdjustmentsSince(s.ctx, 1, since)
+	s.Require().Len(adjustments, 3)
+	factor := 1.0
+	from := since
+	for _, adjustment := range adjustments {
+		factor *= math.Pow(1+adjustment.PreviousFee.MustFloat64(), adjustment.Time.Sub(from).Seconds()/rewardstypes.SecondsPerYear)
+		from = adjustment.Time
+	}
+	factor *= math.Pow(1.04, s.ctx.BlockTime().Sub(from).Seconds()/rewardstypes.SecondsPerYear)
+
+	amount := sdk.NewInt(1000000000000)
+	interest, err := s.rewardsKeeper.CalculationOfVaultInterest(s.ctx, extPair, amount, since.Unix())
+	s.Require().NoError(err)
+	expected := (factor - 1) * 1000000000000
+	s.Require().InDelta(expected, interest.MustFloat64(), 1)
+
+	// The current fee of 0.04 alone would have charged less.
+	flat, err := s.rewardsKeeper.CalculationOfRewards(s.ctx, amount, extPair.StabilityFee, since.Unix())
+	s.Require().NoError(err)
+	s.Require().True(flat.LT(interest))
+
+	ctrlRes, err := s.querier.QueryStabilityFeeController(sdk.WrapSDKContext(s.ctx), &assetTypes.QueryStabilityFeeControllerRequest{ExtendedPairVaultId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(controller, ctrlRes.Controller)
+	s.Require().Equal(s.ctx.BlockTime(), ctrlRes.State.EpochStartTime)
+
+	s.Require().NoError(assetKeeper.RemoveStabilityFeeController(s.ctx, 1))
+	s.Require().ErrorIs(assetKeeper.RemoveStabilityFeeController(s.ctx, 1), assetTypes.ErrorStabilityFeeControllerNotFound)
+	_, found := assetKeeper.GetStabilityFeeControllerState(s.ctx, 1)
+	s.Require().False(found)
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	protobuftypes "github.com/gogo/protobuf/types"
+
+	utils "github.com/comdex-official/comdex/types"
+	"github.com/comdex-official/comdex/x/asset/types"
+)
+
+func (k Keeper) GetPendingChangeID(ctx sdk.Context) uint64 {
+	var (
+		store = k.Store(ctx)
+		key   = types.PendingChangeIDKey
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return 0
+	}
+
+	var id protobuftypes.UInt64Value
+	k.cdc.MustUnmarshal(value, &id)
+
+	return id.GetValue()
+}
+
+func (k Keeper) SetPendingChangeID(ctx sdk.Context, id uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.PendingChangeIDKey
+		value = k.cdc.MustMarshal(
+			&protobuftypes.UInt64Value{
+				Value: id,
+			},
+		)
+	)
+
+	store.Set(key, value)
+}
+
+func (k Keeper) SetPendingChange(ctx sdk.Context, change types.PendingChange) {
+	var (
+		store = k.Store(ctx)
+		key   = types.PendingChangeKey(change.Id)
+		value = k.cdc.MustMarshal(&change)
+	)
+
+	store.Set(key, value)
+}
+
+func (k Keeper) GetPendingChange(ctx sdk.Context, id uint64) (change types.PendingChange, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.PendingChangeKey(id)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return change, false
+	}
+
+	k.cdc.MustUnmarshal(value, &change)
+	return change, true
+}
+
+func (k Keeper) DeletePendingChange(ctx sdk.Context, id uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.PendingChangeKey(id)
+	)
+
+	store.Delete(key)
+}
+
+// GetPendingChanges returns the pending changes of paramClass, or of every
+// class if it is empty, in the order they were scheduled.
+func (k Keeper) GetPendingChanges(ctx sdk.Context, paramClass string) (changes []types.PendingChange) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.PendingChangeKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var change types.PendingChange
+		k.cdc.MustUnmarshal(iter.Value(), &change)
+		if paramClass == "" || change.ParamClass == paramClass {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+func (k Keeper) SetTimelockDelay(ctx sdk.Context, delay types.TimelockDelay) {
+	var (
+		store = k.Store(ctx)
+		key   = types.TimelockDelayKey(delay.ParamClass)
+		value = k.cdc.MustMarshal(&delay)
+	)
+
+	store.Set(key, value)
+}
+
+// GetTimelockDelay returns the delay of paramClass, the default one unless
+// governance set it.
+func (k Keeper) GetTimelockDelay(ctx sdk.Context, paramClass string) time.Duration {
+	var (
+		store = k.Store(ctx)
+		key   = types.TimelockDelayKey(paramClass)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return types.DefaultTimelockDelay
+	}
+
+	var delay types.TimelockDelay
+	k.cdc.MustUnmarshal(value, &delay)
+	return delay.Delay
+}
+
+// GetTimelockDelays returns the delay in force for every parameter class.
+func (k Keeper) GetTimelockDelays(ctx sdk.Context) (delays []types.TimelockDelay) {
+	for _, paramClass := range types.ParamClasses() {
+		delays = append(delays, types.TimelockDelay{
+			ParamClass: paramClass,
+			Delay:      k.GetTimelockDelay(ctx, paramClass),
+		})
+	}
+	return delays
+}
+
+// ScheduleChange queues change to be applied once the delay of paramClass has
+// passed.
+func (k Keeper) ScheduleChange(ctx sdk.Context, paramClass string, change types.RiskParameter) (uint64, error) {
+	if !types.IsParamClass(paramClass) {
+		return 0, sdkerrors.Wrapf(types.ErrorInvalidPendingChange, "unknown param class %s", paramClass)
+	}
+
+	id := k.GetPendingChangeID(ctx) + 1
+	pending, err := types.NewPendingChange(id, paramClass, change, ctx.BlockTime(), k.GetTimelockDelay(ctx, paramClass))
+	if err != nil {
+		return 0, err
+	}
+	k.SetPendingChangeID(ctx, id)
+	k.SetPendingChange(ctx, pending)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleChange,
+			sdk.NewAttribute(types.AttributeKeyPendingChangeID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyParamClass, paramClass),
+			sdk.NewAttribute(types.AttributeKeyActivationTime, pending.ActivationTime.Format(time.RFC3339)),
+		),
+	)
+	return id, nil
+}
+
+func (k Keeper) CancelPendingChange(ctx sdk.Context, id uint64) error {
+	change, found := k.GetPendingChange(ctx, id)
+	if !found {
+		return types.ErrorPendingChangeNotFound
+	}
+	k.DeletePendingChange(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelChange,
+			sdk.NewAttribute(types.AttributeKeyPendingChangeID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyParamClass, change.ParamClass),
+		),
+	)
+	return nil
+}
+
+// ActivatePendingChanges applies the changes of paramClass whose activation
+// time has come with apply, called by the module owning the class. A change
+// apply fails on is dropped.
+func (k Keeper) ActivatePendingChanges(ctx sdk.Context, paramClass string, apply func(ctx sdk.Context, change types.RiskParameter) error) {
+	for _, pending := range k.GetPendingChanges(ctx, paramClass) {
+		if ctx.BlockTime().Before(pending.ActivationTime) {
+			continue
+		}
+		k.DeletePendingChange(ctx, pending.Id)
+
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyPendingChangeID, strconv.FormatUint(pending.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyParamClass, pending.ParamClass),
+		}
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			change, ok := pending.GetChange()
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrorInvalidPendingChange, "pending change %d", pending.Id)
+			}
+			return apply(ctx, change)
+		})
+		if err != nil {
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeActivateChange, attributes...))
+	}
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/comdex-official/comdex/app/wasm/bindings"
+	"github.com/comdex-official/comdex/x/asset"
+	assetTypes "github.com/comdex-official/comdex/x/asset/types"
+)
+
+func (s *KeeperTestSuite) updatePairsVaultMsg(minCr string) *bindings.MsgUpdatePairsVault {
+	extPair, found := s.assetKeeper.GetPairsVault(s.ctx, 1)
+	s.Require().True(found)
+	return &bindings.MsgUpdatePairsVault{
+		AppID:              extPair.AppId,
+		ExtPairID:          extPair.Id,
+		StabilityFee:       extPair.StabilityFee,
+		ClosingFee:         extPair.ClosingFee,
+		LiquidationPenalty: extPair.LiquidationPenalty,
+		DrawDownFee:        extPair.DrawDownFee,
+		IsVaultActive:      extPair.IsVaultActive,
+		DebtCeiling:        extPair.DebtCeiling,
+		DebtFloor:          extPair.DebtFloor,
+		MinCr:              sdk.MustNewDecFromStr(minCr),
+		MinUsdValueLeft:    extPair.MinUsdValueLeft,
+	}
+}
+
+func (s *KeeperTestSuite) TestTimelockedPairsVaultUpdate() {
+	s.ctx = s.ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.TestAddExtendedPairVault()
+	assetKeeper := &s.assetKeeper
+
+	s.Require().NoError(assetKeeper.WasmUpdatePairsVault(s.ctx, s.updatePairsVaultMsg("1.8")))
+	s.Require().NoError(assetKeeper.WasmUpdatePairsVault(s.ctx, s.updatePairsVaultMsg("2.0")))
+
+	res, err := s.querier.QueryPendingChanges(sdk.WrapSDKContext(s.ctx), &assetTypes.QueryPendingChangesRequest{ParamClass: assetTypes.ParamClassExtendedPairVault})
+	s.Require().NoError(err)
+	s.Require().Len(res.PendingChanges, 2)
+	s.Require().Equal(s.ctx.BlockTime().Add(assetTypes.DefaultTimelockDelay), res.PendingChanges[0].ActivationTime)
+	change, ok := res.PendingChanges[0].GetChange()
+	s.Require().True(ok)
+	s.Require().Equal(sdk.MustNewDecFromStr("1.8"), change.(*assetTypes.ExtendedPairVault).MinCr)
+	res, err = s.querier.QueryPendingChanges(sdk.WrapSDKContext(s.ctx), &assetTypes.QueryPendingChangesRequest{ParamClass: assetTypes.ParamClassAuctionParams})
+	s.Require().NoError(err)
+	s.Require().Empty(res.PendingChanges)
+
+	// Governance cancels the second change before it activates.
+	s.Require().NoError(assetKeeper.HandleProposalCancelPendingChange(s.ctx, &assetTypes.CancelPendingChangeProposal{Id: 2}))
+	s.Require().ErrorIs(assetKeeper.HandleProposalCancelPendingChange(s.ctx, &assetTypes.CancelPendingChangeProposal{Id: 2}), assetTypes.ErrorPendingChangeNotFound)
+
+	// Nothing changes before the delay has passed.
+	s.advanceseconds(int64(assetTypes.DefaultTimelockDelay.Seconds()) - 1)
+	asset.BeginBlocker(s.ctx, abci.RequestBeginBlock{}, *assetKeeper)
+	extPair, _ := assetKeeper.GetPairsVault(s.ctx, 1)
+	s.Require().NotEqual(sdk.MustNewDecFromStr("1.8"), extPair.MinCr)
+
+	s.advanceseconds(1)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	asset.BeginBlocker(s.ctx, abci.RequestBeginBlock{}, *assetKeeper)
+	extPair, _ = assetKeeper.GetPairsVault(s.ctx, 1)
+	s.Require().Equal(sdk.MustNewDecFromStr("1.8"), extPair.MinCr)
+	s.Require().Empty(assetKeeper.GetPendingChanges(s.ctx, ""))
+	var activated bool
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == assetTypes.EventTypeActivateChange {
+			activated = true
+		}
+	}
+	s.Require().True(activated)
+}
+
+func (s *KeeperTestSuite) TestTimelockDelay() {
+	s.TestAddExtendedPairVault()
+	assetKeeper := &s.assetKeeper
+
+	s.Require().ErrorIs(assetKeeper.HandleProposalSetTimelockDelay(s.ctx, &assetTypes.SetTimelockDelayProposal{
+		Delay: assetTypes.TimelockDelay{ParamClass: "unknown", Delay: time.Hour},
+	}), assetTypes.ErrorInvalidTimelockDelay)
+	s.Require().ErrorIs(assetKeeper.HandleProposalSetTimelockDelay(s.ctx, &assetTypes.SetTimelockDelayProposal{
+		Delay: assetTypes.TimelockDelay{ParamClass: assetTypes.ParamClassExtendedPairVault, Delay: -time.Hour},
+	}), assetTypes.ErrorInvalidTimelockDelay)
+	s.Require().NoError(assetKeeper.HandleProposalSetTimelockDelay(s.ctx, &assetTypes.SetTimelockDelayProposal{
+		Delay: assetTypes.TimelockDelay{ParamClass: assetTypes.ParamClassExtendedPairVault, Delay: time.Hour},
+	}))
+
+	res, err := s.querier.QueryTimelockDelays(sdk.WrapSDKContext(s.ctx), &assetTypes.QueryTimelockDelaysRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Delays, len(assetTypes.ParamClasses()))
+	for _, delay := range res.Delays {
+		if delay.ParamClass == assetTypes.ParamClassExtendedPairVault {
+			s.Require().Equal(time.Hour, delay.Delay)
+		} else {
+			s.Require().Equal(assetTypes.DefaultTimelockDelay, delay.Delay)
+		}
+	}
+
+	s.Require().NoError(assetKeeper.WasmUpdatePairsVault(s.ctx, s.updatePairsVaultMsg("1.8")))
+	s.advanceseconds(3600)
+	asset.BeginBlocker(s.ctx, abci.RequestBeginBlock{}, *assetKeeper)
+	extPair, _ := assetKeeper.GetPairsVault(s.ctx, 1)
+	s.Require().Equal(sdk.MustNewDecFromStr("1.8"), extPair.MinCr)
+}
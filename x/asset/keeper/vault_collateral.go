@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/comdex-official/comdex/x/asset/types"
+)
+
+func (k Keeper) SetVaultCollateralAsset(ctx sdk.Context, collateral types.VaultCollateralAsset) {
+	var (
+		store = k.Store(ctx)
+		key   = types.VaultCollateralAssetKey(collateral.ExtendedPairVaultId, collateral.AssetId)
+		value = k.cdc.MustMarshal(&collateral)
+	)
+
+	store.Set(key, value)
+}
+
+func (k Keeper) GetVaultCollateralAsset(ctx sdk.Context, extendedPairVaultID, assetID uint64) (collateral types.VaultCollateralAsset, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.VaultCollateralAssetKey(extendedPairVaultID, assetID)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return collateral, false
+	}
+
+	k.cdc.MustUnmarshal(value, &collateral)
+	return collateral, true
+}
+
+func (k Keeper) DeleteVaultCollateralAsset(ctx sdk.Context, extendedPairVaultID, assetID uint64) {
+	store := k.Store(ctx)
+	store.Delete(types.VaultCollateralAssetKey(extendedPairVaultID, assetID))
+}
+
+// GetVaultCollateralAssets returns the basket collateral assets whitelisted
+// for the vaults of an extended pair vault.
+func (k Keeper) GetVaultCollateralAssets(ctx sdk.Context, extendedPairVaultID uint64) (collaterals []types.VaultCollateralAsset) {
+	return k.getVaultCollateralAssets(ctx, types.VaultCollateralAssetsKey(extendedPairVaultID))
+}
+
+func (k Keeper) GetAllVaultCollateralAssets(ctx sdk.Context) (collaterals []types.VaultCollateralAsset) {
+	return k.getVaultCollateralAssets(ctx, types.VaultCollateralAssetKeyPrefix)
+}
+
+func (k Keeper) getVaultCollateralAssets(ctx sdk.Context, prefix []byte) (collaterals []types.VaultCollateralAsset) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, prefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var collateral types.VaultCollateralAsset
+		k.cdc.MustUnmarshal(iter.Value(), &collateral)
+		collaterals = append(collaterals, collateral)
+	}
+
+	return collaterals
+}
+
+func (k Keeper) AddVaultCollateralAsset(ctx sdk.Context, collateral types.VaultCollateralAsset) error {
+	if err := collateral.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrorInvalidVaultCollateralAsset, err.Error())
+	}
+	extPair, found := k.GetPairsVault(ctx, collateral.ExtendedPairVaultId)
+	if !found {
+		return types.ErrorPairDoesNotExist
+	}
+	if extPair.AppId != collateral.AppId {
+		return types.ErrorExtendedPairDoesNotExistForTheApp
+	}
+	if extPair.IsStableMintVault {
+		return sdkerrors.Wrap(types.ErrorInvalidVaultCollateralAsset, "stable mint vaults hold a single collateral")
+	}
+	pair, found := k.GetPair(ctx, extPair.PairId)
+	if !found {
+		return types.ErrorPairDoesNotExist
+	}
+	if collateral.AssetId == pair.AssetIn || collateral.AssetId == pair.AssetOut {
+		return sdkerrors.Wrapf(types.ErrorInvalidVaultCollateralAsset, "asset %d is part of the pair", collateral.AssetId)
+	}
+	asset, found := k.GetAsset(ctx, collateral.AssetId)
+	if !found {
+		return types.ErrorAssetDoesNotExist
+	}
+	// The vault's ratio needs a price for every collateral it holds.
+	if !asset.IsOraclePriceRequired {
+		return sdkerrors.Wrapf(types.ErrorInvalidVaultCollateralAsset, "asset %d has no oracle price", collateral.AssetId)
+	}
+
+	// listing the asset again ends its delisting
+	collateral.Delisting = nil
+	k.SetVaultCollateralAsset(ctx, collateral)
+	return nil
+}
+
+// RemoveVaultCollateralAsset starts the delisting of a basket collateral
+// asset. Deposits of it are frozen right away, its value in the ratio of
+// vaults ramps down after freezeDuration and counts for nothing rampDuration
+// later. Vaults keep what they hold of it.
+func (k Keeper) RemoveVaultCollateralAsset(ctx sdk.Context, extendedPairVaultID, assetID uint64, freezeDuration, rampDuration time.Duration) error {
+	collateral, found := k.GetVaultCollateralAsset(ctx, extendedPairVaultID, assetID)
+	if !found {
+		return types.ErrorVaultCollateralAssetNotFound
+	}
+	if collateral.IsDelisting() {
+		return sdkerrors.Wrapf(types.ErrorAlreadyDelisting, "asset %d of extended pair vault %d", assetID, extendedPairVaultID)
+	}
+
+	delisting := types.NewDelisting(assetID, ctx.BlockTime(), freezeDuration, rampDuration)
+	collateral.Delisting = &delisting
+	k.SetVaultCollateralAsset(ctx, collateral)
+	return nil
+}
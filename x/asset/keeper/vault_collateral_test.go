@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	assetTypes "github.com/comdex-official/comdex/x/asset/types"
+)
+
+func (s *KeeperTestSuite) TestVaultCollateralAssets() {
+	s.TestAddExtendedPairVault()
+	assetKeeper := &s.assetKeeper
+
+	s.Require().NoError(assetKeeper.AddAssetRecords(s.ctx, assetTypes.Asset{
+		Name:                  "ATOM",
+		Denom:                 "uatom",
+		Decimals:              sdk.NewInt(1000000),
+		IsOnChain:             true,
+		IsOraclePriceRequired: true,
+	}))
+	s.Require().NoError(assetKeeper.AddAssetRecords(s.ctx, assetTypes.Asset{
+		Name:      "OSMO",
+		Denom:     "uosmo",
+		Decimals:  sdk.NewInt(1000000),
+		IsOnChain: true,
+	}))
+	atom, _ := assetKeeper.GetAssetForDenom(s.ctx, "uatom")
+	osmo, _ := assetKeeper.GetAssetForDenom(s.ctx, "uosmo")
+	pair, _ := assetKeeper.GetPair(s.ctx, 1)
+
+	collateral := assetTypes.VaultCollateralAsset{
+		AppId:                1,
+		ExtendedPairVaultId:  1,
+		AssetId:              atom.Id,
+		LiquidationThreshold: sdk.MustNewDecFromStr("1.8"),
+		LiquidationPriority:  1,
+	}
+
+	invalid := collateral
+	invalid.LiquidationThreshold = sdk.OneDec()
+	s.Require().ErrorIs(assetKeeper.AddVaultCollateralAsset(s.ctx, invalid), assetTypes.ErrorInvalidVaultCollateralAsset)
+	invalid = collateral
+	invalid.AppId = 2
+	s.Require().ErrorIs(assetKeeper.AddVaultCollateralAsset(s.ctx, invalid), assetTypes.ErrorExtendedPairDoesNotExistForTheApp)
+	invalid = collateral
+	invalid.AssetId = pair.AssetIn
+	s.Require().ErrorIs(assetKeeper.AddVaultCollateralAsset(s.ctx, invalid), assetTypes.ErrorInvalidVaultCollateralAsset)
+	invalid = collateral
+	invalid.AssetId = osmo.Id
+	s.Require().ErrorIs(assetKeeper.AddVaultCollateralAsset(s.ctx, invalid), assetTypes.ErrorInvalidVaultCollateralAsset)
+
+	s.Require().NoError(assetKeeper.AddVaultCollateralAsset(s.ctx, collateral))
+	got, found := assetKeeper.GetVaultCollateralAsset(s.ctx, 1, atom.Id)
+	s.Require().True(found)
+	s.Require().Equal(collateral, got)
+	s.Require().Equal([]assetTypes.VaultCollateralAsset{collateral}, assetKeeper.GetVaultCollateralAssets(s.ctx, 1))
+	s.Require().Empty(assetKeeper.GetVaultCollateralAssets(s.ctx, 2))
+
+	// removing the asset delists it in stages
+	s.Require().ErrorIs(assetKeeper.RemoveVaultCollateralAsset(s.ctx, 1, osmo.Id, time.Hour, time.Hour), assetTypes.ErrorVaultCollateralAssetNotFound)
+	s.Require().NoError(assetKeeper.RemoveVaultCollateralAsset(s.ctx, 1, atom.Id, time.Hour, time.Hour))
+	s.Require().ErrorIs(assetKeeper.RemoveVaultCollateralAsset(s.ctx, 1, atom.Id, time.Hour, time.Hour), assetTypes.ErrorAlreadyDelisting)
+	got, found = assetKeeper.GetVaultCollateralAsset(s.ctx, 1, atom.Id)
+	s.Require().True(found)
+	s.Require().True(got.IsDelisting())
+	s.Require().Equal(sdk.OneDec(), got.ValueFactor(s.ctx.BlockTime().Add(time.Hour)))
+	s.Require().Equal(sdk.MustNewDecFromStr("0.5"), got.ValueFactor(s.ctx.BlockTime().Add(90*time.Minute)))
+	s.Require().True(got.ValueFactor(s.ctx.BlockTime().Add(2 * time.Hour)).IsZero())
+
+	// listing it again ends the delisting
+	s.Require().NoError(assetKeeper.AddVaultCollateralAsset(s.ctx, collateral))
+	got, _ = assetKeeper.GetVaultCollateralAsset(s.ctx, 1, atom.Id)
+	s.Require().False(got.IsDelisting())
+}
@@ -31,6 +31,7 @@ type AppData struct {
 	MinGovDeposit    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_gov_deposit,json=minGovDeposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_gov_deposit" yaml:"min_gov_deposit"`
 	GovTimeInSeconds uint64                                 `protobuf:"varint,5,opt,name=gov_time_in_seconds,json=govTimeInSeconds,proto3" json:"gov_time_in_seconds,omitempty" yaml:"gov_time_in_seconds"`
 	GenesisToken     []MintGenesisToken                     `protobuf:"bytes,6,rep,name=genesis_token,json=genesisToken,proto3" json:"genesis_token" yaml:"genesis_token"`
+	Roles            AppRoles                               `protobuf:"bytes,7,opt,name=roles,proto3" json:"roles" yaml:"roles"`
 }
 
 func (m *AppData) Reset()         { *m = AppData{} }
@@ -66,6 +67,48 @@ func (m *AppData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AppData proto.InternalMessageInfo
 
+// AppRoles are the accounts administering an app. The admin, which can be a
+// multisig account, holds every role and rotates the roles of the app.
+type AppRoles struct {
+	Admin          string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	RiskAdmin      string `protobuf:"bytes,2,opt,name=risk_admin,json=riskAdmin,proto3" json:"risk_admin,omitempty" yaml:"risk_admin"`
+	EmergencyAdmin string `protobuf:"bytes,3,opt,name=emergency_admin,json=emergencyAdmin,proto3" json:"emergency_admin,omitempty" yaml:"emergency_admin"`
+	Treasury       string `protobuf:"bytes,4,opt,name=treasury,proto3" json:"treasury,omitempty" yaml:"treasury"`
+}
+
+func (m *AppRoles) Reset()         { *m = AppRoles{} }
+func (m *AppRoles) String() string { return proto.CompactTextString(m) }
+func (*AppRoles) ProtoMessage()    {}
+func (*AppRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1372b4734b6486fd, []int{1}
+}
+func (m *AppRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppRoles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppRoles.Merge(m, src)
+}
+func (m *AppRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppRoles proto.InternalMessageInfo
+
 type MintGenesisToken struct {
 	AssetId       uint64                                 `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
 	GenesisSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=genesis_supply,json=genesisSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"genesis_supply"`
@@ -77,7 +120,7 @@ func (m *MintGenesisToken) Reset()         { *m = MintGenesisToken{} }
 func (m *MintGenesisToken) String() string { return proto.CompactTextString(m) }
 func (*MintGenesisToken) ProtoMessage()    {}
 func (*MintGenesisToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1372b4734b6486fd, []int{1}
+	return fileDescriptor_1372b4734b6486fd, []int{2}
 }
 func (m *MintGenesisToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -116,7 +159,7 @@ func (m *AppAndGovTime) Reset()         { *m = AppAndGovTime{} }
 func (m *AppAndGovTime) String() string { return proto.CompactTextString(m) }
 func (*AppAndGovTime) ProtoMessage()    {}
 func (*AppAndGovTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1372b4734b6486fd, []int{2}
+	return fileDescriptor_1372b4734b6486fd, []int{3}
 }
 func (m *AppAndGovTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,6 +190,7 @@ var xxx_messageInfo_AppAndGovTime proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*AppData)(nil), "comdex.asset.v1beta1.AppData")
+	proto.RegisterType((*AppRoles)(nil), "comdex.asset.v1beta1.AppRoles")
 	proto.RegisterType((*MintGenesisToken)(nil), "comdex.asset.v1beta1.MintGenesisToken")
 	proto.RegisterType((*AppAndGovTime)(nil), "comdex.asset.v1beta1.AppAndGovTime")
 }
@@ -154,43 +198,50 @@ func init() {
 func init() { proto.RegisterFile("comdex/asset/v1beta1/app.proto", fileDescriptor_1372b4734b6486fd) }
 
 var fileDescriptor_1372b4734b6486fd = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbd, 0x6e, 0xdb, 0x3c,
-	0x14, 0x95, 0xec, 0xfc, 0x32, 0x71, 0xec, 0xc8, 0xfe, 0xbe, 0x1a, 0x41, 0x21, 0x19, 0x2c, 0x10,
-	0x78, 0x89, 0x84, 0xa4, 0x5d, 0xda, 0xcd, 0x42, 0x00, 0xd7, 0x43, 0x0a, 0x54, 0x49, 0x97, 0x2e,
-	0x82, 0x2c, 0x31, 0x0a, 0x11, 0x8b, 0x24, 0x4c, 0xc6, 0xa8, 0xdf, 0xa0, 0x63, 0x1f, 0xa1, 0x63,
-	0x1f, 0xc5, 0x63, 0xc6, 0xa2, 0x83, 0xd0, 0xda, 0x6f, 0xa0, 0xb1, 0x53, 0x21, 0x52, 0xaa, 0xdd,
-	0xc0, 0x1d, 0x5a, 0xa0, 0x93, 0x48, 0x9e, 0xc3, 0x73, 0x74, 0xcf, 0xbd, 0x20, 0x30, 0x43, 0x9a,
-	0x44, 0xe8, 0x9d, 0x13, 0x70, 0x8e, 0x84, 0x33, 0x39, 0x1d, 0x22, 0x11, 0x9c, 0x3a, 0x01, 0x63,
-	0x36, 0x1b, 0x53, 0x41, 0x8d, 0x96, 0xc2, 0x6d, 0x89, 0xdb, 0x05, 0x7e, 0xd4, 0x8a, 0x69, 0x4c,
-	0x25, 0xc1, 0xc9, 0x57, 0x8a, 0x0b, 0x3f, 0x56, 0xc1, 0x76, 0x8f, 0xb1, 0xf3, 0x40, 0x04, 0xc6,
-	0x01, 0xa8, 0xe0, 0xa8, 0xad, 0x77, 0xf4, 0xee, 0x86, 0x57, 0xc1, 0x91, 0xf1, 0x04, 0x6c, 0x90,
-	0x20, 0x41, 0xed, 0x4a, 0x47, 0xef, 0xee, 0xba, 0xf5, 0x2c, 0xb5, 0xf6, 0xa6, 0x41, 0x32, 0x7a,
-	0x01, 0xf3, 0x53, 0xe8, 0x49, 0xd0, 0x78, 0x06, 0x00, 0xbf, 0xa1, 0x63, 0xe1, 0x4b, 0x6a, 0x55,
-	0x52, 0xff, 0xcb, 0x52, 0xeb, 0x50, 0x51, 0x97, 0x18, 0xf4, 0x76, 0xe5, 0xe6, 0x55, 0x7e, 0x8b,
-	0x81, 0x7a, 0x82, 0x89, 0x1f, 0xd3, 0x89, 0x1f, 0x21, 0x46, 0x39, 0x16, 0xed, 0x0d, 0x79, 0xf5,
-	0xe5, 0x2c, 0xb5, 0xb4, 0x2f, 0xa9, 0x75, 0x1c, 0x63, 0x71, 0x73, 0x37, 0xb4, 0x43, 0x9a, 0x38,
-	0x21, 0xe5, 0x09, 0xe5, 0xc5, 0xe7, 0x84, 0x47, 0xb7, 0x8e, 0x98, 0x32, 0xc4, 0xed, 0x01, 0x11,
-	0x59, 0x6a, 0xfd, 0xaf, 0x8c, 0x1e, 0xc8, 0x41, 0xaf, 0x96, 0x60, 0xd2, 0xa7, 0x93, 0x73, 0xb5,
-	0x37, 0x2e, 0x40, 0x33, 0x87, 0x05, 0x4e, 0x90, 0x8f, 0x89, 0xcf, 0x51, 0x48, 0x49, 0xc4, 0xdb,
-	0x9b, 0x79, 0xb5, 0xae, 0x99, 0xa5, 0xd6, 0x91, 0xd2, 0x59, 0x43, 0x82, 0x5e, 0x23, 0xa6, 0x93,
-	0x2b, 0x9c, 0xa0, 0x01, 0xb9, 0x54, 0x47, 0x06, 0x06, 0xb5, 0x18, 0x11, 0xc4, 0x31, 0xf7, 0x05,
-	0xbd, 0x45, 0xa4, 0xbd, 0xd5, 0xa9, 0x76, 0xf7, 0xce, 0x8e, 0xed, 0x75, 0xd9, 0xdb, 0x17, 0x98,
-	0x88, 0xbe, 0xa2, 0x5f, 0xe5, 0x6c, 0xf7, 0x71, 0x5e, 0x66, 0x96, 0x5a, 0xad, 0xc2, 0x74, 0x55,
-	0x0a, 0x7a, 0xfb, 0xf1, 0x0a, 0x17, 0xbe, 0xaf, 0x80, 0xc6, 0x43, 0x01, 0xc3, 0x06, 0x3b, 0xd2,
-	0xc2, 0x2f, 0x3b, 0xe6, 0x36, 0xb3, 0xd4, 0xaa, 0x2b, 0xb9, 0x12, 0x81, 0xde, 0xb6, 0x5c, 0x0e,
-	0x22, 0xe3, 0x0d, 0x38, 0x28, 0x4d, 0xf8, 0x1d, 0x63, 0xa3, 0x69, 0xd1, 0x55, 0xfb, 0xcf, 0xf2,
-	0xf6, 0xca, 0xaa, 0x2f, 0xa5, 0x88, 0xf1, 0x1c, 0xec, 0x63, 0x2e, 0x73, 0x57, 0x29, 0xe4, 0xfd,
-	0xdf, 0x71, 0x1f, 0x65, 0xa9, 0xd5, 0x54, 0xbf, 0xb2, 0x8a, 0x42, 0x0f, 0x60, 0xde, 0xa7, 0x13,
-	0x55, 0xc1, 0x19, 0xd8, 0x1d, 0xa3, 0x10, 0x33, 0x8c, 0x48, 0xd9, 0xfc, 0x56, 0x96, 0x5a, 0x0d,
-	0x75, 0xef, 0x27, 0x04, 0xbd, 0x25, 0x0d, 0x7e, 0xd7, 0x41, 0xad, 0xc7, 0x58, 0x8f, 0x44, 0x7d,
-	0xd5, 0x10, 0xa3, 0x0b, 0xb6, 0x02, 0xc6, 0x96, 0x29, 0x1c, 0x66, 0xa9, 0x55, 0x2b, 0x52, 0x90,
-	0xe7, 0xd0, 0xdb, 0x0c, 0x18, 0x1b, 0x44, 0xbf, 0x1b, 0x80, 0xca, 0x5f, 0x0e, 0xc0, 0x9a, 0x09,
-	0xae, 0xfe, 0xd3, 0x09, 0x76, 0x5f, 0xcf, 0xbe, 0x99, 0xda, 0xa7, 0xb9, 0xa9, 0xcd, 0xe6, 0xa6,
-	0x7e, 0x3f, 0x37, 0xf5, 0xaf, 0x73, 0x53, 0xff, 0xb0, 0x30, 0xb5, 0xfb, 0x85, 0xa9, 0x7d, 0x5e,
-	0x98, 0xda, 0x5b, 0xe7, 0x17, 0xcb, 0x7c, 0x0e, 0x4f, 0xe8, 0xf5, 0x35, 0x0e, 0x71, 0x30, 0x2a,
-	0xf6, 0x4e, 0xf9, 0x6a, 0x48, 0xff, 0xe1, 0x96, 0x7c, 0x04, 0x9e, 0xfe, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0xf0, 0xde, 0x5d, 0x41, 0x52, 0x04, 0x00, 0x00,
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3d, 0x6f, 0xdb, 0x3a,
+	0x14, 0xb5, 0xec, 0x7c, 0xd8, 0x4c, 0x1c, 0x3b, 0x8a, 0xdf, 0x7b, 0x46, 0xf0, 0x20, 0x19, 0x2c,
+	0x10, 0x78, 0x89, 0x84, 0xa4, 0x5d, 0xda, 0xcd, 0x6e, 0x00, 0xd7, 0x05, 0x52, 0xa0, 0x4c, 0xba,
+	0x74, 0x11, 0x14, 0x8b, 0x51, 0x88, 0x58, 0x24, 0x21, 0x2a, 0x46, 0xfd, 0x0f, 0x3a, 0xf6, 0x67,
+	0xf4, 0xa7, 0x64, 0xcc, 0x58, 0x74, 0x10, 0x5a, 0x67, 0xed, 0xa4, 0xb1, 0x53, 0x41, 0x52, 0x8a,
+	0x5d, 0xc3, 0x1d, 0x5a, 0xa0, 0x93, 0xc9, 0x7b, 0xce, 0x3d, 0xd7, 0xbc, 0xf7, 0x5c, 0x01, 0x6b,
+	0xc4, 0xa2, 0x00, 0xbf, 0x73, 0x7d, 0x21, 0x70, 0xe2, 0x4e, 0x8e, 0x2e, 0x70, 0xe2, 0x1f, 0xb9,
+	0x3e, 0xe7, 0x0e, 0x8f, 0x59, 0xc2, 0xcc, 0x96, 0xc6, 0x1d, 0x85, 0x3b, 0x39, 0xbe, 0xdf, 0x0a,
+	0x59, 0xc8, 0x14, 0xc1, 0x95, 0x27, 0xcd, 0x85, 0xdf, 0x2a, 0x60, 0xb3, 0xc7, 0xf9, 0x89, 0x9f,
+	0xf8, 0xe6, 0x0e, 0x28, 0x93, 0xa0, 0x6d, 0x74, 0x8c, 0xee, 0x1a, 0x2a, 0x93, 0xc0, 0x7c, 0x04,
+	0xd6, 0xa8, 0x1f, 0xe1, 0x76, 0xb9, 0x63, 0x74, 0x6b, 0xfd, 0x46, 0x96, 0xda, 0x5b, 0x53, 0x3f,
+	0x1a, 0x3f, 0x83, 0x32, 0x0a, 0x91, 0x02, 0xcd, 0x27, 0x00, 0x88, 0x2b, 0x16, 0x27, 0x9e, 0xa2,
+	0x56, 0x14, 0xf5, 0x9f, 0x2c, 0xb5, 0x77, 0x35, 0x75, 0x8e, 0x41, 0x54, 0x53, 0x97, 0x57, 0x32,
+	0x8b, 0x83, 0x46, 0x44, 0xa8, 0x17, 0xb2, 0x89, 0x17, 0x60, 0xce, 0x04, 0x49, 0xda, 0x6b, 0x2a,
+	0xf5, 0xc5, 0x6d, 0x6a, 0x97, 0x3e, 0xa7, 0xf6, 0x41, 0x48, 0x92, 0xab, 0x9b, 0x0b, 0x67, 0xc4,
+	0x22, 0x77, 0xc4, 0x44, 0xc4, 0x44, 0xfe, 0x73, 0x28, 0x82, 0x6b, 0x37, 0x99, 0x72, 0x2c, 0x9c,
+	0x21, 0x4d, 0xb2, 0xd4, 0xfe, 0x57, 0x17, 0x5a, 0x92, 0x83, 0xa8, 0x1e, 0x11, 0x3a, 0x60, 0x93,
+	0x13, 0x7d, 0x37, 0x4f, 0xc1, 0x9e, 0x84, 0x13, 0x12, 0x61, 0x8f, 0x50, 0x4f, 0xe0, 0x11, 0xa3,
+	0x81, 0x68, 0xaf, 0xcb, 0xd7, 0xf6, 0xad, 0x2c, 0xb5, 0xf7, 0xb5, 0xce, 0x0a, 0x12, 0x44, 0xcd,
+	0x90, 0x4d, 0xce, 0x49, 0x84, 0x87, 0xf4, 0x4c, 0x87, 0x4c, 0x02, 0xea, 0x21, 0xa6, 0x58, 0x10,
+	0xe1, 0x25, 0xec, 0x1a, 0xd3, 0xf6, 0x46, 0xa7, 0xd2, 0xdd, 0x3a, 0x3e, 0x70, 0x56, 0xf5, 0xde,
+	0x39, 0x25, 0x34, 0x19, 0x68, 0xfa, 0xb9, 0x64, 0xf7, 0xff, 0x97, 0xcf, 0xcc, 0x52, 0xbb, 0x95,
+	0x17, 0x5d, 0x94, 0x82, 0x68, 0x3b, 0x5c, 0xe0, 0x9a, 0x2f, 0xc1, 0x7a, 0xcc, 0xc6, 0x58, 0xb4,
+	0x37, 0x3b, 0x46, 0x77, 0xeb, 0xd8, 0x5a, 0x5d, 0xa2, 0xc7, 0x39, 0x92, 0xac, 0x7e, 0x2b, 0x97,
+	0xde, 0xd6, 0xd2, 0x2a, 0x15, 0x22, 0x2d, 0x01, 0x53, 0x03, 0x54, 0x0b, 0xa6, 0x79, 0x00, 0xd6,
+	0xfd, 0x20, 0x22, 0x54, 0x8d, 0xbc, 0xd6, 0x6f, 0xce, 0x93, 0x54, 0x18, 0x22, 0x0d, 0xcb, 0x11,
+	0xc7, 0x44, 0x5c, 0x7b, 0x9a, 0x5c, 0x5e, 0x1e, 0xf1, 0x1c, 0x83, 0xa8, 0x26, 0x2f, 0x3d, 0x95,
+	0xf5, 0x1c, 0x34, 0x70, 0x84, 0xe3, 0x10, 0xd3, 0xd1, 0x34, 0x4f, 0xd5, 0xee, 0xd8, 0x9f, 0x0f,
+	0x6d, 0x89, 0x00, 0xd1, 0xce, 0x43, 0x44, 0x8b, 0xb8, 0xa0, 0x9a, 0xc4, 0xd8, 0x17, 0x37, 0xf1,
+	0x34, 0x37, 0xc8, 0x5e, 0x96, 0xda, 0x0d, 0x9d, 0x5d, 0x20, 0x10, 0x3d, 0x90, 0xe0, 0xfb, 0x32,
+	0x68, 0x2e, 0x77, 0xdb, 0x74, 0x40, 0x55, 0x35, 0xcb, 0x2b, 0xec, 0xbd, 0xa8, 0x52, 0x20, 0x10,
+	0x6d, 0xaa, 0xe3, 0x30, 0x30, 0xdf, 0x80, 0x9d, 0x62, 0x22, 0xe2, 0x86, 0xf3, 0xf1, 0x34, 0x7f,
+	0xb4, 0xf3, 0x7b, 0xe6, 0x44, 0x85, 0x45, 0xce, 0x94, 0x88, 0xf9, 0x14, 0x6c, 0x13, 0xa1, 0x4c,
+	0xaa, 0x2d, 0x23, 0xdb, 0x51, 0xed, 0xff, 0x97, 0xa5, 0xf6, 0x9e, 0xfe, 0x2b, 0x8b, 0x28, 0x44,
+	0x80, 0x88, 0x01, 0x9b, 0xe8, 0x17, 0x1c, 0x83, 0x5a, 0x8c, 0x47, 0x84, 0x13, 0x4c, 0x8b, 0x4d,
+	0x69, 0x65, 0xa9, 0xdd, 0xcc, 0x27, 0x50, 0x40, 0x72, 0x00, 0x0f, 0xe7, 0xef, 0x06, 0xa8, 0xf7,
+	0x38, 0xef, 0xd1, 0x60, 0xa0, 0xdd, 0x6b, 0x76, 0xc1, 0x86, 0xcf, 0xf9, 0xbc, 0x0b, 0xbb, 0x59,
+	0x6a, 0xd7, 0xf3, 0x2e, 0xa8, 0xb8, 0x1c, 0x39, 0xe7, 0xc3, 0xe0, 0x57, 0xdb, 0x52, 0xfe, 0xc3,
+	0x6d, 0x59, 0xb1, 0xee, 0x95, 0xbf, 0xba, 0xee, 0xfd, 0xd7, 0xb7, 0x5f, 0xad, 0xd2, 0xc7, 0x99,
+	0x55, 0xba, 0x9d, 0x59, 0xc6, 0xdd, 0xcc, 0x32, 0xbe, 0xcc, 0x2c, 0xe3, 0xc3, 0xbd, 0x55, 0xba,
+	0xbb, 0xb7, 0x4a, 0x9f, 0xee, 0xad, 0xd2, 0x5b, 0xf7, 0xa7, 0x92, 0x72, 0xa3, 0x0e, 0xd9, 0xe5,
+	0x25, 0x19, 0x11, 0x7f, 0x9c, 0xdf, 0xdd, 0xe2, 0x13, 0xab, 0xea, 0x5f, 0x6c, 0xa8, 0x2f, 0xe6,
+	0xe3, 0x1f, 0x03, 0x00, 0x65, 0xab, 0xde, 0xff, 0x7f, 0x05, 0x00, 0x00,
 }
 
 func (m *AppData) Marshal() (dAtA []byte, err error) {
@@ -213,6 +264,16 @@ func (m *AppData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Roles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if len(m.GenesisToken) > 0 {
 		for iNdEx := len(m.GenesisToken) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -264,6 +325,57 @@ func (m *AppData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AppRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EmergencyAdmin) > 0 {
+		i -= len(m.EmergencyAdmin)
+		copy(dAtA[i:], m.EmergencyAdmin)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.EmergencyAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RiskAdmin) > 0 {
+		i -= len(m.RiskAdmin)
+		copy(dAtA[i:], m.RiskAdmin)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.RiskAdmin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MintGenesisToken) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -401,6 +513,33 @@ func (m *AppData) Size() (n int) {
 			n += 1 + l + sovApp(uint64(l))
 		}
 	}
+	l = m.Roles.Size()
+	n += 1 + l + sovApp(uint64(l))
+	return n
+}
+
+func (m *AppRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	l = len(m.RiskAdmin)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	l = len(m.EmergencyAdmin)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
 	return n
 }
 
@@ -647,6 +786,217 @@ func (m *AppData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Roles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RiskAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApp(dAtA[iNdEx:])
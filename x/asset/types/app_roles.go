@@ -0,0 +1,84 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AppRole is a role administering an app.
+//
+// The native msgs checking a role are MsgSetAppRoles (admin), esm
+// MsgKillSwitch (emergency admin) and tokenmint MsgMintNewTokens (treasury).
+// The assets, pairs, extended pairs, lend asset rates, auction params and
+// esm params of an app change only through governance proposals. Every
+// other app-scoped msg moves only the sender's own positions or funds and
+// takes no role.
+type AppRole int
+
+const (
+	// AppRoleAdmin rotates the roles of the app.
+	AppRoleAdmin AppRole = iota
+	// AppRoleRiskAdmin manages the risk parameters and whitelists of the app.
+	AppRoleRiskAdmin
+	// AppRoleEmergencyAdmin triggers the emergency shutdown of the app.
+	AppRoleEmergencyAdmin
+	// AppRoleTreasury moves the funds of the app.
+	AppRoleTreasury
+)
+
+func (r AppRole) String() string {
+	switch r {
+	case AppRoleAdmin:
+		return "admin"
+	case AppRoleRiskAdmin:
+		return "risk_admin"
+	case AppRoleEmergencyAdmin:
+		return "emergency_admin"
+	case AppRoleTreasury:
+		return "treasury"
+	default:
+		return "unknown"
+	}
+}
+
+// Address returns the address holding role, empty while it is not set.
+func (m AppRoles) Address(role AppRole) string {
+	switch role {
+	case AppRoleAdmin:
+		return m.Admin
+	case AppRoleRiskAdmin:
+		return m.RiskAdmin
+	case AppRoleEmergencyAdmin:
+		return m.EmergencyAdmin
+	case AppRoleTreasury:
+		return m.Treasury
+	default:
+		return ""
+	}
+}
+
+// Holds reports whether address holds role. The admin holds every role.
+func (m AppRoles) Holds(address string, role AppRole) bool {
+	if address == "" {
+		return false
+	}
+	return m.Admin == address || m.Address(role) == address
+}
+
+// IsEmpty reports whether no role of the app is set.
+func (m AppRoles) IsEmpty() bool {
+	return m.Admin == "" && m.RiskAdmin == "" && m.EmergencyAdmin == "" && m.Treasury == ""
+}
+
+// Validate checks the roles are empty or valid addresses.
+func (m AppRoles) Validate() error {
+	for role, address := range []string{m.Admin, m.RiskAdmin, m.EmergencyAdmin, m.Treasury} {
+		if address == "" {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errors.Wrapf(ErrorInvalidAppRoles, "%s: %s", AppRole(role), err)
+		}
+	}
+	return nil
+}
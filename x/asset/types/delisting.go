@@ -0,0 +1,65 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DelistingStage is the stage a delisting is in at a given time.
+type DelistingStage int
+
+const (
+	// DelistingStageFrozen blocks new positions in the asset or pair.
+	DelistingStageFrozen DelistingStage = iota + 1
+	// DelistingStageRamp ramps the ltv of existing positions down.
+	DelistingStageRamp
+	// DelistingStageCloseOut closes the remaining positions out.
+	DelistingStageCloseOut
+)
+
+func NewDelisting(id uint64, startTime time.Time, freezeDuration, rampDuration time.Duration) Delisting {
+	rampStartTime := startTime.Add(freezeDuration)
+	return Delisting{
+		Id:            id,
+		StartTime:     startTime,
+		RampStartTime: rampStartTime,
+		CloseOutTime:  rampStartTime.Add(rampDuration),
+	}
+}
+
+func (m Delisting) Stage(t time.Time) DelistingStage {
+	switch {
+	case !t.Before(m.CloseOutTime):
+		return DelistingStageCloseOut
+	case !t.Before(m.RampStartTime):
+		return DelistingStageRamp
+	default:
+		return DelistingStageFrozen
+	}
+}
+
+// LtvFactor is the share of their ltv positions keep at t, one while frozen
+// and ramping linearly down to zero at close out.
+func (m Delisting) LtvFactor(t time.Time) sdk.Dec {
+	switch m.Stage(t) {
+	case DelistingStageFrozen:
+		return sdk.OneDec()
+	case DelistingStageCloseOut:
+		return sdk.ZeroDec()
+	}
+	remaining := m.CloseOutTime.Sub(t)
+	total := m.CloseOutTime.Sub(m.RampStartTime)
+	return sdk.NewDec(int64(remaining)).QuoInt64(int64(total))
+}
+
+func validateDelistingDurations(freezeDuration, rampDuration time.Duration) error {
+	if freezeDuration < 0 {
+		return errors.Wrap(ErrorInvalidDelisting, "freeze_duration cannot be negative")
+	}
+	if rampDuration < 0 {
+		return errors.Wrap(ErrorInvalidDelisting, "ramp_duration cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,446 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: comdex/asset/v1beta1/delisting.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Delisting retires an asset or a pair in stages. From start_time new lends,
+// borrows, vaults and liquidity are frozen, from ramp_start_time the ltv of
+// existing positions ramps down linearly to zero at close_out_time, when the
+// remaining positions are closed out by liquidation.
+type Delisting struct {
+	Id            uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	StartTime     time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	RampStartTime time.Time `protobuf:"bytes,3,opt,name=ramp_start_time,json=rampStartTime,proto3,stdtime" json:"ramp_start_time" yaml:"ramp_start_time"`
+	CloseOutTime  time.Time `protobuf:"bytes,4,opt,name=close_out_time,json=closeOutTime,proto3,stdtime" json:"close_out_time" yaml:"close_out_time"`
+}
+
+func (m *Delisting) Reset()         { *m = Delisting{} }
+func (m *Delisting) String() string { return proto.CompactTextString(m) }
+func (*Delisting) ProtoMessage()    {}
+func (*Delisting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbf6b696d9da6023, []int{0}
+}
+func (m *Delisting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Delisting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Delisting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Delisting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delisting.Merge(m, src)
+}
+func (m *Delisting) XXX_Size() int {
+	return m.Size()
+}
+func (m *Delisting) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delisting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delisting proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Delisting)(nil), "comdex.asset.v1beta1.Delisting")
+}
+
+func init() {
+	proto.RegisterFile("comdex/asset/v1beta1/delisting.proto", fileDescriptor_fbf6b696d9da6023)
+}
+
+var fileDescriptor_fbf6b696d9da6023 = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbd, 0x4e, 0xeb, 0x30,
+	0x14, 0x80, 0xe3, 0xdc, 0xea, 0x4a, 0xf5, 0xbd, 0x05, 0x11, 0x15, 0x54, 0x55, 0xaa, 0x53, 0x22,
+	0x86, 0x2e, 0xd8, 0x2a, 0x6c, 0x8c, 0x15, 0x3b, 0xa2, 0x30, 0x20, 0x96, 0xca, 0x49, 0x9c, 0x60,
+	0x29, 0xc1, 0x51, 0xed, 0x20, 0xfa, 0x16, 0x7d, 0x0c, 0x1e, 0xa5, 0x63, 0xd9, 0x98, 0x0a, 0xa4,
+	0x6f, 0xd0, 0x27, 0x40, 0xb1, 0x13, 0xfe, 0x96, 0x6e, 0x39, 0x47, 0xdf, 0xf9, 0xbe, 0x48, 0x09,
+	0x3c, 0x0a, 0x44, 0x1a, 0xb2, 0x47, 0x42, 0xa5, 0x64, 0x8a, 0x3c, 0x0c, 0x7d, 0xa6, 0xe8, 0x90,
+	0x84, 0x2c, 0xe1, 0x52, 0xf1, 0xfb, 0x18, 0x67, 0x53, 0xa1, 0x84, 0xd3, 0x36, 0x14, 0xd6, 0x14,
+	0xae, 0xa8, 0x6e, 0x3b, 0x16, 0xb1, 0xd0, 0x00, 0x29, 0x9f, 0x0c, 0xdb, 0x75, 0x63, 0x21, 0xe2,
+	0x84, 0x11, 0x3d, 0xf9, 0x79, 0x44, 0x14, 0x4f, 0x99, 0x54, 0x34, 0xcd, 0x0c, 0xe0, 0x3d, 0xdb,
+	0xb0, 0x79, 0x5e, 0x07, 0x9c, 0x1e, 0xb4, 0x79, 0xd8, 0x01, 0x7d, 0x30, 0x68, 0x8c, 0x5a, 0x9b,
+	0x95, 0xdb, 0x9c, 0xd1, 0x34, 0x39, 0xf3, 0x78, 0xe8, 0x8d, 0x6d, 0x1e, 0x3a, 0x37, 0x10, 0x4a,
+	0x45, 0xa7, 0x6a, 0x52, 0x5a, 0x3a, 0x76, 0x1f, 0x0c, 0xfe, 0x9d, 0x74, 0xb1, 0x49, 0xe0, 0x3a,
+	0x81, 0xaf, 0xeb, 0xc4, 0xa8, 0xb7, 0x58, 0xb9, 0xd6, 0x66, 0xe5, 0xee, 0x19, 0xcd, 0xd7, 0xad,
+	0x37, 0x7f, 0x75, 0xc1, 0xb8, 0xa9, 0x17, 0x25, 0xee, 0x44, 0x70, 0x77, 0x4a, 0xd3, 0x6c, 0xf2,
+	0x4d, 0xff, 0x67, 0xab, 0xde, 0xab, 0xf4, 0x07, 0x46, 0xff, 0x4b, 0x60, 0x1a, 0xad, 0x72, 0x7b,
+	0xf5, 0xd9, 0x09, 0xe0, 0x4e, 0x90, 0x08, 0xc9, 0x26, 0x22, 0xaf, 0x32, 0x8d, 0xad, 0x99, 0xc3,
+	0x2a, 0xb3, 0x6f, 0x32, 0x3f, 0xef, 0x4d, 0xe5, 0xbf, 0x5e, 0x5e, 0xe4, 0x3a, 0x32, 0xba, 0x5c,
+	0xbc, 0x23, 0xeb, 0xa9, 0x40, 0xd6, 0xa2, 0x40, 0x60, 0x59, 0x20, 0xf0, 0x56, 0x20, 0x30, 0x5f,
+	0x23, 0x6b, 0xb9, 0x46, 0xd6, 0xcb, 0x1a, 0x59, 0xb7, 0x24, 0xe6, 0xea, 0x2e, 0xf7, 0x71, 0x20,
+	0x52, 0x62, 0xbe, 0xe6, 0xb1, 0x88, 0x22, 0x1e, 0x70, 0x9a, 0x54, 0x33, 0xa9, 0xff, 0x02, 0x35,
+	0xcb, 0x98, 0xf4, 0xff, 0xea, 0xf7, 0x3a, 0xfd, 0x18, 0x00, 0x24, 0x19, 0xff, 0x2f, 0x22, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Delisting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Delisting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delisting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CloseOutTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CloseOutTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDelisting(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RampStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RampStartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDelisting(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintDelisting(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintDelisting(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelisting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelisting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Delisting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDelisting(uint64(m.Id))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovDelisting(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RampStartTime)
+	n += 1 + l + sovDelisting(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CloseOutTime)
+	n += 1 + l + sovDelisting(uint64(l))
+	return n
+}
+
+func sovDelisting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelisting(x uint64) (n int) {
+	return sovDelisting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Delisting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelisting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delisting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delisting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelisting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelisting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelisting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RampStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseOutTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelisting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CloseOutTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelisting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDelisting(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDelisting
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelisting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelisting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDelisting
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDelisting
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDelisting
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDelisting        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDelisting          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDelisting = fmt.Errorf("proto: unexpected end of group")
+)
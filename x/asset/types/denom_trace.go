@@ -0,0 +1,82 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+// IsIBCDenom reports whether denom is an ibc voucher denom.
+func IsIBCDenom(denom string) bool {
+	return strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/")
+}
+
+// DecimalsExponent returns the exponent of decimals, which must be a power of
+// ten, like 1000000 for an asset with 6 decimal places.
+func DecimalsExponent(decimals sdk.Int) (uint32, bool) {
+	if decimals.IsNil() || !decimals.IsPositive() {
+		return 0, false
+	}
+	digits := decimals.String()
+	if strings.TrimLeft(digits[1:], "0") != "" || digits[0] != '1' {
+		return 0, false
+	}
+	return uint32(len(digits) - 1), true
+}
+
+// NewAssetDenomTrace records the denom trace of an ibc asset.
+func NewAssetDenomTrace(assetID uint64, denom string, trace ibctransfertypes.DenomTrace) AssetDenomTrace {
+	var channelID string
+	if parts := strings.Split(trace.Path, "/"); len(parts) >= 2 {
+		channelID = parts[1]
+	}
+	return AssetDenomTrace{
+		AssetId:   assetID,
+		Denom:     denom,
+		Path:      trace.Path,
+		BaseDenom: trace.BaseDenom,
+		ChannelId: channelID,
+	}
+}
+
+// MetadataExponent returns the exponent of the display unit of metadata, if
+// it has one other than its base unit.
+func MetadataExponent(metadata banktypes.Metadata) (uint32, bool) {
+	if metadata.Display == metadata.Base {
+		return 0, false
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent, true
+		}
+		for _, alias := range unit.Aliases {
+			if alias == metadata.Display {
+				return unit.Exponent, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// AssetMetadata returns metadata updated to describe asset, keeping what it
+// already holds, or new metadata if it is empty.
+func AssetMetadata(metadata banktypes.Metadata, asset Asset, exponent uint32) banktypes.Metadata {
+	if metadata.Base == "" {
+		metadata.Base = asset.Denom
+		metadata.Description = asset.Name
+		metadata.DenomUnits = []*banktypes.DenomUnit{{Denom: asset.Denom, Exponent: 0}}
+		metadata.Display = asset.Denom
+	}
+	if _, found := MetadataExponent(metadata); !found {
+		display := strings.ToLower(asset.Name)
+		if exponent > 0 && display != asset.Denom {
+			metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{Denom: display, Exponent: exponent})
+			metadata.Display = display
+		}
+	}
+	metadata.Name = asset.Name
+	metadata.Symbol = asset.Name
+	return metadata
+}
@@ -0,0 +1,482 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: comdex/asset/v1beta1/denom_trace.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AssetDenomTrace is the denom trace an ibc asset was resolved to when it was
+// registered. channel_id is the channel the asset is received on.
+type AssetDenomTrace struct {
+	AssetId   uint64 `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Path      string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty" yaml:"path"`
+	BaseDenom string `protobuf:"bytes,4,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	ChannelId string `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *AssetDenomTrace) Reset()         { *m = AssetDenomTrace{} }
+func (m *AssetDenomTrace) String() string { return proto.CompactTextString(m) }
+func (*AssetDenomTrace) ProtoMessage()    {}
+func (*AssetDenomTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_528bc921930b5425, []int{0}
+}
+func (m *AssetDenomTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetDenomTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetDenomTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetDenomTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetDenomTrace.Merge(m, src)
+}
+func (m *AssetDenomTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetDenomTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetDenomTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetDenomTrace proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*AssetDenomTrace)(nil), "comdex.asset.v1beta1.AssetDenomTrace")
+}
+
+func init() {
+	proto.RegisterFile("comdex/asset/v1beta1/denom_trace.proto", fileDescriptor_528bc921930b5425)
+}
+
+var fileDescriptor_528bc921930b5425 = []byte{
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0xff, 0x6f, 0x81, 0x1a, 0xa4, 0x42, 0x28, 0x52, 0xc5, 0xe0, 0x54, 0x46, 0xaa,
+	0xba, 0x10, 0xab, 0x82, 0x89, 0x8d, 0x88, 0xa5, 0x23, 0x11, 0x13, 0x4b, 0xe4, 0x24, 0x6e, 0x12,
+	0x29, 0x89, 0xa3, 0xc6, 0x20, 0xfa, 0x16, 0x3c, 0x06, 0x8f, 0xd2, 0xb1, 0x23, 0x53, 0x04, 0xc9,
+	0x1b, 0x64, 0x64, 0x42, 0xb6, 0x53, 0x55, 0x62, 0xf3, 0xbd, 0xe7, 0x3b, 0x47, 0x47, 0xd7, 0x70,
+	0x1a, 0xf0, 0x2c, 0x64, 0x6f, 0x84, 0x96, 0x25, 0x13, 0xe4, 0x75, 0xee, 0x33, 0x41, 0xe7, 0x24,
+	0x64, 0x39, 0xcf, 0x3c, 0xb1, 0xa2, 0x01, 0xb3, 0x8b, 0x15, 0x17, 0xdc, 0x1c, 0x69, 0xce, 0x56,
+	0x9c, 0xdd, 0x71, 0x97, 0xa3, 0x88, 0x47, 0x5c, 0x01, 0x44, 0xbe, 0x34, 0x8b, 0x7f, 0x00, 0x1c,
+	0xde, 0x4b, 0xee, 0x41, 0xc6, 0x3c, 0xc9, 0x14, 0xd3, 0x86, 0x47, 0xca, 0xea, 0x25, 0xe1, 0x18,
+	0x4c, 0xc0, 0xac, 0xe7, 0x9c, 0xb7, 0x95, 0x35, 0x5c, 0xd3, 0x2c, 0xbd, 0xc3, 0x3b, 0x05, 0xbb,
+	0x87, 0xea, 0xb9, 0x08, 0xcd, 0x29, 0xec, 0xab, 0x12, 0xe3, 0x7f, 0x13, 0x30, 0x1b, 0x38, 0xa7,
+	0x6d, 0x65, 0x9d, 0x68, 0x58, 0xad, 0xb1, 0xab, 0x65, 0xf3, 0x0a, 0xf6, 0x0a, 0x2a, 0xe2, 0xf1,
+	0x7f, 0x85, 0x0d, 0xdb, 0xca, 0x3a, 0xd6, 0x98, 0xdc, 0x62, 0x57, 0x89, 0xe6, 0x2d, 0x84, 0x3e,
+	0x2d, 0x99, 0xa7, 0x13, 0x7b, 0x0a, 0xbd, 0x68, 0x2b, 0xeb, 0x4c, 0xa3, 0x7b, 0x0d, 0xbb, 0x03,
+	0x39, 0xa8, 0xde, 0xd2, 0x15, 0xc4, 0x34, 0xcf, 0x59, 0x2a, 0x4b, 0xf7, 0xff, 0xba, 0xf6, 0x1a,
+	0x76, 0x07, 0xdd, 0xb0, 0x08, 0x9d, 0xc7, 0xcd, 0x37, 0x32, 0x3e, 0x6a, 0x64, 0x6c, 0x6a, 0x04,
+	0xb6, 0x35, 0x02, 0x5f, 0x35, 0x02, 0xef, 0x0d, 0x32, 0xb6, 0x0d, 0x32, 0x3e, 0x1b, 0x64, 0x3c,
+	0x93, 0x28, 0x11, 0xf1, 0x8b, 0x6f, 0x07, 0x3c, 0x23, 0xfa, 0xaa, 0xd7, 0x7c, 0xb9, 0x4c, 0x82,
+	0x84, 0xa6, 0xdd, 0x4c, 0x76, 0xff, 0x21, 0xd6, 0x05, 0x2b, 0xfd, 0x03, 0x75, 0xd6, 0x9b, 0xdf,
+	0x01, 0x00, 0x39, 0xea, 0x58, 0x9b, 0xac, 0x01, 0x00, 0x00,
+}
+
+func (m *AssetDenomTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetDenomTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetDenomTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintDenomTrace(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintDenomTrace(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintDenomTrace(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDenomTrace(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AssetId != 0 {
+		i = encodeVarintDenomTrace(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDenomTrace(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDenomTrace(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AssetDenomTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetId != 0 {
+		n += 1 + sovDenomTrace(uint64(m.AssetId))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDenomTrace(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovDenomTrace(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovDenomTrace(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovDenomTrace(uint64(l))
+	}
+	return n
+}
+
+func sovDenomTrace(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDenomTrace(x uint64) (n int) {
+	return sovDenomTrace(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AssetDenomTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenomTrace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetDenomTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetDenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenomTrace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenomTrace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDenomTrace(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDenomTrace
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomTrace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomTrace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDenomTrace
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDenomTrace
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDenomTrace
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDenomTrace        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDenomTrace          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDenomTrace = fmt.Errorf("proto: unexpected end of group")
+)
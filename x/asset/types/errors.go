@@ -42,4 +42,21 @@ var (
 	ErrorUnknownAppType                    = errors.Register(ModuleName, 135, "unknown app type")
 	ErrorProposalTitleMissing              = errors.Register(ModuleName, 136, "proposal title missing")
 	ErrorProposalDescriptionMissing        = errors.Register(ModuleName, 137, "proposal description missing")
+	ErrorInvalidStabilityFeeController     = errors.Register(ModuleName, 138, "invalid stability fee controller")
+	ErrorStabilityFeeControllerNotFound    = errors.Register(ModuleName, 139, "stability fee controller not found")
+	ErrorInvalidVaultCollateralAsset       = errors.Register(ModuleName, 140, "invalid vault collateral asset")
+	ErrorVaultCollateralAssetNotFound      = errors.Register(ModuleName, 141, "vault collateral asset not found")
+	ErrorInvalidDelisting                  = errors.Register(ModuleName, 142, "invalid delisting")
+	ErrorAlreadyDelisting                  = errors.Register(ModuleName, 143, "already being delisted")
+	ErrorAssetDelisted                     = errors.Register(ModuleName, 144, "asset is being delisted")
+	ErrorPairDelisted                      = errors.Register(ModuleName, 145, "pair is being delisted")
+	ErrorInvalidTimelockDelay              = errors.Register(ModuleName, 146, "invalid timelock delay")
+	ErrorPendingChangeNotFound             = errors.Register(ModuleName, 147, "pending change not found")
+	ErrorInvalidPendingChange              = errors.Register(ModuleName, 148, "invalid pending change")
+	ErrorInvalidIBCDenom                   = errors.Register(ModuleName, 149, "invalid ibc denom")
+	ErrorDenomTraceNotFound                = errors.Register(ModuleName, 150, "denom trace not found")
+	ErrorDecimalsMismatch                  = errors.Register(ModuleName, 151, "decimals conflict with the denom metadata")
+	ErrorIBCAssetOnChain                   = errors.Register(ModuleName, 152, "ibc asset cannot be on chain")
+	ErrorInvalidAppRoles                   = errors.Register(ModuleName, 153, "invalid app roles")
+	ErrorUnauthorizedAppRole               = errors.Register(ModuleName, 154, "account does not hold the app role")
 )
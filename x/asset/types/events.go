@@ -0,0 +1,24 @@
+package types
+
+// Event types for the asset module.
+const (
+	EventTypeStabilityFeeAdjusted = "stability_fee_adjusted"
+	EventTypeScheduleChange       = "schedule_pending_change"
+	EventTypeActivateChange       = "activate_pending_change"
+	EventTypeCancelChange         = "cancel_pending_change"
+	EventTypeSetAppRoles          = "set_app_roles"
+
+	AttributeKeyAppID               = "app_id"
+	AttributeKeyExtendedPairVaultID = "extended_pair_vault_id"
+	AttributeKeyPreviousFee         = "previous_fee"
+	AttributeKeyStabilityFee        = "stability_fee"
+	AttributeKeyPrice               = "price"
+	AttributeKeyPendingChangeID     = "pending_change_id"
+	AttributeKeyParamClass          = "param_class"
+	AttributeKeyActivationTime      = "activation_time"
+	AttributeKeyError               = "error"
+	AttributeKeyAdmin               = "admin"
+	AttributeKeyRiskAdmin           = "risk_admin"
+	AttributeKeyEmergencyAdmin      = "emergency_admin"
+	AttributeKeyTreasury            = "treasury"
+)
@@ -9,6 +9,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
@@ -82,8 +83,203 @@ func (m *ExtendedPairVault) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExtendedPairVault proto.InternalMessageInfo
 
+// StabilityFeeController moves the stability fee of an extended pair vault
+// within [min_fee, max_fee], by step every epoch, to bring the price of the
+// minted asset back to target_price.
+// The price is the oracle price, or the time weighted average price of the
+// minted asset on liquidity_pair_id when it is set.
+type StabilityFeeController struct {
+	AppId               uint64                                 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	ExtendedPairVaultId uint64                                 `protobuf:"varint,2,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	TargetPrice         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_price,json=targetPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_price" yaml:"target_price"`
+	DeadBand            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=dead_band,json=deadBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dead_band" yaml:"dead_band"`
+	MinFee              github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_fee" yaml:"min_fee"`
+	MaxFee              github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_fee,json=maxFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_fee" yaml:"max_fee"`
+	Step                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=step,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"step" yaml:"step"`
+	EpochDuration       time.Duration                          `protobuf:"bytes,8,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration" yaml:"epoch_duration"`
+	LiquidityPairId     uint64                                 `protobuf:"varint,9,opt,name=liquidity_pair_id,json=liquidityPairId,proto3" json:"liquidity_pair_id,omitempty" yaml:"liquidity_pair_id"`
+}
+
+func (m *StabilityFeeController) Reset()         { *m = StabilityFeeController{} }
+func (m *StabilityFeeController) String() string { return proto.CompactTextString(m) }
+func (*StabilityFeeController) ProtoMessage()    {}
+func (*StabilityFeeController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23dd38fcddb231cd, []int{1}
+}
+func (m *StabilityFeeController) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StabilityFeeController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StabilityFeeController.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StabilityFeeController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StabilityFeeController.Merge(m, src)
+}
+func (m *StabilityFeeController) XXX_Size() int {
+	return m.Size()
+}
+func (m *StabilityFeeController) XXX_DiscardUnknown() {
+	xxx_messageInfo_StabilityFeeController.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StabilityFeeController proto.InternalMessageInfo
+
+// StabilityFeeControllerState tracks the epochs of a stability fee controller
+// and accumulates the liquidity pair price over the current epoch.
+type StabilityFeeControllerState struct {
+	ExtendedPairVaultId uint64                                 `protobuf:"varint,1,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	EpochStartTime      time.Time                              `protobuf:"bytes,2,opt,name=epoch_start_time,json=epochStartTime,proto3,stdtime" json:"epoch_start_time" yaml:"epoch_start_time"`
+	LastSampleTime      time.Time                              `protobuf:"bytes,3,opt,name=last_sample_time,json=lastSampleTime,proto3,stdtime" json:"last_sample_time" yaml:"last_sample_time"`
+	CumulativePrice     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price" yaml:"cumulative_price"`
+}
+
+func (m *StabilityFeeControllerState) Reset()         { *m = StabilityFeeControllerState{} }
+func (m *StabilityFeeControllerState) String() string { return proto.CompactTextString(m) }
+func (*StabilityFeeControllerState) ProtoMessage()    {}
+func (*StabilityFeeControllerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23dd38fcddb231cd, []int{2}
+}
+func (m *StabilityFeeControllerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StabilityFeeControllerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StabilityFeeControllerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StabilityFeeControllerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StabilityFeeControllerState.Merge(m, src)
+}
+func (m *StabilityFeeControllerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *StabilityFeeControllerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_StabilityFeeControllerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StabilityFeeControllerState proto.InternalMessageInfo
+
+// StabilityFeeAdjustment records a change of the stability fee of an extended
+// pair vault. previous_fee was in force until time.
+type StabilityFeeAdjustment struct {
+	Id                  uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppId               uint64                                 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	ExtendedPairVaultId uint64                                 `protobuf:"varint,3,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	PreviousFee         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=previous_fee,json=previousFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"previous_fee" yaml:"previous_fee"`
+	StabilityFee        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=stability_fee,json=stabilityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stability_fee" yaml:"stability_fee"`
+	// price is the price the controller adjusted the fee for, zero when the
+	// fee was updated directly.
+	Price  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	Time   time.Time                              `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	Height int64                                  `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+}
+
+func (m *StabilityFeeAdjustment) Reset()         { *m = StabilityFeeAdjustment{} }
+func (m *StabilityFeeAdjustment) String() string { return proto.CompactTextString(m) }
+func (*StabilityFeeAdjustment) ProtoMessage()    {}
+func (*StabilityFeeAdjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23dd38fcddb231cd, []int{3}
+}
+func (m *StabilityFeeAdjustment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StabilityFeeAdjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StabilityFeeAdjustment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StabilityFeeAdjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StabilityFeeAdjustment.Merge(m, src)
+}
+func (m *StabilityFeeAdjustment) XXX_Size() int {
+	return m.Size()
+}
+func (m *StabilityFeeAdjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_StabilityFeeAdjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StabilityFeeAdjustment proto.InternalMessageInfo
+
+// VaultCollateralAsset whitelists asset_id as basket collateral of the vaults
+// of an extended pair vault, next to the pair's own collateral.
+type VaultCollateralAsset struct {
+	AppId               uint64 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	ExtendedPairVaultId uint64 `protobuf:"varint,2,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	AssetId             uint64 `protobuf:"varint,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	// liquidation_threshold is the min collateralization ratio the asset would
+	// need as the only collateral of the vault.
+	LiquidationThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liquidation_threshold,json=liquidationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_threshold" yaml:"liquidation_threshold"`
+	// Basket collateral is auctioned in ascending liquidation_priority, before
+	// the pair's own collateral.
+	LiquidationPriority uint64 `protobuf:"varint,5,opt,name=liquidation_priority,json=liquidationPriority,proto3" json:"liquidation_priority,omitempty" yaml:"liquidation_priority"`
+	// delisting winds the asset down once its removal passes, deposits are
+	// frozen before its value in the ratio of vaults ramps down to zero. It is
+	// unset while the asset is listed.
+	Delisting *Delisting `protobuf:"bytes,6,opt,name=delisting,proto3" json:"delisting,omitempty" yaml:"delisting"`
+}
+
+func (m *VaultCollateralAsset) Reset()         { *m = VaultCollateralAsset{} }
+func (m *VaultCollateralAsset) String() string { return proto.CompactTextString(m) }
+func (*VaultCollateralAsset) ProtoMessage()    {}
+func (*VaultCollateralAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23dd38fcddb231cd, []int{4}
+}
+func (m *VaultCollateralAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultCollateralAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultCollateralAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultCollateralAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultCollateralAsset.Merge(m, src)
+}
+func (m *VaultCollateralAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultCollateralAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultCollateralAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultCollateralAsset proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ExtendedPairVault)(nil), "comdex.asset.v1beta1.ExtendedPairVault")
+	proto.RegisterType((*StabilityFeeController)(nil), "comdex.asset.v1beta1.StabilityFeeController")
+	proto.RegisterType((*StabilityFeeControllerState)(nil), "comdex.asset.v1beta1.StabilityFeeControllerState")
+	proto.RegisterType((*StabilityFeeAdjustment)(nil), "comdex.asset.v1beta1.StabilityFeeAdjustment")
+	proto.RegisterType((*VaultCollateralAsset)(nil), "comdex.asset.v1beta1.VaultCollateralAsset")
 }
 
 func init() {
@@ -91,59 +287,95 @@ func init() {
 }
 
 var fileDescriptor_23dd38fcddb231cd = []byte{
-	// 820 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xc1, 0x72, 0xe3, 0x34,
-	0x18, 0x8e, 0xbb, 0xdb, 0x6c, 0xa3, 0x34, 0xed, 0x46, 0xcd, 0x14, 0x13, 0xa6, 0x71, 0xd0, 0x81,
-	0xc9, 0x0c, 0x6c, 0x3c, 0x85, 0xdb, 0x1e, 0x60, 0x48, 0xbb, 0x1d, 0xca, 0x2c, 0x6c, 0x11, 0xd0,
-	0x61, 0xb8, 0x68, 0x64, 0x5b, 0x71, 0x45, 0x65, 0xcb, 0xd8, 0x4a, 0xbb, 0x3d, 0xf0, 0x0e, 0xfb,
-	0x0c, 0x9c, 0x78, 0x94, 0x1e, 0xf7, 0xc8, 0x70, 0x30, 0xd0, 0xbe, 0x81, 0x9f, 0x80, 0x91, 0xe4,
-	0x92, 0xa4, 0xd3, 0x4b, 0x66, 0x4f, 0xd1, 0xff, 0xfd, 0x5f, 0xbe, 0xef, 0x97, 0xf4, 0xeb, 0x37,
-	0xf8, 0x24, 0x94, 0x49, 0xc4, 0x5e, 0xfb, 0xb4, 0x28, 0x98, 0xf2, 0x2f, 0xf6, 0x03, 0xa6, 0xe8,
-	0xbe, 0xcf, 0x5e, 0x2b, 0x96, 0x46, 0x2c, 0x3a, 0xa1, 0x3c, 0x3f, 0xa5, 0x33, 0xa1, 0xc6, 0x59,
-	0x2e, 0x95, 0x84, 0x3d, 0xcb, 0x1e, 0x1b, 0xf6, 0xb8, 0x66, 0xf7, 0x7b, 0xb1, 0x8c, 0xa5, 0x21,
-	0xf8, 0x7a, 0x65, 0xb9, 0x7d, 0x2f, 0x96, 0x32, 0x16, 0xcc, 0x37, 0x51, 0x30, 0x9b, 0xfa, 0x8a,
-	0x27, 0xac, 0x50, 0x34, 0xc9, 0x2c, 0x01, 0xfd, 0xde, 0x06, 0xdd, 0x17, 0xf7, 0x8d, 0xe0, 0x16,
-	0x58, 0xe3, 0x91, 0xeb, 0x0c, 0x9d, 0xd1, 0x63, 0xbc, 0xc6, 0x23, 0x38, 0x02, 0x4d, 0x9a, 0x65,
-	0x84, 0x47, 0xee, 0x9a, 0xc6, 0x26, 0xdd, 0xaa, 0xf4, 0x3a, 0x57, 0x34, 0x11, 0xcf, 0x91, 0xc5,
-	0x11, 0x5e, 0xa7, 0x59, 0x76, 0x1c, 0xc1, 0x8f, 0xc1, 0x93, 0x8c, 0xf2, 0x5c, 0x53, 0x1f, 0x19,
-	0x2a, 0xac, 0x4a, 0x6f, 0xcb, 0x52, 0xeb, 0x04, 0xc2, 0x4d, 0xbd, 0x3a, 0x8e, 0xe0, 0x39, 0xe8,
-	0x14, 0x8a, 0x06, 0x5c, 0x70, 0x75, 0x45, 0xa6, 0x8c, 0xb9, 0x8f, 0x87, 0xce, 0xa8, 0x35, 0x39,
-	0xba, 0x2e, 0xbd, 0xc6, 0x5f, 0xa5, 0xf7, 0x51, 0xcc, 0xd5, 0xd9, 0x2c, 0x18, 0x87, 0x32, 0xf1,
-	0x43, 0x59, 0x24, 0xb2, 0xa8, 0x7f, 0x9e, 0x15, 0xd1, 0xb9, 0xaf, 0xae, 0x32, 0x56, 0x8c, 0x0f,
-	0x59, 0x58, 0x95, 0x5e, 0xcf, 0x1a, 0x2c, 0x89, 0x21, 0xbc, 0xf9, 0x7f, 0x7c, 0xc4, 0x18, 0x64,
-	0xa0, 0x1d, 0x0a, 0x59, 0xf0, 0x34, 0x36, 0x56, 0xeb, 0xc6, 0xea, 0x70, 0x65, 0x2b, 0x68, 0xad,
-	0x16, 0xa4, 0x10, 0x06, 0x75, 0xa4, 0x6d, 0x7e, 0x03, 0x3b, 0x82, 0xff, 0x3a, 0xe3, 0x11, 0x55,
-	0x5c, 0xa6, 0x24, 0x63, 0x29, 0x15, 0xea, 0xca, 0x6d, 0x1a, 0xbb, 0x97, 0x2b, 0xdb, 0xf5, 0xad,
-	0xdd, 0x03, 0x92, 0x08, 0xc3, 0x05, 0xf4, 0xc4, 0x82, 0xf0, 0x17, 0xd0, 0x89, 0x72, 0x7a, 0x49,
-	0x22, 0x79, 0x99, 0x9a, 0x7d, 0x3e, 0x79, 0xb7, 0x23, 0x5d, 0x12, 0x43, 0xb8, 0xad, 0xe3, 0x43,
-	0x79, 0x99, 0xea, 0xad, 0x7e, 0x0e, 0xb6, 0x79, 0x41, 0x2e, 0x74, 0xc7, 0x10, 0x1a, 0x2a, 0x7e,
-	0xc1, 0xdc, 0x8d, 0xa1, 0x33, 0xda, 0x98, 0xec, 0xce, 0xcf, 0xc9, 0xe2, 0x64, 0x2a, 0x68, 0x8c,
-	0x70, 0x87, 0x17, 0xa6, 0xbf, 0xbe, 0x34, 0x20, 0x3c, 0x03, 0x9b, 0x11, 0x0b, 0x14, 0x09, 0x19,
-	0x17, 0x3c, 0x8d, 0xdd, 0x96, 0x29, 0xf5, 0xc5, 0x0a, 0xa5, 0x1e, 0xa7, 0xaa, 0x2a, 0xbd, 0x9d,
-	0xba, 0xd4, 0x05, 0x2d, 0x5d, 0x29, 0x0b, 0xd4, 0x81, 0x8d, 0x60, 0x00, 0x80, 0xc9, 0x4e, 0x85,
-	0x94, 0xb9, 0x0b, 0x8c, 0xcf, 0xc1, 0xca, 0x3e, 0xdd, 0x05, 0x1f, 0xa3, 0x84, 0x70, 0x4b, 0x07,
-	0x47, 0x7a, 0x0d, 0x4f, 0x40, 0x8f, 0x17, 0x44, 0xb7, 0x9c, 0x60, 0x24, 0xe1, 0xa9, 0xb2, 0x27,
-	0xe3, 0xb6, 0xcd, 0x91, 0x78, 0x55, 0xe9, 0x7d, 0x60, 0xff, 0xff, 0x10, 0x0b, 0xe1, 0x2e, 0x2f,
-	0xbe, 0x37, 0xe8, 0x37, 0x3c, 0x55, 0xf6, 0x15, 0x9e, 0x82, 0x66, 0xc2, 0x53, 0x12, 0xe6, 0xee,
-	0xa6, 0xa9, 0xf8, 0x8b, 0x95, 0x2f, 0xb1, 0x7e, 0xa3, 0x56, 0x05, 0xe1, 0xf5, 0x84, 0xa7, 0x07,
-	0x39, 0xdc, 0x07, 0x2d, 0xf3, 0x14, 0x53, 0x9a, 0x30, 0xb7, 0x63, 0xa4, 0x7b, 0x55, 0xe9, 0x3d,
-	0x5d, 0x78, 0xa5, 0x3a, 0x85, 0xf0, 0x86, 0x5e, 0x7f, 0x4b, 0x13, 0x06, 0x4f, 0xc1, 0xae, 0x19,
-	0x37, 0x44, 0xce, 0x14, 0x91, 0x39, 0x0d, 0x05, 0x23, 0x59, 0xce, 0x43, 0xe6, 0x6e, 0x99, 0xed,
-	0x7d, 0x58, 0x95, 0xde, 0x5e, 0x7d, 0xe3, 0x0f, 0xf2, 0x10, 0xde, 0x31, 0x89, 0x57, 0x33, 0xf5,
-	0xca, 0xc0, 0x27, 0x1a, 0x85, 0x13, 0xb0, 0x3d, 0xe7, 0x5b, 0xc1, 0x6d, 0x33, 0x36, 0xfa, 0x55,
-	0xe9, 0xed, 0xde, 0x17, 0xac, 0x95, 0x3a, 0x77, 0x4a, 0x56, 0xe3, 0x6b, 0x00, 0xf5, 0x06, 0x67,
-	0x45, 0x44, 0x2e, 0xa8, 0x98, 0x31, 0x22, 0xd8, 0x54, 0xb9, 0x4f, 0x8d, 0xcc, 0x5e, 0x55, 0x7a,
-	0xef, 0xcf, 0x0f, 0x61, 0x99, 0x83, 0xf0, 0x76, 0xc2, 0xd3, 0x1f, 0x8b, 0xe8, 0x54, 0x43, 0x2f,
-	0xd9, 0x54, 0xc1, 0xe7, 0x60, 0x33, 0x10, 0x32, 0x3c, 0x27, 0x67, 0x8c, 0xc7, 0x67, 0xca, 0xed,
-	0x0e, 0x9d, 0xd1, 0xa3, 0xc9, 0x7b, 0xf3, 0x26, 0x5b, 0xcc, 0x22, 0xdc, 0x36, 0xe1, 0x57, 0x26,
-	0x82, 0x3f, 0x01, 0x60, 0xb3, 0x7a, 0xc6, 0xba, 0x70, 0xe8, 0x8c, 0xda, 0x9f, 0xf6, 0xc7, 0x76,
-	0x00, 0x8f, 0xef, 0x06, 0xf0, 0xf8, 0x87, 0xbb, 0x01, 0x3c, 0xd9, 0xd3, 0xd7, 0x39, 0x6f, 0xab,
-	0xf9, 0x7f, 0xd1, 0x9b, 0xbf, 0x3d, 0x07, 0xb7, 0x0c, 0xa0, 0xe9, 0x93, 0xef, 0xae, 0xff, 0x1d,
-	0x34, 0xfe, 0xb8, 0x19, 0x34, 0xae, 0x6f, 0x06, 0xce, 0xdb, 0x9b, 0x81, 0xf3, 0xcf, 0xcd, 0xc0,
-	0x79, 0x73, 0x3b, 0x68, 0xbc, 0xbd, 0x1d, 0x34, 0xfe, 0xbc, 0x1d, 0x34, 0x7e, 0xf6, 0x97, 0x5a,
-	0x42, 0x7f, 0x1e, 0x9e, 0xc9, 0xe9, 0x94, 0x87, 0x9c, 0x8a, 0x3a, 0xf6, 0xef, 0x3e, 0x2f, 0xa6,
-	0x3f, 0x82, 0xa6, 0x29, 0xe8, 0xb3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x98, 0x15, 0x0f,
-	0x7b, 0x06, 0x00, 0x00,
+	// 1400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0x8f, 0xf3, 0xc7, 0x89, 0xd7, 0x71, 0x9c, 0x6c, 0xdc, 0x54, 0x4d, 0x5b, 0x2b, 0x5d, 0x18,
+	0x26, 0x0c, 0xd4, 0x9e, 0x96, 0x5b, 0x67, 0x28, 0xd4, 0x49, 0x4b, 0xc3, 0x94, 0x36, 0x55, 0x4a,
+	0x06, 0xb8, 0x68, 0xd6, 0xd2, 0xda, 0xde, 0x56, 0xd2, 0x0a, 0x69, 0x9d, 0x26, 0x07, 0x4e, 0x7c,
+	0x81, 0x1e, 0xb9, 0x70, 0xe1, 0xc4, 0xd7, 0xe0, 0xd6, 0x19, 0x2e, 0x3d, 0x32, 0x1c, 0x0c, 0xa4,
+	0xdf, 0xc0, 0x17, 0xae, 0xcc, 0xbe, 0x95, 0x6c, 0x25, 0x31, 0x74, 0x44, 0x86, 0x19, 0x4e, 0xda,
+	0x7d, 0xfb, 0xf6, 0xf7, 0x76, 0xf7, 0xfd, 0xde, 0x1f, 0xa1, 0xf7, 0x1d, 0xe1, 0xbb, 0xec, 0xb0,
+	0x49, 0xe3, 0x98, 0xc9, 0xe6, 0xc1, 0x8d, 0x36, 0x93, 0xf4, 0x46, 0x93, 0x1d, 0x4a, 0x16, 0xb8,
+	0xcc, 0xdd, 0xa5, 0x3c, 0xda, 0xa7, 0x7d, 0x4f, 0x36, 0xc2, 0x48, 0x48, 0x81, 0x6b, 0x5a, 0xbb,
+	0x01, 0xda, 0x8d, 0x44, 0x7b, 0xbd, 0xd6, 0x15, 0x5d, 0x01, 0x0a, 0x4d, 0x35, 0xd2, 0xba, 0xeb,
+	0x66, 0x57, 0x88, 0xae, 0xc7, 0x9a, 0x30, 0x6b, 0xf7, 0x3b, 0x4d, 0xc9, 0x7d, 0x16, 0x4b, 0xea,
+	0x87, 0x89, 0x42, 0xfd, 0xb4, 0x82, 0xdb, 0x8f, 0xa8, 0xe4, 0x22, 0x48, 0xd6, 0xdf, 0x9e, 0x78,
+	0x34, 0x97, 0x79, 0x3c, 0x96, 0x3c, 0xe8, 0x6a, 0x2d, 0xf2, 0x43, 0x19, 0xad, 0xdc, 0x3d, 0x7d,
+	0x5c, 0xbc, 0x84, 0xa6, 0xb9, 0x6b, 0x14, 0x36, 0x0a, 0x9b, 0xb3, 0xd6, 0x34, 0x77, 0xf1, 0x26,
+	0x2a, 0xd2, 0x30, 0xb4, 0xb9, 0x6b, 0x4c, 0x2b, 0x59, 0x6b, 0x65, 0x38, 0x30, 0x2b, 0x47, 0xd4,
+	0xf7, 0x6e, 0x11, 0x2d, 0x27, 0xd6, 0x1c, 0x0d, 0xc3, 0x1d, 0x17, 0xbf, 0x87, 0xe6, 0x43, 0xca,
+	0x23, 0xa5, 0x3a, 0x03, 0xaa, 0x78, 0x38, 0x30, 0x97, 0xb4, 0x6a, 0xb2, 0x40, 0xac, 0xa2, 0x1a,
+	0xed, 0xb8, 0xf8, 0x19, 0xaa, 0xc4, 0x92, 0xb6, 0xb9, 0xc7, 0xe5, 0x91, 0xdd, 0x61, 0xcc, 0x98,
+	0xdd, 0x28, 0x6c, 0x96, 0x5a, 0xf7, 0x5e, 0x0e, 0xcc, 0xa9, 0x5f, 0x07, 0xe6, 0x3b, 0x5d, 0x2e,
+	0x7b, 0xfd, 0x76, 0xc3, 0x11, 0x7e, 0xd3, 0x11, 0xb1, 0x2f, 0xe2, 0xe4, 0x73, 0x3d, 0x76, 0x9f,
+	0x35, 0xe5, 0x51, 0xc8, 0xe2, 0xc6, 0x36, 0x73, 0x86, 0x03, 0xb3, 0xa6, 0x0d, 0x9c, 0x00, 0x23,
+	0xd6, 0xe2, 0x68, 0x7e, 0x8f, 0x31, 0xcc, 0x50, 0xd9, 0xf1, 0x44, 0xcc, 0x83, 0x2e, 0x98, 0x9a,
+	0x03, 0x53, 0xdb, 0xb9, 0x4d, 0x61, 0x6d, 0x2a, 0x03, 0x45, 0x2c, 0x94, 0xcc, 0x94, 0x99, 0x6f,
+	0xd0, 0xaa, 0xc7, 0xbf, 0xee, 0x73, 0x17, 0x7c, 0x61, 0x87, 0x2c, 0xa0, 0x9e, 0x3c, 0x32, 0x8a,
+	0x60, 0xee, 0x41, 0x6e, 0x73, 0xeb, 0xda, 0xdc, 0x04, 0x48, 0x62, 0xe1, 0x8c, 0x74, 0x57, 0x0b,
+	0xf1, 0x53, 0x54, 0x71, 0x23, 0xfa, 0xdc, 0x76, 0xc5, 0xf3, 0x00, 0xee, 0x39, 0x7f, 0xbe, 0x27,
+	0x3d, 0x01, 0x46, 0xac, 0xb2, 0x9a, 0x6f, 0x8b, 0xe7, 0x81, 0xba, 0xea, 0x6d, 0x54, 0xe5, 0xb1,
+	0x7d, 0xa0, 0x18, 0x63, 0x53, 0x47, 0xf2, 0x03, 0x66, 0x2c, 0x6c, 0x14, 0x36, 0x17, 0x5a, 0x6b,
+	0xe3, 0x77, 0xd2, 0x72, 0xbb, 0xe3, 0xd1, 0x2e, 0xb1, 0x2a, 0x3c, 0x06, 0x7e, 0xdd, 0x01, 0x21,
+	0xee, 0xa1, 0x45, 0x97, 0xb5, 0xa5, 0xed, 0x30, 0xee, 0xf1, 0xa0, 0x6b, 0x94, 0xe0, 0xa8, 0x77,
+	0x73, 0x1c, 0x75, 0x27, 0x90, 0xc3, 0x81, 0xb9, 0x9a, 0x1c, 0x35, 0x83, 0xa5, 0x4e, 0xca, 0xda,
+	0x72, 0x4b, 0xcf, 0x70, 0x1b, 0x21, 0x58, 0xed, 0x78, 0x42, 0x44, 0x06, 0x02, 0x3b, 0x5b, 0xb9,
+	0xed, 0xac, 0x64, 0xec, 0x00, 0x12, 0xb1, 0x4a, 0x6a, 0x72, 0x4f, 0x8d, 0xf1, 0x2e, 0xaa, 0xf1,
+	0xd8, 0x56, 0x94, 0xf3, 0x98, 0xed, 0xf3, 0x40, 0xea, 0x97, 0x31, 0xca, 0xf0, 0x24, 0xe6, 0x70,
+	0x60, 0x5e, 0xd6, 0xfb, 0x27, 0x69, 0x11, 0x6b, 0x85, 0xc7, 0x7b, 0x20, 0xfd, 0x8c, 0x07, 0x52,
+	0x47, 0xe1, 0x3e, 0x2a, 0xfa, 0x3c, 0xb0, 0x9d, 0xc8, 0x58, 0x84, 0x13, 0x7f, 0x94, 0xdb, 0x89,
+	0x49, 0x8c, 0x6a, 0x14, 0x62, 0xcd, 0xf9, 0x3c, 0xd8, 0x8a, 0xf0, 0x0d, 0x54, 0x82, 0x50, 0x0c,
+	0xa8, 0xcf, 0x8c, 0x0a, 0x40, 0xd7, 0x86, 0x03, 0x73, 0x39, 0x13, 0xa5, 0x6a, 0x89, 0x58, 0x0b,
+	0x6a, 0xfc, 0x90, 0xfa, 0x0c, 0xef, 0xa3, 0x35, 0xc8, 0x23, 0xb6, 0xe8, 0x4b, 0x5b, 0x44, 0xd4,
+	0xf1, 0x98, 0x1d, 0x46, 0xdc, 0x61, 0xc6, 0x12, 0x5c, 0xef, 0xda, 0x70, 0x60, 0x5e, 0x4d, 0x3c,
+	0x3e, 0x51, 0x8f, 0x58, 0xab, 0xb0, 0xf0, 0xa8, 0x2f, 0x1f, 0x81, 0x78, 0x57, 0x49, 0x71, 0x0b,
+	0x55, 0xc7, 0xfa, 0x1a, 0xb0, 0x0a, 0x69, 0x63, 0x7d, 0x38, 0x30, 0xd7, 0x4e, 0x03, 0x26, 0x48,
+	0x95, 0x14, 0x49, 0x63, 0x7c, 0x8a, 0xb0, 0xba, 0x60, 0x3f, 0x76, 0xed, 0x03, 0xea, 0xf5, 0x99,
+	0xed, 0xb1, 0x8e, 0x34, 0x96, 0x01, 0xe6, 0xea, 0x70, 0x60, 0x5e, 0x1a, 0x3f, 0xc2, 0x49, 0x1d,
+	0x62, 0x55, 0x7d, 0x1e, 0x7c, 0x1e, 0xbb, 0xfb, 0x4a, 0xf4, 0x80, 0x75, 0x24, 0xbe, 0x85, 0x16,
+	0xdb, 0x9e, 0x70, 0x9e, 0xd9, 0x3d, 0xc6, 0xbb, 0x3d, 0x69, 0xac, 0x6c, 0x14, 0x36, 0x67, 0x5a,
+	0x17, 0xc7, 0x24, 0xcb, 0xae, 0x12, 0xab, 0x0c, 0xd3, 0xfb, 0x30, 0xc3, 0x5f, 0x20, 0xa4, 0x57,
+	0x55, 0xa6, 0x36, 0xf0, 0x46, 0x61, 0xb3, 0x7c, 0x73, 0xbd, 0xa1, 0xb3, 0x74, 0x23, 0xcd, 0xd2,
+	0x8d, 0x27, 0x69, 0x1a, 0x6f, 0x5d, 0x55, 0xee, 0x1c, 0xd3, 0x6a, 0xbc, 0x97, 0xbc, 0xf8, 0xcd,
+	0x2c, 0x58, 0x25, 0x10, 0x28, 0x75, 0xf2, 0x7d, 0x11, 0xad, 0xed, 0x65, 0x72, 0xd9, 0x96, 0x08,
+	0x64, 0x24, 0x3c, 0x8f, 0x45, 0x99, 0xcc, 0x5c, 0x78, 0x43, 0x66, 0xde, 0x47, 0x6b, 0x69, 0x5d,
+	0xb2, 0xc1, 0xc7, 0x3a, 0x70, 0x47, 0x39, 0x3d, 0xe3, 0xc2, 0xc9, 0x7a, 0xc4, 0x5a, 0x3d, 0x53,
+	0xd8, 0x76, 0x5c, 0x15, 0xc5, 0x92, 0x46, 0x5d, 0x96, 0xfa, 0x6f, 0x26, 0x77, 0x14, 0x6b, 0xae,
+	0x26, 0x0f, 0x9c, 0xc5, 0x22, 0x56, 0x59, 0x4f, 0xb5, 0xa3, 0x6d, 0x54, 0x72, 0x19, 0x75, 0xed,
+	0x36, 0x0d, 0xdc, 0xa4, 0x54, 0xb4, 0x72, 0x9b, 0x59, 0x4e, 0x83, 0x38, 0x01, 0x22, 0xd6, 0x82,
+	0x1a, 0xb7, 0x68, 0xe0, 0xe2, 0x2f, 0xd1, 0xbc, 0xcf, 0x75, 0xda, 0xd4, 0xe5, 0xe1, 0xe3, 0xdc,
+	0xf0, 0x4b, 0x63, 0xb2, 0x41, 0xc2, 0x54, 0x11, 0xac, 0x72, 0xa5, 0x82, 0xa6, 0x87, 0x00, 0x5d,
+	0x3c, 0x27, 0x34, 0x3d, 0x4c, 0xa1, 0xe9, 0xa1, 0x82, 0x7e, 0x8c, 0x66, 0x63, 0xc9, 0xc2, 0x24,
+	0xd3, 0x7f, 0x98, 0x1b, 0xb7, 0x9c, 0x16, 0x4f, 0x16, 0x12, 0x0b, 0xa0, 0xb0, 0x83, 0x96, 0x58,
+	0x28, 0x9c, 0x9e, 0x9d, 0xf6, 0x14, 0x90, 0xd8, 0xcb, 0x37, 0x2f, 0x9d, 0xa1, 0xf3, 0x76, 0xa2,
+	0xd0, 0xba, 0x96, 0xb0, 0xf9, 0x42, 0x42, 0xa1, 0x13, 0xdb, 0xc9, 0x77, 0x8a, 0xd1, 0x15, 0x10,
+	0xa6, 0x3b, 0xf0, 0x7d, 0xb4, 0xa2, 0x0b, 0x98, 0x2a, 0xd8, 0x69, 0xd3, 0x50, 0x02, 0x2e, 0x5e,
+	0x19, 0x0e, 0x4c, 0x23, 0x5b, 0xf9, 0x32, 0x2a, 0xc4, 0xaa, 0x8e, 0x64, 0xbb, 0xd0, 0x47, 0x90,
+	0x9f, 0x66, 0xd0, 0xe5, 0xc9, 0xf1, 0xb1, 0x27, 0xa9, 0x64, 0xff, 0x40, 0xfd, 0xc2, 0xb9, 0xa8,
+	0xcf, 0xd1, 0xb2, 0xbe, 0x67, 0x2c, 0x69, 0x24, 0x75, 0xdc, 0x4f, 0xbf, 0x31, 0xee, 0xdf, 0x4a,
+	0x5e, 0xea, 0x62, 0xf6, 0xa5, 0xc6, 0x08, 0x3a, 0xfa, 0xf5, 0xfb, 0xef, 0x29, 0xa9, 0xda, 0xa9,
+	0x4c, 0x79, 0x34, 0x96, 0x76, 0x4c, 0xfd, 0xd0, 0x63, 0xda, 0xd4, 0x4c, 0x5e, 0x53, 0xa7, 0x11,
+	0x12, 0x53, 0x4a, 0xbc, 0x07, 0x52, 0x30, 0x25, 0xd1, 0xb2, 0xd3, 0xf7, 0xfb, 0x1e, 0x85, 0xca,
+	0xad, 0x83, 0x5a, 0x47, 0xdb, 0x4e, 0x6e, 0x6e, 0x25, 0x86, 0x4f, 0xe3, 0x11, 0xab, 0x3a, 0x16,
+	0x41, 0x70, 0x93, 0x9f, 0x67, 0x4f, 0xe6, 0xb8, 0x3b, 0xee, 0xd3, 0x7e, 0x2c, 0x7d, 0x16, 0x9c,
+	0xa7, 0x1b, 0xfd, 0x7b, 0xc7, 0xcf, 0x9c, 0x37, 0xe7, 0x85, 0x11, 0x3b, 0xe0, 0xa2, 0x1f, 0x67,
+	0xfa, 0xd6, 0x7f, 0x9d, 0xf3, 0xb2, 0x58, 0xc4, 0x2a, 0xa7, 0x53, 0x15, 0xdc, 0x67, 0x5a, 0xe4,
+	0xb9, 0xff, 0xb0, 0x45, 0x7e, 0x82, 0xe6, 0xb4, 0xbb, 0x75, 0x8a, 0xba, 0x9d, 0xdb, 0xc8, 0x62,
+	0x7a, 0x1f, 0xf0, 0xb1, 0x06, 0xc3, 0x9f, 0xa0, 0x59, 0xa0, 0xeb, 0xfc, 0x1b, 0xe9, 0x7a, 0x31,
+	0xa1, 0x6b, 0x92, 0x91, 0xc6, 0x14, 0x05, 0x00, 0xfc, 0x2e, 0x2a, 0x26, 0x65, 0x79, 0x01, 0xca,
+	0x72, 0xc6, 0xef, 0x69, 0x41, 0x4e, 0x14, 0xc8, 0x9f, 0x33, 0xa8, 0x06, 0xce, 0xda, 0x12, 0x9e,
+	0x47, 0x25, 0x8b, 0xa8, 0x77, 0x47, 0x35, 0x0d, 0xff, 0x83, 0x7a, 0xd9, 0x40, 0x0b, 0xba, 0xa3,
+	0x19, 0xb1, 0x70, 0x75, 0x38, 0x30, 0xab, 0xd9, 0x5e, 0x47, 0xed, 0x9d, 0x87, 0xe1, 0x8e, 0x8b,
+	0xbf, 0x2d, 0xa0, 0x0b, 0xd9, 0xf6, 0x5f, 0xf6, 0x22, 0x16, 0xf7, 0x84, 0x97, 0x96, 0xc0, 0x87,
+	0xb9, 0xbd, 0x74, 0xe5, 0xec, 0x3f, 0xc5, 0x08, 0x94, 0x58, 0xb5, 0x8c, 0xfc, 0x49, 0x2a, 0xc6,
+	0x16, 0xca, 0xca, 0x55, 0x14, 0x8b, 0x88, 0xcb, 0x23, 0xa0, 0xe3, 0x6c, 0xb6, 0xbb, 0x9d, 0xa4,
+	0x45, 0xac, 0xec, 0x3f, 0xd1, 0x6e, 0x22, 0xc5, 0x7b, 0xa8, 0x34, 0xfa, 0x1d, 0x05, 0xca, 0x95,
+	0x6f, 0x9a, 0x8d, 0x49, 0xbf, 0xc8, 0x8d, 0xed, 0x54, 0x2d, 0xdb, 0xa8, 0x8e, 0xf6, 0x42, 0x1b,
+	0x9e, 0x2a, 0x3c, 0x7e, 0xf9, 0x47, 0x7d, 0xea, 0xc7, 0xe3, 0xfa, 0xd4, 0xcb, 0xe3, 0x7a, 0xe1,
+	0xd5, 0x71, 0xbd, 0xf0, 0xfb, 0x71, 0xbd, 0xf0, 0xe2, 0x75, 0x7d, 0xea, 0xd5, 0xeb, 0xfa, 0xd4,
+	0x2f, 0xaf, 0xeb, 0x53, 0x5f, 0x35, 0x4f, 0x3c, 0x94, 0xb2, 0x76, 0x5d, 0x74, 0x3a, 0xdc, 0xe1,
+	0xd4, 0x4b, 0xe6, 0xcd, 0xf4, 0xaf, 0x19, 0x5e, 0xad, 0x5d, 0x04, 0xaa, 0x7e, 0xf0, 0xd7, 0x00,
+	0x26, 0x47, 0xf3, 0x95, 0xed, 0x0f, 0x00, 0x00,
 }
 
 func (m *ExtendedPairVault) Marshal() (dAtA []byte, err error) {
@@ -320,80 +552,1486 @@ func (m *ExtendedPairVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintExtendedPairVault(dAtA []byte, offset int, v uint64) int {
-	offset -= sovExtendedPairVault(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StabilityFeeController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ExtendedPairVault) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *StabilityFeeController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StabilityFeeController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovExtendedPairVault(uint64(m.Id))
+	if m.LiquidityPairId != 0 {
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(m.LiquidityPairId))
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintExtendedPairVault(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Step.Size()
+		i -= size
+		if _, err := m.Step.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DeadBand.Size()
+		i -= size
+		if _, err := m.DeadBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TargetPrice.Size()
+		i -= size
+		if _, err := m.TargetPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.AppId != 0 {
-		n += 1 + sovExtendedPairVault(uint64(m.AppId))
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.PairId != 0 {
-		n += 1 + sovExtendedPairVault(uint64(m.PairId))
+	return len(dAtA) - i, nil
+}
+
+func (m *StabilityFeeControllerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.StabilityFee.Size()
-	n += 1 + l + sovExtendedPairVault(uint64(l))
-	l = m.ClosingFee.Size()
-	n += 1 + l + sovExtendedPairVault(uint64(l))
-	l = m.LiquidationPenalty.Size()
-	n += 1 + l + sovExtendedPairVault(uint64(l))
-	l = m.DrawDownFee.Size()
-	n += 1 + l + sovExtendedPairVault(uint64(l))
-	if m.IsVaultActive {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *StabilityFeeControllerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StabilityFeeControllerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(size))
 	}
-	l = m.DebtCeiling.Size()
-	n += 1 + l + sovExtendedPairVault(uint64(l))
-	l = m.DebtFloor.Size()
-	n += 1 + l + sovExtendedPairVault(uint64(l))
-	if m.IsStableMintVault {
-		n += 2
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastSampleTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastSampleTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	l = m.MinCr.Size()
-	n += 1 + l + sovExtendedPairVault(uint64(l))
-	l = len(m.PairName)
-	if l > 0 {
-		n += 1 + l + sovExtendedPairVault(uint64(l))
+	i -= n3
+	i = encodeVarintExtendedPairVault(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochStartTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	if m.AssetOutOraclePrice {
-		n += 2
+	i -= n4
+	i = encodeVarintExtendedPairVault(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.AssetOutPrice != 0 {
-		n += 1 + sovExtendedPairVault(uint64(m.AssetOutPrice))
+	return len(dAtA) - i, nil
+}
+
+func (m *StabilityFeeAdjustment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MinUsdValueLeft != 0 {
-		n += 2 + sovExtendedPairVault(uint64(m.MinUsdValueLeft))
+	return dAtA[:n], nil
+}
+
+func (m *StabilityFeeAdjustment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StabilityFeeAdjustment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.BlockHeight != 0 {
-		n += 2 + sovExtendedPairVault(uint64(m.BlockHeight))
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintExtendedPairVault(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.StabilityFee.Size()
+		i -= size
+		if _, err := m.StabilityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PreviousFee.Size()
+		i -= size
+		if _, err := m.PreviousFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultCollateralAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultCollateralAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultCollateralAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delisting != nil {
+		{
+			size, err := m.Delisting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExtendedPairVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LiquidationPriority != 0 {
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(m.LiquidationPriority))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.LiquidationThreshold.Size()
+		i -= size
+		if _, err := m.LiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AssetId != 0 {
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppId != 0 {
+		i = encodeVarintExtendedPairVault(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExtendedPairVault(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExtendedPairVault(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExtendedPairVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.Id))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.AppId))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.PairId))
+	}
+	l = m.StabilityFee.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = m.ClosingFee.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = m.LiquidationPenalty.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = m.DrawDownFee.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	if m.IsVaultActive {
+		n += 2
+	}
+	l = m.DebtCeiling.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = m.DebtFloor.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	if m.IsStableMintVault {
+		n += 2
+	}
+	l = m.MinCr.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = len(m.PairName)
+	if l > 0 {
+		n += 1 + l + sovExtendedPairVault(uint64(l))
+	}
+	if m.AssetOutOraclePrice {
+		n += 2
+	}
+	if m.AssetOutPrice != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.AssetOutPrice))
+	}
+	if m.MinUsdValueLeft != 0 {
+		n += 2 + sovExtendedPairVault(uint64(m.MinUsdValueLeft))
+	}
+	if m.BlockHeight != 0 {
+		n += 2 + sovExtendedPairVault(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 2 + l + sovExtendedPairVault(uint64(l))
+	return n
+}
+
+func (m *StabilityFeeController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.ExtendedPairVaultId))
+	}
+	l = m.TargetPrice.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = m.DeadBand.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = m.Step.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	if m.LiquidityPairId != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.LiquidityPairId))
+	}
+	return n
+}
+
+func (m *StabilityFeeControllerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.ExtendedPairVaultId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochStartTime)
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastSampleTime)
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	return n
+}
+
+func (m *StabilityFeeAdjustment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.Id))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.ExtendedPairVaultId))
+	}
+	l = m.PreviousFee.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = m.StabilityFee.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *VaultCollateralAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.ExtendedPairVaultId))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.AssetId))
+	}
+	l = m.LiquidationThreshold.Size()
+	n += 1 + l + sovExtendedPairVault(uint64(l))
+	if m.LiquidationPriority != 0 {
+		n += 1 + sovExtendedPairVault(uint64(m.LiquidationPriority))
+	}
+	if m.Delisting != nil {
+		l = m.Delisting.Size()
+		n += 1 + l + sovExtendedPairVault(uint64(l))
+	}
+	return n
+}
+
+func sovExtendedPairVault(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExtendedPairVault(x uint64) (n int) {
+	return sovExtendedPairVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtendedPairVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedPairVault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedPairVault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosingFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClosingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawDownFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DrawDownFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVaultActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsVaultActive = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsStableMintVault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsStableMintVault = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetOutOraclePrice", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AssetOutOraclePrice = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetOutPrice", wireType)
+			}
+			m.AssetOutPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetOutPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUsdValueLeft", wireType)
+			}
+			m.MinUsdValueLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUsdValueLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtendedPairVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StabilityFeeController) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtendedPairVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StabilityFeeController: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StabilityFeeController: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeadBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Step.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityPairId", wireType)
+			}
+			m.LiquidityPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityPairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtendedPairVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StabilityFeeControllerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtendedPairVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StabilityFeeControllerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StabilityFeeControllerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSampleTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastSampleTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedPairVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtendedPairVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtendedPairVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
-	n += 2 + l + sovExtendedPairVault(uint64(l))
-	return n
-}
 
-func sovExtendedPairVault(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozExtendedPairVault(x uint64) (n int) {
-	return sovExtendedPairVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
+func (m *StabilityFeeAdjustment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -416,10 +2054,10 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtendedPairVault: wiretype end group for non-group")
+			return fmt.Errorf("proto: StabilityFeeAdjustment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtendedPairVault: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StabilityFeeAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -462,9 +2100,9 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
 			}
-			m.PairId = 0
+			m.ExtendedPairVaultId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExtendedPairVault
@@ -474,14 +2112,14 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PairId |= uint64(b&0x7F) << shift
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -509,13 +2147,13 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosingFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -543,13 +2181,13 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClosingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -577,15 +2215,15 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrawDownFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExtendedPairVault
@@ -595,31 +2233,30 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExtendedPairVault
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExtendedPairVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DrawDownFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsVaultActive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExtendedPairVault
@@ -629,51 +2266,66 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsVaultActive = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtCeiling", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExtendedPairVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtendedPairVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthExtendedPairVault
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExtendedPairVault
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultCollateralAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtendedPairVault
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DebtCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtFloor", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultCollateralAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultCollateralAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			var stringLen uint64
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExtendedPairVault
@@ -683,31 +2335,16 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExtendedPairVault
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExtendedPairVault
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DebtFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsStableMintVault", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
 			}
-			var v int
+			m.ExtendedPairVaultId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExtendedPairVault
@@ -717,17 +2354,16 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsStableMintVault = bool(v != 0)
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCr", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
 			}
-			var stringLen uint64
+			m.AssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExtendedPairVault
@@ -737,29 +2373,14 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExtendedPairVault
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExtendedPairVault
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinCr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -787,71 +2408,15 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetOutOraclePrice", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExtendedPairVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AssetOutOraclePrice = bool(v != 0)
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetOutPrice", wireType)
-			}
-			m.AssetOutPrice = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExtendedPairVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetOutPrice |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinUsdValueLeft", wireType)
-			}
-			m.MinUsdValueLeft = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExtendedPairVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinUsdValueLeft |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 17:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPriority", wireType)
 			}
-			m.BlockHeight = 0
+			m.LiquidationPriority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExtendedPairVault
@@ -861,14 +2426,14 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.LiquidationPriority |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delisting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -895,7 +2460,10 @@ func (m *ExtendedPairVault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Delisting == nil {
+				m.Delisting = &Delisting{}
+			}
+			if err := m.Delisting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,12 +1,27 @@
 package types
 
-func NewGenesisState(assets []Asset, pairs []Pair, appData []AppData, extendedPairVault []ExtendedPairVault, params Params) *GenesisState {
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+func NewGenesisState(assets []Asset, pairs []Pair, appData []AppData, extendedPairVault []ExtendedPairVault, params Params, stabilityFeeControllers []StabilityFeeController, stabilityFeeControllerStates []StabilityFeeControllerState, stabilityFeeAdjustments []StabilityFeeAdjustment, vaultCollateralAssets []VaultCollateralAsset, assetDelistings, pairDelistings []Delisting, pendingChanges []PendingChange, timelockDelays []TimelockDelay, denomTraces []AssetDenomTrace) *GenesisState {
 	return &GenesisState{
-		Assets:            assets,
-		Pairs:             pairs,
-		AppData:           appData,
-		ExtendedPairVault: extendedPairVault,
-		Params:            params,
+		Assets:                       assets,
+		Pairs:                        pairs,
+		AppData:                      appData,
+		ExtendedPairVault:            extendedPairVault,
+		Params:                       params,
+		StabilityFeeControllers:      stabilityFeeControllers,
+		StabilityFeeControllerStates: stabilityFeeControllerStates,
+		StabilityFeeAdjustments:      stabilityFeeAdjustments,
+		VaultCollateralAssets:        vaultCollateralAssets,
+		AssetDelistings:              assetDelistings,
+		PairDelistings:               pairDelistings,
+		PendingChanges:               pendingChanges,
+		TimelockDelays:               timelockDelays,
+		DenomTraces:                  denomTraces,
 	}
 }
 
@@ -17,9 +32,32 @@ func DefaultGenesisState() *GenesisState {
 		[]AppData{},
 		[]ExtendedPairVault{},
 		DefaultParams(),
+		[]StabilityFeeController{},
+		[]StabilityFeeControllerState{},
+		[]StabilityFeeAdjustment{},
+		[]VaultCollateralAsset{},
+		[]Delisting{},
+		[]Delisting{},
+		[]PendingChange{},
+		[]TimelockDelay{},
+		[]AssetDenomTrace{},
 	)
 }
 
-func ValidateGenesis(_ *GenesisState) error {
+func ValidateGenesis(state *GenesisState) error {
+	for _, delay := range state.TimelockDelays {
+		if err := delay.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, change := range m.PendingChanges {
+		if err := change.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
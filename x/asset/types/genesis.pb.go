@@ -24,11 +24,20 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Assets            []Asset             `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets" yaml:"assets"`
-	Pairs             []Pair              `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs" yaml:"pairs"`
-	AppData           []AppData           `protobuf:"bytes,3,rep,name=appData,proto3" json:"appData" yaml:"appData"`
-	ExtendedPairVault []ExtendedPairVault `protobuf:"bytes,4,rep,name=extendedPairVault,proto3" json:"extendedPairVault" yaml:"extendedPairVault"`
-	Params            Params              `protobuf:"bytes,5,opt,name=params,proto3" json:"params" yaml:"params"`
+	Assets                       []Asset                       `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets" yaml:"assets"`
+	Pairs                        []Pair                        `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs" yaml:"pairs"`
+	AppData                      []AppData                     `protobuf:"bytes,3,rep,name=appData,proto3" json:"appData" yaml:"appData"`
+	ExtendedPairVault            []ExtendedPairVault           `protobuf:"bytes,4,rep,name=extendedPairVault,proto3" json:"extendedPairVault" yaml:"extendedPairVault"`
+	Params                       Params                        `protobuf:"bytes,5,opt,name=params,proto3" json:"params" yaml:"params"`
+	StabilityFeeControllers      []StabilityFeeController      `protobuf:"bytes,6,rep,name=stabilityFeeControllers,proto3" json:"stabilityFeeControllers" yaml:"stabilityFeeControllers"`
+	StabilityFeeControllerStates []StabilityFeeControllerState `protobuf:"bytes,7,rep,name=stabilityFeeControllerStates,proto3" json:"stabilityFeeControllerStates" yaml:"stabilityFeeControllerStates"`
+	StabilityFeeAdjustments      []StabilityFeeAdjustment      `protobuf:"bytes,8,rep,name=stabilityFeeAdjustments,proto3" json:"stabilityFeeAdjustments" yaml:"stabilityFeeAdjustments"`
+	VaultCollateralAssets        []VaultCollateralAsset        `protobuf:"bytes,9,rep,name=vaultCollateralAssets,proto3" json:"vaultCollateralAssets" yaml:"vaultCollateralAssets"`
+	AssetDelistings              []Delisting                   `protobuf:"bytes,10,rep,name=assetDelistings,proto3" json:"assetDelistings" yaml:"assetDelistings"`
+	PairDelistings               []Delisting                   `protobuf:"bytes,11,rep,name=pairDelistings,proto3" json:"pairDelistings" yaml:"pairDelistings"`
+	PendingChanges               []PendingChange               `protobuf:"bytes,12,rep,name=pendingChanges,proto3" json:"pendingChanges" yaml:"pendingChanges"`
+	TimelockDelays               []TimelockDelay               `protobuf:"bytes,13,rep,name=timelockDelays,proto3" json:"timelockDelays" yaml:"timelockDelays"`
+	DenomTraces                  []AssetDenomTrace             `protobuf:"bytes,14,rep,name=denomTraces,proto3" json:"denomTraces" yaml:"denomTraces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,32 +82,51 @@ func init() {
 }
 
 var fileDescriptor_13a69a7476a1f579 = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x4e, 0xea, 0x40,
-	0x14, 0x87, 0xdb, 0xcb, 0x85, 0x9b, 0x14, 0xee, 0x4d, 0x6e, 0x83, 0xa6, 0x41, 0x1d, 0xea, 0x6c,
-	0x64, 0xa1, 0x6d, 0xc0, 0x9d, 0x3b, 0x1b, 0xff, 0x24, 0xba, 0x50, 0x6b, 0xe2, 0xc2, 0xdd, 0x00,
-	0x43, 0x6d, 0x02, 0x74, 0xd2, 0x19, 0x14, 0xde, 0xc2, 0xc7, 0xf0, 0x15, 0x7c, 0x03, 0x96, 0x2c,
-	0x5d, 0x11, 0x2d, 0x6f, 0xe0, 0x13, 0x98, 0xce, 0x19, 0x36, 0x30, 0xec, 0x3a, 0x9d, 0xef, 0x7c,
-	0xbf, 0x73, 0x4e, 0xc6, 0xc2, 0x9d, 0x64, 0xd0, 0xa5, 0x63, 0x9f, 0x70, 0x4e, 0x85, 0xff, 0xdc,
-	0x6c, 0x53, 0x41, 0x9a, 0x7e, 0x44, 0x87, 0x94, 0xc7, 0xdc, 0x63, 0x69, 0x22, 0x12, 0xbb, 0x0a,
-	0x8c, 0x27, 0x19, 0x4f, 0x31, 0xb5, 0x6a, 0x94, 0x44, 0x89, 0x04, 0xfc, 0xfc, 0x0b, 0xd8, 0x9a,
-	0xab, 0xf5, 0x41, 0x25, 0x10, 0x75, 0x2d, 0xc1, 0x48, 0x9c, 0x2a, 0x00, 0xe9, 0x15, 0x8c, 0xa9,
-	0xfb, 0x43, 0xed, 0x3d, 0x1d, 0x0b, 0x3a, 0xec, 0xd2, 0xee, 0x2d, 0x89, 0xd3, 0x07, 0x32, 0xea,
-	0x2f, 0xe3, 0xf6, 0x37, 0xc4, 0xa5, 0x64, 0xa0, 0xe6, 0xc3, 0xef, 0x05, 0xab, 0x72, 0x09, 0x13,
-	0xdf, 0x0b, 0x22, 0xa8, 0x7d, 0x65, 0x95, 0x24, 0xce, 0x1d, 0xd3, 0x2d, 0x34, 0xca, 0xad, 0x1d,
-	0x4f, 0xb7, 0x01, 0xef, 0x34, 0x3f, 0x05, 0x5b, 0xd3, 0x79, 0xdd, 0xf8, 0x9e, 0xd7, 0xff, 0x4e,
-	0xc8, 0xa0, 0x7f, 0x82, 0xa1, 0x10, 0x87, 0xca, 0x60, 0x5f, 0x58, 0xc5, 0x7c, 0x36, 0xee, 0xfc,
-	0x92, 0xaa, 0x9a, 0x5e, 0x95, 0x77, 0x1d, 0x54, 0x95, 0xa9, 0x02, 0x26, 0x59, 0x86, 0x43, 0x28,
-	0xb7, 0x6f, 0xac, 0x3f, 0x84, 0xb1, 0x33, 0x22, 0x88, 0x53, 0x90, 0xa6, 0xbd, 0x0d, 0x4d, 0x01,
-	0x14, 0x6c, 0x2b, 0xd9, 0x3f, 0xd5, 0x16, 0xfc, 0xc6, 0xe1, 0xd2, 0x62, 0xbf, 0x58, 0xff, 0xd7,
-	0x76, 0xe6, 0xfc, 0x96, 0xea, 0x03, 0xbd, 0xfa, 0x7c, 0x15, 0x0f, 0x5c, 0x15, 0xe2, 0x40, 0xc8,
-	0x9a, 0x0f, 0x87, 0xeb, 0x19, 0xf6, 0xb5, 0x55, 0x82, 0xf5, 0x3b, 0x45, 0xd7, 0x6c, 0x94, 0x5b,
-	0xbb, 0x9b, 0x56, 0x92, 0x33, 0xab, 0xeb, 0x85, 0x4a, 0x1c, 0x2a, 0x45, 0x70, 0x37, 0xfd, 0x42,
-	0xc6, 0x5b, 0x86, 0x8c, 0x69, 0x86, 0xcc, 0x59, 0x86, 0xcc, 0xcf, 0x0c, 0x99, 0xaf, 0x0b, 0x64,
-	0xcc, 0x16, 0xc8, 0xf8, 0x58, 0x20, 0xe3, 0xd1, 0x8f, 0x62, 0xf1, 0x34, 0x6a, 0xe7, 0x21, 0x3e,
-	0x04, 0x1d, 0x25, 0xbd, 0x5e, 0xdc, 0x89, 0x49, 0x5f, 0x9d, 0xfd, 0xe5, 0xeb, 0x10, 0x13, 0x46,
-	0x79, 0xbb, 0x24, 0x5f, 0xc5, 0xf1, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x6f, 0x99, 0x1f,
-	0x1b, 0x03, 0x00, 0x00,
+	// 703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x41, 0x53, 0xd3, 0x4c,
+	0x18, 0xc7, 0x9b, 0x97, 0x97, 0xf2, 0xbe, 0xdb, 0xd2, 0x77, 0xde, 0x1d, 0xc0, 0x9d, 0x0a, 0x69,
+	0x5d, 0x10, 0x19, 0xc5, 0x66, 0xc0, 0x9b, 0x37, 0x42, 0xc5, 0x19, 0x3d, 0xa8, 0x81, 0xe1, 0xe0,
+	0xc5, 0xd9, 0xb6, 0x4b, 0x58, 0x4c, 0x93, 0x4c, 0x76, 0x41, 0xfa, 0x05, 0x3c, 0x3a, 0x5e, 0xbd,
+	0x7a, 0xf2, 0xa3, 0x70, 0xe4, 0xe8, 0x89, 0xd1, 0xf2, 0x0d, 0xfc, 0x04, 0x4e, 0x76, 0xb7, 0x10,
+	0xd2, 0x4d, 0x07, 0x6f, 0x6d, 0xf7, 0xff, 0xfc, 0x7e, 0x4f, 0x9f, 0x24, 0x4f, 0x00, 0xee, 0x46,
+	0xfd, 0x1e, 0x3d, 0x75, 0x08, 0xe7, 0x54, 0x38, 0x27, 0x1b, 0x1d, 0x2a, 0xc8, 0x86, 0xe3, 0xd3,
+	0x90, 0x72, 0xc6, 0x5b, 0x71, 0x12, 0x89, 0x08, 0xce, 0xa9, 0x4c, 0x4b, 0x66, 0x5a, 0x3a, 0x53,
+	0x9f, 0xf3, 0x23, 0x3f, 0x92, 0x01, 0x27, 0xfd, 0xa4, 0xb2, 0xf5, 0xa6, 0x91, 0xa7, 0x2a, 0x55,
+	0xa2, 0x61, 0x4c, 0xc4, 0x84, 0x25, 0x3a, 0x60, 0x9b, 0x11, 0x71, 0xac, 0xcf, 0xd7, 0x8d, 0xe7,
+	0xf4, 0x54, 0xd0, 0xb0, 0x47, 0x7b, 0xaf, 0x09, 0x4b, 0xf6, 0xc9, 0x71, 0x30, 0xd2, 0xdd, 0x2b,
+	0xd0, 0x25, 0xa4, 0xaf, 0xff, 0x5f, 0x7d, 0xc5, 0x18, 0xe9, 0xd1, 0x80, 0x71, 0xc1, 0x42, 0x5f,
+	0xa7, 0x96, 0x8d, 0x29, 0xc1, 0xfa, 0x34, 0x88, 0xba, 0xef, 0x75, 0x68, 0xb5, 0x00, 0x15, 0x46,
+	0xfd, 0x77, 0x22, 0x21, 0x5d, 0xaa, 0x72, 0xf8, 0x4b, 0x15, 0x54, 0x9f, 0xab, 0x21, 0xef, 0x0a,
+	0x22, 0x28, 0x7c, 0x01, 0xca, 0xb2, 0x86, 0x23, 0xab, 0x39, 0xb5, 0x56, 0xd9, 0xbc, 0xdb, 0x32,
+	0x0d, 0xbd, 0xb5, 0x95, 0x7e, 0x73, 0xe7, 0xcf, 0x2e, 0x1a, 0xa5, 0x5f, 0x17, 0x8d, 0xd9, 0x01,
+	0xe9, 0x07, 0x4f, 0xb1, 0x2a, 0xc4, 0x9e, 0x26, 0xc0, 0x1d, 0x30, 0x9d, 0x8e, 0x93, 0xa3, 0xbf,
+	0x24, 0xaa, 0x6e, 0x46, 0xa5, 0x83, 0x72, 0xe7, 0x34, 0xa9, 0xaa, 0x48, 0xb2, 0x0c, 0x7b, 0xaa,
+	0x1c, 0xbe, 0x02, 0x33, 0x24, 0x8e, 0xdb, 0x44, 0x10, 0x34, 0x25, 0x49, 0x4b, 0x05, 0x4d, 0xa9,
+	0x90, 0xbb, 0xa0, 0x61, 0x35, 0xdd, 0x96, 0xfa, 0x19, 0x7b, 0x23, 0x0a, 0xfc, 0x00, 0xfe, 0x1f,
+	0xbb, 0x4c, 0xe8, 0x6f, 0x89, 0x7e, 0x60, 0x46, 0x3f, 0xcb, 0xc7, 0xdd, 0xa6, 0x96, 0x20, 0x25,
+	0x19, 0xe3, 0x61, 0x6f, 0xdc, 0x01, 0x5f, 0x82, 0xb2, 0xba, 0xe2, 0x68, 0xba, 0x69, 0xad, 0x55,
+	0x36, 0x17, 0x8b, 0x46, 0x92, 0x66, 0xf2, 0xe3, 0x55, 0x95, 0xd8, 0xd3, 0x08, 0xf8, 0xc9, 0x02,
+	0x77, 0xb8, 0x20, 0x1d, 0x16, 0x30, 0x31, 0xd8, 0xa1, 0x74, 0x3b, 0x0a, 0x45, 0x12, 0x05, 0x01,
+	0x4d, 0x38, 0x2a, 0xcb, 0x3f, 0xb3, 0x6e, 0xc6, 0xef, 0x1a, 0x8b, 0xdc, 0x55, 0xad, 0xb3, 0x95,
+	0xae, 0x00, 0x8d, 0xbd, 0x22, 0x29, 0xfc, 0x6a, 0x81, 0x45, 0xf3, 0x99, 0xbc, 0xb7, 0x38, 0x9a,
+	0x91, 0x5d, 0x6d, 0xfc, 0x49, 0x57, 0xb2, 0xd2, 0x7d, 0xa4, 0x5b, 0x5b, 0x9e, 0xd4, 0x9a, 0x92,
+	0x60, 0x6f, 0x62, 0x0f, 0x63, 0x53, 0xdb, 0xea, 0x1d, 0x1d, 0x73, 0xd1, 0xa7, 0xa1, 0xe0, 0xe8,
+	0x9f, 0xdb, 0x4e, 0xed, 0xba, 0x68, 0xd2, 0xd4, 0x32, 0xe8, 0xdc, 0xd4, 0x32, 0x27, 0xf0, 0xa3,
+	0x05, 0xe6, 0x4f, 0xd2, 0xbb, 0x63, 0x3b, 0x0a, 0x02, 0x22, 0x68, 0x42, 0x82, 0x2d, 0xf5, 0x04,
+	0xfe, 0x2b, 0xdb, 0x79, 0x68, 0x6e, 0x67, 0xdf, 0x50, 0xe2, 0xae, 0xe8, 0x66, 0x16, 0x55, 0x33,
+	0x46, 0x2c, 0xf6, 0xcc, 0x3a, 0xc8, 0xc0, 0x7f, 0x52, 0xd1, 0x1e, 0x2d, 0x1c, 0x8e, 0x80, 0xec,
+	0xa0, 0x61, 0xee, 0xe0, 0x2a, 0xe7, 0xda, 0x5a, 0xbb, 0x90, 0xd9, 0x03, 0xd7, 0x14, 0xec, 0xe5,
+	0xb9, 0xf0, 0x00, 0xd4, 0xd2, 0x47, 0x3b, 0x63, 0xaa, 0xdc, 0xce, 0xb4, 0xa4, 0x4d, 0xf3, 0xd7,
+	0x7b, 0x22, 0x2b, 0xca, 0x51, 0xe1, 0x11, 0xa8, 0xc5, 0x34, 0xec, 0xb1, 0xd0, 0xdf, 0x3e, 0x24,
+	0xa1, 0x4f, 0x39, 0xaa, 0x4a, 0xcf, 0x72, 0xc1, 0x73, 0x97, 0xcd, 0x8e, 0xb9, 0x6e, 0x80, 0x52,
+	0xd7, 0x8d, 0x1f, 0x52, 0xd7, 0x68, 0x09, 0xb7, 0x69, 0x40, 0x06, 0x1c, 0xcd, 0x4e, 0x72, 0xed,
+	0x65, 0xb3, 0x79, 0xd7, 0x4d, 0x10, 0xf6, 0x72, 0x64, 0xd8, 0x05, 0x15, 0xb9, 0xcb, 0xf7, 0xd2,
+	0x55, 0xce, 0x51, 0x4d, 0x8a, 0xee, 0x4f, 0x58, 0xd5, 0xed, 0xab, 0xb4, 0x5b, 0xd7, 0x2a, 0xa8,
+	0x54, 0x19, 0x0e, 0xf6, 0xb2, 0x54, 0xf7, 0xcd, 0xd9, 0x4f, 0xbb, 0xf4, 0x6d, 0x68, 0x97, 0xce,
+	0x86, 0xb6, 0x75, 0x3e, 0xb4, 0xad, 0x1f, 0x43, 0xdb, 0xfa, 0x7c, 0x69, 0x97, 0xce, 0x2f, 0xed,
+	0xd2, 0xf7, 0x4b, 0xbb, 0xf4, 0xd6, 0xf1, 0x99, 0x38, 0x3c, 0xee, 0xa4, 0x5e, 0x47, 0xb9, 0x1f,
+	0x47, 0x07, 0x07, 0xac, 0xcb, 0x48, 0xa0, 0xbf, 0x3b, 0xa3, 0x57, 0x90, 0x18, 0xc4, 0x94, 0x77,
+	0xca, 0xf2, 0xad, 0xf3, 0xe4, 0xf7, 0x00, 0x6c, 0x93, 0x2e, 0x61, 0xee, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +149,132 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomTraces) > 0 {
+		for iNdEx := len(m.DenomTraces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTraces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.TimelockDelays) > 0 {
+		for iNdEx := len(m.TimelockDelays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimelockDelays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PendingChanges) > 0 {
+		for iNdEx := len(m.PendingChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PairDelistings) > 0 {
+		for iNdEx := len(m.PairDelistings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairDelistings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AssetDelistings) > 0 {
+		for iNdEx := len(m.AssetDelistings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetDelistings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VaultCollateralAssets) > 0 {
+		for iNdEx := len(m.VaultCollateralAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultCollateralAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.StabilityFeeAdjustments) > 0 {
+		for iNdEx := len(m.StabilityFeeAdjustments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StabilityFeeAdjustments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.StabilityFeeControllerStates) > 0 {
+		for iNdEx := len(m.StabilityFeeControllerStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StabilityFeeControllerStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.StabilityFeeControllers) > 0 {
+		for iNdEx := len(m.StabilityFeeControllers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StabilityFeeControllers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -233,6 +387,60 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.StabilityFeeControllers) > 0 {
+		for _, e := range m.StabilityFeeControllers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StabilityFeeControllerStates) > 0 {
+		for _, e := range m.StabilityFeeControllerStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StabilityFeeAdjustments) > 0 {
+		for _, e := range m.StabilityFeeAdjustments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VaultCollateralAssets) > 0 {
+		for _, e := range m.VaultCollateralAssets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AssetDelistings) > 0 {
+		for _, e := range m.AssetDelistings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairDelistings) > 0 {
+		for _, e := range m.PairDelistings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingChanges) > 0 {
+		for _, e := range m.PendingChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimelockDelays) > 0 {
+		for _, e := range m.TimelockDelays {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomTraces) > 0 {
+		for _, e := range m.DenomTraces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -440,6 +648,312 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFeeControllers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StabilityFeeControllers = append(m.StabilityFeeControllers, StabilityFeeController{})
+			if err := m.StabilityFeeControllers[len(m.StabilityFeeControllers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFeeControllerStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StabilityFeeControllerStates = append(m.StabilityFeeControllerStates, StabilityFeeControllerState{})
+			if err := m.StabilityFeeControllerStates[len(m.StabilityFeeControllerStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFeeAdjustments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StabilityFeeAdjustments = append(m.StabilityFeeAdjustments, StabilityFeeAdjustment{})
+			if err := m.StabilityFeeAdjustments[len(m.StabilityFeeAdjustments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultCollateralAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultCollateralAssets = append(m.VaultCollateralAssets, VaultCollateralAsset{})
+			if err := m.VaultCollateralAssets[len(m.VaultCollateralAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDelistings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDelistings = append(m.AssetDelistings, Delisting{})
+			if err := m.AssetDelistings[len(m.AssetDelistings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairDelistings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairDelistings = append(m.PairDelistings, Delisting{})
+			if err := m.PairDelistings[len(m.PairDelistings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChanges = append(m.PendingChanges, PendingChange{})
+			if err := m.PendingChanges[len(m.PendingChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockDelays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockDelays = append(m.TimelockDelays, TimelockDelay{})
+			if err := m.TimelockDelays[len(m.TimelockDelays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTraces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTraces = append(m.DenomTraces, AssetDenomTrace{})
+			if err := m.DenomTraces[len(m.DenomTraces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -15,6 +18,20 @@ const (
 	ProposalAddAssetInApp          = "AddAssetInApp"
 	ProposalUpdateGovTimeInApp     = "UpdateGovTimeInApp"
 	ProposalAddMultipleAssetsPairs = "AddMultipleAssetsPairs"
+
+	ProposalSetStabilityFeeController    = "SetStabilityFeeController"
+	ProposalRemoveStabilityFeeController = "RemoveStabilityFeeController"
+
+	ProposalSetVaultCollateralAsset    = "SetVaultCollateralAsset"
+	ProposalRemoveVaultCollateralAsset = "RemoveVaultCollateralAsset"
+
+	ProposalDelistAsset = "DelistAsset"
+	ProposalDelistPair  = "DelistPair"
+
+	ProposalCancelPendingChange = "CancelPendingChange"
+	ProposalSetTimelockDelay    = "SetTimelockDelay"
+
+	ProposalSetAppRoles = "SetAppRoles"
 )
 
 func init() {
@@ -47,6 +64,33 @@ func init() {
 
 	govtypes.RegisterProposalType(ProposalAddMultipleAssetsPairs)
 	govtypes.RegisterProposalTypeCodec(&AddMultipleAssetsPairsProposal{}, "comdex/AddMultipleAssetsPairsProposal")
+
+	govtypes.RegisterProposalType(ProposalSetStabilityFeeController)
+	govtypes.RegisterProposalTypeCodec(&SetStabilityFeeControllerProposal{}, "comdex/SetStabilityFeeControllerProposal")
+
+	govtypes.RegisterProposalType(ProposalRemoveStabilityFeeController)
+	govtypes.RegisterProposalTypeCodec(&RemoveStabilityFeeControllerProposal{}, "comdex/RemoveStabilityFeeControllerProposal")
+
+	govtypes.RegisterProposalType(ProposalSetVaultCollateralAsset)
+	govtypes.RegisterProposalTypeCodec(&SetVaultCollateralAssetProposal{}, "comdex/SetVaultCollateralAssetProposal")
+
+	govtypes.RegisterProposalType(ProposalRemoveVaultCollateralAsset)
+	govtypes.RegisterProposalTypeCodec(&RemoveVaultCollateralAssetProposal{}, "comdex/RemoveVaultCollateralAssetProposal")
+
+	govtypes.RegisterProposalType(ProposalDelistAsset)
+	govtypes.RegisterProposalTypeCodec(&DelistAssetProposal{}, "comdex/DelistAssetProposal")
+
+	govtypes.RegisterProposalType(ProposalDelistPair)
+	govtypes.RegisterProposalTypeCodec(&DelistPairProposal{}, "comdex/DelistPairProposal")
+
+	govtypes.RegisterProposalType(ProposalCancelPendingChange)
+	govtypes.RegisterProposalTypeCodec(&CancelPendingChangeProposal{}, "comdex/CancelPendingChangeProposal")
+
+	govtypes.RegisterProposalType(ProposalSetTimelockDelay)
+	govtypes.RegisterProposalTypeCodec(&SetTimelockDelayProposal{}, "comdex/SetTimelockDelayProposal")
+
+	govtypes.RegisterProposalType(ProposalSetAppRoles)
+	govtypes.RegisterProposalTypeCodec(&SetAppRolesProposal{}, "comdex/SetAppRolesProposal")
 }
 
 var (
@@ -60,6 +104,15 @@ var (
 	_ govtypes.Content = &AddAppProposal{}
 	_ govtypes.Content = &AddAssetInAppProposal{}
 	_ govtypes.Content = &AddMultipleAssetsPairsProposal{}
+	_ govtypes.Content = &SetStabilityFeeControllerProposal{}
+	_ govtypes.Content = &RemoveStabilityFeeControllerProposal{}
+	_ govtypes.Content = &SetVaultCollateralAssetProposal{}
+	_ govtypes.Content = &RemoveVaultCollateralAssetProposal{}
+	_ govtypes.Content = &DelistAssetProposal{}
+	_ govtypes.Content = &DelistPairProposal{}
+	_ govtypes.Content = &CancelPendingChangeProposal{}
+	_ govtypes.Content = &SetTimelockDelayProposal{}
+	_ govtypes.Content = &SetAppRolesProposal{}
 )
 
 func NewAddAssetsProposal(title, description string, assets Asset) govtypes.Content {
@@ -392,3 +445,322 @@ func (p *AddMultipleAssetsPairsProposal) ValidateBasic() error {
 
 	return nil
 }
+
+func NewSetStabilityFeeControllerProposal(title, description string, controller StabilityFeeController) govtypes.Content {
+	return &SetStabilityFeeControllerProposal{
+		Title:       title,
+		Description: description,
+		Controller:  controller,
+	}
+}
+
+func (p *SetStabilityFeeControllerProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p *SetStabilityFeeControllerProposal) GetDescription() string {
+	return p.Description
+}
+
+func (p *SetStabilityFeeControllerProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetStabilityFeeControllerProposal) ProposalType() string {
+	return ProposalSetStabilityFeeController
+}
+
+func (p *SetStabilityFeeControllerProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := p.Controller.Validate(); err != nil {
+		return errors.Wrap(ErrorInvalidStabilityFeeController, err.Error())
+	}
+
+	return nil
+}
+
+func NewRemoveStabilityFeeControllerProposal(title, description string, extendedPairVaultID uint64) govtypes.Content {
+	return &RemoveStabilityFeeControllerProposal{
+		Title:               title,
+		Description:         description,
+		ExtendedPairVaultId: extendedPairVaultID,
+	}
+}
+
+func (p *RemoveStabilityFeeControllerProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p *RemoveStabilityFeeControllerProposal) GetDescription() string {
+	return p.Description
+}
+
+func (p *RemoveStabilityFeeControllerProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveStabilityFeeControllerProposal) ProposalType() string {
+	return ProposalRemoveStabilityFeeController
+}
+
+func (p *RemoveStabilityFeeControllerProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.ExtendedPairVaultId == 0 {
+		return ErrorInvalidStabilityFeeController
+	}
+
+	return nil
+}
+
+func NewSetVaultCollateralAssetProposal(title, description string, collateral VaultCollateralAsset) govtypes.Content {
+	return &SetVaultCollateralAssetProposal{
+		Title:       title,
+		Description: description,
+		Collateral:  collateral,
+	}
+}
+
+func (p *SetVaultCollateralAssetProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p *SetVaultCollateralAssetProposal) GetDescription() string {
+	return p.Description
+}
+
+func (p *SetVaultCollateralAssetProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetVaultCollateralAssetProposal) ProposalType() string {
+	return ProposalSetVaultCollateralAsset
+}
+
+func (p *SetVaultCollateralAssetProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := p.Collateral.Validate(); err != nil {
+		return errors.Wrap(ErrorInvalidVaultCollateralAsset, err.Error())
+	}
+
+	return nil
+}
+
+func NewRemoveVaultCollateralAssetProposal(title, description string, extendedPairVaultID, assetID uint64, freezeDuration, rampDuration time.Duration) govtypes.Content {
+	return &RemoveVaultCollateralAssetProposal{
+		Title:               title,
+		Description:         description,
+		ExtendedPairVaultId: extendedPairVaultID,
+		AssetId:             assetID,
+		FreezeDuration:      freezeDuration,
+		RampDuration:        rampDuration,
+	}
+}
+
+func (p *RemoveVaultCollateralAssetProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p *RemoveVaultCollateralAssetProposal) GetDescription() string {
+	return p.Description
+}
+
+func (p *RemoveVaultCollateralAssetProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveVaultCollateralAssetProposal) ProposalType() string {
+	return ProposalRemoveVaultCollateralAsset
+}
+
+func (p *RemoveVaultCollateralAssetProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.ExtendedPairVaultId == 0 || p.AssetId == 0 {
+		return ErrorInvalidVaultCollateralAsset
+	}
+
+	return validateDelistingDurations(p.FreezeDuration, p.RampDuration)
+}
+
+func NewDelistAssetProposal(title, description string, assetID uint64, freezeDuration, rampDuration time.Duration) govtypes.Content {
+	return &DelistAssetProposal{
+		Title:          title,
+		Description:    description,
+		AssetId:        assetID,
+		FreezeDuration: freezeDuration,
+		RampDuration:   rampDuration,
+	}
+}
+
+func (p *DelistAssetProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p *DelistAssetProposal) GetDescription() string {
+	return p.Description
+}
+
+func (p *DelistAssetProposal) ProposalRoute() string { return RouterKey }
+
+func (p *DelistAssetProposal) ProposalType() string {
+	return ProposalDelistAsset
+}
+
+func (p *DelistAssetProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.AssetId == 0 {
+		return errors.Wrap(ErrorInvalidDelisting, "asset_id cannot be zero")
+	}
+
+	return validateDelistingDurations(p.FreezeDuration, p.RampDuration)
+}
+
+func NewDelistPairProposal(title, description string, pairID uint64, freezeDuration, rampDuration time.Duration) govtypes.Content {
+	return &DelistPairProposal{
+		Title:          title,
+		Description:    description,
+		PairId:         pairID,
+		FreezeDuration: freezeDuration,
+		RampDuration:   rampDuration,
+	}
+}
+
+func (p *DelistPairProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p *DelistPairProposal) GetDescription() string {
+	return p.Description
+}
+
+func (p *DelistPairProposal) ProposalRoute() string { return RouterKey }
+
+func (p *DelistPairProposal) ProposalType() string {
+	return ProposalDelistPair
+}
+
+func (p *DelistPairProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.PairId == 0 {
+		return errors.Wrap(ErrorInvalidDelisting, "pair_id cannot be zero")
+	}
+
+	return validateDelistingDurations(p.FreezeDuration, p.RampDuration)
+}
+
+func NewCancelPendingChangeProposal(title, description string, id uint64) govtypes.Content {
+	return &CancelPendingChangeProposal{
+		Title:       title,
+		Description: description,
+		Id:          id,
+	}
+}
+
+func (p *CancelPendingChangeProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p *CancelPendingChangeProposal) GetDescription() string {
+	return p.Description
+}
+
+func (p *CancelPendingChangeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *CancelPendingChangeProposal) ProposalType() string {
+	return ProposalCancelPendingChange
+}
+
+func (p *CancelPendingChangeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.Id == 0 {
+		return errors.Wrap(ErrorInvalidPendingChange, "id cannot be zero")
+	}
+
+	return nil
+}
+
+func NewSetTimelockDelayProposal(title, description string, delay TimelockDelay) govtypes.Content {
+	return &SetTimelockDelayProposal{
+		Title:       title,
+		Description: description,
+		Delay:       delay,
+	}
+}
+
+func (p *SetTimelockDelayProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p *SetTimelockDelayProposal) GetDescription() string {
+	return p.Description
+}
+
+func (p *SetTimelockDelayProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetTimelockDelayProposal) ProposalType() string {
+	return ProposalSetTimelockDelay
+}
+
+func (p *SetTimelockDelayProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return p.Delay.Validate()
+}
+
+func NewSetAppRolesProposal(title, description string, appID uint64, roles AppRoles) govtypes.Content {
+	return &SetAppRolesProposal{
+		Title:       title,
+		Description: description,
+		AppId:       appID,
+		Roles:       roles,
+	}
+}
+
+func (p *SetAppRolesProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p *SetAppRolesProposal) GetDescription() string {
+	return p.Description
+}
+
+func (p *SetAppRolesProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetAppRolesProposal) ProposalType() string {
+	return ProposalSetAppRoles
+}
+
+func (p *SetAppRolesProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.AppId == 0 {
+		return errors.Wrap(ErrorInvalidAppRoles, "app id cannot be zero")
+	}
+
+	return p.Roles.Validate()
+}
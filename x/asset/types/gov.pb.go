@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -413,6 +417,369 @@ func (m *AddMultipleAssetsPairsProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddMultipleAssetsPairsProposal proto.InternalMessageInfo
 
+type SetStabilityFeeControllerProposal struct {
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Controller  StabilityFeeController `protobuf:"bytes,3,opt,name=controller,proto3" json:"controller"`
+}
+
+func (m *SetStabilityFeeControllerProposal) Reset()         { *m = SetStabilityFeeControllerProposal{} }
+func (m *SetStabilityFeeControllerProposal) String() string { return proto.CompactTextString(m) }
+func (*SetStabilityFeeControllerProposal) ProtoMessage()    {}
+func (*SetStabilityFeeControllerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5aab0360b917f, []int{10}
+}
+func (m *SetStabilityFeeControllerProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetStabilityFeeControllerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetStabilityFeeControllerProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetStabilityFeeControllerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStabilityFeeControllerProposal.Merge(m, src)
+}
+func (m *SetStabilityFeeControllerProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetStabilityFeeControllerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStabilityFeeControllerProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetStabilityFeeControllerProposal proto.InternalMessageInfo
+
+type RemoveStabilityFeeControllerProposal struct {
+	Title               string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description         string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	ExtendedPairVaultId uint64 `protobuf:"varint,3,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+}
+
+func (m *RemoveStabilityFeeControllerProposal) Reset()         { *m = RemoveStabilityFeeControllerProposal{} }
+func (m *RemoveStabilityFeeControllerProposal) String() string { return proto.CompactTextString(m) }
+func (*RemoveStabilityFeeControllerProposal) ProtoMessage()    {}
+func (*RemoveStabilityFeeControllerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5aab0360b917f, []int{11}
+}
+func (m *RemoveStabilityFeeControllerProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveStabilityFeeControllerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveStabilityFeeControllerProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveStabilityFeeControllerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveStabilityFeeControllerProposal.Merge(m, src)
+}
+func (m *RemoveStabilityFeeControllerProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveStabilityFeeControllerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveStabilityFeeControllerProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveStabilityFeeControllerProposal proto.InternalMessageInfo
+
+type SetVaultCollateralAssetProposal struct {
+	Title       string               `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string               `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Collateral  VaultCollateralAsset `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
+}
+
+func (m *SetVaultCollateralAssetProposal) Reset()         { *m = SetVaultCollateralAssetProposal{} }
+func (m *SetVaultCollateralAssetProposal) String() string { return proto.CompactTextString(m) }
+func (*SetVaultCollateralAssetProposal) ProtoMessage()    {}
+func (*SetVaultCollateralAssetProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5aab0360b917f, []int{12}
+}
+func (m *SetVaultCollateralAssetProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetVaultCollateralAssetProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetVaultCollateralAssetProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetVaultCollateralAssetProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetVaultCollateralAssetProposal.Merge(m, src)
+}
+func (m *SetVaultCollateralAssetProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetVaultCollateralAssetProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetVaultCollateralAssetProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetVaultCollateralAssetProposal proto.InternalMessageInfo
+
+type RemoveVaultCollateralAssetProposal struct {
+	Title               string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description         string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	ExtendedPairVaultId uint64 `protobuf:"varint,3,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	AssetId             uint64 `protobuf:"varint,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	// freeze_duration is how long deposits are frozen before the value of the
+	// asset in the ratio of vaults starts to ramp down over ramp_duration.
+	FreezeDuration time.Duration `protobuf:"bytes,5,opt,name=freeze_duration,json=freezeDuration,proto3,stdduration" json:"freeze_duration" yaml:"freeze_duration"`
+	RampDuration   time.Duration `protobuf:"bytes,6,opt,name=ramp_duration,json=rampDuration,proto3,stdduration" json:"ramp_duration" yaml:"ramp_duration"`
+}
+
+func (m *RemoveVaultCollateralAssetProposal) Reset()         { *m = RemoveVaultCollateralAssetProposal{} }
+func (m *RemoveVaultCollateralAssetProposal) String() string { return proto.CompactTextString(m) }
+func (*RemoveVaultCollateralAssetProposal) ProtoMessage()    {}
+func (*RemoveVaultCollateralAssetProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5aab0360b917f, []int{13}
+}
+func (m *RemoveVaultCollateralAssetProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveVaultCollateralAssetProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveVaultCollateralAssetProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveVaultCollateralAssetProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveVaultCollateralAssetProposal.Merge(m, src)
+}
+func (m *RemoveVaultCollateralAssetProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveVaultCollateralAssetProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveVaultCollateralAssetProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveVaultCollateralAssetProposal proto.InternalMessageInfo
+
+type DelistAssetProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	AssetId     uint64 `protobuf:"varint,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	// freeze_duration is how long new positions are frozen before the ltv
+	// starts to ramp down over ramp_duration.
+	FreezeDuration time.Duration `protobuf:"bytes,4,opt,name=freeze_duration,json=freezeDuration,proto3,stdduration" json:"freeze_duration" yaml:"freeze_duration"`
+	RampDuration   time.Duration `protobuf:"bytes,5,opt,name=ramp_duration,json=rampDuration,proto3,stdduration" json:"ramp_duration" yaml:"ramp_duration"`
+}
+
+func (m *DelistAssetProposal) Reset()         { *m = DelistAssetProposal{} }
+func (m *DelistAssetProposal) String() string { return proto.CompactTextString(m) }
+func (*DelistAssetProposal) ProtoMessage()    {}
+func (*DelistAssetProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5aab0360b917f, []int{14}
+}
+func (m *DelistAssetProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelistAssetProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelistAssetProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelistAssetProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelistAssetProposal.Merge(m, src)
+}
+func (m *DelistAssetProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelistAssetProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelistAssetProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelistAssetProposal proto.InternalMessageInfo
+
+type DelistPairProposal struct {
+	Title          string        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description    string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	PairId         uint64        `protobuf:"varint,3,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty" yaml:"pair_id"`
+	FreezeDuration time.Duration `protobuf:"bytes,4,opt,name=freeze_duration,json=freezeDuration,proto3,stdduration" json:"freeze_duration" yaml:"freeze_duration"`
+	RampDuration   time.Duration `protobuf:"bytes,5,opt,name=ramp_duration,json=rampDuration,proto3,stdduration" json:"ramp_duration" yaml:"ramp_duration"`
+}
+
+func (m *DelistPairProposal) Reset()         { *m = DelistPairProposal{} }
+func (m *DelistPairProposal) String() string { return proto.CompactTextString(m) }
+func (*DelistPairProposal) ProtoMessage()    {}
+func (*DelistPairProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5aab0360b917f, []int{15}
+}
+func (m *DelistPairProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelistPairProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelistPairProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelistPairProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelistPairProposal.Merge(m, src)
+}
+func (m *DelistPairProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelistPairProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelistPairProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelistPairProposal proto.InternalMessageInfo
+
+type CancelPendingChangeProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+}
+
+func (m *CancelPendingChangeProposal) Reset()         { *m = CancelPendingChangeProposal{} }
+func (m *CancelPendingChangeProposal) String() string { return proto.CompactTextString(m) }
+func (*CancelPendingChangeProposal) ProtoMessage()    {}
+func (*CancelPendingChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5aab0360b917f, []int{16}
+}
+func (m *CancelPendingChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelPendingChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelPendingChangeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelPendingChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelPendingChangeProposal.Merge(m, src)
+}
+func (m *CancelPendingChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelPendingChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelPendingChangeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelPendingChangeProposal proto.InternalMessageInfo
+
+type SetTimelockDelayProposal struct {
+	Title       string        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Delay       TimelockDelay `protobuf:"bytes,3,opt,name=delay,proto3" json:"delay" yaml:"delay"`
+}
+
+func (m *SetTimelockDelayProposal) Reset()         { *m = SetTimelockDelayProposal{} }
+func (m *SetTimelockDelayProposal) String() string { return proto.CompactTextString(m) }
+func (*SetTimelockDelayProposal) ProtoMessage()    {}
+func (*SetTimelockDelayProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5aab0360b917f, []int{17}
+}
+func (m *SetTimelockDelayProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetTimelockDelayProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetTimelockDelayProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetTimelockDelayProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTimelockDelayProposal.Merge(m, src)
+}
+func (m *SetTimelockDelayProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetTimelockDelayProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTimelockDelayProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTimelockDelayProposal proto.InternalMessageInfo
+
+type SetAppRolesProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	AppId       uint64   `protobuf:"varint,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	Roles       AppRoles `protobuf:"bytes,4,opt,name=roles,proto3" json:"roles" yaml:"roles"`
+}
+
+func (m *SetAppRolesProposal) Reset()         { *m = SetAppRolesProposal{} }
+func (m *SetAppRolesProposal) String() string { return proto.CompactTextString(m) }
+func (*SetAppRolesProposal) ProtoMessage()    {}
+func (*SetAppRolesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5aab0360b917f, []int{18}
+}
+func (m *SetAppRolesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAppRolesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAppRolesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAppRolesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAppRolesProposal.Merge(m, src)
+}
+func (m *SetAppRolesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAppRolesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAppRolesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAppRolesProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AddAssetsProposal)(nil), "comdex.asset.v1beta1.AddAssetsProposal")
 	proto.RegisterType((*AddMultipleAssetsProposal)(nil), "comdex.asset.v1beta1.AddMultipleAssetsProposal")
@@ -424,44 +791,83 @@ func init() {
 	proto.RegisterType((*UpdateGovTimeInAppProposal)(nil), "comdex.asset.v1beta1.UpdateGovTimeInAppProposal")
 	proto.RegisterType((*AddAssetInAppProposal)(nil), "comdex.asset.v1beta1.AddAssetInAppProposal")
 	proto.RegisterType((*AddMultipleAssetsPairsProposal)(nil), "comdex.asset.v1beta1.AddMultipleAssetsPairsProposal")
+	proto.RegisterType((*SetStabilityFeeControllerProposal)(nil), "comdex.asset.v1beta1.SetStabilityFeeControllerProposal")
+	proto.RegisterType((*RemoveStabilityFeeControllerProposal)(nil), "comdex.asset.v1beta1.RemoveStabilityFeeControllerProposal")
+	proto.RegisterType((*SetVaultCollateralAssetProposal)(nil), "comdex.asset.v1beta1.SetVaultCollateralAssetProposal")
+	proto.RegisterType((*RemoveVaultCollateralAssetProposal)(nil), "comdex.asset.v1beta1.RemoveVaultCollateralAssetProposal")
+	proto.RegisterType((*DelistAssetProposal)(nil), "comdex.asset.v1beta1.DelistAssetProposal")
+	proto.RegisterType((*DelistPairProposal)(nil), "comdex.asset.v1beta1.DelistPairProposal")
+	proto.RegisterType((*CancelPendingChangeProposal)(nil), "comdex.asset.v1beta1.CancelPendingChangeProposal")
+	proto.RegisterType((*SetTimelockDelayProposal)(nil), "comdex.asset.v1beta1.SetTimelockDelayProposal")
+	proto.RegisterType((*SetAppRolesProposal)(nil), "comdex.asset.v1beta1.SetAppRolesProposal")
 }
 
 func init() { proto.RegisterFile("comdex/asset/v1beta1/gov.proto", fileDescriptor_31c5aab0360b917f) }
 
 var fileDescriptor_31c5aab0360b917f = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x33, 0xd6, 0xae, 0xf8, 0xae, 0xc8, 0x1a, 0x57, 0xa9, 0x15, 0x27, 0x25, 0x82, 0xec,
-	0xc5, 0x84, 0x55, 0xfc, 0x7b, 0x4b, 0x55, 0xc4, 0x83, 0xb0, 0x16, 0xbd, 0x78, 0x9b, 0x76, 0x66,
-	0xe3, 0x40, 0xda, 0x19, 0x92, 0xd9, 0xe2, 0x7e, 0x0b, 0x3f, 0x86, 0x82, 0x88, 0x07, 0x0f, 0xde,
-	0xbd, 0xd4, 0xdb, 0x1e, 0x3d, 0x15, 0x6d, 0xbf, 0xc1, 0x7e, 0x02, 0x99, 0x3f, 0x91, 0x80, 0x59,
-	0x71, 0x3d, 0xa4, 0x78, 0x6b, 0xf3, 0x3c, 0xef, 0xcc, 0x6f, 0x1e, 0xde, 0x79, 0x07, 0xf0, 0x48,
-	0x8c, 0x29, 0x7b, 0x1d, 0x93, 0xa2, 0x60, 0x2a, 0x9e, 0x6e, 0x0f, 0x99, 0x22, 0xdb, 0x71, 0x2a,
-	0xa6, 0x91, 0xcc, 0x85, 0x12, 0xfe, 0xa6, 0xd5, 0x23, 0xa3, 0x47, 0x4e, 0xef, 0x6e, 0xa6, 0x22,
-	0x15, 0xc6, 0x10, 0xeb, 0x5f, 0xd6, 0xdb, 0xed, 0xd5, 0xae, 0x65, 0x2b, 0xad, 0x23, 0xa8, 0x75,
-	0x48, 0xc2, 0x73, 0x67, 0xa8, 0xc7, 0x21, 0x52, 0x5a, 0x3d, 0xfc, 0x88, 0xe0, 0x5c, 0x42, 0x69,
-	0xa2, 0xe5, 0x62, 0x27, 0x17, 0x52, 0x14, 0x24, 0xf3, 0xaf, 0x41, 0x5b, 0x71, 0x95, 0xb1, 0x0e,
-	0xea, 0xa1, 0xad, 0xd3, 0xfd, 0x8d, 0xc3, 0x79, 0x70, 0x66, 0x9f, 0x8c, 0xb3, 0xfb, 0xa1, 0xf9,
-	0x1c, 0x0e, 0xac, 0xec, 0xdf, 0x85, 0x75, 0xca, 0x8a, 0x51, 0xce, 0xa5, 0xe2, 0x62, 0xd2, 0x39,
-	0x61, 0xdc, 0x17, 0x0f, 0xe7, 0x81, 0x6f, 0xdd, 0x15, 0x31, 0x1c, 0x54, 0xad, 0xfe, 0x3d, 0x58,
-	0x33, 0x48, 0x45, 0xa7, 0xd5, 0x43, 0x5b, 0xeb, 0x37, 0x2e, 0x47, 0x75, 0xb9, 0x44, 0x86, 0xab,
-	0x7f, 0x72, 0x36, 0x0f, 0xbc, 0x81, 0x2b, 0x08, 0x3f, 0x23, 0xb8, 0x94, 0x50, 0xfa, 0x74, 0x2f,
-	0x53, 0x5c, 0x66, 0x6c, 0xa5, 0xe8, 0xad, 0xe3, 0xa1, 0x7f, 0x42, 0xd0, 0xa9, 0xa0, 0xef, 0x10,
-	0x9e, 0x37, 0x49, 0x7e, 0x1b, 0xda, 0xba, 0x35, 0x4a, 0xf0, 0x6e, 0x3d, 0xb8, 0xa6, 0x72, 0xdc,
-	0xd6, 0xae, 0x9b, 0xe4, 0xfc, 0x0b, 0x49, 0x89, 0xb2, 0x61, 0x37, 0x48, 0x7c, 0x07, 0xda, 0x06,
-	0xee, 0xef, 0xbb, 0xc4, 0xfa, 0xc3, 0xf7, 0x08, 0x36, 0x12, 0x4a, 0x57, 0x98, 0x30, 0x3a, 0x4e,
-	0xc2, 0x1f, 0x10, 0xf8, 0x36, 0x61, 0xad, 0xfd, 0x07, 0xc0, 0xef, 0x10, 0x9c, 0xd5, 0x73, 0x43,
-	0xca, 0x06, 0x61, 0x6f, 0x41, 0x8b, 0x48, 0xe9, 0x50, 0xaf, 0x1c, 0xd1, 0x0b, 0x52, 0x3e, 0x24,
-	0x8a, 0x38, 0x5a, 0xed, 0x0f, 0xbf, 0x20, 0xe8, 0xda, 0x70, 0x1f, 0x8b, 0xe9, 0x73, 0x3e, 0x66,
-	0x4f, 0x26, 0xcd, 0x72, 0x3f, 0x80, 0x53, 0xa9, 0xdd, 0xd9, 0xb1, 0x5f, 0x3d, 0x92, 0x3d, 0x99,
-	0x50, 0x07, 0xe9, 0x4e, 0x50, 0x56, 0xea, 0x4b, 0x78, 0xa1, 0x9c, 0xd4, 0x4d, 0x1f, 0xe0, 0x1f,
-	0x83, 0xff, 0x8a, 0x00, 0xff, 0x3e, 0xa9, 0x1b, 0xbe, 0x92, 0x8f, 0x00, 0xc8, 0xaf, 0x8d, 0xdd,
-	0xe4, 0x0b, 0xfe, 0x30, 0x47, 0x2a, 0xbd, 0x5e, 0x29, 0xec, 0x3f, 0x9b, 0xfd, 0xc0, 0xde, 0xdb,
-	0x05, 0xf6, 0x66, 0x0b, 0x8c, 0x0e, 0x16, 0x18, 0x7d, 0x5f, 0x60, 0xf4, 0x66, 0x89, 0xbd, 0x83,
-	0x25, 0xf6, 0xbe, 0x2d, 0xb1, 0xf7, 0x32, 0x4e, 0xb9, 0x7a, 0xb5, 0x37, 0xd4, 0x4b, 0xc7, 0x76,
-	0xf9, 0xeb, 0x62, 0x77, 0x97, 0x8f, 0x38, 0xc9, 0xdc, 0xff, 0xb8, 0x7c, 0x87, 0xd5, 0xbe, 0x64,
-	0xc5, 0x70, 0xcd, 0x3c, 0xc1, 0x37, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x44, 0x27, 0xbf, 0x14,
-	0x33, 0x08, 0x00, 0x00,
+	// 979 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xd8, 0xb1, 0x4b, 0x5f, 0x93, 0xb4, 0x5d, 0x87, 0xc8, 0x4d, 0x95, 0xdd, 0x74, 0x8a,
+	0x50, 0x04, 0xc5, 0x56, 0x41, 0xfc, 0xbc, 0xd9, 0x0e, 0x20, 0x23, 0x21, 0xc2, 0xba, 0xf4, 0xc0,
+	0x25, 0x8c, 0x3d, 0x63, 0x77, 0xc5, 0x78, 0x67, 0xb4, 0x1e, 0x5b, 0x35, 0x7f, 0x05, 0x47, 0x4e,
+	0x9c, 0x41, 0x42, 0x88, 0x03, 0x07, 0xee, 0x5c, 0xc2, 0x01, 0xa9, 0x17, 0xa4, 0x72, 0x59, 0x4a,
+	0x72, 0xee, 0x01, 0xff, 0x05, 0x68, 0x76, 0x66, 0x13, 0xb7, 0xdd, 0x14, 0x8a, 0x60, 0xa3, 0x72,
+	0xdb, 0x9d, 0xf7, 0xbd, 0xf7, 0xbe, 0xf7, 0xbd, 0xb7, 0x93, 0x17, 0x83, 0xdb, 0x17, 0x23, 0xca,
+	0x6e, 0x37, 0xc8, 0x78, 0xcc, 0x54, 0x63, 0x7a, 0xbd, 0xc7, 0x14, 0xb9, 0xde, 0x18, 0x8a, 0x69,
+	0x5d, 0x46, 0x42, 0x09, 0x67, 0xcd, 0xd8, 0xeb, 0x89, 0xbd, 0x6e, 0xed, 0x1b, 0x6b, 0x43, 0x31,
+	0x14, 0x09, 0xa0, 0xa1, 0x9f, 0x0c, 0x76, 0xc3, 0x1d, 0x0a, 0x31, 0xe4, 0xac, 0x91, 0xbc, 0xf5,
+	0x26, 0x83, 0x06, 0x9d, 0x44, 0x44, 0x05, 0x22, 0xb4, 0xf6, 0xad, 0xcc, 0x5c, 0x26, 0xb2, 0x41,
+	0x78, 0x99, 0x08, 0x49, 0x82, 0x28, 0x4d, 0x91, 0x1d, 0x42, 0x4a, 0x6b, 0xbf, 0x96, 0x69, 0x67,
+	0xb7, 0x15, 0x0b, 0x29, 0xa3, 0xbb, 0x24, 0x88, 0x6e, 0x92, 0x09, 0x4f, 0xd3, 0x5d, 0xcd, 0x44,
+	0xab, 0x60, 0xc4, 0xb8, 0xe8, 0x7f, 0x6a, 0x40, 0xf8, 0x3b, 0x04, 0x17, 0x9b, 0x94, 0x36, 0x35,
+	0x66, 0xbc, 0x1b, 0x09, 0x29, 0xc6, 0x84, 0x3b, 0xcf, 0x43, 0x59, 0x05, 0x8a, 0xb3, 0x1a, 0xda,
+	0x42, 0xdb, 0x67, 0x5b, 0x17, 0xe6, 0xb1, 0xb7, 0x3c, 0x23, 0x23, 0xfe, 0x16, 0x4e, 0x8e, 0xb1,
+	0x6f, 0xcc, 0xce, 0x1b, 0x70, 0x8e, 0xb2, 0x71, 0x3f, 0x0a, 0xa4, 0x16, 0xa2, 0x56, 0x4c, 0xd0,
+	0xeb, 0xf3, 0xd8, 0x73, 0x0c, 0x7a, 0xc1, 0x88, 0xfd, 0x45, 0xa8, 0xf3, 0x26, 0x54, 0x12, 0x5e,
+	0xe3, 0x5a, 0x69, 0x0b, 0x6d, 0x9f, 0x7b, 0xf9, 0x72, 0x3d, 0xab, 0x15, 0xf5, 0x84, 0x57, 0x6b,
+	0x69, 0x3f, 0xf6, 0x0a, 0xbe, 0x75, 0xc0, 0x3f, 0x20, 0xb8, 0xd4, 0xa4, 0xf4, 0xfd, 0x09, 0x57,
+	0x81, 0xe4, 0xec, 0x54, 0xa9, 0x97, 0x9e, 0x8c, 0xfa, 0xf7, 0x08, 0x6a, 0x0b, 0xd4, 0x75, 0xc7,
+	0xf2, 0x64, 0xfe, 0x1a, 0x94, 0xf5, 0xb4, 0xa5, 0xc4, 0x37, 0xb2, 0x89, 0x6b, 0x56, 0x96, 0xb7,
+	0x81, 0xeb, 0x21, 0xa9, 0x7e, 0x24, 0x29, 0x51, 0x46, 0xec, 0x1c, 0x19, 0xbf, 0x0e, 0xe5, 0x84,
+	0xdc, 0xdf, 0x9f, 0x12, 0x83, 0xc7, 0xdf, 0x20, 0xb8, 0xd0, 0xa4, 0xf4, 0x14, 0x15, 0x46, 0x4f,
+	0xa2, 0xf0, 0xb7, 0x08, 0x1c, 0xa3, 0xb0, 0xb6, 0x3d, 0x05, 0x84, 0xbf, 0x46, 0xb0, 0xaa, 0xef,
+	0x0d, 0x29, 0x73, 0x24, 0xfb, 0x2a, 0x94, 0x88, 0x94, 0x96, 0xea, 0xe6, 0x09, 0xb3, 0x20, 0xe5,
+	0x0e, 0x51, 0xc4, 0xb2, 0xd5, 0x78, 0xfc, 0x23, 0x82, 0x0d, 0x23, 0xee, 0xbb, 0x62, 0x7a, 0x23,
+	0x18, 0xb1, 0x4e, 0x98, 0x2f, 0xef, 0x36, 0x9c, 0x19, 0x9a, 0xcc, 0x96, 0xfb, 0xd5, 0x13, 0xb9,
+	0x37, 0x43, 0x6a, 0x49, 0xda, 0x0a, 0x52, 0x4f, 0xfd, 0x11, 0x3e, 0x9b, 0xde, 0xd4, 0x9d, 0xf0,
+	0xa9, 0x10, 0xfe, 0x27, 0x04, 0xee, 0xa3, 0x37, 0x75, 0xce, 0x9f, 0xe4, 0xdb, 0x00, 0xe4, 0x28,
+	0xb1, 0xbd, 0xf9, 0xbc, 0xc7, 0xdc, 0x23, 0x0b, 0xb3, 0xbe, 0xe0, 0x88, 0x7f, 0x45, 0x70, 0xa5,
+	0xcb, 0x54, 0x57, 0x91, 0x5e, 0xc0, 0x03, 0x35, 0x7b, 0x87, 0xb1, 0xb6, 0x08, 0x55, 0x24, 0x38,
+	0x67, 0x79, 0x7e, 0xb0, 0x3e, 0x40, 0xff, 0x28, 0xaf, 0xed, 0xc8, 0xb5, 0xec, 0x72, 0xb2, 0xb9,
+	0xa6, 0xb5, 0x1d, 0x47, 0xc1, 0xf7, 0x10, 0x3c, 0xe7, 0xb3, 0x91, 0x98, 0xb2, 0x53, 0x2f, 0xef,
+	0x26, 0xac, 0xa7, 0xfb, 0xcc, 0x9e, 0xbe, 0x69, 0xf6, 0xa6, 0x7a, 0xa3, 0xd9, 0x0b, 0x68, 0x52,
+	0xea, 0x52, 0xeb, 0xca, 0x3c, 0xf6, 0x36, 0x4d, 0x90, 0x6c, 0x1c, 0xf6, 0xab, 0x8f, 0x2c, 0x44,
+	0x1d, 0x8a, 0x7f, 0x41, 0xe0, 0x75, 0x99, 0x4a, 0x5e, 0xdb, 0x82, 0x73, 0xa2, 0x58, 0x44, 0x78,
+	0xde, 0x7f, 0xce, 0x76, 0x75, 0xf3, 0xd2, 0xe4, 0xb6, 0x79, 0x2f, 0x64, 0x37, 0x2f, 0x8b, 0xe9,
+	0x71, 0xeb, 0xd2, 0x63, 0x7c, 0xb7, 0x04, 0xd8, 0xb4, 0xee, 0x94, 0x4b, 0xfb, 0x8f, 0x1a, 0xe7,
+	0xd4, 0xe1, 0x99, 0x44, 0x18, 0x1d, 0x69, 0x29, 0x89, 0x54, 0x9d, 0xc7, 0xde, 0x79, 0x13, 0x29,
+	0xb5, 0x60, 0xff, 0x4c, 0xf2, 0xd8, 0xa1, 0xce, 0x00, 0xce, 0x0f, 0x22, 0xc6, 0x3e, 0x63, 0x7b,
+	0xe9, 0x7e, 0x5e, 0x2b, 0x27, 0x3a, 0x5f, 0xaa, 0x9b, 0x05, 0xbe, 0x9e, 0x2e, 0xf0, 0xf5, 0x1d,
+	0x0b, 0x68, 0x61, 0x2d, 0xeb, 0x3c, 0xf6, 0xd6, 0x4d, 0xd4, 0x87, 0xfc, 0xf1, 0x17, 0xbf, 0x79,
+	0xc8, 0x5f, 0x35, 0xa7, 0xa9, 0x8f, 0xf3, 0x09, 0xac, 0x44, 0x64, 0x24, 0x8f, 0xb3, 0x54, 0xfe,
+	0x2a, 0xcb, 0x96, 0xcd, 0xb2, 0x66, 0xb2, 0x3c, 0xe0, 0x6d, 0x72, 0x2c, 0xeb, 0xb3, 0x14, 0x8f,
+	0xff, 0x28, 0x42, 0x75, 0x87, 0xf1, 0x60, 0xac, 0xf2, 0xee, 0xe5, 0xa2, 0xe6, 0xa5, 0x7f, 0xa6,
+	0xf9, 0x52, 0x2e, 0x9a, 0x97, 0xff, 0x6d, 0xcd, 0xef, 0x17, 0xc1, 0x31, 0x9a, 0xe7, 0xbc, 0x87,
+	0xbd, 0x08, 0x67, 0x92, 0xaf, 0xe1, 0x48, 0x71, 0x67, 0x1e, 0x7b, 0xab, 0xc6, 0xcb, 0x1a, 0xb0,
+	0x5f, 0xd1, 0x4f, 0xff, 0x2b, 0xbd, 0xbf, 0x44, 0x70, 0xb9, 0x4d, 0xc2, 0x3e, 0xe3, 0xbb, 0x2c,
+	0xa4, 0x41, 0x38, 0x6c, 0xdf, 0x22, 0xe1, 0x90, 0xe5, 0x28, 0xfc, 0x26, 0x14, 0x8f, 0x34, 0x5f,
+	0x99, 0xc7, 0xde, 0x59, 0xe3, 0xa0, 0xe5, 0x2e, 0x06, 0x14, 0xff, 0x8c, 0xa0, 0xd6, 0x65, 0xea,
+	0x86, 0xfd, 0xaf, 0x79, 0x87, 0x71, 0x32, 0xcb, 0x91, 0xdd, 0x07, 0x50, 0xa6, 0x3a, 0xe5, 0xe3,
+	0xf7, 0xc6, 0x07, 0xd8, 0xb5, 0xd6, 0x6c, 0x0f, 0x96, 0xd3, 0xe0, 0x9c, 0xcc, 0xb0, 0x6f, 0xe2,
+	0xe0, 0xfb, 0x08, 0xaa, 0x5d, 0xa6, 0x9a, 0x52, 0xfa, 0x82, 0xb3, 0x3c, 0xf7, 0xb0, 0x6d, 0xa8,
+	0x10, 0x29, 0x8f, 0x07, 0xfc, 0xe2, 0x3c, 0xf6, 0x56, 0x8c, 0x93, 0x39, 0xc7, 0x7e, 0x99, 0x48,
+	0xd9, 0xa1, 0xce, 0x7b, 0x50, 0x8e, 0x34, 0x39, 0x3b, 0xd4, 0xee, 0x89, 0xfb, 0x66, 0x52, 0xc2,
+	0xc3, 0xf5, 0x26, 0xae, 0xd8, 0x37, 0x21, 0x5a, 0x1f, 0xee, 0xff, 0xee, 0x16, 0xbe, 0x3a, 0x70,
+	0x0b, 0xfb, 0x07, 0x2e, 0xba, 0x73, 0xe0, 0xa2, 0x7b, 0x07, 0x2e, 0xfa, 0xfc, 0xd0, 0x2d, 0xdc,
+	0x39, 0x74, 0x0b, 0x77, 0x0f, 0xdd, 0xc2, 0xc7, 0x8d, 0x61, 0xa0, 0x6e, 0x4d, 0x7a, 0x3a, 0x49,
+	0xc3, 0x24, 0x7a, 0x49, 0x0c, 0x06, 0x41, 0x3f, 0x20, 0xdc, 0xbe, 0x37, 0xd2, 0xdf, 0x50, 0xd4,
+	0x4c, 0xb2, 0x71, 0xaf, 0x92, 0x8c, 0xfd, 0x2b, 0x7f, 0x0e, 0x00, 0xa6, 0x9f, 0x95, 0x01, 0x5d,
+	0x12, 0x00, 0x00,
 }
 
 func (m *AddAssetsProposal) Marshal() (dAtA []byte, err error) {
@@ -946,197 +1352,474 @@ func (m *AddMultipleAssetsPairsProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SetStabilityFeeControllerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AddAssetsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = m.Assets.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
+
+func (m *SetStabilityFeeControllerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddMultipleAssetsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetStabilityFeeControllerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	{
+		size, err := m.Controller.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *AddMultiplePairsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateAssetProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveStabilityFeeControllerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveStabilityFeeControllerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveStabilityFeeControllerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Asset.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AddPairsProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetVaultCollateralAssetProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetVaultCollateralAssetProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetVaultCollateralAssetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Pairs.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdatePairProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveVaultCollateralAssetProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveVaultCollateralAssetProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveVaultCollateralAssetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RampDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RampDuration):])
+	if err10 != nil {
+		return 0, err10
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	i -= n10
+	i = encodeVarintGov(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x32
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.FreezeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.FreezeDuration):])
+	if err11 != nil {
+		return 0, err11
 	}
-	l = m.Pairs.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
-}
-
-func (m *AddAppProposal) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n11
+	i = encodeVarintGov(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x2a
+	if m.AssetId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.App.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateGovTimeInAppProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelistAssetProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelistAssetProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelistAssetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RampDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RampDuration):])
+	if err12 != nil {
+		return 0, err12
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	i -= n12
+	i = encodeVarintGov(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x2a
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.FreezeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.FreezeDuration):])
+	if err13 != nil {
+		return 0, err13
 	}
-	l = m.GovTime.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
+	i -= n13
+	i = encodeVarintGov(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	if m.AssetId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AddAssetInAppProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelistPairProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelistPairProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelistPairProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RampDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RampDuration):])
+	if err14 != nil {
+		return 0, err14
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	i -= n14
+	i = encodeVarintGov(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.FreezeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.FreezeDuration):])
+	if err15 != nil {
+		return 0, err15
 	}
-	l = m.App.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
-}
+	i -= n15
+	i = encodeVarintGov(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if m.PairId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *AddMultipleAssetsPairsProposal) Size() (n int) {
+func (m *CancelPendingChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelPendingChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelPendingChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetTimelockDelayProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetTimelockDelayProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetTimelockDelayProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Delay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetAppRolesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAppRolesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAppRolesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Roles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AppId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddAssetsProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1150,8 +1833,27 @@ func (m *AddMultipleAssetsPairsProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
-	if len(m.AssetsPair) > 0 {
-		for _, e := range m.AssetsPair {
+	l = m.Assets.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *AddMultipleAssetsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
 			l = e.Size()
 			n += 1 + l + sovGov(uint64(l))
 		}
@@ -1159,13 +1861,1692 @@ func (m *AddMultipleAssetsPairsProposal) Size() (n int) {
 	return n
 }
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *AddMultiplePairsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
 }
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *UpdateAssetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
 }
-func (m *AddAssetsProposal) Unmarshal(dAtA []byte) error {
+
+func (m *AddPairsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Pairs.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *UpdatePairProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Pairs.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *AddAppProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.App.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *UpdateGovTimeInAppProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.GovTime.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *AddAssetInAppProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.App.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *AddMultipleAssetsPairsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.AssetsPair) > 0 {
+		for _, e := range m.AssetsPair {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetStabilityFeeControllerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Controller.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *RemoveStabilityFeeControllerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovGov(uint64(m.ExtendedPairVaultId))
+	}
+	return n
+}
+
+func (m *SetVaultCollateralAssetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *RemoveVaultCollateralAssetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovGov(uint64(m.ExtendedPairVaultId))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovGov(uint64(m.AssetId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.FreezeDuration)
+	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RampDuration)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *DelistAssetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovGov(uint64(m.AssetId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.FreezeDuration)
+	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RampDuration)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *DelistPairProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovGov(uint64(m.PairId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.FreezeDuration)
+	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RampDuration)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *CancelPendingChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *SetTimelockDelayProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Delay.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *SetAppRolesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovGov(uint64(m.AppId))
+	}
+	l = m.Roles.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddAssetsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddAssetsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddAssetsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddMultipleAssetsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddMultipleAssetsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddMultipleAssetsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, Asset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddMultiplePairsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddMultiplePairsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddMultiplePairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, Pair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateAssetProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateAssetProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateAssetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddPairsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddPairsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pairs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatePairProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePairProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pairs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddAppProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddAppProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddAppProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateGovTimeInAppProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateGovTimeInAppProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateGovTimeInAppProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GovTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddAssetInAppProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddAssetInAppProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddAssetInAppProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddMultipleAssetsPairsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1188,10 +3569,10 @@ func (m *AddAssetsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddAssetsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddMultipleAssetsPairsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddAssetsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddMultipleAssetsPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1260,7 +3641,7 @@ func (m *AddAssetsProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetsPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1287,7 +3668,8 @@ func (m *AddAssetsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AssetsPair = append(m.AssetsPair, AssetPair{})
+			if err := m.AssetsPair[len(m.AssetsPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1312,7 +3694,7 @@ func (m *AddAssetsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddMultipleAssetsProposal) Unmarshal(dAtA []byte) error {
+func (m *SetStabilityFeeControllerProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1335,10 +3717,10 @@ func (m *AddMultipleAssetsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddMultipleAssetsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetStabilityFeeControllerProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMultipleAssetsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetStabilityFeeControllerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1407,7 +3789,7 @@ func (m *AddMultipleAssetsProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1434,8 +3816,7 @@ func (m *AddMultipleAssetsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, Asset{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Controller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1460,7 +3841,7 @@ func (m *AddMultipleAssetsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddMultiplePairsProposal) Unmarshal(dAtA []byte) error {
+func (m *RemoveStabilityFeeControllerProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1483,10 +3864,10 @@ func (m *AddMultiplePairsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddMultiplePairsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveStabilityFeeControllerProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMultiplePairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveStabilityFeeControllerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1554,10 +3935,10 @@ func (m *AddMultiplePairsProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
 			}
-			var msglen int
+			m.ExtendedPairVaultId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1567,26 +3948,11 @@ func (m *AddMultiplePairsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pairs = append(m.Pairs, Pair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -1608,7 +3974,7 @@ func (m *AddMultiplePairsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateAssetProposal) Unmarshal(dAtA []byte) error {
+func (m *SetVaultCollateralAssetProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1631,10 +3997,10 @@ func (m *UpdateAssetProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateAssetProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetVaultCollateralAssetProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateAssetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetVaultCollateralAssetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1703,7 +4069,7 @@ func (m *UpdateAssetProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1730,7 +4096,7 @@ func (m *UpdateAssetProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1755,7 +4121,7 @@ func (m *UpdateAssetProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddPairsProposal) Unmarshal(dAtA []byte) error {
+func (m *RemoveVaultCollateralAssetProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1778,10 +4144,10 @@ func (m *AddPairsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddPairsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveVaultCollateralAssetProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveVaultCollateralAssetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1849,8 +4215,46 @@ func (m *AddPairsProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1877,7 +4281,40 @@ func (m *AddPairsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pairs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.FreezeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RampDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1902,7 +4339,7 @@ func (m *AddPairsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdatePairProposal) Unmarshal(dAtA []byte) error {
+func (m *DelistAssetProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1925,10 +4362,10 @@ func (m *UpdatePairProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatePairProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelistAssetProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatePairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelistAssetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1996,8 +4433,27 @@ func (m *UpdatePairProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2024,7 +4480,40 @@ func (m *UpdatePairProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pairs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.FreezeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RampDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2049,7 +4538,7 @@ func (m *UpdatePairProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddAppProposal) Unmarshal(dAtA []byte) error {
+func (m *DelistPairProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2072,10 +4561,10 @@ func (m *AddAppProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddAppProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelistPairProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddAppProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelistPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2143,8 +4632,27 @@ func (m *AddAppProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2171,7 +4679,40 @@ func (m *AddAppProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.FreezeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RampDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2196,7 +4737,7 @@ func (m *AddAppProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateGovTimeInAppProposal) Unmarshal(dAtA []byte) error {
+func (m *CancelPendingChangeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2219,10 +4760,10 @@ func (m *UpdateGovTimeInAppProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateGovTimeInAppProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelPendingChangeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateGovTimeInAppProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelPendingChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2290,10 +4831,10 @@ func (m *UpdateGovTimeInAppProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2303,25 +4844,11 @@ func (m *UpdateGovTimeInAppProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GovTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2343,7 +4870,7 @@ func (m *UpdateGovTimeInAppProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddAssetInAppProposal) Unmarshal(dAtA []byte) error {
+func (m *SetTimelockDelayProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2366,10 +4893,10 @@ func (m *AddAssetInAppProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddAssetInAppProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetTimelockDelayProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddAssetInAppProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetTimelockDelayProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2438,7 +4965,7 @@ func (m *AddAssetInAppProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2465,7 +4992,7 @@ func (m *AddAssetInAppProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2490,7 +5017,7 @@ func (m *AddAssetInAppProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddMultipleAssetsPairsProposal) Unmarshal(dAtA []byte) error {
+func (m *SetAppRolesProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2513,10 +5040,10 @@ func (m *AddMultipleAssetsPairsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddMultipleAssetsPairsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAppRolesProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMultipleAssetsPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAppRolesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2584,8 +5111,27 @@ func (m *AddMultipleAssetsPairsProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetsPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2612,8 +5158,7 @@ func (m *AddMultipleAssetsPairsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetsPair = append(m.AssetsPair, AssetPair{})
-			if err := m.AssetsPair[len(m.AssetsPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Roles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
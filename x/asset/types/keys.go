@@ -18,6 +18,9 @@ var (
 	AppIDKey        = []byte{0x04}
 	PairsVaultIDKey = []byte{0x05}
 
+	StabilityFeeAdjustmentIDKey = []byte{0x06}
+	PendingChangeIDKey          = []byte{0x07}
+
 	AssetKeyPrefix      = []byte{0x11}
 	PairKeyPrefix       = []byte{0x14}
 	AppKeyPrefix        = []byte{0x15}
@@ -28,6 +31,16 @@ var (
 	AppForNamePrefix       = []byte{0x23}
 	GenesisForAppPrefix    = []byte{0x24}
 	AssetForNameKeyPrefix  = []byte{0x25}
+
+	StabilityFeeControllerKeyPrefix      = []byte{0x31}
+	StabilityFeeControllerStateKeyPrefix = []byte{0x32}
+	StabilityFeeAdjustmentKeyPrefix      = []byte{0x33}
+	VaultCollateralAssetKeyPrefix        = []byte{0x34}
+	AssetDelistingKeyPrefix              = []byte{0x35}
+	PairDelistingKeyPrefix               = []byte{0x36}
+	PendingChangeKeyPrefix               = []byte{0x37}
+	TimelockDelayKeyPrefix               = []byte{0x38}
+	AssetDenomTraceKeyPrefix             = []byte{0x39}
 )
 
 func AppKey(id uint64) []byte {
@@ -65,3 +78,47 @@ func GenesisForApp(appID uint64) []byte {
 func PairKey(id uint64) []byte {
 	return append(PairKeyPrefix, sdk.Uint64ToBigEndian(id)...)
 }
+
+func StabilityFeeControllerKey(extendedPairVaultID uint64) []byte {
+	return append(StabilityFeeControllerKeyPrefix, sdk.Uint64ToBigEndian(extendedPairVaultID)...)
+}
+
+func StabilityFeeControllerStateKey(extendedPairVaultID uint64) []byte {
+	return append(StabilityFeeControllerStateKeyPrefix, sdk.Uint64ToBigEndian(extendedPairVaultID)...)
+}
+
+func StabilityFeeAdjustmentKey(extendedPairVaultID, id uint64) []byte {
+	return append(StabilityFeeAdjustmentsKey(extendedPairVaultID), sdk.Uint64ToBigEndian(id)...)
+}
+
+func StabilityFeeAdjustmentsKey(extendedPairVaultID uint64) []byte {
+	return append(StabilityFeeAdjustmentKeyPrefix, sdk.Uint64ToBigEndian(extendedPairVaultID)...)
+}
+
+func VaultCollateralAssetKey(extendedPairVaultID, assetID uint64) []byte {
+	return append(VaultCollateralAssetsKey(extendedPairVaultID), sdk.Uint64ToBigEndian(assetID)...)
+}
+
+func VaultCollateralAssetsKey(extendedPairVaultID uint64) []byte {
+	return append(VaultCollateralAssetKeyPrefix, sdk.Uint64ToBigEndian(extendedPairVaultID)...)
+}
+
+func AssetDelistingKey(assetID uint64) []byte {
+	return append(AssetDelistingKeyPrefix, sdk.Uint64ToBigEndian(assetID)...)
+}
+
+func PairDelistingKey(pairID uint64) []byte {
+	return append(PairDelistingKeyPrefix, sdk.Uint64ToBigEndian(pairID)...)
+}
+
+func PendingChangeKey(id uint64) []byte {
+	return append(PendingChangeKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func TimelockDelayKey(paramClass string) []byte {
+	return append(TimelockDelayKeyPrefix, []byte(paramClass)...)
+}
+
+func AssetDenomTraceKey(assetID uint64) []byte {
+	return append(AssetDenomTraceKeyPrefix, sdk.Uint64ToBigEndian(assetID)...)
+}
@@ -143,7 +143,8 @@ func (m *QueryAssetRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryAssetRequest proto.InternalMessageInfo
 
 type QueryAssetResponse struct {
-	Asset Asset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset" yaml:"asset"`
+	Asset      Asset            `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset" yaml:"asset"`
+	DenomTrace *AssetDenomTrace `protobuf:"bytes,2,opt,name=denom_trace,json=denomTrace,proto3" json:"denom_trace,omitempty" yaml:"denom_trace"`
 }
 
 func (m *QueryAssetResponse) Reset()         { *m = QueryAssetResponse{} }
@@ -1032,6 +1033,452 @@ func (m *QueryExtendedPairVaultsByAppWithoutStableResponse) XXX_DiscardUnknown()
 
 var xxx_messageInfo_QueryExtendedPairVaultsByAppWithoutStableResponse proto.InternalMessageInfo
 
+type QueryStabilityFeeControllerRequest struct {
+	ExtendedPairVaultId uint64 `protobuf:"varint,1,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+}
+
+func (m *QueryStabilityFeeControllerRequest) Reset()         { *m = QueryStabilityFeeControllerRequest{} }
+func (m *QueryStabilityFeeControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStabilityFeeControllerRequest) ProtoMessage()    {}
+func (*QueryStabilityFeeControllerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e7e9ce3abb4febf, []int{26}
+}
+func (m *QueryStabilityFeeControllerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStabilityFeeControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStabilityFeeControllerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStabilityFeeControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStabilityFeeControllerRequest.Merge(m, src)
+}
+func (m *QueryStabilityFeeControllerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStabilityFeeControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStabilityFeeControllerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStabilityFeeControllerRequest proto.InternalMessageInfo
+
+type QueryStabilityFeeControllerResponse struct {
+	Controller StabilityFeeController      `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller" yaml:"controller"`
+	State      StabilityFeeControllerState `protobuf:"bytes,2,opt,name=state,proto3" json:"state" yaml:"state"`
+}
+
+func (m *QueryStabilityFeeControllerResponse) Reset()         { *m = QueryStabilityFeeControllerResponse{} }
+func (m *QueryStabilityFeeControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStabilityFeeControllerResponse) ProtoMessage()    {}
+func (*QueryStabilityFeeControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e7e9ce3abb4febf, []int{27}
+}
+func (m *QueryStabilityFeeControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStabilityFeeControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStabilityFeeControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStabilityFeeControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStabilityFeeControllerResponse.Merge(m, src)
+}
+func (m *QueryStabilityFeeControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStabilityFeeControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStabilityFeeControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStabilityFeeControllerResponse proto.InternalMessageInfo
+
+type QueryStabilityFeeAdjustmentsRequest struct {
+	ExtendedPairVaultId uint64             `protobuf:"varint,1,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	Pagination          *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty" yaml:"pagination"`
+}
+
+func (m *QueryStabilityFeeAdjustmentsRequest) Reset()         { *m = QueryStabilityFeeAdjustmentsRequest{} }
+func (m *QueryStabilityFeeAdjustmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStabilityFeeAdjustmentsRequest) ProtoMessage()    {}
+func (*QueryStabilityFeeAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e7e9ce3abb4febf, []int{28}
+}
+func (m *QueryStabilityFeeAdjustmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStabilityFeeAdjustmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStabilityFeeAdjustmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStabilityFeeAdjustmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStabilityFeeAdjustmentsRequest.Merge(m, src)
+}
+func (m *QueryStabilityFeeAdjustmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStabilityFeeAdjustmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStabilityFeeAdjustmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStabilityFeeAdjustmentsRequest proto.InternalMessageInfo
+
+type QueryStabilityFeeAdjustmentsResponse struct {
+	Adjustments []StabilityFeeAdjustment `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments" yaml:"adjustments"`
+	Pagination  *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty" yaml:"pagination"`
+}
+
+func (m *QueryStabilityFeeAdjustmentsResponse) Reset()         { *m = QueryStabilityFeeAdjustmentsResponse{} }
+func (m *QueryStabilityFeeAdjustmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStabilityFeeAdjustmentsResponse) ProtoMessage()    {}
+func (*QueryStabilityFeeAdjustmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e7e9ce3abb4febf, []int{29}
+}
+func (m *QueryStabilityFeeAdjustmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStabilityFeeAdjustmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStabilityFeeAdjustmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStabilityFeeAdjustmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStabilityFeeAdjustmentsResponse.Merge(m, src)
+}
+func (m *QueryStabilityFeeAdjustmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStabilityFeeAdjustmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStabilityFeeAdjustmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStabilityFeeAdjustmentsResponse proto.InternalMessageInfo
+
+type QueryVaultCollateralAssetsRequest struct {
+	ExtendedPairVaultId uint64 `protobuf:"varint,1,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+}
+
+func (m *QueryVaultCollateralAssetsRequest) Reset()         { *m = QueryVaultCollateralAssetsRequest{} }
+func (m *QueryVaultCollateralAssetsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultCollateralAssetsRequest) ProtoMessage()    {}
+func (*QueryVaultCollateralAssetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e7e9ce3abb4febf, []int{30}
+}
+func (m *QueryVaultCollateralAssetsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultCollateralAssetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultCollateralAssetsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultCollateralAssetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultCollateralAssetsRequest.Merge(m, src)
+}
+func (m *QueryVaultCollateralAssetsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultCollateralAssetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultCollateralAssetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultCollateralAssetsRequest proto.InternalMessageInfo
+
+type QueryVaultCollateralAssetsResponse struct {
+	Collaterals []VaultCollateralAsset `protobuf:"bytes,1,rep,name=collaterals,proto3" json:"collaterals" yaml:"collaterals"`
+}
+
+func (m *QueryVaultCollateralAssetsResponse) Reset()         { *m = QueryVaultCollateralAssetsResponse{} }
+func (m *QueryVaultCollateralAssetsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultCollateralAssetsResponse) ProtoMessage()    {}
+func (*QueryVaultCollateralAssetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e7e9ce3abb4febf, []int{31}
+}
+func (m *QueryVaultCollateralAssetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultCollateralAssetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultCollateralAssetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultCollateralAssetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultCollateralAssetsResponse.Merge(m, src)
+}
+func (m *QueryVaultCollateralAssetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultCollateralAssetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultCollateralAssetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultCollateralAssetsResponse proto.InternalMessageInfo
+
+type QueryDelistingsRequest struct {
+}
+
+func (m *QueryDelistingsRequest) Reset()         { *m = QueryDelistingsRequest{} }
+func (m *QueryDelistingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelistingsRequest) ProtoMessage()    {}
+func (*QueryDelistingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e7e9ce3abb4febf, []int{32}
+}
+func (m *QueryDelistingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelistingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelistingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelistingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelistingsRequest.Merge(m, src)
+}
+func (m *QueryDelistingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelistingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelistingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelistingsRequest proto.InternalMessageInfo
+
+type QueryDelistingsResponse struct {
+	AssetDelistings []Delisting `protobuf:"bytes,1,rep,name=asset_delistings,json=assetDelistings,proto3" json:"asset_delistings" yaml:"asset_delistings"`
+	PairDelistings  []Delisting `protobuf:"bytes,2,rep,name=pair_delistings,json=pairDelistings,proto3" json:"pair_delistings" yaml:"pair_delistings"`
+}
+
+func (m *QueryDelistingsResponse) Reset()         { *m = QueryDelistingsResponse{} }
+func (m *QueryDelistingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelistingsResponse) ProtoMessage()    {}
+func (*QueryDelistingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e7e9ce3abb4febf, []int{33}
+}
+func (m *QueryDelistingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelistingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelistingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelistingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelistingsResponse.Merge(m, src)
+}
+func (m *QueryDelistingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelistingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelistingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelistingsResponse proto.InternalMessageInfo
+
+type QueryPendingChangesRequest struct {
+	ParamClass string `protobuf:"bytes,1,opt,name=param_class,json=paramClass,proto3" json:"param_class,omitempty" yaml:"param_class"`
+}
+
+func (m *QueryPendingChangesRequest) Reset()         { *m = QueryPendingChangesRequest{} }
+func (m *QueryPendingChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingChangesRequest) ProtoMessage()    {}
+func (*QueryPendingChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e7e9ce3abb4febf, []int{34}
+}
+func (m *QueryPendingChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingChangesRequest.Merge(m, src)
+}
+func (m *QueryPendingChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingChangesRequest proto.InternalMessageInfo
+
+type QueryPendingChangesResponse struct {
+	PendingChanges []PendingChange `protobuf:"bytes,1,rep,name=pending_changes,json=pendingChanges,proto3" json:"pending_changes" yaml:"pending_changes"`
+}
+
+func (m *QueryPendingChangesResponse) Reset()         { *m = QueryPendingChangesResponse{} }
+func (m *QueryPendingChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingChangesResponse) ProtoMessage()    {}
+func (*QueryPendingChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e7e9ce3abb4febf, []int{35}
+}
+func (m *QueryPendingChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingChangesResponse.Merge(m, src)
+}
+func (m *QueryPendingChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingChangesResponse proto.InternalMessageInfo
+
+type QueryTimelockDelaysRequest struct {
+}
+
+func (m *QueryTimelockDelaysRequest) Reset()         { *m = QueryTimelockDelaysRequest{} }
+func (m *QueryTimelockDelaysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockDelaysRequest) ProtoMessage()    {}
+func (*QueryTimelockDelaysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e7e9ce3abb4febf, []int{36}
+}
+func (m *QueryTimelockDelaysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimelockDelaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockDelaysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimelockDelaysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockDelaysRequest.Merge(m, src)
+}
+func (m *QueryTimelockDelaysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimelockDelaysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockDelaysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimelockDelaysRequest proto.InternalMessageInfo
+
+type QueryTimelockDelaysResponse struct {
+	Delays []TimelockDelay `protobuf:"bytes,1,rep,name=delays,proto3" json:"delays" yaml:"delays"`
+}
+
+func (m *QueryTimelockDelaysResponse) Reset()         { *m = QueryTimelockDelaysResponse{} }
+func (m *QueryTimelockDelaysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockDelaysResponse) ProtoMessage()    {}
+func (*QueryTimelockDelaysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e7e9ce3abb4febf, []int{37}
+}
+func (m *QueryTimelockDelaysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimelockDelaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockDelaysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimelockDelaysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockDelaysResponse.Merge(m, src)
+}
+func (m *QueryTimelockDelaysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimelockDelaysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockDelaysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimelockDelaysResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryAssetsRequest)(nil), "comdex.asset.v1beta1.QueryAssetsRequest")
 	proto.RegisterType((*QueryAssetsResponse)(nil), "comdex.asset.v1beta1.QueryAssetsResponse")
@@ -1059,97 +1506,149 @@ func init() {
 	proto.RegisterType((*QueryAllExtendedPairStableVaultsByAppResponse)(nil), "comdex.asset.v1beta1.QueryAllExtendedPairStableVaultsByAppResponse")
 	proto.RegisterType((*QueryExtendedPairVaultsByAppWithoutStableRequest)(nil), "comdex.asset.v1beta1.QueryExtendedPairVaultsByAppWithoutStableRequest")
 	proto.RegisterType((*QueryExtendedPairVaultsByAppWithoutStableResponse)(nil), "comdex.asset.v1beta1.QueryExtendedPairVaultsByAppWithoutStableResponse")
+	proto.RegisterType((*QueryStabilityFeeControllerRequest)(nil), "comdex.asset.v1beta1.QueryStabilityFeeControllerRequest")
+	proto.RegisterType((*QueryStabilityFeeControllerResponse)(nil), "comdex.asset.v1beta1.QueryStabilityFeeControllerResponse")
+	proto.RegisterType((*QueryStabilityFeeAdjustmentsRequest)(nil), "comdex.asset.v1beta1.QueryStabilityFeeAdjustmentsRequest")
+	proto.RegisterType((*QueryStabilityFeeAdjustmentsResponse)(nil), "comdex.asset.v1beta1.QueryStabilityFeeAdjustmentsResponse")
+	proto.RegisterType((*QueryVaultCollateralAssetsRequest)(nil), "comdex.asset.v1beta1.QueryVaultCollateralAssetsRequest")
+	proto.RegisterType((*QueryVaultCollateralAssetsResponse)(nil), "comdex.asset.v1beta1.QueryVaultCollateralAssetsResponse")
+	proto.RegisterType((*QueryDelistingsRequest)(nil), "comdex.asset.v1beta1.QueryDelistingsRequest")
+	proto.RegisterType((*QueryDelistingsResponse)(nil), "comdex.asset.v1beta1.QueryDelistingsResponse")
+	proto.RegisterType((*QueryPendingChangesRequest)(nil), "comdex.asset.v1beta1.QueryPendingChangesRequest")
+	proto.RegisterType((*QueryPendingChangesResponse)(nil), "comdex.asset.v1beta1.QueryPendingChangesResponse")
+	proto.RegisterType((*QueryTimelockDelaysRequest)(nil), "comdex.asset.v1beta1.QueryTimelockDelaysRequest")
+	proto.RegisterType((*QueryTimelockDelaysResponse)(nil), "comdex.asset.v1beta1.QueryTimelockDelaysResponse")
 }
 
 func init() { proto.RegisterFile("comdex/asset/v1beta1/query.proto", fileDescriptor_9e7e9ce3abb4febf) }
 
 var fileDescriptor_9e7e9ce3abb4febf = []byte{
-	// 1345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdb, 0x4f, 0x1c, 0xd5,
-	0x1f, 0xdf, 0xb3, 0xb4, 0x4d, 0x7b, 0xa0, 0x17, 0x0e, 0xf0, 0x2b, 0x9d, 0xc2, 0x2c, 0x0c, 0xbf,
-	0xb2, 0x4b, 0x85, 0x1d, 0xa0, 0x8d, 0xb5, 0x8d, 0xd7, 0x2d, 0x17, 0x31, 0x31, 0xb6, 0x6b, 0x95,
-	0xc4, 0xa4, 0x8e, 0x03, 0x3b, 0x6c, 0x47, 0x97, 0x99, 0xc3, 0x9e, 0xd9, 0xa5, 0xa4, 0x69, 0xa2,
-	0x3c, 0x19, 0x9f, 0x4c, 0x88, 0xff, 0x83, 0xaf, 0xc6, 0x07, 0x63, 0x78, 0x32, 0xbe, 0xf0, 0xd8,
-	0xe8, 0x8b, 0x89, 0x09, 0x2a, 0x18, 0xff, 0x00, 0x12, 0x13, 0x13, 0x13, 0x35, 0x73, 0xce, 0x77,
-	0x76, 0x76, 0x61, 0x66, 0x77, 0x16, 0x0a, 0x21, 0x7d, 0x23, 0x3b, 0xdf, 0xcb, 0xe7, 0x72, 0xce,
-	0xcc, 0xf7, 0x1c, 0x70, 0xdf, 0xbc, 0xbd, 0x98, 0x33, 0x1e, 0xaa, 0x3a, 0x63, 0x86, 0xa3, 0x96,
-	0xc7, 0xe6, 0x0c, 0x47, 0x1f, 0x53, 0x97, 0x4a, 0x46, 0x71, 0x25, 0x4d, 0x8b, 0xb6, 0x63, 0x93,
-	0x4e, 0x11, 0x91, 0xe6, 0x11, 0x69, 0x88, 0x90, 0xae, 0xce, 0xdb, 0x6c, 0xd1, 0x66, 0xea, 0x9c,
-	0xce, 0x0c, 0x11, 0x5e, 0x49, 0xa6, 0x7a, 0xde, 0xb4, 0x74, 0xc7, 0xb4, 0x2d, 0x51, 0x41, 0xea,
-	0xcc, 0xdb, 0x79, 0x9b, 0xff, 0xa9, 0xba, 0x7f, 0xc1, 0xaf, 0x3d, 0x79, 0xdb, 0xce, 0x17, 0x0c,
-	0x55, 0xa7, 0xa6, 0xaa, 0x5b, 0x96, 0xed, 0xf0, 0x14, 0x06, 0x4f, 0x83, 0x71, 0x09, 0x0c, 0x22,
-	0x42, 0x0e, 0x8e, 0xa0, 0x14, 0x9e, 0x27, 0x02, 0x9f, 0x53, 0xdd, 0x2c, 0x42, 0xc0, 0x70, 0x60,
-	0x80, 0xf1, 0xd0, 0x31, 0xac, 0x9c, 0x91, 0xbb, 0xa3, 0x9b, 0xc5, 0x77, 0xf5, 0x52, 0x01, 0xda,
-	0x29, 0x0c, 0x93, 0xbb, 0x2e, 0xcd, 0xd7, 0xdc, 0x68, 0x96, 0x35, 0x96, 0x4a, 0x06, 0x73, 0xc8,
-	0x7d, 0x8c, 0x7d, 0xba, 0xdd, 0xa8, 0x0f, 0xa5, 0x5a, 0xc7, 0x07, 0xd3, 0x42, 0x9b, 0xb4, 0xab,
-	0x4d, 0x5a, 0x48, 0x09, 0xd5, 0xd3, 0x77, 0xf4, 0xbc, 0x01, 0xb9, 0x99, 0xae, 0x9d, 0xcd, 0x44,
-	0xfb, 0x8a, 0xbe, 0x58, 0xb8, 0xa5, 0xf8, 0x35, 0x94, 0x6c, 0x55, 0x41, 0xe5, 0x3b, 0x84, 0x3b,
-	0x6a, 0xba, 0x32, 0x6a, 0x5b, 0xcc, 0x20, 0x6f, 0xe0, 0x53, 0x1c, 0x35, 0xeb, 0x46, 0x7d, 0x2d,
-	0xa9, 0xd6, 0xf1, 0xcb, 0xe9, 0x20, 0x93, 0xd2, 0x3c, 0x2b, 0xd3, 0xb5, 0xb1, 0x99, 0x88, 0xed,
-	0x6c, 0x26, 0xce, 0x8a, 0x5e, 0x22, 0x51, 0xc9, 0x42, 0x05, 0xf2, 0x7e, 0x0d, 0x85, 0x38, 0xa7,
-	0x90, 0x6c, 0x48, 0x41, 0x00, 0x89, 0xc2, 0x61, 0x00, 0xb7, 0xfb, 0x14, 0x3c, 0xdd, 0xce, 0xe1,
-	0xb8, 0x99, 0xe3, 0x7a, 0x9d, 0xc8, 0xc6, 0xcd, 0x9c, 0x72, 0xbf, 0x5a, 0xdd, 0x0a, 0xcd, 0x69,
-	0x7c, 0x92, 0x83, 0x04, 0x61, 0xeb, 0xb2, 0xec, 0x04, 0x96, 0x6d, 0x55, 0x2c, 0x95, 0xac, 0xc8,
-	0x57, 0x96, 0xf1, 0xff, 0xfc, 0xf2, 0xae, 0xb3, 0x47, 0x65, 0xe0, 0x0f, 0x08, 0x5f, 0xdc, 0xd3,
-	0x19, 0xd8, 0xcd, 0xe2, 0x33, 0xee, 0x6a, 0x64, 0x33, 0xd6, 0x82, 0x0d, 0x3e, 0xca, 0xc1, 0x0c,
-	0xdd, 0x3c, 0x37, 0x2a, 0x73, 0x09, 0x48, 0x56, 0xba, 0x9a, 0x45, 0xa6, 0x99, 0xd6, 0x82, 0xad,
-	0x64, 0xfd, 0x5a, 0x87, 0xee, 0x68, 0x12, 0x77, 0xd5, 0x72, 0x0a, 0x73, 0xd5, 0xda, 0x2d, 0x7b,
-	0x85, 0xfb, 0x3d, 0x7c, 0x9a, 0x02, 0x29, 0x10, 0xbd, 0x11, 0xf5, 0x6e, 0xa0, 0x7e, 0xc1, 0xa7,
-	0x0e, 0xcc, 0x2b, 0x95, 0x94, 0x7e, 0x7c, 0x5e, 0xf4, 0xa3, 0x34, 0x0c, 0xd2, 0x2c, 0xbe, 0xe0,
-	0x87, 0x00, 0x98, 0xdb, 0xb8, 0x45, 0xa7, 0x14, 0x70, 0xf4, 0x86, 0x2c, 0x32, 0x4a, 0x27, 0x74,
-	0x47, 0xcf, 0x10, 0x80, 0x81, 0x61, 0x99, 0x51, 0xaa, 0x64, 0xdd, 0x6c, 0x65, 0x12, 0x5f, 0xe2,
-	0x85, 0xa7, 0xed, 0xf2, 0x3d, 0xfb, 0x23, 0xc3, 0xca, 0x54, 0xa3, 0x48, 0xe1, 0x53, 0x3a, 0xa5,
-	0x9a, 0x87, 0x24, 0xd3, 0x5e, 0xb5, 0x1d, 0xf9, 0xef, 0xee, 0x4a, 0xa5, 0x74, 0xc6, 0xc5, 0x27,
-	0x05, 0x95, 0x01, 0xa4, 0x37, 0x71, 0x5b, 0xde, 0x2e, 0x6b, 0x1c, 0x9a, 0x5f, 0xed, 0xe2, 0xce,
-	0x66, 0xa2, 0x43, 0x54, 0xab, 0x7e, 0xaa, 0x64, 0x71, 0xde, 0x2e, 0x73, 0xed, 0x67, 0x72, 0xca,
-	0x92, 0x4f, 0xfc, 0xa8, 0x16, 0xff, 0x3a, 0xf2, 0xb6, 0x3e, 0xef, 0x09, 0x1c, 0xa6, 0xf0, 0x09,
-	0x9d, 0x52, 0xef, 0xcd, 0xd5, 0x40, 0xee, 0x0e, 0x90, 0xbb, 0xb5, 0x22, 0x16, 0x53, 0xb2, 0x3c,
-	0xff, 0xd0, 0x57, 0xb9, 0x8a, 0x7b, 0x39, 0xf8, 0xc9, 0xdd, 0x1f, 0x84, 0xb0, 0xa5, 0xb5, 0x8a,
-	0xb0, 0x1c, 0x96, 0x01, 0xdc, 0x3f, 0x10, 0x5b, 0x9e, 0xff, 0x08, 0x7a, 0x27, 0x83, 0x05, 0xd8,
-	0x53, 0x23, 0x68, 0xef, 0x6b, 0x65, 0xf7, 0x09, 0xec, 0x7d, 0x1e, 0xe5, 0x82, 0xe8, 0x17, 0x9a,
-	0x17, 0x0a, 0x7b, 0x6a, 0x1c, 0x95, 0xf1, 0x7f, 0x20, 0xac, 0xd4, 0x03, 0x11, 0xac, 0x46, 0xcb,
-	0x53, 0x57, 0xe3, 0xd0, 0xd7, 0xc8, 0x57, 0x08, 0x0f, 0x86, 0x13, 0xdd, 0xdf, 0x2b, 0x60, 0x97,
-	0x39, 0xf1, 0xa7, 0x6d, 0xce, 0x9f, 0x08, 0x27, 0x1b, 0x62, 0x06, 0x87, 0x3e, 0xc4, 0x67, 0xbd,
-	0x79, 0x48, 0x73, 0x55, 0x6d, 0xd6, 0xa5, 0x1e, 0x70, 0xa9, 0x53, 0x40, 0xaa, 0xa9, 0xa5, 0x64,
-	0xdb, 0xaa, 0x67, 0xad, 0x43, 0xf7, 0xea, 0x5b, 0x84, 0xd3, 0x41, 0xbc, 0xdf, 0x76, 0xf4, 0xb9,
-	0x82, 0x21, 0xd8, 0xcf, 0x4c, 0x1c, 0x4f, 0xcf, 0x7e, 0x46, 0x58, 0x8d, 0x8c, 0x1d, 0xbc, 0x7b,
-	0x1d, 0xb7, 0xd7, 0xe8, 0xcd, 0x04, 0x8f, 0x96, 0xd4, 0x89, 0x4c, 0xcf, 0xce, 0x66, 0xa2, 0x3b,
-	0xc0, 0x12, 0xc6, 0x29, 0x9d, 0xaf, 0xb6, 0x85, 0xcd, 0xe4, 0x0e, 0xdd, 0x99, 0x6f, 0x10, 0x1e,
-	0x6e, 0xc4, 0xee, 0x78, 0xfa, 0xf2, 0x37, 0xc2, 0x23, 0x11, 0x91, 0x3f, 0x83, 0x3b, 0x6a, 0x1d,
-	0xe1, 0xd1, 0xe0, 0x0f, 0x9e, 0x20, 0x3d, 0x6b, 0x3a, 0x0f, 0xec, 0x92, 0x23, 0xc4, 0x38, 0x76,
-	0xde, 0xfd, 0x8b, 0xf0, 0x58, 0x13, 0xe8, 0x9f, 0x3d, 0xff, 0xc6, 0x57, 0x3b, 0xf0, 0x49, 0xae,
-	0x00, 0xf9, 0x14, 0xe1, 0xd6, 0xaa, 0x73, 0x26, 0x49, 0x05, 0xd3, 0xd9, 0x7b, 0x00, 0x96, 0x86,
-	0x22, 0x44, 0x0a, 0x44, 0xca, 0xff, 0x57, 0x7f, 0xfc, 0x7d, 0x2d, 0x2e, 0x93, 0x1e, 0x35, 0xfc,
-	0x6c, 0xcf, 0xc8, 0x67, 0x08, 0x63, 0x3f, 0x9b, 0x24, 0x1b, 0xd5, 0xf7, 0x80, 0xa4, 0x1a, 0x07,
-	0x02, 0x8e, 0x21, 0x8e, 0x63, 0x80, 0xf4, 0xd7, 0xc3, 0xa1, 0x3e, 0x32, 0x73, 0x8f, 0xc9, 0x1a,
-	0xf2, 0x4e, 0x14, 0x95, 0xe3, 0x1b, 0x19, 0x6e, 0xd4, 0xa8, 0xfa, 0x7c, 0x29, 0x8d, 0x44, 0x8c,
-	0x06, 0x6c, 0x03, 0x1c, 0x5b, 0x2f, 0xb9, 0xac, 0x86, 0xde, 0x5e, 0x30, 0xf2, 0x05, 0xc2, 0xe7,
-	0x6a, 0x0b, 0x90, 0xe7, 0xa2, 0xb4, 0xf1, 0x30, 0x0d, 0x47, 0x0b, 0x06, 0x48, 0x29, 0x0e, 0x49,
-	0x21, 0x7d, 0x75, 0x20, 0x09, 0xb5, 0x3e, 0x46, 0xf8, 0x4c, 0x65, 0xde, 0x27, 0x83, 0xf5, 0xba,
-	0xf8, 0x87, 0x10, 0x29, 0xd9, 0x30, 0x0e, 0x80, 0x28, 0x1c, 0x48, 0x0f, 0x91, 0xd4, 0xb0, 0x9b,
-	0x1f, 0x46, 0x3e, 0x41, 0xf8, 0xb4, 0x97, 0x49, 0xae, 0xd4, 0xaf, 0xec, 0x01, 0x18, 0x6c, 0x14,
-	0x06, 0xfd, 0x07, 0x79, 0xff, 0x3e, 0x22, 0x87, 0xf6, 0x17, 0x32, 0xac, 0x23, 0x38, 0xf6, 0xee,
-	0xd9, 0xfd, 0xe4, 0x5a, 0x9d, 0x56, 0x61, 0xe7, 0x0c, 0xe9, 0x7a, 0x73, 0x49, 0x80, 0xf6, 0x79,
-	0x8e, 0x76, 0x94, 0xa4, 0xd5, 0xba, 0xd7, 0x5c, 0x9a, 0x3f, 0x39, 0x0b, 0xf4, 0xdf, 0x23, 0x38,
-	0x81, 0x06, 0x8e, 0x87, 0xe4, 0x46, 0x3d, 0xb1, 0xea, 0x1c, 0x39, 0xa4, 0x17, 0x9a, 0x4f, 0x04,
-	0x26, 0xe3, 0x9c, 0xc9, 0x30, 0xb9, 0x1a, 0x99, 0x09, 0x23, 0xbf, 0x20, 0x9c, 0x68, 0x30, 0xe4,
-	0x92, 0x17, 0x9b, 0x45, 0x54, 0x3d, 0x83, 0x48, 0x2f, 0xed, 0x33, 0x1b, 0x48, 0xbd, 0xc2, 0x49,
-	0xdd, 0x24, 0x37, 0x42, 0x76, 0x55, 0xd1, 0xce, 0x95, 0xe6, 0x1d, 0xcd, 0xb1, 0xb5, 0x1a, 0x7e,
-	0xea, 0x23, 0xf1, 0x91, 0x7c, 0x4c, 0xfe, 0x09, 0x19, 0xe3, 0x03, 0x46, 0x42, 0x32, 0x11, 0x1d,
-	0x6b, 0xf8, 0x34, 0x2c, 0x4d, 0x1e, 0xb0, 0x0a, 0x30, 0x9f, 0xe2, 0xcc, 0x5f, 0x25, 0x2f, 0x47,
-	0xb1, 0x93, 0xf1, 0x42, 0xc2, 0x55, 0x6d, 0xd9, 0x64, 0x86, 0x2f, 0xc0, 0xd7, 0x08, 0xee, 0x0c,
-	0x6b, 0xae, 0x4a, 0x88, 0x5a, 0x07, 0x65, 0xd0, 0xdd, 0x8c, 0x34, 0x1a, 0x3d, 0x01, 0x18, 0xdc,
-	0xe2, 0x0c, 0xae, 0x93, 0xf1, 0x60, 0x06, 0x79, 0xbb, 0xac, 0x39, 0x6e, 0x96, 0x66, 0x5a, 0x9a,
-	0x6e, 0x69, 0xfc, 0xc5, 0xe0, 0xa1, 0xfe, 0x0b, 0xe1, 0x2b, 0x91, 0x26, 0x46, 0x92, 0xd9, 0x9f,
-	0xdc, 0x35, 0xdc, 0x6e, 0x1f, 0xa8, 0xc6, 0x81, 0x0d, 0xcb, 0xe9, 0x8e, 0xee, 0x53, 0x5f, 0x8b,
-	0xe3, 0xa1, 0xc8, 0x03, 0x17, 0x99, 0x6a, 0xe6, 0xad, 0x17, 0x3e, 0x6f, 0x4a, 0xd3, 0x07, 0xae,
-	0x03, 0x32, 0xbc, 0xc3, 0x65, 0x78, 0x8b, 0xbc, 0xb9, 0x2f, 0x19, 0xb4, 0x65, 0x51, 0x14, 0x9e,
-	0x54, 0x54, 0xc9, 0xdc, 0xdd, 0xf8, 0x4d, 0x8e, 0x7d, 0xb9, 0x25, 0xc7, 0x36, 0xb6, 0x64, 0xf4,
-	0x64, 0x4b, 0x46, 0xbf, 0x6e, 0xc9, 0xe8, 0xf3, 0x6d, 0x39, 0xf6, 0x64, 0x5b, 0x8e, 0xfd, 0xb4,
-	0x2d, 0xc7, 0xde, 0x53, 0xf3, 0xa6, 0xf3, 0xa0, 0x34, 0xe7, 0xf2, 0x80, 0xd6, 0x23, 0xf6, 0xc2,
-	0x82, 0x39, 0x6f, 0xea, 0x05, 0x0f, 0x8a, 0x07, 0xc6, 0x59, 0xa1, 0x06, 0x9b, 0x3b, 0xc5, 0xff,
-	0x63, 0x71, 0xed, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x37, 0x25, 0x4f, 0xdc, 0x19, 0x00,
-	0x00,
+	// 1997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xdd, 0x6b, 0x1c, 0xd7,
+	0x15, 0xd7, 0x95, 0x3f, 0x88, 0xaf, 0x1c, 0xdb, 0xba, 0xfe, 0x52, 0xc6, 0xf2, 0xae, 0x7c, 0x65,
+	0x4b, 0xb2, 0x2b, 0xed, 0x58, 0x4a, 0xa8, 0x63, 0xd3, 0xaf, 0xac, 0x64, 0xd9, 0x0a, 0xb4, 0x71,
+	0x26, 0x4e, 0x0c, 0x05, 0x67, 0x7a, 0xb5, 0x3b, 0x5a, 0x4d, 0x3c, 0x9a, 0x19, 0xef, 0x1d, 0xc9,
+	0x11, 0xae, 0xa1, 0xcd, 0x53, 0x69, 0xa1, 0x14, 0x4c, 0xa1, 0xff, 0x41, 0xdb, 0xc7, 0xd2, 0x87,
+	0x52, 0x4c, 0x1f, 0x4a, 0x5f, 0xfc, 0x18, 0xda, 0x97, 0x42, 0x8b, 0xd2, 0xda, 0xa5, 0x7d, 0x2a,
+	0x14, 0x41, 0xa1, 0x50, 0x68, 0xc3, 0xdc, 0x7b, 0xe6, 0x4b, 0x7b, 0x67, 0x76, 0x56, 0xce, 0x1a,
+	0x93, 0x37, 0x7b, 0xee, 0x39, 0xbf, 0xf3, 0xfb, 0x9d, 0x73, 0xef, 0xcc, 0x3d, 0x67, 0x85, 0xc7,
+	0x1a, 0xde, 0x5a, 0xd3, 0xfa, 0x50, 0x67, 0x9c, 0x5b, 0x81, 0xbe, 0x31, 0xbb, 0x6c, 0x05, 0x6c,
+	0x56, 0xbf, 0xbb, 0x6e, 0xb5, 0x37, 0x6b, 0x7e, 0xdb, 0x0b, 0x3c, 0x72, 0x4c, 0x5a, 0xd4, 0x84,
+	0x45, 0x0d, 0x2c, 0xb4, 0x0b, 0x0d, 0x8f, 0xaf, 0x79, 0x5c, 0x5f, 0x66, 0xdc, 0x92, 0xe6, 0xb1,
+	0xb3, 0xcf, 0x5a, 0xb6, 0xcb, 0x02, 0xdb, 0x73, 0x25, 0x82, 0x76, 0xac, 0xe5, 0xb5, 0x3c, 0xf1,
+	0x4f, 0x3d, 0xfc, 0x17, 0x3c, 0x1d, 0x6d, 0x79, 0x5e, 0xcb, 0xb1, 0x74, 0xe6, 0xdb, 0x3a, 0x73,
+	0x5d, 0x2f, 0x10, 0x2e, 0x1c, 0x56, 0xd5, 0xbc, 0x24, 0x07, 0x69, 0x51, 0x51, 0x5b, 0xf8, 0x3e,
+	0xac, 0x57, 0x95, 0xeb, 0x3e, 0xb3, 0xdb, 0x60, 0x30, 0xad, 0x34, 0xb0, 0x3e, 0x0c, 0x2c, 0xb7,
+	0x69, 0x35, 0x6f, 0x30, 0xbb, 0xfd, 0x1e, 0x5b, 0x77, 0xa2, 0x70, 0x67, 0x95, 0xd6, 0x4d, 0xcb,
+	0xb1, 0x79, 0x60, 0xbb, 0x2d, 0xb0, 0x1a, 0x57, 0x5a, 0x05, 0xf6, 0x9a, 0xe5, 0x78, 0x8d, 0x3b,
+	0x60, 0x34, 0x91, 0x03, 0xe5, 0x7a, 0x6b, 0x66, 0xd0, 0x66, 0x0d, 0x4b, 0xda, 0x51, 0x8e, 0xc9,
+	0xdb, 0x61, 0x66, 0xdf, 0x08, 0xed, 0xb8, 0x61, 0xdd, 0x5d, 0xb7, 0x78, 0x40, 0x6e, 0x63, 0x9c,
+	0x64, 0x78, 0x04, 0x8d, 0xa1, 0xa9, 0xa1, 0xb9, 0x89, 0x9a, 0x2c, 0x47, 0x2d, 0x2c, 0x47, 0x4d,
+	0x56, 0x0f, 0x70, 0x6b, 0x37, 0x58, 0xcb, 0x02, 0xdf, 0xfa, 0xf1, 0xed, 0xad, 0xea, 0xf0, 0x26,
+	0x5b, 0x73, 0xae, 0xd0, 0x04, 0x83, 0x1a, 0x29, 0x40, 0xfa, 0x5b, 0x84, 0x8f, 0x66, 0xa2, 0x72,
+	0xdf, 0x73, 0xb9, 0x45, 0xde, 0xc4, 0xfb, 0x05, 0x5f, 0x3e, 0x82, 0xc6, 0xf6, 0x4c, 0x0d, 0xcd,
+	0x9d, 0xaa, 0xa9, 0xf6, 0x45, 0x4d, 0x78, 0xd5, 0x8f, 0x3f, 0xde, 0xaa, 0x0e, 0x6c, 0x6f, 0x55,
+	0x5f, 0x96, 0xb1, 0xa4, 0x23, 0x35, 0x00, 0x81, 0xbc, 0x9f, 0x91, 0x30, 0x28, 0x24, 0x4c, 0x76,
+	0x95, 0x20, 0x89, 0x94, 0xd1, 0x30, 0x8e, 0x87, 0x13, 0x09, 0x51, 0xde, 0x0e, 0xe1, 0x41, 0xbb,
+	0x29, 0xf2, 0xb5, 0xd7, 0x18, 0xb4, 0x9b, 0xf4, 0x37, 0x28, 0x9d, 0xde, 0x58, 0xe7, 0x35, 0xbc,
+	0x4f, 0xb0, 0x84, 0xcc, 0x16, 0xca, 0x3c, 0x06, 0x32, 0x0f, 0xa6, 0x64, 0x52, 0x43, 0xfa, 0x93,
+	0xf7, 0xf1, 0x50, 0xaa, 0xa4, 0xa0, 0xf2, 0x5c, 0x01, 0xdc, 0x42, 0x68, 0x7d, 0x33, 0x34, 0xae,
+	0x9f, 0xd8, 0xde, 0xaa, 0x12, 0x09, 0x9a, 0xc2, 0xa0, 0x06, 0x6e, 0xc6, 0x36, 0xf4, 0x1e, 0x3e,
+	0x91, 0xd0, 0x0f, 0x77, 0xeb, 0xf3, 0xda, 0x21, 0xbf, 0x47, 0xf8, 0x64, 0x47, 0x64, 0xc8, 0xde,
+	0x2d, 0x7c, 0x20, 0x3c, 0x61, 0x7c, 0xc9, 0x5d, 0xf1, 0x60, 0xa3, 0x54, 0xd4, 0x92, 0x43, 0xbf,
+	0xd0, 0xaa, 0xfe, 0x0a, 0x24, 0x31, 0x8e, 0x6a, 0xb7, 0xb9, 0x69, 0xbb, 0x2b, 0x1e, 0x35, 0x12,
+	0xac, 0xbe, 0x6f, 0x99, 0x49, 0x7c, 0x3c, 0xab, 0x29, 0x6f, 0xdb, 0xb8, 0x3b, 0xd3, 0x1e, 0x6b,
+	0xbf, 0x89, 0x5f, 0xf2, 0x41, 0x14, 0x24, 0xbd, 0x9b, 0xf4, 0x11, 0x90, 0x7e, 0x24, 0x91, 0x0e,
+	0xca, 0x63, 0x24, 0x7a, 0x06, 0x1f, 0x96, 0xf1, 0x7c, 0x3f, 0x8f, 0xd2, 0x2d, 0x7c, 0x24, 0x31,
+	0x01, 0x32, 0xf3, 0x78, 0x0f, 0xf3, 0x7d, 0xe0, 0x71, 0x3a, 0x67, 0xd7, 0xf9, 0xfe, 0x02, 0x0b,
+	0x58, 0x9d, 0x00, 0x0d, 0x0c, 0xdb, 0xd8, 0xf7, 0xa9, 0x11, 0x7a, 0xd3, 0xab, 0xf8, 0x15, 0x01,
+	0x7c, 0xcd, 0xdb, 0xb8, 0xe9, 0xdd, 0xb1, 0xdc, 0x7a, 0x9a, 0xc5, 0x14, 0xde, 0xcf, 0x7c, 0xdf,
+	0x8c, 0x98, 0xd4, 0x87, 0x53, 0xe7, 0x5d, 0x3c, 0x0f, 0x4f, 0x82, 0xef, 0x2f, 0x85, 0xfc, 0x34,
+	0x15, 0x0c, 0x30, 0xbd, 0x8c, 0x0f, 0xb6, 0xbc, 0x0d, 0x53, 0x50, 0x4b, 0xd0, 0x4e, 0x6e, 0x6f,
+	0x55, 0x8f, 0x4a, 0xb4, 0xf4, 0x2a, 0x35, 0x70, 0xcb, 0xdb, 0x10, 0xb9, 0x5f, 0x6a, 0xd2, 0xbb,
+	0x89, 0xf0, 0xe7, 0xb5, 0xf9, 0x1f, 0x21, 0x3c, 0x9c, 0x8a, 0x09, 0x1a, 0x16, 0xf1, 0x5e, 0xe6,
+	0xfb, 0xd1, 0xab, 0xb1, 0x4b, 0xba, 0x8f, 0x42, 0xba, 0x87, 0xe2, 0x64, 0x71, 0x6a, 0x08, 0xff,
+	0xbe, 0xef, 0x72, 0x1d, 0x9f, 0x16, 0xe4, 0xaf, 0xee, 0xfc, 0xc8, 0xe5, 0x6d, 0xad, 0x8f, 0x10,
+	0xae, 0xe4, 0x79, 0x80, 0xf6, 0x6f, 0xc9, 0x23, 0x2f, 0x1e, 0x8e, 0xa0, 0x98, 0xb2, 0x22, 0x01,
+	0x1d, 0x18, 0xaa, 0xb3, 0x6f, 0x6e, 0x84, 0x2b, 0x70, 0xf6, 0x85, 0x55, 0x48, 0xe2, 0x8c, 0xcc,
+	0xb9, 0xe3, 0x74, 0x60, 0x3c, 0xaf, 0xc2, 0xff, 0x1d, 0x61, 0x5a, 0x44, 0x42, 0x9d, 0x8d, 0x3d,
+	0x9f, 0x79, 0x36, 0xfa, 0xbe, 0x47, 0x7e, 0x81, 0xf0, 0x44, 0xbe, 0xd0, 0xdd, 0xbd, 0x02, 0xc8,
+	0x6d, 0x05, 0xe9, 0xcf, 0xb0, 0x38, 0xff, 0x46, 0x78, 0xb2, 0x2b, 0x67, 0xa8, 0xd0, 0x07, 0xf8,
+	0xe5, 0xe8, 0x8e, 0x67, 0x86, 0x59, 0xed, 0xb5, 0x4a, 0xa3, 0x50, 0xa5, 0x63, 0x92, 0x52, 0x06,
+	0x8b, 0x1a, 0x07, 0xd3, 0xf7, 0xc7, 0xbe, 0xd7, 0xea, 0xd7, 0x08, 0xd7, 0x54, 0xba, 0xdf, 0x09,
+	0xd8, 0xb2, 0x63, 0x49, 0xf5, 0x4b, 0x0b, 0x2f, 0x66, 0xcd, 0xfe, 0x84, 0xb0, 0x5e, 0x9a, 0x3b,
+	0xd4, 0xee, 0x3a, 0x1e, 0xce, 0xe4, 0x9b, 0x4b, 0x1d, 0x7b, 0xa6, 0xf6, 0xd6, 0x47, 0xb7, 0xb7,
+	0xaa, 0x23, 0x8a, 0x92, 0x70, 0x21, 0xe9, 0x70, 0xba, 0x2c, 0x7c, 0xa9, 0xd9, 0xf7, 0xca, 0xfc,
+	0x0a, 0xe1, 0xe9, 0x6e, 0xea, 0x5e, 0xcc, 0xba, 0xfc, 0x17, 0xe1, 0x99, 0x92, 0xcc, 0x3f, 0x87,
+	0x27, 0xea, 0x11, 0xc2, 0x17, 0xd5, 0x1f, 0x3c, 0x29, 0xfa, 0x96, 0x1d, 0xac, 0x7a, 0xeb, 0x81,
+	0x4c, 0xc6, 0x0b, 0x57, 0xbb, 0xff, 0x23, 0x3c, 0xdb, 0x03, 0xfb, 0xcf, 0x61, 0xfd, 0xbe, 0x0d,
+	0x5f, 0xe9, 0x50, 0xa2, 0xed, 0xd8, 0xc1, 0xe6, 0xa2, 0x65, 0xcd, 0x7b, 0x6e, 0xd0, 0xf6, 0x1c,
+	0xc7, 0x8a, 0x2f, 0xf5, 0xef, 0xe1, 0x13, 0x19, 0x96, 0xf2, 0x33, 0x9b, 0x14, 0xf0, 0xcc, 0xf6,
+	0x56, 0xf5, 0xb4, 0x42, 0x4d, 0x6c, 0x47, 0x8d, 0xa3, 0x1d, 0x83, 0x82, 0xa5, 0x26, 0xfd, 0x27,
+	0xc2, 0xe3, 0x85, 0xe1, 0x21, 0xe3, 0x2d, 0x8c, 0x1b, 0xf1, 0x53, 0xb8, 0xab, 0x4c, 0xab, 0xd3,
+	0xad, 0x46, 0xda, 0x79, 0x57, 0x48, 0xd0, 0xa8, 0x91, 0x82, 0x26, 0xb7, 0xf1, 0x3e, 0x1e, 0xb0,
+	0x20, 0x6a, 0x3f, 0x67, 0x7b, 0x89, 0xf1, 0x4e, 0xe8, 0xb8, 0xb3, 0xc7, 0x15, 0x68, 0xd4, 0x90,
+	0xa8, 0xf4, 0xcf, 0x2a, 0xbd, 0x6f, 0x34, 0x3f, 0x58, 0xe7, 0xc1, 0x9a, 0xe5, 0x06, 0xbc, 0xcf,
+	0xf9, 0xee, 0xf7, 0x71, 0xda, 0x46, 0xf8, 0x6c, 0xb1, 0xbc, 0xf8, 0x04, 0x0d, 0xb1, 0xe4, 0x31,
+	0x9c, 0x9f, 0x12, 0x05, 0x4d, 0xb0, 0xea, 0x1a, 0xe4, 0x19, 0xda, 0xfe, 0x14, 0x1c, 0x35, 0xd2,
+	0xe0, 0x7d, 0x3f, 0x41, 0xf7, 0xe1, 0xb2, 0x2d, 0x72, 0x3c, 0xef, 0x39, 0x0e, 0x0b, 0xac, 0x36,
+	0x73, 0xb2, 0x43, 0xa8, 0x7e, 0x1d, 0xa0, 0x1f, 0x46, 0xb7, 0xec, 0x9c, 0xe8, 0x90, 0xef, 0x55,
+	0x3c, 0xd4, 0x88, 0xd7, 0xa2, 0x7c, 0x5f, 0x50, 0xe7, 0x5b, 0x85, 0xb4, 0x33, 0xdb, 0x29, 0x30,
+	0x6a, 0xa4, 0xa1, 0xe9, 0x08, 0xb4, 0xfb, 0x0b, 0xd1, 0xa0, 0x2f, 0x4a, 0x01, 0xfd, 0x57, 0x34,
+	0x06, 0x49, 0x2f, 0x01, 0xbf, 0x3b, 0xf8, 0x88, 0xec, 0x58, 0xe3, 0xf9, 0x60, 0x44, 0xb2, 0xaa,
+	0x26, 0x19, 0x63, 0xd4, 0xab, 0xc0, 0xec, 0x64, 0x6a, 0xa6, 0x94, 0x82, 0xa1, 0xc6, 0x61, 0x26,
+	0x87, 0x45, 0xd1, 0x13, 0xb2, 0x8a, 0x0f, 0x8b, 0xd4, 0xa6, 0x62, 0x0d, 0x96, 0x8b, 0x55, 0x81,
+	0x58, 0x27, 0x52, 0x0d, 0x47, 0x3a, 0xd4, 0xa1, 0xf0, 0x49, 0x12, 0x89, 0xbe, 0x0b, 0x8d, 0xfc,
+	0x0d, 0xcb, 0x6d, 0xda, 0x6e, 0x6b, 0x7e, 0x95, 0xb9, 0x2d, 0x2b, 0xde, 0x13, 0x97, 0xf0, 0x90,
+	0xcf, 0xda, 0x6c, 0xcd, 0x6c, 0x38, 0x8c, 0x73, 0xb1, 0x11, 0x0e, 0xa4, 0x27, 0x59, 0xa9, 0x45,
+	0xb1, 0xe3, 0xda, 0x6c, 0x6d, 0x5e, 0xfc, 0xe7, 0x07, 0x08, 0x9f, 0x52, 0xe2, 0x42, 0x36, 0x1d,
+	0x7c, 0xd8, 0x97, 0x2b, 0x66, 0x43, 0x2e, 0x41, 0x32, 0xc7, 0x73, 0xe6, 0x2b, 0x69, 0x98, 0x0e,
+	0x91, 0x59, 0xa4, 0x50, 0x64, 0x26, 0x2a, 0x1d, 0x05, 0x91, 0x37, 0x61, 0x68, 0xbb, 0x60, 0x39,
+	0x6c, 0x33, 0xae, 0xfa, 0x5d, 0x7c, 0x4a, 0xb9, 0x0a, 0x54, 0x0d, 0xbc, 0xbf, 0x29, 0x9e, 0x14,
+	0x33, 0xcc, 0x78, 0xef, 0x9c, 0x96, 0x4a, 0x00, 0x6a, 0x00, 0xd2, 0xdc, 0x4f, 0x47, 0xf1, 0x3e,
+	0x11, 0x93, 0x7c, 0x0f, 0xe1, 0xa1, 0xd4, 0x6c, 0x96, 0x4c, 0xa9, 0xd1, 0x3b, 0x87, 0xc6, 0xda,
+	0xf9, 0x12, 0x96, 0x52, 0x02, 0x3d, 0xfb, 0xd1, 0x1f, 0xfe, 0xf6, 0x70, 0xb0, 0x42, 0x46, 0xf5,
+	0xfc, 0x11, 0x3c, 0x27, 0xdf, 0x47, 0x18, 0x27, 0xde, 0x64, 0xb2, 0x1b, 0x7e, 0x44, 0x64, 0xaa,
+	0xbb, 0x21, 0xf0, 0x38, 0x2f, 0x78, 0x8c, 0x93, 0x33, 0x45, 0x3c, 0xf4, 0xfb, 0x76, 0xf3, 0x01,
+	0x79, 0x88, 0xa2, 0x21, 0x59, 0x3c, 0x91, 0x24, 0xd3, 0xdd, 0x02, 0xa5, 0x47, 0xa6, 0xda, 0x4c,
+	0x49, 0x6b, 0xe0, 0x36, 0x2e, 0xb8, 0x9d, 0x26, 0xa7, 0xf4, 0xdc, 0x1f, 0x19, 0x38, 0xf9, 0x31,
+	0xc2, 0x87, 0xb2, 0x00, 0xe4, 0x0b, 0x65, 0xc2, 0x44, 0x9c, 0xa6, 0xcb, 0x19, 0x03, 0xa5, 0x29,
+	0x41, 0x89, 0x92, 0xb1, 0x02, 0x4a, 0x32, 0x5b, 0xdf, 0x41, 0xf8, 0x40, 0x3c, 0xc2, 0x22, 0x13,
+	0x45, 0x51, 0x92, 0xb9, 0x9a, 0x36, 0xd9, 0xd5, 0x0e, 0x88, 0x50, 0x41, 0x64, 0x94, 0x68, 0x7a,
+	0xde, 0x0f, 0x34, 0x9c, 0x7c, 0x17, 0xe1, 0x97, 0x22, 0x4f, 0x72, 0xae, 0x18, 0x39, 0x22, 0x30,
+	0xd1, 0xcd, 0x0c, 0xe2, 0x4f, 0x88, 0xf8, 0x63, 0xa4, 0x92, 0x1b, 0x5f, 0xa6, 0xe1, 0x11, 0x82,
+	0x57, 0x7b, 0xc7, 0x85, 0x96, 0xbc, 0x5a, 0x10, 0x2a, 0x6f, 0x74, 0xa6, 0xbd, 0xd6, 0x9b, 0x13,
+	0xb0, 0xfd, 0xa2, 0x60, 0x7b, 0x91, 0xd4, 0xf4, 0xc2, 0x5f, 0xa3, 0x52, 0x1f, 0x4f, 0xc9, 0xfe,
+	0x77, 0x08, 0x6b, 0xaa, 0x2e, 0x4d, 0xa0, 0x73, 0x72, 0xa9, 0x28, 0x59, 0x05, 0x53, 0x34, 0xed,
+	0xf5, 0xde, 0x1d, 0x41, 0xc9, 0x9c, 0x50, 0x32, 0x4d, 0x2e, 0x94, 0x56, 0xc2, 0xc9, 0x27, 0x08,
+	0x57, 0xbb, 0xcc, 0x6d, 0xc8, 0x97, 0x7a, 0x65, 0x94, 0x6e, 0xab, 0xb5, 0x2f, 0xef, 0xd2, 0x1b,
+	0x44, 0x7d, 0x55, 0x88, 0xba, 0x4c, 0x2e, 0xe5, 0x9c, 0xaa, 0xb6, 0xd7, 0x5c, 0x6f, 0x04, 0x66,
+	0xe0, 0x99, 0x19, 0x7d, 0xfa, 0x7d, 0xd9, 0xf7, 0x3d, 0x20, 0xff, 0xcb, 0x99, 0x4c, 0x29, 0xa6,
+	0x1c, 0x64, 0xa1, 0x3c, 0xd7, 0xfc, 0x01, 0x8f, 0x76, 0xf5, 0x19, 0x51, 0x40, 0xf9, 0xa2, 0x50,
+	0xfe, 0x35, 0xf2, 0x95, 0x32, 0xe5, 0xe4, 0x02, 0x08, 0x2e, 0x77, 0xf7, 0x6c, 0x6e, 0x25, 0x09,
+	0xf8, 0x65, 0xf4, 0x33, 0x5b, 0x66, 0xfa, 0x4f, 0xf4, 0x02, 0x96, 0xaa, 0x9f, 0x1b, 0xb4, 0x8b,
+	0xe5, 0x1d, 0x40, 0xc1, 0x15, 0xa1, 0xe0, 0x35, 0x32, 0xa7, 0x56, 0x10, 0xfe, 0xac, 0x10, 0x84,
+	0x5e, 0xa6, 0xed, 0x9a, 0xcc, 0x35, 0xc5, 0x8b, 0x21, 0x62, 0xfd, 0x1f, 0x84, 0xcf, 0x95, 0x1a,
+	0x82, 0x90, 0xfa, 0xee, 0xd2, 0x9d, 0xd1, 0x36, 0xff, 0x4c, 0x18, 0xcf, 0x5c, 0xb0, 0x26, 0x0b,
+	0x58, 0x22, 0xfd, 0xe1, 0x20, 0x3e, 0x5f, 0x7a, 0x86, 0x40, 0x16, 0x7b, 0x79, 0xeb, 0xe5, 0x8f,
+	0x50, 0xb4, 0x6b, 0xcf, 0x8c, 0x03, 0x69, 0x78, 0x57, 0xa4, 0xe1, 0x2d, 0xf2, 0xf5, 0x5d, 0xa5,
+	0xc1, 0xbc, 0x27, 0x41, 0x61, 0x25, 0xc9, 0xca, 0xd3, 0xe8, 0x8e, 0xaa, 0xee, 0x95, 0x49, 0xd1,
+	0x7b, 0xb3, 0x70, 0x16, 0xa1, 0x5d, 0xde, 0x85, 0x27, 0x68, 0x7d, 0x4b, 0x68, 0x5d, 0x22, 0xd7,
+	0xd4, 0x5a, 0x79, 0xe4, 0x6d, 0xae, 0x58, 0x96, 0x99, 0x4c, 0x05, 0xf4, 0xfb, 0xea, 0x9e, 0xec,
+	0x01, 0xf9, 0x07, 0xc2, 0xa3, 0x45, 0x0d, 0x2f, 0x29, 0x4b, 0xb6, 0x73, 0x06, 0xa0, 0x5d, 0xd9,
+	0x8d, 0x2b, 0x08, 0xbd, 0x21, 0x84, 0xbe, 0x49, 0xae, 0x97, 0x11, 0x9a, 0x6a, 0x96, 0xf3, 0x95,
+	0x7e, 0x12, 0x7d, 0x3f, 0x95, 0x8d, 0x66, 0xe1, 0xf7, 0xb3, 0xa8, 0x31, 0xd6, 0x5e, 0xef, 0xdd,
+	0x11, 0x34, 0x7e, 0x43, 0x68, 0xbc, 0x4e, 0x16, 0xd5, 0x1a, 0x25, 0xf5, 0xa4, 0x35, 0x35, 0xa3,
+	0x0b, 0x70, 0x9e, 0xc2, 0x9f, 0x44, 0x97, 0xe2, 0x54, 0xab, 0x58, 0x74, 0xa5, 0xec, 0xe8, 0x70,
+	0xb5, 0x99, 0x92, 0xd6, 0xe5, 0x6e, 0xa0, 0x49, 0x63, 0x49, 0x7e, 0x1e, 0xfd, 0x8d, 0x49, 0xb6,
+	0xe1, 0x23, 0x45, 0xef, 0x78, 0x65, 0xcf, 0xa9, 0xcd, 0xf6, 0xe0, 0x01, 0x34, 0x67, 0x04, 0xcd,
+	0x49, 0x72, 0x2e, 0xe7, 0x93, 0x9e, 0xed, 0x0f, 0x13, 0xae, 0xd9, 0x8e, 0xaf, 0x90, 0xab, 0xb2,
+	0x75, 0xd4, 0x66, 0x7b, 0xf0, 0x28, 0xc7, 0x35, 0xfa, 0xbb, 0x22, 0x53, 0x76, 0x8a, 0xf5, 0xb7,
+	0x1f, 0xff, 0xb5, 0x32, 0xf0, 0xb3, 0x27, 0x95, 0x81, 0xc7, 0x4f, 0x2a, 0xe8, 0xe3, 0x27, 0x15,
+	0xf4, 0x97, 0x27, 0x15, 0xf4, 0xa3, 0xa7, 0x95, 0x81, 0x8f, 0x9f, 0x56, 0x06, 0xfe, 0xf8, 0xb4,
+	0x32, 0xf0, 0x4d, 0xbd, 0x65, 0x07, 0xab, 0xeb, 0xcb, 0x21, 0x13, 0x80, 0x9c, 0xf1, 0x56, 0x56,
+	0xec, 0x86, 0xcd, 0x9c, 0x28, 0x44, 0x14, 0x24, 0xd8, 0xf4, 0x2d, 0xbe, 0xbc, 0x5f, 0xfc, 0x29,
+	0xd2, 0xab, 0x9f, 0x0e, 0x00, 0x94, 0x9f, 0xb1, 0x1c, 0x28, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1177,6 +1676,12 @@ type QueryClient interface {
 	QueryGovTokenByApp(ctx context.Context, in *QueryGovTokenByAppRequest, opts ...grpc.CallOption) (*QueryGovTokenByAppResponse, error)
 	QueryAllExtendedPairStableVaultsByApp(ctx context.Context, in *QueryAllExtendedPairStableVaultsByAppRequest, opts ...grpc.CallOption) (*QueryAllExtendedPairStableVaultsByAppResponse, error)
 	QueryExtendedPairVaultsByAppWithoutStable(ctx context.Context, in *QueryExtendedPairVaultsByAppWithoutStableRequest, opts ...grpc.CallOption) (*QueryExtendedPairVaultsByAppWithoutStableResponse, error)
+	QueryStabilityFeeController(ctx context.Context, in *QueryStabilityFeeControllerRequest, opts ...grpc.CallOption) (*QueryStabilityFeeControllerResponse, error)
+	QueryStabilityFeeAdjustments(ctx context.Context, in *QueryStabilityFeeAdjustmentsRequest, opts ...grpc.CallOption) (*QueryStabilityFeeAdjustmentsResponse, error)
+	QueryVaultCollateralAssets(ctx context.Context, in *QueryVaultCollateralAssetsRequest, opts ...grpc.CallOption) (*QueryVaultCollateralAssetsResponse, error)
+	QueryDelistings(ctx context.Context, in *QueryDelistingsRequest, opts ...grpc.CallOption) (*QueryDelistingsResponse, error)
+	QueryPendingChanges(ctx context.Context, in *QueryPendingChangesRequest, opts ...grpc.CallOption) (*QueryPendingChangesResponse, error)
+	QueryTimelockDelays(ctx context.Context, in *QueryTimelockDelaysRequest, opts ...grpc.CallOption) (*QueryTimelockDelaysResponse, error)
 }
 
 type queryClient struct {
@@ -1304,10 +1809,64 @@ func (c *queryClient) QueryExtendedPairVaultsByAppWithoutStable(ctx context.Cont
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	QueryAssets(context.Context, *QueryAssetsRequest) (*QueryAssetsResponse, error)
-	QueryAsset(context.Context, *QueryAssetRequest) (*QueryAssetResponse, error)
+func (c *queryClient) QueryStabilityFeeController(ctx context.Context, in *QueryStabilityFeeControllerRequest, opts ...grpc.CallOption) (*QueryStabilityFeeControllerResponse, error) {
+	out := new(QueryStabilityFeeControllerResponse)
+	err := c.cc.Invoke(ctx, "/comdex.asset.v1beta1.Query/QueryStabilityFeeController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryStabilityFeeAdjustments(ctx context.Context, in *QueryStabilityFeeAdjustmentsRequest, opts ...grpc.CallOption) (*QueryStabilityFeeAdjustmentsResponse, error) {
+	out := new(QueryStabilityFeeAdjustmentsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.asset.v1beta1.Query/QueryStabilityFeeAdjustments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryVaultCollateralAssets(ctx context.Context, in *QueryVaultCollateralAssetsRequest, opts ...grpc.CallOption) (*QueryVaultCollateralAssetsResponse, error) {
+	out := new(QueryVaultCollateralAssetsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.asset.v1beta1.Query/QueryVaultCollateralAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDelistings(ctx context.Context, in *QueryDelistingsRequest, opts ...grpc.CallOption) (*QueryDelistingsResponse, error) {
+	out := new(QueryDelistingsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.asset.v1beta1.Query/QueryDelistings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPendingChanges(ctx context.Context, in *QueryPendingChangesRequest, opts ...grpc.CallOption) (*QueryPendingChangesResponse, error) {
+	out := new(QueryPendingChangesResponse)
+	err := c.cc.Invoke(ctx, "/comdex.asset.v1beta1.Query/QueryPendingChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryTimelockDelays(ctx context.Context, in *QueryTimelockDelaysRequest, opts ...grpc.CallOption) (*QueryTimelockDelaysResponse, error) {
+	out := new(QueryTimelockDelaysResponse)
+	err := c.cc.Invoke(ctx, "/comdex.asset.v1beta1.Query/QueryTimelockDelays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	QueryAssets(context.Context, *QueryAssetsRequest) (*QueryAssetsResponse, error)
+	QueryAsset(context.Context, *QueryAssetRequest) (*QueryAssetResponse, error)
 	QueryAssetPairs(context.Context, *QueryAssetPairsRequest) (*QueryAssetPairsResponse, error)
 	QueryAssetPair(context.Context, *QueryAssetPairRequest) (*QueryAssetPairResponse, error)
 	QueryApps(context.Context, *QueryAppsRequest) (*QueryAppsResponse, error)
@@ -1319,6 +1878,12 @@ type QueryServer interface {
 	QueryGovTokenByApp(context.Context, *QueryGovTokenByAppRequest) (*QueryGovTokenByAppResponse, error)
 	QueryAllExtendedPairStableVaultsByApp(context.Context, *QueryAllExtendedPairStableVaultsByAppRequest) (*QueryAllExtendedPairStableVaultsByAppResponse, error)
 	QueryExtendedPairVaultsByAppWithoutStable(context.Context, *QueryExtendedPairVaultsByAppWithoutStableRequest) (*QueryExtendedPairVaultsByAppWithoutStableResponse, error)
+	QueryStabilityFeeController(context.Context, *QueryStabilityFeeControllerRequest) (*QueryStabilityFeeControllerResponse, error)
+	QueryStabilityFeeAdjustments(context.Context, *QueryStabilityFeeAdjustmentsRequest) (*QueryStabilityFeeAdjustmentsResponse, error)
+	QueryVaultCollateralAssets(context.Context, *QueryVaultCollateralAssetsRequest) (*QueryVaultCollateralAssetsResponse, error)
+	QueryDelistings(context.Context, *QueryDelistingsRequest) (*QueryDelistingsResponse, error)
+	QueryPendingChanges(context.Context, *QueryPendingChangesRequest) (*QueryPendingChangesResponse, error)
+	QueryTimelockDelays(context.Context, *QueryTimelockDelaysRequest) (*QueryTimelockDelaysResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1364,6 +1929,24 @@ func (*UnimplementedQueryServer) QueryAllExtendedPairStableVaultsByApp(ctx conte
 func (*UnimplementedQueryServer) QueryExtendedPairVaultsByAppWithoutStable(ctx context.Context, req *QueryExtendedPairVaultsByAppWithoutStableRequest) (*QueryExtendedPairVaultsByAppWithoutStableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryExtendedPairVaultsByAppWithoutStable not implemented")
 }
+func (*UnimplementedQueryServer) QueryStabilityFeeController(ctx context.Context, req *QueryStabilityFeeControllerRequest) (*QueryStabilityFeeControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryStabilityFeeController not implemented")
+}
+func (*UnimplementedQueryServer) QueryStabilityFeeAdjustments(ctx context.Context, req *QueryStabilityFeeAdjustmentsRequest) (*QueryStabilityFeeAdjustmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryStabilityFeeAdjustments not implemented")
+}
+func (*UnimplementedQueryServer) QueryVaultCollateralAssets(ctx context.Context, req *QueryVaultCollateralAssetsRequest) (*QueryVaultCollateralAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVaultCollateralAssets not implemented")
+}
+func (*UnimplementedQueryServer) QueryDelistings(ctx context.Context, req *QueryDelistingsRequest) (*QueryDelistingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDelistings not implemented")
+}
+func (*UnimplementedQueryServer) QueryPendingChanges(ctx context.Context, req *QueryPendingChangesRequest) (*QueryPendingChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingChanges not implemented")
+}
+func (*UnimplementedQueryServer) QueryTimelockDelays(ctx context.Context, req *QueryTimelockDelaysRequest) (*QueryTimelockDelaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTimelockDelays not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1603,6 +2186,114 @@ func _Query_QueryExtendedPairVaultsByAppWithoutStable_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryStabilityFeeController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStabilityFeeControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryStabilityFeeController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.asset.v1beta1.Query/QueryStabilityFeeController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryStabilityFeeController(ctx, req.(*QueryStabilityFeeControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryStabilityFeeAdjustments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStabilityFeeAdjustmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryStabilityFeeAdjustments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.asset.v1beta1.Query/QueryStabilityFeeAdjustments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryStabilityFeeAdjustments(ctx, req.(*QueryStabilityFeeAdjustmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVaultCollateralAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultCollateralAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVaultCollateralAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.asset.v1beta1.Query/QueryVaultCollateralAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVaultCollateralAssets(ctx, req.(*QueryVaultCollateralAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDelistings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelistingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDelistings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.asset.v1beta1.Query/QueryDelistings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDelistings(ctx, req.(*QueryDelistingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPendingChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.asset.v1beta1.Query/QueryPendingChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingChanges(ctx, req.(*QueryPendingChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTimelockDelays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockDelaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTimelockDelays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.asset.v1beta1.Query/QueryTimelockDelays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTimelockDelays(ctx, req.(*QueryTimelockDelaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "comdex.asset.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1659,6 +2350,30 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryExtendedPairVaultsByAppWithoutStable",
 			Handler:    _Query_QueryExtendedPairVaultsByAppWithoutStable_Handler,
 		},
+		{
+			MethodName: "QueryStabilityFeeController",
+			Handler:    _Query_QueryStabilityFeeController_Handler,
+		},
+		{
+			MethodName: "QueryStabilityFeeAdjustments",
+			Handler:    _Query_QueryStabilityFeeAdjustments_Handler,
+		},
+		{
+			MethodName: "QueryVaultCollateralAssets",
+			Handler:    _Query_QueryVaultCollateralAssets_Handler,
+		},
+		{
+			MethodName: "QueryDelistings",
+			Handler:    _Query_QueryDelistings_Handler,
+		},
+		{
+			MethodName: "QueryPendingChanges",
+			Handler:    _Query_QueryPendingChanges_Handler,
+		},
+		{
+			MethodName: "QueryTimelockDelays",
+			Handler:    _Query_QueryTimelockDelays_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comdex/asset/v1beta1/query.proto",
@@ -1796,6 +2511,18 @@ func (m *QueryAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DenomTrace != nil {
+		{
+			size, err := m.DenomTrace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2462,20 +3189,20 @@ func (m *QueryAllExtendedPairStableVaultsIDByAppResponse) MarshalToSizedBuffer(d
 		dAtA[i] = 0x12
 	}
 	if len(m.ExtendedPairsId) > 0 {
-		dAtA18 := make([]byte, len(m.ExtendedPairsId)*10)
-		var j17 int
+		dAtA19 := make([]byte, len(m.ExtendedPairsId)*10)
+		var j18 int
 		for _, num := range m.ExtendedPairsId {
 			for num >= 1<<7 {
-				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j17++
+				j18++
 			}
-			dAtA18[j17] = uint8(num)
-			j17++
+			dAtA19[j18] = uint8(num)
+			j18++
 		}
-		i -= j17
-		copy(dAtA[i:], dAtA18[:j17])
-		i = encodeVarintQuery(dAtA, i, uint64(j17))
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintQuery(dAtA, i, uint64(j18))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2660,270 +3387,449 @@ func (m *QueryExtendedPairVaultsByAppWithoutStableResponse) MarshalToSizedBuffer
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryStabilityFeeControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAssetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryStabilityFeeControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStabilityFeeControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStabilityFeeControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStabilityFeeControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStabilityFeeControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Controller.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryStabilityFeeAdjustmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAssetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Asset.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryStabilityFeeAdjustmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAssetPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStabilityFeeAdjustmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAssetPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PairsInfo) > 0 {
-		for _, e := range m.PairsInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryStabilityFeeAdjustmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAssetPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PairInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryStabilityFeeAdjustmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAppRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStabilityFeeAdjustmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Adjustments) > 0 {
+		for iNdEx := len(m.Adjustments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Adjustments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAppResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVaultCollateralAssetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.App.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGovTokenByAppRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVaultCollateralAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultCollateralAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGovTokenByAppResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVaultCollateralAssetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultCollateralAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultCollateralAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GovAssetId != 0 {
-		n += 1 + sovQuery(uint64(m.GovAssetId))
+	if len(m.Collaterals) > 0 {
+		for iNdEx := len(m.Collaterals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collaterals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAppsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelistingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelistingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelistingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAppsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelistingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelistingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelistingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Apps) > 0 {
-		for _, e := range m.Apps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.PairDelistings) > 0 {
+		for iNdEx := len(m.PairDelistings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairDelistings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.AssetDelistings) > 0 {
+		for iNdEx := len(m.AssetDelistings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetDelistings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryExtendedPairVaultRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryPendingChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryExtendedPairVaultResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PairVault.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPendingChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllExtendedPairVaultsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPendingChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ParamClass) > 0 {
+		i -= len(m.ParamClass)
+		copy(dAtA[i:], m.ParamClass)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ParamClass)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllExtendedPairVaultsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPendingChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PairVault) > 0 {
-		for _, e := range m.PairVault {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.PendingChanges) > 0 {
+		for iNdEx := len(m.PendingChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockDelaysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllExtendedPairVaultsByAppRequest) Size() (n int) {
+func (m *QueryTimelockDelaysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockDelaysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockDelaysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockDelaysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockDelaysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delays) > 0 {
+		for iNdEx := len(m.Delays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAssetsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
-	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -2931,14 +3837,14 @@ func (m *QueryAllExtendedPairVaultsByAppRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllExtendedPairVaultsByAppResponse) Size() (n int) {
+func (m *QueryAssetsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ExtendedPair) > 0 {
-		for _, e := range m.ExtendedPair {
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2950,15 +3856,39 @@ func (m *QueryAllExtendedPairVaultsByAppResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllExtendedPairStableVaultsIDByAppRequest) Size() (n int) {
+func (m *QueryAssetRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DenomTrace != nil {
+		l = m.DenomTrace.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -2966,18 +3896,17 @@ func (m *QueryAllExtendedPairStableVaultsIDByAppRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllExtendedPairStableVaultsIDByAppResponse) Size() (n int) {
+func (m *QueryAssetPairsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ExtendedPairsId) > 0 {
-		l = 0
-		for _, e := range m.ExtendedPairsId {
-			l += sovQuery(uint64(e))
+	if len(m.PairsInfo) > 0 {
+		for _, e := range m.PairsInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		n += 1 + sovQuery(uint64(l)) + l
 	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
@@ -2986,7 +3915,53 @@ func (m *QueryAllExtendedPairStableVaultsIDByAppResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllExtendedPairStableVaultsByAppRequest) Size() (n int) {
+func (m *QueryAssetPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryAssetPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PairInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAppRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.App.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGovTokenByAppRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2995,6 +3970,27 @@ func (m *QueryAllExtendedPairStableVaultsByAppRequest) Size() (n int) {
 	if m.AppId != 0 {
 		n += 1 + sovQuery(uint64(m.AppId))
 	}
+	return n
+}
+
+func (m *QueryGovTokenByAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovAssetId != 0 {
+		n += 1 + sovQuery(uint64(m.GovAssetId))
+	}
+	return n
+}
+
+func (m *QueryAppsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -3002,14 +3998,14 @@ func (m *QueryAllExtendedPairStableVaultsByAppRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllExtendedPairStableVaultsByAppResponse) Size() (n int) {
+func (m *QueryAppsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ExtendedPair) > 0 {
-		for _, e := range m.ExtendedPair {
+	if len(m.Apps) > 0 {
+		for _, e := range m.Apps {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -3021,15 +4017,35 @@ func (m *QueryAllExtendedPairStableVaultsByAppResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryExtendedPairVaultsByAppWithoutStableRequest) Size() (n int) {
+func (m *QueryExtendedPairVaultRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryExtendedPairVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PairVault.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllExtendedPairVaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -3037,14 +4053,14 @@ func (m *QueryExtendedPairVaultsByAppWithoutStableRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryExtendedPairVaultsByAppWithoutStableResponse) Size() (n int) {
+func (m *QueryAllExtendedPairVaultsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ExtendedPair) > 0 {
-		for _, e := range m.ExtendedPair {
+	if len(m.PairVault) > 0 {
+		for _, e := range m.PairVault {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -3056,13 +4072,803 @@ func (m *QueryExtendedPairVaultsByAppWithoutStableResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryAllExtendedPairVaultsByAppRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllExtendedPairVaultsByAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExtendedPair) > 0 {
+		for _, e := range m.ExtendedPair {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllExtendedPairStableVaultsIDByAppRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllExtendedPairStableVaultsIDByAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExtendedPairsId) > 0 {
+		l = 0
+		for _, e := range m.ExtendedPairsId {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllExtendedPairStableVaultsByAppRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllExtendedPairStableVaultsByAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExtendedPair) > 0 {
+		for _, e := range m.ExtendedPair {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExtendedPairVaultsByAppWithoutStableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExtendedPairVaultsByAppWithoutStableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExtendedPair) > 0 {
+		for _, e := range m.ExtendedPair {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStabilityFeeControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovQuery(uint64(m.ExtendedPairVaultId))
+	}
+	return n
+}
+
+func (m *QueryStabilityFeeControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Controller.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStabilityFeeAdjustmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovQuery(uint64(m.ExtendedPairVaultId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStabilityFeeAdjustmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Adjustments) > 0 {
+		for _, e := range m.Adjustments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultCollateralAssetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovQuery(uint64(m.ExtendedPairVaultId))
+	}
+	return n
+}
+
+func (m *QueryVaultCollateralAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Collaterals) > 0 {
+		for _, e := range m.Collaterals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelistingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDelistingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AssetDelistings) > 0 {
+		for _, e := range m.AssetDelistings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PairDelistings) > 0 {
+		for _, e := range m.PairDelistings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParamClass)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingChanges) > 0 {
+		for _, e := range m.PendingChanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTimelockDelaysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTimelockDelaysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delays) > 0 {
+		for _, e := range m.Delays {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAssetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, Asset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTrace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DenomTrace == nil {
+				m.DenomTrace = &AssetDenomTrace{}
+			}
+			if err := m.DenomTrace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryAssetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAssetPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3085,13 +4891,47 @@ func (m *QueryAssetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssetPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssetPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairsInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairsInfo = append(m.PairsInfo, PairInfo{})
+			if err := m.PairsInfo[len(m.PairsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3121,7 +4961,7 @@ func (m *QueryAssetsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3148,7 +4988,7 @@ func (m *QueryAssetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAssetPairRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3171,15 +5011,84 @@ func (m *QueryAssetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssetPairRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssetPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3206,14 +5115,132 @@ func (m *QueryAssetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, Asset{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PairInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3238,12 +5265,9 @@ func (m *QueryAssetsResponse) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3268,7 +5292,7 @@ func (m *QueryAssetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGovTokenByAppRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3291,17 +5315,17 @@ func (m *QueryAssetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGovTokenByAppRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGovTokenByAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			m.Id = 0
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3311,7 +5335,7 @@ func (m *QueryAssetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3337,7 +5361,7 @@ func (m *QueryAssetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGovTokenByAppResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3360,17 +5384,17 @@ func (m *QueryAssetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGovTokenByAppResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGovTokenByAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovAssetId", wireType)
 			}
-			var msglen int
+			m.GovAssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3380,25 +5404,11 @@ func (m *QueryAssetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GovAssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3420,7 +5430,7 @@ func (m *QueryAssetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAppsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3443,10 +5453,10 @@ func (m *QueryAssetPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3506,7 +5516,7 @@ func (m *QueryAssetPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAppsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3529,15 +5539,15 @@ func (m *QueryAssetPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairsInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3564,8 +5574,8 @@ func (m *QueryAssetPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairsInfo = append(m.PairsInfo, PairInfo{})
-			if err := m.PairsInfo[len(m.PairsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Apps = append(m.Apps, AppData{})
+			if err := m.Apps[len(m.Apps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3626,7 +5636,7 @@ func (m *QueryAssetPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExtendedPairVaultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3649,10 +5659,10 @@ func (m *QueryAssetPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExtendedPairVaultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExtendedPairVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3695,7 +5705,7 @@ func (m *QueryAssetPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetPairResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExtendedPairVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3718,15 +5728,15 @@ func (m *QueryAssetPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExtendedPairVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExtendedPairVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairVault", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3753,7 +5763,7 @@ func (m *QueryAssetPairResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PairInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PairVault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3778,7 +5788,7 @@ func (m *QueryAssetPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAppRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllExtendedPairVaultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3801,17 +5811,17 @@ func (m *QueryAppRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAppRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllExtendedPairVaultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllExtendedPairVaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3821,11 +5831,28 @@ func (m *QueryAppRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3847,7 +5874,7 @@ func (m *QueryAppRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAppResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllExtendedPairVaultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3870,15 +5897,15 @@ func (m *QueryAppResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAppResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllExtendedPairVaultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllExtendedPairVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairVault", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3905,7 +5932,44 @@ func (m *QueryAppResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PairVault = append(m.PairVault, ExtendedPairVault{})
+			if err := m.PairVault[len(m.PairVault)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3930,7 +5994,7 @@ func (m *QueryAppResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGovTokenByAppRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllExtendedPairVaultsByAppRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3953,10 +6017,10 @@ func (m *QueryGovTokenByAppRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGovTokenByAppRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllExtendedPairVaultsByAppRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGovTokenByAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllExtendedPairVaultsByAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3978,6 +6042,42 @@ func (m *QueryGovTokenByAppRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3999,7 +6099,7 @@ func (m *QueryGovTokenByAppRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGovTokenByAppResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllExtendedPairVaultsByAppResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4022,17 +6122,17 @@ func (m *QueryGovTokenByAppResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGovTokenByAppResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllExtendedPairVaultsByAppResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGovTokenByAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllExtendedPairVaultsByAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovAssetId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPair", wireType)
 			}
-			m.GovAssetId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4042,11 +6142,62 @@ func (m *QueryGovTokenByAppResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GovAssetId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedPair = append(m.ExtendedPair, ExtendedPairVault{})
+			if err := m.ExtendedPair[len(m.ExtendedPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4068,7 +6219,7 @@ func (m *QueryGovTokenByAppResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAppsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllExtendedPairStableVaultsIDByAppRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4091,13 +6242,32 @@ func (m *QueryAppsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAppsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsIDByAppRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAppsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsIDByAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4154,7 +6324,7 @@ func (m *QueryAppsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAppsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllExtendedPairStableVaultsIDByAppResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4177,46 +6347,88 @@ func (m *QueryAppsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAppsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsIDByAppResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAppsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsIDByAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apps", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.ExtendedPairsId = append(m.ExtendedPairsId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExtendedPairsId) == 0 {
+					m.ExtendedPairsId = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExtendedPairsId = append(m.ExtendedPairsId, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairsId", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Apps = append(m.Apps, AppData{})
-			if err := m.Apps[len(m.Apps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -4274,7 +6486,7 @@ func (m *QueryAppsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExtendedPairVaultRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllExtendedPairStableVaultsByAppRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4297,17 +6509,17 @@ func (m *QueryExtendedPairVaultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExtendedPairVaultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsByAppRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExtendedPairVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsByAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			m.Id = 0
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4317,11 +6529,47 @@ func (m *QueryExtendedPairVaultRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4343,7 +6591,7 @@ func (m *QueryExtendedPairVaultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExtendedPairVaultResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllExtendedPairStableVaultsByAppResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4366,15 +6614,15 @@ func (m *QueryExtendedPairVaultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExtendedPairVaultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsByAppResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExtendedPairVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsByAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairVault", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4401,7 +6649,44 @@ func (m *QueryExtendedPairVaultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PairVault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExtendedPair = append(m.ExtendedPair, ExtendedPairVault{})
+			if err := m.ExtendedPair[len(m.ExtendedPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4426,7 +6711,7 @@ func (m *QueryExtendedPairVaultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllExtendedPairVaultsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExtendedPairVaultsByAppWithoutStableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4445,17 +6730,36 @@ func (m *QueryAllExtendedPairVaultsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllExtendedPairVaultsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllExtendedPairVaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExtendedPairVaultsByAppWithoutStableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExtendedPairVaultsByAppWithoutStableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4512,7 +6816,7 @@ func (m *QueryAllExtendedPairVaultsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllExtendedPairVaultsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExtendedPairVaultsByAppWithoutStableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4535,15 +6839,15 @@ func (m *QueryAllExtendedPairVaultsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllExtendedPairVaultsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExtendedPairVaultsByAppWithoutStableResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllExtendedPairVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExtendedPairVaultsByAppWithoutStableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairVault", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4570,8 +6874,8 @@ func (m *QueryAllExtendedPairVaultsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairVault = append(m.PairVault, ExtendedPairVault{})
-			if err := m.PairVault[len(m.PairVault)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExtendedPair = append(m.ExtendedPair, ExtendedPairVault{})
+			if err := m.ExtendedPair[len(m.ExtendedPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4632,7 +6936,7 @@ func (m *QueryAllExtendedPairVaultsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllExtendedPairVaultsByAppRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStabilityFeeControllerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4655,36 +6959,17 @@ func (m *QueryAllExtendedPairVaultsByAppRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllExtendedPairVaultsByAppRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStabilityFeeControllerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllExtendedPairVaultsByAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStabilityFeeControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
-			}
-			m.AppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
+			m.ExtendedPairVaultId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4694,28 +6979,11 @@ func (m *QueryAllExtendedPairVaultsByAppRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4737,7 +7005,7 @@ func (m *QueryAllExtendedPairVaultsByAppRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllExtendedPairVaultsByAppResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStabilityFeeControllerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4760,15 +7028,15 @@ func (m *QueryAllExtendedPairVaultsByAppResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllExtendedPairVaultsByAppResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStabilityFeeControllerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllExtendedPairVaultsByAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStabilityFeeControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4795,14 +7063,13 @@ func (m *QueryAllExtendedPairVaultsByAppResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtendedPair = append(m.ExtendedPair, ExtendedPairVault{})
-			if err := m.ExtendedPair[len(m.ExtendedPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Controller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4829,10 +7096,7 @@ func (m *QueryAllExtendedPairVaultsByAppResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4857,7 +7121,7 @@ func (m *QueryAllExtendedPairVaultsByAppResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllExtendedPairStableVaultsIDByAppRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStabilityFeeAdjustmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4880,17 +7144,17 @@ func (m *QueryAllExtendedPairStableVaultsIDByAppRequest) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsIDByAppRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStabilityFeeAdjustmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsIDByAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStabilityFeeAdjustmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
 			}
-			m.AppId = 0
+			m.ExtendedPairVaultId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4900,7 +7164,7 @@ func (m *QueryAllExtendedPairStableVaultsIDByAppRequest) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4962,7 +7226,7 @@ func (m *QueryAllExtendedPairStableVaultsIDByAppRequest) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *QueryAllExtendedPairStableVaultsIDByAppResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStabilityFeeAdjustmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4985,93 +7249,137 @@ func (m *QueryAllExtendedPairStableVaultsIDByAppResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsIDByAppResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStabilityFeeAdjustmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsIDByAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStabilityFeeAdjustmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ExtendedPairsId = append(m.ExtendedPairsId, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adjustments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ExtendedPairsId) == 0 {
-					m.ExtendedPairsId = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ExtendedPairsId = append(m.ExtendedPairsId, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairsId", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Adjustments = append(m.Adjustments, StabilityFeeAdjustment{})
+			if err := m.Adjustments[len(m.Adjustments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultCollateralAssetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultCollateralAssetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultCollateralAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5081,28 +7389,11 @@ func (m *QueryAllExtendedPairStableVaultsIDByAppResponse) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5124,7 +7415,7 @@ func (m *QueryAllExtendedPairStableVaultsIDByAppResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *QueryAllExtendedPairStableVaultsByAppRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultCollateralAssetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5147,34 +7438,15 @@ func (m *QueryAllExtendedPairStableVaultsByAppRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsByAppRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultCollateralAssetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsByAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultCollateralAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
-			}
-			m.AppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collaterals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5201,10 +7473,8 @@ func (m *QueryAllExtendedPairStableVaultsByAppRequest) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Collaterals = append(m.Collaterals, VaultCollateralAsset{})
+			if err := m.Collaterals[len(m.Collaterals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5229,7 +7499,7 @@ func (m *QueryAllExtendedPairStableVaultsByAppRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryAllExtendedPairStableVaultsByAppResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelistingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5252,15 +7522,65 @@ func (m *QueryAllExtendedPairStableVaultsByAppResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsByAppResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelistingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllExtendedPairStableVaultsByAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelistingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelistingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelistingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelistingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDelistings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5287,14 +7607,14 @@ func (m *QueryAllExtendedPairStableVaultsByAppResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtendedPair = append(m.ExtendedPair, ExtendedPairVault{})
-			if err := m.ExtendedPair[len(m.ExtendedPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AssetDelistings = append(m.AssetDelistings, Delisting{})
+			if err := m.AssetDelistings[len(m.AssetDelistings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairDelistings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5321,10 +7641,8 @@ func (m *QueryAllExtendedPairStableVaultsByAppResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PairDelistings = append(m.PairDelistings, Delisting{})
+			if err := m.PairDelistings[len(m.PairDelistings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5349,7 +7667,7 @@ func (m *QueryAllExtendedPairStableVaultsByAppResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryExtendedPairVaultsByAppWithoutStableRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5372,36 +7690,17 @@ func (m *QueryExtendedPairVaultsByAppWithoutStableRequest) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExtendedPairVaultsByAppWithoutStableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExtendedPairVaultsByAppWithoutStableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
-			}
-			m.AppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamClass", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5411,27 +7710,23 @@ func (m *QueryExtendedPairVaultsByAppWithoutStableRequest) Unmarshal(dAtA []byte
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ParamClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5454,7 +7749,7 @@ func (m *QueryExtendedPairVaultsByAppWithoutStableRequest) Unmarshal(dAtA []byte
 	}
 	return nil
 }
-func (m *QueryExtendedPairVaultsByAppWithoutStableResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5477,15 +7772,15 @@ func (m *QueryExtendedPairVaultsByAppWithoutStableResponse) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExtendedPairVaultsByAppWithoutStableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExtendedPairVaultsByAppWithoutStableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5512,14 +7807,114 @@ func (m *QueryExtendedPairVaultsByAppWithoutStableResponse) Unmarshal(dAtA []byt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtendedPair = append(m.ExtendedPair, ExtendedPairVault{})
-			if err := m.ExtendedPair[len(m.ExtendedPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingChanges = append(m.PendingChanges, PendingChange{})
+			if err := m.PendingChanges[len(m.PendingChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimelockDelaysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimelockDelaysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimelockDelaysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimelockDelaysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimelockDelaysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimelockDelaysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delays", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5546,10 +7941,8 @@ func (m *QueryExtendedPairVaultsByAppWithoutStableResponse) Unmarshal(dAtA []byt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delays = append(m.Delays, TimelockDelay{})
+			if err := m.Delays[len(m.Delays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
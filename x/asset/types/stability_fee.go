@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (m StabilityFeeController) Validate() error {
+	if m.ExtendedPairVaultId == 0 {
+		return fmt.Errorf("extended_pair_vault_id cannot be zero")
+	}
+	if m.TargetPrice.IsNil() || !m.TargetPrice.IsPositive() {
+		return fmt.Errorf("target_price must be positive")
+	}
+	if m.DeadBand.IsNil() || m.DeadBand.IsNegative() || m.DeadBand.GTE(sdk.OneDec()) {
+		return fmt.Errorf("dead_band must be between 0 and 1")
+	}
+	// The fee is kept positive: a zero fee stops interest accrual altogether,
+	// which is only done by updating the extended pair vault.
+	if m.MinFee.IsNil() || !m.MinFee.IsPositive() {
+		return fmt.Errorf("min_fee must be positive")
+	}
+	if m.MaxFee.IsNil() || m.MaxFee.LT(m.MinFee) || m.MaxFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max_fee must be between min_fee and 1")
+	}
+	if m.Step.IsNil() || !m.Step.IsPositive() {
+		return fmt.Errorf("step must be positive")
+	}
+	if m.EpochDuration <= 0 {
+		return fmt.Errorf("epoch_duration must be positive")
+	}
+	return nil
+}
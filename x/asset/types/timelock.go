@@ -0,0 +1,88 @@
+package types
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+)
+
+// Parameter classes whose updates are timelocked.
+const (
+	ParamClassExtendedPairVault    = "extended_pair_vault"
+	ParamClassCollectorLookupTable = "collector_lookup_table"
+	ParamClassAssetRatesParams     = "asset_rates_params"
+	ParamClassAuctionParams        = "auction_params"
+	ParamClassLendAuctionParams    = "lend_auction_params"
+)
+
+// DefaultTimelockDelay is the delay of parameter classes governance has not
+// set one for.
+const DefaultTimelockDelay = 48 * time.Hour
+
+// ParamClasses lists the timelocked parameter classes.
+func ParamClasses() []string {
+	return []string{
+		ParamClassExtendedPairVault,
+		ParamClassCollectorLookupTable,
+		ParamClassAssetRatesParams,
+		ParamClassAuctionParams,
+		ParamClassLendAuctionParams,
+	}
+}
+
+func IsParamClass(paramClass string) bool {
+	for _, class := range ParamClasses() {
+		if class == paramClass {
+			return true
+		}
+	}
+	return false
+}
+
+// RiskParameter is a record of a timelocked parameter class, modules
+// register the records they timelock as implementations.
+type RiskParameter interface {
+	proto.Message
+}
+
+var _ codectypes.UnpackInterfacesMessage = PendingChange{}
+
+func NewPendingChange(id uint64, paramClass string, change RiskParameter, scheduledTime time.Time, delay time.Duration) (PendingChange, error) {
+	value, err := codectypes.NewAnyWithValue(change)
+	if err != nil {
+		return PendingChange{}, err
+	}
+	return PendingChange{
+		Id:             id,
+		ParamClass:     paramClass,
+		Change:         value,
+		ScheduledTime:  scheduledTime,
+		ActivationTime: scheduledTime.Add(delay),
+	}, nil
+}
+
+// GetChange returns the record the change applies.
+func (m PendingChange) GetChange() (RiskParameter, bool) {
+	if m.Change == nil {
+		return nil, false
+	}
+	change, ok := m.Change.GetCachedValue().(RiskParameter)
+	return change, ok
+}
+
+func (m PendingChange) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var change RiskParameter
+	return unpacker.UnpackAny(m.Change, &change)
+}
+
+func (m TimelockDelay) Validate() error {
+	if !IsParamClass(m.ParamClass) {
+		return errors.Wrapf(ErrorInvalidTimelockDelay, "unknown param class %s", m.ParamClass)
+	}
+	if m.Delay < 0 {
+		return errors.Wrap(ErrorInvalidTimelockDelay, "delay cannot be negative")
+	}
+	return nil
+}
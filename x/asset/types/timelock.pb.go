@@ -0,0 +1,714 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: comdex/asset/v1beta1/timelock.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingChange is an update of a risk parameter record, applied once the
+// timelock of its parameter class has passed unless governance cancels it.
+type PendingChange struct {
+	Id             uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	ParamClass     string     `protobuf:"bytes,2,opt,name=param_class,json=paramClass,proto3" json:"param_class,omitempty" yaml:"param_class"`
+	Change         *types.Any `protobuf:"bytes,3,opt,name=change,proto3" json:"change,omitempty" yaml:"change"`
+	ScheduledTime  time.Time  `protobuf:"bytes,4,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time" yaml:"scheduled_time"`
+	ActivationTime time.Time  `protobuf:"bytes,5,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time" yaml:"activation_time"`
+}
+
+func (m *PendingChange) Reset()         { *m = PendingChange{} }
+func (m *PendingChange) String() string { return proto.CompactTextString(m) }
+func (*PendingChange) ProtoMessage()    {}
+func (*PendingChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8625b8ac60a74082, []int{0}
+}
+func (m *PendingChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChange.Merge(m, src)
+}
+func (m *PendingChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChange proto.InternalMessageInfo
+
+// TimelockDelay is the minimum delay between scheduling and applying the
+// changes of a parameter class.
+type TimelockDelay struct {
+	ParamClass string        `protobuf:"bytes,1,opt,name=param_class,json=paramClass,proto3" json:"param_class,omitempty" yaml:"param_class"`
+	Delay      time.Duration `protobuf:"bytes,2,opt,name=delay,proto3,stdduration" json:"delay" yaml:"delay"`
+}
+
+func (m *TimelockDelay) Reset()         { *m = TimelockDelay{} }
+func (m *TimelockDelay) String() string { return proto.CompactTextString(m) }
+func (*TimelockDelay) ProtoMessage()    {}
+func (*TimelockDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8625b8ac60a74082, []int{1}
+}
+func (m *TimelockDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimelockDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockDelay.Merge(m, src)
+}
+func (m *TimelockDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockDelay proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*PendingChange)(nil), "comdex.asset.v1beta1.PendingChange")
+	proto.RegisterType((*TimelockDelay)(nil), "comdex.asset.v1beta1.TimelockDelay")
+}
+
+func init() {
+	proto.RegisterFile("comdex/asset/v1beta1/timelock.proto", fileDescriptor_8625b8ac60a74082)
+}
+
+var fileDescriptor_8625b8ac60a74082 = []byte{
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x6e, 0xd3, 0x40,
+	0x1c, 0xf7, 0x85, 0xb6, 0x52, 0x2f, 0xb8, 0x08, 0x2b, 0x54, 0x6e, 0x24, 0xce, 0xe1, 0x58, 0xb2,
+	0xe0, 0x53, 0xcb, 0x80, 0xc4, 0x82, 0x70, 0xbb, 0xb0, 0x41, 0xd4, 0x89, 0xa5, 0xba, 0xf8, 0x2e,
+	0xce, 0x09, 0xdb, 0x17, 0xc5, 0x97, 0x0a, 0xbf, 0x45, 0x25, 0x16, 0x1e, 0x81, 0x47, 0xc9, 0xd8,
+	0x91, 0xc9, 0x40, 0xf2, 0x06, 0x59, 0x58, 0xd1, 0x7d, 0x84, 0x0f, 0x67, 0xa0, 0x9b, 0xff, 0xf7,
+	0xfb, 0xbc, 0xf3, 0x1f, 0x3e, 0x4d, 0x65, 0xc1, 0xf8, 0x47, 0x42, 0xab, 0x8a, 0x2b, 0x72, 0x7d,
+	0x3a, 0xe6, 0x8a, 0x9e, 0x12, 0x25, 0x0a, 0x9e, 0xcb, 0xf4, 0x43, 0x3c, 0x9b, 0x4b, 0x25, 0x83,
+	0x9e, 0x25, 0xc5, 0x86, 0x14, 0x3b, 0x52, 0xbf, 0x97, 0xc9, 0x4c, 0x1a, 0x02, 0xd1, 0x5f, 0x96,
+	0xdb, 0x3f, 0xc9, 0xa4, 0xcc, 0x72, 0x4e, 0xcc, 0x34, 0x5e, 0x4c, 0x08, 0x2d, 0x6b, 0x07, 0xa1,
+	0x36, 0xc4, 0x16, 0x73, 0xaa, 0x84, 0x2c, 0x1d, 0x1e, 0xb5, 0x71, 0x5d, 0xa3, 0x52, 0xb4, 0x98,
+	0x59, 0x02, 0xfe, 0xd9, 0x81, 0xfe, 0x5b, 0x5e, 0x32, 0x51, 0x66, 0xe7, 0x53, 0x5a, 0x66, 0x3c,
+	0x78, 0x0c, 0x3b, 0x82, 0x85, 0x60, 0x00, 0x86, 0x7b, 0x89, 0xbf, 0x69, 0xa2, 0xc3, 0x9a, 0x16,
+	0xf9, 0x4b, 0x2c, 0x18, 0x1e, 0x75, 0x04, 0x0b, 0x5e, 0xc0, 0xee, 0x8c, 0xce, 0x69, 0x71, 0x95,
+	0xe6, 0xb4, 0xaa, 0xc2, 0xce, 0x00, 0x0c, 0x0f, 0x93, 0xe3, 0x4d, 0x13, 0x05, 0x96, 0xf7, 0x17,
+	0x88, 0x47, 0xd0, 0x4c, 0xe7, 0x7a, 0x08, 0x5e, 0xc1, 0x83, 0xd4, 0x24, 0x84, 0xf7, 0x06, 0x60,
+	0xd8, 0x3d, 0xeb, 0xc5, 0xb6, 0x5b, 0xbc, 0xed, 0x16, 0xbf, 0x2e, 0xeb, 0xe4, 0xe1, 0xa6, 0x89,
+	0x7c, 0xeb, 0x64, 0xd9, 0x78, 0xe4, 0x64, 0x01, 0x83, 0x47, 0x55, 0x3a, 0xe5, 0x6c, 0x91, 0x73,
+	0x76, 0xa5, 0xef, 0x11, 0xee, 0x19, 0xa3, 0xfe, 0x8e, 0xd1, 0xe5, 0xf6, 0x92, 0xc9, 0x93, 0x65,
+	0x13, 0x79, 0x9b, 0x26, 0x7a, 0x64, 0x2d, 0xff, 0xd5, 0xe3, 0x9b, 0x6f, 0x11, 0x18, 0xf9, 0xbf,
+	0x0f, 0xb5, 0x2c, 0xc8, 0xe0, 0x03, 0x9a, 0x2a, 0x71, 0x6d, 0x5e, 0xd1, 0xc6, 0xec, 0xff, 0x37,
+	0x06, 0xbb, 0x98, 0x63, 0x1b, 0xd3, 0x32, 0xb0, 0x39, 0x47, 0x7f, 0x4e, 0xb5, 0x10, 0x7f, 0x02,
+	0xd0, 0xbf, 0x74, 0x4b, 0x71, 0xc1, 0x73, 0x5a, 0xb7, 0x9f, 0x16, 0xdc, 0xf9, 0x69, 0xdf, 0xc0,
+	0x7d, 0xa6, 0x1d, 0xcc, 0xdf, 0xe8, 0x9e, 0x9d, 0xec, 0x34, 0xbd, 0x70, 0x5b, 0x91, 0x84, 0xae,
+	0xe8, 0x7d, 0xeb, 0x68, 0x54, 0xf8, 0xb3, 0xae, 0x67, 0x1d, 0x92, 0x77, 0xcb, 0x1f, 0xc8, 0xfb,
+	0xb2, 0x42, 0xde, 0x72, 0x85, 0xc0, 0xed, 0x0a, 0x81, 0xef, 0x2b, 0x04, 0x6e, 0xd6, 0xc8, 0xbb,
+	0x5d, 0x23, 0xef, 0xeb, 0x1a, 0x79, 0xef, 0x49, 0x26, 0xd4, 0x74, 0x31, 0x8e, 0x53, 0x59, 0x10,
+	0xbb, 0xc4, 0xcf, 0xe4, 0x64, 0x22, 0x52, 0x41, 0x73, 0x37, 0x93, 0xed, 0xee, 0xab, 0x7a, 0xc6,
+	0xab, 0xf1, 0x81, 0xa9, 0xf1, 0xfc, 0xd7, 0x00, 0x57, 0x53, 0xff, 0x65, 0x18, 0x03, 0x00, 0x00,
+}
+
+func (m *PendingChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTimelock(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ScheduledTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTimelock(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Change != nil {
+		{
+			size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ParamClass) > 0 {
+		i -= len(m.ParamClass)
+		copy(dAtA[i:], m.ParamClass)
+		i = encodeVarintTimelock(dAtA, i, uint64(len(m.ParamClass)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTimelock(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ParamClass) > 0 {
+		i -= len(m.ParamClass)
+		copy(dAtA[i:], m.ParamClass)
+		i = encodeVarintTimelock(dAtA, i, uint64(len(m.ParamClass)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTimelock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTimelock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTimelock(uint64(m.Id))
+	}
+	l = len(m.ParamClass)
+	if l > 0 {
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	if m.Change != nil {
+		l = m.Change.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ScheduledTime)
+	n += 1 + l + sovTimelock(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovTimelock(uint64(l))
+	return n
+}
+
+func (m *TimelockDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParamClass)
+	if l > 0 {
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovTimelock(uint64(l))
+	return n
+}
+
+func sovTimelock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTimelock(x uint64) (n int) {
+	return sovTimelock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Change == nil {
+				m.Change = &types.Any{}
+			}
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimelock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimelockDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimelock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTimelock(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTimelock
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTimelock
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTimelock
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTimelock        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTimelock          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTimelock = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = (*MsgSetAppRoles)(nil)
+
+func NewMsgSetAppRoles(from sdk.AccAddress, appID uint64, roles AppRoles) *MsgSetAppRoles {
+	return &MsgSetAppRoles{
+		From:  from.String(),
+		AppId: appID,
+		Roles: roles,
+	}
+}
+
+func (msg MsgSetAppRoles) Route() string { return RouterKey }
+
+func (msg MsgSetAppRoles) Type() string { return "set_app_roles" }
+
+func (msg *MsgSetAppRoles) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return sdkerrors.Wrapf(ErrorInvalidFrom, "%s", err)
+	}
+	if msg.AppId == 0 {
+		return sdkerrors.Wrap(ErrorInvalidID, "app id cannot be zero")
+	}
+
+	return msg.Roles.Validate()
+}
+
+func (msg *MsgSetAppRoles) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes get the bytes for the message signer to sign on.
+func (msg *MsgSetAppRoles) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
@@ -0,0 +1,598 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: comdex/asset/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetAppRoles rotates the roles of an app, signed by its admin.
+type MsgSetAppRoles struct {
+	From  string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	AppId uint64   `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	Roles AppRoles `protobuf:"bytes,3,opt,name=roles,proto3" json:"roles" yaml:"roles"`
+}
+
+func (m *MsgSetAppRoles) Reset()         { *m = MsgSetAppRoles{} }
+func (m *MsgSetAppRoles) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAppRoles) ProtoMessage()    {}
+func (*MsgSetAppRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78d9fd76d8e93e29, []int{0}
+}
+func (m *MsgSetAppRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAppRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAppRoles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAppRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAppRoles.Merge(m, src)
+}
+func (m *MsgSetAppRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAppRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAppRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAppRoles proto.InternalMessageInfo
+
+type MsgSetAppRolesResponse struct {
+}
+
+func (m *MsgSetAppRolesResponse) Reset()         { *m = MsgSetAppRolesResponse{} }
+func (m *MsgSetAppRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAppRolesResponse) ProtoMessage()    {}
+func (*MsgSetAppRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78d9fd76d8e93e29, []int{1}
+}
+func (m *MsgSetAppRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAppRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAppRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAppRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAppRolesResponse.Merge(m, src)
+}
+func (m *MsgSetAppRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAppRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAppRolesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAppRolesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetAppRoles)(nil), "comdex.asset.v1beta1.MsgSetAppRoles")
+	proto.RegisterType((*MsgSetAppRolesResponse)(nil), "comdex.asset.v1beta1.MsgSetAppRolesResponse")
+}
+
+func init() { proto.RegisterFile("comdex/asset/v1beta1/tx.proto", fileDescriptor_78d9fd76d8e93e29) }
+
+var fileDescriptor_78d9fd76d8e93e29 = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x4d, 0x4b, 0xc3, 0x30,
+	0x18, 0xc7, 0x13, 0xf7, 0x02, 0x66, 0xbe, 0x60, 0x19, 0x52, 0x06, 0xa6, 0x23, 0x7a, 0xe8, 0x41,
+	0x1b, 0x36, 0x6f, 0xde, 0xec, 0x4d, 0x61, 0x07, 0xeb, 0xcd, 0x8b, 0x64, 0x5b, 0xd6, 0x15, 0x36,
+	0x13, 0x96, 0x28, 0xdb, 0xb7, 0xf0, 0x63, 0x08, 0x7e, 0x91, 0x1d, 0x77, 0xf4, 0x54, 0xb4, 0xfd,
+	0x06, 0xfd, 0x04, 0xd2, 0x66, 0x05, 0x07, 0x3d, 0x78, 0x4b, 0x9e, 0xdf, 0x3f, 0xbf, 0xe7, 0x09,
+	0x0f, 0x3a, 0x1b, 0x89, 0xf9, 0x98, 0x2f, 0x29, 0x53, 0x8a, 0x6b, 0xfa, 0xd6, 0x1b, 0x72, 0xcd,
+	0x7a, 0x54, 0x2f, 0x3d, 0xb9, 0x10, 0x5a, 0x58, 0x6d, 0x83, 0xbd, 0x02, 0x7b, 0x5b, 0xdc, 0x69,
+	0x87, 0x22, 0x14, 0x45, 0x80, 0xe6, 0x27, 0x93, 0xed, 0xe0, 0x4a, 0x15, 0x93, 0xd2, 0x70, 0xf2,
+	0x09, 0xd1, 0xd1, 0x40, 0x85, 0x8f, 0x5c, 0xdf, 0x4a, 0x19, 0x88, 0x19, 0x57, 0xd6, 0x39, 0xaa,
+	0x4f, 0x16, 0x62, 0x6e, 0xc3, 0x2e, 0x74, 0xf7, 0xfd, 0xe3, 0x2c, 0x76, 0x5a, 0x2b, 0x36, 0x9f,
+	0xdd, 0x90, 0xbc, 0x4a, 0x82, 0x02, 0x5a, 0x2e, 0x6a, 0x32, 0x29, 0x9f, 0xa3, 0xb1, 0xbd, 0xd7,
+	0x85, 0x6e, 0xdd, 0x3f, 0xc9, 0x62, 0xe7, 0xd0, 0xc4, 0x4c, 0x9d, 0x04, 0x0d, 0x26, 0xe5, 0xdd,
+	0xd8, 0xba, 0x47, 0x8d, 0x45, 0xee, 0xb5, 0x6b, 0x5d, 0xe8, 0xb6, 0xfa, 0xd8, 0xab, 0x9a, 0xde,
+	0x2b, 0xbb, 0xfb, 0xed, 0x75, 0xec, 0x80, 0x2c, 0x76, 0x0e, 0x8c, 0xac, 0x78, 0x4a, 0x02, 0xa3,
+	0x20, 0x36, 0x3a, 0xdd, 0x1d, 0x36, 0xe0, 0x4a, 0x8a, 0x17, 0xc5, 0xfb, 0x53, 0x54, 0x1b, 0xa8,
+	0xd0, 0x62, 0xa8, 0xf5, 0xf7, 0x2b, 0x17, 0xd5, 0xcd, 0x76, 0x1d, 0x9d, 0xcb, 0xff, 0xa4, 0xca,
+	0x4e, 0xfe, 0xc3, 0xfa, 0x07, 0x83, 0x8f, 0x04, 0x83, 0x75, 0x82, 0xe1, 0x26, 0xc1, 0xf0, 0x3b,
+	0xc1, 0xf0, 0x3d, 0xc5, 0x60, 0x93, 0x62, 0xf0, 0x95, 0x62, 0xf0, 0x44, 0xc3, 0x48, 0x4f, 0x5f,
+	0x87, 0xb9, 0x95, 0x1a, 0xf3, 0x95, 0x98, 0x4c, 0xa2, 0x51, 0xc4, 0x66, 0xdb, 0x3b, 0x2d, 0x17,
+	0xa2, 0x57, 0x92, 0xab, 0x61, 0xb3, 0xd8, 0xc5, 0xf5, 0xef, 0x00, 0x3a, 0x04, 0x8c, 0x45, 0xf8,
+	0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SetAppRoles(ctx context.Context, in *MsgSetAppRoles, opts ...grpc.CallOption) (*MsgSetAppRolesResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetAppRoles(ctx context.Context, in *MsgSetAppRoles, opts ...grpc.CallOption) (*MsgSetAppRolesResponse, error) {
+	out := new(MsgSetAppRolesResponse)
+	err := c.cc.Invoke(ctx, "/comdex.asset.v1beta1.Msg/SetAppRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SetAppRoles(context.Context, *MsgSetAppRoles) (*MsgSetAppRolesResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetAppRoles(ctx context.Context, req *MsgSetAppRoles) (*MsgSetAppRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppRoles not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetAppRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAppRoles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAppRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.asset.v1beta1.Msg/SetAppRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAppRoles(ctx, req.(*MsgSetAppRoles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "comdex.asset.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetAppRoles",
+			Handler:    _Msg_SetAppRoles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comdex/asset/v1beta1/tx.proto",
+}
+
+func (m *MsgSetAppRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAppRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAppRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Roles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAppRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAppRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAppRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetAppRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	l = m.Roles.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAppRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetAppRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAppRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAppRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Roles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAppRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAppRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAppRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (m VaultCollateralAsset) Validate() error {
+	if m.AppId == 0 {
+		return fmt.Errorf("app_id cannot be zero")
+	}
+	if m.ExtendedPairVaultId == 0 {
+		return fmt.Errorf("extended_pair_vault_id cannot be zero")
+	}
+	if m.AssetId == 0 {
+		return fmt.Errorf("asset_id cannot be zero")
+	}
+	if m.LiquidationThreshold.IsNil() || m.LiquidationThreshold.LTE(sdk.OneDec()) {
+		return fmt.Errorf("liquidation_threshold must be greater than 1")
+	}
+	return nil
+}
+
+// IsDelisting reports whether deposits of the asset are frozen.
+func (m VaultCollateralAsset) IsDelisting() bool {
+	return m.Delisting != nil
+}
+
+// ValueFactor is the share of its value the asset adds to the collateral of a
+// vault at t, one while listed and ramping down to zero as it is delisted.
+func (m VaultCollateralAsset) ValueFactor(t time.Time) sdk.Dec {
+	if m.Delisting == nil {
+		return sdk.OneDec()
+	}
+	return m.Delisting.LtvFactor(t)
+}
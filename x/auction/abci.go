@@ -15,6 +15,8 @@ import (
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper, assetKeeper expected.AssetKeeper, collectorKeeper expected.CollectorKeeper, esmKeeper expected.EsmKeeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, ctx.BlockTime(), telemetry.MetricKeyBeginBlocker)
 
+	k.ActivatePendingChanges(ctx)
+
 	auctionMapData, auctionMappingFound := collectorKeeper.GetAllAuctionMappingForApp(ctx)
 	if auctionMappingFound {
 		for _, data := range auctionMapData {
@@ -100,4 +102,6 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, assetKeeper expected.AssetKe
 			}
 		}
 	}
+
+	k.PruneAuctionHistory(ctx)
 }
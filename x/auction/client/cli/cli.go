@@ -27,11 +27,18 @@ func GetQueryCmd() *cobra.Command {
 		queryDutchAuctions(),
 		queryDutchBiddings(),
 		queryProtocolStats(),
+		queryBadDebts(),
 		queryGenericAuctionParams(),
 		queryDutchLendAuction(),
 		queryDutchLendAuctions(),
 		queryDutchLendBiddings(),
 		queryFilterDutchAuctions(),
+		queryAuctionHistory(),
+		queryAuctionAnalytics(),
+		queryPreBid(),
+		queryPreBids(),
+		queryPreBidQueue(),
+		queryParams(),
 	)
 
 	return cmd
@@ -51,6 +58,10 @@ func GetTxCmd() *cobra.Command {
 		txPlaceDebtBid(),
 		txPlaceDutchBid(),
 		txPlaceDutchLendBid(),
+		txCommitDutchBid(),
+		txRevealDutchBid(),
+		txSubmitPreBid(),
+		txWithdrawPreBid(),
 	)
 
 	return cmd
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -409,6 +410,43 @@ func queryProtocolStats() *cobra.Command {
 	return cmd
 }
 
+func queryBadDebts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bad-debts [appid]",
+		Short: "Query bad debt left by auctions and how it was covered",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(ctx)
+			res, err := queryClient.QueryBadDebts(
+				context.Background(),
+				&types.QueryBadDebtsRequest{
+					AppId:      appID,
+					Pagination: pagination,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bad-debts")
+	return cmd
+}
+
 func queryDutchBiddings() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "dutch-biddings [bidder] [app-id] [history]",
@@ -676,3 +714,263 @@ func queryFilterDutchAuctions() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "filter-dutch-auctions")
 	return cmd
 }
+
+const (
+	flagAuctionType   = "auction-type"
+	flagAssetID       = "asset-id"
+	flagVaultOwner    = "vault-owner"
+	flagLockedVaultID = "locked-vault-id"
+	flagStartTime     = "start-time"
+	flagEndTime       = "end-time"
+)
+
+func queryAuctionHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction-history [appid]",
+		Short: "Query closed auctions filtered by type, asset, vault owner, locked vault and close time",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			auctionType, _ := cmd.Flags().GetString(flagAuctionType)
+			assetID, _ := cmd.Flags().GetUint64(flagAssetID)
+			vaultOwner, _ := cmd.Flags().GetString(flagVaultOwner)
+			lockedVaultID, _ := cmd.Flags().GetUint64(flagLockedVaultID)
+			var startTime, endTime time.Time
+			if value, _ := cmd.Flags().GetString(flagStartTime); value != "" {
+				startTime, err = time.Parse(time.RFC3339, value)
+				if err != nil {
+					return err
+				}
+			}
+			if value, _ := cmd.Flags().GetString(flagEndTime); value != "" {
+				endTime, err = time.Parse(time.RFC3339, value)
+				if err != nil {
+					return err
+				}
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(ctx)
+			res, err := queryClient.QueryAuctionHistory(
+				context.Background(),
+				&types.QueryAuctionHistoryRequest{
+					AppId:         appID,
+					AuctionType:   auctionType,
+					AssetId:       assetID,
+					VaultOwner:    vaultOwner,
+					LockedVaultId: lockedVaultID,
+					StartTime:     startTime,
+					EndTime:       endTime,
+					Pagination:    pagination,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagAuctionType, "", "auction type: surplus, debt, dutch or dutch_lend")
+	cmd.Flags().Uint64(flagAssetID, 0, "asset auctioned or bid")
+	cmd.Flags().String(flagVaultOwner, "", "owner of the liquidated vault")
+	cmd.Flags().Uint64(flagLockedVaultID, 0, "locked vault auctioned")
+	cmd.Flags().String(flagStartTime, "", "closed at or after, RFC3339")
+	cmd.Flags().String(flagEndTime, "", "closed before, RFC3339")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auction-history")
+	return cmd
+}
+
+func queryAuctionAnalytics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction-analytics [appid] [asset id]",
+		Short: "Query clearing analytics of collateral auctions, an asset id of 0 lists every collateral",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			assetID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(ctx)
+			res, err := queryClient.QueryAuctionAnalytics(
+				context.Background(),
+				&types.QueryAuctionAnalyticsRequest{
+					AppId:   appID,
+					AssetId: assetID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func queryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query auction module params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(ctx)
+			res, err := queryClient.QueryParams(
+				context.Background(),
+				&types.QueryParamsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagCollateralAssetID = "collateral-asset-id"
+	flagDebtAssetID       = "debt-asset-id"
+	flagBidder            = "bidder"
+)
+
+func queryPreBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pre-bid [pre-bid id]",
+		Short: "Query a pre-bid",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			preBidID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(ctx)
+			res, err := queryClient.QueryPreBid(
+				context.Background(),
+				&types.QueryPreBidRequest{
+					PreBidId: preBidID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func queryPreBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pre-bids [appid]",
+		Short: "Query pre-bids of an app filtered by collateral asset, debt asset and bidder",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			collateralAssetID, _ := cmd.Flags().GetUint64(flagCollateralAssetID)
+			debtAssetID, _ := cmd.Flags().GetUint64(flagDebtAssetID)
+			bidder, _ := cmd.Flags().GetString(flagBidder)
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(ctx)
+			res, err := queryClient.QueryPreBids(
+				context.Background(),
+				&types.QueryPreBidsRequest{
+					AppId:             appID,
+					CollateralAssetId: collateralAssetID,
+					DebtAssetId:       debtAssetID,
+					Bidder:            bidder,
+					Pagination:        pagination,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(flagCollateralAssetID, 0, "collateral asset bid for")
+	cmd.Flags().Uint64(flagDebtAssetID, 0, "debt asset bid with")
+	cmd.Flags().String(flagBidder, "", "bidder of the pre-bids")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pre-bids")
+	return cmd
+}
+
+func queryPreBidQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pre-bid-queue [appid] [collateral asset id] [debt asset id]",
+		Short: "Query the debt token bid at each premium slot of a pre-bid queue",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			collateralAssetID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			debtAssetID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(ctx)
+			res, err := queryClient.QueryPreBidQueue(
+				context.Background(),
+				&types.QueryPreBidQueueRequest{
+					AppId:             appID,
+					CollateralAssetId: collateralAssetID,
+					DebtAssetId:       debtAssetID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
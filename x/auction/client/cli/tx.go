@@ -15,9 +15,9 @@ import (
 
 func txPlaceSurplusBid() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "bid-surplus [auction-id] [bid] [app-id] [auction-mapping-id]",
-		Short: "Place a bid on an auction",
-		Args:  cobra.ExactArgs(4),
+		Use:   "bid-surplus [auction-id] [bid] [app-id] [auction-mapping-id] [quantity]",
+		Short: "Place a bid on an auction for quantity of its lot, the whole lot when quantity is omitted",
+		Args:  cobra.RangeArgs(4, 5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -44,7 +44,15 @@ func txPlaceSurplusBid() *cobra.Command {
 				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
 			}
 
-			msg := types.NewMsgPlaceSurplusBid(clientCtx.GetFromAddress().String(), id, amt, appID, auctionMappingID)
+			var quantity sdk.Coin
+			if len(args) > 4 {
+				quantity, err = sdk.ParseCoinNormalized(args[4])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgPlaceSurplusBid(clientCtx.GetFromAddress().String(), id, amt, quantity, appID, auctionMappingID)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -189,3 +197,193 @@ func txPlaceDutchLendBid() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func txCommitDutchBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-dutch-bid [auction-id] [quantity] [payment] [salt] [escrow] [app-id] [auction-mapping-id]",
+		Short: "Commit to a bid of payment for quantity of the collateral of a sealed Dutch auction",
+		Long:  "Commit to a bid of payment for quantity of the collateral of a sealed Dutch auction. Only the hash of the bid is sent, keep the salt to reveal it.",
+		Args:  cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			quantity, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			payment, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			escrow, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[5], 10, 64)
+			if err != nil {
+				return fmt.Errorf("app-id '%s' not a valid uint", args[5])
+			}
+
+			auctionMappingID, err := strconv.ParseUint(args[6], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-mapping-id '%s' not a valid uint", args[6])
+			}
+
+			bidder := clientCtx.GetFromAddress().String()
+			commitment := types.SealedBidCommitment(bidder, auctionID, quantity, payment, args[3])
+			msg := types.NewMsgCommitDutchBid(bidder, auctionID, commitment, escrow, appID, auctionMappingID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txRevealDutchBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-dutch-bid [auction-id] [bidding-id] [quantity] [payment] [salt] [app-id] [auction-mapping-id]",
+		Short: "Reveal a bid committed to on a sealed Dutch auction",
+		Args:  cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			biddingID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("bidding-id '%s' not a valid uint", args[1])
+			}
+
+			quantity, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			payment, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[5], 10, 64)
+			if err != nil {
+				return fmt.Errorf("app-id '%s' not a valid uint", args[5])
+			}
+
+			auctionMappingID, err := strconv.ParseUint(args[6], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-mapping-id '%s' not a valid uint", args[6])
+			}
+
+			msg := types.NewMsgRevealDutchBid(clientCtx.GetFromAddress().String(), auctionID, biddingID, quantity, payment, args[4], appID, auctionMappingID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txSubmitPreBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-pre-bid [app-id] [collateral-asset-id] [debt-asset-id] [premium] [amount]",
+		Short: "Deposit debt token in the pre-bid queue of a collateral, to buy it from liquidations at a premium in percent below its oracle price",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("app-id '%s' not a valid uint", args[0])
+			}
+
+			collateralAssetID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("collateral-asset-id '%s' not a valid uint", args[1])
+			}
+
+			debtAssetID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("debt-asset-id '%s' not a valid uint", args[2])
+			}
+
+			premium, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("premium '%s' not a valid uint", args[3])
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitPreBid(clientCtx.GetFromAddress().String(), appID, collateralAssetID, debtAssetID, premium, amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txWithdrawPreBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-pre-bid [pre-bid-id] [amount]",
+		Short: "Withdraw debt token left in a pre-bid, all of it when amount is zero",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			preBidID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pre-bid-id '%s' not a valid uint", args[0])
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawPreBid(clientCtx.GetFromAddress().String(), preBidID, amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -46,10 +46,13 @@ type LiquidationKeeper interface {
 
 type AssetKeeper interface {
 	GetAsset(ctx sdk.Context, id uint64) (assettypes.Asset, bool)
+	GetAssetForDenom(ctx sdk.Context, denom string) (assettypes.Asset, bool)
 	GetPair(ctx sdk.Context, id uint64) (assettypes.Pair, bool)
 	GetApps(ctx sdk.Context) (apps []assettypes.AppData, found bool)
 	GetApp(ctx sdk.Context, id uint64) (app assettypes.AppData, found bool)
 	GetPairsVault(ctx sdk.Context, id uint64) (pairs assettypes.ExtendedPairVault, found bool)
+	ScheduleChange(ctx sdk.Context, paramClass string, change assettypes.RiskParameter) (uint64, error)
+	ActivatePendingChanges(ctx sdk.Context, paramClass string, apply func(ctx sdk.Context, change assettypes.RiskParameter) error)
 }
 
 type VaultKeeper interface {
@@ -57,9 +60,9 @@ type VaultKeeper interface {
 	SetAppExtendedPairVaultMappingData(ctx sdk.Context, appExtendedPairVaultData vaulttypes.AppExtendedPairVaultMappingData)
 	UpdateTokenMintedAmountLockerMapping(ctx sdk.Context, appMappingID uint64, extendedPairID uint64, amount sdk.Int, changeType bool)
 	UpdateCollateralLockedAmountLockerMapping(ctx sdk.Context, appMappingID uint64, extendedPairID uint64, amount sdk.Int, changeType bool)
-	DeleteUserVaultExtendedPairMapping(ctx sdk.Context, from string, appMapping uint64, extendedPairVault uint64)
+	DeleteUserVaultExtendedPairMapping(ctx sdk.Context, from string, appMapping uint64, extendedPairVault uint64, vaultID uint64)
 	CreateNewVault(ctx sdk.Context, From string, AppID uint64, ExtendedPairVaultID uint64, AmountIn sdk.Int, AmountOut sdk.Int) error
-	GetUserAppExtendedPairMappingData(ctx sdk.Context, from string, appMapping uint64, extendedPairVault uint64) (userVaultAssetData vaulttypes.OwnerAppExtendedPairVaultMappingData, found bool)
+	GetUserAppExtendedPairMappingData(ctx sdk.Context, from string, appMapping uint64, extendedPairVault uint64) (userVaultAssetData []vaulttypes.OwnerAppExtendedPairVaultMappingData, found bool)
 	GetUserAppMappingData(ctx sdk.Context, from string, appMapping uint64) (userVaultAssetData []vaulttypes.OwnerAppExtendedPairVaultMappingData, found bool)
 	// CheckUserAppToExtendedPairMapping(ctx sdk.Context, userVaultAssetData vaulttypes.UserVaultAssetMapping, extendedPairVaultID uint64, appMappingID uint64) (vaultID uint64, found bool)
 	SetVault(ctx sdk.Context, vault vaulttypes.Vault)
@@ -111,4 +114,6 @@ type LendKeeper interface {
 	SetLend(ctx sdk.Context, lend lendtypes.LendAsset)
 	SetAllReserveStatsByAssetID(ctx sdk.Context, allReserveStats lendtypes.AllReserveStats)
 	GetAllReserveStatsByAssetID(ctx sdk.Context, id uint64) (allReserveStats lendtypes.AllReserveStats, found bool)
+	GetBadDebtWaterfall(ctx sdk.Context) (waterfall lendtypes.BadDebtWaterfall)
+	SocializeBadDebt(ctx sdk.Context, poolID, assetID uint64, amount sdk.Int) sdk.Int
 }
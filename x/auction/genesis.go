@@ -41,6 +41,23 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, state *types.GenesisState) {
 		lendAuctionID = item.AuctionId
 	}
 	k.SetLendAuctionID(ctx, lendAuctionID)
+
+	for _, item := range state.BadDebts {
+		k.SetBadDebt(ctx, item)
+	}
+
+	for _, item := range state.SealedBids {
+		k.SetSealedBid(ctx, item)
+	}
+
+	for _, item := range state.AuctionStats {
+		k.SetAuctionStats(ctx, item)
+	}
+
+	for _, item := range state.PreBids {
+		k.SetPreBid(ctx, item)
+	}
+	k.SetPreBidID(ctx, state.PreBidID)
 }
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
@@ -53,5 +70,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		k.GetDutchLendAuctions(ctx, 3),
 		k.GetParams(ctx),
 		k.GetUserBiddingID(ctx),
+		k.GetAllBadDebts(ctx),
+		k.GetAllSealedBids(ctx),
+		k.GetAllAuctionStats(ctx),
+		k.GetAllPreBids(ctx),
+		k.GetPreBidID(ctx),
 	)
 }
@@ -31,6 +31,22 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := server.MsgPlaceDutchLendBid(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCommitDutchBidRequest:
+			res, err := server.MsgCommitDutchBid(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRevealDutchBidRequest:
+			res, err := server.MsgRevealDutchBid(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSubmitPreBidRequest:
+			res, err := server.MsgSubmitPreBid(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgWithdrawPreBidRequest:
+			res, err := server.MsgWithdrawPreBid(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(types.ErrorUnknownMsgType, "%T", msg)
 		}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auctiontypes "github.com/comdex-official/comdex/x/auction/types"
+)
+
+func (k Keeper) SetAuctionStats(ctx sdk.Context, stats auctiontypes.AuctionStats) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.AuctionStatsKey(stats.AppId, stats.AssetId)
+		value = k.cdc.MustMarshal(&stats)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetAuctionStats(ctx sdk.Context, appID, assetID uint64) (stats auctiontypes.AuctionStats, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.AuctionStatsKey(appID, assetID)
+		value = store.Get(key)
+	)
+	if value == nil {
+		return stats, false
+	}
+	k.cdc.MustUnmarshal(value, &stats)
+	return stats, true
+}
+
+func (k Keeper) GetAuctionStatsForApp(ctx sdk.Context, appID uint64) []auctiontypes.AuctionStats {
+	return k.getAuctionStats(ctx, auctiontypes.AuctionStatsAppKey(appID))
+}
+
+func (k Keeper) GetAllAuctionStats(ctx sdk.Context) []auctiontypes.AuctionStats {
+	return k.getAuctionStats(ctx, auctiontypes.AuctionStatsKeyPrefix)
+}
+
+func (k Keeper) getAuctionStats(ctx sdk.Context, prefix []byte) (stats []auctiontypes.AuctionStats) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, prefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var item auctiontypes.AuctionStats
+		k.cdc.MustUnmarshal(iter.Value(), &item)
+		stats = append(stats, item)
+	}
+	return stats
+}
+
+// auctionStats returns the stats of the collateral of a dutch auction,
+// starting them when it is the first auction of the collateral.
+func (k Keeper) auctionStats(ctx sdk.Context, auction auctiontypes.DutchAuction) auctiontypes.AuctionStats {
+	stats, found := k.GetAuctionStats(ctx, auction.AppId, auction.AssetOutId)
+	if !found {
+		stats = auctiontypes.NewAuctionStats(auction.AppId, auction.AssetOutId)
+	}
+	return stats
+}
+
+// recordAuctionFill adds a bid that bought collateral of a dutch auction to
+// the stats of the collateral. Fills are left out while the collateral has no
+// oracle price to compare the bid with.
+func (k Keeper) recordAuctionFill(ctx sdk.Context, auction auctiontypes.DutchAuction, collateral, payment sdk.Coin) {
+	if !collateral.Amount.IsPositive() {
+		return
+	}
+	twaData, found := k.market.GetTwa(ctx, auction.AssetOutId)
+	if !found || !twaData.IsPriceActive {
+		return
+	}
+	filledValue, err := k.CalcDollarValueForToken(ctx, auction.AssetOutId, sdk.NewDecFromInt(sdk.NewIntFromUint64(twaData.Twa)), collateral.Amount)
+	if err != nil {
+		return
+	}
+	paidValue, err := k.CalcDollarValueForToken(ctx, auction.AssetInId, auction.InflowTokenCurrentPrice, payment.Amount)
+	if err != nil {
+		return
+	}
+
+	stats := k.auctionStats(ctx, auction)
+	stats.Fills++
+	stats.FilledValue = stats.FilledValue.Add(filledValue)
+	stats.PaidValue = stats.PaidValue.Add(paidValue)
+	k.SetAuctionStats(ctx, stats)
+}
+
+// recordAuctionClose adds a closed dutch auction to the stats of its
+// collateral, with the time since its vault was liquidated and the share of
+// the lot left unsold.
+func (k Keeper) recordAuctionClose(ctx sdk.Context, auction auctiontypes.DutchAuction, liquidatedAt time.Time) {
+	stats := k.auctionStats(ctx, auction)
+	stats.AuctionsClosed++
+	if clearTime := ctx.BlockTime().Sub(liquidatedAt); clearTime > 0 {
+		stats.ClearSeconds += uint64(clearTime / time.Second)
+	}
+	if auction.OutflowTokenInitAmount.Amount.IsPositive() {
+		unsold := sdk.NewDecFromInt(auction.OutflowTokenCurrentAmount.Amount).QuoInt(auction.OutflowTokenInitAmount.Amount)
+		stats.UnsoldShare = stats.UnsoldShare.Add(unsold)
+	}
+	k.SetAuctionStats(ctx, stats)
+}
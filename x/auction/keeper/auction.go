@@ -39,13 +39,55 @@ func (k Keeper) AddAuctionParams(ctx sdk.Context, auctionParamsBinding *bindings
 		DebtId:                 auctionParamsBinding.DebtID,
 		DutchId:                auctionParamsBinding.DutchID,
 		BidDurationSeconds:     auctionParamsBinding.BidDurationSeconds,
+		SealedBid:              auctionParamsBinding.SealedBid,
+		CommitDurationSeconds:  auctionParamsBinding.CommitDurationSeconds,
+		RevealDurationSeconds:  auctionParamsBinding.RevealDurationSeconds,
+	}
+	if err := k.validateSealedBidParams(ctx, auctionParams); err != nil {
+		return err
 	}
 
+	// the first params of an app take effect immediately, updates are timelocked
+	if _, found := k.GetAuctionParams(ctx, auctionParams.AppId); found {
+		_, err := k.asset.ScheduleChange(ctx, assettypes.ParamClassAuctionParams, &auctionParams)
+		return err
+	}
 	k.SetAuctionParams(ctx, auctionParams)
 
 	return nil
 }
 
+// validateSealedBidParams checks the sealed bid windows are set, and that the
+// app runs no lend auctions, which only take public bids.
+func (k Keeper) validateSealedBidParams(ctx sdk.Context, auctionParams auctiontypes.AuctionParams) error {
+	if !auctionParams.SealedBid {
+		return nil
+	}
+	if auctionParams.CommitDurationSeconds == 0 || auctionParams.RevealDurationSeconds == 0 {
+		return auctiontypes.ErrorInvalidSealedBidParams
+	}
+	if _, found := k.lend.GetAddAuctionParamsData(ctx, auctionParams.AppId); found {
+		return auctiontypes.ErrorSealedBidLendApp
+	}
+	return nil
+}
+
+// ActivatePendingChanges applies the auction params updates whose timelock
+// delay has passed.
+func (k Keeper) ActivatePendingChanges(ctx sdk.Context) {
+	k.asset.ActivatePendingChanges(ctx, assettypes.ParamClassAuctionParams, func(ctx sdk.Context, change assettypes.RiskParameter) error {
+		auctionParams, ok := change.(*auctiontypes.AuctionParams)
+		if !ok {
+			return assettypes.ErrorInvalidPendingChange
+		}
+		if err := k.validateSealedBidParams(ctx, *auctionParams); err != nil {
+			return err
+		}
+		k.SetAuctionParams(ctx, *auctionParams)
+		return nil
+	})
+}
+
 func (k Keeper) makeFalseForFlags(ctx sdk.Context, appID, assetID uint64) error {
 	auctionLookupTable, found := k.collector.GetAuctionMappingForApp(ctx, appID, assetID)
 	if !found {
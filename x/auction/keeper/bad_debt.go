@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auctiontypes "github.com/comdex-official/comdex/x/auction/types"
+	lendtypes "github.com/comdex-official/comdex/x/lend/types"
+)
+
+func (k Keeper) SetBadDebt(ctx sdk.Context, badDebt auctiontypes.BadDebt) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.BadDebtKey(badDebt.AppId, badDebt.PoolId, badDebt.AssetId)
+		value = k.cdc.MustMarshal(&badDebt)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetBadDebt(ctx sdk.Context, appID, poolID, assetID uint64) (badDebt auctiontypes.BadDebt, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.BadDebtKey(appID, poolID, assetID)
+		value = store.Get(key)
+	)
+	if value == nil {
+		return badDebt, false
+	}
+	k.cdc.MustUnmarshal(value, &badDebt)
+	return badDebt, true
+}
+
+func (k Keeper) GetAllBadDebts(ctx sdk.Context) (badDebts []auctiontypes.BadDebt) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, auctiontypes.BadDebtKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var badDebt auctiontypes.BadDebt
+		k.cdc.MustUnmarshal(iter.Value(), &badDebt)
+		badDebts = append(badDebts, badDebt)
+	}
+	return badDebts
+}
+
+// coverBadDebt records the shortfall of an auction that closed without
+// covering its debt and covers it following the bad debt waterfall set by
+// governance. Lend auctions pass the module of the pool the debt is owed to,
+// which receives the covered amount. Vault auctions pass an empty pool module,
+// the reserve and socialize steps do not apply to them and the covered amount
+// stays with the auction module to be burned. It returns the amount left
+// outstanding.
+func (k Keeper) coverBadDebt(ctx sdk.Context, appID, poolID, assetID uint64, poolModuleName string, shortfall sdk.Coin) (sdk.Int, error) {
+	badDebt, found := k.GetBadDebt(ctx, appID, poolID, assetID)
+	if !found {
+		badDebt = auctiontypes.BadDebt{
+			AppId:              appID,
+			PoolId:             poolID,
+			AssetId:            assetID,
+			Recorded:           sdk.ZeroInt(),
+			CoveredByReserve:   sdk.ZeroInt(),
+			CoveredByCollector: sdk.ZeroInt(),
+			Socialized:         sdk.ZeroInt(),
+			Outstanding:        sdk.ZeroInt(),
+		}
+	}
+	badDebt.Recorded = badDebt.Recorded.Add(shortfall.Amount)
+	k.emitBadDebtEvent(ctx, auctiontypes.EventTypeBadDebtRecorded, badDebt, "", shortfall.Amount)
+
+	isLend := poolModuleName != ""
+	remaining := shortfall.Amount
+	for _, step := range k.lend.GetBadDebtWaterfall(ctx).Steps {
+		if !remaining.IsPositive() {
+			break
+		}
+		var (
+			covered  = sdk.ZeroInt()
+			stepName string
+			err      error
+		)
+		switch step {
+		case lendtypes.BadDebtCoverageReserve:
+			if !isLend {
+				continue
+			}
+			stepName = "reserve"
+			covered, err = k.coverBadDebtFromReserve(ctx, assetID, poolModuleName, sdk.NewCoin(shortfall.Denom, remaining))
+			badDebt.CoveredByReserve = badDebt.CoveredByReserve.Add(covered)
+		case lendtypes.BadDebtCoverageCollector:
+			stepName = "collector"
+			covered, err = k.coverBadDebtFromCollector(ctx, appID, assetID, poolModuleName, sdk.NewCoin(shortfall.Denom, remaining))
+			badDebt.CoveredByCollector = badDebt.CoveredByCollector.Add(covered)
+		case lendtypes.BadDebtCoverageSocialize:
+			if !isLend {
+				continue
+			}
+			stepName = "socialize"
+			covered = k.lend.SocializeBadDebt(ctx, poolID, assetID, remaining)
+			badDebt.Socialized = badDebt.Socialized.Add(covered)
+		}
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if covered.IsPositive() {
+			remaining = remaining.Sub(covered)
+			k.emitBadDebtEvent(ctx, auctiontypes.EventTypeBadDebtCovered, badDebt, stepName, covered)
+		}
+	}
+
+	badDebt.Outstanding = badDebt.Outstanding.Add(remaining)
+	k.SetBadDebt(ctx, badDebt)
+	return remaining, nil
+}
+
+func (k Keeper) coverBadDebtFromReserve(ctx sdk.Context, assetID uint64, poolModuleName string, shortfall sdk.Coin) (sdk.Int, error) {
+	covered := sdk.MinInt(shortfall.Amount, k.lend.ModuleBalance(ctx, lendtypes.ModuleName, shortfall.Denom))
+	if !covered.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	err := k.lend.UpdateReserveBalances(ctx, assetID, poolModuleName, sdk.NewCoin(shortfall.Denom, covered), false)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	allReserveStats, found := k.lend.GetAllReserveStatsByAssetID(ctx, assetID)
+	if !found {
+		allReserveStats = lendtypes.AllReserveStats{
+			AssetID:                        assetID,
+			AmountOutFromReserveToLenders:  sdk.ZeroInt(),
+			AmountOutFromReserveForAuction: sdk.ZeroInt(),
+			AmountInFromLiqPenalty:         sdk.ZeroInt(),
+			AmountInFromRepayments:         sdk.ZeroInt(),
+			TotalAmountOutToLenders:        sdk.ZeroInt(),
+		}
+	}
+	allReserveStats.AmountOutFromReserveForAuction = allReserveStats.AmountOutFromReserveForAuction.Add(covered)
+	k.lend.SetAllReserveStatsByAssetID(ctx, allReserveStats)
+	return covered, nil
+}
+
+func (k Keeper) coverBadDebtFromCollector(ctx sdk.Context, appID, assetID uint64, poolModuleName string, shortfall sdk.Coin) (sdk.Int, error) {
+	netFeeData, found := k.collector.GetNetFeeCollectedData(ctx, appID, assetID)
+	if !found {
+		return sdk.ZeroInt(), nil
+	}
+	// the collector keeps a balance of at least one after every withdrawal
+	covered := sdk.MinInt(shortfall.Amount, netFeeData.NetFeesCollected.Sub(sdk.OneInt()))
+	if !covered.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	_, err := k.collector.GetAmountFromCollector(ctx, appID, assetID, covered)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	k.SetProtocolStatistics(ctx, appID, assetID, covered)
+
+	if poolModuleName != "" {
+		err = k.bank.SendCoinsFromModuleToModule(ctx, auctiontypes.ModuleName, poolModuleName, sdk.NewCoins(sdk.NewCoin(shortfall.Denom, covered)))
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+	}
+	return covered, nil
+}
+
+func (k Keeper) emitBadDebtEvent(ctx sdk.Context, eventType string, badDebt auctiontypes.BadDebt, step string, amount sdk.Int) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(auctiontypes.DataAppID, strconv.FormatUint(badDebt.AppId, 10)),
+		sdk.NewAttribute(auctiontypes.DataPoolID, strconv.FormatUint(badDebt.PoolId, 10)),
+		sdk.NewAttribute(auctiontypes.DataAssetID, strconv.FormatUint(badDebt.AssetId, 10)),
+		sdk.NewAttribute(auctiontypes.DataAmount, amount.String()),
+	}
+	if step != "" {
+		attributes = append(attributes, sdk.NewAttribute(auctiontypes.DataBadDebtStep, step))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attributes...))
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auctiontypes "github.com/comdex-official/comdex/x/auction/types"
+)
+
+// batchBid is a bid for part of a batch auction lot.
+type batchBid struct {
+	Price    sdk.Dec
+	Quantity sdk.Int
+}
+
+// clearBatch fills lot with bids in order of price, highest first when
+// descending and lowest first otherwise, bids at the same price in the order
+// they were placed. It returns the uniform price the lot clears at, which is
+// the price of the last bid filled, and the quantity filled for each bid.
+func clearBatch(bids []batchBid, lot sdk.Int, descending bool) (clearingPrice sdk.Dec, fills []sdk.Int) {
+	order := make([]int, len(bids))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		if descending {
+			return bids[order[i]].Price.GT(bids[order[j]].Price)
+		}
+		return bids[order[i]].Price.LT(bids[order[j]].Price)
+	})
+
+	clearingPrice = sdk.ZeroDec()
+	fills = make([]sdk.Int, len(bids))
+	for i := range fills {
+		fills[i] = sdk.ZeroInt()
+	}
+	remaining := lot
+	for _, i := range order {
+		if !remaining.IsPositive() {
+			break
+		}
+		if !bids[i].Quantity.IsPositive() {
+			continue
+		}
+		fills[i] = sdk.MinInt(bids[i].Quantity, remaining)
+		remaining = remaining.Sub(fills[i])
+		clearingPrice = bids[i].Price
+	}
+	return clearingPrice, fills
+}
+
+// batchCovered reports whether bids ask for at least the whole lot.
+func batchCovered(bids []batchBid, lot sdk.Int) bool {
+	total := sdk.ZeroInt()
+	for _, bid := range bids {
+		total = total.Add(bid.Quantity)
+	}
+	return total.GTE(lot)
+}
+
+// batchMinQuantity is the least quantity a bid asks for of lot.
+func batchMinQuantity(lot sdk.Int) sdk.Int {
+	return lot.AddRaw(auctiontypes.MaxBatchBidsPerAuction - 1).QuoRaw(auctiontypes.MaxBatchBidsPerAuction)
+}
+
+// batchEvictions returns the bids to drop for a new bid, the last of fills, to
+// fit in an auction already holding MaxBatchBidsPerAuction bids: those the new
+// bid leaves without any fill. A full auction only takes a bid that is filled
+// and fills out another.
+func batchEvictions(fills []sdk.Int) ([]int, error) {
+	last := len(fills) - 1
+	if last < auctiontypes.MaxBatchBidsPerAuction {
+		return nil, nil
+	}
+	if !fills[last].IsPositive() {
+		return nil, auctiontypes.ErrorBatchAuctionFull
+	}
+	var evictions []int
+	for i, fill := range fills[:last] {
+		if !fill.IsPositive() {
+			evictions = append(evictions, i)
+		}
+	}
+	if len(evictions) == 0 {
+		return nil, auctiontypes.ErrorBatchAuctionFull
+	}
+	return evictions, nil
+}
+
+// withoutBids returns biddingIDs less the bids of the given ids, and the bids
+// taken out.
+func withoutBids(biddingIDs []*auctiontypes.BidOwnerMapping, ids map[uint64]bool) (kept, removed []*auctiontypes.BidOwnerMapping) {
+	kept = make([]*auctiontypes.BidOwnerMapping, 0, len(biddingIDs))
+	for _, biddingID := range biddingIDs {
+		if ids[biddingID.BidId] {
+			removed = append(removed, biddingID)
+		} else {
+			kept = append(kept, biddingID)
+		}
+	}
+	return kept, removed
+}
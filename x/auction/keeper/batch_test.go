@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/auction"
+	auctionKeeper "github.com/comdex-official/comdex/x/auction/keeper"
+	auctionTypes "github.com/comdex-official/comdex/x/auction/types"
+	tokenmintKeeper1 "github.com/comdex-official/comdex/x/tokenmint/keeper"
+	tokenminttypes "github.com/comdex-official/comdex/x/tokenmint/types"
+)
+
+func (s *KeeperTestSuite) TestSurplusBatchAuction() {
+	s.TestSurplusActivator()
+	k, ctx := &s.keeper, &s.ctx
+	server := auctionKeeper.NewMsgServiceServer(*k)
+
+	_, err := tokenmintKeeper1.NewMsgServer(s.tokenmintKeeper).MsgMintNewTokens(sdk.WrapSDKContext(*ctx), &tokenminttypes.MsgMintNewTokensRequest{
+		From:    "cosmos1hm7w7dnvdnra78pz9qxysy7u4tuhc3fnpjmyj7",
+		AppId:   1,
+		AssetId: 3,
+	})
+	s.Require().NoError(err)
+
+	bidders := []sdk.AccAddress{
+		sdk.AccAddress("surplus_bidder_1____"),
+		sdk.AccAddress("surplus_bidder_2____"),
+		sdk.AccAddress("surplus_bidder_3____"),
+	}
+	for _, bidder := range bidders {
+		s.fundAddr(bidder, ParseCoin("10000uharbor"))
+	}
+	bid := func(bidder sdk.AccAddress, amount, quantity string) error {
+		_, err := server.MsgPlaceSurplusBid(sdk.WrapSDKContext(*ctx), &auctionTypes.MsgPlaceSurplusBidRequest{
+			AuctionId:        1,
+			Bidder:           bidder.String(),
+			Amount:           ParseCoin(amount),
+			AppId:            1,
+			AuctionMappingId: 1,
+			Quantity:         ParseCoin(quantity),
+		})
+		return err
+	}
+
+	// The lot is 200000ucmst.
+	s.Require().ErrorIs(bid(bidders[0], "1000uharbor", "300000ucmst"), auctionTypes.ErrorInvalidBidQuantity)
+	s.Require().NoError(bid(bidders[0], "1000uharbor", "100000ucmst"))
+	s.Require().NoError(bid(bidders[1], "1500uharbor", "100000ucmst"))
+	// Once the lot is covered bids have to beat the clearing price by the bid factor.
+	s.Require().ErrorIs(bid(bidders[2], "1005uharbor", "100000ucmst"), auctionTypes.ErrorLowBidAmount)
+	s.Require().NoError(bid(bidders[2], "1000uharbor", "50000ucmst"))
+
+	surplusAuction, err := k.GetSurplusAuction(*ctx, 1, 1, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.01"), surplusAuction.ClearingPrice)
+	s.Require().Equal(ParseCoin("2000uharbor"), surplusAuction.Bid)
+
+	s.advanceseconds(301)
+	auction.BeginBlocker(*ctx, s.app.AuctionKeeper, s.app.AssetKeeper, s.app.CollectorKeeper, s.app.EsmKeeper)
+	_, err = k.GetSurplusAuction(*ctx, 1, 1, 1)
+	s.Require().Error(err)
+
+	// Every bid pays the clearing price, the lowest priced bid is filled partially.
+	for i, expected := range []struct {
+		harbor, cmst string
+	}{
+		{"9500uharbor", "50000ucmst"},
+		{"9000uharbor", "100000ucmst"},
+		{"9500uharbor", "50000ucmst"},
+	} {
+		harbor, err := s.getBalance(bidders[i].String(), "uharbor")
+		s.Require().NoError(err)
+		s.Require().Equal(ParseCoin(expected.harbor), harbor)
+		cmst, err := s.getBalance(bidders[i].String(), "ucmst")
+		s.Require().NoError(err)
+		s.Require().Equal(ParseCoin(expected.cmst), cmst)
+
+		biddings := k.GetHistorySurplusUserBiddings(*ctx, bidders[i].String(), 1)
+		s.Require().Len(biddings, 1)
+		s.Require().Equal(auctionTypes.SuccessBiddingStatus, biddings[0].BiddingStatus)
+		s.Require().Equal(ParseCoin(expected.cmst), biddings[0].Filled)
+	}
+
+	history, err := k.GetHistorySurplusAuction(*ctx, 1, 1, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.01"), history.ClearingPrice)
+	s.Require().Equal(ParseCoin("2000uharbor"), history.Bid)
+}
+
+func (s *KeeperTestSuite) TestSurplusBatchAuctionBidCap() {
+	s.TestSurplusActivator()
+	k, ctx := &s.keeper, &s.ctx
+	server := auctionKeeper.NewMsgServiceServer(*k)
+
+	_, err := tokenmintKeeper1.NewMsgServer(s.tokenmintKeeper).MsgMintNewTokens(sdk.WrapSDKContext(*ctx), &tokenminttypes.MsgMintNewTokensRequest{
+		From:    "cosmos1hm7w7dnvdnra78pz9qxysy7u4tuhc3fnpjmyj7",
+		AppId:   1,
+		AssetId: 3,
+	})
+	s.Require().NoError(err)
+
+	bidder1 := sdk.AccAddress("surplus_bidder_1____")
+	bidder2 := sdk.AccAddress("surplus_bidder_2____")
+	s.fundAddr(bidder1, ParseCoin("10000uharbor"))
+	s.fundAddr(bidder2, ParseCoin("10000uharbor"))
+	bid := func(bidder sdk.AccAddress, amount, quantity string) error {
+		_, err := server.MsgPlaceSurplusBid(sdk.WrapSDKContext(*ctx), &auctionTypes.MsgPlaceSurplusBidRequest{
+			AuctionId:        1,
+			Bidder:           bidder.String(),
+			Amount:           ParseCoin(amount),
+			AppId:            1,
+			AuctionMappingId: 1,
+			Quantity:         ParseCoin(quantity),
+		})
+		return err
+	}
+
+	// A bid asks for at least a twentieth of the 200000ucmst lot, so twenty bids cover it.
+	s.Require().ErrorIs(bid(bidder1, "100uharbor", "9999ucmst"), auctionTypes.ErrorInvalidBidQuantity)
+	for i := 0; i < auctionTypes.MaxBatchBidsPerAuction; i++ {
+		s.Require().NoError(bid(bidder1, "100uharbor", "10000ucmst"))
+	}
+	s.Require().ErrorIs(bid(bidder2, "100uharbor", "10000ucmst"), auctionTypes.ErrorLowBidAmount)
+
+	// A bid on the full auction fills out the last bid, which is refunded.
+	s.Require().NoError(bid(bidder2, "200uharbor", "10000ucmst"))
+	surplusAuction, err := k.GetSurplusAuction(*ctx, 1, 1, 1)
+	s.Require().NoError(err)
+	s.Require().Len(surplusAuction.BiddingIds, auctionTypes.MaxBatchBidsPerAuction)
+	harbor, err := s.getBalance(bidder1.String(), "uharbor")
+	s.Require().NoError(err)
+	s.Require().Equal(ParseCoin("8100uharbor"), harbor)
+	biddings := k.GetHistorySurplusUserBiddings(*ctx, bidder1.String(), 1)
+	s.Require().Len(biddings, 1)
+	s.Require().Equal(auctionTypes.RejectedBiddingStatus, biddings[0].BiddingStatus)
+	s.Require().Equal(uint64(auctionTypes.MaxBatchBidsPerAuction), biddings[0].BiddingId)
+}
+
+func (s *KeeperTestSuite) TestDebtBatchAuction() {
+	s.TestDebtActivator()
+	k, ctx := &s.keeper, &s.ctx
+	server := auctionKeeper.NewMsgServiceServer(*k)
+
+	_, err := tokenmintKeeper1.NewMsgServer(s.tokenmintKeeper).MsgMintNewTokens(sdk.WrapSDKContext(*ctx), &tokenminttypes.MsgMintNewTokensRequest{
+		From:    "cosmos1hm7w7dnvdnra78pz9qxysy7u4tuhc3fnpjmyj7",
+		AppId:   1,
+		AssetId: 3,
+	})
+	s.Require().NoError(err)
+
+	bidders := []sdk.AccAddress{
+		sdk.AccAddress("debt_bidder_1_______"),
+		sdk.AccAddress("debt_bidder_2_______"),
+	}
+	for _, bidder := range bidders {
+		s.fundAddr(bidder, ParseCoin("200000ucmst"))
+	}
+	bid := func(bidder sdk.AccAddress, mint, quantity string) error {
+		_, err := server.MsgPlaceDebtBid(sdk.WrapSDKContext(*ctx), &auctionTypes.MsgPlaceDebtBidRequest{
+			AuctionId:         1,
+			Bidder:            bidder.String(),
+			Bid:               ParseCoin(mint),
+			ExpectedUserToken: ParseCoin(quantity),
+			AppId:             1,
+			AuctionMappingId:  2,
+		})
+		return err
+	}
+
+	// 200000ucmst are raised for at most 2000000uharbor, 10uharbor per ucmst.
+	s.Require().ErrorIs(bid(bidders[0], "1100000uharbor", "100000ucmst"), auctionTypes.ErrorMaxBidAmount)
+	s.Require().NoError(bid(bidders[0], "900000uharbor", "150000ucmst"))
+	s.Require().NoError(bid(bidders[1], "800000uharbor", "100000ucmst"))
+
+	s.advanceseconds(301)
+	auction.BeginBlocker(*ctx, s.app.AuctionKeeper, s.app.AssetKeeper, s.app.CollectorKeeper, s.app.EsmKeeper)
+
+	// The cheaper bid fills first and both are minted at the clearing price of 8uharbor per ucmst.
+	for i, expected := range []struct {
+		harbor, cmst string
+	}{
+		{"1200000uharbor", "50000ucmst"},
+		{"400000uharbor", "150000ucmst"},
+	} {
+		harbor, err := s.getBalance(bidders[i].String(), "uharbor")
+		s.Require().NoError(err)
+		s.Require().Equal(ParseCoin(expected.harbor), harbor)
+		cmst, err := s.getBalance(bidders[i].String(), "ucmst")
+		s.Require().NoError(err)
+		s.Require().Equal(ParseCoin(expected.cmst), cmst)
+	}
+
+	history, err := k.GetHistoryDebtAuction(*ctx, 1, 2, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("8"), history.ClearingPrice)
+	s.Require().Equal(ParseCoin("1600000uharbor"), history.CurrentBidAmount)
+}
@@ -129,6 +129,7 @@ func (k Keeper) StartDebtAuction(
 		BiddingIds:          []*auctiontypes.BidOwnerMapping{},
 		AuctionMappingId:    auctionParams.DebtId,
 		BidFactor:           bidFactor,
+		ClearingPrice:       sdk.ZeroDec(),
 		AssetInId:           assetInID,
 		AssetOutId:          assetOutID,
 	}
@@ -190,65 +191,91 @@ func (k Keeper) closeDebtAuction(
 	ctx sdk.Context,
 	debtAuction auctiontypes.DebtAuction,
 	statusEsm bool,
-) error { // If there are bids
-	if statusEsm && debtAuction.BiddingIds != nil {
-		bidding, err := k.GetDebtUserBidding(ctx, debtAuction.Bidder.String(), debtAuction.AppId, debtAuction.ActiveBiddingId)
-		if err != nil {
-			return err
-		}
-		bidder, err := sdk.AccAddressFromBech32(bidding.Bidder)
-		if err != nil {
-			panic(err)
-		}
-		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(debtAuction.ExpectedUserToken))
-		if err != nil {
-			return err
-		}
-	} else if (debtAuction.AuctionStatus != auctiontypes.AuctionStartNoBids) && !statusEsm {
-		err := k.tokenMint.MintNewTokensForApp(ctx, debtAuction.AppId, debtAuction.AssetOutId, debtAuction.Bidder.String(), debtAuction.CurrentBidAmount.Amount)
-		if err != nil {
-			return err
-		}
-
-		bidding, err := k.GetDebtUserBidding(ctx, debtAuction.Bidder.String(), debtAuction.AppId, debtAuction.ActiveBiddingId)
-		if err != nil {
-			return err
-		}
-		bidding.BiddingStatus = auctiontypes.SuccessBiddingStatus
-		err = k.SetDebtUserBidding(ctx, bidding)
-
-		if err != nil {
-			return err
-		}
-		for _, biddingID := range debtAuction.BiddingIds {
-			bidding, err := k.GetDebtUserBidding(ctx, biddingID.BidOwner, debtAuction.AppId, biddingID.BidId)
-			if err != nil {
-				return err
+) error {
+	biddings, bids := k.getDebtBatch(ctx, debtAuction)
+
+	raised := sdk.ZeroInt()
+	if !statusEsm {
+		clearingPrice, fills := clearBatch(bids, debtAuction.ExpectedUserToken.Amount, false)
+		minted := sdk.ZeroInt()
+		for i, bidding := range biddings {
+			if bidding.BiddingStatus != auctiontypes.PlacedBiddingStatus {
+				continue
 			}
-			bidding.AuctionStatus = auctiontypes.ClosedAuctionStatus
-			err = k.SetDebtUserBidding(ctx, bidding)
+			bidder, err := sdk.AccAddressFromBech32(bidding.Bidder)
 			if err != nil {
 				return err
 			}
-			err = k.DeleteDebtUserBidding(ctx, bidding)
+
+			bidding.BiddingStatus = auctiontypes.RejectedBiddingStatus
+			if fills[i].IsPositive() {
+				mint := clearingPrice.MulInt(fills[i]).TruncateInt()
+				if mint.IsPositive() {
+					err = k.tokenMint.MintNewTokensForApp(ctx, debtAuction.AppId, debtAuction.AssetOutId, bidding.Bidder, mint)
+					if err != nil {
+						return err
+					}
+				}
+				minted = minted.Add(mint)
+				bidding.BiddingStatus = auctiontypes.SuccessBiddingStatus
+			}
+			// refund the unfilled quantity
+			refund := bidding.OutflowTokens.Amount.Sub(fills[i])
+			if refund.IsPositive() {
+				err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(sdk.NewCoin(bidding.OutflowTokens.Denom, refund)))
+				if err != nil {
+					return err
+				}
+			}
+			bidding.Filled = sdk.NewCoin(bidding.OutflowTokens.Denom, fills[i])
+			biddings[i] = bidding
+
+			raised = raised.Add(fills[i])
+		}
+		debtAuction.ClearingPrice = clearingPrice
+		debtAuction.CurrentBidAmount = sdk.NewCoin(debtAuction.AuctionedToken.Denom, minted)
+		debtAuction.ExpectedMintedToken = debtAuction.CurrentBidAmount
+	} else {
+		for i, bidding := range biddings {
+			if bidding.BiddingStatus != auctiontypes.PlacedBiddingStatus {
+				continue
+			}
+			bidder, err := sdk.AccAddressFromBech32(bidding.Bidder)
 			if err != nil {
 				return err
 			}
-			err = k.SetHistoryDebtUserBidding(ctx, bidding)
+			err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(bidding.OutflowTokens))
 			if err != nil {
 				return err
 			}
+			bidding.BiddingStatus = auctiontypes.RejectedBiddingStatus
+			biddings[i] = bidding
 		}
+	}
 
-		// send to collector module the amount collected in debt auction
-
-		err = k.bank.SendCoinsFromModuleToModule(ctx, auctiontypes.ModuleName, collectortypes.ModuleName, sdk.NewCoins(debtAuction.ExpectedUserToken))
-
+	for _, bidding := range biddings {
+		bidding.AuctionStatus = auctiontypes.ClosedAuctionStatus
+		err := k.SetDebtUserBidding(ctx, bidding)
+		if err != nil {
+			return err
+		}
+		err = k.DeleteDebtUserBidding(ctx, bidding)
 		if err != nil {
 			return err
 		}
+		err = k.SetHistoryDebtUserBidding(ctx, bidding)
+		if err != nil {
+			return err
+		}
+	}
 
-		err = k.collector.SetNetFeeCollectedData(ctx, debtAuction.AppId, debtAuction.AssetInId, debtAuction.ExpectedUserToken.Amount)
+	// send to collector module the amount collected in debt auction
+	if raised.IsPositive() {
+		err := k.bank.SendCoinsFromModuleToModule(ctx, auctiontypes.ModuleName, collectortypes.ModuleName, sdk.NewCoins(sdk.NewCoin(debtAuction.ExpectedUserToken.Denom, raised)))
+		if err != nil {
+			return err
+		}
+		err = k.collector.SetNetFeeCollectedData(ctx, debtAuction.AppId, debtAuction.AssetInId, raised)
 		if err != nil {
 			return auctiontypes.ErrorUnableToSetNetFees
 		}
@@ -271,73 +298,99 @@ func (k Keeper) closeDebtAuction(
 	return nil
 }
 
+// getDebtBatch returns the biddings of a debt auction and the bids they place
+// on its lot of expected user token. Biddings no longer placed bid for nothing.
+func (k Keeper) getDebtBatch(ctx sdk.Context, debtAuction auctiontypes.DebtAuction) (biddings []auctiontypes.DebtBiddings, bids []batchBid) {
+	for _, biddingID := range debtAuction.BiddingIds {
+		bidding, err := k.GetDebtUserBidding(ctx, biddingID.BidOwner, debtAuction.AppId, biddingID.BidId)
+		if err != nil {
+			continue
+		}
+		bid := batchBid{Price: sdk.ZeroDec(), Quantity: sdk.ZeroInt()}
+		if bidding.BiddingStatus == auctiontypes.PlacedBiddingStatus && bidding.OutflowTokens.Amount.IsPositive() {
+			bid.Quantity = bidding.OutflowTokens.Amount
+			bid.Price = bidding.Price
+			if bid.Price.IsNil() {
+				bid.Price = bidding.Bid.Amount.ToDec().QuoInt(bid.Quantity)
+			}
+		}
+		biddings = append(biddings, bidding)
+		bids = append(bids, bid)
+	}
+	return biddings, bids
+}
+
 func (k Keeper) PlaceDebtAuctionBid(ctx sdk.Context, appID, auctionMappingID, auctionID uint64, bidder sdk.AccAddress, bid sdk.Coin, expectedUserToken sdk.Coin) error {
 	auction, err := k.GetDebtAuction(ctx, appID, auctionMappingID, auctionID)
-	auctionParam, _ := k.GetAuctionParams(ctx, appID)
-
 	if err != nil {
 		return auctiontypes.ErrorInvalidDebtAuctionID
 	}
 	if expectedUserToken.Denom != auction.ExpectedUserToken.Denom {
 		return auctiontypes.ErrorInvalidDebtUserExpectedDenom
 	}
-
-	if !expectedUserToken.Amount.Equal(auction.ExpectedUserToken.Amount) {
-		return auctiontypes.ErrorDebtExpectedUserAmount
+	minQuantity := batchMinQuantity(auction.ExpectedUserToken.Amount)
+	if expectedUserToken.Amount.LT(minQuantity) || expectedUserToken.Amount.GT(auction.ExpectedUserToken.Amount) {
+		return sdkerrors.Wrapf(auctiontypes.ErrorDebtExpectedUserAmount, "expected user token should be at least %s%s and at most %s", minQuantity, auction.ExpectedUserToken.Denom, auction.ExpectedUserToken)
 	}
-	if bid.Denom != auction.ExpectedMintedToken.Denom {
+	if bid.Denom != auction.AuctionedToken.Denom {
 		return auctiontypes.ErrorInvalidDebtMintedDenom
 	}
+	price := bid.Amount.ToDec().QuoInt(expectedUserToken.Amount)
+	if price.GT(auction.AuctionedToken.Amount.ToDec().QuoInt(auction.ExpectedUserToken.Amount)) {
+		return auctiontypes.ErrorMaxBidAmount
+	}
 
-	if auction.AuctionStatus != auctiontypes.AuctionStartNoBids {
-		change := auction.BidFactor.MulInt(auction.ExpectedMintedToken.Amount).Ceil().TruncateInt()
-		maxBidAmount := auction.ExpectedMintedToken.Amount.Sub(change)
-		if bid.Amount.GT(maxBidAmount) {
-			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "bid should be less than or equal to %d ", maxBidAmount.Uint64())
-		}
-	} else {
-		if bid.Amount.GT(auction.AuctionedToken.Amount) {
-			return auctiontypes.ErrorMaxBidAmount
+	ctx.GasMeter().ConsumeGas(auctiontypes.BatchBidGas*uint64(len(auction.BiddingIds)), "BatchBidGas")
+
+	// once the lot is covered a bid has to improve the clearing price by the bid factor
+	biddings, bids := k.getDebtBatch(ctx, auction)
+	if batchCovered(bids, auction.ExpectedUserToken.Amount) {
+		clearingPrice, _ := clearBatch(bids, auction.ExpectedUserToken.Amount, false)
+		maxPrice := clearingPrice.Mul(sdk.OneDec().Sub(auction.BidFactor))
+		if price.GT(maxPrice) {
+			return sdkerrors.Wrapf(auctiontypes.ErrorMaxBidAmount, "bid price should be less than or equal to %s", maxPrice)
 		}
 	}
-	err = k.bank.SendCoinsFromAccountToModule(ctx, bidder, auctiontypes.ModuleName, sdk.NewCoins(expectedUserToken))
+
+	bids = append(bids, batchBid{Price: price, Quantity: expectedUserToken.Amount})
+	clearingPrice, fills := clearBatch(bids, auction.ExpectedUserToken.Amount, false)
+	evictions, err := batchEvictions(fills)
 	if err != nil {
 		return err
 	}
+	filled := sdk.ZeroInt()
+	for _, fill := range fills {
+		filled = filled.Add(fill)
+	}
 
-	biddingID, err := k.CreateNewDebtBid(ctx, appID, auctionMappingID, auctionID, bidder.String(), bid, expectedUserToken)
+	err = k.bank.SendCoinsFromAccountToModule(ctx, bidder, auctiontypes.ModuleName, sdk.NewCoins(expectedUserToken))
+	if err != nil {
+		return err
+	}
+	biddingID, err := k.CreateNewDebtBid(ctx, appID, auctionMappingID, auctionID, bidder.String(), bid, expectedUserToken, price)
 	if err != nil {
 		return err
 	}
-	// If auction gets bid from second time onwards . refund previous bidder
-	if auction.AuctionStatus != auctiontypes.AuctionStartNoBids {
-		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, auction.Bidder, sdk.NewCoins(auction.ExpectedUserToken))
-		if err != nil {
-			return err
-		}
-		bidding, err := k.GetDebtUserBidding(ctx, auction.Bidder.String(), auction.AppId, auction.ActiveBiddingId)
-		if err != nil {
-			return err
-		}
-		bidding.BiddingStatus = auctiontypes.RejectedBiddingStatus
 
-		err = k.SetDebtUserBidding(ctx, bidding)
-		if err != nil {
+	evicted := make(map[uint64]bool, len(evictions))
+	for _, i := range evictions {
+		if err := k.evictDebtBid(ctx, biddings[i]); err != nil {
 			return err
 		}
-	} else {
-		auction.AuctionStatus = auctiontypes.AuctionGoingOn
+		evicted[biddings[i].BiddingId] = true
 	}
+	biddingIDs, evictedIDs := withoutBids(auction.BiddingIds, evicted)
+	auction.BiddingIds = biddingIDs
+	auction.EvictedBiddingIds = append(auction.EvictedBiddingIds, evictedIDs...)
+
+	auction.AuctionStatus = auctiontypes.AuctionGoingOn
 	auction.ActiveBiddingId = biddingID
 	bidIDOwner := &auctiontypes.BidOwnerMapping{BidId: biddingID, BidOwner: bidder.String()}
 	auction.BiddingIds = append(auction.BiddingIds, bidIDOwner)
 	auction.Bidder = bidder
-	auction.CurrentBidAmount = bid
-	auction.ExpectedMintedToken = bid
-	auction.BidEndTime = ctx.BlockTime().Add(time.Second * time.Duration(auctionParam.BidDurationSeconds))
-	if auction.BidEndTime.After(auction.EndTime) {
-		auction.BidEndTime = auction.EndTime
-	}
+	auction.ClearingPrice = clearingPrice
+	auction.CurrentBidAmount = sdk.NewCoin(auction.AuctionedToken.Denom, clearingPrice.MulInt(filled).TruncateInt())
+	auction.ExpectedMintedToken = auction.CurrentBidAmount
 	err = k.SetDebtAuction(ctx, auction)
 	if err != nil {
 		return err
@@ -345,7 +398,29 @@ func (k Keeper) PlaceDebtAuctionBid(ctx sdk.Context, appID, auctionMappingID, au
 	return nil
 }
 
-func (k Keeper) CreateNewDebtBid(ctx sdk.Context, appID, auctionMappingID, auctionID uint64, bidder string, bid sdk.Coin, expectedUserToken sdk.Coin) (biddingID uint64, err error) {
+// evictDebtBid refunds a bid left without any fill of a full auction and moves
+// it to the history.
+func (k Keeper) evictDebtBid(ctx sdk.Context, bidding auctiontypes.DebtBiddings) error {
+	if bidding.BiddingStatus == auctiontypes.PlacedBiddingStatus {
+		bidder, err := sdk.AccAddressFromBech32(bidding.Bidder)
+		if err != nil {
+			return err
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(bidding.OutflowTokens))
+		if err != nil {
+			return err
+		}
+		bidding.BiddingStatus = auctiontypes.RejectedBiddingStatus
+	}
+	bidding.AuctionStatus = auctiontypes.ClosedAuctionStatus
+	err := k.DeleteDebtUserBidding(ctx, bidding)
+	if err != nil {
+		return err
+	}
+	return k.SetHistoryDebtUserBidding(ctx, bidding)
+}
+
+func (k Keeper) CreateNewDebtBid(ctx sdk.Context, appID, auctionMappingID, auctionID uint64, bidder string, bid sdk.Coin, expectedUserToken sdk.Coin, price sdk.Dec) (biddingID uint64, err error) {
 	bidding := auctiontypes.DebtBiddings{
 		BiddingId:        k.GetUserBiddingID(ctx) + 1,
 		AuctionId:        auctionID,
@@ -357,6 +432,8 @@ func (k Keeper) CreateNewDebtBid(ctx sdk.Context, appID, auctionMappingID, aucti
 		AppId:            appID,
 		AuctionMappingId: auctionMappingID,
 		OutflowTokens:    expectedUserToken,
+		Price:            price,
+		Filled:           sdk.NewCoin(expectedUserToken.Denom, sdk.ZeroInt()),
 	}
 
 	k.SetUserBiddingID(ctx, bidding.BiddingId)
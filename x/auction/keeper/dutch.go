@@ -34,6 +34,23 @@ func (k Keeper) DutchActivator(ctx sdk.Context, lockedVault liquidationtypes.Loc
 		outflowToken := sdk.NewCoin(assetIn.Denom, lockedVault.AmountIn) // cmdx
 		inflowToken := sdk.NewCoin(assetOut.Denom, sdk.ZeroInt())        // cmst
 
+		// basket collateral is auctioned first, in the order of the queue,
+		// pool coin collateral is auctioned as the asset it was unwound into
+		lockedVault.IsBasketAuction = len(lockedVault.CollateralQueue) > 0
+		if lockedVault.IsBasketAuction {
+			outflowToken = lockedVault.CollateralQueue[0]
+			lockedVault.CollateralQueue = lockedVault.CollateralQueue[1:]
+		} else if lockedVault.IsCollateralUnwound() {
+			outflowToken = lockedVault.UnwoundCollateral
+		}
+		if outflowToken.Denom != assetIn.Denom {
+			assetIn, found = k.asset.GetAssetForDenom(ctx, outflowToken.Denom)
+			if !found {
+				return fmt.Errorf("asset not found for denom %s", outflowToken.Denom)
+			}
+		}
+		k.liquidation.SetLockedVault(ctx, lockedVault)
+
 		liquidationPenalty := extendedPair.LiquidationPenalty
 
 		err1 := k.StartDutchAuction(ctx, outflowToken, inflowToken, lockedVault.AppId, assetOut.Id, assetIn.Id, lockedVault.LockedVaultId, lockedVault.Owner, liquidationPenalty)
@@ -65,7 +82,7 @@ func (k Keeper) StartDutchAuction(
 	if !found {
 		return auctiontypes.ErrorInvalidLockedVault
 	}
-	k.vault.DeleteUserVaultExtendedPairMapping(ctx, lockedVault.Owner, appID, lockedVault.ExtendedPairId)
+	k.vault.DeleteUserVaultExtendedPairMapping(ctx, lockedVault.Owner, appID, lockedVault.ExtendedPairId, lockedVault.OriginalVaultId)
 
 	extendedPairVault := lockedVault.ExtendedPairId
 
@@ -104,6 +121,7 @@ func (k Keeper) StartDutchAuction(
 	inFlowTokenTargetAmount := lockedVault.AmountOut
 	mulfactor := inFlowTokenTargetAmount.ToDec().Mul(liquidationPenalty)
 	inFlowTokenTargetAmount = inFlowTokenTargetAmount.Add(mulfactor.TruncateInt()).Add(lockedVault.InterestAccumulated)
+	inFlowTokenTargetAmount = inFlowTokenTargetAmount.Sub(lockedVault.RecoveredDebt())
 	inFlowTokenTarget := sdk.NewCoin(inFlowToken.Denom, inFlowTokenTargetAmount)
 	// These prices are in uusd
 	outFlowTokenInitialPrice := k.getOutflowTokenInitialPrice(sdk.NewIntFromUint64(outFlowTokenPrice), auctionParams.Buffer)
@@ -135,6 +153,7 @@ func (k Keeper) StartDutchAuction(
 		VaultOwner:                vaultOwner,
 		LiquidationPenalty:        liquidationPenalty,
 	}
+	setSealedBidWindows(&auction, auctionParams)
 	auction.AuctionId = k.GetAuctionID(ctx) + 1
 	k.SetAuctionID(ctx, auction.AuctionId)
 	err = k.SetDutchAuction(ctx, auction)
@@ -158,7 +177,7 @@ func (k Keeper) StartDutchAuction(
 		),
 	)
 
-	return nil
+	return k.fillDutchAuctionFromPreBids(ctx, auction)
 }
 
 func (k Keeper) PlaceDutchAuctionBid(ctx sdk.Context, appID, auctionMappingID, auctionID uint64, bidder sdk.AccAddress, bid sdk.Coin) error {
@@ -169,6 +188,9 @@ func (k Keeper) PlaceDutchAuctionBid(ctx sdk.Context, appID, auctionMappingID, a
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "auction id %d not found", auctionID)
 	}
+	if auction.Sealed {
+		return auctiontypes.ErrorSealedAuction
+	}
 	if bid.Denom != auction.OutflowTokenCurrentAmount.Denom {
 		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "bid denom %s not found", bid.Denom)
 	}
@@ -271,6 +293,7 @@ func (k Keeper) PlaceDutchAuctionBid(ctx sdk.Context, appID, auctionMappingID, a
 		}
 	}
 
+	k.recordAuctionFill(ctx, auction, outFlowTokenCoin, inFlowTokenCoin)
 	biddingID, err := k.CreateNewDutchBid(ctx, appID, auctionMappingID, auctionID, bidder.String(), inFlowTokenCoin, outFlowTokenCoin)
 	if err != nil {
 		return err
@@ -284,6 +307,12 @@ func (k Keeper) PlaceDutchAuctionBid(ctx sdk.Context, appID, auctionMappingID, a
 	// calculate inflow amount and outflow amount if  user  transaction successful
 	auction.OutflowTokenCurrentAmount = auction.OutflowTokenCurrentAmount.Sub(outFlowTokenCoin)
 	auction.InflowTokenCurrentAmount = auction.InflowTokenCurrentAmount.Add(inFlowTokenCoin)
+	return k.settleDutchAuction(ctx, auction, lockedVault)
+}
+
+// settleDutchAuction closes a dutch auction once its bids raised the debt
+// target or bought all of its collateral, and saves it otherwise.
+func (k Keeper) settleDutchAuction(ctx sdk.Context, auction auctiontypes.DutchAuction, lockedVault liquidationtypes.LockedVault) error {
 	// collateral not over but target cmst reached then send remaining collateral to owner
 	// if inflow token current amount >= InflowTokenTargetAmount
 	if auction.InflowTokenCurrentAmount.IsGTE(auction.InflowTokenTargetAmount) {
@@ -300,6 +329,10 @@ func (k Keeper) PlaceDutchAuctionBid(ctx sdk.Context, appID, auctionMappingID, a
 				return err
 			}
 		}
+		err = k.returnUnauctionedCollateral(ctx, lockedVault, vaultHolder)
+		if err != nil {
+			return err
+		}
 
 		err = k.SetDutchAuction(ctx, auction)
 		if err != nil {
@@ -313,14 +346,18 @@ func (k Keeper) PlaceDutchAuctionBid(ctx sdk.Context, appID, auctionMappingID, a
 			return err
 		}
 	} else if auction.OutflowTokenCurrentAmount.Amount.IsZero() && auction.InflowTokenCurrentAmount.IsLT(auction.InflowTokenTargetAmount) { // entire collateral sold out, but debt is left
+		// the vault has more collateral to auction for the debt left
+		if lockedVault.IsBasketAuction {
+			return k.startNextDutchAuction(ctx, auction, lockedVault)
+		}
+		// the debt left is bad debt of the app, covered following the bad debt waterfall
 		requiredAmount := auction.InflowTokenTargetAmount.Sub(auction.InflowTokenCurrentAmount)
-		_, err := k.collector.GetAmountFromCollector(ctx, auction.AppId, auction.AssetInId, requiredAmount.Amount)
+		outstanding, err := k.coverBadDebt(ctx, auction.AppId, 0, auction.AssetInId, "", requiredAmount)
 		if err != nil {
 			return err
 		}
-
-		// storing protocol loss
-		k.SetProtocolStatistics(ctx, auction.AppId, auction.AssetInId, requiredAmount.Amount)
+		// the outstanding debt stays minted and is not burned on close
+		auction.InflowTokenTargetAmount.Amount = auction.InflowTokenTargetAmount.Amount.Sub(outstanding)
 
 		err = k.SetDutchAuction(ctx, auction)
 		if err != nil {
@@ -333,7 +370,7 @@ func (k Keeper) PlaceDutchAuctionBid(ctx sdk.Context, appID, auctionMappingID, a
 			return err
 		}
 	} else {
-		err = k.SetDutchAuction(ctx, auction)
+		err := k.SetDutchAuction(ctx, auction)
 		if err != nil {
 			return err
 		}
@@ -341,6 +378,67 @@ func (k Keeper) PlaceDutchAuctionBid(ctx sdk.Context, appID, auctionMappingID, a
 	return nil
 }
 
+// lockedPairCollateral returns the pair collateral a locked vault held, the
+// pool coin for collateral unwound before the auction.
+func (k Keeper) lockedPairCollateral(ctx sdk.Context, lockedVault liquidationtypes.LockedVault) (sdk.Coin, error) {
+	extendedPair, found := k.asset.GetPairsVault(ctx, lockedVault.ExtendedPairId)
+	if !found {
+		return sdk.Coin{}, auctiontypes.ErrorInvalidExtendedPairVault
+	}
+	pair, _ := k.asset.GetPair(ctx, extendedPair.PairId)
+	assetIn, _ := k.asset.GetAsset(ctx, pair.AssetIn)
+	return sdk.NewCoin(assetIn.Denom, lockedVault.AmountIn), nil
+}
+
+// returnUnauctionedCollateral sends the collateral of a locked vault that
+// was not put up for auction back to its owner.
+func (k Keeper) returnUnauctionedCollateral(ctx sdk.Context, lockedVault liquidationtypes.LockedVault, owner sdk.AccAddress) error {
+	collateral := sdk.NewCoins(lockedVault.CollateralQueue...)
+	if lockedVault.IsBasketAuction {
+		if lockedVault.IsCollateralUnwound() {
+			collateral = collateral.Add(lockedVault.UnwoundCollateral)
+		} else {
+			pairCollateral, err := k.lockedPairCollateral(ctx, lockedVault)
+			if err != nil {
+				return err
+			}
+			collateral = collateral.Add(pairCollateral)
+		}
+	}
+	if collateral.IsZero() {
+		return nil
+	}
+	return k.bank.SendCoinsFromModuleToAccount(ctx, vaulttypes.ModuleName, owner, collateral)
+}
+
+// startNextDutchAuction ends the sold out basket collateral auction of a
+// locked vault, keeping the debt it raised, and auctions the next collateral
+// of the vault.
+func (k Keeper) startNextDutchAuction(ctx sdk.Context, dutchAuction auctiontypes.DutchAuction, lockedVault liquidationtypes.LockedVault) error {
+	err := k.closeDutchBiddings(ctx, dutchAuction)
+	if err != nil {
+		return err
+	}
+	lockedVault.DebtRecovered = lockedVault.RecoveredDebt().Add(dutchAuction.InflowTokenCurrentAmount.Amount)
+	k.liquidation.SetLockedVault(ctx, lockedVault)
+
+	dutchAuction.AuctionStatus = auctiontypes.AuctionEnded
+	err = k.SetDutchAuction(ctx, dutchAuction)
+	if err != nil {
+		return err
+	}
+	err = k.DeleteDutchAuction(ctx, dutchAuction)
+	if err != nil {
+		return err
+	}
+	err = k.SetHistoryDutchAuction(ctx, dutchAuction)
+	if err != nil {
+		return err
+	}
+	k.recordAuctionClose(ctx, dutchAuction, lockedVault.LiquidationTimestamp)
+	return k.DutchActivator(ctx, lockedVault)
+}
+
 func (k Keeper) CreateNewDutchBid(ctx sdk.Context, appID, auctionMappingID, auctionID uint64, bidder string, outFlowTokenCoin sdk.Coin, inFlowTokenCoin sdk.Coin) (biddingID uint64, err error) {
 	bidding := auctiontypes.DutchBiddings{
 		BiddingId:          k.GetUserBiddingID(ctx) + 1,
@@ -365,27 +463,10 @@ func (k Keeper) CreateNewDutchBid(ctx sdk.Context, appID, auctionMappingID, auct
 func (k Keeper) CloseDutchAuction(
 	ctx sdk.Context,
 	dutchAuction auctiontypes.DutchAuction,
-) error { // delete dutch biddings
-	if dutchAuction.AuctionStatus != auctiontypes.AuctionStartNoBids {
-		for _, biddingID := range dutchAuction.BiddingIds {
-			bidding, err := k.GetDutchUserBidding(ctx, biddingID.BidOwner, dutchAuction.AppId, biddingID.BidId)
-			if err != nil {
-				return err
-			}
-			bidding.AuctionStatus = auctiontypes.ClosedAuctionStatus
-			err = k.SetDutchUserBidding(ctx, bidding)
-			if err != nil {
-				return err
-			}
-			err = k.DeleteDutchUserBidding(ctx, bidding)
-			if err != nil {
-				return err
-			}
-			err = k.SetHistoryDutchUserBidding(ctx, bidding)
-			if err != nil {
-				return err
-			}
-		}
+) error {
+	err := k.closeDutchBiddings(ctx, dutchAuction)
+	if err != nil {
+		return err
 	}
 
 	lockedVault, found := k.liquidation.GetLockedVault(ctx, dutchAuction.AppId, dutchAuction.LockedVaultId)
@@ -397,7 +478,13 @@ func (k Keeper) CloseDutchAuction(
 	// burn and send target CMST to collector
 	burnToken := sdk.NewCoin(dutchAuction.InflowTokenCurrentAmount.Denom, sdk.ZeroInt())
 	burnToken.Amount = lockedVault.AmountOut
-	penaltyCoin.Amount = dutchAuction.InflowTokenTargetAmount.Amount.Sub(burnToken.Amount)
+	// the debt raised by earlier basket auctions is held by the module as well
+	penaltyCoin.Amount = dutchAuction.InflowTokenTargetAmount.Amount.Add(lockedVault.RecoveredDebt()).Sub(burnToken.Amount)
+	// bad debt left outstanding lowered the target below the debt
+	if penaltyCoin.Amount.IsNegative() {
+		burnToken.Amount = burnToken.Amount.Add(penaltyCoin.Amount)
+		penaltyCoin.Amount = sdk.ZeroInt()
+	}
 
 	// burning
 	if burnToken.Amount.GT(sdk.ZeroInt()) {
@@ -415,7 +502,16 @@ func (k Keeper) CloseDutchAuction(
 		}
 	}
 
-	err := k.UpdateProtocolData(ctx, dutchAuction.OutflowTokenInitAmount, burnToken, lockedVault.ExtendedPairId)
+	collateralToken := dutchAuction.OutflowTokenInitAmount
+	if lockedVault.IsCollateralUnwound() || lockedVault.IsBasketAuction {
+		// the pair locked the pool coin the auctioned asset was unwound from,
+		// or the pair collateral returned to the owner with the basket auction
+		collateralToken, err = k.lockedPairCollateral(ctx, lockedVault)
+		if err != nil {
+			return err
+		}
+	}
+	err = k.UpdateProtocolData(ctx, collateralToken, burnToken, lockedVault.ExtendedPairId)
 	if err != nil {
 		return err
 	}
@@ -457,11 +553,38 @@ func (k Keeper) CloseDutchAuction(
 	if err != nil {
 		return err
 	}
+	k.recordAuctionClose(ctx, dutchAuction, lockedVault.LiquidationTimestamp)
 	k.liquidation.DeleteLockedVault(ctx, lockedVault.AppId, lockedVault.LockedVaultId)
 
 	return nil
 }
 
+// closeDutchBiddings moves the biddings of a dutch auction to history.
+func (k Keeper) closeDutchBiddings(ctx sdk.Context, dutchAuction auctiontypes.DutchAuction) error {
+	if dutchAuction.AuctionStatus != auctiontypes.AuctionStartNoBids {
+		for _, biddingID := range dutchAuction.BiddingIds {
+			bidding, err := k.GetDutchUserBidding(ctx, biddingID.BidOwner, dutchAuction.AppId, biddingID.BidId)
+			if err != nil {
+				return err
+			}
+			bidding.AuctionStatus = auctiontypes.ClosedAuctionStatus
+			err = k.SetDutchUserBidding(ctx, bidding)
+			if err != nil {
+				return err
+			}
+			err = k.DeleteDutchUserBidding(ctx, bidding)
+			if err != nil {
+				return err
+			}
+			err = k.SetHistoryDutchUserBidding(ctx, bidding)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (k Keeper) RestartDutchAuctions(ctx sdk.Context, appID uint64) error {
 	auctionParams, found := k.GetAuctionParams(ctx, appID)
 	if !found {
@@ -512,27 +635,56 @@ func (k Keeper) RestartDutchAuctions(ctx sdk.Context, appID uint64) error {
 				if found {
 					status = esmStatus.Status
 				}
+				// collateral unwound from pool coins can't be put back into the
+				// vault, so such an auction is restarted a few times to recover the
+				// debt, after which the debt left is covered as bad debt,
+				// basket collateral auctions are restarted until the pair collateral is up
+				inflowCurrent := dutchAuction.InflowTokenCurrentAmount.Amount.Add(lockedVault.RecoveredDebt())
+				debt := lockedVault.AmountOut
+				if status && lockedVault.IsCollateralUnwound() && inflowCurrent.LT(debt) {
+					if dutchAuction.EsmRestarts < auctiontypes.MaxUnwoundCollateralESMRestarts {
+						dutchAuction.EsmRestarts++
+						status = false
+					} else {
+						required := sdk.NewCoin(dutchAuction.InflowTokenCurrentAmount.Denom, debt.Sub(inflowCurrent))
+						outstanding, err := k.coverBadDebt(ctx, appID, 0, dutchAuction.AssetInId, "", required)
+						if err != nil {
+							return err
+						}
+						// the outstanding debt stays minted and is not burned
+						debt = debt.Sub(outstanding)
+						inflowCurrent = inflowCurrent.Add(required.Amount).Sub(outstanding)
+					}
+				}
+				if lockedVault.IsBasketAuction {
+					status = false
+				}
 
 				if status {
+					// the auction closes before its sealed bids are settled
+					if err := k.refundSealedBids(ctx, dutchAuction); err != nil {
+						return err
+					}
 					// check user mapping of if vault exists for user
 					// if not create new vault of user with cmdx cmst
 					// if exists append in existing
 					// close auction func call
 					inflowLeft := dutchAuction.InflowTokenTargetAmount.Amount.Sub(dutchAuction.InflowTokenCurrentAmount.Amount)
-					penaltyAmt := dutchAuction.InflowTokenTargetAmount.Amount.Sub(lockedVault.AmountOut)
+					penaltyAmt := dutchAuction.InflowTokenTargetAmount.Amount.Add(lockedVault.RecoveredDebt()).Sub(lockedVault.AmountOut)
 					flag := false
-					if dutchAuction.InflowTokenCurrentAmount.Amount.GTE(lockedVault.AmountOut) {
+					if inflowCurrent.GTE(debt) {
 						flag = true
 					}
 					penaltyCoin := sdk.NewCoin(dutchAuction.InflowTokenCurrentAmount.Denom, sdk.ZeroInt())
 					// burn and send target CMST to collector
 					burnToken := sdk.NewCoin(dutchAuction.InflowTokenCurrentAmount.Denom, sdk.ZeroInt())
-					burnToken.Amount = lockedVault.AmountOut
-					penaltyCoin.Amount = dutchAuction.InflowTokenCurrentAmount.Amount.Sub(burnToken.Amount)
-					vaultID, userExists := k.vault.GetUserAppExtendedPairMappingData(ctx, dutchAuction.VaultOwner.String(), dutchAuction.AppId, lockedVault.ExtendedPairId)
+					burnToken.Amount = debt
+					penaltyCoin.Amount = inflowCurrent.Sub(burnToken.Amount)
+					userVaults, userExists := k.vault.GetUserAppExtendedPairMappingData(ctx, dutchAuction.VaultOwner.String(), dutchAuction.AppId, lockedVault.ExtendedPairId)
 					if !flag {
 						if userExists {
-							vaultData, _ := k.vault.GetVault(ctx, vaultID.VaultId)
+							// append to the owner's oldest vault on the extended pair
+							vaultData, _ := k.vault.GetVault(ctx, userVaults[0].VaultId)
 							if dutchAuction.OutflowTokenCurrentAmount.Amount.GT(sdk.ZeroInt()) {
 								err := k.bank.SendCoinsFromModuleToModule(ctx, auctiontypes.ModuleName, vaulttypes.ModuleName, sdk.NewCoins(dutchAuction.OutflowTokenCurrentAmount))
 								if err != nil {
@@ -558,7 +710,7 @@ func (k Keeper) RestartDutchAuctions(ctx sdk.Context, appID uint64) error {
 							length := k.vault.GetLengthOfVault(ctx)
 							k.vault.SetLengthOfVault(ctx, length+1)
 						}
-						burnToken.Amount = dutchAuction.InflowTokenCurrentAmount.Amount
+						burnToken.Amount = inflowCurrent
 					}
 					// burning
 					if burnToken.Amount.GT(sdk.ZeroInt()) {
@@ -604,7 +756,15 @@ func (k Keeper) RestartDutchAuctions(ctx sdk.Context, appID uint64) error {
 						// send that collateral to esm data for asset
 					}
 
-					err := k.UpdateProtocolData(ctx, dutchAuction.OutflowTokenInitAmount.Sub(dutchAuction.OutflowTokenCurrentAmount), burnToken, lockedVault.ExtendedPairId)
+					collateralToken := dutchAuction.OutflowTokenInitAmount.Sub(dutchAuction.OutflowTokenCurrentAmount)
+					if lockedVault.IsCollateralUnwound() {
+						// the pair locked the pool coin the auctioned asset was unwound from
+						collateralToken, err = k.lockedPairCollateral(ctx, lockedVault)
+						if err != nil {
+							return err
+						}
+					}
+					err := k.UpdateProtocolData(ctx, collateralToken, burnToken, lockedVault.ExtendedPairId)
 					if err != nil {
 						return err
 					}
@@ -634,6 +794,7 @@ func (k Keeper) RestartDutchAuctions(ctx sdk.Context, appID uint64) error {
 					if err != nil {
 						return err
 					}
+					k.recordAuctionClose(ctx, dutchAuction, lockedVault.LiquidationTimestamp)
 					k.liquidation.DeleteLockedVault(ctx, lockedVault.AppId, lockedVault.LockedVaultId)
 				} else {
 					twaData, found := k.market.GetTwa(ctx, dutchAuction.AssetOutId)
@@ -649,6 +810,7 @@ func (k Keeper) RestartDutchAuctions(ctx sdk.Context, appID uint64) error {
 					dutchAuction.OutflowTokenInitialPrice = outFlowTokenInitialPrice
 					dutchAuction.OutflowTokenEndPrice = outFlowTokenEndPrice
 					dutchAuction.OutflowTokenCurrentPrice = outFlowTokenInitialPrice
+					setSealedBidWindows(&dutchAuction, auctionParams)
 					err := k.SetDutchAuction(ctx, dutchAuction)
 					if err != nil {
 						return err
@@ -679,6 +841,7 @@ func (k Keeper) UpdateProtocolData(ctx sdk.Context, collateralToken, burnToken s
 }
 
 func (k Keeper) RestartDutch(ctx sdk.Context, appID uint64) error {
+	k.SettleSealedDutchAuctions(ctx, appID)
 	err := k.RestartDutchAuctions(ctx, appID)
 	if err != nil {
 		return err
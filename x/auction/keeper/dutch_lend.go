@@ -42,6 +42,16 @@ func (k Keeper) LendDutchActivator(ctx sdk.Context, lockedVault liquidationtypes
 			ctx.Logger().Error(auctiontypes.ErrorAssetRates.Error(), lockedVault.LockedVaultId)
 			return auctiontypes.ErrorAssetRates
 		}
+
+		// pool coin collateral is auctioned as the asset it was unwound into,
+		// keeping aside the liquidation bonus paid to the bidders
+		if lockedVault.IsCollateralUnwound() {
+			assetIn, found = k.asset.GetAssetForDenom(ctx, lockedVault.UnwoundCollateral.Denom)
+			if !found {
+				return auctiontypes.ErrorPrices
+			}
+			outflowToken = sdk.NewCoin(assetIn.Denom, lockedVault.UnwoundCollateral.Amount.ToDec().Quo(sdk.OneDec().Add(AssetRatesStats.LiquidationBonus)).TruncateInt())
+		}
 		liquidationPenalty := AssetRatesStats.LiquidationPenalty
 		// from here the lend dutch auction is started
 		err1 := k.StartLendDutchAuction(ctx, outflowToken, inflowToken, lockedVault, assetOut.Id, assetIn.Id, liquidationPenalty)
@@ -130,7 +140,7 @@ func (k Keeper) StartLendDutchAuction(
 		),
 	)
 
-	return nil
+	return k.fillDutchLendAuctionFromPreBids(ctx, auction)
 }
 
 func (k Keeper) PlaceLendDutchAuctionBid(ctx sdk.Context, appID, auctionMappingID, auctionID uint64, bidder sdk.AccAddress, bid sdk.Coin) error {
@@ -241,6 +251,7 @@ func (k Keeper) PlaceLendDutchAuctionBid(ctx sdk.Context, appID, auctionMappingI
 	auctionBonus := slice.ToDec().Mul(assetStats.LiquidationBonus)
 	totalAmountToBidder := sdk.NewCoin(auction.OutflowTokenInitAmount.Denom, slice.Add(auctionBonus.TruncateInt()))
 
+	k.recordAuctionFill(ctx, auction, totalAmountToBidder, inFlowTokenCoin)
 	biddingID, err := k.CreateNewDutchLendBid(ctx, appID, auctionMappingID, auctionID, bidder.String(), inFlowTokenCoin, outFlowTokenCoin)
 	if err != nil {
 		return err
@@ -255,6 +266,16 @@ func (k Keeper) PlaceLendDutchAuctionBid(ctx sdk.Context, appID, auctionMappingI
 	auction.OutflowTokenCurrentAmount = auction.OutflowTokenCurrentAmount.Sub(outFlowTokenCoin)
 	auction.InflowTokenCurrentAmount = auction.InflowTokenCurrentAmount.Add(inFlowTokenCoin)
 
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(totalAmountToBidder))
+	if err != nil {
+		return err
+	}
+	return k.settleDutchLendAuction(ctx, auction, lockedVault, assetOutPool)
+}
+
+// settleDutchLendAuction closes a lend dutch auction once its bids raised the
+// debt target or bought all of its collateral, and saves it otherwise.
+func (k Keeper) settleDutchLendAuction(ctx sdk.Context, auction auctiontypes.DutchAuction, lockedVault liquidationtypes.LockedVault, assetOutPool lendtypes.Pool) error {
 	// collateral not over but target cmst reached then send remaining collateral to owner
 	// if inflow token current amount >= InflowTokenTargetAmount
 	if auction.InflowTokenCurrentAmount.IsGTE(auction.InflowTokenTargetAmount) {
@@ -269,10 +290,6 @@ func (k Keeper) PlaceLendDutchAuctionBid(ctx sdk.Context, appID, auctionMappingI
 				return err
 			}
 		}
-		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(totalAmountToBidder))
-		if err != nil {
-			return err
-		}
 		err = k.SetDutchLendAuction(ctx, auction)
 		if err != nil {
 			return err
@@ -283,24 +300,13 @@ func (k Keeper) PlaceLendDutchAuctionBid(ctx sdk.Context, appID, auctionMappingI
 			return err
 		}
 	} else if auction.OutflowTokenCurrentAmount.Amount.IsZero() && auction.InflowTokenCurrentAmount.IsLT(auction.InflowTokenTargetAmount) { // entire collateral sold out
-		// take requiredAmount from reserve-pool
+		// the debt left is bad debt of the pool, covered following the bad debt waterfall
 		requiredAmount := auction.InflowTokenTargetAmount.Sub(auction.InflowTokenCurrentAmount)
-		// get reserve balance if the requiredAmount is available in the reserves or not
-		modBal := k.lend.ModuleBalance(ctx, lendtypes.ModuleName, requiredAmount.Denom)
-		if modBal.LT(requiredAmount.Amount) {
-			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "Reserve pool having insufficient balance for this bid")
-		}
-
-		// reduce the qty from reserve pool
 		pairID := lockedVault.ExtendedPairId
 		lendPair, _ := k.lend.GetLendPair(ctx, pairID)
 		inFlowTokenAssetID := lendPair.AssetOut
 
-		err = k.lend.UpdateReserveBalances(ctx, inFlowTokenAssetID, assetOutPool.ModuleName, requiredAmount, false)
-		if err != nil {
-			return err
-		}
-		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(totalAmountToBidder))
+		_, err := k.coverBadDebt(ctx, lockedVault.AppId, assetOutPool.PoolID, inFlowTokenAssetID, assetOutPool.ModuleName, requiredAmount)
 		if err != nil {
 			return err
 		}
@@ -309,31 +315,13 @@ func (k Keeper) PlaceLendDutchAuctionBid(ctx sdk.Context, appID, auctionMappingI
 		if err != nil {
 			return err
 		}
-		allReserveStats, found := k.lend.GetAllReserveStatsByAssetID(ctx, inFlowTokenAssetID)
-		if !found {
-			allReserveStats = lendtypes.AllReserveStats{
-				AssetID:                        inFlowTokenAssetID,
-				AmountOutFromReserveToLenders:  sdk.ZeroInt(),
-				AmountOutFromReserveForAuction: sdk.ZeroInt(),
-				AmountInFromLiqPenalty:         sdk.ZeroInt(),
-				AmountInFromRepayments:         sdk.ZeroInt(),
-				TotalAmountOutToLenders:        sdk.ZeroInt(),
-			}
-		}
-		allReserveStats.AmountOutFromReserveForAuction = allReserveStats.AmountOutFromReserveForAuction.Add(requiredAmount.Amount)
-		k.lend.SetAllReserveStatsByAssetID(ctx, allReserveStats)
 		// remove dutch auction
 		err = k.CloseDutchLendAuction(ctx, auction)
 		if err != nil {
 			return err
 		}
 	} else {
-		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(totalAmountToBidder))
-		if err != nil {
-			return err
-		}
-
-		err = k.SetDutchLendAuction(ctx, auction)
+		err := k.SetDutchLendAuction(ctx, auction)
 		if err != nil {
 			return err
 		}
@@ -429,6 +417,7 @@ func (k Keeper) CloseDutchLendAuction(
 	if err != nil {
 		return err
 	}
+	k.recordAuctionClose(ctx, dutchAuction, lockedVault.LiquidationTimestamp)
 	err = k.liquidation.UnLiquidateLockedBorrows(ctx, lockedVault.AppId, lockedVault.LockedVaultId, dutchAuction)
 	if err != nil {
 		return err
@@ -7,6 +7,7 @@ import (
 	auctionKeeper "github.com/comdex-official/comdex/x/auction/keeper"
 	auctionTypes "github.com/comdex-official/comdex/x/auction/types"
 	collectorTypes "github.com/comdex-official/comdex/x/collector/types"
+	esmtypes "github.com/comdex-official/comdex/x/esm/types"
 	liquidationTypes "github.com/comdex-official/comdex/x/liquidation/types"
 	markettypes "github.com/comdex-official/comdex/x/market/types"
 	vaultKeeper1 "github.com/comdex-official/comdex/x/vault/keeper"
@@ -560,6 +561,52 @@ func (s *KeeperTestSuite) TestCloseDutchAuctionWithProtocolLoss() {
 	s.Require().True(found)
 	loss := afterAuction.InflowTokenTargetAmount.Sub(afterAuction.InflowTokenCurrentAmount).Amount.ToDec()
 	s.Require().Equal(loss, stats.Loss)
+
+	// the collector covered all of the bad debt
+	badDebt, found := k.GetBadDebt(*ctx, appId, 0, 2)
+	s.Require().True(found)
+	s.Require().Equal(loss.TruncateInt(), badDebt.Recorded)
+	s.Require().Equal(badDebt.Recorded, badDebt.CoveredByCollector)
+	s.Require().True(badDebt.Outstanding.IsZero())
+}
+
+func (s *KeeperTestSuite) TestCloseDutchAuctionWithOutstandingBadDebt() {
+	userAddress1 := "cosmos1q7q90qsl9g0gl2zz0njxwv2a649yqrtyxtnv3v"
+	s.TestDutchBid()
+	k, ctx := &s.keeper, &s.ctx
+	appId := uint64(1)
+	auctionMappingId := uint64(3)
+	auctionId := uint64(1)
+	server := auctionKeeper.NewMsgServiceServer(*k)
+	beforeAuction, err := k.GetDutchAuction(*ctx, appId, auctionMappingId, auctionId)
+	s.Require().NoError(err)
+	s.advanceseconds(250)
+
+	auction.BeginBlocker(*ctx, s.app.AuctionKeeper, s.app.AssetKeeper, s.app.CollectorKeeper, s.app.EsmKeeper)
+
+	// the collector only holds the fees collected so far, so the bid closes
+	// the auction with the rest of the bad debt left outstanding
+	_, err = server.MsgPlaceDutchBid(sdk.WrapSDKContext(*ctx),
+		&auctionTypes.MsgPlaceDutchBidRequest{
+			AuctionId:        1,
+			Bidder:           userAddress1,
+			Amount:           beforeAuction.OutflowTokenCurrentAmount,
+			AppId:            appId,
+			AuctionMappingId: auctionMappingId,
+		})
+	s.Require().NoError(err)
+
+	_, err = k.GetDutchAuction(*ctx, appId, auctionMappingId, auctionId)
+	s.Require().Error(err)
+
+	badDebt, found := k.GetBadDebt(*ctx, appId, 0, 2)
+	s.Require().True(found)
+	s.Require().True(badDebt.CoveredByCollector.IsPositive())
+	s.Require().True(badDebt.Outstanding.IsPositive())
+	s.Require().Equal(badDebt.Recorded, badDebt.CoveredByCollector.Add(badDebt.Outstanding))
+	stats, found := k.GetProtocolStat(*ctx, appId, 2)
+	s.Require().True(found)
+	s.Require().Equal(badDebt.CoveredByCollector.ToDec(), stats.Loss)
 }
 
 func (s *KeeperTestSuite) TestRestartDutchAuction() {
@@ -623,3 +670,43 @@ func (s *KeeperTestSuite) TestRestartDutchAuction() {
 
 	s.Require().Equal(dutchAuction.OutflowTokenCurrentPrice, startPrice.Mul(sdk.MustNewDecFromStr("0.8")))
 }
+
+func (s *KeeperTestSuite) TestRestartUnwoundDutchAuctionAfterESM() {
+	s.TestDutchBid()
+	k, liquidationKeeper, ctx := &s.keeper, &s.liquidationKeeper, &s.ctx
+	appId := uint64(1)
+	auctionMappingId := uint64(3)
+	auctionId := uint64(1)
+	dutchAuction, err := k.GetDutchAuction(*ctx, appId, auctionMappingId, auctionId)
+	s.Require().NoError(err)
+
+	// the auctioned collateral was unwound from a pool coin and can't go back
+	// to the vault after the emergency shutdown
+	lockedVault, found := liquidationKeeper.GetLockedVault(*ctx, appId, dutchAuction.LockedVaultId)
+	s.Require().True(found)
+	lockedVault.UnwoundCollateral = dutchAuction.OutflowTokenInitAmount
+	liquidationKeeper.SetLockedVault(*ctx, lockedVault)
+	s.app.EsmKeeper.SetESMStatus(*ctx, esmtypes.ESMStatus{AppId: appId, Status: true})
+	s.app.EsmKeeper.SetSnapshotOfPrices(*ctx, appId, dutchAuction.AssetOutId, 1000000)
+
+	for i := uint64(1); i <= auctionTypes.MaxUnwoundCollateralESMRestarts; i++ {
+		s.advanceseconds(301)
+		s.Require().NoError(k.RestartDutchAuctions(*ctx, appId))
+		dutchAuction, err = k.GetDutchAuction(*ctx, appId, auctionMappingId, auctionId)
+		s.Require().NoError(err)
+		s.Require().Equal(i, dutchAuction.EsmRestarts)
+	}
+
+	// past the restarts the debt left is covered as bad debt and the auction closes
+	s.advanceseconds(301)
+	s.Require().NoError(k.RestartDutchAuctions(*ctx, appId))
+	_, err = k.GetDutchAuction(*ctx, appId, auctionMappingId, auctionId)
+	s.Require().Error(err)
+	_, found = liquidationKeeper.GetLockedVault(*ctx, appId, dutchAuction.LockedVaultId)
+	s.Require().False(found)
+
+	badDebt, found := k.GetBadDebt(*ctx, appId, 0, dutchAuction.AssetInId)
+	s.Require().True(found)
+	s.Require().Equal(lockedVault.AmountOut.Sub(dutchAuction.InflowTokenCurrentAmount.Amount), badDebt.Recorded)
+	s.Require().Equal(badDebt.Recorded, badDebt.CoveredByCollector.Add(badDebt.Outstanding))
+}
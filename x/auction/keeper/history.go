@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auctiontypes "github.com/comdex-official/comdex/x/auction/types"
+)
+
+func (k Keeper) SetHistoryIndex(ctx sdk.Context, index auctiontypes.AuctionHistoryIndex) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.HistoryIndexKey(index)
+		value = k.cdc.MustMarshal(&index)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) DeleteHistoryIndex(ctx sdk.Context, index auctiontypes.AuctionHistoryIndex) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.HistoryIndexKey(index)
+	)
+	store.Delete(key)
+}
+
+// GetHistoryIndexes returns up to limit auctions that moved to history at or
+// before closedBefore, oldest first.
+func (k Keeper) GetHistoryIndexes(ctx sdk.Context, closedBefore time.Time, limit uint64) (indexes []auctiontypes.AuctionHistoryIndex) {
+	var (
+		store = k.Store(ctx)
+		iter  = store.Iterator(auctiontypes.HistoryIndexKeyPrefix, sdk.InclusiveEndBytes(auctiontypes.HistoryIndexTimeKey(closedBefore)))
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid() && uint64(len(indexes)) < limit; iter.Next() {
+		var index auctiontypes.AuctionHistoryIndex
+		k.cdc.MustUnmarshal(iter.Value(), &index)
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
+
+// historyAuctionKey returns the key the auction of a history index is kept under.
+func historyAuctionKey(index auctiontypes.AuctionHistoryIndex) []byte {
+	if index.Lend {
+		return auctiontypes.HistoryLendAuctionKey(index.AppId, index.AuctionType, index.AuctionId)
+	}
+	return auctiontypes.HistoryAuctionKey(index.AppId, index.AuctionType, index.AuctionId)
+}
+
+// historyBiddingIDs returns the bids of a history auction of the given type,
+// with the bids evicted from surplus and debt auctions.
+func (k Keeper) historyBiddingIDs(auctionType string, value []byte) []*auctiontypes.BidOwnerMapping {
+	switch auctionType {
+	case auctiontypes.SurplusString:
+		var auction auctiontypes.SurplusAuction
+		k.cdc.MustUnmarshal(value, &auction)
+		return append(auction.BiddingIds, auction.EvictedBiddingIds...)
+	case auctiontypes.DebtString:
+		var auction auctiontypes.DebtAuction
+		k.cdc.MustUnmarshal(value, &auction)
+		return append(auction.BiddingIds, auction.EvictedBiddingIds...)
+	default:
+		var auction auctiontypes.DutchAuction
+		k.cdc.MustUnmarshal(value, &auction)
+		return auction.BiddingIds
+	}
+}
+
+// deleteHistoryAuction removes a closed auction and its bids from history.
+func (k Keeper) deleteHistoryAuction(ctx sdk.Context, index auctiontypes.AuctionHistoryIndex) {
+	var (
+		store = k.Store(ctx)
+		key   = historyAuctionKey(index)
+		value = store.Get(key)
+	)
+	if value == nil {
+		return
+	}
+
+	for _, bid := range k.historyBiddingIDs(index.AuctionType, value) {
+		if index.Lend {
+			store.Delete(auctiontypes.HistoryLendUserKey(bid.BidOwner, index.AppId, index.AuctionType, bid.BidId))
+		} else {
+			store.Delete(auctiontypes.HistoryUserKey(bid.BidOwner, index.AppId, index.AuctionType, bid.BidId))
+		}
+	}
+	store.Delete(key)
+}
+
+// PruneAuctionHistory removes the auctions, with their bids, that have been in
+// history for longer than the retention period, at most the prune limit of
+// them a block.
+func (k Keeper) PruneAuctionHistory(ctx sdk.Context) {
+	retention := k.HistoryRetentionSeconds(ctx)
+	if retention == 0 {
+		return
+	}
+
+	closedBefore := ctx.BlockTime().Add(-time.Second * time.Duration(retention))
+	indexes := k.GetHistoryIndexes(ctx, closedBefore, k.HistoryPruneLimit(ctx))
+	if len(indexes) == 0 {
+		return
+	}
+	for _, index := range indexes {
+		k.deleteHistoryAuction(ctx, index)
+		k.DeleteHistoryIndex(ctx, index)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			auctiontypes.EventTypeAuctionHistoryPruned,
+			sdk.NewAttribute(auctiontypes.DataCount, strconv.Itoa(len(indexes))),
+		),
+	)
+}
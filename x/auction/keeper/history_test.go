@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/auction"
+	auctionKeeper "github.com/comdex-official/comdex/x/auction/keeper"
+	auctionTypes "github.com/comdex-official/comdex/x/auction/types"
+)
+
+func (s *KeeperTestSuite) TestAuctionHistory() {
+	s.TestDutchActivator()
+	k, ctx := &s.keeper, &s.ctx
+	server := auctionKeeper.NewMsgServiceServer(*k)
+	queryServer := auctionKeeper.NewQueryServer(*k)
+	owner := "cosmos1q7q90qsl9g0gl2zz0njxwv2a649yqrtyxtnv3v"
+	bidder := sdk.AccAddress("history_bidder______")
+
+	// The whole lot is bid for 10 seconds after the vault was liquidated, the
+	// target of 1120000ucmst is raised with 933333ucmdx and the rest is unsold.
+	s.advanceseconds(10)
+	s.fundAddr(bidder, ParseCoin("2000000ucmst"))
+	_, err := server.MsgPlaceDutchBid(sdk.WrapSDKContext(*ctx), &auctionTypes.MsgPlaceDutchBidRequest{
+		AuctionId: 1, Bidder: bidder.String(), Amount: ParseCoin("1000000ucmdx"), AppId: 1, AuctionMappingId: 3,
+	})
+	s.Require().NoError(err)
+	_, err = k.GetHistoryDutchAuction(*ctx, 1, 3, 1)
+	s.Require().NoError(err)
+	s.Require().Len(k.GetHistoryDutchUserBiddings(*ctx, bidder.String(), 1), 1)
+	closedAt := ctx.BlockTime()
+
+	analytics, err := queryServer.QueryAuctionAnalytics(sdk.WrapSDKContext(*ctx), &auctionTypes.QueryAuctionAnalyticsRequest{AppId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal([]auctionTypes.AuctionAnalytics{{
+		AppId:                     1,
+		AssetId:                   1,
+		Fills:                     1,
+		AuctionsClosed:            1,
+		AverageDiscount:           sdk.OneDec().Sub(sdk.NewDec(1120000).Quo(sdk.NewDec(933333))),
+		AverageTimeToClearSeconds: 10,
+		AverageUnsoldShare:        sdk.MustNewDecFromStr("0.066667"),
+	}}, analytics.Analytics)
+	analytics, err = queryServer.QueryAuctionAnalytics(sdk.WrapSDKContext(*ctx), &auctionTypes.QueryAuctionAnalyticsRequest{AppId: 1, AssetId: 2})
+	s.Require().NoError(err)
+	s.Require().Empty(analytics.Analytics)
+
+	queryHistory := func(req auctionTypes.QueryAuctionHistoryRequest) []auctionTypes.DutchAuction {
+		req.AppId = 1
+		res, err := queryServer.QueryAuctionHistory(sdk.WrapSDKContext(*ctx), &req)
+		s.Require().NoError(err)
+		s.Require().Empty(res.SurplusAuctions)
+		s.Require().Empty(res.DebtAuctions)
+		s.Require().Empty(res.DutchLendAuctions)
+		return res.DutchAuctions
+	}
+	s.Require().Len(queryHistory(auctionTypes.QueryAuctionHistoryRequest{}), 1)
+	s.Require().Len(queryHistory(auctionTypes.QueryAuctionHistoryRequest{AuctionType: auctionTypes.DutchString, AssetId: 1, VaultOwner: owner, LockedVaultId: 1}), 1)
+	s.Require().Len(queryHistory(auctionTypes.QueryAuctionHistoryRequest{StartTime: closedAt, EndTime: closedAt.Add(1)}), 1)
+	s.Require().Empty(queryHistory(auctionTypes.QueryAuctionHistoryRequest{AuctionType: auctionTypes.SurplusString}))
+	s.Require().Empty(queryHistory(auctionTypes.QueryAuctionHistoryRequest{AssetId: 3}))
+	s.Require().Empty(queryHistory(auctionTypes.QueryAuctionHistoryRequest{VaultOwner: bidder.String()}))
+	s.Require().Empty(queryHistory(auctionTypes.QueryAuctionHistoryRequest{LockedVaultId: 2}))
+	s.Require().Empty(queryHistory(auctionTypes.QueryAuctionHistoryRequest{EndTime: closedAt}))
+	_, err = queryServer.QueryAuctionHistory(sdk.WrapSDKContext(*ctx), &auctionTypes.QueryAuctionHistoryRequest{AppId: 1, AuctionType: "english"})
+	s.Require().Error(err)
+
+	// History is kept for 100 seconds once a retention is set.
+	k.SetParams(*ctx, auctionTypes.NewParams(100, 10, auctionTypes.DefaultPreBidActivationSeconds, auctionTypes.DefaultPreBidMaxPremium, auctionTypes.DefaultPreBidMinAmount))
+	s.advanceseconds(100)
+	auction.BeginBlocker(*ctx, s.app.AuctionKeeper, s.app.AssetKeeper, s.app.CollectorKeeper, s.app.EsmKeeper)
+	s.Require().Len(queryHistory(auctionTypes.QueryAuctionHistoryRequest{}), 1)
+
+	s.advanceseconds(1)
+	auction.BeginBlocker(*ctx, s.app.AuctionKeeper, s.app.AssetKeeper, s.app.CollectorKeeper, s.app.EsmKeeper)
+	_, err = k.GetHistoryDutchAuction(*ctx, 1, 3, 1)
+	s.Require().Error(err)
+	s.Require().Empty(k.GetHistoryDutchUserBiddings(*ctx, bidder.String(), 1))
+	s.Require().Empty(queryHistory(auctionTypes.QueryAuctionHistoryRequest{}))
+
+	// The analytics outlive the pruned history.
+	_, found := k.GetAuctionStats(*ctx, 1, 1)
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestAuctionHistoryPrunesEvictedBids() {
+	s.TestSurplusBatchAuctionBidCap()
+	k, ctx := &s.keeper, &s.ctx
+	k.SetParams(*ctx, auctionTypes.NewParams(100, 10, auctionTypes.DefaultPreBidActivationSeconds, auctionTypes.DefaultPreBidMaxPremium, auctionTypes.DefaultPreBidMinAmount))
+
+	// The auction with its evicted bid closes to history, then is pruned.
+	s.advanceseconds(301)
+	auction.BeginBlocker(*ctx, s.app.AuctionKeeper, s.app.AssetKeeper, s.app.CollectorKeeper, s.app.EsmKeeper)
+	history, err := k.GetHistorySurplusAuction(*ctx, 1, 1, 1)
+	s.Require().NoError(err)
+	s.Require().Len(history.EvictedBiddingIds, 1)
+
+	s.advanceseconds(101)
+	auction.BeginBlocker(*ctx, s.app.AuctionKeeper, s.app.AssetKeeper, s.app.CollectorKeeper, s.app.EsmKeeper)
+	store := k.Store(*ctx)
+	for _, prefix := range [][]byte{auctionTypes.HistoryAuctionKeyPrefix, auctionTypes.HistoryUserKeyPrefix, auctionTypes.HistoryIndexKeyPrefix} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		s.Require().False(iter.Valid())
+		s.Require().NoError(iter.Close())
+	}
+}
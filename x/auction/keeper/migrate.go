@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/auction/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	//  History is pruned and queried by the time auctions closed, which was
+	//  not kept before, so the auctions already in history are indexed by
+	//  their end time.
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	for _, index := range m.keeper.historyIndexesFromStore(ctx, types.HistoryAuctionKeyPrefix, false) {
+		m.keeper.SetHistoryIndex(ctx, index)
+	}
+	for _, index := range m.keeper.historyIndexesFromStore(ctx, types.LendHistoryAuctionKeyPrefix, true) {
+		m.keeper.SetHistoryIndex(ctx, index)
+	}
+	return nil
+}
+
+// historyIndexesFromStore indexes the history auctions under prefix, keyed by
+// app id, auction type and auction id, by their end time or the block time if
+// that is earlier.
+func (k Keeper) historyIndexesFromStore(ctx sdk.Context, prefix []byte, lend bool) (indexes []types.AuctionHistoryIndex) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, prefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(prefix):]
+		if len(key) <= 16 {
+			continue
+		}
+		index := types.AuctionHistoryIndex{
+			AppId:       sdk.BigEndianToUint64(key[:8]),
+			AuctionType: string(key[8 : len(key)-8]),
+			Lend:        lend,
+			AuctionId:   sdk.BigEndianToUint64(key[len(key)-8:]),
+			ClosedAt:    ctx.BlockTime(),
+		}
+		switch index.AuctionType {
+		case types.SurplusString:
+			var auction types.SurplusAuction
+			k.cdc.MustUnmarshal(iter.Value(), &auction)
+			index.ClosedAt = auction.EndTime
+		case types.DebtString:
+			var auction types.DebtAuction
+			k.cdc.MustUnmarshal(iter.Value(), &auction)
+			index.ClosedAt = auction.EndTime
+		default:
+			var auction types.DutchAuction
+			k.cdc.MustUnmarshal(iter.Value(), &auction)
+			index.ClosedAt = auction.EndTime
+		}
+		if index.ClosedAt.After(ctx.BlockTime()) {
+			index.ClosedAt = ctx.BlockTime()
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
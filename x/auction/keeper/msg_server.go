@@ -24,7 +24,7 @@ func (k msgServer) MsgPlaceSurplusBid(goCtx context.Context, msg *types.MsgPlace
 	if err != nil {
 		return nil, err
 	}
-	err = k.PlaceSurplusAuctionBid(ctx, msg.AppId, msg.AuctionMappingId, msg.AuctionId, bidder, msg.Amount)
+	err = k.PlaceSurplusAuctionBid(ctx, msg.AppId, msg.AuctionMappingId, msg.AuctionId, bidder, msg.Amount, msg.Quantity)
 	if err != nil {
 		return nil, err
 	}
@@ -73,3 +73,57 @@ func (k msgServer) MsgPlaceDutchLendBid(goCtx context.Context, msg *types.MsgPla
 	ctx.GasMeter().ConsumeGas(types.DutchLendBidGas, "DutchLendBidGas")
 	return &types.MsgPlaceDutchLendBidResponse{}, nil
 }
+
+func (k msgServer) MsgCommitDutchBid(goCtx context.Context, msg *types.MsgCommitDutchBidRequest) (*types.MsgCommitDutchBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+	biddingID, err := k.CommitDutchAuctionBid(ctx, msg.AppId, msg.AuctionMappingId, msg.AuctionId, bidder, msg.Commitment, msg.Escrow)
+	if err != nil {
+		return nil, err
+	}
+	ctx.GasMeter().ConsumeGas(types.DutchBidGas, "DutchBidGas")
+	return &types.MsgCommitDutchBidResponse{BiddingId: biddingID}, nil
+}
+
+func (k msgServer) MsgRevealDutchBid(goCtx context.Context, msg *types.MsgRevealDutchBidRequest) (*types.MsgRevealDutchBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+	err = k.RevealDutchAuctionBid(ctx, msg.AppId, msg.AuctionMappingId, msg.AuctionId, bidder, msg.BiddingId, msg.Quantity, msg.Payment, msg.Salt)
+	if err != nil {
+		return nil, err
+	}
+	ctx.GasMeter().ConsumeGas(types.DutchBidGas, "DutchBidGas")
+	return &types.MsgRevealDutchBidResponse{}, nil
+}
+
+func (k msgServer) MsgSubmitPreBid(goCtx context.Context, msg *types.MsgSubmitPreBidRequest) (*types.MsgSubmitPreBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+	preBidID, err := k.SubmitPreBid(ctx, msg.AppId, msg.CollateralAssetId, msg.DebtAssetId, bidder, msg.Premium, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitPreBidResponse{PreBidId: preBidID}, nil
+}
+
+func (k msgServer) MsgWithdrawPreBid(goCtx context.Context, msg *types.MsgWithdrawPreBidRequest) (*types.MsgWithdrawPreBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+	err = k.WithdrawPreBid(ctx, msg.PreBidId, bidder, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWithdrawPreBidResponse{}, nil
+}
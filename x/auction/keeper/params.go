@@ -6,9 +6,50 @@ import (
 	"github.com/comdex-official/comdex/x/auction/types"
 )
 
+// HistoryRetentionSeconds returns how long closed auctions are kept in history.
+func (k Keeper) HistoryRetentionSeconds(ctx sdk.Context) (res uint64) {
+	res = types.DefaultHistoryRetentionSeconds
+	k.paramstore.GetIfExists(ctx, types.KeyHistoryRetentionSeconds, &res)
+	return
+}
+
+// HistoryPruneLimit returns the most closed auctions pruned from history in a block.
+func (k Keeper) HistoryPruneLimit(ctx sdk.Context) (res uint64) {
+	res = types.DefaultHistoryPruneLimit
+	k.paramstore.GetIfExists(ctx, types.KeyHistoryPruneLimit, &res)
+	return
+}
+
+// PreBidActivationSeconds returns how long after submission pre-bids start to fill liquidations.
+func (k Keeper) PreBidActivationSeconds(ctx sdk.Context) (res uint64) {
+	res = types.DefaultPreBidActivationSeconds
+	k.paramstore.GetIfExists(ctx, types.KeyPreBidActivationSeconds, &res)
+	return
+}
+
+// PreBidMaxPremium returns the highest premium slot of the pre-bid queues.
+func (k Keeper) PreBidMaxPremium(ctx sdk.Context) (res uint64) {
+	res = types.DefaultPreBidMaxPremium
+	k.paramstore.GetIfExists(ctx, types.KeyPreBidMaxPremium, &res)
+	return
+}
+
+// PreBidMinAmount returns the least debt token a pre-bid holds.
+func (k Keeper) PreBidMinAmount(ctx sdk.Context) (res uint64) {
+	res = types.DefaultPreBidMinAmount
+	k.paramstore.GetIfExists(ctx, types.KeyPreBidMinAmount, &res)
+	return
+}
+
 // GetParams get all parameters as types.Params.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.HistoryRetentionSeconds(ctx),
+		k.HistoryPruneLimit(ctx),
+		k.PreBidActivationSeconds(ctx),
+		k.PreBidMaxPremium(ctx),
+		k.PreBidMinAmount(ctx),
+	)
 }
 
 // SetParams set the params.
@@ -0,0 +1,475 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	protobuftypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
+	auctiontypes "github.com/comdex-official/comdex/x/auction/types"
+)
+
+func (k Keeper) GetPreBidID(ctx sdk.Context) uint64 {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.PreBidIDKey
+		value = store.Get(key)
+	)
+	if value == nil {
+		return 0
+	}
+	var id protobuftypes.UInt64Value
+	k.cdc.MustUnmarshal(value, &id)
+
+	return id.GetValue()
+}
+
+func (k Keeper) SetPreBidID(ctx sdk.Context, id uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.PreBidIDKey
+		value = k.cdc.MustMarshal(
+			&protobuftypes.UInt64Value{
+				Value: id,
+			},
+		)
+	)
+
+	store.Set(key, value)
+}
+
+// SetPreBid saves a pre-bid and queues it at its premium slot.
+func (k Keeper) SetPreBid(ctx sdk.Context, bid auctiontypes.PreBid) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.PreBidKey(bid.Id)
+		value = k.cdc.MustMarshal(&bid)
+	)
+	store.Set(key, value)
+	store.Set(auctiontypes.PreBidQueueKey(bid.AppId, bid.CollateralAssetId, bid.DebtAssetId, bid.Premium, bid.Id), sdk.Uint64ToBigEndian(bid.Id))
+}
+
+func (k Keeper) GetPreBid(ctx sdk.Context, id uint64) (bid auctiontypes.PreBid, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.PreBidKey(id)
+		value = store.Get(key)
+	)
+	if value == nil {
+		return bid, false
+	}
+	k.cdc.MustUnmarshal(value, &bid)
+	return bid, true
+}
+
+func (k Keeper) DeletePreBid(ctx sdk.Context, bid auctiontypes.PreBid) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.PreBidKey(bid.Id)
+	)
+	store.Delete(key)
+	store.Delete(auctiontypes.PreBidQueueKey(bid.AppId, bid.CollateralAssetId, bid.DebtAssetId, bid.Premium, bid.Id))
+}
+
+func (k Keeper) GetAllPreBids(ctx sdk.Context) (bids []auctiontypes.PreBid) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, auctiontypes.PreBidKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var bid auctiontypes.PreBid
+		k.cdc.MustUnmarshal(iter.Value(), &bid)
+		bids = append(bids, bid)
+	}
+	return bids
+}
+
+// GetPreBidQueue returns the pre-bids on a collateral and debt asset of an app
+// in the order they fill liquidations, lowest premium first and in the order
+// they were submitted within a premium slot.
+func (k Keeper) GetPreBidQueue(ctx sdk.Context, appID, collateralAssetID, debtAssetID uint64) (bids []auctiontypes.PreBid) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, auctiontypes.PreBidQueuePairKey(appID, collateralAssetID, debtAssetID))
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		bid, found := k.GetPreBid(ctx, sdk.BigEndianToUint64(iter.Value()))
+		if found {
+			bids = append(bids, bid)
+		}
+	}
+	return bids
+}
+
+// preBidQueueHead returns up to limit pre-bids from the front of the pre-bid
+// queue of a collateral and debt asset of an app.
+func (k Keeper) preBidQueueHead(ctx sdk.Context, appID, collateralAssetID, debtAssetID uint64, limit int) (bids []auctiontypes.PreBid) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, auctiontypes.PreBidQueuePairKey(appID, collateralAssetID, debtAssetID))
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid() && len(bids) < limit; iter.Next() {
+		bid, found := k.GetPreBid(ctx, sdk.BigEndianToUint64(iter.Value()))
+		if found {
+			bids = append(bids, bid)
+		}
+	}
+	return bids
+}
+
+// SubmitPreBid deposits debt token in the pre-bid queue of a collateral and
+// debt asset of an app. The pre-bid fills liquidations once the activation
+// delay has passed.
+func (k Keeper) SubmitPreBid(ctx sdk.Context, appID, collateralAssetID, debtAssetID uint64, bidder sdk.AccAddress, premium uint64, amount sdk.Coin) (uint64, error) {
+	if _, found := k.asset.GetApp(ctx, appID); !found {
+		return 0, assettypes.AppIdsDoesntExist
+	}
+	if maxPremium := k.PreBidMaxPremium(ctx); premium > maxPremium {
+		return 0, sdkerrors.Wrapf(auctiontypes.ErrorInvalidPreBidPremium, "premium %d, highest slot %d", premium, maxPremium)
+	}
+	collateralAsset, found := k.asset.GetAsset(ctx, collateralAssetID)
+	if !found {
+		return 0, assettypes.ErrorAssetDoesNotExist
+	}
+	debtAsset, found := k.asset.GetAsset(ctx, debtAssetID)
+	if !found {
+		return 0, assettypes.ErrorAssetDoesNotExist
+	}
+	if amount.Denom != debtAsset.Denom {
+		return 0, sdkerrors.Wrapf(auctiontypes.ErrorInvalidPreBidDenom, "denom %s, expected %s", amount.Denom, debtAsset.Denom)
+	}
+	if minAmount := sdk.NewIntFromUint64(k.PreBidMinAmount(ctx)); amount.Amount.LT(minAmount) {
+		return 0, sdkerrors.Wrapf(auctiontypes.ErrorPreBidTooSmall, "amount %s, minimum %s", amount.Amount, minAmount)
+	}
+
+	err := k.bank.SendCoinsFromAccountToModule(ctx, bidder, auctiontypes.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return 0, err
+	}
+
+	bid := auctiontypes.PreBid{
+		Id:                k.GetPreBidID(ctx) + 1,
+		AppId:             appID,
+		CollateralAssetId: collateralAssetID,
+		DebtAssetId:       debtAssetID,
+		Bidder:            bidder.String(),
+		Premium:           premium,
+		Amount:            amount,
+		Filled:            sdk.NewCoin(collateralAsset.Denom, sdk.ZeroInt()),
+		SubmittedAt:       ctx.BlockTime(),
+		ActiveAt:          ctx.BlockTime().Add(time.Second * time.Duration(k.PreBidActivationSeconds(ctx))),
+	}
+	k.SetPreBidID(ctx, bid.Id)
+	k.SetPreBid(ctx, bid)
+
+	k.emitPreBidEvent(ctx, auctiontypes.EventTypePreBidSubmit, bid, amount)
+	return bid.Id, nil
+}
+
+// WithdrawPreBid returns debt token left in a pre-bid to its bidder, all of it
+// when amount is zero, and removes the pre-bid once it is empty. A pre-bid
+// left holding less than the minimum amount has to be withdrawn in full.
+func (k Keeper) WithdrawPreBid(ctx sdk.Context, id uint64, bidder sdk.AccAddress, amount sdk.Coin) error {
+	bid, found := k.GetPreBid(ctx, id)
+	if !found {
+		return auctiontypes.ErrorPreBidNotFound
+	}
+	if bid.Bidder != bidder.String() {
+		return auctiontypes.ErrorPreBidUnauthorized
+	}
+	if amount.Denom != bid.Amount.Denom {
+		return sdkerrors.Wrapf(auctiontypes.ErrorInvalidPreBidDenom, "denom %s, expected %s", amount.Denom, bid.Amount.Denom)
+	}
+	if amount.IsZero() {
+		amount = bid.Amount
+	}
+	if amount.Amount.GT(bid.Amount.Amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s left in pre-bid, %s withdrawn", bid.Amount, amount)
+	}
+	if left, minAmount := bid.Amount.Amount.Sub(amount.Amount), sdk.NewIntFromUint64(k.PreBidMinAmount(ctx)); left.IsPositive() && left.LT(minAmount) {
+		return sdkerrors.Wrapf(auctiontypes.ErrorPreBidTooSmall, "%s left in pre-bid, minimum %s", left, minAmount)
+	}
+
+	err := k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+	bid.Amount = bid.Amount.Sub(amount)
+	if bid.Amount.IsZero() {
+		k.DeletePreBid(ctx, bid)
+	} else {
+		k.SetPreBid(ctx, bid)
+	}
+
+	k.emitPreBidEvent(ctx, auctiontypes.EventTypePreBidWithdraw, bid, amount)
+	return nil
+}
+
+// refundPreBid returns what is left in a pre-bid that cannot fill anymore to
+// its bidder and removes the pre-bid.
+func (k Keeper) refundPreBid(ctx sdk.Context, bid auctiontypes.PreBid) error {
+	bidder, err := sdk.AccAddressFromBech32(bid.Bidder)
+	if err != nil {
+		return err
+	}
+	if bid.Amount.IsPositive() {
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(bid.Amount))
+		if err != nil {
+			return err
+		}
+	}
+	k.DeletePreBid(ctx, bid)
+
+	k.emitPreBidEvent(ctx, auctiontypes.EventTypePreBidRefund, bid, bid.Amount)
+	return nil
+}
+
+func (k Keeper) emitPreBidEvent(ctx sdk.Context, eventType string, bid auctiontypes.PreBid, amount sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(auctiontypes.DataAppID, strconv.FormatUint(bid.AppId, 10)),
+			sdk.NewAttribute(auctiontypes.DataPreBidID, strconv.FormatUint(bid.Id, 10)),
+			sdk.NewAttribute(auctiontypes.DataBidder, bid.Bidder),
+			sdk.NewAttribute(auctiontypes.DataPremium, strconv.FormatUint(bid.Premium, 10)),
+			sdk.NewAttribute(auctiontypes.DataAmount, amount.String()),
+		),
+	)
+}
+
+// preBidFill moves the collateral bought by a pre-bid and its payment, and
+// records the bid. It returns the bid id and the collateral the bidder got.
+type preBidFill func(bid auctiontypes.PreBid, collateral, payment sdk.Coin) (uint64, sdk.Coin, error)
+
+// preBidCheck reports whether the auction can sell collateral for payment to
+// a pre-bid.
+type preBidCheck func(auction auctiontypes.DutchAuction, collateral, payment sdk.Coin) (bool, error)
+
+// fillFromPreBids fills a dutch auction that just started from the active
+// pre-bids on its collateral and debt asset, lowest premium first. A pre-bid
+// buys at the oracle price of the collateral less its premium, and the queue
+// stops at the first premium priced below the end price of the auction. Only
+// the first MaxPreBidsPerAuction pre-bids of the queue are tried, and those
+// left below the minimum amount or too small to buy any collateral are
+// refunded. It reports whether any collateral was sold.
+func (k Keeper) fillFromPreBids(ctx sdk.Context, auction *auctiontypes.DutchAuction, check preBidCheck, fill preBidFill) (bool, error) {
+	twaData, found := k.market.GetTwa(ctx, auction.AssetOutId)
+	if !found || !twaData.IsPriceActive {
+		return false, nil
+	}
+	oraclePrice := sdk.NewDecFromInt(sdk.NewIntFromUint64(twaData.Twa))
+	minAmount := sdk.NewIntFromUint64(k.PreBidMinAmount(ctx))
+
+	filled := false
+	for _, bid := range k.preBidQueueHead(ctx, auction.AppId, auction.AssetOutId, auction.AssetInId, auctiontypes.MaxPreBidsPerAuction) {
+		tab := auction.InflowTokenTargetAmount.Amount.Sub(auction.InflowTokenCurrentAmount.Amount)
+		if !tab.IsPositive() || !auction.OutflowTokenCurrentAmount.IsPositive() {
+			break
+		}
+		if bid.ActiveAt.After(ctx.BlockTime()) || bid.Amount.Denom != auction.InflowTokenTargetAmount.Denom {
+			continue
+		}
+		if bid.Amount.Amount.LT(minAmount) {
+			if err := k.refundPreBid(ctx, bid); err != nil {
+				return filled, err
+			}
+			continue
+		}
+		price := oraclePrice.Mul(sdk.OneDec().Sub(sdk.NewDecWithPrec(int64(bid.Premium), 2)))
+		if price.LT(auction.OutflowTokenEndPrice) {
+			break
+		}
+
+		// the pre-bid pays at most what is left of the debt target, buying
+		// the collateral that is worth at its price
+		pay := sdk.MinInt(bid.Amount.Amount, tab)
+		_, quantity, err := k.vault.GetAmountOfOtherToken(ctx, auction.AssetInId, auction.InflowTokenCurrentPrice, pay, auction.AssetOutId, price)
+		if err != nil {
+			return filled, err
+		}
+		if !quantity.IsPositive() {
+			if pay.LT(tab) {
+				// all of the pre-bid buys no collateral at its price
+				if err := k.refundPreBid(ctx, bid); err != nil {
+					return filled, err
+				}
+				continue
+			}
+			break
+		}
+		if quantity.GT(auction.OutflowTokenCurrentAmount.Amount) {
+			quantity = auction.OutflowTokenCurrentAmount.Amount
+			_, pay, err = k.vault.GetAmountOfOtherToken(ctx, auction.AssetOutId, price, quantity, auction.AssetInId, auction.InflowTokenCurrentPrice)
+			if err != nil {
+				return filled, err
+			}
+		}
+		if !pay.IsPositive() {
+			break
+		}
+
+		collateral := sdk.NewCoin(auction.OutflowTokenCurrentAmount.Denom, quantity)
+		payment := sdk.NewCoin(auction.InflowTokenTargetAmount.Denom, pay)
+		if check != nil {
+			ok, err := check(*auction, collateral, payment)
+			if err != nil {
+				return filled, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		biddingID, received, err := fill(bid, collateral, payment)
+		if err != nil {
+			return filled, err
+		}
+		k.recordAuctionFill(ctx, *auction, received, payment)
+		auction.BiddingIds = append(auction.BiddingIds, &auctiontypes.BidOwnerMapping{BidId: biddingID, BidOwner: bid.Bidder})
+		auction.AuctionStatus = auctiontypes.AuctionGoingOn
+		auction.OutflowTokenCurrentAmount = auction.OutflowTokenCurrentAmount.Sub(collateral)
+		auction.InflowTokenCurrentAmount = auction.InflowTokenCurrentAmount.Add(payment)
+		filled = true
+
+		bid.Amount = bid.Amount.Sub(payment)
+		if received.Denom == bid.Filled.Denom {
+			bid.Filled = bid.Filled.Add(received)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				auctiontypes.EventTypePreBidFill,
+				sdk.NewAttribute(auctiontypes.DataAppID, strconv.FormatUint(bid.AppId, 10)),
+				sdk.NewAttribute(auctiontypes.DataAuctionID, strconv.FormatUint(auction.AuctionId, 10)),
+				sdk.NewAttribute(auctiontypes.DataPreBidID, strconv.FormatUint(bid.Id, 10)),
+				sdk.NewAttribute(auctiontypes.DataBidder, bid.Bidder),
+				sdk.NewAttribute(auctiontypes.DataQuantity, received.String()),
+				sdk.NewAttribute(auctiontypes.DataAmount, payment.String()),
+			),
+		)
+
+		// a pre-bid left below the minimum amount is refunded, it could
+		// only fill dust
+		switch {
+		case bid.Amount.IsZero():
+			k.DeletePreBid(ctx, bid)
+		case bid.Amount.Amount.LT(minAmount):
+			if err := k.refundPreBid(ctx, bid); err != nil {
+				return filled, err
+			}
+		default:
+			k.SetPreBid(ctx, bid)
+		}
+	}
+	return filled, nil
+}
+
+// fillDutchAuctionFromPreBids sells the collateral of a vault dutch auction
+// that just started to the pre-bid queue first, the rest stays in the auction.
+func (k Keeper) fillDutchAuctionFromPreBids(ctx sdk.Context, auction auctiontypes.DutchAuction) error {
+	filled, err := k.fillFromPreBids(ctx, &auction, nil, func(bid auctiontypes.PreBid, collateral, payment sdk.Coin) (uint64, sdk.Coin, error) {
+		bidder, err := sdk.AccAddressFromBech32(bid.Bidder)
+		if err != nil {
+			return 0, collateral, err
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(collateral))
+		if err != nil {
+			return 0, collateral, err
+		}
+		biddingID, err := k.CreateNewDutchBid(ctx, auction.AppId, auction.AuctionMappingId, auction.AuctionId, bid.Bidder, payment, collateral)
+		return biddingID, collateral, err
+	})
+	if err != nil || !filled {
+		return err
+	}
+
+	lockedVault, found := k.liquidation.GetLockedVault(ctx, auction.AppId, auction.LockedVaultId)
+	if !found {
+		return auctiontypes.ErrorInvalidLockedVault
+	}
+	return k.settleDutchAuction(ctx, auction, lockedVault)
+}
+
+// fillDutchLendAuctionFromPreBids sells the collateral of a lend dutch auction
+// that just started to the pre-bid queue first, with the liquidation bonus
+// bidders get, the rest stays in the auction. Like public bids, a pre-bid
+// cannot leave less than the dust of the pair in collateral or debt.
+func (k Keeper) fillDutchLendAuctionFromPreBids(ctx sdk.Context, auction auctiontypes.DutchAuction) error {
+	lockedVault, found := k.liquidation.GetLockedVault(ctx, auction.AppId, auction.LockedVaultId)
+	if !found {
+		return auctiontypes.ErrorInvalidLockedVault
+	}
+	lendPair, found := k.lend.GetLendPair(ctx, lockedVault.ExtendedPairId)
+	if !found {
+		return auctiontypes.ErrorInvalidPair
+	}
+	assetStats, _ := k.lend.GetAssetRatesParams(ctx, lendPair.AssetIn)
+	assetOutPool, _ := k.lend.GetPool(ctx, lendPair.AssetOutPoolID)
+
+	dust := sdk.NewDecFromInt(sdk.NewIntFromUint64(lendPair.MinUsdValueLeft))
+	check := func(auction auctiontypes.DutchAuction, collateral, payment sdk.Coin) (bool, error) {
+		collateralLeft, err := k.CalcDollarValueForToken(ctx, auction.AssetOutId, auction.OutflowTokenCurrentPrice, auction.OutflowTokenCurrentAmount.Amount.Sub(collateral.Amount))
+		if err != nil {
+			return false, err
+		}
+		debtLeft, err := k.CalcDollarValueForToken(ctx, auction.AssetInId, auction.InflowTokenCurrentPrice, auction.InflowTokenTargetAmount.Amount.Sub(auction.InflowTokenCurrentAmount.Amount).Sub(payment.Amount))
+		if err != nil {
+			return false, err
+		}
+		if collateralLeft.IsPositive() && collateralLeft.LT(dust) && debtLeft.IsPositive() {
+			return false, nil
+		}
+		return !(debtLeft.IsPositive() && debtLeft.LT(dust) && collateralLeft.IsPositive()), nil
+	}
+
+	filled, err := k.fillFromPreBids(ctx, &auction, check, func(bid auctiontypes.PreBid, collateral, payment sdk.Coin) (uint64, sdk.Coin, error) {
+		bidder, err := sdk.AccAddressFromBech32(bid.Bidder)
+		if err != nil {
+			return 0, collateral, err
+		}
+		// sending inflow token back to the pool
+		err = k.bank.SendCoinsFromModuleToModule(ctx, auctiontypes.ModuleName, assetOutPool.ModuleName, sdk.NewCoins(payment))
+		if err != nil {
+			return 0, collateral, err
+		}
+		auctionBonus := collateral.Amount.ToDec().Mul(assetStats.LiquidationBonus)
+		received := collateral.AddAmount(auctionBonus.TruncateInt())
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(received))
+		if err != nil {
+			return 0, collateral, err
+		}
+		biddingID, err := k.CreateNewDutchLendBid(ctx, auction.AppId, auction.AuctionMappingId, auction.AuctionId, bid.Bidder, payment, collateral)
+		return biddingID, received, err
+	})
+	if err != nil || !filled {
+		return err
+	}
+	return k.settleDutchLendAuction(ctx, auction, lockedVault, assetOutPool)
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auctionKeeper "github.com/comdex-official/comdex/x/auction/keeper"
+	auctionTypes "github.com/comdex-official/comdex/x/auction/types"
+)
+
+func (s *KeeperTestSuite) TestPreBids() {
+	s.AddAppAsset()
+	s.AddPairAndExtendedPairVault1()
+	k, ctx := &s.keeper, &s.ctx
+	server := auctionKeeper.NewMsgServiceServer(*k)
+	queryServer := auctionKeeper.NewQueryServer(*k)
+	bidder1 := sdk.AccAddress("pre_bidder_1________")
+	bidder2 := sdk.AccAddress("pre_bidder_2________")
+	s.fundAddr(bidder1, ParseCoin("1000000ucmst"))
+	s.fundAddr(bidder2, ParseCoin("1000000ucmst"))
+
+	submit := func(bidder sdk.AccAddress, premium uint64, amount string) (uint64, error) {
+		res, err := server.MsgSubmitPreBid(sdk.WrapSDKContext(*ctx), auctionTypes.NewMsgSubmitPreBid(bidder.String(), 1, 1, 2, premium, ParseCoin(amount)))
+		if err != nil {
+			return 0, err
+		}
+		return res.PreBidId, nil
+	}
+
+	_, err := submit(bidder1, 31, "100000ucmst")
+	s.Require().ErrorIs(err, auctionTypes.ErrorInvalidPreBidPremium)
+	_, err = submit(bidder1, 5, "100000ucmdx")
+	s.Require().ErrorIs(err, auctionTypes.ErrorInvalidPreBidDenom)
+	_, err = submit(bidder1, 5, "99999ucmst")
+	s.Require().ErrorIs(err, auctionTypes.ErrorPreBidTooSmall)
+
+	// Pre-bids at 5% and 10% become active before the vaults are liquidated,
+	// the one at 2% does not, and 30% is priced below the end price.
+	id1, err := submit(bidder1, 10, "200000ucmst")
+	s.Require().NoError(err)
+	id2, err := submit(bidder2, 5, "300000ucmst")
+	s.Require().NoError(err)
+	id3, err := submit(bidder2, 30, "100000ucmst")
+	s.Require().NoError(err)
+	s.advanceseconds(int64(auctionTypes.DefaultPreBidActivationSeconds))
+	id4, err := submit(bidder1, 2, "100000ucmst")
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1, 2, 3, 4}, []uint64{id1, id2, id3, id4})
+
+	queue, err := queryServer.QueryPreBidQueue(sdk.WrapSDKContext(*ctx), &auctionTypes.QueryPreBidQueueRequest{AppId: 1, CollateralAssetId: 1, DebtAssetId: 2})
+	s.Require().NoError(err)
+	s.Require().Equal([]auctionTypes.PreBidSlot{
+		{Premium: 2, Total: sdk.NewInt(100000), Active: sdk.ZeroInt()},
+		{Premium: 5, Total: sdk.NewInt(300000), Active: sdk.NewInt(300000)},
+		{Premium: 10, Total: sdk.NewInt(200000), Active: sdk.NewInt(200000)},
+		{Premium: 30, Total: sdk.NewInt(100000), Active: sdk.NewInt(100000)},
+	}, queue.Slots)
+
+	// The first auction is filled from the queue as it starts, lowest
+	// premium first, at the oracle price of 1ucmst per ucmdx less the premium.
+	s.LiquidateVaults1()
+	dutchAuction, err := k.GetDutchAuction(*ctx, 1, 3, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(auctionTypes.AuctionGoingOn, dutchAuction.AuctionStatus)
+	s.Require().Len(dutchAuction.BiddingIds, 2)
+	s.Require().Equal(sdk.NewInt(500000), dutchAuction.InflowTokenCurrentAmount.Amount)
+	s.Require().Equal(sdk.NewInt(1000000-315789-222222), dutchAuction.OutflowTokenCurrentAmount.Amount)
+	s.Require().Equal(ParseCoin("315789ucmdx"), s.app.BankKeeper.GetBalance(*ctx, bidder2, "ucmdx"))
+	s.Require().Equal(ParseCoin("222222ucmdx"), s.app.BankKeeper.GetBalance(*ctx, bidder1, "ucmdx"))
+
+	_, found := k.GetPreBid(*ctx, id1)
+	s.Require().False(found)
+	_, found = k.GetPreBid(*ctx, id2)
+	s.Require().False(found)
+	bid, found := k.GetPreBid(*ctx, id3)
+	s.Require().True(found)
+	s.Require().Equal(ParseCoin("100000ucmst"), bid.Amount)
+	s.Require().True(bid.Filled.IsZero())
+
+	// The second auction finds no active pre-bid it can sell to.
+	dutchAuction, err = k.GetDutchAuction(*ctx, 1, 3, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(auctionTypes.AuctionStartNoBids, dutchAuction.AuctionStatus)
+	s.Require().Equal(sdk.ZeroInt(), dutchAuction.InflowTokenCurrentAmount.Amount)
+
+	res, err := queryServer.QueryPreBids(sdk.WrapSDKContext(*ctx), &auctionTypes.QueryPreBidsRequest{AppId: 1, Bidder: bidder1.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.PreBids, 1)
+	s.Require().Equal(id4, res.PreBids[0].Id)
+
+	// Only the bidder withdraws a pre-bid, and withdrawing all of it removes it.
+	_, err = server.MsgWithdrawPreBid(sdk.WrapSDKContext(*ctx), auctionTypes.NewMsgWithdrawPreBid(bidder1.String(), id3, ParseCoin("1ucmst")))
+	s.Require().ErrorIs(err, auctionTypes.ErrorPreBidUnauthorized)
+	_, err = server.MsgWithdrawPreBid(sdk.WrapSDKContext(*ctx), auctionTypes.NewMsgWithdrawPreBid(bidder2.String(), id3, ParseCoin("100001ucmst")))
+	s.Require().Error(err)
+	_, err = server.MsgWithdrawPreBid(sdk.WrapSDKContext(*ctx), auctionTypes.NewMsgWithdrawPreBid(bidder2.String(), id3, ParseCoin("40000ucmst")))
+	s.Require().ErrorIs(err, auctionTypes.ErrorPreBidTooSmall)
+	bid, found = k.GetPreBid(*ctx, id3)
+	s.Require().True(found)
+	s.Require().Equal(ParseCoin("100000ucmst"), bid.Amount)
+	_, err = server.MsgWithdrawPreBid(sdk.WrapSDKContext(*ctx), auctionTypes.NewMsgWithdrawPreBid(bidder2.String(), id3, ParseCoin("0ucmst")))
+	s.Require().NoError(err)
+	_, found = k.GetPreBid(*ctx, id3)
+	s.Require().False(found)
+	s.Require().Equal(ParseCoin("700000ucmst"), s.app.BankKeeper.GetBalance(*ctx, bidder2, "ucmst"))
+}
+
+func (s *KeeperTestSuite) TestPreBidsRefundedBelowMinimum() {
+	s.AddAppAsset()
+	s.AddPairAndExtendedPairVault1()
+	k, ctx := &s.keeper, &s.ctx
+	server := auctionKeeper.NewMsgServiceServer(*k)
+	bidder := sdk.AccAddress("pre_bidder_1________")
+	count := 2*auctionTypes.MaxPreBidsPerAuction + 1
+	s.fundAddr(bidder, sdk.NewCoin("ucmst", sdk.NewInt(int64(count)*100000)))
+
+	var ids []uint64
+	for i := 0; i < count; i++ {
+		res, err := server.MsgSubmitPreBid(sdk.WrapSDKContext(*ctx), auctionTypes.NewMsgSubmitPreBid(bidder.String(), 1, 1, 2, 5, ParseCoin("100000ucmst")))
+		s.Require().NoError(err)
+		ids = append(ids, res.PreBidId)
+	}
+	s.advanceseconds(int64(auctionTypes.DefaultPreBidActivationSeconds))
+
+	// Raising the minimum leaves the queued pre-bids as dust. Each auction
+	// refunds the ones it tries, up to the cap, and sells them nothing.
+	params := k.GetParams(*ctx)
+	params.PreBidMinAmount = 200000
+	k.SetParams(*ctx, params)
+	s.LiquidateVaults1()
+	for _, auctionID := range []uint64{1, 2} {
+		dutchAuction, err := k.GetDutchAuction(*ctx, 1, 3, auctionID)
+		s.Require().NoError(err)
+		s.Require().Equal(auctionTypes.AuctionStartNoBids, dutchAuction.AuctionStatus)
+	}
+	for i, id := range ids {
+		_, found := k.GetPreBid(*ctx, id)
+		s.Require().Equal(i == count-1, found)
+	}
+	s.Require().Equal(sdk.NewInt(int64(count-1)*100000), s.app.BankKeeper.GetBalance(*ctx, bidder, "ucmst").Amount)
+}
@@ -407,6 +407,45 @@ func (q QueryServer) QueryProtocolStatistics(c context.Context, req *types.Query
 	}, nil
 }
 
+func (q QueryServer) QueryBadDebts(c context.Context, req *types.QueryBadDebtsRequest) (*types.QueryBadDebtsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	var (
+		items []types.BadDebt
+		ctx   = sdk.UnwrapSDKContext(c)
+		key   []byte
+	)
+
+	key = types.BadDebtAppIDKey(req.AppId)
+
+	pagination, err := query.FilteredPaginate(
+		prefix.NewStore(q.Store(ctx), key),
+		req.Pagination,
+		func(_, value []byte, accumulate bool) (bool, error) {
+			var item types.BadDebt
+			if err := q.cdc.Unmarshal(value, &item); err != nil {
+				return false, err
+			}
+
+			if accumulate {
+				items = append(items, item)
+			}
+
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBadDebtsResponse{
+		BadDebts:   items,
+		Pagination: pagination,
+	}, nil
+}
+
 func (q QueryServer) QueryGenericAuctionParams(c context.Context, req *types.QueryGenericAuctionParamRequest) (*types.QueryGenericAuctionParamResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
@@ -578,3 +617,218 @@ func (q QueryServer) QueryFilterDutchAuctions(c context.Context, req *types.Quer
 		Pagination: pagination,
 	}, nil
 }
+
+func (q QueryServer) QueryAuctionHistory(c context.Context, req *types.QueryAuctionHistoryRequest) (*types.QueryAuctionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+	switch req.AuctionType {
+	case "", types.SurplusString, types.DebtString, types.DutchString, types.DutchLendString:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid auction type %s", req.AuctionType)
+	}
+
+	var (
+		res   types.QueryAuctionHistoryResponse
+		ctx   = sdk.UnwrapSDKContext(c)
+		store = q.Store(ctx)
+	)
+	matchesAsset := func(assetInID, assetOutID uint64) bool {
+		return req.AssetId == 0 || req.AssetId == assetInID || req.AssetId == assetOutID
+	}
+	matchesVault := func(auction types.DutchAuction) bool {
+		return (req.VaultOwner == "" || req.VaultOwner == auction.VaultOwner.String()) &&
+			(req.LockedVaultId == 0 || req.LockedVaultId == auction.LockedVaultId)
+	}
+	vaultFiltered := req.VaultOwner != "" || req.LockedVaultId != 0
+
+	pagination, err := query.FilteredPaginate(
+		prefix.NewStore(store, types.HistoryIndexKeyPrefix),
+		req.Pagination,
+		func(_, value []byte, accumulate bool) (bool, error) {
+			var index types.AuctionHistoryIndex
+			if err := q.cdc.Unmarshal(value, &index); err != nil {
+				return false, err
+			}
+			auctionType := index.AuctionType
+			if index.Lend {
+				auctionType = types.DutchLendString
+			}
+			if index.AppId != req.AppId || (req.AuctionType != "" && req.AuctionType != auctionType) ||
+				index.ClosedAt.Before(req.StartTime) || (!req.EndTime.IsZero() && !index.ClosedAt.Before(req.EndTime)) {
+				return false, nil
+			}
+			value = store.Get(historyAuctionKey(index))
+			if value == nil {
+				return false, nil
+			}
+
+			switch auctionType {
+			case types.SurplusString:
+				var item types.SurplusAuction
+				if err := q.cdc.Unmarshal(value, &item); err != nil {
+					return false, err
+				}
+				if vaultFiltered || !matchesAsset(item.AssetInId, item.AssetOutId) {
+					return false, nil
+				}
+				if accumulate {
+					res.SurplusAuctions = append(res.SurplusAuctions, item)
+				}
+			case types.DebtString:
+				var item types.DebtAuction
+				if err := q.cdc.Unmarshal(value, &item); err != nil {
+					return false, err
+				}
+				if vaultFiltered || !matchesAsset(item.AssetInId, item.AssetOutId) {
+					return false, nil
+				}
+				if accumulate {
+					res.DebtAuctions = append(res.DebtAuctions, item)
+				}
+			default:
+				var item types.DutchAuction
+				if err := q.cdc.Unmarshal(value, &item); err != nil {
+					return false, err
+				}
+				if !matchesVault(item) || !matchesAsset(item.AssetInId, item.AssetOutId) {
+					return false, nil
+				}
+				if accumulate && index.Lend {
+					res.DutchLendAuctions = append(res.DutchLendAuctions, item)
+				} else if accumulate {
+					res.DutchAuctions = append(res.DutchAuctions, item)
+				}
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.Pagination = pagination
+
+	return &res, nil
+}
+
+func (q QueryServer) QueryAuctionAnalytics(c context.Context, req *types.QueryAuctionAnalyticsRequest) (*types.QueryAuctionAnalyticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var stats []types.AuctionStats
+	if req.AssetId != 0 {
+		item, found := q.GetAuctionStats(ctx, req.AppId, req.AssetId)
+		if found {
+			stats = append(stats, item)
+		}
+	} else {
+		stats = q.GetAuctionStatsForApp(ctx, req.AppId)
+	}
+
+	analytics := make([]types.AuctionAnalytics, 0, len(stats))
+	for _, item := range stats {
+		analytics = append(analytics, item.Analytics())
+	}
+
+	return &types.QueryAuctionAnalyticsResponse{
+		Analytics: analytics,
+	}, nil
+}
+
+func (q QueryServer) QueryPreBid(c context.Context, req *types.QueryPreBidRequest) (*types.QueryPreBidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	item, found := q.GetPreBid(ctx, req.PreBidId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pre-bid does not exist for id %d", req.PreBidId)
+	}
+
+	return &types.QueryPreBidResponse{
+		PreBid: item,
+	}, nil
+}
+
+func (q QueryServer) QueryPreBids(c context.Context, req *types.QueryPreBidsRequest) (*types.QueryPreBidsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	var (
+		items []types.PreBid
+		ctx   = sdk.UnwrapSDKContext(c)
+	)
+
+	pagination, err := query.FilteredPaginate(
+		prefix.NewStore(q.Store(ctx), types.PreBidKeyPrefix),
+		req.Pagination,
+		func(_, value []byte, accumulate bool) (bool, error) {
+			var item types.PreBid
+			if err := q.cdc.Unmarshal(value, &item); err != nil {
+				return false, err
+			}
+			if item.AppId != req.AppId ||
+				(req.CollateralAssetId != 0 && item.CollateralAssetId != req.CollateralAssetId) ||
+				(req.DebtAssetId != 0 && item.DebtAssetId != req.DebtAssetId) ||
+				(req.Bidder != "" && item.Bidder != req.Bidder) {
+				return false, nil
+			}
+
+			if accumulate {
+				items = append(items, item)
+			}
+
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPreBidsResponse{
+		PreBids:    items,
+		Pagination: pagination,
+	}, nil
+}
+
+func (q QueryServer) QueryPreBidQueue(c context.Context, req *types.QueryPreBidQueueRequest) (*types.QueryPreBidQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var slots []types.PreBidSlot
+	for _, bid := range q.GetPreBidQueue(ctx, req.AppId, req.CollateralAssetId, req.DebtAssetId) {
+		if len(slots) == 0 || slots[len(slots)-1].Premium != bid.Premium {
+			slots = append(slots, types.PreBidSlot{Premium: bid.Premium, Total: sdk.ZeroInt(), Active: sdk.ZeroInt()})
+		}
+		slot := &slots[len(slots)-1]
+		slot.Total = slot.Total.Add(bid.Amount.Amount)
+		if !bid.ActiveAt.After(ctx.BlockTime()) {
+			slot.Active = slot.Active.Add(bid.Amount.Amount)
+		}
+	}
+
+	return &types.QueryPreBidQueueResponse{
+		Slots: slots,
+	}, nil
+}
+
+func (q QueryServer) QueryParams(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{
+		Params: q.GetParams(ctx),
+	}, nil
+}
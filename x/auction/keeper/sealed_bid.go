@@ -0,0 +1,346 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/comdex-official/comdex/types"
+	auctiontypes "github.com/comdex-official/comdex/x/auction/types"
+	collectortypes "github.com/comdex-official/comdex/x/collector/types"
+)
+
+func (k Keeper) SetSealedBid(ctx sdk.Context, bid auctiontypes.SealedBid) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.SealedBidKey(bid.AppId, bid.AuctionId, bid.BiddingId)
+		value = k.cdc.MustMarshal(&bid)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetSealedBid(ctx sdk.Context, appID, auctionID, biddingID uint64) (bid auctiontypes.SealedBid, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.SealedBidKey(appID, auctionID, biddingID)
+		value = store.Get(key)
+	)
+	if value == nil {
+		return bid, false
+	}
+	k.cdc.MustUnmarshal(value, &bid)
+	return bid, true
+}
+
+func (k Keeper) DeleteSealedBid(ctx sdk.Context, bid auctiontypes.SealedBid) {
+	var (
+		store = k.Store(ctx)
+		key   = auctiontypes.SealedBidKey(bid.AppId, bid.AuctionId, bid.BiddingId)
+	)
+	store.Delete(key)
+}
+
+// GetSealedBids returns the sealed bids on an auction in the order they were
+// committed.
+func (k Keeper) GetSealedBids(ctx sdk.Context, appID, auctionID uint64) []auctiontypes.SealedBid {
+	return k.getSealedBids(ctx, auctiontypes.SealedBidAuctionKey(appID, auctionID))
+}
+
+func (k Keeper) GetAllSealedBids(ctx sdk.Context) []auctiontypes.SealedBid {
+	return k.getSealedBids(ctx, auctiontypes.SealedBidKeyPrefix)
+}
+
+func (k Keeper) getSealedBids(ctx sdk.Context, prefix []byte) (bids []auctiontypes.SealedBid) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, prefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var bid auctiontypes.SealedBid
+		k.cdc.MustUnmarshal(iter.Value(), &bid)
+		bids = append(bids, bid)
+	}
+	return bids
+}
+
+// IsSealedBidApp reports whether the app auctions vault collateral with
+// sealed bids. Lend auctions only take public bids, so such an app cannot
+// run them.
+func (k Keeper) IsSealedBidApp(ctx sdk.Context, appID uint64) bool {
+	auctionParams, found := k.GetAuctionParams(ctx, appID)
+	return found && auctionParams.SealedBid
+}
+
+// setSealedBidWindows opens the commit window of a dutch auction at its start
+// when the app auctions collateral with sealed bids, the auction ends with the
+// reveal window that follows.
+func setSealedBidWindows(auction *auctiontypes.DutchAuction, auctionParams auctiontypes.AuctionParams) {
+	auction.Sealed = auctionParams.SealedBid
+	if !auction.Sealed {
+		return
+	}
+	auction.CommitEndTime = auction.StartTime.Add(time.Second * time.Duration(auctionParams.CommitDurationSeconds))
+	auction.RevealEndTime = auction.CommitEndTime.Add(time.Second * time.Duration(auctionParams.RevealDurationSeconds))
+	auction.EndTime = auction.RevealEndTime
+}
+
+// CommitDutchAuctionBid escrows a hashed bid on a sealed dutch auction. The
+// escrow is in the debt token and has to cover the payment of the bid.
+func (k Keeper) CommitDutchAuctionBid(ctx sdk.Context, appID, auctionMappingID, auctionID uint64, bidder sdk.AccAddress, commitment []byte, escrow sdk.Coin) (uint64, error) {
+	auction, err := k.GetDutchAuction(ctx, appID, auctionMappingID, auctionID)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "auction id %d not found", auctionID)
+	}
+	if !auction.Sealed {
+		return 0, auctiontypes.ErrorNotSealedAuction
+	}
+	if ctx.BlockTime().After(auction.CommitEndTime) {
+		return 0, auctiontypes.ErrorCommitWindowClosed
+	}
+	if escrow.Denom != auction.InflowTokenTargetAmount.Denom {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "escrow denom %s, expected %s", escrow.Denom, auction.InflowTokenTargetAmount.Denom)
+	}
+	if !escrow.IsPositive() {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "escrow %s", escrow)
+	}
+
+	err = k.bank.SendCoinsFromAccountToModule(ctx, bidder, auctiontypes.ModuleName, sdk.NewCoins(escrow))
+	if err != nil {
+		return 0, err
+	}
+
+	bid := auctiontypes.SealedBid{
+		BiddingId:        k.GetUserBiddingID(ctx) + 1,
+		AuctionId:        auctionID,
+		AppId:            appID,
+		AuctionMappingId: auctionMappingID,
+		Bidder:           bidder.String(),
+		Commitment:       commitment,
+		Escrow:           escrow,
+		BiddingTimestamp: ctx.BlockTime(),
+	}
+	k.SetUserBiddingID(ctx, bid.BiddingId)
+	k.SetSealedBid(ctx, bid)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			auctiontypes.EventTypeSealedBidCommit,
+			sdk.NewAttribute(auctiontypes.DataAppID, strconv.FormatUint(appID, 10)),
+			sdk.NewAttribute(auctiontypes.DataAuctionID, strconv.FormatUint(auctionID, 10)),
+			sdk.NewAttribute(auctiontypes.DataBiddingID, strconv.FormatUint(bid.BiddingId, 10)),
+			sdk.NewAttribute(auctiontypes.DataBidder, bid.Bidder),
+			sdk.NewAttribute(auctiontypes.DataAmount, escrow.String()),
+		),
+	)
+	return bid.BiddingId, nil
+}
+
+// RevealDutchAuctionBid opens a committed bid of payment for quantity of the
+// collateral of a sealed dutch auction after its commit window.
+func (k Keeper) RevealDutchAuctionBid(ctx sdk.Context, appID, auctionMappingID, auctionID uint64, bidder sdk.AccAddress, biddingID uint64, quantity, payment sdk.Coin, salt string) error {
+	auction, err := k.GetDutchAuction(ctx, appID, auctionMappingID, auctionID)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "auction id %d not found", auctionID)
+	}
+	if !auction.Sealed {
+		return auctiontypes.ErrorNotSealedAuction
+	}
+	if !ctx.BlockTime().After(auction.CommitEndTime) || ctx.BlockTime().After(auction.RevealEndTime) {
+		return auctiontypes.ErrorRevealWindowClosed
+	}
+	bid, found := k.GetSealedBid(ctx, appID, auctionID, biddingID)
+	if !found || bid.Bidder != bidder.String() {
+		return auctiontypes.ErrorSealedBidNotFound
+	}
+	if bid.Revealed {
+		return auctiontypes.ErrorSealedBidRevealed
+	}
+	if !bytes.Equal(bid.Commitment, auctiontypes.SealedBidCommitment(bid.Bidder, auctionID, quantity, payment, salt)) {
+		return auctiontypes.ErrorInvalidCommitment
+	}
+	if quantity.Denom != auction.OutflowTokenInitAmount.Denom || !quantity.IsPositive() {
+		return sdkerrors.Wrapf(auctiontypes.ErrorInvalidBidQuantity, "%s", quantity)
+	}
+	if payment.Denom != bid.Escrow.Denom || !payment.IsPositive() || payment.Amount.GT(bid.Escrow.Amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "payment %s is not covered by escrow %s", payment, bid.Escrow)
+	}
+
+	bid.Revealed = true
+	bid.Quantity = quantity
+	bid.Payment = payment
+	k.SetSealedBid(ctx, bid)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			auctiontypes.EventTypeSealedBidReveal,
+			sdk.NewAttribute(auctiontypes.DataAppID, strconv.FormatUint(appID, 10)),
+			sdk.NewAttribute(auctiontypes.DataAuctionID, strconv.FormatUint(auctionID, 10)),
+			sdk.NewAttribute(auctiontypes.DataBiddingID, strconv.FormatUint(biddingID, 10)),
+			sdk.NewAttribute(auctiontypes.DataBidder, bid.Bidder),
+			sdk.NewAttribute(auctiontypes.DataQuantity, quantity.String()),
+			sdk.NewAttribute(auctiontypes.DataAmount, payment.String()),
+		),
+	)
+	return nil
+}
+
+// SettleSealedDutchAuctions clears the sealed dutch auctions of an app whose
+// reveal window has passed. When the settlement of an auction fails, its
+// sealed bids are refunded so their escrow is not left behind when the
+// auction restarts or closes.
+func (k Keeper) SettleSealedDutchAuctions(ctx sdk.Context, appID uint64) {
+	for _, dutchAuction := range k.GetDutchAuctions(ctx, appID) {
+		if !dutchAuction.Sealed || !ctx.BlockTime().After(dutchAuction.RevealEndTime) {
+			continue
+		}
+		dutchAuction := dutchAuction
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.settleSealedDutchAuction(ctx, dutchAuction)
+		})
+		if err == nil {
+			continue
+		}
+		_ = utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.refundSealedBids(ctx, dutchAuction)
+		})
+	}
+}
+
+// refundSealedBids deletes the sealed bids on a dutch auction and returns
+// their escrow to the bidders, revealed or not.
+func (k Keeper) refundSealedBids(ctx sdk.Context, auction auctiontypes.DutchAuction) error {
+	for _, bid := range k.GetSealedBids(ctx, auction.AppId, auction.AuctionId) {
+		bidder, err := sdk.AccAddressFromBech32(bid.Bidder)
+		if err != nil {
+			return err
+		}
+		k.DeleteSealedBid(ctx, bid)
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(bid.Escrow))
+		if err != nil {
+			return err
+		}
+		k.emitSealedBidEvent(ctx, auctiontypes.EventTypeSealedBidRefund, bid, bid.Escrow.Amount, sdk.ZeroInt())
+	}
+	return nil
+}
+
+// settleSealedDutchAuction sells the collateral of a sealed dutch auction to
+// the revealed bids offering the most debt token per collateral, each paying
+// its own bid, until the debt target is raised or the collateral is sold. Bids
+// below the end price of the auction are not filled. The escrow of bids that
+// were never revealed is slashed to the collector. The auction is then settled
+// like after a public bid, and restarts with new windows if it is still open.
+func (k Keeper) settleSealedDutchAuction(ctx sdk.Context, auction auctiontypes.DutchAuction) error {
+	lockedVault, found := k.liquidation.GetLockedVault(ctx, auction.AppId, auction.LockedVaultId)
+	if !found {
+		return auctiontypes.ErrorInvalidLockedVault
+	}
+
+	var revealed []auctiontypes.SealedBid
+	for _, bid := range k.GetSealedBids(ctx, auction.AppId, auction.AuctionId) {
+		k.DeleteSealedBid(ctx, bid)
+		if bid.Revealed {
+			revealed = append(revealed, bid)
+			continue
+		}
+		err := k.bank.SendCoinsFromModuleToModule(ctx, auctiontypes.ModuleName, collectortypes.ModuleName, sdk.NewCoins(bid.Escrow))
+		if err != nil {
+			return err
+		}
+		err = k.collector.SetNetFeeCollectedData(ctx, auction.AppId, auction.AssetInId, bid.Escrow.Amount)
+		if err != nil {
+			return err
+		}
+		k.emitSealedBidEvent(ctx, auctiontypes.EventTypeSealedBidSlash, bid, bid.Escrow.Amount, sdk.ZeroInt())
+	}
+	sort.SliceStable(revealed, func(i, j int) bool {
+		return revealed[i].Price().GT(revealed[j].Price())
+	})
+
+	filled := false
+	for _, bid := range revealed {
+		bidder, err := sdk.AccAddressFromBech32(bid.Bidder)
+		if err != nil {
+			return err
+		}
+		fill, pay := sdk.ZeroInt(), sdk.ZeroInt()
+		tab := auction.InflowTokenTargetAmount.Amount.Sub(auction.InflowTokenCurrentAmount.Amount)
+		if tab.IsPositive() && auction.OutflowTokenCurrentAmount.IsPositive() {
+			_, reserve, err := k.vault.GetAmountOfOtherToken(ctx, auction.AssetOutId, auction.OutflowTokenEndPrice, bid.Quantity.Amount, auction.AssetInId, auction.InflowTokenCurrentPrice)
+			if err != nil {
+				return err
+			}
+			if bid.Payment.Amount.GTE(reserve) {
+				price := bid.Price()
+				fill = sdk.MinInt(bid.Quantity.Amount, auction.OutflowTokenCurrentAmount.Amount)
+				pay = price.MulInt(fill).Ceil().TruncateInt()
+				if pay.GTE(tab) {
+					pay = tab
+					fill = sdk.MinInt(fill, tab.ToDec().Quo(price).Ceil().TruncateInt())
+				}
+			}
+		}
+
+		if refund := bid.Escrow.Amount.Sub(pay); refund.IsPositive() {
+			err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(sdk.NewCoin(bid.Escrow.Denom, refund)))
+			if err != nil {
+				return err
+			}
+		}
+		if !fill.IsPositive() {
+			k.emitSealedBidEvent(ctx, auctiontypes.EventTypeSealedBidSettle, bid, pay, fill)
+			continue
+		}
+
+		inFlowTokenCoin := sdk.NewCoin(auction.InflowTokenTargetAmount.Denom, pay)
+		outFlowTokenCoin := sdk.NewCoin(auction.OutflowTokenInitAmount.Denom, fill)
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(outFlowTokenCoin))
+		if err != nil {
+			return err
+		}
+		k.recordAuctionFill(ctx, auction, outFlowTokenCoin, inFlowTokenCoin)
+		biddingID, err := k.CreateNewDutchBid(ctx, auction.AppId, auction.AuctionMappingId, auction.AuctionId, bid.Bidder, inFlowTokenCoin, outFlowTokenCoin)
+		if err != nil {
+			return err
+		}
+		auction.BiddingIds = append(auction.BiddingIds, &auctiontypes.BidOwnerMapping{BidId: biddingID, BidOwner: bid.Bidder})
+		auction.OutflowTokenCurrentAmount = auction.OutflowTokenCurrentAmount.Sub(outFlowTokenCoin)
+		auction.InflowTokenCurrentAmount = auction.InflowTokenCurrentAmount.Add(inFlowTokenCoin)
+		filled = true
+		k.emitSealedBidEvent(ctx, auctiontypes.EventTypeSealedBidSettle, bid, pay, fill)
+	}
+
+	if !filled {
+		return nil
+	}
+	if auction.AuctionStatus == auctiontypes.AuctionStartNoBids {
+		auction.AuctionStatus = auctiontypes.AuctionGoingOn
+	}
+	return k.settleDutchAuction(ctx, auction, lockedVault)
+}
+
+func (k Keeper) emitSealedBidEvent(ctx sdk.Context, eventType string, bid auctiontypes.SealedBid, amount, quantity sdk.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(auctiontypes.DataAppID, strconv.FormatUint(bid.AppId, 10)),
+			sdk.NewAttribute(auctiontypes.DataAuctionID, strconv.FormatUint(bid.AuctionId, 10)),
+			sdk.NewAttribute(auctiontypes.DataBiddingID, strconv.FormatUint(bid.BiddingId, 10)),
+			sdk.NewAttribute(auctiontypes.DataBidder, bid.Bidder),
+			sdk.NewAttribute(auctiontypes.DataQuantity, quantity.String()),
+			sdk.NewAttribute(auctiontypes.DataAmount, amount.String()),
+		),
+	)
+}
@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/app/wasm/bindings"
+	"github.com/comdex-official/comdex/x/auction"
+	auctionKeeper "github.com/comdex-official/comdex/x/auction/keeper"
+	auctionTypes "github.com/comdex-official/comdex/x/auction/types"
+	collectorTypes "github.com/comdex-official/comdex/x/collector/types"
+	lendtypes "github.com/comdex-official/comdex/x/lend/types"
+)
+
+func (s *KeeperTestSuite) TestSealedDutchAuction() {
+	s.TestDutchActivator()
+	k, ctx := &s.keeper, &s.ctx
+	server := auctionKeeper.NewMsgServiceServer(*k)
+	owner := "cosmos1q7q90qsl9g0gl2zz0njxwv2a649yqrtyxtnv3v"
+
+	// The app switches to sealed bids, the auction takes them once it restarts.
+	auctionParams, found := k.GetAuctionParams(*ctx, 1)
+	s.Require().True(found)
+	auctionParams.SealedBid = true
+	auctionParams.CommitDurationSeconds = 100
+	auctionParams.RevealDurationSeconds = 50
+	k.SetAuctionParams(*ctx, auctionParams)
+	s.advanceseconds(301)
+	auction.BeginBlocker(*ctx, s.app.AuctionKeeper, s.app.AssetKeeper, s.app.CollectorKeeper, s.app.EsmKeeper)
+
+	// 1000000ucmdx are auctioned for 1120000ucmst, at no less than 0.72ucmst per ucmdx.
+	dutchAuction, err := k.GetDutchAuction(*ctx, 1, 3, 1)
+	s.Require().NoError(err)
+	s.Require().True(dutchAuction.Sealed)
+	s.Require().Equal(dutchAuction.RevealEndTime, dutchAuction.EndTime)
+	s.Require().Equal(ParseCoin("1120000ucmst"), dutchAuction.InflowTokenTargetAmount)
+
+	_, err = server.MsgPlaceDutchBid(sdk.WrapSDKContext(*ctx), &auctionTypes.MsgPlaceDutchBidRequest{
+		AuctionId: 1, Bidder: owner, Amount: ParseCoin("1000ucmdx"), AppId: 1, AuctionMappingId: 3,
+	})
+	s.Require().ErrorIs(err, auctionTypes.ErrorSealedAuction)
+
+	bidders := []sdk.AccAddress{
+		sdk.AccAddress("sealed_bidder_1_____"),
+		sdk.AccAddress("sealed_bidder_2_____"),
+		sdk.AccAddress("sealed_bidder_3_____"),
+		sdk.AccAddress("sealed_bidder_4_____"),
+	}
+	bids := []struct {
+		quantity, payment, escrow string
+	}{
+		{"400000ucmdx", "480000ucmst", "500000ucmst"},
+		{"600000ucmdx", "720000ucmst", "800000ucmst"},
+		{"300000ucmdx", "150000ucmst", "200000ucmst"},
+		{"100000ucmdx", "100000ucmst", "100000ucmst"},
+	}
+	commit := func(i int) (uint64, error) {
+		commitment := auctionTypes.SealedBidCommitment(bidders[i].String(), 1, ParseCoin(bids[i].quantity), ParseCoin(bids[i].payment), "salt")
+		res, err := server.MsgCommitDutchBid(sdk.WrapSDKContext(*ctx), &auctionTypes.MsgCommitDutchBidRequest{
+			AuctionId: 1, Bidder: bidders[i].String(), Commitment: commitment, Escrow: ParseCoin(bids[i].escrow), AppId: 1, AuctionMappingId: 3,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return res.BiddingId, nil
+	}
+	reveal := func(i int, biddingID uint64, salt string) error {
+		_, err := server.MsgRevealDutchBid(sdk.WrapSDKContext(*ctx), &auctionTypes.MsgRevealDutchBidRequest{
+			AuctionId: 1, Bidder: bidders[i].String(), BiddingId: biddingID, Quantity: ParseCoin(bids[i].quantity), Payment: ParseCoin(bids[i].payment), Salt: salt, AppId: 1, AuctionMappingId: 3,
+		})
+		return err
+	}
+
+	biddingIDs := make([]uint64, len(bidders))
+	for i, bidder := range bidders {
+		s.fundAddr(bidder, ParseCoin(bids[i].escrow))
+		biddingIDs[i], err = commit(i)
+		s.Require().NoError(err)
+		balance, err := s.getBalance(bidder.String(), "ucmst")
+		s.Require().NoError(err)
+		s.Require().True(balance.IsZero())
+	}
+	s.Require().ErrorIs(reveal(0, biddingIDs[0], "salt"), auctionTypes.ErrorRevealWindowClosed)
+
+	s.advanceseconds(101)
+	_, err = commit(0)
+	s.Require().ErrorIs(err, auctionTypes.ErrorCommitWindowClosed)
+	s.Require().ErrorIs(reveal(0, biddingIDs[0], "pepper"), auctionTypes.ErrorInvalidCommitment)
+	s.Require().ErrorIs(reveal(0, biddingIDs[1], "salt"), auctionTypes.ErrorSealedBidNotFound)
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(reveal(i, biddingIDs[i], "salt"))
+	}
+	s.Require().ErrorIs(reveal(0, biddingIDs[0], "salt"), auctionTypes.ErrorSealedBidRevealed)
+
+	ownerBefore, err := s.getBalance(owner, "ucmdx")
+	s.Require().NoError(err)
+	collectorBefore := s.app.BankKeeper.GetBalance(*ctx, s.app.AccountKeeper.GetModuleAddress(collectorTypes.ModuleName), "ucmst")
+
+	s.advanceseconds(50)
+	auction.BeginBlocker(*ctx, s.app.AuctionKeeper, s.app.AssetKeeper, s.app.CollectorKeeper, s.app.EsmKeeper)
+
+	// The best bids pay what they offered until the target is raised, the
+	// last one partially, the bid below the end price is refunded and the
+	// bid never revealed loses its escrow.
+	for i, expected := range []struct {
+		cmdx, cmst string
+	}{
+		{"400000ucmdx", "20000ucmst"},
+		{"533334ucmdx", "160000ucmst"},
+		{"0ucmdx", "200000ucmst"},
+		{"0ucmdx", "0ucmst"},
+	} {
+		cmdx, err := s.getBalance(bidders[i].String(), "ucmdx")
+		s.Require().NoError(err)
+		s.Require().Equal(ParseCoin(expected.cmdx), cmdx)
+		cmst, err := s.getBalance(bidders[i].String(), "ucmst")
+		s.Require().NoError(err)
+		s.Require().Equal(ParseCoin(expected.cmst), cmst)
+	}
+	s.Require().Empty(k.GetSealedBids(*ctx, 1, 1))
+
+	// The auction closed like a public one, the collateral left went back to
+	// the owner and the penalty and the slashed escrow to the collector.
+	_, err = k.GetDutchAuction(*ctx, 1, 3, 1)
+	s.Require().Error(err)
+	history, err := k.GetHistoryDutchAuction(*ctx, 1, 3, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(ParseCoin("1120000ucmst"), history.InflowTokenCurrentAmount)
+	s.Require().Len(history.BiddingIds, 2)
+	_, found = s.app.LiquidationKeeper.GetLockedVault(*ctx, 1, 1)
+	s.Require().False(found)
+
+	ownerAfter, err := s.getBalance(owner, "ucmdx")
+	s.Require().NoError(err)
+	s.Require().Equal(ParseCoin("66666ucmdx"), ownerAfter.Sub(ownerBefore))
+	collectorAfter := s.app.BankKeeper.GetBalance(*ctx, s.app.AccountKeeper.GetModuleAddress(collectorTypes.ModuleName), "ucmst")
+	s.Require().Equal(ParseCoin("220000ucmst"), collectorAfter.Sub(collectorBefore))
+}
+
+func (s *KeeperTestSuite) TestSealedBidLendApp() {
+	k, ctx := &s.keeper, &s.ctx
+	sealedParams := func(appID uint64) *bindings.MsgAddAuctionParams {
+		return &bindings.MsgAddAuctionParams{
+			AppID: appID, AuctionDurationSeconds: 300, Buffer: Dec("1.2"), Cusp: Dec("0.6"), Step: 1, PriceFunctionType: 1,
+			SurplusID: 1, DebtID: 2, DutchID: 3, BidDurationSeconds: 300, SealedBid: true, CommitDurationSeconds: 100, RevealDurationSeconds: 50,
+		}
+	}
+	lendParams := func(appID uint64) lendtypes.AuctionParams {
+		return lendtypes.AuctionParams{
+			AppId: appID, AuctionDurationSeconds: 21600, Buffer: Dec("1.2"), Cusp: Dec("0.7"), Step: sdk.NewInt(360), PriceFunctionType: 1, DutchId: 3, BidDurationSeconds: 3600,
+		}
+	}
+
+	// An app running lend auctions cannot switch to sealed bids.
+	s.Require().NoError(s.app.LendKeeper.AddAuctionParamsData(*ctx, lendParams(5)))
+	s.Require().ErrorIs(k.AddAuctionParams(*ctx, sealedParams(5)), auctionTypes.ErrorSealedBidLendApp)
+	_, found := k.GetAuctionParams(*ctx, 5)
+	s.Require().False(found)
+
+	// An app auctioning collateral with sealed bids cannot start lend auctions.
+	s.Require().NoError(k.AddAuctionParams(*ctx, sealedParams(6)))
+	s.Require().True(k.IsSealedBidApp(*ctx, 6))
+	err := s.app.LendKeeper.HandleAddAuctionParamsRecords(*ctx, &lendtypes.AddAuctionParamsProposal{AuctionParams: lendParams(6)})
+	s.Require().ErrorIs(err, lendtypes.ErrorSealedBidApp)
+	_, found = s.app.LendKeeper.GetAddAuctionParamsData(*ctx, 6)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestSealedBidsRefundedWhenSettlementFails() {
+	s.TestDutchActivator()
+	k, ctx := &s.keeper, &s.ctx
+	server := auctionKeeper.NewMsgServiceServer(*k)
+
+	auctionParams, found := k.GetAuctionParams(*ctx, 1)
+	s.Require().True(found)
+	auctionParams.SealedBid = true
+	auctionParams.CommitDurationSeconds = 100
+	auctionParams.RevealDurationSeconds = 50
+	k.SetAuctionParams(*ctx, auctionParams)
+	s.advanceseconds(301)
+	auction.BeginBlocker(*ctx, s.app.AuctionKeeper, s.app.AssetKeeper, s.app.CollectorKeeper, s.app.EsmKeeper)
+
+	bidders := []sdk.AccAddress{
+		sdk.AccAddress("sealed_bidder_1_____"),
+		sdk.AccAddress("sealed_bidder_2_____"),
+	}
+	quantity, payment, escrow := ParseCoin("400000ucmdx"), ParseCoin("480000ucmst"), ParseCoin("500000ucmst")
+	biddingIDs := make([]uint64, len(bidders))
+	for i, bidder := range bidders {
+		s.fundAddr(bidder, escrow)
+		res, err := server.MsgCommitDutchBid(sdk.WrapSDKContext(*ctx), &auctionTypes.MsgCommitDutchBidRequest{
+			AuctionId: 1, Bidder: bidder.String(), Commitment: auctionTypes.SealedBidCommitment(bidder.String(), 1, quantity, payment, "salt"), Escrow: escrow, AppId: 1, AuctionMappingId: 3,
+		})
+		s.Require().NoError(err)
+		biddingIDs[i] = res.BiddingId
+	}
+	s.advanceseconds(101)
+	_, err := server.MsgRevealDutchBid(sdk.WrapSDKContext(*ctx), &auctionTypes.MsgRevealDutchBidRequest{
+		AuctionId: 1, Bidder: bidders[0].String(), BiddingId: biddingIDs[0], Quantity: quantity, Payment: payment, Salt: "salt", AppId: 1, AuctionMappingId: 3,
+	})
+	s.Require().NoError(err)
+
+	// Without its locked vault the auction cannot be settled, the escrow of
+	// every sealed bid goes back to its bidder instead of staying behind.
+	s.app.LiquidationKeeper.DeleteLockedVault(*ctx, 1, 1)
+	s.advanceseconds(50)
+	auction.BeginBlocker(*ctx, s.app.AuctionKeeper, s.app.AssetKeeper, s.app.CollectorKeeper, s.app.EsmKeeper)
+
+	s.Require().Empty(k.GetSealedBids(*ctx, 1, 1))
+	for _, bidder := range bidders {
+		balance, err := s.getBalance(bidder.String(), "ucmst")
+		s.Require().NoError(err)
+		s.Require().Equal(escrow, balance)
+	}
+}
@@ -210,6 +210,12 @@ func (k Keeper) SetHistorySurplusAuction(ctx sdk.Context, auction auctiontypes.S
 		value = k.cdc.MustMarshal(&auction)
 	)
 	store.Set(key, value)
+	k.SetHistoryIndex(ctx, auctiontypes.AuctionHistoryIndex{
+		AppId:       auction.AppId,
+		AuctionType: auctionType,
+		AuctionId:   auction.AuctionId,
+		ClosedAt:    ctx.BlockTime(),
+	})
 	return nil
 }
 
@@ -472,6 +478,12 @@ func (k Keeper) SetHistoryDebtAuction(ctx sdk.Context, auction auctiontypes.Debt
 		value = k.cdc.MustMarshal(&auction)
 	)
 	store.Set(key, value)
+	k.SetHistoryIndex(ctx, auctiontypes.AuctionHistoryIndex{
+		AppId:       auction.AppId,
+		AuctionType: auctionType,
+		AuctionId:   auction.AuctionId,
+		ClosedAt:    ctx.BlockTime(),
+	})
 	return nil
 }
 
@@ -739,6 +751,12 @@ func (k Keeper) SetHistoryDutchAuction(ctx sdk.Context, auction auctiontypes.Dut
 		value = k.cdc.MustMarshal(&auction)
 	)
 	store.Set(key, value)
+	k.SetHistoryIndex(ctx, auctiontypes.AuctionHistoryIndex{
+		AppId:       auction.AppId,
+		AuctionType: auctionType,
+		AuctionId:   auction.AuctionId,
+		ClosedAt:    ctx.BlockTime(),
+	})
 	return nil
 }
 
@@ -1091,6 +1109,13 @@ func (k Keeper) SetHistoryDutchLendAuction(ctx sdk.Context, auction auctiontypes
 		value = k.cdc.MustMarshal(&auction)
 	)
 	store.Set(key, value)
+	k.SetHistoryIndex(ctx, auctiontypes.AuctionHistoryIndex{
+		AppId:       auction.AppId,
+		AuctionType: auctionType,
+		Lend:        true,
+		AuctionId:   auction.AuctionId,
+		ClosedAt:    ctx.BlockTime(),
+	})
 	return nil
 }
 
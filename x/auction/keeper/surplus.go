@@ -128,6 +128,7 @@ func (k Keeper) StartSurplusAuction(
 		EndTime:          ctx.BlockTime().Add(time.Second * time.Duration(auctionParams.AuctionDurationSeconds)),
 		BidEndTime:       ctx.BlockTime().Add(time.Second * time.Duration(auctionParams.AuctionDurationSeconds)),
 		BidFactor:        bidFactor,
+		ClearingPrice:    sdk.ZeroDec(),
 		BiddingIds:       []*auctiontypes.BidOwnerMapping{},
 		AuctionStatus:    auctiontypes.AuctionStartNoBids,
 		AppId:            appID,
@@ -195,74 +196,101 @@ func (k Keeper) closeSurplusAuction(
 	surplusAuction auctiontypes.SurplusAuction,
 	statusEsm bool,
 ) error {
-	if statusEsm && surplusAuction.Bidder != nil {
-		err := k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, surplusAuction.Bidder, sdk.NewCoins(surplusAuction.Bid))
-		if err != nil {
-			return err
+	biddings, bids := k.getSurplusBatch(ctx, surplusAuction)
+
+	unsold := surplusAuction.SellToken.Amount
+	if !statusEsm {
+		clearingPrice, fills := clearBatch(bids, surplusAuction.SellToken.Amount, true)
+		proceeds := sdk.ZeroInt()
+		for i, bidding := range biddings {
+			if bidding.BiddingStatus != auctiontypes.PlacedBiddingStatus {
+				continue
+			}
+			bidder, err := sdk.AccAddressFromBech32(bidding.Bidder)
+			if err != nil {
+				return err
+			}
+
+			payment := sdk.ZeroInt()
+			bidding.BiddingStatus = auctiontypes.RejectedBiddingStatus
+			if fills[i].IsPositive() {
+				payment = surplusPayment(clearingPrice, fills[i], bidding.Bid.Amount)
+				err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(sdk.NewCoin(surplusAuction.SellToken.Denom, fills[i])))
+				if err != nil {
+					return err
+				}
+				bidding.BiddingStatus = auctiontypes.SuccessBiddingStatus
+			}
+			// refund what the bid escrowed above the clearing price and for the unfilled quantity
+			refund := bidding.Bid.Amount.Sub(payment)
+			if refund.IsPositive() {
+				err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(sdk.NewCoin(bidding.Bid.Denom, refund)))
+				if err != nil {
+					return err
+				}
+			}
+			bidding.Filled = sdk.NewCoin(surplusAuction.SellToken.Denom, fills[i])
+			biddings[i] = bidding
+
+			proceeds = proceeds.Add(payment)
+			unsold = unsold.Sub(fills[i])
 		}
+		surplusAuction.ClearingPrice = clearingPrice
+		surplusAuction.Bid = sdk.NewCoin(surplusAuction.BuyToken.Denom, proceeds)
 
-		err1 := k.bank.SendCoinsFromModuleToModule(ctx, auctiontypes.ModuleName, collectortypes.ModuleName, sdk.NewCoins(surplusAuction.SellToken))
-		if err1 != nil {
-			return err1
+		// burn tokens by sending bid tokens from auction to tokenMint module and then call burn function
+		if proceeds.IsPositive() {
+			err := k.bank.SendCoinsFromModuleToModule(ctx, auctiontypes.ModuleName, tokenminttypes.ModuleName, sdk.NewCoins(surplusAuction.Bid))
+			if err != nil {
+				return err
+			}
+			err = k.tokenMint.BurnTokensForApp(ctx, surplusAuction.AppId, surplusAuction.AssetInId, proceeds)
+			if err != nil {
+				return err
+			}
 		}
-		err2 := k.collector.SetNetFeeCollectedData(ctx, surplusAuction.AppId, surplusAuction.AssetOutId, surplusAuction.SellToken.Amount)
-		if err2 != nil {
-			return auctiontypes.ErrorUnableToSetNetFees
+	} else {
+		for i, bidding := range biddings {
+			if bidding.BiddingStatus != auctiontypes.PlacedBiddingStatus {
+				continue
+			}
+			bidder, err := sdk.AccAddressFromBech32(bidding.Bidder)
+			if err != nil {
+				return err
+			}
+			err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(bidding.Bid))
+			if err != nil {
+				return err
+			}
+			bidding.BiddingStatus = auctiontypes.RejectedBiddingStatus
+			biddings[i] = bidding
 		}
-	} else if !statusEsm && surplusAuction.Bidder != nil {
-		highestBidReceived := surplusAuction.Bid
+	}
 
-		err := k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, surplusAuction.Bidder, sdk.NewCoins(surplusAuction.SellToken))
+	for _, bidding := range biddings {
+		bidding.AuctionStatus = auctiontypes.ClosedAuctionStatus
+		err := k.SetSurplusUserBidding(ctx, bidding)
 		if err != nil {
 			return err
 		}
-
-		bidding, err := k.GetSurplusUserBidding(ctx, surplusAuction.Bidder.String(), surplusAuction.AppId, surplusAuction.ActiveBiddingId)
+		err = k.DeleteSurplusUserBidding(ctx, bidding)
 		if err != nil {
 			return err
 		}
-		bidding.BiddingStatus = auctiontypes.SuccessBiddingStatus
-		err = k.SetSurplusUserBidding(ctx, bidding)
+		err = k.SetHistorySurplusUserBidding(ctx, bidding)
 		if err != nil {
 			return err
 		}
+	}
 
-		// burn tokens by sending bid tokens from auction to tokenMint module and then call burn function
-		err = k.bank.SendCoinsFromModuleToModule(ctx, auctiontypes.ModuleName, tokenminttypes.ModuleName, sdk.NewCoins(highestBidReceived))
+	// return the part of the lot left unsold to the collector
+	if unsold.IsPositive() {
+		err := k.bank.SendCoinsFromModuleToModule(ctx, auctiontypes.ModuleName, collectortypes.ModuleName, sdk.NewCoins(sdk.NewCoin(surplusAuction.SellToken.Denom, unsold)))
 		if err != nil {
 			return err
 		}
-		err = k.tokenMint.BurnTokensForApp(ctx, surplusAuction.AppId, surplusAuction.AssetInId, highestBidReceived.Amount)
+		err = k.collector.SetNetFeeCollectedData(ctx, surplusAuction.AppId, surplusAuction.AssetOutId, unsold)
 		if err != nil {
-			return err
-		}
-
-		for _, biddingID := range surplusAuction.BiddingIds {
-			bidding, err := k.GetSurplusUserBidding(ctx, biddingID.BidOwner, surplusAuction.AppId, biddingID.BidId)
-			if err != nil {
-				continue
-			}
-			bidding.AuctionStatus = auctiontypes.ClosedAuctionStatus
-			err = k.SetSurplusUserBidding(ctx, bidding)
-			if err != nil {
-				return err
-			}
-			err = k.DeleteSurplusUserBidding(ctx, bidding)
-			if err != nil {
-				return err
-			}
-			err = k.SetHistorySurplusUserBidding(ctx, bidding)
-			if err != nil {
-				return err
-			}
-		}
-	} else {
-		err1 := k.bank.SendCoinsFromModuleToModule(ctx, auctiontypes.ModuleName, collectortypes.ModuleName, sdk.NewCoins(surplusAuction.SellToken))
-		if err1 != nil {
-			return err1
-		}
-		err2 := k.collector.SetNetFeeCollectedData(ctx, surplusAuction.AppId, surplusAuction.AssetOutId, surplusAuction.SellToken.Amount)
-		if err2 != nil {
 			return auctiontypes.ErrorUnableToSetNetFees
 		}
 	}
@@ -283,63 +311,104 @@ func (k Keeper) closeSurplusAuction(
 	return nil
 }
 
-func (k Keeper) PlaceSurplusAuctionBid(ctx sdk.Context, appID, auctionMappingID, auctionID uint64, bidder sdk.AccAddress, bid sdk.Coin) error {
-	auction, err := k.GetSurplusAuction(ctx, appID, auctionMappingID, auctionID)
-	auctionParam, _ := k.GetAuctionParams(ctx, appID)
+// getSurplusBatch returns the biddings of a surplus auction and the bids they
+// place on its lot. Biddings no longer placed bid for nothing.
+func (k Keeper) getSurplusBatch(ctx sdk.Context, surplusAuction auctiontypes.SurplusAuction) (biddings []auctiontypes.SurplusBiddings, bids []batchBid) {
+	for _, biddingID := range surplusAuction.BiddingIds {
+		bidding, err := k.GetSurplusUserBidding(ctx, biddingID.BidOwner, surplusAuction.AppId, biddingID.BidId)
+		if err != nil {
+			continue
+		}
+		bid := batchBid{Price: sdk.ZeroDec(), Quantity: sdk.ZeroInt()}
+		if bidding.BiddingStatus == auctiontypes.PlacedBiddingStatus && bidding.AuctionedCollateral.Amount.IsPositive() {
+			bid.Quantity = bidding.AuctionedCollateral.Amount
+			bid.Price = bidding.Price
+			if bid.Price.IsNil() {
+				bid.Price = bidding.Bid.Amount.ToDec().QuoInt(bid.Quantity)
+			}
+		}
+		biddings = append(biddings, bidding)
+		bids = append(bids, bid)
+	}
+	return biddings, bids
+}
 
+// surplusPayment is what a bid pays for quantity at the clearing price, never
+// more than it escrowed.
+func surplusPayment(clearingPrice sdk.Dec, quantity, escrow sdk.Int) sdk.Int {
+	return sdk.MinInt(clearingPrice.MulInt(quantity).Ceil().TruncateInt(), escrow)
+}
+
+func (k Keeper) PlaceSurplusAuctionBid(ctx sdk.Context, appID, auctionMappingID, auctionID uint64, bidder sdk.AccAddress, bid, quantity sdk.Coin) error {
+	auction, err := k.GetSurplusAuction(ctx, appID, auctionMappingID, auctionID)
 	if err != nil {
 		return auctiontypes.ErrorInvalidSurplusAuctionID
 	}
 	if bid.Denom != auction.BuyToken.Denom {
 		return auctiontypes.ErrorInvalidBiddingDenom
 	}
+	if quantity.Denom == "" {
+		quantity = auction.SellToken
+	}
+	minQuantity := batchMinQuantity(auction.SellToken.Amount)
+	if quantity.Denom != auction.SellToken.Denom || quantity.Amount.IsNil() || quantity.Amount.LT(minQuantity) || quantity.Amount.GT(auction.SellToken.Amount) {
+		return sdkerrors.Wrapf(auctiontypes.ErrorInvalidBidQuantity, "quantity should be at least %s%s and at most %s", minQuantity, auction.SellToken.Denom, auction.SellToken)
+	}
+	if !bid.Amount.IsPositive() {
+		return auctiontypes.ErrorLowBidAmount
+	}
+	price := bid.Amount.ToDec().QuoInt(quantity.Amount)
 
-	if auction.AuctionStatus != auctiontypes.AuctionStartNoBids {
-		change := auction.BidFactor.MulInt(auction.Bid.Amount).Ceil().TruncateInt()
-		minBidAmount := auction.Bid.Amount.Add(change)
-		if bid.Amount.LT(minBidAmount) {
-			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "bid should be greater than or equal to %d ", minBidAmount)
-		}
-	} else {
-		if bid.Amount.LTE(auction.Bid.Amount) {
-			return auctiontypes.ErrorLowBidAmount
+	ctx.GasMeter().ConsumeGas(auctiontypes.BatchBidGas*uint64(len(auction.BiddingIds)), "BatchBidGas")
+
+	// once the lot is covered a bid has to improve the clearing price by the bid factor
+	biddings, bids := k.getSurplusBatch(ctx, auction)
+	if batchCovered(bids, auction.SellToken.Amount) {
+		clearingPrice, _ := clearBatch(bids, auction.SellToken.Amount, true)
+		minPrice := clearingPrice.Mul(sdk.OneDec().Add(auction.BidFactor))
+		if price.LT(minPrice) {
+			return sdkerrors.Wrapf(auctiontypes.ErrorLowBidAmount, "bid price should be greater than or equal to %s", minPrice)
 		}
 	}
+
+	bids = append(bids, batchBid{Price: price, Quantity: quantity.Amount})
+	clearingPrice, fills := clearBatch(bids, auction.SellToken.Amount, true)
+	evictions, err := batchEvictions(fills)
+	if err != nil {
+		return err
+	}
+	filled := sdk.ZeroInt()
+	for _, fill := range fills {
+		filled = filled.Add(fill)
+	}
+
 	err = k.bank.SendCoinsFromAccountToModule(ctx, bidder, auctiontypes.ModuleName, sdk.NewCoins(bid))
 	if err != nil {
 		return err
 	}
-	biddingID, err := k.CreateNewSurplusBid(ctx, appID, auctionMappingID, auctionID, bidder.String(), bid)
+	biddingID, err := k.CreateNewSurplusBid(ctx, appID, auctionMappingID, auctionID, bidder.String(), bid, quantity, price)
 	if err != nil {
 		return err
 	}
-	if auction.AuctionStatus != auctiontypes.AuctionStartNoBids {
-		// auction.Bidder as previous bidder . refund previous bidder
-		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, auction.Bidder, sdk.NewCoins(auction.Bid))
-		if err != nil {
-			return err
-		}
-		bidding, err := k.GetSurplusUserBidding(ctx, auction.Bidder.String(), auction.AppId, auction.ActiveBiddingId)
-		if err != nil {
-			return err
-		}
-		bidding.BiddingStatus = auctiontypes.RejectedBiddingStatus
-		err = k.SetSurplusUserBidding(ctx, bidding)
-		if err != nil {
+
+	evicted := make(map[uint64]bool, len(evictions))
+	for _, i := range evictions {
+		if err := k.evictSurplusBid(ctx, biddings[i]); err != nil {
 			return err
 		}
-	} else {
-		auction.AuctionStatus = auctiontypes.AuctionGoingOn
+		evicted[biddings[i].BiddingId] = true
 	}
+	biddingIDs, evictedIDs := withoutBids(auction.BiddingIds, evicted)
+	auction.BiddingIds = biddingIDs
+	auction.EvictedBiddingIds = append(auction.EvictedBiddingIds, evictedIDs...)
+
+	auction.AuctionStatus = auctiontypes.AuctionGoingOn
 	auction.ActiveBiddingId = biddingID
 	bidIDOwner := &auctiontypes.BidOwnerMapping{BidId: biddingID, BidOwner: bidder.String()}
 	auction.BiddingIds = append(auction.BiddingIds, bidIDOwner)
 	auction.Bidder = bidder
-	auction.Bid = bid
-	auction.BidEndTime = ctx.BlockTime().Add(time.Second * time.Duration(auctionParam.BidDurationSeconds))
-	if auction.BidEndTime.After(auction.EndTime) {
-		auction.BidEndTime = auction.EndTime
-	}
+	auction.ClearingPrice = clearingPrice
+	auction.Bid = sdk.NewCoin(auction.BuyToken.Denom, clearingPrice.MulInt(filled).Ceil().TruncateInt())
 	err = k.SetSurplusAuction(ctx, auction)
 	if err != nil {
 		return err
@@ -347,22 +416,42 @@ func (k Keeper) PlaceSurplusAuctionBid(ctx sdk.Context, appID, auctionMappingID,
 	return nil
 }
 
-func (k Keeper) CreateNewSurplusBid(ctx sdk.Context, appID, auctionMappingID, auctionID uint64, bidder string, bid sdk.Coin) (biddingID uint64, err error) {
-	auction, err := k.GetSurplusAuction(ctx, appID, auctionMappingID, auctionID)
+// evictSurplusBid refunds a bid left without any fill of a full auction and
+// moves it to the history.
+func (k Keeper) evictSurplusBid(ctx sdk.Context, bidding auctiontypes.SurplusBiddings) error {
+	if bidding.BiddingStatus == auctiontypes.PlacedBiddingStatus {
+		bidder, err := sdk.AccAddressFromBech32(bidding.Bidder)
+		if err != nil {
+			return err
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, bidder, sdk.NewCoins(bidding.Bid))
+		if err != nil {
+			return err
+		}
+		bidding.BiddingStatus = auctiontypes.RejectedBiddingStatus
+	}
+	bidding.AuctionStatus = auctiontypes.ClosedAuctionStatus
+	err := k.DeleteSurplusUserBidding(ctx, bidding)
 	if err != nil {
-		return biddingID, err
+		return err
 	}
+	return k.SetHistorySurplusUserBidding(ctx, bidding)
+}
+
+func (k Keeper) CreateNewSurplusBid(ctx sdk.Context, appID, auctionMappingID, auctionID uint64, bidder string, bid, quantity sdk.Coin, price sdk.Dec) (biddingID uint64, err error) {
 	bidding := auctiontypes.SurplusBiddings{
 		BiddingId:           k.GetUserBiddingID(ctx) + 1,
 		AuctionId:           auctionID,
 		AuctionStatus:       auctiontypes.ActiveAuctionStatus,
-		AuctionedCollateral: auction.SellToken,
+		AuctionedCollateral: quantity,
 		Bidder:              bidder,
 		Bid:                 bid,
 		BiddingTimestamp:    ctx.BlockTime(),
 		BiddingStatus:       auctiontypes.PlacedBiddingStatus,
 		AppId:               appID,
 		AuctionMappingId:    auctionMappingID,
+		Price:               price,
+		Filled:              sdk.NewCoin(quantity.Denom, sdk.ZeroInt()),
 	}
 	k.SetUserBiddingID(ctx, bidding.BiddingId)
 	err = k.SetSurplusUserBidding(ctx, bidding)
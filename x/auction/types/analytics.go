@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAuctionStats returns empty stats for a collateral asset of an app.
+func NewAuctionStats(appID, assetID uint64) AuctionStats {
+	return AuctionStats{
+		AppId:       appID,
+		AssetId:     assetID,
+		FilledValue: sdk.ZeroDec(),
+		PaidValue:   sdk.ZeroDec(),
+		UnsoldShare: sdk.ZeroDec(),
+	}
+}
+
+// Analytics averages the stats over the fills and closed auctions.
+func (s AuctionStats) Analytics() AuctionAnalytics {
+	analytics := AuctionAnalytics{
+		AppId:              s.AppId,
+		AssetId:            s.AssetId,
+		Fills:              s.Fills,
+		AuctionsClosed:     s.AuctionsClosed,
+		AverageDiscount:    sdk.ZeroDec(),
+		AverageUnsoldShare: sdk.ZeroDec(),
+	}
+	if s.FilledValue.IsPositive() {
+		analytics.AverageDiscount = sdk.OneDec().Sub(s.PaidValue.Quo(s.FilledValue))
+	}
+	if s.AuctionsClosed > 0 {
+		analytics.AverageTimeToClearSeconds = s.ClearSeconds / s.AuctionsClosed
+		analytics.AverageUnsoldShare = s.UnsoldShare.QuoInt64(int64(s.AuctionsClosed))
+	}
+	return analytics
+}
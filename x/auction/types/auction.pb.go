@@ -46,6 +46,12 @@ type SurplusAuction struct {
 	AssetInId        uint64                                        `protobuf:"varint,14,opt,name=asset_in_id,json=assetInId,proto3" json:"asset_in_id,omitempty" yaml:"asset_in_id"`
 	AssetOutId       uint64                                        `protobuf:"varint,15,opt,name=asset_out_id,json=assetOutId,proto3" json:"asset_out_id,omitempty" yaml:"asset_out_id"`
 	BidEndTime       time.Time                                     `protobuf:"bytes,16,opt,name=bid_end_time,json=bidEndTime,proto3,stdtime" json:"bid_end_time" yaml:"bid_end_time"`
+	// clearing_price is the uniform price, in buy token per sell token, the
+	// bids placed so far clear at.
+	ClearingPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=clearing_price,json=clearingPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"clearing_price" yaml:"clearing_price"`
+	// evicted_bidding_ids are the bids refunded to make room for better ones,
+	// kept in history with the auction.
+	EvictedBiddingIds []*BidOwnerMapping `protobuf:"bytes,18,rep,name=evicted_bidding_ids,json=evictedBiddingIds,proto3" json:"evicted_bidding_ids,omitempty" yaml:"evicted_bidding_ids"`
 }
 
 func (m *SurplusAuction) Reset()         { *m = SurplusAuction{} }
@@ -99,6 +105,12 @@ type DebtAuction struct {
 	AssetInId           uint64                                        `protobuf:"varint,15,opt,name=asset_in_id,json=assetInId,proto3" json:"asset_in_id,omitempty" yaml:"asset_in_id"`
 	AssetOutId          uint64                                        `protobuf:"varint,16,opt,name=asset_out_id,json=assetOutId,proto3" json:"asset_out_id,omitempty" yaml:"asset_out_id"`
 	BidEndTime          time.Time                                     `protobuf:"bytes,17,opt,name=bid_end_time,json=bidEndTime,proto3,stdtime" json:"bid_end_time" yaml:"bid_end_time"`
+	// clearing_price is the uniform price, in minted token per expected user
+	// token, the bids placed so far clear at.
+	ClearingPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=clearing_price,json=clearingPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"clearing_price" yaml:"clearing_price"`
+	// evicted_bidding_ids are the bids refunded to make room for better ones,
+	// kept in history with the auction.
+	EvictedBiddingIds []*BidOwnerMapping `protobuf:"bytes,19,rep,name=evicted_bidding_ids,json=evictedBiddingIds,proto3" json:"evicted_bidding_ids,omitempty" yaml:"evicted_bidding_ids"`
 }
 
 func (m *DebtAuction) Reset()         { *m = DebtAuction{} }
@@ -155,6 +167,10 @@ type DutchAuction struct {
 	LockedVaultId             uint64                                        `protobuf:"varint,18,opt,name=locked_vault_id,json=lockedVaultId,proto3" json:"locked_vault_id,omitempty" yaml:"locked_vault_id"`
 	VaultOwner                github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,19,opt,name=vault_owner,json=vaultOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"vault_owner,omitempty" yaml:"vault_owner"`
 	LiquidationPenalty        github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,20,opt,name=liquidation_penalty,json=liquidationPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_penalty" yaml:"liquidatin_penalty"`
+	Sealed                    bool                                          `protobuf:"varint,21,opt,name=sealed,proto3" json:"sealed,omitempty" yaml:"sealed"`
+	CommitEndTime             time.Time                                     `protobuf:"bytes,22,opt,name=commit_end_time,json=commitEndTime,proto3,stdtime" json:"commit_end_time" yaml:"commit_end_time"`
+	RevealEndTime             time.Time                                     `protobuf:"bytes,23,opt,name=reveal_end_time,json=revealEndTime,proto3,stdtime" json:"reveal_end_time" yaml:"reveal_end_time"`
+	EsmRestarts               uint64                                        `protobuf:"varint,24,opt,name=esm_restarts,json=esmRestarts,proto3" json:"esm_restarts,omitempty" yaml:"esm_restarts"`
 }
 
 func (m *DutchAuction) Reset()         { *m = DutchAuction{} }
@@ -278,6 +294,12 @@ type AuctionParams struct {
 	DebtId                 uint64                                 `protobuf:"varint,8,opt,name=debt_id,json=debtId,proto3" json:"debt_id,omitempty" yaml:"debt_id"`
 	DutchId                uint64                                 `protobuf:"varint,9,opt,name=dutch_id,json=dutchId,proto3" json:"dutch_id,omitempty" yaml:"dutch_id"`
 	BidDurationSeconds     uint64                                 `protobuf:"varint,10,opt,name=bid_duration_seconds,json=bidDurationSeconds,proto3" json:"bid_duration_seconds,omitempty" yaml:"bid_duration_seconds"`
+	// sealed_bid makes dutch auctions take hashed bids during a commit window
+	// that are revealed afterwards, instead of public bids. Lend auctions only
+	// take public bids, so it cannot be set on an app running them.
+	SealedBid             bool   `protobuf:"varint,11,opt,name=sealed_bid,json=sealedBid,proto3" json:"sealed_bid,omitempty" yaml:"sealed_bid"`
+	CommitDurationSeconds uint64 `protobuf:"varint,12,opt,name=commit_duration_seconds,json=commitDurationSeconds,proto3" json:"commit_duration_seconds,omitempty" yaml:"commit_duration_seconds"`
+	RevealDurationSeconds uint64 `protobuf:"varint,13,opt,name=reveal_duration_seconds,json=revealDurationSeconds,proto3" json:"reveal_duration_seconds,omitempty" yaml:"reveal_duration_seconds"`
 }
 
 func (m *AuctionParams) Reset()         { *m = AuctionParams{} }
@@ -313,6 +335,194 @@ func (m *AuctionParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AuctionParams proto.InternalMessageInfo
 
+// BadDebt is the shortfall left by liquidation auctions of an asset that
+// closed without covering their debt, and how it was covered. Lend auctions
+// record it per pool, vault auctions with a pool id of zero.
+type BadDebt struct {
+	AppId              uint64                                 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	PoolId             uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	AssetId            uint64                                 `protobuf:"varint,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	Recorded           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=recorded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"recorded" yaml:"recorded"`
+	CoveredByReserve   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=covered_by_reserve,json=coveredByReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"covered_by_reserve" yaml:"covered_by_reserve"`
+	CoveredByCollector github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=covered_by_collector,json=coveredByCollector,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"covered_by_collector" yaml:"covered_by_collector"`
+	Socialized         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=socialized,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"socialized" yaml:"socialized"`
+	Outstanding        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=outstanding,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outstanding" yaml:"outstanding"`
+}
+
+func (m *BadDebt) Reset()         { *m = BadDebt{} }
+func (m *BadDebt) String() string { return proto.CompactTextString(m) }
+func (*BadDebt) ProtoMessage()    {}
+func (*BadDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4bb9aead25d5fe6c, []int{6}
+}
+func (m *BadDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadDebt.Merge(m, src)
+}
+func (m *BadDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadDebt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadDebt proto.InternalMessageInfo
+
+// AuctionHistoryIndex orders the closed auctions kept in history by the time
+// they closed.
+type AuctionHistoryIndex struct {
+	AppId       uint64    `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	AuctionType string    `protobuf:"bytes,2,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty" yaml:"auction_type"`
+	Lend        bool      `protobuf:"varint,3,opt,name=lend,proto3" json:"lend,omitempty" yaml:"lend"`
+	AuctionId   uint64    `protobuf:"varint,4,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty" yaml:"auction_id"`
+	ClosedAt    time.Time `protobuf:"bytes,5,opt,name=closed_at,json=closedAt,proto3,stdtime" json:"closed_at" yaml:"closed_at"`
+}
+
+func (m *AuctionHistoryIndex) Reset()         { *m = AuctionHistoryIndex{} }
+func (m *AuctionHistoryIndex) String() string { return proto.CompactTextString(m) }
+func (*AuctionHistoryIndex) ProtoMessage()    {}
+func (*AuctionHistoryIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4bb9aead25d5fe6c, []int{7}
+}
+func (m *AuctionHistoryIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionHistoryIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionHistoryIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionHistoryIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionHistoryIndex.Merge(m, src)
+}
+func (m *AuctionHistoryIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionHistoryIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionHistoryIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionHistoryIndex proto.InternalMessageInfo
+
+// AuctionStats adds up how the liquidation auctions of a collateral asset of
+// an app cleared.
+type AuctionStats struct {
+	AppId   uint64 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	AssetId uint64 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	Fills   uint64 `protobuf:"varint,3,opt,name=fills,proto3" json:"fills,omitempty" yaml:"fills"`
+	// filled_value is the oracle value of the collateral sold.
+	FilledValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=filled_value,json=filledValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"filled_value" yaml:"filled_value"`
+	// paid_value is the value of the debt token paid for it.
+	PaidValue      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=paid_value,json=paidValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"paid_value" yaml:"paid_value"`
+	AuctionsClosed uint64                                 `protobuf:"varint,6,opt,name=auctions_closed,json=auctionsClosed,proto3" json:"auctions_closed,omitempty" yaml:"auctions_closed"`
+	// clear_seconds is the time from liquidation to close of the auctions.
+	ClearSeconds uint64 `protobuf:"varint,7,opt,name=clear_seconds,json=clearSeconds,proto3" json:"clear_seconds,omitempty" yaml:"clear_seconds"`
+	// unsold_share is the share of the lot left unsold, summed over the
+	// auctions.
+	UnsoldShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=unsold_share,json=unsoldShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unsold_share" yaml:"unsold_share"`
+}
+
+func (m *AuctionStats) Reset()         { *m = AuctionStats{} }
+func (m *AuctionStats) String() string { return proto.CompactTextString(m) }
+func (*AuctionStats) ProtoMessage()    {}
+func (*AuctionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4bb9aead25d5fe6c, []int{8}
+}
+func (m *AuctionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionStats.Merge(m, src)
+}
+func (m *AuctionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionStats proto.InternalMessageInfo
+
+// AuctionAnalytics are the clearing averages of the liquidation auctions of a
+// collateral asset of an app.
+type AuctionAnalytics struct {
+	AppId          uint64 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	AssetId        uint64 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	Fills          uint64 `protobuf:"varint,3,opt,name=fills,proto3" json:"fills,omitempty" yaml:"fills"`
+	AuctionsClosed uint64 `protobuf:"varint,4,opt,name=auctions_closed,json=auctionsClosed,proto3" json:"auctions_closed,omitempty" yaml:"auctions_closed"`
+	// average_discount is the discount to the oracle price the collateral was
+	// sold at, weighted by value. It is negative when sold at a premium.
+	AverageDiscount           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=average_discount,json=averageDiscount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_discount" yaml:"average_discount"`
+	AverageTimeToClearSeconds uint64                                 `protobuf:"varint,6,opt,name=average_time_to_clear_seconds,json=averageTimeToClearSeconds,proto3" json:"average_time_to_clear_seconds,omitempty" yaml:"average_time_to_clear_seconds"`
+	AverageUnsoldShare        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=average_unsold_share,json=averageUnsoldShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_unsold_share" yaml:"average_unsold_share"`
+}
+
+func (m *AuctionAnalytics) Reset()         { *m = AuctionAnalytics{} }
+func (m *AuctionAnalytics) String() string { return proto.CompactTextString(m) }
+func (*AuctionAnalytics) ProtoMessage()    {}
+func (*AuctionAnalytics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4bb9aead25d5fe6c, []int{9}
+}
+func (m *AuctionAnalytics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionAnalytics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionAnalytics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionAnalytics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionAnalytics.Merge(m, src)
+}
+func (m *AuctionAnalytics) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionAnalytics) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionAnalytics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionAnalytics proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*SurplusAuction)(nil), "comdex.auction.v1beta1.SurplusAuction")
 	proto.RegisterType((*DebtAuction)(nil), "comdex.auction.v1beta1.DebtAuction")
@@ -320,6 +530,10 @@ func init() {
 	proto.RegisterType((*BidOwnerMapping)(nil), "comdex.auction.v1beta1.bidOwnerMapping")
 	proto.RegisterType((*ProtocolStatistics)(nil), "comdex.auction.v1beta1.ProtocolStatistics")
 	proto.RegisterType((*AuctionParams)(nil), "comdex.auction.v1beta1.AuctionParams")
+	proto.RegisterType((*BadDebt)(nil), "comdex.auction.v1beta1.BadDebt")
+	proto.RegisterType((*AuctionHistoryIndex)(nil), "comdex.auction.v1beta1.AuctionHistoryIndex")
+	proto.RegisterType((*AuctionStats)(nil), "comdex.auction.v1beta1.AuctionStats")
+	proto.RegisterType((*AuctionAnalytics)(nil), "comdex.auction.v1beta1.AuctionAnalytics")
 }
 
 func init() {
@@ -327,113 +541,159 @@ func init() {
 }
 
 var fileDescriptor_4bb9aead25d5fe6c = []byte{
-	// 1687 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1b, 0xcf, 0xb6, 0x49, 0x1c, 0x8f, 0x93, 0x38, 0xde, 0x7c, 0x39, 0x49, 0xeb, 0x4d, 0xa7, 0xaf,
-	0xde, 0x46, 0x7a, 0x55, 0x5b, 0xed, 0xfb, 0xea, 0x95, 0x40, 0x42, 0x10, 0x37, 0x2d, 0x58, 0xa5,
-	0x34, 0x6c, 0xd2, 0x82, 0x2a, 0x55, 0x66, 0x77, 0x67, 0xec, 0x0e, 0x5d, 0xef, 0x2e, 0xbb, 0xb3,
-	0x6d, 0x23, 0x10, 0x57, 0x4e, 0x48, 0x95, 0x10, 0x12, 0x27, 0x0e, 0x9c, 0x00, 0x21, 0x21, 0x71,
-	0xe1, 0xcc, 0xad, 0x17, 0xa4, 0x1e, 0x11, 0x07, 0x03, 0xe9, 0x7f, 0xe0, 0x23, 0x27, 0x34, 0x1f,
-	0xbb, 0x6b, 0x6f, 0xdc, 0x3a, 0x9b, 0x04, 0x4e, 0xf1, 0xce, 0x33, 0xcf, 0xef, 0xf9, 0xed, 0x33,
-	0xcf, 0xd7, 0x6c, 0xc0, 0xbf, 0x2c, 0xb7, 0x83, 0xf0, 0xa3, 0x9a, 0x11, 0x5a, 0x94, 0xb8, 0x4e,
-	0xed, 0xc1, 0x25, 0x13, 0x53, 0xe3, 0x52, 0xf4, 0x5c, 0xf5, 0x7c, 0x97, 0xba, 0xea, 0x92, 0xd8,
-	0x55, 0x8d, 0x56, 0xe5, 0xae, 0xd5, 0x85, 0xb6, 0xdb, 0x76, 0xf9, 0x96, 0x1a, 0xfb, 0x25, 0x76,
-	0xaf, 0x6a, 0x6d, 0xd7, 0x6d, 0xdb, 0xb8, 0xc6, 0x9f, 0xcc, 0xb0, 0x55, 0xa3, 0xa4, 0x83, 0x03,
-	0x6a, 0x74, 0x3c, 0xb9, 0xa1, 0x62, 0xb9, 0x41, 0xc7, 0x0d, 0x6a, 0xa6, 0x11, 0xe0, 0xd8, 0xa2,
-	0xe5, 0x12, 0x69, 0x0e, 0x7e, 0x0b, 0xc0, 0xec, 0x4e, 0xe8, 0x7b, 0x76, 0x18, 0x6c, 0x0a, 0x8b,
-	0xea, 0xff, 0x00, 0x90, 0xc6, 0x9b, 0x04, 0x95, 0x95, 0x75, 0x65, 0x63, 0xbc, 0xbe, 0xd8, 0xeb,
-	0x6a, 0xa5, 0x3d, 0xa3, 0x63, 0xbf, 0x0c, 0x13, 0x19, 0xd4, 0xf3, 0xf2, 0xa1, 0x81, 0xd4, 0x8f,
-	0x01, 0x08, 0xb0, 0x6d, 0x37, 0xa9, 0x7b, 0x1f, 0x3b, 0xe5, 0x53, 0xeb, 0xca, 0x46, 0xe1, 0xf2,
-	0x4a, 0x55, 0x58, 0xaf, 0x32, 0xeb, 0xd1, 0x9b, 0x54, 0xaf, 0xb8, 0xc4, 0xa9, 0x6f, 0x3d, 0xe9,
-	0x6a, 0x63, 0x09, 0x68, 0xa2, 0x0a, 0xff, 0xec, 0x6a, 0x17, 0xda, 0x84, 0xde, 0x0b, 0xcd, 0xaa,
-	0xe5, 0x76, 0x6a, 0x92, 0xbf, 0xf8, 0x73, 0x31, 0x40, 0xf7, 0x6b, 0x74, 0xcf, 0xc3, 0x01, 0x47,
-	0xd1, 0xf3, 0x4c, 0x6f, 0x97, 0xa9, 0xa9, 0x1f, 0x82, 0xbc, 0x19, 0xee, 0x49, 0xf3, 0xa7, 0x47,
-	0x99, 0xbf, 0x22, 0xcd, 0xcf, 0x09, 0xf3, 0xb1, 0x66, 0x26, 0xeb, 0x53, 0x66, 0xb8, 0x27, 0x8c,
-	0xbf, 0x01, 0x4a, 0x86, 0x45, 0xc9, 0x03, 0xdc, 0x34, 0x09, 0x42, 0xc4, 0x69, 0x33, 0xcf, 0x8d,
-	0x73, 0xcf, 0x9d, 0xe9, 0x75, 0xb5, 0xb2, 0xf4, 0x5c, 0x7a, 0x0b, 0xd4, 0x8b, 0x62, 0xad, 0x2e,
-	0x96, 0x1a, 0x48, 0xbd, 0x03, 0x26, 0x99, 0x1c, 0xfb, 0xe5, 0x89, 0x75, 0x65, 0x23, 0x5f, 0xaf,
-	0xf7, 0xba, 0xda, 0x8c, 0x24, 0xc9, 0xd7, 0x19, 0xc3, 0x8b, 0x87, 0x60, 0xb8, 0x69, 0x59, 0x9b,
-	0x08, 0xf9, 0x38, 0x08, 0x74, 0x89, 0xa8, 0xbe, 0x0f, 0x4e, 0x9b, 0x04, 0x95, 0x27, 0x47, 0x39,
-	0xe7, 0x15, 0xe9, 0x1c, 0x10, 0xdb, 0xcd, 0xe4, 0x16, 0x66, 0x44, 0xd5, 0xc1, 0x14, 0x76, 0x50,
-	0x93, 0x85, 0x63, 0x39, 0xc7, 0x0d, 0xae, 0x56, 0x45, 0xac, 0x56, 0xa3, 0x58, 0xad, 0xee, 0x46,
-	0xb1, 0x5a, 0x5f, 0x93, 0x16, 0x8b, 0xc2, 0x62, 0xa4, 0x09, 0x1f, 0xff, 0xa6, 0x29, 0x7a, 0x0e,
-	0x3b, 0x88, 0x6d, 0x55, 0x4d, 0x00, 0x4c, 0x82, 0x9a, 0x2d, 0xc3, 0xa2, 0xae, 0x5f, 0x9e, 0xe2,
-	0xfe, 0xe1, 0x07, 0xf9, 0x6b, 0x57, 0xfb, 0xf7, 0x21, 0xd8, 0x6d, 0x61, 0x2b, 0x89, 0xb8, 0x04,
-	0x09, 0xea, 0x79, 0x93, 0xa0, 0x6b, 0xfc, 0xb7, 0xfa, 0x1e, 0x28, 0x24, 0xe7, 0x13, 0x94, 0xf3,
-	0xeb, 0xa7, 0x37, 0x0a, 0x97, 0x2f, 0x54, 0x87, 0x27, 0x65, 0xd5, 0x24, 0xe8, 0xe6, 0x43, 0x07,
-	0xfb, 0x37, 0x0c, 0xcf, 0x23, 0x4e, 0xbb, 0xbe, 0xd4, 0xeb, 0x6a, 0x6a, 0x72, 0x5a, 0x12, 0x05,
-	0xea, 0xc0, 0x8c, 0x0e, 0x38, 0x50, 0x5f, 0x03, 0xb3, 0x51, 0x0a, 0x05, 0xd4, 0xa0, 0x61, 0x50,
-	0x06, 0x3c, 0x50, 0x56, 0x7a, 0x5d, 0x6d, 0x71, 0x30, 0xc5, 0x84, 0x1c, 0xea, 0x33, 0x72, 0x61,
-	0x87, 0x3f, 0xab, 0x1b, 0x60, 0xd2, 0xf0, 0x3c, 0x16, 0x62, 0x05, 0xae, 0x59, 0x4a, 0x62, 0x44,
-	0xac, 0x43, 0x7d, 0xc2, 0xf0, 0xbc, 0x06, 0x52, 0xab, 0x60, 0xca, 0x08, 0x02, 0x4c, 0xd9, 0xde,
-	0x69, 0xbe, 0x77, 0x3e, 0xf1, 0x72, 0x24, 0x81, 0x7a, 0x8e, 0xff, 0x6c, 0x20, 0xf5, 0x3a, 0x50,
-	0x23, 0xdb, 0x1d, 0xf1, 0x4a, 0x4c, 0x73, 0x86, 0x6b, 0x9e, 0xed, 0x75, 0xb5, 0x95, 0x41, 0x7e,
-	0xc9, 0x1e, 0xa8, 0xcf, 0xc9, 0x45, 0xe9, 0x8a, 0x06, 0x52, 0xff, 0x0f, 0x0a, 0xd2, 0x04, 0x2f,
-	0x24, 0xb3, 0x1c, 0xa5, 0xcf, 0x43, 0x7d, 0x42, 0x56, 0x49, 0x38, 0x05, 0x56, 0x49, 0x5e, 0x02,
-	0xd3, 0x42, 0xe4, 0x86, 0x9c, 0x78, 0x91, 0x2b, 0x2e, 0xf7, 0xba, 0xda, 0x7c, 0xbf, 0xa2, 0x90,
-	0x42, 0x1d, 0xf0, 0xc7, 0x9b, 0x21, 0xe3, 0x7f, 0x17, 0x4c, 0xb3, 0x73, 0x8d, 0x23, 0x6f, 0x6e,
-	0x64, 0xe4, 0x69, 0x32, 0xf2, 0xe6, 0x93, 0xa8, 0x18, 0x8c, 0x3e, 0x76, 0x74, 0x57, 0x45, 0x00,
-	0xc2, 0xaf, 0xa6, 0x41, 0x61, 0x0b, 0x9b, 0xf4, 0x78, 0x95, 0xf2, 0x53, 0x05, 0x14, 0xe5, 0x13,
-	0x46, 0x87, 0xad, 0x97, 0x0d, 0xc9, 0x73, 0x69, 0x00, 0x3a, 0xd2, 0xcf, 0x94, 0x9f, 0xb3, 0xb1,
-	0xb2, 0x28, 0x5e, 0x5f, 0x28, 0x60, 0x1e, 0x3f, 0xf2, 0xb0, 0x45, 0x31, 0x6a, 0x86, 0x01, 0xf6,
-	0x0f, 0x5b, 0x44, 0x6f, 0x48, 0x4e, 0xab, 0x32, 0x6b, 0x0f, 0x62, 0x64, 0xe2, 0x55, 0x8a, 0x00,
-	0x6e, 0x05, 0xd8, 0x17, 0xd4, 0xbe, 0x54, 0xc0, 0x62, 0x0c, 0xdb, 0x21, 0x0e, 0x8d, 0x1d, 0x36,
-	0x3e, 0x8a, 0xdc, 0x4d, 0x49, 0xee, 0x4c, 0x8a, 0x5c, 0x3f, 0x4a, 0x26, 0x7a, 0xb1, 0x8f, 0x6e,
-	0x70, 0x04, 0x41, 0xb0, 0xbf, 0xcc, 0x4d, 0x9c, 0x50, 0x99, 0x1b, 0xda, 0x4c, 0x26, 0x8f, 0xd7,
-	0x4c, 0x72, 0x27, 0xde, 0x4c, 0x3e, 0x57, 0x80, 0x6a, 0x85, 0xbe, 0x8f, 0x1d, 0xca, 0x48, 0x34,
-	0x8d, 0x8e, 0x1b, 0x3a, 0x94, 0x57, 0xe5, 0x17, 0x9e, 0xcb, 0x9b, 0xd2, 0x07, 0xb2, 0x94, 0x1c,
-	0x84, 0xc8, 0x74, 0x28, 0x73, 0x52, 0xbf, 0x4e, 0xd0, 0x26, 0xd7, 0x1e, 0x52, 0x5e, 0xf3, 0x47,
-	0x2e, 0xaf, 0x20, 0x43, 0x79, 0x2d, 0x1c, 0xa2, 0xbc, 0xa6, 0x9a, 0xcb, 0xf4, 0xc9, 0x37, 0x97,
-	0x13, 0x2d, 0xe0, 0x83, 0xfd, 0x76, 0xf6, 0x6f, 0xe9, 0xb7, 0xa9, 0x26, 0x51, 0x3c, 0x6a, 0x93,
-	0x98, 0x3b, 0x7a, 0x93, 0x28, 0x9d, 0x6c, 0x93, 0xf8, 0xb1, 0x04, 0xa6, 0xb7, 0x42, 0x6a, 0xdd,
-	0x3b, 0x5e, 0x97, 0xf8, 0x46, 0x01, 0x2b, 0x6e, 0x48, 0x5b, 0xb6, 0xfb, 0x50, 0x14, 0xab, 0x26,
-	0x71, 0x08, 0x8d, 0xd2, 0x6c, 0x64, 0xbf, 0xd8, 0x91, 0x94, 0xd7, 0x85, 0x91, 0xe7, 0x22, 0x65,
-	0xca, 0xb6, 0x25, 0x09, 0xc3, 0x6b, 0x5f, 0xc3, 0x21, 0x54, 0xe6, 0xdc, 0x0f, 0x0a, 0x38, 0x33,
-	0x68, 0x21, 0x4a, 0x6b, 0x49, 0x77, 0x64, 0x2b, 0xb9, 0x2d, 0xe9, 0x9e, 0x1f, 0x46, 0x77, 0x10,
-	0x2c, 0x13, 0xe3, 0x95, 0x7e, 0xc6, 0x57, 0x04, 0x8e, 0x24, 0xfd, 0x9d, 0x02, 0x56, 0x89, 0xd3,
-	0x67, 0x86, 0x1a, 0x7e, 0x1b, 0xc7, 0x94, 0x47, 0x36, 0x98, 0x5d, 0x49, 0xf9, 0x9c, 0xa0, 0xfc,
-	0x7c, 0xa8, 0x4c, 0x84, 0x97, 0x05, 0x0e, 0xe7, 0xbb, 0xcb, 0x51, 0x24, 0xdd, 0xef, 0x15, 0xb0,
-	0x36, 0x60, 0x23, 0xe5, 0xe2, 0x89, 0x51, 0x7c, 0x6f, 0x49, 0xbe, 0x70, 0x08, 0xdf, 0x63, 0x78,
-	0xb8, 0xdc, 0x47, 0x78, 0xd0, 0xc1, 0x9f, 0x29, 0x60, 0xed, 0x60, 0xdc, 0x11, 0xc3, 0x6e, 0x7a,
-	0x3e, 0xb1, 0x30, 0x6f, 0x69, 0x79, 0xe1, 0xc6, 0x4c, 0x05, 0x05, 0x3e, 0x2f, 0xa4, 0x63, 0x68,
-	0xa8, 0x97, 0xd3, 0x91, 0x4a, 0x0c, 0x7b, 0x9b, 0x89, 0x86, 0xb0, 0x8a, 0x5e, 0x5e, 0xb0, 0xca,
-	0x9d, 0x24, 0xab, 0x01, 0xe8, 0x14, 0x2b, 0xe9, 0x2c, 0xc1, 0xea, 0x13, 0x05, 0x2c, 0x0f, 0xaa,
-	0xb2, 0x02, 0x23, 0x18, 0x89, 0x8b, 0xce, 0x76, 0x66, 0x46, 0x95, 0x61, 0x8c, 0x62, 0x58, 0xa8,
-	0x2f, 0xf4, 0xb3, 0xb9, 0xea, 0x20, 0xc1, 0xe4, 0x71, 0x3a, 0x2d, 0x06, 0xdd, 0x93, 0xe7, 0x64,
-	0x76, 0x32, 0x93, 0x39, 0xf7, 0x82, 0xa8, 0x93, 0x7c, 0x96, 0x0f, 0x46, 0x92, 0xa0, 0xd4, 0x3f,
-	0x64, 0x81, 0x13, 0x1a, 0xb2, 0x0e, 0x8e, 0x09, 0x85, 0x8c, 0x63, 0xc2, 0xbb, 0x00, 0x04, 0xd4,
-	0xf0, 0xa9, 0xe0, 0x35, 0x3d, 0x92, 0xd7, 0xd9, 0xd4, 0x17, 0x8f, 0x58, 0x57, 0x30, 0xcb, 0xf3,
-	0x05, 0xce, 0x2d, 0x35, 0x26, 0xcc, 0xfc, 0x53, 0x63, 0xc2, 0xec, 0xd1, 0xc6, 0x84, 0x64, 0x5e,
-	0x2a, 0x8e, 0x98, 0x97, 0x52, 0xcd, 0x7e, 0xee, 0xa8, 0xcd, 0xbe, 0x74, 0xf8, 0x66, 0x5f, 0x07,
-	0x45, 0xdb, 0xb5, 0xee, 0x63, 0xd4, 0x7c, 0x60, 0x84, 0x36, 0xd7, 0x56, 0xb9, 0xf6, 0x6a, 0x72,
-	0x99, 0x4a, 0x6d, 0x80, 0xfa, 0x8c, 0x58, 0xb9, 0xcd, 0x16, 0x1a, 0x48, 0xbd, 0x07, 0x0a, 0x42,
-	0xe6, 0x32, 0x3f, 0x97, 0xe7, 0x79, 0x0a, 0xbc, 0x9e, 0xd0, 0xee, 0x13, 0x1e, 0x61, 0xa0, 0x06,
-	0x5c, 0x9d, 0x1f, 0xa1, 0xfa, 0x11, 0x98, 0xb7, 0xc9, 0x07, 0x21, 0x41, 0x06, 0xf7, 0xbb, 0x87,
-	0x1d, 0xc3, 0xa6, 0x7b, 0xe5, 0x05, 0x6e, 0xf1, 0x7a, 0xe6, 0xa4, 0x93, 0xc7, 0x18, 0x43, 0xc6,
-	0x88, 0x50, 0x57, 0xfb, 0xec, 0x6c, 0xcb, 0xc5, 0xab, 0xa0, 0x98, 0x0a, 0x26, 0x75, 0x91, 0xdf,
-	0x20, 0xe2, 0xb9, 0x45, 0x9f, 0x30, 0x09, 0x6a, 0x20, 0x75, 0x0d, 0xb0, 0x11, 0x4e, 0xfa, 0x83,
-	0xcd, 0x22, 0x79, 0x7d, 0x2a, 0x52, 0x85, 0x3f, 0x29, 0x40, 0xdd, 0x66, 0xf1, 0x6f, 0xb9, 0x36,
-	0xcb, 0x15, 0x12, 0x50, 0x62, 0xf5, 0x8f, 0xd5, 0x4a, 0x86, 0xb1, 0xfa, 0xd4, 0x21, 0xc6, 0xea,
-	0xb7, 0xc1, 0xb8, 0xed, 0x06, 0x01, 0x9f, 0x32, 0xf2, 0xe2, 0xeb, 0x55, 0x26, 0x37, 0x15, 0xa2,
-	0x30, 0x08, 0x02, 0xa8, 0x73, 0x28, 0xf8, 0xf3, 0x04, 0x98, 0x91, 0xf3, 0xdb, 0xb6, 0xe1, 0x1b,
-	0x9d, 0x2c, 0xf4, 0xef, 0x82, 0x72, 0x94, 0x38, 0x28, 0xf4, 0xc5, 0x49, 0x06, 0xd8, 0x72, 0x1d,
-	0x14, 0xc8, 0xd7, 0x39, 0xdf, 0xeb, 0x6a, 0xda, 0x60, 0x8a, 0xa5, 0x77, 0x42, 0x7d, 0x49, 0x8a,
-	0xb6, 0xa4, 0x64, 0x47, 0x08, 0xd4, 0x77, 0xc0, 0xa4, 0x19, 0xb6, 0x5a, 0xd8, 0x97, 0xef, 0xfb,
-	0x6a, 0xe6, 0xf7, 0x8d, 0xae, 0x80, 0x1c, 0x05, 0xea, 0x12, 0x8e, 0xb9, 0xd1, 0x0a, 0x03, 0x8f,
-	0x4f, 0x3e, 0xc7, 0x70, 0x23, 0xc3, 0x80, 0x3a, 0x87, 0x62, 0x90, 0x01, 0xc5, 0x9e, 0xfc, 0x96,
-	0x99, 0x05, 0xb2, 0xe1, 0xd0, 0x04, 0x92, 0x61, 0x40, 0x9d, 0x43, 0xa9, 0x6f, 0x81, 0x79, 0xde,
-	0x2f, 0x9a, 0xad, 0xd0, 0x11, 0xae, 0x63, 0x0a, 0xf2, 0x7e, 0x5c, 0x49, 0xbe, 0x46, 0x0c, 0xd9,
-	0x04, 0xf5, 0x12, 0x5f, 0xbd, 0x26, 0x17, 0x77, 0xf7, 0x3c, 0xcc, 0xa6, 0xf3, 0x40, 0x7c, 0xff,
-	0x66, 0x67, 0x9b, 0x4b, 0x4f, 0xe7, 0x89, 0x0c, 0xea, 0x79, 0xf9, 0xd0, 0x40, 0xea, 0x7f, 0x40,
-	0x0e, 0x61, 0x93, 0x47, 0xe8, 0x14, 0x57, 0x51, 0x7b, 0x5d, 0x6d, 0x56, 0xa8, 0x48, 0x01, 0xd4,
-	0x27, 0xd9, 0x2f, 0x11, 0xcf, 0x88, 0x5d, 0x08, 0xd8, 0xee, 0x7c, 0x3a, 0x9e, 0x23, 0x09, 0xd4,
-	0x73, 0xfc, 0x27, 0x8f, 0xe7, 0x05, 0x96, 0x5d, 0x07, 0x82, 0x47, 0x5c, 0x47, 0xb5, 0x5e, 0x57,
-	0x5b, 0x4b, 0x2e, 0x22, 0x07, 0x03, 0x47, 0x35, 0x09, 0x4a, 0x05, 0x4d, 0x7d, 0xe7, 0xc9, 0x1f,
-	0x95, 0xb1, 0xaf, 0xf7, 0x2b, 0x63, 0x4f, 0xf6, 0x2b, 0xca, 0xd3, 0xfd, 0x8a, 0xf2, 0xfb, 0x7e,
-	0x45, 0x79, 0xfc, 0xac, 0x32, 0xf6, 0xf4, 0x59, 0x65, 0xec, 0x97, 0x67, 0x95, 0xb1, 0x3b, 0x97,
-	0x06, 0x0e, 0x85, 0x75, 0x9a, 0x8b, 0x6e, 0xab, 0x45, 0x2c, 0x62, 0xd8, 0xf2, 0xb9, 0x96, 0xfc,
-	0xeb, 0x82, 0x9f, 0x91, 0x39, 0xc9, 0xbb, 0xdc, 0x7f, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x07,
-	0xc7, 0xb4, 0x31, 0xd9, 0x18, 0x00, 0x00,
+	// 2420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
+	0xf5, 0xf7, 0x6c, 0x6c, 0xcf, 0x4c, 0xcd, 0xd8, 0x63, 0xd7, 0xf8, 0xa3, 0x6d, 0x67, 0xdd, 0xde,
+	0xca, 0x6a, 0xd7, 0x7f, 0xad, 0x32, 0x56, 0xf6, 0x8f, 0x90, 0x88, 0x14, 0x81, 0xc7, 0xce, 0xb2,
+	0xa3, 0x25, 0x24, 0x94, 0x9d, 0x80, 0x22, 0x45, 0x43, 0x4f, 0x57, 0x8d, 0xdd, 0x9b, 0x9e, 0xee,
+	0xa1, 0xab, 0xdb, 0x89, 0x01, 0xb1, 0x47, 0x0e, 0x08, 0x29, 0xd2, 0x0a, 0x89, 0x13, 0x67, 0x90,
+	0x40, 0x48, 0x9c, 0x90, 0xe0, 0xc2, 0x2d, 0x07, 0x0e, 0x7b, 0x44, 0x1c, 0x06, 0x48, 0xee, 0x1c,
+	0xe6, 0xc8, 0x09, 0xd5, 0x47, 0x7f, 0x8e, 0xcd, 0x4c, 0xdb, 0x0e, 0x9c, 0xdc, 0xf5, 0xaa, 0xea,
+	0xf7, 0x7e, 0xf5, 0xea, 0xd5, 0x7b, 0xaf, 0x6a, 0x0c, 0xde, 0x35, 0xdd, 0x1e, 0xa1, 0xcf, 0x77,
+	0x8c, 0xc0, 0xf4, 0x2d, 0xd7, 0xd9, 0x39, 0xb9, 0xd5, 0xa1, 0xbe, 0x71, 0x2b, 0x6c, 0x37, 0xfa,
+	0x9e, 0xeb, 0xbb, 0x70, 0x45, 0x8e, 0x6a, 0x84, 0x52, 0x35, 0x6a, 0x7d, 0xe9, 0xc8, 0x3d, 0x72,
+	0xc5, 0x90, 0x1d, 0xfe, 0x25, 0x47, 0xaf, 0xeb, 0x47, 0xae, 0x7b, 0x64, 0xd3, 0x1d, 0xd1, 0xea,
+	0x04, 0xdd, 0x1d, 0xdf, 0xea, 0x51, 0xe6, 0x1b, 0xbd, 0xbe, 0x1a, 0xb0, 0x69, 0xba, 0xac, 0xe7,
+	0xb2, 0x9d, 0x8e, 0xc1, 0x68, 0xa4, 0xd1, 0x74, 0x2d, 0xa5, 0x0e, 0x7d, 0x5e, 0x05, 0xf3, 0x07,
+	0x81, 0xd7, 0xb7, 0x03, 0xb6, 0x2b, 0x35, 0xc2, 0x2f, 0x01, 0xa0, 0x94, 0xb7, 0x2d, 0xa2, 0x15,
+	0xb6, 0x0a, 0xdb, 0xd3, 0xcd, 0xe5, 0xe1, 0x40, 0x5f, 0x3c, 0x35, 0x7a, 0xf6, 0x6d, 0x14, 0xf7,
+	0x21, 0x5c, 0x56, 0x8d, 0x16, 0x81, 0x3f, 0x02, 0x80, 0x51, 0xdb, 0x6e, 0xfb, 0xee, 0x53, 0xea,
+	0x68, 0x6f, 0x6d, 0x15, 0xb6, 0x2b, 0x1f, 0xae, 0x35, 0xa4, 0xf6, 0x06, 0xd7, 0x1e, 0xae, 0xa4,
+	0xb1, 0xe7, 0x5a, 0x4e, 0x73, 0xff, 0xe5, 0x40, 0x9f, 0x8a, 0x41, 0xe3, 0xa9, 0xe8, 0x5f, 0x03,
+	0xfd, 0xfd, 0x23, 0xcb, 0x3f, 0x0e, 0x3a, 0x0d, 0xd3, 0xed, 0xed, 0x28, 0xfe, 0xf2, 0xcf, 0x4d,
+	0x46, 0x9e, 0xee, 0xf8, 0xa7, 0x7d, 0xca, 0x04, 0x0a, 0x2e, 0xf3, 0x79, 0x87, 0x7c, 0x1a, 0xfc,
+	0x01, 0x28, 0x77, 0x82, 0x53, 0xa5, 0xfe, 0xda, 0x38, 0xf5, 0x7b, 0x4a, 0xfd, 0x82, 0x54, 0x1f,
+	0xcd, 0xcc, 0xa5, 0xbd, 0xd4, 0x09, 0x4e, 0xa5, 0xf2, 0x8f, 0xc1, 0xa2, 0x61, 0xfa, 0xd6, 0x09,
+	0x6d, 0x77, 0x2c, 0x42, 0x2c, 0xe7, 0x88, 0x5b, 0x6e, 0x5a, 0x58, 0xee, 0xfa, 0x70, 0xa0, 0x6b,
+	0xca, 0x72, 0xd9, 0x21, 0x08, 0xd7, 0xa4, 0xac, 0x29, 0x45, 0x2d, 0x02, 0x1f, 0x83, 0x59, 0xde,
+	0x4f, 0x3d, 0x6d, 0x66, 0xab, 0xb0, 0x5d, 0x6e, 0x36, 0x87, 0x03, 0x7d, 0x4e, 0x91, 0x14, 0x72,
+	0xce, 0xf0, 0xe6, 0x04, 0x0c, 0x77, 0x4d, 0x73, 0x97, 0x10, 0x8f, 0x32, 0x86, 0x15, 0x22, 0xfc,
+	0x14, 0x5c, 0xeb, 0x58, 0x44, 0x9b, 0x1d, 0x67, 0x9c, 0x3b, 0xca, 0x38, 0x20, 0xd2, 0x9b, 0xcb,
+	0x2c, 0x5c, 0x09, 0xc4, 0xa0, 0x44, 0x1d, 0xd2, 0xe6, 0xee, 0xa8, 0x15, 0x85, 0xc2, 0xf5, 0x86,
+	0xf4, 0xd5, 0x46, 0xe8, 0xab, 0x8d, 0xc3, 0xd0, 0x57, 0x9b, 0x1b, 0x4a, 0x63, 0x4d, 0x6a, 0x0c,
+	0x67, 0xa2, 0x17, 0x7f, 0xd3, 0x0b, 0xb8, 0x48, 0x1d, 0xc2, 0x87, 0xc2, 0x0e, 0x00, 0x1d, 0x8b,
+	0xb4, 0xbb, 0x86, 0xe9, 0xbb, 0x9e, 0x56, 0x12, 0xf6, 0x11, 0x1b, 0xf9, 0xd7, 0x81, 0xfe, 0xde,
+	0x04, 0xec, 0xf6, 0xa9, 0x19, 0x7b, 0x5c, 0x8c, 0x84, 0x70, 0xb9, 0x63, 0x91, 0x8f, 0xc4, 0x37,
+	0xfc, 0x2e, 0xa8, 0xc4, 0xfb, 0xc3, 0xb4, 0xf2, 0xd6, 0xb5, 0xed, 0xca, 0x87, 0xef, 0x37, 0xce,
+	0x3e, 0x94, 0x8d, 0x8e, 0x45, 0xee, 0x3f, 0x73, 0xa8, 0x77, 0xcf, 0xe8, 0xf7, 0x2d, 0xe7, 0xa8,
+	0xb9, 0x32, 0x1c, 0xe8, 0x30, 0xde, 0x2d, 0x85, 0x82, 0x30, 0xe8, 0x84, 0x1b, 0xcc, 0xe0, 0xd7,
+	0xc0, 0x7c, 0x78, 0x84, 0x98, 0x6f, 0xf8, 0x01, 0xd3, 0x80, 0x70, 0x94, 0xb5, 0xe1, 0x40, 0x5f,
+	0x4e, 0x1f, 0x31, 0xd9, 0x8f, 0xf0, 0x9c, 0x12, 0x1c, 0x88, 0x36, 0xdc, 0x06, 0xb3, 0x46, 0xbf,
+	0xcf, 0x5d, 0xac, 0x22, 0x66, 0x2e, 0xc6, 0x3e, 0x22, 0xe5, 0x08, 0xcf, 0x18, 0xfd, 0x7e, 0x8b,
+	0xc0, 0x06, 0x28, 0x19, 0x8c, 0x51, 0x9f, 0x8f, 0xad, 0x8a, 0xb1, 0xf5, 0xd8, 0xca, 0x61, 0x0f,
+	0xc2, 0x45, 0xf1, 0xd9, 0x22, 0xf0, 0x13, 0x00, 0x43, 0xdd, 0x3d, 0xb9, 0x24, 0x3e, 0x73, 0x4e,
+	0xcc, 0x7c, 0x7b, 0x38, 0xd0, 0xd7, 0xd2, 0xfc, 0xe2, 0x31, 0x08, 0x2f, 0x28, 0xa1, 0x32, 0x45,
+	0x8b, 0xc0, 0x2f, 0x83, 0x8a, 0x52, 0x21, 0x02, 0xc9, 0xbc, 0x40, 0x49, 0x58, 0x28, 0xd1, 0xc9,
+	0x23, 0x89, 0xa0, 0xc0, 0x23, 0xc9, 0x57, 0x40, 0x55, 0x76, 0xb9, 0x81, 0x20, 0x5e, 0x13, 0x13,
+	0x57, 0x87, 0x03, 0xbd, 0x9e, 0x9c, 0x28, 0x7b, 0x11, 0x06, 0xa2, 0x79, 0x3f, 0xe0, 0xfc, 0x9f,
+	0x80, 0x2a, 0xdf, 0xd7, 0xc8, 0xf3, 0x16, 0xc6, 0x7a, 0x9e, 0xae, 0x3c, 0xaf, 0x1e, 0x7b, 0x45,
+	0xda, 0xfb, 0xf8, 0xd6, 0xdd, 0x55, 0x0e, 0xe8, 0x80, 0x79, 0xd3, 0xa6, 0x86, 0xc7, 0xd7, 0xdc,
+	0xf7, 0x2c, 0x93, 0x6a, 0x8b, 0xc2, 0x09, 0xbf, 0x9e, 0xdb, 0x09, 0xd5, 0x46, 0xa7, 0xd1, 0x10,
+	0x9e, 0x0b, 0x05, 0x0f, 0x78, 0x1b, 0x3e, 0x03, 0x75, 0x7a, 0x62, 0x99, 0x3e, 0x25, 0xed, 0xa4,
+	0x53, 0xc2, 0x7c, 0x4e, 0xb9, 0x39, 0x1c, 0xe8, 0xeb, 0xea, 0x60, 0x8d, 0xa2, 0x21, 0xbc, 0xa8,
+	0xa4, 0x51, 0x10, 0x62, 0xe8, 0x27, 0xf3, 0xa0, 0xb2, 0x4f, 0x3b, 0xfe, 0xe5, 0x52, 0xc2, 0x4f,
+	0x0b, 0xa0, 0xa6, 0x5a, 0x94, 0x4c, 0x9a, 0x18, 0x5a, 0x6a, 0x43, 0x56, 0x52, 0xd0, 0xe1, 0xfc,
+	0x5c, 0x81, 0x68, 0x3e, 0x9a, 0x2c, 0xa3, 0xf4, 0xcf, 0x0b, 0xa0, 0x4e, 0x9f, 0xf7, 0xa9, 0x30,
+	0x41, 0xc0, 0xa8, 0x37, 0x69, 0xb6, 0xb8, 0xa7, 0x38, 0x85, 0x56, 0x1c, 0xc5, 0xc8, 0xc5, 0x6b,
+	0x31, 0x04, 0x78, 0xc8, 0xa8, 0x27, 0xa9, 0xfd, 0xa2, 0x00, 0x96, 0x23, 0xd8, 0x9e, 0xe5, 0xf8,
+	0x91, 0xc1, 0xa6, 0xc7, 0x91, 0xbb, 0xaf, 0xc8, 0x5d, 0xcf, 0x90, 0x4b, 0xa2, 0xe4, 0xa2, 0x17,
+	0xd9, 0xe8, 0x9e, 0x40, 0x90, 0x04, 0x93, 0xf1, 0x7c, 0xe6, 0x8a, 0xe2, 0xf9, 0x99, 0x59, 0x73,
+	0xf6, 0x72, 0x59, 0xb3, 0x78, 0xe5, 0x59, 0xf3, 0x67, 0x05, 0x00, 0xcd, 0xc0, 0xf3, 0xa8, 0xe3,
+	0x73, 0x12, 0x6d, 0xa3, 0xe7, 0x06, 0x8e, 0xaf, 0x95, 0xc6, 0xed, 0xcb, 0x37, 0x94, 0x0d, 0x54,
+	0xcc, 0x1c, 0x85, 0xc8, 0xb5, 0x29, 0x0b, 0x6a, 0x7e, 0xd3, 0x22, 0xbb, 0x62, 0xf6, 0x19, 0x79,
+	0xa4, 0x7c, 0xe1, 0x3c, 0x02, 0x72, 0xe4, 0x91, 0xca, 0x04, 0x79, 0x24, 0x93, 0x45, 0xab, 0x57,
+	0x9f, 0x45, 0xaf, 0x34, 0x53, 0xa5, 0x0b, 0x8b, 0xf9, 0x37, 0x52, 0x58, 0x64, 0xb2, 0x61, 0xed,
+	0xa2, 0xd9, 0x70, 0xe1, 0xe2, 0xd9, 0x70, 0xf1, 0x4d, 0x67, 0x43, 0xf8, 0xbf, 0xc8, 0x86, 0xf5,
+	0x37, 0x9e, 0x0d, 0xff, 0x59, 0x07, 0xd5, 0xfd, 0xc0, 0x37, 0x8f, 0x2f, 0x97, 0x0e, 0x7f, 0x55,
+	0x00, 0x6b, 0x6e, 0xe0, 0x77, 0x6d, 0xf7, 0x99, 0x8c, 0xca, 0x6d, 0xcb, 0xb1, 0xfc, 0x30, 0x9e,
+	0x8c, 0x4d, 0x8c, 0x07, 0x6a, 0x6f, 0xb6, 0xa4, 0x92, 0x73, 0x91, 0x72, 0x85, 0x95, 0x15, 0x05,
+	0x23, 0x82, 0x7c, 0xcb, 0xb1, 0x7c, 0x15, 0x5c, 0x7e, 0x57, 0x00, 0xd7, 0xd3, 0x1a, 0xc2, 0xf8,
+	0xa5, 0xe8, 0x8e, 0xcd, 0x99, 0x8f, 0x14, 0xdd, 0x1b, 0x67, 0xd1, 0x4d, 0x83, 0xe5, 0x62, 0xbc,
+	0x96, 0x64, 0xbc, 0x27, 0x71, 0x14, 0xe9, 0x5f, 0x17, 0xc0, 0xba, 0xe5, 0x24, 0xd4, 0xf8, 0x86,
+	0x77, 0x44, 0x23, 0xca, 0x63, 0x33, 0xe9, 0xa1, 0xa2, 0xfc, 0x8e, 0xa4, 0x7c, 0x3e, 0x54, 0x2e,
+	0xc2, 0xab, 0x96, 0x13, 0xf1, 0x3d, 0x14, 0x28, 0x8a, 0xee, 0x6f, 0x0b, 0x60, 0xc3, 0x72, 0xce,
+	0xb5, 0x8a, 0x36, 0x33, 0x8e, 0xef, 0x43, 0xc5, 0x17, 0x9d, 0xc1, 0xf7, 0x12, 0x16, 0xd6, 0x2c,
+	0xe7, 0x1c, 0x03, 0x7f, 0x5e, 0x00, 0x1b, 0xa3, 0x7e, 0x67, 0x19, 0xb6, 0x3a, 0xff, 0xb3, 0xe2,
+	0xfc, 0x1f, 0xe6, 0x3e, 0xff, 0xe8, 0x3c, 0x97, 0x8e, 0xa0, 0x11, 0xd6, 0xb2, 0x9e, 0x6a, 0x19,
+	0xb6, 0x8c, 0x0b, 0xa3, 0xac, 0xc2, 0xc5, 0x4b, 0x56, 0xc5, 0xab, 0x64, 0x95, 0x82, 0xce, 0xb0,
+	0x52, 0xc6, 0x92, 0xac, 0x7e, 0x5c, 0x00, 0xab, 0xe9, 0xa9, 0x3c, 0x92, 0x4a, 0x46, 0xf2, 0xea,
+	0xfa, 0x20, 0x37, 0xa3, 0xcd, 0xb3, 0x18, 0x45, 0xb0, 0x08, 0x2f, 0x25, 0xd9, 0xdc, 0x75, 0x88,
+	0x64, 0xf2, 0x22, 0x7b, 0x2c, 0xd2, 0xe6, 0x29, 0x0b, 0x32, 0x07, 0xb9, 0xc9, 0xbc, 0xf3, 0x1f,
+	0xbc, 0x4e, 0xf1, 0x59, 0x1d, 0xf5, 0x24, 0x49, 0x29, 0x59, 0x4d, 0x82, 0x2b, 0xaa, 0x26, 0x47,
+	0xeb, 0xa1, 0x4a, 0xce, 0x7a, 0xe8, 0x3b, 0x00, 0x30, 0xdf, 0xf0, 0x7c, 0xc9, 0xab, 0x3a, 0x96,
+	0xd7, 0xdb, 0x99, 0x37, 0xac, 0x68, 0xae, 0x64, 0x56, 0x16, 0x02, 0xc1, 0x2d, 0x53, 0x0f, 0xcd,
+	0xfd, 0xb7, 0xea, 0xa1, 0xf9, 0x8b, 0xd5, 0x43, 0x71, 0x61, 0x58, 0x1b, 0x53, 0x18, 0x66, 0xaa,
+	0x9a, 0x85, 0x8b, 0x56, 0x35, 0x8b, 0x93, 0x57, 0x35, 0x4d, 0x50, 0xb3, 0x5d, 0xf3, 0x29, 0x25,
+	0xed, 0x13, 0x23, 0xb0, 0xc5, 0x6c, 0x28, 0x66, 0xaf, 0xc7, 0xb7, 0xc6, 0xcc, 0x00, 0x84, 0xe7,
+	0xa4, 0xe4, 0x11, 0x17, 0xb4, 0x08, 0x3c, 0x06, 0x15, 0xd9, 0xe7, 0x72, 0x3b, 0x6b, 0x75, 0x59,
+	0xb7, 0xc4, 0xb4, 0x13, 0x9d, 0x17, 0xb8, 0x39, 0x00, 0x31, 0x5d, 0x6c, 0x21, 0xfc, 0x21, 0xa8,
+	0xdb, 0xd6, 0xf7, 0x02, 0x8b, 0x18, 0xc2, 0xee, 0x7d, 0xea, 0x18, 0xb6, 0x7f, 0xaa, 0x2d, 0x09,
+	0x8d, 0x9f, 0xe4, 0x3e, 0x74, 0x6a, 0x1b, 0x23, 0xc8, 0x08, 0x11, 0x61, 0x98, 0xd0, 0xf3, 0x40,
+	0x0a, 0xe1, 0xff, 0x81, 0x59, 0x46, 0x0d, 0x9b, 0x12, 0x6d, 0x79, 0xab, 0xb0, 0x5d, 0x4a, 0x6e,
+	0xa4, 0x94, 0x23, 0xac, 0x06, 0xc0, 0x2e, 0xa8, 0x99, 0x6e, 0xaf, 0x67, 0xf9, 0x71, 0xbd, 0xb8,
+	0x32, 0xf6, 0x04, 0xa0, 0xf4, 0x65, 0x3d, 0x03, 0x20, 0x8f, 0xc1, 0x9c, 0x94, 0x86, 0x55, 0x63,
+	0x17, 0xd4, 0x3c, 0x7a, 0x42, 0x0d, 0x3b, 0xd6, 0xb3, 0x9a, 0x57, 0x4f, 0x06, 0x40, 0xe9, 0x91,
+	0xd2, 0x50, 0xcf, 0x6d, 0x50, 0xa5, 0xac, 0xd7, 0xf6, 0xa8, 0x38, 0x85, 0x4c, 0xd3, 0xb2, 0x1e,
+	0x96, 0xec, 0x45, 0xb8, 0x42, 0x59, 0x0f, 0x87, 0xad, 0xbb, 0xa0, 0x96, 0x39, 0x83, 0x70, 0x59,
+	0xdc, 0x30, 0xa3, 0x72, 0x0f, 0xcf, 0x74, 0x2c, 0xd2, 0x22, 0x70, 0x03, 0xf0, 0x12, 0x5f, 0xb9,
+	0x11, 0x2f, 0xe1, 0xca, 0xb8, 0x14, 0x4e, 0x45, 0x7f, 0x2a, 0x00, 0xf8, 0x80, 0x2f, 0xc6, 0x74,
+	0x6d, 0x1e, 0x62, 0x2c, 0xe6, 0x5b, 0x66, 0xf2, 0xda, 0x55, 0xc8, 0x71, 0xed, 0x7a, 0x6b, 0x82,
+	0x6b, 0xd7, 0xb7, 0xc0, 0xb4, 0xed, 0x32, 0x26, 0x8a, 0xb3, 0x72, 0xf3, 0x4e, 0x6e, 0xef, 0xaa,
+	0x84, 0xa7, 0x87, 0x31, 0x84, 0x05, 0x14, 0xfa, 0x43, 0x11, 0xcc, 0xa9, 0xb2, 0xf7, 0x81, 0xe1,
+	0x19, 0xbd, 0x3c, 0xf4, 0x9f, 0x00, 0x2d, 0x8c, 0x37, 0x24, 0xf0, 0xe4, 0x01, 0x60, 0xd4, 0x74,
+	0x1d, 0xc2, 0xd4, 0x72, 0x6e, 0x0c, 0x07, 0xba, 0x9e, 0x8e, 0x4c, 0xd9, 0x91, 0x08, 0xaf, 0xa8,
+	0xae, 0x7d, 0xd5, 0x73, 0x20, 0x3b, 0xe0, 0xb7, 0xc1, 0x6c, 0x27, 0xe8, 0x76, 0xa9, 0xa7, 0xd6,
+	0xfb, 0xd5, 0xdc, 0xeb, 0x0d, 0x9f, 0x08, 0x04, 0x0a, 0xc2, 0x0a, 0x8e, 0x9b, 0xd1, 0x0c, 0x58,
+	0x5f, 0x9b, 0xbe, 0x9c, 0x19, 0x39, 0x06, 0xc2, 0x02, 0x8a, 0x43, 0x32, 0x9f, 0xf6, 0xb5, 0x99,
+	0xdc, 0x90, 0x2d, 0xc7, 0x8f, 0x21, 0x39, 0x06, 0xc2, 0x02, 0x0a, 0x7e, 0x13, 0xd4, 0x45, 0x9a,
+	0x6d, 0x77, 0x03, 0x47, 0x9a, 0x8e, 0x4f, 0x50, 0xef, 0x27, 0x89, 0x5b, 0xce, 0x19, 0x83, 0x10,
+	0x5e, 0x14, 0xd2, 0x8f, 0x94, 0xf0, 0xf0, 0xb4, 0x4f, 0xf9, 0xa5, 0x86, 0xc9, 0x1f, 0x82, 0xf8,
+	0xde, 0x16, 0xb3, 0x97, 0x9a, 0xb8, 0x0f, 0xe1, 0xb2, 0x6a, 0xb4, 0x08, 0xfc, 0x00, 0x14, 0x09,
+	0xed, 0x08, 0x0f, 0x2d, 0x89, 0x29, 0x70, 0x38, 0xd0, 0xe7, 0xe5, 0x14, 0xd5, 0x81, 0xf0, 0x2c,
+	0xff, 0x92, 0xfe, 0x4c, 0xf8, 0x3d, 0x8a, 0x8f, 0x2e, 0x67, 0xfd, 0x39, 0xec, 0x41, 0xb8, 0x28,
+	0x3e, 0x85, 0x3f, 0x2f, 0xf1, 0xd3, 0x35, 0xe2, 0x3c, 0xf2, 0xb9, 0x42, 0x1f, 0x0e, 0xf4, 0x8d,
+	0xf8, 0xa2, 0x3a, 0xea, 0x38, 0xb0, 0x63, 0x91, 0xac, 0xd3, 0xf0, 0x55, 0x8a, 0x80, 0xc7, 0x6f,
+	0x7d, 0xa2, 0x42, 0x28, 0xa5, 0x56, 0x19, 0xf5, 0xf1, 0x55, 0x8a, 0x46, 0xd3, 0xe2, 0xef, 0x4b,
+	0xab, 0x2a, 0xb6, 0x8d, 0x70, 0x91, 0xcf, 0xea, 0x28, 0xae, 0xce, 0xce, 0x19, 0x88, 0xf0, 0xb2,
+	0xec, 0xc9, 0x32, 0x7a, 0x0c, 0x56, 0x55, 0x3c, 0x1b, 0xc1, 0x9e, 0xcb, 0x62, 0x9f, 0x33, 0x10,
+	0xe1, 0x65, 0xd9, 0x93, 0xc1, 0x46, 0xbf, 0x9f, 0x01, 0xc5, 0xa6, 0x41, 0xf8, 0x53, 0x6e, 0x8e,
+	0x73, 0xfb, 0x01, 0x28, 0xf6, 0x5d, 0xd7, 0x8e, 0xa3, 0x4e, 0x62, 0x4f, 0x55, 0x07, 0xc2, 0xb3,
+	0xfc, 0x2b, 0x13, 0xa3, 0xae, 0x4d, 0x10, 0xa3, 0x9e, 0x80, 0x92, 0x47, 0x4d, 0xd7, 0x23, 0x94,
+	0xa8, 0x03, 0xb6, 0x9b, 0xfb, 0x34, 0xd4, 0x42, 0x6b, 0x48, 0x1c, 0x84, 0x23, 0x48, 0x78, 0x0a,
+	0xa0, 0xe9, 0x9e, 0x50, 0x8f, 0x6f, 0xe2, 0x29, 0x8f, 0xef, 0xd4, 0x3b, 0xa1, 0xda, 0x4c, 0xee,
+	0x74, 0x2b, 0x15, 0x85, 0x6f, 0x77, 0x23, 0x88, 0x08, 0x2f, 0x28, 0x61, 0xf3, 0x14, 0x4b, 0x11,
+	0xfc, 0x0c, 0x2c, 0x25, 0x06, 0x9a, 0xae, 0x6d, 0x53, 0xf1, 0x9e, 0x24, 0x6f, 0x45, 0xf7, 0x72,
+	0x2b, 0xdf, 0x18, 0x51, 0x1e, 0x61, 0x22, 0x0c, 0x23, 0xf5, 0x7b, 0xa1, 0x10, 0x9a, 0x00, 0x30,
+	0xd7, 0xb4, 0x0c, 0xdb, 0xfa, 0x3e, 0x25, 0x5a, 0x31, 0xf7, 0x33, 0x96, 0x54, 0x1b, 0x9e, 0x84,
+	0x08, 0x09, 0xe1, 0x04, 0x2c, 0xec, 0x82, 0x8a, 0x1b, 0xf8, 0xcc, 0x37, 0x1c, 0x5e, 0x7a, 0xaa,
+	0xab, 0xcc, 0x7e, 0x6e, 0x2d, 0x30, 0xba, 0xca, 0x84, 0x50, 0x08, 0x27, 0x81, 0xd1, 0x6f, 0xde,
+	0x02, 0x75, 0x95, 0x78, 0x3e, 0xb6, 0x98, 0xef, 0x7a, 0xa7, 0x2d, 0x87, 0xd0, 0xe7, 0x39, 0xdc,
+	0xf8, 0x36, 0xa8, 0x86, 0x49, 0x45, 0x44, 0x46, 0x91, 0x9e, 0x53, 0x35, 0x66, 0x90, 0x0c, 0x89,
+	0x15, 0x23, 0x88, 0x83, 0xe1, 0x0d, 0x30, 0x6d, 0x53, 0x47, 0x7a, 0x74, 0xa9, 0x59, 0x4b, 0xe4,
+	0x46, 0xea, 0x10, 0x9e, 0x1b, 0xa9, 0x43, 0x32, 0xcf, 0x40, 0xd3, 0x13, 0x3e, 0x03, 0x3d, 0x04,
+	0x65, 0xd3, 0x76, 0x19, 0x25, 0x6d, 0xc3, 0x9f, 0xe0, 0x29, 0xfd, 0x7a, 0xfa, 0x97, 0xea, 0x68,
+	0xaa, 0x2c, 0x7a, 0x4a, 0xb2, 0xbd, 0xeb, 0xa3, 0x3f, 0x4f, 0x83, 0xea, 0x6e, 0x7c, 0x9d, 0x79,
+	0x93, 0x65, 0xc6, 0x7b, 0x60, 0xa6, 0x6b, 0xd9, 0x36, 0x53, 0xe7, 0x7d, 0x61, 0x38, 0xd0, 0xab,
+	0x72, 0xb0, 0x10, 0x23, 0x2c, 0xbb, 0xe1, 0x31, 0xa8, 0xf2, 0x0f, 0x51, 0x88, 0xdb, 0x01, 0x55,
+	0xc7, 0xfd, 0x6e, 0xee, 0x7c, 0x5a, 0x8f, 0xc1, 0x43, 0x2c, 0x84, 0x2b, 0xb2, 0xf9, 0x88, 0xb7,
+	0xf8, 0x03, 0x6e, 0xdf, 0xb0, 0x42, 0x3d, 0x33, 0x97, 0x7b, 0xc0, 0x8d, 0x91, 0x10, 0x2e, 0xf3,
+	0x86, 0xd4, 0xb1, 0x17, 0xfd, 0x98, 0xc5, 0xda, 0xd2, 0xea, 0xda, 0x6c, 0xf6, 0xde, 0x91, 0x19,
+	0x80, 0xa2, 0x9f, 0xa0, 0xd8, 0x9e, 0x10, 0xc0, 0x3b, 0x40, 0x3e, 0x6a, 0x46, 0x21, 0x5e, 0xe6,
+	0x59, 0x6d, 0x38, 0xd0, 0x97, 0x12, 0x8f, 0xa0, 0x71, 0x60, 0xaf, 0x8a, 0x76, 0x98, 0x2b, 0x8e,
+	0x41, 0x35, 0x70, 0x98, 0x6b, 0x93, 0x36, 0x3b, 0x36, 0xbc, 0xf0, 0x21, 0xe1, 0xc2, 0x16, 0x4d,
+	0x62, 0x21, 0x5c, 0x91, 0xcd, 0x03, 0xd1, 0xfa, 0xe3, 0x34, 0x58, 0x50, 0xee, 0xb4, 0xeb, 0x18,
+	0xf6, 0xe9, 0x1b, 0xae, 0x5c, 0x27, 0x75, 0xa9, 0x33, 0x36, 0x61, 0x3a, 0xf7, 0x26, 0xf8, 0x60,
+	0xc1, 0x38, 0xa1, 0x9e, 0x71, 0x44, 0xdb, 0xc4, 0x62, 0x66, 0xf4, 0xd8, 0x56, 0x6e, 0xb6, 0x72,
+	0x5b, 0x72, 0x55, 0xe9, 0xcc, 0xe0, 0xf1, 0xdf, 0xa8, 0xa4, 0x68, 0x5f, 0x49, 0xe0, 0xa7, 0xe0,
+	0xed, 0x70, 0x94, 0x6f, 0xf5, 0x68, 0xdb, 0x77, 0xdb, 0x69, 0x57, 0x90, 0xde, 0xb4, 0x3d, 0x1c,
+	0xe8, 0xef, 0xa6, 0x41, 0xcf, 0x1c, 0x8e, 0xf0, 0x9a, 0xea, 0xe7, 0xb1, 0xe2, 0xd0, 0xdd, 0x4b,
+	0xfa, 0xc9, 0x67, 0x60, 0x29, 0x9c, 0x9c, 0xf2, 0x97, 0x62, 0xee, 0x54, 0x24, 0x57, 0xb9, 0x91,
+	0x26, 0x94, 0xf6, 0x1b, 0xa8, 0xc4, 0x0f, 0x63, 0xf7, 0x69, 0x1e, 0xbc, 0xfc, 0xc7, 0xe6, 0xd4,
+	0x2f, 0x5f, 0x6d, 0x4e, 0xbd, 0x7c, 0xb5, 0x59, 0xf8, 0xe2, 0xd5, 0x66, 0xe1, 0xef, 0xaf, 0x36,
+	0x0b, 0x2f, 0x5e, 0x6f, 0x4e, 0x7d, 0xf1, 0x7a, 0x73, 0xea, 0x2f, 0xaf, 0x37, 0xa7, 0x1e, 0xdf,
+	0x4a, 0x29, 0xe7, 0xef, 0x20, 0x37, 0xdd, 0x6e, 0xd7, 0xe2, 0x79, 0x46, 0xb5, 0x77, 0xe2, 0x7f,
+	0x95, 0x12, 0x5c, 0x3a, 0xb3, 0x22, 0x3c, 0xfe, 0xff, 0xbf, 0x07, 0x00, 0x9e, 0x8a, 0x3c, 0x10,
+	0x49, 0x25, 0x00, 0x00,
 }
 
 func (m *SurplusAuction) Marshal() (dAtA []byte, err error) {
@@ -456,6 +716,34 @@ func (m *SurplusAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvictedBiddingIds) > 0 {
+		for iNdEx := len(m.EvictedBiddingIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvictedBiddingIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	{
+		size := m.ClearingPrice.Size()
+		i -= size
+		if _, err := m.ClearingPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BidEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BidEndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -598,6 +886,34 @@ func (m *DebtAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvictedBiddingIds) > 0 {
+		for iNdEx := len(m.EvictedBiddingIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvictedBiddingIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	{
+		size := m.ClearingPrice.Size()
+		i -= size
+		if _, err := m.ClearingPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
 	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BidEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BidEndTime):])
 	if err6 != nil {
 		return 0, err6
@@ -752,6 +1068,45 @@ func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EsmRestarts != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.EsmRestarts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RevealEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RevealEndTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintAuction(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CommitEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CommitEndTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintAuction(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.Sealed {
+		i--
+		if m.Sealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	{
 		size := m.LiquidationPenalty.Size()
 		i -= size
@@ -818,12 +1173,12 @@ func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x6a
 		}
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err12 != nil {
-		return 0, err12
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n12
-	i = encodeVarintAuction(dAtA, i, uint64(n12))
+	i -= n14
+	i = encodeVarintAuction(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x62
 	if m.AuctionStatus != 0 {
@@ -831,12 +1186,12 @@ func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err13 != nil {
-		return 0, err13
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n13
-	i = encodeVarintAuction(dAtA, i, uint64(n13))
+	i -= n15
+	i = encodeVarintAuction(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1025,6 +1380,26 @@ func (m *AuctionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RevealDurationSeconds != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.RevealDurationSeconds))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CommitDurationSeconds != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.CommitDurationSeconds))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SealedBid {
+		i--
+		if m.SealedBid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.BidDurationSeconds != 0 {
 		i = encodeVarintAuction(dAtA, i, uint64(m.BidDurationSeconds))
 		i--
@@ -1093,54 +1468,346 @@ func (m *AuctionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuction(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BadDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SurplusAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BadDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovAuction(uint64(m.AuctionId))
-	}
-	l = m.SellToken.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	l = m.BuyToken.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	if m.ActiveBiddingId != 0 {
-		n += 1 + sovAuction(uint64(m.ActiveBiddingId))
+	{
+		size := m.Outstanding.Size()
+		i -= size
+		if _, err := m.Outstanding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovAuction(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Socialized.Size()
+		i -= size
+		if _, err := m.Socialized.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	l = m.Bid.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovAuction(uint64(l))
-	l = m.BidFactor.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	if len(m.BiddingIds) > 0 {
-		for _, e := range m.BiddingIds {
-			l = e.Size()
-			n += 1 + l + sovAuction(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CoveredByCollector.Size()
+		i -= size
+		if _, err := m.CoveredByCollector.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	if m.AuctionStatus != 0 {
-		n += 1 + sovAuction(uint64(m.AuctionStatus))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CoveredByReserve.Size()
+		i -= size
+		if _, err := m.CoveredByReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	if m.AppId != 0 {
-		n += 1 + sovAuction(uint64(m.AppId))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Recorded.Size()
+		i -= size
+		if _, err := m.Recorded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AssetId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionHistoryIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionHistoryIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionHistoryIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClosedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClosedAt):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintAuction(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x2a
+	if m.AuctionId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Lend {
+		i--
+		if m.Lend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AppId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UnsoldShare.Size()
+		i -= size
+		if _, err := m.UnsoldShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.ClearSeconds != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.ClearSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AuctionsClosed != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionsClosed))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.PaidValue.Size()
+		i -= size
+		if _, err := m.PaidValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FilledValue.Size()
+		i -= size
+		if _, err := m.FilledValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Fills != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Fills))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssetId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionAnalytics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionAnalytics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionAnalytics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AverageUnsoldShare.Size()
+		i -= size
+		if _, err := m.AverageUnsoldShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.AverageTimeToClearSeconds != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AverageTimeToClearSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.AverageDiscount.Size()
+		i -= size
+		if _, err := m.AverageDiscount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.AuctionsClosed != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionsClosed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Fills != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Fills))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssetId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SurplusAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionId))
+	}
+	l = m.SellToken.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.BuyToken.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.ActiveBiddingId != 0 {
+		n += 1 + sovAuction(uint64(m.ActiveBiddingId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.BidFactor.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if len(m.BiddingIds) > 0 {
+		for _, e := range m.BiddingIds {
+			l = e.Size()
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	if m.AuctionStatus != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionStatus))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovAuction(uint64(m.AppId))
 	}
 	if m.AssetId != 0 {
 		n += 1 + sovAuction(uint64(m.AssetId))
@@ -1156,6 +1823,14 @@ func (m *SurplusAuction) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BidEndTime)
 	n += 2 + l + sovAuction(uint64(l))
+	l = m.ClearingPrice.Size()
+	n += 2 + l + sovAuction(uint64(l))
+	if len(m.EvictedBiddingIds) > 0 {
+		for _, e := range m.EvictedBiddingIds {
+			l = e.Size()
+			n += 2 + l + sovAuction(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1213,6 +1888,14 @@ func (m *DebtAuction) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BidEndTime)
 	n += 2 + l + sovAuction(uint64(l))
+	l = m.ClearingPrice.Size()
+	n += 2 + l + sovAuction(uint64(l))
+	if len(m.EvictedBiddingIds) > 0 {
+		for _, e := range m.EvictedBiddingIds {
+			l = e.Size()
+			n += 2 + l + sovAuction(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1275,6 +1958,16 @@ func (m *DutchAuction) Size() (n int) {
 	}
 	l = m.LiquidationPenalty.Size()
 	n += 2 + l + sovAuction(uint64(l))
+	if m.Sealed {
+		n += 3
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CommitEndTime)
+	n += 2 + l + sovAuction(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RevealEndTime)
+	n += 2 + l + sovAuction(uint64(l))
+	if m.EsmRestarts != 0 {
+		n += 2 + sovAuction(uint64(m.EsmRestarts))
+	}
 	return n
 }
 
@@ -1344,13 +2037,132 @@ func (m *AuctionParams) Size() (n int) {
 	if m.BidDurationSeconds != 0 {
 		n += 1 + sovAuction(uint64(m.BidDurationSeconds))
 	}
+	if m.SealedBid {
+		n += 2
+	}
+	if m.CommitDurationSeconds != 0 {
+		n += 1 + sovAuction(uint64(m.CommitDurationSeconds))
+	}
+	if m.RevealDurationSeconds != 0 {
+		n += 1 + sovAuction(uint64(m.RevealDurationSeconds))
+	}
 	return n
 }
 
-func sovAuction(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *BadDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovAuction(uint64(m.AppId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovAuction(uint64(m.PoolId))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovAuction(uint64(m.AssetId))
+	}
+	l = m.Recorded.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.CoveredByReserve.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.CoveredByCollector.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.Socialized.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.Outstanding.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
 }
-func sozAuction(x uint64) (n int) {
+
+func (m *AuctionHistoryIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovAuction(uint64(m.AppId))
+	}
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.Lend {
+		n += 2
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ClosedAt)
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *AuctionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovAuction(uint64(m.AppId))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovAuction(uint64(m.AssetId))
+	}
+	if m.Fills != 0 {
+		n += 1 + sovAuction(uint64(m.Fills))
+	}
+	l = m.FilledValue.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.PaidValue.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.AuctionsClosed != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionsClosed))
+	}
+	if m.ClearSeconds != 0 {
+		n += 1 + sovAuction(uint64(m.ClearSeconds))
+	}
+	l = m.UnsoldShare.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *AuctionAnalytics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovAuction(uint64(m.AppId))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovAuction(uint64(m.AssetId))
+	}
+	if m.Fills != 0 {
+		n += 1 + sovAuction(uint64(m.Fills))
+	}
+	if m.AuctionsClosed != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionsClosed))
+	}
+	l = m.AverageDiscount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.AverageTimeToClearSeconds != 0 {
+		n += 1 + sovAuction(uint64(m.AverageTimeToClearSeconds))
+	}
+	l = m.AverageUnsoldShare.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func sovAuction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuction(x uint64) (n int) {
 	return sovAuction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
@@ -1386,7 +2198,1162 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			m.AuctionId = 0
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveBiddingId", wireType)
+			}
+			m.ActiveBiddingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveBiddingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BidFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BiddingIds = append(m.BiddingIds, &BidOwnerMapping{})
+			if err := m.BiddingIds[len(m.BiddingIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStatus", wireType)
+			}
+			m.AuctionStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionStatus |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			}
+			m.AuctionMappingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetInId", wireType)
+			}
+			m.AssetInId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetInId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetOutId", wireType)
+			}
+			m.AssetOutId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetOutId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BidEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClearingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictedBiddingIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvictedBiddingIds = append(m.EvictedBiddingIds, &BidOwnerMapping{})
+			if err := m.EvictedBiddingIds[len(m.EvictedBiddingIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DebtAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebtAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebtAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionedToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuctionedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedUserToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectedUserToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedMintedToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectedMintedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveBiddingId", wireType)
+			}
+			m.ActiveBiddingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveBiddingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBidAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStatus", wireType)
+			}
+			m.AuctionStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionStatus |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BiddingIds = append(m.BiddingIds, &BidOwnerMapping{})
+			if err := m.BiddingIds[len(m.BiddingIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			}
+			m.AuctionMappingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BidFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetInId", wireType)
+			}
+			m.AssetInId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetInId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetOutId", wireType)
+			}
+			m.AssetOutId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetOutId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BidEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClearingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictedBiddingIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvictedBiddingIds = append(m.EvictedBiddingIds, &BidOwnerMapping{})
+			if err := m.EvictedBiddingIds[len(m.EvictedBiddingIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokenInitAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutflowTokenInitAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokenCurrentAmount", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1396,14 +3363,28 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutflowTokenCurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowTokenTargetAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1430,13 +3411,13 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SellToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InflowTokenTargetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuyToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowTokenCurrentAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1463,15 +3444,15 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BuyToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InflowTokenCurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveBiddingId", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokenInitialPrice", wireType)
 			}
-			m.ActiveBiddingId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1481,14 +3462,29 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActiveBiddingId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutflowTokenInitialPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokenCurrentPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1516,13 +3512,15 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			if err := m.OutflowTokenCurrentPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokenEndPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1532,26 +3530,61 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutflowTokenEndPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowTokenCurrentPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflowTokenCurrentPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
@@ -1584,11 +3617,30 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStatus", wireType)
+			}
+			m.AuctionStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionStatus |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1598,27 +3650,26 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BidFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 13:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BiddingIds", wireType)
 			}
@@ -1652,11 +3703,11 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
 			}
-			m.AuctionStatus = 0
+			m.AuctionMappingId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1666,12 +3717,12 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionStatus |= uint64(b&0x7F) << shift
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 15:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
@@ -1690,11 +3741,11 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 12:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetInId", wireType)
 			}
-			m.AssetId = 0
+			m.AssetInId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1704,16 +3755,16 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetId |= uint64(b&0x7F) << shift
+				m.AssetInId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetOutId", wireType)
 			}
-			m.AuctionMappingId = 0
+			m.AssetOutId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1723,16 +3774,101 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				m.AssetOutId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedVaultId", wireType)
+			}
+			m.LockedVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockedVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultOwner = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetInId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sealed", wireType)
 			}
-			m.AssetInId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1742,16 +3878,17 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetInId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetOutId", wireType)
+			m.Sealed = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitEndTime", wireType)
 			}
-			m.AssetOutId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1761,14 +3898,28 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetOutId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CommitEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1795,10 +3946,29 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BidEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RevealEndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EsmRestarts", wireType)
+			}
+			m.EsmRestarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EsmRestarts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1820,7 +3990,7 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DebtAuction) Unmarshal(dAtA []byte) error {
+func (m *BidOwnerMapping) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1843,17 +4013,17 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DebtAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: bidOwnerMapping: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebtAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: bidOwnerMapping: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
 			}
-			m.AuctionId = 0
+			m.BidId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1863,16 +4033,16 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				m.BidId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionedToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1882,30 +4052,79 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuctionedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BidOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedUserToken", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1915,30 +4134,35 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
 			}
-			if err := m.ExpectedUserToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedMintedToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Loss", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1948,30 +4172,81 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExpectedMintedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Loss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1981,30 +4256,16 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveBiddingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionDurationSeconds", wireType)
 			}
-			m.ActiveBiddingId = 0
+			m.AuctionDurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2014,14 +4275,14 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActiveBiddingId |= uint64(b&0x7F) << shift
+				m.AuctionDurationSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2049,13 +4310,15 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			if err := m.Buffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBidAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cusp", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2065,30 +4328,31 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cusp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStatus", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			m.AuctionStatus = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2098,16 +4362,31 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionStatus |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Step.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFunctionType", wireType)
 			}
-			m.AppId = 0
+			m.PriceFunctionType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2117,16 +4396,16 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				m.PriceFunctionType |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SurplusId", wireType)
 			}
-			m.AssetId = 0
+			m.SurplusId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2136,16 +4415,16 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetId |= uint64(b&0x7F) << shift
+				m.SurplusId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BiddingIds", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtId", wireType)
 			}
-			var msglen int
+			m.DebtId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2155,31 +4434,16 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DebtId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BiddingIds = append(m.BiddingIds, &BidOwnerMapping{})
-			if err := m.BiddingIds[len(m.BiddingIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchId", wireType)
 			}
-			m.AuctionMappingId = 0
+			m.DutchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2189,16 +4453,16 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				m.DutchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidFactor", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidDurationSeconds", wireType)
 			}
-			var stringLen uint64
+			m.BidDurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2208,31 +4472,16 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BidDurationSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BidFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 15:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetInId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBid", wireType)
 			}
-			m.AssetInId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2242,16 +4491,17 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetInId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			m.SealedBid = bool(v != 0)
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetOutId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitDurationSeconds", wireType)
 			}
-			m.AssetOutId = 0
+			m.CommitDurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2261,16 +4511,16 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetOutId |= uint64(b&0x7F) << shift
+				m.CommitDurationSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidEndTime", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealDurationSeconds", wireType)
 			}
-			var msglen int
+			m.RevealDurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2280,25 +4530,11 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RevealDurationSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BidEndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -2320,7 +4556,7 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DutchAuction) Unmarshal(dAtA []byte) error {
+func (m *BadDebt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2343,17 +4579,17 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: BadDebt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BadDebt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			m.AuctionId = 0
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2363,16 +4599,16 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokenInitAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2382,96 +4618,16 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OutflowTokenInitAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokenCurrentAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OutflowTokenCurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflowTokenTargetAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InflowTokenTargetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflowTokenCurrentAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
 			}
-			var msglen int
+			m.AssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2481,28 +4637,14 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InflowTokenCurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokenInitialPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recorded", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2530,13 +4672,13 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutflowTokenInitialPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Recorded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokenCurrentPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredByReserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2564,13 +4706,13 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutflowTokenCurrentPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoveredByReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokenEndPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredByCollector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2598,13 +4740,13 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutflowTokenEndPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoveredByCollector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflowTokenCurrentPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Socialized", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2632,15 +4774,15 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflowTokenCurrentPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Socialized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2650,131 +4792,77 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Outstanding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStatus", wireType)
-			}
-			m.AuctionStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionStatus |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BiddingIds", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuction
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BiddingIds = append(m.BiddingIds, &BidOwnerMapping{})
-			if err := m.BiddingIds[len(m.BiddingIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionHistoryIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
 			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.AuctionMappingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionMappingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 15:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionHistoryIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionHistoryIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
@@ -2793,11 +4881,11 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetInId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
 			}
-			m.AssetInId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2807,35 +4895,29 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetInId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetOutId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
 			}
-			m.AssetOutId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetOutId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
 			}
-		case 18:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedVaultId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lend", wireType)
 			}
-			m.LockedVaultId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2845,16 +4927,17 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LockedVaultId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultOwner", wireType)
+			m.Lend = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			var stringLen uint64
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2864,29 +4947,16 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VaultOwner = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 20:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2896,23 +4966,22 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2937,7 +5006,7 @@ func (m *DutchAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BidOwnerMapping) Unmarshal(dAtA []byte) error {
+func (m *AuctionStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2960,17 +5029,17 @@ func (m *BidOwnerMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: bidOwnerMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuctionStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: bidOwnerMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuctionStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			m.BidId = 0
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -2980,14 +5049,52 @@ func (m *BidOwnerMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BidId |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			m.Fills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3015,63 +5122,49 @@ func (m *BidOwnerMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BidOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuction(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.FilledValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuction
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidValue", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProtocolStatistics) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuction
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PaidValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProtocolStatistics: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtocolStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionsClosed", wireType)
 			}
-			m.AppId = 0
+			m.AuctionsClosed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -3081,16 +5174,16 @@ func (m *ProtocolStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				m.AuctionsClosed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearSeconds", wireType)
 			}
-			m.AssetId = 0
+			m.ClearSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -3100,14 +5193,14 @@ func (m *ProtocolStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetId |= uint64(b&0x7F) << shift
+				m.ClearSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Loss", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsoldShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3135,7 +5228,7 @@ func (m *ProtocolStatistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Loss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnsoldShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3160,7 +5253,7 @@ func (m *ProtocolStatistics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuctionParams) Unmarshal(dAtA []byte) error {
+func (m *AuctionAnalytics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3183,10 +5276,10 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuctionParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuctionAnalytics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuctionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuctionAnalytics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3210,9 +5303,9 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionDurationSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
 			}
-			m.AuctionDurationSeconds = 0
+			m.AssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -3222,16 +5315,16 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionDurationSeconds |= uint64(b&0x7F) << shift
+				m.AssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
 			}
-			var stringLen uint64
+			m.Fills = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -3241,31 +5334,16 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Fills |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Buffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cusp", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionsClosed", wireType)
 			}
-			var stringLen uint64
+			m.AuctionsClosed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -3275,29 +5353,14 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuctionsClosed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Cusp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageDiscount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3325,15 +5388,15 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Step.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AverageDiscount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceFunctionType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageTimeToClearSeconds", wireType)
 			}
-			m.PriceFunctionType = 0
+			m.AverageTimeToClearSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -3343,35 +5406,16 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PriceFunctionType |= uint64(b&0x7F) << shift
+				m.AverageTimeToClearSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SurplusId", wireType)
-			}
-			m.SurplusId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SurplusId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageUnsoldShare", wireType)
 			}
-			m.DebtId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -3381,49 +5425,26 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DebtId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DutchId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
 			}
-			m.DutchId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DutchId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidDurationSeconds", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BidDurationSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BidDurationSeconds |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.AverageUnsoldShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -40,6 +40,11 @@ type SurplusBiddings struct {
 	BiddingStatus       string                                  `protobuf:"bytes,8,opt,name=bidding_status,json=biddingStatus,proto3" json:"bidding_status,omitempty" yaml:"bidding_status"`
 	AuctionMappingId    uint64                                  `protobuf:"varint,9,opt,name=auction_mapping_id,json=auctionMappingId,proto3" json:"auction_mapping_id,omitempty" yaml:"auction_mapping_id"`
 	AppId               uint64                                  `protobuf:"varint,10,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	// price is the bid in buy token per unit of auctioned collateral.
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	// filled is the part of auctioned collateral the bid received when the
+	// auction cleared.
+	Filled github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,12,opt,name=filled,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"filled" yaml:"filled"`
 }
 
 func (m *SurplusBiddings) Reset()         { *m = SurplusBiddings{} }
@@ -86,6 +91,11 @@ type DebtBiddings struct {
 	BiddingStatus    string                                  `protobuf:"bytes,8,opt,name=bidding_status,json=biddingStatus,proto3" json:"bidding_status,omitempty" yaml:"bidding_status"`
 	AuctionMappingId uint64                                  `protobuf:"varint,9,opt,name=auction_mapping_id,json=auctionMappingId,proto3" json:"auction_mapping_id,omitempty" yaml:"auction_mapping_id"`
 	AppId            uint64                                  `protobuf:"varint,10,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	// price is the bid in minted token per unit of outflow tokens.
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	// filled is the part of outflow tokens taken from the bid when the
+	// auction cleared.
+	Filled github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,12,opt,name=filled,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"filled" yaml:"filled"`
 }
 
 func (m *DebtBiddings) Reset()         { *m = DebtBiddings{} }
@@ -167,10 +177,116 @@ func (m *DutchBiddings) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DutchBiddings proto.InternalMessageInfo
 
+// SealedBid is a hashed bid on a sealed dutch auction with the escrow backing
+// it. The quantity and payment are set once the bid is revealed.
+type SealedBid struct {
+	BiddingId        uint64     `protobuf:"varint,1,opt,name=bidding_id,json=biddingId,proto3" json:"bidding_id,omitempty" yaml:"bidding_id"`
+	AuctionId        uint64     `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty" yaml:"auction_id"`
+	AppId            uint64     `protobuf:"varint,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	AuctionMappingId uint64     `protobuf:"varint,4,opt,name=auction_mapping_id,json=auctionMappingId,proto3" json:"auction_mapping_id,omitempty" yaml:"auction_mapping_id"`
+	Bidder           string     `protobuf:"bytes,5,opt,name=bidder,proto3" json:"bidder,omitempty" yaml:"bidder"`
+	Commitment       []byte     `protobuf:"bytes,6,opt,name=commitment,proto3" json:"commitment,omitempty" yaml:"commitment"`
+	Escrow           types.Coin `protobuf:"bytes,7,opt,name=escrow,proto3" json:"escrow" yaml:"escrow"`
+	Revealed         bool       `protobuf:"varint,8,opt,name=revealed,proto3" json:"revealed,omitempty" yaml:"revealed"`
+	// quantity of collateral bid for.
+	Quantity types.Coin `protobuf:"bytes,9,opt,name=quantity,proto3" json:"quantity" yaml:"quantity"`
+	// payment offered for the quantity, at most the escrow.
+	Payment          types.Coin `protobuf:"bytes,10,opt,name=payment,proto3" json:"payment" yaml:"payment"`
+	BiddingTimestamp time.Time  `protobuf:"bytes,11,opt,name=bidding_timestamp,json=biddingTimestamp,proto3,stdtime" json:"bidding_timestamp" yaml:"bidding_timestamp"`
+}
+
+func (m *SealedBid) Reset()         { *m = SealedBid{} }
+func (m *SealedBid) String() string { return proto.CompactTextString(m) }
+func (*SealedBid) ProtoMessage()    {}
+func (*SealedBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a3f4b8597bafd2, []int{3}
+}
+func (m *SealedBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SealedBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBid.Merge(m, src)
+}
+func (m *SealedBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBid proto.InternalMessageInfo
+
+// PreBid is a standing bid of debt token for the collateral of the
+// liquidations of a collateral and debt asset pair of an app, at a premium
+// below the oracle price of the collateral.
+type PreBid struct {
+	Id                uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	AppId             uint64 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	CollateralAssetId uint64 `protobuf:"varint,3,opt,name=collateral_asset_id,json=collateralAssetId,proto3" json:"collateral_asset_id,omitempty" yaml:"collateral_asset_id"`
+	DebtAssetId       uint64 `protobuf:"varint,4,opt,name=debt_asset_id,json=debtAssetId,proto3" json:"debt_asset_id,omitempty" yaml:"debt_asset_id"`
+	Bidder            string `protobuf:"bytes,5,opt,name=bidder,proto3" json:"bidder,omitempty" yaml:"bidder"`
+	// premium is the discount to the oracle price bid, in percent.
+	Premium uint64 `protobuf:"varint,6,opt,name=premium,proto3" json:"premium,omitempty" yaml:"premium"`
+	// amount of debt token left to bid.
+	Amount types.Coin `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	// filled is the collateral bought so far.
+	Filled      types.Coin `protobuf:"bytes,8,opt,name=filled,proto3" json:"filled" yaml:"filled"`
+	SubmittedAt time.Time  `protobuf:"bytes,9,opt,name=submitted_at,json=submittedAt,proto3,stdtime" json:"submitted_at" yaml:"submitted_at"`
+	// active_at is when the pre-bid starts to fill liquidations.
+	ActiveAt time.Time `protobuf:"bytes,10,opt,name=active_at,json=activeAt,proto3,stdtime" json:"active_at" yaml:"active_at"`
+}
+
+func (m *PreBid) Reset()         { *m = PreBid{} }
+func (m *PreBid) String() string { return proto.CompactTextString(m) }
+func (*PreBid) ProtoMessage()    {}
+func (*PreBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a3f4b8597bafd2, []int{4}
+}
+func (m *PreBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PreBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreBid.Merge(m, src)
+}
+func (m *PreBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreBid proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*SurplusBiddings)(nil), "comdex.auction.v1beta1.SurplusBiddings")
 	proto.RegisterType((*DebtBiddings)(nil), "comdex.auction.v1beta1.DebtBiddings")
 	proto.RegisterType((*DutchBiddings)(nil), "comdex.auction.v1beta1.DutchBiddings")
+	proto.RegisterType((*SealedBid)(nil), "comdex.auction.v1beta1.SealedBid")
+	proto.RegisterType((*PreBid)(nil), "comdex.auction.v1beta1.PreBid")
 }
 
 func init() {
@@ -178,50 +294,75 @@ func init() {
 }
 
 var fileDescriptor_a5a3f4b8597bafd2 = []byte{
-	// 675 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xbd, 0x6e, 0xd3, 0x50,
-	0x14, 0x8e, 0x69, 0x6b, 0xc8, 0x85, 0x94, 0xc6, 0x6d, 0x91, 0x1b, 0x84, 0x5d, 0x59, 0x20, 0xc2,
-	0x50, 0x5b, 0x05, 0x26, 0x24, 0x24, 0x48, 0x3b, 0x10, 0xa1, 0x32, 0xb8, 0x9d, 0x58, 0xa2, 0xeb,
-	0x9f, 0xa4, 0x97, 0xda, 0xbe, 0x56, 0x7c, 0x0d, 0xf4, 0x0d, 0x10, 0x53, 0x47, 0x24, 0x60, 0xe7,
-	0x51, 0xca, 0xd6, 0x91, 0xc9, 0x40, 0xfa, 0x06, 0x19, 0x99, 0x90, 0xaf, 0x8f, 0xed, 0xba, 0x54,
-	0x0a, 0x19, 0x23, 0x75, 0x4a, 0xce, 0xdf, 0x77, 0xbe, 0x9c, 0xf3, 0x1d, 0xe5, 0xa2, 0x7b, 0x36,
-	0xf5, 0x1d, 0xf7, 0xbd, 0x81, 0x63, 0x9b, 0x11, 0x1a, 0x18, 0x6f, 0x37, 0x2d, 0x97, 0xe1, 0x4d,
-	0xc3, 0x22, 0x8e, 0x43, 0x82, 0x41, 0xa4, 0x87, 0x43, 0xca, 0xa8, 0x74, 0x2b, 0x4b, 0xd3, 0x21,
-	0x4d, 0x87, 0xb4, 0xd6, 0xca, 0x80, 0x0e, 0x28, 0x4f, 0x31, 0xd2, 0x6f, 0x59, 0x76, 0x4b, 0x1d,
-	0x50, 0x3a, 0xf0, 0x5c, 0x83, 0x5b, 0x56, 0xdc, 0x37, 0x18, 0xf1, 0xdd, 0x88, 0x61, 0x3f, 0x84,
-	0x04, 0xc5, 0xa6, 0x91, 0x4f, 0x23, 0xc3, 0xc2, 0x91, 0x5b, 0xb4, 0xb4, 0x29, 0x09, 0xb2, 0xb8,
-	0xf6, 0x55, 0x44, 0x37, 0x77, 0xe3, 0x61, 0xe8, 0xc5, 0x51, 0x07, 0x88, 0x48, 0x8f, 0x11, 0x02,
-	0x52, 0x3d, 0xe2, 0xc8, 0xc2, 0xba, 0xd0, 0x9e, 0xef, 0xac, 0x8e, 0x13, 0xb5, 0x79, 0x88, 0x7d,
-	0xef, 0x89, 0x56, 0xc6, 0x34, 0xb3, 0x0e, 0x46, 0xd7, 0x49, 0xab, 0x80, 0x73, 0x5a, 0x75, 0xe5,
-	0x7c, 0x55, 0x19, 0xd3, 0xcc, 0x3a, 0x18, 0x5d, 0x47, 0x7a, 0x86, 0x16, 0xf3, 0x48, 0xc4, 0x30,
-	0x8b, 0x23, 0x79, 0x6e, 0x5d, 0x68, 0xd7, 0x3b, 0x6b, 0xe3, 0x44, 0x5d, 0xad, 0x56, 0x66, 0x71,
-	0xcd, 0x6c, 0x80, 0x63, 0x97, 0xdb, 0xd2, 0x17, 0x01, 0xad, 0x80, 0xc7, 0x75, 0x7a, 0x36, 0xf5,
-	0x3c, 0xcc, 0xdc, 0x21, 0xf6, 0xe4, 0xf9, 0x75, 0xa1, 0x7d, 0xfd, 0xe1, 0x9a, 0x9e, 0x4d, 0x40,
-	0x4f, 0x27, 0x90, 0x4f, 0x53, 0xdf, 0xa2, 0x24, 0xe8, 0xbc, 0x3a, 0x4e, 0xd4, 0xda, 0x38, 0x51,
-	0x6f, 0x57, 0xfa, 0x54, 0x40, 0xb4, 0x3f, 0x89, 0x7a, 0x7f, 0x40, 0xd8, 0x7e, 0x6c, 0xe9, 0x36,
-	0xf5, 0x0d, 0x98, 0x66, 0xf6, 0xb1, 0x11, 0x39, 0x07, 0x06, 0x3b, 0x0c, 0xdd, 0x88, 0xe3, 0x99,
-	0xcb, 0x05, 0xc2, 0x56, 0x01, 0x20, 0x3d, 0x40, 0x62, 0x3a, 0x23, 0x77, 0x28, 0x2f, 0xf0, 0x1f,
-	0xd6, 0x1c, 0x27, 0x6a, 0xa3, 0x1c, 0xa4, 0x3b, 0xd4, 0x4c, 0x48, 0x90, 0xde, 0xa0, 0x39, 0x8b,
-	0x38, 0xb2, 0x38, 0x89, 0xf7, 0x53, 0xe0, 0x8d, 0x0a, 0x98, 0xa9, 0x68, 0xa6, 0x4d, 0x24, 0x1f,
-	0x35, 0xf3, 0x3d, 0x16, 0x92, 0x91, 0xaf, 0xf2, 0xce, 0x2d, 0x3d, 0x13, 0x95, 0x9e, 0x8b, 0x4a,
-	0xdf, 0xcb, 0x33, 0x3a, 0x77, 0xa1, 0xb5, 0x5c, 0x95, 0x42, 0x01, 0xa1, 0x1d, 0xfd, 0x54, 0x05,
-	0x73, 0x09, 0xfc, 0x45, 0x5d, 0xba, 0xe6, 0x3c, 0x17, 0xd6, 0x7c, 0xed, 0xfc, 0x9a, 0xab, 0x71,
-	0xcd, 0x6c, 0x80, 0x03, 0xd6, 0xfc, 0x12, 0x49, 0xb9, 0x10, 0x7c, 0x1c, 0x86, 0x20, 0xce, 0x3a,
-	0x97, 0xd9, 0x9d, 0x71, 0xa2, 0xae, 0x55, 0xc5, 0x52, 0xe6, 0x68, 0xe6, 0x12, 0x38, 0x77, 0x32,
-	0x5f, 0xd7, 0x91, 0xda, 0x48, 0xc4, 0x61, 0x98, 0x02, 0x20, 0x0e, 0x70, 0x66, 0x29, 0x99, 0x5f,
-	0x33, 0x17, 0x70, 0x18, 0x76, 0x1d, 0xed, 0x83, 0x88, 0x6e, 0x6c, 0xbb, 0x16, 0x9b, 0xd1, 0xe3,
-	0xf8, 0x28, 0xa0, 0x45, 0x1a, 0xb3, 0xbe, 0x47, 0xdf, 0xf5, 0x18, 0x3d, 0x70, 0x83, 0x68, 0xf2,
-	0x59, 0xbc, 0x80, 0x1d, 0x43, 0x87, 0x6a, 0xf9, 0x54, 0x4a, 0x6b, 0x40, 0xed, 0x1e, 0x2f, 0xbd,
-	0x3c, 0x85, 0xd9, 0x3e, 0x85, 0xef, 0x22, 0x6a, 0x6c, 0xc7, 0xcc, 0xde, 0x9f, 0xd1, 0x5b, 0xf8,
-	0x2c, 0xa0, 0x95, 0x8a, 0x98, 0x7b, 0xd8, 0xa7, 0x71, 0xc0, 0xa6, 0xfe, 0xa3, 0xb8, 0x08, 0x64,
-	0x2a, 0xd9, 0x49, 0x67, 0xef, 0xe2, 0x39, 0xaf, 0x97, 0x3e, 0x09, 0x68, 0x99, 0x04, 0xff, 0x92,
-	0x5b, 0x98, 0x44, 0x6e, 0x07, 0xc8, 0xb5, 0x32, 0x72, 0x17, 0x60, 0x4c, 0xc5, 0xad, 0x99, 0x01,
-	0x9c, 0xa5, 0x56, 0xde, 0xad, 0x38, 0xe9, 0x6e, 0x2f, 0x6f, 0xe9, 0xbf, 0x6e, 0xa9, 0xb3, 0x7b,
-	0xfc, 0x5b, 0xa9, 0x7d, 0x1b, 0x29, 0xb5, 0xe3, 0x91, 0x22, 0x9c, 0x8c, 0x14, 0xe1, 0xd7, 0x48,
-	0x11, 0x8e, 0x4e, 0x95, 0xda, 0xc9, 0xa9, 0x52, 0xfb, 0x71, 0xaa, 0xd4, 0x5e, 0x6f, 0x56, 0x16,
-	0x96, 0x3e, 0x09, 0x37, 0x68, 0xbf, 0x4f, 0x6c, 0x82, 0x3d, 0xb0, 0x8d, 0xf2, 0x2d, 0xc9, 0xf7,
-	0x67, 0x89, 0x7c, 0xb2, 0x8f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x0e, 0x99, 0xf1, 0x6a,
-	0x0a, 0x00, 0x00,
+	// 1074 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xbd, 0x6f, 0xe4, 0x44,
+	0x14, 0x5f, 0x27, 0xd9, 0xaf, 0x49, 0x36, 0x97, 0x4c, 0x92, 0xc3, 0x09, 0xdc, 0x3a, 0x1a, 0xf1,
+	0x11, 0x24, 0xce, 0x56, 0xf8, 0x68, 0x10, 0xa0, 0xcb, 0x5e, 0x8a, 0x8b, 0x4e, 0x17, 0x21, 0x27,
+	0x34, 0x14, 0xac, 0xc6, 0xf6, 0xec, 0xde, 0x70, 0xb6, 0xc7, 0xd8, 0xe3, 0x1c, 0xa9, 0xe9, 0xa8,
+	0xae, 0x44, 0x82, 0x3f, 0x80, 0x3f, 0x25, 0x74, 0x27, 0x51, 0x80, 0x28, 0x0c, 0x24, 0x0d, 0xf5,
+	0x96, 0x54, 0xc8, 0x9e, 0xb1, 0xbd, 0x0e, 0x11, 0xbe, 0x05, 0x81, 0x38, 0x29, 0x55, 0x76, 0xde,
+	0x7b, 0xbf, 0xdf, 0xbc, 0x79, 0xef, 0xf7, 0x66, 0xb2, 0x0b, 0x5e, 0xb1, 0x99, 0xe7, 0x90, 0xcf,
+	0x0d, 0x1c, 0xdb, 0x9c, 0x32, 0xdf, 0x38, 0xd9, 0xb5, 0x08, 0xc7, 0xbb, 0x86, 0x45, 0x1d, 0x87,
+	0xfa, 0xe3, 0x48, 0x0f, 0x42, 0xc6, 0x19, 0xbc, 0x29, 0xc2, 0x74, 0x19, 0xa6, 0xcb, 0xb0, 0xad,
+	0xf5, 0x31, 0x1b, 0xb3, 0x2c, 0xc4, 0x48, 0x3f, 0x89, 0xe8, 0x2d, 0x6d, 0xcc, 0xd8, 0xd8, 0x25,
+	0x46, 0xb6, 0xb2, 0xe2, 0x91, 0xc1, 0xa9, 0x47, 0x22, 0x8e, 0xbd, 0x40, 0x06, 0xf4, 0x6d, 0x16,
+	0x79, 0x2c, 0x32, 0x2c, 0x1c, 0x91, 0x62, 0x4b, 0x9b, 0x51, 0x5f, 0xf8, 0xd1, 0x6f, 0x6d, 0x70,
+	0xe3, 0x28, 0x0e, 0x03, 0x37, 0x8e, 0x06, 0x32, 0x11, 0xf8, 0x36, 0x00, 0x32, 0xa9, 0x21, 0x75,
+	0x54, 0x65, 0x5b, 0xd9, 0x59, 0x18, 0x6c, 0x4c, 0x12, 0x6d, 0xf5, 0x14, 0x7b, 0xee, 0xbb, 0xa8,
+	0xf4, 0x21, 0xb3, 0x2b, 0x17, 0x07, 0x4e, 0x8a, 0x92, 0x39, 0xa7, 0xa8, 0xb9, 0xcb, 0xa8, 0xd2,
+	0x87, 0xcc, 0xae, 0x5c, 0x1c, 0x38, 0xf0, 0x0e, 0x58, 0xce, 0x3d, 0x11, 0xc7, 0x3c, 0x8e, 0xd4,
+	0xf9, 0x6d, 0x65, 0xa7, 0x3b, 0xd8, 0x9c, 0x24, 0xda, 0x46, 0x15, 0x29, 0xfc, 0xc8, 0xec, 0x49,
+	0xc3, 0x51, 0xb6, 0x86, 0xdf, 0x28, 0x60, 0x5d, 0x5a, 0x88, 0x33, 0xb4, 0x99, 0xeb, 0x62, 0x4e,
+	0x42, 0xec, 0xaa, 0x0b, 0xdb, 0xca, 0xce, 0xe2, 0x9b, 0x9b, 0xba, 0xa8, 0x80, 0x9e, 0x56, 0x20,
+	0xaf, 0xa6, 0x7e, 0x97, 0x51, 0x7f, 0x70, 0x78, 0x96, 0x68, 0x8d, 0x49, 0xa2, 0xbd, 0x58, 0xd9,
+	0xa7, 0x42, 0x82, 0x7e, 0x4f, 0xb4, 0xd7, 0xc6, 0x94, 0x3f, 0x8c, 0x2d, 0xdd, 0x66, 0x9e, 0x21,
+	0xab, 0x29, 0xfe, 0xdc, 0x8e, 0x9c, 0x47, 0x06, 0x3f, 0x0d, 0x48, 0x94, 0xf1, 0x99, 0x6b, 0x05,
+	0xc3, 0xdd, 0x82, 0x00, 0xbe, 0x0e, 0x5a, 0x69, 0x8d, 0x48, 0xa8, 0x36, 0xb3, 0x83, 0xad, 0x4e,
+	0x12, 0xad, 0x57, 0x16, 0x92, 0x84, 0xc8, 0x94, 0x01, 0xf0, 0x53, 0x30, 0x6f, 0x51, 0x47, 0x6d,
+	0xd5, 0xe5, 0xfd, 0xbe, 0xcc, 0x1b, 0x14, 0x34, 0x33, 0xa5, 0x99, 0x6e, 0x02, 0x3d, 0xb0, 0x9a,
+	0xf7, 0xb1, 0x90, 0x8c, 0xda, 0xce, 0x76, 0xde, 0xd2, 0x85, 0xa8, 0xf4, 0x5c, 0x54, 0xfa, 0x71,
+	0x1e, 0x31, 0x78, 0x59, 0x6e, 0xad, 0x56, 0xa5, 0x50, 0x50, 0xa0, 0x27, 0x3f, 0x6b, 0x8a, 0xb9,
+	0x22, 0xed, 0x05, 0x2e, 0x6d, 0x73, 0x1e, 0x2b, 0xdb, 0xdc, 0xb9, 0xdc, 0xe6, 0xaa, 0x1f, 0x99,
+	0x3d, 0x69, 0x90, 0x6d, 0xbe, 0x0f, 0x60, 0x2e, 0x04, 0x0f, 0x07, 0x81, 0x14, 0x67, 0x37, 0x93,
+	0xd9, 0xad, 0x49, 0xa2, 0x6d, 0x56, 0xc5, 0x52, 0xc6, 0x20, 0x73, 0x45, 0x1a, 0x1f, 0x08, 0xdb,
+	0x81, 0x03, 0x77, 0x40, 0x0b, 0x07, 0x41, 0x4a, 0x00, 0x32, 0x82, 0xa9, 0xa6, 0x08, 0x3b, 0x32,
+	0x9b, 0x38, 0x08, 0x0e, 0x1c, 0x78, 0x0c, 0x9a, 0x41, 0x48, 0x6d, 0xa2, 0x2e, 0x66, 0xf9, 0x7e,
+	0x90, 0x9e, 0xff, 0xa7, 0x44, 0x7b, 0xf5, 0x19, 0x8a, 0xbd, 0x4f, 0xec, 0x49, 0xa2, 0x2d, 0x09,
+	0xda, 0x8c, 0x04, 0x99, 0x82, 0x0c, 0x46, 0xa0, 0x35, 0xa2, 0xae, 0x4b, 0x1c, 0x75, 0xa9, 0xae,
+	0xd9, 0x77, 0x64, 0xc5, 0x65, 0x7a, 0x02, 0x36, 0x53, 0xbf, 0xe5, 0x56, 0xe8, 0xfb, 0x36, 0x58,
+	0xda, 0x27, 0x16, 0x7f, 0x4e, 0xe7, 0xfc, 0x4b, 0x05, 0x2c, 0xb3, 0x98, 0x8f, 0x5c, 0xf6, 0x78,
+	0xc8, 0xd9, 0x23, 0xe2, 0x47, 0xf5, 0x13, 0x7e, 0x4f, 0x16, 0x4f, 0xee, 0x50, 0x85, 0xcf, 0x54,
+	0xc4, 0x9e, 0xc4, 0x1e, 0x67, 0xd0, 0xeb, 0xa9, 0xbe, 0x9e, 0xea, 0xff, 0xc9, 0x54, 0x7f, 0xd7,
+	0x02, 0xbd, 0xfd, 0x98, 0xdb, 0x0f, 0x9f, 0xd3, 0xb1, 0xfe, 0x5a, 0x01, 0xeb, 0x95, 0xb9, 0x1c,
+	0x62, 0x8f, 0xc5, 0x3e, 0x9f, 0xf9, 0xf9, 0xbe, 0x8a, 0x64, 0xa6, 0x8a, 0xc2, 0xe9, 0x11, 0xdf,
+	0xcb, 0xf0, 0xf0, 0x2b, 0x05, 0xac, 0x51, 0xff, 0xcf, 0xc9, 0x35, 0xeb, 0x92, 0x7b, 0x20, 0x93,
+	0xdb, 0x12, 0xc9, 0x51, 0xff, 0x9f, 0xe5, 0xb6, 0x4a, 0xfd, 0xcb, 0xa9, 0x95, 0x57, 0x50, 0xab,
+	0xee, 0x0a, 0xba, 0xbe, 0x16, 0x9e, 0xe9, 0x5a, 0x40, 0x3f, 0x34, 0x41, 0xf7, 0x88, 0x60, 0x97,
+	0x38, 0x03, 0xea, 0xfc, 0xa7, 0x73, 0x54, 0xe6, 0x38, 0x5f, 0x73, 0x75, 0x5d, 0x5d, 0x9a, 0x85,
+	0xbf, 0x57, 0x9a, 0x19, 0x9e, 0xb1, 0x77, 0x00, 0xb0, 0x99, 0xe7, 0x51, 0xee, 0x11, 0x9f, 0x67,
+	0x92, 0x5b, 0x9a, 0x3e, 0x57, 0xe9, 0x43, 0xe6, 0x54, 0x20, 0xbc, 0x07, 0x5a, 0x24, 0xb2, 0x43,
+	0xf6, 0x58, 0x6d, 0xd7, 0x8d, 0xcc, 0x46, 0xf5, 0x4e, 0x14, 0x30, 0x64, 0x4a, 0x3c, 0x34, 0x40,
+	0x27, 0x24, 0x27, 0x59, 0x77, 0x32, 0x3d, 0x75, 0x06, 0x6b, 0x93, 0x44, 0xbb, 0x21, 0x82, 0x73,
+	0x0f, 0x32, 0x8b, 0x20, 0x78, 0x08, 0x3a, 0x9f, 0xc5, 0xd8, 0xe7, 0x94, 0x9f, 0xaa, 0xdd, 0xba,
+	0xcd, 0x5f, 0x90, 0x9b, 0x4b, 0xbe, 0x1c, 0x88, 0xcc, 0x82, 0x03, 0xde, 0x07, 0xed, 0x00, 0x9f,
+	0x66, 0xc7, 0x07, 0x75, 0x74, 0x37, 0x25, 0xdd, 0xb2, 0x7c, 0x27, 0x04, 0x0e, 0x99, 0x39, 0xc3,
+	0xd5, 0x23, 0xb9, 0xf8, 0x6f, 0x8d, 0x24, 0xfa, 0xa2, 0x09, 0x5a, 0x1f, 0x86, 0x24, 0x95, 0xf5,
+	0x2d, 0x30, 0x57, 0xc8, 0xb9, 0x37, 0x49, 0xb4, 0xae, 0xa0, 0x4a, 0x05, 0x32, 0x47, 0xa7, 0x95,
+	0x38, 0x57, 0xa3, 0xc4, 0x43, 0xb0, 0x56, 0x7e, 0x51, 0x1a, 0xe2, 0x28, 0x22, 0xbc, 0x14, 0x70,
+	0xbf, 0xbc, 0xfb, 0xae, 0x08, 0x42, 0xe6, 0x6a, 0x69, 0xdd, 0x4b, 0x8d, 0x07, 0x0e, 0x7c, 0x0f,
+	0xf4, 0x1c, 0x62, 0xf1, 0x92, 0x49, 0x88, 0x5a, 0x9d, 0x24, 0xda, 0xba, 0x60, 0xaa, 0xb8, 0x91,
+	0xb9, 0x98, 0xae, 0x73, 0xf4, 0x0c, 0x52, 0x7e, 0x03, 0xb4, 0x83, 0x90, 0x78, 0x34, 0xf6, 0x32,
+	0x1d, 0x2f, 0x0c, 0xe0, 0x54, 0xa7, 0x84, 0x23, 0xed, 0x94, 0xf8, 0x94, 0x2a, 0x58, 0x5e, 0xfa,
+	0xb3, 0x2a, 0x58, 0xc0, 0x90, 0x29, 0xf1, 0x29, 0x93, 0xfc, 0xff, 0xa0, 0x33, 0x23, 0x93, 0x80,
+	0xa1, 0xfc, 0xd1, 0x87, 0x9f, 0x80, 0xa5, 0x28, 0xb6, 0x3c, 0xca, 0x39, 0x71, 0x86, 0x98, 0xab,
+	0xdd, 0x5a, 0xe1, 0x68, 0x92, 0x70, 0x4d, 0x10, 0x4e, 0xa3, 0x85, 0x66, 0x16, 0x0b, 0xd3, 0x1e,
+	0x87, 0x1f, 0x81, 0x2e, 0xb6, 0x39, 0x3d, 0x21, 0x43, 0x9c, 0x8b, 0xfd, 0xaf, 0xc8, 0x5f, 0x92,
+	0xe4, 0x2b, 0xf2, 0xdc, 0x39, 0x54, 0x30, 0x77, 0xc4, 0x7a, 0x8f, 0x0f, 0x8e, 0xce, 0x7e, 0xed,
+	0x37, 0xbe, 0x3d, 0xef, 0x37, 0xce, 0xce, 0xfb, 0xca, 0xd3, 0xf3, 0xbe, 0xf2, 0xcb, 0x79, 0x5f,
+	0x79, 0x72, 0xd1, 0x6f, 0x3c, 0xbd, 0xe8, 0x37, 0x7e, 0xbc, 0xe8, 0x37, 0x3e, 0xde, 0xad, 0x3c,
+	0x88, 0xe9, 0x0f, 0x21, 0xb7, 0xd9, 0x68, 0x44, 0x6d, 0x8a, 0x5d, 0xb9, 0x36, 0xca, 0x5f, 0x50,
+	0xb2, 0xf7, 0xd1, 0x6a, 0x65, 0x09, 0xbd, 0xf5, 0xc7, 0x00, 0xda, 0x2c, 0x44, 0x35, 0x60, 0x11,
+	0x00, 0x00,
 }
 
 func (m *SurplusBiddings) Marshal() (dAtA []byte, err error) {
@@ -244,6 +385,26 @@ func (m *SurplusBiddings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Filled.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBiddings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBiddings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
 	if m.AppId != 0 {
 		i = encodeVarintBiddings(dAtA, i, uint64(m.AppId))
 		i--
@@ -261,12 +422,12 @@ func (m *SurplusBiddings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x42
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BiddingTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BiddingTimestamp):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BiddingTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BiddingTimestamp):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintBiddings(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintBiddings(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x3a
 	{
@@ -336,6 +497,26 @@ func (m *DebtBiddings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Filled.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBiddings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBiddings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
 	if m.AppId != 0 {
 		i = encodeVarintBiddings(dAtA, i, uint64(m.AppId))
 		i--
@@ -353,12 +534,12 @@ func (m *DebtBiddings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x42
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BiddingTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BiddingTimestamp):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BiddingTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BiddingTimestamp):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintBiddings(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintBiddings(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x3a
 	{
@@ -445,12 +626,12 @@ func (m *DutchBiddings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x42
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BiddingTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BiddingTimestamp):])
-	if err7 != nil {
-		return 0, err7
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BiddingTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BiddingTimestamp):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n7
-	i = encodeVarintBiddings(dAtA, i, uint64(n7))
+	i -= n9
+	i = encodeVarintBiddings(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.Bidder) > 0 {
@@ -500,6 +681,202 @@ func (m *DutchBiddings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SealedBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BiddingTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BiddingTimestamp):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintBiddings(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBiddings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.Quantity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBiddings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBiddings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintBiddings(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintBiddings(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AuctionMappingId != 0 {
+		i = encodeVarintBiddings(dAtA, i, uint64(m.AuctionMappingId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AppId != 0 {
+		i = encodeVarintBiddings(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintBiddings(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BiddingId != 0 {
+		i = encodeVarintBiddings(dAtA, i, uint64(m.BiddingId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PreBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ActiveAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ActiveAt):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintBiddings(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x52
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintBiddings(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.Filled.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBiddings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBiddings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Premium != 0 {
+		i = encodeVarintBiddings(dAtA, i, uint64(m.Premium))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintBiddings(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DebtAssetId != 0 {
+		i = encodeVarintBiddings(dAtA, i, uint64(m.DebtAssetId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CollateralAssetId != 0 {
+		i = encodeVarintBiddings(dAtA, i, uint64(m.CollateralAssetId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintBiddings(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintBiddings(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBiddings(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBiddings(v)
 	base := offset
@@ -547,6 +924,10 @@ func (m *SurplusBiddings) Size() (n int) {
 	if m.AppId != 0 {
 		n += 1 + sovBiddings(uint64(m.AppId))
 	}
+	l = m.Price.Size()
+	n += 1 + l + sovBiddings(uint64(l))
+	l = m.Filled.Size()
+	n += 1 + l + sovBiddings(uint64(l))
 	return n
 }
 
@@ -586,6 +967,10 @@ func (m *DebtBiddings) Size() (n int) {
 	if m.AppId != 0 {
 		n += 1 + sovBiddings(uint64(m.AppId))
 	}
+	l = m.Price.Size()
+	n += 1 + l + sovBiddings(uint64(l))
+	l = m.Filled.Size()
+	n += 1 + l + sovBiddings(uint64(l))
 	return n
 }
 
@@ -628,15 +1013,91 @@ func (m *DutchBiddings) Size() (n int) {
 	return n
 }
 
-func sovBiddings(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBiddings(x uint64) (n int) {
-	return sovBiddings(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *SealedBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BiddingId != 0 {
+		n += 1 + sovBiddings(uint64(m.BiddingId))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovBiddings(uint64(m.AuctionId))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovBiddings(uint64(m.AppId))
+	}
+	if m.AuctionMappingId != 0 {
+		n += 1 + sovBiddings(uint64(m.AuctionMappingId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovBiddings(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovBiddings(uint64(l))
+	}
+	l = m.Escrow.Size()
+	n += 1 + l + sovBiddings(uint64(l))
+	if m.Revealed {
+		n += 2
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovBiddings(uint64(l))
+	l = m.Payment.Size()
+	n += 1 + l + sovBiddings(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BiddingTimestamp)
+	n += 1 + l + sovBiddings(uint64(l))
+	return n
 }
-func (m *SurplusBiddings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *PreBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBiddings(uint64(m.Id))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovBiddings(uint64(m.AppId))
+	}
+	if m.CollateralAssetId != 0 {
+		n += 1 + sovBiddings(uint64(m.CollateralAssetId))
+	}
+	if m.DebtAssetId != 0 {
+		n += 1 + sovBiddings(uint64(m.DebtAssetId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovBiddings(uint64(l))
+	}
+	if m.Premium != 0 {
+		n += 1 + sovBiddings(uint64(m.Premium))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBiddings(uint64(l))
+	l = m.Filled.Size()
+	n += 1 + l + sovBiddings(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt)
+	n += 1 + l + sovBiddings(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ActiveAt)
+	n += 1 + l + sovBiddings(uint64(l))
+	return n
+}
+
+func sovBiddings(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBiddings(x uint64) (n int) {
+	return sovBiddings(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SurplusBiddings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -934,6 +1395,73 @@ func (m *SurplusBiddings) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBiddings(dAtA[iNdEx:])
@@ -998,16 +1526,763 @@ func (m *DebtBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BiddingId |= uint64(b&0x7F) << shift
+				m.BiddingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutflowTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BiddingTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BiddingStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			}
+			m.AuctionMappingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBiddings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBiddings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchBiddings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchBiddings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingId", wireType)
+			}
+			m.BiddingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BiddingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokenAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutflowTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowTokenAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflowTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BiddingTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BiddingStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			}
+			m.AuctionMappingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBiddings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealedBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBiddings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealedBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealedBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingId", wireType)
+			}
+			m.BiddingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BiddingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBiddings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
 			}
-			m.AuctionId = 0
+			m.AuctionMappingId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1017,14 +2292,14 @@ func (m *DebtBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1052,13 +2327,13 @@ func (m *DebtBiddings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuctionStatus = string(dAtA[iNdEx:postIndex])
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1068,30 +2343,31 @@ func (m *DebtBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBiddings
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBiddings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutflowTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1101,29 +2377,30 @@ func (m *DebtBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBiddings
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBiddings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1133,28 +2410,15 @@ func (m *DebtBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBiddings
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBiddings
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			m.Revealed = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BiddingTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1181,15 +2445,15 @@ func (m *DebtBiddings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BiddingTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BiddingStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1199,29 +2463,30 @@ func (m *DebtBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBiddings
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBiddings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BiddingStatus = string(dAtA[iNdEx:postIndex])
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingTimestamp", wireType)
 			}
-			m.AuctionMappingId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1231,30 +2496,25 @@ func (m *DebtBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthBiddings
 			}
-			m.AppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBiddings
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BiddingTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBiddings(dAtA[iNdEx:])
@@ -1276,7 +2536,7 @@ func (m *DebtBiddings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
+func (m *PreBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1299,17 +2559,17 @@ func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DutchBiddings: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DutchBiddings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BiddingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.BiddingId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1319,16 +2579,16 @@ func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BiddingId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			m.AuctionId = 0
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1338,16 +2598,16 @@ func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStatus", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAssetId", wireType)
 			}
-			var stringLen uint64
+			m.CollateralAssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1357,29 +2617,16 @@ func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CollateralAssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBiddings
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBiddings
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AuctionStatus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutflowTokenAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAssetId", wireType)
 			}
-			var msglen int
+			m.DebtAssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1389,30 +2636,16 @@ func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DebtAssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBiddings
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBiddings
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OutflowTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflowTokenAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1422,30 +2655,29 @@ func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBiddings
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBiddings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflowTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Premium", wireType)
 			}
-			var stringLen uint64
+			m.Premium = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1455,27 +2687,14 @@ func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Premium |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBiddings
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBiddings
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BiddingTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1502,15 +2721,15 @@ func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BiddingTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BiddingStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1520,29 +2739,30 @@ func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBiddings
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBiddings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BiddingStatus = string(dAtA[iNdEx:postIndex])
+			if err := m.Filled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
 			}
-			m.AuctionMappingId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1552,16 +2772,30 @@ func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmittedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveAt", wireType)
 			}
-			m.AppId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBiddings
@@ -1571,11 +2805,25 @@ func (m *DutchBiddings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBiddings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ActiveAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBiddings(dAtA[iNdEx:])
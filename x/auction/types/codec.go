@@ -6,6 +6,8 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
@@ -13,6 +15,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPlaceDebtBidRequest{}, "comdex/auction/MsgPlaceDebtBidRequest", nil)
 	cdc.RegisterConcrete(&MsgPlaceDutchBidRequest{}, "comdex/auction/MsgPlaceDutchBidRequest", nil)
 	cdc.RegisterConcrete(&MsgPlaceDutchLendBidRequest{}, "comdex/auction/MsgPlaceDutchLendBidRequest", nil)
+	cdc.RegisterConcrete(&MsgCommitDutchBidRequest{}, "comdex/auction/MsgCommitDutchBidRequest", nil)
+	cdc.RegisterConcrete(&MsgRevealDutchBidRequest{}, "comdex/auction/MsgRevealDutchBidRequest", nil)
+	cdc.RegisterConcrete(&MsgSubmitPreBidRequest{}, "comdex/auction/MsgSubmitPreBidRequest", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPreBidRequest{}, "comdex/auction/MsgWithdrawPreBidRequest", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -22,6 +28,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgPlaceDebtBidRequest{},
 		&MsgPlaceDutchBidRequest{},
 		&MsgPlaceDutchLendBidRequest{},
+		&MsgCommitDutchBidRequest{},
+		&MsgRevealDutchBidRequest{},
+		&MsgSubmitPreBidRequest{},
+		&MsgWithdrawPreBidRequest{},
+	)
+	registry.RegisterImplementations(
+		(*assettypes.RiskParameter)(nil),
+		&AuctionParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
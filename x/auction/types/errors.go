@@ -28,4 +28,20 @@ var (
 	ErrorInvalidAuctionParams         = sdkerrors.Register(ModuleName, 219, "auction params not found for given app id")
 	ErrorInStartDutchAuction          = sdkerrors.Register(ModuleName, 220, "error in start dutch auction for locked vault id")
 	ErrorAssetRates                   = sdkerrors.Register(ModuleName, 221, "error in asset rates")
+	ErrorInvalidBidQuantity           = sdkerrors.Register(ModuleName, 222, "invalid bid quantity")
+	ErrorSealedAuction                = sdkerrors.Register(ModuleName, 223, "auction only accepts sealed bids")
+	ErrorNotSealedAuction             = sdkerrors.Register(ModuleName, 224, "auction does not accept sealed bids")
+	ErrorCommitWindowClosed           = sdkerrors.Register(ModuleName, 225, "commit window of auction is closed")
+	ErrorRevealWindowClosed           = sdkerrors.Register(ModuleName, 226, "reveal window of auction is not open")
+	ErrorInvalidCommitment            = sdkerrors.Register(ModuleName, 227, "revealed bid does not match commitment")
+	ErrorSealedBidNotFound            = sdkerrors.Register(ModuleName, 228, "sealed bid not found")
+	ErrorSealedBidRevealed            = sdkerrors.Register(ModuleName, 229, "sealed bid already revealed")
+	ErrorInvalidSealedBidParams       = sdkerrors.Register(ModuleName, 230, "sealed bid auctions need commit and reveal durations")
+	ErrorPreBidNotFound               = sdkerrors.Register(ModuleName, 231, "pre-bid not found")
+	ErrorInvalidPreBidPremium         = sdkerrors.Register(ModuleName, 232, "pre-bid premium above the highest premium slot")
+	ErrorInvalidPreBidDenom           = sdkerrors.Register(ModuleName, 233, "pre-bid denom is not the debt asset")
+	ErrorPreBidUnauthorized           = sdkerrors.Register(ModuleName, 234, "pre-bid belongs to another bidder")
+	ErrorBatchAuctionFull             = sdkerrors.Register(ModuleName, 235, "auction holds the maximum number of bids, a bid has to fill out another")
+	ErrorSealedBidLendApp             = sdkerrors.Register(ModuleName, 236, "sealed bids are not supported on lend auctions of the app")
+	ErrorPreBidTooSmall               = sdkerrors.Register(ModuleName, 237, "pre-bid amount below the minimum")
 )
@@ -7,6 +7,18 @@ const (
 	EventTypeRestartLendDutchErr   = "restart_lend_dutch_err"
 	EventTypeDutchNewAuction       = "dutch_new_auction"
 	EventTypeLendDutchNewAuction   = "lend_dutch_new_auction"
+	EventTypeBadDebtRecorded       = "bad_debt_recorded"
+	EventTypeBadDebtCovered        = "bad_debt_covered"
+	EventTypeSealedBidCommit       = "sealed_bid_commit"
+	EventTypeSealedBidReveal       = "sealed_bid_reveal"
+	EventTypeSealedBidSlash        = "sealed_bid_slash"
+	EventTypeSealedBidSettle       = "sealed_bid_settle"
+	EventTypeSealedBidRefund       = "sealed_bid_refund"
+	EventTypeAuctionHistoryPruned  = "auction_history_pruned"
+	EventTypePreBidSubmit          = "pre_bid_submit"
+	EventTypePreBidWithdraw        = "pre_bid_withdraw"
+	EventTypePreBidFill            = "pre_bid_fill"
+	EventTypePreBidRefund          = "pre_bid_refund"
 	AttributeKeyOwner              = "vault_owner"
 	AttributeKeyCollateral         = "collateral_token"
 	AttributeKeyDebt               = "debt_token"
@@ -14,6 +26,16 @@ const (
 	AttributeKeyEndTime            = "end_time"
 	DataAppID                      = "data_app_id"
 	DataAssetID                    = "data_asset_id"
+	DataPoolID                     = "data_pool_id"
+	DataBadDebtStep                = "data_bad_debt_step"
+	DataAmount                     = "data_amount"
+	DataAuctionID                  = "data_auction_id"
+	DataBiddingID                  = "data_bidding_id"
+	DataBidder                     = "data_bidder"
+	DataQuantity                   = "data_quantity"
+	DataCount                      = "data_count"
+	DataPreBidID                   = "data_pre_bid_id"
+	DataPremium                    = "data_premium"
 	DataAssetOutOraclePrice        = "data_asset_out_oracle_price"
 	DataAssetOutPrice              = "data_asset_out_price"
 	DatIsAuctionActive             = "data_is_auction_active"
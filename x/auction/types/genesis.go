@@ -1,6 +1,6 @@
 package types
 
-func NewGenesisState(surplusAuction []SurplusAuction, debtAuction []DebtAuction, dutchAuction []DutchAuction, protocolStatistics []ProtocolStatistics, auctionParams []AuctionParams, dutchLendAuction []DutchAuction, params Params, userBiddingID uint64) *GenesisState {
+func NewGenesisState(surplusAuction []SurplusAuction, debtAuction []DebtAuction, dutchAuction []DutchAuction, protocolStatistics []ProtocolStatistics, auctionParams []AuctionParams, dutchLendAuction []DutchAuction, params Params, userBiddingID uint64, badDebts []BadDebt, sealedBids []SealedBid, auctionStats []AuctionStats, preBids []PreBid, preBidID uint64) *GenesisState {
 	return &GenesisState{
 		SurplusAuction:     surplusAuction,
 		DebtAuction:        debtAuction,
@@ -10,11 +10,16 @@ func NewGenesisState(surplusAuction []SurplusAuction, debtAuction []DebtAuction,
 		DutchLendAuction:   dutchLendAuction,
 		Params:             params,
 		UserBiddingID:      userBiddingID,
+		BadDebts:           badDebts,
+		SealedBids:         sealedBids,
+		AuctionStats:       auctionStats,
+		PreBids:            preBids,
+		PreBidID:           preBidID,
 	}
 }
 
 func DefaultGenesisState() *GenesisState {
-	var UserBiddingID uint64
+	var UserBiddingID, PreBidID uint64
 	return NewGenesisState(
 		[]SurplusAuction{},
 		[]DebtAuction{},
@@ -24,6 +29,11 @@ func DefaultGenesisState() *GenesisState {
 		[]DutchAuction{},
 		DefaultParams(),
 		UserBiddingID,
+		[]BadDebt{},
+		[]SealedBid{},
+		[]AuctionStats{},
+		[]PreBid{},
+		PreBidID,
 	)
 }
 
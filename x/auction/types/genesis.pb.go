@@ -32,6 +32,11 @@ type GenesisState struct {
 	DutchLendAuction   []DutchAuction       `protobuf:"bytes,6,rep,name=dutchLendAuction,proto3" json:"dutchLendAuction" yaml:"dutchLendAuction"`
 	Params             Params               `protobuf:"bytes,7,opt,name=params,proto3" json:"params"`
 	UserBiddingID      uint64               `protobuf:"varint,8,opt,name=UserBiddingID,proto3" json:"UserBiddingID,omitempty"`
+	BadDebts           []BadDebt            `protobuf:"bytes,9,rep,name=badDebts,proto3" json:"badDebts" yaml:"badDebts"`
+	SealedBids         []SealedBid          `protobuf:"bytes,10,rep,name=sealedBids,proto3" json:"sealedBids" yaml:"sealedBids"`
+	AuctionStats       []AuctionStats       `protobuf:"bytes,11,rep,name=auctionStats,proto3" json:"auctionStats" yaml:"auctionStats"`
+	PreBids            []PreBid             `protobuf:"bytes,12,rep,name=preBids,proto3" json:"preBids" yaml:"preBids"`
+	PreBidID           uint64               `protobuf:"varint,13,opt,name=preBidID,proto3" json:"preBidID,omitempty" yaml:"preBidID"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +128,41 @@ func (m *GenesisState) GetUserBiddingID() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetBadDebts() []BadDebt {
+	if m != nil {
+		return m.BadDebts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSealedBids() []SealedBid {
+	if m != nil {
+		return m.SealedBids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionStats() []AuctionStats {
+	if m != nil {
+		return m.AuctionStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPreBids() []PreBid {
+	if m != nil {
+		return m.PreBids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPreBidID() uint64 {
+	if m != nil {
+		return m.PreBidID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "comdex.auction.v1beta1.GenesisState")
 }
@@ -132,36 +172,44 @@ func init() {
 }
 
 var fileDescriptor_49088f171dd3086d = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x1c, 0x86, 0x1b, 0x56, 0x0a, 0x72, 0x37, 0x84, 0xcc, 0x80, 0x50, 0x20, 0x2d, 0x5e, 0x87, 0x2a,
-	0x24, 0x12, 0x75, 0xdc, 0x10, 0x97, 0x45, 0x93, 0x10, 0x82, 0xc3, 0xe4, 0x89, 0x0b, 0x37, 0x27,
-	0xf1, 0x32, 0x4b, 0x4d, 0x1c, 0x62, 0x07, 0xb1, 0x03, 0x77, 0x8e, 0x7c, 0xac, 0x1d, 0x77, 0xe4,
-	0x34, 0xa1, 0xf6, 0x1b, 0xf0, 0x09, 0x50, 0x6c, 0x47, 0x4b, 0x68, 0x7d, 0xd8, 0x2d, 0x7f, 0x5e,
-	0x3f, 0x4f, 0x7e, 0x6f, 0x6c, 0x30, 0x8d, 0x79, 0x96, 0xd0, 0xef, 0x01, 0xa9, 0x62, 0xc9, 0x78,
-	0x1e, 0x7c, 0x9b, 0x47, 0x54, 0x92, 0x79, 0x90, 0xd2, 0x9c, 0x0a, 0x26, 0xfc, 0xa2, 0xe4, 0x92,
-	0xc3, 0x47, 0x3a, 0xe5, 0x9b, 0x94, 0x6f, 0x52, 0xa3, 0xdd, 0x94, 0xa7, 0x5c, 0x45, 0x82, 0xfa,
-	0x4a, 0xa7, 0x47, 0x7b, 0x16, 0x66, 0x41, 0x4a, 0x92, 0x19, 0xe4, 0xc8, 0x26, 0x6e, 0x14, 0x3a,
-	0xb5, 0x6f, 0x49, 0x45, 0x2c, 0x49, 0x58, 0x9e, 0x1a, 0x18, 0xfa, 0x39, 0x00, 0xdb, 0xef, 0xf5,
-	0x17, 0x9f, 0x48, 0x22, 0x29, 0xcc, 0xc0, 0x3d, 0x51, 0x95, 0xc5, 0xa2, 0x12, 0x87, 0x7a, 0xa5,
-	0xeb, 0x4c, 0xb6, 0x66, 0xc3, 0x83, 0x97, 0xfe, 0xe6, 0x49, 0xfc, 0x93, 0x4e, 0x3a, 0x7c, 0x7e,
-	0x71, 0x35, 0xee, 0xfd, 0xbd, 0x1a, 0x3f, 0x3c, 0x27, 0xd9, 0xe2, 0x2d, 0xea, 0xb2, 0x10, 0xfe,
-	0x0f, 0x0e, 0x09, 0x18, 0x26, 0x34, 0x92, 0x8d, 0xeb, 0x96, 0x72, 0xed, 0xd9, 0x5c, 0x47, 0xd7,
-	0xd1, 0x70, 0x64, 0x44, 0x50, 0x8b, 0x5a, 0x14, 0x84, 0xdb, 0x4c, 0x48, 0xc1, 0x76, 0x52, 0xc9,
-	0xf8, 0xac, 0x71, 0x6c, 0x29, 0xc7, 0xd4, 0xea, 0x68, 0x65, 0xc3, 0xa7, 0x46, 0xf2, 0xc0, 0x48,
-	0x5a, 0xef, 0x10, 0xee, 0x60, 0xe1, 0x0f, 0x00, 0x55, 0xa5, 0x31, 0x5f, 0xd4, 0x4d, 0x32, 0x21,
-	0x59, 0x2c, 0xdc, 0xbe, 0x92, 0xbd, 0xb2, 0xc9, 0x8e, 0xd7, 0x56, 0x84, 0x2f, 0x8c, 0xf2, 0x89,
-	0x56, 0xae, 0x33, 0x11, 0xde, 0x20, 0x82, 0x0c, 0xec, 0x18, 0xf8, 0xb1, 0xda, 0x2c, 0xee, 0x6d,
-	0x65, 0xde, 0xb7, 0x99, 0x0f, 0xdb, 0xe1, 0xf0, 0x99, 0x91, 0xee, 0x6a, 0x69, 0x87, 0x84, 0x70,
-	0x97, 0x0c, 0xbf, 0x82, 0xfb, 0x6a, 0xf2, 0x4f, 0x34, 0x4f, 0x9a, 0x52, 0x07, 0x37, 0x28, 0x75,
-	0x6c, 0x64, 0x8f, 0x5b, 0xa5, 0xb6, 0x58, 0x08, 0xaf, 0xe1, 0xe1, 0x3b, 0x30, 0xd0, 0x67, 0xc0,
-	0xbd, 0x33, 0x71, 0x66, 0xc3, 0x03, 0xcf, 0x5a, 0xa8, 0x9e, 0xa7, 0x5f, 0x2b, 0xb0, 0x59, 0x03,
-	0xa7, 0x60, 0xe7, 0xb3, 0xa0, 0x65, 0xa8, 0xb7, 0xfe, 0x87, 0x23, 0xf7, 0xee, 0xc4, 0x99, 0xf5,
-	0x71, 0xf7, 0x61, 0xf8, 0xf1, 0x62, 0xe9, 0x39, 0x97, 0x4b, 0xcf, 0xf9, 0xb3, 0xf4, 0x9c, 0x5f,
-	0x2b, 0xaf, 0x77, 0xb9, 0xf2, 0x7a, 0xbf, 0x57, 0x5e, 0xef, 0xcb, 0x3c, 0x65, 0xf2, 0xac, 0x8a,
-	0x6a, 0x67, 0xa0, 0xbd, 0xaf, 0xf9, 0xe9, 0x29, 0x8b, 0x19, 0x59, 0x98, 0xfb, 0xe0, 0xfa, 0xa0,
-	0xc9, 0xf3, 0x82, 0x8a, 0x68, 0xa0, 0x7e, 0xd1, 0x9b, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf3,
-	0xf6, 0xde, 0xc2, 0x26, 0x04, 0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0x12, 0x41,
+	0x1c, 0xc7, 0x59, 0x41, 0x4a, 0x07, 0xa8, 0x3a, 0xad, 0xed, 0x16, 0x75, 0xa1, 0x53, 0x6a, 0x88,
+	0x89, 0x6c, 0xa8, 0x37, 0xe3, 0x85, 0x09, 0x89, 0x69, 0xf4, 0x40, 0xa6, 0x7a, 0x31, 0x5e, 0xf6,
+	0xcf, 0x94, 0x4e, 0x02, 0x2c, 0x32, 0x8b, 0xb1, 0x07, 0xdf, 0xc1, 0xa7, 0xf0, 0x59, 0x7a, 0xec,
+	0xd1, 0x53, 0x63, 0xe0, 0x0d, 0x7c, 0x02, 0xb3, 0x33, 0xb3, 0x30, 0x5b, 0x3a, 0x4d, 0x7a, 0x5b,
+	0xd8, 0xef, 0x7c, 0x3e, 0xf3, 0xfb, 0xcd, 0x6f, 0x16, 0x34, 0x83, 0x68, 0x14, 0xd2, 0x1f, 0xae,
+	0x37, 0x0b, 0x62, 0x16, 0x8d, 0xdd, 0xef, 0x1d, 0x9f, 0xc6, 0x5e, 0xc7, 0x1d, 0xd0, 0x31, 0xe5,
+	0x8c, 0xb7, 0x27, 0xd3, 0x28, 0x8e, 0xe0, 0xae, 0x4c, 0xb5, 0x55, 0xaa, 0xad, 0x52, 0xb5, 0x9d,
+	0x41, 0x34, 0x88, 0x44, 0xc4, 0x4d, 0x9e, 0x64, 0xba, 0x76, 0x68, 0x60, 0x4e, 0xbc, 0xa9, 0x37,
+	0x52, 0xc8, 0x9a, 0x49, 0x9c, 0x2a, 0x64, 0xea, 0xc8, 0x90, 0xf2, 0x59, 0x18, 0xb2, 0xf1, 0x40,
+	0xc1, 0xd0, 0xef, 0x4d, 0x50, 0x79, 0x2f, 0x77, 0x7c, 0x1a, 0x7b, 0x31, 0x85, 0x23, 0xb0, 0xc5,
+	0x67, 0xd3, 0xc9, 0x70, 0xc6, 0xbb, 0x72, 0xa5, 0x6d, 0x35, 0xf2, 0xad, 0xf2, 0xf1, 0xcb, 0xf6,
+	0xed, 0x95, 0xb4, 0x4f, 0x33, 0x69, 0xfc, 0xe2, 0xf2, 0xba, 0x9e, 0xfb, 0x77, 0x5d, 0x7f, 0x7a,
+	0xe1, 0x8d, 0x86, 0x6f, 0x51, 0x96, 0x85, 0xc8, 0x0d, 0x38, 0xf4, 0x40, 0x39, 0xa4, 0x7e, 0x9c,
+	0xba, 0x1e, 0x08, 0xd7, 0xa1, 0xc9, 0xd5, 0x5b, 0x45, 0x71, 0x4d, 0x89, 0xa0, 0x14, 0x69, 0x14,
+	0x44, 0x74, 0x26, 0xa4, 0xa0, 0x12, 0xce, 0xe2, 0xe0, 0x3c, 0x75, 0xe4, 0x85, 0xa3, 0x69, 0x74,
+	0x68, 0x59, 0xfc, 0x4c, 0x49, 0xb6, 0x95, 0x44, 0x7b, 0x87, 0x48, 0x06, 0x0b, 0x7f, 0x02, 0x28,
+	0x5a, 0x1a, 0x44, 0xc3, 0xa4, 0x93, 0x8c, 0xc7, 0x2c, 0xe0, 0x76, 0x41, 0xc8, 0x5e, 0x99, 0x64,
+	0xfd, 0xb5, 0x15, 0xf8, 0x40, 0x29, 0xf7, 0xa5, 0x72, 0x9d, 0x89, 0xc8, 0x2d, 0x22, 0xc8, 0x40,
+	0x55, 0xc1, 0xfb, 0x62, 0x58, 0xec, 0x87, 0xc2, 0x7c, 0x64, 0x32, 0x77, 0xf5, 0x30, 0x7e, 0xae,
+	0xa4, 0x3b, 0x52, 0x9a, 0x21, 0x21, 0x92, 0x25, 0xc3, 0x6f, 0xe0, 0xb1, 0xa8, 0xfc, 0x23, 0x1d,
+	0x87, 0x69, 0x53, 0x8b, 0xf7, 0x68, 0x6a, 0x5d, 0xc9, 0xf6, 0xb4, 0xa6, 0x6a, 0x2c, 0x44, 0xd6,
+	0xf0, 0xf0, 0x1d, 0x28, 0xca, 0x3b, 0x60, 0x6f, 0x34, 0xac, 0x56, 0xf9, 0xd8, 0x31, 0x36, 0x54,
+	0xd6, 0x53, 0x48, 0x14, 0x44, 0xad, 0x81, 0x4d, 0x50, 0xfd, 0xcc, 0xe9, 0x14, 0xcb, 0xd1, 0x3f,
+	0xe9, 0xd9, 0xa5, 0x86, 0xd5, 0x2a, 0x90, 0xec, 0x9f, 0xf0, 0x13, 0x28, 0xf9, 0x5e, 0x98, 0x8c,
+	0x18, 0xb7, 0x37, 0x45, 0x39, 0x75, 0x93, 0x05, 0xcb, 0x1c, 0xde, 0x53, 0x95, 0x3c, 0x92, 0x95,
+	0xa4, 0xcb, 0x11, 0x59, 0x92, 0xe0, 0x57, 0x00, 0x38, 0xf5, 0x86, 0x34, 0xc4, 0x2c, 0xe4, 0x36,
+	0x10, 0xdc, 0x03, 0xe3, 0x5d, 0x4a, 0x93, 0x78, 0x5f, 0x91, 0x9f, 0xa8, 0x6b, 0xb4, 0x44, 0x20,
+	0xa2, 0xf1, 0x92, 0xd9, 0x56, 0x8c, 0x64, 0x14, 0xb8, 0x5d, 0xbe, 0xfb, 0x18, 0xba, 0x5a, 0xf6,
+	0xe6, 0x6c, 0xeb, 0x1c, 0x44, 0x32, 0x58, 0xd8, 0x07, 0x1b, 0x93, 0x29, 0x15, 0x15, 0x54, 0x1a,
+	0xf9, 0x3b, 0xfb, 0x2f, 0x62, 0x78, 0x57, 0xb1, 0xb7, 0xd2, 0x21, 0xa6, 0x72, 0xef, 0x29, 0x06,
+	0xba, 0xa0, 0x24, 0x1f, 0x4f, 0x7a, 0x76, 0x35, 0x39, 0x0d, 0xbc, 0xbd, 0xea, 0x63, 0xfa, 0x06,
+	0x91, 0x65, 0x08, 0x7f, 0xb8, 0x9c, 0x3b, 0xd6, 0xd5, 0xdc, 0xb1, 0xfe, 0xce, 0x1d, 0xeb, 0xd7,
+	0xc2, 0xc9, 0x5d, 0x2d, 0x9c, 0xdc, 0x9f, 0x85, 0x93, 0xfb, 0xd2, 0x19, 0xb0, 0xf8, 0x7c, 0xe6,
+	0x27, 0x3b, 0x72, 0xe5, 0xae, 0x5e, 0x47, 0x67, 0x67, 0x2c, 0x60, 0xde, 0x50, 0xfd, 0x76, 0x57,
+	0x9f, 0xc1, 0xf8, 0x62, 0x42, 0xb9, 0x5f, 0x14, 0x17, 0xe8, 0xcd, 0xff, 0x01, 0x00, 0x25, 0x40,
+	0xa0, 0xee, 0xc4, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -184,6 +232,67 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PreBidID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PreBidID))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.PreBids) > 0 {
+		for iNdEx := len(m.PreBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AuctionStats) > 0 {
+		for iNdEx := len(m.AuctionStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.SealedBids) > 0 {
+		for iNdEx := len(m.SealedBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SealedBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BadDebts) > 0 {
+		for iNdEx := len(m.BadDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BadDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.UserBiddingID != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.UserBiddingID))
 		i--
@@ -344,6 +453,33 @@ func (m *GenesisState) Size() (n int) {
 	if m.UserBiddingID != 0 {
 		n += 1 + sovGenesis(uint64(m.UserBiddingID))
 	}
+	if len(m.BadDebts) > 0 {
+		for _, e := range m.BadDebts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SealedBids) > 0 {
+		for _, e := range m.SealedBids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuctionStats) > 0 {
+		for _, e := range m.AuctionStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PreBids) > 0 {
+		for _, e := range m.PreBids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PreBidID != 0 {
+		n += 1 + sovGenesis(uint64(m.PreBidID))
+	}
 	return n
 }
 
@@ -638,6 +774,161 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BadDebts = append(m.BadDebts, BadDebt{})
+			if err := m.BadDebts[len(m.BadDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealedBids = append(m.SealedBids, SealedBid{})
+			if err := m.SealedBids[len(m.SealedBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionStats = append(m.AuctionStats, AuctionStats{})
+			if err := m.AuctionStats[len(m.AuctionStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreBids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreBids = append(m.PreBids, PreBid{})
+			if err := m.PreBids[len(m.PreBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreBidID", wireType)
+			}
+			m.PreBidID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreBidID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -32,6 +34,7 @@ const (
 	SurplusString                = "surplus"
 	DebtString                   = "debt"
 	DutchString                  = "dutch"
+	DutchLendString              = "dutch_lend"
 )
 
 var (
@@ -48,6 +51,13 @@ var (
 	LendUserKeyPrefix           = []byte{0x21}
 	LendHistoryAuctionKeyPrefix = []byte{0x22}
 	LendHistoryUserKeyPrefix    = []byte{0x23}
+	BadDebtKeyPrefix            = []byte{0x24}
+	SealedBidKeyPrefix          = []byte{0x25}
+	HistoryIndexKeyPrefix       = []byte{0x26}
+	AuctionStatsKeyPrefix       = []byte{0x27}
+	PreBidKeyPrefix             = []byte{0x28}
+	PreBidQueueKeyPrefix        = []byte{0x29}
+	PreBidIDKey                 = []byte{0x30}
 )
 
 func AuctionKey(appID uint64, auctionType string, auctionID uint64) []byte {
@@ -125,3 +135,51 @@ func HistoryLendUserAuctionTypeKey(bidder string, appID uint64, auctionType stri
 func HistoryLendAuctionTypeKey(appID uint64, auctionType string) []byte {
 	return append(append(LendHistoryAuctionKeyPrefix, sdk.Uint64ToBigEndian(appID)...), auctionType...)
 }
+
+func BadDebtKey(appID, poolID, assetID uint64) []byte {
+	return append(append(append(BadDebtKeyPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(poolID)...), sdk.Uint64ToBigEndian(assetID)...)
+}
+
+func BadDebtAppIDKey(appID uint64) []byte {
+	return append(BadDebtKeyPrefix, sdk.Uint64ToBigEndian(appID)...)
+}
+
+func SealedBidKey(appID, auctionID, biddingID uint64) []byte {
+	return append(append(append(SealedBidKeyPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(auctionID)...), sdk.Uint64ToBigEndian(biddingID)...)
+}
+
+func SealedBidAuctionKey(appID, auctionID uint64) []byte {
+	return append(append(SealedBidKeyPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(auctionID)...)
+}
+
+func HistoryIndexTimeKey(closedAt time.Time) []byte {
+	return append(HistoryIndexKeyPrefix, sdk.FormatTimeBytes(closedAt)...)
+}
+
+func HistoryIndexKey(index AuctionHistoryIndex) []byte {
+	lend := []byte{0}
+	if index.Lend {
+		lend = []byte{1}
+	}
+	return append(append(append(append(HistoryIndexTimeKey(index.ClosedAt), sdk.Uint64ToBigEndian(index.AppId)...), lend...), index.AuctionType...), sdk.Uint64ToBigEndian(index.AuctionId)...)
+}
+
+func AuctionStatsKey(appID, assetID uint64) []byte {
+	return append(append(AuctionStatsKeyPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(assetID)...)
+}
+
+func AuctionStatsAppKey(appID uint64) []byte {
+	return append(AuctionStatsKeyPrefix, sdk.Uint64ToBigEndian(appID)...)
+}
+
+func PreBidKey(id uint64) []byte {
+	return append(PreBidKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func PreBidQueuePairKey(appID, collateralAssetID, debtAssetID uint64) []byte {
+	return append(append(append(PreBidQueueKeyPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(collateralAssetID)...), sdk.Uint64ToBigEndian(debtAssetID)...)
+}
+
+func PreBidQueueKey(appID, collateralAssetID, debtAssetID, premium, id uint64) []byte {
+	return append(append(PreBidQueuePairKey(appID, collateralAssetID, debtAssetID), sdk.Uint64ToBigEndian(premium)...), sdk.Uint64ToBigEndian(id)...)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,10 @@ var (
 	_ sdk.Msg = (*MsgPlaceDebtBidRequest)(nil)
 	_ sdk.Msg = (*MsgPlaceDutchBidRequest)(nil)
 	_ sdk.Msg = (*MsgPlaceDutchLendBidRequest)(nil)
+	_ sdk.Msg = (*MsgCommitDutchBidRequest)(nil)
+	_ sdk.Msg = (*MsgRevealDutchBidRequest)(nil)
+	_ sdk.Msg = (*MsgSubmitPreBidRequest)(nil)
+	_ sdk.Msg = (*MsgWithdrawPreBidRequest)(nil)
 )
 
 const (
@@ -19,15 +24,20 @@ const (
 	TypeMsgPlaceDebtBidRequest      = "place_debt_bid"
 	TypeMsgPlaceDutchBidRequest     = "place_dutch_bid"
 	TypeMsgPlaceDutchLendBidRequest = "place_dutch_lend_bid"
+	TypeMsgCommitDutchBidRequest    = "commit_dutch_bid"
+	TypeMsgRevealDutchBidRequest    = "reveal_dutch_bid"
+	TypeMsgSubmitPreBidRequest      = "submit_pre_bid"
+	TypeMsgWithdrawPreBidRequest    = "withdraw_pre_bid"
 )
 
-func NewMsgPlaceSurplusBid(from string, auctionID uint64, amt sdk.Coin, appID, auctionMappingID uint64) *MsgPlaceSurplusBidRequest {
+func NewMsgPlaceSurplusBid(from string, auctionID uint64, amt, quantity sdk.Coin, appID, auctionMappingID uint64) *MsgPlaceSurplusBidRequest {
 	return &MsgPlaceSurplusBidRequest{
 		Bidder:           from,
 		AuctionId:        auctionID,
 		Amount:           amt,
 		AppId:            appID,
 		AuctionMappingId: auctionMappingID,
+		Quantity:         quantity,
 	}
 }
 
@@ -45,6 +55,9 @@ func (m MsgPlaceSurplusBidRequest) ValidateBasic() error {
 	if !m.Amount.IsValid() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bid amount %s", m.Amount)
 	}
+	if m.Quantity.Denom != "" && (!m.Quantity.IsValid() || m.Quantity.IsZero()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bid quantity %s", m.Quantity)
+	}
 	return nil
 }
 
@@ -172,3 +185,184 @@ func (m MsgPlaceDutchLendBidRequest) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{from}
 }
+
+func NewMsgCommitDutchBid(from string, auctionID uint64, commitment []byte, escrow sdk.Coin, appID, auctionMappingID uint64) *MsgCommitDutchBidRequest {
+	return &MsgCommitDutchBidRequest{
+		Bidder:           from,
+		AuctionId:        auctionID,
+		Commitment:       commitment,
+		Escrow:           escrow,
+		AppId:            appID,
+		AuctionMappingId: auctionMappingID,
+	}
+}
+
+func (m MsgCommitDutchBidRequest) Route() string { return RouterKey }
+func (m MsgCommitDutchBidRequest) Type() string  { return TypeMsgCommitDutchBidRequest }
+
+func (m MsgCommitDutchBidRequest) ValidateBasic() error {
+	if m.AuctionId == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Bidder)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "--from address cannot be empty or invalid")
+	}
+	if len(m.Commitment) != sha256.Size {
+		return sdkerrors.Wrapf(ErrorInvalidCommitment, "commitment must be %d bytes", sha256.Size)
+	}
+	if !m.Escrow.IsValid() || m.Escrow.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "escrow %s", m.Escrow)
+	}
+	return nil
+}
+
+func (m MsgCommitDutchBidRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCommitDutchBidRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Bidder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevealDutchBid(from string, auctionID, biddingID uint64, quantity, payment sdk.Coin, salt string, appID, auctionMappingID uint64) *MsgRevealDutchBidRequest {
+	return &MsgRevealDutchBidRequest{
+		Bidder:           from,
+		AuctionId:        auctionID,
+		BiddingId:        biddingID,
+		Quantity:         quantity,
+		Payment:          payment,
+		Salt:             salt,
+		AppId:            appID,
+		AuctionMappingId: auctionMappingID,
+	}
+}
+
+func (m MsgRevealDutchBidRequest) Route() string { return RouterKey }
+func (m MsgRevealDutchBidRequest) Type() string  { return TypeMsgRevealDutchBidRequest }
+
+func (m MsgRevealDutchBidRequest) ValidateBasic() error {
+	if m.AuctionId == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	if m.BiddingId == 0 {
+		return errors.New("bidding id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Bidder)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "--from address cannot be empty or invalid")
+	}
+	if !m.Quantity.IsValid() || m.Quantity.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bid quantity %s", m.Quantity)
+	}
+	if !m.Payment.IsValid() || m.Payment.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bid payment %s", m.Payment)
+	}
+	return nil
+}
+
+func (m MsgRevealDutchBidRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRevealDutchBidRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Bidder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgSubmitPreBid(from string, appID, collateralAssetID, debtAssetID, premium uint64, amount sdk.Coin) *MsgSubmitPreBidRequest {
+	return &MsgSubmitPreBidRequest{
+		Bidder:            from,
+		AppId:             appID,
+		CollateralAssetId: collateralAssetID,
+		DebtAssetId:       debtAssetID,
+		Premium:           premium,
+		Amount:            amount,
+	}
+}
+
+func (m MsgSubmitPreBidRequest) Route() string { return RouterKey }
+func (m MsgSubmitPreBidRequest) Type() string  { return TypeMsgSubmitPreBidRequest }
+
+func (m MsgSubmitPreBidRequest) ValidateBasic() error {
+	if m.AppId == 0 {
+		return errors.New("app id cannot be zero")
+	}
+	if m.CollateralAssetId == 0 || m.DebtAssetId == 0 {
+		return errors.New("asset id cannot be zero")
+	}
+	if m.CollateralAssetId == m.DebtAssetId {
+		return errors.New("collateral and debt asset cannot be the same")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Bidder)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "--from address cannot be empty or invalid")
+	}
+	if m.Premium >= 100 {
+		return sdkerrors.Wrapf(ErrorInvalidPreBidPremium, "premium %d", m.Premium)
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "pre-bid amount %s", m.Amount)
+	}
+	return nil
+}
+
+func (m MsgSubmitPreBidRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSubmitPreBidRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Bidder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgWithdrawPreBid(from string, preBidID uint64, amount sdk.Coin) *MsgWithdrawPreBidRequest {
+	return &MsgWithdrawPreBidRequest{
+		Bidder:   from,
+		PreBidId: preBidID,
+		Amount:   amount,
+	}
+}
+
+func (m MsgWithdrawPreBidRequest) Route() string { return RouterKey }
+func (m MsgWithdrawPreBidRequest) Type() string  { return TypeMsgWithdrawPreBidRequest }
+
+func (m MsgWithdrawPreBidRequest) ValidateBasic() error {
+	if m.PreBidId == 0 {
+		return errors.New("pre-bid id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Bidder)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "--from address cannot be empty or invalid")
+	}
+	if !m.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "withdraw amount %s", m.Amount)
+	}
+	return nil
+}
+
+func (m MsgWithdrawPreBidRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgWithdrawPreBidRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Bidder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
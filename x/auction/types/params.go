@@ -1,37 +1,84 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// Auction params default values.
+var (
+	DefaultHistoryRetentionSeconds = uint64(0)
+	DefaultHistoryPruneLimit       = uint64(100)
+	DefaultPreBidActivationSeconds = uint64(600)
+	DefaultPreBidMaxPremium        = uint64(30)
+	DefaultPreBidMinAmount         = uint64(100000)
+)
+
+var (
+	KeyHistoryRetentionSeconds = []byte("HistoryRetentionSeconds")
+	KeyHistoryPruneLimit       = []byte("HistoryPruneLimit")
+	KeyPreBidActivationSeconds = []byte("PreBidActivationSeconds")
+	KeyPreBidMaxPremium        = []byte("PreBidMaxPremium")
+	KeyPreBidMinAmount         = []byte("PreBidMinAmount")
+)
+
 const (
 	DutchBidGas     = sdk.Gas(31183)
 	DebtBidGas      = sdk.Gas(27580)
 	DutchLendBidGas = sdk.Gas(31183)
 	SurplusBidGas   = sdk.Gas(27580)
+	// BatchBidGas is charged for every bid already placed on a surplus or
+	// debt auction, which a new bid is cleared against.
+	BatchBidGas = sdk.Gas(2000)
 )
 
+// MaxBatchBidsPerAuction caps the bids placed on a surplus or debt auction.
+// A bid asks for at least the lot over this cap, so that many bids cover it.
+const MaxBatchBidsPerAuction = 20
+
+// MaxUnwoundCollateralESMRestarts is how many times a dutch auction of
+// collateral unwound from pool coins is restarted after the emergency
+// shutdown, before the debt it left is covered as bad debt.
+const MaxUnwoundCollateralESMRestarts = 3
+
+// MaxPreBidsPerAuction caps the pre-bids a dutch auction tries to fill from
+// as it starts, lowest premium first.
+const MaxPreBidsPerAuction = 20
+
 // ParamKeyTable the param key table for launch module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(historyRetentionSeconds, historyPruneLimit, preBidActivationSeconds, preBidMaxPremium, preBidMinAmount uint64) Params {
+	return Params{
+		HistoryRetentionSeconds: historyRetentionSeconds,
+		HistoryPruneLimit:       historyPruneLimit,
+		PreBidActivationSeconds: preBidActivationSeconds,
+		PreBidMaxPremium:        preBidMaxPremium,
+		PreBidMinAmount:         preBidMinAmount,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultHistoryRetentionSeconds, DefaultHistoryPruneLimit, DefaultPreBidActivationSeconds, DefaultPreBidMaxPremium, DefaultPreBidMinAmount)
 }
 
 // ParamSetPairs get the params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyHistoryRetentionSeconds, &p.HistoryRetentionSeconds, validateHistoryRetentionSeconds),
+		paramtypes.NewParamSetPair(KeyHistoryPruneLimit, &p.HistoryPruneLimit, validateHistoryPruneLimit),
+		paramtypes.NewParamSetPair(KeyPreBidActivationSeconds, &p.PreBidActivationSeconds, validatePreBidActivationSeconds),
+		paramtypes.NewParamSetPair(KeyPreBidMaxPremium, &p.PreBidMaxPremium, validatePreBidMaxPremium),
+		paramtypes.NewParamSetPair(KeyPreBidMinAmount, &p.PreBidMinAmount, validatePreBidMinAmount),
+	}
 }
 
 // Validate validates the set of params.
@@ -39,10 +86,71 @@ func (p Params) Validate() error {
 	for _, v := range []struct {
 		value     interface{}
 		validator func(interface{}) error
-	}{} {
+	}{
+		{p.HistoryRetentionSeconds, validateHistoryRetentionSeconds},
+		{p.HistoryPruneLimit, validateHistoryPruneLimit},
+		{p.PreBidActivationSeconds, validatePreBidActivationSeconds},
+		{p.PreBidMaxPremium, validatePreBidMaxPremium},
+		{p.PreBidMinAmount, validatePreBidMinAmount},
+	} {
 		if err := v.validator(v.value); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+func validateHistoryRetentionSeconds(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateHistoryPruneLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("history prune limit must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validatePreBidActivationSeconds(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePreBidMaxPremium(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v >= 100 {
+		return fmt.Errorf("pre-bid max premium must be less than 100 percent: %d", v)
+	}
+
+	return nil
+}
+
+func validatePreBidMinAmount(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("pre-bid min amount must be positive: %d", v)
+	}
+
+	return nil
+}
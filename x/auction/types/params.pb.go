@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -23,6 +24,21 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Params struct {
+	// history_retention_seconds is how long closed auctions and their bids are
+	// kept in history, zero keeps them forever.
+	HistoryRetentionSeconds uint64 `protobuf:"varint,1,opt,name=history_retention_seconds,json=historyRetentionSeconds,proto3" json:"history_retention_seconds,omitempty" yaml:"history_retention_seconds"`
+	// history_prune_limit is the most closed auctions pruned from history in a
+	// block.
+	HistoryPruneLimit uint64 `protobuf:"varint,2,opt,name=history_prune_limit,json=historyPruneLimit,proto3" json:"history_prune_limit,omitempty" yaml:"history_prune_limit"`
+	// pre_bid_activation_seconds is how long after submission pre-bids start to
+	// fill liquidations.
+	PreBidActivationSeconds uint64 `protobuf:"varint,3,opt,name=pre_bid_activation_seconds,json=preBidActivationSeconds,proto3" json:"pre_bid_activation_seconds,omitempty" yaml:"pre_bid_activation_seconds"`
+	// pre_bid_max_premium is the highest premium slot of the pre-bid queues, in
+	// percent.
+	PreBidMaxPremium uint64 `protobuf:"varint,4,opt,name=pre_bid_max_premium,json=preBidMaxPremium,proto3" json:"pre_bid_max_premium,omitempty" yaml:"pre_bid_max_premium"`
+	// pre_bid_min_amount is the least debt token a pre-bid holds, in base
+	// units.
+	PreBidMinAmount uint64 `protobuf:"varint,5,opt,name=pre_bid_min_amount,json=preBidMinAmount,proto3" json:"pre_bid_min_amount,omitempty" yaml:"pre_bid_min_amount"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -58,6 +74,41 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetHistoryRetentionSeconds() uint64 {
+	if m != nil {
+		return m.HistoryRetentionSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetHistoryPruneLimit() uint64 {
+	if m != nil {
+		return m.HistoryPruneLimit
+	}
+	return 0
+}
+
+func (m *Params) GetPreBidActivationSeconds() uint64 {
+	if m != nil {
+		return m.PreBidActivationSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetPreBidMaxPremium() uint64 {
+	if m != nil {
+		return m.PreBidMaxPremium
+	}
+	return 0
+}
+
+func (m *Params) GetPreBidMinAmount() uint64 {
+	if m != nil {
+		return m.PreBidMinAmount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "comdex.auction.v1beta1.Params")
 }
@@ -67,17 +118,30 @@ func init() {
 }
 
 var fileDescriptor_4370eec7f59a9a46 = []byte{
-	// 145 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0xcf, 0x4d,
-	0x49, 0xad, 0xd0, 0x4f, 0x2c, 0x4d, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x83, 0x28, 0xd2, 0x83, 0x2a, 0xd2, 0x83, 0x2a, 0x52, 0xe2, 0xe0, 0x62, 0x0b, 0x00, 0xab,
-	0x73, 0xf2, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xc3, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x88, 0x31, 0xba, 0xf9, 0x69, 0x69, 0x99,
-	0xc9, 0x99, 0x89, 0x39, 0x50, 0xbe, 0x3e, 0xc2, 0xf6, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0xb0, 0xad, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x3e, 0xe4, 0x24, 0x9c, 0x00, 0x00,
-	0x00,
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x4e, 0xc2, 0x40,
+	0x18, 0xc7, 0xa9, 0x20, 0x43, 0x17, 0xb5, 0x18, 0x29, 0x24, 0xb6, 0x58, 0x35, 0x71, 0xb1, 0x0d,
+	0x71, 0x73, 0x83, 0x51, 0xc5, 0x90, 0xba, 0xb9, 0xd4, 0x6b, 0x7b, 0xc0, 0x25, 0x5c, 0xef, 0x72,
+	0xbd, 0x12, 0x78, 0x0b, 0x5f, 0xc7, 0x37, 0x70, 0x64, 0x74, 0x6a, 0x0c, 0xbc, 0x41, 0x9f, 0xc0,
+	0xf4, 0xae, 0x45, 0x21, 0xb2, 0xdd, 0xfd, 0xbf, 0xdf, 0xff, 0x97, 0x6f, 0xf8, 0xd4, 0xcb, 0x80,
+	0xe0, 0x10, 0xce, 0x1d, 0x90, 0x04, 0x1c, 0x91, 0xc8, 0x99, 0x75, 0x7d, 0xc8, 0x41, 0xd7, 0xa1,
+	0x80, 0x01, 0x1c, 0xdb, 0x94, 0x11, 0x4e, 0xb4, 0x33, 0x09, 0xd9, 0x05, 0x64, 0x17, 0x50, 0xfb,
+	0x74, 0x4c, 0xc6, 0x44, 0x20, 0x4e, 0xfe, 0x92, 0xb4, 0xf5, 0x51, 0x55, 0xeb, 0x43, 0x51, 0xd7,
+	0xde, 0xd4, 0xd6, 0x04, 0xc5, 0x9c, 0xb0, 0x85, 0xc7, 0x20, 0x87, 0x51, 0xde, 0xf6, 0x62, 0x18,
+	0x90, 0x28, 0x8c, 0x75, 0xa5, 0xa3, 0xdc, 0xd4, 0xfa, 0x57, 0x59, 0x6a, 0x76, 0x16, 0x00, 0x4f,
+	0xef, 0xad, 0xbd, 0xa8, 0xe5, 0x36, 0x8b, 0x99, 0x5b, 0x8e, 0x5e, 0xe4, 0x44, 0x7b, 0x56, 0x1b,
+	0x65, 0x8d, 0xb2, 0x24, 0x82, 0xde, 0x14, 0x61, 0xc4, 0xf5, 0x03, 0xe1, 0x36, 0xb2, 0xd4, 0x6c,
+	0x6f, 0xbb, 0xff, 0x40, 0x96, 0x7b, 0x52, 0xa4, 0xc3, 0x3c, 0x7c, 0xca, 0x33, 0xcd, 0x57, 0xdb,
+	0x94, 0x41, 0xcf, 0x47, 0xa1, 0x07, 0x02, 0x8e, 0x66, 0x60, 0x6b, 0xe5, 0xaa, 0xd0, 0x5e, 0x67,
+	0xa9, 0x79, 0x21, 0xb5, 0xfb, 0x59, 0xcb, 0x6d, 0x52, 0x06, 0xfb, 0x28, 0xec, 0x6d, 0x46, 0xe5,
+	0xce, 0x03, 0xb5, 0x51, 0xf6, 0x30, 0x98, 0x7b, 0x94, 0x41, 0x8c, 0x12, 0xac, 0xd7, 0x76, 0x77,
+	0xfe, 0x07, 0xb2, 0xdc, 0x63, 0x69, 0x1d, 0x80, 0xf9, 0x50, 0x46, 0xda, 0x83, 0xaa, 0x6d, 0x48,
+	0x14, 0x79, 0x00, 0x93, 0x24, 0xe2, 0xfa, 0xa1, 0xb0, 0x9d, 0x67, 0xa9, 0xd9, 0xda, 0xb1, 0x6d,
+	0x18, 0xcb, 0x3d, 0x2a, 0x64, 0x28, 0xea, 0x89, 0xa4, 0xff, 0xf8, 0xb9, 0x32, 0x94, 0xe5, 0xca,
+	0x50, 0xbe, 0x57, 0x86, 0xf2, 0xbe, 0x36, 0x2a, 0xcb, 0xb5, 0x51, 0xf9, 0x5a, 0x1b, 0x95, 0xd7,
+	0xee, 0x18, 0xf1, 0x49, 0xe2, 0xdb, 0x01, 0xc1, 0x8e, 0x3c, 0x87, 0x5b, 0x32, 0x1a, 0xa1, 0x00,
+	0x81, 0x69, 0xf1, 0x77, 0x7e, 0xaf, 0x88, 0x2f, 0x28, 0x8c, 0xfd, 0xba, 0xb8, 0x87, 0xbb, 0x9f,
+	0x01, 0x00, 0x23, 0x38, 0x25, 0x75, 0x64, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -100,6 +164,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PreBidMinAmount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PreBidMinAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PreBidMaxPremium != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PreBidMaxPremium))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreBidActivationSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PreBidActivationSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HistoryPruneLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HistoryPruneLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HistoryRetentionSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HistoryRetentionSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -120,6 +209,21 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.HistoryRetentionSeconds != 0 {
+		n += 1 + sovParams(uint64(m.HistoryRetentionSeconds))
+	}
+	if m.HistoryPruneLimit != 0 {
+		n += 1 + sovParams(uint64(m.HistoryPruneLimit))
+	}
+	if m.PreBidActivationSeconds != 0 {
+		n += 1 + sovParams(uint64(m.PreBidActivationSeconds))
+	}
+	if m.PreBidMaxPremium != 0 {
+		n += 1 + sovParams(uint64(m.PreBidMaxPremium))
+	}
+	if m.PreBidMinAmount != 0 {
+		n += 1 + sovParams(uint64(m.PreBidMinAmount))
+	}
 	return n
 }
 
@@ -158,6 +262,101 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetentionSeconds", wireType)
+			}
+			m.HistoryRetentionSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetentionSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryPruneLimit", wireType)
+			}
+			m.HistoryPruneLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryPruneLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreBidActivationSeconds", wireType)
+			}
+			m.PreBidActivationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreBidActivationSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreBidMaxPremium", wireType)
+			}
+			m.PreBidMaxPremium = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreBidMaxPremium |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreBidMinAmount", wireType)
+			}
+			m.PreBidMinAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreBidMinAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
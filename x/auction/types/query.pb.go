@@ -6,10 +6,13 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -17,12 +20,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -887,6 +892,82 @@ func (m *QueryProtocolStatisticsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryProtocolStatisticsResponse proto.InternalMessageInfo
 
+type QueryBadDebtsRequest struct {
+	AppId      uint64             `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty" yaml:"pagination"`
+}
+
+func (m *QueryBadDebtsRequest) Reset()         { *m = QueryBadDebtsRequest{} }
+func (m *QueryBadDebtsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBadDebtsRequest) ProtoMessage()    {}
+func (*QueryBadDebtsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{22}
+}
+func (m *QueryBadDebtsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBadDebtsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBadDebtsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBadDebtsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBadDebtsRequest.Merge(m, src)
+}
+func (m *QueryBadDebtsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBadDebtsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBadDebtsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBadDebtsRequest proto.InternalMessageInfo
+
+type QueryBadDebtsResponse struct {
+	BadDebts   []BadDebt           `protobuf:"bytes,1,rep,name=bad_debts,json=badDebts,proto3" json:"bad_debts" yaml:"bad_debts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty" yaml:"pagination"`
+}
+
+func (m *QueryBadDebtsResponse) Reset()         { *m = QueryBadDebtsResponse{} }
+func (m *QueryBadDebtsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBadDebtsResponse) ProtoMessage()    {}
+func (*QueryBadDebtsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{23}
+}
+func (m *QueryBadDebtsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBadDebtsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBadDebtsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBadDebtsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBadDebtsResponse.Merge(m, src)
+}
+func (m *QueryBadDebtsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBadDebtsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBadDebtsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBadDebtsResponse proto.InternalMessageInfo
+
 type QueryGenericAuctionParamRequest struct {
 	AppId uint64 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
 }
@@ -895,7 +976,7 @@ func (m *QueryGenericAuctionParamRequest) Reset()         { *m = QueryGenericAuc
 func (m *QueryGenericAuctionParamRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGenericAuctionParamRequest) ProtoMessage()    {}
 func (*QueryGenericAuctionParamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ff4a64a3f291f95, []int{22}
+	return fileDescriptor_5ff4a64a3f291f95, []int{24}
 }
 func (m *QueryGenericAuctionParamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,7 +1013,7 @@ func (m *QueryGenericAuctionParamResponse) Reset()         { *m = QueryGenericAu
 func (m *QueryGenericAuctionParamResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGenericAuctionParamResponse) ProtoMessage()    {}
 func (*QueryGenericAuctionParamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ff4a64a3f291f95, []int{23}
+	return fileDescriptor_5ff4a64a3f291f95, []int{25}
 }
 func (m *QueryGenericAuctionParamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,7 +1053,7 @@ func (m *QueryDutchLendAuctionRequest) Reset()         { *m = QueryDutchLendAuct
 func (m *QueryDutchLendAuctionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDutchLendAuctionRequest) ProtoMessage()    {}
 func (*QueryDutchLendAuctionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ff4a64a3f291f95, []int{24}
+	return fileDescriptor_5ff4a64a3f291f95, []int{26}
 }
 func (m *QueryDutchLendAuctionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1009,7 +1090,7 @@ func (m *QueryDutchLendAuctionResponse) Reset()         { *m = QueryDutchLendAuc
 func (m *QueryDutchLendAuctionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDutchLendAuctionResponse) ProtoMessage()    {}
 func (*QueryDutchLendAuctionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ff4a64a3f291f95, []int{25}
+	return fileDescriptor_5ff4a64a3f291f95, []int{27}
 }
 func (m *QueryDutchLendAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1048,7 +1129,7 @@ func (m *QueryDutchLendAuctionsRequest) Reset()         { *m = QueryDutchLendAuc
 func (m *QueryDutchLendAuctionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDutchLendAuctionsRequest) ProtoMessage()    {}
 func (*QueryDutchLendAuctionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ff4a64a3f291f95, []int{26}
+	return fileDescriptor_5ff4a64a3f291f95, []int{28}
 }
 func (m *QueryDutchLendAuctionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1086,7 +1167,7 @@ func (m *QueryDutchLendAuctionsResponse) Reset()         { *m = QueryDutchLendAu
 func (m *QueryDutchLendAuctionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDutchLendAuctionsResponse) ProtoMessage()    {}
 func (*QueryDutchLendAuctionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ff4a64a3f291f95, []int{27}
+	return fileDescriptor_5ff4a64a3f291f95, []int{29}
 }
 func (m *QueryDutchLendAuctionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1126,7 +1207,7 @@ func (m *QueryDutchLendBiddingsRequest) Reset()         { *m = QueryDutchLendBid
 func (m *QueryDutchLendBiddingsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDutchLendBiddingsRequest) ProtoMessage()    {}
 func (*QueryDutchLendBiddingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ff4a64a3f291f95, []int{28}
+	return fileDescriptor_5ff4a64a3f291f95, []int{30}
 }
 func (m *QueryDutchLendBiddingsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1165,7 +1246,7 @@ func (m *QueryDutchLendBiddingsResponse) Reset()         { *m = QueryDutchLendBi
 func (m *QueryDutchLendBiddingsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDutchLendBiddingsResponse) ProtoMessage()    {}
 func (*QueryDutchLendBiddingsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ff4a64a3f291f95, []int{29}
+	return fileDescriptor_5ff4a64a3f291f95, []int{31}
 }
 func (m *QueryDutchLendBiddingsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1205,7 +1286,7 @@ func (m *QueryFilterDutchAuctionsRequest) Reset()         { *m = QueryFilterDutc
 func (m *QueryFilterDutchAuctionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFilterDutchAuctionsRequest) ProtoMessage()    {}
 func (*QueryFilterDutchAuctionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ff4a64a3f291f95, []int{30}
+	return fileDescriptor_5ff4a64a3f291f95, []int{32}
 }
 func (m *QueryFilterDutchAuctionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1324,7 @@ func (m *QueryFilterDutchAuctionsResponse) Reset()         { *m = QueryFilterDut
 func (m *QueryFilterDutchAuctionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFilterDutchAuctionsResponse) ProtoMessage()    {}
 func (*QueryFilterDutchAuctionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ff4a64a3f291f95, []int{31}
+	return fileDescriptor_5ff4a64a3f291f95, []int{33}
 }
 func (m *QueryFilterDutchAuctionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1272,1099 +1353,1629 @@ func (m *QueryFilterDutchAuctionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryFilterDutchAuctionsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QuerySurplusAuctionRequest)(nil), "comdex.auction.v1beta1.QuerySurplusAuctionRequest")
-	proto.RegisterType((*QuerySurplusAuctionResponse)(nil), "comdex.auction.v1beta1.QuerySurplusAuctionResponse")
-	proto.RegisterType((*QuerySurplusAuctionsRequest)(nil), "comdex.auction.v1beta1.QuerySurplusAuctionsRequest")
-	proto.RegisterType((*QuerySurplusAuctionsResponse)(nil), "comdex.auction.v1beta1.QuerySurplusAuctionsResponse")
-	proto.RegisterType((*QuerySurplusBiddingsRequest)(nil), "comdex.auction.v1beta1.QuerySurplusBiddingsRequest")
-	proto.RegisterType((*QuerySurplusBiddingsResponse)(nil), "comdex.auction.v1beta1.QuerySurplusBiddingsResponse")
-	proto.RegisterType((*QueryDebtAuctionRequest)(nil), "comdex.auction.v1beta1.QueryDebtAuctionRequest")
-	proto.RegisterType((*QueryDebtAuctionResponse)(nil), "comdex.auction.v1beta1.QueryDebtAuctionResponse")
-	proto.RegisterType((*QueryDebtAuctionsRequest)(nil), "comdex.auction.v1beta1.QueryDebtAuctionsRequest")
-	proto.RegisterType((*QueryDebtAuctionsResponse)(nil), "comdex.auction.v1beta1.QueryDebtAuctionsResponse")
-	proto.RegisterType((*QueryDebtBiddingsRequest)(nil), "comdex.auction.v1beta1.QueryDebtBiddingsRequest")
-	proto.RegisterType((*QueryDebtBiddingsResponse)(nil), "comdex.auction.v1beta1.QueryDebtBiddingsResponse")
-	proto.RegisterType((*QueryDutchAuctionRequest)(nil), "comdex.auction.v1beta1.QueryDutchAuctionRequest")
-	proto.RegisterType((*QueryDutchAuctionResponse)(nil), "comdex.auction.v1beta1.QueryDutchAuctionResponse")
-	proto.RegisterType((*QueryDutchAuctionsRequest)(nil), "comdex.auction.v1beta1.QueryDutchAuctionsRequest")
-	proto.RegisterType((*QueryDutchAuctionsResponse)(nil), "comdex.auction.v1beta1.QueryDutchAuctionsResponse")
-	proto.RegisterType((*QueryDutchBiddingsRequest)(nil), "comdex.auction.v1beta1.QueryDutchBiddingsRequest")
-	proto.RegisterType((*QueryDutchBiddingsResponse)(nil), "comdex.auction.v1beta1.QueryDutchBiddingsResponse")
-	proto.RegisterType((*QueryBiddingsForSurplusAuctionRequest)(nil), "comdex.auction.v1beta1.QueryBiddingsForSurplusAuctionRequest")
-	proto.RegisterType((*QueryBiddingsForSurplusAuctionResponse)(nil), "comdex.auction.v1beta1.QueryBiddingsForSurplusAuctionResponse")
-	proto.RegisterType((*QueryProtocolStatisticsRequest)(nil), "comdex.auction.v1beta1.QueryProtocolStatisticsRequest")
-	proto.RegisterType((*QueryProtocolStatisticsResponse)(nil), "comdex.auction.v1beta1.QueryProtocolStatisticsResponse")
-	proto.RegisterType((*QueryGenericAuctionParamRequest)(nil), "comdex.auction.v1beta1.QueryGenericAuctionParamRequest")
-	proto.RegisterType((*QueryGenericAuctionParamResponse)(nil), "comdex.auction.v1beta1.QueryGenericAuctionParamResponse")
-	proto.RegisterType((*QueryDutchLendAuctionRequest)(nil), "comdex.auction.v1beta1.QueryDutchLendAuctionRequest")
-	proto.RegisterType((*QueryDutchLendAuctionResponse)(nil), "comdex.auction.v1beta1.QueryDutchLendAuctionResponse")
-	proto.RegisterType((*QueryDutchLendAuctionsRequest)(nil), "comdex.auction.v1beta1.QueryDutchLendAuctionsRequest")
-	proto.RegisterType((*QueryDutchLendAuctionsResponse)(nil), "comdex.auction.v1beta1.QueryDutchLendAuctionsResponse")
-	proto.RegisterType((*QueryDutchLendBiddingsRequest)(nil), "comdex.auction.v1beta1.QueryDutchLendBiddingsRequest")
-	proto.RegisterType((*QueryDutchLendBiddingsResponse)(nil), "comdex.auction.v1beta1.QueryDutchLendBiddingsResponse")
-	proto.RegisterType((*QueryFilterDutchAuctionsRequest)(nil), "comdex.auction.v1beta1.QueryFilterDutchAuctionsRequest")
-	proto.RegisterType((*QueryFilterDutchAuctionsResponse)(nil), "comdex.auction.v1beta1.QueryFilterDutchAuctionsResponse")
+// QueryAuctionHistoryRequest filters the closed auctions of an app. An empty
+// auction type lists surplus, debt, dutch and dutch_lend auctions, the vault
+// owner and locked vault filters only match dutch auctions. The time range is
+// on the time the auctions closed, a zero end time leaves it open.
+type QueryAuctionHistoryRequest struct {
+	AppId         uint64             `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AuctionType   string             `protobuf:"bytes,2,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	AssetId       uint64             `protobuf:"varint,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	VaultOwner    string             `protobuf:"bytes,4,opt,name=vault_owner,json=vaultOwner,proto3" json:"vault_owner,omitempty"`
+	LockedVaultId uint64             `protobuf:"varint,5,opt,name=locked_vault_id,json=lockedVaultId,proto3" json:"locked_vault_id,omitempty"`
+	StartTime     time.Time          `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime       time.Time          `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	Pagination    *query.PageRequest `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty" yaml:"pagination"`
+}
+
+func (m *QueryAuctionHistoryRequest) Reset()         { *m = QueryAuctionHistoryRequest{} }
+func (m *QueryAuctionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionHistoryRequest) ProtoMessage()    {}
+func (*QueryAuctionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{34}
+}
+func (m *QueryAuctionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterFile("comdex/auction/v1beta1/query.proto", fileDescriptor_5ff4a64a3f291f95)
+func (m *QueryAuctionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-var fileDescriptor_5ff4a64a3f291f95 = []byte{
-	// 1478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x5d, 0x4f, 0x1c, 0x55,
-	0x18, 0xde, 0xb3, 0x7c, 0x14, 0x8e, 0xa9, 0x96, 0x23, 0xd0, 0x65, 0x2d, 0x0b, 0x8e, 0xa5, 0xad,
-	0x8d, 0xee, 0x16, 0x5a, 0x29, 0x92, 0x46, 0xdb, 0x6d, 0x4b, 0x4b, 0xb4, 0x06, 0x97, 0x68, 0x0a,
-	0x88, 0x38, 0xbb, 0x33, 0x2c, 0x63, 0x76, 0x77, 0xa6, 0x3b, 0xb3, 0x2a, 0x21, 0x5c, 0xf8, 0x91,
-	0x78, 0x67, 0x4c, 0x4c, 0xd4, 0x18, 0x7f, 0x80, 0xd1, 0x18, 0xaf, 0xbd, 0xf0, 0x06, 0x53, 0xcb,
-	0x4d, 0x0d, 0x89, 0xd1, 0x9a, 0x34, 0x25, 0x0a, 0xc6, 0x1b, 0xef, 0xf8, 0x05, 0x66, 0xce, 0x9c,
-	0x33, 0x3b, 0x5f, 0x67, 0x3e, 0x02, 0xc4, 0x21, 0xde, 0xed, 0xcc, 0x9e, 0xf7, 0x9d, 0xe7, 0x79,
-	0x9f, 0x77, 0x9e, 0x99, 0xf7, 0x0c, 0xe4, 0x4a, 0x72, 0x55, 0x10, 0xdf, 0xc9, 0xf1, 0x8d, 0x92,
-	0x26, 0xc9, 0xb5, 0xdc, 0x5b, 0xc3, 0x45, 0x51, 0xe3, 0x87, 0x73, 0xb7, 0x1a, 0x62, 0x7d, 0x39,
-	0xab, 0xd4, 0x65, 0x4d, 0x46, 0xbd, 0xc6, 0x9a, 0x2c, 0x59, 0x93, 0x25, 0x6b, 0xd2, 0xdd, 0x65,
-	0xb9, 0x2c, 0xe3, 0x25, 0x39, 0xfd, 0x97, 0xb1, 0x3a, 0x7d, 0xac, 0x2c, 0xcb, 0xe5, 0x8a, 0x98,
-	0xe3, 0x15, 0x29, 0xc7, 0xd7, 0x6a, 0xb2, 0xc6, 0xeb, 0x41, 0x2a, 0xf9, 0xf7, 0x38, 0xe3, 0x7a,
-	0x34, 0xb7, 0xb1, 0x6a, 0x88, 0xb1, 0xaa, 0x28, 0x09, 0x82, 0x54, 0x2b, 0xd3, 0x64, 0xa7, 0x4b,
-	0xb2, 0x5a, 0x95, 0xd5, 0x5c, 0x91, 0x57, 0x45, 0x03, 0xb1, 0xb9, 0x52, 0xe1, 0xcb, 0x52, 0x8d,
-	0x6f, 0xa6, 0xe4, 0xbe, 0x00, 0x30, 0xfd, 0xb2, 0xbe, 0x64, 0xba, 0x51, 0x57, 0x2a, 0x0d, 0xf5,
-	0x92, 0x91, 0xba, 0x20, 0xde, 0x6a, 0x88, 0xaa, 0x86, 0x7a, 0x60, 0x3b, 0xaf, 0x28, 0x0b, 0x92,
-	0x90, 0x02, 0x83, 0xe0, 0x54, 0x6b, 0xa1, 0x8d, 0x57, 0x94, 0x49, 0x01, 0x3d, 0x05, 0x11, 0xc1,
-	0xb0, 0x50, 0xe5, 0x15, 0x45, 0xaa, 0x95, 0xf5, 0x25, 0x49, 0xbc, 0xe4, 0x08, 0xf9, 0xe7, 0x86,
-	0xf1, 0xc7, 0xa4, 0x80, 0xfa, 0x21, 0xa4, 0xab, 0x25, 0x21, 0xd5, 0x82, 0x57, 0x75, 0x92, 0x33,
-	0x93, 0x02, 0x4a, 0xc1, 0x43, 0x4b, 0x92, 0xaa, 0xc9, 0xf5, 0xe5, 0x54, 0xeb, 0x20, 0x38, 0xd5,
-	0x51, 0xa0, 0x87, 0xdc, 0xdb, 0xf0, 0x31, 0x4f, 0x6c, 0xaa, 0x22, 0xd7, 0x54, 0x11, 0xdd, 0x84,
-	0x87, 0x48, 0x16, 0x8c, 0xee, 0xa1, 0x91, 0x13, 0x59, 0x6f, 0x49, 0xb2, 0xf6, 0x04, 0xf9, 0xde,
-	0xf5, 0xcd, 0x81, 0xc4, 0xce, 0xe6, 0xc0, 0xc3, 0xcb, 0x7c, 0xb5, 0x32, 0xce, 0x91, 0xd5, 0x5c,
-	0x81, 0xa6, 0xe3, 0xbe, 0x05, 0x9e, 0x57, 0x56, 0x03, 0xca, 0x62, 0x61, 0x92, 0xb4, 0x31, 0x41,
-	0xf3, 0x10, 0x36, 0x4b, 0x8f, 0x4b, 0x60, 0xa0, 0xd5, 0x75, 0xca, 0xea, 0x3a, 0x65, 0x8d, 0xce,
-	0xa2, 0x80, 0xa7, 0xf8, 0xb2, 0x48, 0x2e, 0x96, 0xef, 0xd9, 0xd9, 0x1c, 0xe8, 0x32, 0x90, 0x36,
-	0x73, 0x70, 0x05, 0x4b, 0x42, 0xee, 0x3e, 0x80, 0xc7, 0xbc, 0xf1, 0x92, 0x52, 0xcd, 0xc1, 0x0e,
-	0xc2, 0x4d, 0x4d, 0x81, 0xc1, 0x96, 0x08, 0xb5, 0x3a, 0x4a, 0x6a, 0xf5, 0x88, 0xad, 0x56, 0x2a,
-	0x57, 0x30, 0x13, 0xa2, 0xd7, 0x6d, 0xe4, 0x92, 0x98, 0xdc, 0xc9, 0x40, 0x72, 0x06, 0xb2, 0x30,
-	0xec, 0x6e, 0x3b, 0xd4, 0xc8, 0x93, 0x76, 0xa7, 0x6a, 0xf4, 0xc2, 0x76, 0xfd, 0x0e, 0x10, 0xeb,
-	0x58, 0x8d, 0xce, 0x02, 0x39, 0xb2, 0xa8, 0x94, 0x64, 0xa8, 0xd4, 0xe2, 0xa7, 0x52, 0xeb, 0x5e,
-	0xab, 0xf4, 0x61, 0xd2, 0xae, 0x52, 0x93, 0x07, 0x51, 0xe9, 0x49, 0x3b, 0x91, 0x7c, 0xd7, 0xce,
-	0xe6, 0xc0, 0x61, 0x23, 0xa7, 0x71, 0x9e, 0x33, 0xb9, 0xbd, 0x06, 0x3b, 0xe8, 0x5d, 0x9f, 0x4a,
-	0x62, 0x41, 0x4f, 0x06, 0x08, 0x4a, 0xaf, 0xe6, 0x54, 0x94, 0xa6, 0xe1, 0x0a, 0x66, 0x46, 0x87,
-	0xa2, 0x2d, 0x7b, 0xae, 0xe8, 0x67, 0x00, 0x1e, 0xc5, 0x95, 0xb8, 0x22, 0x16, 0xb5, 0x58, 0x59,
-	0xce, 0x06, 0x80, 0x29, 0x37, 0x32, 0xa2, 0xcf, 0x2b, 0x4e, 0xc3, 0x79, 0x82, 0x55, 0x73, 0x4b,
-	0x74, 0xa0, 0xdb, 0xec, 0xb7, 0x39, 0x7c, 0xed, 0x41, 0x29, 0xb6, 0x4e, 0xf6, 0x2b, 0x80, 0x7d,
-	0x1e, 0x60, 0x4d, 0xc7, 0x77, 0xda, 0x58, 0x28, 0x05, 0x62, 0xe0, 0x61, 0x6b, 0x56, 0x11, 0x0e,
-	0xaa, 0x81, 0xbd, 0x9b, 0xb4, 0x88, 0xb3, 0x1b, 0xf7, 0x9a, 0x71, 0xb9, 0xd7, 0x71, 0x3f, 0x1d,
-	0xe3, 0x64, 0x5d, 0x9f, 0x9b, 0x42, 0x36, 0xb4, 0xd2, 0x52, 0xac, 0xbc, 0x4b, 0xa5, 0xea, 0xd8,
-	0x90, 0x11, 0x75, 0x5e, 0x75, 0x7a, 0x17, 0xbb, 0xe2, 0x96, 0xf0, 0xe0, 0x57, 0xa5, 0x6f, 0x80,
-	0xc7, 0x55, 0x63, 0x6b, 0x2f, 0xf7, 0xe8, 0xeb, 0xae, 0x03, 0x2d, 0x29, 0xd2, 0x8c, 0xcb, 0x5f,
-	0xc2, 0x55, 0x29, 0x06, 0x06, 0xf3, 0xa3, 0x4d, 0x87, 0x83, 0xea, 0x30, 0xef, 0x27, 0xad, 0xfa,
-	0xec, 0xc6, 0x62, 0x66, 0x5d, 0x16, 0x33, 0xe4, 0x2b, 0x65, 0x9c, 0x3c, 0xe6, 0x83, 0x24, 0x1c,
-	0xc2, 0x55, 0xa0, 0xa0, 0x26, 0xe4, 0x7a, 0x0c, 0xe7, 0x33, 0x47, 0x33, 0xb4, 0xed, 0x75, 0x33,
-	0xfc, 0x0d, 0xe0, 0x89, 0xa0, 0x32, 0x90, 0xc6, 0xb0, 0xbe, 0x0e, 0x83, 0x7d, 0x7e, 0x1d, 0xde,
-	0xfb, 0x7b, 0xf7, 0x53, 0x00, 0x33, 0x98, 0xe8, 0x94, 0x3e, 0x93, 0x97, 0xe4, 0xca, 0xb4, 0xc6,
-	0x6b, 0x92, 0xaa, 0x49, 0xa5, 0x20, 0x23, 0x9d, 0xf7, 0x40, 0xb6, 0xb7, 0x0a, 0x0c, 0x30, 0x81,
-	0x91, 0xd2, 0x97, 0x60, 0x9b, 0xaa, 0xf1, 0x1a, 0xad, 0xfb, 0x69, 0x56, 0xdd, 0xdd, 0x29, 0xf2,
-	0x8f, 0x93, 0xd2, 0xf7, 0x19, 0x28, 0xdc, 0x2b, 0xb8, 0x82, 0x91, 0x7b, 0xdf, 0x15, 0x18, 0x23,
-	0x3c, 0xaf, 0x89, 0x35, 0xb1, 0x2e, 0x95, 0x48, 0x7b, 0x4d, 0xf1, 0x75, 0xbe, 0xea, 0xaf, 0x00,
-	0xf7, 0x11, 0x80, 0x83, 0xec, 0x50, 0x52, 0xa3, 0x37, 0xe1, 0x61, 0xde, 0x72, 0x5e, 0x25, 0x8f,
-	0x60, 0xa6, 0x23, 0x59, 0x93, 0xa8, 0xf9, 0x7e, 0x52, 0xa6, 0x1e, 0xdb, 0xd3, 0x65, 0x41, 0xc1,
-	0xff, 0x72, 0x05, 0x7b, 0x6a, 0xee, 0x4b, 0xba, 0x17, 0x80, 0x6d, 0xed, 0x45, 0xb1, 0x26, 0xc4,
-	0x6c, 0x4f, 0xa7, 0x9f, 0x81, 0x6e, 0x9f, 0x5f, 0x54, 0xbe, 0x03, 0x8c, 0x2b, 0xc7, 0x79, 0x57,
-	0x27, 0xc3, 0x42, 0x7c, 0xf0, 0x5f, 0x58, 0xee, 0xb8, 0xf4, 0x38, 0xc0, 0xfb, 0x3a, 0x19, 0x16,
-	0x93, 0xff, 0xd7, 0x8b, 0xcb, 0x1d, 0xfa, 0xbc, 0x98, 0x90, 0x2a, 0x9a, 0x58, 0x8f, 0x32, 0x12,
-	0x74, 0xc3, 0x36, 0x41, 0xac, 0xc9, 0x55, 0xcc, 0xb9, 0xb3, 0x60, 0x1c, 0xfc, 0x77, 0x9a, 0x3e,
-	0xa0, 0xb6, 0xee, 0xc9, 0xe4, 0xc0, 0xdf, 0x7d, 0x23, 0x77, 0x53, 0xb0, 0x0d, 0xf3, 0x43, 0xdb,
-	0x00, 0x3e, 0xea, 0xb1, 0x77, 0x8c, 0x46, 0x58, 0x54, 0xd8, 0x9f, 0x0b, 0xd2, 0x67, 0x23, 0xc5,
-	0x18, 0x68, 0xb9, 0xd2, 0x7b, 0xbf, 0xfc, 0xf5, 0x49, 0x72, 0x1e, 0xcd, 0xe5, 0x18, 0x9f, 0x37,
-	0x54, 0x23, 0x8e, 0x9e, 0x5e, 0x31, 0xda, 0x67, 0x35, 0xb7, 0xe2, 0x7e, 0x5e, 0x59, 0x4e, 0xe2,
-	0x03, 0xd2, 0x2d, 0xab, 0xe8, 0x36, 0x80, 0xdd, 0x5e, 0x3b, 0xe4, 0x28, 0x0a, 0x64, 0xda, 0xc3,
-	0xe9, 0x73, 0xd1, 0x82, 0x08, 0xd1, 0x3c, 0x26, 0x7a, 0x01, 0x8d, 0x87, 0x23, 0xaa, 0x5a, 0x98,
-	0x9a, 0x3c, 0x7e, 0x76, 0xf0, 0xa0, 0x77, 0x7f, 0x38, 0x1e, 0x0e, 0x87, 0x0d, 0xc7, 0xc3, 0x69,
-	0x66, 0xdc, 0x0b, 0x98, 0xc7, 0x55, 0x74, 0x39, 0x80, 0x07, 0xb5, 0x9d, 0xdc, 0x8a, 0x61, 0x6d,
-	0xab, 0x5e, 0x84, 0xee, 0x01, 0x78, 0xc4, 0xb9, 0xe1, 0x87, 0x72, 0xbe, 0xb8, 0xdc, 0x9b, 0xc6,
-	0xe9, 0x33, 0xe1, 0x03, 0x08, 0x89, 0x37, 0x30, 0x89, 0x59, 0x74, 0x93, 0x45, 0x42, 0x10, 0x8b,
-	0xda, 0xae, 0x5a, 0xee, 0x7b, 0x00, 0xbb, 0x5c, 0x5b, 0x99, 0x28, 0x34, 0x52, 0x53, 0xa4, 0xe1,
-	0x08, 0x11, 0x84, 0xdc, 0xf3, 0x98, 0xdc, 0xb3, 0xe8, 0x7c, 0x08, 0x72, 0x9e, 0x6d, 0xb6, 0x66,
-	0xc5, 0x6e, 0xf6, 0x58, 0x30, 0x76, 0x67, 0x83, 0x0d, 0x47, 0x88, 0x20, 0xd8, 0xaf, 0x63, 0xec,
-	0x79, 0x74, 0xd1, 0x0f, 0x7b, 0xa8, 0xd6, 0xba, 0x6f, 0x92, 0xb0, 0x98, 0x6f, 0x10, 0x09, 0xf7,
-	0xae, 0x5e, 0x10, 0x09, 0x8f, 0xdd, 0x36, 0x8e, 0xc7, 0x24, 0xe6, 0xd0, 0x0c, 0x93, 0x84, 0x1e,
-	0xb5, 0xab, 0xf6, 0xfa, 0x01, 0x40, 0xe4, 0xde, 0xca, 0x42, 0xe1, 0xc1, 0x9a, 0x22, 0x8d, 0x44,
-	0x09, 0x21, 0x04, 0x2f, 0x62, 0x82, 0xe3, 0x68, 0x2c, 0x0c, 0x41, 0xcf, 0x16, 0xfb, 0xc9, 0x86,
-	0xdf, 0xec, 0xb1, 0x10, 0xf8, 0x9d, 0x4d, 0x36, 0x12, 0x25, 0x84, 0xe0, 0x9f, 0xc4, 0xf8, 0x2f,
-	0xa3, 0x4b, 0xbe, 0xf8, 0x43, 0xb5, 0xd9, 0x1a, 0xfd, 0x98, 0xe5, 0x9e, 0x5f, 0xd1, 0xa8, 0x2f,
-	0x34, 0xe6, 0xb8, 0x9f, 0x3e, 0x1f, 0x39, 0x8e, 0xf0, 0x1a, 0xc5, 0xbc, 0xce, 0xa0, 0x2c, 0x8b,
-	0x97, 0x42, 0x62, 0xf1, 0x5c, 0x6d, 0xd2, 0xd1, 0xd5, 0xe8, 0x63, 0x8d, 0xb1, 0x2a, 0xf2, 0x87,
-	0xc3, 0x1e, 0x9a, 0xd3, 0x63, 0xd1, 0x03, 0xc3, 0x12, 0x21, 0xc7, 0xc6, 0x14, 0xdc, 0x24, 0xf2,
-	0x0f, 0x80, 0x3d, 0x9e, 0x43, 0x13, 0x3a, 0x17, 0xdc, 0x26, 0xee, 0x69, 0x39, 0xfd, 0x4c, 0xc4,
-	0x28, 0x02, 0x5f, 0xc4, 0xf0, 0x17, 0xd0, 0xbc, 0x6f, 0x7f, 0x55, 0xc4, 0x9a, 0xb0, 0x2b, 0x13,
-	0xb8, 0x0b, 0x60, 0xaf, 0xf7, 0x88, 0x88, 0xa2, 0x01, 0x37, 0x3b, 0x6f, 0x34, 0x6a, 0x18, 0x21,
-	0x7c, 0x05, 0x13, 0x7e, 0x0e, 0x5d, 0x08, 0x4b, 0xd8, 0xd3, 0x14, 0x7e, 0x73, 0xf1, 0x31, 0x8d,
-	0x21, 0x24, 0x1f, 0xa7, 0x39, 0x8c, 0x46, 0x0d, 0x23, 0x7c, 0x6e, 0x60, 0x3e, 0xd7, 0xd0, 0xd5,
-	0x40, 0x3e, 0xa1, 0x4c, 0xe2, 0x01, 0xfd, 0x6c, 0xe4, 0x31, 0x4f, 0x04, 0xdc, 0x5e, 0xec, 0x59,
-	0x2a, 0xe0, 0xf6, 0xf2, 0x19, 0x5d, 0xb8, 0x97, 0x30, 0xbd, 0xeb, 0x68, 0x82, 0x45, 0x6f, 0x11,
-	0x07, 0xb3, 0x5c, 0x1c, 0x4f, 0x67, 0x16, 0x7e, 0xf9, 0xe9, 0xf5, 0x3f, 0x33, 0x89, 0xaf, 0xb6,
-	0x32, 0x89, 0xf5, 0xad, 0x0c, 0xd8, 0xd8, 0xca, 0x80, 0x3f, 0xb6, 0x32, 0xe0, 0xe3, 0xed, 0x4c,
-	0x62, 0x63, 0x3b, 0x93, 0xf8, 0x7d, 0x3b, 0x93, 0x98, 0x1d, 0x2e, 0x4b, 0xda, 0x52, 0xa3, 0xa8,
-	0x23, 0x26, 0xd7, 0x7c, 0x5a, 0x5e, 0x5c, 0x94, 0x4a, 0x12, 0x5f, 0xa1, 0x18, 0x9a, 0x28, 0xb4,
-	0x65, 0x45, 0x54, 0x8b, 0xed, 0xd8, 0xac, 0xce, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xd3,
-	0x79, 0x93, 0x8e, 0x25, 0x00, 0x00,
+func (m *QueryAuctionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionHistoryRequest.Merge(m, src)
+}
+func (m *QueryAuctionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionHistoryRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryAuctionHistoryRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	QuerySurplusAuction(ctx context.Context, in *QuerySurplusAuctionRequest, opts ...grpc.CallOption) (*QuerySurplusAuctionResponse, error)
-	QuerySurplusAuctions(ctx context.Context, in *QuerySurplusAuctionsRequest, opts ...grpc.CallOption) (*QuerySurplusAuctionsResponse, error)
-	QuerySurplusBiddings(ctx context.Context, in *QuerySurplusBiddingsRequest, opts ...grpc.CallOption) (*QuerySurplusBiddingsResponse, error)
-	QueryDebtAuction(ctx context.Context, in *QueryDebtAuctionRequest, opts ...grpc.CallOption) (*QueryDebtAuctionResponse, error)
-	QueryDebtAuctions(ctx context.Context, in *QueryDebtAuctionsRequest, opts ...grpc.CallOption) (*QueryDebtAuctionsResponse, error)
-	QueryDebtBiddings(ctx context.Context, in *QueryDebtBiddingsRequest, opts ...grpc.CallOption) (*QueryDebtBiddingsResponse, error)
-	QueryDutchAuction(ctx context.Context, in *QueryDutchAuctionRequest, opts ...grpc.CallOption) (*QueryDutchAuctionResponse, error)
-	QueryDutchAuctions(ctx context.Context, in *QueryDutchAuctionsRequest, opts ...grpc.CallOption) (*QueryDutchAuctionsResponse, error)
-	QueryDutchBiddings(ctx context.Context, in *QueryDutchBiddingsRequest, opts ...grpc.CallOption) (*QueryDutchBiddingsResponse, error)
-	QueryProtocolStatistics(ctx context.Context, in *QueryProtocolStatisticsRequest, opts ...grpc.CallOption) (*QueryProtocolStatisticsResponse, error)
-	QueryGenericAuctionParams(ctx context.Context, in *QueryGenericAuctionParamRequest, opts ...grpc.CallOption) (*QueryGenericAuctionParamResponse, error)
-	QueryDutchLendAuction(ctx context.Context, in *QueryDutchLendAuctionRequest, opts ...grpc.CallOption) (*QueryDutchLendAuctionResponse, error)
-	QueryDutchLendAuctions(ctx context.Context, in *QueryDutchLendAuctionsRequest, opts ...grpc.CallOption) (*QueryDutchLendAuctionsResponse, error)
-	QueryDutchLendBiddings(ctx context.Context, in *QueryDutchLendBiddingsRequest, opts ...grpc.CallOption) (*QueryDutchLendBiddingsResponse, error)
-	QueryFilterDutchAuctions(ctx context.Context, in *QueryFilterDutchAuctionsRequest, opts ...grpc.CallOption) (*QueryFilterDutchAuctionsResponse, error)
+type QueryAuctionHistoryResponse struct {
+	SurplusAuctions   []SurplusAuction    `protobuf:"bytes,1,rep,name=surplus_auctions,json=surplusAuctions,proto3" json:"surplus_auctions" yaml:"surplus_auctions"`
+	DebtAuctions      []DebtAuction       `protobuf:"bytes,2,rep,name=debt_auctions,json=debtAuctions,proto3" json:"debt_auctions" yaml:"debt_auctions"`
+	DutchAuctions     []DutchAuction      `protobuf:"bytes,3,rep,name=dutch_auctions,json=dutchAuctions,proto3" json:"dutch_auctions" yaml:"dutch_auctions"`
+	DutchLendAuctions []DutchAuction      `protobuf:"bytes,4,rep,name=dutch_lend_auctions,json=dutchLendAuctions,proto3" json:"dutch_lend_auctions" yaml:"dutch_lend_auctions"`
+	Pagination        *query.PageResponse `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty" yaml:"pagination"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAuctionHistoryResponse) Reset()         { *m = QueryAuctionHistoryResponse{} }
+func (m *QueryAuctionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionHistoryResponse) ProtoMessage()    {}
+func (*QueryAuctionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{35}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAuctionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) QuerySurplusAuction(ctx context.Context, in *QuerySurplusAuctionRequest, opts ...grpc.CallOption) (*QuerySurplusAuctionResponse, error) {
-	out := new(QuerySurplusAuctionResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QuerySurplusAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) QuerySurplusAuctions(ctx context.Context, in *QuerySurplusAuctionsRequest, opts ...grpc.CallOption) (*QuerySurplusAuctionsResponse, error) {
-	out := new(QuerySurplusAuctionsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QuerySurplusAuctions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAuctionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionHistoryResponse.Merge(m, src)
 }
-
-func (c *queryClient) QuerySurplusBiddings(ctx context.Context, in *QuerySurplusBiddingsRequest, opts ...grpc.CallOption) (*QuerySurplusBiddingsResponse, error) {
-	out := new(QuerySurplusBiddingsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QuerySurplusBiddings", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAuctionHistoryResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) QueryDebtAuction(ctx context.Context, in *QueryDebtAuctionRequest, opts ...grpc.CallOption) (*QueryDebtAuctionResponse, error) {
-	out := new(QueryDebtAuctionResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDebtAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAuctionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryDebtAuctions(ctx context.Context, in *QueryDebtAuctionsRequest, opts ...grpc.CallOption) (*QueryDebtAuctionsResponse, error) {
-	out := new(QueryDebtAuctionsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDebtAuctions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryAuctionHistoryResponse proto.InternalMessageInfo
+
+type QueryAuctionAnalyticsRequest struct {
+	AppId   uint64 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AssetId uint64 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
 }
 
-func (c *queryClient) QueryDebtBiddings(ctx context.Context, in *QueryDebtBiddingsRequest, opts ...grpc.CallOption) (*QueryDebtBiddingsResponse, error) {
-	out := new(QueryDebtBiddingsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDebtBiddings", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionAnalyticsRequest) Reset()         { *m = QueryAuctionAnalyticsRequest{} }
+func (m *QueryAuctionAnalyticsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionAnalyticsRequest) ProtoMessage()    {}
+func (*QueryAuctionAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{36}
+}
+func (m *QueryAuctionAnalyticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionAnalyticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionAnalyticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAuctionAnalyticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionAnalyticsRequest.Merge(m, src)
+}
+func (m *QueryAuctionAnalyticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionAnalyticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionAnalyticsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryDutchAuction(ctx context.Context, in *QueryDutchAuctionRequest, opts ...grpc.CallOption) (*QueryDutchAuctionResponse, error) {
-	out := new(QueryDutchAuctionResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDutchAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryAuctionAnalyticsRequest proto.InternalMessageInfo
+
+type QueryAuctionAnalyticsResponse struct {
+	Analytics []AuctionAnalytics `protobuf:"bytes,1,rep,name=analytics,proto3" json:"analytics" yaml:"analytics"`
 }
 
-func (c *queryClient) QueryDutchAuctions(ctx context.Context, in *QueryDutchAuctionsRequest, opts ...grpc.CallOption) (*QueryDutchAuctionsResponse, error) {
-	out := new(QueryDutchAuctionsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDutchAuctions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionAnalyticsResponse) Reset()         { *m = QueryAuctionAnalyticsResponse{} }
+func (m *QueryAuctionAnalyticsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionAnalyticsResponse) ProtoMessage()    {}
+func (*QueryAuctionAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{37}
+}
+func (m *QueryAuctionAnalyticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionAnalyticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionAnalyticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAuctionAnalyticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionAnalyticsResponse.Merge(m, src)
+}
+func (m *QueryAuctionAnalyticsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionAnalyticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionAnalyticsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryDutchBiddings(ctx context.Context, in *QueryDutchBiddingsRequest, opts ...grpc.CallOption) (*QueryDutchBiddingsResponse, error) {
-	out := new(QueryDutchBiddingsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDutchBiddings", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryAuctionAnalyticsResponse proto.InternalMessageInfo
+
+type QueryPreBidRequest struct {
+	PreBidId uint64 `protobuf:"varint,1,opt,name=pre_bid_id,json=preBidId,proto3" json:"pre_bid_id,omitempty"`
 }
 
-func (c *queryClient) QueryProtocolStatistics(ctx context.Context, in *QueryProtocolStatisticsRequest, opts ...grpc.CallOption) (*QueryProtocolStatisticsResponse, error) {
-	out := new(QueryProtocolStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryProtocolStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPreBidRequest) Reset()         { *m = QueryPreBidRequest{} }
+func (m *QueryPreBidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPreBidRequest) ProtoMessage()    {}
+func (*QueryPreBidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{38}
 }
-
-func (c *queryClient) QueryGenericAuctionParams(ctx context.Context, in *QueryGenericAuctionParamRequest, opts ...grpc.CallOption) (*QueryGenericAuctionParamResponse, error) {
-	out := new(QueryGenericAuctionParamResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryGenericAuctionParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPreBidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreBidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreBidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPreBidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreBidRequest.Merge(m, src)
+}
+func (m *QueryPreBidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreBidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreBidRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryDutchLendAuction(ctx context.Context, in *QueryDutchLendAuctionRequest, opts ...grpc.CallOption) (*QueryDutchLendAuctionResponse, error) {
-	out := new(QueryDutchLendAuctionResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDutchLendAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryPreBidRequest proto.InternalMessageInfo
+
+type QueryPreBidResponse struct {
+	PreBid PreBid `protobuf:"bytes,1,opt,name=pre_bid,json=preBid,proto3" json:"pre_bid" yaml:"pre_bid"`
 }
 
-func (c *queryClient) QueryDutchLendAuctions(ctx context.Context, in *QueryDutchLendAuctionsRequest, opts ...grpc.CallOption) (*QueryDutchLendAuctionsResponse, error) {
-	out := new(QueryDutchLendAuctionsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDutchLendAuctions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPreBidResponse) Reset()         { *m = QueryPreBidResponse{} }
+func (m *QueryPreBidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPreBidResponse) ProtoMessage()    {}
+func (*QueryPreBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{39}
+}
+func (m *QueryPreBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPreBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreBidResponse.Merge(m, src)
+}
+func (m *QueryPreBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreBidResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryDutchLendBiddings(ctx context.Context, in *QueryDutchLendBiddingsRequest, opts ...grpc.CallOption) (*QueryDutchLendBiddingsResponse, error) {
-	out := new(QueryDutchLendBiddingsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDutchLendBiddings", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryPreBidResponse proto.InternalMessageInfo
+
+// QueryPreBidsRequest lists the pre-bids of an app, zero asset ids and an
+// empty bidder match every pre-bid.
+type QueryPreBidsRequest struct {
+	AppId             uint64             `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	CollateralAssetId uint64             `protobuf:"varint,2,opt,name=collateral_asset_id,json=collateralAssetId,proto3" json:"collateral_asset_id,omitempty"`
+	DebtAssetId       uint64             `protobuf:"varint,3,opt,name=debt_asset_id,json=debtAssetId,proto3" json:"debt_asset_id,omitempty"`
+	Bidder            string             `protobuf:"bytes,4,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Pagination        *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty" yaml:"pagination"`
 }
 
-func (c *queryClient) QueryFilterDutchAuctions(ctx context.Context, in *QueryFilterDutchAuctionsRequest, opts ...grpc.CallOption) (*QueryFilterDutchAuctionsResponse, error) {
-	out := new(QueryFilterDutchAuctionsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryFilterDutchAuctions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPreBidsRequest) Reset()         { *m = QueryPreBidsRequest{} }
+func (m *QueryPreBidsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPreBidsRequest) ProtoMessage()    {}
+func (*QueryPreBidsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{40}
+}
+func (m *QueryPreBidsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreBidsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreBidsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	QuerySurplusAuction(context.Context, *QuerySurplusAuctionRequest) (*QuerySurplusAuctionResponse, error)
-	QuerySurplusAuctions(context.Context, *QuerySurplusAuctionsRequest) (*QuerySurplusAuctionsResponse, error)
-	QuerySurplusBiddings(context.Context, *QuerySurplusBiddingsRequest) (*QuerySurplusBiddingsResponse, error)
-	QueryDebtAuction(context.Context, *QueryDebtAuctionRequest) (*QueryDebtAuctionResponse, error)
-	QueryDebtAuctions(context.Context, *QueryDebtAuctionsRequest) (*QueryDebtAuctionsResponse, error)
-	QueryDebtBiddings(context.Context, *QueryDebtBiddingsRequest) (*QueryDebtBiddingsResponse, error)
-	QueryDutchAuction(context.Context, *QueryDutchAuctionRequest) (*QueryDutchAuctionResponse, error)
-	QueryDutchAuctions(context.Context, *QueryDutchAuctionsRequest) (*QueryDutchAuctionsResponse, error)
-	QueryDutchBiddings(context.Context, *QueryDutchBiddingsRequest) (*QueryDutchBiddingsResponse, error)
-	QueryProtocolStatistics(context.Context, *QueryProtocolStatisticsRequest) (*QueryProtocolStatisticsResponse, error)
-	QueryGenericAuctionParams(context.Context, *QueryGenericAuctionParamRequest) (*QueryGenericAuctionParamResponse, error)
-	QueryDutchLendAuction(context.Context, *QueryDutchLendAuctionRequest) (*QueryDutchLendAuctionResponse, error)
-	QueryDutchLendAuctions(context.Context, *QueryDutchLendAuctionsRequest) (*QueryDutchLendAuctionsResponse, error)
-	QueryDutchLendBiddings(context.Context, *QueryDutchLendBiddingsRequest) (*QueryDutchLendBiddingsResponse, error)
-	QueryFilterDutchAuctions(context.Context, *QueryFilterDutchAuctionsRequest) (*QueryFilterDutchAuctionsResponse, error)
+func (m *QueryPreBidsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreBidsRequest.Merge(m, src)
+}
+func (m *QueryPreBidsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreBidsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreBidsRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryPreBidsRequest proto.InternalMessageInfo
+
+type QueryPreBidsResponse struct {
+	PreBids    []PreBid            `protobuf:"bytes,1,rep,name=pre_bids,json=preBids,proto3" json:"pre_bids" yaml:"pre_bids"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty" yaml:"pagination"`
 }
 
-func (*UnimplementedQueryServer) QuerySurplusAuction(ctx context.Context, req *QuerySurplusAuctionRequest) (*QuerySurplusAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySurplusAuction not implemented")
+func (m *QueryPreBidsResponse) Reset()         { *m = QueryPreBidsResponse{} }
+func (m *QueryPreBidsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPreBidsResponse) ProtoMessage()    {}
+func (*QueryPreBidsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{41}
 }
-func (*UnimplementedQueryServer) QuerySurplusAuctions(ctx context.Context, req *QuerySurplusAuctionsRequest) (*QuerySurplusAuctionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySurplusAuctions not implemented")
+func (m *QueryPreBidsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QuerySurplusBiddings(ctx context.Context, req *QuerySurplusBiddingsRequest) (*QuerySurplusBiddingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySurplusBiddings not implemented")
+func (m *QueryPreBidsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreBidsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryDebtAuction(ctx context.Context, req *QueryDebtAuctionRequest) (*QueryDebtAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDebtAuction not implemented")
+func (m *QueryPreBidsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreBidsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryDebtAuctions(ctx context.Context, req *QueryDebtAuctionsRequest) (*QueryDebtAuctionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDebtAuctions not implemented")
+func (m *QueryPreBidsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryDebtBiddings(ctx context.Context, req *QueryDebtBiddingsRequest) (*QueryDebtBiddingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDebtBiddings not implemented")
+func (m *QueryPreBidsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreBidsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryDutchAuction(ctx context.Context, req *QueryDutchAuctionRequest) (*QueryDutchAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDutchAuction not implemented")
+
+var xxx_messageInfo_QueryPreBidsResponse proto.InternalMessageInfo
+
+type QueryPreBidQueueRequest struct {
+	AppId             uint64 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	CollateralAssetId uint64 `protobuf:"varint,2,opt,name=collateral_asset_id,json=collateralAssetId,proto3" json:"collateral_asset_id,omitempty"`
+	DebtAssetId       uint64 `protobuf:"varint,3,opt,name=debt_asset_id,json=debtAssetId,proto3" json:"debt_asset_id,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryDutchAuctions(ctx context.Context, req *QueryDutchAuctionsRequest) (*QueryDutchAuctionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDutchAuctions not implemented")
+
+func (m *QueryPreBidQueueRequest) Reset()         { *m = QueryPreBidQueueRequest{} }
+func (m *QueryPreBidQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPreBidQueueRequest) ProtoMessage()    {}
+func (*QueryPreBidQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{42}
 }
-func (*UnimplementedQueryServer) QueryDutchBiddings(ctx context.Context, req *QueryDutchBiddingsRequest) (*QueryDutchBiddingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDutchBiddings not implemented")
+func (m *QueryPreBidQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryProtocolStatistics(ctx context.Context, req *QueryProtocolStatisticsRequest) (*QueryProtocolStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryProtocolStatistics not implemented")
+func (m *QueryPreBidQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreBidQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryGenericAuctionParams(ctx context.Context, req *QueryGenericAuctionParamRequest) (*QueryGenericAuctionParamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryGenericAuctionParams not implemented")
+func (m *QueryPreBidQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreBidQueueRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryDutchLendAuction(ctx context.Context, req *QueryDutchLendAuctionRequest) (*QueryDutchLendAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDutchLendAuction not implemented")
+func (m *QueryPreBidQueueRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryDutchLendAuctions(ctx context.Context, req *QueryDutchLendAuctionsRequest) (*QueryDutchLendAuctionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDutchLendAuctions not implemented")
+func (m *QueryPreBidQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreBidQueueRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryDutchLendBiddings(ctx context.Context, req *QueryDutchLendBiddingsRequest) (*QueryDutchLendBiddingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDutchLendBiddings not implemented")
+
+var xxx_messageInfo_QueryPreBidQueueRequest proto.InternalMessageInfo
+
+// PreBidSlot is the debt token bid at a premium slot of a pre-bid queue.
+type PreBidSlot struct {
+	Premium uint64                                 `protobuf:"varint,1,opt,name=premium,proto3" json:"premium,omitempty" yaml:"premium"`
+	Total   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total" yaml:"total"`
+	// active is the part of the total that fills liquidations.
+	Active github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=active,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"active" yaml:"active"`
 }
-func (*UnimplementedQueryServer) QueryFilterDutchAuctions(ctx context.Context, req *QueryFilterDutchAuctionsRequest) (*QueryFilterDutchAuctionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryFilterDutchAuctions not implemented")
+
+func (m *PreBidSlot) Reset()         { *m = PreBidSlot{} }
+func (m *PreBidSlot) String() string { return proto.CompactTextString(m) }
+func (*PreBidSlot) ProtoMessage()    {}
+func (*PreBidSlot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{43}
+}
+func (m *PreBidSlot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreBidSlot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreBidSlot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PreBidSlot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreBidSlot.Merge(m, src)
+}
+func (m *PreBidSlot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreBidSlot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreBidSlot.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_PreBidSlot proto.InternalMessageInfo
+
+type QueryPreBidQueueResponse struct {
+	Slots []PreBidSlot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots" yaml:"slots"`
 }
 
-func _Query_QuerySurplusAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySurplusAuctionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySurplusAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QuerySurplusAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySurplusAuction(ctx, req.(*QuerySurplusAuctionRequest))
+func (m *QueryPreBidQueueResponse) Reset()         { *m = QueryPreBidQueueResponse{} }
+func (m *QueryPreBidQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPreBidQueueResponse) ProtoMessage()    {}
+func (*QueryPreBidQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{44}
+}
+func (m *QueryPreBidQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreBidQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreBidQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPreBidQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreBidQueueResponse.Merge(m, src)
+}
+func (m *QueryPreBidQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreBidQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreBidQueueResponse.DiscardUnknown(m)
 }
 
-func _Query_QuerySurplusAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySurplusAuctionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySurplusAuctions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QuerySurplusAuctions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySurplusAuctions(ctx, req.(*QuerySurplusAuctionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryPreBidQueueResponse proto.InternalMessageInfo
+
+type QueryParamsRequest struct {
 }
 
-func _Query_QuerySurplusBiddings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySurplusBiddingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySurplusBiddings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QuerySurplusBiddings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySurplusBiddings(ctx, req.(*QuerySurplusBiddingsRequest))
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{45}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_QueryDebtAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDebtAuctionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDebtAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QueryDebtAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDebtAuction(ctx, req.(*QueryDebtAuctionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryDebtAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDebtAuctionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDebtAuctions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QueryDebtAuctions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDebtAuctions(ctx, req.(*QueryDebtAuctionsRequest))
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff4a64a3f291f95, []int{46}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryDebtBiddings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDebtBiddingsRequest)
-	if err := dec(in); err != nil {
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QuerySurplusAuctionRequest)(nil), "comdex.auction.v1beta1.QuerySurplusAuctionRequest")
+	proto.RegisterType((*QuerySurplusAuctionResponse)(nil), "comdex.auction.v1beta1.QuerySurplusAuctionResponse")
+	proto.RegisterType((*QuerySurplusAuctionsRequest)(nil), "comdex.auction.v1beta1.QuerySurplusAuctionsRequest")
+	proto.RegisterType((*QuerySurplusAuctionsResponse)(nil), "comdex.auction.v1beta1.QuerySurplusAuctionsResponse")
+	proto.RegisterType((*QuerySurplusBiddingsRequest)(nil), "comdex.auction.v1beta1.QuerySurplusBiddingsRequest")
+	proto.RegisterType((*QuerySurplusBiddingsResponse)(nil), "comdex.auction.v1beta1.QuerySurplusBiddingsResponse")
+	proto.RegisterType((*QueryDebtAuctionRequest)(nil), "comdex.auction.v1beta1.QueryDebtAuctionRequest")
+	proto.RegisterType((*QueryDebtAuctionResponse)(nil), "comdex.auction.v1beta1.QueryDebtAuctionResponse")
+	proto.RegisterType((*QueryDebtAuctionsRequest)(nil), "comdex.auction.v1beta1.QueryDebtAuctionsRequest")
+	proto.RegisterType((*QueryDebtAuctionsResponse)(nil), "comdex.auction.v1beta1.QueryDebtAuctionsResponse")
+	proto.RegisterType((*QueryDebtBiddingsRequest)(nil), "comdex.auction.v1beta1.QueryDebtBiddingsRequest")
+	proto.RegisterType((*QueryDebtBiddingsResponse)(nil), "comdex.auction.v1beta1.QueryDebtBiddingsResponse")
+	proto.RegisterType((*QueryDutchAuctionRequest)(nil), "comdex.auction.v1beta1.QueryDutchAuctionRequest")
+	proto.RegisterType((*QueryDutchAuctionResponse)(nil), "comdex.auction.v1beta1.QueryDutchAuctionResponse")
+	proto.RegisterType((*QueryDutchAuctionsRequest)(nil), "comdex.auction.v1beta1.QueryDutchAuctionsRequest")
+	proto.RegisterType((*QueryDutchAuctionsResponse)(nil), "comdex.auction.v1beta1.QueryDutchAuctionsResponse")
+	proto.RegisterType((*QueryDutchBiddingsRequest)(nil), "comdex.auction.v1beta1.QueryDutchBiddingsRequest")
+	proto.RegisterType((*QueryDutchBiddingsResponse)(nil), "comdex.auction.v1beta1.QueryDutchBiddingsResponse")
+	proto.RegisterType((*QueryBiddingsForSurplusAuctionRequest)(nil), "comdex.auction.v1beta1.QueryBiddingsForSurplusAuctionRequest")
+	proto.RegisterType((*QueryBiddingsForSurplusAuctionResponse)(nil), "comdex.auction.v1beta1.QueryBiddingsForSurplusAuctionResponse")
+	proto.RegisterType((*QueryProtocolStatisticsRequest)(nil), "comdex.auction.v1beta1.QueryProtocolStatisticsRequest")
+	proto.RegisterType((*QueryProtocolStatisticsResponse)(nil), "comdex.auction.v1beta1.QueryProtocolStatisticsResponse")
+	proto.RegisterType((*QueryBadDebtsRequest)(nil), "comdex.auction.v1beta1.QueryBadDebtsRequest")
+	proto.RegisterType((*QueryBadDebtsResponse)(nil), "comdex.auction.v1beta1.QueryBadDebtsResponse")
+	proto.RegisterType((*QueryGenericAuctionParamRequest)(nil), "comdex.auction.v1beta1.QueryGenericAuctionParamRequest")
+	proto.RegisterType((*QueryGenericAuctionParamResponse)(nil), "comdex.auction.v1beta1.QueryGenericAuctionParamResponse")
+	proto.RegisterType((*QueryDutchLendAuctionRequest)(nil), "comdex.auction.v1beta1.QueryDutchLendAuctionRequest")
+	proto.RegisterType((*QueryDutchLendAuctionResponse)(nil), "comdex.auction.v1beta1.QueryDutchLendAuctionResponse")
+	proto.RegisterType((*QueryDutchLendAuctionsRequest)(nil), "comdex.auction.v1beta1.QueryDutchLendAuctionsRequest")
+	proto.RegisterType((*QueryDutchLendAuctionsResponse)(nil), "comdex.auction.v1beta1.QueryDutchLendAuctionsResponse")
+	proto.RegisterType((*QueryDutchLendBiddingsRequest)(nil), "comdex.auction.v1beta1.QueryDutchLendBiddingsRequest")
+	proto.RegisterType((*QueryDutchLendBiddingsResponse)(nil), "comdex.auction.v1beta1.QueryDutchLendBiddingsResponse")
+	proto.RegisterType((*QueryFilterDutchAuctionsRequest)(nil), "comdex.auction.v1beta1.QueryFilterDutchAuctionsRequest")
+	proto.RegisterType((*QueryFilterDutchAuctionsResponse)(nil), "comdex.auction.v1beta1.QueryFilterDutchAuctionsResponse")
+	proto.RegisterType((*QueryAuctionHistoryRequest)(nil), "comdex.auction.v1beta1.QueryAuctionHistoryRequest")
+	proto.RegisterType((*QueryAuctionHistoryResponse)(nil), "comdex.auction.v1beta1.QueryAuctionHistoryResponse")
+	proto.RegisterType((*QueryAuctionAnalyticsRequest)(nil), "comdex.auction.v1beta1.QueryAuctionAnalyticsRequest")
+	proto.RegisterType((*QueryAuctionAnalyticsResponse)(nil), "comdex.auction.v1beta1.QueryAuctionAnalyticsResponse")
+	proto.RegisterType((*QueryPreBidRequest)(nil), "comdex.auction.v1beta1.QueryPreBidRequest")
+	proto.RegisterType((*QueryPreBidResponse)(nil), "comdex.auction.v1beta1.QueryPreBidResponse")
+	proto.RegisterType((*QueryPreBidsRequest)(nil), "comdex.auction.v1beta1.QueryPreBidsRequest")
+	proto.RegisterType((*QueryPreBidsResponse)(nil), "comdex.auction.v1beta1.QueryPreBidsResponse")
+	proto.RegisterType((*QueryPreBidQueueRequest)(nil), "comdex.auction.v1beta1.QueryPreBidQueueRequest")
+	proto.RegisterType((*PreBidSlot)(nil), "comdex.auction.v1beta1.PreBidSlot")
+	proto.RegisterType((*QueryPreBidQueueResponse)(nil), "comdex.auction.v1beta1.QueryPreBidQueueResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "comdex.auction.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "comdex.auction.v1beta1.QueryParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("comdex/auction/v1beta1/query.proto", fileDescriptor_5ff4a64a3f291f95)
+}
+
+var fileDescriptor_5ff4a64a3f291f95 = []byte{
+	// 2425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x5f, 0x6c, 0x1c, 0x47,
+	0x19, 0xf7, 0x9e, 0xff, 0xdd, 0x8d, 0xe3, 0x26, 0x9e, 0x38, 0xc9, 0x79, 0xeb, 0xdc, 0xb9, 0xd3,
+	0xc6, 0xa4, 0x8e, 0x73, 0x17, 0x3b, 0x69, 0x12, 0xa2, 0xa8, 0x69, 0x2e, 0x6d, 0x1a, 0x0b, 0xda,
+	0xa6, 0xeb, 0x50, 0x9a, 0x84, 0x70, 0xdd, 0xbb, 0x5d, 0x5f, 0x36, 0xbd, 0xbb, 0xdd, 0xdc, 0xee,
+	0xa5, 0xb5, 0xa2, 0x3c, 0x14, 0x8a, 0x78, 0x41, 0x55, 0x25, 0x24, 0x40, 0x80, 0xd4, 0x57, 0x04,
+	0x42, 0x3c, 0x02, 0x0f, 0x08, 0x29, 0xa8, 0x6d, 0x84, 0x04, 0x04, 0x21, 0x28, 0xa2, 0xaa, 0x81,
+	0x04, 0x21, 0x21, 0xde, 0xfc, 0xc2, 0x2b, 0xda, 0x99, 0x6f, 0xf6, 0x76, 0xf7, 0x76, 0x6f, 0x77,
+	0x89, 0x4d, 0xce, 0xea, 0x53, 0xbc, 0xbb, 0xdf, 0xf7, 0xcd, 0xef, 0xf7, 0x7d, 0xdf, 0x7c, 0x33,
+	0xf3, 0xcd, 0x05, 0x91, 0xaa, 0xde, 0x50, 0xd4, 0x37, 0x8b, 0x72, 0xbb, 0x6a, 0x69, 0x7a, 0xb3,
+	0x78, 0x63, 0xa1, 0xa2, 0x5a, 0xf2, 0x42, 0xf1, 0x7a, 0x5b, 0x6d, 0xad, 0x16, 0x8c, 0x96, 0x6e,
+	0xe9, 0x78, 0x37, 0x93, 0x29, 0x80, 0x4c, 0x01, 0x64, 0xc4, 0xc9, 0x9a, 0x5e, 0xd3, 0xa9, 0x48,
+	0xd1, 0xfe, 0x8b, 0x49, 0x8b, 0xd3, 0x35, 0x5d, 0xaf, 0xd5, 0xd5, 0xa2, 0x6c, 0x68, 0x45, 0xb9,
+	0xd9, 0xd4, 0x2d, 0xd9, 0x56, 0x32, 0xe1, 0xeb, 0x13, 0x21, 0xe3, 0x71, 0xdb, 0x4c, 0x6a, 0x5f,
+	0x88, 0x54, 0x45, 0x53, 0x14, 0xad, 0x59, 0xe3, 0xc6, 0x1e, 0x0f, 0x11, 0x33, 0xe4, 0x96, 0xdc,
+	0xe0, 0x42, 0x73, 0x55, 0xdd, 0x6c, 0xe8, 0x66, 0xb1, 0x22, 0x9b, 0x2a, 0xa3, 0xe5, 0x92, 0xab,
+	0x69, 0x4d, 0xd9, 0x35, 0x6e, 0x1e, 0xb0, 0xd3, 0xa7, 0x4a, 0x7b, 0xa5, 0x68, 0x69, 0x0d, 0xd5,
+	0xb4, 0xe4, 0x86, 0xc1, 0x04, 0xc8, 0x77, 0x05, 0x24, 0xbe, 0x6c, 0xdb, 0x58, 0x6e, 0xb7, 0x8c,
+	0x7a, 0xdb, 0x3c, 0xcd, 0x46, 0x96, 0xd4, 0xeb, 0x6d, 0xd5, 0xb4, 0xf0, 0x2e, 0x34, 0x22, 0x1b,
+	0x46, 0x59, 0x53, 0xb2, 0xc2, 0x8c, 0xb0, 0x7f, 0x48, 0x1a, 0x96, 0x0d, 0x63, 0x49, 0xc1, 0xf3,
+	0x08, 0x03, 0xc4, 0x72, 0x43, 0x36, 0x0c, 0xad, 0x59, 0xb3, 0x45, 0x52, 0x54, 0x64, 0x07, 0x7c,
+	0x79, 0x81, 0x7d, 0x58, 0x52, 0xf0, 0x5e, 0x84, 0xb8, 0xb4, 0xa6, 0x64, 0x07, 0xa9, 0x54, 0x06,
+	0xde, 0x2c, 0x29, 0x38, 0x8b, 0x46, 0xaf, 0x6a, 0xa6, 0xa5, 0xb7, 0x56, 0xb3, 0x43, 0x33, 0xc2,
+	0xfe, 0xb4, 0xc4, 0x1f, 0xc9, 0x1b, 0xe8, 0xd1, 0x40, 0x6c, 0xa6, 0xa1, 0x37, 0x4d, 0x15, 0xbf,
+	0x8a, 0x46, 0xc1, 0x0a, 0x45, 0x37, 0xb6, 0x38, 0x5b, 0x08, 0x0e, 0x6c, 0xc1, 0x6b, 0xa0, 0xb4,
+	0xfb, 0xce, 0x5a, 0x7e, 0x60, 0x7d, 0x2d, 0xff, 0xc8, 0xaa, 0xdc, 0xa8, 0x9f, 0x20, 0x20, 0x4d,
+	0x24, 0x6e, 0x8e, 0xfc, 0x58, 0x08, 0x1c, 0xd9, 0x8c, 0x70, 0x8b, 0x8b, 0x49, 0xca, 0xc3, 0x04,
+	0x5f, 0x41, 0xa8, 0x13, 0x9b, 0xec, 0xa0, 0x83, 0xd6, 0x0e, 0x64, 0xc1, 0x0e, 0x64, 0x81, 0xe5,
+	0x27, 0x07, 0x7c, 0x5e, 0xae, 0xa9, 0x30, 0x58, 0x69, 0xd7, 0xfa, 0x5a, 0x7e, 0x82, 0x21, 0xed,
+	0xd8, 0x20, 0x92, 0xcb, 0x20, 0xf9, 0x58, 0x40, 0xd3, 0xc1, 0x78, 0xc1, 0x55, 0x97, 0x51, 0x1a,
+	0xb8, 0x99, 0x59, 0x61, 0x66, 0x30, 0x81, 0xaf, 0xf6, 0x80, 0xaf, 0xb6, 0x7b, 0x7c, 0x65, 0x12,
+	0xc9, 0x31, 0x88, 0xbf, 0xec, 0x21, 0x97, 0xa2, 0xe4, 0x3e, 0x13, 0x49, 0x8e, 0x21, 0x8b, 0xc3,
+	0xee, 0x7d, 0x5f, 0x34, 0x4a, 0x30, 0x69, 0x78, 0x34, 0x76, 0xa3, 0x11, 0x7b, 0x1e, 0xa9, 0x2d,
+	0x1a, 0x8d, 0x8c, 0x04, 0x4f, 0xae, 0x28, 0xa5, 0x42, 0xa2, 0x34, 0xd8, 0x2b, 0x4a, 0x43, 0x1b,
+	0x1d, 0xa5, 0xaf, 0xa7, 0xd0, 0x74, 0x30, 0x0f, 0x88, 0xd2, 0x93, 0x5e, 0x22, 0xa5, 0x89, 0xf5,
+	0xb5, 0xfc, 0x38, 0xb3, 0xc9, 0xde, 0x13, 0x87, 0xdb, 0x97, 0x50, 0x9a, 0xd7, 0x8e, 0x6c, 0x6a,
+	0x66, 0x10, 0x3c, 0xde, 0x2b, 0xa0, 0x7c, 0x34, 0x7f, 0x44, 0xb9, 0x19, 0x22, 0x39, 0x16, 0x7d,
+	0x11, 0x1d, 0xdc, 0xf0, 0x88, 0x7e, 0x5b, 0x40, 0x7b, 0xa8, 0x27, 0x9e, 0x55, 0x2b, 0x56, 0x5f,
+	0x95, 0x9c, 0xbb, 0x02, 0xca, 0x76, 0x23, 0x83, 0xf8, 0x7c, 0xc1, 0x5f, 0x70, 0x1e, 0x0f, 0xf3,
+	0xb9, 0x4b, 0x3b, 0xb2, 0xda, 0x6c, 0x76, 0x71, 0xf8, 0x61, 0x00, 0xa5, 0xbe, 0xad, 0x64, 0x7f,
+	0x14, 0xd0, 0x54, 0x00, 0x58, 0xa7, 0xe2, 0xfb, 0xcb, 0x58, 0xac, 0x08, 0xf4, 0x41, 0x0d, 0xbb,
+	0xed, 0x0e, 0xc2, 0x56, 0x2d, 0x60, 0x6f, 0xa5, 0xd0, 0x54, 0x00, 0x89, 0xe4, 0xd5, 0xeb, 0x62,
+	0x57, 0xf5, 0x7a, 0xa2, 0x57, 0x1c, 0xfb, 0xa9, 0x74, 0x7d, 0xc7, 0x09, 0x64, 0xdb, 0xaa, 0x5e,
+	0xed, 0xab, 0xda, 0x65, 0xa2, 0xa9, 0x00, 0x64, 0x10, 0x9d, 0x57, 0xfc, 0xb5, 0x2b, 0xdc, 0xe3,
+	0x2e, 0xf5, 0xe8, 0xad, 0xd2, 0x8f, 0x84, 0x80, 0x51, 0xfb, 0xb6, 0xbc, 0x7c, 0xc4, 0xb7, 0xbb,
+	0x3e, 0xb4, 0xe0, 0xa4, 0x8b, 0x5d, 0xf5, 0x25, 0x9e, 0x97, 0xfa, 0xa0, 0xc0, 0xfc, 0xca, 0x13,
+	0x87, 0xad, 0x5a, 0x61, 0xbe, 0x9a, 0x42, 0x62, 0x10, 0x8b, 0xe4, 0x25, 0xe6, 0x52, 0x57, 0x89,
+	0xd9, 0xd7, 0x33, 0x94, 0xfd, 0x54, 0x63, 0xde, 0x4e, 0xa1, 0x7d, 0xd4, 0x0b, 0x1c, 0xd4, 0x59,
+	0xbd, 0xd5, 0x87, 0xe7, 0x33, 0x5f, 0x32, 0x0c, 0x6f, 0x74, 0x32, 0xfc, 0x53, 0x40, 0xb3, 0x51,
+	0x6e, 0x80, 0xc4, 0x70, 0x6f, 0x87, 0x85, 0x4d, 0xde, 0x0e, 0x6f, 0xfc, 0xdc, 0xfd, 0x96, 0x80,
+	0x72, 0x94, 0xe8, 0xf9, 0x96, 0x6e, 0xe9, 0x55, 0xbd, 0xbe, 0x6c, 0xc9, 0x96, 0x66, 0x5a, 0x5a,
+	0x35, 0xaa, 0x90, 0x5e, 0x09, 0x40, 0xb6, 0xb1, 0x11, 0xc8, 0x87, 0x02, 0x03, 0xd7, 0x57, 0xd1,
+	0xb0, 0x69, 0xc9, 0x16, 0xf7, 0xfb, 0x5c, 0x98, 0xdf, 0xbb, 0x4d, 0x94, 0x1e, 0x03, 0xd7, 0x4f,
+	0x31, 0x14, 0xdd, 0x12, 0x44, 0x62, 0xb6, 0x37, 0x3d, 0x02, 0xdf, 0x10, 0xd0, 0x24, 0x4b, 0x35,
+	0x59, 0xb1, 0x77, 0x1c, 0x0f, 0xd9, 0xef, 0xbf, 0x13, 0xd0, 0x2e, 0x1f, 0x1c, 0x67, 0x19, 0xcf,
+	0x54, 0x64, 0xa5, 0xac, 0xa8, 0x15, 0xc7, 0xe3, 0xf9, 0x30, 0x8f, 0x83, 0x72, 0x29, 0x0b, 0x6e,
+	0xde, 0x01, 0x19, 0xce, 0xf5, 0xed, 0x14, 0x07, 0xfb, 0x9b, 0xee, 0xe0, 0xe3, 0x90, 0x48, 0xcf,
+	0xab, 0x4d, 0xb5, 0xa5, 0x55, 0x61, 0xfe, 0x9e, 0xb7, 0xfb, 0x5a, 0xbd, 0x5d, 0x4d, 0xde, 0x11,
+	0xd0, 0x4c, 0xb8, 0x2a, 0xb8, 0xe5, 0x1a, 0x1a, 0x97, 0x5d, 0xef, 0x4d, 0xd8, 0xe3, 0x84, 0x96,
+	0x7c, 0xb7, 0x11, 0xb3, 0xb4, 0x17, 0x1c, 0xb4, 0xcb, 0xb3, 0x7c, 0x97, 0x59, 0xd7, 0x8d, 0x48,
+	0x5e, 0xd3, 0xe4, 0xfb, 0xbc, 0xd9, 0x42, 0xd7, 0x8d, 0xcf, 0xab, 0x4d, 0xa5, 0xcf, 0x9a, 0x66,
+	0x7b, 0x43, 0xd0, 0x6d, 0xf2, 0x4e, 0xf0, 0x27, 0x42, 0xc8, 0xc8, 0xfd, 0xdc, 0x36, 0xcb, 0x85,
+	0x21, 0xde, 0xfa, 0x3b, 0xc2, 0x0f, 0xbb, 0xe2, 0xb1, 0x85, 0x1b, 0x67, 0xb9, 0x30, 0x26, 0x9f,
+	0xae, 0x9d, 0xe1, 0x87, 0x7c, 0x41, 0x3e, 0xab, 0xd5, 0x2d, 0xb5, 0x95, 0xe4, 0xcc, 0x35, 0x89,
+	0x86, 0x15, 0xb5, 0xa9, 0x37, 0x28, 0xe7, 0x8c, 0xc4, 0x1e, 0x1e, 0x5e, 0x4c, 0x3f, 0xe1, 0x65,
+	0x3d, 0x90, 0xc9, 0xd6, 0x9f, 0x7d, 0xef, 0x0d, 0xc2, 0x49, 0x06, 0x30, 0x9d, 0x63, 0x6e, 0x8d,
+	0x08, 0xd2, 0x63, 0x68, 0x1b, 0xaf, 0xfa, 0xd6, 0xaa, 0xa1, 0x52, 0x5c, 0x19, 0x69, 0x0c, 0xde,
+	0x5d, 0x58, 0x35, 0x54, 0x3c, 0x85, 0xd2, 0xb2, 0x69, 0xaa, 0x56, 0x67, 0x59, 0x18, 0xa5, 0xcf,
+	0x4b, 0x0a, 0xce, 0xa3, 0xb1, 0x1b, 0x72, 0xbb, 0x6e, 0x95, 0xf5, 0x37, 0x9a, 0x6a, 0x8b, 0xc6,
+	0x2c, 0x23, 0x21, 0xfa, 0xea, 0x25, 0xfb, 0x0d, 0x9e, 0x45, 0xdb, 0xeb, 0x7a, 0xf5, 0x75, 0x55,
+	0x29, 0x33, 0x39, 0x4d, 0xa1, 0xbb, 0xf6, 0x21, 0x69, 0x9c, 0xbd, 0x7e, 0xc5, 0x7e, 0xbb, 0xa4,
+	0xe0, 0x33, 0x08, 0x99, 0x96, 0xdc, 0xb2, 0xca, 0x96, 0xd6, 0x50, 0xb3, 0x23, 0xd4, 0x39, 0x62,
+	0x81, 0xdd, 0x25, 0x15, 0xf8, 0x5d, 0x52, 0xe1, 0x02, 0xbf, 0x4b, 0x2a, 0xa5, 0x6d, 0x7f, 0xbf,
+	0xfb, 0xd7, 0xbc, 0x20, 0x65, 0xa8, 0x9e, 0xfd, 0x05, 0x9f, 0x42, 0x69, 0xb5, 0xa9, 0x30, 0x13,
+	0xa3, 0x09, 0x4c, 0x8c, 0xaa, 0x4d, 0x85, 0x1a, 0xf0, 0x66, 0x60, 0x7a, 0xa3, 0x33, 0xf0, 0x97,
+	0x43, 0xe8, 0xd1, 0xc0, 0x08, 0x41, 0xf2, 0xb5, 0xd0, 0x0e, 0x93, 0x9d, 0x15, 0xca, 0xff, 0xe3,
+	0xdd, 0x49, 0x1e, 0xd2, 0x70, 0x0f, 0x03, 0xe2, 0xb7, 0x46, 0xa4, 0xed, 0xa6, 0x47, 0xc1, 0xc4,
+	0x2b, 0x68, 0xdc, 0xde, 0x9a, 0x75, 0x06, 0x4c, 0xc5, 0xef, 0x72, 0x4e, 0xc3, 0x68, 0x93, 0x6c,
+	0x34, 0x8f, 0x1d, 0x22, 0x6d, 0x53, 0x3a, 0xa2, 0x26, 0xbe, 0x86, 0x1e, 0x51, 0xec, 0x09, 0xd3,
+	0x19, 0x68, 0x30, 0xc1, 0xf4, 0xf2, 0xed, 0x97, 0xbc, 0x96, 0x88, 0x34, 0xae, 0xb8, 0x27, 0x33,
+	0x7e, 0x13, 0xed, 0x64, 0x12, 0x75, 0x3b, 0x1d, 0x9c, 0x01, 0x87, 0x12, 0x0c, 0x48, 0x60, 0x40,
+	0xd1, 0x3d, 0xa0, 0xc7, 0x1c, 0x91, 0x26, 0x14, 0xff, 0x22, 0xee, 0x9b, 0xe3, 0xc3, 0x1b, 0x3e,
+	0xc7, 0xcf, 0xc3, 0x46, 0x10, 0x06, 0x3c, 0xdd, 0x94, 0xeb, 0xab, 0x31, 0x0e, 0x6d, 0xee, 0x19,
+	0x9c, 0xf2, 0xcc, 0x60, 0xf2, 0x16, 0x5f, 0xb3, 0xbb, 0x4d, 0x42, 0x56, 0xbe, 0x86, 0x32, 0x32,
+	0x7f, 0x09, 0xe9, 0xb8, 0x3f, 0x62, 0x97, 0xeb, 0x18, 0xf1, 0x9f, 0x04, 0x1c, 0x43, 0x44, 0xea,
+	0x18, 0x25, 0x8b, 0x08, 0xc3, 0x99, 0x4f, 0x2d, 0x69, 0x0a, 0xe7, 0x32, 0x8d, 0x90, 0xd1, 0x52,
+	0xcb, 0x15, 0x4d, 0xe9, 0xf0, 0x49, 0x1b, 0x54, 0x64, 0x49, 0x21, 0x2b, 0x68, 0xa7, 0x47, 0x07,
+	0xc0, 0xbe, 0x84, 0x46, 0x41, 0x09, 0xb6, 0x9a, 0xb9, 0xf0, 0xd3, 0xa1, 0xad, 0xe8, 0xdf, 0x64,
+	0x82, 0x32, 0x91, 0x46, 0xd8, 0x48, 0xe4, 0x3f, 0x82, 0x67, 0xa0, 0x28, 0x4f, 0x17, 0xd0, 0xce,
+	0xaa, 0x5e, 0xaf, 0xcb, 0x96, 0xda, 0x92, 0xeb, 0x65, 0x9f, 0xd3, 0x27, 0x3a, 0x9f, 0x4e, 0x43,
+	0x01, 0x25, 0x7c, 0xfa, 0x79, 0x0b, 0xec, 0x18, 0x9d, 0x3b, 0x20, 0xd3, 0xd9, 0x34, 0x0d, 0x79,
+	0x36, 0x4d, 0x9b, 0xdc, 0x0c, 0xf9, 0x35, 0x3f, 0xa1, 0x3a, 0xcc, 0xc1, 0xc7, 0x12, 0x4a, 0x83,
+	0x9b, 0x78, 0x3e, 0x44, 0x39, 0xd9, 0xb7, 0x3a, 0x72, 0x6d, 0x22, 0x8d, 0x32, 0x2f, 0x6f, 0xfe,
+	0xe2, 0xf8, 0x36, 0xbf, 0xff, 0x63, 0x88, 0x5e, 0x6e, 0xab, 0x6d, 0xf5, 0xff, 0x1f, 0x4a, 0xf2,
+	0x2f, 0x01, 0x21, 0x86, 0x60, 0xb9, 0xae, 0x5b, 0x78, 0x9e, 0x66, 0x6b, 0x43, 0x6b, 0x37, 0xd8,
+	0xd0, 0x25, 0xec, 0xc9, 0x44, 0xfb, 0x03, 0xf3, 0x91, 0xfd, 0x17, 0xbe, 0x80, 0x86, 0x2d, 0xdd,
+	0x92, 0xeb, 0x6c, 0x8d, 0x2e, 0x3d, 0x6d, 0x3b, 0xf5, 0x2f, 0x6b, 0xf9, 0xd9, 0x9a, 0x66, 0x5d,
+	0x6d, 0x57, 0xec, 0x10, 0x14, 0xe1, 0x87, 0x1a, 0xec, 0x9f, 0x83, 0xa6, 0xf2, 0x7a, 0xd1, 0x5e,
+	0xd4, 0xcd, 0xc2, 0x52, 0xd3, 0x5a, 0x5f, 0xcb, 0x6f, 0x63, 0x96, 0xa9, 0x11, 0x22, 0x31, 0x63,
+	0xf8, 0x8b, 0x68, 0x44, 0xae, 0x5a, 0xda, 0x0d, 0x95, 0xe2, 0xcd, 0x94, 0x4e, 0x25, 0x36, 0x0b,
+	0xbb, 0x5e, 0x66, 0x85, 0x48, 0x60, 0x8e, 0x5c, 0x83, 0x6b, 0x0b, 0x8f, 0xc7, 0x21, 0x85, 0x5e,
+	0x44, 0xc3, 0x66, 0x5d, 0x77, 0x1a, 0x0a, 0xa4, 0x77, 0xfe, 0xd8, 0xbe, 0x2a, 0x4d, 0x42, 0x0e,
+	0x01, 0x09, 0xaa, 0x6e, 0x77, 0x6b, 0xe8, 0xbf, 0x93, 0xbc, 0x82, 0xd0, 0x03, 0x33, 0x04, 0x96,
+	0x2c, 0xa3, 0x9d, 0x9e, 0xb7, 0x30, 0xf8, 0x49, 0x34, 0x62, 0xb8, 0xcf, 0xec, 0xe1, 0xd9, 0xcb,
+	0x0e, 0xeb, 0x43, 0xf6, 0xc8, 0x12, 0xe8, 0x2c, 0x7e, 0x8d, 0xa0, 0x61, 0x6a, 0x15, 0xdf, 0xe7,
+	0xa5, 0xc1, 0xbb, 0x08, 0xe3, 0xc5, 0x30, 0x7b, 0xe1, 0x3f, 0x7b, 0x11, 0x0f, 0x27, 0xd2, 0x61,
+	0x44, 0x48, 0xf5, 0x2b, 0x7f, 0xf8, 0xc7, 0x37, 0x53, 0x57, 0xf0, 0xe5, 0x62, 0xc8, 0xaf, 0x78,
+	0x60, 0xb1, 0xe7, 0xaf, 0x6f, 0xb2, 0x34, 0xbf, 0x55, 0xbc, 0xd9, 0xdd, 0x16, 0x70, 0xbd, 0xa4,
+	0x0f, 0xb0, 0x29, 0xbf, 0x85, 0xdf, 0xe7, 0x65, 0x60, 0xd9, 0xb7, 0x73, 0x48, 0x02, 0x99, 0x87,
+	0x44, 0x3c, 0x92, 0x4c, 0x09, 0x88, 0x96, 0x28, 0xd1, 0x93, 0xf8, 0x44, 0x3c, 0xa2, 0xa6, 0x8b,
+	0xa9, 0xc3, 0xe3, 0xb7, 0x3e, 0x1e, 0xfc, 0x90, 0x15, 0x8f, 0x87, 0xef, 0x20, 0x2b, 0x1e, 0x49,
+	0xa6, 0x04, 0x3c, 0x3e, 0x47, 0x79, 0x3c, 0x87, 0xcf, 0x44, 0xf0, 0xe0, 0xa7, 0xbb, 0xe2, 0x4d,
+	0x56, 0xea, 0x6f, 0x05, 0x11, 0xfa, 0x48, 0x40, 0x3b, 0xfc, 0x17, 0xd7, 0xb8, 0xd8, 0x13, 0x57,
+	0xf7, 0x8f, 0x1f, 0xc4, 0x43, 0xf1, 0x15, 0x80, 0xc4, 0x6b, 0x94, 0xc4, 0x25, 0xfc, 0x6a, 0x18,
+	0x09, 0xbb, 0xe0, 0x3d, 0x50, 0xca, 0xfd, 0x5c, 0x40, 0x13, 0xfe, 0xe1, 0x4d, 0x1c, 0x1b, 0xa9,
+	0x13, 0xa4, 0x85, 0x04, 0x1a, 0x40, 0xee, 0x14, 0x25, 0xf7, 0x59, 0x7c, 0x2c, 0x06, 0xb9, 0xc0,
+	0x34, 0xbb, 0xed, 0xc6, 0xee, 0xe4, 0x58, 0x34, 0x76, 0x7f, 0x82, 0x2d, 0x24, 0xd0, 0x00, 0xec,
+	0xe7, 0x28, 0xf6, 0x12, 0x7e, 0xa6, 0x17, 0xf6, 0x58, 0xa9, 0xf5, 0xb1, 0x43, 0xc2, 0xb5, 0x27,
+	0x8e, 0x22, 0xd1, 0x7d, 0x3b, 0x2d, 0x2e, 0x24, 0xd0, 0x00, 0x12, 0x32, 0x25, 0x71, 0x19, 0x5f,
+	0x0c, 0x25, 0x61, 0x6b, 0x3d, 0x50, 0x7a, 0xfd, 0x42, 0x40, 0xb8, 0x0b, 0x80, 0x89, 0xe3, 0x83,
+	0x75, 0x82, 0xb4, 0x98, 0x44, 0x05, 0x08, 0x3e, 0x43, 0x09, 0x9e, 0xc0, 0xc7, 0xe3, 0x10, 0x0c,
+	0x4c, 0xb1, 0x0f, 0x3c, 0xf8, 0x9d, 0x1c, 0x8b, 0x81, 0xdf, 0x9f, 0x64, 0x8b, 0x49, 0x54, 0x00,
+	0xff, 0x12, 0xc5, 0x7f, 0x06, 0x9f, 0xee, 0x89, 0x3f, 0x56, 0x9a, 0xdd, 0xee, 0x6c, 0xca, 0xfc,
+	0xf7, 0x30, 0xf8, 0x68, 0x4f, 0x68, 0xa1, 0xd7, 0x56, 0xe2, 0xb1, 0xc4, 0x7a, 0xc0, 0xeb, 0x28,
+	0xe5, 0x75, 0x08, 0x17, 0xc2, 0x78, 0x19, 0xa0, 0x4b, 0xef, 0x87, 0x1c, 0x3a, 0xf8, 0x3d, 0x01,
+	0x8d, 0x7b, 0x6e, 0x4e, 0xf0, 0x7c, 0x4f, 0x08, 0xbe, 0xfb, 0x1e, 0xf1, 0x60, 0x4c, 0x69, 0x80,
+	0xb9, 0x40, 0x61, 0x1e, 0xc0, 0x4f, 0x86, 0xc1, 0xac, 0xc8, 0x0a, 0xbd, 0x6b, 0xe9, 0x20, 0xfc,
+	0x80, 0x5f, 0xd4, 0x07, 0xdc, 0x67, 0x98, 0xb8, 0xb7, 0xc3, 0xc2, 0x6f, 0x4f, 0xc4, 0xe3, 0xc9,
+	0x15, 0xe3, 0xba, 0x1a, 0x9e, 0xd9, 0x8e, 0xab, 0x43, 0xe4, 0xdf, 0xfc, 0x92, 0xca, 0xdf, 0x3d,
+	0xc7, 0x47, 0xa2, 0x13, 0xb9, 0xfb, 0xda, 0x44, 0x7c, 0x2a, 0xa1, 0x16, 0xc0, 0x57, 0x29, 0xfc,
+	0x32, 0xbe, 0xd2, 0x73, 0x06, 0xd8, 0xcd, 0x82, 0x07, 0x2a, 0x53, 0xbf, 0x11, 0xd0, 0xee, 0x40,
+	0x20, 0x26, 0x4e, 0x06, 0xdc, 0x49, 0xb5, 0xa3, 0x49, 0xd5, 0x80, 0xf0, 0xb3, 0x94, 0xf0, 0xd3,
+	0xf8, 0x64, 0x5c, 0xc2, 0x81, 0x65, 0xeb, 0x4f, 0x5d, 0x7c, 0x9c, 0xd2, 0x15, 0x93, 0x8f, 0xbf,
+	0x7c, 0x1d, 0x4d, 0xaa, 0x06, 0x7c, 0x5e, 0xa0, 0x7c, 0x9e, 0xc7, 0xcf, 0x45, 0xf2, 0x89, 0x55,
+	0xc6, 0x3e, 0xe1, 0x3f, 0xd0, 0x0a, 0x68, 0x2c, 0x47, 0x4c, 0xaf, 0xf0, 0xa6, 0xba, 0x78, 0x3c,
+	0xb9, 0x22, 0xd0, 0x7b, 0x91, 0xd2, 0x3b, 0x87, 0xcf, 0x86, 0xd1, 0x5b, 0xa1, 0xca, 0x61, 0xeb,
+	0x0c, 0x6d, 0xd3, 0xbb, 0xf9, 0xfd, 0x94, 0x9f, 0x73, 0xbc, 0x6d, 0xcb, 0x88, 0x73, 0x4e, 0x60,
+	0x17, 0x5a, 0x3c, 0x9c, 0x48, 0x07, 0x08, 0x1d, 0xa3, 0x84, 0x16, 0x70, 0x31, 0xa2, 0x5e, 0x00,
+	0xe2, 0x4e, 0xc1, 0xf8, 0x19, 0x2f, 0x18, 0xfe, 0xbe, 0x54, 0x44, 0xc1, 0x08, 0x69, 0xaf, 0x89,
+	0x4f, 0x25, 0xd4, 0x02, 0xfc, 0x8b, 0x14, 0xff, 0x3c, 0x9e, 0x0b, 0xc5, 0xcf, 0x55, 0x3a, 0xd0,
+	0xbf, 0x27, 0xa0, 0x31, 0xd7, 0xf1, 0x19, 0xcf, 0x45, 0xac, 0x6b, 0xae, 0xce, 0x99, 0x78, 0x20,
+	0x96, 0x2c, 0x80, 0x3b, 0x4c, 0xc1, 0x1d, 0xc4, 0x07, 0xc2, 0xd7, 0x3d, 0xb5, 0xa2, 0x29, 0xc5,
+	0x9b, 0x9d, 0x66, 0x1c, 0x45, 0xb7, 0xcd, 0x65, 0xcc, 0xc4, 0x71, 0x86, 0x74, 0xdc, 0x38, 0x1f,
+	0x4f, 0x18, 0x00, 0x1e, 0xa2, 0x00, 0xe7, 0xf0, 0xfe, 0xde, 0x00, 0x5d, 0xbe, 0xfb, 0x3d, 0x3f,
+	0x19, 0xb9, 0x5a, 0x0f, 0x11, 0x27, 0xa3, 0xee, 0xb6, 0x90, 0x78, 0x28, 0xbe, 0x02, 0x20, 0xbd,
+	0x44, 0x91, 0x5e, 0xc0, 0x52, 0x6f, 0xa4, 0xd7, 0x6d, 0x25, 0xd7, 0x84, 0x0b, 0xe8, 0x32, 0xd1,
+	0x69, 0xe8, 0xea, 0x25, 0xdd, 0xc2, 0xef, 0x38, 0xf9, 0xc0, 0x96, 0xed, 0x88, 0x7c, 0x70, 0xf7,
+	0x41, 0xc4, 0x03, 0xb1, 0x64, 0x81, 0xc4, 0x2c, 0x25, 0x31, 0x83, 0x73, 0xc5, 0x9e, 0xff, 0x35,
+	0xa8, 0xb4, 0x7c, 0xe7, 0xef, 0xb9, 0x81, 0x1f, 0xdc, 0xcb, 0x0d, 0xdc, 0xb9, 0x97, 0x13, 0xee,
+	0xde, 0xcb, 0x09, 0x7f, 0xbb, 0x97, 0x13, 0xde, 0xbd, 0x9f, 0x1b, 0xb8, 0x7b, 0x3f, 0x37, 0xf0,
+	0xe7, 0xfb, 0xb9, 0x81, 0x4b, 0x0b, 0x9e, 0x0e, 0x92, 0x6d, 0xeb, 0xa0, 0xbe, 0xb2, 0xa2, 0x55,
+	0x35, 0xb9, 0xce, 0x6d, 0x77, 0xac, 0xd3, 0x86, 0x52, 0x65, 0x84, 0x6e, 0xb2, 0x0e, 0xff, 0x77,
+	0x00, 0xb1, 0xbb, 0x67, 0x53, 0x54, 0x35, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	QuerySurplusAuction(ctx context.Context, in *QuerySurplusAuctionRequest, opts ...grpc.CallOption) (*QuerySurplusAuctionResponse, error)
+	QuerySurplusAuctions(ctx context.Context, in *QuerySurplusAuctionsRequest, opts ...grpc.CallOption) (*QuerySurplusAuctionsResponse, error)
+	QuerySurplusBiddings(ctx context.Context, in *QuerySurplusBiddingsRequest, opts ...grpc.CallOption) (*QuerySurplusBiddingsResponse, error)
+	QueryDebtAuction(ctx context.Context, in *QueryDebtAuctionRequest, opts ...grpc.CallOption) (*QueryDebtAuctionResponse, error)
+	QueryDebtAuctions(ctx context.Context, in *QueryDebtAuctionsRequest, opts ...grpc.CallOption) (*QueryDebtAuctionsResponse, error)
+	QueryDebtBiddings(ctx context.Context, in *QueryDebtBiddingsRequest, opts ...grpc.CallOption) (*QueryDebtBiddingsResponse, error)
+	QueryDutchAuction(ctx context.Context, in *QueryDutchAuctionRequest, opts ...grpc.CallOption) (*QueryDutchAuctionResponse, error)
+	QueryDutchAuctions(ctx context.Context, in *QueryDutchAuctionsRequest, opts ...grpc.CallOption) (*QueryDutchAuctionsResponse, error)
+	QueryDutchBiddings(ctx context.Context, in *QueryDutchBiddingsRequest, opts ...grpc.CallOption) (*QueryDutchBiddingsResponse, error)
+	QueryProtocolStatistics(ctx context.Context, in *QueryProtocolStatisticsRequest, opts ...grpc.CallOption) (*QueryProtocolStatisticsResponse, error)
+	QueryBadDebts(ctx context.Context, in *QueryBadDebtsRequest, opts ...grpc.CallOption) (*QueryBadDebtsResponse, error)
+	QueryGenericAuctionParams(ctx context.Context, in *QueryGenericAuctionParamRequest, opts ...grpc.CallOption) (*QueryGenericAuctionParamResponse, error)
+	QueryDutchLendAuction(ctx context.Context, in *QueryDutchLendAuctionRequest, opts ...grpc.CallOption) (*QueryDutchLendAuctionResponse, error)
+	QueryDutchLendAuctions(ctx context.Context, in *QueryDutchLendAuctionsRequest, opts ...grpc.CallOption) (*QueryDutchLendAuctionsResponse, error)
+	QueryDutchLendBiddings(ctx context.Context, in *QueryDutchLendBiddingsRequest, opts ...grpc.CallOption) (*QueryDutchLendBiddingsResponse, error)
+	QueryFilterDutchAuctions(ctx context.Context, in *QueryFilterDutchAuctionsRequest, opts ...grpc.CallOption) (*QueryFilterDutchAuctionsResponse, error)
+	QueryAuctionHistory(ctx context.Context, in *QueryAuctionHistoryRequest, opts ...grpc.CallOption) (*QueryAuctionHistoryResponse, error)
+	QueryAuctionAnalytics(ctx context.Context, in *QueryAuctionAnalyticsRequest, opts ...grpc.CallOption) (*QueryAuctionAnalyticsResponse, error)
+	QueryPreBid(ctx context.Context, in *QueryPreBidRequest, opts ...grpc.CallOption) (*QueryPreBidResponse, error)
+	QueryPreBids(ctx context.Context, in *QueryPreBidsRequest, opts ...grpc.CallOption) (*QueryPreBidsResponse, error)
+	QueryPreBidQueue(ctx context.Context, in *QueryPreBidQueueRequest, opts ...grpc.CallOption) (*QueryPreBidQueueResponse, error)
+	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QuerySurplusAuction(ctx context.Context, in *QuerySurplusAuctionRequest, opts ...grpc.CallOption) (*QuerySurplusAuctionResponse, error) {
+	out := new(QuerySurplusAuctionResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QuerySurplusAuction", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDebtBiddings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QueryDebtBiddings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDebtBiddings(ctx, req.(*QueryDebtBiddingsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryDutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDutchAuctionRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QuerySurplusAuctions(ctx context.Context, in *QuerySurplusAuctionsRequest, opts ...grpc.CallOption) (*QuerySurplusAuctionsResponse, error) {
+	out := new(QuerySurplusAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QuerySurplusAuctions", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDutchAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QueryDutchAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDutchAuction(ctx, req.(*QueryDutchAuctionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryDutchAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDutchAuctionsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QuerySurplusBiddings(ctx context.Context, in *QuerySurplusBiddingsRequest, opts ...grpc.CallOption) (*QuerySurplusBiddingsResponse, error) {
+	out := new(QuerySurplusBiddingsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QuerySurplusBiddings", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDutchAuctions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QueryDutchAuctions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDutchAuctions(ctx, req.(*QueryDutchAuctionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryDutchBiddings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDutchBiddingsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryDebtAuction(ctx context.Context, in *QueryDebtAuctionRequest, opts ...grpc.CallOption) (*QueryDebtAuctionResponse, error) {
+	out := new(QueryDebtAuctionResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDebtAuction", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDutchBiddings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QueryDutchBiddings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDutchBiddings(ctx, req.(*QueryDutchBiddingsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryProtocolStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProtocolStatisticsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryDebtAuctions(ctx context.Context, in *QueryDebtAuctionsRequest, opts ...grpc.CallOption) (*QueryDebtAuctionsResponse, error) {
+	out := new(QueryDebtAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDebtAuctions", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryProtocolStatistics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QueryProtocolStatistics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryProtocolStatistics(ctx, req.(*QueryProtocolStatisticsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryGenericAuctionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGenericAuctionParamRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryDebtBiddings(ctx context.Context, in *QueryDebtBiddingsRequest, opts ...grpc.CallOption) (*QueryDebtBiddingsResponse, error) {
+	out := new(QueryDebtBiddingsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDebtBiddings", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryGenericAuctionParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QueryGenericAuctionParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryGenericAuctionParams(ctx, req.(*QueryGenericAuctionParamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryDutchLendAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDutchLendAuctionRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryDutchAuction(ctx context.Context, in *QueryDutchAuctionRequest, opts ...grpc.CallOption) (*QueryDutchAuctionResponse, error) {
+	out := new(QueryDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDutchAuction", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDutchLendAuction(ctx, in)
-	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDutchAuctions(ctx context.Context, in *QueryDutchAuctionsRequest, opts ...grpc.CallOption) (*QueryDutchAuctionsResponse, error) {
+	out := new(QueryDutchAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDutchAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDutchBiddings(ctx context.Context, in *QueryDutchBiddingsRequest, opts ...grpc.CallOption) (*QueryDutchBiddingsResponse, error) {
+	out := new(QueryDutchBiddingsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDutchBiddings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryProtocolStatistics(ctx context.Context, in *QueryProtocolStatisticsRequest, opts ...grpc.CallOption) (*QueryProtocolStatisticsResponse, error) {
+	out := new(QueryProtocolStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryProtocolStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryBadDebts(ctx context.Context, in *QueryBadDebtsRequest, opts ...grpc.CallOption) (*QueryBadDebtsResponse, error) {
+	out := new(QueryBadDebtsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryBadDebts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryGenericAuctionParams(ctx context.Context, in *QueryGenericAuctionParamRequest, opts ...grpc.CallOption) (*QueryGenericAuctionParamResponse, error) {
+	out := new(QueryGenericAuctionParamResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryGenericAuctionParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDutchLendAuction(ctx context.Context, in *QueryDutchLendAuctionRequest, opts ...grpc.CallOption) (*QueryDutchLendAuctionResponse, error) {
+	out := new(QueryDutchLendAuctionResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDutchLendAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDutchLendAuctions(ctx context.Context, in *QueryDutchLendAuctionsRequest, opts ...grpc.CallOption) (*QueryDutchLendAuctionsResponse, error) {
+	out := new(QueryDutchLendAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDutchLendAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDutchLendBiddings(ctx context.Context, in *QueryDutchLendBiddingsRequest, opts ...grpc.CallOption) (*QueryDutchLendBiddingsResponse, error) {
+	out := new(QueryDutchLendBiddingsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryDutchLendBiddings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryFilterDutchAuctions(ctx context.Context, in *QueryFilterDutchAuctionsRequest, opts ...grpc.CallOption) (*QueryFilterDutchAuctionsResponse, error) {
+	out := new(QueryFilterDutchAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryFilterDutchAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAuctionHistory(ctx context.Context, in *QueryAuctionHistoryRequest, opts ...grpc.CallOption) (*QueryAuctionHistoryResponse, error) {
+	out := new(QueryAuctionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryAuctionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAuctionAnalytics(ctx context.Context, in *QueryAuctionAnalyticsRequest, opts ...grpc.CallOption) (*QueryAuctionAnalyticsResponse, error) {
+	out := new(QueryAuctionAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryAuctionAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPreBid(ctx context.Context, in *QueryPreBidRequest, opts ...grpc.CallOption) (*QueryPreBidResponse, error) {
+	out := new(QueryPreBidResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryPreBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPreBids(ctx context.Context, in *QueryPreBidsRequest, opts ...grpc.CallOption) (*QueryPreBidsResponse, error) {
+	out := new(QueryPreBidsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryPreBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPreBidQueue(ctx context.Context, in *QueryPreBidQueueRequest, opts ...grpc.CallOption) (*QueryPreBidQueueResponse, error) {
+	out := new(QueryPreBidQueueResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryPreBidQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Query/QueryParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	QuerySurplusAuction(context.Context, *QuerySurplusAuctionRequest) (*QuerySurplusAuctionResponse, error)
+	QuerySurplusAuctions(context.Context, *QuerySurplusAuctionsRequest) (*QuerySurplusAuctionsResponse, error)
+	QuerySurplusBiddings(context.Context, *QuerySurplusBiddingsRequest) (*QuerySurplusBiddingsResponse, error)
+	QueryDebtAuction(context.Context, *QueryDebtAuctionRequest) (*QueryDebtAuctionResponse, error)
+	QueryDebtAuctions(context.Context, *QueryDebtAuctionsRequest) (*QueryDebtAuctionsResponse, error)
+	QueryDebtBiddings(context.Context, *QueryDebtBiddingsRequest) (*QueryDebtBiddingsResponse, error)
+	QueryDutchAuction(context.Context, *QueryDutchAuctionRequest) (*QueryDutchAuctionResponse, error)
+	QueryDutchAuctions(context.Context, *QueryDutchAuctionsRequest) (*QueryDutchAuctionsResponse, error)
+	QueryDutchBiddings(context.Context, *QueryDutchBiddingsRequest) (*QueryDutchBiddingsResponse, error)
+	QueryProtocolStatistics(context.Context, *QueryProtocolStatisticsRequest) (*QueryProtocolStatisticsResponse, error)
+	QueryBadDebts(context.Context, *QueryBadDebtsRequest) (*QueryBadDebtsResponse, error)
+	QueryGenericAuctionParams(context.Context, *QueryGenericAuctionParamRequest) (*QueryGenericAuctionParamResponse, error)
+	QueryDutchLendAuction(context.Context, *QueryDutchLendAuctionRequest) (*QueryDutchLendAuctionResponse, error)
+	QueryDutchLendAuctions(context.Context, *QueryDutchLendAuctionsRequest) (*QueryDutchLendAuctionsResponse, error)
+	QueryDutchLendBiddings(context.Context, *QueryDutchLendBiddingsRequest) (*QueryDutchLendBiddingsResponse, error)
+	QueryFilterDutchAuctions(context.Context, *QueryFilterDutchAuctionsRequest) (*QueryFilterDutchAuctionsResponse, error)
+	QueryAuctionHistory(context.Context, *QueryAuctionHistoryRequest) (*QueryAuctionHistoryResponse, error)
+	QueryAuctionAnalytics(context.Context, *QueryAuctionAnalyticsRequest) (*QueryAuctionAnalyticsResponse, error)
+	QueryPreBid(context.Context, *QueryPreBidRequest) (*QueryPreBidResponse, error)
+	QueryPreBids(context.Context, *QueryPreBidsRequest) (*QueryPreBidsResponse, error)
+	QueryPreBidQueue(context.Context, *QueryPreBidQueueRequest) (*QueryPreBidQueueResponse, error)
+	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QuerySurplusAuction(ctx context.Context, req *QuerySurplusAuctionRequest) (*QuerySurplusAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySurplusAuction not implemented")
+}
+func (*UnimplementedQueryServer) QuerySurplusAuctions(ctx context.Context, req *QuerySurplusAuctionsRequest) (*QuerySurplusAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySurplusAuctions not implemented")
+}
+func (*UnimplementedQueryServer) QuerySurplusBiddings(ctx context.Context, req *QuerySurplusBiddingsRequest) (*QuerySurplusBiddingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySurplusBiddings not implemented")
+}
+func (*UnimplementedQueryServer) QueryDebtAuction(ctx context.Context, req *QueryDebtAuctionRequest) (*QueryDebtAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDebtAuction not implemented")
+}
+func (*UnimplementedQueryServer) QueryDebtAuctions(ctx context.Context, req *QueryDebtAuctionsRequest) (*QueryDebtAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDebtAuctions not implemented")
+}
+func (*UnimplementedQueryServer) QueryDebtBiddings(ctx context.Context, req *QueryDebtBiddingsRequest) (*QueryDebtBiddingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDebtBiddings not implemented")
+}
+func (*UnimplementedQueryServer) QueryDutchAuction(ctx context.Context, req *QueryDutchAuctionRequest) (*QueryDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDutchAuction not implemented")
+}
+func (*UnimplementedQueryServer) QueryDutchAuctions(ctx context.Context, req *QueryDutchAuctionsRequest) (*QueryDutchAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDutchAuctions not implemented")
+}
+func (*UnimplementedQueryServer) QueryDutchBiddings(ctx context.Context, req *QueryDutchBiddingsRequest) (*QueryDutchBiddingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDutchBiddings not implemented")
+}
+func (*UnimplementedQueryServer) QueryProtocolStatistics(ctx context.Context, req *QueryProtocolStatisticsRequest) (*QueryProtocolStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProtocolStatistics not implemented")
+}
+func (*UnimplementedQueryServer) QueryBadDebts(ctx context.Context, req *QueryBadDebtsRequest) (*QueryBadDebtsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBadDebts not implemented")
+}
+func (*UnimplementedQueryServer) QueryGenericAuctionParams(ctx context.Context, req *QueryGenericAuctionParamRequest) (*QueryGenericAuctionParamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGenericAuctionParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryDutchLendAuction(ctx context.Context, req *QueryDutchLendAuctionRequest) (*QueryDutchLendAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDutchLendAuction not implemented")
+}
+func (*UnimplementedQueryServer) QueryDutchLendAuctions(ctx context.Context, req *QueryDutchLendAuctionsRequest) (*QueryDutchLendAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDutchLendAuctions not implemented")
+}
+func (*UnimplementedQueryServer) QueryDutchLendBiddings(ctx context.Context, req *QueryDutchLendBiddingsRequest) (*QueryDutchLendBiddingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDutchLendBiddings not implemented")
+}
+func (*UnimplementedQueryServer) QueryFilterDutchAuctions(ctx context.Context, req *QueryFilterDutchAuctionsRequest) (*QueryFilterDutchAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFilterDutchAuctions not implemented")
+}
+func (*UnimplementedQueryServer) QueryAuctionHistory(ctx context.Context, req *QueryAuctionHistoryRequest) (*QueryAuctionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuctionHistory not implemented")
+}
+func (*UnimplementedQueryServer) QueryAuctionAnalytics(ctx context.Context, req *QueryAuctionAnalyticsRequest) (*QueryAuctionAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuctionAnalytics not implemented")
+}
+func (*UnimplementedQueryServer) QueryPreBid(ctx context.Context, req *QueryPreBidRequest) (*QueryPreBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPreBid not implemented")
+}
+func (*UnimplementedQueryServer) QueryPreBids(ctx context.Context, req *QueryPreBidsRequest) (*QueryPreBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPreBids not implemented")
+}
+func (*UnimplementedQueryServer) QueryPreBidQueue(ctx context.Context, req *QueryPreBidQueueRequest) (*QueryPreBidQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPreBidQueue not implemented")
+}
+func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QuerySurplusAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySurplusAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySurplusAuction(ctx, in)
+	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QueryDutchLendAuction",
+		FullMethod: "/comdex.auction.v1beta1.Query/QuerySurplusAuction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDutchLendAuction(ctx, req.(*QueryDutchLendAuctionRequest))
+		return srv.(QueryServer).QuerySurplusAuction(ctx, req.(*QuerySurplusAuctionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_QueryDutchLendAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDutchLendAuctionsRequest)
+func _Query_QuerySurplusAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySurplusAuctionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).QueryDutchLendAuctions(ctx, in)
+		return srv.(QueryServer).QuerySurplusAuctions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QueryDutchLendAuctions",
+		FullMethod: "/comdex.auction.v1beta1.Query/QuerySurplusAuctions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDutchLendAuctions(ctx, req.(*QueryDutchLendAuctionsRequest))
+		return srv.(QueryServer).QuerySurplusAuctions(ctx, req.(*QuerySurplusAuctionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_QueryDutchLendBiddings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDutchLendBiddingsRequest)
+func _Query_QuerySurplusBiddings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySurplusBiddingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).QueryDutchLendBiddings(ctx, in)
+		return srv.(QueryServer).QuerySurplusBiddings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QueryDutchLendBiddings",
+		FullMethod: "/comdex.auction.v1beta1.Query/QuerySurplusBiddings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDutchLendBiddings(ctx, req.(*QueryDutchLendBiddingsRequest))
+		return srv.(QueryServer).QuerySurplusBiddings(ctx, req.(*QuerySurplusBiddingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_QueryFilterDutchAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFilterDutchAuctionsRequest)
+func _Query_QueryDebtAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDebtAuctionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).QueryFilterDutchAuctions(ctx, in)
+		return srv.(QueryServer).QueryDebtAuction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/comdex.auction.v1beta1.Query/QueryFilterDutchAuctions",
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryDebtAuction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryFilterDutchAuctions(ctx, req.(*QueryFilterDutchAuctionsRequest))
+		return srv.(QueryServer).QueryDebtAuction(ctx, req.(*QueryDebtAuctionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "comdex.auction.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QuerySurplusAuction",
-			Handler:    _Query_QuerySurplusAuction_Handler,
-		},
-		{
-			MethodName: "QuerySurplusAuctions",
-			Handler:    _Query_QuerySurplusAuctions_Handler,
-		},
-		{
-			MethodName: "QuerySurplusBiddings",
-			Handler:    _Query_QuerySurplusBiddings_Handler,
-		},
-		{
-			MethodName: "QueryDebtAuction",
-			Handler:    _Query_QueryDebtAuction_Handler,
-		},
-		{
-			MethodName: "QueryDebtAuctions",
-			Handler:    _Query_QueryDebtAuctions_Handler,
-		},
-		{
-			MethodName: "QueryDebtBiddings",
-			Handler:    _Query_QueryDebtBiddings_Handler,
-		},
-		{
-			MethodName: "QueryDutchAuction",
-			Handler:    _Query_QueryDutchAuction_Handler,
-		},
-		{
-			MethodName: "QueryDutchAuctions",
-			Handler:    _Query_QueryDutchAuctions_Handler,
-		},
-		{
-			MethodName: "QueryDutchBiddings",
-			Handler:    _Query_QueryDutchBiddings_Handler,
-		},
-		{
-			MethodName: "QueryProtocolStatistics",
-			Handler:    _Query_QueryProtocolStatistics_Handler,
-		},
-		{
-			MethodName: "QueryGenericAuctionParams",
-			Handler:    _Query_QueryGenericAuctionParams_Handler,
-		},
-		{
-			MethodName: "QueryDutchLendAuction",
-			Handler:    _Query_QueryDutchLendAuction_Handler,
-		},
-		{
-			MethodName: "QueryDutchLendAuctions",
-			Handler:    _Query_QueryDutchLendAuctions_Handler,
-		},
-		{
-			MethodName: "QueryDutchLendBiddings",
-			Handler:    _Query_QueryDutchLendBiddings_Handler,
-		},
-		{
-			MethodName: "QueryFilterDutchAuctions",
-			Handler:    _Query_QueryFilterDutchAuctions_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "comdex/auction/v1beta1/query.proto",
-}
-
-func (m *QuerySurplusAuctionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryDebtAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDebtAuctionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySurplusAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDebtAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryDebtAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDebtAuctions(ctx, req.(*QueryDebtAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySurplusAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.History {
-		i--
-		if m.History {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+func _Query_QueryDebtBiddings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDebtBiddingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDebtBiddings(ctx, in)
 	}
-	if m.AuctionMappingId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionMappingId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryDebtBiddings",
 	}
-	if m.AppId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDebtBiddings(ctx, req.(*QueryDebtBiddingsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySurplusAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryDutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutchAuctionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySurplusAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySurplusAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDutchAuction(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryDutchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDutchAuction(ctx, req.(*QueryDutchAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySurplusAuctionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryDutchAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutchAuctionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDutchAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryDutchAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDutchAuctions(ctx, req.(*QueryDutchAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySurplusAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryDutchBiddings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutchBiddingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDutchBiddings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryDutchBiddings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDutchBiddings(ctx, req.(*QueryDutchBiddingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySurplusAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Query_QueryProtocolStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.History {
-		i--
-		if m.History {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProtocolStatistics(ctx, in)
 	}
-	if m.AppId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryProtocolStatistics",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProtocolStatistics(ctx, req.(*QueryProtocolStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySurplusAuctionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryBadDebts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBadDebtsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBadDebts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryBadDebts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBadDebts(ctx, req.(*QueryBadDebtsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySurplusAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySurplusAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_QueryGenericAuctionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGenericAuctionParamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Auctions) > 0 {
-		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGenericAuctionParams(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryGenericAuctionParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGenericAuctionParams(ctx, req.(*QueryGenericAuctionParamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySurplusBiddingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryDutchLendAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutchLendAuctionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySurplusBiddingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDutchLendAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryDutchLendAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDutchLendAuction(ctx, req.(*QueryDutchLendAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySurplusBiddingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func _Query_QueryDutchLendAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutchLendAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.History {
-		i--
-		if m.History {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDutchLendAuctions(ctx, in)
 	}
-	if m.AppId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryDutchLendAuctions",
 	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDutchLendAuctions(ctx, req.(*QueryDutchLendAuctionsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySurplusBiddingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryDutchLendBiddings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutchLendBiddingsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySurplusBiddingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySurplusBiddingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDutchLendBiddings(ctx, in)
 	}
-	if len(m.Biddings) > 0 {
-		for iNdEx := len(m.Biddings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Biddings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryDutchLendBiddings",
 	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDutchLendBiddings(ctx, req.(*QueryDutchLendBiddingsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDebtAuctionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryFilterDutchAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFilterDutchAuctionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDebtAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFilterDutchAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryFilterDutchAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFilterDutchAuctions(ctx, req.(*QueryFilterDutchAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDebtAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.History {
-		i--
-		if m.History {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+func _Query_QueryAuctionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAuctionHistory(ctx, in)
 	}
-	if m.AuctionMappingId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionMappingId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryAuctionHistory",
 	}
-	if m.AppId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAuctionHistory(ctx, req.(*QueryAuctionHistoryRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDebtAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryAuctionAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionAnalyticsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAuctionAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryAuctionAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAuctionAnalytics(ctx, req.(*QueryAuctionAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDebtAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+func _Query_QueryPreBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPreBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryPreBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPreBid(ctx, req.(*QueryPreBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPreBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPreBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryPreBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPreBids(ctx, req.(*QueryPreBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPreBidQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreBidQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPreBidQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryPreBidQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPreBidQueue(ctx, req.(*QueryPreBidQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Query/QueryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "comdex.auction.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QuerySurplusAuction",
+			Handler:    _Query_QuerySurplusAuction_Handler,
+		},
+		{
+			MethodName: "QuerySurplusAuctions",
+			Handler:    _Query_QuerySurplusAuctions_Handler,
+		},
+		{
+			MethodName: "QuerySurplusBiddings",
+			Handler:    _Query_QuerySurplusBiddings_Handler,
+		},
+		{
+			MethodName: "QueryDebtAuction",
+			Handler:    _Query_QueryDebtAuction_Handler,
+		},
+		{
+			MethodName: "QueryDebtAuctions",
+			Handler:    _Query_QueryDebtAuctions_Handler,
+		},
+		{
+			MethodName: "QueryDebtBiddings",
+			Handler:    _Query_QueryDebtBiddings_Handler,
+		},
+		{
+			MethodName: "QueryDutchAuction",
+			Handler:    _Query_QueryDutchAuction_Handler,
+		},
+		{
+			MethodName: "QueryDutchAuctions",
+			Handler:    _Query_QueryDutchAuctions_Handler,
+		},
+		{
+			MethodName: "QueryDutchBiddings",
+			Handler:    _Query_QueryDutchBiddings_Handler,
+		},
+		{
+			MethodName: "QueryProtocolStatistics",
+			Handler:    _Query_QueryProtocolStatistics_Handler,
+		},
+		{
+			MethodName: "QueryBadDebts",
+			Handler:    _Query_QueryBadDebts_Handler,
+		},
+		{
+			MethodName: "QueryGenericAuctionParams",
+			Handler:    _Query_QueryGenericAuctionParams_Handler,
+		},
+		{
+			MethodName: "QueryDutchLendAuction",
+			Handler:    _Query_QueryDutchLendAuction_Handler,
+		},
+		{
+			MethodName: "QueryDutchLendAuctions",
+			Handler:    _Query_QueryDutchLendAuctions_Handler,
+		},
+		{
+			MethodName: "QueryDutchLendBiddings",
+			Handler:    _Query_QueryDutchLendBiddings_Handler,
+		},
+		{
+			MethodName: "QueryFilterDutchAuctions",
+			Handler:    _Query_QueryFilterDutchAuctions_Handler,
+		},
+		{
+			MethodName: "QueryAuctionHistory",
+			Handler:    _Query_QueryAuctionHistory_Handler,
+		},
+		{
+			MethodName: "QueryAuctionAnalytics",
+			Handler:    _Query_QueryAuctionAnalytics_Handler,
+		},
+		{
+			MethodName: "QueryPreBid",
+			Handler:    _Query_QueryPreBid_Handler,
+		},
+		{
+			MethodName: "QueryPreBids",
+			Handler:    _Query_QueryPreBids_Handler,
+		},
+		{
+			MethodName: "QueryPreBidQueue",
+			Handler:    _Query_QueryPreBidQueue_Handler,
+		},
+		{
+			MethodName: "QueryParams",
+			Handler:    _Query_QueryParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comdex/auction/v1beta1/query.proto",
+}
+
+func (m *QuerySurplusAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySurplusAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDebtAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySurplusAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.History {
+		i--
+		if m.History {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AuctionMappingId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionMappingId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySurplusAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySurplusAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySurplusAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
 		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2378,7 +2989,7 @@ func (m *QueryDebtAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDebtAuctionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySurplusAuctionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2388,12 +2999,12 @@ func (m *QueryDebtAuctionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDebtAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySurplusAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDebtAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySurplusAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2428,7 +3039,7 @@ func (m *QueryDebtAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDebtAuctionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySurplusAuctionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2438,12 +3049,12 @@ func (m *QueryDebtAuctionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDebtAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySurplusAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDebtAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySurplusAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2477,7 +3088,7 @@ func (m *QueryDebtAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDebtBiddingsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySurplusBiddingsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2487,12 +3098,12 @@ func (m *QueryDebtBiddingsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDebtBiddingsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySurplusBiddingsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDebtBiddingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySurplusBiddingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2534,7 +3145,7 @@ func (m *QueryDebtBiddingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDebtBiddingsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySurplusBiddingsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2544,12 +3155,12 @@ func (m *QueryDebtBiddingsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDebtBiddingsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySurplusBiddingsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDebtBiddingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySurplusBiddingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2590,7 +3201,7 @@ func (m *QueryDebtBiddingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchAuctionRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDebtAuctionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2600,12 +3211,12 @@ func (m *QueryDutchAuctionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDutchAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDebtAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDebtAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2638,7 +3249,7 @@ func (m *QueryDutchAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchAuctionResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDebtAuctionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2648,16 +3259,28 @@ func (m *QueryDutchAuctionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDutchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDebtAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDebtAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2671,7 +3294,7 @@ func (m *QueryDutchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchAuctionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDebtAuctionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2681,12 +3304,12 @@ func (m *QueryDutchAuctionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDutchAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDebtAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDebtAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2721,7 +3344,7 @@ func (m *QueryDutchAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchAuctionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDebtAuctionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2731,12 +3354,12 @@ func (m *QueryDutchAuctionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDutchAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDebtAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDebtAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2770,7 +3393,7 @@ func (m *QueryDutchAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchBiddingsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDebtBiddingsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2780,12 +3403,12 @@ func (m *QueryDutchBiddingsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDutchBiddingsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDebtBiddingsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchBiddingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDebtBiddingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2827,7 +3450,7 @@ func (m *QueryDutchBiddingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchBiddingsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDebtBiddingsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2837,12 +3460,12 @@ func (m *QueryDutchBiddingsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDutchBiddingsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDebtBiddingsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchBiddingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDebtBiddingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2883,7 +3506,7 @@ func (m *QueryDutchBiddingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBiddingsForSurplusAuctionRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDutchAuctionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2893,28 +3516,16 @@ func (m *QueryBiddingsForSurplusAuctionRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryBiddingsForSurplusAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDutchAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBiddingsForSurplusAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDutchAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
 	if m.History {
 		i--
 		if m.History {
@@ -2943,7 +3554,7 @@ func (m *QueryBiddingsForSurplusAuctionRequest) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBiddingsForSurplusAuctionResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDutchAuctionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2953,46 +3564,30 @@ func (m *QueryBiddingsForSurplusAuctionResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryBiddingsForSurplusAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDutchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBiddingsForSurplusAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDutchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Biddings) > 0 {
-		for iNdEx := len(m.Biddings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Biddings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryProtocolStatisticsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDutchAuctionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3002,12 +3597,12 @@ func (m *QueryProtocolStatisticsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryProtocolStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDutchAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProtocolStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDutchAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3022,7 +3617,17 @@ func (m *QueryProtocolStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
+	}
+	if m.History {
+		i--
+		if m.History {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.AppId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
@@ -3032,7 +3637,7 @@ func (m *QueryProtocolStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryProtocolStatisticsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDutchAuctionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3042,12 +3647,12 @@ func (m *QueryProtocolStatisticsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryProtocolStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDutchAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProtocolStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDutchAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3064,10 +3669,10 @@ func (m *QueryProtocolStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Stats) > 0 {
-		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3081,7 +3686,7 @@ func (m *QueryProtocolStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGenericAuctionParamRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDutchBiddingsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3091,25 +3696,54 @@ func (m *QueryGenericAuctionParamRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGenericAuctionParamRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDutchBiddingsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGenericAuctionParamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDutchBiddingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.History {
+		i--
+		if m.History {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.AppId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGenericAuctionParamResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDutchBiddingsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3119,30 +3753,53 @@ func (m *QueryGenericAuctionParamResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGenericAuctionParamResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDutchBiddingsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGenericAuctionParamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDutchBiddingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.AuctionParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Biddings) > 0 {
+		for iNdEx := len(m.Biddings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Biddings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchLendAuctionRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBiddingsForSurplusAuctionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3152,16 +3809,28 @@ func (m *QueryDutchLendAuctionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDutchLendAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBiddingsForSurplusAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchLendAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBiddingsForSurplusAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.History {
 		i--
 		if m.History {
@@ -3190,7 +3859,7 @@ func (m *QueryDutchLendAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchLendAuctionResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBiddingsForSurplusAuctionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3200,30 +3869,46 @@ func (m *QueryDutchLendAuctionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDutchLendAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBiddingsForSurplusAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchLendAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBiddingsForSurplusAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Biddings) > 0 {
+		for iNdEx := len(m.Biddings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Biddings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchLendAuctionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryProtocolStatisticsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3233,12 +3918,12 @@ func (m *QueryDutchLendAuctionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDutchLendAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProtocolStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchLendAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProtocolStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3253,27 +3938,17 @@ func (m *QueryDutchLendAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.History {
-		i--
-		if m.History {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.AppId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+	if m.AppId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchLendAuctionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryProtocolStatisticsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3283,12 +3958,12 @@ func (m *QueryDutchLendAuctionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDutchLendAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProtocolStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchLendAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProtocolStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3305,10 +3980,10 @@ func (m *QueryDutchLendAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Auctions) > 0 {
-		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3322,7 +3997,7 @@ func (m *QueryDutchLendAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchLendBiddingsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBadDebtsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3332,12 +4007,12 @@ func (m *QueryDutchLendBiddingsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDutchLendBiddingsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBadDebtsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchLendBiddingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBadDebtsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3352,34 +4027,17 @@ func (m *QueryDutchLendBiddingsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.History {
-		i--
-		if m.History {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
 	if m.AppId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchLendBiddingsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBadDebtsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3389,12 +4047,12 @@ func (m *QueryDutchLendBiddingsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDutchLendBiddingsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBadDebtsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchLendBiddingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBadDebtsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3409,12 +4067,12 @@ func (m *QueryDutchLendBiddingsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if len(m.Biddings) > 0 {
-		for iNdEx := len(m.Biddings) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BadDebts) > 0 {
+		for iNdEx := len(m.BadDebts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Biddings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BadDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3422,20 +4080,41 @@ func (m *QueryDutchLendBiddingsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGenericAuctionParamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGenericAuctionParamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGenericAuctionParamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFilterDutchAuctionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGenericAuctionParamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3445,12 +4124,126 @@ func (m *QueryFilterDutchAuctionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFilterDutchAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGenericAuctionParamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFilterDutchAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGenericAuctionParamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AuctionParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutchLendAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutchLendAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutchLendAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.History {
+		i--
+		if m.History {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AuctionMappingId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionMappingId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutchLendAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutchLendAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutchLendAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutchLendAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutchLendAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutchLendAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3465,7 +4258,7 @@ func (m *QueryFilterDutchAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
 	if m.History {
 		i--
@@ -3475,16 +4268,7 @@ func (m *QueryFilterDutchAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Denom) > 0 {
-		for iNdEx := len(m.Denom) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denom[iNdEx])
-			copy(dAtA[i:], m.Denom[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+		dAtA[i] = 0x10
 	}
 	if m.AppId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
@@ -3494,7 +4278,7 @@ func (m *QueryFilterDutchAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFilterDutchAuctionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDutchLendAuctionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3504,12 +4288,12 @@ func (m *QueryFilterDutchAuctionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFilterDutchAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDutchLendAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFilterDutchAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDutchLendAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3543,473 +4327,827 @@ func (m *QueryFilterDutchAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDutchLendBiddingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QuerySurplusAuctionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryDutchLendBiddingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutchLendBiddingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.AuctionMappingId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionMappingId))
+	if m.History {
+		i--
+		if m.History {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
+	if m.AppId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.History {
-		n += 2
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySurplusAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDutchLendBiddingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Auction.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySurplusAuctionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
-	}
-	if m.History {
-		n += 2
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryDutchLendBiddingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySurplusAuctionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDutchLendBiddingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Auctions) > 0 {
-		for _, e := range m.Auctions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Biddings) > 0 {
+		for iNdEx := len(m.Biddings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Biddings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySurplusBiddingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFilterDutchAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilterDutchAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilterDutchAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.History {
-		n += 2
+		i--
+		if m.History {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		for iNdEx := len(m.Denom) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denom[iNdEx])
+			copy(dAtA[i:], m.Denom[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.AppId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySurplusBiddingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFilterDutchAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilterDutchAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilterDutchAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Biddings) > 0 {
-		for _, e := range m.Biddings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDebtAuctionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuctionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.AuctionMappingId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionMappingId))
+	n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err32 != nil {
+		return 0, err32
 	}
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
+	i -= n32
+	i = encodeVarintQuery(dAtA, i, uint64(n32))
+	i--
+	dAtA[i] = 0x3a
+	n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err33 != nil {
+		return 0, err33
 	}
-	if m.History {
-		n += 2
+	i -= n33
+	i = encodeVarintQuery(dAtA, i, uint64(n33))
+	i--
+	dAtA[i] = 0x32
+	if m.LockedVaultId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockedVaultId))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *QueryDebtAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.VaultOwner) > 0 {
+		i -= len(m.VaultOwner)
+		copy(dAtA[i:], m.VaultOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VaultOwner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = m.Auction.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.AssetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
-func (m *QueryDebtAuctionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
-	}
-	if m.History {
-		n += 2
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDebtAuctionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuctionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Auctions) > 0 {
-		for _, e := range m.Auctions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DutchLendAuctions) > 0 {
+		for iNdEx := len(m.DutchLendAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DutchLendAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	return n
+	if len(m.DutchAuctions) > 0 {
+		for iNdEx := len(m.DutchAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DutchAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DebtAuctions) > 0 {
+		for iNdEx := len(m.DebtAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DebtAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SurplusAuctions) > 0 {
+		for iNdEx := len(m.SurplusAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SurplusAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDebtBiddingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuctionAnalyticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionAnalyticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionAnalyticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.AssetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
-	}
-	if m.History {
-		n += 2
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDebtBiddingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuctionAnalyticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionAnalyticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionAnalyticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Biddings) > 0 {
-		for _, e := range m.Biddings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Analytics) > 0 {
+		for iNdEx := len(m.Analytics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Analytics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchAuctionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
-	}
-	if m.AuctionMappingId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionMappingId))
-	}
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
-	}
-	if m.History {
-		n += 2
+func (m *QueryPreBidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDutchAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Auction.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPreBidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchAuctionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPreBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
-	}
-	if m.History {
-		n += 2
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PreBidId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PreBidId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDutchAuctionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Auctions) > 0 {
-		for _, e := range m.Auctions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPreBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDutchBiddingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
-	}
-	if m.History {
-		n += 2
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPreBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDutchBiddingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPreBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Biddings) > 0 {
-		for _, e := range m.Biddings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.PreBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBiddingsForSurplusAuctionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPreBidsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreBidsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreBidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.AuctionMappingId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionMappingId))
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
+	if m.DebtAssetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DebtAssetId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.History {
-		n += 2
+	if m.CollateralAssetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CollateralAssetId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.AppId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBiddingsForSurplusAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Biddings) > 0 {
-		for _, e := range m.Biddings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPreBidsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProtocolStatisticsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPreBidsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProtocolStatisticsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPreBidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Stats) > 0 {
-		for _, e := range m.Stats {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PreBids) > 0 {
+		for iNdEx := len(m.PreBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGenericAuctionParamRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPreBidQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreBidQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreBidQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.DebtAssetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DebtAssetId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CollateralAssetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CollateralAssetId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGenericAuctionParamResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PreBidSlot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AuctionParams.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDutchLendAuctionRequest) Size() (n int) {
-	if m == nil {
+func (m *PreBidSlot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreBidSlot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Active.Size()
+		i -= size
+		if _, err := m.Active.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Premium != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Premium))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreBidQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreBidQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreBidQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Slots) > 0 {
+		for iNdEx := len(m.Slots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySurplusAuctionRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -4029,7 +5167,7 @@ func (m *QueryDutchLendAuctionRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryDutchLendAuctionResponse) Size() (n int) {
+func (m *QuerySurplusAuctionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4040,7 +5178,7 @@ func (m *QueryDutchLendAuctionResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryDutchLendAuctionsRequest) Size() (n int) {
+func (m *QuerySurplusAuctionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4059,7 +5197,7 @@ func (m *QueryDutchLendAuctionsRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryDutchLendAuctionsResponse) Size() (n int) {
+func (m *QuerySurplusAuctionsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4078,7 +5216,7 @@ func (m *QueryDutchLendAuctionsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryDutchLendBiddingsRequest) Size() (n int) {
+func (m *QuerySurplusBiddingsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4101,7 +5239,7 @@ func (m *QueryDutchLendBiddingsRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryDutchLendBiddingsResponse) Size() (n int) {
+func (m *QuerySurplusBiddingsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4124,7 +5262,7 @@ func (m *QueryDutchLendBiddingsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryFilterDutchAuctionsRequest) Size() (n int) {
+func (m *QueryDebtAuctionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4133,15 +5271,26 @@ func (m *QueryFilterDutchAuctionsRequest) Size() (n int) {
 	if m.AppId != 0 {
 		n += 1 + sovQuery(uint64(m.AppId))
 	}
-	if len(m.Denom) > 0 {
-		for _, s := range m.Denom {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.AuctionMappingId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionMappingId))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
 	}
 	if m.History {
 		n += 2
 	}
+	return n
+}
+
+func (m *QueryDebtAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Auction.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -4149,7 +5298,26 @@ func (m *QueryFilterDutchAuctionsRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryFilterDutchAuctionsResponse) Size() (n int) {
+func (m *QueryDebtAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.History {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDebtAuctionsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4168,13 +5336,2274 @@ func (m *QueryFilterDutchAuctionsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryDebtBiddingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.History {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryDebtBiddingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Biddings) > 0 {
+		for _, e := range m.Biddings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutchAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.AuctionMappingId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionMappingId))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.History {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDutchAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Auction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDutchAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.History {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutchAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutchBiddingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.History {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutchBiddingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Biddings) > 0 {
+		for _, e := range m.Biddings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBiddingsForSurplusAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.AuctionMappingId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionMappingId))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.History {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBiddingsForSurplusAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Biddings) > 0 {
+		for _, e := range m.Biddings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProtocolStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProtocolStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBadDebtsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBadDebtsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BadDebts) > 0 {
+		for _, e := range m.BadDebts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGenericAuctionParamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	return n
+}
+
+func (m *QueryGenericAuctionParamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuctionParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDutchLendAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.AuctionMappingId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionMappingId))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.History {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDutchLendAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Auction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDutchLendAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.History {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutchLendAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutchLendBiddingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.History {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutchLendBiddingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Biddings) > 0 {
+		for _, e := range m.Biddings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFilterDutchAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if len(m.Denom) > 0 {
+		for _, s := range m.Denom {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.History {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFilterDutchAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovQuery(uint64(m.AssetId))
+	}
+	l = len(m.VaultOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LockedVaultId != 0 {
+		n += 1 + sovQuery(uint64(m.LockedVaultId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SurplusAuctions) > 0 {
+		for _, e := range m.SurplusAuctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DebtAuctions) > 0 {
+		for _, e := range m.DebtAuctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DutchAuctions) > 0 {
+		for _, e := range m.DutchAuctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DutchLendAuctions) > 0 {
+		for _, e := range m.DutchLendAuctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionAnalyticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovQuery(uint64(m.AssetId))
+	}
+	return n
+}
+
+func (m *QueryAuctionAnalyticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Analytics) > 0 {
+		for _, e := range m.Analytics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPreBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreBidId != 0 {
+		n += 1 + sovQuery(uint64(m.PreBidId))
+	}
+	return n
+}
+
+func (m *QueryPreBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PreBid.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPreBidsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.CollateralAssetId != 0 {
+		n += 1 + sovQuery(uint64(m.CollateralAssetId))
+	}
+	if m.DebtAssetId != 0 {
+		n += 1 + sovQuery(uint64(m.DebtAssetId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPreBidsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PreBids) > 0 {
+		for _, e := range m.PreBids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPreBidQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.CollateralAssetId != 0 {
+		n += 1 + sovQuery(uint64(m.CollateralAssetId))
+	}
+	if m.DebtAssetId != 0 {
+		n += 1 + sovQuery(uint64(m.DebtAssetId))
+	}
+	return n
+}
+
+func (m *PreBidSlot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Premium != 0 {
+		n += 1 + sovQuery(uint64(m.Premium))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Active.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPreBidQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slots) > 0 {
+		for _, e := range m.Slots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySurplusAuctionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySurplusAuctionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySurplusAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			}
+			m.AuctionMappingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.History = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySurplusAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySurplusAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySurplusAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySurplusAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySurplusAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySurplusAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.History = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySurplusAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySurplusAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySurplusAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, SurplusAuction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySurplusBiddingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySurplusBiddingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySurplusBiddingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.History = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySurplusBiddingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySurplusBiddingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySurplusBiddingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Biddings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Biddings = append(m.Biddings, SurplusBiddings{})
+			if err := m.Biddings[len(m.Biddings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDebtAuctionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDebtAuctionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDebtAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			}
+			m.AuctionMappingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.History = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDebtAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDebtAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDebtAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDebtAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDebtAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDebtAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.History = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDebtAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDebtAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDebtAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, DebtAuction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDebtBiddingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDebtBiddingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDebtBiddingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.History = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDebtBiddingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDebtBiddingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDebtBiddingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Biddings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Biddings = append(m.Biddings, DebtBiddings{})
+			if err := m.Biddings[len(m.Biddings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySurplusAuctionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDutchAuctionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4197,10 +7626,10 @@ func (m *QuerySurplusAuctionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySurplusAuctionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDutchAuctionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySurplusAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDutchAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4301,7 +7730,7 @@ func (m *QuerySurplusAuctionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySurplusAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDutchAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4324,15 +7753,257 @@ func (m *QuerySurplusAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySurplusAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDutchAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySurplusAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDutchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutchAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutchAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.History = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutchAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutchAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutchAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, DutchAuction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4359,7 +8030,10 @@ func (m *QuerySurplusAuctionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4384,7 +8058,7 @@ func (m *QuerySurplusAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySurplusAuctionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDutchBiddingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4407,13 +8081,45 @@ func (m *QuerySurplusAuctionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySurplusAuctionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDutchBiddingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySurplusAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDutchBiddingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
@@ -4432,7 +8138,7 @@ func (m *QuerySurplusAuctionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
@@ -4452,7 +8158,7 @@ func (m *QuerySurplusAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.History = bool(v != 0)
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4509,7 +8215,7 @@ func (m *QuerySurplusAuctionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySurplusAuctionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDutchBiddingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4532,15 +8238,47 @@ func (m *QuerySurplusAuctionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySurplusAuctionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDutchBiddingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySurplusAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDutchBiddingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Biddings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4567,12 +8305,12 @@ func (m *QuerySurplusAuctionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auctions = append(m.Auctions, SurplusAuction{})
-			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Biddings = append(m.Biddings, DutchBiddings{})
+			if err := m.Biddings[len(m.Biddings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4629,7 +8367,7 @@ func (m *QuerySurplusAuctionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySurplusBiddingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBiddingsForSurplusAuctionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4652,17 +8390,17 @@ func (m *QuerySurplusBiddingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySurplusBiddingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBiddingsForSurplusAuctionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySurplusBiddingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBiddingsForSurplusAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			var stringLen uint64
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4672,29 +8410,16 @@ func (m *QuerySurplusBiddingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
 			}
-			m.AppId = 0
+			m.AuctionMappingId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4704,12 +8429,31 @@ func (m *QuerySurplusBiddingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
@@ -4729,7 +8473,7 @@ func (m *QuerySurplusBiddingsRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.History = bool(v != 0)
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4786,7 +8530,7 @@ func (m *QuerySurplusBiddingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySurplusBiddingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBiddingsForSurplusAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4809,45 +8553,13 @@ func (m *QuerySurplusBiddingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySurplusBiddingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBiddingsForSurplusAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySurplusBiddingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBiddingsForSurplusAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Biddings", wireType)
 			}
@@ -4881,7 +8593,7 @@ func (m *QuerySurplusBiddingsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4938,7 +8650,7 @@ func (m *QuerySurplusBiddingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDebtAuctionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProtocolStatisticsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4961,10 +8673,10 @@ func (m *QueryDebtAuctionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDebtAuctionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProtocolStatisticsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDebtAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProtocolStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4987,10 +8699,10 @@ func (m *QueryDebtAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.AuctionMappingId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5000,50 +8712,28 @@ func (m *QueryDebtAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.AuctionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			m.History = bool(v != 0)
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5065,7 +8755,7 @@ func (m *QueryDebtAuctionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDebtAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProtocolStatisticsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5088,15 +8778,15 @@ func (m *QueryDebtAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDebtAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProtocolStatisticsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDebtAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProtocolStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5123,11 +8813,12 @@ func (m *QueryDebtAuctionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, ProtocolStatistics{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5157,7 +8848,7 @@ func (m *QueryDebtAuctionResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5184,7 +8875,7 @@ func (m *QueryDebtAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDebtAuctionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBadDebtsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5207,10 +8898,10 @@ func (m *QueryDebtAuctionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDebtAuctionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBadDebtsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDebtAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBadDebtsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5233,26 +8924,6 @@ func (m *QueryDebtAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.History = bool(v != 0)
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5309,7 +8980,7 @@ func (m *QueryDebtAuctionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDebtAuctionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBadDebtsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5332,15 +9003,15 @@ func (m *QueryDebtAuctionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDebtAuctionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBadDebtsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDebtAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBadDebtsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5367,8 +9038,8 @@ func (m *QueryDebtAuctionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auctions = append(m.Auctions, DebtAuction{})
-			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BadDebts = append(m.BadDebts, BadDebt{})
+			if err := m.BadDebts[len(m.BadDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5429,7 +9100,7 @@ func (m *QueryDebtAuctionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDebtBiddingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGenericAuctionParamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5452,17 +9123,17 @@ func (m *QueryDebtBiddingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDebtBiddingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGenericAuctionParamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDebtBiddingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGenericAuctionParamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			var stringLen uint64
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5472,66 +9143,64 @@ func (m *QueryDebtBiddingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
-			}
-			m.AppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGenericAuctionParamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.History = bool(v != 0)
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGenericAuctionParamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGenericAuctionParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5558,10 +9227,7 @@ func (m *QueryDebtBiddingsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuctionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5586,7 +9252,7 @@ func (m *QueryDebtBiddingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDebtBiddingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDutchLendAuctionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5609,17 +9275,17 @@ func (m *QueryDebtBiddingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDebtBiddingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDutchLendAuctionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDebtBiddingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDutchLendAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			var stringLen uint64
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5629,29 +9295,54 @@ func (m *QueryDebtBiddingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.AuctionMappingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Biddings", wireType)
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5661,29 +9352,65 @@ func (m *QueryDebtBiddingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+			m.History = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutchLendAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Biddings = append(m.Biddings, DebtBiddings{})
-			if err := m.Biddings[len(m.Biddings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutchLendAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutchLendAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5710,10 +9437,7 @@ func (m *QueryDebtBiddingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5738,7 +9462,7 @@ func (m *QueryDebtBiddingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDutchAuctionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDutchLendAuctionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5761,10 +9485,10 @@ func (m *QueryDutchAuctionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDutchAuctionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDutchLendAuctionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDutchAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDutchLendAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5788,9 +9512,9 @@ func (m *QueryDutchAuctionRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			m.AuctionMappingId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5800,16 +9524,17 @@ func (m *QueryDutchAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.History = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.AuctionId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5819,31 +9544,28 @@ func (m *QueryDutchAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.History = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5865,7 +9587,7 @@ func (m *QueryDutchAuctionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDutchAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDutchLendAuctionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5888,15 +9610,15 @@ func (m *QueryDutchAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDutchAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDutchLendAuctionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDutchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDutchLendAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5923,7 +9645,44 @@ func (m *QueryDutchAuctionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Auctions = append(m.Auctions, DutchAuction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5948,7 +9707,7 @@ func (m *QueryDutchAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDutchLendBiddingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5971,13 +9730,45 @@ func (m *QueryDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDutchAuctionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDutchLendBiddingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDutchAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDutchLendBiddingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
@@ -5996,7 +9787,7 @@ func (m *QueryDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
@@ -6016,7 +9807,7 @@ func (m *QueryDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.History = bool(v != 0)
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6073,7 +9864,7 @@ func (m *QueryDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDutchAuctionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDutchLendBiddingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6096,15 +9887,47 @@ func (m *QueryDutchAuctionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDutchAuctionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDutchLendBiddingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDutchAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDutchLendBiddingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Biddings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6131,12 +9954,12 @@ func (m *QueryDutchAuctionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auctions = append(m.Auctions, DutchAuction{})
-			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Biddings = append(m.Biddings, DutchBiddings{})
+			if err := m.Biddings[len(m.Biddings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6193,7 +10016,7 @@ func (m *QueryDutchAuctionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDutchBiddingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFilterDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6216,17 +10039,17 @@ func (m *QueryDutchBiddingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDutchBiddingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFilterDutchAuctionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDutchBiddingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFilterDutchAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			var stringLen uint64
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6236,29 +10059,16 @@ func (m *QueryDutchBiddingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.AppId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6268,11 +10078,24 @@ func (m *QueryDutchBiddingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = append(m.Denom, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
@@ -6350,7 +10173,7 @@ func (m *QueryDutchBiddingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDutchBiddingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFilterDutchAuctionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6373,47 +10196,15 @@ func (m *QueryDutchBiddingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDutchBiddingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFilterDutchAuctionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDutchBiddingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFilterDutchAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Biddings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6440,12 +10231,12 @@ func (m *QueryDutchBiddingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Biddings = append(m.Biddings, DutchBiddings{})
-			if err := m.Biddings[len(m.Biddings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Auctions = append(m.Auctions, DutchAuction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6502,7 +10293,7 @@ func (m *QueryDutchBiddingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBiddingsForSurplusAuctionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6525,10 +10316,10 @@ func (m *QueryBiddingsForSurplusAuctionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBiddingsForSurplusAuctionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBiddingsForSurplusAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6551,10 +10342,10 @@ func (m *QueryBiddingsForSurplusAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
 			}
-			m.AuctionMappingId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6564,16 +10355,29 @@ func (m *QueryBiddingsForSurplusAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
 			}
-			m.AuctionId = 0
+			m.AssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6583,16 +10387,16 @@ func (m *QueryBiddingsForSurplusAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				m.AssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultOwner", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6602,15 +10406,46 @@ func (m *QueryBiddingsForSurplusAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.History = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedVaultId", wireType)
+			}
+			m.LockedVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockedVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6637,66 +10472,13 @@ func (m *QueryBiddingsForSurplusAuctionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBiddingsForSurplusAuctionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBiddingsForSurplusAuctionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBiddingsForSurplusAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Biddings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6723,12 +10505,11 @@ func (m *QueryBiddingsForSurplusAuctionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Biddings = append(m.Biddings, SurplusBiddings{})
-			if err := m.Biddings[len(m.Biddings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6758,7 +10539,7 @@ func (m *QueryBiddingsForSurplusAuctionResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6785,7 +10566,7 @@ func (m *QueryBiddingsForSurplusAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProtocolStatisticsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6808,17 +10589,17 @@ func (m *QueryProtocolStatisticsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProtocolStatisticsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProtocolStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SurplusAuctions", wireType)
 			}
-			m.AppId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6828,14 +10609,29 @@ func (m *QueryProtocolStatisticsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SurplusAuctions = append(m.SurplusAuctions, SurplusAuction{})
+			if err := m.SurplusAuctions[len(m.SurplusAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAuctions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6862,66 +10658,48 @@ func (m *QueryProtocolStatisticsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DebtAuctions = append(m.DebtAuctions, DebtAuction{})
+			if err := m.DebtAuctions[len(m.DebtAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuctions", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProtocolStatisticsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DutchAuctions = append(m.DutchAuctions, DutchAuction{})
+			if err := m.DutchAuctions[len(m.DutchAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProtocolStatisticsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProtocolStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchLendAuctions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6948,12 +10726,12 @@ func (m *QueryProtocolStatisticsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stats = append(m.Stats, ProtocolStatistics{})
-			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DutchLendAuctions = append(m.DutchLendAuctions, DutchAuction{})
+			if err := m.DutchLendAuctions[len(m.DutchLendAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7010,7 +10788,7 @@ func (m *QueryProtocolStatisticsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGenericAuctionParamRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionAnalyticsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7033,10 +10811,10 @@ func (m *QueryGenericAuctionParamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGenericAuctionParamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionAnalyticsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGenericAuctionParamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionAnalyticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7058,6 +10836,25 @@ func (m *QueryGenericAuctionParamRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7079,7 +10876,7 @@ func (m *QueryGenericAuctionParamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGenericAuctionParamResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionAnalyticsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7102,15 +10899,15 @@ func (m *QueryGenericAuctionParamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGenericAuctionParamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionAnalyticsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGenericAuctionParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionAnalyticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analytics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7137,7 +10934,8 @@ func (m *QueryGenericAuctionParamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuctionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Analytics = append(m.Analytics, AuctionAnalytics{})
+			if err := m.Analytics[len(m.Analytics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7162,7 +10960,7 @@ func (m *QueryGenericAuctionParamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDutchLendAuctionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPreBidRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7185,74 +10983,17 @@ func (m *QueryDutchLendAuctionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDutchLendAuctionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPreBidRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDutchLendAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPreBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
-			}
-			m.AppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
-			}
-			m.AuctionMappingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionMappingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
-			}
-			m.AuctionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreBidId", wireType)
 			}
-			var v int
+			m.PreBidId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7262,12 +11003,11 @@ func (m *QueryDutchLendAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PreBidId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.History = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7289,7 +11029,7 @@ func (m *QueryDutchLendAuctionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDutchLendAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPreBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7312,15 +11052,15 @@ func (m *QueryDutchLendAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDutchLendAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPreBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDutchLendAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPreBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreBid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7347,7 +11087,7 @@ func (m *QueryDutchLendAuctionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7372,7 +11112,7 @@ func (m *QueryDutchLendAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDutchLendAuctionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPreBidsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7395,10 +11135,10 @@ func (m *QueryDutchLendAuctionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDutchLendAuctionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPreBidsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDutchLendAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPreBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7422,9 +11162,9 @@ func (m *QueryDutchLendAuctionsRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAssetId", wireType)
 			}
-			var v int
+			m.CollateralAssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7434,17 +11174,16 @@ func (m *QueryDutchLendAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CollateralAssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.History = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAssetId", wireType)
 			}
-			var msglen int
+			m.DebtAssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7454,83 +11193,16 @@ func (m *QueryDutchLendAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DebtAssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDutchLendAuctionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDutchLendAuctionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDutchLendAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7540,27 +11212,25 @@ func (m *QueryDutchLendAuctionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auctions = append(m.Auctions, DutchAuction{})
-			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7590,7 +11260,7 @@ func (m *QueryDutchLendAuctionsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -7617,7 +11287,7 @@ func (m *QueryDutchLendAuctionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDutchLendBiddingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPreBidsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7640,49 +11310,17 @@ func (m *QueryDutchLendBiddingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDutchLendBiddingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPreBidsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDutchLendBiddingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPreBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreBids", wireType)
 			}
-			m.AppId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7692,32 +11330,27 @@ func (m *QueryDutchLendBiddingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.History = bool(v != 0)
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreBids = append(m.PreBids, PreBid{})
+			if err := m.PreBids[len(m.PreBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7747,7 +11380,7 @@ func (m *QueryDutchLendBiddingsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -7774,7 +11407,7 @@ func (m *QueryDutchLendBiddingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDutchLendBiddingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPreBidQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7797,17 +11430,17 @@ func (m *QueryDutchLendBiddingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDutchLendBiddingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPreBidQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDutchLendBiddingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPreBidQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			var stringLen uint64
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7817,29 +11450,16 @@ func (m *QueryDutchLendBiddingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Biddings", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAssetId", wireType)
 			}
-			var msglen int
+			m.CollateralAssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7849,31 +11469,16 @@ func (m *QueryDutchLendBiddingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CollateralAssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Biddings = append(m.Biddings, DutchBiddings{})
-			if err := m.Biddings[len(m.Biddings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAssetId", wireType)
 			}
-			var msglen int
+			m.DebtAssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7883,28 +11488,11 @@ func (m *QueryDutchLendBiddingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DebtAssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7926,7 +11514,7 @@ func (m *QueryDutchLendBiddingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFilterDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
+func (m *PreBidSlot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7949,17 +11537,17 @@ func (m *QueryFilterDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFilterDutchAuctionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreBidSlot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFilterDutchAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreBidSlot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Premium", wireType)
 			}
-			m.AppId = 0
+			m.Premium = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7969,14 +11557,14 @@ func (m *QueryFilterDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				m.Premium |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8004,33 +11592,15 @@ func (m *QueryFilterDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = append(m.Denom, string(dAtA[iNdEx:postIndex]))
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.History = bool(v != 0)
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8040,25 +11610,23 @@ func (m *QueryFilterDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Active.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8083,7 +11651,7 @@ func (m *QueryFilterDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFilterDutchAuctionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPreBidQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8106,15 +11674,15 @@ func (m *QueryFilterDutchAuctionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFilterDutchAuctionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPreBidQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFilterDutchAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPreBidQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8141,14 +11709,114 @@ func (m *QueryFilterDutchAuctionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auctions = append(m.Auctions, DutchAuction{})
-			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Slots = append(m.Slots, PreBidSlot{})
+			if err := m.Slots[len(m.Slots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8175,10 +11843,7 @@ func (m *QueryFilterDutchAuctionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SealedBidCommitment is the hash a bidder commits to for a sealed dutch
+// auction bid of payment for quantity of the collateral. The salt keeps the
+// bid from being guessed before it is revealed.
+func SealedBidCommitment(bidder string, auctionID uint64, quantity, payment sdk.Coin, salt string) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%d/%s/%s/%s", bidder, auctionID, quantity, payment, salt)))
+	return hash[:]
+}
+
+// Price is the payment offered per unit of collateral by a revealed bid.
+func (m SealedBid) Price() sdk.Dec {
+	return m.Payment.Amount.ToDec().Quo(m.Quantity.Amount.ToDec())
+}
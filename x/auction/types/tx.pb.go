@@ -35,6 +35,8 @@ type MsgPlaceSurplusBidRequest struct {
 	Amount           types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 	AppId            uint64     `protobuf:"varint,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
 	AuctionMappingId uint64     `protobuf:"varint,5,opt,name=auction_mapping_id,json=auctionMappingId,proto3" json:"auction_mapping_id,omitempty"`
+	// quantity of the sell token bid for, the whole lot when empty.
+	Quantity types.Coin `protobuf:"bytes,6,opt,name=quantity,proto3" json:"quantity"`
 }
 
 func (m *MsgPlaceSurplusBidRequest) Reset()         { *m = MsgPlaceSurplusBidRequest{} }
@@ -338,6 +340,325 @@ func (m *MsgPlaceDutchLendBidResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPlaceDutchLendBidResponse proto.InternalMessageInfo
 
+// MsgCommitDutchBidRequest commits to a bid on a sealed dutch auction. The
+// commitment is the sha256 hash of the bid, see SealedBidCommitment.
+type MsgCommitDutchBidRequest struct {
+	AuctionId        uint64     `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder           string     `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Commitment       []byte     `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Escrow           types.Coin `protobuf:"bytes,4,opt,name=escrow,proto3" json:"escrow"`
+	AppId            uint64     `protobuf:"varint,5,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AuctionMappingId uint64     `protobuf:"varint,6,opt,name=auction_mapping_id,json=auctionMappingId,proto3" json:"auction_mapping_id,omitempty"`
+}
+
+func (m *MsgCommitDutchBidRequest) Reset()         { *m = MsgCommitDutchBidRequest{} }
+func (m *MsgCommitDutchBidRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitDutchBidRequest) ProtoMessage()    {}
+func (*MsgCommitDutchBidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8457d7b1ca5de6a, []int{8}
+}
+func (m *MsgCommitDutchBidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitDutchBidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitDutchBidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitDutchBidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitDutchBidRequest.Merge(m, src)
+}
+func (m *MsgCommitDutchBidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitDutchBidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitDutchBidRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitDutchBidRequest proto.InternalMessageInfo
+
+type MsgCommitDutchBidResponse struct {
+	BiddingId uint64 `protobuf:"varint,1,opt,name=bidding_id,json=biddingId,proto3" json:"bidding_id,omitempty"`
+}
+
+func (m *MsgCommitDutchBidResponse) Reset()         { *m = MsgCommitDutchBidResponse{} }
+func (m *MsgCommitDutchBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitDutchBidResponse) ProtoMessage()    {}
+func (*MsgCommitDutchBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8457d7b1ca5de6a, []int{9}
+}
+func (m *MsgCommitDutchBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitDutchBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitDutchBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitDutchBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitDutchBidResponse.Merge(m, src)
+}
+func (m *MsgCommitDutchBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitDutchBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitDutchBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitDutchBidResponse proto.InternalMessageInfo
+
+type MsgRevealDutchBidRequest struct {
+	AuctionId        uint64     `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder           string     `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	BiddingId        uint64     `protobuf:"varint,3,opt,name=bidding_id,json=biddingId,proto3" json:"bidding_id,omitempty"`
+	Quantity         types.Coin `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity"`
+	Payment          types.Coin `protobuf:"bytes,5,opt,name=payment,proto3" json:"payment"`
+	Salt             string     `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
+	AppId            uint64     `protobuf:"varint,7,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AuctionMappingId uint64     `protobuf:"varint,8,opt,name=auction_mapping_id,json=auctionMappingId,proto3" json:"auction_mapping_id,omitempty"`
+}
+
+func (m *MsgRevealDutchBidRequest) Reset()         { *m = MsgRevealDutchBidRequest{} }
+func (m *MsgRevealDutchBidRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealDutchBidRequest) ProtoMessage()    {}
+func (*MsgRevealDutchBidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8457d7b1ca5de6a, []int{10}
+}
+func (m *MsgRevealDutchBidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealDutchBidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealDutchBidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealDutchBidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealDutchBidRequest.Merge(m, src)
+}
+func (m *MsgRevealDutchBidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealDutchBidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealDutchBidRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealDutchBidRequest proto.InternalMessageInfo
+
+type MsgRevealDutchBidResponse struct {
+}
+
+func (m *MsgRevealDutchBidResponse) Reset()         { *m = MsgRevealDutchBidResponse{} }
+func (m *MsgRevealDutchBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealDutchBidResponse) ProtoMessage()    {}
+func (*MsgRevealDutchBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8457d7b1ca5de6a, []int{11}
+}
+func (m *MsgRevealDutchBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealDutchBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealDutchBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealDutchBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealDutchBidResponse.Merge(m, src)
+}
+func (m *MsgRevealDutchBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealDutchBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealDutchBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealDutchBidResponse proto.InternalMessageInfo
+
+// MsgSubmitPreBidRequest deposits debt token in the pre-bid queue of a
+// collateral and debt asset pair of an app, at a premium slot in percent.
+type MsgSubmitPreBidRequest struct {
+	Bidder            string     `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	AppId             uint64     `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	CollateralAssetId uint64     `protobuf:"varint,3,opt,name=collateral_asset_id,json=collateralAssetId,proto3" json:"collateral_asset_id,omitempty"`
+	DebtAssetId       uint64     `protobuf:"varint,4,opt,name=debt_asset_id,json=debtAssetId,proto3" json:"debt_asset_id,omitempty"`
+	Premium           uint64     `protobuf:"varint,5,opt,name=premium,proto3" json:"premium,omitempty"`
+	Amount            types.Coin `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgSubmitPreBidRequest) Reset()         { *m = MsgSubmitPreBidRequest{} }
+func (m *MsgSubmitPreBidRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitPreBidRequest) ProtoMessage()    {}
+func (*MsgSubmitPreBidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8457d7b1ca5de6a, []int{12}
+}
+func (m *MsgSubmitPreBidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitPreBidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitPreBidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitPreBidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitPreBidRequest.Merge(m, src)
+}
+func (m *MsgSubmitPreBidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitPreBidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitPreBidRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitPreBidRequest proto.InternalMessageInfo
+
+type MsgSubmitPreBidResponse struct {
+	PreBidId uint64 `protobuf:"varint,1,opt,name=pre_bid_id,json=preBidId,proto3" json:"pre_bid_id,omitempty"`
+}
+
+func (m *MsgSubmitPreBidResponse) Reset()         { *m = MsgSubmitPreBidResponse{} }
+func (m *MsgSubmitPreBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitPreBidResponse) ProtoMessage()    {}
+func (*MsgSubmitPreBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8457d7b1ca5de6a, []int{13}
+}
+func (m *MsgSubmitPreBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitPreBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitPreBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitPreBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitPreBidResponse.Merge(m, src)
+}
+func (m *MsgSubmitPreBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitPreBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitPreBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitPreBidResponse proto.InternalMessageInfo
+
+// MsgWithdrawPreBidRequest withdraws debt token left in a pre-bid, all of it
+// when the amount is zero.
+type MsgWithdrawPreBidRequest struct {
+	Bidder   string     `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	PreBidId uint64     `protobuf:"varint,2,opt,name=pre_bid_id,json=preBidId,proto3" json:"pre_bid_id,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgWithdrawPreBidRequest) Reset()         { *m = MsgWithdrawPreBidRequest{} }
+func (m *MsgWithdrawPreBidRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawPreBidRequest) ProtoMessage()    {}
+func (*MsgWithdrawPreBidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8457d7b1ca5de6a, []int{14}
+}
+func (m *MsgWithdrawPreBidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawPreBidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawPreBidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawPreBidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawPreBidRequest.Merge(m, src)
+}
+func (m *MsgWithdrawPreBidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawPreBidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawPreBidRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawPreBidRequest proto.InternalMessageInfo
+
+type MsgWithdrawPreBidResponse struct {
+}
+
+func (m *MsgWithdrawPreBidResponse) Reset()         { *m = MsgWithdrawPreBidResponse{} }
+func (m *MsgWithdrawPreBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawPreBidResponse) ProtoMessage()    {}
+func (*MsgWithdrawPreBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8457d7b1ca5de6a, []int{15}
+}
+func (m *MsgWithdrawPreBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawPreBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawPreBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawPreBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawPreBidResponse.Merge(m, src)
+}
+func (m *MsgWithdrawPreBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawPreBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawPreBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawPreBidResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgPlaceSurplusBidRequest)(nil), "comdex.auction.v1beta1.MsgPlaceSurplusBidRequest")
 	proto.RegisterType((*MsgPlaceSurplusBidResponse)(nil), "comdex.auction.v1beta1.MsgPlaceSurplusBidResponse")
@@ -347,45 +668,74 @@ func init() {
 	proto.RegisterType((*MsgPlaceDutchBidResponse)(nil), "comdex.auction.v1beta1.MsgPlaceDutchBidResponse")
 	proto.RegisterType((*MsgPlaceDutchLendBidRequest)(nil), "comdex.auction.v1beta1.MsgPlaceDutchLendBidRequest")
 	proto.RegisterType((*MsgPlaceDutchLendBidResponse)(nil), "comdex.auction.v1beta1.MsgPlaceDutchLendBidResponse")
+	proto.RegisterType((*MsgCommitDutchBidRequest)(nil), "comdex.auction.v1beta1.MsgCommitDutchBidRequest")
+	proto.RegisterType((*MsgCommitDutchBidResponse)(nil), "comdex.auction.v1beta1.MsgCommitDutchBidResponse")
+	proto.RegisterType((*MsgRevealDutchBidRequest)(nil), "comdex.auction.v1beta1.MsgRevealDutchBidRequest")
+	proto.RegisterType((*MsgRevealDutchBidResponse)(nil), "comdex.auction.v1beta1.MsgRevealDutchBidResponse")
+	proto.RegisterType((*MsgSubmitPreBidRequest)(nil), "comdex.auction.v1beta1.MsgSubmitPreBidRequest")
+	proto.RegisterType((*MsgSubmitPreBidResponse)(nil), "comdex.auction.v1beta1.MsgSubmitPreBidResponse")
+	proto.RegisterType((*MsgWithdrawPreBidRequest)(nil), "comdex.auction.v1beta1.MsgWithdrawPreBidRequest")
+	proto.RegisterType((*MsgWithdrawPreBidResponse)(nil), "comdex.auction.v1beta1.MsgWithdrawPreBidResponse")
 }
 
 func init() { proto.RegisterFile("comdex/auction/v1beta1/tx.proto", fileDescriptor_a8457d7b1ca5de6a) }
 
 var fileDescriptor_a8457d7b1ca5de6a = []byte{
-	// 523 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xf5, 0x36, 0x4d, 0xa4, 0x0e, 0x07, 0xca, 0xaa, 0x84, 0xc4, 0x94, 0x6d, 0x94, 0x53, 0x0e,
-	0x60, 0xe3, 0x14, 0x89, 0x7b, 0xe0, 0x12, 0x89, 0x48, 0x28, 0x85, 0x0b, 0x17, 0x64, 0x7b, 0xb7,
-	0xee, 0x8a, 0xc4, 0xbb, 0x78, 0xd7, 0x28, 0x88, 0x13, 0x7f, 0xc0, 0x89, 0x6f, 0xe0, 0x53, 0x72,
-	0x42, 0x11, 0x27, 0x4e, 0x08, 0x92, 0x2f, 0xe0, 0x0f, 0x90, 0xed, 0x0d, 0x34, 0x4d, 0xaa, 0xb8,
-	0xe2, 0xd4, 0x5b, 0x36, 0xf3, 0x66, 0xde, 0x7b, 0xe3, 0x99, 0x5d, 0x38, 0x0a, 0xc5, 0x98, 0xb2,
-	0x89, 0xeb, 0xa7, 0xa1, 0xe6, 0x22, 0x76, 0xdf, 0x79, 0x01, 0xd3, 0xbe, 0xe7, 0xea, 0x89, 0x23,
-	0x13, 0xa1, 0x05, 0xae, 0x17, 0x00, 0xc7, 0x00, 0x1c, 0x03, 0xb0, 0x0f, 0x22, 0x11, 0x89, 0x1c,
-	0xe2, 0x66, 0xbf, 0x0a, 0xb4, 0x4d, 0x42, 0xa1, 0xc6, 0x42, 0xb9, 0x81, 0xaf, 0xd8, 0xdf, 0x5a,
-	0xa1, 0xe0, 0x71, 0x11, 0x6f, 0xcf, 0x10, 0x34, 0x07, 0x2a, 0x7a, 0x3e, 0xf2, 0x43, 0x76, 0x92,
-	0x26, 0x72, 0x94, 0xaa, 0x1e, 0xa7, 0x43, 0xf6, 0x36, 0x65, 0x4a, 0xe3, 0x7b, 0x00, 0x86, 0xe6,
-	0x35, 0xa7, 0x0d, 0xd4, 0x42, 0x9d, 0xdd, 0xe1, 0x9e, 0xf9, 0xa7, 0x4f, 0x71, 0x1d, 0x6a, 0x01,
-	0xa7, 0x94, 0x25, 0x8d, 0x9d, 0x16, 0xea, 0xec, 0x0d, 0xcd, 0x09, 0x3f, 0x86, 0x9a, 0x3f, 0x16,
-	0x69, 0xac, 0x1b, 0x95, 0x16, 0xea, 0xdc, 0xe8, 0x36, 0x9d, 0x42, 0x85, 0x93, 0xa9, 0x58, 0x0a,
-	0x76, 0x9e, 0x08, 0x1e, 0xf7, 0x76, 0xa7, 0x3f, 0x8e, 0xac, 0xa1, 0x81, 0xe3, 0xdb, 0x50, 0xf3,
-	0xa5, 0xcc, 0xb8, 0x76, 0x73, 0xae, 0xaa, 0x2f, 0x65, 0x9f, 0xe2, 0xfb, 0x80, 0x97, 0x32, 0xc6,
-	0xbe, 0x94, 0x3c, 0x8e, 0x32, 0x48, 0x35, 0x87, 0xec, 0x9b, 0xc8, 0xa0, 0x08, 0xf4, 0x69, 0xfb,
-	0x10, 0xec, 0x4d, 0x8e, 0x94, 0x14, 0xb1, 0x62, 0xed, 0xcf, 0x3b, 0x50, 0x5f, 0x86, 0x9f, 0xb2,
-	0x40, 0xff, 0xbf, 0x5b, 0x0f, 0x2a, 0x01, 0xa7, 0x65, 0xad, 0x66, 0x58, 0x3c, 0x80, 0x5b, 0x6c,
-	0x22, 0x59, 0xa8, 0x19, 0x7d, 0xa9, 0x58, 0xf2, 0x42, 0xbc, 0x61, 0x71, 0x6e, 0xb9, 0x44, 0x81,
-	0xf5, 0xcc, 0x73, 0x6d, 0xab, 0x6e, 0x6f, 0x5b, 0xed, 0x92, 0xb6, 0x35, 0xe1, 0xce, 0x5a, 0x5f,
-	0x4c, 0xcf, 0xbe, 0xa2, 0x73, 0xb1, 0x54, 0x87, 0x67, 0xd7, 0x7d, 0x44, 0x6c, 0x68, 0xac, 0xfb,
-	0x31, 0x66, 0xbf, 0x21, 0xb8, 0xbb, 0x12, 0x7c, 0xc6, 0x62, 0x7a, 0xdd, 0x0d, 0x13, 0x38, 0xdc,
-	0xec, 0xa9, 0x30, 0xdd, 0xfd, 0x5d, 0x81, 0xca, 0x40, 0x45, 0xf8, 0x03, 0xe0, 0xf5, 0xdd, 0xc1,
-	0x9e, 0xb3, 0xf9, 0xce, 0x71, 0x2e, 0xbd, 0x39, 0xec, 0xee, 0x55, 0x52, 0x0a, 0x11, 0x38, 0x81,
-	0x9b, 0x17, 0x26, 0x10, 0x3b, 0xdb, 0xca, 0xac, 0xae, 0xb0, 0xed, 0x96, 0xc6, 0x1b, 0xce, 0x14,
-	0xf6, 0x2f, 0x4e, 0x02, 0xde, 0x5e, 0x64, 0x75, 0x07, 0xec, 0x87, 0xe5, 0x13, 0x0c, 0xed, 0x47,
-	0x04, 0x07, 0x9b, 0x3e, 0x08, 0x3e, 0x2e, 0x55, 0x6a, 0x75, 0x24, 0xed, 0x47, 0x57, 0x4b, 0x2a,
-	0x34, 0xf4, 0x4e, 0xa6, 0xbf, 0x88, 0xf5, 0x65, 0x4e, 0xac, 0xe9, 0x9c, 0xa0, 0xd9, 0x9c, 0xa0,
-	0x9f, 0x73, 0x82, 0x3e, 0x2d, 0x88, 0x35, 0x5b, 0x10, 0xeb, 0xfb, 0x82, 0x58, 0xaf, 0xbc, 0x88,
-	0xeb, 0xb3, 0x34, 0xc8, 0xaa, 0xbb, 0x05, 0xc3, 0x03, 0x71, 0x7a, 0xca, 0x43, 0xee, 0x8f, 0xcc,
-	0xd9, 0xfd, 0xf7, 0x50, 0xe9, 0xf7, 0x92, 0xa9, 0xa0, 0x96, 0x3f, 0x2b, 0xc7, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x7f, 0x4f, 0x7a, 0x59, 0xc7, 0x06, 0x00, 0x00,
+	// 857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xf3, 0xb7, 0x7d, 0x0b, 0x62, 0x3b, 0x2c, 0xdd, 0xc4, 0x5b, 0xbc, 0x95, 0x4f, 0x3d,
+	0x80, 0x8d, 0xbb, 0x48, 0x2b, 0xe0, 0x44, 0x97, 0x4b, 0x24, 0x22, 0x55, 0x29, 0x08, 0x89, 0x4b,
+	0x35, 0xf6, 0x4c, 0xd3, 0x11, 0xb6, 0xc7, 0xb5, 0xc7, 0x6d, 0x2a, 0x4e, 0x1c, 0x39, 0x20, 0x71,
+	0xe2, 0x33, 0xf0, 0x35, 0xb8, 0xf5, 0x84, 0x2a, 0xb8, 0x70, 0x42, 0x90, 0xde, 0xe1, 0x2b, 0x20,
+	0xdb, 0x93, 0xc6, 0x4e, 0xdc, 0xc4, 0x61, 0x7b, 0xe9, 0xcd, 0x9e, 0xf7, 0x7b, 0x7f, 0x7e, 0x3f,
+	0xbf, 0x79, 0x33, 0x86, 0xe7, 0x0e, 0xf7, 0x08, 0x1d, 0x9b, 0x38, 0x76, 0x04, 0xe3, 0xbe, 0x79,
+	0x6e, 0xd9, 0x54, 0x60, 0xcb, 0x14, 0x63, 0x23, 0x08, 0xb9, 0xe0, 0x68, 0x3b, 0x03, 0x18, 0x12,
+	0x60, 0x48, 0x80, 0xfa, 0x64, 0xc4, 0x47, 0x3c, 0x85, 0x98, 0xc9, 0x53, 0x86, 0x56, 0x35, 0x87,
+	0x47, 0x1e, 0x8f, 0x4c, 0x1b, 0x47, 0xf4, 0x36, 0x96, 0xc3, 0x99, 0x9f, 0xd9, 0xf5, 0x1f, 0xea,
+	0xd0, 0x1b, 0x44, 0xa3, 0x43, 0x17, 0x3b, 0xf4, 0x28, 0x0e, 0x03, 0x37, 0x8e, 0x0e, 0x18, 0x19,
+	0xd2, 0xb3, 0x98, 0x46, 0x02, 0xbd, 0x0b, 0x20, 0xd3, 0x1c, 0x33, 0xd2, 0x55, 0x76, 0x95, 0xbd,
+	0xe6, 0x70, 0x53, 0xae, 0xf4, 0x09, 0xda, 0x86, 0xb6, 0xcd, 0x08, 0xa1, 0x61, 0xb7, 0xbe, 0xab,
+	0xec, 0x6d, 0x0e, 0xe5, 0x1b, 0x7a, 0x09, 0x6d, 0xec, 0xf1, 0xd8, 0x17, 0xdd, 0xc6, 0xae, 0xb2,
+	0xf7, 0x68, 0xbf, 0x67, 0x64, 0x55, 0x18, 0x49, 0x15, 0xd3, 0x82, 0x8d, 0x57, 0x9c, 0xf9, 0x07,
+	0xcd, 0xab, 0x3f, 0x9f, 0xd7, 0x86, 0x12, 0x8e, 0xde, 0x81, 0x36, 0x0e, 0x82, 0x24, 0x57, 0x33,
+	0xcd, 0xd5, 0xc2, 0x41, 0xd0, 0x27, 0xe8, 0x3d, 0x40, 0xd3, 0x32, 0x3c, 0x1c, 0x04, 0xcc, 0x1f,
+	0x25, 0x90, 0x56, 0x0a, 0x79, 0x2c, 0x2d, 0x83, 0xcc, 0xd0, 0x27, 0xe8, 0x13, 0xd8, 0x38, 0x8b,
+	0xb1, 0x2f, 0x98, 0xb8, 0xec, 0xb6, 0xab, 0xe5, 0xbf, 0x75, 0xd0, 0x77, 0x40, 0x2d, 0x93, 0x23,
+	0x0a, 0xb8, 0x1f, 0x51, 0xfd, 0xa7, 0x3a, 0x6c, 0x4f, 0xcd, 0x9f, 0x51, 0x5b, 0xbc, 0xbe, 0x54,
+	0x16, 0x34, 0x6c, 0x46, 0xaa, 0xea, 0x94, 0x60, 0xd1, 0x00, 0xb6, 0xe8, 0x38, 0xa0, 0x8e, 0xa0,
+	0xe4, 0xcb, 0x88, 0x86, 0x5f, 0xf0, 0x6f, 0xa8, 0xdf, 0x6d, 0x56, 0x0b, 0xb0, 0xe8, 0x99, 0xd3,
+	0xbc, 0xb5, 0x5a, 0xf3, 0x76, 0xb9, 0xe6, 0x7a, 0x0f, 0x9e, 0x2e, 0xe8, 0x22, 0x35, 0xfb, 0x55,
+	0xc9, 0xd9, 0x62, 0xe1, 0x9c, 0x3e, 0xf0, 0xfe, 0xd2, 0x55, 0xe8, 0x2e, 0xf2, 0x91, 0x64, 0x7f,
+	0x53, 0xe0, 0x59, 0xc1, 0xf8, 0x39, 0xf5, 0xc9, 0x43, 0x27, 0xac, 0xc1, 0x4e, 0x39, 0x27, 0x49,
+	0xfa, 0x5f, 0x25, 0x55, 0xe4, 0x15, 0xf7, 0x3c, 0x26, 0xee, 0xe9, 0x13, 0x6b, 0x00, 0x4e, 0x1a,
+	0xcf, 0xa3, 0x92, 0xf5, 0x1b, 0xc3, 0xdc, 0x4a, 0xa2, 0x08, 0x8d, 0x9c, 0x90, 0x5f, 0x54, 0xed,
+	0x7c, 0x09, 0xbf, 0x9f, 0x76, 0xff, 0x18, 0x7a, 0x25, 0x84, 0x33, 0x39, 0x12, 0xc6, 0x09, 0x09,
+	0x19, 0x42, 0x32, 0x96, 0x2b, 0x7d, 0xa2, 0xff, 0x52, 0x4f, 0xd5, 0x1a, 0xd2, 0x73, 0x8a, 0xdd,
+	0x7b, 0x52, 0xab, 0x98, 0xb2, 0x31, 0x97, 0xb2, 0x30, 0x11, 0x9b, 0x6b, 0x4e, 0x44, 0xf4, 0x11,
+	0x74, 0x02, 0x7c, 0x99, 0x7e, 0x86, 0x56, 0x35, 0xdf, 0x29, 0x1e, 0x21, 0x68, 0x46, 0xd8, 0x15,
+	0xa9, 0x8c, 0x9b, 0xc3, 0xf4, 0x39, 0xa7, 0x7f, 0x67, 0xb5, 0xfe, 0x1b, 0x77, 0xe8, 0xff, 0x0c,
+	0x7a, 0x25, 0x12, 0xca, 0x76, 0xfc, 0x47, 0x49, 0x87, 0xf4, 0x51, 0x6c, 0x7b, 0x4c, 0x1c, 0x86,
+	0x34, 0x27, 0xef, 0x4c, 0x3f, 0xa5, 0xa0, 0xdf, 0xac, 0xa8, 0x7a, 0xbe, 0x28, 0x03, 0xde, 0x76,
+	0xb8, 0xeb, 0x62, 0x41, 0x43, 0xec, 0x1e, 0xe3, 0x28, 0xa2, 0x62, 0xa6, 0xef, 0xd6, 0xcc, 0xf4,
+	0x69, 0x62, 0xe9, 0x13, 0xa4, 0xc3, 0x9b, 0x84, 0xda, 0x62, 0x86, 0xcc, 0x36, 0xdd, 0xa3, 0x64,
+	0x71, 0x8a, 0xe9, 0x42, 0x27, 0x08, 0xa9, 0xc7, 0x62, 0x4f, 0x36, 0xe0, 0xf4, 0x35, 0xb7, 0xc9,
+	0xdb, 0x6b, 0x6d, 0x72, 0xfd, 0x25, 0x3c, 0x5d, 0xe0, 0x2b, 0x7b, 0x71, 0x07, 0x20, 0x08, 0xe9,
+	0xb1, 0xcd, 0xc8, 0xac, 0x9f, 0x36, 0x82, 0x14, 0xd3, 0x27, 0xfa, 0xf7, 0xd9, 0xc6, 0xfd, 0x8a,
+	0x89, 0x53, 0x12, 0xe2, 0x8b, 0x6a, 0x5a, 0x15, 0x43, 0xd6, 0x8b, 0x21, 0xff, 0xf7, 0xa4, 0x92,
+	0x9f, 0x74, 0xbe, 0x94, 0x8c, 0xc6, 0xfe, 0xef, 0x1d, 0x68, 0x0c, 0xa2, 0x11, 0xfa, 0x16, 0xd0,
+	0xe2, 0xe9, 0x8c, 0x2c, 0xa3, 0xfc, 0x4a, 0x64, 0xdc, 0x79, 0xb1, 0x51, 0xf7, 0xd7, 0x71, 0x91,
+	0x5a, 0x86, 0xf0, 0xd6, 0xdc, 0x19, 0x87, 0x8c, 0x55, 0x61, 0x8a, 0x97, 0x04, 0xd5, 0xac, 0x8c,
+	0x97, 0x39, 0x63, 0x78, 0x3c, 0x7f, 0xd6, 0xa0, 0xd5, 0x41, 0x8a, 0x43, 0x45, 0xfd, 0xa0, 0xba,
+	0x83, 0x4c, 0xfb, 0x9d, 0x02, 0x4f, 0xca, 0x46, 0x3e, 0x7a, 0x51, 0x29, 0x54, 0xf1, 0xd0, 0x53,
+	0x3f, 0x5c, 0xcf, 0x49, 0xd6, 0x30, 0x86, 0xad, 0x85, 0x19, 0x8b, 0x96, 0x51, 0x29, 0x3d, 0x7f,
+	0x54, 0x6b, 0x0d, 0x8f, 0x42, 0xe6, 0xe2, 0x74, 0x59, 0x9a, 0xb9, 0x74, 0x96, 0xab, 0xd6, 0x1a,
+	0x1e, 0x85, 0x16, 0xcb, 0xef, 0xe4, 0xa5, 0x2d, 0x56, 0x32, 0xe2, 0x54, 0xb3, 0x32, 0xbe, 0xc0,
+	0xb6, 0xb8, 0xf1, 0x96, 0xb2, 0x2d, 0x1d, 0x17, 0xaa, 0xb5, 0x86, 0x47, 0x96, 0xf9, 0xe0, 0xe8,
+	0xea, 0x6f, 0xad, 0xf6, 0xf3, 0x44, 0xab, 0x5d, 0x4d, 0x34, 0xe5, 0x7a, 0xa2, 0x29, 0x7f, 0x4d,
+	0x34, 0xe5, 0xc7, 0x1b, 0xad, 0x76, 0x7d, 0xa3, 0xd5, 0xfe, 0xb8, 0xd1, 0x6a, 0x5f, 0x5b, 0x23,
+	0x26, 0x4e, 0x63, 0x3b, 0x09, 0x6d, 0x66, 0xe1, 0xdf, 0xe7, 0x27, 0x27, 0xcc, 0x61, 0xd8, 0x95,
+	0xef, 0xe6, 0xec, 0x4f, 0x49, 0x5c, 0x06, 0x34, 0xb2, 0xdb, 0xe9, 0x7f, 0xcd, 0x8b, 0xff, 0x06,
+	0x00, 0xe3, 0xf2, 0x9e, 0x62, 0x48, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -404,6 +754,10 @@ type MsgClient interface {
 	MsgPlaceDebtBid(ctx context.Context, in *MsgPlaceDebtBidRequest, opts ...grpc.CallOption) (*MsgPlaceDebtBidResponse, error)
 	MsgPlaceDutchBid(ctx context.Context, in *MsgPlaceDutchBidRequest, opts ...grpc.CallOption) (*MsgPlaceDutchBidResponse, error)
 	MsgPlaceDutchLendBid(ctx context.Context, in *MsgPlaceDutchLendBidRequest, opts ...grpc.CallOption) (*MsgPlaceDutchLendBidResponse, error)
+	MsgCommitDutchBid(ctx context.Context, in *MsgCommitDutchBidRequest, opts ...grpc.CallOption) (*MsgCommitDutchBidResponse, error)
+	MsgRevealDutchBid(ctx context.Context, in *MsgRevealDutchBidRequest, opts ...grpc.CallOption) (*MsgRevealDutchBidResponse, error)
+	MsgSubmitPreBid(ctx context.Context, in *MsgSubmitPreBidRequest, opts ...grpc.CallOption) (*MsgSubmitPreBidResponse, error)
+	MsgWithdrawPreBid(ctx context.Context, in *MsgWithdrawPreBidRequest, opts ...grpc.CallOption) (*MsgWithdrawPreBidResponse, error)
 }
 
 type msgClient struct {
@@ -450,12 +804,52 @@ func (c *msgClient) MsgPlaceDutchLendBid(ctx context.Context, in *MsgPlaceDutchL
 	return out, nil
 }
 
+func (c *msgClient) MsgCommitDutchBid(ctx context.Context, in *MsgCommitDutchBidRequest, opts ...grpc.CallOption) (*MsgCommitDutchBidResponse, error) {
+	out := new(MsgCommitDutchBidResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Msg/MsgCommitDutchBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MsgRevealDutchBid(ctx context.Context, in *MsgRevealDutchBidRequest, opts ...grpc.CallOption) (*MsgRevealDutchBidResponse, error) {
+	out := new(MsgRevealDutchBidResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Msg/MsgRevealDutchBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MsgSubmitPreBid(ctx context.Context, in *MsgSubmitPreBidRequest, opts ...grpc.CallOption) (*MsgSubmitPreBidResponse, error) {
+	out := new(MsgSubmitPreBidResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Msg/MsgSubmitPreBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MsgWithdrawPreBid(ctx context.Context, in *MsgWithdrawPreBidRequest, opts ...grpc.CallOption) (*MsgWithdrawPreBidResponse, error) {
+	out := new(MsgWithdrawPreBidResponse)
+	err := c.cc.Invoke(ctx, "/comdex.auction.v1beta1.Msg/MsgWithdrawPreBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	MsgPlaceSurplusBid(context.Context, *MsgPlaceSurplusBidRequest) (*MsgPlaceSurplusBidResponse, error)
 	MsgPlaceDebtBid(context.Context, *MsgPlaceDebtBidRequest) (*MsgPlaceDebtBidResponse, error)
 	MsgPlaceDutchBid(context.Context, *MsgPlaceDutchBidRequest) (*MsgPlaceDutchBidResponse, error)
 	MsgPlaceDutchLendBid(context.Context, *MsgPlaceDutchLendBidRequest) (*MsgPlaceDutchLendBidResponse, error)
+	MsgCommitDutchBid(context.Context, *MsgCommitDutchBidRequest) (*MsgCommitDutchBidResponse, error)
+	MsgRevealDutchBid(context.Context, *MsgRevealDutchBidRequest) (*MsgRevealDutchBidResponse, error)
+	MsgSubmitPreBid(context.Context, *MsgSubmitPreBidRequest) (*MsgSubmitPreBidResponse, error)
+	MsgWithdrawPreBid(context.Context, *MsgWithdrawPreBidRequest) (*MsgWithdrawPreBidResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -474,6 +868,18 @@ func (*UnimplementedMsgServer) MsgPlaceDutchBid(ctx context.Context, req *MsgPla
 func (*UnimplementedMsgServer) MsgPlaceDutchLendBid(ctx context.Context, req *MsgPlaceDutchLendBidRequest) (*MsgPlaceDutchLendBidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MsgPlaceDutchLendBid not implemented")
 }
+func (*UnimplementedMsgServer) MsgCommitDutchBid(ctx context.Context, req *MsgCommitDutchBidRequest) (*MsgCommitDutchBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgCommitDutchBid not implemented")
+}
+func (*UnimplementedMsgServer) MsgRevealDutchBid(ctx context.Context, req *MsgRevealDutchBidRequest) (*MsgRevealDutchBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgRevealDutchBid not implemented")
+}
+func (*UnimplementedMsgServer) MsgSubmitPreBid(ctx context.Context, req *MsgSubmitPreBidRequest) (*MsgSubmitPreBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgSubmitPreBid not implemented")
+}
+func (*UnimplementedMsgServer) MsgWithdrawPreBid(ctx context.Context, req *MsgWithdrawPreBidRequest) (*MsgWithdrawPreBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgWithdrawPreBid not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -551,6 +957,78 @@ func _Msg_MsgPlaceDutchLendBid_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MsgCommitDutchBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitDutchBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MsgCommitDutchBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Msg/MsgCommitDutchBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgCommitDutchBid(ctx, req.(*MsgCommitDutchBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MsgRevealDutchBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealDutchBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MsgRevealDutchBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Msg/MsgRevealDutchBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgRevealDutchBid(ctx, req.(*MsgRevealDutchBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MsgSubmitPreBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPreBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MsgSubmitPreBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Msg/MsgSubmitPreBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgSubmitPreBid(ctx, req.(*MsgSubmitPreBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MsgWithdrawPreBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawPreBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MsgWithdrawPreBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.auction.v1beta1.Msg/MsgWithdrawPreBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgWithdrawPreBid(ctx, req.(*MsgWithdrawPreBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "comdex.auction.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -571,10 +1049,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MsgPlaceDutchLendBid",
 			Handler:    _Msg_MsgPlaceDutchLendBid_Handler,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "comdex/auction/v1beta1/tx.proto",
-}
+		{
+			MethodName: "MsgCommitDutchBid",
+			Handler:    _Msg_MsgCommitDutchBid_Handler,
+		},
+		{
+			MethodName: "MsgRevealDutchBid",
+			Handler:    _Msg_MsgRevealDutchBid_Handler,
+		},
+		{
+			MethodName: "MsgSubmitPreBid",
+			Handler:    _Msg_MsgSubmitPreBid_Handler,
+		},
+		{
+			MethodName: "MsgWithdrawPreBid",
+			Handler:    _Msg_MsgWithdrawPreBid_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comdex/auction/v1beta1/tx.proto",
+}
 
 func (m *MsgPlaceSurplusBidRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -596,6 +1090,16 @@ func (m *MsgPlaceSurplusBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Quantity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.AuctionMappingId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.AuctionMappingId))
 		i--
@@ -898,158 +1402,1608 @@ func (m *MsgPlaceDutchLendBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCommitDutchBidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgPlaceSurplusBidRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCommitDutchBidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitDutchBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
-	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AuctionMappingId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionMappingId))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.AuctionMappingId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionMappingId))
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPlaceSurplusBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCommitDutchBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitDutchBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitDutchBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.BiddingId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BiddingId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPlaceDebtBidRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevealDutchBidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealDutchBidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealDutchBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
-	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AuctionMappingId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionMappingId))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = m.Bid.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExpectedUserToken.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.AuctionMappingId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionMappingId))
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Quantity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BiddingId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BiddingId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPlaceDebtBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevealDutchBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealDutchBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealDutchBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPlaceDutchBidRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitPreBidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitPreBidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitPreBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if m.Premium != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Premium))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DebtAssetId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DebtAssetId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CollateralAssetId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CollateralAssetId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.AuctionMappingId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionMappingId))
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitPreBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitPreBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitPreBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreBidId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PreBidId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPreBidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPreBidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPreBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PreBidId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PreBidId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPreBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPreBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPreBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPlaceSurplusBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.AuctionMappingId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionMappingId))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceSurplusBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceDebtBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExpectedUserToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.AuctionMappingId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionMappingId))
+	}
+	return n
+}
+
+func (m *MsgPlaceDebtBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceDutchBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.AuctionMappingId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionMappingId))
+	}
+	return n
+}
+
+func (m *MsgPlaceDutchBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceDutchLendBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.AuctionMappingId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionMappingId))
+	}
+	return n
+}
+
+func (m *MsgPlaceDutchLendBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitDutchBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Escrow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.AuctionMappingId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionMappingId))
+	}
+	return n
+}
+
+func (m *MsgCommitDutchBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BiddingId != 0 {
+		n += 1 + sovTx(uint64(m.BiddingId))
+	}
+	return n
+}
+
+func (m *MsgRevealDutchBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BiddingId != 0 {
+		n += 1 + sovTx(uint64(m.BiddingId))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Payment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.AuctionMappingId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionMappingId))
+	}
+	return n
+}
+
+func (m *MsgRevealDutchBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitPreBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.CollateralAssetId != 0 {
+		n += 1 + sovTx(uint64(m.CollateralAssetId))
+	}
+	if m.DebtAssetId != 0 {
+		n += 1 + sovTx(uint64(m.DebtAssetId))
+	}
+	if m.Premium != 0 {
+		n += 1 + sovTx(uint64(m.Premium))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitPreBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreBidId != 0 {
+		n += 1 + sovTx(uint64(m.PreBidId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawPreBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PreBidId != 0 {
+		n += 1 + sovTx(uint64(m.PreBidId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawPreBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPlaceSurplusBidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceSurplusBidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceSurplusBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			}
+			m.AuctionMappingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceSurplusBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceSurplusBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceSurplusBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceDebtBidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceDebtBidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceDebtBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedUserToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectedUserToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			}
+			m.AuctionMappingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceDebtBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceDebtBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceDebtBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceDutchBidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceDutchBidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceDutchBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			}
+			m.AuctionMappingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceDutchBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceDutchBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceDutchBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceDutchLendBidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceDutchLendBidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceDutchLendBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+			}
+			m.AuctionMappingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgPlaceDutchBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgPlaceDutchLendBidRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
-	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
-	}
-	if m.AuctionMappingId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionMappingId))
+func (m *MsgPlaceDutchLendBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceDutchLendBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceDutchLendBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgPlaceDutchLendBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgPlaceSurplusBidRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitDutchBidRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1072,10 +3026,10 @@ func (m *MsgPlaceSurplusBidRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceSurplusBidRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitDutchBidRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceSurplusBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitDutchBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1131,7 +3085,41 @@ func (m *MsgPlaceSurplusBidRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1158,11 +3146,11 @@ func (m *MsgPlaceSurplusBidRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
@@ -1181,7 +3169,7 @@ func (m *MsgPlaceSurplusBidRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
 			}
@@ -1221,7 +3209,7 @@ func (m *MsgPlaceSurplusBidRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceSurplusBidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitDutchBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1244,12 +3232,31 @@ func (m *MsgPlaceSurplusBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceSurplusBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitDutchBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceSurplusBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitDutchBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingId", wireType)
+			}
+			m.BiddingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BiddingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1271,7 +3278,7 @@ func (m *MsgPlaceSurplusBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceDebtBidRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealDutchBidRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1294,10 +3301,10 @@ func (m *MsgPlaceDebtBidRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceDebtBidRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealDutchBidRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceDebtBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealDutchBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1352,8 +3359,27 @@ func (m *MsgPlaceDebtBidRequest) Unmarshal(dAtA []byte) error {
 			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingId", wireType)
+			}
+			m.BiddingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BiddingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1380,13 +3406,13 @@ func (m *MsgPlaceDebtBidRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedUserToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1413,11 +3439,43 @@ func (m *MsgPlaceDebtBidRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExpectedUserToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
@@ -1436,7 +3494,7 @@ func (m *MsgPlaceDebtBidRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
 			}
@@ -1476,7 +3534,7 @@ func (m *MsgPlaceDebtBidRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceDebtBidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealDutchBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1499,10 +3557,10 @@ func (m *MsgPlaceDebtBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceDebtBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealDutchBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceDebtBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealDutchBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1526,7 +3584,7 @@ func (m *MsgPlaceDebtBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceDutchBidRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPreBidRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1549,32 +3607,13 @@ func (m *MsgPlaceDutchBidRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceDutchBidRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPreBidRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceDutchBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPreBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
-			}
-			m.AuctionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
@@ -1606,11 +3645,11 @@ func (m *MsgPlaceDutchBidRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			var msglen int
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1620,30 +3659,35 @@ func (m *MsgPlaceDutchBidRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAssetId", wireType)
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CollateralAssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CollateralAssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAssetId", wireType)
 			}
-			m.AppId = 0
+			m.DebtAssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1653,16 +3697,16 @@ func (m *MsgPlaceDutchBidRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				m.DebtAssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Premium", wireType)
 			}
-			m.AuctionMappingId = 0
+			m.Premium = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1672,11 +3716,44 @@ func (m *MsgPlaceDutchBidRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionMappingId |= uint64(b&0x7F) << shift
+				m.Premium |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1698,7 +3775,7 @@ func (m *MsgPlaceDutchBidRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceDutchBidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPreBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1721,12 +3798,31 @@ func (m *MsgPlaceDutchBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceDutchBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPreBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceDutchBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPreBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreBidId", wireType)
+			}
+			m.PreBidId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreBidId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1748,7 +3844,7 @@ func (m *MsgPlaceDutchBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceDutchLendBidRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawPreBidRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1771,32 +3867,13 @@ func (m *MsgPlaceDutchLendBidRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceDutchLendBidRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawPreBidRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceDutchLendBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawPreBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
-			}
-			m.AuctionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
@@ -1828,6 +3905,25 @@ func (m *MsgPlaceDutchLendBidRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreBidId", wireType)
+			}
+			m.PreBidId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreBidId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
@@ -1861,44 +3957,6 @@ func (m *MsgPlaceDutchLendBidRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
-			}
-			m.AppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMappingId", wireType)
-			}
-			m.AuctionMappingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionMappingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1920,7 +3978,7 @@ func (m *MsgPlaceDutchLendBidRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceDutchLendBidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawPreBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1943,10 +4001,10 @@ func (m *MsgPlaceDutchLendBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceDutchLendBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawPreBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceDutchLendBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawPreBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
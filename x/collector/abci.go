@@ -1 +1,12 @@
 package collector
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/comdex-official/comdex/x/collector/keeper"
+)
+
+func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k keeper.Keeper) {
+	k.ActivatePendingChanges(ctx)
+}
@@ -23,6 +23,8 @@ type AssetKeeper interface {
 	GetApp(ctx sdk.Context, id uint64) (types.AppData, bool)
 	GetAsset(ctx sdk.Context, id uint64) (types.Asset, bool)
 	GetMintGenesisTokenData(ctx sdk.Context, appID, assetID uint64) (mintData types.MintGenesisToken, found bool)
+	ScheduleChange(ctx sdk.Context, paramClass string, change types.RiskParameter) (uint64, error)
+	ActivatePendingChanges(ctx sdk.Context, paramClass string, apply func(ctx sdk.Context, change types.RiskParameter) error)
 }
 
 type AuctionKeeper interface {
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/comdex-official/comdex/app/wasm/bindings"
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
 	auctiontypes "github.com/comdex-official/comdex/x/auction/types"
 	"github.com/comdex-official/comdex/x/collector/types"
 	lockertypes "github.com/comdex-official/comdex/x/locker/types"
@@ -677,45 +678,80 @@ func (k Keeper) WasmSetAuctionMappingForAppQuery(ctx sdk.Context, appID uint64)
 	return false, types.ErrorAppDoesNotExist.Error()
 }
 
+// WasmUpdateCollectorLookupTable schedules the update of the collector lookup
+// table, which takes effect once the timelock delay of the class has passed.
 func (k Keeper) WasmUpdateCollectorLookupTable(ctx sdk.Context, updateColBinding *bindings.MsgUpdateCollectorLookupTable) error {
-	Collector, _ := k.GetCollectorLookupTable(ctx, updateColBinding.AppID, updateColBinding.AssetID)
-	_, found := k.rewards.GetReward(ctx, Collector.AppId, Collector.CollectorAssetId)
+	Collector, found := k.GetCollectorLookupTable(ctx, updateColBinding.AppID, updateColBinding.AssetID)
+	if !found {
+		return types.ErrorDataDoesNotExists
+	}
+	Collector.BidFactor = updateColBinding.BidFactor
+	Collector.DebtThreshold = updateColBinding.DebtThreshold
+	Collector.SurplusThreshold = updateColBinding.SurplusThreshold
+	Collector.LockerSavingRate = updateColBinding.LSR
+	Collector.LotSize = updateColBinding.LotSize
+	Collector.DebtLotSize = updateColBinding.DebtLotSize
+
+	_, err := k.asset.ScheduleChange(ctx, assettypes.ParamClassCollectorLookupTable, &Collector)
+	return err
+}
+
+// UpdateCollectorLookupTable applies a scheduled update of a collector lookup
+// table.
+func (k Keeper) UpdateCollectorLookupTable(ctx sdk.Context, update types.CollectorLookupTableData) error {
+	Collector, found := k.GetCollectorLookupTable(ctx, update.AppId, update.CollectorAssetId)
+	if !found {
+		return types.ErrorDataDoesNotExists
+	}
+	_, found = k.rewards.GetReward(ctx, Collector.AppId, Collector.CollectorAssetId)
 	if found {
-		if Collector.LockerSavingRate != updateColBinding.LSR {
-			if updateColBinding.LSR.IsZero() {
+		if Collector.LockerSavingRate != update.LockerSavingRate {
+			if update.LockerSavingRate.IsZero() {
 				// run script to distribute reward
-				k.LockerIterateRewards(ctx, Collector.LockerSavingRate, Collector.BlockHeight, Collector.BlockTime.Unix(), updateColBinding.AppID, updateColBinding.AssetID, false)
+				k.LockerIterateRewards(ctx, Collector.LockerSavingRate, Collector.BlockHeight, Collector.BlockTime.Unix(), update.AppId, update.CollectorAssetId, false)
 				Collector.BlockTime = ctx.BlockTime()
 				Collector.BlockHeight = 0
 			} else if Collector.LockerSavingRate.IsZero() {
 				// do nothing
 				Collector.BlockHeight = ctx.BlockHeight()
 				Collector.BlockTime = ctx.BlockTime()
-			} else if Collector.LockerSavingRate.GT(sdk.ZeroDec()) && updateColBinding.LSR.GT(sdk.ZeroDec()) {
+			} else if Collector.LockerSavingRate.GT(sdk.ZeroDec()) && update.LockerSavingRate.GT(sdk.ZeroDec()) {
 				// run script to distribute
-				k.LockerIterateRewards(ctx, Collector.LockerSavingRate, Collector.BlockHeight, Collector.BlockTime.Unix(), updateColBinding.AppID, updateColBinding.AssetID, true)
+				k.LockerIterateRewards(ctx, Collector.LockerSavingRate, Collector.BlockHeight, Collector.BlockTime.Unix(), update.AppId, update.CollectorAssetId, true)
 				Collector.BlockHeight = ctx.BlockHeight()
 				Collector.BlockTime = ctx.BlockTime()
 			}
 		}
 	}
 
-	Collector.BidFactor = updateColBinding.BidFactor
-	Collector.DebtThreshold = updateColBinding.DebtThreshold
-	Collector.SurplusThreshold = updateColBinding.SurplusThreshold
-	Collector.LockerSavingRate = updateColBinding.LSR
-	Collector.LotSize = updateColBinding.LotSize
-	Collector.DebtLotSize = updateColBinding.DebtLotSize
+	Collector.BidFactor = update.BidFactor
+	Collector.DebtThreshold = update.DebtThreshold
+	Collector.SurplusThreshold = update.SurplusThreshold
+	Collector.LockerSavingRate = update.LockerSavingRate
+	Collector.LotSize = update.LotSize
+	Collector.DebtLotSize = update.DebtLotSize
 
 	var (
 		store = ctx.KVStore(k.storeKey)
-		key   = types.CollectorLookupTableMappingKey(updateColBinding.AppID, updateColBinding.AssetID)
+		key   = types.CollectorLookupTableMappingKey(update.AppId, update.CollectorAssetId)
 		value = k.cdc.MustMarshal(&Collector)
 	)
 	store.Set(key, value)
 	return nil
 }
 
+// ActivatePendingChanges applies the collector lookup table updates whose
+// timelock delay has passed.
+func (k Keeper) ActivatePendingChanges(ctx sdk.Context) {
+	k.asset.ActivatePendingChanges(ctx, assettypes.ParamClassCollectorLookupTable, func(ctx sdk.Context, change assettypes.RiskParameter) error {
+		update, ok := change.(*types.CollectorLookupTableData)
+		if !ok {
+			return assettypes.ErrorInvalidPendingChange
+		}
+		return k.UpdateCollectorLookupTable(ctx, *update)
+	})
+}
+
 func (k Keeper) LockerIterateRewards(ctx sdk.Context, collectorLsr sdk.Dec, collectorBh, collectorBt int64, appID, assetID uint64, changeTypes bool) {
 	lockers, found := k.locker.GetLockerLookupTable(ctx, appID, assetID)
 	if found {
@@ -119,6 +119,13 @@ func (s *KeeperTestSuite) TestWasmUpdateCollectorLookupTable() {
 			s.Require().NoError(err)
 			result, found := collectorKeeper.GetCollectorLookupTable(*ctx, tc.msg.AppID, tc.msg.AssetID)
 			s.Require().True(found)
+			s.Require().NotEqual(result.SurplusThreshold, tc.msg.SurplusThreshold)
+
+			// the update only takes effect once the timelock delay has passed
+			s.advanceseconds(int64(assetTypes.DefaultTimelockDelay.Seconds()))
+			collectorKeeper.ActivatePendingChanges(*ctx)
+			result, found = collectorKeeper.GetCollectorLookupTable(*ctx, tc.msg.AppID, tc.msg.AssetID)
+			s.Require().True(found)
 			s.Require().Equal(result.AppId, tc.msg.AppID)
 			s.Require().Equal(result.CollectorAssetId, tc.msg.AssetID)
 			s.Require().Equal(result.SurplusThreshold, tc.msg.SurplusThreshold)
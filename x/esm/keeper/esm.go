@@ -447,9 +447,12 @@ func (k Keeper) SetUpCollateralRedemptionForVault(ctx sdk.Context, appID uint64,
 				k.SetAssetToAmount(ctx, assetToAmtInData)
 				k.SetAssetToAmount(ctx, assetToAmtOutData)
 			}
+			if err := k.poolBasketCollateral(ctx, appID, data); err != nil {
+				return err
+			}
 			k.vault.DeleteVault(ctx, data.Id)
 			k.vault.DeleteAddressFromAppExtendedPairVaultMapping(ctx, data.ExtendedPairVaultID, data.Id, data.AppId)
-			k.vault.DeleteUserVaultExtendedPairMapping(ctx, data.Owner, appID, data.ExtendedPairVaultID)
+			k.vault.DeleteUserVaultExtendedPairMapping(ctx, data.Owner, appID, data.ExtendedPairVaultID, data.Id)
 			k.vault.UpdateTokenMintedAmountLockerMapping(ctx, appID, data.ExtendedPairVaultID, data.AmountOut, false)
 			k.vault.UpdateCollateralLockedAmountLockerMapping(ctx, appID, data.ExtendedPairVaultID, data.AmountIn, false)
 			length := k.vault.GetLengthOfVault(ctx)
@@ -463,6 +466,42 @@ func (k Keeper) SetUpCollateralRedemptionForVault(ctx sdk.Context, appID uint64,
 	return nil
 }
 
+// poolBasketCollateral moves the basket collateral of a vault into the esm
+// module next to its pair collateral.
+func (k Keeper) poolBasketCollateral(ctx sdk.Context, appID uint64, vault vaulttypes.Vault) error {
+	if vault.Collaterals.IsZero() {
+		return nil
+	}
+	coolOffData, _ := k.GetDataAfterCoolOff(ctx, appID)
+	for _, coin := range vault.Collaterals {
+		assetData, found := k.asset.GetAssetForDenom(ctx, coin.Denom)
+		if !found {
+			return assettypes.ErrorAssetDoesNotExist
+		}
+		rate, found := k.GetSnapshotOfPrices(ctx, appID, assetData.Id)
+		if !found {
+			return types.ErrPriceNotFound
+		}
+		coolOffData.CollateralTotalAmount = coolOffData.CollateralTotalAmount.Add(k.CalcDollarValueOfToken(ctx, rate, coin.Amount, assetData.Decimals))
+		assetToAmtData, found := k.GetAssetToAmount(ctx, appID, assetData.Id)
+		if !found {
+			assetToAmtData.AppId = appID
+			assetToAmtData.AssetID = assetData.Id
+			assetToAmtData.Amount = coin.Amount
+			assetToAmtData.IsCollateral = true
+		} else {
+			assetToAmtData.Amount = assetToAmtData.Amount.Add(coin.Amount)
+		}
+		k.SetAssetToAmount(ctx, assetToAmtData)
+	}
+	err := k.bank.SendCoinsFromModuleToModule(ctx, vaulttypes.ModuleName, types.ModuleName, vault.Collaterals)
+	if err != nil {
+		return err
+	}
+	k.SetDataAfterCoolOff(ctx, coolOffData)
+	return nil
+}
+
 // StableMintVault Function
 
 func (k Keeper) SetUpCollateralRedemptionForStableVault(ctx sdk.Context, appID uint64, esmData types.ESMTriggerParams) error {
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
 	"github.com/comdex-official/comdex/x/esm/types"
 )
 
@@ -68,3 +69,12 @@ func (k Keeper) Admin(ctx sdk.Context, from string) bool {
 	}
 	return false
 }
+
+// IsEmergencyAdmin reports whether from is the emergency admin of the app.
+func (k Keeper) IsEmergencyAdmin(ctx sdk.Context, appID uint64, from string) bool {
+	app, found := k.asset.GetApp(ctx, appID)
+	if !found {
+		return false
+	}
+	return app.Roles.Holds(from, assettypes.AppRoleEmergencyAdmin)
+}
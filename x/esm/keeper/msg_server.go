@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/comdex-official/comdex/x/esm/types"
 )
@@ -50,7 +51,10 @@ func (m msgServer) ExecuteESM(goCtx context.Context, execute *types.MsgExecuteES
 
 func (m msgServer) MsgKillSwitch(c context.Context, msg *types.MsgKillRequest) (*types.MsgKillResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	if !m.keeper.Admin(ctx, msg.From) {
+	if msg.KillSwitchParams == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "kill switch params cannot be empty")
+	}
+	if !m.keeper.Admin(ctx, msg.From) && !m.keeper.IsEmergencyAdmin(ctx, msg.KillSwitchParams.AppId, msg.From) {
 		return nil, types.ErrorUnauthorized
 	}
 
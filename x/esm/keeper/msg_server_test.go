@@ -0,0 +1,16 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/comdex-official/comdex/x/esm/types"
+)
+
+func (s *KeeperTestSuite) TestMsgKillSwitchWithoutParams() {
+	msg := &types.MsgKillRequest{From: sdk.AccAddress("stranger____________").String()}
+	s.Require().ErrorIs(msg.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+
+	_, err := s.msgServer.MsgKillSwitch(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
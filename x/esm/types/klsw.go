@@ -18,6 +18,9 @@ func (m *MsgKillRequest) ValidateBasic() error {
 	if m.From == "" {
 		return errors.Wrap(errors.ErrInvalidAddress, "from cannot be empty")
 	}
+	if m.KillSwitchParams == nil {
+		return errors.Wrap(errors.ErrInvalidRequest, "kill switch params cannot be empty")
+	}
 
 	return nil
 }
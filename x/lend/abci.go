@@ -0,0 +1,22 @@
+package lend
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/comdex-official/comdex/x/lend/keeper"
+	"github.com/comdex-official/comdex/x/lend/types"
+)
+
+// BeginBlocker applies the risk parameter updates whose timelock expired,
+// moves the curves of the adaptive interest rate model before any position
+// accrues interest in the block and settles fixed-term loans that matured or
+// fell below their liquidation threshold.
+func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, ctx.BlockTime(), telemetry.MetricKeyBeginBlocker)
+
+	k.ActivatePendingChanges(ctx)
+	k.UpdateAdaptiveRates(ctx)
+	k.ProcessFixedTermLoans(ctx)
+}
@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	flag "github.com/spf13/pflag"
+
+	"github.com/comdex-official/comdex/x/lend/types"
 )
 
 const (
@@ -47,6 +50,56 @@ func ParseDecSliceFromString(s string, separator string) ([]sdk.Dec, error) {
 	return newParsedDec, nil
 }
 
+// ParseInterestRateModel parses the optional interest rate model fields of
+// an asset rates json file, leaving the kinked model when they are omitted.
+func ParseInterestRateModel(modelID, rateCap, adjustmentSpeed string) (uint64, sdk.Dec, sdk.Dec, error) {
+	var (
+		id    uint64
+		limit = sdk.ZeroDec()
+		speed = sdk.ZeroDec()
+		err   error
+	)
+	if modelID != "" {
+		if id, err = strconv.ParseUint(modelID, 10, 64); err != nil {
+			return 0, limit, speed, err
+		}
+	}
+	if rateCap != "" {
+		if limit, err = sdk.NewDecFromStr(rateCap); err != nil {
+			return 0, limit, speed, err
+		}
+	}
+	if adjustmentSpeed != "" {
+		if speed, err = sdk.NewDecFromStr(adjustmentSpeed); err != nil {
+			return 0, limit, speed, err
+		}
+	}
+	return id, limit, speed, nil
+}
+
+// ParseBadDebtWaterfall parses a comma separated list of the steps reserve,
+// collector and socialize. An empty list leaves all bad debt outstanding.
+func ParseBadDebtWaterfall(s string) (types.BadDebtWaterfall, error) {
+	steps := map[string]uint64{
+		"reserve":   types.BadDebtCoverageReserve,
+		"collector": types.BadDebtCoverageCollector,
+		"socialize": types.BadDebtCoverageSocialize,
+	}
+	waterfall := types.BadDebtWaterfall{Steps: []uint64{}}
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		step, ok := steps[name]
+		if !ok {
+			return waterfall, fmt.Errorf("unknown bad debt waterfall step %s", name)
+		}
+		waterfall.Steps = append(waterfall.Steps, step)
+	}
+	return waterfall, waterfall.Validate()
+}
+
 func ParseBoolFromString(s uint64) bool {
 	switch s {
 	case 1:
@@ -148,6 +201,9 @@ type addAssetRatesParamsInputs struct {
 	LiquidationBonus     string `json:"liquidation_bonus"`
 	ReserveFactor        string `json:"reserve_factor"`
 	CAssetID             string `json:"c_asset_id"`
+	InterestRateModelID  string `json:"interest_rate_model_id"`
+	RateCap              string `json:"rate_cap"`
+	AdjustmentSpeed      string `json:"adjustment_speed"`
 	Title                string
 	Description          string
 	Deposit              string
@@ -189,6 +245,9 @@ type addAssetRatesPoolPairsInputs struct {
 	SupplyCap            string `json:"supply_cap"`
 	CPoolName            string `json:"c_pool_name"`
 	MinUSDValueLeft      string `json:"min_usd_value_left"`
+	InterestRateModelID  string `json:"interest_rate_model_id"`
+	RateCap              string `json:"rate_cap"`
+	AdjustmentSpeed      string `json:"adjustment_speed"`
 	Title                string
 	Description          string
 	Deposit              string
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/spf13/cobra"
 
@@ -52,6 +53,13 @@ func GetQueryCmd() *cobra.Command {
 		QueryFundModBalByAssetPool(),
 		queryLendInterest(),
 		queryBorrowInterest(),
+		queryPositionManagerGrants(),
+		queryEModeCategories(),
+		queryInterestRateProjection(),
+		queryBadDebtWaterfall(),
+		queryCrossCollateralAccount(),
+		queryFixedTermMarkets(),
+		queryFixedTermPositions(),
 	)
 
 	return cmd
@@ -967,3 +975,216 @@ func queryBorrowInterest() *cobra.Command {
 
 	return cmd
 }
+
+func queryPositionManagerGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position-manager-grants [owner]",
+		Short: "position managers granted by an owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryPositionManagerGrants(cmd.Context(), &types.QueryPositionManagerGrantsRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryEModeCategories() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "e-mode-categories",
+		Short: "e-mode categories",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryEModeCategories(cmd.Context(), &types.QueryEModeCategoriesRequest{})
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryInterestRateProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interest-rate-projection [pool-id] [asset-id] [utilisation]",
+		Short: "current rates of an asset in a pool and the rates at a given utilisation",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			assetID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			utilisation, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryInterestRateProjection(cmd.Context(), &types.QueryInterestRateProjectionRequest{
+				PoolId:      poolID,
+				AssetId:     assetID,
+				Utilisation: utilisation,
+			})
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryBadDebtWaterfall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bad-debt-waterfall",
+		Short: "bad debt waterfall and the cToken exchange rates lowered by socialized bad debt",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryBadDebtWaterfall(cmd.Context(), &types.QueryBadDebtWaterfallRequest{})
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCrossCollateralAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-collateral-account [owner] [pool-id]",
+		Short: "cross-collateral account of an owner in a pool and its health",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryCrossCollateralAccount(cmd.Context(), &types.QueryCrossCollateralAccountRequest{
+				Owner:  args[0],
+				PoolId: poolID,
+			})
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryFixedTermMarkets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fixed-term-markets",
+		Short: "fixed-term markets and their liquidity",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryFixedTermMarkets(cmd.Context(), &types.QueryFixedTermMarketsRequest{})
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryFixedTermPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fixed-term-positions [owner]",
+		Short: "fixed-term deposits and loans of an owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryFixedTermPositions(cmd.Context(), &types.QueryFixedTermPositionsRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -41,6 +42,17 @@ func GetTxCmd() *cobra.Command {
 		txFundModuleAccounts(),
 		txCalculateInterestAndRewards(),
 		txFundReserveAccounts(),
+		txGrantPositionManager(),
+		txRevokePositionManager(),
+		txDepositAccountCollateral(),
+		txWithdrawAccountCollateral(),
+		txAccountBorrow(),
+		txAccountRepay(),
+		txLiquidateAccount(),
+		txFixedTermCommit(),
+		txFixedTermRedeem(),
+		txFixedTermBorrow(),
+		txFixedTermRepay(),
 	)
 
 	return cmd
@@ -883,14 +895,37 @@ func CmdAddNewAssetRatesParamsProposal() *cobra.Command {
 }
 
 func NewCreateassetRatesParams(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	assetRatesParamsInput, assetRatesParams, err := parseAssetRatesParams(fs)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	from := clientCtx.GetFromAddress()
+
+	deposit, err := sdk.ParseCoinsNormalized(assetRatesParamsInput.Deposit)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	content := types.NewAddassetRatesParams(assetRatesParamsInput.Title, assetRatesParamsInput.Description, assetRatesParams)
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	return txf, msg, nil
+}
+
+func parseAssetRatesParams(fs *flag.FlagSet) (*addAssetRatesParamsInputs, types.AssetRatesParams, error) {
 	assetRatesParamsInput, err := parseAssetRateStatsFlags(fs)
 	if err != nil {
-		return txf, nil, fmt.Errorf("failed to parse asset rates stats : %w", err)
+		return nil, types.AssetRatesParams{}, fmt.Errorf("failed to parse asset rates stats : %w", err)
 	}
 
 	assetID, err := strconv.ParseUint(assetRatesParamsInput.AssetID, 10, 64)
 	if err != nil {
-		return txf, nil, err
+		return nil, types.AssetRatesParams{}, err
 	}
 
 	uOptimal := assetRatesParamsInput.UOptimal
@@ -903,7 +938,7 @@ func NewCreateassetRatesParams(clientCtx client.Context, txf tx.Factory, fs *fla
 
 	enableStableBorrow, err := strconv.ParseUint(assetRatesParamsInput.EnableStableBorrow, 10, 64)
 	if err != nil {
-		return txf, nil, err
+		return nil, types.AssetRatesParams{}, err
 	}
 	stableBase := assetRatesParamsInput.StableBase
 
@@ -923,7 +958,12 @@ func NewCreateassetRatesParams(clientCtx client.Context, txf tx.Factory, fs *fla
 
 	cAssetID, err := strconv.ParseUint(assetRatesParamsInput.CAssetID, 10, 64)
 	if err != nil {
-		return txf, nil, err
+		return nil, types.AssetRatesParams{}, err
+	}
+
+	interestRateModelID, rateCap, adjustmentSpeed, err := ParseInterestRateModel(assetRatesParamsInput.InterestRateModelID, assetRatesParamsInput.RateCap, assetRatesParamsInput.AdjustmentSpeed)
+	if err != nil {
+		return nil, types.AssetRatesParams{}, err
 	}
 
 	newUOptimal, _ := sdk.NewDecFromStr(uOptimal)
@@ -956,23 +996,50 @@ func NewCreateassetRatesParams(clientCtx client.Context, txf tx.Factory, fs *fla
 		LiquidationBonus:     newLiquidationBonus,
 		ReserveFactor:        newReserveFactor,
 		CAssetID:             cAssetID,
+		InterestRateModelID:  interestRateModelID,
+		RateCap:              rateCap,
+		AdjustmentSpeed:      adjustmentSpeed,
 	}
 
-	from := clientCtx.GetFromAddress()
+	return assetRatesParamsInput, assetRatesParams, nil
+}
 
-	deposit, err := sdk.ParseCoinsNormalized(assetRatesParamsInput.Deposit)
-	if err != nil {
-		return txf, nil, err
-	}
+func CmdUpdateAssetRatesParamsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-asset-rates-params [flags]",
+		Short: "Update the rates params of a lend asset",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
 
-	content := types.NewAddassetRatesParams(assetRatesParamsInput.Title, assetRatesParamsInput.Description, assetRatesParams)
+			assetRatesParamsInput, assetRatesParams, err := parseAssetRatesParams(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
-	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
-	if err != nil {
-		return txf, nil, err
+			deposit, err := sdk.ParseCoinsNormalized(assetRatesParamsInput.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateAssetRatesParamsProposal(assetRatesParamsInput.Title, assetRatesParamsInput.Description, assetRatesParams)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
 	}
 
-	return txf, msg, nil
+	cmd.Flags().AddFlagSet(FlagSetAddAssetRatesParamsMapping())
+	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	return cmd
 }
 
 func CmdAddNewAuctionParamsProposal() *cobra.Command {
@@ -1274,6 +1341,11 @@ func NewCreateAssetRatesPoolPairs(clientCtx client.Context, txf tx.Factory, fs *
 		return txf, nil, err
 	}
 
+	interestRateModelID, rateCap, adjustmentSpeed, err := ParseInterestRateModel(assetRatesPoolPairs.InterestRateModelID, assetRatesPoolPairs.RateCap, assetRatesPoolPairs.AdjustmentSpeed)
+	if err != nil {
+		return txf, nil, err
+	}
+
 	newUOptimal, _ := sdk.NewDecFromStr(uOptimal)
 	newBase, _ := sdk.NewDecFromStr(base)
 	newSlope1, _ := sdk.NewDecFromStr(slope1)
@@ -1346,6 +1418,9 @@ func NewCreateAssetRatesPoolPairs(clientCtx client.Context, txf tx.Factory, fs *
 		CPoolName:            cPoolName,
 		AssetData:            assetData,
 		MinUsdValueLeft:      minUSDValueLeft,
+		InterestRateModelID:  interestRateModelID,
+		RateCap:              rateCap,
+		AdjustmentSpeed:      adjustmentSpeed,
 	}
 
 	content := types.NewAddassetRatesPoolPairs(assetRatesPoolPairs.Title, assetRatesPoolPairs.Description, assetRatesPoolPairsE)
@@ -1357,3 +1432,666 @@ func NewCreateAssetRatesPoolPairs(clientCtx client.Context, txf tx.Factory, fs *
 
 	return txf, msg, nil
 }
+
+func txGrantPositionManager() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-position-manager [manager] [permissions]",
+		Short: "allow a manager to act on your lend and borrow positions",
+		Long: `Permissions is a comma separated list of deposit, repay and withdraw_to_owner.
+				Funds withdrawn by a manager are always sent to the owner of the position.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			permissions, err := types.ParsePositionPermissions(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantPositionManager(ctx.GetFromAddress().String(), args[0], permissions)
+
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txRevokePositionManager() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-position-manager [manager]",
+		Short: "revoke all permissions granted to a position manager",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokePositionManager(ctx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txDepositAccountCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-account-collateral [lend-id] [amount]",
+		Short: "lock cTokens of a lend position as collateral of the cross-collateral account in its pool",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lendID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositAccountCollateral(ctx.GetFromAddress().String(), lendID, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txWithdrawAccountCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-account-collateral [lend-id] [amount]",
+		Short: "return cTokens locked in the cross-collateral account to their lend position",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lendID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawAccountCollateral(ctx.GetFromAddress().String(), lendID, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txAccountBorrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-borrow [pool-id] [asset-id] [amount]",
+		Short: "borrow an asset of the pool against the cross-collateral account",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			assetID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAccountBorrow(ctx.GetFromAddress().String(), poolID, assetID, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txAccountRepay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-repay [pool-id] [amount]",
+		Short: "repay a debt of the cross-collateral account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAccountRepay(ctx.GetFromAddress().String(), poolID, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txLiquidateAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidate-account [owner] [pool-id] [amount]",
+		Short: "repay debt of an unhealthy cross-collateral account in exchange for its collateral",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLiquidateAccount(ctx.GetFromAddress().String(), args[0], poolID, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txFixedTermCommit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fixed-term-commit [market-id] [amount]",
+		Short: "commit liquidity to a fixed-term market until its maturity",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			marketID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFixedTermCommit(ctx.GetFromAddress().String(), marketID, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txFixedTermRedeem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fixed-term-redeem [deposit-id]",
+		Short: "redeem a deposit of a settled fixed-term market with its interest",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFixedTermRedeem(ctx.GetFromAddress().String(), depositID)
+
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txFixedTermBorrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fixed-term-borrow [market-id] [lend-id] [pair-id] [amount-in] [amount-out]",
+		Short: "borrow from a fixed-term market at its rate against cTokens of a lend position",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			marketID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			lendID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pairID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amountIn, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			amountOut, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFixedTermBorrow(ctx.GetFromAddress().String(), marketID, lendID, pairID, amountIn, amountOut)
+
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func txFixedTermRepay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fixed-term-repay [loan-id]",
+		Short: "repay a fixed-term loan, with the early repayment penalty before maturity",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			loanID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFixedTermRepay(ctx.GetFromAddress().String(), loanID)
+
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSetBadDebtWaterfallProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bad-debt-waterfall [steps]",
+		Short: "Set the order bad debt is covered in, as a comma separated list of reserve, collector and socialize",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			waterfall, err := ParseBadDebtWaterfall(args[0])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetBadDebtWaterfallProposal(title, description, waterfall)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+func CmdSetEModeCategoryProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-e-mode-category [id] [name] [asset-ids] [ltv] [liquidation-threshold]",
+		Short: "Add or update an e-mode category, use id 0 to add a new category",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			assetIDs, err := ParseUint64SliceFromString(args[2], ",")
+			if err != nil {
+				return err
+			}
+
+			ltv, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			liquidationThreshold, err := sdk.NewDecFromStr(args[4])
+			if err != nil {
+				return err
+			}
+
+			category := types.EModeCategory{
+				ID:                   id,
+				Name:                 args[1],
+				AssetIDs:             assetIDs,
+				Ltv:                  ltv,
+				LiquidationThreshold: liquidationThreshold,
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetEModeCategoryProposal(title, description, category)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+func CmdAddFixedTermMarketProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-fixed-term-market [app-id] [pool-id] [asset-id] [maturity] [rate] [early-repayment-penalty]",
+		Short: "Add a fixed-term market maturing at an RFC3339 time with a fixed yearly rate",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			assetID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			maturity, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			rate, err := sdk.NewDecFromStr(args[4])
+			if err != nil {
+				return err
+			}
+
+			penalty, err := sdk.NewDecFromStr(args[5])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			market := types.FixedTermMarket{
+				AppID:                 appID,
+				PoolID:                poolID,
+				AssetID:               assetID,
+				Maturity:              maturity.UTC(),
+				Rate:                  rate,
+				EarlyRepaymentPenalty: penalty,
+			}
+			content := types.NewAddFixedTermMarketProposal(title, description, market)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+func CmdUpdatePoolPairsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-lend-pool-pairs [pool-id] [asset-ids] [supply-caps] [min-usd-value-left]",
+		Short: "Update the supply caps of a lend pool's assets and the min usd value left of the pairs borrowing from it",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			assetIDs, err := ParseUint64SliceFromString(args[1], ",")
+			if err != nil {
+				return err
+			}
+
+			supplyCaps, err := ParseDecSliceFromString(args[2], ",")
+			if err != nil {
+				return err
+			}
+			if len(assetIDs) != len(supplyCaps) {
+				return fmt.Errorf("got %d asset ids and %d supply caps", len(assetIDs), len(supplyCaps))
+			}
+
+			minUSDValueLeft, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			var assetData []*types.AssetDataPoolMapping
+			for i := range assetIDs {
+				assetData = append(assetData, &types.AssetDataPoolMapping{
+					AssetID:   assetIDs[i],
+					SupplyCap: supplyCaps[i],
+				})
+			}
+			content := types.NewUpdatePoolPairsProposal(title, description, poolID, assetData, minUSDValueLeft)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
@@ -17,4 +17,9 @@ var (
 	AddMultipleLendPairsHandler   = govclient.NewProposalHandler(cli.CmdAddNewMultipleLendPairsProposal, rest.AddNewPairsProposalRESTHandler)
 	AddPoolPairsHandler           = govclient.NewProposalHandler(cli.CmdAddPoolPairsProposal, rest.AddPoolPairsProposalRESTHandler)
 	AddAssetRatesPoolPairsHandler = govclient.NewProposalHandler(cli.CmdAddAssetRatesPoolPairsProposal, rest.AddAssetRatesPoolPairsProposalRESTHandler)
+	SetEModeCategoryHandler       = govclient.NewProposalHandler(cli.CmdSetEModeCategoryProposal, rest.SetEModeCategoryProposalRESTHandler)
+	SetBadDebtWaterfallHandler    = govclient.NewProposalHandler(cli.CmdSetBadDebtWaterfallProposal, rest.SetBadDebtWaterfallProposalRESTHandler)
+	AddFixedTermMarketHandler     = govclient.NewProposalHandler(cli.CmdAddFixedTermMarketProposal, rest.AddFixedTermMarketProposalRESTHandler)
+	UpdateAssetRatesParamsHandler = govclient.NewProposalHandler(cli.CmdUpdateAssetRatesParamsProposal, rest.UpdateAssetRatesParamsProposalRESTHandler)
+	UpdatePoolPairsHandler        = govclient.NewProposalHandler(cli.CmdUpdatePoolPairsProposal, rest.UpdatePoolPairsProposalRESTHandler)
 )
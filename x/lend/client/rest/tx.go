@@ -20,12 +20,17 @@ type AddNewAssetsRequest struct {
 }
 
 type (
-	AddNewPairsRequest         struct{}
-	UpdateNewPairRequest       struct{}
-	AddPoolRequest             struct{}
-	AddAssetToPairRequest      struct{}
-	AddAssetRatesParamsRequest struct{}
-	AddAuctionParamsRequest    struct{}
+	AddNewPairsRequest            struct{}
+	UpdateNewPairRequest          struct{}
+	AddPoolRequest                struct{}
+	AddAssetToPairRequest         struct{}
+	AddAssetRatesParamsRequest    struct{}
+	AddAuctionParamsRequest       struct{}
+	SetEModeCategoryRequest       struct{}
+	SetBadDebtWaterfallRequest    struct{}
+	AddFixedTermMarketRequest     struct{}
+	UpdateAssetRatesParamsRequest struct{}
+	UpdatePoolPairsRequest        struct{}
 )
 
 func AddNewPairsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
@@ -84,6 +89,27 @@ func AddNewAuctionParamsProposalRESTHandler(clientCtx client.Context) govrest.Pr
 	}
 }
 
+func SetEModeCategoryProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-e-mode-category",
+		Handler:  SetEModeCategoryRESTHandler(clientCtx),
+	}
+}
+
+func SetBadDebtWaterfallProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-bad-debt-waterfall",
+		Handler:  SetBadDebtWaterfallRESTHandler(clientCtx),
+	}
+}
+
+func AddFixedTermMarketProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add-fixed-term-market",
+		Handler:  AddFixedTermMarketRESTHandler(clientCtx),
+	}
+}
+
 func AddNewPairsRESTHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req AddNewPairsRequest
@@ -143,3 +169,67 @@ func AddAuctionParamsRESTHandler(clientCtx client.Context) http.HandlerFunc {
 		}
 	}
 }
+
+func SetEModeCategoryRESTHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetEModeCategoryRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+	}
+}
+
+func SetBadDebtWaterfallRESTHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetBadDebtWaterfallRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+	}
+}
+
+func AddFixedTermMarketRESTHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AddFixedTermMarketRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+	}
+}
+
+func UpdateAssetRatesParamsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update-asset-rates-params",
+		Handler:  UpdateAssetRatesParamsRESTHandler(clientCtx),
+	}
+}
+
+func UpdatePoolPairsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update-lend-pool-pairs",
+		Handler:  UpdatePoolPairsRESTHandler(clientCtx),
+	}
+}
+
+func UpdateAssetRatesParamsRESTHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateAssetRatesParamsRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+	}
+}
+
+func UpdatePoolPairsRESTHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdatePoolPairsRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+	}
+}
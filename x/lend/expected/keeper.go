@@ -43,6 +43,10 @@ type AssetKeeper interface {
 	GetApp(ctx sdk.Context, id uint64) (assettypes.AppData, bool)
 	SetApp(ctx sdk.Context, app assettypes.AppData)
 	SetAppID(ctx sdk.Context, id uint64)
+	IsAssetDelisting(ctx sdk.Context, assetID uint64) bool
+	AssetDelistingLtvFactor(ctx sdk.Context, assetID uint64) sdk.Dec
+	ScheduleChange(ctx sdk.Context, paramClass string, change assettypes.RiskParameter) (uint64, error)
+	ActivatePendingChanges(ctx sdk.Context, paramClass string, apply func(ctx sdk.Context, change assettypes.RiskParameter) error)
 }
 
 type EsmKeeper interface {
@@ -53,4 +57,6 @@ type LiquidationKeeper interface {
 	GetLockedVaultByApp(ctx sdk.Context, appID uint64) (lockedVault []types.LockedVault)
 }
 
-type AuctionKeeper interface{}
+type AuctionKeeper interface {
+	IsSealedBidApp(ctx sdk.Context, appID uint64) bool
+}
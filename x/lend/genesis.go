@@ -77,6 +77,51 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, state *types.GenesisState) {
 	for _, item := range state.AllReserveStats {
 		k.SetAllReserveStatsByAssetID(ctx, item)
 	}
+	for _, item := range state.PositionManagerGrants {
+		k.SetPositionManagerGrant(ctx, item)
+	}
+	var eModeCategoryID uint64
+	for _, item := range state.EModeCategories {
+		k.SetEModeCategoryRecord(ctx, item)
+		if item.ID > eModeCategoryID {
+			eModeCategoryID = item.ID
+		}
+	}
+	k.SetEModeCategoryID(ctx, eModeCategoryID)
+	for _, item := range state.AdaptiveRateStates {
+		k.SetAdaptiveRateState(ctx, item)
+	}
+	k.SetBadDebtWaterfall(ctx, state.BadDebtWaterfall)
+	for _, item := range state.CTokenExchangeRates {
+		k.SetCTokenExchangeRate(ctx, item)
+	}
+	for _, item := range state.CrossCollateralAccounts {
+		k.SetCrossCollateralAccount(ctx, item)
+	}
+	var fixedTermMarketID uint64
+	for _, item := range state.FixedTermMarkets {
+		k.SetFixedTermMarket(ctx, item)
+		if item.ID > fixedTermMarketID {
+			fixedTermMarketID = item.ID
+		}
+	}
+	k.SetFixedTermMarketID(ctx, fixedTermMarketID)
+	var fixedTermDepositID uint64
+	for _, item := range state.FixedTermDeposits {
+		k.SetFixedTermDeposit(ctx, item)
+		if item.ID > fixedTermDepositID {
+			fixedTermDepositID = item.ID
+		}
+	}
+	k.SetFixedTermDepositID(ctx, fixedTermDepositID)
+	var fixedTermLoanID uint64
+	for _, item := range state.FixedTermLoans {
+		k.SetFixedTermLoan(ctx, item)
+		if item.ID > fixedTermLoanID {
+			fixedTermLoanID = item.ID
+		}
+	}
+	k.SetFixedTermLoanID(ctx, fixedTermLoanID)
 }
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
@@ -96,5 +141,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		k.GetAllFundModBal(ctx),
 		k.GetAllFundReserveBal(ctx),
 		k.GetTotalReserveStatsByAssetID(ctx),
+		k.GetPositionManagerGrants(ctx),
+		k.GetEModeCategories(ctx),
+		k.GetAdaptiveRateStates(ctx),
+		k.GetBadDebtWaterfall(ctx),
+		k.GetCTokenExchangeRates(ctx),
+		k.GetCrossCollateralAccounts(ctx),
+		k.GetFixedTermMarkets(ctx),
+		k.GetFixedTermDeposits(ctx),
+		k.GetFixedTermLoans(ctx),
 	)
 }
@@ -73,6 +73,50 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := server.FundReserveAccounts(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgGrantPositionManager:
+			res, err := server.GrantPositionManager(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRevokePositionManager:
+			res, err := server.RevokePositionManager(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgDepositAccountCollateral:
+			res, err := server.DepositAccountCollateral(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgWithdrawAccountCollateral:
+			res, err := server.WithdrawAccountCollateral(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAccountBorrow:
+			res, err := server.AccountBorrow(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAccountRepay:
+			res, err := server.AccountRepay(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgLiquidateAccount:
+			res, err := server.LiquidateAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFixedTermCommit:
+			res, err := server.FixedTermCommit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFixedTermRedeem:
+			res, err := server.FixedTermRedeem(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFixedTermBorrow:
+			res, err := server.FixedTermBorrow(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFixedTermRepay:
+			res, err := server.FixedTermRepay(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -101,6 +145,16 @@ func NewLendHandler(k keeper.Keeper) govtypes.Handler {
 			return handleAddPoolPairsProposal(ctx, k, c)
 		case *types.AddAssetRatesPoolPairsProposal:
 			return handleAddAssetRatesPoolPairsProposal(ctx, k, c)
+		case *types.SetEModeCategoryProposal:
+			return handleSetEModeCategoryProposal(ctx, k, c)
+		case *types.SetBadDebtWaterfallProposal:
+			return handleSetBadDebtWaterfallProposal(ctx, k, c)
+		case *types.AddFixedTermMarketProposal:
+			return handleAddFixedTermMarketProposal(ctx, k, c)
+		case *types.UpdateAssetRatesParamsProposal:
+			return handleUpdateAssetRatesParamsProposal(ctx, k, c)
+		case *types.UpdatePoolPairsProposal:
+			return handleUpdatePoolPairsProposal(ctx, k, c)
 
 		default:
 			return errors.Wrapf(types.ErrorUnknownProposalType, "%T", c)
@@ -143,3 +197,23 @@ func handleAddPoolPairsProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddPo
 func handleAddAssetRatesPoolPairsProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddAssetRatesPoolPairsProposal) error {
 	return k.HandleAddAssetRatesPoolPairsRecords(ctx, p)
 }
+
+func handleSetEModeCategoryProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetEModeCategoryProposal) error {
+	return k.HandleSetEModeCategoryRecords(ctx, p)
+}
+
+func handleSetBadDebtWaterfallProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetBadDebtWaterfallProposal) error {
+	return k.HandleSetBadDebtWaterfallRecords(ctx, p)
+}
+
+func handleAddFixedTermMarketProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddFixedTermMarketProposal) error {
+	return k.HandleAddFixedTermMarketRecords(ctx, p)
+}
+
+func handleUpdateAssetRatesParamsProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateAssetRatesParamsProposal) error {
+	return k.HandleUpdateAssetRatesParamsRecords(ctx, p)
+}
+
+func handleUpdatePoolPairsProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdatePoolPairsProposal) error {
+	return k.HandleUpdatePoolPairsRecords(ctx, p)
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/lend/types"
+)
+
+func (k Keeper) SetBadDebtWaterfall(ctx sdk.Context, waterfall types.BadDebtWaterfall) {
+	var (
+		store = k.Store(ctx)
+		key   = types.BadDebtWaterfallKey
+		value = k.cdc.MustMarshal(&waterfall)
+	)
+	store.Set(key, value)
+}
+
+// GetBadDebtWaterfall returns the waterfall set by governance, or the
+// default waterfall if none was set.
+func (k Keeper) GetBadDebtWaterfall(ctx sdk.Context) (waterfall types.BadDebtWaterfall) {
+	var (
+		store = k.Store(ctx)
+		key   = types.BadDebtWaterfallKey
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return types.DefaultBadDebtWaterfall()
+	}
+
+	k.cdc.MustUnmarshal(value, &waterfall)
+	return waterfall
+}
+
+func (k Keeper) SetCTokenExchangeRate(ctx sdk.Context, rate types.CTokenExchangeRate) {
+	var (
+		store = k.Store(ctx)
+		key   = types.CTokenExchangeRateKey(rate.PoolID, rate.AssetID)
+		value = k.cdc.MustMarshal(&rate)
+	)
+	store.Set(key, value)
+}
+
+// GetCTokenExchangeRate returns the amount of the underlying asset a cToken
+// of the pool redeems for, which is one until bad debt is socialized.
+func (k Keeper) GetCTokenExchangeRate(ctx sdk.Context, poolID, assetID uint64) sdk.Dec {
+	var (
+		store = k.Store(ctx)
+		key   = types.CTokenExchangeRateKey(poolID, assetID)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return sdk.OneDec()
+	}
+
+	var rate types.CTokenExchangeRate
+	k.cdc.MustUnmarshal(value, &rate)
+	return rate.Rate
+}
+
+func (k Keeper) GetCTokenExchangeRates(ctx sdk.Context) (rates []types.CTokenExchangeRate) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.CTokenExchangeRateKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var rate types.CTokenExchangeRate
+		k.cdc.MustUnmarshal(iter.Value(), &rate)
+		rates = append(rates, rate)
+	}
+	return rates
+}
+
+// CTokenRedeemAmount returns the amount of the underlying asset paid out for
+// burning amount cTokens of the pool.
+func (k Keeper) CTokenRedeemAmount(ctx sdk.Context, poolID, assetID uint64, amount sdk.Int) sdk.Int {
+	return k.GetCTokenExchangeRate(ctx, poolID, assetID).MulInt(amount).TruncateInt()
+}
+
+// CTokenMintAmount returns the cTokens of the pool minted for depositing
+// amount of the underlying asset. Nothing can be deposited once the lenders
+// of the pool lost all of it to bad debt.
+func (k Keeper) CTokenMintAmount(ctx sdk.Context, poolID, assetID uint64, amount sdk.Int) (sdk.Int, error) {
+	rate := k.GetCTokenExchangeRate(ctx, poolID, assetID)
+	if !rate.IsPositive() {
+		return sdk.ZeroInt(), types.ErrorCTokenWrittenOff
+	}
+	return amount.ToDec().Quo(rate).TruncateInt(), nil
+}
+
+// CTokenBurnAmount returns the cTokens of the pool worth amount of the
+// underlying asset, rounded up so the pool never pays out more than the
+// cTokens burned are worth.
+func (k Keeper) CTokenBurnAmount(ctx sdk.Context, poolID, assetID uint64, amount sdk.Int) sdk.Int {
+	rate := k.GetCTokenExchangeRate(ctx, poolID, assetID)
+	if !rate.IsPositive() {
+		return amount
+	}
+	return amount.ToDec().Quo(rate).Ceil().TruncateInt()
+}
+
+// CTokenCollateral returns the amount of the underlying asset that amount
+// cTokens of a lend position are worth as collateral.
+func (k Keeper) CTokenCollateral(ctx sdk.Context, lendID uint64, amount sdk.Int) sdk.Int {
+	lendPos, found := k.GetLend(ctx, lendID)
+	if !found {
+		return amount
+	}
+	return k.CTokenRedeemAmount(ctx, lendPos.PoolID, lendPos.AssetID, amount)
+}
+
+// SocializeBadDebt spreads amount of bad debt across the lenders of the asset
+// in the pool by lowering the cToken exchange rate. It returns the amount
+// socialized, which is capped by what the lenders of the pool can redeem.
+func (k Keeper) SocializeBadDebt(ctx sdk.Context, poolID, assetID uint64, amount sdk.Int) sdk.Int {
+	assetStats, found := k.GetAssetStatsByPoolIDAndAssetID(ctx, poolID, assetID)
+	if !found || !assetStats.TotalLend.IsPositive() || !amount.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	rate := k.GetCTokenExchangeRate(ctx, poolID, assetID)
+	claims := rate.MulInt(assetStats.TotalLend).TruncateInt()
+	if !claims.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	socialized := sdk.MinInt(amount, claims)
+
+	rate = rate.MulInt(claims.Sub(socialized)).QuoInt(claims)
+	k.SetCTokenExchangeRate(ctx, types.CTokenExchangeRate{
+		PoolID:  poolID,
+		AssetID: assetID,
+		Rate:    rate,
+	})
+	return socialized
+}
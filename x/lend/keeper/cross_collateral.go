@@ -0,0 +1,697 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
+	esmtypes "github.com/comdex-official/comdex/x/esm/types"
+	"github.com/comdex-official/comdex/x/lend/types"
+)
+
+// AccountCloseFactor is the share of a debt of an unhealthy cross-collateral
+// account a liquidator can repay at once.
+var AccountCloseFactor = sdk.NewDecWithPrec(5, 1)
+
+func (k Keeper) SetCrossCollateralAccount(ctx sdk.Context, account types.CrossCollateralAccount) {
+	owner, _ := sdk.AccAddressFromBech32(account.Owner)
+	var (
+		store = k.Store(ctx)
+		key   = types.CrossCollateralAccountKey(owner, account.PoolID)
+		value = k.cdc.MustMarshal(&account)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) DeleteCrossCollateralAccount(ctx sdk.Context, owner sdk.AccAddress, poolID uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.CrossCollateralAccountKey(owner, poolID)
+	)
+	store.Delete(key)
+}
+
+func (k Keeper) GetCrossCollateralAccount(ctx sdk.Context, owner sdk.AccAddress, poolID uint64) (account types.CrossCollateralAccount, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.CrossCollateralAccountKey(owner, poolID)
+		value = store.Get(key)
+	)
+	if value == nil {
+		return account, false
+	}
+	k.cdc.MustUnmarshal(value, &account)
+	return account, true
+}
+
+func (k Keeper) GetCrossCollateralAccounts(ctx sdk.Context) (accounts []types.CrossCollateralAccount) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.CrossCollateralAccountKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var account types.CrossCollateralAccount
+		k.cdc.MustUnmarshal(iter.Value(), &account)
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
+// IsLendAccountCollateral reports whether cTokens of the lend position are
+// locked in the cross-collateral account of its owner.
+func (k Keeper) IsLendAccountCollateral(ctx sdk.Context, lendPos types.LendAsset) bool {
+	owner, err := sdk.AccAddressFromBech32(lendPos.Owner)
+	if err != nil {
+		return false
+	}
+	account, found := k.GetCrossCollateralAccount(ctx, owner, lendPos.PoolID)
+	if !found {
+		return false
+	}
+	for _, collateral := range account.Collateral {
+		if collateral.LendID == lendPos.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// DepositAccountCollateral moves cTokens of a lend position into the
+// cross-collateral account of its owner. Like the collateral of a borrow they
+// are held by the pool and no longer available to borrow from the position.
+func (k Keeper) DepositAccountCollateral(ctx sdk.Context, addr string, lendID uint64, amount sdk.Coin) error {
+	owner, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return err
+	}
+
+	lendPos, found := k.GetLend(ctx, lendID)
+	if !found {
+		return types.ErrLendNotFound
+	}
+
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, lendPos.AppID)
+	if killSwitchParams.BreakerEnable {
+		return esmtypes.ErrCircuitBreakerEnabled
+	}
+
+	if lendPos.Owner != addr {
+		return types.ErrLendAccessUnauthorized
+	}
+	if err = k.checkNotDelisting(ctx, lendPos.AssetID); err != nil {
+		return err
+	}
+
+	assetRatesStat, found := k.GetAssetRatesParams(ctx, lendPos.AssetID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrorAssetRatesParamsNotFound, strconv.FormatUint(lendPos.AssetID, 10))
+	}
+	cAsset, found := k.Asset.GetAsset(ctx, assetRatesStat.CAssetID)
+	if !found {
+		return assettypes.ErrorAssetDoesNotExist
+	}
+	if amount.Denom != cAsset.Denom {
+		return types.ErrBadOfferCoinType
+	}
+	if amount.Amount.GT(lendPos.AvailableToBorrow) {
+		return types.ErrAvailableToBorrowInsufficient
+	}
+
+	pool, found := k.GetPool(ctx, lendPos.PoolID)
+	if !found {
+		return types.ErrPoolNotFound
+	}
+
+	account, found := k.GetCrossCollateralAccount(ctx, owner, lendPos.PoolID)
+	if !found {
+		account = types.CrossCollateralAccount{
+			Owner:  addr,
+			PoolID: lendPos.PoolID,
+			AppID:  lendPos.AppID,
+		}
+	}
+	if account.AppID != lendPos.AppID {
+		return types.ErrorAppMappingIDMismatch
+	}
+
+	if err = k.bank.SendCoinsFromAccountToModule(ctx, owner, pool.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	lendPos.AvailableToBorrow = lendPos.AvailableToBorrow.Sub(amount.Amount)
+	k.SetLend(ctx, lendPos)
+
+	deposited := false
+	for i, collateral := range account.Collateral {
+		if collateral.LendID == lendID {
+			account.Collateral[i].Amount = collateral.Amount.Add(amount)
+			deposited = true
+			break
+		}
+	}
+	if !deposited {
+		account.Collateral = append(account.Collateral, types.AccountCollateral{
+			LendID:  lendID,
+			AssetID: lendPos.AssetID,
+			Amount:  amount,
+		})
+	}
+	k.SetCrossCollateralAccount(ctx, account)
+	return nil
+}
+
+// WithdrawAccountCollateral returns cTokens locked in the cross-collateral
+// account to their lend position as long as the debts of the account stay
+// within its borrow limit.
+func (k Keeper) WithdrawAccountCollateral(ctx sdk.Context, addr string, lendID uint64, amount sdk.Coin) error {
+	owner, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return err
+	}
+
+	lendPos, found := k.GetLend(ctx, lendID)
+	if !found {
+		return types.ErrLendNotFound
+	}
+
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, lendPos.AppID)
+	if killSwitchParams.BreakerEnable {
+		return esmtypes.ErrCircuitBreakerEnabled
+	}
+
+	if lendPos.Owner != addr {
+		return types.ErrLendAccessUnauthorized
+	}
+
+	account, found := k.GetCrossCollateralAccount(ctx, owner, lendPos.PoolID)
+	if !found {
+		return types.ErrorAccountNotFound
+	}
+	index := -1
+	for i, collateral := range account.Collateral {
+		if collateral.LendID == lendID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return types.ErrorAccountCollateralNotFound
+	}
+	collateral := account.Collateral[index]
+	if amount.Denom != collateral.Amount.Denom {
+		return types.ErrBadOfferCoinType
+	}
+	if amount.Amount.GT(collateral.Amount.Amount) {
+		return types.ErrWithdrawAmountLimitExceeds
+	}
+
+	pool, found := k.GetPool(ctx, lendPos.PoolID)
+	if !found {
+		return types.ErrPoolNotFound
+	}
+
+	if err = k.accrueAccountInterest(ctx, &account); err != nil {
+		return err
+	}
+	collateral.Amount = collateral.Amount.Sub(amount)
+	if collateral.Amount.IsZero() {
+		account.Collateral = append(account.Collateral[:index], account.Collateral[index+1:]...)
+	} else {
+		account.Collateral[index] = collateral
+	}
+
+	health, err := k.CalculateAccountHealth(ctx, account)
+	if err != nil {
+		return err
+	}
+	if health.DebtValue.GT(health.BorrowLimit) {
+		return types.ErrorAccountUnhealthy
+	}
+
+	if err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, owner, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	lendPos.AvailableToBorrow = lendPos.AvailableToBorrow.Add(amount.Amount)
+	k.SetLend(ctx, lendPos)
+	k.setOrDeleteCrossCollateralAccount(ctx, owner, account)
+	return nil
+}
+
+// AccountBorrow lends an asset of the pool to the owner of the
+// cross-collateral account against all of its collateral.
+func (k Keeper) AccountBorrow(ctx sdk.Context, addr string, poolID, assetID uint64, loan sdk.Coin) error {
+	owner, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return err
+	}
+
+	account, found := k.GetCrossCollateralAccount(ctx, owner, poolID)
+	if !found {
+		return types.ErrorAccountNotFound
+	}
+
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, account.AppID)
+	if killSwitchParams.BreakerEnable {
+		return esmtypes.ErrCircuitBreakerEnabled
+	}
+
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return types.ErrPoolNotFound
+	}
+	inPool := false
+	for _, assetData := range pool.AssetData {
+		if assetData.AssetID == assetID {
+			inPool = true
+			break
+		}
+	}
+	if !inPool {
+		return types.ErrInvalidAsset
+	}
+	if err = k.checkNotDelisting(ctx, assetID); err != nil {
+		return err
+	}
+	asset, found := k.Asset.GetAsset(ctx, assetID)
+	if !found {
+		return assettypes.ErrorAssetDoesNotExist
+	}
+	if loan.Denom != asset.Denom {
+		return types.ErrInvalidAsset
+	}
+
+	minUSDVal, _ := sdk.NewDecFromStr(types.DollarOneValue)
+	loanValue, err := k.Market.CalcAssetPrice(ctx, assetID, loan.Amount)
+	if loanValue.LT(minUSDVal) || err != nil {
+		return types.ErrBorrowLessThanMinAmount
+	}
+
+	availableAmount := k.variableLiquidity(ctx, pool, assetID, loan.Denom)
+	if loan.Amount.GT(availableAmount) {
+		return sdkerrors.Wrap(types.ErrBorrowingPoolInsufficient, loan.String())
+	}
+
+	if err = k.accrueAccountInterest(ctx, &account); err != nil {
+		return err
+	}
+	borrowed := false
+	for i, debt := range account.Debts {
+		if debt.AssetID == assetID {
+			account.Debts[i].AmountOut = debt.AmountOut.Add(loan)
+			borrowed = true
+			break
+		}
+	}
+	if !borrowed {
+		account.Debts = append(account.Debts, types.AccountDebt{
+			AssetID:             assetID,
+			AmountOut:           loan,
+			InterestAccumulated: sdk.ZeroDec(),
+			ReserveInterest:     sdk.ZeroDec(),
+			LastInteractionTime: ctx.BlockTime(),
+		})
+	}
+
+	health, err := k.CalculateAccountHealth(ctx, account)
+	if err != nil {
+		return err
+	}
+	if health.DebtValue.GT(health.BorrowLimit) {
+		return types.ErrorAccountUnhealthy
+	}
+
+	if err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, owner, sdk.NewCoins(loan)); err != nil {
+		return err
+	}
+	k.UpdateBorrowStats(ctx, accountDebtPair(poolID, assetID), false, loan.Amount, true)
+	k.SetCrossCollateralAccount(ctx, account)
+	return nil
+}
+
+// AccountRepay repays a debt of the cross-collateral account of the owner.
+// The payment covers the interest owed to the reserve first, then the
+// interest owed to the lenders and finally the principal.
+func (k Keeper) AccountRepay(ctx sdk.Context, addr string, poolID uint64, payment sdk.Coin) (sdk.Coin, error) {
+	owner, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	account, found := k.GetCrossCollateralAccount(ctx, owner, poolID)
+	if !found {
+		return sdk.Coin{}, types.ErrorAccountNotFound
+	}
+
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, account.AppID)
+	if killSwitchParams.BreakerEnable {
+		return sdk.Coin{}, esmtypes.ErrCircuitBreakerEnabled
+	}
+
+	if err = k.accrueAccountInterest(ctx, &account); err != nil {
+		return sdk.Coin{}, err
+	}
+	repaid, err := k.repayAccountDebt(ctx, owner, &account, payment)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	k.setOrDeleteCrossCollateralAccount(ctx, owner, account)
+	return repaid, nil
+}
+
+// LiquidateAccount repays up to the close factor of a debt of an account
+// whose health factor fell below one. The liquidator is paid the value
+// repaid plus the liquidation bonus out of the collateral of the account,
+// starting with the collateral of the highest value.
+func (k Keeper) LiquidateAccount(ctx sdk.Context, liquidatorAddr, ownerAddr string, poolID uint64, payment sdk.Coin) (sdk.Coin, error) {
+	liquidator, err := sdk.AccAddressFromBech32(liquidatorAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	owner, err := sdk.AccAddressFromBech32(ownerAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	account, found := k.GetCrossCollateralAccount(ctx, owner, poolID)
+	if !found {
+		return sdk.Coin{}, types.ErrorAccountNotFound
+	}
+
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, account.AppID)
+	if killSwitchParams.BreakerEnable {
+		return sdk.Coin{}, esmtypes.ErrCircuitBreakerEnabled
+	}
+
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return sdk.Coin{}, types.ErrPoolNotFound
+	}
+
+	if err = k.accrueAccountInterest(ctx, &account); err != nil {
+		return sdk.Coin{}, err
+	}
+	health, err := k.CalculateAccountHealth(ctx, account)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !health.DebtValue.IsPositive() || health.HealthFactor.GTE(sdk.OneDec()) {
+		return sdk.Coin{}, types.ErrorAccountHealthy
+	}
+
+	debt, found := accountDebtByDenom(account, payment.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrorAccountDebtNotFound
+	}
+	maxRepay := AccountCloseFactor.MulInt(debt.AmountOut.Amount.Add(debt.InterestAccumulated.Ceil().TruncateInt())).TruncateInt()
+	if payment.Amount.GT(maxRepay) {
+		payment.Amount = maxRepay
+	}
+
+	repaid, err := k.repayAccountDebt(ctx, liquidator, &account, payment)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	repaidValue, err := k.Market.CalcAssetPrice(ctx, debt.AssetID, repaid.Amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	type collateralValue struct {
+		index int
+		value sdk.Dec
+	}
+	values := make([]collateralValue, 0, len(account.Collateral))
+	for i, collateral := range account.Collateral {
+		value, err := k.accountCollateralValue(ctx, poolID, collateral)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		values = append(values, collateralValue{index: i, value: value})
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].value.GT(values[j].value)
+	})
+
+	remaining := repaidValue
+	for _, v := range values {
+		if !remaining.IsPositive() {
+			break
+		}
+		if !v.value.IsPositive() {
+			continue
+		}
+		collateral := account.Collateral[v.index]
+		assetRatesStat, found := k.GetAssetRatesParams(ctx, collateral.AssetID)
+		if !found {
+			return sdk.Coin{}, sdkerrors.Wrap(types.ErrorAssetRatesParamsNotFound, strconv.FormatUint(collateral.AssetID, 10))
+		}
+
+		// the value still owed to the liquidator, bonus included, capped by
+		// the value of this collateral
+		seizeValue := sdk.MinDec(remaining.Mul(sdk.OneDec().Add(assetRatesStat.LiquidationBonus)), v.value)
+		seized := collateral.Amount.Amount.ToDec().Mul(seizeValue).Quo(v.value).TruncateInt()
+		if !seized.IsPositive() {
+			continue
+		}
+		if err = k.seizeAccountCollateral(ctx, pool, liquidator, collateral, seized); err != nil {
+			return sdk.Coin{}, err
+		}
+		account.Collateral[v.index].Amount.Amount = collateral.Amount.Amount.Sub(seized)
+		remaining = remaining.Sub(seizeValue.Quo(sdk.OneDec().Add(assetRatesStat.LiquidationBonus)))
+	}
+
+	collateral := account.Collateral[:0]
+	for _, c := range account.Collateral {
+		if c.Amount.IsPositive() {
+			collateral = append(collateral, c)
+		}
+	}
+	account.Collateral = collateral
+	k.setOrDeleteCrossCollateralAccount(ctx, owner, account)
+	return repaid, nil
+}
+
+// CalculateAccountHealth values the collateral and debts of the account.
+// Collateral is valued at what its cTokens redeem for.
+func (k Keeper) CalculateAccountHealth(ctx sdk.Context, account types.CrossCollateralAccount) (types.AccountHealth, error) {
+	health := types.AccountHealth{
+		CollateralValue:  sdk.ZeroDec(),
+		BorrowLimit:      sdk.ZeroDec(),
+		LiquidationLimit: sdk.ZeroDec(),
+		DebtValue:        sdk.ZeroDec(),
+		HealthFactor:     sdk.ZeroDec(),
+	}
+	for _, collateral := range account.Collateral {
+		assetRatesStat, found := k.GetAssetRatesParams(ctx, collateral.AssetID)
+		if !found {
+			return health, sdkerrors.Wrap(types.ErrorAssetRatesParamsNotFound, strconv.FormatUint(collateral.AssetID, 10))
+		}
+		value, err := k.accountCollateralValue(ctx, account.PoolID, collateral)
+		if err != nil {
+			return health, err
+		}
+		health.CollateralValue = health.CollateralValue.Add(value)
+		factor := k.delistingLtvFactor(ctx, collateral.AssetID)
+		health.BorrowLimit = health.BorrowLimit.Add(value.Mul(assetRatesStat.Ltv).Mul(factor))
+		health.LiquidationLimit = health.LiquidationLimit.Add(value.Mul(assetRatesStat.LiquidationThreshold).Mul(factor))
+	}
+	debtFactor := sdk.OneDec()
+	for _, debt := range account.Debts {
+		debtFactor = sdk.MinDec(debtFactor, k.delistingLtvFactor(ctx, debt.AssetID))
+		value, err := k.Market.CalcAssetPrice(ctx, debt.AssetID, debt.AmountOut.Amount.Add(debt.InterestAccumulated.Ceil().TruncateInt()))
+		if err != nil {
+			return health, err
+		}
+		health.DebtValue = health.DebtValue.Add(value)
+	}
+	health.BorrowLimit = health.BorrowLimit.Mul(debtFactor)
+	health.LiquidationLimit = health.LiquidationLimit.Mul(debtFactor)
+	if health.DebtValue.IsPositive() {
+		health.HealthFactor = health.LiquidationLimit.Quo(health.DebtValue)
+	}
+	return health, nil
+}
+
+func (k Keeper) accountCollateralValue(ctx sdk.Context, poolID uint64, collateral types.AccountCollateral) (sdk.Dec, error) {
+	return k.Market.CalcAssetPrice(ctx, collateral.AssetID, k.CTokenRedeemAmount(ctx, poolID, collateral.AssetID, collateral.Amount.Amount))
+}
+
+// accrueAccountInterest adds the interest owed on every debt of the account
+// since its last interaction at the current variable borrow rate.
+func (k Keeper) accrueAccountInterest(ctx sdk.Context, account *types.CrossCollateralAccount) error {
+	currentTime := ctx.BlockTime()
+	for i, debt := range account.Debts {
+		secondsElapsed := currentTime.Unix() - debt.LastInteractionTime.Unix()
+		if secondsElapsed <= 0 {
+			continue
+		}
+		borrowAPR, err := k.GetBorrowAPRByAssetID(ctx, account.PoolID, debt.AssetID, false)
+		if err != nil {
+			return err
+		}
+		assetRatesStat, found := k.GetAssetRatesParams(ctx, debt.AssetID)
+		if !found {
+			return sdkerrors.Wrap(types.ErrorAssetRatesParamsNotFound, strconv.FormatUint(debt.AssetID, 10))
+		}
+		yearsElapsed := sdk.NewDec(secondsElapsed).QuoInt64(types.SecondsPerYear)
+		interest := debt.AmountOut.Amount.ToDec().Mul(borrowAPR).Mul(yearsElapsed)
+		account.Debts[i].InterestAccumulated = debt.InterestAccumulated.Add(interest)
+		account.Debts[i].ReserveInterest = debt.ReserveInterest.Add(interest.Mul(assetRatesStat.ReserveFactor))
+		account.Debts[i].LastInteractionTime = currentTime
+	}
+	return nil
+}
+
+// repayAccountDebt takes payment from payer and applies it to the debt of the
+// account in its denom, splitting it between the reserve, the lenders and the
+// principal the same way RepayAsset does. It returns the amount repaid, which
+// is capped by what the debt owes.
+func (k Keeper) repayAccountDebt(ctx sdk.Context, payer sdk.AccAddress, account *types.CrossCollateralAccount, payment sdk.Coin) (sdk.Coin, error) {
+	index := -1
+	for i, debt := range account.Debts {
+		if debt.AmountOut.Denom == payment.Denom {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return sdk.Coin{}, types.ErrorAccountDebtNotFound
+	}
+	debt := account.Debts[index]
+
+	pool, found := k.GetPool(ctx, account.PoolID)
+	if !found {
+		return sdk.Coin{}, types.ErrPoolNotFound
+	}
+	assetRatesStat, found := k.GetAssetRatesParams(ctx, debt.AssetID)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrorAssetRatesParamsNotFound, strconv.FormatUint(debt.AssetID, 10))
+	}
+	cAsset, found := k.Asset.GetAsset(ctx, assetRatesStat.CAssetID)
+	if !found {
+		return sdk.Coin{}, assettypes.ErrorAssetDoesNotExist
+	}
+
+	interestDue := debt.InterestAccumulated.Ceil().TruncateInt()
+	reserveDue := sdk.MinInt(debt.ReserveInterest.TruncateInt(), interestDue)
+	payment.Amount = sdk.MinInt(payment.Amount, debt.AmountOut.Amount.Add(interestDue))
+	if !payment.IsPositive() {
+		return sdk.Coin{}, types.ErrInvalidRepayment
+	}
+
+	if err := k.bank.SendCoinsFromAccountToModule(ctx, payer, pool.ModuleName, sdk.NewCoins(payment)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	toReserve := sdk.MinInt(payment.Amount, reserveDue)
+	if toReserve.IsPositive() {
+		err := k.UpdateReserveBalances(ctx, debt.AssetID, pool.ModuleName, sdk.NewCoin(payment.Denom, toReserve), true)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		k.UpdateReserverAmtFromRepayments(ctx, debt.AssetID, toReserve)
+	}
+
+	// interest paid to the lenders is minted as cTokens for the pool
+	toLenders := sdk.MinInt(payment.Amount.Sub(toReserve), interestDue.Sub(reserveDue))
+	if toLenders.IsPositive() {
+		err := k.bank.MintCoins(ctx, pool.ModuleName, sdk.NewCoins(sdk.NewCoin(cAsset.Denom, toLenders)))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		assetStats, _ := k.GetAssetStatsByPoolIDAndAssetID(ctx, account.PoolID, debt.AssetID)
+		assetStats.TotalInterestAccumulated = assetStats.TotalInterestAccumulated.Add(toLenders)
+		k.SetAssetStatsByPoolIDAndAssetID(ctx, assetStats)
+	}
+
+	interestPaid := toReserve.Add(toLenders)
+	if interestPaid.Equal(interestDue) {
+		debt.InterestAccumulated = sdk.ZeroDec()
+		debt.ReserveInterest = sdk.ZeroDec()
+	} else {
+		debt.InterestAccumulated = debt.InterestAccumulated.Sub(interestPaid.ToDec())
+		debt.ReserveInterest = debt.ReserveInterest.Sub(toReserve.ToDec())
+	}
+
+	principal := payment.Amount.Sub(interestPaid)
+	if principal.IsPositive() {
+		debt.AmountOut.Amount = debt.AmountOut.Amount.Sub(principal)
+		k.UpdateBorrowStats(ctx, accountDebtPair(account.PoolID, debt.AssetID), false, principal, false)
+	}
+
+	if debt.AmountOut.IsZero() && debt.InterestAccumulated.IsZero() {
+		account.Debts = append(account.Debts[:index], account.Debts[index+1:]...)
+	} else {
+		account.Debts[index] = debt
+	}
+	return payment, nil
+}
+
+// seizeAccountCollateral burns seized cTokens of the collateral held by the
+// pool and pays the liquidator what they redeem for, reducing the lend
+// position they came from.
+func (k Keeper) seizeAccountCollateral(ctx sdk.Context, pool types.Pool, liquidator sdk.AccAddress, collateral types.AccountCollateral, seized sdk.Int) error {
+	lendPos, found := k.GetLend(ctx, collateral.LendID)
+	if !found {
+		return types.ErrLendNotFound
+	}
+
+	redeemed := sdk.NewCoin(lendPos.AmountIn.Denom, k.CTokenRedeemAmount(ctx, pool.PoolID, collateral.AssetID, seized))
+	availableAmount := k.ModuleBalance(ctx, pool.ModuleName, redeemed.Denom)
+	if redeemed.Amount.GT(availableAmount) {
+		return sdkerrors.Wrap(types.ErrLendingPoolInsufficient, redeemed.String())
+	}
+
+	err := k.bank.BurnCoins(ctx, pool.ModuleName, sdk.NewCoins(sdk.NewCoin(collateral.Amount.Denom, seized)))
+	if err != nil {
+		return err
+	}
+	if err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, liquidator, sdk.NewCoins(redeemed)); err != nil {
+		return err
+	}
+
+	k.UpdateLendStats(ctx, lendPos.AssetID, lendPos.PoolID, seized, false)
+	lendPos.AmountIn.Amount = lendPos.AmountIn.Amount.Sub(sdk.MinInt(seized, lendPos.AmountIn.Amount))
+	k.SetLend(ctx, lendPos)
+	return nil
+}
+
+// setOrDeleteCrossCollateralAccount removes accounts left without collateral
+// and debts from the store.
+func (k Keeper) setOrDeleteCrossCollateralAccount(ctx sdk.Context, owner sdk.AccAddress, account types.CrossCollateralAccount) {
+	if len(account.Collateral) == 0 && len(account.Debts) == 0 {
+		k.DeleteCrossCollateralAccount(ctx, owner, account.PoolID)
+		return
+	}
+	k.SetCrossCollateralAccount(ctx, account)
+}
+
+func accountDebtByDenom(account types.CrossCollateralAccount, denom string) (types.AccountDebt, bool) {
+	for _, debt := range account.Debts {
+		if debt.AmountOut.Denom == denom {
+			return debt, true
+		}
+	}
+	return types.AccountDebt{}, false
+}
+
+// accountDebtPair is the pair UpdateBorrowStats books an account debt under,
+// account debts are variable rate borrows of the asset in the pool.
+func accountDebtPair(poolID, assetID uint64) types.Extended_Pair {
+	return types.Extended_Pair{
+		AssetOut:       assetID,
+		AssetOutPoolID: poolID,
+	}
+}
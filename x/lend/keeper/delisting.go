@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/comdex-official/comdex/x/lend/types"
+)
+
+// checkNotDelisting refuses new lends and borrows of assets being delisted.
+func (k Keeper) checkNotDelisting(ctx sdk.Context, assetIDs ...uint64) error {
+	for _, assetID := range assetIDs {
+		if k.Asset.IsAssetDelisting(ctx, assetID) {
+			return sdkerrors.Wrapf(types.ErrorAssetDelisted, "asset %d", assetID)
+		}
+	}
+	return nil
+}
+
+// delistingLtvFactor is the share of the ltv and liquidation threshold kept
+// by positions in the assets, the lowest of their delisting ltv factors.
+func (k Keeper) delistingLtvFactor(ctx sdk.Context, assetIDs ...uint64) sdk.Dec {
+	factor := sdk.OneDec()
+	for _, assetID := range assetIDs {
+		factor = sdk.MinDec(factor, k.Asset.AssetDelistingLtvFactor(ctx, assetID))
+	}
+	return factor
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	protobuftypes "github.com/gogo/protobuf/types"
+
+	"github.com/comdex-official/comdex/x/lend/types"
+)
+
+func (k Keeper) SetEModeCategoryID(ctx sdk.Context, id uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.EModeCategoryIDKey
+		value = k.cdc.MustMarshal(
+			&protobuftypes.UInt64Value{
+				Value: id,
+			},
+		)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetEModeCategoryID(ctx sdk.Context) uint64 {
+	var (
+		store = k.Store(ctx)
+		key   = types.EModeCategoryIDKey
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return 0
+	}
+
+	var id protobuftypes.UInt64Value
+	k.cdc.MustUnmarshal(value, &id)
+
+	return id.GetValue()
+}
+
+func (k Keeper) SetEModeCategoryRecord(ctx sdk.Context, category types.EModeCategory) {
+	var (
+		store = k.Store(ctx)
+		key   = types.EModeCategoryKey(category.ID)
+		value = k.cdc.MustMarshal(&category)
+	)
+	store.Set(key, value)
+	for _, assetID := range category.AssetIDs {
+		k.setAssetEModeCategory(ctx, assetID, category.ID)
+	}
+}
+
+func (k Keeper) GetEModeCategory(ctx sdk.Context, id uint64) (category types.EModeCategory, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.EModeCategoryKey(id)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return category, false
+	}
+
+	k.cdc.MustUnmarshal(value, &category)
+	return category, true
+}
+
+func (k Keeper) GetEModeCategories(ctx sdk.Context) (categories []types.EModeCategory) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.EModeCategoryKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var category types.EModeCategory
+		k.cdc.MustUnmarshal(iter.Value(), &category)
+		categories = append(categories, category)
+	}
+	return categories
+}
+
+func (k Keeper) setAssetEModeCategory(ctx sdk.Context, assetID, categoryID uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.AssetEModeCategoryKey(assetID)
+		value = k.cdc.MustMarshal(
+			&protobuftypes.UInt64Value{
+				Value: categoryID,
+			},
+		)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) deleteAssetEModeCategory(ctx sdk.Context, assetID uint64) {
+	store := k.Store(ctx)
+	store.Delete(types.AssetEModeCategoryKey(assetID))
+}
+
+// GetAssetEModeCategory returns the e-mode category the asset belongs to.
+func (k Keeper) GetAssetEModeCategory(ctx sdk.Context, assetID uint64) (category types.EModeCategory, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.AssetEModeCategoryKey(assetID)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return category, false
+	}
+
+	var id protobuftypes.UInt64Value
+	k.cdc.MustUnmarshal(value, &id)
+
+	return k.GetEModeCategory(ctx, id.GetValue())
+}
+
+// SetEModeCategory adds a new e-mode category, or replaces the category with
+// the same id. An asset can only be part of one category, and the category
+// ltv and liquidation threshold cannot be lower than those of its members.
+func (k Keeper) SetEModeCategory(ctx sdk.Context, category types.EModeCategory) error {
+	if err := category.Validate(); err != nil {
+		return err
+	}
+
+	old, found := k.GetEModeCategory(ctx, category.ID)
+	if category.ID != 0 && !found {
+		return types.ErrorEModeCategoryNotFound
+	}
+
+	for _, assetID := range category.AssetIDs {
+		assetRatesParams, found := k.GetAssetRatesParams(ctx, assetID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrorAssetRatesParamsNotFound, "asset %d", assetID)
+		}
+		if other, found := k.GetAssetEModeCategory(ctx, assetID); found && other.ID != category.ID {
+			return sdkerrors.Wrapf(types.ErrorInvalidEModeCategory, "asset %d is already in category %d", assetID, other.ID)
+		}
+		if category.Ltv.LT(assetRatesParams.Ltv) || category.LiquidationThreshold.LT(assetRatesParams.LiquidationThreshold) {
+			return sdkerrors.Wrapf(types.ErrorInvalidEModeCategory, "category rates are lower than those of asset %d", assetID)
+		}
+	}
+
+	if category.ID == 0 {
+		category.ID = k.GetEModeCategoryID(ctx) + 1
+		k.SetEModeCategoryID(ctx, category.ID)
+	}
+	for _, assetID := range old.AssetIDs {
+		k.deleteAssetEModeCategory(ctx, assetID)
+	}
+
+	k.SetEModeCategoryRecord(ctx, category)
+	return nil
+}
+
+// GetEModeCategoryForPair returns the e-mode category shared by the
+// collateral and the borrowed asset of an intra-pool pair. Inter-pool
+// borrows are bridged through the transit assets, so e-mode never applies
+// to them.
+func (k Keeper) GetEModeCategoryForPair(ctx sdk.Context, pair types.Extended_Pair) (category types.EModeCategory, found bool) {
+	if pair.IsInterPool {
+		return category, false
+	}
+	category, found = k.GetAssetEModeCategory(ctx, pair.AssetIn)
+	if !found || !category.HasAsset(pair.AssetOut) {
+		return types.EModeCategory{}, false
+	}
+	return category, true
+}
+
+// GetAssetRatesParamsForPair returns the rates params of the collateral
+// asset of pair, with the ltv and liquidation threshold of its e-mode
+// category when the borrowed asset is in the same category. The category
+// never lowers the asset's own rates, which may have been raised after the
+// category was set. Both are scaled down while either asset of the pair is
+// being delisted.
+func (k Keeper) GetAssetRatesParamsForPair(ctx sdk.Context, pair types.Extended_Pair) (assetRatesParams types.AssetRatesParams, found bool) {
+	assetRatesParams, found = k.GetAssetRatesParams(ctx, pair.AssetIn)
+	if !found {
+		return assetRatesParams, false
+	}
+	if category, ok := k.GetEModeCategoryForPair(ctx, pair); ok {
+		assetRatesParams.Ltv = sdk.MaxDec(assetRatesParams.Ltv, category.Ltv)
+		assetRatesParams.LiquidationThreshold = sdk.MaxDec(assetRatesParams.LiquidationThreshold, category.LiquidationThreshold)
+	}
+	factor := k.delistingLtvFactor(ctx, pair.AssetIn, pair.AssetOut)
+	assetRatesParams.Ltv = assetRatesParams.Ltv.Mul(factor)
+	assetRatesParams.LiquidationThreshold = assetRatesParams.LiquidationThreshold.Mul(factor)
+	return assetRatesParams, true
+}
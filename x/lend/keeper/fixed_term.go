@@ -0,0 +1,1001 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	protobuftypes "github.com/gogo/protobuf/types"
+
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
+	esmtypes "github.com/comdex-official/comdex/x/esm/types"
+	"github.com/comdex-official/comdex/x/lend/types"
+)
+
+func (k Keeper) SetFixedTermMarketID(ctx sdk.Context, id uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermMarketIDKey
+		value = k.cdc.MustMarshal(
+			&protobuftypes.UInt64Value{
+				Value: id,
+			},
+		)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetFixedTermMarketID(ctx sdk.Context) uint64 {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermMarketIDKey
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return 0
+	}
+
+	var id protobuftypes.UInt64Value
+	k.cdc.MustUnmarshal(value, &id)
+
+	return id.GetValue()
+}
+
+// SetFixedTermMarket stores the market and books the change of its available
+// liquidity to the reserved total of its pool and asset.
+func (k Keeper) SetFixedTermMarket(ctx sdk.Context, market types.FixedTermMarket) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermMarketKey(market.ID)
+		value = k.cdc.MustMarshal(&market)
+	)
+	delta := market.Available
+	if prev, found := k.GetFixedTermMarket(ctx, market.ID); found {
+		delta = delta.Sub(prev.Available)
+	}
+	if !delta.IsZero() {
+		k.setFixedTermReserved(ctx, market.PoolID, market.AssetID, k.FixedTermReserved(ctx, market.PoolID, market.AssetID).Add(delta))
+	}
+	store.Set(key, value)
+}
+
+func (k Keeper) GetFixedTermMarket(ctx sdk.Context, id uint64) (market types.FixedTermMarket, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermMarketKey(id)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return market, false
+	}
+
+	k.cdc.MustUnmarshal(value, &market)
+	return market, true
+}
+
+func (k Keeper) GetFixedTermMarkets(ctx sdk.Context) (markets []types.FixedTermMarket) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.FixedTermMarketKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var market types.FixedTermMarket
+		k.cdc.MustUnmarshal(iter.Value(), &market)
+		markets = append(markets, market)
+	}
+	return markets
+}
+
+func (k Keeper) SetFixedTermDepositID(ctx sdk.Context, id uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermDepositIDKey
+		value = k.cdc.MustMarshal(
+			&protobuftypes.UInt64Value{
+				Value: id,
+			},
+		)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetFixedTermDepositID(ctx sdk.Context) uint64 {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermDepositIDKey
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return 0
+	}
+
+	var id protobuftypes.UInt64Value
+	k.cdc.MustUnmarshal(value, &id)
+
+	return id.GetValue()
+}
+
+func (k Keeper) SetFixedTermDeposit(ctx sdk.Context, deposit types.FixedTermDeposit) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermDepositKey(deposit.ID)
+		value = k.cdc.MustMarshal(&deposit)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) DeleteFixedTermDeposit(ctx sdk.Context, id uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermDepositKey(id)
+	)
+	store.Delete(key)
+}
+
+func (k Keeper) GetFixedTermDeposit(ctx sdk.Context, id uint64) (deposit types.FixedTermDeposit, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermDepositKey(id)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return deposit, false
+	}
+
+	k.cdc.MustUnmarshal(value, &deposit)
+	return deposit, true
+}
+
+func (k Keeper) GetFixedTermDeposits(ctx sdk.Context) (deposits []types.FixedTermDeposit) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.FixedTermDepositKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.FixedTermDeposit
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+	return deposits
+}
+
+func (k Keeper) SetFixedTermLoanID(ctx sdk.Context, id uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermLoanIDKey
+		value = k.cdc.MustMarshal(
+			&protobuftypes.UInt64Value{
+				Value: id,
+			},
+		)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetFixedTermLoanID(ctx sdk.Context) uint64 {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermLoanIDKey
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return 0
+	}
+
+	var id protobuftypes.UInt64Value
+	k.cdc.MustUnmarshal(value, &id)
+
+	return id.GetValue()
+}
+
+func (k Keeper) SetFixedTermLoan(ctx sdk.Context, loan types.FixedTermLoan) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermLoanKey(loan.ID)
+		value = k.cdc.MustMarshal(&loan)
+	)
+	store.Set(key, value)
+	store.Set(types.FixedTermLoanMaturityKey(loan.Maturity, loan.ID), k.cdc.MustMarshal(&protobuftypes.UInt64Value{Value: loan.ID}))
+	store.Set(types.FixedTermLoanLendKey(loan.LendID, loan.ID), k.cdc.MustMarshal(&protobuftypes.UInt64Value{Value: loan.ID}))
+}
+
+func (k Keeper) DeleteFixedTermLoan(ctx sdk.Context, id uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermLoanKey(id)
+	)
+	if loan, found := k.GetFixedTermLoan(ctx, id); found {
+		store.Delete(types.FixedTermLoanMaturityKey(loan.Maturity, loan.ID))
+		store.Delete(types.FixedTermLoanLendKey(loan.LendID, loan.ID))
+	}
+	store.Delete(key)
+}
+
+func (k Keeper) GetFixedTermLoan(ctx sdk.Context, id uint64) (loan types.FixedTermLoan, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermLoanKey(id)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return loan, false
+	}
+
+	k.cdc.MustUnmarshal(value, &loan)
+	return loan, true
+}
+
+func (k Keeper) GetFixedTermLoans(ctx sdk.Context) (loans []types.FixedTermLoan) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.FixedTermLoanKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var loan types.FixedTermLoan
+		k.cdc.MustUnmarshal(iter.Value(), &loan)
+		loans = append(loans, loan)
+	}
+	return loans
+}
+
+// GetMaturedFixedTermLoans returns up to limit loans maturing at or before
+// the block time, soonest maturity first.
+func (k Keeper) GetMaturedFixedTermLoans(ctx sdk.Context, limit int) (loans []types.FixedTermLoan) {
+	var (
+		store = k.Store(ctx)
+		iter  = store.Iterator(types.FixedTermLoanMaturityKeyPrefix, sdk.PrefixEndBytes(types.FixedTermLoanMaturityTimeKey(ctx.BlockTime())))
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid() && len(loans) < limit; iter.Next() {
+		var id protobuftypes.UInt64Value
+		k.cdc.MustUnmarshal(iter.Value(), &id)
+		if loan, found := k.GetFixedTermLoan(ctx, id.GetValue()); found {
+			loans = append(loans, loan)
+		}
+	}
+	return loans
+}
+
+func (k Keeper) SetFixedTermLoanCursor(ctx sdk.Context, id uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermLoanCursorKey
+		value = k.cdc.MustMarshal(
+			&protobuftypes.UInt64Value{
+				Value: id,
+			},
+		)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetFixedTermLoanCursor(ctx sdk.Context) uint64 {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermLoanCursorKey
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return 0
+	}
+
+	var id protobuftypes.UInt64Value
+	k.cdc.MustUnmarshal(value, &id)
+
+	return id.GetValue()
+}
+
+// nextFixedTermLoans returns up to limit loans after id, wrapping around to
+// the lowest loan ids.
+func (k Keeper) nextFixedTermLoans(ctx sdk.Context, id uint64, limit int) (loans []types.FixedTermLoan) {
+	store := k.Store(ctx)
+	ranges := [][2][]byte{
+		{types.FixedTermLoanKey(id + 1), sdk.PrefixEndBytes(types.FixedTermLoanKeyPrefix)},
+		{types.FixedTermLoanKeyPrefix, types.FixedTermLoanKey(id + 1)},
+	}
+	for _, r := range ranges {
+		iter := store.Iterator(r[0], r[1])
+		for ; iter.Valid() && len(loans) < limit; iter.Next() {
+			var loan types.FixedTermLoan
+			k.cdc.MustUnmarshal(iter.Value(), &loan)
+			loans = append(loans, loan)
+		}
+		if err := iter.Close(); err != nil {
+			return loans
+		}
+	}
+	return loans
+}
+
+// AddFixedTermMarket opens a new maturity bucket for an asset of a pool at
+// the rate set by governance.
+func (k Keeper) AddFixedTermMarket(ctx sdk.Context, market types.FixedTermMarket) error {
+	if err := market.Validate(); err != nil {
+		return err
+	}
+	if !market.Maturity.After(ctx.BlockTime()) {
+		return sdkerrors.Wrap(types.ErrorInvalidFixedTermMarket, "maturity must be in the future")
+	}
+	if _, found := k.Asset.GetApp(ctx, market.AppID); !found {
+		return assettypes.AppIdsDoesntExist
+	}
+	pool, found := k.GetPool(ctx, market.PoolID)
+	if !found {
+		return types.ErrPoolNotFound
+	}
+	inPool := false
+	for _, assetData := range pool.AssetData {
+		if assetData.AssetID == market.AssetID {
+			inPool = true
+			break
+		}
+	}
+	if !inPool {
+		return sdkerrors.Wrap(types.ErrorInvalidFixedTermMarket, "asset is not part of the pool")
+	}
+	if _, found = k.GetAssetRatesParams(ctx, market.AssetID); !found {
+		return sdkerrors.Wrap(types.ErrorAssetRatesParamsNotFound, strconv.FormatUint(market.AssetID, 10))
+	}
+
+	market.ID = k.GetFixedTermMarketID(ctx) + 1
+	market.TotalCommitted = sdk.ZeroInt()
+	market.Available = sdk.ZeroInt()
+	market.TotalBorrowed = sdk.ZeroInt()
+	market.Shortfall = sdk.ZeroInt()
+	k.SetFixedTermMarket(ctx, market)
+	k.SetFixedTermMarketID(ctx, market.ID)
+	return nil
+}
+
+func (k Keeper) setFixedTermReserved(ctx sdk.Context, poolID, assetID uint64, reserved sdk.Int) {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermReservedKey(poolID, assetID)
+		value = k.cdc.MustMarshal(&sdk.IntProto{Int: reserved})
+	)
+	store.Set(key, value)
+}
+
+// FixedTermReserved is the liquidity of the pool committed to fixed-term
+// markets of the asset and not lent out. It is held by the pool module but
+// cannot be borrowed or withdrawn at a variable rate.
+func (k Keeper) FixedTermReserved(ctx sdk.Context, poolID, assetID uint64) sdk.Int {
+	var (
+		store = k.Store(ctx)
+		key   = types.FixedTermReservedKey(poolID, assetID)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return sdk.ZeroInt()
+	}
+
+	var reserved sdk.IntProto
+	k.cdc.MustUnmarshal(value, &reserved)
+	return reserved.Int
+}
+
+// variableLiquidity is the balance of the pool module in the asset less what
+// is reserved for fixed-term markets.
+func (k Keeper) variableLiquidity(ctx sdk.Context, pool types.Pool, assetID uint64, denom string) sdk.Int {
+	return k.ModuleBalance(ctx, pool.ModuleName, denom).Sub(k.FixedTermReserved(ctx, pool.PoolID, assetID))
+}
+
+// FixedTermCommit commits liquidity to a fixed-term market until maturity.
+func (k Keeper) FixedTermCommit(ctx sdk.Context, addr string, marketID uint64, amount sdk.Coin) (uint64, error) {
+	lender, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return 0, err
+	}
+
+	market, found := k.GetFixedTermMarket(ctx, marketID)
+	if !found {
+		return 0, types.ErrorFixedTermMarketNotFound
+	}
+
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, market.AppID)
+	if killSwitchParams.BreakerEnable {
+		return 0, esmtypes.ErrCircuitBreakerEnabled
+	}
+
+	if !ctx.BlockTime().Before(market.Maturity) {
+		return 0, types.ErrorFixedTermMarketMatured
+	}
+	if err = k.checkNotDelisting(ctx, market.AssetID); err != nil {
+		return 0, err
+	}
+	asset, found := k.Asset.GetAsset(ctx, market.AssetID)
+	if !found {
+		return 0, assettypes.ErrorAssetDoesNotExist
+	}
+	if amount.Denom != asset.Denom {
+		return 0, types.ErrBadOfferCoinType
+	}
+	pool, found := k.GetPool(ctx, market.PoolID)
+	if !found {
+		return 0, types.ErrPoolNotFound
+	}
+
+	if err = k.bank.SendCoinsFromAccountToModule(ctx, lender, pool.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return 0, err
+	}
+
+	deposit := types.FixedTermDeposit{
+		ID:       k.GetFixedTermDepositID(ctx) + 1,
+		MarketID: marketID,
+		Owner:    addr,
+		Amount:   amount,
+	}
+	k.SetFixedTermDeposit(ctx, deposit)
+	k.SetFixedTermDepositID(ctx, deposit.ID)
+
+	market.TotalCommitted = market.TotalCommitted.Add(amount.Amount)
+	market.Available = market.Available.Add(amount.Amount)
+	k.SetFixedTermMarket(ctx, market)
+	return deposit.ID, nil
+}
+
+// FixedTermRedeem pays a lender its share of a matured market once all of
+// its loans are settled. The share covers the committed liquidity and the
+// interest repaid to the market. The share of the market's shortfall is paid
+// from the variable rate liquidity of the pool, and the redemption waits
+// until the pool has it.
+func (k Keeper) FixedTermRedeem(ctx sdk.Context, addr string, depositID uint64) (sdk.Coin, error) {
+	lender, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	deposit, found := k.GetFixedTermDeposit(ctx, depositID)
+	if !found {
+		return sdk.Coin{}, types.ErrorFixedTermDepositNotFound
+	}
+	if deposit.Owner != addr {
+		return sdk.Coin{}, types.ErrLendAccessUnauthorized
+	}
+	market, found := k.GetFixedTermMarket(ctx, deposit.MarketID)
+	if !found {
+		return sdk.Coin{}, types.ErrorFixedTermMarketNotFound
+	}
+
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, market.AppID)
+	if killSwitchParams.BreakerEnable {
+		return sdk.Coin{}, esmtypes.ErrCircuitBreakerEnabled
+	}
+
+	if ctx.BlockTime().Before(market.Maturity) || !market.TotalBorrowed.IsZero() {
+		return sdk.Coin{}, types.ErrorFixedTermMarketNotSettled
+	}
+	pool, found := k.GetPool(ctx, market.PoolID)
+	if !found {
+		return sdk.Coin{}, types.ErrPoolNotFound
+	}
+
+	fromAvailable := deposit.Amount.Amount.Mul(market.Available).Quo(market.TotalCommitted)
+	fromShortfall := deposit.Amount.Amount.Mul(market.Shortfall).Quo(market.TotalCommitted)
+	if fromShortfall.GT(k.variableLiquidity(ctx, pool, market.AssetID, deposit.Amount.Denom)) {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrorFixedTermLiquidityInsufficient, "shortfall of the market is not repaid yet")
+	}
+	redeemed := sdk.NewCoin(deposit.Amount.Denom, fromAvailable.Add(fromShortfall))
+	if redeemed.IsPositive() {
+		if err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, lender, sdk.NewCoins(redeemed)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	market.TotalCommitted = market.TotalCommitted.Sub(deposit.Amount.Amount)
+	market.Available = market.Available.Sub(fromAvailable)
+	market.Shortfall = market.Shortfall.Sub(fromShortfall)
+	k.SetFixedTermMarket(ctx, market)
+	k.DeleteFixedTermDeposit(ctx, depositID)
+	return redeemed, nil
+}
+
+// FixedTermBorrow lends liquidity of a fixed-term market against cTokens of a
+// lend position, which are locked in the pool as for a borrow of the pair.
+// The interest owed until maturity is fixed when the loan is opened.
+func (k Keeper) FixedTermBorrow(ctx sdk.Context, addr string, marketID, lendID, pairID uint64, amountIn, loan sdk.Coin) (uint64, error) {
+	borrower, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return 0, err
+	}
+
+	market, found := k.GetFixedTermMarket(ctx, marketID)
+	if !found {
+		return 0, types.ErrorFixedTermMarketNotFound
+	}
+	lendPos, found := k.GetLend(ctx, lendID)
+	if !found {
+		return 0, types.ErrLendNotFound
+	}
+
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, lendPos.AppID)
+	if killSwitchParams.BreakerEnable {
+		return 0, esmtypes.ErrCircuitBreakerEnabled
+	}
+
+	if lendPos.Owner != addr {
+		return 0, types.ErrLendAccessUnauthorized
+	}
+	if lendPos.AppID != market.AppID {
+		return 0, types.ErrorAppMappingIDMismatch
+	}
+	if !ctx.BlockTime().Before(market.Maturity) {
+		return 0, types.ErrorFixedTermMarketMatured
+	}
+
+	// the pair is the one a borrow of the market asset against the lend
+	// position would use, liquidation of a converted loan goes through it
+	pair, found := k.GetLendPair(ctx, pairID)
+	if !found {
+		return 0, types.ErrorPairNotFound
+	}
+	pairMapping, _ := k.GetAssetToPair(ctx, pair.AssetIn, lendPos.PoolID)
+	if !uint64InSlice(pairID, pairMapping.PairID) || pair.IsInterPool ||
+		pair.AssetIn != lendPos.AssetID || pair.AssetOut != market.AssetID || pair.AssetOutPoolID != market.PoolID {
+		return 0, types.ErrorPairNotFound
+	}
+
+	if err = k.checkNotDelisting(ctx, pair.AssetIn, pair.AssetOut); err != nil {
+		return 0, err
+	}
+
+	assetIn, found := k.Asset.GetAsset(ctx, lendPos.AssetID)
+	if !found {
+		return 0, assettypes.ErrorAssetDoesNotExist
+	}
+	assetOut, found := k.Asset.GetAsset(ctx, market.AssetID)
+	if !found {
+		return 0, assettypes.ErrorAssetDoesNotExist
+	}
+	assetInRatesStats, found := k.GetAssetRatesParamsForPair(ctx, pair)
+	if !found {
+		return 0, types.ErrAssetStatsNotFound
+	}
+	cAsset, found := k.Asset.GetAsset(ctx, assetInRatesStats.CAssetID)
+	if !found {
+		return 0, assettypes.ErrorAssetDoesNotExist
+	}
+	if amountIn.Denom != cAsset.Denom {
+		return 0, types.ErrBadOfferCoinType
+	}
+	if loan.Denom != assetOut.Denom {
+		return 0, types.ErrInvalidAsset
+	}
+	if amountIn.Amount.GT(lendPos.AvailableToBorrow) {
+		return 0, types.ErrAvailableToBorrowInsufficient
+	}
+	if loan.Amount.GT(market.Available) {
+		return 0, sdkerrors.Wrap(types.ErrorFixedTermLiquidityInsufficient, loan.String())
+	}
+
+	minUSDVal, _ := sdk.NewDecFromStr(types.DollarOneValue)
+	loanValue, err := k.Market.CalcAssetPrice(ctx, market.AssetID, loan.Amount)
+	if loanValue.LT(minUSDVal) || err != nil {
+		return 0, types.ErrBorrowLessThanMinAmount
+	}
+
+	interestDue := fixedTermInterest(loan.Amount, market.Rate, ctx.BlockTime(), market.Maturity)
+	collateral := k.CTokenRedeemAmount(ctx, lendPos.PoolID, lendPos.AssetID, amountIn.Amount)
+	err = k.VerifyCollateralizationRatio(ctx, collateral, assetIn, loan.Amount.Add(interestDue.Ceil().TruncateInt()), assetOut, assetInRatesStats.Ltv)
+	if err != nil {
+		return 0, err
+	}
+
+	collateralPool, found := k.GetPool(ctx, lendPos.PoolID)
+	if !found {
+		return 0, types.ErrPoolNotFound
+	}
+	pool, found := k.GetPool(ctx, market.PoolID)
+	if !found {
+		return 0, types.ErrPoolNotFound
+	}
+
+	if err = k.bank.SendCoinsFromAccountToModule(ctx, borrower, collateralPool.ModuleName, sdk.NewCoins(amountIn)); err != nil {
+		return 0, err
+	}
+	if err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, borrower, sdk.NewCoins(loan)); err != nil {
+		return 0, err
+	}
+
+	lendPos.AvailableToBorrow = lendPos.AvailableToBorrow.Sub(amountIn.Amount)
+	k.SetLend(ctx, lendPos)
+
+	fixedLoan := types.FixedTermLoan{
+		ID:          k.GetFixedTermLoanID(ctx) + 1,
+		MarketID:    marketID,
+		Owner:       addr,
+		LendID:      lendID,
+		PairID:      pairID,
+		AmountIn:    amountIn,
+		AmountOut:   loan,
+		Rate:        market.Rate,
+		StartTime:   ctx.BlockTime(),
+		Maturity:    market.Maturity,
+		InterestDue: interestDue,
+	}
+	k.SetFixedTermLoan(ctx, fixedLoan)
+	k.SetFixedTermLoanID(ctx, fixedLoan.ID)
+
+	market.Available = market.Available.Sub(loan.Amount)
+	market.TotalBorrowed = market.TotalBorrowed.Add(loan.Amount)
+	k.SetFixedTermMarket(ctx, market)
+	return fixedLoan.ID, nil
+}
+
+// FixedTermRepay closes a fixed-term loan. Before maturity the borrower pays
+// the interest accrued so far and the early repayment penalty of the market
+// on the interest left until maturity.
+func (k Keeper) FixedTermRepay(ctx sdk.Context, addr string, loanID uint64) (sdk.Coin, error) {
+	borrower, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	loan, found := k.GetFixedTermLoan(ctx, loanID)
+	if !found {
+		return sdk.Coin{}, types.ErrorFixedTermLoanNotFound
+	}
+	if loan.Owner != addr {
+		return sdk.Coin{}, types.ErrLendAccessUnauthorized
+	}
+	market, found := k.GetFixedTermMarket(ctx, loan.MarketID)
+	if !found {
+		return sdk.Coin{}, types.ErrorFixedTermMarketNotFound
+	}
+
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, market.AppID)
+	if killSwitchParams.BreakerEnable {
+		return sdk.Coin{}, esmtypes.ErrCircuitBreakerEnabled
+	}
+
+	interest := loan.InterestDue
+	if ctx.BlockTime().Before(loan.Maturity) {
+		accrued := fixedTermInterest(loan.AmountOut.Amount, loan.Rate, loan.StartTime, ctx.BlockTime())
+		interest = accrued.Add(loan.InterestDue.Sub(accrued).Mul(market.EarlyRepaymentPenalty))
+	}
+	interestPaid := interest.Ceil().TruncateInt()
+	payment := sdk.NewCoin(loan.AmountOut.Denom, loan.AmountOut.Amount.Add(interestPaid))
+
+	pool, found := k.GetPool(ctx, market.PoolID)
+	if !found {
+		return sdk.Coin{}, types.ErrPoolNotFound
+	}
+	if err = k.bank.SendCoinsFromAccountToModule(ctx, borrower, pool.ModuleName, sdk.NewCoins(payment)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err = k.settleFixedTermLoan(ctx, &market, loan, interestPaid); err != nil {
+		return sdk.Coin{}, err
+	}
+	k.SetFixedTermMarket(ctx, market)
+
+	if err = k.releaseFixedTermCollateral(ctx, borrower, loan); err != nil {
+		return sdk.Coin{}, err
+	}
+	k.DeleteFixedTermLoan(ctx, loanID)
+	return payment, nil
+}
+
+// ProcessFixedTermLoans settles the loans of matured markets and hands loans
+// past their liquidation threshold to the variable rate borrows, where the
+// liquidation module picks them up. A matured loan moves to the next market
+// of the asset that can fund it within its ltv, and otherwise becomes a
+// variable rate borrow of the pool.
+// Up to MaxFixedTermLoansPerBlock matured loans are settled in order of
+// maturity, and up to MaxFixedTermLoansPerBlock open loans are checked round
+// robin across blocks.
+func (k Keeper) ProcessFixedTermLoans(ctx sdk.Context) {
+	for _, loan := range k.GetMaturedFixedTermLoans(ctx, types.MaxFixedTermLoansPerBlock) {
+		k.processFixedTermLoanCached(ctx, loan)
+	}
+
+	loans := k.nextFixedTermLoans(ctx, k.GetFixedTermLoanCursor(ctx), types.MaxFixedTermLoansPerBlock)
+	for _, loan := range loans {
+		if !ctx.BlockTime().Before(loan.Maturity) {
+			// settled through the maturity index
+			continue
+		}
+		k.processFixedTermLoanCached(ctx, loan)
+	}
+	if len(loans) > 0 {
+		k.SetFixedTermLoanCursor(ctx, loans[len(loans)-1].ID)
+	}
+}
+
+func (k Keeper) processFixedTermLoanCached(ctx sdk.Context, loan types.FixedTermLoan) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.processFixedTermLoan(cacheCtx, loan); err != nil {
+		ctx.Logger().Error("error processing fixed-term loan", "id", loan.ID, "error", err.Error())
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+func (k Keeper) processFixedTermLoan(ctx sdk.Context, loan types.FixedTermLoan) error {
+	market, found := k.GetFixedTermMarket(ctx, loan.MarketID)
+	if !found {
+		return types.ErrorFixedTermMarketNotFound
+	}
+	pair, found := k.GetLendPair(ctx, loan.PairID)
+	if !found {
+		return types.ErrorPairNotFound
+	}
+	assetRatesStats, found := k.GetAssetRatesParamsForPair(ctx, pair)
+	if !found {
+		return types.ErrAssetStatsNotFound
+	}
+	assetIn, found := k.Asset.GetAsset(ctx, pair.AssetIn)
+	if !found {
+		return assettypes.ErrorAssetDoesNotExist
+	}
+	assetOut, found := k.Asset.GetAsset(ctx, pair.AssetOut)
+	if !found {
+		return assettypes.ErrorAssetDoesNotExist
+	}
+
+	collateral := k.CTokenCollateral(ctx, loan.LendID, loan.AmountIn.Amount)
+	if ctx.BlockTime().Before(loan.Maturity) {
+		interest := fixedTermInterest(loan.AmountOut.Amount, loan.Rate, loan.StartTime, ctx.BlockTime()).Ceil().TruncateInt()
+		ratio, err := k.CalculateCollateralizationRatio(ctx, collateral, assetIn, loan.AmountOut.Amount.Add(interest), assetOut)
+		if err != nil {
+			return err
+		}
+		if ratio.LTE(assetRatesStats.LiquidationThreshold) {
+			return nil
+		}
+		return k.convertFixedTermLoan(ctx, &market, loan, interest)
+	}
+
+	owed := loan.AmountOut.Amount.Add(loan.InterestDue.Ceil().TruncateInt())
+	if next, found := k.nextFixedTermMarket(ctx, market, owed); found {
+		err := k.VerifyCollateralizationRatio(ctx, collateral, assetIn, owed.Add(fixedTermInterest(owed, next.Rate, ctx.BlockTime(), next.Maturity).Ceil().TruncateInt()), assetOut, assetRatesStats.Ltv)
+		if err == nil {
+			return k.rolloverFixedTermLoan(ctx, &market, next, loan)
+		}
+	}
+	return k.convertFixedTermLoan(ctx, &market, loan, loan.InterestDue.Ceil().TruncateInt())
+}
+
+// nextFixedTermMarket returns the market of the same asset maturing the
+// soonest after the block time with enough liquidity to lend amount.
+func (k Keeper) nextFixedTermMarket(ctx sdk.Context, market types.FixedTermMarket, amount sdk.Int) (next types.FixedTermMarket, found bool) {
+	for _, m := range k.GetFixedTermMarkets(ctx) {
+		if m.ID == market.ID || m.AppID != market.AppID || m.PoolID != market.PoolID || m.AssetID != market.AssetID {
+			continue
+		}
+		if !m.Maturity.After(ctx.BlockTime()) || m.Available.LT(amount) {
+			continue
+		}
+		if !found || m.Maturity.Before(next.Maturity) {
+			next, found = m, true
+		}
+	}
+	return next, found
+}
+
+// rolloverFixedTermLoan repays a matured loan with liquidity of the next
+// market and opens a loan of the amount owed there at its rate.
+func (k Keeper) rolloverFixedTermLoan(ctx sdk.Context, market *types.FixedTermMarket, next types.FixedTermMarket, loan types.FixedTermLoan) error {
+	interestPaid := loan.InterestDue.Ceil().TruncateInt()
+	owed := loan.AmountOut.Amount.Add(interestPaid)
+	if err := k.settleFixedTermLoan(ctx, market, loan, interestPaid); err != nil {
+		return err
+	}
+	k.SetFixedTermMarket(ctx, *market)
+
+	rolled := types.FixedTermLoan{
+		ID:          k.GetFixedTermLoanID(ctx) + 1,
+		MarketID:    next.ID,
+		Owner:       loan.Owner,
+		LendID:      loan.LendID,
+		PairID:      loan.PairID,
+		AmountIn:    loan.AmountIn,
+		AmountOut:   sdk.NewCoin(loan.AmountOut.Denom, owed),
+		Rate:        next.Rate,
+		StartTime:   ctx.BlockTime(),
+		Maturity:    next.Maturity,
+		InterestDue: fixedTermInterest(owed, next.Rate, ctx.BlockTime(), next.Maturity),
+	}
+	k.SetFixedTermLoan(ctx, rolled)
+	k.SetFixedTermLoanID(ctx, rolled.ID)
+	k.DeleteFixedTermLoan(ctx, loan.ID)
+
+	next.Available = next.Available.Sub(owed)
+	next.TotalBorrowed = next.TotalBorrowed.Add(owed)
+	k.SetFixedTermMarket(ctx, next)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFixedRollover,
+		sdk.NewAttribute(types.AttributeKeyLoanID, strconv.FormatUint(loan.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyMarketID, strconv.FormatUint(next.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyAmountOut, rolled.AmountOut.String()),
+	))
+	return nil
+}
+
+// convertFixedTermLoan repays the market with variable rate liquidity of the
+// pool and turns the loan into a variable rate borrow of its pair, so that it
+// accrues interest and is liquidated like any other borrow. What the variable
+// rate liquidity cannot pay is booked as the market's shortfall, which its
+// lenders redeem once the borrow is repaid.
+func (k Keeper) convertFixedTermLoan(ctx sdk.Context, market *types.FixedTermMarket, loan types.FixedTermLoan, interestPaid sdk.Int) error {
+	pair, found := k.GetLendPair(ctx, loan.PairID)
+	if !found {
+		return types.ErrorPairNotFound
+	}
+	pool, found := k.GetPool(ctx, market.PoolID)
+	if !found {
+		return types.ErrPoolNotFound
+	}
+	owed := sdk.NewCoin(loan.AmountOut.Denom, loan.AmountOut.Amount.Add(interestPaid))
+	if err := k.settleFixedTermLoan(ctx, market, loan, interestPaid); err != nil {
+		return err
+	}
+	k.SetFixedTermMarket(ctx, *market)
+	// the market is reserved more than the pool holds by the shortfall
+	shortfall := sdk.MinInt(k.variableLiquidity(ctx, pool, market.AssetID, owed.Denom).Neg(), market.Available)
+	if shortfall.IsPositive() {
+		market.Available = market.Available.Sub(shortfall)
+		market.Shortfall = market.Shortfall.Add(shortfall)
+	}
+	k.SetFixedTermMarket(ctx, *market)
+
+	assetStats, _ := k.AssetStatsByPoolIDAndAssetID(ctx, pair.AssetOutPoolID, pair.AssetOut)
+	reserveGlobalIndex, err := k.GetReserveRate(ctx, pair.AssetOutPoolID, pair.AssetOut)
+	if err != nil {
+		reserveGlobalIndex = sdk.OneDec()
+	}
+	borrowPos := types.BorrowAsset{
+		ID:                  k.GetUserBorrowIDCounter(ctx) + 1,
+		LendingID:           loan.LendID,
+		PairID:              loan.PairID,
+		AmountIn:            loan.AmountIn,
+		AmountOut:           owed,
+		BridgedAssetAmount:  sdk.NewCoin(owed.Denom, sdk.ZeroInt()),
+		IsStableBorrow:      false,
+		StableBorrowRate:    sdk.ZeroDec(),
+		BorrowingTime:       ctx.BlockTime(),
+		InterestAccumulated: sdk.ZeroDec(),
+		GlobalIndex:         assetStats.BorrowApr,
+		ReserveGlobalIndex:  reserveGlobalIndex,
+		LastInteractionTime: ctx.BlockTime(),
+		CPoolName:           pool.CPoolName,
+		IsLiquidated:        false,
+	}
+	k.UpdateBorrowStats(ctx, pair, false, owed.Amount, true)
+
+	poolAssetLBMappingData, _ := k.GetAssetStatsByPoolIDAndAssetID(ctx, pair.AssetOutPoolID, pair.AssetOut)
+	poolAssetLBMappingData.BorrowIds = append(poolAssetLBMappingData.BorrowIds, borrowPos.ID)
+	k.SetAssetStatsByPoolIDAndAssetID(ctx, poolAssetLBMappingData)
+
+	k.SetUserBorrowIDCounter(ctx, borrowPos.ID)
+	k.SetBorrow(ctx, borrowPos)
+
+	mappingData, _ := k.GetUserLendBorrowMapping(ctx, loan.Owner, loan.LendID)
+	mappingData.BorrowId = append(mappingData.BorrowId, borrowPos.ID)
+	k.SetUserLendBorrowMapping(ctx, mappingData)
+	k.DeleteFixedTermLoan(ctx, loan.ID)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFixedConvert,
+		sdk.NewAttribute(types.AttributeKeyLoanID, strconv.FormatUint(loan.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyBorrowID, strconv.FormatUint(borrowPos.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyAmountOut, owed.String()),
+	))
+	return nil
+}
+
+// settleFixedTermLoan books the repayment of a loan, already held by the pool
+// module, to its market. The reserve factor of the asset is taken from the
+// interest and the rest goes to the lenders of the market.
+func (k Keeper) settleFixedTermLoan(ctx sdk.Context, market *types.FixedTermMarket, loan types.FixedTermLoan, interestPaid sdk.Int) error {
+	assetRatesStats, found := k.GetAssetRatesParams(ctx, market.AssetID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrorAssetRatesParamsNotFound, strconv.FormatUint(market.AssetID, 10))
+	}
+	pool, found := k.GetPool(ctx, market.PoolID)
+	if !found {
+		return types.ErrPoolNotFound
+	}
+
+	toReserve := assetRatesStats.ReserveFactor.MulInt(interestPaid).TruncateInt()
+	if toReserve.IsPositive() {
+		err := k.UpdateReserveBalances(ctx, market.AssetID, pool.ModuleName, sdk.NewCoin(loan.AmountOut.Denom, toReserve), true)
+		if err != nil {
+			return err
+		}
+		k.UpdateReserverAmtFromRepayments(ctx, market.AssetID, toReserve)
+	}
+
+	market.Available = market.Available.Add(loan.AmountOut.Amount).Add(interestPaid.Sub(toReserve))
+	market.TotalBorrowed = market.TotalBorrowed.Sub(loan.AmountOut.Amount)
+	return nil
+}
+
+// releaseFixedTermCollateral returns the cTokens locked by a repaid loan to
+// the borrower and its lend position.
+func (k Keeper) releaseFixedTermCollateral(ctx sdk.Context, borrower sdk.AccAddress, loan types.FixedTermLoan) error {
+	lendPos, found := k.GetLend(ctx, loan.LendID)
+	if !found {
+		return types.ErrLendNotFound
+	}
+	collateralPool, found := k.GetPool(ctx, lendPos.PoolID)
+	if !found {
+		return types.ErrPoolNotFound
+	}
+	if err := k.bank.SendCoinsFromModuleToAccount(ctx, collateralPool.ModuleName, borrower, sdk.NewCoins(loan.AmountIn)); err != nil {
+		return err
+	}
+	lendPos.AvailableToBorrow = lendPos.AvailableToBorrow.Add(loan.AmountIn.Amount)
+	k.SetLend(ctx, lendPos)
+	return nil
+}
+
+// IsLendFixedTermCollateral reports whether cTokens of the lend position are
+// locked by an open fixed-term loan.
+func (k Keeper) IsLendFixedTermCollateral(ctx sdk.Context, lendID uint64) bool {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.FixedTermLoanLendPrefix(lendID))
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	return iter.Valid()
+}
+
+// fixedTermInterest is the simple interest on amount at the yearly rate
+// between from and to.
+func fixedTermInterest(amount sdk.Int, rate sdk.Dec, from, to time.Time) sdk.Dec {
+	secondsElapsed := to.Unix() - from.Unix()
+	if secondsElapsed <= 0 {
+		return sdk.ZeroDec()
+	}
+	yearsElapsed := sdk.NewDec(secondsElapsed).QuoInt64(types.SecondsPerYear)
+	return amount.ToDec().Mul(rate).Mul(yearsElapsed)
+}
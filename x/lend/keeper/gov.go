@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
 	"github.com/comdex-official/comdex/x/lend/types"
 )
 
@@ -27,6 +28,14 @@ func (k Keeper) HandleAddAssetRatesParamsRecords(ctx sdk.Context, p *types.AddAs
 }
 
 func (k Keeper) HandleAddAuctionParamsRecords(ctx sdk.Context, p *types.AddAuctionParamsProposal) error {
+	if k.Auction.IsSealedBidApp(ctx, p.AuctionParams.AppId) {
+		return types.ErrorSealedBidApp
+	}
+	// the first params of an app take effect immediately, updates are timelocked
+	if _, found := k.GetAddAuctionParamsData(ctx, p.AuctionParams.AppId); found {
+		_, err := k.Asset.ScheduleChange(ctx, assettypes.ParamClassLendAuctionParams, &p.AuctionParams)
+		return err
+	}
 	return k.AddAuctionParamsData(ctx, p.AuctionParams)
 }
 
@@ -41,3 +50,31 @@ func (k Keeper) HandleAddPoolPairsRecords(ctx sdk.Context, p *types.AddPoolPairs
 func (k Keeper) HandleAddAssetRatesPoolPairsRecords(ctx sdk.Context, p *types.AddAssetRatesPoolPairsProposal) error {
 	return k.AddAssetRatesPoolPairs(ctx, p.AssetRatesPoolPairs)
 }
+
+func (k Keeper) HandleSetEModeCategoryRecords(ctx sdk.Context, p *types.SetEModeCategoryProposal) error {
+	return k.SetEModeCategory(ctx, p.Category)
+}
+
+func (k Keeper) HandleSetBadDebtWaterfallRecords(ctx sdk.Context, p *types.SetBadDebtWaterfallProposal) error {
+	if err := p.Waterfall.Validate(); err != nil {
+		return err
+	}
+	k.SetBadDebtWaterfall(ctx, p.Waterfall)
+	return nil
+}
+
+func (k Keeper) HandleAddFixedTermMarketRecords(ctx sdk.Context, p *types.AddFixedTermMarketProposal) error {
+	return k.AddFixedTermMarket(ctx, p.Market)
+}
+
+func (k Keeper) HandleUpdateAssetRatesParamsRecords(ctx sdk.Context, p *types.UpdateAssetRatesParamsProposal) error {
+	if err := k.ValidateAssetRatesParamsUpdate(ctx, p.AssetRatesParams); err != nil {
+		return err
+	}
+	_, err := k.Asset.ScheduleChange(ctx, assettypes.ParamClassAssetRatesParams, &p.AssetRatesParams)
+	return err
+}
+
+func (k Keeper) HandleUpdatePoolPairsRecords(ctx sdk.Context, p *types.UpdatePoolPairsProposal) error {
+	return k.UpdatePoolPairs(ctx, p.PoolID, p.AssetData, p.MinUsdValueLeft)
+}
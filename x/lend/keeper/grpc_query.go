@@ -226,9 +226,13 @@ func (q QueryServer) QueryPair(c context.Context, req *types.QueryPairRequest) (
 		return &types.QueryPairResponse{}, nil
 	}
 
-	return &types.QueryPairResponse{
+	res := &types.QueryPairResponse{
 		ExtendedPair: item,
-	}, nil
+	}
+	if category, found := q.GetEModeCategoryForPair(ctx, item); found {
+		res.EModeCategory = &category
+	}
+	return res, nil
 }
 
 func (q QueryServer) QueryPools(c context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
@@ -617,3 +621,150 @@ func (q QueryServer) QueryBorrowInterest(c context.Context, req *types.QueryBorr
 	borrowInterest, _ := q.IterateBorrowsForQuery(ctx)
 	return &types.QueryBorrowInterestResponse{PoolInterest: borrowInterest}, nil
 }
+
+func (q QueryServer) QueryPositionManagerGrants(c context.Context, req *types.QueryPositionManagerGrantsRequest) (*types.QueryPositionManagerGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryPositionManagerGrantsResponse{Grants: q.GetPositionManagerGrantsByOwner(ctx, owner)}, nil
+}
+
+func (q QueryServer) QueryEModeCategories(c context.Context, req *types.QueryEModeCategoriesRequest) (*types.QueryEModeCategoriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryEModeCategoriesResponse{Categories: q.GetEModeCategories(ctx)}, nil
+}
+
+func (q QueryServer) QueryBadDebtWaterfall(c context.Context, req *types.QueryBadDebtWaterfallRequest) (*types.QueryBadDebtWaterfallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBadDebtWaterfallResponse{
+		Waterfall:     q.GetBadDebtWaterfall(ctx),
+		ExchangeRates: q.GetCTokenExchangeRates(ctx),
+	}, nil
+}
+
+func (q QueryServer) QueryCrossCollateralAccount(c context.Context, req *types.QueryCrossCollateralAccountRequest) (*types.QueryCrossCollateralAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	account, found := q.GetCrossCollateralAccount(ctx, owner, req.PoolId)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrorAccountNotFound.Error())
+	}
+	// interest accrued since the last interaction is only added to the
+	// returned account, it is not stored
+	if err = q.accrueAccountInterest(ctx, &account); err != nil {
+		return nil, err
+	}
+	health, err := q.CalculateAccountHealth(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCrossCollateralAccountResponse{
+		Account: account,
+		Health:  health,
+	}, nil
+}
+
+func (q QueryServer) QueryFixedTermMarkets(c context.Context, req *types.QueryFixedTermMarketsRequest) (*types.QueryFixedTermMarketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFixedTermMarketsResponse{
+		Markets: q.GetFixedTermMarkets(ctx),
+	}, nil
+}
+
+func (q QueryServer) QueryFixedTermPositions(c context.Context, req *types.QueryFixedTermPositionsRequest) (*types.QueryFixedTermPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		deposits []types.FixedTermDeposit
+		loans    []types.FixedTermLoan
+	)
+	for _, deposit := range q.GetFixedTermDeposits(ctx) {
+		if deposit.Owner == req.Owner {
+			deposits = append(deposits, deposit)
+		}
+	}
+	for _, loan := range q.GetFixedTermLoans(ctx) {
+		if loan.Owner == req.Owner {
+			loans = append(loans, loan)
+		}
+	}
+	return &types.QueryFixedTermPositionsResponse{
+		Deposits: deposits,
+		Loans:    loans,
+	}, nil
+}
+
+func (q QueryServer) QueryInterestRateProjection(c context.Context, req *types.QueryInterestRateProjectionRequest) (*types.QueryInterestRateProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+	if req.Utilisation.IsNil() || req.Utilisation.IsNegative() || req.Utilisation.GT(sdk.OneDec()) {
+		return nil, status.Error(codes.InvalidArgument, "utilisation must be between 0 and 1")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	assetRatesParams, found := q.GetAssetRatesParams(ctx, req.AssetId)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrorAssetRatesParamsNotFound.Error())
+	}
+	utilisation, err := q.GetUtilisationRatioByPoolIDAndAssetID(ctx, req.PoolId, req.AssetId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	current, err := q.interestRatesAtUtilisation(ctx, req.PoolId, req.AssetId, utilisation)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	projected, err := q.interestRatesAtUtilisation(ctx, req.PoolId, req.AssetId, req.Utilisation)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryInterestRateProjectionResponse{
+		InterestRateModelID: assetRatesParams.InterestRateModelID,
+		Current:             current,
+		Projected:           projected,
+	}, nil
+}
+
+func (q QueryServer) interestRatesAtUtilisation(ctx sdk.Context, poolID, assetID uint64, utilisation sdk.Dec) (rates types.InterestRates, err error) {
+	rates.Utilisation = utilisation
+	if rates.LendApr, err = q.GetLendAPRAtUtilisation(ctx, poolID, assetID, utilisation); err != nil {
+		return rates, err
+	}
+	if rates.BorrowApr, err = q.GetBorrowAPRAtUtilisation(ctx, poolID, assetID, utilisation, false); err != nil {
+		return rates, err
+	}
+	if rates.StableBorrowApr, err = q.GetBorrowAPRAtUtilisation(ctx, poolID, assetID, utilisation, true); err != nil {
+		return rates, err
+	}
+	return rates, nil
+}
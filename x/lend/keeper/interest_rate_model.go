@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/lend/types"
+)
+
+func (k Keeper) SetAdaptiveRateState(ctx sdk.Context, state types.AdaptiveRateState) {
+	var (
+		store = k.Store(ctx)
+		key   = types.AdaptiveRateStateKey(state.PoolID, state.AssetID)
+		value = k.cdc.MustMarshal(&state)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetAdaptiveRateState(ctx sdk.Context, poolID, assetID uint64) (state types.AdaptiveRateState, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.AdaptiveRateStateKey(poolID, assetID)
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return state, false
+	}
+
+	k.cdc.MustUnmarshal(value, &state)
+	return state, true
+}
+
+func (k Keeper) DeleteAdaptiveRateState(ctx sdk.Context, poolID, assetID uint64) {
+	store := k.Store(ctx)
+	store.Delete(types.AdaptiveRateStateKey(poolID, assetID))
+}
+
+func (k Keeper) GetAdaptiveRateStates(ctx sdk.Context) (states []types.AdaptiveRateState) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.AdaptiveRateStateKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var state types.AdaptiveRateState
+		k.cdc.MustUnmarshal(iter.Value(), &state)
+		states = append(states, state)
+	}
+	return states
+}
+
+// GetAdaptiveRateMultiplier returns the curve multiplier of an asset in a
+// pool, which is one until the adaptive model has moved it.
+func (k Keeper) GetAdaptiveRateMultiplier(ctx sdk.Context, poolID, assetID uint64) sdk.Dec {
+	state, found := k.GetAdaptiveRateState(ctx, poolID, assetID)
+	if !found {
+		return sdk.OneDec()
+	}
+	return state.Multiplier
+}
+
+// UpdateAdaptiveRates moves the curve of every asset priced by the adaptive
+// model by the time elapsed at the utilisation of the previous block, and
+// drops the curve of assets that switched to another model.
+func (k Keeper) UpdateAdaptiveRates(ctx sdk.Context) {
+	for _, assetStats := range k.GetAllAssetStatsByPoolIDAndAssetID(ctx) {
+		assetRatesParams, found := k.GetAssetRatesParams(ctx, assetStats.AssetID)
+		if !found || assetRatesParams.InterestRateModelID != types.InterestRateModelAdaptive {
+			k.DeleteAdaptiveRateState(ctx, assetStats.PoolID, assetStats.AssetID)
+			continue
+		}
+
+		state, found := k.GetAdaptiveRateState(ctx, assetStats.PoolID, assetStats.AssetID)
+		if !found {
+			k.SetAdaptiveRateState(ctx, types.AdaptiveRateState{
+				PoolID:      assetStats.PoolID,
+				AssetID:     assetStats.AssetID,
+				Multiplier:  sdk.OneDec(),
+				LastUpdated: ctx.BlockTime(),
+			})
+			continue
+		}
+
+		secondsElapsed := ctx.BlockTime().Unix() - state.LastUpdated.Unix()
+		if secondsElapsed <= 0 {
+			continue
+		}
+		utilisation, err := k.GetUtilisationRatioByPoolIDAndAssetID(ctx, assetStats.PoolID, assetStats.AssetID)
+		if err != nil {
+			continue
+		}
+		yearsElapsed := sdk.NewDec(secondsElapsed).QuoInt64(types.SecondsPerYear)
+		state.Multiplier = types.NextAdaptiveMultiplier(assetRatesParams, state.Multiplier, utilisation, yearsElapsed)
+		state.LastUpdated = ctx.BlockTime()
+		k.SetAdaptiveRateState(ctx, state)
+	}
+}
@@ -139,6 +139,9 @@ func (k Keeper) LendAsset(ctx sdk.Context, lenderAddr string, AssetID uint64, Am
 	if killSwitchParams.BreakerEnable {
 		return esmtypes.ErrCircuitBreakerEnabled
 	}
+	if err := k.checkNotDelisting(ctx, AssetID); err != nil {
+		return err
+	}
 	asset, found := k.Asset.GetAsset(ctx, AssetID)
 	if !found {
 		return assettypes.ErrorAssetDoesNotExist
@@ -203,16 +206,22 @@ func (k Keeper) LendAsset(ctx sdk.Context, lenderAddr string, AssetID uint64, Am
 		return assettypes.ErrorAssetDoesNotExist
 	}
 
+	// cTokens are minted at the exchange rate of the pool, which is below one
+	// once bad debt was socialized across its lenders
+	cTokens, err := k.CTokenMintAmount(ctx, PoolID, AssetID, Amount.Amount)
+	if err != nil {
+		return err
+	}
 	if err = k.bank.SendCoinsFromAccountToModule(ctx, addr, pool.ModuleName, sdk.NewCoins(Amount)); err != nil {
 		return err
 	}
 	// mint c/Token and set new total cToken supply
 
-	if err = k.bank.MintCoins(ctx, pool.ModuleName, sdk.NewCoins(sdk.NewCoin(cAsset.Denom, Amount.Amount))); err != nil {
+	if err = k.bank.MintCoins(ctx, pool.ModuleName, sdk.NewCoins(sdk.NewCoin(cAsset.Denom, cTokens))); err != nil {
 		return err
 	}
 
-	err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, addr, sdk.NewCoins(sdk.NewCoin(cAsset.Denom, Amount.Amount)))
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, addr, sdk.NewCoins(sdk.NewCoin(cAsset.Denom, cTokens)))
 	if err != nil {
 		return err
 	}
@@ -230,16 +239,16 @@ func (k Keeper) LendAsset(ctx sdk.Context, lenderAddr string, AssetID uint64, Am
 		AssetID:             AssetID,
 		PoolID:              PoolID,
 		Owner:               lenderAddr,
-		AmountIn:            Amount,
+		AmountIn:            sdk.NewCoin(Amount.Denom, cTokens),
 		LendingTime:         ctx.BlockTime(),
-		AvailableToBorrow:   Amount.Amount,
+		AvailableToBorrow:   cTokens,
 		AppID:               AppID,
 		GlobalIndex:         globalIndex,
 		LastInteractionTime: ctx.BlockTime(),
 		CPoolName:           pool.CPoolName,
 		TotalRewards:        sdk.ZeroInt(),
 	}
-	k.UpdateLendStats(ctx, AssetID, PoolID, Amount.Amount, true) // update global lend data in poolAssetLBMappingData
+	k.UpdateLendStats(ctx, AssetID, PoolID, cTokens, true) // update global lend data in poolAssetLBMappingData
 	k.SetUserLendIDCounter(ctx, lendPos.ID)
 	k.SetLend(ctx, lendPos)
 
@@ -312,9 +321,12 @@ func (k Keeper) WithdrawAsset(ctx sdk.Context, addr string, lendID uint64, withd
 		return sdkerrors.Wrap(types.ErrBadOfferCoinAmount, withdrawal.Denom)
 	}
 
-	availableAmount := k.ModuleBalance(ctx, pool.ModuleName, withdrawal.Denom)
+	// cTokens redeem for less than the amount lent once bad debt was
+	// socialized across the lenders of the pool
+	redeemed := sdk.NewCoin(withdrawal.Denom, k.CTokenRedeemAmount(ctx, lendPos.PoolID, lendPos.AssetID, withdrawal.Amount))
+	availableAmount := k.variableLiquidity(ctx, pool, lendPos.AssetID, withdrawal.Denom)
 
-	if withdrawal.Amount.GT(availableAmount) {
+	if redeemed.Amount.GT(availableAmount) {
 		return sdkerrors.Wrap(types.ErrLendingPoolInsufficient, withdrawal.String())
 	}
 
@@ -339,7 +351,7 @@ func (k Keeper) WithdrawAsset(ctx sdk.Context, addr string, lendID uint64, withd
 			return err
 		}
 
-		if err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, lenderAddr, sdk.NewCoins(withdrawal)); err != nil {
+		if err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, lenderAddr, sdk.NewCoins(redeemed)); err != nil {
 			return err
 		}
 		k.UpdateLendStats(ctx, lendPos.AssetID, lendPos.PoolID, withdrawal.Amount, false)
@@ -356,7 +368,7 @@ func (k Keeper) WithdrawAsset(ctx sdk.Context, addr string, lendID uint64, withd
 			return err
 		}
 
-		if err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, lenderAddr, sdk.NewCoins(withdrawal)); err != nil {
+		if err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, lenderAddr, sdk.NewCoins(redeemed)); err != nil {
 			return err
 		}
 
@@ -383,6 +395,9 @@ func (k Keeper) DepositAsset(ctx sdk.Context, addr string, lendID uint64, deposi
 	if killSwitchParams.BreakerEnable {
 		return esmtypes.ErrCircuitBreakerEnabled
 	}
+	if err = k.checkNotDelisting(ctx, lendPos.AssetID); err != nil {
+		return err
+	}
 	indexGlobalCurrent, err := k.IterateLends(ctx, lendID)
 	if err != nil {
 		return err
@@ -416,23 +431,27 @@ func (k Keeper) DepositAsset(ctx sdk.Context, addr string, lendID uint64, deposi
 	}
 	cAsset, _ := k.Asset.GetAsset(ctx, assetRatesStat.CAssetID)
 
+	cTokens, err := k.CTokenMintAmount(ctx, lendPos.PoolID, lendPos.AssetID, deposit.Amount)
+	if err != nil {
+		return err
+	}
 	if err = k.bank.SendCoinsFromAccountToModule(ctx, lenderAddr, pool.ModuleName, sdk.NewCoins(deposit)); err != nil {
 		return err
 	}
 
-	if err = k.bank.MintCoins(ctx, pool.ModuleName, sdk.NewCoins(sdk.NewCoin(cAsset.Denom, deposit.Amount))); err != nil {
+	if err = k.bank.MintCoins(ctx, pool.ModuleName, sdk.NewCoins(sdk.NewCoin(cAsset.Denom, cTokens))); err != nil {
 		return err
 	}
 
-	err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, lenderAddr, sdk.NewCoins(sdk.NewCoin(cAsset.Denom, deposit.Amount)))
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, lenderAddr, sdk.NewCoins(sdk.NewCoin(cAsset.Denom, cTokens)))
 	if err != nil {
 		return err
 	}
 
-	lendPos.AmountIn = lendPos.AmountIn.Add(deposit)
-	lendPos.AvailableToBorrow = lendPos.AvailableToBorrow.Add(deposit.Amount)
+	lendPos.AmountIn.Amount = lendPos.AmountIn.Amount.Add(cTokens)
+	lendPos.AvailableToBorrow = lendPos.AvailableToBorrow.Add(cTokens)
 
-	k.UpdateLendStats(ctx, lendPos.AssetID, lendPos.PoolID, deposit.Amount, true)
+	k.UpdateLendStats(ctx, lendPos.AssetID, lendPos.PoolID, cTokens, true)
 	k.SetLend(ctx, lendPos)
 	return nil
 }
@@ -470,9 +489,16 @@ func (k Keeper) CloseLend(ctx sdk.Context, addr string, lendID uint64) error {
 	if lendIDToBorrowIDMapping.BorrowId != nil {
 		return types.ErrBorrowingPositionOpen
 	}
-	availableAmount := k.ModuleBalance(ctx, pool.ModuleName, lendPos.AmountIn.Denom)
+	if k.IsLendAccountCollateral(ctx, lendPos) {
+		return types.ErrorLendUsedAsAccountCollateral
+	}
+	if k.IsLendFixedTermCollateral(ctx, lendID) {
+		return types.ErrorLendUsedAsFixedTermCollateral
+	}
+	redeemed := k.CTokenRedeemAmount(ctx, lendPos.PoolID, lendPos.AssetID, lendPos.AvailableToBorrow)
+	availableAmount := k.variableLiquidity(ctx, pool, lendPos.AssetID, lendPos.AmountIn.Denom)
 
-	if lendPos.AvailableToBorrow.GT(availableAmount) {
+	if redeemed.GT(availableAmount) {
 		return sdkerrors.Wrap(types.ErrLendingPoolInsufficient, lendPos.AvailableToBorrow.String())
 	}
 
@@ -491,7 +517,7 @@ func (k Keeper) CloseLend(ctx sdk.Context, addr string, lendID uint64) error {
 		return err
 	}
 
-	if err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, lenderAddr, sdk.NewCoins(sdk.NewCoin(lendPos.AmountIn.Denom, lendPos.AvailableToBorrow))); err != nil {
+	if err = k.bank.SendCoinsFromModuleToAccount(ctx, pool.ModuleName, lenderAddr, sdk.NewCoins(sdk.NewCoin(lendPos.AmountIn.Denom, redeemed))); err != nil {
 		return err
 	}
 
@@ -536,6 +562,9 @@ func (k Keeper) BorrowAsset(ctx sdk.Context, addr string, lendID, pairID uint64,
 	if !found {
 		return types.ErrorPairNotFound
 	}
+	if err := k.checkNotDelisting(ctx, pair.AssetIn, pair.AssetOut); err != nil {
+		return err
+	}
 	pairMapping, _ := k.GetAssetToPair(ctx, pair.AssetIn, lendPos.PoolID)
 	found = uint64InSlice(pairID, pairMapping.PairID)
 	if !found {
@@ -550,7 +579,7 @@ func (k Keeper) BorrowAsset(ctx sdk.Context, addr string, lendID, pairID uint64,
 	if !found {
 		return assettypes.ErrorAssetDoesNotExist
 	}
-	assetInRatesStats, found := k.GetAssetRatesParams(ctx, pair.AssetIn)
+	assetInRatesStats, found := k.GetAssetRatesParamsForPair(ctx, pair)
 	if !found {
 		return types.ErrAssetStatsNotFound
 	}
@@ -602,13 +631,15 @@ func (k Keeper) BorrowAsset(ctx sdk.Context, addr string, lendID, pairID uint64,
 		return sdkerrors.Wrap(types.ErrStableBorrowDisabled, loan.String())
 	}
 
-	err = k.VerifyCollateralizationRatio(ctx, AmountIn.Amount, assetIn, loan.Amount, assetOut, assetInRatesStats.Ltv)
+	// the cTokens are collateral for what they redeem for in the pool
+	collateral := k.CTokenRedeemAmount(ctx, lendPos.PoolID, lendPos.AssetID, AmountIn.Amount)
+	err = k.VerifyCollateralizationRatio(ctx, collateral, assetIn, loan.Amount, assetOut, assetInRatesStats.Ltv)
 	if err != nil {
 		return err
 	}
 	borrowID := k.GetUserBorrowIDCounter(ctx)
 
-	availableAmount := k.ModuleBalance(ctx, AssetOutPool.ModuleName, loan.Denom)
+	availableAmount := k.variableLiquidity(ctx, AssetOutPool, pair.AssetOut, loan.Denom)
 	// check sufficient amt in pool to borrow
 	if loan.Amount.GT(availableAmount) {
 		return sdkerrors.Wrap(types.ErrBorrowingPoolInsufficient, loan.String())
@@ -676,7 +707,7 @@ func (k Keeper) BorrowAsset(ctx sdk.Context, addr string, lendID, pairID uint64,
 		mappingData.BorrowId = append(mappingData.BorrowId, borrowPos.ID)
 		k.SetUserLendBorrowMapping(ctx, mappingData)
 	} else {
-		updatedAmtIn := AmountIn.Amount.ToDec().Mul(assetInRatesStats.Ltv)
+		updatedAmtIn := collateral.ToDec().Mul(assetInRatesStats.Ltv)
 		updatedAmtInPrice, err := k.Market.CalcAssetPrice(ctx, lendPos.AssetID, updatedAmtIn.TruncateInt())
 		if err != nil {
 			return err
@@ -1078,7 +1109,8 @@ func (k Keeper) DepositBorrowAsset(ctx sdk.Context, borrowID uint64, addr string
 		borrowPos.AmountIn = borrowPos.AmountIn.Add(AmountIn)
 		k.SetBorrow(ctx, borrowPos)
 	} else {
-		amtIn, err := k.Market.CalcAssetPrice(ctx, pair.AssetIn, (sdk.NewDecFromInt(AmountIn.Amount).Mul(assetRatesStat.Ltv)).TruncateInt())
+		collateral := k.CTokenRedeemAmount(ctx, lendPos.PoolID, lendPos.AssetID, AmountIn.Amount)
+		amtIn, err := k.Market.CalcAssetPrice(ctx, pair.AssetIn, (sdk.NewDecFromInt(collateral).Mul(assetRatesStat.Ltv)).TruncateInt())
 		if err != nil {
 			return err
 		}
@@ -1163,6 +1195,9 @@ func (k Keeper) DrawAsset(ctx sdk.Context, borrowID uint64, borrowerAddr string,
 	if !found {
 		return types.ErrorPairNotFound
 	}
+	if err := k.checkNotDelisting(ctx, pair.AssetIn, pair.AssetOut); err != nil {
+		return err
+	}
 	pool, found := k.GetPool(ctx, pair.AssetOutPoolID)
 	if !found {
 		return types.ErrPoolNotFound
@@ -1202,16 +1237,17 @@ func (k Keeper) DrawAsset(ctx sdk.Context, borrowID uint64, borrowerAddr string,
 	if !found {
 		return assettypes.ErrorAssetDoesNotExist
 	}
-	assetRatesStats, found := k.GetAssetRatesParams(ctx, pair.AssetIn)
+	assetRatesStats, found := k.GetAssetRatesParamsForPair(ctx, pair)
 	if !found {
 		return types.ErrorAssetStatsNotFound
 	}
-	assetOutModBal := k.ModuleBalance(ctx, pool.ModuleName, assetOut.Denom)
+	assetOutModBal := k.variableLiquidity(ctx, pool, pair.AssetOut, assetOut.Denom)
 
 	if amount.Amount.GT(assetOutModBal) {
 		return types.ErrInsufficientFundsInPool
 	}
-	err = k.VerifyCollateralizationRatio(ctx, borrowPos.AmountIn.Amount, assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()).Add(amount.Amount), assetOut, assetRatesStats.Ltv)
+	collateral := k.CTokenRedeemAmount(ctx, lendPos.PoolID, lendPos.AssetID, borrowPos.AmountIn.Amount)
+	err = k.VerifyCollateralizationRatio(ctx, collateral, assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()).Add(amount.Amount), assetOut, assetRatesStats.Ltv)
 	if err != nil {
 		return err
 	}
@@ -1345,6 +1381,9 @@ func (k Keeper) BorrowAlternate(ctx sdk.Context, lenderAddr string, AssetID, Poo
 	if killSwitchParams.BreakerEnable {
 		return esmtypes.ErrCircuitBreakerEnabled
 	}
+	if err := k.checkNotDelisting(ctx, AssetID); err != nil {
+		return err
+	}
 	asset, found := k.Asset.GetAsset(ctx, AssetID)
 	if !found {
 		return assettypes.ErrorAssetDoesNotExist
@@ -1605,7 +1644,8 @@ func (k Keeper) CreteNewBorrow(ctx sdk.Context, liqBorrow liquidationtypes.Locke
 
 	// Adjusting bridged asset qty after auctions
 	if !kind.BridgedAssetAmount.Amount.Equal(sdk.ZeroInt()) {
-		amtIn, _ := k.Market.CalcAssetPrice(ctx, pair.AssetIn, borrowPos.AmountIn.Amount.ToDec().Mul(assetInRatesStats.Ltv).TruncateInt())
+		collateral := k.CTokenRedeemAmount(ctx, lendPos.PoolID, lendPos.AssetID, borrowPos.AmountIn.Amount)
+		amtIn, _ := k.Market.CalcAssetPrice(ctx, pair.AssetIn, collateral.ToDec().Mul(assetInRatesStats.Ltv).TruncateInt())
 		priceFirstBridgedAsset, _ := k.Market.CalcAssetPrice(ctx, firstTransitAssetID, sdk.OneInt())
 		priceSecondBridgedAsset, _ := k.Market.CalcAssetPrice(ctx, secondTransitAssetID, sdk.OneInt())
 		firstBridgedAsset, _ := k.Asset.GetAsset(ctx, firstTransitAssetID)
@@ -9,7 +9,8 @@ import (
 func (k Keeper) GetUtilisationRatioByPoolIDAndAssetID(ctx sdk.Context, poolID, assetID uint64) (sdk.Dec, error) {
 	pool, _ := k.GetPool(ctx, poolID)
 	asset, _ := k.Asset.GetAsset(ctx, assetID)
-	moduleBalance := k.ModuleBalance(ctx, pool.ModuleName, asset.Denom)
+	// liquidity committed to fixed-term markets is not lent at variable rates
+	moduleBalance := k.variableLiquidity(ctx, pool, assetID, asset.Denom)
 	assetStats, found := k.GetAssetStatsByPoolIDAndAssetID(ctx, poolID, assetID)
 	if !found {
 		return sdk.ZeroDec(), types.ErrAssetStatsNotFound
@@ -22,58 +23,49 @@ func (k Keeper) GetUtilisationRatioByPoolIDAndAssetID(ctx sdk.Context, poolID, a
 }
 
 func (k Keeper) GetBorrowAPRByAssetID(ctx sdk.Context, poolID, assetID uint64, IsStableBorrow bool) (borrowAPY sdk.Dec, err error) {
-	assetRatesStats, found := k.GetAssetRatesParams(ctx, assetID)
-	if !found {
-		return sdk.ZeroDec(), types.ErrorAssetStatsNotFound
-	}
 	currentUtilisationRatio, err := k.GetUtilisationRatioByPoolIDAndAssetID(ctx, poolID, assetID)
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
-	// for normal borrow
-	if !IsStableBorrow {
-		if currentUtilisationRatio.LT(assetRatesStats.UOptimal) {
-			utilisationRatio := currentUtilisationRatio.Quo(assetRatesStats.UOptimal)
-			multiplicationFactor := utilisationRatio.Mul(assetRatesStats.Slope1)
-			borrowAPY = assetRatesStats.Base.Add(multiplicationFactor)
-			return borrowAPY, nil
-		}
-		utilisationNumerator := currentUtilisationRatio.Sub(assetRatesStats.UOptimal)
-		utilisationDenominator := sdk.OneDec().Sub(assetRatesStats.UOptimal)
-		utilisationRatio := utilisationNumerator.Quo(utilisationDenominator)
-		multiplicationFactor := utilisationRatio.Mul(assetRatesStats.Slope2)
-		borrowAPY = assetRatesStats.Base.Add(assetRatesStats.Slope1).Add(multiplicationFactor)
-		return borrowAPY, nil
-	} // for stable borrow
-	if currentUtilisationRatio.LT(assetRatesStats.UOptimal) {
-		utilisationRatio := currentUtilisationRatio.Quo(assetRatesStats.UOptimal)
-		multiplicationFactor := utilisationRatio.Mul(assetRatesStats.StableSlope1)
-		borrowAPY = assetRatesStats.StableBase.Add(multiplicationFactor)
-		return borrowAPY, nil
-	}
-	utilisationNumerator := currentUtilisationRatio.Sub(assetRatesStats.UOptimal)
-	utilisationDenominator := sdk.OneDec().Sub(assetRatesStats.UOptimal)
-	utilisationRatio := utilisationNumerator.Quo(utilisationDenominator)
-	multiplicationFactor := utilisationRatio.Mul(assetRatesStats.StableSlope2)
-	borrowAPY = assetRatesStats.StableBase.Add(assetRatesStats.StableSlope1).Add(multiplicationFactor)
-	return borrowAPY, nil
+	return k.GetBorrowAPRAtUtilisation(ctx, poolID, assetID, currentUtilisationRatio, IsStableBorrow)
 }
 
-func (k Keeper) GetLendAPRByAssetIDAndPoolID(ctx sdk.Context, poolID, assetID uint64) (lendAPY sdk.Dec, err error) {
+// GetBorrowAPRAtUtilisation prices the borrow rate of an asset in a pool at
+// utilisation with the interest rate model of the asset.
+func (k Keeper) GetBorrowAPRAtUtilisation(ctx sdk.Context, poolID, assetID uint64, utilisation sdk.Dec, IsStableBorrow bool) (borrowAPY sdk.Dec, err error) {
 	assetRatesStats, found := k.GetAssetRatesParams(ctx, assetID)
 	if !found {
 		return sdk.ZeroDec(), types.ErrorAssetStatsNotFound
 	}
-	borrowAPY, err := k.GetBorrowAPRByAssetID(ctx, poolID, assetID, false)
+	model, err := types.GetInterestRateModel(assetRatesStats.InterestRateModelID)
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
+	multiplier := k.GetAdaptiveRateMultiplier(ctx, poolID, assetID)
+	return model.BorrowRate(assetRatesStats, utilisation, multiplier, IsStableBorrow), nil
+}
+
+func (k Keeper) GetLendAPRByAssetIDAndPoolID(ctx sdk.Context, poolID, assetID uint64) (lendAPY sdk.Dec, err error) {
 	currentUtilisationRatio, err := k.GetUtilisationRatioByPoolIDAndAssetID(ctx, poolID, assetID)
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
+	return k.GetLendAPRAtUtilisation(ctx, poolID, assetID, currentUtilisationRatio)
+}
+
+// GetLendAPRAtUtilisation returns the rate lenders earn when the asset is
+// borrowed at utilisation, net of the reserve factor.
+func (k Keeper) GetLendAPRAtUtilisation(ctx sdk.Context, poolID, assetID uint64, utilisation sdk.Dec) (lendAPY sdk.Dec, err error) {
+	assetRatesStats, found := k.GetAssetRatesParams(ctx, assetID)
+	if !found {
+		return sdk.ZeroDec(), types.ErrorAssetStatsNotFound
+	}
+	borrowAPY, err := k.GetBorrowAPRAtUtilisation(ctx, poolID, assetID, utilisation, false)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
 	mulFactor := sdk.OneDec().Sub(assetRatesStats.ReserveFactor)
-	lendAPY = borrowAPY.Mul(currentUtilisationRatio).Mul(mulFactor)
+	lendAPY = borrowAPY.Mul(utilisation).Mul(mulFactor)
 
 	return lendAPY, nil
 }
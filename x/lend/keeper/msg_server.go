@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -48,7 +49,11 @@ func (m msgServer) Withdraw(goCtx context.Context, withdraw *types.MsgWithdraw)
 
 	lendID := withdraw.LendId
 
-	if err := m.keeper.WithdrawAsset(ctx, withdraw.Lender, lendID, withdraw.Amount); err != nil {
+	owner, err := m.keeper.actAsOwner(ctx, m.keeper.lendOwner(ctx, lendID), withdraw.Lender, types.PositionPermissionWithdrawToOwner, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.keeper.WithdrawAsset(ctx, owner, lendID, withdraw.Amount); err != nil {
 		return nil, err
 	}
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -69,7 +74,11 @@ func (m msgServer) Deposit(goCtx context.Context, deposit *types.MsgDeposit) (*t
 
 	lendID := deposit.LendId
 
-	if err := m.keeper.DepositAsset(ctx, deposit.Lender, lendID, deposit.Amount); err != nil {
+	owner, err := m.keeper.actAsOwner(ctx, m.keeper.lendOwner(ctx, lendID), deposit.Lender, types.PositionPermissionDeposit, sdk.Coins{deposit.Amount})
+	if err != nil {
+		return nil, err
+	}
+	if err := m.keeper.DepositAsset(ctx, owner, lendID, deposit.Amount); err != nil {
 		return nil, err
 	}
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -130,7 +139,11 @@ func (m msgServer) Repay(goCtx context.Context, repay *types.MsgRepay) (*types.M
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	ctx.GasMeter().ConsumeGas(types.RepayAssetGas, "RepayAssetGas")
 
-	if err := m.keeper.RepayAsset(ctx, repay.BorrowId, repay.Borrower, repay.Amount); err != nil {
+	owner, err := m.keeper.actAsOwner(ctx, m.keeper.borrowOwner(ctx, repay.BorrowId), repay.Borrower, types.PositionPermissionRepay, sdk.Coins{repay.Amount})
+	if err != nil {
+		return nil, err
+	}
+	if err := m.keeper.RepayAsset(ctx, repay.BorrowId, owner, repay.Amount); err != nil {
 		return nil, err
 	}
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -150,7 +163,12 @@ func (m msgServer) DepositBorrow(goCtx context.Context, borrow *types.MsgDeposit
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	ctx.GasMeter().ConsumeGas(types.DepositBorrowAssetGas, "DepositBorrowAssetGas")
 
-	if err := m.keeper.DepositBorrowAsset(ctx, borrow.BorrowId, borrow.Borrower, borrow.Amount); err != nil {
+	// Collateral is moved from the owner's cTokens, a manager adds none of its own.
+	owner, err := m.keeper.actAsOwner(ctx, m.keeper.borrowOwner(ctx, borrow.BorrowId), borrow.Borrower, types.PositionPermissionDeposit, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.keeper.DepositBorrowAsset(ctx, borrow.BorrowId, owner, borrow.Amount); err != nil {
 		return nil, err
 	}
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -279,3 +297,237 @@ func (m msgServer) FundReserveAccounts(goCtx context.Context, accounts *types.Ms
 
 	return &types.MsgFundReserveAccountsResponse{}, nil
 }
+
+func (m msgServer) GrantPositionManager(goCtx context.Context, grant *types.MsgGrantPositionManager) (*types.MsgGrantPositionManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m.keeper.SetPositionManagerGrant(ctx, types.PositionManagerGrant{
+		Owner:       grant.Owner,
+		Manager:     grant.Manager,
+		Permissions: grant.Permissions,
+	})
+
+	permissions := make([]string, 0, len(grant.Permissions))
+	for _, p := range grant.Permissions {
+		permissions = append(permissions, p.String())
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantManager,
+			sdk.NewAttribute(types.AttributeKeyOwner, grant.Owner),
+			sdk.NewAttribute(types.AttributeKeyManager, grant.Manager),
+			sdk.NewAttribute(types.AttributeKeyPerms, strings.Join(permissions, ",")),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+	return &types.MsgGrantPositionManagerResponse{}, nil
+}
+
+func (m msgServer) RevokePositionManager(goCtx context.Context, revoke *types.MsgRevokePositionManager) (*types.MsgRevokePositionManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(revoke.Owner)
+	if err != nil {
+		return nil, err
+	}
+	manager, err := sdk.AccAddressFromBech32(revoke.Manager)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := m.keeper.GetPositionManagerGrant(ctx, owner, manager); !found {
+		return nil, types.ErrorPositionManagerGrantNotFound
+	}
+	m.keeper.DeletePositionManagerGrant(ctx, owner, manager)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeManager,
+			sdk.NewAttribute(types.AttributeKeyOwner, revoke.Owner),
+			sdk.NewAttribute(types.AttributeKeyManager, revoke.Manager),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+	return &types.MsgRevokePositionManagerResponse{}, nil
+}
+
+func (m msgServer) DepositAccountCollateral(goCtx context.Context, deposit *types.MsgDepositAccountCollateral) (*types.MsgDepositAccountCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.GasMeter().ConsumeGas(types.AccountCollateralGas, "AccountCollateralGas")
+
+	if err := m.keeper.DepositAccountCollateral(ctx, deposit.Owner, deposit.LendId, deposit.Amount); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAccountDeposit,
+			sdk.NewAttribute(types.AttributeKeyOwner, deposit.Owner),
+			sdk.NewAttribute(types.AttributeKeyLendID, strconv.FormatUint(deposit.LendId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, deposit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+	return &types.MsgDepositAccountCollateralResponse{}, nil
+}
+
+func (m msgServer) WithdrawAccountCollateral(goCtx context.Context, withdraw *types.MsgWithdrawAccountCollateral) (*types.MsgWithdrawAccountCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.GasMeter().ConsumeGas(types.AccountCollateralGas, "AccountCollateralGas")
+
+	if err := m.keeper.WithdrawAccountCollateral(ctx, withdraw.Owner, withdraw.LendId, withdraw.Amount); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAccountWithdraw,
+			sdk.NewAttribute(types.AttributeKeyOwner, withdraw.Owner),
+			sdk.NewAttribute(types.AttributeKeyLendID, strconv.FormatUint(withdraw.LendId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmountOut, withdraw.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+	return &types.MsgWithdrawAccountCollateralResponse{}, nil
+}
+
+func (m msgServer) AccountBorrow(goCtx context.Context, borrow *types.MsgAccountBorrow) (*types.MsgAccountBorrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.GasMeter().ConsumeGas(types.AccountBorrowGas, "AccountBorrowGas")
+
+	if err := m.keeper.AccountBorrow(ctx, borrow.Owner, borrow.PoolId, borrow.AssetId, borrow.Amount); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAccountBorrow,
+			sdk.NewAttribute(types.AttributeKeyOwner, borrow.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(borrow.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAssetID, strconv.FormatUint(borrow.AssetId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmountOut, borrow.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+	return &types.MsgAccountBorrowResponse{}, nil
+}
+
+func (m msgServer) AccountRepay(goCtx context.Context, repay *types.MsgAccountRepay) (*types.MsgAccountRepayResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.GasMeter().ConsumeGas(types.AccountRepayGas, "AccountRepayGas")
+
+	repaid, err := m.keeper.AccountRepay(ctx, repay.Owner, repay.PoolId, repay.Amount)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAccountRepay,
+			sdk.NewAttribute(types.AttributeKeyOwner, repay.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(repay.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, repaid.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+	return &types.MsgAccountRepayResponse{}, nil
+}
+
+func (m msgServer) LiquidateAccount(goCtx context.Context, liquidate *types.MsgLiquidateAccount) (*types.MsgLiquidateAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.GasMeter().ConsumeGas(types.LiquidateAccountGas, "LiquidateAccountGas")
+
+	repaid, err := m.keeper.LiquidateAccount(ctx, liquidate.Liquidator, liquidate.Owner, liquidate.PoolId, liquidate.Amount)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAccountLiq,
+			sdk.NewAttribute(types.AttributeKeyLiquidator, liquidate.Liquidator),
+			sdk.NewAttribute(types.AttributeKeyOwner, liquidate.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(liquidate.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, repaid.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+	return &types.MsgLiquidateAccountResponse{}, nil
+}
+
+func (m msgServer) FixedTermCommit(goCtx context.Context, commit *types.MsgFixedTermCommit) (*types.MsgFixedTermCommitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.GasMeter().ConsumeGas(types.FixedTermCommitGas, "FixedTermCommitGas")
+
+	depositID, err := m.keeper.FixedTermCommit(ctx, commit.Lender, commit.MarketId, commit.Amount)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFixedCommit,
+			sdk.NewAttribute(types.AttributeKeyCreator, commit.Lender),
+			sdk.NewAttribute(types.AttributeKeyMarketID, strconv.FormatUint(commit.MarketId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositID, strconv.FormatUint(depositID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, commit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+	return &types.MsgFixedTermCommitResponse{}, nil
+}
+
+func (m msgServer) FixedTermRedeem(goCtx context.Context, redeem *types.MsgFixedTermRedeem) (*types.MsgFixedTermRedeemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.GasMeter().ConsumeGas(types.FixedTermRedeemGas, "FixedTermRedeemGas")
+
+	redeemed, err := m.keeper.FixedTermRedeem(ctx, redeem.Lender, redeem.DepositId)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFixedRedeem,
+			sdk.NewAttribute(types.AttributeKeyCreator, redeem.Lender),
+			sdk.NewAttribute(types.AttributeKeyDepositID, strconv.FormatUint(redeem.DepositId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmountOut, redeemed.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+	return &types.MsgFixedTermRedeemResponse{}, nil
+}
+
+func (m msgServer) FixedTermBorrow(goCtx context.Context, borrow *types.MsgFixedTermBorrow) (*types.MsgFixedTermBorrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.GasMeter().ConsumeGas(types.FixedTermBorrowGas, "FixedTermBorrowGas")
+
+	loanID, err := m.keeper.FixedTermBorrow(ctx, borrow.Borrower, borrow.MarketId, borrow.LendId, borrow.PairId, borrow.AmountIn, borrow.AmountOut)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFixedBorrow,
+			sdk.NewAttribute(types.AttributeKeyCreator, borrow.Borrower),
+			sdk.NewAttribute(types.AttributeKeyMarketID, strconv.FormatUint(borrow.MarketId, 10)),
+			sdk.NewAttribute(types.AttributeKeyLoanID, strconv.FormatUint(loanID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, borrow.AmountIn.String()),
+			sdk.NewAttribute(types.AttributeKeyAmountOut, borrow.AmountOut.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+	return &types.MsgFixedTermBorrowResponse{}, nil
+}
+
+func (m msgServer) FixedTermRepay(goCtx context.Context, repay *types.MsgFixedTermRepay) (*types.MsgFixedTermRepayResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.GasMeter().ConsumeGas(types.FixedTermRepayGas, "FixedTermRepayGas")
+
+	paid, err := m.keeper.FixedTermRepay(ctx, repay.Borrower, repay.LoanId)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFixedRepay,
+			sdk.NewAttribute(types.AttributeKeyCreator, repay.Borrower),
+			sdk.NewAttribute(types.AttributeKeyLoanID, strconv.FormatUint(repay.LoanId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, paid.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+	return &types.MsgFixedTermRepayResponse{}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	"time"
 
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
 	"github.com/comdex-official/comdex/x/lend/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -2088,3 +2089,664 @@ func (s *KeeperTestSuite) TestMsgCalculateInterestAndRewards() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgPositionManager() {
+	owner := s.addr(1)
+	manager := s.addr(2)
+
+	assetOneID := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	assetTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+	assetThreeID := s.CreateNewAsset("ASSETTHREE", "uasset3", 2000000)
+	cAssetOneID := s.CreateNewAsset("CASSETONE", "ucasset1", 1000000)
+	cAssetTwoID := s.CreateNewAsset("CASSETTWO", "ucasset2", 2000000)
+	cAssetThreeID := s.CreateNewAsset("CASSETTHRE", "ucasset3", 2000000)
+
+	assetDataPoolOne := []*types.AssetDataPoolMapping{
+		{AssetID: assetOneID, AssetTransitType: 3, SupplyCap: sdk.NewDec(5000000000000000000)},
+		{AssetID: assetTwoID, AssetTransitType: 1, SupplyCap: sdk.NewDec(1000000000000000000)},
+		{AssetID: assetThreeID, AssetTransitType: 2, SupplyCap: sdk.NewDec(5000000000000000000)},
+	}
+	poolOneID := s.CreateNewPool("cmdx", "CMDX-ATOM-CMST", assetDataPoolOne)
+
+	s.AddAssetRatesStats(assetThreeID, newDec("0.8"), newDec("0.002"), newDec("0.06"), newDec("0.6"), true, newDec("0.04"), newDec("0.04"), newDec("0.06"), newDec("0.8"), newDec("0.85"), newDec("0.025"), newDec("0.025"), newDec("0.1"), cAssetThreeID)
+	s.AddAssetRatesStats(assetOneID, newDec("0.75"), newDec("0.002"), newDec("0.07"), newDec("1.25"), false, newDec("0.0"), newDec("0.0"), newDec("0.0"), newDec("0.7"), newDec("0.75"), newDec("0.05"), newDec("0.05"), newDec("0.2"), cAssetOneID)
+	s.AddAssetRatesStats(assetTwoID, newDec("0.5"), newDec("0.002"), newDec("0.08"), newDec("2.0"), false, newDec("0.0"), newDec("0.0"), newDec("0.0"), newDec("0.5"), newDec("0.55"), newDec("0.05"), newDec("0.05"), newDec("0.2"), cAssetTwoID)
+
+	pairID := s.AddExtendedLendPair(assetOneID, assetTwoID, false, poolOneID, 1000000)
+	s.AddAssetToPair(assetOneID, poolOneID, []uint64{pairID})
+
+	appOneID := s.CreateNewApp("commodo", "cmmdo")
+
+	s.fundAddr(owner, sdk.NewCoins(sdk.NewCoin("uasset1", newInt(1000))))
+	_, err := s.msgServer.Lend(sdk.WrapSDKContext(s.ctx), types.NewMsgLend(owner.String(), assetOneID, sdk.NewCoin("uasset1", newInt(1000)), poolOneID, appOneID))
+	s.Require().NoError(err)
+
+	s.fundAddr(manager, sdk.NewCoins(sdk.NewCoin("uasset1", newInt(500))))
+	deposit := types.NewMsgDeposit(manager.String(), 1, sdk.NewCoin("uasset1", newInt(500)))
+	withdraw := types.NewMsgWithdraw(manager.String(), 1, sdk.NewCoin("uasset1", newInt(300)))
+
+	// Without a grant the manager cannot touch the position.
+	_, err = s.msgServer.Deposit(sdk.WrapSDKContext(s.ctx), deposit)
+	s.Require().ErrorIs(err, types.ErrLendAccessUnauthorized)
+
+	_, err = s.msgServer.GrantPositionManager(sdk.WrapSDKContext(s.ctx), types.NewMsgGrantPositionManager(
+		owner.String(), manager.String(), []types.PositionPermission{types.PositionPermissionDeposit},
+	))
+	s.Require().NoError(err)
+
+	// The deposit is paid by the manager and the cTokens are minted to the owner.
+	_, err = s.msgServer.Deposit(sdk.WrapSDKContext(s.ctx), deposit)
+	s.Require().NoError(err)
+	s.Require().True(s.getBalance(manager, "uasset1").Amount.IsZero())
+	s.Require().Equal(newInt(1500), s.getBalance(owner, "ucasset1").Amount)
+	lend, found := s.app.LendKeeper.GetLend(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(owner.String(), lend.Owner)
+	s.Require().Equal(newInt(1500), lend.AmountIn.Amount)
+
+	_, err = s.msgServer.Withdraw(sdk.WrapSDKContext(s.ctx), withdraw)
+	s.Require().ErrorIs(err, types.ErrLendAccessUnauthorized)
+
+	_, err = s.msgServer.GrantPositionManager(sdk.WrapSDKContext(s.ctx), types.NewMsgGrantPositionManager(
+		owner.String(), manager.String(), []types.PositionPermission{types.PositionPermissionWithdrawToOwner},
+	))
+	s.Require().NoError(err)
+
+	// Withdrawn assets go to the owner, never to the manager.
+	_, err = s.msgServer.Withdraw(sdk.WrapSDKContext(s.ctx), withdraw)
+	s.Require().NoError(err)
+	s.Require().Equal(newInt(300), s.getBalance(owner, "uasset1").Amount)
+	s.Require().Equal(newInt(1200), s.getBalance(owner, "ucasset1").Amount)
+	s.Require().True(s.getBalance(manager, "uasset1").Amount.IsZero())
+
+	res, err := s.querier.QueryPositionManagerGrants(sdk.WrapSDKContext(s.ctx), &types.QueryPositionManagerGrantsRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Grants, 1)
+	s.Require().Equal([]types.PositionPermission{types.PositionPermissionWithdrawToOwner}, res.Grants[0].Permissions)
+
+	_, err = s.msgServer.RevokePositionManager(sdk.WrapSDKContext(s.ctx), types.NewMsgRevokePositionManager(owner.String(), manager.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.RevokePositionManager(sdk.WrapSDKContext(s.ctx), types.NewMsgRevokePositionManager(owner.String(), manager.String()))
+	s.Require().ErrorIs(err, types.ErrorPositionManagerGrantNotFound)
+	_, err = s.msgServer.Withdraw(sdk.WrapSDKContext(s.ctx), withdraw)
+	s.Require().ErrorIs(err, types.ErrLendAccessUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestEModeBorrow() {
+	owner := s.addr(1)
+
+	assetOneID := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	assetTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+	assetThreeID := s.CreateNewAsset("ASSETTHREE", "uasset3", 2000000)
+	cAssetOneID := s.CreateNewAsset("CASSETONE", "ucasset1", 1000000)
+	cAssetTwoID := s.CreateNewAsset("CASSETTWO", "ucasset2", 2000000)
+	cAssetThreeID := s.CreateNewAsset("CASSETTHRE", "ucasset3", 2000000)
+
+	assetDataPoolOne := []*types.AssetDataPoolMapping{
+		{AssetID: assetOneID, AssetTransitType: 3, SupplyCap: sdk.NewDec(5000000000000000000)},
+		{AssetID: assetTwoID, AssetTransitType: 1, SupplyCap: sdk.NewDec(1000000000000000000)},
+		{AssetID: assetThreeID, AssetTransitType: 2, SupplyCap: sdk.NewDec(5000000000000000000)},
+	}
+	poolOneID := s.CreateNewPool("cmdx", "CMDX-ATOM-CMST", assetDataPoolOne)
+
+	s.AddAssetRatesStats(assetThreeID, newDec("0.8"), newDec("0.002"), newDec("0.06"), newDec("0.6"), true, newDec("0.04"), newDec("0.04"), newDec("0.06"), newDec("0.8"), newDec("0.85"), newDec("0.025"), newDec("0.025"), newDec("0.1"), cAssetThreeID)
+	s.AddAssetRatesStats(assetOneID, newDec("0.75"), newDec("0.002"), newDec("0.07"), newDec("1.25"), false, newDec("0.0"), newDec("0.0"), newDec("0.0"), newDec("0.7"), newDec("0.75"), newDec("0.05"), newDec("0.05"), newDec("0.2"), cAssetOneID)
+	s.AddAssetRatesStats(assetTwoID, newDec("0.5"), newDec("0.002"), newDec("0.08"), newDec("2.0"), false, newDec("0.0"), newDec("0.0"), newDec("0.0"), newDec("0.5"), newDec("0.55"), newDec("0.05"), newDec("0.05"), newDec("0.2"), cAssetTwoID)
+
+	pairID := s.AddExtendedLendPair(assetOneID, assetTwoID, false, poolOneID, 1000000)
+	s.AddAssetToPair(assetOneID, poolOneID, []uint64{pairID})
+
+	appOneID := s.CreateNewApp("commodo", "cmmdo")
+
+	s.fundAddr(owner, sdk.NewCoins(sdk.NewCoin("uasset1", newInt(1000)), sdk.NewCoin("uasset2", newInt(10000000000))))
+	_, err := s.msgServer.Lend(sdk.WrapSDKContext(s.ctx), types.NewMsgLend(owner.String(), assetOneID, sdk.NewCoin("uasset1", newInt(1000)), poolOneID, appOneID))
+	s.Require().NoError(err)
+	_, err = s.msgServer.FundModuleAccounts(sdk.WrapSDKContext(s.ctx), types.NewMsgFundModuleAccounts(poolOneID, assetTwoID, owner.String(), sdk.NewCoin("uasset2", newInt(10000000000))))
+	s.Require().NoError(err)
+
+	borrow := types.NewMsgBorrow(owner.String(), 1, pairID, false, sdk.NewCoin("ucasset1", newInt(100)), sdk.NewCoin("uasset2", newInt(80)))
+
+	// A cr of 0.8 is above the 0.7 ltv of the collateral.
+	_, err = s.msgServer.Borrow(sdk.WrapSDKContext(s.ctx), borrow)
+	s.Require().ErrorIs(err, types.ErrorInvalidCollateralizationRatio)
+
+	category := types.EModeCategory{
+		Name:                 "correlated",
+		AssetIDs:             []uint64{assetOneID, assetTwoID},
+		Ltv:                  newDec("0.6"),
+		LiquidationThreshold: newDec("0.95"),
+	}
+	// The category cannot lower the ltv of its members.
+	s.Require().ErrorIs(s.app.LendKeeper.SetEModeCategory(s.ctx, category), types.ErrorInvalidEModeCategory)
+
+	category.Ltv = newDec("0.9")
+	s.Require().NoError(s.app.LendKeeper.SetEModeCategory(s.ctx, category))
+
+	// An asset can only be part of one category.
+	other := types.EModeCategory{
+		Name:                 "other",
+		AssetIDs:             []uint64{assetTwoID, assetThreeID},
+		Ltv:                  newDec("0.9"),
+		LiquidationThreshold: newDec("0.95"),
+	}
+	s.Require().ErrorIs(s.app.LendKeeper.SetEModeCategory(s.ctx, other), types.ErrorInvalidEModeCategory)
+
+	params, found := s.app.LendKeeper.GetAssetRatesParamsForPair(s.ctx, types.Extended_Pair{AssetIn: assetOneID, AssetOut: assetTwoID})
+	s.Require().True(found)
+	s.Require().Equal(newDec("0.9"), params.Ltv)
+	s.Require().Equal(newDec("0.95"), params.LiquidationThreshold)
+	params, found = s.app.LendKeeper.GetAssetRatesParamsForPair(s.ctx, types.Extended_Pair{AssetIn: assetOneID, AssetOut: assetThreeID})
+	s.Require().True(found)
+	s.Require().Equal(newDec("0.7"), params.Ltv)
+	params, found = s.app.LendKeeper.GetAssetRatesParamsForPair(s.ctx, types.Extended_Pair{AssetIn: assetOneID, AssetOut: assetTwoID, IsInterPool: true})
+	s.Require().True(found)
+	s.Require().Equal(newDec("0.7"), params.Ltv)
+
+	// Raising the asset's rates above the category keeps the asset's rates.
+	assetOneParams, found := s.app.LendKeeper.GetAssetRatesParams(s.ctx, assetOneID)
+	s.Require().True(found)
+	assetOneParams.Ltv = newDec("0.92")
+	s.app.LendKeeper.SetAssetRatesParams(s.ctx, assetOneParams)
+	params, found = s.app.LendKeeper.GetAssetRatesParamsForPair(s.ctx, types.Extended_Pair{AssetIn: assetOneID, AssetOut: assetTwoID})
+	s.Require().True(found)
+	s.Require().Equal(newDec("0.92"), params.Ltv)
+	s.Require().Equal(newDec("0.95"), params.LiquidationThreshold)
+	assetOneParams.Ltv = newDec("0.7")
+	s.app.LendKeeper.SetAssetRatesParams(s.ctx, assetOneParams)
+
+	_, err = s.msgServer.Borrow(sdk.WrapSDKContext(s.ctx), borrow)
+	s.Require().NoError(err)
+
+	res, err := s.querier.QueryPair(sdk.WrapSDKContext(s.ctx), &types.QueryPairRequest{Id: pairID})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.EModeCategory)
+	s.Require().Equal(uint64(1), res.EModeCategory.ID)
+}
+
+func (s *KeeperTestSuite) TestAdaptiveInterestRate() {
+	owner := s.addr(1)
+
+	assetOneID := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	assetTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+	assetThreeID := s.CreateNewAsset("ASSETTHREE", "uasset3", 2000000)
+	cAssetTwoID := s.CreateNewAsset("CASSETTWO", "ucasset2", 2000000)
+
+	assetDataPoolOne := []*types.AssetDataPoolMapping{
+		{AssetID: assetOneID, AssetTransitType: 3, SupplyCap: sdk.NewDec(5000000000000000000)},
+		{AssetID: assetTwoID, AssetTransitType: 1, SupplyCap: sdk.NewDec(1000000000000000000)},
+		{AssetID: assetThreeID, AssetTransitType: 2, SupplyCap: sdk.NewDec(5000000000000000000)},
+	}
+	poolOneID := s.CreateNewPool("cmdx", "CMDX-ATOM-CMST", assetDataPoolOne)
+	s.AddAssetRatesStats(assetTwoID, newDec("0.8"), newDec("0.02"), newDec("0.1"), newDec("1"), true, newDec("0.05"), newDec("0.1"), newDec("1"), newDec("0.5"), newDec("0.55"), newDec("0.05"), newDec("0.05"), newDec("0.2"), cAssetTwoID)
+
+	params, found := s.app.LendKeeper.GetAssetRatesParams(s.ctx, assetTwoID)
+	s.Require().True(found)
+	params.InterestRateModelID = types.InterestRateModelAdaptive
+	params.AdjustmentSpeed = newDec("2")
+	s.Require().NoError(params.Validate())
+	s.app.LendKeeper.SetAssetRatesParams(s.ctx, params)
+
+	// 900 borrowed out of 1000 supplied puts the pool at 90% utilisation.
+	s.fundAddr(owner, sdk.NewCoins(sdk.NewCoin("uasset2", newInt(100))))
+	_, err := s.msgServer.FundModuleAccounts(sdk.WrapSDKContext(s.ctx), types.NewMsgFundModuleAccounts(poolOneID, assetTwoID, owner.String(), sdk.NewCoin("uasset2", newInt(100))))
+	s.Require().NoError(err)
+	stats, found := s.app.LendKeeper.GetAssetStatsByPoolIDAndAssetID(s.ctx, poolOneID, assetTwoID)
+	s.Require().True(found)
+	stats.TotalBorrowed = newInt(900)
+	s.app.LendKeeper.SetAssetStatsByPoolIDAndAssetID(s.ctx, stats)
+
+	kinkedRate, err := s.app.LendKeeper.GetBorrowAPRByAssetID(s.ctx, poolOneID, assetTwoID, false)
+	s.Require().NoError(err)
+	s.Require().Equal(newDec("0.62"), kinkedRate)
+
+	s.app.LendKeeper.UpdateAdaptiveRates(s.ctx)
+	s.Require().Equal(sdk.OneDec(), s.app.LendKeeper.GetAdaptiveRateMultiplier(s.ctx, poolOneID, assetTwoID))
+
+	// Utilisation above u_optimal raises the curve over time.
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Duration(types.SecondsPerYear/4) * time.Second))
+	s.app.LendKeeper.UpdateAdaptiveRates(s.ctx)
+	multiplier := s.app.LendKeeper.GetAdaptiveRateMultiplier(s.ctx, poolOneID, assetTwoID)
+	s.Require().Equal(newDec("1.25"), multiplier)
+
+	adaptiveRate, err := s.app.LendKeeper.GetBorrowAPRByAssetID(s.ctx, poolOneID, assetTwoID, false)
+	s.Require().NoError(err)
+	s.Require().Equal(kinkedRate.Mul(multiplier), adaptiveRate)
+
+	res, err := s.querier.QueryInterestRateProjection(sdk.WrapSDKContext(s.ctx), &types.QueryInterestRateProjectionRequest{
+		PoolId:      poolOneID,
+		AssetId:     assetTwoID,
+		Utilisation: newDec("0.4"),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(types.InterestRateModelAdaptive, res.InterestRateModelID)
+	s.Require().Equal(newDec("0.9"), res.Current.Utilisation)
+	s.Require().Equal(adaptiveRate, res.Current.BorrowApr)
+	s.Require().Equal(newDec("0.07").Mul(multiplier), res.Projected.BorrowApr)
+	s.Require().Equal(newDec("0.07").Mul(multiplier).Mul(newDec("0.4")).Mul(newDec("0.8")), res.Projected.LendApr)
+
+	// Switching back to the kinked model drops the curve.
+	params.InterestRateModelID = types.InterestRateModelKinked
+	s.app.LendKeeper.SetAssetRatesParams(s.ctx, params)
+	s.app.LendKeeper.UpdateAdaptiveRates(s.ctx)
+	_, found = s.app.LendKeeper.GetAdaptiveRateState(s.ctx, poolOneID, assetTwoID)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestSocializeBadDebt() {
+	owner := s.addr(1)
+
+	assetOneID := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	assetTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+	assetThreeID := s.CreateNewAsset("ASSETTHREE", "uasset3", 2000000)
+	cAssetTwoID := s.CreateNewAsset("CASSETTWO", "ucasset2", 2000000)
+
+	assetDataPoolOne := []*types.AssetDataPoolMapping{
+		{AssetID: assetOneID, AssetTransitType: 3, SupplyCap: sdk.NewDec(5000000000000000000)},
+		{AssetID: assetTwoID, AssetTransitType: 1, SupplyCap: sdk.NewDec(1000000000000000000)},
+		{AssetID: assetThreeID, AssetTransitType: 2, SupplyCap: sdk.NewDec(5000000000000000000)},
+	}
+	poolOneID := s.CreateNewPool("cmdx", "CMDX-ATOM-CMST", assetDataPoolOne)
+	s.AddAssetRatesStats(assetTwoID, newDec("0.8"), newDec("0.02"), newDec("0.1"), newDec("1"), true, newDec("0.05"), newDec("0.1"), newDec("1"), newDec("0.5"), newDec("0.55"), newDec("0.05"), newDec("0.05"), newDec("0.2"), cAssetTwoID)
+	appOneID := s.CreateNewApp("commodo", "cmmdo")
+
+	s.Require().Equal(types.DefaultBadDebtWaterfall(), s.app.LendKeeper.GetBadDebtWaterfall(s.ctx))
+	s.Require().Error((&types.BadDebtWaterfall{Steps: []uint64{types.BadDebtCoverageReserve, types.BadDebtCoverageReserve}}).Validate())
+
+	s.fundAddr(owner, sdk.NewCoins(sdk.NewCoin("uasset2", newInt(1000))))
+	_, err := s.msgServer.Lend(sdk.WrapSDKContext(s.ctx), types.NewMsgLend(owner.String(), assetTwoID, sdk.NewCoin("uasset2", newInt(1000)), poolOneID, appOneID))
+	s.Require().NoError(err)
+
+	// A quarter of what the lenders can redeem is lost to bad debt.
+	socialized := s.app.LendKeeper.SocializeBadDebt(s.ctx, poolOneID, assetTwoID, newInt(250))
+	s.Require().Equal(newInt(250), socialized)
+	s.Require().Equal(newDec("0.75"), s.app.LendKeeper.GetCTokenExchangeRate(s.ctx, poolOneID, assetTwoID))
+
+	// Socializing is capped by what is left to redeem.
+	socialized = s.app.LendKeeper.SocializeBadDebt(s.ctx, poolOneID, assetTwoID, newInt(5000))
+	s.Require().Equal(newInt(750), socialized)
+	s.Require().True(s.app.LendKeeper.GetCTokenExchangeRate(s.ctx, poolOneID, assetTwoID).IsZero())
+
+	s.app.LendKeeper.SetCTokenExchangeRate(s.ctx, types.CTokenExchangeRate{PoolID: poolOneID, AssetID: assetTwoID, Rate: newDec("0.75")})
+	_, err = s.msgServer.Withdraw(sdk.WrapSDKContext(s.ctx), types.NewMsgWithdraw(owner.String(), 1, sdk.NewCoin("uasset2", newInt(400))))
+	s.Require().NoError(err)
+	s.Require().Equal(newInt(300), s.getBalance(owner, "uasset2").Amount)
+	s.Require().Equal(newInt(600), s.getBalance(owner, "ucasset2").Amount)
+
+	// Deposits after the haircut mint cTokens at the exchange rate, so they
+	// redeem for what was deposited.
+	_, err = s.msgServer.Deposit(sdk.WrapSDKContext(s.ctx), types.NewMsgDeposit(owner.String(), 1, sdk.NewCoin("uasset2", newInt(300))))
+	s.Require().NoError(err)
+	s.Require().Equal(newInt(1000), s.getBalance(owner, "ucasset2").Amount)
+	lend, found := s.app.LendKeeper.GetLend(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(newInt(1000), lend.AvailableToBorrow)
+	s.Require().Equal(newInt(750), s.app.LendKeeper.CTokenCollateral(s.ctx, 1, lend.AvailableToBorrow))
+
+	// Nothing is deposited once the cTokens were written off.
+	s.app.LendKeeper.SetCTokenExchangeRate(s.ctx, types.CTokenExchangeRate{PoolID: poolOneID, AssetID: assetTwoID, Rate: sdk.ZeroDec()})
+	s.fundAddr(owner, sdk.NewCoins(sdk.NewCoin("uasset2", newInt(100))))
+	_, err = s.msgServer.Deposit(sdk.WrapSDKContext(s.ctx), types.NewMsgDeposit(owner.String(), 1, sdk.NewCoin("uasset2", newInt(100))))
+	s.Require().ErrorIs(err, types.ErrorCTokenWrittenOff)
+}
+
+func (s *KeeperTestSuite) TestCrossCollateralAccount() {
+	owner := s.addr(1)
+	supplier := s.addr(2)
+	liquidator := s.addr(3)
+
+	assetOneID := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	assetTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+	assetThreeID := s.CreateNewAsset("ASSETTHREE", "uasset3", 2000000)
+	cAssetOneID := s.CreateNewAsset("CASSETONE", "ucasset1", 1000000)
+	cAssetTwoID := s.CreateNewAsset("CASSETTWO", "ucasset2", 2000000)
+	cAssetThreeID := s.CreateNewAsset("CASSETTRE", "ucasset3", 2000000)
+
+	assetDataPoolOne := []*types.AssetDataPoolMapping{
+		{AssetID: assetOneID, AssetTransitType: 3, SupplyCap: sdk.NewDec(5000000000000000000)},
+		{AssetID: assetTwoID, AssetTransitType: 1, SupplyCap: sdk.NewDec(1000000000000000000)},
+		{AssetID: assetThreeID, AssetTransitType: 2, SupplyCap: sdk.NewDec(5000000000000000000)},
+	}
+	poolOneID := s.CreateNewPool("cmdx", "CMDX-ATOM-CMST", assetDataPoolOne)
+	s.AddAssetRatesStats(assetOneID, newDec("0.75"), newDec("0.002"), newDec("0.07"), newDec("1.25"), false, newDec("0.0"), newDec("0.0"), newDec("0.0"), newDec("0.6"), newDec("0.65"), newDec("0.05"), newDec("0.05"), newDec("0.2"), cAssetOneID)
+	s.AddAssetRatesStats(assetTwoID, newDec("0.8"), newDec("0.002"), newDec("0.06"), newDec("0.6"), true, newDec("0.04"), newDec("0.04"), newDec("0.06"), newDec("0.5"), newDec("0.55"), newDec("0.05"), newDec("0.05"), newDec("0.2"), cAssetTwoID)
+	s.AddAssetRatesStats(assetThreeID, newDec("0.8"), newDec("0.002"), newDec("0.06"), newDec("0.6"), true, newDec("0.04"), newDec("0.04"), newDec("0.06"), newDec("0.8"), newDec("0.85"), newDec("0.025"), newDec("0.025"), newDec("0.1"), cAssetThreeID)
+	appOneID := s.CreateNewApp("commodo", "cmmdo")
+
+	s.fundAddr(owner, sdk.NewCoins(sdk.NewCoin("uasset1", newInt(10000000000)), sdk.NewCoin("uasset2", newInt(10000000000))))
+	s.fundAddr(supplier, sdk.NewCoins(sdk.NewCoin("uasset3", newInt(20000000000))))
+	s.fundAddr(liquidator, sdk.NewCoins(sdk.NewCoin("uasset3", newInt(10000000000))))
+
+	_, err := s.msgServer.Lend(sdk.WrapSDKContext(s.ctx), types.NewMsgLend(owner.String(), assetOneID, sdk.NewCoin("uasset1", newInt(10000000000)), poolOneID, appOneID))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Lend(sdk.WrapSDKContext(s.ctx), types.NewMsgLend(owner.String(), assetTwoID, sdk.NewCoin("uasset2", newInt(10000000000)), poolOneID, appOneID))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Lend(sdk.WrapSDKContext(s.ctx), types.NewMsgLend(supplier.String(), assetThreeID, sdk.NewCoin("uasset3", newInt(20000000000)), poolOneID, appOneID))
+	s.Require().NoError(err)
+
+	// Borrowing needs collateral in the account.
+	_, err = s.msgServer.AccountBorrow(sdk.WrapSDKContext(s.ctx), types.NewMsgAccountBorrow(owner.String(), poolOneID, assetThreeID, sdk.NewCoin("uasset3", newInt(1000000000))))
+	s.Require().ErrorIs(err, types.ErrorAccountNotFound)
+
+	_, err = s.msgServer.DepositAccountCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgDepositAccountCollateral(owner.String(), 1, sdk.NewCoin("ucasset1", newInt(10000000000))))
+	s.Require().NoError(err)
+	_, err = s.msgServer.DepositAccountCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgDepositAccountCollateral(owner.String(), 2, sdk.NewCoin("ucasset2", newInt(10000000000))))
+	s.Require().NoError(err)
+	lendPos, _ := s.app.LendKeeper.GetLend(s.ctx, 1)
+	s.Require().True(lendPos.AvailableToBorrow.IsZero())
+
+	// The borrow limit combines both positions, 0.6 + 0.5 of the lent value.
+	_, err = s.msgServer.AccountBorrow(sdk.WrapSDKContext(s.ctx), types.NewMsgAccountBorrow(owner.String(), poolOneID, assetThreeID, sdk.NewCoin("uasset3", newInt(11500000000))))
+	s.Require().ErrorIs(err, types.ErrorAccountUnhealthy)
+	_, err = s.msgServer.AccountBorrow(sdk.WrapSDKContext(s.ctx), types.NewMsgAccountBorrow(owner.String(), poolOneID, assetThreeID, sdk.NewCoin("uasset3", newInt(10000000000))))
+	s.Require().NoError(err)
+	s.Require().Equal(newInt(10000000000), s.getBalance(owner, "uasset3").Amount)
+
+	res, err := s.querier.QueryCrossCollateralAccount(sdk.WrapSDKContext(s.ctx), &types.QueryCrossCollateralAccountRequest{Owner: owner.String(), PoolId: poolOneID})
+	s.Require().NoError(err)
+	s.Require().Len(res.Account.Collateral, 2)
+	s.Require().Len(res.Account.Debts, 1)
+	s.Require().Equal(newDec("1.2"), res.Health.HealthFactor)
+
+	// Collateral backing the debt can be neither withdrawn nor closed.
+	_, err = s.msgServer.WithdrawAccountCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgWithdrawAccountCollateral(owner.String(), 1, sdk.NewCoin("ucasset1", newInt(10000000000))))
+	s.Require().ErrorIs(err, types.ErrorAccountUnhealthy)
+	s.Require().ErrorIs(s.app.LendKeeper.CloseLend(s.ctx, owner.String(), 1), types.ErrorLendUsedAsAccountCollateral)
+
+	// Healthy accounts cannot be liquidated.
+	_, err = s.msgServer.LiquidateAccount(sdk.WrapSDKContext(s.ctx), types.NewMsgLiquidateAccount(liquidator.String(), owner.String(), poolOneID, sdk.NewCoin("uasset3", newInt(10000000000))))
+	s.Require().ErrorIs(err, types.ErrorAccountHealthy)
+
+	// Lowering the liquidation thresholds takes the health factor below one.
+	for _, assetID := range []uint64{assetOneID, assetTwoID} {
+		assetRatesParams, _ := s.app.LendKeeper.GetAssetRatesParams(s.ctx, assetID)
+		assetRatesParams.LiquidationThreshold = newDec("0.3")
+		s.app.LendKeeper.SetAssetRatesParams(s.ctx, assetRatesParams)
+	}
+
+	// The liquidator repays the close factor of the debt and is paid its
+	// value plus the bonus out of the first collateral of highest value.
+	_, err = s.msgServer.LiquidateAccount(sdk.WrapSDKContext(s.ctx), types.NewMsgLiquidateAccount(liquidator.String(), owner.String(), poolOneID, sdk.NewCoin("uasset3", newInt(10000000000))))
+	s.Require().NoError(err)
+	s.Require().Equal(newInt(5000000000), s.getBalance(liquidator, "uasset3").Amount)
+	s.Require().Equal(newInt(5250000000), s.getBalance(liquidator, "uasset1").Amount)
+	lendPos, _ = s.app.LendKeeper.GetLend(s.ctx, 1)
+	s.Require().Equal(newInt(4750000000), lendPos.AmountIn.Amount)
+
+	// Repaying the rest of the debt frees the collateral.
+	_, err = s.msgServer.AccountRepay(sdk.WrapSDKContext(s.ctx), types.NewMsgAccountRepay(owner.String(), poolOneID, sdk.NewCoin("uasset3", newInt(5000000000))))
+	s.Require().NoError(err)
+	_, err = s.msgServer.WithdrawAccountCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgWithdrawAccountCollateral(owner.String(), 1, sdk.NewCoin("ucasset1", newInt(4750000000))))
+	s.Require().NoError(err)
+	_, err = s.msgServer.WithdrawAccountCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgWithdrawAccountCollateral(owner.String(), 2, sdk.NewCoin("ucasset2", newInt(10000000000))))
+	s.Require().NoError(err)
+	_, found := s.app.LendKeeper.GetCrossCollateralAccount(s.ctx, owner, poolOneID)
+	s.Require().False(found)
+	s.Require().Equal(newInt(4750000000), s.getBalance(owner, "ucasset1").Amount)
+
+	assetStats, _ := s.app.LendKeeper.GetAssetStatsByPoolIDAndAssetID(s.ctx, poolOneID, assetThreeID)
+	s.Require().True(assetStats.TotalBorrowed.IsZero())
+}
+
+func (s *KeeperTestSuite) TestFixedTermLoans() {
+	owner := s.addr(1)
+	lender := s.addr(2)
+	supplier := s.addr(3)
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:00Z"))
+	year := time.Duration(types.SecondsPerYear) * time.Second
+
+	assetOneID := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	assetTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+	assetThreeID := s.CreateNewAsset("ASSETTHREE", "uasset3", 2000000)
+	cAssetOneID := s.CreateNewAsset("CASSETONE", "ucasset1", 1000000)
+	cAssetTwoID := s.CreateNewAsset("CASSETTWO", "ucasset2", 2000000)
+	cAssetThreeID := s.CreateNewAsset("CASSETTRE", "ucasset3", 2000000)
+
+	assetDataPoolOne := []*types.AssetDataPoolMapping{
+		{AssetID: assetOneID, AssetTransitType: 3, SupplyCap: sdk.NewDec(5000000000000000000)},
+		{AssetID: assetTwoID, AssetTransitType: 1, SupplyCap: sdk.NewDec(1000000000000000000)},
+		{AssetID: assetThreeID, AssetTransitType: 2, SupplyCap: sdk.NewDec(5000000000000000000)},
+	}
+	poolOneID := s.CreateNewPool("cmdx", "CMDX-ATOM-CMST", assetDataPoolOne)
+	s.AddAssetRatesStats(assetOneID, newDec("0.75"), newDec("0.002"), newDec("0.07"), newDec("1.25"), false, newDec("0.0"), newDec("0.0"), newDec("0.0"), newDec("0.6"), newDec("0.65"), newDec("0.05"), newDec("0.05"), newDec("0.2"), cAssetOneID)
+	s.AddAssetRatesStats(assetTwoID, newDec("0.8"), newDec("0.002"), newDec("0.06"), newDec("0.6"), true, newDec("0.04"), newDec("0.04"), newDec("0.06"), newDec("0.5"), newDec("0.55"), newDec("0.05"), newDec("0.05"), newDec("0.2"), cAssetTwoID)
+	s.AddAssetRatesStats(assetThreeID, newDec("0.8"), newDec("0.002"), newDec("0.06"), newDec("0.6"), true, newDec("0.04"), newDec("0.04"), newDec("0.06"), newDec("0.8"), newDec("0.85"), newDec("0.025"), newDec("0.025"), newDec("0.1"), cAssetThreeID)
+	pairID := s.AddExtendedLendPair(assetOneID, assetThreeID, false, poolOneID, 1000000)
+	s.AddAssetToPair(assetOneID, poolOneID, []uint64{pairID})
+	appOneID := s.CreateNewApp("commodo", "cmmdo")
+
+	s.fundAddr(owner, sdk.NewCoins(sdk.NewCoin("uasset1", newInt(10000000000)), sdk.NewCoin("uasset3", newInt(1000000000))))
+	s.fundAddr(lender, sdk.NewCoins(sdk.NewCoin("uasset3", newInt(15000000000))))
+	s.fundAddr(supplier, sdk.NewCoins(sdk.NewCoin("uasset3", newInt(20000000000))))
+
+	_, err := s.msgServer.Lend(sdk.WrapSDKContext(s.ctx), types.NewMsgLend(owner.String(), assetOneID, sdk.NewCoin("uasset1", newInt(10000000000)), poolOneID, appOneID))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Lend(sdk.WrapSDKContext(s.ctx), types.NewMsgLend(supplier.String(), assetThreeID, sdk.NewCoin("uasset3", newInt(20000000000)), poolOneID, appOneID))
+	s.Require().NoError(err)
+
+	market := types.FixedTermMarket{
+		AppID:                 appOneID,
+		PoolID:                poolOneID,
+		AssetID:               assetThreeID,
+		Maturity:              s.ctx.BlockTime().Add(year),
+		Rate:                  newDec("0.1"),
+		EarlyRepaymentPenalty: newDec("0.5"),
+	}
+	s.Require().NoError(s.app.LendKeeper.AddFixedTermMarket(s.ctx, market))
+	_, err = s.msgServer.FixedTermCommit(sdk.WrapSDKContext(s.ctx), types.NewMsgFixedTermCommit(lender.String(), 1, sdk.NewCoin("uasset3", newInt(10000000000))))
+	s.Require().NoError(err)
+
+	// Committed liquidity is not lent at variable rates.
+	reserved := s.app.LendKeeper.FixedTermReserved(s.ctx, poolOneID, assetThreeID)
+	s.Require().Equal(newInt(10000000000), reserved)
+	utilisation, err := s.app.LendKeeper.GetUtilisationRatioByPoolIDAndAssetID(s.ctx, poolOneID, assetThreeID)
+	s.Require().NoError(err)
+	s.Require().True(utilisation.IsZero())
+
+	// The interest until maturity counts against the ltv of the pair.
+	_, err = s.msgServer.FixedTermBorrow(sdk.WrapSDKContext(s.ctx), types.NewMsgFixedTermBorrow(owner.String(), 1, 1, pairID, sdk.NewCoin("ucasset1", newInt(5000000000)), sdk.NewCoin("uasset3", newInt(2800000000))))
+	s.Require().ErrorIs(err, types.ErrorInvalidCollateralizationRatio)
+	_, err = s.msgServer.FixedTermBorrow(sdk.WrapSDKContext(s.ctx), types.NewMsgFixedTermBorrow(owner.String(), 1, 1, pairID, sdk.NewCoin("ucasset1", newInt(5000000000)), sdk.NewCoin("uasset3", newInt(2000000000))))
+	s.Require().NoError(err)
+	loan, found := s.app.LendKeeper.GetFixedTermLoan(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(newDec("200000000"), loan.InterestDue)
+	s.Require().ErrorIs(s.app.LendKeeper.CloseLend(s.ctx, owner.String(), 1), types.ErrorLendUsedAsFixedTermCollateral)
+
+	// Repaying half way pays the accrued interest and half of the rest.
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(year / 2))
+	_, err = s.msgServer.FixedTermRepay(sdk.WrapSDKContext(s.ctx), types.NewMsgFixedTermRepay(owner.String(), 1))
+	s.Require().NoError(err)
+	s.Require().Equal(newInt(850000000), s.getBalance(owner, "uasset3").Amount)
+	s.Require().Equal(newInt(10000000000), s.getBalance(owner, "ucasset1").Amount)
+	market, _ = s.app.LendKeeper.GetFixedTermMarket(s.ctx, 1)
+	s.Require().Equal(newInt(10135000000), market.Available)
+	s.Require().True(market.TotalBorrowed.IsZero())
+
+	// A loan open at maturity rolls over into the next market.
+	s.Require().NoError(s.app.LendKeeper.AddFixedTermMarket(s.ctx, types.FixedTermMarket{
+		AppID:                 appOneID,
+		PoolID:                poolOneID,
+		AssetID:               assetThreeID,
+		Maturity:              s.ctx.BlockTime().Add(year + year/2),
+		Rate:                  newDec("0.1"),
+		EarlyRepaymentPenalty: newDec("0.5"),
+	}))
+	_, err = s.msgServer.FixedTermCommit(sdk.WrapSDKContext(s.ctx), types.NewMsgFixedTermCommit(lender.String(), 2, sdk.NewCoin("uasset3", newInt(5000000000))))
+	s.Require().NoError(err)
+	_, err = s.msgServer.FixedTermBorrow(sdk.WrapSDKContext(s.ctx), types.NewMsgFixedTermBorrow(owner.String(), 1, 1, pairID, sdk.NewCoin("ucasset1", newInt(5000000000)), sdk.NewCoin("uasset3", newInt(2000000000))))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.FixedTermRedeem(sdk.WrapSDKContext(s.ctx), types.NewMsgFixedTermRedeem(lender.String(), 1))
+	s.Require().ErrorIs(err, types.ErrorFixedTermMarketNotSettled)
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(year / 2))
+	s.app.LendKeeper.ProcessFixedTermLoans(s.ctx)
+	_, found = s.app.LendKeeper.GetFixedTermLoan(s.ctx, 2)
+	s.Require().False(found)
+	loan, found = s.app.LendKeeper.GetFixedTermLoan(s.ctx, 3)
+	s.Require().True(found)
+	s.Require().Equal(uint64(2), loan.MarketID)
+	s.Require().Equal(newInt(2100000000), loan.AmountOut.Amount)
+
+	// Lenders of the settled market redeem their share with the interest.
+	_, err = s.msgServer.FixedTermRedeem(sdk.WrapSDKContext(s.ctx), types.NewMsgFixedTermRedeem(lender.String(), 1))
+	s.Require().NoError(err)
+	s.Require().Equal(newInt(10225000000), s.getBalance(lender, "uasset3").Amount)
+
+	// Without a market to roll into, the loan becomes a variable rate borrow.
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(year))
+	s.app.LendKeeper.ProcessFixedTermLoans(s.ctx)
+	_, found = s.app.LendKeeper.GetFixedTermLoan(s.ctx, 3)
+	s.Require().False(found)
+	borrowPos, found := s.app.LendKeeper.GetBorrow(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(newInt(2310000000), borrowPos.AmountOut.Amount)
+	s.Require().Equal(uint64(1), borrowPos.LendingID)
+	assetStats, _ := s.app.LendKeeper.GetAssetStatsByPoolIDAndAssetID(s.ctx, poolOneID, assetThreeID)
+	s.Require().Equal(newInt(2310000000), assetStats.TotalBorrowed)
+	market, _ = s.app.LendKeeper.GetFixedTermMarket(s.ctx, 2)
+	s.Require().True(market.TotalBorrowed.IsZero())
+	s.Require().Equal(newInt(5189000000), market.Available)
+
+	// A loan converts even when the variable rate liquidity cannot repay the
+	// market, and the rest is booked as the market's shortfall.
+	s.Require().NoError(s.app.LendKeeper.AddFixedTermMarket(s.ctx, types.FixedTermMarket{
+		AppID:                 appOneID,
+		PoolID:                poolOneID,
+		AssetID:               assetThreeID,
+		Maturity:              s.ctx.BlockTime().Add(year),
+		Rate:                  newDec("0.1"),
+		EarlyRepaymentPenalty: newDec("0.5"),
+	}))
+	_, err = s.msgServer.FixedTermCommit(sdk.WrapSDKContext(s.ctx), types.NewMsgFixedTermCommit(lender.String(), 3, sdk.NewCoin("uasset3", newInt(1000000000))))
+	s.Require().NoError(err)
+	_, err = s.msgServer.FixedTermBorrow(sdk.WrapSDKContext(s.ctx), types.NewMsgFixedTermBorrow(owner.String(), 3, 1, pairID, sdk.NewCoin("ucasset1", newInt(2000000000)), sdk.NewCoin("uasset3", newInt(500000000))))
+	s.Require().NoError(err)
+
+	pool, _ := s.app.LendKeeper.GetPool(s.ctx, poolOneID)
+	drained := sdk.NewCoins(sdk.NewCoin("uasset3", s.app.LendKeeper.ModuleBalance(s.ctx, pool.ModuleName, "uasset3").Sub(s.app.LendKeeper.FixedTermReserved(s.ctx, poolOneID, assetThreeID))))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, pool.ModuleName, supplier, drained))
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(year))
+	s.app.LendKeeper.ProcessFixedTermLoans(s.ctx)
+	_, found = s.app.LendKeeper.GetFixedTermLoan(s.ctx, 4)
+	s.Require().False(found)
+	borrowPos, found = s.app.LendKeeper.GetBorrow(s.ctx, 2)
+	s.Require().True(found)
+	s.Require().Equal(newInt(550000000), borrowPos.AmountOut.Amount)
+	market, _ = s.app.LendKeeper.GetFixedTermMarket(s.ctx, 3)
+	s.Require().True(market.TotalBorrowed.IsZero())
+	s.Require().Equal(newInt(1045000000), market.Available.Add(market.Shortfall))
+	s.Require().True(market.Shortfall.IsPositive())
+
+	// The shortfall is redeemed once the pool has the liquidity back.
+	_, err = s.msgServer.FixedTermRedeem(sdk.WrapSDKContext(s.ctx), types.NewMsgFixedTermRedeem(lender.String(), 3))
+	s.Require().ErrorIs(err, types.ErrorFixedTermLiquidityInsufficient)
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromAccountToModule(s.ctx, supplier, pool.ModuleName, drained))
+	balance := s.getBalance(lender, "uasset3").Amount
+	_, err = s.msgServer.FixedTermRedeem(sdk.WrapSDKContext(s.ctx), types.NewMsgFixedTermRedeem(lender.String(), 3))
+	s.Require().NoError(err)
+	s.Require().Equal(balance.Add(newInt(1045000000)), s.getBalance(lender, "uasset3").Amount)
+	market, _ = s.app.LendKeeper.GetFixedTermMarket(s.ctx, 3)
+	s.Require().True(market.Shortfall.IsZero())
+
+	// The reserved total follows the available liquidity of the markets, and
+	// the lend position is no longer locked once its loans are closed.
+	reserved = sdk.ZeroInt()
+	for _, m := range s.app.LendKeeper.GetFixedTermMarkets(s.ctx) {
+		reserved = reserved.Add(m.Available)
+	}
+	s.Require().Equal(reserved, s.app.LendKeeper.FixedTermReserved(s.ctx, poolOneID, assetThreeID))
+	s.Require().False(s.app.LendKeeper.IsLendFixedTermCollateral(s.ctx, 1))
+}
+
+func (s *KeeperTestSuite) TestUpdateAndDelistingProposals() {
+	owner := s.addr(1)
+	supplier := s.addr(2)
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:00Z"))
+	day := 24 * time.Hour
+
+	assetOneID := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	assetTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+	assetThreeID := s.CreateNewAsset("ASSETTHREE", "uasset3", 2000000)
+	assetFourID := s.CreateNewAsset("ASSETFOUR", "uasset4", 2000000)
+	cAssetOneID := s.CreateNewAsset("CASSETONE", "ucasset1", 1000000)
+	cAssetTwoID := s.CreateNewAsset("CASSETTWO", "ucasset2", 2000000)
+	cAssetThreeID := s.CreateNewAsset("CASSETTRE", "ucasset3", 2000000)
+
+	assetDataPoolOne := []*types.AssetDataPoolMapping{
+		{AssetID: assetOneID, AssetTransitType: 3, SupplyCap: sdk.NewDec(5000000000000000000)},
+		{AssetID: assetTwoID, AssetTransitType: 1, SupplyCap: sdk.NewDec(1000000000000000000)},
+		{AssetID: assetThreeID, AssetTransitType: 2, SupplyCap: sdk.NewDec(5000000000000000000)},
+	}
+	poolOneID := s.CreateNewPool("cmdx", "CMDX-ATOM-CMST", assetDataPoolOne)
+	s.AddAssetRatesStats(assetOneID, newDec("0.75"), newDec("0.002"), newDec("0.07"), newDec("1.25"), false, newDec("0.0"), newDec("0.0"), newDec("0.0"), newDec("0.6"), newDec("0.65"), newDec("0.05"), newDec("0.05"), newDec("0.2"), cAssetOneID)
+	s.AddAssetRatesStats(assetTwoID, newDec("0.8"), newDec("0.002"), newDec("0.06"), newDec("0.6"), true, newDec("0.04"), newDec("0.04"), newDec("0.06"), newDec("0.5"), newDec("0.55"), newDec("0.05"), newDec("0.05"), newDec("0.2"), cAssetTwoID)
+	s.AddAssetRatesStats(assetThreeID, newDec("0.8"), newDec("0.002"), newDec("0.06"), newDec("0.6"), true, newDec("0.04"), newDec("0.04"), newDec("0.06"), newDec("0.8"), newDec("0.85"), newDec("0.025"), newDec("0.025"), newDec("0.1"), cAssetThreeID)
+	pairID := s.AddExtendedLendPair(assetOneID, assetThreeID, false, poolOneID, 1000000)
+	s.AddAssetToPair(assetOneID, poolOneID, []uint64{pairID})
+	appOneID := s.CreateNewApp("commodo", "cmmdo")
+
+	// Asset rates params are updated in place, keeping their cAsset.
+	assetRatesParams, found := s.app.LendKeeper.GetAssetRatesParams(s.ctx, assetOneID)
+	s.Require().True(found)
+	assetRatesParams.Ltv = newDec("0.5")
+	assetRatesParams.LiquidationThreshold = newDec("0.55")
+	s.Require().NoError(s.app.LendKeeper.HandleUpdateAssetRatesParamsRecords(s.ctx, types.NewUpdateAssetRatesParamsProposal("title", "description", assetRatesParams).(*types.UpdateAssetRatesParamsProposal)))
+	s.Require().Len(s.app.AssetKeeper.GetPendingChanges(s.ctx, assettypes.ParamClassAssetRatesParams), 1)
+	assetRatesParams, _ = s.app.LendKeeper.GetAssetRatesParams(s.ctx, assetOneID)
+	s.Require().Equal(newDec("0.6"), assetRatesParams.Ltv)
+
+	// The update waits out the timelock delay of its param class.
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(assettypes.DefaultTimelockDelay))
+	s.app.LendKeeper.ActivatePendingChanges(s.ctx)
+	s.Require().Empty(s.app.AssetKeeper.GetPendingChanges(s.ctx, assettypes.ParamClassAssetRatesParams))
+	assetRatesParams, _ = s.app.LendKeeper.GetAssetRatesParams(s.ctx, assetOneID)
+	s.Require().Equal(newDec("0.5"), assetRatesParams.Ltv)
+	assetRatesParams.CAssetID = cAssetTwoID
+	s.Require().ErrorIs(s.app.LendKeeper.UpdateAssetRatesParams(s.ctx, assetRatesParams), types.ErrorCAssetIDImmutable)
+	assetRatesParams.AssetID = assetFourID
+	s.Require().ErrorIs(s.app.LendKeeper.UpdateAssetRatesParams(s.ctx, assetRatesParams), types.ErrorAssetRatesParamsNotFound)
+
+	// Supply caps of the pool and the min usd value left of its pairs.
+	supplyCap := []*types.AssetDataPoolMapping{{AssetID: assetOneID, SupplyCap: sdk.NewDec(4000000000000000000)}}
+	s.Require().NoError(s.app.LendKeeper.UpdatePoolPairs(s.ctx, poolOneID, supplyCap, 2000000))
+	pool, _ := s.app.LendKeeper.GetPool(s.ctx, poolOneID)
+	s.Require().Equal(sdk.NewDec(4000000000000000000), pool.AssetData[0].SupplyCap)
+	s.Require().Equal(uint64(3), pool.AssetData[0].AssetTransitType)
+	pair, _ := s.app.LendKeeper.GetLendPair(s.ctx, pairID)
+	s.Require().Equal(uint64(2000000), pair.MinUsdValueLeft)
+	notInPool := []*types.AssetDataPoolMapping{{AssetID: assetFourID, SupplyCap: sdk.NewDec(1)}}
+	s.Require().ErrorIs(s.app.LendKeeper.UpdatePoolPairs(s.ctx, poolOneID, notInPool, 0), types.ErrorAssetNotInPool)
+
+	s.fundAddr(owner, sdk.NewCoins(sdk.NewCoin("uasset1", newInt(10000000000))))
+	s.fundAddr(supplier, sdk.NewCoins(sdk.NewCoin("uasset3", newInt(20000000000))))
+	_, err := s.msgServer.Lend(sdk.WrapSDKContext(s.ctx), types.NewMsgLend(owner.String(), assetOneID, sdk.NewCoin("uasset1", newInt(5000000000)), poolOneID, appOneID))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Lend(sdk.WrapSDKContext(s.ctx), types.NewMsgLend(supplier.String(), assetThreeID, sdk.NewCoin("uasset3", newInt(20000000000)), poolOneID, appOneID))
+	s.Require().NoError(err)
+
+	// Delisting freezes new lends and borrows right away.
+	s.Require().NoError(s.app.AssetKeeper.DelistAsset(s.ctx, assetOneID, day, 2*day))
+	s.Require().ErrorIs(s.app.AssetKeeper.DelistAsset(s.ctx, assetOneID, day, 2*day), assettypes.ErrorAlreadyDelisting)
+	_, err = s.msgServer.Lend(sdk.WrapSDKContext(s.ctx), types.NewMsgLend(owner.String(), assetOneID, sdk.NewCoin("uasset1", newInt(5000000000)), poolOneID, appOneID))
+	s.Require().ErrorIs(err, types.ErrorAssetDelisted)
+	_, err = s.msgServer.Borrow(sdk.WrapSDKContext(s.ctx), types.NewMsgBorrow(owner.String(), 1, pairID, false, sdk.NewCoin("ucasset1", newInt(1000000000)), sdk.NewCoin("uasset3", newInt(100000000))))
+	s.Require().ErrorIs(err, types.ErrorAssetDelisted)
+
+	pair, _ = s.app.LendKeeper.GetLendPair(s.ctx, pairID)
+	assetRatesParams, _ = s.app.LendKeeper.GetAssetRatesParamsForPair(s.ctx, pair)
+	s.Require().Equal(newDec("0.5"), assetRatesParams.Ltv)
+
+	// The ltv ramps down linearly once the freeze is over.
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2 * day))
+	assetRatesParams, _ = s.app.LendKeeper.GetAssetRatesParamsForPair(s.ctx, pair)
+	s.Require().Equal(newDec("0.25"), assetRatesParams.Ltv)
+	s.Require().Equal(newDec("0.275"), assetRatesParams.LiquidationThreshold)
+
+	// At close-out every position in the asset is liquidatable.
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(day))
+	assetRatesParams, _ = s.app.LendKeeper.GetAssetRatesParamsForPair(s.ctx, pair)
+	s.Require().True(assetRatesParams.Ltv.IsZero())
+	s.Require().True(assetRatesParams.LiquidationThreshold.IsZero())
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	protobuftypes "github.com/gogo/protobuf/types"
 
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
 	"github.com/comdex-official/comdex/x/lend/types"
 )
 
@@ -400,6 +402,9 @@ func (k Keeper) AddAssetRatesParams(ctx sdk.Context, records ...types.AssetRates
 			LiquidationBonus:     msg.LiquidationBonus,
 			ReserveFactor:        msg.ReserveFactor,
 			CAssetID:             msg.CAssetID,
+			InterestRateModelID:  msg.InterestRateModelID,
+			RateCap:              msg.RateCap,
+			AdjustmentSpeed:      msg.AdjustmentSpeed,
 		}
 
 		k.SetAssetRatesParams(ctx, assetRatesParams)
@@ -407,6 +412,91 @@ func (k Keeper) AddAssetRatesParams(ctx sdk.Context, records ...types.AssetRates
 	return nil
 }
 
+// UpdateAssetRatesParams replaces the rates params of an asset, its cAsset
+// stays the same since it is minted for the existing lends.
+func (k Keeper) UpdateAssetRatesParams(ctx sdk.Context, assetRatesParams types.AssetRatesParams) error {
+	if err := k.ValidateAssetRatesParamsUpdate(ctx, assetRatesParams); err != nil {
+		return err
+	}
+
+	k.SetAssetRatesParams(ctx, assetRatesParams)
+	for _, pool := range k.GetPools(ctx) {
+		k.UpdateAPR(ctx, pool.PoolID, assetRatesParams.AssetID)
+	}
+	return nil
+}
+
+// ValidateAssetRatesParamsUpdate checks assetRatesParams can replace the
+// params of its asset.
+func (k Keeper) ValidateAssetRatesParamsUpdate(ctx sdk.Context, assetRatesParams types.AssetRatesParams) error {
+	existing, found := k.GetAssetRatesParams(ctx, assetRatesParams.AssetID)
+	if !found {
+		return types.ErrorAssetRatesParamsNotFound
+	}
+	if existing.CAssetID != assetRatesParams.CAssetID {
+		return types.ErrorCAssetIDImmutable
+	}
+	return assetRatesParams.Validate()
+}
+
+// ActivatePendingChanges applies the asset rates params and auction params
+// updates whose timelock delay has passed.
+func (k Keeper) ActivatePendingChanges(ctx sdk.Context) {
+	k.Asset.ActivatePendingChanges(ctx, assettypes.ParamClassAssetRatesParams, func(ctx sdk.Context, change assettypes.RiskParameter) error {
+		assetRatesParams, ok := change.(*types.AssetRatesParams)
+		if !ok {
+			return assettypes.ErrorInvalidPendingChange
+		}
+		return k.UpdateAssetRatesParams(ctx, *assetRatesParams)
+	})
+	k.Asset.ActivatePendingChanges(ctx, assettypes.ParamClassLendAuctionParams, func(ctx sdk.Context, change assettypes.RiskParameter) error {
+		auctionParams, ok := change.(*types.AuctionParams)
+		if !ok {
+			return assettypes.ErrorInvalidPendingChange
+		}
+		if k.Auction.IsSealedBidApp(ctx, auctionParams.AppId) {
+			return types.ErrorSealedBidApp
+		}
+		return k.AddAuctionParamsData(ctx, *auctionParams)
+	})
+}
+
+// UpdatePoolPairs sets the supply caps of assets already in the pool, and
+// when minUsdValueLeft is set, the min usd value left of the pairs borrowing
+// from the pool.
+func (k Keeper) UpdatePoolPairs(ctx sdk.Context, poolID uint64, assetData []*types.AssetDataPoolMapping, minUsdValueLeft uint64) error {
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return types.ErrPoolNotFound
+	}
+
+	for _, update := range assetData {
+		var inPool bool
+		for _, data := range pool.AssetData {
+			if data.AssetID == update.AssetID {
+				data.SupplyCap = update.SupplyCap
+				inPool = true
+			}
+		}
+		if !inPool {
+			return sdkerrors.Wrapf(types.ErrorAssetNotInPool, "asset %d, pool %d", update.AssetID, poolID)
+		}
+	}
+	k.SetPool(ctx, pool)
+
+	if minUsdValueLeft == 0 {
+		return nil
+	}
+	for _, pair := range k.GetLendPairs(ctx) {
+		if pair.AssetOutPoolID != poolID {
+			continue
+		}
+		pair.MinUsdValueLeft = minUsdValueLeft
+		k.SetLendPair(ctx, pair)
+	}
+	return nil
+}
+
 func (k Keeper) AddAuctionParamsData(ctx sdk.Context, param types.AuctionParams) error {
 	var (
 		store = k.Store(ctx)
@@ -523,6 +613,9 @@ func (k Keeper) AddAssetRatesPoolPairs(ctx sdk.Context, msg types.AssetRatesPool
 		LiquidationBonus:     msg.LiquidationBonus,
 		ReserveFactor:        msg.ReserveFactor,
 		CAssetID:             msg.CAssetID,
+		InterestRateModelID:  msg.InterestRateModelID,
+		RateCap:              msg.RateCap,
+		AdjustmentSpeed:      msg.AdjustmentSpeed,
 	}
 
 	k.SetAssetRatesParams(ctx, assetRatesParams)
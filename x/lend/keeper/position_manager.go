@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/lend/types"
+)
+
+func (k Keeper) SetPositionManagerGrant(ctx sdk.Context, grant types.PositionManagerGrant) {
+	var (
+		store = k.Store(ctx)
+		key   = types.PositionManagerGrantKey(sdk.MustAccAddressFromBech32(grant.Owner), sdk.MustAccAddressFromBech32(grant.Manager))
+		value = k.cdc.MustMarshal(&grant)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetPositionManagerGrant(ctx sdk.Context, owner, manager sdk.AccAddress) (grant types.PositionManagerGrant, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.PositionManagerGrantKey(owner, manager)
+		value = store.Get(key)
+	)
+	if value == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshal(value, &grant)
+	return grant, true
+}
+
+func (k Keeper) DeletePositionManagerGrant(ctx sdk.Context, owner, manager sdk.AccAddress) {
+	store := k.Store(ctx)
+	store.Delete(types.PositionManagerGrantKey(owner, manager))
+}
+
+func (k Keeper) GetPositionManagerGrants(ctx sdk.Context) (grants []types.PositionManagerGrant) {
+	return k.getPositionManagerGrants(ctx, types.PositionManagerGrantKeyPrefix)
+}
+
+func (k Keeper) GetPositionManagerGrantsByOwner(ctx sdk.Context, owner sdk.AccAddress) (grants []types.PositionManagerGrant) {
+	return k.getPositionManagerGrants(ctx, types.PositionManagerGrantOwnerKey(owner))
+}
+
+func (k Keeper) getPositionManagerGrants(ctx sdk.Context, prefix []byte) (grants []types.PositionManagerGrant) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, prefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.PositionManagerGrant
+		k.cdc.MustUnmarshal(iter.Value(), &grant)
+		grants = append(grants, grant)
+	}
+	return grants
+}
+
+// actAsOwner returns the owner of a position that sender wants to act on with
+// permission. When sender is a manager of owner, funds are first moved from
+// the manager to the owner, so the keeper can handle the request as if the
+// owner had sent it and any withdrawal is paid out to the owner.
+func (k Keeper) actAsOwner(ctx sdk.Context, owner, sender string, permission types.PositionPermission, funds sdk.Coins) (string, error) {
+	// An unknown position is left to the keeper to report.
+	if owner == "" || owner == sender {
+		return sender, nil
+	}
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return "", err
+	}
+	managerAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return "", err
+	}
+	grant, found := k.GetPositionManagerGrant(ctx, ownerAddr, managerAddr)
+	if !found || !grant.HasPermission(permission) {
+		return "", types.ErrLendAccessUnauthorized
+	}
+	if !funds.IsZero() {
+		if err := k.bank.SendCoins(ctx, managerAddr, ownerAddr, funds); err != nil {
+			return "", err
+		}
+	}
+	return owner, nil
+}
+
+// lendOwner returns the owner of the lend position, or an empty string if it
+// does not exist.
+func (k Keeper) lendOwner(ctx sdk.Context, lendID uint64) string {
+	lendPos, _ := k.GetLend(ctx, lendID)
+	return lendPos.Owner
+}
+
+// borrowOwner returns the owner of the borrow position, or an empty string if
+// it does not exist.
+func (k Keeper) borrowOwner(ctx sdk.Context, borrowID uint64) string {
+	borrowPos, found := k.GetBorrow(ctx, borrowID)
+	if !found {
+		return ""
+	}
+	return k.lendOwner(ctx, borrowPos.LendingID)
+}
@@ -42,6 +42,9 @@ func (k Keeper) CalculateCollateralizationRatio(
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
+	if !totalIn.IsPositive() {
+		return sdk.ZeroDec(), types.ErrorInvalidCollateralizationRatio
+	}
 
 	return totalOut.Quo(totalIn), nil
 }
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// BadDebtCoverageReserve covers the shortfall from the reserve of the
+	// borrowed asset.
+	BadDebtCoverageReserve uint64 = iota + 1
+	// BadDebtCoverageCollector covers the shortfall from the surplus the
+	// collector holds for the app.
+	BadDebtCoverageCollector
+	// BadDebtCoverageSocialize spreads the shortfall across the lenders of
+	// the pool by lowering the cToken exchange rate.
+	BadDebtCoverageSocialize
+)
+
+// DefaultBadDebtWaterfall is used until governance sets a waterfall.
+func DefaultBadDebtWaterfall() BadDebtWaterfall {
+	return BadDebtWaterfall{
+		Steps: []uint64{BadDebtCoverageReserve, BadDebtCoverageCollector, BadDebtCoverageSocialize},
+	}
+}
+
+// Validate checks that every step is known and taken at most once. An empty
+// waterfall is valid and leaves all bad debt outstanding.
+func (m *BadDebtWaterfall) Validate() error {
+	seen := map[uint64]bool{}
+	for _, step := range m.Steps {
+		if step < BadDebtCoverageReserve || step > BadDebtCoverageSocialize {
+			return sdkerrors.Wrapf(ErrorInvalidBadDebtWaterfall, "unknown step %d", step)
+		}
+		if seen[step] {
+			return sdkerrors.Wrapf(ErrorInvalidBadDebtWaterfall, "duplicate step %d", step)
+		}
+		seen[step] = true
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
@@ -32,6 +34,22 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFundReserveAccounts{}, "comdex/lend/MsgFundReserveAccounts", nil)
 	cdc.RegisterConcrete(&AddPoolPairsProposal{}, "comdex/lend/AddPoolPairsProposal", nil)
 	cdc.RegisterConcrete(&AddAssetRatesPoolPairsProposal{}, "comdex/lend/AddAssetRatesPoolPairsProposal", nil)
+	cdc.RegisterConcrete(&SetEModeCategoryProposal{}, "comdex/lend/SetEModeCategoryProposal", nil)
+	cdc.RegisterConcrete(&SetBadDebtWaterfallProposal{}, "comdex/lend/SetBadDebtWaterfallProposal", nil)
+	cdc.RegisterConcrete(&AddFixedTermMarketProposal{}, "comdex/lend/AddFixedTermMarketProposal", nil)
+	cdc.RegisterConcrete(&UpdateAssetRatesParamsProposal{}, "comdex/lend/UpdateAssetRatesParamsProposal", nil)
+	cdc.RegisterConcrete(&UpdatePoolPairsProposal{}, "comdex/lend/UpdatePoolPairsProposal", nil)
+	cdc.RegisterConcrete(&MsgGrantPositionManager{}, "comdex/lend/MsgGrantPositionManager", nil)
+	cdc.RegisterConcrete(&MsgRevokePositionManager{}, "comdex/lend/MsgRevokePositionManager", nil)
+	cdc.RegisterConcrete(&MsgDepositAccountCollateral{}, "comdex/lend/MsgDepositAccountCollateral", nil)
+	cdc.RegisterConcrete(&MsgWithdrawAccountCollateral{}, "comdex/lend/MsgWithdrawAccountCollateral", nil)
+	cdc.RegisterConcrete(&MsgAccountBorrow{}, "comdex/lend/MsgAccountBorrow", nil)
+	cdc.RegisterConcrete(&MsgAccountRepay{}, "comdex/lend/MsgAccountRepay", nil)
+	cdc.RegisterConcrete(&MsgLiquidateAccount{}, "comdex/lend/MsgLiquidateAccount", nil)
+	cdc.RegisterConcrete(&MsgFixedTermCommit{}, "comdex/lend/MsgFixedTermCommit", nil)
+	cdc.RegisterConcrete(&MsgFixedTermRedeem{}, "comdex/lend/MsgFixedTermRedeem", nil)
+	cdc.RegisterConcrete(&MsgFixedTermBorrow{}, "comdex/lend/MsgFixedTermBorrow", nil)
+	cdc.RegisterConcrete(&MsgFixedTermRepay{}, "comdex/lend/MsgFixedTermRepay", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -46,6 +64,16 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&AddMultipleAssetToPairProposal{},
 		&AddPoolPairsProposal{},
 		&AddAssetRatesPoolPairsProposal{},
+		&SetEModeCategoryProposal{},
+		&SetBadDebtWaterfallProposal{},
+		&AddFixedTermMarketProposal{},
+		&UpdateAssetRatesParamsProposal{},
+		&UpdatePoolPairsProposal{},
+	)
+	registry.RegisterImplementations(
+		(*assettypes.RiskParameter)(nil),
+		&AssetRatesParams{},
+		&AuctionParams{},
 	)
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
@@ -62,6 +90,17 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgFundModuleAccounts{},
 		&MsgCalculateInterestAndRewards{},
 		&MsgFundReserveAccounts{},
+		&MsgGrantPositionManager{},
+		&MsgRevokePositionManager{},
+		&MsgDepositAccountCollateral{},
+		&MsgWithdrawAccountCollateral{},
+		&MsgAccountBorrow{},
+		&MsgAccountRepay{},
+		&MsgLiquidateAccount{},
+		&MsgFixedTermCommit{},
+		&MsgFixedTermRedeem{},
+		&MsgFixedTermBorrow{},
+		&MsgFixedTermRepay{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
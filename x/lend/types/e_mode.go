@@ -0,0 +1,50 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks the stateless fields of an e-mode category. Whether the
+// ltv and liquidation threshold are at least those of its member assets is
+// checked by the keeper when the category is set.
+func (m *EModeCategory) Validate() error {
+	if strings.TrimSpace(m.Name) == "" {
+		return sdkerrors.Wrap(ErrorInvalidEModeCategory, "name cannot be empty")
+	}
+	if len(m.AssetIDs) < 2 {
+		return sdkerrors.Wrap(ErrorInvalidEModeCategory, "category must contain at least two assets")
+	}
+	seen := map[uint64]bool{}
+	for _, id := range m.AssetIDs {
+		if id == 0 {
+			return sdkerrors.Wrap(ErrorInvalidEModeCategory, "asset id cannot be zero")
+		}
+		if seen[id] {
+			return sdkerrors.Wrapf(ErrorInvalidEModeCategory, "duplicate asset id %d", id)
+		}
+		seen[id] = true
+	}
+	if m.Ltv.IsNil() || !m.Ltv.IsPositive() {
+		return sdkerrors.Wrap(ErrorInvalidEModeCategory, "ltv must be positive")
+	}
+	if m.LiquidationThreshold.IsNil() || m.LiquidationThreshold.LT(m.Ltv) {
+		return sdkerrors.Wrap(ErrorInvalidEModeCategory, "liquidation threshold cannot be less than ltv")
+	}
+	if m.LiquidationThreshold.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrorInvalidEModeCategory, "liquidation threshold must be less than one")
+	}
+	return nil
+}
+
+// HasAsset reports whether assetID is a member of the category.
+func (m *EModeCategory) HasAsset(assetID uint64) bool {
+	for _, id := range m.AssetIDs {
+		if id == assetID {
+			return true
+		}
+	}
+	return false
+}
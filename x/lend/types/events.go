@@ -15,16 +15,36 @@ const (
 	EventTypeFundModuleAccn  = "fundModuleAccn"
 	EventTypeBorrowInterest  = "borrowInterest"
 	EventTypeLendRewards     = "lendRewards"
+	EventTypeGrantManager    = "grantPositionManager"
+	EventTypeRevokeManager   = "revokePositionManager"
+	EventTypeAccountDeposit  = "accountDeposit"
+	EventTypeAccountWithdraw = "accountWithdraw"
+	EventTypeAccountBorrow   = "accountBorrow"
+	EventTypeAccountRepay    = "accountRepay"
+	EventTypeAccountLiq      = "accountLiquidate"
+	EventTypeFixedCommit     = "fixedTermCommit"
+	EventTypeFixedRedeem     = "fixedTermRedeem"
+	EventTypeFixedBorrow     = "fixedTermBorrow"
+	EventTypeFixedRepay      = "fixedTermRepay"
+	EventTypeFixedRollover   = "fixedTermRollover"
+	EventTypeFixedConvert    = "fixedTermConvert"
 
-	AttributeKeyCreator   = "creator"
-	AttributeKeyAppID     = "appId"
-	AttributeKeyPoolID    = "PoolId"
-	AttributeKeyAssetID   = "AssetId"
-	AttributeKeyAmountIn  = "amountIn"
-	AttributeKeyAmountOut = "amountOut"
-	AttributeKeyTimestamp = "timestamp"
-	AttributeKeyLendID    = "lendId"
-	AttributeKeyPairID    = "pairId"
-	AttributeKeyIsStable  = "isStableBorrow"
-	AttributeKeyBorrowID  = "borrowId"
+	AttributeKeyCreator    = "creator"
+	AttributeKeyAppID      = "appId"
+	AttributeKeyPoolID     = "PoolId"
+	AttributeKeyAssetID    = "AssetId"
+	AttributeKeyAmountIn   = "amountIn"
+	AttributeKeyAmountOut  = "amountOut"
+	AttributeKeyTimestamp  = "timestamp"
+	AttributeKeyLendID     = "lendId"
+	AttributeKeyPairID     = "pairId"
+	AttributeKeyIsStable   = "isStableBorrow"
+	AttributeKeyBorrowID   = "borrowId"
+	AttributeKeyOwner      = "owner"
+	AttributeKeyManager    = "manager"
+	AttributeKeyPerms      = "permissions"
+	AttributeKeyLiquidator = "liquidator"
+	AttributeKeyMarketID   = "marketId"
+	AttributeKeyDepositID  = "depositId"
+	AttributeKeyLoanID     = "loanId"
 )
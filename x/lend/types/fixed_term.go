@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxFixedTermLoansPerBlock is the maximum number of matured fixed-term
+	// loans settled in a block, and of open loans whose health is checked in
+	// a block. Open loans are checked round robin across blocks.
+	MaxFixedTermLoansPerBlock = 20
+)
+
+// Validate checks the stateless fields of a fixed-term market proposed by
+// governance. Whether the asset belongs to the pool and the maturity is in the
+// future is checked by the keeper when the market is added.
+func (m *FixedTermMarket) Validate() error {
+	if m.AppID == 0 {
+		return sdkerrors.Wrap(ErrorInvalidFixedTermMarket, "app id cannot be zero")
+	}
+	if m.PoolID == 0 {
+		return sdkerrors.Wrap(ErrorInvalidFixedTermMarket, "pool id cannot be zero")
+	}
+	if m.AssetID == 0 {
+		return sdkerrors.Wrap(ErrorInvalidFixedTermMarket, "asset id cannot be zero")
+	}
+	if m.Maturity.IsZero() {
+		return sdkerrors.Wrap(ErrorInvalidFixedTermMarket, "maturity cannot be empty")
+	}
+	if m.Rate.IsNil() || m.Rate.IsNegative() {
+		return sdkerrors.Wrap(ErrorInvalidFixedTermMarket, "rate cannot be negative")
+	}
+	if m.EarlyRepaymentPenalty.IsNil() || m.EarlyRepaymentPenalty.IsNegative() || m.EarlyRepaymentPenalty.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrorInvalidFixedTermMarket, "early repayment penalty must be between 0 and 1")
+	}
+	return nil
+}
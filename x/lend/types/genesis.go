@@ -1,6 +1,6 @@
 package types
 
-func NewGenesisState(borrowAsset []BorrowAsset, borrowInterestTracker []BorrowInterestTracker, lendAsset []LendAsset, pool []Pool, assetToPairMapping []AssetToPairMapping, poolAssetLBMapping []PoolAssetLBMapping, lendRewardsTracker []LendRewardsTracker, userAssetLendBorrowMapping []UserAssetLendBorrowMapping, reserveBuybackAssetData []ReserveBuybackAssetData, extendedPair []Extended_Pair, auctionParams []AuctionParams, assetRatesParams []AssetRatesParams, modBal ModBal, reserveBal ReserveBal, allReserveStats []AllReserveStats) *GenesisState {
+func NewGenesisState(borrowAsset []BorrowAsset, borrowInterestTracker []BorrowInterestTracker, lendAsset []LendAsset, pool []Pool, assetToPairMapping []AssetToPairMapping, poolAssetLBMapping []PoolAssetLBMapping, lendRewardsTracker []LendRewardsTracker, userAssetLendBorrowMapping []UserAssetLendBorrowMapping, reserveBuybackAssetData []ReserveBuybackAssetData, extendedPair []Extended_Pair, auctionParams []AuctionParams, assetRatesParams []AssetRatesParams, modBal ModBal, reserveBal ReserveBal, allReserveStats []AllReserveStats, positionManagerGrants []PositionManagerGrant, eModeCategories []EModeCategory, adaptiveRateStates []AdaptiveRateState, badDebtWaterfall BadDebtWaterfall, cTokenExchangeRates []CTokenExchangeRate, crossCollateralAccounts []CrossCollateralAccount, fixedTermMarkets []FixedTermMarket, fixedTermDeposits []FixedTermDeposit, fixedTermLoans []FixedTermLoan) *GenesisState {
 	return &GenesisState{
 		BorrowAsset:                borrowAsset,
 		BorrowInterestTracker:      borrowInterestTracker,
@@ -17,6 +17,15 @@ func NewGenesisState(borrowAsset []BorrowAsset, borrowInterestTracker []BorrowIn
 		ModBal:                     modBal,
 		ReserveBal:                 reserveBal,
 		AllReserveStats:            allReserveStats,
+		PositionManagerGrants:      positionManagerGrants,
+		EModeCategories:            eModeCategories,
+		AdaptiveRateStates:         adaptiveRateStates,
+		BadDebtWaterfall:           badDebtWaterfall,
+		CTokenExchangeRates:        cTokenExchangeRates,
+		CrossCollateralAccounts:    crossCollateralAccounts,
+		FixedTermMarkets:           fixedTermMarkets,
+		FixedTermDeposits:          fixedTermDeposits,
+		FixedTermLoans:             fixedTermLoans,
 	}
 }
 
@@ -37,6 +46,15 @@ func DefaultGenesisState() *GenesisState {
 		ModBal{},
 		ReserveBal{},
 		[]AllReserveStats{},
+		[]PositionManagerGrant{},
+		[]EModeCategory{},
+		[]AdaptiveRateState{},
+		DefaultBadDebtWaterfall(),
+		[]CTokenExchangeRate{},
+		[]CrossCollateralAccount{},
+		[]FixedTermMarket{},
+		[]FixedTermDeposit{},
+		[]FixedTermLoan{},
 	)
 }
 
@@ -39,6 +39,15 @@ type GenesisState struct {
 	ModBal                     ModBal                       `protobuf:"bytes,13,opt,name=modBal,proto3" json:"modBal" yaml:"modBal"`
 	ReserveBal                 ReserveBal                   `protobuf:"bytes,14,opt,name=reserveBal,proto3" json:"reserveBal" yaml:"reserveBal"`
 	AllReserveStats            []AllReserveStats            `protobuf:"bytes,15,rep,name=allReserveStats,proto3" json:"allReserveStats" yaml:"allReserveStats"`
+	PositionManagerGrants      []PositionManagerGrant       `protobuf:"bytes,16,rep,name=positionManagerGrants,proto3" json:"positionManagerGrants" yaml:"positionManagerGrants"`
+	EModeCategories            []EModeCategory              `protobuf:"bytes,17,rep,name=eModeCategories,proto3" json:"eModeCategories" yaml:"eModeCategories"`
+	AdaptiveRateStates         []AdaptiveRateState          `protobuf:"bytes,18,rep,name=adaptiveRateStates,proto3" json:"adaptiveRateStates" yaml:"adaptiveRateStates"`
+	BadDebtWaterfall           BadDebtWaterfall             `protobuf:"bytes,19,opt,name=badDebtWaterfall,proto3" json:"badDebtWaterfall" yaml:"badDebtWaterfall"`
+	CTokenExchangeRates        []CTokenExchangeRate         `protobuf:"bytes,20,rep,name=cTokenExchangeRates,proto3" json:"cTokenExchangeRates" yaml:"cTokenExchangeRates"`
+	CrossCollateralAccounts    []CrossCollateralAccount     `protobuf:"bytes,21,rep,name=crossCollateralAccounts,proto3" json:"crossCollateralAccounts" yaml:"crossCollateralAccounts"`
+	FixedTermMarkets           []FixedTermMarket            `protobuf:"bytes,22,rep,name=fixedTermMarkets,proto3" json:"fixedTermMarkets" yaml:"fixedTermMarkets"`
+	FixedTermDeposits          []FixedTermDeposit           `protobuf:"bytes,23,rep,name=fixedTermDeposits,proto3" json:"fixedTermDeposits" yaml:"fixedTermDeposits"`
+	FixedTermLoans             []FixedTermLoan              `protobuf:"bytes,24,rep,name=fixedTermLoans,proto3" json:"fixedTermLoans" yaml:"fixedTermLoans"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -179,6 +188,69 @@ func (m *GenesisState) GetAllReserveStats() []AllReserveStats {
 	return nil
 }
 
+func (m *GenesisState) GetPositionManagerGrants() []PositionManagerGrant {
+	if m != nil {
+		return m.PositionManagerGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEModeCategories() []EModeCategory {
+	if m != nil {
+		return m.EModeCategories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdaptiveRateStates() []AdaptiveRateState {
+	if m != nil {
+		return m.AdaptiveRateStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBadDebtWaterfall() BadDebtWaterfall {
+	if m != nil {
+		return m.BadDebtWaterfall
+	}
+	return BadDebtWaterfall{}
+}
+
+func (m *GenesisState) GetCTokenExchangeRates() []CTokenExchangeRate {
+	if m != nil {
+		return m.CTokenExchangeRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCrossCollateralAccounts() []CrossCollateralAccount {
+	if m != nil {
+		return m.CrossCollateralAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFixedTermMarkets() []FixedTermMarket {
+	if m != nil {
+		return m.FixedTermMarkets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFixedTermDeposits() []FixedTermDeposit {
+	if m != nil {
+		return m.FixedTermDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFixedTermLoans() []FixedTermLoan {
+	if m != nil {
+		return m.FixedTermLoans
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "comdex.lend.v1beta1.GenesisState")
 }
@@ -186,51 +258,69 @@ func init() {
 func init() { proto.RegisterFile("comdex/lend/v1beta1/genesis.proto", fileDescriptor_4df703d992154ae9) }
 
 var fileDescriptor_4df703d992154ae9 = []byte{
-	// 699 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xcb, 0x4e, 0x1b, 0x3b,
-	0x18, 0xc7, 0x33, 0x07, 0x0e, 0xe7, 0xe0, 0x90, 0x03, 0xc7, 0x40, 0x19, 0x52, 0x34, 0x09, 0x16,
-	0x6d, 0x41, 0x6a, 0x33, 0x82, 0xee, 0xba, 0xc3, 0x6a, 0xd5, 0x8b, 0x40, 0x8a, 0x5c, 0xda, 0x05,
-	0x8b, 0x46, 0x4e, 0xc6, 0xa4, 0x23, 0x26, 0xe3, 0xc8, 0xe3, 0x70, 0xa9, 0xfa, 0x00, 0x5d, 0x55,
-	0x5d, 0x74, 0xd5, 0x27, 0x62, 0xc9, 0xb2, 0x2b, 0x54, 0xc1, 0x1b, 0xf4, 0x09, 0x2a, 0x5f, 0x02,
-	0x93, 0xc4, 0xc9, 0x0e, 0xcb, 0xff, 0xef, 0xf7, 0xfb, 0xf0, 0x7c, 0x8e, 0xc1, 0x7a, 0x8b, 0x77,
-	0x22, 0x76, 0x16, 0x26, 0x2c, 0x8d, 0xc2, 0x93, 0xed, 0x26, 0x93, 0x74, 0x3b, 0x6c, 0xb3, 0x94,
-	0x65, 0x71, 0x56, 0xeb, 0x0a, 0x2e, 0x39, 0x5c, 0x34, 0x91, 0x9a, 0x8a, 0xd4, 0x6c, 0xa4, 0xbc,
-	0xd4, 0xe6, 0x6d, 0xae, 0xf7, 0x43, 0xf5, 0x97, 0x89, 0x96, 0x03, 0x17, 0x4d, 0xd7, 0x99, 0xfd,
-	0xaa, 0x6b, 0xbf, 0x4b, 0x05, 0xed, 0x58, 0x19, 0xfa, 0x5e, 0x02, 0x73, 0x2f, 0x8d, 0xfe, 0xad,
-	0xa4, 0x92, 0xc1, 0x0f, 0xa0, 0xd8, 0xe4, 0x42, 0xf0, 0xd3, 0xdd, 0x2c, 0x63, 0xd2, 0xf7, 0xaa,
-	0x53, 0x9b, 0xc5, 0x9d, 0x6a, 0xcd, 0xd1, 0x53, 0x0d, 0xdf, 0xe5, 0x70, 0xf9, 0xe2, 0xaa, 0x52,
-	0xf8, 0x7d, 0x55, 0x81, 0xe7, 0xb4, 0x93, 0x3c, 0x43, 0x39, 0x04, 0x22, 0x79, 0x20, 0xfc, 0xe2,
-	0x81, 0x65, 0xb3, 0x7e, 0x9d, 0x4a, 0x26, 0x58, 0x26, 0x0f, 0x04, 0x6d, 0x1d, 0x33, 0xe1, 0xff,
-	0xa5, 0x55, 0x8f, 0x27, 0xa8, 0x1a, 0xb1, 0x2d, 0x69, 0x48, 0x53, 0x83, 0x37, 0xac, 0x76, 0x2d,
-	0xaf, 0x1d, 0x02, 0x23, 0xe2, 0x16, 0xc2, 0xf7, 0x60, 0x56, 0x49, 0xcc, 0x3f, 0x3a, 0xa5, 0xed,
-	0x81, 0xd3, 0xbe, 0xd7, 0x4f, 0x61, 0xdf, 0xfa, 0x16, 0x8c, 0xef, 0xb6, 0x1c, 0x91, 0x3b, 0x14,
-	0xc4, 0x60, 0xba, 0xcb, 0x79, 0xe2, 0x4f, 0x6b, 0xe4, 0xaa, 0x13, 0x59, 0xe7, 0x3c, 0xc1, 0x8b,
-	0x96, 0x56, 0x34, 0x34, 0x55, 0x84, 0x88, 0xae, 0x85, 0x9f, 0x00, 0xa4, 0x0a, 0x76, 0xc0, 0xeb,
-	0x34, 0x16, 0xfb, 0xb4, 0xdb, 0x8d, 0xd3, 0xb6, 0xff, 0xb7, 0x26, 0x3e, 0x72, 0x12, 0x77, 0x47,
-	0xe2, 0x78, 0xdd, 0xf2, 0x57, 0x0d, 0x7f, 0x14, 0x88, 0x88, 0xc3, 0xa2, 0xdc, 0xaa, 0x07, 0x0d,
-	0xdc, 0xc3, 0x7d, 0xf7, 0xcc, 0x04, 0x77, 0x7d, 0x24, 0x3e, 0xec, 0x1e, 0x05, 0x22, 0xe2, 0xb0,
-	0xc0, 0xcf, 0x00, 0x2a, 0x32, 0x61, 0xa7, 0x54, 0x44, 0x59, 0x7f, 0x34, 0xfe, 0xd1, 0xee, 0xad,
-	0xb1, 0x1f, 0xa7, 0x21, 0x4c, 0xfe, 0x76, 0x2e, 0x86, 0xec, 0xa3, 0x48, 0x44, 0x1c, 0x1e, 0xf8,
-	0xc3, 0x03, 0xe5, 0x5e, 0xc6, 0x84, 0x69, 0x8a, 0xa5, 0x91, 0x99, 0xbb, 0xfe, 0x11, 0xfc, 0xab,
-	0xdb, 0x08, 0x9d, 0x6d, 0xbc, 0x1b, 0x5b, 0x86, 0xb7, 0x6c, 0x33, 0xeb, 0xa6, 0x99, 0xf1, 0x02,
-	0x44, 0x26, 0xd8, 0xe1, 0x57, 0x0f, 0xac, 0x08, 0x96, 0x31, 0x71, 0xc2, 0x70, 0xef, 0xbc, 0x49,
-	0x5b, 0xc7, 0x3a, 0xf8, 0x9c, 0x4a, 0xea, 0xcf, 0x4e, 0xb8, 0x3b, 0xc4, 0x5d, 0x83, 0x1f, 0xda,
-	0xb6, 0x02, 0xd3, 0xd6, 0x18, 0x34, 0x22, 0xe3, 0xa4, 0x90, 0x81, 0x12, 0x3b, 0x93, 0x2c, 0x8d,
-	0x58, 0xd4, 0x50, 0xf3, 0xe3, 0x03, 0xdd, 0x05, 0x72, 0x76, 0xf1, 0x22, 0x9f, 0xc4, 0x6b, 0xd6,
-	0xbd, 0x64, 0xdc, 0x03, 0x18, 0x44, 0xe6, 0xfa, 0x6b, 0xb5, 0x84, 0x47, 0xa0, 0x44, 0x7b, 0x2d,
-	0x19, 0xf3, 0xb4, 0xae, 0x7f, 0xb9, 0xfc, 0xe2, 0x04, 0xcd, 0x6e, 0x3e, 0x39, 0xac, 0x19, 0xc0,
-	0x20, 0x32, 0x88, 0x85, 0x02, 0x2c, 0xe8, 0xcb, 0x40, 0xa8, 0x64, 0x99, 0x55, 0xcd, 0x69, 0xd5,
-	0x83, 0xf1, 0x17, 0x2e, 0x17, 0xc6, 0x15, 0x6b, 0x5b, 0xc9, 0x5d, 0xb7, 0xdc, 0x3e, 0x22, 0x23,
-	0x7c, 0xf8, 0x06, 0xcc, 0x74, 0x78, 0x84, 0x69, 0xe2, 0x97, 0xaa, 0xde, 0x66, 0x71, 0xe7, 0xbe,
-	0xd3, 0xb4, 0xaf, 0x23, 0x78, 0xd9, 0xf2, 0x4b, 0x86, 0x6f, 0x0a, 0x11, 0xb1, 0x04, 0x78, 0x08,
-	0x40, 0xff, 0x4b, 0xd1, 0xc4, 0xff, 0x4f, 0xf3, 0x2a, 0x13, 0x27, 0x82, 0x26, 0x78, 0xd5, 0x32,
-	0xff, 0x1f, 0x1c, 0x02, 0xc5, 0xcd, 0xd1, 0x60, 0x0a, 0xe6, 0x69, 0x92, 0xd8, 0x3a, 0xf5, 0x50,
-	0x64, 0xfe, 0xbc, 0x3e, 0x9a, 0x0d, 0xf7, 0xd1, 0x0c, 0x66, 0x71, 0x60, 0x2d, 0xf7, 0xec, 0xc9,
-	0x0c, 0x6e, 0x23, 0x32, 0x0c, 0xc7, 0xaf, 0x2e, 0xae, 0x03, 0xef, 0xf2, 0x3a, 0xf0, 0x7e, 0x5d,
-	0x07, 0xde, 0xb7, 0x9b, 0xa0, 0x70, 0x79, 0x13, 0x14, 0x7e, 0xde, 0x04, 0x85, 0xc3, 0x5a, 0x3b,
-	0x96, 0x1f, 0x7b, 0x4d, 0xa5, 0x0d, 0x8d, 0xfa, 0x09, 0x3f, 0x3a, 0x8a, 0x5b, 0x31, 0x4d, 0xec,
-	0x3a, 0xb4, 0xef, 0x9d, 0x3c, 0xef, 0xb2, 0xac, 0x39, 0xa3, 0xdf, 0xb9, 0xa7, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x35, 0x6f, 0x90, 0x3f, 0x79, 0x07, 0x00, 0x00,
+	// 980 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0xc1, 0x6e, 0x1c, 0x35,
+	0x18, 0xc7, 0x33, 0xb4, 0x14, 0xea, 0x24, 0x4d, 0xe2, 0x64, 0x1b, 0x27, 0x94, 0xc9, 0xc6, 0x2a,
+	0xa5, 0x15, 0xb0, 0xab, 0x96, 0x1b, 0xb7, 0x38, 0x69, 0x0a, 0x28, 0x91, 0x22, 0x13, 0x40, 0xea,
+	0x81, 0xc8, 0x3b, 0xe3, 0x6c, 0x47, 0x99, 0x1d, 0x2f, 0xb6, 0x37, 0xcd, 0x22, 0x38, 0x22, 0x71,
+	0x01, 0x71, 0xe6, 0x89, 0x7a, 0xec, 0x91, 0x0b, 0x15, 0x4a, 0xde, 0x80, 0x27, 0x40, 0x63, 0x7b,
+	0xd3, 0x59, 0x8f, 0x77, 0x6e, 0x99, 0x99, 0xff, 0xf7, 0xfb, 0x7d, 0xf1, 0x7c, 0xf6, 0x2c, 0xd8,
+	0x4e, 0xc4, 0x20, 0xe5, 0x17, 0xdd, 0x9c, 0x17, 0x69, 0xf7, 0xfc, 0x71, 0x8f, 0x6b, 0xf6, 0xb8,
+	0xdb, 0xe7, 0x05, 0x57, 0x99, 0xea, 0x0c, 0xa5, 0xd0, 0x02, 0xae, 0xda, 0x48, 0xa7, 0x8c, 0x74,
+	0x5c, 0x64, 0x73, 0xad, 0x2f, 0xfa, 0xc2, 0x3c, 0xef, 0x96, 0x7f, 0xd9, 0xe8, 0x66, 0x1c, 0xa2,
+	0x99, 0x3a, 0xfb, 0xbc, 0x1d, 0x7a, 0x3e, 0x64, 0x92, 0x0d, 0x9c, 0x0c, 0xff, 0xd3, 0x02, 0x0b,
+	0xcf, 0xac, 0xfe, 0x1b, 0xcd, 0x34, 0x87, 0x3f, 0x80, 0xf9, 0x9e, 0x90, 0x52, 0xbc, 0xdc, 0x51,
+	0x8a, 0x6b, 0x14, 0xb5, 0x6f, 0x3c, 0x9c, 0x7f, 0xd2, 0xee, 0x04, 0x7a, 0xea, 0x90, 0xb7, 0x39,
+	0xb2, 0xf9, 0xea, 0xcd, 0xd6, 0xdc, 0x7f, 0x6f, 0xb6, 0xe0, 0x98, 0x0d, 0xf2, 0x2f, 0x70, 0x05,
+	0x81, 0x69, 0x15, 0x08, 0x7f, 0x8b, 0x40, 0xcb, 0x5e, 0x7f, 0x55, 0x68, 0x2e, 0xb9, 0xd2, 0xc7,
+	0x92, 0x25, 0x67, 0x5c, 0xa2, 0x77, 0x8c, 0xea, 0xd3, 0x06, 0xd5, 0x49, 0xe6, 0x4a, 0x4e, 0xb4,
+	0xad, 0x21, 0xf7, 0x9d, 0xf6, 0x5e, 0x55, 0xeb, 0x81, 0x31, 0x0d, 0x0b, 0xe1, 0x77, 0xe0, 0x76,
+	0x29, 0xb1, 0xff, 0xe8, 0x0d, 0x63, 0x8f, 0x83, 0xf6, 0x83, 0x49, 0x8a, 0x20, 0xe7, 0x5b, 0xb6,
+	0xbe, 0xeb, 0x72, 0x4c, 0xdf, 0xa2, 0x20, 0x01, 0x37, 0x87, 0x42, 0xe4, 0xe8, 0xa6, 0x41, 0x6e,
+	0x04, 0x91, 0x47, 0x42, 0xe4, 0x64, 0xd5, 0xd1, 0xe6, 0x2d, 0xad, 0x2c, 0xc2, 0xd4, 0xd4, 0xc2,
+	0x9f, 0x00, 0x64, 0x25, 0xec, 0x58, 0x1c, 0xb1, 0x4c, 0x1e, 0xb2, 0xe1, 0x30, 0x2b, 0xfa, 0xe8,
+	0x5d, 0x43, 0xfc, 0x38, 0x48, 0xdc, 0xa9, 0xc5, 0xc9, 0xb6, 0xe3, 0x6f, 0x58, 0x7e, 0x1d, 0x88,
+	0x69, 0xc0, 0x52, 0xba, 0xcb, 0x1e, 0x0c, 0xf0, 0x80, 0x4c, 0xdc, 0xb7, 0x1a, 0xdc, 0x47, 0xb5,
+	0xb8, 0xef, 0xae, 0x03, 0x31, 0x0d, 0x58, 0xe0, 0xcf, 0x00, 0x96, 0x64, 0xca, 0x5f, 0x32, 0x99,
+	0xaa, 0xc9, 0x68, 0xbc, 0x67, 0xdc, 0x8f, 0x66, 0xbe, 0x9c, 0x13, 0x69, 0xf3, 0xd7, 0x73, 0xe1,
+	0xd9, 0xeb, 0x48, 0x4c, 0x03, 0x1e, 0xf8, 0x57, 0x04, 0x36, 0x47, 0x8a, 0x4b, 0xdb, 0x14, 0x2f,
+	0x52, 0x3b, 0x77, 0x93, 0x25, 0x78, 0xdf, 0xb4, 0xd1, 0x0d, 0xb6, 0xf1, 0xed, 0xcc, 0x32, 0xf2,
+	0xc8, 0x35, 0xb3, 0x6d, 0x9b, 0x99, 0x2d, 0xc0, 0xb4, 0xc1, 0x0e, 0xff, 0x88, 0xc0, 0xba, 0xe4,
+	0x8a, 0xcb, 0x73, 0x4e, 0x46, 0xe3, 0x1e, 0x4b, 0xce, 0x4c, 0x70, 0x8f, 0x69, 0x86, 0x6e, 0x37,
+	0xec, 0x1d, 0x1a, 0xae, 0x21, 0x0f, 0x5c, 0x5b, 0xb1, 0x6d, 0x6b, 0x06, 0x1a, 0xd3, 0x59, 0x52,
+	0xc8, 0xc1, 0x22, 0xbf, 0xd0, 0xbc, 0x48, 0x79, 0x7a, 0x52, 0xce, 0x0f, 0x02, 0xa6, 0x0b, 0x1c,
+	0xec, 0xe2, 0x69, 0x35, 0x49, 0xee, 0x39, 0xf7, 0x9a, 0x75, 0x4f, 0x61, 0x30, 0x5d, 0x98, 0x5c,
+	0x97, 0x97, 0xf0, 0x14, 0x2c, 0xb2, 0x51, 0xa2, 0x33, 0x51, 0x1c, 0x99, 0x93, 0x0b, 0xcd, 0x37,
+	0x68, 0x76, 0xaa, 0x49, 0x5f, 0x33, 0x85, 0xc1, 0x74, 0x1a, 0x0b, 0x25, 0x58, 0x36, 0x9b, 0x81,
+	0x32, 0xcd, 0x95, 0x53, 0x2d, 0x18, 0xd5, 0x47, 0xb3, 0x37, 0x5c, 0x25, 0x4c, 0xb6, 0x9c, 0x6d,
+	0xbd, 0xb2, 0xdd, 0x2a, 0xcf, 0x31, 0xad, 0xf1, 0xe1, 0xd7, 0xe0, 0xd6, 0x40, 0xa4, 0x84, 0xe5,
+	0x68, 0xb1, 0x1d, 0x3d, 0x9c, 0x7f, 0xf2, 0x41, 0xd0, 0x74, 0x68, 0x22, 0xa4, 0xe5, 0xf8, 0x8b,
+	0x96, 0x6f, 0x0b, 0x31, 0x75, 0x04, 0xf8, 0x1c, 0x80, 0xc9, 0x9b, 0x62, 0x39, 0xba, 0x63, 0x78,
+	0x5b, 0x8d, 0x13, 0xc1, 0x72, 0xb2, 0xe1, 0x98, 0x2b, 0xd3, 0x43, 0x50, 0x72, 0x2b, 0x34, 0x58,
+	0x80, 0x25, 0x96, 0xe7, 0xae, 0xae, 0xfc, 0x50, 0x28, 0xb4, 0x64, 0x96, 0xe6, 0x7e, 0x78, 0x69,
+	0xa6, 0xb3, 0x24, 0x76, 0x96, 0xbb, 0x6e, 0x65, 0xa6, 0x1f, 0x63, 0xea, 0xc3, 0xe1, 0xaf, 0x11,
+	0x68, 0x0d, 0x85, 0xca, 0xca, 0xd7, 0x73, 0xc8, 0x0a, 0xd6, 0xe7, 0xf2, 0x99, 0x64, 0x85, 0x56,
+	0x68, 0xb9, 0xe1, 0x28, 0x38, 0x0a, 0x54, 0xf8, 0x9f, 0x88, 0x20, 0x15, 0xd3, 0xb0, 0x0d, 0xe6,
+	0x60, 0x89, 0x1f, 0x8a, 0x94, 0xef, 0x32, 0xcd, 0xfb, 0x42, 0x66, 0x5c, 0xa1, 0x95, 0xa6, 0x21,
+	0xaf, 0x64, 0xc7, 0xfe, 0x7f, 0xed, 0x81, 0x30, 0xf5, 0xd1, 0x70, 0x0c, 0x20, 0x4b, 0xd9, 0x50,
+	0x67, 0xe7, 0xbc, 0x1c, 0x12, 0xf3, 0x41, 0x56, 0x08, 0x1a, 0xe1, 0x83, 0xf0, 0x42, 0xfb, 0xf1,
+	0xda, 0x99, 0x5f, 0xe3, 0x95, 0x67, 0x7e, 0xed, 0x66, 0x39, 0xfc, 0x3d, 0x96, 0xee, 0xf1, 0x9e,
+	0xfe, 0x9e, 0x69, 0x2e, 0x4f, 0x59, 0x9e, 0xa3, 0xd5, 0x76, 0x34, 0x73, 0xf8, 0x89, 0x17, 0xf6,
+	0x87, 0xdf, 0x87, 0x61, 0x5a, 0xe3, 0xc3, 0x5f, 0xc0, 0x6a, 0x72, 0x2c, 0xce, 0x78, 0xf1, 0xf4,
+	0x22, 0x79, 0xc1, 0x8a, 0xbe, 0xe9, 0x47, 0xa1, 0xb5, 0x86, 0x0f, 0xcd, 0x6e, 0x2d, 0x4f, 0xb0,
+	0x13, 0x6f, 0x5a, 0x71, 0x80, 0x88, 0x69, 0xc8, 0x03, 0x7f, 0x8f, 0xc0, 0x7a, 0x22, 0x85, 0x52,
+	0xbb, 0x22, 0xcf, 0xcb, 0xa6, 0x58, 0xbe, 0x93, 0x24, 0x62, 0x54, 0x4e, 0x59, 0xcb, 0xf4, 0xf0,
+	0x49, 0xb8, 0x87, 0x60, 0x8d, 0x7f, 0x9c, 0xce, 0x20, 0x63, 0x3a, 0xcb, 0x09, 0x7f, 0x04, 0xcb,
+	0xa7, 0xd9, 0x05, 0x4f, 0x8f, 0xb9, 0x1c, 0x1c, 0x32, 0x79, 0xc6, 0xb5, 0x42, 0x77, 0x1b, 0x36,
+	0xd9, 0xfe, 0x74, 0xd8, 0x7f, 0x03, 0x3e, 0x0b, 0xd3, 0x1a, 0x1e, 0x8e, 0xc0, 0xca, 0xf5, 0xbd,
+	0x3d, 0x6e, 0xb6, 0x80, 0x42, 0xeb, 0x0d, 0x67, 0xde, 0xbe, 0x97, 0x26, 0x6d, 0x27, 0x45, 0x9e,
+	0x74, 0x42, 0xc3, 0xb4, 0x6e, 0x80, 0x19, 0xb8, 0x73, 0x7d, 0xf3, 0x40, 0xb0, 0x42, 0x21, 0xd4,
+	0xb0, 0xa9, 0xf6, 0xab, 0x51, 0xf2, 0xa1, 0x13, 0xb6, 0x3c, 0xa1, 0xe1, 0x60, 0xea, 0x81, 0xc9,
+	0x97, 0xaf, 0x2e, 0xe3, 0xe8, 0xf5, 0x65, 0x1c, 0xfd, 0x7b, 0x19, 0x47, 0x7f, 0x5e, 0xc5, 0x73,
+	0xaf, 0xaf, 0xe2, 0xb9, 0xbf, 0xaf, 0xe2, 0xb9, 0xe7, 0x9d, 0x7e, 0xa6, 0x5f, 0x8c, 0x7a, 0xa5,
+	0xb2, 0x6b, 0xb5, 0x9f, 0x89, 0xd3, 0xd3, 0x2c, 0xc9, 0x58, 0xee, 0xae, 0xbb, 0xee, 0x87, 0xb3,
+	0x1e, 0x0f, 0xb9, 0xea, 0xdd, 0x32, 0x3f, 0x98, 0x3f, 0xff, 0x7f, 0x00, 0x07, 0x5e, 0x7c, 0xf9,
+	0xc2, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -253,6 +343,146 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FixedTermLoans) > 0 {
+		for iNdEx := len(m.FixedTermLoans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FixedTermLoans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.FixedTermDeposits) > 0 {
+		for iNdEx := len(m.FixedTermDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FixedTermDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.FixedTermMarkets) > 0 {
+		for iNdEx := len(m.FixedTermMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FixedTermMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.CrossCollateralAccounts) > 0 {
+		for iNdEx := len(m.CrossCollateralAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossCollateralAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.CTokenExchangeRates) > 0 {
+		for iNdEx := len(m.CTokenExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CTokenExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	{
+		size, err := m.BadDebtWaterfall.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.AdaptiveRateStates) > 0 {
+		for iNdEx := len(m.AdaptiveRateStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdaptiveRateStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.EModeCategories) > 0 {
+		for iNdEx := len(m.EModeCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EModeCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PositionManagerGrants) > 0 {
+		for iNdEx := len(m.PositionManagerGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionManagerGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.AllReserveStats) > 0 {
 		for iNdEx := len(m.AllReserveStats) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -557,6 +787,56 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PositionManagerGrants) > 0 {
+		for _, e := range m.PositionManagerGrants {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EModeCategories) > 0 {
+		for _, e := range m.EModeCategories {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdaptiveRateStates) > 0 {
+		for _, e := range m.AdaptiveRateStates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.BadDebtWaterfall.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.CTokenExchangeRates) > 0 {
+		for _, e := range m.CTokenExchangeRates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CrossCollateralAccounts) > 0 {
+		for _, e := range m.CrossCollateralAccounts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FixedTermMarkets) > 0 {
+		for _, e := range m.FixedTermMarkets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FixedTermDeposits) > 0 {
+		for _, e := range m.FixedTermDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FixedTermLoans) > 0 {
+		for _, e := range m.FixedTermLoans {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1103,6 +1383,311 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionManagerGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionManagerGrants = append(m.PositionManagerGrants, PositionManagerGrant{})
+			if err := m.PositionManagerGrants[len(m.PositionManagerGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EModeCategories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EModeCategories = append(m.EModeCategories, EModeCategory{})
+			if err := m.EModeCategories[len(m.EModeCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveRateStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdaptiveRateStates = append(m.AdaptiveRateStates, AdaptiveRateState{})
+			if err := m.AdaptiveRateStates[len(m.AdaptiveRateStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebtWaterfall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebtWaterfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CTokenExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CTokenExchangeRates = append(m.CTokenExchangeRates, CTokenExchangeRate{})
+			if err := m.CTokenExchangeRates[len(m.CTokenExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossCollateralAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossCollateralAccounts = append(m.CrossCollateralAccounts, CrossCollateralAccount{})
+			if err := m.CrossCollateralAccounts[len(m.CrossCollateralAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedTermMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixedTermMarkets = append(m.FixedTermMarkets, FixedTermMarket{})
+			if err := m.FixedTermMarkets[len(m.FixedTermMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedTermDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixedTermDeposits = append(m.FixedTermDeposits, FixedTermDeposit{})
+			if err := m.FixedTermDeposits[len(m.FixedTermDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedTermLoans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixedTermLoans = append(m.FixedTermLoans, FixedTermLoan{})
+			if err := m.FixedTermLoans[len(m.FixedTermLoans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -14,6 +16,11 @@ var (
 	ProposalAddMultipleAssetToPair = "ProposalAddMultipleAssetToPair"
 	ProposalAddPoolPairs           = "ProposalAddPoolPairs"
 	ProposalAddAssetRatesPoolPairs = "ProposalAddAssetRatesPoolPairs"
+	ProposalSetEModeCategory       = "ProposalSetEModeCategory"
+	ProposalSetBadDebtWaterfall    = "ProposalSetBadDebtWaterfall"
+	ProposalAddFixedTermMarket     = "ProposalAddFixedTermMarket"
+	ProposalUpdateAssetRatesParams = "ProposalUpdateAssetRatesParams"
+	ProposalUpdatePoolPairs        = "ProposalUpdatePoolPairs"
 )
 
 func init() {
@@ -35,6 +42,16 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(&AddPoolPairsProposal{}, "comdex/AddPoolPairsProposal")
 	govtypes.RegisterProposalType(ProposalAddAssetRatesPoolPairs)
 	govtypes.RegisterProposalTypeCodec(&AddAssetRatesPoolPairsProposal{}, "comdex/AddAssetRatesPoolPairsProposal")
+	govtypes.RegisterProposalType(ProposalSetEModeCategory)
+	govtypes.RegisterProposalTypeCodec(&SetEModeCategoryProposal{}, "comdex/SetEModeCategoryProposal")
+	govtypes.RegisterProposalType(ProposalSetBadDebtWaterfall)
+	govtypes.RegisterProposalTypeCodec(&SetBadDebtWaterfallProposal{}, "comdex/SetBadDebtWaterfallProposal")
+	govtypes.RegisterProposalType(ProposalAddFixedTermMarket)
+	govtypes.RegisterProposalTypeCodec(&AddFixedTermMarketProposal{}, "comdex/AddFixedTermMarketProposal")
+	govtypes.RegisterProposalType(ProposalUpdateAssetRatesParams)
+	govtypes.RegisterProposalTypeCodec(&UpdateAssetRatesParamsProposal{}, "comdex/UpdateAssetRatesParamsProposal")
+	govtypes.RegisterProposalType(ProposalUpdatePoolPairs)
+	govtypes.RegisterProposalTypeCodec(&UpdatePoolPairsProposal{}, "comdex/UpdatePoolPairsProposal")
 }
 
 var (
@@ -47,6 +64,11 @@ var (
 	_ govtypes.Content = &AddMultipleAssetToPairProposal{}
 	_ govtypes.Content = &AddPoolPairsProposal{}
 	_ govtypes.Content = &AddAssetRatesPoolPairsProposal{}
+	_ govtypes.Content = &SetEModeCategoryProposal{}
+	_ govtypes.Content = &SetBadDebtWaterfallProposal{}
+	_ govtypes.Content = &AddFixedTermMarketProposal{}
+	_ govtypes.Content = &UpdateAssetRatesParamsProposal{}
+	_ govtypes.Content = &UpdatePoolPairsProposal{}
 )
 
 func NewAddLendPairsProposal(title, description string, pairs Extended_Pair) govtypes.Content {
@@ -309,3 +331,161 @@ func (p *AddAssetRatesPoolPairsProposal) ValidateBasic() error {
 
 	return nil
 }
+
+func NewSetEModeCategoryProposal(title, description string, category EModeCategory) govtypes.Content {
+	return &SetEModeCategoryProposal{
+		Title:       title,
+		Description: description,
+		Category:    category,
+	}
+}
+
+func (p *SetEModeCategoryProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (p *SetEModeCategoryProposal) ProposalType() string {
+	return ProposalSetEModeCategory
+}
+
+func (p *SetEModeCategoryProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err = p.Category.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewSetBadDebtWaterfallProposal(title, description string, waterfall BadDebtWaterfall) govtypes.Content {
+	return &SetBadDebtWaterfallProposal{
+		Title:       title,
+		Description: description,
+		Waterfall:   waterfall,
+	}
+}
+
+func (p *SetBadDebtWaterfallProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (p *SetBadDebtWaterfallProposal) ProposalType() string {
+	return ProposalSetBadDebtWaterfall
+}
+
+func (p *SetBadDebtWaterfallProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err = p.Waterfall.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewAddFixedTermMarketProposal(title, description string, market FixedTermMarket) govtypes.Content {
+	return &AddFixedTermMarketProposal{
+		Title:       title,
+		Description: description,
+		Market:      market,
+	}
+}
+
+func (p *AddFixedTermMarketProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (p *AddFixedTermMarketProposal) ProposalType() string {
+	return ProposalAddFixedTermMarket
+}
+
+func (p *AddFixedTermMarketProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err = p.Market.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewUpdateAssetRatesParamsProposal(title, description string, assetRatesParams AssetRatesParams) govtypes.Content {
+	return &UpdateAssetRatesParamsProposal{
+		Title:            title,
+		Description:      description,
+		AssetRatesParams: assetRatesParams,
+	}
+}
+
+func (p *UpdateAssetRatesParamsProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (p *UpdateAssetRatesParamsProposal) ProposalType() string {
+	return ProposalUpdateAssetRatesParams
+}
+
+func (p *UpdateAssetRatesParamsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err = p.AssetRatesParams.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewUpdatePoolPairsProposal(title, description string, poolID uint64, assetData []*AssetDataPoolMapping, minUsdValueLeft uint64) govtypes.Content {
+	return &UpdatePoolPairsProposal{
+		Title:           title,
+		Description:     description,
+		PoolID:          poolID,
+		AssetData:       assetData,
+		MinUsdValueLeft: minUsdValueLeft,
+	}
+}
+
+func (p *UpdatePoolPairsProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (p *UpdatePoolPairsProposal) ProposalType() string {
+	return ProposalUpdatePoolPairs
+}
+
+func (p *UpdatePoolPairsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.PoolID == 0 {
+		return fmt.Errorf("pool id cannot be zero")
+	}
+	if len(p.AssetData) == 0 && p.MinUsdValueLeft == 0 {
+		return ErrorEmptyProposalAssets
+	}
+	for _, data := range p.AssetData {
+		if data == nil || data.AssetID == 0 {
+			return fmt.Errorf("asset id cannot be zero")
+		}
+		if data.SupplyCap.IsNil() || data.SupplyCap.IsNegative() {
+			return fmt.Errorf("supply cap of asset %d cannot be negative", data.AssetID)
+		}
+	}
+
+	return nil
+}
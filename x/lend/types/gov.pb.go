@@ -503,6 +503,186 @@ func (m *AddPoolPairsProposal) GetPoolPairs() PoolPairs {
 	return PoolPairs{}
 }
 
+type SetEModeCategoryProposal struct {
+	Title       string        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Category    EModeCategory `protobuf:"bytes,3,opt,name=category,proto3" json:"category"`
+}
+
+func (m *SetEModeCategoryProposal) Reset()         { *m = SetEModeCategoryProposal{} }
+func (m *SetEModeCategoryProposal) String() string { return proto.CompactTextString(m) }
+func (*SetEModeCategoryProposal) ProtoMessage()    {}
+func (*SetEModeCategoryProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c877ba3eefc3a22, []int{8}
+}
+func (m *SetEModeCategoryProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetEModeCategoryProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetEModeCategoryProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetEModeCategoryProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetEModeCategoryProposal.Merge(m, src)
+}
+func (m *SetEModeCategoryProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetEModeCategoryProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetEModeCategoryProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetEModeCategoryProposal proto.InternalMessageInfo
+
+func (m *SetEModeCategoryProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetEModeCategoryProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetEModeCategoryProposal) GetCategory() EModeCategory {
+	if m != nil {
+		return m.Category
+	}
+	return EModeCategory{}
+}
+
+type SetBadDebtWaterfallProposal struct {
+	Title       string           `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Waterfall   BadDebtWaterfall `protobuf:"bytes,3,opt,name=waterfall,proto3" json:"waterfall"`
+}
+
+func (m *SetBadDebtWaterfallProposal) Reset()         { *m = SetBadDebtWaterfallProposal{} }
+func (m *SetBadDebtWaterfallProposal) String() string { return proto.CompactTextString(m) }
+func (*SetBadDebtWaterfallProposal) ProtoMessage()    {}
+func (*SetBadDebtWaterfallProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c877ba3eefc3a22, []int{9}
+}
+func (m *SetBadDebtWaterfallProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBadDebtWaterfallProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBadDebtWaterfallProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetBadDebtWaterfallProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBadDebtWaterfallProposal.Merge(m, src)
+}
+func (m *SetBadDebtWaterfallProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBadDebtWaterfallProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBadDebtWaterfallProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBadDebtWaterfallProposal proto.InternalMessageInfo
+
+func (m *SetBadDebtWaterfallProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetBadDebtWaterfallProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetBadDebtWaterfallProposal) GetWaterfall() BadDebtWaterfall {
+	if m != nil {
+		return m.Waterfall
+	}
+	return BadDebtWaterfall{}
+}
+
+type AddFixedTermMarketProposal struct {
+	Title       string          `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Market      FixedTermMarket `protobuf:"bytes,3,opt,name=market,proto3" json:"market"`
+}
+
+func (m *AddFixedTermMarketProposal) Reset()         { *m = AddFixedTermMarketProposal{} }
+func (m *AddFixedTermMarketProposal) String() string { return proto.CompactTextString(m) }
+func (*AddFixedTermMarketProposal) ProtoMessage()    {}
+func (*AddFixedTermMarketProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c877ba3eefc3a22, []int{10}
+}
+func (m *AddFixedTermMarketProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddFixedTermMarketProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddFixedTermMarketProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddFixedTermMarketProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFixedTermMarketProposal.Merge(m, src)
+}
+func (m *AddFixedTermMarketProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddFixedTermMarketProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFixedTermMarketProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddFixedTermMarketProposal proto.InternalMessageInfo
+
+func (m *AddFixedTermMarketProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *AddFixedTermMarketProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AddFixedTermMarketProposal) GetMarket() FixedTermMarket {
+	if m != nil {
+		return m.Market
+	}
+	return FixedTermMarket{}
+}
+
 type AddAssetRatesPoolPairsProposal struct {
 	Title               string              `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
 	Description         string              `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
@@ -513,7 +693,7 @@ func (m *AddAssetRatesPoolPairsProposal) Reset()         { *m = AddAssetRatesPoo
 func (m *AddAssetRatesPoolPairsProposal) String() string { return proto.CompactTextString(m) }
 func (*AddAssetRatesPoolPairsProposal) ProtoMessage()    {}
 func (*AddAssetRatesPoolPairsProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c877ba3eefc3a22, []int{8}
+	return fileDescriptor_4c877ba3eefc3a22, []int{11}
 }
 func (m *AddAssetRatesPoolPairsProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,6 +743,144 @@ func (m *AddAssetRatesPoolPairsProposal) GetAssetRatesPoolPairs() AssetRatesPool
 	return AssetRatesPoolPairs{}
 }
 
+type UpdateAssetRatesParamsProposal struct {
+	Title            string           `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description      string           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	AssetRatesParams AssetRatesParams `protobuf:"bytes,3,opt,name=AssetRatesParams,proto3" json:"AssetRatesParams"`
+}
+
+func (m *UpdateAssetRatesParamsProposal) Reset()         { *m = UpdateAssetRatesParamsProposal{} }
+func (m *UpdateAssetRatesParamsProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateAssetRatesParamsProposal) ProtoMessage()    {}
+func (*UpdateAssetRatesParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c877ba3eefc3a22, []int{12}
+}
+func (m *UpdateAssetRatesParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateAssetRatesParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateAssetRatesParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateAssetRatesParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAssetRatesParamsProposal.Merge(m, src)
+}
+func (m *UpdateAssetRatesParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateAssetRatesParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAssetRatesParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAssetRatesParamsProposal proto.InternalMessageInfo
+
+func (m *UpdateAssetRatesParamsProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdateAssetRatesParamsProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateAssetRatesParamsProposal) GetAssetRatesParams() AssetRatesParams {
+	if m != nil {
+		return m.AssetRatesParams
+	}
+	return AssetRatesParams{}
+}
+
+// UpdatePoolPairsProposal updates the supply caps of the assets of a pool and
+// the min usd value left of the pairs borrowing from it.
+type UpdatePoolPairsProposal struct {
+	Title           string                  `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description     string                  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	PoolID          uint64                  `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	AssetData       []*AssetDataPoolMapping `protobuf:"bytes,4,rep,name=asset_data,json=assetData,proto3" json:"asset_data,omitempty" yaml:"asset_data"`
+	MinUsdValueLeft uint64                  `protobuf:"varint,5,opt,name=min_usd_value_left,json=minUsdValueLeft,proto3" json:"min_usd_value_left,omitempty" yaml:"min_usd_value_left"`
+}
+
+func (m *UpdatePoolPairsProposal) Reset()         { *m = UpdatePoolPairsProposal{} }
+func (m *UpdatePoolPairsProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdatePoolPairsProposal) ProtoMessage()    {}
+func (*UpdatePoolPairsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c877ba3eefc3a22, []int{13}
+}
+func (m *UpdatePoolPairsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePoolPairsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePoolPairsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatePoolPairsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePoolPairsProposal.Merge(m, src)
+}
+func (m *UpdatePoolPairsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePoolPairsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePoolPairsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePoolPairsProposal proto.InternalMessageInfo
+
+func (m *UpdatePoolPairsProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdatePoolPairsProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdatePoolPairsProposal) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *UpdatePoolPairsProposal) GetAssetData() []*AssetDataPoolMapping {
+	if m != nil {
+		return m.AssetData
+	}
+	return nil
+}
+
+func (m *UpdatePoolPairsProposal) GetMinUsdValueLeft() uint64 {
+	if m != nil {
+		return m.MinUsdValueLeft
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*LendPairsProposal)(nil), "comdex.lend.v1beta1.LendPairsProposal")
 	proto.RegisterType((*MultipleLendPairsProposal)(nil), "comdex.lend.v1beta1.MultipleLendPairsProposal")
@@ -572,46 +890,67 @@ func init() {
 	proto.RegisterType((*AddAssetRatesParams)(nil), "comdex.lend.v1beta1.AddAssetRatesParams")
 	proto.RegisterType((*AddAuctionParamsProposal)(nil), "comdex.lend.v1beta1.AddAuctionParamsProposal")
 	proto.RegisterType((*AddPoolPairsProposal)(nil), "comdex.lend.v1beta1.AddPoolPairsProposal")
+	proto.RegisterType((*SetEModeCategoryProposal)(nil), "comdex.lend.v1beta1.SetEModeCategoryProposal")
+	proto.RegisterType((*SetBadDebtWaterfallProposal)(nil), "comdex.lend.v1beta1.SetBadDebtWaterfallProposal")
+	proto.RegisterType((*AddFixedTermMarketProposal)(nil), "comdex.lend.v1beta1.AddFixedTermMarketProposal")
 	proto.RegisterType((*AddAssetRatesPoolPairsProposal)(nil), "comdex.lend.v1beta1.AddAssetRatesPoolPairsProposal")
+	proto.RegisterType((*UpdateAssetRatesParamsProposal)(nil), "comdex.lend.v1beta1.UpdateAssetRatesParamsProposal")
+	proto.RegisterType((*UpdatePoolPairsProposal)(nil), "comdex.lend.v1beta1.UpdatePoolPairsProposal")
 }
 
 func init() { proto.RegisterFile("comdex/lend/v1beta1/gov.proto", fileDescriptor_4c877ba3eefc3a22) }
 
 var fileDescriptor_4c877ba3eefc3a22 = []byte{
-	// 528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0xc1, 0x6a, 0x13, 0x41,
-	0x18, 0xc7, 0x33, 0xb6, 0x15, 0xfa, 0x55, 0x21, 0x6e, 0x4a, 0xd9, 0x16, 0x9c, 0x94, 0x01, 0x35,
-	0x97, 0xee, 0x52, 0x7b, 0x11, 0x0f, 0x42, 0x02, 0x82, 0x07, 0x2b, 0x21, 0x0a, 0x82, 0x20, 0x3a,
-	0xc9, 0x4c, 0xd7, 0x81, 0xc9, 0xce, 0xb2, 0x3b, 0x29, 0xed, 0x5b, 0xf8, 0x1a, 0x3e, 0x80, 0x37,
-	0xbd, 0xf7, 0xe0, 0x21, 0x47, 0xbd, 0x04, 0x49, 0x7c, 0x82, 0xfa, 0x02, 0x32, 0xbb, 0x53, 0xbb,
-	0xdb, 0x6e, 0x0c, 0x78, 0xd8, 0xdc, 0x92, 0xfd, 0xfe, 0xdf, 0xf7, 0xff, 0xff, 0x32, 0x93, 0x6f,
-	0xe1, 0xee, 0x40, 0x0d, 0x19, 0x3f, 0xf1, 0x25, 0x0f, 0x99, 0x7f, 0xbc, 0xdf, 0xe7, 0x9a, 0xee,
-	0xfb, 0x81, 0x3a, 0xf6, 0xa2, 0x58, 0x69, 0xe5, 0x34, 0xb2, 0xb2, 0x67, 0xca, 0x9e, 0x2d, 0xef,
-	0x6c, 0x06, 0x2a, 0x50, 0x69, 0xdd, 0x37, 0x9f, 0x32, 0xe9, 0x0e, 0x2e, 0x9b, 0x94, 0xf6, 0xa5,
-	0x75, 0xf2, 0x19, 0xc1, 0x9d, 0xe7, 0x3c, 0x64, 0x5d, 0x2a, 0xe2, 0xa4, 0x1b, 0xab, 0x48, 0x25,
-	0x54, 0x3a, 0xf7, 0x61, 0x4d, 0x0b, 0x2d, 0xb9, 0x8b, 0x76, 0x51, 0x6b, 0xbd, 0x53, 0x3f, 0x9f,
-	0x34, 0x6f, 0x9d, 0xd2, 0xa1, 0x7c, 0x4c, 0xd2, 0xc7, 0xa4, 0x97, 0x95, 0x9d, 0x47, 0xb0, 0xc1,
-	0x78, 0x32, 0x88, 0x45, 0xa4, 0x85, 0x0a, 0xdd, 0x1b, 0xa9, 0x7a, 0xeb, 0x7c, 0xd2, 0x74, 0x32,
-	0x75, 0xae, 0x48, 0x7a, 0x79, 0xa9, 0xf3, 0x04, 0xd6, 0x22, 0x63, 0xe9, 0xae, 0xec, 0xa2, 0xd6,
-	0xc6, 0x43, 0xe2, 0x95, 0x20, 0x79, 0x4f, 0x4f, 0x34, 0x0f, 0x19, 0x67, 0xef, 0x4c, 0xba, 0xce,
-	0xea, 0xd9, 0xa4, 0x59, 0xeb, 0x65, 0x6d, 0xe4, 0x2b, 0x82, 0xed, 0xc3, 0x91, 0xd4, 0x22, 0x92,
-	0x7c, 0xc9, 0xf9, 0x57, 0xfe, 0x27, 0xff, 0x27, 0x04, 0xf5, 0x36, 0x63, 0x5d, 0xa5, 0x64, 0x95,
-	0xb1, 0x0f, 0x60, 0xd5, 0x58, 0xda, 0x5f, 0x7d, 0xbb, 0x34, 0xb5, 0x11, 0xd8, 0xb0, 0xa9, 0x98,
-	0xfc, 0x40, 0xb0, 0xd5, 0x66, 0xac, 0x9d, 0x24, 0x5c, 0xbf, 0x52, 0x86, 0xa5, 0xc2, 0xc4, 0x6f,
-	0xc1, 0xc9, 0x19, 0x1f, 0xd2, 0x28, 0x12, 0x61, 0x60, 0xf3, 0x3f, 0x28, 0xcd, 0x7f, 0x5d, 0x6e,
-	0x69, 0x4a, 0x06, 0x91, 0xdf, 0x08, 0x70, 0x9b, 0xb1, 0x8b, 0xab, 0xb4, 0x1c, 0x46, 0x05, 0x6e,
-	0xce, 0xf8, 0xa5, 0x08, 0x03, 0xc9, 0x2f, 0x49, 0xcd, 0xfd, 0xda, 0x5b, 0x44, 0x5a, 0x68, 0xb2,
-	0xbc, 0x73, 0x87, 0x92, 0x31, 0x82, 0xc6, 0xc5, 0x89, 0xf6, 0xa8, 0xe6, 0x49, 0x97, 0xc6, 0x74,
-	0x98, 0x54, 0x80, 0xfa, 0x1a, 0xea, 0x57, 0x5d, 0xed, 0x61, 0xde, 0x9b, 0x8f, 0x98, 0x13, 0x5b,
-	0xb4, 0x6b, 0x43, 0xc8, 0x37, 0x04, 0xae, 0x41, 0x1a, 0x0d, 0x8c, 0x4f, 0xf6, 0xb0, 0xc2, 0x23,
-	0x7c, 0x01, 0xb7, 0x0b, 0xd6, 0xff, 0xdc, 0x6b, 0x05, 0xa5, 0x25, 0x2a, 0xb6, 0x93, 0x2f, 0x08,
-	0x36, 0xed, 0x7e, 0xa8, 0x7a, 0xb5, 0x75, 0x60, 0xfd, 0xaf, 0xad, 0xc5, 0xc0, 0x73, 0x17, 0x45,
-	0xaa, 0xb2, 0x08, 0x97, 0x6d, 0xe4, 0x57, 0xf6, 0xb7, 0xca, 0x9d, 0xd2, 0x12, 0x40, 0xde, 0x43,
-	0xa3, 0x24, 0x80, 0x45, 0x6a, 0x2d, 0xba, 0x6e, 0x57, 0xe0, 0xca, 0x46, 0x75, 0x9e, 0x9d, 0x4d,
-	0x31, 0x1a, 0x4f, 0x31, 0xfa, 0x39, 0xc5, 0xe8, 0xe3, 0x0c, 0xd7, 0xc6, 0x33, 0x5c, 0xfb, 0x3e,
-	0xc3, 0xb5, 0x37, 0x5e, 0x20, 0xf4, 0x87, 0x51, 0xdf, 0x98, 0xf8, 0x99, 0xd1, 0x9e, 0x3a, 0x3a,
-	0x12, 0x03, 0x41, 0xa5, 0xfd, 0xee, 0xdb, 0x97, 0xb2, 0x3e, 0x8d, 0x78, 0xd2, 0xbf, 0x99, 0xbe,
-	0x8e, 0x0f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x27, 0xa0, 0xa1, 0x74, 0xfa, 0x07, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0x4d, 0x6f, 0xd3, 0x48,
+	0x18, 0xc7, 0x33, 0x6d, 0x9a, 0xdd, 0x4c, 0xf7, 0xa5, 0x75, 0xab, 0xae, 0xdb, 0xdd, 0xda, 0xd5,
+	0x68, 0x81, 0x72, 0x68, 0xa2, 0x52, 0x21, 0x21, 0x0e, 0x48, 0x09, 0x29, 0xa2, 0xa8, 0x41, 0x51,
+	0xda, 0x52, 0x09, 0x09, 0x85, 0x49, 0x66, 0x12, 0x2c, 0x26, 0x1e, 0xcb, 0x9e, 0x94, 0xf6, 0x5b,
+	0xf0, 0x35, 0xf8, 0x00, 0xdc, 0xe0, 0xc2, 0xa9, 0x42, 0x1c, 0x7a, 0x04, 0x21, 0x59, 0x28, 0xe5,
+	0xc8, 0x29, 0x7c, 0x01, 0x34, 0xf6, 0x24, 0x4d, 0x52, 0xa7, 0x95, 0x90, 0x70, 0x6e, 0x89, 0x9f,
+	0x97, 0xff, 0xff, 0xe7, 0xc7, 0xf3, 0x58, 0x86, 0xcb, 0x35, 0xde, 0x24, 0xf4, 0x30, 0xcb, 0xa8,
+	0x4d, 0xb2, 0x07, 0xeb, 0x55, 0x2a, 0xf0, 0x7a, 0xb6, 0xc1, 0x0f, 0x32, 0x8e, 0xcb, 0x05, 0xd7,
+	0xe6, 0xc2, 0x70, 0x46, 0x86, 0x33, 0x2a, 0xbc, 0x34, 0xdf, 0xe0, 0x0d, 0x1e, 0xc4, 0xb3, 0xf2,
+	0x57, 0x98, 0xba, 0x64, 0x44, 0x75, 0x0a, 0xea, 0x82, 0x38, 0x7a, 0x0d, 0xe0, 0xec, 0x36, 0xb5,
+	0x49, 0x09, 0x5b, 0xae, 0x57, 0x72, 0xb9, 0xc3, 0x3d, 0xcc, 0xb4, 0xab, 0x70, 0x4a, 0x58, 0x82,
+	0x51, 0x1d, 0xac, 0x80, 0xd5, 0x74, 0x7e, 0xa6, 0xe3, 0x9b, 0x7f, 0x1c, 0xe1, 0x26, 0xbb, 0x8d,
+	0x82, 0xcb, 0xa8, 0x1c, 0x86, 0xb5, 0x5b, 0x70, 0x9a, 0x50, 0xaf, 0xe6, 0x5a, 0x8e, 0xb0, 0xb8,
+	0xad, 0x4f, 0x04, 0xd9, 0x0b, 0x1d, 0xdf, 0xd4, 0xc2, 0xec, 0xbe, 0x20, 0x2a, 0xf7, 0xa7, 0x6a,
+	0x77, 0xe0, 0x94, 0x23, 0x25, 0xf5, 0xc9, 0x15, 0xb0, 0x3a, 0x7d, 0x03, 0x65, 0x22, 0x90, 0x32,
+	0x9b, 0x87, 0x82, 0xda, 0x84, 0x92, 0x8a, 0x74, 0x97, 0x4f, 0x1e, 0xfb, 0x66, 0xa2, 0x1c, 0x96,
+	0xa1, 0xb7, 0x00, 0x2e, 0x16, 0x5b, 0x4c, 0x58, 0x0e, 0xa3, 0x63, 0xf6, 0x3f, 0xf9, 0x33, 0xfe,
+	0x5f, 0x01, 0x38, 0x93, 0x23, 0xa4, 0xc4, 0x39, 0x8b, 0xd3, 0xf6, 0x06, 0x4c, 0x4a, 0x49, 0x75,
+	0xd7, 0x17, 0x23, 0x5d, 0xcb, 0x04, 0x65, 0x36, 0x48, 0x46, 0x9f, 0x00, 0x5c, 0xc8, 0x11, 0x92,
+	0xf3, 0x3c, 0x2a, 0x76, 0xb9, 0x64, 0x89, 0xd1, 0xf1, 0x13, 0xa8, 0xf5, 0x09, 0x17, 0xb1, 0xe3,
+	0x58, 0x76, 0x43, 0xf9, 0xbf, 0x16, 0xe9, 0xff, 0x7c, 0xba, 0xa2, 0x89, 0x68, 0x84, 0xbe, 0x03,
+	0x68, 0xe4, 0x08, 0xe9, 0x3e, 0x4a, 0xe3, 0x61, 0xe4, 0x50, 0xef, 0x13, 0xde, 0xb1, 0xec, 0x06,
+	0xa3, 0x67, 0xa4, 0xf2, 0xf9, 0x5a, 0xbb, 0x8c, 0x74, 0xa0, 0x48, 0xf1, 0x8e, 0x6c, 0x8a, 0x4e,
+	0x00, 0x9c, 0xeb, 0x4e, 0xb4, 0x8c, 0x05, 0xf5, 0x4a, 0xd8, 0xc5, 0x4d, 0x2f, 0x06, 0xd4, 0x7d,
+	0x38, 0x33, 0xac, 0xaa, 0x86, 0x79, 0x65, 0x34, 0x62, 0x5f, 0xb2, 0x42, 0x3b, 0xd7, 0x04, 0x7d,
+	0x00, 0x50, 0x97, 0x48, 0xad, 0x9a, 0xd4, 0x09, 0x2f, 0xc6, 0x38, 0xc2, 0x87, 0xf0, 0xcf, 0x01,
+	0xe9, 0x0b, 0xf7, 0xda, 0x40, 0xa6, 0x22, 0x1a, 0x2c, 0x47, 0x6f, 0x00, 0x9c, 0x57, 0xfb, 0x21,
+	0xee, 0xd5, 0x96, 0x87, 0xe9, 0x9e, 0xac, 0xc2, 0x30, 0x46, 0x2e, 0x8a, 0x20, 0x4b, 0x21, 0x9c,
+	0x95, 0xa1, 0x77, 0x00, 0xea, 0x3b, 0x54, 0x6c, 0x16, 0x39, 0xa1, 0x77, 0xb1, 0xa0, 0x0d, 0xee,
+	0x1e, 0xc5, 0x88, 0x50, 0x80, 0xbf, 0xd7, 0x94, 0xea, 0xc5, 0x2f, 0x98, 0x7e, 0x7f, 0x8a, 0xa2,
+	0x57, 0x89, 0xde, 0x03, 0xf8, 0xef, 0x0e, 0x15, 0x79, 0x4c, 0x0a, 0xb4, 0x2a, 0xf6, 0xb1, 0xa0,
+	0x6e, 0x1d, 0x33, 0x16, 0x23, 0xc7, 0x16, 0x4c, 0xbf, 0xe8, 0xca, 0x5e, 0x78, 0x4c, 0x86, 0x3d,
+	0x76, 0x27, 0xd2, 0xab, 0x96, 0x13, 0x59, 0xca, 0x11, 0x72, 0xcf, 0x3a, 0xa4, 0x64, 0x97, 0xba,
+	0xcd, 0x22, 0x76, 0x9f, 0x53, 0x11, 0xeb, 0x63, 0x95, 0x6a, 0x06, 0x9a, 0x0a, 0xe4, 0xff, 0x48,
+	0x90, 0x21, 0x7f, 0x8a, 0x43, 0x55, 0xa2, 0xaf, 0xe1, 0xb6, 0xee, 0x3b, 0xfc, 0x63, 0x38, 0x1f,
+	0x4f, 0xe1, 0x5c, 0x84, 0x01, 0x45, 0xb5, 0x7a, 0xd9, 0x16, 0x1b, 0x3a, 0x33, 0x51, 0xad, 0xd0,
+	0x67, 0x00, 0x8d, 0x3d, 0x87, 0x60, 0x41, 0x87, 0xd7, 0x5c, 0x8c, 0x98, 0xbf, 0x6c, 0x53, 0x7f,
+	0x9b, 0x80, 0xff, 0x84, 0x74, 0xe3, 0x98, 0xde, 0x4d, 0xf8, 0x9b, 0xc3, 0x39, 0xab, 0x58, 0x24,
+	0xa0, 0x49, 0xe6, 0xff, 0x6b, 0xfb, 0x66, 0x4a, 0x3a, 0xd9, 0x2a, 0x74, 0x7c, 0xf3, 0xaf, 0xb0,
+	0x5e, 0xa5, 0xa0, 0x72, 0x4a, 0xfe, 0xda, 0x22, 0x1a, 0x83, 0x10, 0x4b, 0x90, 0x0a, 0xc1, 0x02,
+	0xeb, 0xc9, 0xe0, 0xa5, 0x7c, 0x7d, 0xf4, 0x7d, 0x28, 0x60, 0x81, 0x65, 0xcf, 0xee, 0x0b, 0x19,
+	0xb5, 0x7d, 0x33, 0xdd, 0x8b, 0x74, 0x7c, 0x73, 0x36, 0xd4, 0x39, 0xeb, 0x89, 0xca, 0x69, 0xdc,
+	0x8d, 0x6b, 0x0f, 0xa0, 0xd6, 0xb4, 0xec, 0x4a, 0xcb, 0x23, 0x95, 0x03, 0xcc, 0x5a, 0xb4, 0xc2,
+	0x68, 0x5d, 0xe8, 0x53, 0x81, 0xdf, 0xe5, 0x8e, 0x6f, 0x2e, 0x86, 0xd5, 0xe7, 0x73, 0x50, 0xf9,
+	0xef, 0xa6, 0x65, 0xef, 0x79, 0xe4, 0x91, 0xbc, 0xb4, 0x4d, 0xeb, 0x22, 0x7f, 0xff, 0xb8, 0x6d,
+	0x80, 0x93, 0xb6, 0x01, 0xbe, 0xb4, 0x0d, 0xf0, 0xf2, 0xd4, 0x48, 0x9c, 0x9c, 0x1a, 0x89, 0x8f,
+	0xa7, 0x46, 0xe2, 0x71, 0xa6, 0x61, 0x89, 0x67, 0xad, 0xaa, 0xa4, 0xc8, 0x86, 0x24, 0x6b, 0xbc,
+	0x5e, 0xb7, 0x6a, 0x16, 0x66, 0xea, 0x7f, 0x56, 0x7d, 0x38, 0x88, 0x23, 0x87, 0x7a, 0xd5, 0x54,
+	0xf0, 0xc9, 0xb0, 0xf1, 0x63, 0x00, 0x35, 0x1a, 0xdf, 0x6a, 0x9e, 0x0c, 0x00, 0x00,
 }
 
 func (m *LendPairsProposal) Marshal() (dAtA []byte, err error) {
@@ -998,7 +1337,7 @@ func (m *AddPoolPairsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AddAssetRatesPoolPairsProposal) Marshal() (dAtA []byte, err error) {
+func (m *SetEModeCategoryProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1008,18 +1347,18 @@ func (m *AddAssetRatesPoolPairsProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddAssetRatesPoolPairsProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetEModeCategoryProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddAssetRatesPoolPairsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetEModeCategoryProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.AssetRatesPoolPairs.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Category.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1045,48 +1384,297 @@ func (m *AddAssetRatesPoolPairsProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SetBadDebtWaterfallProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *LendPairsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = m.Pairs.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
+
+func (m *SetBadDebtWaterfallProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultipleLendPairsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetBadDebtWaterfallProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
+	{
+		size, err := m.Waterfall.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddFixedTermMarketProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddFixedTermMarketProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddFixedTermMarketProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddAssetRatesPoolPairsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddAssetRatesPoolPairsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddAssetRatesPoolPairsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AssetRatesPoolPairs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateAssetRatesParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateAssetRatesParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateAssetRatesParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AssetRatesParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatePoolPairsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePoolPairsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePoolPairsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinUsdValueLeft != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MinUsdValueLeft))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AssetData) > 0 {
+		for iNdEx := len(m.AssetData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LendPairsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Pairs.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *MultipleLendPairsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
 	if len(m.Pairs) > 0 {
@@ -1156,92 +1744,934 @@ func (m *AddMultipleAssetToPairProposal) Size() (n int) {
 			n += 1 + l + sovGov(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *AddAssetRatesParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = m.AssetRatesParams.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
-}
-
-func (m *AddAuctionParamsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = m.AuctionParams.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
-}
-
-func (m *AddPoolPairsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = m.PoolPairs.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
-}
+	return n
+}
+
+func (m *AddAssetRatesParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.AssetRatesParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *AddAuctionParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.AuctionParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *AddPoolPairsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.PoolPairs.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *SetEModeCategoryProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Category.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *SetBadDebtWaterfallProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Waterfall.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *AddFixedTermMarketProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Market.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *AddAssetRatesPoolPairsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.AssetRatesPoolPairs.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *UpdateAssetRatesParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.AssetRatesParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *UpdatePoolPairsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovGov(uint64(m.PoolID))
+	}
+	if len(m.AssetData) > 0 {
+		for _, e := range m.AssetData {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MinUsdValueLeft != 0 {
+		n += 1 + sovGov(uint64(m.MinUsdValueLeft))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LendPairsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LendPairsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LendPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pairs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultipleLendPairsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultipleLendPairsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultipleLendPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, Extended_Pair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddPoolsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddPoolsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddPoolsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddAssetToPairProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddAssetToPairProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddAssetToPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetToPairMapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AssetToPairMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddMultipleAssetToPairProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddMultipleAssetToPairProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddMultipleAssetToPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetToPairSingleMapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetToPairSingleMapping = append(m.AssetToPairSingleMapping, AssetToPairSingleMapping{})
+			if err := m.AssetToPairSingleMapping[len(m.AssetToPairSingleMapping)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *AddAssetRatesPoolPairsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.AssetRatesPoolPairs.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
-}
-
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *LendPairsProposal) Unmarshal(dAtA []byte) error {
+func (m *AddAssetRatesParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1264,10 +2694,10 @@ func (m *LendPairsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LendPairsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddAssetRatesParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LendPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddAssetRatesParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1336,7 +2766,7 @@ func (m *LendPairsProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRatesParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1363,7 +2793,7 @@ func (m *LendPairsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pairs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AssetRatesParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1388,7 +2818,7 @@ func (m *LendPairsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultipleLendPairsProposal) Unmarshal(dAtA []byte) error {
+func (m *AddAuctionParamsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1411,10 +2841,10 @@ func (m *MultipleLendPairsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultipleLendPairsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddAuctionParamsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultipleLendPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddAuctionParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1483,7 +2913,7 @@ func (m *MultipleLendPairsProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1510,8 +2940,7 @@ func (m *MultipleLendPairsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, Extended_Pair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuctionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1536,7 +2965,7 @@ func (m *MultipleLendPairsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddPoolsProposal) Unmarshal(dAtA []byte) error {
+func (m *AddPoolPairsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1559,10 +2988,10 @@ func (m *AddPoolsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddPoolsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddPoolPairsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddPoolsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddPoolPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1631,7 +3060,7 @@ func (m *AddPoolsProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1658,7 +3087,7 @@ func (m *AddPoolsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolPairs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1683,7 +3112,7 @@ func (m *AddPoolsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddAssetToPairProposal) Unmarshal(dAtA []byte) error {
+func (m *SetEModeCategoryProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1706,10 +3135,10 @@ func (m *AddAssetToPairProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddAssetToPairProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetEModeCategoryProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddAssetToPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetEModeCategoryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1778,7 +3207,7 @@ func (m *AddAssetToPairProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetToPairMapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1805,7 +3234,7 @@ func (m *AddAssetToPairProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AssetToPairMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Category.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1830,7 +3259,7 @@ func (m *AddAssetToPairProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddMultipleAssetToPairProposal) Unmarshal(dAtA []byte) error {
+func (m *SetBadDebtWaterfallProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1853,10 +3282,10 @@ func (m *AddMultipleAssetToPairProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddMultipleAssetToPairProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBadDebtWaterfallProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMultipleAssetToPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBadDebtWaterfallProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1925,7 +3354,7 @@ func (m *AddMultipleAssetToPairProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetToPairSingleMapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waterfall", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1952,8 +3381,7 @@ func (m *AddMultipleAssetToPairProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetToPairSingleMapping = append(m.AssetToPairSingleMapping, AssetToPairSingleMapping{})
-			if err := m.AssetToPairSingleMapping[len(m.AssetToPairSingleMapping)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Waterfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1978,7 +3406,7 @@ func (m *AddMultipleAssetToPairProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddAssetRatesParams) Unmarshal(dAtA []byte) error {
+func (m *AddFixedTermMarketProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2001,10 +3429,10 @@ func (m *AddAssetRatesParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddAssetRatesParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddFixedTermMarketProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddAssetRatesParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddFixedTermMarketProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2073,7 +3501,7 @@ func (m *AddAssetRatesParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetRatesParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2100,7 +3528,7 @@ func (m *AddAssetRatesParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AssetRatesParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2125,7 +3553,7 @@ func (m *AddAssetRatesParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddAuctionParamsProposal) Unmarshal(dAtA []byte) error {
+func (m *AddAssetRatesPoolPairsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2148,10 +3576,10 @@ func (m *AddAuctionParamsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddAuctionParamsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddAssetRatesPoolPairsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddAuctionParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddAssetRatesPoolPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2220,7 +3648,7 @@ func (m *AddAuctionParamsProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRatesPoolPairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2247,7 +3675,7 @@ func (m *AddAuctionParamsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuctionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AssetRatesPoolPairs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2272,7 +3700,7 @@ func (m *AddAuctionParamsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddPoolPairsProposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateAssetRatesParamsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2295,10 +3723,10 @@ func (m *AddPoolPairsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddPoolPairsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateAssetRatesParamsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddPoolPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateAssetRatesParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2367,7 +3795,7 @@ func (m *AddPoolPairsProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRatesParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2394,7 +3822,7 @@ func (m *AddPoolPairsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolPairs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AssetRatesParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2419,7 +3847,7 @@ func (m *AddPoolPairsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddAssetRatesPoolPairsProposal) Unmarshal(dAtA []byte) error {
+func (m *UpdatePoolPairsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2442,10 +3870,10 @@ func (m *AddAssetRatesPoolPairsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddAssetRatesPoolPairsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdatePoolPairsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddAssetRatesPoolPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdatePoolPairsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2513,8 +3941,27 @@ func (m *AddAssetRatesPoolPairsProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetRatesPoolPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2541,10 +3988,30 @@ func (m *AddAssetRatesPoolPairsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AssetRatesPoolPairs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AssetData = append(m.AssetData, &AssetDataPoolMapping{})
+			if err := m.AssetData[len(m.AssetData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUsdValueLeft", wireType)
+			}
+			m.MinUsdValueLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUsdValueLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// InterestRateModelKinked is the two slope model with a kink at
+	// UOptimal, it is the default for assets without a model set.
+	InterestRateModelKinked uint64 = iota
+	// InterestRateModelLinearCap grows linearly with utilisation from Base
+	// by Slope1, up to RateCap.
+	InterestRateModelLinearCap
+	// InterestRateModelAdaptive scales the kinked curve by a multiplier that
+	// moves over time towards the rate bringing utilisation to UOptimal.
+	InterestRateModelAdaptive
+)
+
+var (
+	MinAdaptiveMultiplier = sdk.NewDecWithPrec(1, 1)
+	MaxAdaptiveMultiplier = sdk.NewDec(10)
+)
+
+// InterestRateModel prices the borrow rate of an asset from its utilisation.
+type InterestRateModel interface {
+	// Validate checks the model specific fields of params.
+	Validate(params AssetRatesParams) error
+	// BorrowRate returns the yearly borrow rate at utilisation. multiplier is
+	// the curve multiplier of the adaptive model and is one for other models.
+	BorrowRate(params AssetRatesParams, utilisation, multiplier sdk.Dec, isStableBorrow bool) sdk.Dec
+}
+
+var interestRateModels = map[uint64]InterestRateModel{
+	InterestRateModelKinked:    kinkedModel{},
+	InterestRateModelLinearCap: linearCapModel{},
+	InterestRateModelAdaptive:  adaptiveModel{},
+}
+
+// GetInterestRateModel returns the interest rate model registered under id.
+func GetInterestRateModel(id uint64) (InterestRateModel, error) {
+	model, ok := interestRateModels[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown interest rate model %d", id)
+	}
+	return model, nil
+}
+
+type kinkedModel struct{}
+
+func (kinkedModel) Validate(AssetRatesParams) error { return nil }
+
+func (kinkedModel) BorrowRate(params AssetRatesParams, utilisation, _ sdk.Dec, isStableBorrow bool) sdk.Dec {
+	base, slope1, slope2 := params.Base, params.Slope1, params.Slope2
+	if isStableBorrow {
+		base, slope1, slope2 = params.StableBase, params.StableSlope1, params.StableSlope2
+	}
+	if utilisation.LT(params.UOptimal) {
+		return base.Add(utilisation.Quo(params.UOptimal).Mul(slope1))
+	}
+	utilisationRatio := utilisation.Sub(params.UOptimal).Quo(sdk.OneDec().Sub(params.UOptimal))
+	return base.Add(slope1).Add(utilisationRatio.Mul(slope2))
+}
+
+type linearCapModel struct{}
+
+func (linearCapModel) Validate(params AssetRatesParams) error {
+	if params.RateCap.IsNil() || !params.RateCap.IsPositive() {
+		return fmt.Errorf("RateCap must be positive")
+	}
+	if params.RateCap.LT(params.Base) {
+		return fmt.Errorf("RateCap cannot be less than base")
+	}
+	return nil
+}
+
+func (linearCapModel) BorrowRate(params AssetRatesParams, utilisation, _ sdk.Dec, isStableBorrow bool) sdk.Dec {
+	base, slope := params.Base, params.Slope1
+	if isStableBorrow {
+		base, slope = params.StableBase, params.StableSlope1
+	}
+	return sdk.MinDec(base.Add(utilisation.Mul(slope)), params.RateCap)
+}
+
+type adaptiveModel struct{}
+
+func (adaptiveModel) Validate(params AssetRatesParams) error {
+	if params.AdjustmentSpeed.IsNil() || !params.AdjustmentSpeed.IsPositive() {
+		return fmt.Errorf("AdjustmentSpeed must be positive")
+	}
+	if params.UOptimal.GTE(sdk.OneDec()) {
+		return fmt.Errorf("UOptimal must be less than one")
+	}
+	return nil
+}
+
+func (adaptiveModel) BorrowRate(params AssetRatesParams, utilisation, multiplier sdk.Dec, isStableBorrow bool) sdk.Dec {
+	return kinkedModel{}.BorrowRate(params, utilisation, multiplier, isStableBorrow).Mul(multiplier)
+}
+
+// NextAdaptiveMultiplier moves the curve multiplier of the adaptive model
+// after years at utilisation. The multiplier rises while utilisation is above
+// UOptimal and falls while it is below, by up to AdjustmentSpeed a year.
+func NextAdaptiveMultiplier(params AssetRatesParams, multiplier, utilisation, years sdk.Dec) sdk.Dec {
+	var deviation sdk.Dec
+	if utilisation.LT(params.UOptimal) {
+		deviation = utilisation.Sub(params.UOptimal).Quo(params.UOptimal)
+	} else {
+		deviation = utilisation.Sub(params.UOptimal).Quo(sdk.OneDec().Sub(params.UOptimal))
+	}
+	next := multiplier.Mul(sdk.OneDec().Add(params.AdjustmentSpeed.Mul(deviation).Mul(years)))
+	return sdk.MinDec(sdk.MaxDec(next, MinAdaptiveMultiplier), MaxAdaptiveMultiplier)
+}
+
+func validateInterestRateModel(params AssetRatesParams) error {
+	model, err := GetInterestRateModel(params.InterestRateModelID)
+	if err != nil {
+		return err
+	}
+	return model.Validate(params)
+}
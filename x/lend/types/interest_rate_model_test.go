@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/comdex-official/comdex/x/lend/types"
+)
+
+func TestInterestRateModels(t *testing.T) {
+	params := types.AssetRatesParams{
+		UOptimal:        sdk.MustNewDecFromStr("0.8"),
+		Base:            sdk.MustNewDecFromStr("0.02"),
+		Slope1:          sdk.MustNewDecFromStr("0.1"),
+		Slope2:          sdk.MustNewDecFromStr("1"),
+		StableBase:      sdk.MustNewDecFromStr("0.05"),
+		StableSlope1:    sdk.MustNewDecFromStr("0.1"),
+		StableSlope2:    sdk.MustNewDecFromStr("1"),
+		RateCap:         sdk.MustNewDecFromStr("0.08"),
+		AdjustmentSpeed: sdk.MustNewDecFromStr("2"),
+	}
+
+	testCases := []struct {
+		name        string
+		modelID     uint64
+		utilisation string
+		multiplier  string
+		stable      bool
+		expRate     string
+	}{
+		{"kinked below optimal", types.InterestRateModelKinked, "0.4", "1", false, "0.07"},
+		{"kinked above optimal", types.InterestRateModelKinked, "0.9", "1", false, "0.62"},
+		{"kinked stable", types.InterestRateModelKinked, "0.4", "1", true, "0.1"},
+		{"linear below cap", types.InterestRateModelLinearCap, "0.5", "1", false, "0.07"},
+		{"linear capped", types.InterestRateModelLinearCap, "0.9", "1", false, "0.08"},
+		{"adaptive scales the kinked curve", types.InterestRateModelAdaptive, "0.4", "2", false, "0.14"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			model, err := types.GetInterestRateModel(tc.modelID)
+			require.NoError(t, err)
+			rate := model.BorrowRate(params, sdk.MustNewDecFromStr(tc.utilisation), sdk.MustNewDecFromStr(tc.multiplier), tc.stable)
+			require.Equal(t, sdk.MustNewDecFromStr(tc.expRate), rate)
+		})
+	}
+
+	_, err := types.GetInterestRateModel(99)
+	require.Error(t, err)
+
+	// Half a year at full utilisation raises the curve by half the speed.
+	next := types.NextAdaptiveMultiplier(params, sdk.OneDec(), sdk.OneDec(), sdk.MustNewDecFromStr("0.5"))
+	require.Equal(t, sdk.NewDec(2), next)
+	// Idle markets lower the curve, down to the minimum multiplier.
+	next = types.NextAdaptiveMultiplier(params, sdk.OneDec(), sdk.ZeroDec(), sdk.NewDec(1))
+	require.Equal(t, types.MinAdaptiveMultiplier, next)
+	next = types.NextAdaptiveMultiplier(params, sdk.OneDec(), params.UOptimal, sdk.NewDec(1))
+	require.Equal(t, sdk.OneDec(), next)
+}
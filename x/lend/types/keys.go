@@ -1,7 +1,10 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -46,6 +49,17 @@ var (
 	TypeBorrowAlternateAssetRequest        = ModuleName + ":borrow-alternate"
 	TypeCalculateInterestAndRewardsRequest = ModuleName + ":calculate-interest-rewards"
 	TypeFundReserveAccountRequest          = ModuleName + ":fund-reserve"
+	TypeGrantPositionManagerRequest        = ModuleName + ":grant-position-manager"
+	TypeRevokePositionManagerRequest       = ModuleName + ":revoke-position-manager"
+	TypeDepositAccountCollateralRequest    = ModuleName + ":deposit-account-collateral"
+	TypeWithdrawAccountCollateralRequest   = ModuleName + ":withdraw-account-collateral"
+	TypeAccountBorrowRequest               = ModuleName + ":account-borrow"
+	TypeAccountRepayRequest                = ModuleName + ":account-repay"
+	TypeLiquidateAccountRequest            = ModuleName + ":liquidate-account"
+	TypeFixedTermCommitRequest             = ModuleName + ":fixed-term-commit"
+	TypeFixedTermRedeemRequest             = ModuleName + ":fixed-term-redeem"
+	TypeFixedTermBorrowRequest             = ModuleName + ":fixed-term-borrow"
+	TypeFixedTermRepayRequest              = ModuleName + ":fixed-term-repay"
 )
 
 var (
@@ -73,6 +87,24 @@ var (
 	KeyFundReserveBal                     = []byte{0x49}
 	AllReserveStatsPrefix                 = []byte{0x50}
 	AssetAndPoolWiseModBalKeyPrefix       = []byte{0x51}
+	PositionManagerGrantKeyPrefix         = []byte{0x52}
+	EModeCategoryKeyPrefix                = []byte{0x53}
+	EModeCategoryIDKey                    = []byte{0x54}
+	AssetEModeCategoryKeyPrefix           = []byte{0x55}
+	AdaptiveRateStateKeyPrefix            = []byte{0x56}
+	BadDebtWaterfallKey                   = []byte{0x57}
+	CTokenExchangeRateKeyPrefix           = []byte{0x58}
+	CrossCollateralAccountKeyPrefix       = []byte{0x59}
+	FixedTermMarketKeyPrefix              = []byte{0x5A}
+	FixedTermMarketIDKey                  = []byte{0x5B}
+	FixedTermDepositKeyPrefix             = []byte{0x5C}
+	FixedTermDepositIDKey                 = []byte{0x5D}
+	FixedTermLoanKeyPrefix                = []byte{0x5E}
+	FixedTermLoanIDKey                    = []byte{0x5F}
+	FixedTermLoanMaturityKeyPrefix        = []byte{0x60}
+	FixedTermLoanCursorKey                = []byte{0x61}
+	FixedTermLoanLendKeyPrefix            = []byte{0x62}
+	FixedTermReservedKeyPrefix            = []byte{0x63}
 )
 
 func LendUserKey(ID uint64) []byte {
@@ -135,3 +167,63 @@ func AllReserveStatsKey(ID uint64) []byte {
 func FundModBalanceKey(assetID, poolID uint64) []byte {
 	return append(append(AssetAndPoolWiseModBalKeyPrefix, sdk.Uint64ToBigEndian(assetID)...), sdk.Uint64ToBigEndian(poolID)...)
 }
+
+func PositionManagerGrantOwnerKey(owner sdk.AccAddress) []byte {
+	return append(PositionManagerGrantKeyPrefix, address.MustLengthPrefix(owner)...)
+}
+
+func PositionManagerGrantKey(owner, manager sdk.AccAddress) []byte {
+	return append(PositionManagerGrantOwnerKey(owner), address.MustLengthPrefix(manager)...)
+}
+
+func EModeCategoryKey(ID uint64) []byte {
+	return append(EModeCategoryKeyPrefix, sdk.Uint64ToBigEndian(ID)...)
+}
+
+func AssetEModeCategoryKey(assetID uint64) []byte {
+	return append(AssetEModeCategoryKeyPrefix, sdk.Uint64ToBigEndian(assetID)...)
+}
+
+func AdaptiveRateStateKey(poolID, assetID uint64) []byte {
+	return append(append(AdaptiveRateStateKeyPrefix, sdk.Uint64ToBigEndian(poolID)...), sdk.Uint64ToBigEndian(assetID)...)
+}
+
+func CTokenExchangeRateKey(poolID, assetID uint64) []byte {
+	return append(append(CTokenExchangeRateKeyPrefix, sdk.Uint64ToBigEndian(poolID)...), sdk.Uint64ToBigEndian(assetID)...)
+}
+
+func CrossCollateralAccountKey(owner sdk.AccAddress, poolID uint64) []byte {
+	return append(append(CrossCollateralAccountKeyPrefix, address.MustLengthPrefix(owner)...), sdk.Uint64ToBigEndian(poolID)...)
+}
+
+func FixedTermMarketKey(ID uint64) []byte {
+	return append(FixedTermMarketKeyPrefix, sdk.Uint64ToBigEndian(ID)...)
+}
+
+func FixedTermDepositKey(ID uint64) []byte {
+	return append(FixedTermDepositKeyPrefix, sdk.Uint64ToBigEndian(ID)...)
+}
+
+func FixedTermLoanKey(ID uint64) []byte {
+	return append(FixedTermLoanKeyPrefix, sdk.Uint64ToBigEndian(ID)...)
+}
+
+func FixedTermLoanMaturityTimeKey(maturity time.Time) []byte {
+	return append(FixedTermLoanMaturityKeyPrefix, sdk.FormatTimeBytes(maturity)...)
+}
+
+func FixedTermLoanMaturityKey(maturity time.Time, ID uint64) []byte {
+	return append(FixedTermLoanMaturityTimeKey(maturity), sdk.Uint64ToBigEndian(ID)...)
+}
+
+func FixedTermLoanLendPrefix(lendID uint64) []byte {
+	return append(FixedTermLoanLendKeyPrefix, sdk.Uint64ToBigEndian(lendID)...)
+}
+
+func FixedTermLoanLendKey(lendID, ID uint64) []byte {
+	return append(FixedTermLoanLendPrefix(lendID), sdk.Uint64ToBigEndian(ID)...)
+}
+
+func FixedTermReservedKey(poolID, assetID uint64) []byte {
+	return append(append(FixedTermReservedKeyPrefix, sdk.Uint64ToBigEndian(poolID)...), sdk.Uint64ToBigEndian(assetID)...)
+}
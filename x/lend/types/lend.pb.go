@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -29,6 +29,45 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PositionPermission enumerates what a position manager may do on behalf of
+// the owner of a lend or borrow position.
+type PositionPermission int32
+
+const (
+	// POSITION_PERMISSION_UNSPECIFIED specifies no permission.
+	PositionPermissionUnspecified PositionPermission = 0
+	// POSITION_PERMISSION_DEPOSIT allows depositing into lend positions and
+	// adding collateral to borrow positions.
+	PositionPermissionDeposit PositionPermission = 1
+	// POSITION_PERMISSION_REPAY allows repaying borrows with the manager's funds.
+	PositionPermissionRepay PositionPermission = 2
+	// POSITION_PERMISSION_WITHDRAW_TO_OWNER allows withdrawing from lend
+	// positions, which is always paid out to the owner.
+	PositionPermissionWithdrawToOwner PositionPermission = 3
+)
+
+var PositionPermission_name = map[int32]string{
+	0: "POSITION_PERMISSION_UNSPECIFIED",
+	1: "POSITION_PERMISSION_DEPOSIT",
+	2: "POSITION_PERMISSION_REPAY",
+	3: "POSITION_PERMISSION_WITHDRAW_TO_OWNER",
+}
+
+var PositionPermission_value = map[string]int32{
+	"POSITION_PERMISSION_UNSPECIFIED":       0,
+	"POSITION_PERMISSION_DEPOSIT":           1,
+	"POSITION_PERMISSION_REPAY":             2,
+	"POSITION_PERMISSION_WITHDRAW_TO_OWNER": 3,
+}
+
+func (x PositionPermission) String() string {
+	return proto.EnumName(PositionPermission_name, int32(x))
+}
+
+func (PositionPermission) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{0}
+}
+
 type LendAsset struct {
 	ID                  uint64                                  `protobuf:"varint,1,opt,name=lending_id,json=lendingId,proto3" json:"lending_id,omitempty" yaml:"lending_id"`
 	AssetID             uint64                                  `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
@@ -338,7 +377,7 @@ func (m *Pool) GetAssetData() []*AssetDataPoolMapping {
 
 type UserAssetLendBorrowMapping struct {
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
-	// to check if poool id is needed
+	//to check if poool id is needed
 	LendId   uint64   `protobuf:"varint,2,opt,name=lend_id,json=lendId,proto3" json:"lend_id,omitempty" yaml:"lend_id"`
 	PoolId   uint64   `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 	BorrowId []uint64 `protobuf:"varint,4,rep,packed,name=borrow_id,json=borrowId,proto3" json:"borrow_id,omitempty" yaml:"borrow_id"`
@@ -695,6 +734,14 @@ type AssetRatesParams struct {
 	LiquidationBonus     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=liquidation_bonus,json=liquidationBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_bonus" yaml:"liquidation_bonus"`
 	ReserveFactor        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=reserve_factor,json=reserveFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_factor" yaml:"reserve_factor"`
 	CAssetID             uint64                                 `protobuf:"varint,15,opt,name=c_asset_id,json=cAssetId,proto3" json:"c_asset_id,omitempty" yaml:"c_asset_id"`
+	// interest_rate_model_id selects the model pricing the asset, the kinked
+	// two slope model is used by default.
+	InterestRateModelID uint64 `protobuf:"varint,16,opt,name=interest_rate_model_id,json=interestRateModelId,proto3" json:"interest_rate_model_id,omitempty" yaml:"interest_rate_model_id"`
+	// rate_cap is the maximum borrow rate of the linear model.
+	RateCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=rate_cap,json=rateCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_cap" yaml:"rate_cap"`
+	// adjustment_speed is the yearly rate at which the adaptive model shifts
+	// its curve when utilisation is fully away from u_optimal.
+	AdjustmentSpeed github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=adjustment_speed,json=adjustmentSpeed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"adjustment_speed" yaml:"adjustment_speed"`
 }
 
 func (m *AssetRatesParams) Reset()         { *m = AssetRatesParams{} }
@@ -751,6 +798,13 @@ func (m *AssetRatesParams) GetCAssetID() uint64 {
 	return 0
 }
 
+func (m *AssetRatesParams) GetInterestRateModelID() uint64 {
+	if m != nil {
+		return m.InterestRateModelID
+	}
+	return 0
+}
+
 type ReserveBuybackAssetData struct {
 	AssetID       uint64                                 `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
 	ReserveAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=reserve_amount,json=reserveAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserve_amount" yaml:"reserve_amount"`
@@ -1701,6 +1755,9 @@ type AssetRatesPoolPairs struct {
 	CPoolName            string                                 `protobuf:"bytes,17,opt,name=cpool_name,json=cpoolName,proto3" json:"cpool_name,omitempty" yaml:"cpool_name"`
 	AssetData            []*AssetDataPoolMapping                `protobuf:"bytes,18,rep,name=asset_data,json=assetData,proto3" json:"asset_data,omitempty" yaml:"asset_data"`
 	MinUsdValueLeft      uint64                                 `protobuf:"varint,19,opt,name=min_usd_value_left,json=minUsdValueLeft,proto3" json:"min_usd_value_left,omitempty" yaml:"min_usd_value_left"`
+	InterestRateModelID  uint64                                 `protobuf:"varint,20,opt,name=interest_rate_model_id,json=interestRateModelId,proto3" json:"interest_rate_model_id,omitempty" yaml:"interest_rate_model_id"`
+	RateCap              github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=rate_cap,json=rateCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_cap" yaml:"rate_cap"`
+	AdjustmentSpeed      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,22,opt,name=adjustment_speed,json=adjustmentSpeed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"adjustment_speed" yaml:"adjustment_speed"`
 }
 
 func (m *AssetRatesPoolPairs) Reset()         { *m = AssetRatesPoolPairs{} }
@@ -1785,909 +1842,1253 @@ func (m *AssetRatesPoolPairs) GetMinUsdValueLeft() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*LendAsset)(nil), "comdex.lend.v1beta1.LendAsset")
-	proto.RegisterType((*BorrowAsset)(nil), "comdex.lend.v1beta1.BorrowAsset")
-	proto.RegisterType((*Pool)(nil), "comdex.lend.v1beta1.Pool")
-	proto.RegisterType((*UserAssetLendBorrowMapping)(nil), "comdex.lend.v1beta1.UserAssetLendBorrowMapping")
-	proto.RegisterType((*AssetDataPoolMapping)(nil), "comdex.lend.v1beta1.AssetDataPoolMapping")
-	proto.RegisterType((*Extended_Pair)(nil), "comdex.lend.v1beta1.Extended_Pair")
-	proto.RegisterType((*AssetToPairMapping)(nil), "comdex.lend.v1beta1.AssetToPairMapping")
-	proto.RegisterType((*PoolAssetLBMapping)(nil), "comdex.lend.v1beta1.PoolAssetLBMapping")
-	proto.RegisterType((*AssetRatesParams)(nil), "comdex.lend.v1beta1.AssetRatesParams")
-	proto.RegisterType((*ReserveBuybackAssetData)(nil), "comdex.lend.v1beta1.ReserveBuybackAssetData")
-	proto.RegisterType((*AuctionParams)(nil), "comdex.lend.v1beta1.AuctionParams")
-	proto.RegisterType((*BorrowInterestTracker)(nil), "comdex.lend.v1beta1.Borrow_interest_tracker")
-	proto.RegisterType((*LendRewardsTracker)(nil), "comdex.lend.v1beta1.Lend_rewards_tracker")
-	proto.RegisterType((*ModuleBalance)(nil), "comdex.lend.v1beta1.ModuleBalance")
-	proto.RegisterType((*ModuleBalanceStats)(nil), "comdex.lend.v1beta1.ModuleBalanceStats")
-	proto.RegisterType((*ModBal)(nil), "comdex.lend.v1beta1.ModBal")
-	proto.RegisterType((*ReserveBal)(nil), "comdex.lend.v1beta1.ReserveBal")
-	proto.RegisterType((*FundModBal)(nil), "comdex.lend.v1beta1.FundModBal")
-	proto.RegisterType((*FundReserveBal)(nil), "comdex.lend.v1beta1.FundReserveBal")
-	proto.RegisterType((*AllReserveStats)(nil), "comdex.lend.v1beta1.AllReserveStats")
-	proto.RegisterType((*AssetToPairSingleMapping)(nil), "comdex.lend.v1beta1.AssetToPairSingleMapping")
-	proto.RegisterType((*PoolPairs)(nil), "comdex.lend.v1beta1.PoolPairs")
-	proto.RegisterType((*PoolInterestData)(nil), "comdex.lend.v1beta1.PoolInterestData")
-	proto.RegisterType((*PoolInterest)(nil), "comdex.lend.v1beta1.PoolInterest")
-	proto.RegisterType((*PoolInterestDataB)(nil), "comdex.lend.v1beta1.PoolInterestDataB")
-	proto.RegisterType((*PoolInterestB)(nil), "comdex.lend.v1beta1.PoolInterestB")
-	proto.RegisterType((*AssetRatesPoolPairs)(nil), "comdex.lend.v1beta1.AssetRatesPoolPairs")
+func (m *AssetRatesPoolPairs) GetInterestRateModelID() uint64 {
+	if m != nil {
+		return m.InterestRateModelID
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("comdex/lend/v1beta1/lend.proto", fileDescriptor_b87bb4bef8334ddd) }
-
-var fileDescriptor_b87bb4bef8334ddd = []byte{
-	// 2982 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x6c, 0x24, 0x47,
-	0xf5, 0xdf, 0x1e, 0x7b, 0xed, 0x99, 0x37, 0xb6, 0xd7, 0xae, 0xb1, 0xb3, 0x13, 0x6f, 0xe2, 0xd9,
-	0xd4, 0xfe, 0xff, 0x89, 0x03, 0xca, 0x58, 0x6b, 0x92, 0x03, 0x51, 0xa2, 0xe0, 0xf1, 0xee, 0x26,
-	0x93, 0x78, 0x37, 0x49, 0xad, 0x03, 0x02, 0x02, 0xad, 0x9e, 0xe9, 0xb2, 0xb7, 0xb5, 0x3d, 0xdd,
-	0xbd, 0xdd, 0x3d, 0xde, 0x35, 0x90, 0x80, 0x12, 0x09, 0x21, 0x20, 0x22, 0x70, 0xe5, 0x8e, 0xc4,
-	0x1d, 0x2e, 0x48, 0x1c, 0x10, 0x52, 0xc8, 0x81, 0x43, 0xf8, 0x38, 0x44, 0x39, 0x0c, 0xc8, 0x39,
-	0x20, 0x71, 0xdc, 0x03, 0x07, 0x4e, 0xa8, 0xaa, 0x5e, 0x7f, 0xcd, 0xb4, 0xbd, 0xdb, 0x9e, 0x24,
-	0x0b, 0xd2, 0x9e, 0x3c, 0xf5, 0xaa, 0xea, 0xf7, 0x5e, 0xbd, 0xf7, 0xea, 0xd5, 0x7b, 0x55, 0x6d,
-	0x58, 0xe9, 0xba, 0x3d, 0x93, 0xdf, 0x5a, 0xb3, 0xb9, 0x63, 0xae, 0xed, 0x9d, 0xef, 0xf0, 0xd0,
-	0x38, 0x2f, 0x1b, 0x4d, 0xcf, 0x77, 0x43, 0x97, 0xd4, 0x54, 0x7f, 0x53, 0x92, 0xb0, 0x7f, 0x79,
-	0x71, 0xd7, 0xdd, 0x75, 0x65, 0xff, 0x9a, 0xf8, 0xa5, 0x86, 0x2e, 0x37, 0x76, 0x5d, 0x77, 0xd7,
-	0xe6, 0x6b, 0xb2, 0xd5, 0xe9, 0xef, 0xac, 0x85, 0x56, 0x8f, 0x07, 0xa1, 0xd1, 0xf3, 0x70, 0xc0,
-	0x4a, 0xd7, 0x0d, 0x7a, 0x6e, 0xb0, 0xd6, 0x31, 0x02, 0x1e, 0xf3, 0xea, 0xba, 0x96, 0xa3, 0xfa,
-	0xe9, 0xdb, 0x65, 0xa8, 0x6c, 0x71, 0xc7, 0xdc, 0x08, 0x02, 0x1e, 0x92, 0xa7, 0x01, 0x04, 0x53,
-	0xcb, 0xd9, 0xd5, 0x2d, 0xb3, 0xae, 0x9d, 0xd5, 0x56, 0x27, 0x5b, 0x67, 0x0e, 0x06, 0x8d, 0x52,
-	0xfb, 0xc2, 0xed, 0x41, 0x63, 0x61, 0xdf, 0xe8, 0xd9, 0x4f, 0xd3, 0x64, 0x04, 0x65, 0x15, 0x6c,
-	0xb4, 0x4d, 0xf2, 0x45, 0x28, 0x1b, 0x02, 0x44, 0xcc, 0x2c, 0xc9, 0x99, 0x2b, 0x07, 0x83, 0xc6,
-	0xb4, 0x04, 0x96, 0xd3, 0x4f, 0xa9, 0xe9, 0xd1, 0x20, 0xca, 0xa6, 0xe5, 0xcf, 0xb6, 0x49, 0x9e,
-	0x82, 0x69, 0xcf, 0x75, 0x6d, 0x31, 0x73, 0x42, 0xce, 0x7c, 0xe8, 0x60, 0xd0, 0x98, 0x7a, 0xc5,
-	0x75, 0x6d, 0x39, 0x71, 0x4e, 0x4d, 0xc4, 0x21, 0x94, 0x4d, 0x89, 0x5f, 0x6d, 0x93, 0x3c, 0x0a,
-	0x27, 0xdd, 0x9b, 0x0e, 0xf7, 0xeb, 0x93, 0x67, 0xb5, 0xd5, 0x4a, 0x6b, 0xfe, 0xf6, 0xa0, 0x31,
-	0xa3, 0x86, 0x4a, 0x32, 0x65, 0xaa, 0x9b, 0x7c, 0x1b, 0x2a, 0x46, 0xcf, 0xed, 0x3b, 0xa1, 0x6e,
-	0x39, 0xf5, 0x93, 0x67, 0xb5, 0xd5, 0xea, 0xfa, 0x83, 0x4d, 0xa5, 0x97, 0xa6, 0xd0, 0x4b, 0xa4,
-	0xe3, 0xe6, 0xa6, 0x6b, 0x39, 0xad, 0xcd, 0xf7, 0x07, 0x8d, 0x13, 0xb7, 0x07, 0x8d, 0x79, 0x14,
-	0x37, 0x9a, 0x49, 0xff, 0x3d, 0x68, 0x3c, 0xb6, 0x6b, 0x85, 0xd7, 0xfa, 0x9d, 0x66, 0xd7, 0xed,
-	0xad, 0xa1, 0x62, 0xd5, 0x9f, 0x27, 0x02, 0xf3, 0xfa, 0x5a, 0xb8, 0xef, 0xf1, 0x40, 0x82, 0xb0,
-	0xb2, 0x9a, 0xd6, 0x76, 0xc8, 0x37, 0x61, 0x26, 0x52, 0x98, 0xb0, 0x4d, 0x7d, 0x4a, 0xf2, 0x5f,
-	0x6e, 0x2a, 0xc3, 0x35, 0x23, 0xc3, 0x35, 0xb7, 0x23, 0xc3, 0xb5, 0x1a, 0x28, 0x40, 0x2d, 0xab,
-	0x6e, 0x31, 0x9b, 0xbe, 0xfb, 0xb7, 0x86, 0xc6, 0xaa, 0x48, 0x12, 0x53, 0xc8, 0x77, 0xa0, 0x66,
-	0xec, 0x19, 0x96, 0x6d, 0x74, 0x6c, 0xae, 0x87, 0xae, 0xde, 0x71, 0x7d, 0xdf, 0xbd, 0x59, 0x9f,
-	0x96, 0x2a, 0xd9, 0x12, 0x50, 0x1f, 0x0d, 0x1a, 0x8f, 0xde, 0x85, 0xdc, 0x6d, 0x27, 0xbc, 0x3d,
-	0x68, 0x2c, 0xe3, 0xaa, 0x47, 0x21, 0x29, 0x5b, 0x88, 0xa9, 0xdb, 0x6e, 0x4b, 0xd2, 0xc8, 0x79,
-	0x98, 0x32, 0x3c, 0x4f, 0x18, 0xae, 0x2c, 0x0d, 0xb7, 0x7c, 0x30, 0x68, 0x9c, 0xdc, 0xf0, 0x3c,
-	0x69, 0xb7, 0x59, 0xc4, 0x92, 0x03, 0x28, 0x3b, 0x69, 0x78, 0x5e, 0xdb, 0x24, 0xd7, 0x60, 0x66,
-	0xd7, 0x76, 0x3b, 0x86, 0xad, 0x5b, 0x8e, 0xc9, 0x6f, 0xd5, 0x2b, 0x52, 0xd2, 0x8b, 0x05, 0x24,
-	0xbd, 0xc0, 0xbb, 0x89, 0x7a, 0xd2, 0x58, 0x94, 0x55, 0x55, 0xb3, 0x2d, 0x5a, 0xe4, 0x16, 0x2c,
-	0xd9, 0x46, 0x20, 0x6c, 0x17, 0x72, 0xdf, 0xe8, 0x86, 0x96, 0xeb, 0x28, 0x1b, 0xc0, 0x1d, 0x6d,
-	0xb0, 0x8a, 0x36, 0x78, 0x08, 0x6d, 0x90, 0x07, 0xa3, 0x8c, 0x51, 0x13, 0x7d, 0xed, 0xa4, 0x4b,
-	0x1a, 0x65, 0x03, 0xa0, 0x2b, 0xdd, 0xd5, 0x31, 0x7a, 0xbc, 0x5e, 0x95, 0x2b, 0xa4, 0x07, 0x83,
-	0x46, 0x65, 0x53, 0x38, 0xf5, 0x15, 0xa3, 0xc7, 0x93, 0xed, 0x94, 0x0c, 0xa4, 0xac, 0x22, 0x1b,
-	0xa2, 0x9f, 0x5c, 0x87, 0xd9, 0xd0, 0x0d, 0x0d, 0x5b, 0xf7, 0xf9, 0x4d, 0xc3, 0x37, 0x83, 0xfa,
-	0x8c, 0x44, 0xb9, 0x54, 0xd8, 0xa2, 0x8b, 0x8a, 0x4d, 0x06, 0x8c, 0xb2, 0x19, 0xd9, 0x66, 0xd8,
-	0xfc, 0x57, 0x15, 0xaa, 0xca, 0xa2, 0x2a, 0x0e, 0x7c, 0x09, 0x66, 0x94, 0xd1, 0x33, 0x91, 0xe0,
-	0xe1, 0x38, 0x12, 0xa0, 0xee, 0xd3, 0x63, 0x28, 0xab, 0xc6, 0xcd, 0xb6, 0x29, 0x34, 0x90, 0x8a,
-	0x24, 0x2a, 0x1e, 0x48, 0x0d, 0x6c, 0x61, 0xc0, 0xb8, 0x73, 0x40, 0xb9, 0x08, 0xf3, 0x56, 0xa0,
-	0x07, 0xa1, 0x74, 0x43, 0x74, 0x6b, 0x11, 0x1e, 0xca, 0xad, 0x33, 0xb7, 0x07, 0x8d, 0xd3, 0x6a,
-	0xee, 0xf0, 0x08, 0xca, 0xe6, 0xac, 0xe0, 0xaa, 0xa4, 0xa0, 0x8b, 0x8a, 0xe0, 0x62, 0x58, 0xbe,
-	0x10, 0x63, 0x32, 0x15, 0x5c, 0x0c, 0xcb, 0xcf, 0x04, 0x17, 0x35, 0x44, 0x04, 0x17, 0xd1, 0x63,
-	0xde, 0xdb, 0xa0, 0xf1, 0x26, 0x00, 0x42, 0xb8, 0xfd, 0x10, 0x43, 0xc6, 0x11, 0xdc, 0x2f, 0x20,
-	0xf7, 0x85, 0x0c, 0x77, 0xb7, 0x1f, 0x16, 0x62, 0x8f, 0xeb, 0x7d, 0xb9, 0x1f, 0x92, 0x9f, 0x6b,
-	0xb0, 0xd8, 0xf1, 0x2d, 0x73, 0x97, 0x9b, 0xba, 0x8a, 0xd7, 0xaa, 0x4f, 0x86, 0x95, 0x23, 0x45,
-	0xb9, 0x82, 0xa2, 0x9c, 0x41, 0x0f, 0xc9, 0x01, 0x29, 0x24, 0x14, 0x41, 0x04, 0xe9, 0x97, 0x1b,
-	0x72, 0x3e, 0x31, 0x61, 0x2e, 0xf1, 0x3c, 0xb9, 0xa1, 0xcb, 0x77, 0xdc, 0xd0, 0x8f, 0xa0, 0x5c,
-	0x4b, 0xc3, 0x9e, 0x9b, 0xec, 0xe4, 0xd9, 0x98, 0x28, 0xf7, 0xf0, 0x3e, 0x90, 0x8c, 0x67, 0xe9,
-	0xbe, 0x11, 0x72, 0x8c, 0x56, 0x2f, 0x15, 0x8e, 0x56, 0x0f, 0x2a, 0xbe, 0xa3, 0x88, 0x94, 0xcd,
-	0x07, 0x29, 0x77, 0x65, 0x46, 0xc8, 0xc9, 0xf7, 0x34, 0x58, 0x94, 0xd1, 0x86, 0x07, 0xa1, 0x6e,
-	0x74, 0xbb, 0xfd, 0x5e, 0xdf, 0x36, 0x42, 0x6e, 0xca, 0xc0, 0x55, 0x69, 0x5d, 0x2e, 0xcc, 0x1d,
-	0xad, 0x91, 0x87, 0x49, 0x59, 0x2d, 0x22, 0x6f, 0x24, 0xd4, 0x91, 0x28, 0x5d, 0xfd, 0xd4, 0xa2,
-	0xf4, 0x77, 0x61, 0xd1, 0xe7, 0x01, 0xf7, 0xf7, 0xb8, 0x9e, 0xe1, 0x38, 0x33, 0xde, 0x5a, 0xf3,
-	0x30, 0x29, 0x23, 0x48, 0x7e, 0xfe, 0x6e, 0x8e, 0x89, 0xd9, 0xcf, 0xf6, 0x98, 0x98, 0x3b, 0xce,
-	0x31, 0xf1, 0x2c, 0xcc, 0x5a, 0x81, 0x6e, 0x5b, 0x37, 0xfa, 0x96, 0x29, 0x5d, 0xe4, 0x94, 0x8c,
-	0x90, 0xf5, 0x24, 0xf0, 0x67, 0xba, 0x29, 0x9b, 0xb1, 0x82, 0xad, 0xa4, 0xf9, 0xeb, 0x12, 0x4c,
-	0x0a, 0x5e, 0xe9, 0x14, 0x4c, 0x2b, 0x90, 0x82, 0x5d, 0x84, 0x6a, 0xcf, 0x35, 0xfb, 0x36, 0x57,
-	0x4b, 0x28, 0xc9, 0x25, 0xfc, 0xdf, 0xc1, 0xa0, 0x01, 0x97, 0x25, 0x19, 0xd7, 0x40, 0xd4, 0xf4,
-	0xd4, 0x50, 0xca, 0xa0, 0x17, 0x8f, 0x18, 0x52, 0xc4, 0xc4, 0x71, 0x14, 0x61, 0x03, 0xa8, 0x20,
-	0x63, 0x1a, 0xa1, 0x51, 0x9f, 0x3c, 0x3b, 0xb1, 0x5a, 0x5d, 0x7f, 0xbc, 0x99, 0x93, 0x49, 0x37,
-	0x65, 0x28, 0xb9, 0x60, 0x84, 0x86, 0xc0, 0xbe, 0x6c, 0x78, 0x9e, 0xe5, 0xec, 0x2a, 0x6e, 0x71,
-	0x4f, 0x2a, 0x96, 0xc6, 0x98, 0x94, 0x55, 0x8c, 0xa8, 0x9f, 0xfe, 0x49, 0x83, 0xe5, 0xd7, 0x02,
-	0xee, 0xcb, 0x19, 0xe2, 0x48, 0x53, 0xbb, 0x17, 0xd1, 0x92, 0xcc, 0x54, 0x3b, 0x3a, 0x33, 0xfd,
-	0x3c, 0x4c, 0x0b, 0xd1, 0x92, 0x23, 0x92, 0x24, 0xba, 0xc6, 0x0e, 0xca, 0xa6, 0xc4, 0xaf, 0xb6,
-	0x29, 0x06, 0x67, 0xb3, 0x64, 0x72, 0x84, 0x61, 0xce, 0x43, 0x05, 0x83, 0x8c, 0x3c, 0xf7, 0x26,
-	0x56, 0x27, 0x5b, 0x8b, 0xc9, 0xf9, 0x14, 0x77, 0x51, 0x56, 0x56, 0xbf, 0xdb, 0x26, 0x7d, 0xab,
-	0x04, 0x8b, 0x79, 0xba, 0xc9, 0x64, 0xf6, 0x5a, 0xb1, 0xcc, 0xfe, 0x25, 0x20, 0x8a, 0x1a, 0xfa,
-	0x86, 0x13, 0x58, 0xa1, 0x2e, 0x76, 0x2a, 0xae, 0xf5, 0xe1, 0x24, 0x2c, 0x8e, 0x8e, 0xa1, 0x6c,
-	0x5e, 0x12, 0xb7, 0x15, 0x6d, 0x7b, 0xdf, 0xe3, 0xa4, 0x03, 0x10, 0xf4, 0x3d, 0xcf, 0xde, 0xd7,
-	0xbb, 0x86, 0x87, 0x5e, 0xb2, 0x59, 0x38, 0x3e, 0xa0, 0x61, 0x13, 0x24, 0xca, 0x2a, 0xaa, 0xb1,
-	0x69, 0x78, 0xf4, 0x1f, 0x25, 0x98, 0xbd, 0x78, 0x2b, 0xe4, 0x8e, 0xc9, 0x4d, 0x5d, 0x24, 0x09,
-	0x64, 0x0e, 0x4a, 0xd1, 0xba, 0x59, 0xc9, 0x32, 0x49, 0x33, 0xd6, 0x86, 0x83, 0x0b, 0xa9, 0x8d,
-	0xa8, 0xc0, 0x89, 0x55, 0xe0, 0x08, 0x4b, 0x28, 0xaa, 0x38, 0xca, 0x95, 0xe1, 0x52, 0x96, 0x88,
-	0xbb, 0x28, 0x53, 0xb0, 0xe2, 0xf8, 0x7d, 0x46, 0x6e, 0x6a, 0x19, 0x48, 0x74, 0x61, 0x4f, 0x99,
-	0xb8, 0x0c, 0x6f, 0xea, 0xa4, 0x9b, 0xb2, 0xaa, 0x15, 0xc8, 0xd8, 0x22, 0xb7, 0xf2, 0x57, 0x61,
-	0x21, 0x46, 0xd5, 0x23, 0x8f, 0x39, 0x29, 0x19, 0x37, 0x0f, 0x06, 0x8d, 0xb9, 0x0d, 0x64, 0x13,
-	0x6f, 0xee, 0xfa, 0x90, 0x28, 0x7a, 0xec, 0x4d, 0x73, 0x46, 0x7a, 0xac, 0x49, 0x5e, 0x04, 0xd2,
-	0xb3, 0x1c, 0xbd, 0x1f, 0x98, 0xfa, 0x9e, 0x61, 0xf7, 0xb9, 0x6e, 0xf3, 0x1d, 0x95, 0x9f, 0x64,
-	0xcc, 0x39, 0x3a, 0x86, 0xb2, 0x53, 0x3d, 0xcb, 0x79, 0x2d, 0x30, 0xbf, 0x2c, 0x48, 0x5b, 0x82,
-	0xf2, 0x5b, 0x0d, 0x88, 0x14, 0x65, 0xdb, 0x15, 0x7a, 0x8e, 0x9c, 0xed, 0x98, 0x81, 0x68, 0xcc,
-	0xea, 0x13, 0x13, 0xc4, 0x09, 0xb9, 0x51, 0xee, 0x2a, 0x41, 0xa4, 0x3f, 0xae, 0x00, 0x11, 0x62,
-	0xa9, 0x10, 0xd0, 0xba, 0x77, 0xf2, 0x37, 0xa1, 0x8c, 0xb1, 0x22, 0xc0, 0x05, 0xa4, 0x1c, 0x32,
-	0xea, 0xa1, 0x6c, 0x5a, 0x85, 0x91, 0x80, 0x3c, 0x09, 0x10, 0xef, 0xff, 0x00, 0x63, 0xc3, 0x52,
-	0xb2, 0x31, 0x92, 0x3e, 0xca, 0x2a, 0x51, 0x70, 0x08, 0x88, 0x03, 0x73, 0xaa, 0x84, 0x50, 0x24,
-	0xae, 0x5c, 0xaa, 0xd2, 0x7a, 0xbe, 0x70, 0x41, 0xb2, 0x94, 0x2e, 0x48, 0x22, 0x34, 0xca, 0x54,
-	0xb9, 0xd3, 0xc2, 0x36, 0x79, 0x4b, 0x83, 0x25, 0x35, 0x24, 0x93, 0x33, 0x71, 0x53, 0xba, 0x5b,
-	0x45, 0x25, 0x9a, 0x85, 0xf8, 0x3e, 0x94, 0xe6, 0x3b, 0x04, 0x4a, 0x59, 0x4d, 0xd2, 0xd3, 0x95,
-	0x03, 0x37, 0x45, 0xc4, 0x51, 0xc3, 0x85, 0xee, 0xb0, 0xa6, 0xde, 0x2c, 0xcc, 0x78, 0x21, 0xcd,
-	0x58, 0x20, 0x51, 0x56, 0x91, 0x0d, 0x71, 0x70, 0x90, 0x9f, 0x6a, 0xb0, 0xac, 0xba, 0x72, 0x53,
-	0xbe, 0xb2, 0x64, 0x7a, 0xb5, 0x30, 0xd3, 0x47, 0xd2, 0x4c, 0xf3, 0x13, 0xbf, 0xba, 0xec, 0x6c,
-	0xe7, 0x64, 0x7f, 0xaf, 0xa3, 0x4b, 0x19, 0x9e, 0x8f, 0x19, 0xef, 0x46, 0xe1, 0x38, 0x9b, 0x76,
-	0x40, 0xc3, 0xf3, 0xd1, 0x01, 0x37, 0x3c, 0x5f, 0x68, 0x15, 0x9d, 0x4c, 0xe0, 0xc3, 0x78, 0x71,
-	0x3c, 0x41, 0x8a, 0xdd, 0x55, 0xf0, 0xd8, 0x83, 0x85, 0x6c, 0xae, 0x2d, 0x58, 0xa9, 0x24, 0xf6,
-	0xc5, 0xc2, 0xac, 0xea, 0x79, 0xc9, 0xbb, 0xe4, 0x78, 0x2a, 0x9d, 0xbb, 0x0b, 0xbe, 0x37, 0x61,
-	0xa1, 0x1f, 0x5a, 0xb6, 0x15, 0x18, 0x32, 0x01, 0xf4, 0xc5, 0x1f, 0x4c, 0x65, 0x8f, 0xcd, 0x77,
-	0x04, 0x90, 0xb2, 0xf9, 0x14, 0x8d, 0x49, 0xd2, 0x7b, 0x55, 0x98, 0x97, 0xd1, 0x42, 0x54, 0x10,
-	0xc1, 0x2b, 0x86, 0x6f, 0xf4, 0x82, 0x71, 0x4e, 0x6e, 0x1d, 0x2a, 0x7d, 0xdd, 0xf5, 0x42, 0xab,
-	0x67, 0xd8, 0x98, 0xd7, 0xb5, 0x0a, 0x2f, 0x00, 0x0f, 0xb9, 0x18, 0x88, 0xb2, 0x72, 0xff, 0x65,
-	0xf5, 0x93, 0xbc, 0x0a, 0x93, 0xa2, 0x7c, 0xc4, 0x73, 0xfc, 0xd9, 0xc2, 0xd8, 0x55, 0xb4, 0xbf,
-	0x11, 0x70, 0xca, 0x24, 0x14, 0xf9, 0x0a, 0x4c, 0x05, 0xb6, 0xeb, 0xf1, 0xf3, 0x78, 0x23, 0xf8,
-	0x5c, 0x61, 0x50, 0xbc, 0xb2, 0x52, 0x28, 0x94, 0x21, 0x5c, 0x0c, 0xbc, 0x8e, 0x41, 0x6f, 0x3c,
-	0xe0, 0xf5, 0x08, 0x78, 0x9d, 0xbc, 0x0a, 0x8b, 0xdc, 0x91, 0x5e, 0x95, 0xbd, 0xe7, 0x98, 0x92,
-	0x07, 0x7e, 0x23, 0x29, 0x67, 0xf2, 0x46, 0x51, 0x46, 0x14, 0x39, 0x73, 0xdf, 0xc1, 0xa1, 0x1a,
-	0x8d, 0x12, 0xea, 0x55, 0x41, 0xeb, 0x42, 0x61, 0x81, 0x49, 0xd6, 0xe7, 0xa5, 0x96, 0x01, 0xbd,
-	0x5d, 0xe8, 0xfa, 0x3a, 0xcc, 0x62, 0x1f, 0xaa, 0xbc, 0x5c, 0xf8, 0x7e, 0x4a, 0x31, 0x5a, 0xcc,
-	0x30, 0x8a, 0x34, 0x3f, 0xa3, 0xda, 0x57, 0x95, 0xfe, 0x87, 0x98, 0xad, 0x63, 0x50, 0xfa, 0x44,
-	0x98, 0xad, 0x67, 0x99, 0xad, 0x93, 0x2b, 0x30, 0x61, 0x87, 0x7b, 0x18, 0x97, 0x9e, 0x29, 0xcc,
-	0x02, 0x30, 0xee, 0x85, 0x7b, 0x94, 0x09, 0x20, 0xf2, 0xb6, 0x06, 0x4b, 0x51, 0x05, 0x26, 0x8b,
-	0xc2, 0x6b, 0x3e, 0x0f, 0xae, 0xb9, 0xb6, 0x89, 0xf1, 0xe8, 0x4a, 0x61, 0x16, 0x51, 0xb9, 0x99,
-	0x07, 0x4a, 0xd9, 0x62, 0x8a, 0xbe, 0x1d, 0x91, 0xc9, 0x1b, 0x50, 0x4b, 0x8f, 0xf7, 0xb8, 0x63,
-	0xd8, 0xe1, 0x3e, 0x86, 0xa6, 0xad, 0xc2, 0x22, 0x2c, 0x8f, 0x8a, 0x80, 0x90, 0x94, 0x91, 0x14,
-	0xf5, 0x15, 0x45, 0x14, 0x71, 0x31, 0x3d, 0xb6, 0xe3, 0x3a, 0xfd, 0x40, 0x16, 0xd8, 0x63, 0xc4,
-	0xc5, 0x11, 0x40, 0xca, 0xe6, 0x53, 0xb4, 0x96, 0x20, 0x89, 0xbc, 0x25, 0xba, 0x0a, 0xd8, 0x31,
-	0xba, 0xa1, 0xeb, 0x63, 0x9d, 0xfd, 0x7c, 0x61, 0xae, 0x4b, 0xd9, 0x8b, 0x05, 0x85, 0x46, 0xd9,
-	0x2c, 0x12, 0x2e, 0xc9, 0x36, 0x79, 0x0e, 0xa0, 0xab, 0xc7, 0x41, 0xf7, 0x94, 0x0c, 0xba, 0x8f,
-	0x1c, 0x0c, 0x1a, 0xe5, 0xcd, 0x24, 0xea, 0x46, 0x95, 0xac, 0x9e, 0xc4, 0xdd, 0x72, 0x57, 0x75,
-	0x9b, 0xf4, 0x57, 0x25, 0x38, 0xcd, 0x14, 0x64, 0xab, 0xbf, 0xdf, 0x31, 0xba, 0xd7, 0xe3, 0xa2,
-	0x6c, 0x9c, 0x78, 0x9e, 0xd2, 0x03, 0xde, 0xe5, 0x95, 0xc6, 0xcb, 0xdf, 0xb2, 0x68, 0x89, 0x1e,
-	0xf0, 0x92, 0xce, 0x81, 0xb9, 0x8e, 0x12, 0x3f, 0xe2, 0x37, 0x31, 0x1e, 0xbf, 0x2c, 0x1a, 0x65,
-	0xb3, 0x48, 0x50, 0xfc, 0xe8, 0x3f, 0x27, 0x61, 0x76, 0xa3, 0x2f, 0xef, 0x56, 0xf0, 0xf0, 0x5b,
-	0x8d, 0xdf, 0x26, 0x94, 0xaa, 0x16, 0x0e, 0x7d, 0x92, 0xf8, 0x06, 0xd4, 0x0d, 0x35, 0x55, 0x37,
-	0xfb, 0xbe, 0x72, 0xa8, 0x80, 0x77, 0x5d, 0xc7, 0x0c, 0x30, 0x19, 0x3f, 0x77, 0x7b, 0xd0, 0x68,
-	0xe0, 0xdc, 0x43, 0x46, 0x52, 0xf6, 0x00, 0x76, 0x5d, 0xc0, 0x9e, 0xab, 0xaa, 0x43, 0x9c, 0x1e,
-	0x9d, 0xfe, 0xce, 0x0e, 0xf7, 0x51, 0x05, 0xc7, 0x3e, 0x3d, 0x14, 0x0a, 0x65, 0x08, 0x27, 0x8e,
-	0xd0, 0x6e, 0x3f, 0xf0, 0xf0, 0xb4, 0x3b, 0xf6, 0x11, 0x2a, 0x30, 0x28, 0x93, 0x50, 0x02, 0x32,
-	0x08, 0xb9, 0x87, 0xe7, 0xdc, 0xb3, 0x85, 0x8d, 0x55, 0x8d, 0x02, 0x2c, 0x17, 0x90, 0xe2, 0x0f,
-	0xb9, 0x02, 0x35, 0xcf, 0xb7, 0xba, 0x5c, 0xdf, 0xe9, 0x3b, 0x78, 0x2d, 0xb6, 0xef, 0x71, 0xac,
-	0x1a, 0x57, 0x92, 0x58, 0x92, 0x33, 0x88, 0xb2, 0x05, 0x49, 0xbd, 0x84, 0x44, 0x79, 0x0d, 0xd0,
-	0x84, 0xb2, 0xd9, 0x0f, 0xbb, 0xd7, 0x84, 0x65, 0xa7, 0x87, 0x0b, 0xf0, 0xa8, 0x87, 0xb2, 0x69,
-	0xf9, 0xb3, 0x6d, 0x8a, 0x33, 0xb6, 0x63, 0x99, 0xa3, 0x96, 0x55, 0x2f, 0x56, 0xa9, 0x33, 0x36,
-	0x6f, 0x14, 0x65, 0xa4, 0x63, 0x99, 0x43, 0x16, 0xa5, 0x1f, 0x69, 0x70, 0xba, 0x85, 0x75, 0x52,
-	0x94, 0x5a, 0x87, 0xbe, 0xd1, 0xbd, 0xce, 0x7d, 0xf2, 0x74, 0xee, 0xdb, 0xc9, 0xe9, 0xbb, 0x7a,
-	0x35, 0x11, 0x45, 0x4f, 0xb4, 0xaf, 0x54, 0x89, 0x88, 0xe8, 0xe8, 0x39, 0xc7, 0x3e, 0x2a, 0x72,
-	0x41, 0x29, 0xab, 0x21, 0x5d, 0x96, 0xa7, 0x11, 0xf5, 0x8f, 0x1a, 0x2c, 0x8a, 0xca, 0x24, 0x7a,
-	0x2c, 0x8a, 0x57, 0xf6, 0x64, 0xce, 0xeb, 0xf0, 0xd2, 0x1d, 0x9f, 0x71, 0xde, 0x84, 0x5a, 0x04,
-	0x94, 0xae, 0x6b, 0x4a, 0x9f, 0xc6, 0x55, 0x36, 0x41, 0x4e, 0xa9, 0x5a, 0x86, 0xbe, 0xa7, 0xc1,
-	0xac, 0xba, 0x8c, 0x6c, 0x19, 0xb6, 0xe1, 0x74, 0xf9, 0x71, 0x4b, 0xf4, 0x37, 0x61, 0x11, 0x2f,
-	0x30, 0x3b, 0x0a, 0x48, 0x64, 0x63, 0xa1, 0x88, 0x10, 0x13, 0xab, 0xd5, 0xf5, 0xc7, 0x72, 0xef,
-	0x1a, 0x33, 0x8c, 0xaf, 0x8a, 0xe1, 0xad, 0x73, 0xd9, 0x17, 0x92, 0x3c, 0x48, 0xca, 0x48, 0x6f,
-	0x64, 0x22, 0xfd, 0x83, 0x06, 0x64, 0x14, 0x6f, 0x9c, 0x33, 0x61, 0x0f, 0xa6, 0x91, 0xaf, 0x34,
-	0xc7, 0x91, 0x0f, 0x3b, 0x1b, 0x28, 0xf6, 0x5c, 0x94, 0x76, 0xcb, 0x79, 0x85, 0xde, 0x72, 0x22,
-	0x66, 0xf4, 0x0d, 0x98, 0xba, 0xec, 0x9a, 0x2d, 0xc3, 0x26, 0x01, 0xd4, 0x76, 0xfa, 0x8e, 0xa9,
-	0x67, 0xb5, 0x50, 0xd7, 0xa4, 0x4a, 0x1b, 0xb9, 0x2a, 0xbd, 0xd4, 0x77, 0x4c, 0x35, 0xbb, 0x45,
-	0x51, 0x26, 0x0c, 0x20, 0x39, 0x48, 0x94, 0x2d, 0xec, 0xa8, 0xf1, 0x89, 0xda, 0xe8, 0x0f, 0x34,
-	0x80, 0xe8, 0x84, 0x35, 0x6c, 0xf2, 0x2d, 0x58, 0x94, 0x33, 0xa3, 0x3d, 0x92, 0x15, 0xe2, 0xdc,
-	0xa1, 0x42, 0x24, 0x10, 0xc3, 0x36, 0xcd, 0x83, 0xa3, 0x8c, 0xec, 0x64, 0x26, 0x49, 0xe2, 0xf7,
-	0x27, 0x00, 0x92, 0x05, 0x8d, 0x63, 0xcb, 0x94, 0x53, 0x97, 0x0a, 0x38, 0x75, 0xe6, 0x99, 0x73,
-	0xe2, 0xb3, 0xff, 0x36, 0xc2, 0xe4, 0x9e, 0x2b, 0xef, 0x7c, 0xad, 0x1e, 0x97, 0xe7, 0x58, 0xa1,
-	0x6f, 0x23, 0xd2, 0xb3, 0xf1, 0xdb, 0x08, 0x24, 0xc9, 0xf7, 0x95, 0xc7, 0x61, 0x4a, 0xe8, 0x9c,
-	0xfb, 0x78, 0x9c, 0xa5, 0x32, 0x00, 0x45, 0xa7, 0x0c, 0x07, 0xd0, 0xbf, 0x94, 0x60, 0x2e, 0x6b,
-	0xd4, 0x71, 0x8c, 0x91, 0xd1, 0x6a, 0xe9, 0x1e, 0x6b, 0x75, 0xe2, 0x53, 0xd3, 0xea, 0xe4, 0x9d,
-	0xb4, 0xfa, 0xe1, 0x14, 0x9c, 0xda, 0xb0, 0x6d, 0x54, 0xea, 0xd8, 0xf1, 0xea, 0x17, 0x1a, 0xa4,
-	0x1e, 0xb7, 0xf5, 0x1d, 0xdf, 0xed, 0xc5, 0xdb, 0x2c, 0x74, 0xe5, 0xd5, 0x1a, 0xf7, 0x03, 0x3c,
-	0x5a, 0xbe, 0x5e, 0x38, 0x77, 0x79, 0x7c, 0xf8, 0xf9, 0xfc, 0x30, 0x0e, 0x94, 0x3d, 0x1c, 0xbf,
-	0x95, 0x5f, 0xf2, 0xdd, 0x1e, 0xae, 0x6f, 0xdb, 0xdd, 0x52, 0xfd, 0xe4, 0x97, 0x1a, 0x9c, 0x3b,
-	0x0c, 0x66, 0xc7, 0xf5, 0x75, 0x4c, 0x14, 0xf1, 0x54, 0x7f, 0xbd, 0xb0, 0xa4, 0x9f, 0x3b, 0x5a,
-	0xd2, 0x14, 0x0b, 0xca, 0x56, 0xf2, 0x44, 0xbd, 0xe4, 0xfa, 0x98, 0x2c, 0x93, 0x9f, 0x68, 0xb0,
-	0x1c, 0xbb, 0x9c, 0xc2, 0xb1, 0xad, 0x1b, 0x71, 0x81, 0x38, 0x39, 0xde, 0xfd, 0xe3, 0xe1, 0xc8,
-	0x22, 0x5f, 0x46, 0x97, 0x15, 0x82, 0x6d, 0x59, 0x37, 0xa2, 0x5a, 0xf1, 0x1d, 0x0d, 0x1e, 0x1c,
-	0x9a, 0xe7, 0x73, 0xcf, 0xd8, 0xef, 0x71, 0x27, 0x0c, 0x70, 0x2b, 0xb3, 0xc2, 0x02, 0x9d, 0xcd,
-	0x15, 0x28, 0x01, 0x1e, 0x92, 0x87, 0xc5, 0x1d, 0xe4, 0x67, 0x1a, 0x9c, 0x51, 0xf7, 0xa8, 0x29,
-	0x85, 0xa7, 0xfc, 0x4d, 0x5d, 0x48, 0x6f, 0x17, 0x96, 0x88, 0xa6, 0xaf, 0x68, 0x73, 0xa1, 0x29,
-	0x3b, 0x2d, 0x7b, 0x37, 0x22, 0x13, 0xc6, 0x2e, 0x46, 0x7f, 0xaf, 0x41, 0x3d, 0xf5, 0x7c, 0x72,
-	0xd5, 0x72, 0x76, 0x6d, 0xfe, 0x5f, 0xf2, 0x88, 0x72, 0xd7, 0x5f, 0xd9, 0x88, 0xf3, 0xaf, 0x22,
-	0xc4, 0x12, 0x03, 0x83, 0xfb, 0x8f, 0xd0, 0x85, 0x1e, 0xa1, 0x0f, 0x79, 0x8d, 0x3b, 0x79, 0xac,
-	0xd7, 0xb8, 0xdf, 0x68, 0x30, 0x9f, 0xae, 0x02, 0xc6, 0xbd, 0x6e, 0xb8, 0x0e, 0xb3, 0xea, 0xe9,
-	0x29, 0x2a, 0x60, 0x4a, 0xe3, 0x7d, 0xbe, 0x96, 0x01, 0xa3, 0x4c, 0x7e, 0x53, 0x19, 0x57, 0x2c,
-	0x7f, 0xd6, 0x60, 0x26, 0x2d, 0xfc, 0x71, 0x1d, 0xe9, 0x87, 0x1a, 0x90, 0x4c, 0x85, 0xa4, 0xec,
-	0xa8, 0x12, 0xfc, 0xff, 0xcf, 0xb5, 0xe3, 0xb0, 0xce, 0x5a, 0x4f, 0x89, 0x15, 0x1e, 0x0c, 0x1a,
-	0x23, 0xda, 0x4c, 0x0c, 0x32, 0xca, 0x82, 0xb2, 0x79, 0x6f, 0x68, 0x38, 0xfd, 0x9d, 0x06, 0x0b,
-	0x23, 0xe8, 0xe3, 0x98, 0xe4, 0x06, 0x9c, 0xea, 0x64, 0x6b, 0x56, 0x34, 0xca, 0x0b, 0x85, 0x8d,
-	0xf2, 0x40, 0xf6, 0xa9, 0x30, 0x36, 0x0b, 0x7e, 0x97, 0x15, 0x1b, 0xe6, 0xaf, 0x1a, 0xcc, 0xa6,
-	0xd7, 0xd0, 0x3a, 0xae, 0x65, 0x7e, 0x74, 0x94, 0x65, 0x1e, 0xbd, 0x3b, 0xcb, 0x7c, 0x72, 0xa6,
-	0x79, 0x67, 0x0e, 0x6a, 0xa9, 0xb7, 0x96, 0x38, 0x7e, 0xdd, 0x7f, 0x6e, 0xb9, 0xff, 0xdc, 0x72,
-	0xff, 0xb9, 0xe5, 0xfe, 0x73, 0xcb, 0xfd, 0xe7, 0x96, 0xff, 0x99, 0xe7, 0x96, 0xe1, 0xe4, 0x71,
-	0xfe, 0x13, 0x49, 0x1e, 0x17, 0xc6, 0x4f, 0x1e, 0xc9, 0x3d, 0x49, 0x1e, 0x6b, 0xc7, 0x49, 0x1e,
-	0x5b, 0x2f, 0xbc, 0x7f, 0xb0, 0xa2, 0x7d, 0x70, 0xb0, 0xa2, 0xfd, 0xfd, 0x60, 0x45, 0x7b, 0xf7,
-	0xe3, 0x95, 0x13, 0x1f, 0x7c, 0xbc, 0x72, 0xe2, 0xc3, 0x8f, 0x57, 0x4e, 0x7c, 0xad, 0x99, 0x31,
-	0xb7, 0x58, 0xc9, 0x13, 0xee, 0xce, 0x8e, 0xd5, 0xb5, 0x0c, 0x1b, 0xdb, 0x6b, 0xf8, 0x7f, 0x50,
-	0xd2, 0xf4, 0x9d, 0x29, 0x79, 0x3b, 0xf1, 0x85, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xb0,
-	0x53, 0x62, 0x23, 0x35, 0x00, 0x00,
+// PositionManagerGrant lets manager act on the lend and borrow positions of
+// owner within the granted permissions.
+type PositionManagerGrant struct {
+	Owner       string               `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Manager     string               `protobuf:"bytes,2,opt,name=manager,proto3" json:"manager,omitempty" yaml:"manager"`
+	Permissions []PositionPermission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=comdex.lend.v1beta1.PositionPermission" json:"permissions,omitempty" yaml:"permissions"`
 }
 
-func (m *LendAsset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PositionManagerGrant) Reset()         { *m = PositionManagerGrant{} }
+func (m *PositionManagerGrant) String() string { return proto.CompactTextString(m) }
+func (*PositionManagerGrant) ProtoMessage()    {}
+func (*PositionManagerGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{27}
+}
+func (m *PositionManagerGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionManagerGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionManagerGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *LendAsset) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PositionManagerGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionManagerGrant.Merge(m, src)
+}
+func (m *PositionManagerGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionManagerGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionManagerGrant.DiscardUnknown(m)
 }
 
-func (m *LendAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalRewards.Size()
-		i -= size
-		if _, err := m.TotalRewards.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+var xxx_messageInfo_PositionManagerGrant proto.InternalMessageInfo
+
+func (m *PositionManagerGrant) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	i--
-	dAtA[i] = 0x62
-	if len(m.CPoolName) > 0 {
-		i -= len(m.CPoolName)
-		copy(dAtA[i:], m.CPoolName)
-		i = encodeVarintLend(dAtA, i, uint64(len(m.CPoolName)))
-		i--
-		dAtA[i] = 0x5a
+	return ""
+}
+
+func (m *PositionManagerGrant) GetManager() string {
+	if m != nil {
+		return m.Manager
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastInteractionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastInteractionTime):])
-	if err1 != nil {
-		return 0, err1
+	return ""
+}
+
+func (m *PositionManagerGrant) GetPermissions() []PositionPermission {
+	if m != nil {
+		return m.Permissions
 	}
-	i -= n1
-	i = encodeVarintLend(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.GlobalIndex.Size()
-		i -= size
-		if _, err := m.GlobalIndex.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+// EModeCategory groups correlated assets. Borrowing a member asset against
+// another member of the same category uses the category's ltv and
+// liquidation threshold instead of those of the collateral asset.
+type EModeCategory struct {
+	ID                   uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Name                 string                                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	AssetIDs             []uint64                               `protobuf:"varint,3,rep,packed,name=asset_ids,json=assetIds,proto3" json:"asset_ids,omitempty" yaml:"asset_ids"`
+	Ltv                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=ltv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ltv" yaml:"ltv"`
+	LiquidationThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=liquidation_threshold,json=liquidationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_threshold" yaml:"liquidation_threshold"`
+}
+
+func (m *EModeCategory) Reset()         { *m = EModeCategory{} }
+func (m *EModeCategory) String() string { return proto.CompactTextString(m) }
+func (*EModeCategory) ProtoMessage()    {}
+func (*EModeCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{28}
+}
+func (m *EModeCategory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EModeCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EModeCategory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x4a
-	if m.AppID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AppID))
-		i--
-		dAtA[i] = 0x40
+}
+func (m *EModeCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EModeCategory.Merge(m, src)
+}
+func (m *EModeCategory) XXX_Size() int {
+	return m.Size()
+}
+func (m *EModeCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_EModeCategory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EModeCategory proto.InternalMessageInfo
+
+func (m *EModeCategory) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	{
-		size := m.AvailableToBorrow.Size()
-		i -= size
-		if _, err := m.AvailableToBorrow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *EModeCategory) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	i--
-	dAtA[i] = 0x3a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LendingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LendingTime):])
-	if err2 != nil {
-		return 0, err2
+	return ""
+}
+
+func (m *EModeCategory) GetAssetIDs() []uint64 {
+	if m != nil {
+		return m.AssetIDs
 	}
-	i -= n2
-	i = encodeVarintLend(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+// AdaptiveRateState is the curve of an asset priced by the adaptive interest
+// rate model in a pool. The multiplier scales the kinked curve and moves
+// towards the rate that brings utilisation back to u_optimal.
+type AdaptiveRateState struct {
+	PoolID      uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	AssetID     uint64                                 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	Multiplier  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier" yaml:"multiplier"`
+	LastUpdated time.Time                              `protobuf:"bytes,4,opt,name=last_updated,json=lastUpdated,proto3,stdtime" json:"last_updated" yaml:"last_updated"`
+}
+
+func (m *AdaptiveRateState) Reset()         { *m = AdaptiveRateState{} }
+func (m *AdaptiveRateState) String() string { return proto.CompactTextString(m) }
+func (*AdaptiveRateState) ProtoMessage()    {}
+func (*AdaptiveRateState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{29}
+}
+func (m *AdaptiveRateState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdaptiveRateState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdaptiveRateState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintLend(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PoolID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.AssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *AdaptiveRateState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptiveRateState.Merge(m, src)
+}
+func (m *AdaptiveRateState) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdaptiveRateState) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptiveRateState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdaptiveRateState proto.InternalMessageInfo
+
+func (m *AdaptiveRateState) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
 	}
-	if m.ID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *AdaptiveRateState) GetAssetID() uint64 {
+	if m != nil {
+		return m.AssetID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BorrowAsset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AdaptiveRateState) GetLastUpdated() time.Time {
+	if m != nil {
+		return m.LastUpdated
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *BorrowAsset) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BadDebtWaterfall is the order in which the shortfall of a liquidation
+// auction is covered. Steps are taken from the BadDebtCoverage constants.
+type BadDebtWaterfall struct {
+	Steps []uint64 `protobuf:"varint,1,rep,packed,name=steps,proto3" json:"steps,omitempty" yaml:"steps"`
 }
 
-func (m *BorrowAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsLiquidated {
-		i--
-		if m.IsLiquidated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
-	}
-	if len(m.CPoolName) > 0 {
-		i -= len(m.CPoolName)
-		copy(dAtA[i:], m.CPoolName)
-		i = encodeVarintLend(dAtA, i, uint64(len(m.CPoolName)))
-		i--
-		dAtA[i] = 0x72
-	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastInteractionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastInteractionTime):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintLend(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.ReserveGlobalIndex.Size()
-		i -= size
-		if _, err := m.ReserveGlobalIndex.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.GlobalIndex.Size()
-		i -= size
-		if _, err := m.GlobalIndex.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.InterestAccumulated.Size()
-		i -= size
-		if _, err := m.InterestAccumulated.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.StableBorrowRate.Size()
-		i -= size
-		if _, err := m.StableBorrowRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BorrowingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BorrowingTime):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintLend(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.BridgedAssetAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *BadDebtWaterfall) Reset()         { *m = BadDebtWaterfall{} }
+func (m *BadDebtWaterfall) String() string { return proto.CompactTextString(m) }
+func (*BadDebtWaterfall) ProtoMessage()    {}
+func (*BadDebtWaterfall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{30}
+}
+func (m *BadDebtWaterfall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadDebtWaterfall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadDebtWaterfall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLend(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLend(dAtA, i, uint64(size))
+}
+func (m *BadDebtWaterfall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadDebtWaterfall.Merge(m, src)
+}
+func (m *BadDebtWaterfall) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadDebtWaterfall) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadDebtWaterfall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadDebtWaterfall proto.InternalMessageInfo
+
+func (m *BadDebtWaterfall) GetSteps() []uint64 {
+	if m != nil {
+		return m.Steps
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+// CTokenExchangeRate is the amount of the underlying asset a cToken of a
+// pool redeems for. It starts at one and is lowered when bad debt is
+// socialized across the lenders of the pool.
+type CTokenExchangeRate struct {
+	PoolID  uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	AssetID uint64                                 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	Rate    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate" yaml:"rate"`
+}
+
+func (m *CTokenExchangeRate) Reset()         { *m = CTokenExchangeRate{} }
+func (m *CTokenExchangeRate) String() string { return proto.CompactTextString(m) }
+func (*CTokenExchangeRate) ProtoMessage()    {}
+func (*CTokenExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{31}
+}
+func (m *CTokenExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CTokenExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CTokenExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.PairID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.PairID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.IsStableBorrow {
-		i--
-		if m.IsStableBorrow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LendingID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.LendingID))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.ID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *CTokenExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CTokenExchangeRate.Merge(m, src)
+}
+func (m *CTokenExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *CTokenExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CTokenExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CTokenExchangeRate proto.InternalMessageInfo
+
+func (m *CTokenExchangeRate) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CTokenExchangeRate) GetAssetID() uint64 {
+	if m != nil {
+		return m.AssetID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AccountCollateral is the amount of cTokens of a lend position locked as
+// collateral of a cross-collateral account.
+type AccountCollateral struct {
+	LendID  uint64     `protobuf:"varint,1,opt,name=lend_id,json=lendId,proto3" json:"lend_id,omitempty" yaml:"lend_id"`
+	AssetID uint64     `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	Amount  types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount" yaml:"amount"`
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetData) > 0 {
-		for iNdEx := len(m.AssetData) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AssetData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLend(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *AccountCollateral) Reset()         { *m = AccountCollateral{} }
+func (m *AccountCollateral) String() string { return proto.CompactTextString(m) }
+func (*AccountCollateral) ProtoMessage()    {}
+func (*AccountCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{32}
+}
+func (m *AccountCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.CPoolName) > 0 {
-		i -= len(m.CPoolName)
-		copy(dAtA[i:], m.CPoolName)
-		i = encodeVarintLend(dAtA, i, uint64(len(m.CPoolName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintLend(dAtA, i, uint64(len(m.ModuleName)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *AccountCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountCollateral.Merge(m, src)
+}
+func (m *AccountCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountCollateral proto.InternalMessageInfo
+
+func (m *AccountCollateral) GetLendID() uint64 {
+	if m != nil {
+		return m.LendID
 	}
-	if m.PoolID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *AccountCollateral) GetAssetID() uint64 {
+	if m != nil {
+		return m.AssetID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *UserAssetLendBorrowMapping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AccountCollateral) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *UserAssetLendBorrowMapping) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AccountDebt is an asset borrowed by a cross-collateral account.
+type AccountDebt struct {
+	AssetID             uint64                                 `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	AmountOut           types.Coin                             `protobuf:"bytes,2,opt,name=amount_out,json=amountOut,proto3" json:"amount_out" yaml:"amount_out"`
+	InterestAccumulated github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=interest_accumulated,json=interestAccumulated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_accumulated" yaml:"interest_accumulated"`
+	ReserveInterest     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reserve_interest,json=reserveInterest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_interest" yaml:"reserve_interest"`
+	LastInteractionTime time.Time                              `protobuf:"bytes,5,opt,name=last_interaction_time,json=lastInteractionTime,proto3,stdtime" json:"last_interaction_time" yaml:"last_interaction_time"`
 }
 
-func (m *UserAssetLendBorrowMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BorrowId) > 0 {
-		dAtA10 := make([]byte, len(m.BorrowId)*10)
-		var j9 int
-		for _, num := range m.BorrowId {
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA10[j9] = uint8(num)
-			j9++
-		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintLend(dAtA, i, uint64(j9))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x18
+func (m *AccountDebt) Reset()         { *m = AccountDebt{} }
+func (m *AccountDebt) String() string { return proto.CompactTextString(m) }
+func (*AccountDebt) ProtoMessage()    {}
+func (*AccountDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{33}
+}
+func (m *AccountDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.LendId != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.LendId))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *AccountDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountDebt.Merge(m, src)
+}
+func (m *AccountDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountDebt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountDebt proto.InternalMessageInfo
+
+func (m *AccountDebt) GetAssetID() uint64 {
+	if m != nil {
+		return m.AssetID
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintLend(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *AccountDebt) GetAmountOut() types.Coin {
+	if m != nil {
+		return m.AmountOut
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *AssetDataPoolMapping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AccountDebt) GetLastInteractionTime() time.Time {
+	if m != nil {
+		return m.LastInteractionTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *AssetDataPoolMapping) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CrossCollateralAccount aggregates the lend positions of an owner in a pool
+// into one borrowing power with a single health factor. It lives side by side
+// with the borrows tied to a single lend position.
+type CrossCollateralAccount struct {
+	Owner      string              `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	PoolID     uint64              `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	AppID      uint64              `protobuf:"varint,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	Collateral []AccountCollateral `protobuf:"bytes,4,rep,name=collateral,proto3" json:"collateral" yaml:"collateral"`
+	Debts      []AccountDebt       `protobuf:"bytes,5,rep,name=debts,proto3" json:"debts" yaml:"debts"`
 }
 
-func (m *AssetDataPoolMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SupplyCap.Size()
-		i -= size
-		if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *CrossCollateralAccount) Reset()         { *m = CrossCollateralAccount{} }
+func (m *CrossCollateralAccount) String() string { return proto.CompactTextString(m) }
+func (*CrossCollateralAccount) ProtoMessage()    {}
+func (*CrossCollateralAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{34}
+}
+func (m *CrossCollateralAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossCollateralAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossCollateralAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.AssetTransitType != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetTransitType))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.AssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CrossCollateralAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossCollateralAccount.Merge(m, src)
+}
+func (m *CrossCollateralAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossCollateralAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossCollateralAccount.DiscardUnknown(m)
 }
 
-func (m *Extended_Pair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CrossCollateralAccount proto.InternalMessageInfo
+
+func (m *CrossCollateralAccount) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Extended_Pair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CrossCollateralAccount) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
 }
 
-func (m *Extended_Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinUsdValueLeft != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.MinUsdValueLeft))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.AssetOutPoolID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetOutPoolID))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.IsInterPool {
-		i--
-		if m.IsInterPool {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.AssetOut != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetOut))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AssetIn != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetIn))
-		i--
-		dAtA[i] = 0x10
+func (m *CrossCollateralAccount) GetAppID() uint64 {
+	if m != nil {
+		return m.AppID
 	}
-	if m.Id != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *CrossCollateralAccount) GetCollateral() []AccountCollateral {
+	if m != nil {
+		return m.Collateral
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AssetToPairMapping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CrossCollateralAccount) GetDebts() []AccountDebt {
+	if m != nil {
+		return m.Debts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AssetToPairMapping) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AccountHealth is the value of the collateral and debts of a
+// cross-collateral account. The health factor is the liquidation limit over
+// the debt value, the account can be liquidated when it falls below one.
+type AccountHealth struct {
+	CollateralValue  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=collateral_value,json=collateralValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"collateral_value" yaml:"collateral_value"`
+	BorrowLimit      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=borrow_limit,json=borrowLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"borrow_limit" yaml:"borrow_limit"`
+	LiquidationLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidation_limit,json=liquidationLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_limit" yaml:"liquidation_limit"`
+	DebtValue        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=debt_value,json=debtValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"debt_value" yaml:"debt_value"`
+	HealthFactor     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=health_factor,json=healthFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"health_factor" yaml:"health_factor"`
 }
 
-func (m *AssetToPairMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PairID) > 0 {
-		dAtA12 := make([]byte, len(m.PairID)*10)
-		var j11 int
-		for _, num := range m.PairID {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
+func (m *AccountHealth) Reset()         { *m = AccountHealth{} }
+func (m *AccountHealth) String() string { return proto.CompactTextString(m) }
+func (*AccountHealth) ProtoMessage()    {}
+func (*AccountHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{35}
+}
+func (m *AccountHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintLend(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.AssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *AccountHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHealth.Merge(m, src)
+}
+func (m *AccountHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountHealth proto.InternalMessageInfo
+
+// FixedTermMarket is a maturity bucket of an asset in a pool. Lenders commit
+// liquidity to the bucket until maturity and borrowers lock its rate until
+// then. The committed liquidity is held by the module account of the pool but
+// kept apart from the variable rate liquidity.
+type FixedTermMarket struct {
+	ID       uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	AppID    uint64    `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	PoolID   uint64    `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	AssetID  uint64    `protobuf:"varint,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	Maturity time.Time `protobuf:"bytes,5,opt,name=maturity,proto3,stdtime" json:"maturity" yaml:"maturity"`
+	// rate is the yearly rate loans of the bucket pay until maturity.
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate" yaml:"rate"`
+	// early_repayment_penalty is the share of the interest left until maturity
+	// a borrower repaying early pays on top of the interest accrued.
+	EarlyRepaymentPenalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=early_repayment_penalty,json=earlyRepaymentPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"early_repayment_penalty" yaml:"early_repayment_penalty"`
+	TotalCommitted        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=total_committed,json=totalCommitted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_committed" yaml:"total_committed"`
+	// available is the liquidity of the bucket not lent out, including the
+	// interest repaid to it.
+	Available     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=available,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"available" yaml:"available"`
+	TotalBorrowed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=total_borrowed,json=totalBorrowed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_borrowed" yaml:"total_borrowed"`
+	// shortfall is the part of converted loans the variable rate liquidity of
+	// the pool could not pay the bucket. Lenders redeem it from the pool as
+	// the variable rate borrows are repaid.
+	Shortfall github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=shortfall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shortfall" yaml:"shortfall"`
+}
+
+func (m *FixedTermMarket) Reset()         { *m = FixedTermMarket{} }
+func (m *FixedTermMarket) String() string { return proto.CompactTextString(m) }
+func (*FixedTermMarket) ProtoMessage()    {}
+func (*FixedTermMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{36}
+}
+func (m *FixedTermMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FixedTermMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FixedTermMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PoolID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *FixedTermMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixedTermMarket.Merge(m, src)
+}
+func (m *FixedTermMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FixedTermMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixedTermMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixedTermMarket proto.InternalMessageInfo
+
+func (m *FixedTermMarket) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PoolAssetLBMapping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FixedTermMarket) GetAppID() uint64 {
+	if m != nil {
+		return m.AppID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PoolAssetLBMapping) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FixedTermMarket) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
 }
 
-func (m *PoolAssetLBMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.UtilisationRatio.Size()
-		i -= size
-		if _, err := m.UtilisationRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *FixedTermMarket) GetAssetID() uint64 {
+	if m != nil {
+		return m.AssetID
+	}
+	return 0
+}
+
+func (m *FixedTermMarket) GetMaturity() time.Time {
+	if m != nil {
+		return m.Maturity
+	}
+	return time.Time{}
+}
+
+// FixedTermDeposit is liquidity committed by a lender to a fixed-term market.
+type FixedTermDeposit struct {
+	ID       uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	MarketID uint64     `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty" yaml:"market_id"`
+	Owner    string     `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Amount   types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+}
+
+func (m *FixedTermDeposit) Reset()         { *m = FixedTermDeposit{} }
+func (m *FixedTermDeposit) String() string { return proto.CompactTextString(m) }
+func (*FixedTermDeposit) ProtoMessage()    {}
+func (*FixedTermDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{37}
+}
+func (m *FixedTermDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FixedTermDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FixedTermDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.StableBorrowApr.Size()
-		i -= size
-		if _, err := m.StableBorrowApr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *FixedTermDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixedTermDeposit.Merge(m, src)
+}
+func (m *FixedTermDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FixedTermDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixedTermDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixedTermDeposit proto.InternalMessageInfo
+
+func (m *FixedTermDeposit) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *FixedTermDeposit) GetMarketID() uint64 {
+	if m != nil {
+		return m.MarketID
+	}
+	return 0
+}
+
+func (m *FixedTermDeposit) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *FixedTermDeposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// FixedTermLoan is a loan of a fixed-term market collateralised by cTokens
+// of a lend position of the borrower, as for a borrow of the pair.
+type FixedTermLoan struct {
+	ID        uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	MarketID  uint64                                 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty" yaml:"market_id"`
+	Owner     string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LendID    uint64                                 `protobuf:"varint,4,opt,name=lend_id,json=lendId,proto3" json:"lend_id,omitempty" yaml:"lend_id"`
+	PairID    uint64                                 `protobuf:"varint,5,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty" yaml:"pair_id"`
+	AmountIn  types.Coin                             `protobuf:"bytes,6,opt,name=amount_in,json=amountIn,proto3" json:"amount_in" yaml:"amount_in"`
+	AmountOut types.Coin                             `protobuf:"bytes,7,opt,name=amount_out,json=amountOut,proto3" json:"amount_out" yaml:"amount_out"`
+	Rate      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate" yaml:"rate"`
+	StartTime time.Time                              `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	Maturity  time.Time                              `protobuf:"bytes,10,opt,name=maturity,proto3,stdtime" json:"maturity" yaml:"maturity"`
+	// interest_due is the interest owed at maturity.
+	InterestDue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=interest_due,json=interestDue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_due" yaml:"interest_due"`
+}
+
+func (m *FixedTermLoan) Reset()         { *m = FixedTermLoan{} }
+func (m *FixedTermLoan) String() string { return proto.CompactTextString(m) }
+func (*FixedTermLoan) ProtoMessage()    {}
+func (*FixedTermLoan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b87bb4bef8334ddd, []int{38}
+}
+func (m *FixedTermLoan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FixedTermLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FixedTermLoan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x5a
+}
+func (m *FixedTermLoan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixedTermLoan.Merge(m, src)
+}
+func (m *FixedTermLoan) XXX_Size() int {
+	return m.Size()
+}
+func (m *FixedTermLoan) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixedTermLoan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixedTermLoan proto.InternalMessageInfo
+
+func (m *FixedTermLoan) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *FixedTermLoan) GetMarketID() uint64 {
+	if m != nil {
+		return m.MarketID
+	}
+	return 0
+}
+
+func (m *FixedTermLoan) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *FixedTermLoan) GetLendID() uint64 {
+	if m != nil {
+		return m.LendID
+	}
+	return 0
+}
+
+func (m *FixedTermLoan) GetPairID() uint64 {
+	if m != nil {
+		return m.PairID
+	}
+	return 0
+}
+
+func (m *FixedTermLoan) GetAmountIn() types.Coin {
+	if m != nil {
+		return m.AmountIn
+	}
+	return types.Coin{}
+}
+
+func (m *FixedTermLoan) GetAmountOut() types.Coin {
+	if m != nil {
+		return m.AmountOut
+	}
+	return types.Coin{}
+}
+
+func (m *FixedTermLoan) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *FixedTermLoan) GetMaturity() time.Time {
+	if m != nil {
+		return m.Maturity
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("comdex.lend.v1beta1.PositionPermission", PositionPermission_name, PositionPermission_value)
+	proto.RegisterType((*LendAsset)(nil), "comdex.lend.v1beta1.LendAsset")
+	proto.RegisterType((*BorrowAsset)(nil), "comdex.lend.v1beta1.BorrowAsset")
+	proto.RegisterType((*Pool)(nil), "comdex.lend.v1beta1.Pool")
+	proto.RegisterType((*UserAssetLendBorrowMapping)(nil), "comdex.lend.v1beta1.UserAssetLendBorrowMapping")
+	proto.RegisterType((*AssetDataPoolMapping)(nil), "comdex.lend.v1beta1.AssetDataPoolMapping")
+	proto.RegisterType((*Extended_Pair)(nil), "comdex.lend.v1beta1.Extended_Pair")
+	proto.RegisterType((*AssetToPairMapping)(nil), "comdex.lend.v1beta1.AssetToPairMapping")
+	proto.RegisterType((*PoolAssetLBMapping)(nil), "comdex.lend.v1beta1.PoolAssetLBMapping")
+	proto.RegisterType((*AssetRatesParams)(nil), "comdex.lend.v1beta1.AssetRatesParams")
+	proto.RegisterType((*ReserveBuybackAssetData)(nil), "comdex.lend.v1beta1.ReserveBuybackAssetData")
+	proto.RegisterType((*AuctionParams)(nil), "comdex.lend.v1beta1.AuctionParams")
+	proto.RegisterType((*BorrowInterestTracker)(nil), "comdex.lend.v1beta1.Borrow_interest_tracker")
+	proto.RegisterType((*LendRewardsTracker)(nil), "comdex.lend.v1beta1.Lend_rewards_tracker")
+	proto.RegisterType((*ModuleBalance)(nil), "comdex.lend.v1beta1.ModuleBalance")
+	proto.RegisterType((*ModuleBalanceStats)(nil), "comdex.lend.v1beta1.ModuleBalanceStats")
+	proto.RegisterType((*ModBal)(nil), "comdex.lend.v1beta1.ModBal")
+	proto.RegisterType((*ReserveBal)(nil), "comdex.lend.v1beta1.ReserveBal")
+	proto.RegisterType((*FundModBal)(nil), "comdex.lend.v1beta1.FundModBal")
+	proto.RegisterType((*FundReserveBal)(nil), "comdex.lend.v1beta1.FundReserveBal")
+	proto.RegisterType((*AllReserveStats)(nil), "comdex.lend.v1beta1.AllReserveStats")
+	proto.RegisterType((*AssetToPairSingleMapping)(nil), "comdex.lend.v1beta1.AssetToPairSingleMapping")
+	proto.RegisterType((*PoolPairs)(nil), "comdex.lend.v1beta1.PoolPairs")
+	proto.RegisterType((*PoolInterestData)(nil), "comdex.lend.v1beta1.PoolInterestData")
+	proto.RegisterType((*PoolInterest)(nil), "comdex.lend.v1beta1.PoolInterest")
+	proto.RegisterType((*PoolInterestDataB)(nil), "comdex.lend.v1beta1.PoolInterestDataB")
+	proto.RegisterType((*PoolInterestB)(nil), "comdex.lend.v1beta1.PoolInterestB")
+	proto.RegisterType((*AssetRatesPoolPairs)(nil), "comdex.lend.v1beta1.AssetRatesPoolPairs")
+	proto.RegisterType((*PositionManagerGrant)(nil), "comdex.lend.v1beta1.PositionManagerGrant")
+	proto.RegisterType((*EModeCategory)(nil), "comdex.lend.v1beta1.EModeCategory")
+	proto.RegisterType((*AdaptiveRateState)(nil), "comdex.lend.v1beta1.AdaptiveRateState")
+	proto.RegisterType((*BadDebtWaterfall)(nil), "comdex.lend.v1beta1.BadDebtWaterfall")
+	proto.RegisterType((*CTokenExchangeRate)(nil), "comdex.lend.v1beta1.CTokenExchangeRate")
+	proto.RegisterType((*AccountCollateral)(nil), "comdex.lend.v1beta1.AccountCollateral")
+	proto.RegisterType((*AccountDebt)(nil), "comdex.lend.v1beta1.AccountDebt")
+	proto.RegisterType((*CrossCollateralAccount)(nil), "comdex.lend.v1beta1.CrossCollateralAccount")
+	proto.RegisterType((*AccountHealth)(nil), "comdex.lend.v1beta1.AccountHealth")
+	proto.RegisterType((*FixedTermMarket)(nil), "comdex.lend.v1beta1.FixedTermMarket")
+	proto.RegisterType((*FixedTermDeposit)(nil), "comdex.lend.v1beta1.FixedTermDeposit")
+	proto.RegisterType((*FixedTermLoan)(nil), "comdex.lend.v1beta1.FixedTermLoan")
+}
+
+func init() { proto.RegisterFile("comdex/lend/v1beta1/lend.proto", fileDescriptor_b87bb4bef8334ddd) }
+
+var fileDescriptor_b87bb4bef8334ddd = []byte{
+	// 4157 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x5d, 0x6c, 0x1c, 0x4b,
+	0x56, 0x7f, 0x7a, 0x66, 0x6c, 0xcf, 0x9c, 0xf1, 0xc7, 0xb8, 0x6c, 0x27, 0x1d, 0xe7, 0xc6, 0xe3,
+	0x54, 0xfe, 0xf7, 0x6e, 0xee, 0xfe, 0x59, 0x47, 0x09, 0xbb, 0x12, 0x44, 0x7b, 0x59, 0x3c, 0xb6,
+	0x73, 0x33, 0xbb, 0x4e, 0xe2, 0x5b, 0x76, 0x08, 0x0b, 0x0b, 0xbd, 0x35, 0xd3, 0x65, 0xbb, 0x49,
+	0x4f, 0x77, 0xa7, 0xbb, 0x27, 0x89, 0x81, 0xbd, 0xa0, 0x5d, 0x09, 0x5d, 0x02, 0x88, 0x0b, 0xaf,
+	0x70, 0x9f, 0x90, 0x90, 0x78, 0xe3, 0x81, 0x7d, 0x00, 0x89, 0x07, 0x84, 0x04, 0x2b, 0x84, 0xc4,
+	0xe5, 0xe3, 0x61, 0x59, 0xa4, 0x01, 0xf9, 0x3e, 0x80, 0x78, 0xe0, 0x21, 0x0f, 0x3c, 0xf0, 0x84,
+	0xea, 0xa3, 0xbf, 0x66, 0xc6, 0xb1, 0xdb, 0x93, 0x8f, 0x45, 0xca, 0xd3, 0x74, 0x9d, 0xaa, 0xfa,
+	0x9d, 0xaa, 0x73, 0xaa, 0x4e, 0x9d, 0x3a, 0x55, 0x35, 0xb0, 0xd4, 0x76, 0x3b, 0x26, 0x7b, 0x72,
+	0xd5, 0x66, 0x8e, 0x79, 0xf5, 0xd1, 0xb5, 0x16, 0x0b, 0xe9, 0x35, 0x91, 0x58, 0xf1, 0x7c, 0x37,
+	0x74, 0xd1, 0x9c, 0xcc, 0x5f, 0x11, 0x24, 0x95, 0xbf, 0x38, 0xbf, 0xe7, 0xee, 0xb9, 0x22, 0xff,
+	0x2a, 0xff, 0x92, 0x45, 0x17, 0xeb, 0x7b, 0xae, 0xbb, 0x67, 0xb3, 0xab, 0x22, 0xd5, 0xea, 0xee,
+	0x5e, 0x0d, 0xad, 0x0e, 0x0b, 0x42, 0xda, 0xf1, 0x54, 0x81, 0xa5, 0xb6, 0x1b, 0x74, 0xdc, 0xe0,
+	0x6a, 0x8b, 0x06, 0x2c, 0xe6, 0xd5, 0x76, 0x2d, 0x47, 0xe6, 0xe3, 0xef, 0x94, 0xa1, 0xb2, 0xc9,
+	0x1c, 0x73, 0x35, 0x08, 0x58, 0x88, 0x6e, 0x00, 0x70, 0xa6, 0x96, 0xb3, 0x67, 0x58, 0xa6, 0xae,
+	0x2d, 0x6b, 0x57, 0x4a, 0x8d, 0x0b, 0x87, 0xbd, 0x7a, 0xa1, 0xb9, 0xfe, 0xac, 0x57, 0x9f, 0x3d,
+	0xa0, 0x1d, 0xfb, 0x06, 0x4e, 0x4a, 0x60, 0x52, 0x51, 0x89, 0xa6, 0x89, 0x7e, 0x1c, 0xca, 0x94,
+	0x83, 0xf0, 0x9a, 0x05, 0x51, 0x73, 0xe9, 0xb0, 0x57, 0x9f, 0x10, 0xc0, 0xa2, 0xfa, 0x8c, 0xac,
+	0x1e, 0x15, 0xc2, 0x64, 0x42, 0x7c, 0x36, 0x4d, 0xf4, 0x25, 0x98, 0xf0, 0x5c, 0xd7, 0xe6, 0x35,
+	0x8b, 0xa2, 0xe6, 0x5b, 0x87, 0xbd, 0xfa, 0xf8, 0x96, 0xeb, 0xda, 0xa2, 0xe2, 0xb4, 0xac, 0xa8,
+	0x8a, 0x60, 0x32, 0xce, 0xbf, 0x9a, 0x26, 0x7a, 0x07, 0xc6, 0xdc, 0xc7, 0x0e, 0xf3, 0xf5, 0xd2,
+	0xb2, 0x76, 0xa5, 0xd2, 0xa8, 0x3d, 0xeb, 0xd5, 0x27, 0x65, 0x51, 0x41, 0xc6, 0x44, 0x66, 0xa3,
+	0x5f, 0x82, 0x0a, 0xed, 0xb8, 0x5d, 0x27, 0x34, 0x2c, 0x47, 0x1f, 0x5b, 0xd6, 0xae, 0x54, 0xaf,
+	0x9f, 0x5f, 0x91, 0x72, 0x59, 0xe1, 0x72, 0x89, 0x64, 0xbc, 0xb2, 0xe6, 0x5a, 0x4e, 0x63, 0xed,
+	0x7b, 0xbd, 0xfa, 0x99, 0x67, 0xbd, 0x7a, 0x4d, 0x35, 0x37, 0xaa, 0x89, 0xff, 0xa7, 0x57, 0xff,
+	0xdc, 0x9e, 0x15, 0xee, 0x77, 0x5b, 0x2b, 0x6d, 0xb7, 0x73, 0x55, 0x09, 0x56, 0xfe, 0x7c, 0x21,
+	0x30, 0x1f, 0x5c, 0x0d, 0x0f, 0x3c, 0x16, 0x08, 0x10, 0x52, 0x96, 0xd5, 0x9a, 0x0e, 0xfa, 0x79,
+	0x98, 0x8c, 0x04, 0xc6, 0x75, 0xa3, 0x8f, 0x0b, 0xfe, 0x8b, 0x2b, 0x52, 0x71, 0x2b, 0x91, 0xe2,
+	0x56, 0x76, 0x22, 0xc5, 0x35, 0xea, 0xaa, 0x01, 0x73, 0x59, 0x71, 0xf3, 0xda, 0xf8, 0xe3, 0x7f,
+	0xad, 0x6b, 0xa4, 0xaa, 0x48, 0xbc, 0x0a, 0xfa, 0x65, 0x98, 0xa3, 0x8f, 0xa8, 0x65, 0xd3, 0x96,
+	0xcd, 0x8c, 0xd0, 0x35, 0x5a, 0xae, 0xef, 0xbb, 0x8f, 0xf5, 0x09, 0x21, 0x92, 0x4d, 0x0e, 0xf5,
+	0x83, 0x5e, 0xfd, 0x9d, 0x13, 0xb4, 0xbb, 0xe9, 0x84, 0xcf, 0x7a, 0xf5, 0x45, 0xd5, 0xeb, 0x41,
+	0x48, 0x4c, 0x66, 0x63, 0xea, 0x8e, 0xdb, 0x10, 0x34, 0x74, 0x0d, 0xc6, 0xa9, 0xe7, 0x71, 0xc5,
+	0x95, 0x85, 0xe2, 0x16, 0x0f, 0x7b, 0xf5, 0xb1, 0x55, 0xcf, 0x13, 0x7a, 0x9b, 0x52, 0x58, 0xa2,
+	0x00, 0x26, 0x63, 0xd4, 0xf3, 0x9a, 0x26, 0xda, 0x87, 0xc9, 0x3d, 0xdb, 0x6d, 0x51, 0xdb, 0xb0,
+	0x1c, 0x93, 0x3d, 0xd1, 0x2b, 0xa2, 0xa5, 0x1b, 0x39, 0x5a, 0xba, 0xce, 0xda, 0x89, 0x78, 0xd2,
+	0x58, 0x98, 0x54, 0x65, 0xb2, 0xc9, 0x53, 0xe8, 0x09, 0x2c, 0xd8, 0x34, 0xe0, 0xba, 0x0b, 0x99,
+	0x4f, 0xdb, 0xa1, 0xe5, 0x3a, 0x52, 0x07, 0x70, 0xac, 0x0e, 0xae, 0x28, 0x1d, 0xbc, 0xa5, 0x74,
+	0x30, 0x0c, 0x46, 0x2a, 0x63, 0x8e, 0xe7, 0x35, 0x93, 0x2c, 0xa1, 0x94, 0x55, 0x80, 0xb6, 0x18,
+	0xae, 0x0e, 0xed, 0x30, 0xbd, 0x2a, 0x7a, 0x88, 0x0f, 0x7b, 0xf5, 0xca, 0x1a, 0x1f, 0xd4, 0x77,
+	0x68, 0x87, 0x25, 0xd3, 0x29, 0x29, 0x88, 0x49, 0xa5, 0xed, 0xa9, 0x7c, 0xf4, 0x00, 0xa6, 0x42,
+	0x37, 0xa4, 0xb6, 0xe1, 0xb3, 0xc7, 0xd4, 0x37, 0x03, 0x7d, 0x52, 0xa0, 0xdc, 0xcc, 0xad, 0xd1,
+	0x79, 0xc9, 0x26, 0x03, 0x86, 0xc9, 0xa4, 0x48, 0x13, 0x95, 0xfc, 0xef, 0x2a, 0x54, 0xa5, 0x46,
+	0xa5, 0x1d, 0xf8, 0x49, 0x98, 0x94, 0x4a, 0xcf, 0x58, 0x82, 0x8b, 0xb1, 0x25, 0x50, 0xb2, 0x4f,
+	0x97, 0xc1, 0xa4, 0x1a, 0x27, 0x9b, 0x26, 0x97, 0x40, 0xca, 0x92, 0x48, 0x7b, 0x20, 0x24, 0xb0,
+	0xa9, 0x0c, 0xc6, 0xf1, 0x06, 0x65, 0x03, 0x6a, 0x56, 0x60, 0x04, 0xa1, 0x18, 0x86, 0x6a, 0x58,
+	0x73, 0xf3, 0x50, 0x6e, 0x5c, 0x78, 0xd6, 0xab, 0x9f, 0x93, 0x75, 0xfb, 0x4b, 0x60, 0x32, 0x6d,
+	0x05, 0xdb, 0x82, 0xa2, 0x86, 0x28, 0x37, 0x2e, 0xd4, 0xf2, 0x79, 0x33, 0x4a, 0x29, 0xe3, 0x42,
+	0x2d, 0x3f, 0x63, 0x5c, 0x64, 0x11, 0x6e, 0x5c, 0x78, 0x8e, 0xf9, 0x7a, 0x8d, 0xc6, 0x87, 0x00,
+	0x0a, 0xc2, 0xed, 0x86, 0xfa, 0xf8, 0x71, 0xdc, 0xd7, 0x15, 0xf7, 0xd9, 0x0c, 0x77, 0xb7, 0x1b,
+	0xe6, 0x62, 0xaf, 0xfa, 0x7b, 0xb7, 0x1b, 0xa2, 0xdf, 0xd3, 0x60, 0xbe, 0xe5, 0x5b, 0xe6, 0x1e,
+	0x33, 0x0d, 0x69, 0xaf, 0x65, 0x9e, 0x3e, 0x71, 0x5c, 0x53, 0xee, 0xa8, 0xa6, 0x5c, 0x50, 0x23,
+	0x64, 0x08, 0x48, 0xae, 0x46, 0x21, 0x85, 0x20, 0xc6, 0xe5, 0xaa, 0xa8, 0x8f, 0x4c, 0x98, 0x4e,
+	0x46, 0x9e, 0x98, 0xd0, 0xe5, 0x63, 0x27, 0xf4, 0x25, 0xd5, 0xae, 0x85, 0xfe, 0x91, 0x9b, 0xcc,
+	0xe4, 0xa9, 0x98, 0x28, 0xe6, 0xf0, 0x01, 0xa0, 0xcc, 0xc8, 0x32, 0x7c, 0x1a, 0x32, 0x65, 0xad,
+	0xbe, 0x96, 0xdb, 0x5a, 0x9d, 0x97, 0x7c, 0x07, 0x11, 0x31, 0xa9, 0x05, 0xa9, 0xe1, 0x4a, 0x68,
+	0xc8, 0xd0, 0xaf, 0x6a, 0x30, 0x2f, 0xac, 0x0d, 0x0b, 0x42, 0x83, 0xb6, 0xdb, 0xdd, 0x4e, 0xd7,
+	0xa6, 0x21, 0x33, 0x85, 0xe1, 0xaa, 0x34, 0x6e, 0xe7, 0xe6, 0xae, 0xb4, 0x31, 0x0c, 0x13, 0x93,
+	0xb9, 0x88, 0xbc, 0x9a, 0x50, 0x07, 0xac, 0x74, 0xf5, 0xa5, 0x59, 0xe9, 0x5f, 0x81, 0x79, 0x9f,
+	0x05, 0xcc, 0x7f, 0xc4, 0x8c, 0x0c, 0xc7, 0xc9, 0xd1, 0xfa, 0x3a, 0x0c, 0x13, 0x13, 0xa4, 0xc8,
+	0xef, 0x9f, 0x64, 0x99, 0x98, 0x7a, 0xb5, 0xcb, 0xc4, 0xf4, 0x69, 0x96, 0x89, 0xf7, 0x60, 0xca,
+	0x0a, 0x0c, 0xdb, 0x7a, 0xd8, 0xb5, 0x4c, 0x31, 0x44, 0x66, 0x84, 0x85, 0xd4, 0x13, 0xc3, 0x9f,
+	0xc9, 0xc6, 0x64, 0xd2, 0x0a, 0x36, 0x93, 0xe4, 0x77, 0x0b, 0x50, 0xe2, 0xbc, 0xd2, 0x2e, 0x98,
+	0x96, 0xc3, 0x05, 0xdb, 0x80, 0x6a, 0xc7, 0x35, 0xbb, 0x36, 0x93, 0x5d, 0x28, 0x88, 0x2e, 0xfc,
+	0xbf, 0xc3, 0x5e, 0x1d, 0x6e, 0x0b, 0xb2, 0xea, 0x03, 0x92, 0xd5, 0x53, 0x45, 0x31, 0x81, 0x4e,
+	0x5c, 0xa2, 0x4f, 0x10, 0xc5, 0xd3, 0x08, 0xc2, 0x06, 0x90, 0x46, 0xc6, 0xa4, 0x21, 0xd5, 0x4b,
+	0xcb, 0xc5, 0x2b, 0xd5, 0xeb, 0xef, 0xae, 0x0c, 0xf1, 0xa4, 0x57, 0x84, 0x29, 0x59, 0xa7, 0x21,
+	0xe5, 0xd8, 0xb7, 0xa9, 0xe7, 0x59, 0xce, 0x9e, 0xe4, 0x16, 0xe7, 0x24, 0xdc, 0x12, 0x4c, 0x4c,
+	0x2a, 0x34, 0xca, 0xc7, 0x7f, 0xaf, 0xc1, 0xe2, 0xbd, 0x80, 0xf9, 0xa2, 0x06, 0x5f, 0xd2, 0xe4,
+	0xec, 0x55, 0x68, 0x89, 0x67, 0xaa, 0x3d, 0xdf, 0x33, 0xfd, 0xff, 0x30, 0xc1, 0x9b, 0x96, 0x2c,
+	0x91, 0x28, 0x91, 0xb5, 0xca, 0xc0, 0x64, 0x9c, 0x7f, 0x35, 0x4d, 0x5e, 0x38, 0xeb, 0x25, 0xa3,
+	0xe7, 0x28, 0xe6, 0x1a, 0x54, 0x94, 0x91, 0x11, 0xeb, 0x5e, 0xf1, 0x4a, 0xa9, 0x31, 0x9f, 0xac,
+	0x4f, 0x71, 0x16, 0x26, 0x65, 0xf9, 0xdd, 0x34, 0xf1, 0xb7, 0x0b, 0x30, 0x3f, 0x4c, 0x36, 0x19,
+	0xcf, 0x5e, 0xcb, 0xe7, 0xd9, 0x7f, 0x0d, 0x90, 0xa4, 0x86, 0x3e, 0x75, 0x02, 0x2b, 0x34, 0xf8,
+	0x4c, 0x55, 0x7d, 0xbd, 0x98, 0x98, 0xc5, 0xc1, 0x32, 0x98, 0xd4, 0x04, 0x71, 0x47, 0xd2, 0x76,
+	0x0e, 0x3c, 0x86, 0x5a, 0x00, 0x41, 0xd7, 0xf3, 0xec, 0x03, 0xa3, 0x4d, 0x3d, 0x35, 0x4a, 0xd6,
+	0x72, 0xdb, 0x07, 0xa5, 0xd8, 0x04, 0x09, 0x93, 0x8a, 0x4c, 0xac, 0x51, 0x0f, 0xff, 0x7b, 0x01,
+	0xa6, 0x36, 0x9e, 0x84, 0xcc, 0x31, 0x99, 0x69, 0x70, 0x27, 0x01, 0x4d, 0x43, 0x21, 0xea, 0x37,
+	0x29, 0x58, 0x26, 0x5a, 0x89, 0xa5, 0xe1, 0xa8, 0x8e, 0xcc, 0x0d, 0x88, 0xc0, 0x89, 0x45, 0xe0,
+	0x70, 0x4d, 0x48, 0x2a, 0x5f, 0xca, 0xa5, 0xe2, 0x52, 0x9a, 0x88, 0xb3, 0x30, 0x91, 0xb0, 0x7c,
+	0xf9, 0xfd, 0xb2, 0x98, 0xd4, 0xc2, 0x90, 0x18, 0x5c, 0x9f, 0x7a, 0x69, 0xc8, 0xa4, 0x4e, 0xb2,
+	0x31, 0xa9, 0x5a, 0x81, 0xb0, 0x2d, 0x62, 0x2a, 0x7f, 0x1d, 0x66, 0x63, 0x54, 0x23, 0x1a, 0x31,
+	0x63, 0x82, 0xf1, 0xca, 0x61, 0xaf, 0x3e, 0xbd, 0xaa, 0xd8, 0xc4, 0x93, 0x5b, 0xef, 0x6b, 0x8a,
+	0x11, 0x8f, 0xa6, 0x69, 0x9a, 0x2e, 0x6b, 0xa2, 0xaf, 0x02, 0xea, 0x58, 0x8e, 0xd1, 0x0d, 0x4c,
+	0xe3, 0x11, 0xb5, 0xbb, 0xcc, 0xb0, 0xd9, 0xae, 0xf4, 0x4f, 0x32, 0xea, 0x1c, 0x2c, 0x83, 0xc9,
+	0x4c, 0xc7, 0x72, 0xee, 0x05, 0xe6, 0x4f, 0x71, 0xd2, 0x26, 0xa7, 0xfc, 0xb9, 0x06, 0x48, 0x34,
+	0x65, 0xc7, 0xe5, 0x72, 0x8e, 0x06, 0xdb, 0x29, 0x0d, 0xd1, 0x88, 0xbb, 0x4f, 0xe5, 0x20, 0x16,
+	0x97, 0x8b, 0x31, 0xc7, 0x63, 0x1c, 0x44, 0xfc, 0x9b, 0x15, 0x40, 0xbc, 0x59, 0xd2, 0x04, 0x34,
+	0x5e, 0x5f, 0xfb, 0x57, 0xa0, 0xac, 0x6c, 0x45, 0xa0, 0x3a, 0x90, 0x1a, 0x90, 0x51, 0x0e, 0x26,
+	0x13, 0xd2, 0x8c, 0x04, 0xe8, 0x8b, 0x00, 0xf1, 0xfc, 0x0f, 0x94, 0x6d, 0x58, 0x48, 0x26, 0x46,
+	0x92, 0x87, 0x49, 0x25, 0x32, 0x0e, 0x01, 0x72, 0x60, 0x5a, 0x6e, 0x21, 0x24, 0x89, 0xc9, 0x21,
+	0x55, 0x69, 0xbc, 0x9f, 0x7b, 0x43, 0xb2, 0x90, 0xde, 0x90, 0x44, 0x68, 0x98, 0xc8, 0xed, 0x4e,
+	0x43, 0xa5, 0xd1, 0xb7, 0x35, 0x58, 0x90, 0x45, 0x32, 0x3e, 0x13, 0x33, 0xc5, 0x70, 0xab, 0x34,
+	0xee, 0xe4, 0xe6, 0xfb, 0x56, 0x9a, 0x6f, 0x1f, 0x28, 0x26, 0x73, 0x82, 0x9e, 0xde, 0x39, 0x30,
+	0x93, 0x5b, 0x1c, 0x59, 0x9c, 0xcb, 0x4e, 0x9f, 0xc8, 0x6d, 0x71, 0x24, 0xe3, 0xd9, 0x34, 0x63,
+	0x8e, 0x84, 0x49, 0x45, 0x24, 0xf8, 0xc2, 0x81, 0x7e, 0x47, 0x83, 0x45, 0x99, 0x35, 0xd4, 0xe5,
+	0x2b, 0x0b, 0xa6, 0xdb, 0xb9, 0x99, 0x5e, 0x4a, 0x33, 0x1d, 0xee, 0xf8, 0xe9, 0x22, 0xb3, 0x39,
+	0xc4, 0xfb, 0xfb, 0x86, 0x1a, 0x52, 0xd4, 0xf3, 0x95, 0xc7, 0xbb, 0x9a, 0xdb, 0xce, 0xa6, 0x07,
+	0x20, 0xf5, 0x7c, 0x35, 0x00, 0x57, 0x3d, 0x9f, 0x4b, 0x55, 0x0d, 0x32, 0x8e, 0x0f, 0xa3, 0xd9,
+	0xf1, 0x04, 0x29, 0x1e, 0xae, 0x9c, 0xc7, 0x23, 0x98, 0xcd, 0xfa, 0xda, 0x9c, 0x95, 0x74, 0x62,
+	0xbf, 0x9a, 0x9b, 0x95, 0x3e, 0xcc, 0x79, 0x17, 0x1c, 0x67, 0xd2, 0xbe, 0x3b, 0xe7, 0xfb, 0x18,
+	0x66, 0xbb, 0xa1, 0x65, 0x5b, 0x01, 0x15, 0x0e, 0xa0, 0xcf, 0x7f, 0xf4, 0xc9, 0xd1, 0xf8, 0x0e,
+	0x00, 0x62, 0x52, 0x4b, 0xd1, 0x88, 0x20, 0xfd, 0xfa, 0x34, 0xd4, 0x84, 0xb5, 0xe0, 0x3b, 0x88,
+	0x60, 0x8b, 0xfa, 0xb4, 0x13, 0x8c, 0xb2, 0x72, 0x1b, 0x50, 0xe9, 0x1a, 0xae, 0x17, 0x5a, 0x1d,
+	0x6a, 0x2b, 0xbf, 0xae, 0x91, 0xbb, 0x03, 0x6a, 0x91, 0x8b, 0x81, 0x30, 0x29, 0x77, 0xef, 0xca,
+	0x4f, 0xf4, 0x01, 0x94, 0xf8, 0xf6, 0x51, 0xad, 0xe3, 0xef, 0xe5, 0xc6, 0xae, 0x2a, 0xfd, 0xd3,
+	0x80, 0x61, 0x22, 0xa0, 0xd0, 0x7d, 0x18, 0x0f, 0x6c, 0xd7, 0x63, 0xd7, 0x54, 0x44, 0xf0, 0x2b,
+	0xb9, 0x41, 0x55, 0xc8, 0x4a, 0xa2, 0x60, 0xa2, 0xe0, 0x62, 0xe0, 0xeb, 0xfa, 0xd8, 0x0b, 0x00,
+	0xbe, 0x1e, 0x01, 0x5f, 0x47, 0x1f, 0xc0, 0x3c, 0x73, 0xc4, 0xa8, 0xca, 0xc6, 0x39, 0xc6, 0xc5,
+	0x82, 0x5f, 0x4f, 0xb6, 0x33, 0xc3, 0x4a, 0x61, 0x82, 0x24, 0x39, 0x13, 0xef, 0x60, 0x50, 0x8d,
+	0x4a, 0x71, 0xf1, 0x4a, 0xa3, 0xb5, 0x9e, 0xbb, 0xc1, 0x28, 0x3b, 0xe6, 0x85, 0x94, 0x41, 0xa6,
+	0x1a, 0x5c, 0xd6, 0x0f, 0x60, 0x4a, 0xe5, 0x29, 0x91, 0x97, 0x73, 0xc7, 0xa7, 0x24, 0xa3, 0xf9,
+	0x0c, 0xa3, 0x48, 0xf2, 0x93, 0x32, 0xbd, 0x2d, 0xe5, 0xdf, 0xc7, 0xec, 0xba, 0x5e, 0x79, 0x71,
+	0xcc, 0xae, 0x67, 0x99, 0x5d, 0x47, 0x77, 0xa0, 0x68, 0x87, 0x8f, 0x94, 0x5d, 0xfa, 0x72, 0x6e,
+	0x16, 0xa0, 0xec, 0x5e, 0xf8, 0x08, 0x13, 0x0e, 0x84, 0xbe, 0xa3, 0xc1, 0x42, 0xb4, 0x03, 0x13,
+	0x9b, 0xc2, 0x7d, 0x9f, 0x05, 0xfb, 0xae, 0x6d, 0xea, 0xd5, 0xdc, 0x2b, 0x99, 0x64, 0x11, 0x6d,
+	0x37, 0x87, 0x81, 0x62, 0x32, 0x9f, 0xa2, 0xef, 0x44, 0x64, 0xf4, 0x2d, 0x98, 0x4b, 0x97, 0xf7,
+	0x98, 0x43, 0xed, 0xf0, 0x40, 0x9f, 0xcc, 0x1d, 0x27, 0x96, 0x4d, 0x58, 0x1c, 0x6c, 0x82, 0x82,
+	0xc4, 0x04, 0xa5, 0xa8, 0x5b, 0x92, 0xc8, 0xed, 0x62, 0xba, 0x6c, 0xcb, 0x75, 0xba, 0x81, 0x3e,
+	0x35, 0x9a, 0x5d, 0x1c, 0x00, 0xc4, 0xa4, 0x96, 0xa2, 0x35, 0x38, 0x89, 0xfb, 0x2d, 0x51, 0x28,
+	0x60, 0x97, 0xb6, 0x43, 0xd7, 0xd7, 0xa7, 0x73, 0xfb, 0x2d, 0x92, 0xeb, 0x42, 0x36, 0xb0, 0x20,
+	0xd1, 0x30, 0x99, 0x52, 0x84, 0x9b, 0x22, 0x8d, 0xbe, 0x02, 0xd0, 0x36, 0x62, 0xa3, 0x3b, 0x23,
+	0x8c, 0xee, 0xa5, 0xc3, 0x5e, 0xbd, 0xbc, 0x96, 0x58, 0xdd, 0x68, 0x27, 0x6b, 0x24, 0x76, 0xb7,
+	0xdc, 0x5e, 0x55, 0x86, 0xb7, 0x03, 0x67, 0xe3, 0xe5, 0xda, 0xa7, 0x21, 0x33, 0x3a, 0xae, 0xc9,
+	0x84, 0x3f, 0x59, 0x13, 0x60, 0x3f, 0x76, 0xd8, 0xab, 0xcf, 0x45, 0x8b, 0x36, 0x37, 0xf6, 0xb7,
+	0x79, 0xbe, 0xc0, 0xbd, 0xd8, 0x17, 0xe6, 0xc9, 0x54, 0x4f, 0x05, 0x7a, 0x92, 0x5a, 0x62, 0xa9,
+	0x17, 0xc5, 0xf8, 0x96, 0x6a, 0x76, 0xb4, 0xa5, 0x3e, 0xc2, 0xc1, 0x64, 0x82, 0x7f, 0xae, 0x51,
+	0x0f, 0x85, 0x50, 0xa3, 0xe6, 0x2f, 0x74, 0x83, 0xb0, 0xc3, 0x9c, 0xd0, 0x08, 0x3c, 0xc6, 0x4c,
+	0x1d, 0x09, 0x2e, 0xcd, 0xdc, 0x5c, 0x54, 0xc4, 0xb7, 0x1f, 0x0f, 0x93, 0x99, 0x84, 0xb4, 0x2d,
+	0x28, 0x7f, 0x52, 0x80, 0x73, 0x44, 0x6a, 0xa5, 0xd1, 0x3d, 0x68, 0xd1, 0xf6, 0x83, 0x78, 0x5f,
+	0x3b, 0xca, 0x92, 0x98, 0x1a, 0x4a, 0x2a, 0x1c, 0x5a, 0x18, 0xcd, 0x05, 0xce, 0xa2, 0x25, 0x43,
+	0x49, 0xc5, 0x39, 0x1d, 0x98, 0x6e, 0xc9, 0xe6, 0x47, 0xfc, 0x8a, 0xa3, 0xf1, 0xcb, 0xa2, 0x61,
+	0x32, 0xa5, 0x08, 0x92, 0x1f, 0xfe, 0xcf, 0x12, 0x4c, 0xad, 0x76, 0x45, 0x78, 0x4a, 0xf9, 0x0f,
+	0x57, 0xe2, 0xe3, 0x1d, 0x29, 0xaa, 0xd9, 0x23, 0x4f, 0x75, 0x7e, 0x0e, 0x74, 0x2a, 0xab, 0x1a,
+	0x66, 0xd7, 0x97, 0x73, 0x32, 0x60, 0x6d, 0xd7, 0x31, 0x03, 0xb5, 0x9f, 0xb9, 0xfc, 0xac, 0x57,
+	0xaf, 0xab, 0xba, 0x47, 0x94, 0xc4, 0xe4, 0xac, 0xca, 0x5a, 0x57, 0x39, 0xdb, 0x32, 0x83, 0x2f,
+	0xc0, 0xad, 0xee, 0xee, 0x2e, 0xf3, 0xf5, 0xe2, 0x68, 0x0b, 0xb0, 0x44, 0xc1, 0x44, 0xc1, 0x71,
+	0x2f, 0xa4, 0xdd, 0x0d, 0x3c, 0xbd, 0x34, 0x9a, 0x17, 0xc2, 0x31, 0x30, 0x11, 0x50, 0x1c, 0x32,
+	0x08, 0x99, 0xa7, 0x8f, 0xe5, 0x86, 0x94, 0xca, 0xaa, 0x46, 0x6b, 0x14, 0xe3, 0x90, 0xfc, 0x07,
+	0xdd, 0x81, 0x39, 0xcf, 0xb7, 0xda, 0xcc, 0xd8, 0xed, 0x3a, 0x52, 0x74, 0xbc, 0x82, 0xda, 0x78,
+	0x2f, 0x25, 0xe6, 0x78, 0x48, 0x21, 0x4c, 0x66, 0x05, 0xf5, 0xa6, 0x22, 0x8a, 0x48, 0xca, 0x0a,
+	0x94, 0xcd, 0x6e, 0xd8, 0xde, 0xe7, 0x9a, 0x9d, 0xe8, 0x8f, 0x61, 0x44, 0x39, 0x98, 0x4c, 0x88,
+	0xcf, 0xa6, 0xc9, 0xdd, 0x94, 0x96, 0x65, 0x0e, 0x6a, 0x56, 0x1e, 0xfa, 0xa5, 0xdc, 0x94, 0x61,
+	0xa5, 0x30, 0x41, 0x2d, 0xcb, 0xec, 0xd3, 0x28, 0xfe, 0x81, 0x06, 0xe7, 0x1a, 0x6a, 0xab, 0x19,
+	0xd9, 0xab, 0xd0, 0xa7, 0xed, 0x07, 0xcc, 0x47, 0x37, 0x86, 0x1e, 0x3f, 0x9d, 0x3b, 0xd1, 0xc1,
+	0x13, 0xdf, 0x37, 0x46, 0xf3, 0x4a, 0xee, 0xb2, 0x15, 0xba, 0x5e, 0x1c, 0x6d, 0xb5, 0x1d, 0x0a,
+	0x8a, 0xc9, 0x9c, 0xa2, 0x8b, 0x1d, 0x7e, 0x44, 0xfd, 0x5b, 0x0d, 0xe6, 0xf9, 0xe6, 0x2e, 0x3a,
+	0x6f, 0x8b, 0x7b, 0xf6, 0xc5, 0x21, 0x07, 0xec, 0x0b, 0xc7, 0x9e, 0x84, 0x7d, 0x08, 0x73, 0x11,
+	0x50, 0x7a, 0x6b, 0x58, 0x78, 0x19, 0xa7, 0x01, 0x48, 0x71, 0x4a, 0x6d, 0x07, 0xf1, 0x5f, 0x69,
+	0x30, 0x25, 0xe3, 0xb9, 0x0d, 0x6a, 0x53, 0xa7, 0xcd, 0x4e, 0x1b, 0xe5, 0xf8, 0x10, 0xe6, 0x55,
+	0x0c, 0xb8, 0x25, 0x81, 0xb8, 0x43, 0x1b, 0x72, 0x0b, 0xc1, 0xc3, 0xb5, 0x9f, 0x1b, 0x1a, 0xae,
+	0xcd, 0x30, 0xde, 0xe6, 0xc5, 0x1b, 0x97, 0xb3, 0x87, 0x4c, 0xc3, 0x20, 0x31, 0x41, 0x9d, 0x81,
+	0x8a, 0xf8, 0xaf, 0x35, 0x40, 0x83, 0x78, 0xa3, 0xac, 0x09, 0x8f, 0x60, 0x42, 0xf1, 0xd5, 0x0b,
+	0xc7, 0x9d, 0x8d, 0xad, 0xaa, 0x66, 0x4f, 0x47, 0x3b, 0x17, 0x51, 0x2f, 0xd7, 0x71, 0x58, 0xc4,
+	0x0c, 0x7f, 0x0b, 0xc6, 0x6f, 0xbb, 0x66, 0x83, 0xda, 0x28, 0x80, 0xb9, 0xdd, 0xae, 0x63, 0x1a,
+	0x59, 0x29, 0xe8, 0x9a, 0x10, 0x69, 0x7d, 0xa8, 0x48, 0x6f, 0x76, 0x1d, 0x53, 0xd6, 0x6e, 0x60,
+	0xd5, 0x26, 0x65, 0x40, 0x86, 0x20, 0x61, 0x32, 0xbb, 0x2b, 0xcb, 0x27, 0x62, 0xc3, 0x1f, 0x69,
+	0x00, 0xd1, 0x0a, 0x4b, 0x6d, 0xf4, 0x8b, 0x30, 0x2f, 0x6a, 0x46, 0x73, 0x24, 0xdb, 0x88, 0xcb,
+	0x47, 0x36, 0x22, 0x81, 0xe8, 0xd7, 0xe9, 0x30, 0x38, 0x4c, 0xd0, 0x6e, 0xa6, 0x92, 0x20, 0xfe,
+	0x5a, 0x11, 0x20, 0xe9, 0xd0, 0x28, 0xba, 0x4c, 0x0d, 0xea, 0x42, 0x8e, 0x41, 0x9d, 0x39, 0x29,
+	0x2e, 0xbe, 0xfa, 0xeb, 0x25, 0x26, 0xf3, 0x5c, 0x11, 0x36, 0xe7, 0x67, 0x56, 0xa5, 0xbc, 0xd7,
+	0x4b, 0xd2, 0xb5, 0xd5, 0xf5, 0x12, 0x45, 0xe2, 0x55, 0xd0, 0xbb, 0x30, 0xce, 0x65, 0xce, 0x7c,
+	0xb5, 0x9c, 0xa5, 0x3c, 0x00, 0x49, 0xc7, 0x44, 0x15, 0xc0, 0xff, 0x58, 0x80, 0xe9, 0xac, 0x52,
+	0x47, 0x51, 0x46, 0x46, 0xaa, 0x85, 0xd7, 0x2c, 0xd5, 0xe2, 0x4b, 0x93, 0x6a, 0xe9, 0x38, 0xa9,
+	0x7e, 0x7f, 0x1c, 0x66, 0x56, 0x6d, 0x5b, 0x09, 0x75, 0x64, 0x7b, 0xf5, 0x87, 0x1a, 0xa4, 0xee,
+	0x07, 0x18, 0xbb, 0xbe, 0xdb, 0x89, 0xa7, 0x59, 0xe8, 0x8a, 0xe8, 0x24, 0xf3, 0x03, 0xb5, 0xb4,
+	0xfc, 0x6c, 0x6e, 0xdf, 0xe5, 0xdd, 0xfe, 0x1b, 0x08, 0x47, 0x71, 0xc0, 0xe4, 0x62, 0x7c, 0xdd,
+	0xe0, 0xa6, 0xef, 0x76, 0x54, 0xff, 0x76, 0xdc, 0x4d, 0x99, 0x8f, 0xfe, 0x48, 0x83, 0xcb, 0x47,
+	0xc1, 0xec, 0xba, 0xbe, 0xa1, 0x1c, 0x45, 0xb5, 0xaa, 0x7f, 0x23, 0x77, 0x4b, 0x3f, 0xff, 0xfc,
+	0x96, 0xa6, 0x58, 0x60, 0xb2, 0x34, 0xac, 0xa9, 0x37, 0x5d, 0x5f, 0x39, 0xcb, 0xe8, 0xb7, 0x35,
+	0x58, 0x8c, 0x87, 0x9c, 0xc4, 0xb1, 0xad, 0x87, 0xf1, 0x1e, 0xbb, 0x34, 0x5a, 0x08, 0xf7, 0x68,
+	0x64, 0xee, 0x2f, 0xab, 0x21, 0xcb, 0x1b, 0xb6, 0x69, 0x3d, 0x8c, 0xb6, 0xdb, 0xbf, 0xa5, 0xc1,
+	0xf9, 0xbe, 0x7a, 0x3e, 0xf3, 0xe8, 0x01, 0xdf, 0x23, 0x05, 0x6a, 0x2a, 0x93, 0xdc, 0x0d, 0x5a,
+	0x1e, 0xda, 0xa0, 0x04, 0xb8, 0xaf, 0x3d, 0x24, 0xce, 0x40, 0xbf, 0xab, 0xc1, 0x05, 0x19, 0x8a,
+	0x4e, 0x09, 0x3c, 0x35, 0xde, 0x64, 0x4c, 0x7f, 0x27, 0x77, 0x8b, 0x70, 0x3a, 0xca, 0x3d, 0x14,
+	0x1a, 0x93, 0x73, 0x22, 0x77, 0x35, 0x52, 0x61, 0x3c, 0xc4, 0xf0, 0x5f, 0x6a, 0xa0, 0xa7, 0x4e,
+	0xa0, 0xb6, 0x2d, 0x67, 0xcf, 0x66, 0x3f, 0x24, 0xe7, 0x50, 0x27, 0xbe, 0xa8, 0xc4, 0xd7, 0xbf,
+	0x0a, 0x6f, 0x16, 0x2f, 0x18, 0xbc, 0x39, 0xc7, 0xcf, 0x75, 0x8e, 0x7f, 0xc4, 0x81, 0xe6, 0xd8,
+	0xa9, 0x0e, 0x34, 0xff, 0x4c, 0x83, 0x5a, 0x7a, 0x17, 0x30, 0x6a, 0xb8, 0xe1, 0x01, 0x4c, 0xc9,
+	0xd3, 0xbb, 0x68, 0x03, 0x53, 0x18, 0xed, 0x06, 0x60, 0x06, 0x0c, 0x13, 0x71, 0x2d, 0x35, 0xde,
+	0xb1, 0xfc, 0x83, 0x06, 0x93, 0xe9, 0xc6, 0x9f, 0x76, 0x20, 0x3d, 0xd5, 0x00, 0x65, 0x76, 0x48,
+	0x52, 0x8f, 0xd2, 0xc1, 0x7f, 0x7b, 0xa8, 0x1e, 0xfb, 0x65, 0xd6, 0xf8, 0x12, 0xef, 0xe1, 0x61,
+	0xaf, 0x3e, 0x20, 0xcd, 0x44, 0x21, 0x83, 0x2c, 0x30, 0xa9, 0x79, 0x7d, 0xc5, 0xf1, 0x5f, 0x68,
+	0x30, 0x3b, 0x80, 0x3e, 0x8a, 0x4a, 0x1e, 0xc2, 0x4c, 0x2b, 0xbb, 0x67, 0x55, 0x4a, 0xb9, 0x95,
+	0x5b, 0x29, 0x67, 0xb3, 0xa7, 0xad, 0xb1, 0x5a, 0xd4, 0xd5, 0xb6, 0x58, 0x31, 0xff, 0xa4, 0xc1,
+	0x54, 0xba, 0x0f, 0x8d, 0xd3, 0x6a, 0xe6, 0x37, 0x9e, 0xa7, 0x99, 0x77, 0x4e, 0xa6, 0x99, 0x17,
+	0xa7, 0x9a, 0x4f, 0x6b, 0x30, 0x97, 0x3a, 0xae, 0x8a, 0xed, 0xd7, 0x9b, 0x13, 0xab, 0x37, 0x27,
+	0x56, 0x6f, 0x4e, 0xac, 0xde, 0x9c, 0x58, 0xbd, 0x39, 0xb1, 0xfa, 0xbf, 0x73, 0x62, 0xd5, 0xe7,
+	0x3c, 0xd6, 0x5e, 0x88, 0xf3, 0x38, 0x3b, 0xba, 0xf3, 0x88, 0x5e, 0x8b, 0xf3, 0x38, 0x77, 0x1a,
+	0xe7, 0xf1, 0x39, 0xa7, 0x7e, 0xf3, 0x2f, 0xfb, 0xd4, 0x6f, 0xe1, 0x95, 0x9c, 0xfa, 0x9d, 0x7d,
+	0xe9, 0xa7, 0x7e, 0x7f, 0xa7, 0xc1, 0xfc, 0x96, 0x1b, 0x58, 0x7c, 0x26, 0xdd, 0xa6, 0x0e, 0xdd,
+	0x63, 0xfe, 0xfb, 0x3e, 0x75, 0xc2, 0x13, 0xdf, 0xc6, 0xfd, 0x11, 0x98, 0xe8, 0xc8, 0x7a, 0xca,
+	0x7d, 0x48, 0x5d, 0xb0, 0x55, 0x19, 0x98, 0x44, 0x45, 0x10, 0x85, 0xaa, 0xc7, 0xfc, 0x8e, 0x15,
+	0x04, 0x96, 0xeb, 0xc8, 0x9b, 0x77, 0xd3, 0x47, 0x84, 0xb0, 0xa3, 0x56, 0x6d, 0xc5, 0xe5, 0x1b,
+	0x67, 0x93, 0x09, 0x91, 0x42, 0xc1, 0x24, 0x8d, 0x89, 0xff, 0x8b, 0x5f, 0x46, 0xe5, 0x2a, 0x5b,
+	0xa3, 0x21, 0xdb, 0x73, 0xfd, 0x03, 0x74, 0x39, 0xb9, 0x8c, 0xda, 0x98, 0x8b, 0x9f, 0xe3, 0x54,
+	0xd4, 0x08, 0x30, 0xb1, 0xb8, 0xa1, 0x7a, 0x19, 0x4a, 0xa9, 0x5d, 0xdc, 0x4c, 0xe2, 0x79, 0xc8,
+	0xf9, 0x22, 0x32, 0xd1, 0x7b, 0xd1, 0xb5, 0xd4, 0xe4, 0xda, 0xe0, 0x32, 0x9f, 0xf4, 0x6a, 0xce,
+	0x07, 0xfd, 0x57, 0x54, 0xc5, 0x7d, 0xc0, 0xb2, 0xf2, 0xb5, 0x82, 0x68, 0xc9, 0x29, 0xbd, 0xfc,
+	0x25, 0x67, 0xec, 0xd5, 0x2d, 0x39, 0xf8, 0x6f, 0x0a, 0x30, 0xbb, 0x6a, 0x52, 0x2f, 0xb4, 0x1e,
+	0x31, 0x3e, 0x5d, 0x78, 0xb8, 0x8d, 0xbd, 0x86, 0x50, 0x40, 0x1b, 0xa0, 0xd3, 0xb5, 0x43, 0xcb,
+	0xb3, 0xad, 0xf8, 0xc8, 0xf3, 0xd4, 0x37, 0xe4, 0x12, 0x24, 0x6e, 0x6f, 0xe3, 0x84, 0x78, 0x99,
+	0x48, 0x83, 0xd0, 0xe8, 0x7a, 0xf2, 0xe5, 0x40, 0xee, 0xd0, 0x71, 0xba, 0x76, 0xf4, 0x32, 0x91,
+	0x06, 0xe1, 0x3d, 0x45, 0xb9, 0x01, 0xb5, 0x06, 0x35, 0xd7, 0x59, 0x2b, 0xbc, 0x4f, 0x43, 0xe6,
+	0xef, 0x52, 0xdb, 0xe6, 0x53, 0x31, 0x08, 0x99, 0x17, 0x88, 0x93, 0x81, 0x52, 0x7a, 0x2a, 0x0a,
+	0x32, 0x26, 0x32, 0x1b, 0xff, 0xb3, 0x06, 0x68, 0x6d, 0xc7, 0x7d, 0xc0, 0x9c, 0x8d, 0x27, 0xed,
+	0x7d, 0xea, 0xec, 0x31, 0xf2, 0x7a, 0x34, 0xf1, 0x01, 0x94, 0xc4, 0xbb, 0x9f, 0x11, 0x7d, 0x7e,
+	0xf9, 0xd2, 0x47, 0x40, 0x71, 0x3b, 0x35, 0xbb, 0xda, 0x6e, 0xf3, 0x70, 0xd4, 0x9a, 0x6b, 0xdb,
+	0x5c, 0x36, 0x54, 0x3c, 0xc0, 0x88, 0x9e, 0x02, 0xa4, 0xba, 0xc6, 0x43, 0x55, 0xe9, 0xae, 0x0d,
+	0x3c, 0x0a, 0x18, 0xa1, 0x6b, 0xb7, 0x60, 0x3c, 0x75, 0xad, 0xe0, 0xb9, 0xe1, 0xf5, 0x05, 0xa5,
+	0xf8, 0xa9, 0x74, 0x00, 0x10, 0x13, 0x55, 0x1f, 0x7f, 0x5c, 0x82, 0xaa, 0xea, 0x11, 0x57, 0xf7,
+	0x28, 0x9b, 0xb8, 0xed, 0xcc, 0xcb, 0xb7, 0x63, 0xe3, 0xfe, 0xe7, 0x8f, 0x7c, 0xf9, 0x96, 0x7e,
+	0xce, 0x76, 0xe4, 0x7b, 0xaa, 0xe2, 0x2b, 0x7b, 0x4f, 0x15, 0x42, 0x2d, 0x72, 0xc3, 0xa2, 0x6c,
+	0xbd, 0x34, 0xda, 0x92, 0xd8, 0x8f, 0x87, 0xc9, 0x8c, 0x22, 0xc5, 0x41, 0x9c, 0x23, 0x9f, 0x36,
+	0x8d, 0xbd, 0xe4, 0xa7, 0x4d, 0xf8, 0x5f, 0x0a, 0x70, 0x76, 0xcd, 0x77, 0x83, 0x20, 0x19, 0xe2,
+	0x6a, 0x84, 0x9c, 0x78, 0x39, 0x3e, 0xe5, 0x71, 0x5c, 0xf2, 0x24, 0xb9, 0x78, 0xd2, 0x27, 0xc9,
+	0x14, 0xa0, 0x1d, 0x37, 0x53, 0x2f, 0x3d, 0x27, 0x22, 0x32, 0x30, 0x6f, 0xfb, 0x47, 0x60, 0x82,
+	0x83, 0x49, 0x0a, 0x14, 0x6d, 0xc2, 0x98, 0xc9, 0x5a, 0x22, 0xf6, 0xce, 0xd1, 0x97, 0x9f, 0x87,
+	0xce, 0xe7, 0x50, 0x63, 0x5e, 0xe1, 0x4e, 0x46, 0x07, 0x4a, 0x2d, 0x1e, 0x5f, 0x97, 0x20, 0xf8,
+	0x4f, 0xf9, 0x4d, 0x1d, 0x59, 0xf8, 0x16, 0xa3, 0x76, 0xb8, 0xcf, 0xc7, 0x57, 0xc2, 0x4d, 0xba,
+	0x9a, 0xba, 0x36, 0xda, 0xf8, 0xea, 0xc7, 0xc3, 0x64, 0x26, 0x21, 0x09, 0xcf, 0x95, 0xbf, 0x12,
+	0x54, 0x01, 0x2c, 0xdb, 0xea, 0x58, 0x51, 0x30, 0xec, 0xd4, 0xaf, 0x04, 0xd3, 0x58, 0xf1, 0xb5,
+	0x8e, 0x4d, 0x9e, 0xea, 0xdf, 0x8d, 0x49, 0x76, 0xc5, 0x17, 0xb7, 0x1b, 0x53, 0x3c, 0xd3, 0xbb,
+	0x31, 0xc9, 0xb8, 0x05, 0xc0, 0x65, 0xae, 0x44, 0x5a, 0x1a, 0x6d, 0x29, 0x4e, 0x90, 0x30, 0xa9,
+	0xf0, 0x84, 0x14, 0xe3, 0x03, 0x98, 0xda, 0x17, 0x6a, 0x8c, 0x36, 0x7c, 0x63, 0xa3, 0x05, 0x0b,
+	0x32, 0x60, 0x98, 0x4c, 0xca, 0xb4, 0xdc, 0xee, 0xe1, 0xef, 0x4e, 0xc0, 0xcc, 0x4d, 0xeb, 0x09,
+	0x33, 0x77, 0x98, 0xdf, 0xb9, 0x4d, 0xfd, 0x07, 0x2c, 0x3c, 0x99, 0x5b, 0x99, 0x4c, 0xac, 0xc2,
+	0x49, 0x27, 0xd6, 0x29, 0xff, 0xd8, 0x21, 0xbd, 0x7e, 0x94, 0xf2, 0xae, 0x1f, 0xe5, 0x0e, 0x0d,
+	0xbb, 0xbe, 0x15, 0x1e, 0x9c, 0xc0, 0xc8, 0x5d, 0x50, 0x93, 0x6c, 0x26, 0x72, 0xf2, 0x65, 0x4d,
+	0x69, 0xd7, 0x62, 0xa0, 0xd8, 0x09, 0x18, 0x7f, 0x61, 0x4e, 0x00, 0xfa, 0x48, 0x83, 0x73, 0x8c,
+	0xfa, 0xf6, 0x41, 0x72, 0x7c, 0x16, 0x47, 0x38, 0x64, 0x00, 0x6c, 0x2b, 0x37, 0x9b, 0x25, 0xc9,
+	0xe6, 0x08, 0x58, 0x4c, 0x16, 0x44, 0x4e, 0x7c, 0x2a, 0x17, 0x05, 0x3a, 0x1e, 0xc2, 0x8c, 0x3c,
+	0x3f, 0x6b, 0xbb, 0x9d, 0x8e, 0x15, 0x26, 0x8f, 0x4e, 0x4e, 0x1d, 0xd4, 0xee, 0x83, 0xc3, 0x44,
+	0x3e, 0x1d, 0x5a, 0x8b, 0x08, 0xe8, 0x9b, 0x50, 0x89, 0xff, 0x4b, 0x42, 0xaf, 0xe4, 0x0e, 0xd5,
+	0x4a, 0x66, 0xb5, 0xbe, 0xbf, 0xaa, 0xe0, 0x4b, 0x7e, 0xf4, 0x3d, 0xe4, 0xb9, 0x12, 0xbc, 0xd4,
+	0xe7, 0x4a, 0xdf, 0x84, 0x4a, 0xb0, 0xef, 0xfa, 0x21, 0xf7, 0x72, 0xf5, 0xea, 0x68, 0x3d, 0x8a,
+	0x81, 0xf8, 0xcb, 0xc4, 0xf8, 0xfb, 0x3f, 0x34, 0xa8, 0xc5, 0xf3, 0x76, 0x5d, 0x5e, 0x26, 0x38,
+	0xd9, 0xc4, 0x7d, 0x0f, 0x2a, 0x1d, 0x31, 0xcf, 0x93, 0xb9, 0x2b, 0xb6, 0x7a, 0x72, 0xf2, 0x37,
+	0xd7, 0x13, 0xce, 0x71, 0x31, 0x4c, 0xca, 0xf2, 0x3b, 0xfd, 0x37, 0x2b, 0xc5, 0xe7, 0xaf, 0xd7,
+	0x89, 0x3f, 0x59, 0x1a, 0xd1, 0x9f, 0xfc, 0xfd, 0x71, 0x98, 0x8a, 0xbb, 0xba, 0xe9, 0x52, 0xe7,
+	0x87, 0xaa, 0x9f, 0x29, 0x4f, 0xbd, 0x94, 0xc3, 0x53, 0x4f, 0x1d, 0xef, 0x8e, 0xe5, 0xf8, 0x1f,
+	0x8a, 0xad, 0xf4, 0x3d, 0x98, 0x63, 0xff, 0x09, 0x42, 0x3f, 0xea, 0x1e, 0x4c, 0xea, 0x72, 0x4b,
+	0xd6, 0xc5, 0x9e, 0x78, 0x31, 0x2e, 0x76, 0x64, 0x22, 0xcb, 0x2f, 0xce, 0x44, 0xfe, 0x34, 0x40,
+	0x10, 0x52, 0x5f, 0x5d, 0xc1, 0xa9, 0x1c, 0x6b, 0xcc, 0x2f, 0x66, 0x1b, 0x9a, 0xd4, 0x95, 0xe6,
+	0xbc, 0x22, 0x08, 0xbc, 0x78, 0x66, 0x91, 0x80, 0x17, 0xb5, 0x48, 0xec, 0xc3, 0x64, 0x72, 0xea,
+	0xd5, 0x65, 0xa3, 0xfe, 0x63, 0x42, 0x1a, 0x8b, 0x3f, 0xf0, 0x55, 0xc9, 0xf5, 0x2e, 0xfb, 0xfc,
+	0x1f, 0x17, 0x00, 0x0d, 0x86, 0x94, 0xd0, 0x4d, 0xa8, 0x6f, 0xdd, 0xdd, 0x6e, 0xee, 0x34, 0xef,
+	0xde, 0x31, 0xb6, 0x36, 0xc8, 0xed, 0xe6, 0xf6, 0x36, 0xff, 0xbc, 0x77, 0x67, 0x7b, 0x6b, 0x63,
+	0xad, 0x79, 0xb3, 0xb9, 0xb1, 0x5e, 0x3b, 0xb3, 0x78, 0xe9, 0xe9, 0x27, 0xcb, 0x17, 0x07, 0x2b,
+	0xdf, 0x73, 0x02, 0x8f, 0xb5, 0xad, 0x5d, 0x8b, 0x99, 0xe8, 0x27, 0xe0, 0xc2, 0x30, 0x9c, 0xf5,
+	0x0d, 0x41, 0xad, 0x69, 0x8b, 0x17, 0x9f, 0x7e, 0xb2, 0x7c, 0x7e, 0x10, 0x23, 0xb2, 0x49, 0x37,
+	0xe0, 0xfc, 0xb0, 0xfa, 0x64, 0x63, 0x6b, 0xf5, 0xeb, 0xb5, 0xc2, 0xe2, 0x85, 0xa7, 0x9f, 0x2c,
+	0x9f, 0x1b, 0xac, 0x2d, 0x96, 0x25, 0xb4, 0x05, 0x6f, 0x0f, 0xab, 0x7b, 0xbf, 0xb9, 0x73, 0x6b,
+	0x9d, 0xac, 0xde, 0x37, 0x76, 0xee, 0x1a, 0x77, 0xef, 0xdf, 0xd9, 0x20, 0xb5, 0xe2, 0xe2, 0xdb,
+	0x4f, 0x3f, 0x59, 0xbe, 0x34, 0x88, 0x73, 0xdf, 0x0a, 0xf7, 0x4d, 0x9f, 0x3e, 0xde, 0x71, 0xef,
+	0xf2, 0xd9, 0xba, 0x58, 0xfa, 0xe8, 0x0f, 0x96, 0xce, 0x34, 0x6e, 0x7d, 0xef, 0x70, 0x49, 0xfb,
+	0xf4, 0x70, 0x49, 0xfb, 0xb7, 0xc3, 0x25, 0xed, 0xe3, 0xcf, 0x96, 0xce, 0x7c, 0xfa, 0xd9, 0xd2,
+	0x99, 0xef, 0x7f, 0xb6, 0x74, 0xe6, 0x67, 0x56, 0x32, 0x8a, 0xe1, 0x3e, 0xf9, 0x17, 0xdc, 0xdd,
+	0x5d, 0xab, 0x6d, 0x51, 0x5b, 0xa5, 0xaf, 0xaa, 0x7f, 0xea, 0x12, 0x4a, 0x6a, 0x8d, 0x8b, 0x11,
+	0xf2, 0xa3, 0xff, 0x3b, 0x00, 0x05, 0x31, 0x2b, 0xf7, 0xc5, 0x4b, 0x00, 0x00,
+}
+
+func (m *LendAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LendAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LendAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.BorrowApr.Size()
+		size := m.TotalRewards.Size()
 		i -= size
-		if _, err := m.BorrowApr.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TotalRewards.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
+	dAtA[i] = 0x62
+	if len(m.CPoolName) > 0 {
+		i -= len(m.CPoolName)
+		copy(dAtA[i:], m.CPoolName)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.CPoolName)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastInteractionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastInteractionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLend(dAtA, i, uint64(n1))
+	i--
 	dAtA[i] = 0x52
 	{
-		size := m.LendApr.Size()
+		size := m.GlobalIndex.Size()
 		i -= size
-		if _, err := m.LendApr.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GlobalIndex.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x4a
-	{
-		size := m.TotalInterestAccumulated.Size()
-		i -= size
-		if _, err := m.TotalInterestAccumulated.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+	if m.AppID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AppID))
+		i--
+		dAtA[i] = 0x40
 	}
-	i--
-	dAtA[i] = 0x42
 	{
-		size := m.TotalLend.Size()
+		size := m.AvailableToBorrow.Size()
 		i -= size
-		if _, err := m.TotalLend.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.AvailableToBorrow.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x3a
-	{
-		size := m.TotalStableBorrowed.Size()
-		i -= size
-		if _, err := m.TotalStableBorrowed.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LendingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LendingTime):])
+	if err2 != nil {
+		return 0, err2
 	}
+	i -= n2
+	i = encodeVarintLend(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x32
 	{
-		size := m.TotalBorrowed.Size()
-		i -= size
-		if _, err := m.TotalBorrowed.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x2a
-	if len(m.BorrowIds) > 0 {
-		dAtA14 := make([]byte, len(m.BorrowIds)*10)
-		var j13 int
-		for _, num := range m.BorrowIds {
-			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j13++
-			}
-			dAtA14[j13] = uint8(num)
-			j13++
-		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintLend(dAtA, i, uint64(j13))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.LendIds) > 0 {
-		dAtA16 := make([]byte, len(m.LendIds)*10)
-		var j15 int
-		for _, num := range m.LendIds {
-			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j15++
-			}
-			dAtA16[j15] = uint8(num)
-			j15++
-		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintLend(dAtA, i, uint64(j15))
+	if m.PoolID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
 	if m.AssetID != 0 {
 		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.PoolID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+	if m.ID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.ID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AssetRatesParams) Marshal() (dAtA []byte, err error) {
+func (m *BorrowAsset) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2697,45 +3098,45 @@ func (m *AssetRatesParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AssetRatesParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *BorrowAsset) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetRatesParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BorrowAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CAssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.CAssetID))
+	if m.IsLiquidated {
+		i--
+		if m.IsLiquidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x78
 	}
-	{
-		size := m.ReserveFactor.Size()
-		i -= size
-		if _, err := m.ReserveFactor.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+	if len(m.CPoolName) > 0 {
+		i -= len(m.CPoolName)
+		copy(dAtA[i:], m.CPoolName)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.CPoolName)))
+		i--
+		dAtA[i] = 0x72
 	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.LiquidationBonus.Size()
-		i -= size
-		if _, err := m.LiquidationBonus.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastInteractionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastInteractionTime):])
+	if err4 != nil {
+		return 0, err4
 	}
+	i -= n4
+	i = encodeVarintLend(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x6a
 	{
-		size := m.LiquidationPenalty.Size()
+		size := m.ReserveGlobalIndex.Size()
 		i -= size
-		if _, err := m.LiquidationPenalty.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ReserveGlobalIndex.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
@@ -2743,9 +3144,9 @@ func (m *AssetRatesParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x62
 	{
-		size := m.LiquidationThreshold.Size()
+		size := m.GlobalIndex.Size()
 		i -= size
-		if _, err := m.LiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GlobalIndex.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
@@ -2753,9 +3154,9 @@ func (m *AssetRatesParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x5a
 	{
-		size := m.Ltv.Size()
+		size := m.InterestAccumulated.Size()
 		i -= size
-		if _, err := m.Ltv.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.InterestAccumulated.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
@@ -2763,94 +3164,82 @@ func (m *AssetRatesParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x52
 	{
-		size := m.StableSlope2.Size()
+		size := m.StableBorrowRate.Size()
 		i -= size
-		if _, err := m.StableSlope2.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.StableBorrowRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x4a
-	{
-		size := m.StableSlope1.Size()
-		i -= size
-		if _, err := m.StableSlope1.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BorrowingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BorrowingTime):])
+	if err5 != nil {
+		return 0, err5
 	}
+	i -= n5
+	i = encodeVarintLend(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x42
 	{
-		size := m.StableBase.Size()
-		i -= size
-		if _, err := m.StableBase.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.BridgedAssetAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x3a
-	if m.EnableStableBorrow {
-		i--
-		if m.EnableStableBorrow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
 	{
-		size := m.Slope2.Size()
-		i -= size
-		if _, err := m.Slope2.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Slope1.Size()
 		i -= size
-		if _, err := m.Slope1.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x32
 	{
-		size := m.Base.Size()
-		i -= size
-		if _, err := m.Base.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.UOptimal.Size()
-		i -= size
-		if _, err := m.UOptimal.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	dAtA[i] = 0x2a
+	if m.PairID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PairID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsStableBorrow {
+		i--
+		if m.IsStableBorrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.AssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+	if m.LendingID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.LendingID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.ID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReserveBuybackAssetData) Marshal() (dAtA []byte, err error) {
+func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2860,45 +3249,53 @@ func (m *ReserveBuybackAssetData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReserveBuybackAssetData) MarshalTo(dAtA []byte) (int, error) {
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReserveBuybackAssetData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.BuybackAmount.Size()
-		i -= size
-		if _, err := m.BuybackAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.AssetData) > 0 {
+		for iNdEx := len(m.AssetData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ReserveAmount.Size()
-		i -= size
-		if _, err := m.ReserveAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+	if len(m.CPoolName) > 0 {
+		i -= len(m.CPoolName)
+		copy(dAtA[i:], m.CPoolName)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.CPoolName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.AssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AuctionParams) Marshal() (dAtA []byte, err error) {
+func (m *UserAssetLendBorrowMapping) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2908,75 +3305,55 @@ func (m *AuctionParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AuctionParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserAssetLendBorrowMapping) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AuctionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserAssetLendBorrowMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BidDurationSeconds != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.BidDurationSeconds))
+	if len(m.BorrowId) > 0 {
+		dAtA10 := make([]byte, len(m.BorrowId)*10)
+		var j9 int
+		for _, num := range m.BorrowId {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintLend(dAtA, i, uint64(j9))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x22
 	}
-	if m.DutchId != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.DutchId))
+	if m.PoolId != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x18
 	}
-	if m.PriceFunctionType != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.PriceFunctionType))
+	if m.LendId != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.LendId))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x10
 	}
-	{
-		size := m.Step.Size()
-		i -= size
-		if _, err := m.Step.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Cusp.Size()
-		i -= size
-		if _, err := m.Cusp.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Buffer.Size()
-		i -= size
-		if _, err := m.Buffer.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.AuctionDurationSeconds != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AuctionDurationSeconds))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.AppId != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AppId))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BorrowInterestTracker) Marshal() (dAtA []byte, err error) {
+func (m *AssetDataPoolMapping) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2986,35 +3363,40 @@ func (m *BorrowInterestTracker) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BorrowInterestTracker) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetDataPoolMapping) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BorrowInterestTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetDataPoolMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.ReservePoolInterest.Size()
+		size := m.SupplyCap.Size()
 		i -= size
-		if _, err := m.ReservePoolInterest.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	if m.BorrowingId != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.BorrowingId))
+	if m.AssetTransitType != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetTransitType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LendRewardsTracker) Marshal() (dAtA []byte, err error) {
+func (m *Extended_Pair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3024,35 +3406,55 @@ func (m *LendRewardsTracker) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LendRewardsTracker) MarshalTo(dAtA []byte) (int, error) {
+func (m *Extended_Pair) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LendRewardsTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Extended_Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.RewardsAccumulated.Size()
-		i -= size
-		if _, err := m.RewardsAccumulated.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.MinUsdValueLeft != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.MinUsdValueLeft))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AssetOutPoolID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetOutPoolID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsInterPool {
+		i--
+		if m.IsInterPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x20
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.LendingId != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.LendingId))
+	if m.AssetOut != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetOut))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssetIn != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetIn))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ModuleBalance) Marshal() (dAtA []byte, err error) {
+func (m *AssetToPairMapping) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3062,29 +3464,38 @@ func (m *ModuleBalance) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ModuleBalance) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetToPairMapping) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModuleBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetToPairMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ModuleBalanceStats) > 0 {
-		for iNdEx := len(m.ModuleBalanceStats) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ModuleBalanceStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLend(dAtA, i, uint64(size))
+	if len(m.PairID) > 0 {
+		dAtA12 := make([]byte, len(m.PairID)*10)
+		var j11 int
+		for _, num := range m.PairID {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
 			}
-			i--
-			dAtA[i] = 0x12
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintLend(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.PoolID != 0 {
 		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
@@ -3094,7 +3505,7 @@ func (m *ModuleBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ModuleBalanceStats) Marshal() (dAtA []byte, err error) {
+func (m *PoolAssetLBMapping) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3104,167 +3515,146 @@ func (m *ModuleBalanceStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ModuleBalanceStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolAssetLBMapping) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModuleBalanceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolAssetLBMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.UtilisationRatio.Size()
+		i -= size
+		if _, err := m.UtilisationRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.StableBorrowApr.Size()
 		i -= size
+		if _, err := m.StableBorrowApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.AssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
-		i--
-		dAtA[i] = 0x8
+	dAtA[i] = 0x5a
+	{
+		size := m.BorrowApr.Size()
+		i -= size
+		if _, err := m.BorrowApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ModBal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.LendApr.Size()
+		i -= size
+		if _, err := m.LendApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ModBal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ModBal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FundModuleBalance) > 0 {
-		for iNdEx := len(m.FundModuleBalance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FundModuleBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLend(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TotalInterestAccumulated.Size()
+		i -= size
+		if _, err := m.TotalInterestAccumulated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ReserveBal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TotalLend.Size()
+		i -= size
+		if _, err := m.TotalLend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReserveBal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReserveBal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FundReserveBalance) > 0 {
-		for iNdEx := len(m.FundReserveBalance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FundReserveBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLend(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TotalStableBorrowed.Size()
+		i -= size
+		if _, err := m.TotalStableBorrowed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FundModBal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *FundModBal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FundModBal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Funder) > 0 {
-		i -= len(m.Funder)
-		copy(dAtA[i:], m.Funder)
-		i = encodeVarintLend(dAtA, i, uint64(len(m.Funder)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositTime):])
-	if err18 != nil {
-		return 0, err18
-	}
-	i -= n18
-	i = encodeVarintLend(dAtA, i, uint64(n18))
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x32
 	{
-		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TotalBorrowed.Size()
+		i -= size
+		if _, err := m.TotalBorrowed.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.PoolID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+	dAtA[i] = 0x2a
+	if len(m.BorrowIds) > 0 {
+		dAtA14 := make([]byte, len(m.BorrowIds)*10)
+		var j13 int
+		for _, num := range m.BorrowIds {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintLend(dAtA, i, uint64(j13))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x22
+	}
+	if len(m.LendIds) > 0 {
+		dAtA16 := make([]byte, len(m.LendIds)*10)
+		var j15 int
+		for _, num := range m.LendIds {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintLend(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.AssetID != 0 {
 		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FundReserveBal) Marshal() (dAtA []byte, err error) {
+func (m *AssetRatesParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3274,166 +3664,191 @@ func (m *FundReserveBal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FundReserveBal) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetRatesParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FundReserveBal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetRatesParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Funder) > 0 {
-		i -= len(m.Funder)
-		copy(dAtA[i:], m.Funder)
-		i = encodeVarintLend(dAtA, i, uint64(len(m.Funder)))
-		i--
-		dAtA[i] = 0x22
-	}
-	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositTime):])
-	if err20 != nil {
-		return 0, err20
+	{
+		size := m.AdjustmentSpeed.Size()
+		i -= size
+		if _, err := m.AdjustmentSpeed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	i -= n20
-	i = encodeVarintLend(dAtA, i, uint64(n20))
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
 	{
-		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.RateCap.Size()
+		i -= size
+		if _, err := m.RateCap.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.AssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.InterestRateModelID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.InterestRateModelID))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AllReserveStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.CAssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.CAssetID))
+		i--
+		dAtA[i] = 0x78
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AllReserveStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AllReserveStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size := m.TotalAmountOutToLenders.Size()
+		size := m.ReserveFactor.Size()
 		i -= size
-		if _, err := m.TotalAmountOutToLenders.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ReserveFactor.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
+	dAtA[i] = 0x72
 	{
-		size := m.AmountInFromRepayments.Size()
+		size := m.LiquidationBonus.Size()
 		i -= size
-		if _, err := m.AmountInFromRepayments.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LiquidationBonus.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x6a
 	{
-		size := m.AmountInFromLiqPenalty.Size()
+		size := m.LiquidationPenalty.Size()
 		i -= size
-		if _, err := m.AmountInFromLiqPenalty.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LiquidationPenalty.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x62
 	{
-		size := m.AmountOutFromReserveForAuction.Size()
+		size := m.LiquidationThreshold.Size()
 		i -= size
-		if _, err := m.AmountOutFromReserveForAuction.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x5a
 	{
-		size := m.AmountOutFromReserveToLenders.Size()
+		size := m.Ltv.Size()
 		i -= size
-		if _, err := m.AmountOutFromReserveToLenders.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Ltv.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.AssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AssetToPairSingleMapping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *AssetToPairSingleMapping) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AssetToPairSingleMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PairID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.PairID))
-		i--
-		dAtA[i] = 0x18
+	dAtA[i] = 0x52
+	{
+		size := m.StableSlope2.Size()
+		i -= size
+		if _, err := m.StableSlope2.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	if m.AssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
-		i--
-		dAtA[i] = 0x10
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.StableSlope1.Size()
+		i -= size
+		if _, err := m.StableSlope1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	if m.PoolID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.StableBase.Size()
+		i -= size
+		if _, err := m.StableBase.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.EnableStableBorrow {
+		i--
+		if m.EnableStableBorrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Slope2.Size()
+		i -= size
+		if _, err := m.Slope2.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Slope1.Size()
+		i -= size
+		if _, err := m.Slope1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Base.Size()
+		i -= size
+		if _, err := m.Base.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.UOptimal.Size()
+		i -= size
+		if _, err := m.UOptimal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolPairs) Marshal() (dAtA []byte, err error) {
+func (m *ReserveBuybackAssetData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3443,58 +3858,45 @@ func (m *PoolPairs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolPairs) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReserveBuybackAssetData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReserveBuybackAssetData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinUsdValueLeft != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.MinUsdValueLeft))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.AssetData) > 0 {
-		for iNdEx := len(m.AssetData) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AssetData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLend(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	{
+		size := m.BuybackAmount.Size()
+		i -= size
+		if _, err := m.BuybackAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	if len(m.CPoolName) > 0 {
-		i -= len(m.CPoolName)
-		copy(dAtA[i:], m.CPoolName)
-		i = encodeVarintLend(dAtA, i, uint64(len(m.CPoolName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintLend(dAtA, i, uint64(len(m.ModuleName)))
-		i--
-		dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReserveAmount.Size()
+		i -= size
+		if _, err := m.ReserveAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	if m.PoolID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+	i--
+	dAtA[i] = 0x12
+	if m.AssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolInterestData) Marshal() (dAtA []byte, err error) {
+func (m *AuctionParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3504,35 +3906,75 @@ func (m *PoolInterestData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolInterestData) MarshalTo(dAtA []byte) (int, error) {
+func (m *AuctionParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolInterestData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuctionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.BidDurationSeconds != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.BidDurationSeconds))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DutchId != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.DutchId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PriceFunctionType != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PriceFunctionType))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
-		size := m.LendInterest.Size()
+		size := m.Step.Size()
 		i -= size
-		if _, err := m.LendInterest.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Step.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.AssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+	dAtA[i] = 0x2a
+	{
+		size := m.Cusp.Size()
+		i -= size
+		if _, err := m.Cusp.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Buffer.Size()
+		i -= size
+		if _, err := m.Buffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.AuctionDurationSeconds != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AuctionDurationSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppId != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AppId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolInterest) Marshal() (dAtA []byte, err error) {
+func (m *BorrowInterestTracker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3542,39 +3984,35 @@ func (m *PoolInterest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolInterest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BorrowInterestTracker) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolInterest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BorrowInterestTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PoolInterestData) > 0 {
-		for iNdEx := len(m.PoolInterestData) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolInterestData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLend(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.ReservePoolInterest.Size()
+		i -= size
+		if _, err := m.ReservePoolInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	if m.PoolID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+	i--
+	dAtA[i] = 0x1a
+	if m.BorrowingId != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.BorrowingId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolInterestDataB) Marshal() (dAtA []byte, err error) {
+func (m *LendRewardsTracker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3584,35 +4022,35 @@ func (m *PoolInterestDataB) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolInterestDataB) MarshalTo(dAtA []byte) (int, error) {
+func (m *LendRewardsTracker) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolInterestDataB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LendRewardsTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.BorrowInterest.Size()
+		size := m.RewardsAccumulated.Size()
 		i -= size
-		if _, err := m.BorrowInterest.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.RewardsAccumulated.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if m.AssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+	if m.LendingId != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.LendingId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolInterestB) Marshal() (dAtA []byte, err error) {
+func (m *ModuleBalance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3622,20 +4060,20 @@ func (m *PoolInterestB) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolInterestB) MarshalTo(dAtA []byte) (int, error) {
+func (m *ModuleBalance) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolInterestB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ModuleBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PoolInterestData) > 0 {
-		for iNdEx := len(m.PoolInterestData) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ModuleBalanceStats) > 0 {
+		for iNdEx := len(m.ModuleBalanceStats) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PoolInterestData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ModuleBalanceStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3654,7 +4092,7 @@ func (m *PoolInterestB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AssetRatesPoolPairs) Marshal() (dAtA []byte, err error) {
+func (m *ModuleBalanceStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3664,27 +4102,58 @@ func (m *AssetRatesPoolPairs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AssetRatesPoolPairs) MarshalTo(dAtA []byte) (int, error) {
+func (m *ModuleBalanceStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetRatesPoolPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ModuleBalanceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinUsdValueLeft != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.MinUsdValueLeft))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	if len(m.AssetData) > 0 {
-		for iNdEx := len(m.AssetData) - 1; iNdEx >= 0; iNdEx-- {
+	i--
+	dAtA[i] = 0x12
+	if m.AssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModBal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModBal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModBal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FundModuleBalance) > 0 {
+		for iNdEx := len(m.FundModuleBalance) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AssetData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FundModuleBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3692,158 +4161,224 @@ func (m *AssetRatesPoolPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintLend(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveBal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveBal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveBal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FundReserveBalance) > 0 {
+		for iNdEx := len(m.FundReserveBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundReserveBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLend(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x92
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.CPoolName) > 0 {
-		i -= len(m.CPoolName)
-		copy(dAtA[i:], m.CPoolName)
-		i = encodeVarintLend(dAtA, i, uint64(len(m.CPoolName)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return len(dAtA) - i, nil
+}
+
+func (m *FundModBal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintLend(dAtA, i, uint64(len(m.ModuleName)))
-		i--
-		dAtA[i] = 0x1
+	return dAtA[:n], nil
+}
+
+func (m *FundModBal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundModBal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.Funder)))
 		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x2a
 	}
-	if m.CAssetID != 0 {
-		i = encodeVarintLend(dAtA, i, uint64(m.CAssetID))
-		i--
-		dAtA[i] = 0x78
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositTime):])
+	if err18 != nil {
+		return 0, err18
 	}
+	i -= n18
+	i = encodeVarintLend(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x22
 	{
-		size := m.ReserveFactor.Size()
-		i -= size
-		if _, err := m.ReserveFactor.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x72
-	{
-		size := m.LiquidationBonus.Size()
-		i -= size
-		if _, err := m.LiquidationBonus.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundReserveBal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FundReserveBal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundReserveBal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintLend(dAtA, i, uint64(n20))
 	i--
-	dAtA[i] = 0x6a
+	dAtA[i] = 0x1a
 	{
-		size := m.LiquidationPenalty.Size()
-		i -= size
-		if _, err := m.LiquidationPenalty.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x62
+	dAtA[i] = 0x12
+	if m.AssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllReserveStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllReserveStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllReserveStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.LiquidationThreshold.Size()
+		size := m.TotalAmountOutToLenders.Size()
 		i -= size
-		if _, err := m.LiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TotalAmountOutToLenders.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x5a
+	dAtA[i] = 0x32
 	{
-		size := m.Ltv.Size()
+		size := m.AmountInFromRepayments.Size()
 		i -= size
-		if _, err := m.Ltv.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.AmountInFromRepayments.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x52
+	dAtA[i] = 0x2a
 	{
-		size := m.StableSlope2.Size()
+		size := m.AmountInFromLiqPenalty.Size()
 		i -= size
-		if _, err := m.StableSlope2.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.AmountInFromLiqPenalty.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x4a
+	dAtA[i] = 0x22
 	{
-		size := m.StableSlope1.Size()
+		size := m.AmountOutFromReserveForAuction.Size()
 		i -= size
-		if _, err := m.StableSlope1.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.AmountOutFromReserveForAuction.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x42
+	dAtA[i] = 0x1a
 	{
-		size := m.StableBase.Size()
+		size := m.AmountOutFromReserveToLenders.Size()
 		i -= size
-		if _, err := m.StableBase.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.EnableStableBorrow {
-		i--
-		if m.EnableStableBorrow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.Slope2.Size()
-		i -= size
-		if _, err := m.Slope2.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Slope1.Size()
-		i -= size
-		if _, err := m.Slope1.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Base.Size()
-		i -= size
-		if _, err := m.Base.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLend(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.UOptimal.Size()
-		i -= size
-		if _, err := m.UOptimal.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.AmountOutFromReserveToLenders.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLend(dAtA, i, uint64(size))
@@ -3858,720 +4393,4697 @@ func (m *AssetRatesPoolPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLend(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLend(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AssetToPairSingleMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *LendAsset) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AssetToPairSingleMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetToPairSingleMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovLend(uint64(m.ID))
+	if m.PairID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PairID))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.PoolID != 0 {
-		n += 1 + sovLend(uint64(m.PoolID))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovLend(uint64(l))
-	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LendingTime)
-	n += 1 + l + sovLend(uint64(l))
-	l = m.AvailableToBorrow.Size()
-	n += 1 + l + sovLend(uint64(l))
-	if m.AppID != 0 {
-		n += 1 + sovLend(uint64(m.AppID))
-	}
-	l = m.GlobalIndex.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastInteractionTime)
-	n += 1 + l + sovLend(uint64(l))
-	l = len(m.CPoolName)
-	if l > 0 {
-		n += 1 + l + sovLend(uint64(l))
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.TotalRewards.Size()
-	n += 1 + l + sovLend(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BorrowAsset) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovLend(uint64(m.ID))
-	}
-	if m.LendingID != 0 {
-		n += 1 + sovLend(uint64(m.LendingID))
+	if m.MinUsdValueLeft != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.MinUsdValueLeft))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.IsStableBorrow {
-		n += 2
+	if len(m.AssetData) > 0 {
+		for iNdEx := len(m.AssetData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.PairID != 0 {
-		n += 1 + sovLend(uint64(m.PairID))
+	if len(m.CPoolName) > 0 {
+		i -= len(m.CPoolName)
+		copy(dAtA[i:], m.CPoolName)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.CPoolName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.AmountOut.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.BridgedAssetAmount.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BorrowingTime)
-	n += 1 + l + sovLend(uint64(l))
-	l = m.StableBorrowRate.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.InterestAccumulated.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.GlobalIndex.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.ReserveGlobalIndex.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastInteractionTime)
-	n += 1 + l + sovLend(uint64(l))
-	l = len(m.CPoolName)
-	if l > 0 {
-		n += 1 + l + sovLend(uint64(l))
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IsLiquidated {
-		n += 2
+	if m.PoolID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolInterestData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolInterestData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolInterestData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovLend(uint64(m.PoolID))
-	}
-	l = len(m.ModuleName)
-	if l > 0 {
-		n += 1 + l + sovLend(uint64(l))
-	}
-	l = len(m.CPoolName)
-	if l > 0 {
-		n += 1 + l + sovLend(uint64(l))
-	}
-	if len(m.AssetData) > 0 {
-		for _, e := range m.AssetData {
-			l = e.Size()
-			n += 1 + l + sovLend(uint64(l))
+	{
+		size := m.LendInterest.Size()
+		i -= size
+		if _, err := m.LendInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.AssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UserAssetLendBorrowMapping) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovLend(uint64(l))
-	}
-	if m.LendId != 0 {
-		n += 1 + sovLend(uint64(m.LendId))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovLend(uint64(m.PoolId))
-	}
-	if len(m.BorrowId) > 0 {
-		l = 0
-		for _, e := range m.BorrowId {
-			l += sovLend(uint64(e))
-		}
-		n += 1 + sovLend(uint64(l)) + l
+func (m *PoolInterest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AssetDataPoolMapping) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
-	}
-	if m.AssetTransitType != 0 {
-		n += 1 + sovLend(uint64(m.AssetTransitType))
-	}
-	l = m.SupplyCap.Size()
-	n += 1 + l + sovLend(uint64(l))
-	return n
+func (m *PoolInterest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Extended_Pair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PoolInterest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLend(uint64(m.Id))
-	}
-	if m.AssetIn != 0 {
-		n += 1 + sovLend(uint64(m.AssetIn))
-	}
-	if m.AssetOut != 0 {
-		n += 1 + sovLend(uint64(m.AssetOut))
-	}
-	if m.IsInterPool {
-		n += 2
-	}
-	if m.AssetOutPoolID != 0 {
-		n += 1 + sovLend(uint64(m.AssetOutPoolID))
+	if len(m.PoolInterestData) > 0 {
+		for iNdEx := len(m.PoolInterestData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolInterestData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.MinUsdValueLeft != 0 {
-		n += 1 + sovLend(uint64(m.MinUsdValueLeft))
+	if m.PoolID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetToPairMapping) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolInterestDataB) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolInterestDataB) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolInterestDataB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovLend(uint64(m.PoolID))
+	{
+		size := m.BorrowInterest.Size()
+		i -= size
+		if _, err := m.BorrowInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
-	}
-	if len(m.PairID) > 0 {
-		l = 0
-		for _, e := range m.PairID {
-			l += sovLend(uint64(e))
-		}
-		n += 1 + sovLend(uint64(l)) + l
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolAssetLBMapping) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolInterestB) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolInterestB) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolInterestB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovLend(uint64(m.PoolID))
-	}
-	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
-	}
-	if len(m.LendIds) > 0 {
-		l = 0
-		for _, e := range m.LendIds {
-			l += sovLend(uint64(e))
+	if len(m.PoolInterestData) > 0 {
+		for iNdEx := len(m.PoolInterestData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolInterestData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		n += 1 + sovLend(uint64(l)) + l
 	}
-	if len(m.BorrowIds) > 0 {
-		l = 0
-		for _, e := range m.BorrowIds {
-			l += sovLend(uint64(e))
-		}
-		n += 1 + sovLend(uint64(l)) + l
+	if m.PoolID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.TotalBorrowed.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.TotalStableBorrowed.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.TotalLend.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.TotalInterestAccumulated.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.LendApr.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.BorrowApr.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.StableBorrowApr.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.UtilisationRatio.Size()
-	n += 1 + l + sovLend(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetRatesParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssetRatesPoolPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetRatesPoolPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetRatesPoolPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
+	{
+		size := m.AdjustmentSpeed.Size()
+		i -= size
+		if _, err := m.AdjustmentSpeed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	l = m.UOptimal.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.Base.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.Slope1.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.Slope2.Size()
-	n += 1 + l + sovLend(uint64(l))
-	if m.EnableStableBorrow {
-		n += 2
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.RateCap.Size()
+		i -= size
+		if _, err := m.RateCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	l = m.StableBase.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.StableSlope1.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.StableSlope2.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.Ltv.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.LiquidationThreshold.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.LiquidationPenalty.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.LiquidationBonus.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.ReserveFactor.Size()
-	n += 1 + l + sovLend(uint64(l))
-	if m.CAssetID != 0 {
-		n += 1 + sovLend(uint64(m.CAssetID))
-	}
-	return n
-}
-
-func (m *ReserveBuybackAssetData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
-	}
-	l = m.ReserveAmount.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.BuybackAmount.Size()
-	n += 1 + l + sovLend(uint64(l))
-	return n
-}
-
-func (m *AuctionParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovLend(uint64(m.AppId))
-	}
-	if m.AuctionDurationSeconds != 0 {
-		n += 1 + sovLend(uint64(m.AuctionDurationSeconds))
-	}
-	l = m.Buffer.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.Cusp.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.Step.Size()
-	n += 1 + l + sovLend(uint64(l))
-	if m.PriceFunctionType != 0 {
-		n += 1 + sovLend(uint64(m.PriceFunctionType))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.InterestRateModelID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.InterestRateModelID))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	if m.DutchId != 0 {
-		n += 1 + sovLend(uint64(m.DutchId))
+	if m.MinUsdValueLeft != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.MinUsdValueLeft))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.BidDurationSeconds != 0 {
-		n += 1 + sovLend(uint64(m.BidDurationSeconds))
+	if len(m.AssetData) > 0 {
+		for iNdEx := len(m.AssetData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	return n
-}
-
-func (m *BorrowInterestTracker) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.CPoolName) > 0 {
+		i -= len(m.CPoolName)
+		copy(dAtA[i:], m.CPoolName)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.CPoolName)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	var l int
-	_ = l
-	if m.BorrowingId != 0 {
-		n += 1 + sovLend(uint64(m.BorrowingId))
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	l = m.ReservePoolInterest.Size()
-	n += 1 + l + sovLend(uint64(l))
-	return n
-}
-
-func (m *LendRewardsTracker) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CAssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.CAssetID))
+		i--
+		dAtA[i] = 0x78
 	}
-	var l int
-	_ = l
-	if m.LendingId != 0 {
-		n += 1 + sovLend(uint64(m.LendingId))
+	{
+		size := m.ReserveFactor.Size()
+		i -= size
+		if _, err := m.ReserveFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	l = m.RewardsAccumulated.Size()
-	n += 1 + l + sovLend(uint64(l))
-	return n
-}
-
-func (m *ModuleBalance) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.LiquidationBonus.Size()
+		i -= size
+		if _, err := m.LiquidationBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovLend(uint64(m.PoolID))
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.LiquidationPenalty.Size()
+		i -= size
+		if _, err := m.LiquidationPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	if len(m.ModuleBalanceStats) > 0 {
-		for _, e := range m.ModuleBalanceStats {
-			l = e.Size()
-			n += 1 + l + sovLend(uint64(l))
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.LiquidationThreshold.Size()
+		i -= size
+		if _, err := m.LiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ModuleBalanceStats) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.Ltv.Size()
+		i -= size
+		if _, err := m.Ltv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.StableSlope2.Size()
+		i -= size
+		if _, err := m.StableSlope2.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	l = m.Balance.Size()
-	n += 1 + l + sovLend(uint64(l))
-	return n
-}
-
-func (m *ModBal) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.StableSlope1.Size()
+		i -= size
+		if _, err := m.StableSlope1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if len(m.FundModuleBalance) > 0 {
-		for _, e := range m.FundModuleBalance {
-			l = e.Size()
-			n += 1 + l + sovLend(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.StableBase.Size()
+		i -= size
+		if _, err := m.StableBase.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ReserveBal) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x3a
+	if m.EnableStableBorrow {
+		i--
+		if m.EnableStableBorrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	if len(m.FundReserveBalance) > 0 {
-		for _, e := range m.FundReserveBalance {
-			l = e.Size()
-			n += 1 + l + sovLend(uint64(l))
+	{
+		size := m.Slope2.Size()
+		i -= size
+		if _, err := m.Slope2.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *FundModBal) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Slope1.Size()
+		i -= size
+		if _, err := m.Slope1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Base.Size()
+		i -= size
+		if _, err := m.Base.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	if m.PoolID != 0 {
-		n += 1 + sovLend(uint64(m.PoolID))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.UOptimal.Size()
+		i -= size
+		if _, err := m.UOptimal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositTime)
-	n += 1 + l + sovLend(uint64(l))
-	l = len(m.Funder)
-	if l > 0 {
-		n += 1 + l + sovLend(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.AssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FundReserveBal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
-	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositTime)
-	n += 1 + l + sovLend(uint64(l))
-	l = len(m.Funder)
-	if l > 0 {
-		n += 1 + l + sovLend(uint64(l))
+func (m *PositionManagerGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AllReserveStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
-	}
-	l = m.AmountOutFromReserveToLenders.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.AmountOutFromReserveForAuction.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.AmountInFromLiqPenalty.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.AmountInFromRepayments.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.TotalAmountOutToLenders.Size()
-	n += 1 + l + sovLend(uint64(l))
-	return n
+func (m *PositionManagerGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetToPairSingleMapping) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PositionManagerGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovLend(uint64(m.PoolID))
+	if len(m.Permissions) > 0 {
+		dAtA23 := make([]byte, len(m.Permissions)*10)
+		var j22 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j22++
+			}
+			dAtA23[j22] = uint8(num)
+			j22++
+		}
+		i -= j22
+		copy(dAtA[i:], dAtA23[:j22])
+		i = encodeVarintLend(dAtA, i, uint64(j22))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PairID != 0 {
-		n += 1 + sovLend(uint64(m.PairID))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolPairs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EModeCategory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EModeCategory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EModeCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovLend(uint64(m.PoolID))
-	}
-	l = len(m.ModuleName)
-	if l > 0 {
-		n += 1 + l + sovLend(uint64(l))
+	{
+		size := m.LiquidationThreshold.Size()
+		i -= size
+		if _, err := m.LiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	l = len(m.CPoolName)
-	if l > 0 {
-		n += 1 + l + sovLend(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Ltv.Size()
+		i -= size
+		if _, err := m.Ltv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	if len(m.AssetData) > 0 {
-		for _, e := range m.AssetData {
-			l = e.Size()
-			n += 1 + l + sovLend(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.AssetIDs) > 0 {
+		dAtA25 := make([]byte, len(m.AssetIDs)*10)
+		var j24 int
+		for _, num := range m.AssetIDs {
+			for num >= 1<<7 {
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j24++
+			}
+			dAtA25[j24] = uint8(num)
+			j24++
 		}
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintLend(dAtA, i, uint64(j24))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MinUsdValueLeft != 0 {
-		n += 1 + sovLend(uint64(m.MinUsdValueLeft))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.ID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolInterestData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
+func (m *AdaptiveRateState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.LendInterest.Size()
-	n += 1 + l + sovLend(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolInterest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AdaptiveRateState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdaptiveRateState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovLend(uint64(m.PoolID))
+	n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdated):])
+	if err26 != nil {
+		return 0, err26
 	}
-	if len(m.PoolInterestData) > 0 {
-		for _, e := range m.PoolInterestData {
-			l = e.Size()
-			n += 1 + l + sovLend(uint64(l))
+	i -= n26
+	i = encodeVarintLend(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *PoolInterestDataB) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+	i--
+	dAtA[i] = 0x1a
 	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.BorrowInterest.Size()
-	n += 1 + l + sovLend(uint64(l))
-	return n
+	if m.PoolID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolInterestB) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BadDebtWaterfall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BadDebtWaterfall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadDebtWaterfall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovLend(uint64(m.PoolID))
+	if len(m.Steps) > 0 {
+		dAtA28 := make([]byte, len(m.Steps)*10)
+		var j27 int
+		for _, num := range m.Steps {
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			dAtA28[j27] = uint8(num)
+			j27++
+		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintLend(dAtA, i, uint64(j27))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.PoolInterestData) > 0 {
-		for _, e := range m.PoolInterestData {
-			l = e.Size()
-			n += 1 + l + sovLend(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *CTokenExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CTokenExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CTokenExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if m.AssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetRatesPoolPairs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.AssetID != 0 {
-		n += 1 + sovLend(uint64(m.AssetID))
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.UOptimal.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.Base.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.Slope1.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.Slope2.Size()
-	n += 1 + l + sovLend(uint64(l))
-	if m.EnableStableBorrow {
-		n += 2
+	if m.LendID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.LendID))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.StableBase.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.StableSlope1.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.StableSlope2.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.Ltv.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.LiquidationThreshold.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.LiquidationPenalty.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.LiquidationBonus.Size()
-	n += 1 + l + sovLend(uint64(l))
-	l = m.ReserveFactor.Size()
-	n += 1 + l + sovLend(uint64(l))
-	if m.CAssetID != 0 {
-		n += 1 + sovLend(uint64(m.CAssetID))
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ModuleName)
-	if l > 0 {
-		n += 2 + l + sovLend(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *AccountDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastInteractionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastInteractionTime):])
+	if err30 != nil {
+		return 0, err30
 	}
-	l = len(m.CPoolName)
-	if l > 0 {
-		n += 2 + l + sovLend(uint64(l))
+	i -= n30
+	i = encodeVarintLend(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ReserveInterest.Size()
+		i -= size
+		if _, err := m.ReserveInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	if len(m.AssetData) > 0 {
-		for _, e := range m.AssetData {
-			l = e.Size()
-			n += 2 + l + sovLend(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InterestAccumulated.Size()
+		i -= size
+		if _, err := m.InterestAccumulated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	if m.MinUsdValueLeft != 0 {
-		n += 2 + sovLend(uint64(m.MinUsdValueLeft))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLend(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.AssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovLend(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *CrossCollateralAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozLend(x uint64) (n int) {
-	return sovLend(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *CrossCollateralAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *LendAsset) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLend
+
+func (m *CrossCollateralAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Debts) > 0 {
+		for iNdEx := len(m.Debts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Debts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AppID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AppID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.HealthFactor.Size()
+		i -= size
+		if _, err := m.HealthFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DebtValue.Size()
+		i -= size
+		if _, err := m.DebtValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LiquidationLimit.Size()
+		i -= size
+		if _, err := m.LiquidationLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BorrowLimit.Size()
+		i -= size
+		if _, err := m.BorrowLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CollateralValue.Size()
+		i -= size
+		if _, err := m.CollateralValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FixedTermMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixedTermMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FixedTermMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shortfall.Size()
+		i -= size
+		if _, err := m.Shortfall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.TotalBorrowed.Size()
+		i -= size
+		if _, err := m.TotalBorrowed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Available.Size()
+		i -= size
+		if _, err := m.Available.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TotalCommitted.Size()
+		i -= size
+		if _, err := m.TotalCommitted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.EarlyRepaymentPenalty.Size()
+		i -= size
+		if _, err := m.EarlyRepaymentPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Maturity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Maturity):])
+	if err32 != nil {
+		return 0, err32
+	}
+	i -= n32
+	i = encodeVarintLend(dAtA, i, uint64(n32))
+	i--
+	dAtA[i] = 0x2a
+	if m.AssetID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AssetID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.AppID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FixedTermDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixedTermDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FixedTermDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.MarketID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FixedTermLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixedTermLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FixedTermLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InterestDue.Size()
+		i -= size
+		if _, err := m.InterestDue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Maturity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Maturity):])
+	if err34 != nil {
+		return 0, err34
+	}
+	i -= n34
+	i = encodeVarintLend(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0x52
+	n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err35 != nil {
+		return 0, err35
+	}
+	i -= n35
+	i = encodeVarintLend(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLend(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.PairID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.PairID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LendID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.LendID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLend(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.MarketID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintLend(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLend(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLend(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LendAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovLend(uint64(m.ID))
+	}
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LendingTime)
+	n += 1 + l + sovLend(uint64(l))
+	l = m.AvailableToBorrow.Size()
+	n += 1 + l + sovLend(uint64(l))
+	if m.AppID != 0 {
+		n += 1 + sovLend(uint64(m.AppID))
+	}
+	l = m.GlobalIndex.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastInteractionTime)
+	n += 1 + l + sovLend(uint64(l))
+	l = len(m.CPoolName)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	l = m.TotalRewards.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *BorrowAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovLend(uint64(m.ID))
+	}
+	if m.LendingID != 0 {
+		n += 1 + sovLend(uint64(m.LendingID))
+	}
+	if m.IsStableBorrow {
+		n += 2
+	}
+	if m.PairID != 0 {
+		n += 1 + sovLend(uint64(m.PairID))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.BridgedAssetAmount.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BorrowingTime)
+	n += 1 + l + sovLend(uint64(l))
+	l = m.StableBorrowRate.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.InterestAccumulated.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.GlobalIndex.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.ReserveGlobalIndex.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastInteractionTime)
+	n += 1 + l + sovLend(uint64(l))
+	l = len(m.CPoolName)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	if m.IsLiquidated {
+		n += 2
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	l = len(m.CPoolName)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	if len(m.AssetData) > 0 {
+		for _, e := range m.AssetData {
+			l = e.Size()
+			n += 1 + l + sovLend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UserAssetLendBorrowMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	if m.LendId != 0 {
+		n += 1 + sovLend(uint64(m.LendId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLend(uint64(m.PoolId))
+	}
+	if len(m.BorrowId) > 0 {
+		l = 0
+		for _, e := range m.BorrowId {
+			l += sovLend(uint64(e))
+		}
+		n += 1 + sovLend(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *AssetDataPoolMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	if m.AssetTransitType != 0 {
+		n += 1 + sovLend(uint64(m.AssetTransitType))
+	}
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *Extended_Pair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLend(uint64(m.Id))
+	}
+	if m.AssetIn != 0 {
+		n += 1 + sovLend(uint64(m.AssetIn))
+	}
+	if m.AssetOut != 0 {
+		n += 1 + sovLend(uint64(m.AssetOut))
+	}
+	if m.IsInterPool {
+		n += 2
+	}
+	if m.AssetOutPoolID != 0 {
+		n += 1 + sovLend(uint64(m.AssetOutPoolID))
+	}
+	if m.MinUsdValueLeft != 0 {
+		n += 1 + sovLend(uint64(m.MinUsdValueLeft))
+	}
+	return n
+}
+
+func (m *AssetToPairMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	if len(m.PairID) > 0 {
+		l = 0
+		for _, e := range m.PairID {
+			l += sovLend(uint64(e))
+		}
+		n += 1 + sovLend(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *PoolAssetLBMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	if len(m.LendIds) > 0 {
+		l = 0
+		for _, e := range m.LendIds {
+			l += sovLend(uint64(e))
+		}
+		n += 1 + sovLend(uint64(l)) + l
+	}
+	if len(m.BorrowIds) > 0 {
+		l = 0
+		for _, e := range m.BorrowIds {
+			l += sovLend(uint64(e))
+		}
+		n += 1 + sovLend(uint64(l)) + l
+	}
+	l = m.TotalBorrowed.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.TotalStableBorrowed.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.TotalLend.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.TotalInterestAccumulated.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.LendApr.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.BorrowApr.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.StableBorrowApr.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.UtilisationRatio.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *AssetRatesParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = m.UOptimal.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Base.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Slope1.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Slope2.Size()
+	n += 1 + l + sovLend(uint64(l))
+	if m.EnableStableBorrow {
+		n += 2
+	}
+	l = m.StableBase.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.StableSlope1.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.StableSlope2.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Ltv.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.LiquidationThreshold.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.LiquidationPenalty.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.LiquidationBonus.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.ReserveFactor.Size()
+	n += 1 + l + sovLend(uint64(l))
+	if m.CAssetID != 0 {
+		n += 1 + sovLend(uint64(m.CAssetID))
+	}
+	if m.InterestRateModelID != 0 {
+		n += 2 + sovLend(uint64(m.InterestRateModelID))
+	}
+	l = m.RateCap.Size()
+	n += 2 + l + sovLend(uint64(l))
+	l = m.AdjustmentSpeed.Size()
+	n += 2 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *ReserveBuybackAssetData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = m.ReserveAmount.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.BuybackAmount.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *AuctionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovLend(uint64(m.AppId))
+	}
+	if m.AuctionDurationSeconds != 0 {
+		n += 1 + sovLend(uint64(m.AuctionDurationSeconds))
+	}
+	l = m.Buffer.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Cusp.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Step.Size()
+	n += 1 + l + sovLend(uint64(l))
+	if m.PriceFunctionType != 0 {
+		n += 1 + sovLend(uint64(m.PriceFunctionType))
+	}
+	if m.DutchId != 0 {
+		n += 1 + sovLend(uint64(m.DutchId))
+	}
+	if m.BidDurationSeconds != 0 {
+		n += 1 + sovLend(uint64(m.BidDurationSeconds))
+	}
+	return n
+}
+
+func (m *BorrowInterestTracker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BorrowingId != 0 {
+		n += 1 + sovLend(uint64(m.BorrowingId))
+	}
+	l = m.ReservePoolInterest.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *LendRewardsTracker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LendingId != 0 {
+		n += 1 + sovLend(uint64(m.LendingId))
+	}
+	l = m.RewardsAccumulated.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *ModuleBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	if len(m.ModuleBalanceStats) > 0 {
+		for _, e := range m.ModuleBalanceStats {
+			l = e.Size()
+			n += 1 + l + sovLend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleBalanceStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *ModBal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FundModuleBalance) > 0 {
+		for _, e := range m.FundModuleBalance {
+			l = e.Size()
+			n += 1 + l + sovLend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReserveBal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FundReserveBalance) > 0 {
+		for _, e := range m.FundReserveBalance {
+			l = e.Size()
+			n += 1 + l + sovLend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FundModBal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositTime)
+	n += 1 + l + sovLend(uint64(l))
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	return n
+}
+
+func (m *FundReserveBal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositTime)
+	n += 1 + l + sovLend(uint64(l))
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	return n
+}
+
+func (m *AllReserveStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = m.AmountOutFromReserveToLenders.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.AmountOutFromReserveForAuction.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.AmountInFromLiqPenalty.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.AmountInFromRepayments.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.TotalAmountOutToLenders.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *AssetToPairSingleMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	if m.PairID != 0 {
+		n += 1 + sovLend(uint64(m.PairID))
+	}
+	return n
+}
+
+func (m *PoolPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	l = len(m.CPoolName)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	if len(m.AssetData) > 0 {
+		for _, e := range m.AssetData {
+			l = e.Size()
+			n += 1 + l + sovLend(uint64(l))
+		}
+	}
+	if m.MinUsdValueLeft != 0 {
+		n += 1 + sovLend(uint64(m.MinUsdValueLeft))
+	}
+	return n
+}
+
+func (m *PoolInterestData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = m.LendInterest.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *PoolInterest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	if len(m.PoolInterestData) > 0 {
+		for _, e := range m.PoolInterestData {
+			l = e.Size()
+			n += 1 + l + sovLend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolInterestDataB) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = m.BorrowInterest.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *PoolInterestB) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	if len(m.PoolInterestData) > 0 {
+		for _, e := range m.PoolInterestData {
+			l = e.Size()
+			n += 1 + l + sovLend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssetRatesPoolPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = m.UOptimal.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Base.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Slope1.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Slope2.Size()
+	n += 1 + l + sovLend(uint64(l))
+	if m.EnableStableBorrow {
+		n += 2
+	}
+	l = m.StableBase.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.StableSlope1.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.StableSlope2.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Ltv.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.LiquidationThreshold.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.LiquidationPenalty.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.LiquidationBonus.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.ReserveFactor.Size()
+	n += 1 + l + sovLend(uint64(l))
+	if m.CAssetID != 0 {
+		n += 1 + sovLend(uint64(m.CAssetID))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 2 + l + sovLend(uint64(l))
+	}
+	l = len(m.CPoolName)
+	if l > 0 {
+		n += 2 + l + sovLend(uint64(l))
+	}
+	if len(m.AssetData) > 0 {
+		for _, e := range m.AssetData {
+			l = e.Size()
+			n += 2 + l + sovLend(uint64(l))
+		}
+	}
+	if m.MinUsdValueLeft != 0 {
+		n += 2 + sovLend(uint64(m.MinUsdValueLeft))
+	}
+	if m.InterestRateModelID != 0 {
+		n += 2 + sovLend(uint64(m.InterestRateModelID))
+	}
+	l = m.RateCap.Size()
+	n += 2 + l + sovLend(uint64(l))
+	l = m.AdjustmentSpeed.Size()
+	n += 2 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *PositionManagerGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovLend(uint64(e))
+		}
+		n += 1 + sovLend(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EModeCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovLend(uint64(m.ID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	if len(m.AssetIDs) > 0 {
+		l = 0
+		for _, e := range m.AssetIDs {
+			l += sovLend(uint64(e))
+		}
+		n += 1 + sovLend(uint64(l)) + l
+	}
+	l = m.Ltv.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.LiquidationThreshold.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *AdaptiveRateState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdated)
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *BadDebtWaterfall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		l = 0
+		for _, e := range m.Steps {
+			l += sovLend(uint64(e))
+		}
+		n += 1 + sovLend(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *CTokenExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *AccountCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LendID != 0 {
+		n += 1 + sovLend(uint64(m.LendID))
+	}
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *AccountDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = m.AmountOut.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.InterestAccumulated.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.ReserveInterest.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastInteractionTime)
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *CrossCollateralAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	if m.AppID != 0 {
+		n += 1 + sovLend(uint64(m.AppID))
+	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovLend(uint64(l))
+		}
+	}
+	if len(m.Debts) > 0 {
+		for _, e := range m.Debts {
+			l = e.Size()
+			n += 1 + l + sovLend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollateralValue.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.BorrowLimit.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.LiquidationLimit.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.DebtValue.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.HealthFactor.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *FixedTermMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovLend(uint64(m.ID))
+	}
+	if m.AppID != 0 {
+		n += 1 + sovLend(uint64(m.AppID))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovLend(uint64(m.PoolID))
+	}
+	if m.AssetID != 0 {
+		n += 1 + sovLend(uint64(m.AssetID))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Maturity)
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.EarlyRepaymentPenalty.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.TotalCommitted.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Available.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.TotalBorrowed.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Shortfall.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *FixedTermDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovLend(uint64(m.ID))
+	}
+	if m.MarketID != 0 {
+		n += 1 + sovLend(uint64(m.MarketID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func (m *FixedTermLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovLend(uint64(m.ID))
+	}
+	if m.MarketID != 0 {
+		n += 1 + sovLend(uint64(m.MarketID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLend(uint64(l))
+	}
+	if m.LendID != 0 {
+		n += 1 + sovLend(uint64(m.LendID))
+	}
+	if m.PairID != 0 {
+		n += 1 + sovLend(uint64(m.PairID))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovLend(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovLend(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Maturity)
+	n += 1 + l + sovLend(uint64(l))
+	l = m.InterestDue.Size()
+	n += 1 + l + sovLend(uint64(l))
+	return n
+}
+
+func sovLend(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLend(x uint64) (n int) {
+	return sovLend(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LendAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LendAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LendAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			m.AssetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LendingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableToBorrow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AvailableToBorrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			m.AppID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInteractionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastInteractionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPoolName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CPoolName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BorrowAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BorrowAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendingID", wireType)
+			}
+			m.LendingID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LendingID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsStableBorrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsStableBorrow = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairID", wireType)
+			}
+			m.PairID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgedAssetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgedAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BorrowingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableBorrowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StableBorrowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestAccumulated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestAccumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveGlobalIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveGlobalIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInteractionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastInteractionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPoolName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CPoolName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLiquidated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLiquidated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPoolName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CPoolName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetData = append(m.AssetData, &AssetDataPoolMapping{})
+			if err := m.AssetData[len(m.AssetData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserAssetLendBorrowMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserAssetLendBorrowMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserAssetLendBorrowMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendId", wireType)
+			}
+			m.LendId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LendId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BorrowId = append(m.BorrowId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLend
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLend
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BorrowId) == 0 {
+					m.BorrowId = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BorrowId = append(m.BorrowId, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowId", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetDataPoolMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetDataPoolMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetDataPoolMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			m.AssetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetTransitType", wireType)
+			}
+			m.AssetTransitType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetTransitType |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Extended_Pair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Extended_Pair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Extended_Pair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetIn", wireType)
+			}
+			m.AssetIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetIn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetOut", wireType)
+			}
+			m.AssetOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetOut |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInterPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInterPool = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetOutPoolID", wireType)
+			}
+			m.AssetOutPoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetOutPoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUsdValueLeft", wireType)
+			}
+			m.MinUsdValueLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUsdValueLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetToPairMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetToPairMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetToPairMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			m.AssetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PairID = append(m.PairID, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLend
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLend
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PairID) == 0 {
+					m.PairID = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PairID = append(m.PairID, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairID", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAssetLBMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAssetLBMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			m.AssetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LendIds = append(m.LendIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLend
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLend
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LendIds) == 0 {
+					m.LendIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LendIds = append(m.LendIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendIds", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BorrowIds = append(m.BorrowIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLend
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLend
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BorrowIds) == 0 {
+					m.BorrowIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BorrowIds = append(m.BorrowIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowIds", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBorrowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBorrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStableBorrowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStableBorrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalInterestAccumulated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalInterestAccumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LendApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableBorrowApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StableBorrowApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtilisationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UtilisationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetRatesParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetRatesParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			m.AssetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UOptimal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UOptimal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slope1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slope1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slope2", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slope2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStableBorrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableStableBorrow = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.StableBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LendAsset: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LendAsset: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSlope1", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -4581,16 +9093,31 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
 			}
-			m.AssetID = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StableSlope1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSlope2", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -4600,16 +9127,31 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
 			}
-			m.PoolID = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StableSlope2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -4619,14 +9161,29 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ltv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4654,13 +9211,15 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -4670,30 +9229,31 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LendingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationBonus", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -4703,28 +9263,29 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LendingTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableToBorrow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4752,15 +9313,15 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AvailableToBorrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CAssetID", wireType)
 			}
-			m.AppID = 0
+			m.CAssetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -4770,14 +9331,33 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppID |= uint64(b&0x7F) << shift
+				m.CAssetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModelID", wireType)
+			}
+			m.InterestRateModelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterestRateModelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4805,15 +9385,99 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInteractionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentSpeed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdjustmentSpeed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveBuybackAssetData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveBuybackAssetData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveBuybackAssetData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
-			var msglen int
+			m.AssetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -4823,28 +9487,14 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AssetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastInteractionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPoolName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4872,11 +9522,13 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CPoolName = string(dAtA[iNdEx:postIndex])
+			if err := m.ReserveAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4904,7 +9556,7 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BuybackAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4929,7 +9581,7 @@ func (m *LendAsset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
+func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4952,17 +9604,17 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BorrowAsset: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuctionParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BorrowAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuctionParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			m.ID = 0
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -4972,16 +9624,16 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LendingID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionDurationSeconds", wireType)
 			}
-			m.LendingID = 0
+			m.AuctionDurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -4991,55 +9643,16 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LendingID |= uint64(b&0x7F) << shift
+				m.AuctionDurationSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsStableBorrow", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsStableBorrow = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairID", wireType)
-			}
-			m.PairID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PairID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5049,30 +9662,31 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Buffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cusp", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5082,30 +9696,31 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cusp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgedAssetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5115,30 +9730,31 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgedAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Step.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowingTime", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFunctionType", wireType)
 			}
-			var msglen int
+			m.PriceFunctionType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5148,30 +9764,16 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PriceFunctionType |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BorrowingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableBorrowRate", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchId", wireType)
 			}
-			var stringLen uint64
+			m.DutchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5181,31 +9783,16 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DutchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StableBorrowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestAccumulated", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidDurationSeconds", wireType)
 			}
-			var stringLen uint64
+			m.BidDurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5215,31 +9802,66 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BidDurationSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLend
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InterestAccumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BorrowInterestTracker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalIndex", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Borrow_interest_tracker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Borrow_interest_tracker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowingId", wireType)
+			}
+			m.BorrowingId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5249,29 +9871,14 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BorrowingId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GlobalIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveGlobalIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePoolInterest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5299,15 +9906,65 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveGlobalIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReservePoolInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInteractionTime", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LendRewardsTracker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lend_rewards_tracker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lend_rewards_tracker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendingId", wireType)
+			}
+			m.LendingId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5317,28 +9974,14 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LendingId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastInteractionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPoolName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAccumulated", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5366,28 +10009,10 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CPoolName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLiquidated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.RewardsAccumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IsLiquidated = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLend(dAtA[iNdEx:])
@@ -5409,7 +10034,7 @@ func (m *BorrowAsset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *ModuleBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5432,10 +10057,10 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleBalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5459,9 +10084,9 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalanceStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5471,29 +10096,100 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			m.ModuleBalanceStats = append(m.ModuleBalanceStats, ModuleBalanceStats{})
+			if err := m.ModuleBalanceStats[len(m.ModuleBalanceStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleBalanceStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleBalanceStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleBalanceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			m.AssetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPoolName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5503,27 +10199,78 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModBal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CPoolName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModBal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModBal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundModuleBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5550,8 +10297,8 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetData = append(m.AssetData, &AssetDataPoolMapping{})
-			if err := m.AssetData[len(m.AssetData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FundModuleBalance = append(m.FundModuleBalance, FundModBal{})
+			if err := m.FundModuleBalance[len(m.FundModuleBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5576,7 +10323,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserAssetLendBorrowMapping) Unmarshal(dAtA []byte) error {
+func (m *ReserveBal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5599,17 +10346,17 @@ func (m *UserAssetLendBorrowMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserAssetLendBorrowMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReserveBal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserAssetLendBorrowMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReserveBal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundReserveBalance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5619,138 +10366,26 @@ func (m *UserAssetLendBorrowMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LendId", wireType)
-			}
-			m.LendId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LendId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BorrowId = append(m.BorrowId, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthLend
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthLend
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BorrowId) == 0 {
-					m.BorrowId = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BorrowId = append(m.BorrowId, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowId", wireType)
+			m.FundReserveBalance = append(m.FundReserveBalance, FundReserveBal{})
+			if err := m.FundReserveBalance[len(m.FundReserveBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLend(dAtA[iNdEx:])
@@ -5772,7 +10407,7 @@ func (m *UserAssetLendBorrowMapping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetDataPoolMapping) Unmarshal(dAtA []byte) error {
+func (m *FundModBal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5795,10 +10430,10 @@ func (m *AssetDataPoolMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetDataPoolMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundModBal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetDataPoolMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundModBal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5822,9 +10457,9 @@ func (m *AssetDataPoolMapping) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetTransitType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			m.AssetTransitType = 0
+			m.PoolID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5834,14 +10469,80 @@ func (m *AssetDataPoolMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetTransitType |= uint64(b&0x7F) << shift
+				m.PoolID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DepositTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5869,9 +10570,7 @@ func (m *AssetDataPoolMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5894,7 +10593,7 @@ func (m *AssetDataPoolMapping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Extended_Pair) Unmarshal(dAtA []byte) error {
+func (m *FundReserveBal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5917,17 +10616,17 @@ func (m *Extended_Pair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Extended_Pair: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundReserveBal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Extended_Pair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundReserveBal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
-			m.Id = 0
+			m.AssetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5937,16 +10636,16 @@ func (m *Extended_Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.AssetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetIn", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
 			}
-			m.AssetIn = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5956,16 +10655,30 @@ func (m *Extended_Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetIn |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetOut", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositTime", wireType)
 			}
-			m.AssetOut = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -5975,55 +10688,30 @@ func (m *Extended_Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetOut |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInterPool", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLend
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
 			}
-			m.IsInterPool = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetOutPoolID", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.AssetOutPoolID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetOutPoolID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DepositTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinUsdValueLeft", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
-			m.MinUsdValueLeft = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -6033,11 +10721,24 @@ func (m *Extended_Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinUsdValueLeft |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLend(dAtA[iNdEx:])
@@ -6059,7 +10760,7 @@ func (m *Extended_Pair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetToPairMapping) Unmarshal(dAtA []byte) error {
+func (m *AllReserveStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6082,17 +10783,17 @@ func (m *AssetToPairMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetToPairMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllReserveStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetToPairMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllReserveStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
-			m.PoolID = 0
+			m.AssetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -6102,16 +10803,16 @@ func (m *AssetToPairMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				m.AssetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOutFromReserveToLenders", wireType)
 			}
-			m.AssetID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -6121,87 +10822,162 @@ func (m *AssetToPairMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOutFromReserveToLenders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOutFromReserveForAuction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
 				}
-				m.PairID = append(m.PairID, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthLend
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthLend
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOutFromReserveForAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountInFromLiqPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
 				}
-				if postIndex > l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountInFromLiqPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountInFromRepayments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.PairID) == 0 {
-					m.PairID = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountInFromRepayments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmountOutToLenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PairID = append(m.PairID, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairID", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if err := m.TotalAmountOutToLenders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLend(dAtA[iNdEx:])
@@ -6223,7 +10999,7 @@ func (m *AssetToPairMapping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
+func (m *AssetToPairSingleMapping) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6246,10 +11022,10 @@ func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAssetLBMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetToPairSingleMapping: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAssetLBMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetToPairSingleMapping: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6268,185 +11044,14 @@ func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
 				iNdEx++
 				m.PoolID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
-			}
-			m.AssetID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.LendIds = append(m.LendIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthLend
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthLend
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.LendIds) == 0 {
-					m.LendIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.LendIds = append(m.LendIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field LendIds", wireType)
-			}
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BorrowIds = append(m.BorrowIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthLend
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthLend
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BorrowIds) == 0 {
-					m.BorrowIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BorrowIds = append(m.BorrowIds, v)
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowIds", wireType)
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBorrowed", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
-			var stringLen uint64
+			m.AssetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -6456,29 +11061,102 @@ func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AssetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairID", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.PairID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLend
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalBorrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
 			}
-			iNdEx = postIndex
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStableBorrowed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6506,13 +11184,11 @@ func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalStableBorrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPoolName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6540,15 +11216,13 @@ func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CPoolName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalInterestAccumulated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetData", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -6558,31 +11232,31 @@ func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalInterestAccumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AssetData = append(m.AssetData, &AssetDataPoolMapping{})
+			if err := m.AssetData[len(m.AssetData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LendApr", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUsdValueLeft", wireType)
 			}
-			var stringLen uint64
+			m.MinUsdValueLeft = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -6592,29 +11266,83 @@ func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinUsdValueLeft |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLend
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LendApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolInterestData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
 			}
-			iNdEx = postIndex
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolInterestData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolInterestData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			m.AssetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowApr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LendInterest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6639,18 +11367,68 @@ func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LendInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolInterest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BorrowApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableBorrowApr", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolInterest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolInterest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			var stringLen uint64
+			m.PoolID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -6660,31 +11438,16 @@ func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StableBorrowApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UtilisationRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolInterestData", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -6694,23 +11457,23 @@ func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UtilisationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolInterestData = append(m.PoolInterestData, PoolInterestData{})
+			if err := m.PoolInterestData[len(m.PoolInterestData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6735,7 +11498,7 @@ func (m *PoolAssetLBMapping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
+func (m *PoolInterestDataB) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6758,10 +11521,10 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetRatesParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolInterestDataB: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetRatesParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolInterestDataB: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6785,7 +11548,7 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UOptimal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowInterest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6813,49 +11576,65 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UOptimal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BorrowInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolInterestB) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slope1", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolInterestB: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolInterestB: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			var stringLen uint64
+			m.PoolID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -6865,31 +11644,16 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Slope1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slope2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolInterestData", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -6899,51 +11663,81 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Slope2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolInterestData = append(m.PoolInterestData, PoolInterestDataB{})
+			if err := m.PoolInterestData[len(m.PoolInterestData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStableBorrow", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
 			}
-			m.EnableStableBorrow = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableBase", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetRatesPoolPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetRatesPoolPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
-			var stringLen uint64
+			m.AssetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -6953,29 +11747,14 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AssetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StableBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableSlope1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UOptimal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7003,13 +11782,13 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StableSlope1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UOptimal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableSlope2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7037,13 +11816,13 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StableSlope2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slope1", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7071,13 +11850,13 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Ltv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Slope1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slope2", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7105,15 +11884,15 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Slope2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStableBorrow", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -7123,29 +11902,15 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+			m.EnableStableBorrow = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationBonus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableBase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7173,13 +11938,13 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StableBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSlope1", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7207,101 +11972,13 @@ func (m *AssetRatesParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StableSlope1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CAssetID", wireType)
-			}
-			m.CAssetID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CAssetID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReserveBuybackAssetData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReserveBuybackAssetData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReserveBuybackAssetData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
-			}
-			m.AssetID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSlope2", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7329,13 +12006,13 @@ func (m *ReserveBuybackAssetData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StableSlope2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuybackAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7363,84 +12040,15 @@ func (m *ReserveBuybackAssetData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BuybackAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ltv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AuctionParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AuctionParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuctionParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
-			}
-			m.AppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionDurationSeconds", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
 			}
-			m.AuctionDurationSeconds = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -7450,14 +12058,29 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionDurationSeconds |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7485,13 +12108,13 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Buffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cusp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationBonus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7519,13 +12142,13 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Cusp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7553,15 +12176,15 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Step.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceFunctionType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CAssetID", wireType)
 			}
-			m.PriceFunctionType = 0
+			m.CAssetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -7571,16 +12194,16 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PriceFunctionType |= uint64(b&0x7F) << shift
+				m.CAssetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DutchId", wireType)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
-			m.DutchId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -7590,16 +12213,29 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DutchId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidDurationSeconds", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
 			}
-			m.BidDurationSeconds = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPoolName", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -7609,66 +12245,63 @@ func (m *AuctionParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BidDurationSeconds |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLend(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BorrowInterestTracker) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLend
+			m.CPoolName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetData", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AssetData = append(m.AssetData, &AssetDataPoolMapping{})
+			if err := m.AssetData[len(m.AssetData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Borrow_interest_tracker: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Borrow_interest_tracker: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 19:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUsdValueLeft", wireType)
 			}
-			m.BorrowingId = 0
+			m.MinUsdValueLeft = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -7678,14 +12311,33 @@ func (m *BorrowInterestTracker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BorrowingId |= uint64(b&0x7F) << shift
+				m.MinUsdValueLeft |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModelID", wireType)
+			}
+			m.InterestRateModelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterestRateModelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservePoolInterest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7713,82 +12365,13 @@ func (m *BorrowInterestTracker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReservePoolInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LendRewardsTracker) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Lend_rewards_tracker: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Lend_rewards_tracker: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LendingId", wireType)
-			}
-			m.LendingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LendingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.RateCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 2:
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAccumulated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentSpeed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7816,7 +12399,7 @@ func (m *LendRewardsTracker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardsAccumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AdjustmentSpeed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7841,7 +12424,7 @@ func (m *LendRewardsTracker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleBalance) Unmarshal(dAtA []byte) error {
+func (m *PositionManagerGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7864,17 +12447,17 @@ func (m *ModuleBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionManagerGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionManagerGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.PoolID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -7884,16 +12467,29 @@ func (m *ModuleBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalanceStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -7903,26 +12499,93 @@ func (m *ModuleBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleBalanceStats = append(m.ModuleBalanceStats, ModuleBalanceStats{})
-			if err := m.ModuleBalanceStats[len(m.ModuleBalanceStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v PositionPermission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PositionPermission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLend
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLend
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]PositionPermission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PositionPermission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PositionPermission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLend(dAtA[iNdEx:])
@@ -7944,7 +12607,7 @@ func (m *ModuleBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleBalanceStats) Unmarshal(dAtA []byte) error {
+func (m *EModeCategory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7967,17 +12630,17 @@ func (m *ModuleBalanceStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleBalanceStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: EModeCategory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleBalanceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EModeCategory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.AssetID = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -7987,16 +12650,16 @@ func (m *ModuleBalanceStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetID |= uint64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -8006,80 +12669,105 @@ func (m *ModuleBalanceStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ModBal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AssetIDs = append(m.AssetIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLend
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLend
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AssetIDs) == 0 {
+					m.AssetIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AssetIDs = append(m.AssetIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetIDs", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ModBal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModBal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundModuleBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -8089,81 +12777,31 @@ func (m *ModBal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundModuleBalance = append(m.FundModuleBalance, FundModBal{})
-			if err := m.FundModuleBalance[len(m.FundModuleBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ltv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReserveBal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReserveBal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReserveBal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundReserveBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -8173,23 +12811,23 @@ func (m *ReserveBal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundReserveBalance = append(m.FundReserveBalance, FundReserveBal{})
-			if err := m.FundReserveBalance[len(m.FundReserveBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8214,7 +12852,7 @@ func (m *ReserveBal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundModBal) Unmarshal(dAtA []byte) error {
+func (m *AdaptiveRateState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8237,17 +12875,17 @@ func (m *FundModBal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundModBal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdaptiveRateState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundModBal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdaptiveRateState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			m.AssetID = 0
+			m.PoolID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -8257,16 +12895,16 @@ func (m *FundModBal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetID |= uint64(b&0x7F) << shift
+				m.PoolID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
-			m.PoolID = 0
+			m.AssetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -8276,16 +12914,16 @@ func (m *FundModBal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				m.AssetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -8295,28 +12933,29 @@ func (m *FundModBal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8343,42 +12982,10 @@ func (m *FundModBal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DepositTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdated, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Funder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLend(dAtA[iNdEx:])
@@ -8400,7 +13007,7 @@ func (m *FundModBal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundReserveBal) Unmarshal(dAtA []byte) error {
+func (m *BadDebtWaterfall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8423,129 +13030,88 @@ func (m *FundReserveBal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundReserveBal: wiretype end group for non-group")
+			return fmt.Errorf("proto: BadDebtWaterfall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundReserveBal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BadDebtWaterfall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
-			}
-			m.AssetID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Steps = append(m.Steps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthLend
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLend
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DepositTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.Steps) == 0 {
+					m.Steps = make([]uint64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Steps = append(m.Steps, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Funder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLend(dAtA[iNdEx:])
@@ -8567,7 +13133,7 @@ func (m *FundReserveBal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllReserveStats) Unmarshal(dAtA []byte) error {
+func (m *CTokenExchangeRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8590,17 +13156,17 @@ func (m *AllReserveStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllReserveStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: CTokenExchangeRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllReserveStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CTokenExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			m.AssetID = 0
+			m.PoolID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -8610,16 +13176,16 @@ func (m *AllReserveStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetID |= uint64(b&0x7F) << shift
+				m.PoolID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountOutFromReserveToLenders", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
-			var stringLen uint64
+			m.AssetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -8629,131 +13195,14 @@ func (m *AllReserveStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AssetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AmountOutFromReserveToLenders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountOutFromReserveForAuction", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AmountOutFromReserveForAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountInFromLiqPenalty", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AmountInFromLiqPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountInFromRepayments", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AmountInFromRepayments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmountOutToLenders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8781,7 +13230,7 @@ func (m *AllReserveStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAmountOutToLenders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8806,7 +13255,7 @@ func (m *AllReserveStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetToPairSingleMapping) Unmarshal(dAtA []byte) error {
+func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8829,17 +13278,17 @@ func (m *AssetToPairSingleMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetToPairSingleMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetToPairSingleMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LendID", wireType)
 			}
-			m.PoolID = 0
+			m.LendID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -8849,7 +13298,7 @@ func (m *AssetToPairSingleMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				m.LendID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8874,10 +13323,10 @@ func (m *AssetToPairSingleMapping) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.PairID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -8887,11 +13336,25 @@ func (m *AssetToPairSingleMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PairID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLend(dAtA[iNdEx:])
@@ -8913,7 +13376,7 @@ func (m *AssetToPairSingleMapping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolPairs) Unmarshal(dAtA []byte) error {
+func (m *AccountDebt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8936,17 +13399,17 @@ func (m *PoolPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountDebt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountDebt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
-			m.PoolID = 0
+			m.AssetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -8956,14 +13419,47 @@ func (m *PoolPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				m.AssetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestAccumulated", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8991,11 +13487,13 @@ func (m *PoolPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			if err := m.InterestAccumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPoolName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveInterest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9023,11 +13521,13 @@ func (m *PoolPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CPoolName = string(dAtA[iNdEx:postIndex])
+			if err := m.ReserveInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInteractionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9054,30 +13554,10 @@ func (m *PoolPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetData = append(m.AssetData, &AssetDataPoolMapping{})
-			if err := m.AssetData[len(m.AssetData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastInteractionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinUsdValueLeft", wireType)
-			}
-			m.MinUsdValueLeft = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinUsdValueLeft |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLend(dAtA[iNdEx:])
@@ -9099,7 +13579,7 @@ func (m *PoolPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolInterestData) Unmarshal(dAtA []byte) error {
+func (m *CrossCollateralAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9122,17 +13602,17 @@ func (m *PoolInterestData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolInterestData: wiretype end group for non-group")
+			return fmt.Errorf("proto: CrossCollateralAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolInterestData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CrossCollateralAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.AssetID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9142,16 +13622,67 @@ func (m *PoolInterestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			m.AppID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LendInterest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9161,23 +13692,57 @@ func (m *PoolInterestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LendInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Collateral = append(m.Collateral, AccountCollateral{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Debts = append(m.Debts, AccountDebt{})
+			if err := m.Debts[len(m.Debts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9202,7 +13767,7 @@ func (m *PoolInterestData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolInterest) Unmarshal(dAtA []byte) error {
+func (m *AccountHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9225,17 +13790,17 @@ func (m *PoolInterest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolInterest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolInterest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralValue", wireType)
 			}
-			m.PoolID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9245,16 +13810,31 @@ func (m *PoolInterest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolInterestData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9264,81 +13844,65 @@ func (m *PoolInterest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolInterestData = append(m.PoolInterestData, PoolInterestData{})
-			if err := m.PoolInterestData[len(m.PoolInterestData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BorrowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLend(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationLimit", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLend
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PoolInterestDataB) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLend
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LiquidationLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PoolInterestDataB: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolInterestDataB: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtValue", wireType)
 			}
-			m.AssetID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9348,14 +13912,29 @@ func (m *PoolInterestDataB) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowInterest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9383,7 +13962,7 @@ func (m *PoolInterestDataB) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BorrowInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HealthFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9408,7 +13987,7 @@ func (m *PoolInterestDataB) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolInterestB) Unmarshal(dAtA []byte) error {
+func (m *FixedTermMarket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9431,13 +14010,51 @@ func (m *PoolInterestB) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolInterestB: wiretype end group for non-group")
+			return fmt.Errorf("proto: FixedTermMarket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolInterestB: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FixedTermMarket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			m.AppID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
@@ -9456,9 +14073,28 @@ func (m *PoolInterestB) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			m.AssetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolInterestData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maturity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9485,66 +14121,49 @@ func (m *PoolInterestB) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolInterestData = append(m.PoolInterestData, PoolInterestDataB{})
-			if err := m.PoolInterestData[len(m.PoolInterestData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Maturity, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLend(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLend
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLend
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AssetRatesPoolPairs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetRatesPoolPairs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyRepaymentPenalty", wireType)
 			}
-			m.AssetID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9554,14 +14173,29 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyRepaymentPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UOptimal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCommitted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9589,13 +14223,13 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UOptimal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalCommitted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9623,13 +14257,13 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Available.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slope1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBorrowed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9657,13 +14291,13 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Slope1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalBorrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slope2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9691,15 +14325,65 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Slope2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shortfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FixedTermDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FixedTermDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FixedTermDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStableBorrow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var v int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9709,15 +14393,33 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableStableBorrow = bool(v != 0)
-		case 7:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			m.MarketID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9745,15 +14447,13 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StableBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableSlope1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9763,31 +14463,80 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StableSlope1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableSlope2", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FixedTermLoan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FixedTermLoan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FixedTermLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9797,31 +14546,16 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StableSlope2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
 			}
-			var stringLen uint64
+			m.MarketID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9831,29 +14565,14 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Ltv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9881,15 +14600,13 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendID", wireType)
 			}
-			var stringLen uint64
+			m.LendID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9899,31 +14616,16 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LendID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationBonus", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairID", wireType)
 			}
-			var stringLen uint64
+			m.PairID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9933,31 +14635,16 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PairID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLend
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidationBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -9967,31 +14654,30 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CAssetID", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
 			}
-			m.CAssetID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -10001,14 +14687,28 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CAssetID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10036,13 +14736,15 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 17:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPoolName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -10052,27 +14754,28 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLend
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CPoolName = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 18:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maturity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10099,16 +14802,15 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetData = append(m.AssetData, &AssetDataPoolMapping{})
-			if err := m.AssetData[len(m.AssetData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Maturity, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinUsdValueLeft", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestDue", wireType)
 			}
-			m.MinUsdValueLeft = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLend
@@ -10118,11 +14820,26 @@ func (m *AssetRatesPoolPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinUsdValueLeft |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestDue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLend(dAtA[iNdEx:])
@@ -99,7 +99,7 @@ func (m *AssetRatesParams) Validate() error {
 	if m.CAssetID == 0 {
 		return fmt.Errorf("cAssetID cannot be zero")
 	}
-	return nil
+	return validateInterestRateModel(*m)
 }
 
 func (m *PoolPairs) Validate() error {
@@ -161,5 +161,11 @@ func (m *AssetRatesPoolPairs) Validate() error {
 	if m.AssetData == nil {
 		return fmt.Errorf("AssetData cannot be nil")
 	}
-	return nil
+	return validateInterestRateModel(AssetRatesParams{
+		UOptimal:            m.UOptimal,
+		Base:                m.Base,
+		InterestRateModelID: m.InterestRateModelID,
+		RateCap:             m.RateCap,
+		AdjustmentSpeed:     m.AdjustmentSpeed,
+	})
 }
@@ -20,6 +20,14 @@ const (
 	CloseBorrowAssetGas           = sdk.Gas(22763)
 	BorrowAssetAlternateGas       = sdk.Gas(22763)
 	CalculateInterestAndRewardGas = sdk.Gas(22763)
+	AccountCollateralGas          = sdk.Gas(22763)
+	AccountBorrowGas              = sdk.Gas(22763)
+	AccountRepayGas               = sdk.Gas(22763)
+	LiquidateAccountGas           = sdk.Gas(32763)
+	FixedTermCommitGas            = sdk.Gas(22763)
+	FixedTermRedeemGas            = sdk.Gas(22763)
+	FixedTermBorrowGas            = sdk.Gas(32763)
+	FixedTermRepayGas             = sdk.Gas(22763)
 )
 
 const (
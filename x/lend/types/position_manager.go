@@ -0,0 +1,51 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ParsePositionPermissions parses a comma separated list of permissions such
+// as "deposit,repay,withdraw_to_owner".
+func ParsePositionPermissions(s string) ([]PositionPermission, error) {
+	var permissions []PositionPermission
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		p, ok := PositionPermission_value["POSITION_PERMISSION_"+name]
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrorInvalidPermissions, "unknown permission %s", name)
+		}
+		permissions = append(permissions, PositionPermission(p))
+	}
+	return permissions, nil
+}
+
+// ValidatePositionPermissions checks that permissions is a non-empty set of
+// known permissions.
+func ValidatePositionPermissions(permissions []PositionPermission) error {
+	if len(permissions) == 0 {
+		return sdkerrors.Wrap(ErrorInvalidPermissions, "permissions cannot be empty")
+	}
+	seen := map[PositionPermission]bool{}
+	for _, p := range permissions {
+		if _, ok := PositionPermission_name[int32(p)]; !ok || p == PositionPermissionUnspecified {
+			return sdkerrors.Wrapf(ErrorInvalidPermissions, "unknown permission %d", p)
+		}
+		if seen[p] {
+			return sdkerrors.Wrapf(ErrorInvalidPermissions, "duplicate permission %s", p)
+		}
+		seen[p] = true
+	}
+	return nil
+}
+
+// HasPermission returns whether the grant allows permission.
+func (g PositionManagerGrant) HasPermission(permission PositionPermission) bool {
+	for _, p := range g.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
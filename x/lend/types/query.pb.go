@@ -524,6 +524,8 @@ var xxx_messageInfo_QueryPairRequest proto.InternalMessageInfo
 
 type QueryPairResponse struct {
 	ExtendedPair Extended_Pair `protobuf:"bytes,1,opt,name=ExtendedPair,proto3" json:"ExtendedPair" yaml:"extended_pair"`
+	// e_mode_category is the category shared by the assets of the pair, if any.
+	EModeCategory *EModeCategory `protobuf:"bytes,2,opt,name=e_mode_category,json=eModeCategory,proto3" json:"e_mode_category,omitempty" yaml:"e_mode_category"`
 }
 
 func (m *QueryPairResponse) Reset()         { *m = QueryPairResponse{} }
@@ -2047,2022 +2049,2140 @@ func (m *QueryBorrowInterestResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBorrowInterestResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "comdex.lend.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "comdex.lend.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryLendsRequest)(nil), "comdex.lend.v1beta1.QueryLendsRequest")
-	proto.RegisterType((*QueryLendsResponse)(nil), "comdex.lend.v1beta1.QueryLendsResponse")
-	proto.RegisterType((*QueryLendRequest)(nil), "comdex.lend.v1beta1.QueryLendRequest")
-	proto.RegisterType((*QueryLendResponse)(nil), "comdex.lend.v1beta1.QueryLendResponse")
-	proto.RegisterType((*QueryAllLendByOwnerRequest)(nil), "comdex.lend.v1beta1.QueryAllLendByOwnerRequest")
-	proto.RegisterType((*QueryAllLendByOwnerResponse)(nil), "comdex.lend.v1beta1.QueryAllLendByOwnerResponse")
-	proto.RegisterType((*QueryAllLendByOwnerAndPoolRequest)(nil), "comdex.lend.v1beta1.QueryAllLendByOwnerAndPoolRequest")
-	proto.RegisterType((*QueryAllLendByOwnerAndPoolResponse)(nil), "comdex.lend.v1beta1.QueryAllLendByOwnerAndPoolResponse")
-	proto.RegisterType((*QueryPairsRequest)(nil), "comdex.lend.v1beta1.QueryPairsRequest")
-	proto.RegisterType((*QueryPairsResponse)(nil), "comdex.lend.v1beta1.QueryPairsResponse")
-	proto.RegisterType((*QueryPairRequest)(nil), "comdex.lend.v1beta1.QueryPairRequest")
-	proto.RegisterType((*QueryPairResponse)(nil), "comdex.lend.v1beta1.QueryPairResponse")
-	proto.RegisterType((*QueryAssetRatesParamsRequest)(nil), "comdex.lend.v1beta1.QueryAssetRatesParamsRequest")
-	proto.RegisterType((*QueryAssetRatesParamsResponse)(nil), "comdex.lend.v1beta1.QueryAssetRatesParamsResponse")
-	proto.RegisterType((*QueryAssetRatesParamRequest)(nil), "comdex.lend.v1beta1.QueryAssetRatesParamRequest")
-	proto.RegisterType((*QueryAssetRatesParamResponse)(nil), "comdex.lend.v1beta1.QueryAssetRatesParamResponse")
-	proto.RegisterType((*QueryPoolsRequest)(nil), "comdex.lend.v1beta1.QueryPoolsRequest")
-	proto.RegisterType((*QueryPoolsResponse)(nil), "comdex.lend.v1beta1.QueryPoolsResponse")
-	proto.RegisterType((*QueryPoolRequest)(nil), "comdex.lend.v1beta1.QueryPoolRequest")
-	proto.RegisterType((*QueryPoolResponse)(nil), "comdex.lend.v1beta1.QueryPoolResponse")
-	proto.RegisterType((*QueryAssetToPairMappingsRequest)(nil), "comdex.lend.v1beta1.QueryAssetToPairMappingsRequest")
-	proto.RegisterType((*QueryAssetToPairMappingsResponse)(nil), "comdex.lend.v1beta1.QueryAssetToPairMappingsResponse")
-	proto.RegisterType((*QueryAssetToPairMappingRequest)(nil), "comdex.lend.v1beta1.QueryAssetToPairMappingRequest")
-	proto.RegisterType((*QueryAssetToPairMappingResponse)(nil), "comdex.lend.v1beta1.QueryAssetToPairMappingResponse")
-	proto.RegisterType((*QueryBorrowsRequest)(nil), "comdex.lend.v1beta1.QueryBorrowsRequest")
-	proto.RegisterType((*QueryBorrowsResponse)(nil), "comdex.lend.v1beta1.QueryBorrowsResponse")
-	proto.RegisterType((*QueryBorrowRequest)(nil), "comdex.lend.v1beta1.QueryBorrowRequest")
-	proto.RegisterType((*QueryBorrowResponse)(nil), "comdex.lend.v1beta1.QueryBorrowResponse")
-	proto.RegisterType((*QueryAllBorrowByOwnerRequest)(nil), "comdex.lend.v1beta1.QueryAllBorrowByOwnerRequest")
-	proto.RegisterType((*QueryAllBorrowByOwnerResponse)(nil), "comdex.lend.v1beta1.QueryAllBorrowByOwnerResponse")
-	proto.RegisterType((*QueryAllBorrowByOwnerAndPoolRequest)(nil), "comdex.lend.v1beta1.QueryAllBorrowByOwnerAndPoolRequest")
-	proto.RegisterType((*QueryAllBorrowByOwnerAndPoolResponse)(nil), "comdex.lend.v1beta1.QueryAllBorrowByOwnerAndPoolResponse")
-	proto.RegisterType((*QueryPoolAssetLBMappingRequest)(nil), "comdex.lend.v1beta1.QueryPoolAssetLBMappingRequest")
-	proto.RegisterType((*QueryPoolAssetLBMappingResponse)(nil), "comdex.lend.v1beta1.QueryPoolAssetLBMappingResponse")
-	proto.RegisterType((*QueryReserveBuybackAssetDataRequest)(nil), "comdex.lend.v1beta1.QueryReserveBuybackAssetDataRequest")
-	proto.RegisterType((*QueryReserveBuybackAssetDataResponse)(nil), "comdex.lend.v1beta1.QueryReserveBuybackAssetDataResponse")
-	proto.RegisterType((*QueryAuctionParamRequest)(nil), "comdex.lend.v1beta1.QueryAuctionParamRequest")
-	proto.RegisterType((*QueryAuctionParamResponse)(nil), "comdex.lend.v1beta1.QueryAuctionParamResponse")
-	proto.RegisterType((*QueryModuleBalanceRequest)(nil), "comdex.lend.v1beta1.QueryModuleBalanceRequest")
-	proto.RegisterType((*QueryModuleBalanceResponse)(nil), "comdex.lend.v1beta1.QueryModuleBalanceResponse")
-	proto.RegisterType((*QueryFundModBalRequest)(nil), "comdex.lend.v1beta1.QueryFundModBalRequest")
-	proto.RegisterType((*QueryFundModBalResponse)(nil), "comdex.lend.v1beta1.QueryFundModBalResponse")
-	proto.RegisterType((*QueryFundReserveBalRequest)(nil), "comdex.lend.v1beta1.QueryFundReserveBalRequest")
-	proto.RegisterType((*QueryFundReserveBalResponse)(nil), "comdex.lend.v1beta1.QueryFundReserveBalResponse")
-	proto.RegisterType((*QueryAllReserveStatsRequest)(nil), "comdex.lend.v1beta1.QueryAllReserveStatsRequest")
-	proto.RegisterType((*QueryAllReserveStatsResponse)(nil), "comdex.lend.v1beta1.QueryAllReserveStatsResponse")
-	proto.RegisterType((*QueryFundModBalByAssetPoolRequest)(nil), "comdex.lend.v1beta1.QueryFundModBalByAssetPoolRequest")
-	proto.RegisterType((*QueryFundModBalByAssetPoolResponse)(nil), "comdex.lend.v1beta1.QueryFundModBalByAssetPoolResponse")
-	proto.RegisterType((*QueryLendInterestRequest)(nil), "comdex.lend.v1beta1.QueryLendInterestRequest")
-	proto.RegisterType((*QueryLendInterestResponse)(nil), "comdex.lend.v1beta1.QueryLendInterestResponse")
-	proto.RegisterType((*QueryBorrowInterestRequest)(nil), "comdex.lend.v1beta1.QueryBorrowInterestRequest")
-	proto.RegisterType((*QueryBorrowInterestResponse)(nil), "comdex.lend.v1beta1.QueryBorrowInterestResponse")
+type QueryEModeCategoriesRequest struct {
 }
 
-func init() { proto.RegisterFile("comdex/lend/v1beta1/query.proto", fileDescriptor_462bf3f1a3eff175) }
-
-var fileDescriptor_462bf3f1a3eff175 = []byte{
-	// 2336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xdd, 0x6f, 0x1d, 0x47,
-	0x15, 0xf7, 0xba, 0xb6, 0x4b, 0x27, 0x9f, 0x1d, 0xdb, 0x4d, 0xb2, 0x76, 0xee, 0xb5, 0x27, 0xfe,
-	0x88, 0x9b, 0xf8, 0x6e, 0x9c, 0xb4, 0x40, 0xaa, 0x0a, 0x92, 0x25, 0x69, 0x1b, 0x14, 0xab, 0x66,
-	0x41, 0x42, 0x42, 0xc0, 0xd5, 0x5e, 0xef, 0xc6, 0x5c, 0x75, 0xbd, 0x7b, 0x7b, 0x77, 0xdd, 0xd6,
-	0xb2, 0x2c, 0x52, 0x68, 0x1f, 0x10, 0x2f, 0x45, 0xe5, 0x05, 0x21, 0x21, 0x24, 0x04, 0xaa, 0x04,
-	0x08, 0x5e, 0xe0, 0x01, 0x78, 0xe0, 0x31, 0x42, 0x80, 0x82, 0xfa, 0x50, 0x0a, 0xc2, 0x82, 0x84,
-	0xbf, 0x20, 0x12, 0x2f, 0x3c, 0xa1, 0x99, 0x39, 0xb3, 0x9f, 0xb3, 0xb3, 0x7b, 0xab, 0xda, 0x10,
-	0x9e, 0x72, 0xb3, 0x33, 0xe7, 0xcc, 0xef, 0x77, 0xe6, 0xcc, 0x99, 0x99, 0xdf, 0x18, 0x35, 0xd7,
-	0x83, 0x4d, 0xc7, 0x7d, 0xcd, 0xf0, 0x5c, 0xdf, 0x31, 0x5e, 0x59, 0xe9, 0xb8, 0x91, 0xbd, 0x62,
-	0xbc, 0xbc, 0xe5, 0xf6, 0xb7, 0x5b, 0xbd, 0x7e, 0x10, 0x05, 0x78, 0x9c, 0x77, 0x68, 0xd1, 0x0e,
-	0x2d, 0xe8, 0xa0, 0x3f, 0xb9, 0x1e, 0x84, 0x9b, 0x41, 0x68, 0x74, 0xec, 0xd0, 0xe5, 0xbd, 0x63,
-	0xdb, 0x9e, 0xbd, 0xd1, 0xf5, 0xed, 0xa8, 0x1b, 0xf8, 0xdc, 0x81, 0x3e, 0xb1, 0x11, 0x6c, 0x04,
-	0xec, 0xa7, 0x41, 0x7f, 0xc1, 0xd7, 0xe9, 0x8d, 0x20, 0xd8, 0xf0, 0x5c, 0xc3, 0xee, 0x75, 0x0d,
-	0xdb, 0xf7, 0x83, 0x88, 0x99, 0x84, 0xd0, 0xda, 0x90, 0xa1, 0x62, 0x08, 0x78, 0xfb, 0x8c, 0xac,
-	0xbd, 0x67, 0xf7, 0xed, 0xcd, 0x94, 0x87, 0x04, 0xa1, 0xe8, 0xb1, 0x1e, 0x74, 0x01, 0x15, 0x99,
-	0x40, 0xf8, 0x33, 0x14, 0xf7, 0x1a, 0x33, 0xb2, 0xdc, 0x97, 0xb7, 0xdc, 0x30, 0x22, 0x6b, 0x68,
-	0x3c, 0xf3, 0x35, 0xec, 0x05, 0x7e, 0xe8, 0xe2, 0xcb, 0x68, 0x8c, 0x3b, 0x3f, 0xa9, 0xcd, 0x68,
-	0x67, 0x0f, 0x5d, 0x9c, 0x6a, 0x49, 0x82, 0xd2, 0xe2, 0x46, 0xe6, 0xc8, 0x9d, 0xbd, 0xe6, 0x90,
-	0x05, 0x06, 0xa4, 0x8f, 0x1e, 0x67, 0x1e, 0x6f, 0xba, 0xbe, 0x23, 0x86, 0xc1, 0x5f, 0x42, 0x28,
-	0x09, 0x13, 0xf8, 0x5c, 0x68, 0x71, 0xc4, 0x2d, 0x8a, 0xb8, 0xc5, 0x67, 0x20, 0xf1, 0xbc, 0xe1,
-	0x82, 0xad, 0x39, 0xf9, 0x60, 0xaf, 0xf9, 0xf8, 0xb6, 0xbd, 0xe9, 0x3d, 0x43, 0x12, 0x1f, 0xc4,
-	0x4a, 0x39, 0x24, 0xbf, 0xd5, 0x80, 0x1c, 0x0c, 0x0a, 0x2c, 0x3e, 0x8d, 0x46, 0x29, 0x5e, 0x4a,
-	0xe2, 0x91, 0xb3, 0x87, 0x2e, 0x36, 0xa4, 0x24, 0xa8, 0xc9, 0xd5, 0x30, 0x74, 0x23, 0x73, 0x82,
-	0xf2, 0x78, 0xb0, 0xd7, 0x3c, 0xcc, 0x07, 0x63, 0xa6, 0xc4, 0xe2, 0x2e, 0xf0, 0x97, 0x33, 0x0c,
-	0x86, 0x19, 0x83, 0xc5, 0x4a, 0x06, 0x1c, 0x48, 0x1d, 0x0a, 0x04, 0x1d, 0x8f, 0x19, 0x88, 0xa8,
-	0x1d, 0x45, 0xc3, 0x5d, 0x87, 0x45, 0x6b, 0xc4, 0x1a, 0xee, 0x3a, 0xe4, 0x8b, 0xa9, 0xd0, 0xc6,
-	0x24, 0x9f, 0x47, 0x23, 0x14, 0x21, 0x04, 0xb5, 0x8a, 0xe3, 0x38, 0x70, 0x3c, 0x94, 0x70, 0x24,
-	0x16, 0x73, 0x40, 0x7e, 0xa0, 0x21, 0x9d, 0xb9, 0xbf, 0xea, 0x79, 0xd4, 0xc0, 0xdc, 0x7e, 0xf1,
-	0x55, 0xdf, 0xed, 0x0b, 0x30, 0x0b, 0x68, 0x34, 0xa0, 0xff, 0x67, 0x03, 0x3d, 0x66, 0x1e, 0x4f,
-	0x02, 0xc5, 0x3e, 0x13, 0x8b, 0x37, 0xe7, 0xa6, 0x7a, 0xf8, 0xc3, 0x9e, 0xea, 0xdf, 0x6b, 0x68,
-	0x4a, 0x8a, 0x12, 0xc2, 0xb1, 0x3a, 0xd8, 0x9c, 0x9f, 0x80, 0x78, 0x1c, 0x4b, 0xe2, 0xd1, 0xee,
-	0x1e, 0xe0, 0xb4, 0xbf, 0xa7, 0xa1, 0x59, 0x09, 0x9d, 0xab, 0xbe, 0xb3, 0x16, 0x04, 0xde, 0xa0,
-	0xb1, 0x3f, 0x87, 0x1e, 0xed, 0x05, 0x81, 0xd7, 0xee, 0x3a, 0x0c, 0xea, 0x88, 0x89, 0x1f, 0xec,
-	0x35, 0x8f, 0x02, 0x02, 0xde, 0x40, 0xac, 0x31, 0xfa, 0xeb, 0x86, 0x93, 0x9b, 0xa8, 0x47, 0x3e,
-	0xec, 0x89, 0xba, 0xab, 0x21, 0xa2, 0x62, 0xf6, 0x10, 0xae, 0x51, 0x51, 0xda, 0xd6, 0xec, 0x6e,
-	0xff, 0xa0, 0x4a, 0xdb, 0xdf, 0xb4, 0xb8, 0x6e, 0xb3, 0x41, 0x21, 0x6c, 0x1b, 0xe8, 0x88, 0xfb,
-	0x5a, 0xe4, 0xfa, 0x8e, 0xeb, 0xb0, 0x06, 0x08, 0x1f, 0x91, 0x86, 0xef, 0x3a, 0xf4, 0x6c, 0xd3,
-	0xae, 0xe6, 0x69, 0x08, 0xe1, 0x24, 0x1f, 0x58, 0xb8, 0x69, 0xf7, 0xa8, 0x1f, 0x62, 0x65, 0xfd,
-	0x1e, 0x58, 0xdd, 0xa3, 0xa3, 0x95, 0xd5, 0xbd, 0xed, 0x54, 0xdc, 0xe3, 0x08, 0x38, 0xe8, 0xf0,
-	0xf5, 0x14, 0x52, 0x88, 0x7c, 0x9d, 0x00, 0x4c, 0x43, 0x00, 0x26, 0x24, 0x01, 0x20, 0x56, 0xc6,
-	0x2b, 0xd9, 0x45, 0xd3, 0x3c, 0x89, 0x69, 0xf6, 0x59, 0x76, 0xe4, 0x86, 0x99, 0xfd, 0x73, 0xbf,
-	0x67, 0xff, 0x5f, 0x1a, 0x3a, 0x5d, 0x32, 0x3e, 0x84, 0x21, 0x42, 0xc7, 0xf3, 0x6d, 0x90, 0x0b,
-	0xf3, 0xd2, 0x50, 0xe4, 0x3b, 0x9b, 0xb3, 0x10, 0x8d, 0x53, 0x1c, 0x89, 0x4d, 0xdb, 0xdb, 0x7d,
-	0xda, 0xa1, 0x0d, 0x3b, 0xba, 0x55, 0x18, 0x61, 0xdf, 0xb3, 0x62, 0x59, 0x14, 0xf9, 0xec, 0xc0,
-	0x65, 0x09, 0xf2, 0x6d, 0x4d, 0x3e, 0x4d, 0xf2, 0x28, 0x65, 0x4e, 0x36, 0xfb, 0x12, 0xa5, 0xdc,
-	0x51, 0x88, 0x16, 0xbc, 0x83, 0xca, 0x98, 0x5f, 0xc7, 0xf5, 0x82, 0x0f, 0x0a, 0x01, 0xb8, 0x8e,
-	0x46, 0x69, 0xd9, 0x17, 0xb9, 0x71, 0x4a, 0x7e, 0x9e, 0x0b, 0x02, 0x2f, 0x5f, 0x61, 0x99, 0x15,
-	0xb1, 0xb8, 0xf5, 0xc1, 0x55, 0x83, 0xd4, 0xe6, 0x97, 0x9f, 0xec, 0xcf, 0xa7, 0xa2, 0x1a, 0xf3,
-	0x33, 0xd1, 0x08, 0x45, 0x08, 0xf1, 0x54, 0xd0, 0xcb, 0x1d, 0x80, 0xa8, 0x11, 0xb1, 0x98, 0x2d,
-	0xb9, 0xad, 0xa1, 0x66, 0x92, 0x45, 0x9f, 0x0b, 0x68, 0x01, 0x58, 0xb5, 0x7b, 0xbd, 0xae, 0xbf,
-	0x71, 0x50, 0xb3, 0xf7, 0xe6, 0x30, 0x9a, 0x29, 0x87, 0x00, 0x5c, 0x6f, 0x6b, 0x68, 0xdc, 0x2e,
-	0xb6, 0xc3, 0xd4, 0x2e, 0x96, 0x27, 0x74, 0xa6, 0xbf, 0x39, 0x0f, 0x91, 0x38, 0x9d, 0x4e, 0xe9,
-	0x28, 0x60, 0x65, 0xb0, 0xbd, 0x09, 0x4e, 0x89, 0x25, 0x1b, 0x6a, 0xdf, 0xf3, 0x60, 0x17, 0x35,
-	0x4a, 0xc2, 0x20, 0x26, 0xa2, 0x85, 0x3e, 0xc2, 0x11, 0x8b, 0xdc, 0x30, 0xc7, 0x93, 0x63, 0x9c,
-	0x68, 0x21, 0xd6, 0xa3, 0xec, 0xe7, 0x0d, 0x67, 0xa0, 0xa3, 0x11, 0xf9, 0x7e, 0x79, 0x26, 0xc4,
-	0xb3, 0xb0, 0x8b, 0x70, 0xb1, 0x15, 0x32, 0xa2, 0xf6, 0x1c, 0xcc, 0xc1, 0x1c, 0x4c, 0x2b, 0xe6,
-	0x80, 0x58, 0x92, 0x81, 0x48, 0x04, 0x17, 0x37, 0x33, 0xe8, 0xf7, 0x83, 0x57, 0x0f, 0x2a, 0x3f,
-	0x7f, 0xa7, 0xa1, 0x89, 0xec, 0xb0, 0x10, 0x0d, 0x0b, 0x3d, 0xda, 0xe1, 0x9f, 0x20, 0x0d, 0x67,
-	0xa4, 0x21, 0xe0, 0x66, 0xfc, 0x28, 0xf7, 0x04, 0x70, 0x87, 0x49, 0x00, 0x73, 0x62, 0x09, 0x47,
-	0xfb, 0x9e, 0x64, 0x73, 0x50, 0x29, 0x39, 0xa8, 0xb2, 0x72, 0x73, 0x2b, 0x13, 0xe8, 0x98, 0xf0,
-	0x8b, 0x68, 0x8c, 0xe3, 0x84, 0x20, 0x57, 0xf3, 0x9d, 0x04, 0xbe, 0x47, 0xd2, 0x7c, 0x89, 0x05,
-	0x6e, 0xc8, 0x0f, 0xe3, 0x3d, 0xcc, 0xf3, 0xb8, 0xd9, 0xff, 0xe6, 0x05, 0xec, 0xdd, 0xf8, 0x48,
-	0x52, 0xc0, 0xf9, 0x10, 0xe7, 0xc2, 0xfb, 0x1a, 0x3a, 0x23, 0x65, 0xf5, 0x7f, 0x70, 0x13, 0xfb,
-	0x8b, 0x86, 0xe6, 0xd4, 0xdc, 0x1e, 0xe2, 0x89, 0x13, 0x3b, 0x05, 0x25, 0xc2, 0x20, 0xdd, 0x34,
-	0xff, 0x2b, 0x3b, 0x85, 0x6c, 0xfc, 0x64, 0xa7, 0x28, 0xb6, 0x2a, 0x77, 0x8a, 0x62, 0xf7, 0xfc,
-	0x4e, 0xc1, 0x80, 0x70, 0xf0, 0x5e, 0x27, 0xb5, 0x53, 0x14, 0x2d, 0xc9, 0x15, 0xc8, 0x6c, 0xcb,
-	0x0d, 0xdd, 0xfe, 0x2b, 0xae, 0xb9, 0xb5, 0xdd, 0xb1, 0xd7, 0x5f, 0x62, 0x9d, 0xae, 0xd9, 0x91,
-	0x2d, 0xc2, 0x74, 0x2a, 0x1f, 0xa6, 0x38, 0x22, 0xe4, 0x57, 0x22, 0x81, 0x4a, 0x5d, 0x00, 0xd3,
-	0x6f, 0x69, 0xe8, 0x44, 0x49, 0x1f, 0xe0, 0x7b, 0x5e, 0xca, 0xb7, 0xc4, 0xc6, 0x5c, 0x02, 0xd2,
-	0xb3, 0x9c, 0x74, 0x9f, 0x77, 0x6b, 0x77, 0x78, 0x3f, 0xe0, 0xef, 0xd8, 0x91, 0x4d, 0xac, 0xb2,
-	0x71, 0xc9, 0x0a, 0x3a, 0xc9, 0x93, 0x7f, 0x6b, 0x9d, 0x26, 0x4c, 0xe6, 0x1e, 0x31, 0x89, 0xc6,
-	0xec, 0x5e, 0x2f, 0x61, 0x3c, 0x6a, 0xf7, 0x7a, 0x37, 0x1c, 0xf2, 0x86, 0x86, 0x4e, 0x49, 0x6c,
-	0x92, 0xab, 0xb7, 0x9d, 0xfa, 0x1e, 0x2a, 0x6f, 0x9e, 0x69, 0x0f, 0x61, 0xfe, 0xea, 0x0d, 0x6e,
-	0xe2, 0x1b, 0x44, 0xd6, 0x2f, 0x79, 0x01, 0x50, 0xac, 0x06, 0xce, 0x96, 0xe7, 0x9a, 0xb6, 0x67,
-	0xfb, 0xeb, 0x62, 0xdd, 0xa7, 0xb3, 0x54, 0xab, 0xcc, 0xd2, 0x37, 0x85, 0xb4, 0x97, 0x73, 0x95,
-	0x30, 0xca, 0x34, 0x28, 0x19, 0x65, 0x7a, 0xe6, 0x19, 0x6d, 0xb2, 0xc6, 0x76, 0x87, 0xb7, 0x12,
-	0x2b, 0xeb, 0x97, 0x9c, 0x44, 0x4f, 0x30, 0x18, 0xcf, 0x6d, 0xf9, 0xce, 0x6a, 0xe0, 0x98, 0xb6,
-	0xa8, 0xab, 0xe4, 0xab, 0xe8, 0x44, 0xa1, 0x25, 0xbe, 0xe8, 0x1f, 0x4d, 0xbe, 0xa6, 0xe0, 0x4d,
-	0x95, 0xc1, 0x33, 0x6d, 0xcf, 0x6c, 0x02, 0xae, 0x13, 0x1c, 0xd7, 0xad, 0x2d, 0xdf, 0x69, 0x6f,
-	0x06, 0x4e, 0x82, 0x2c, 0xe7, 0x93, 0x4c, 0x43, 0x84, 0xe8, 0x67, 0x91, 0x4a, 0x09, 0xbc, 0xb7,
-	0x85, 0xea, 0x98, 0x6f, 0x8e, 0xef, 0x97, 0x38, 0xdb, 0x92, 0xc2, 0xd9, 0x54, 0xa6, 0xbc, 0xed,
-	0x99, 0x67, 0x00, 0xeb, 0x54, 0x0a, 0x6b, 0x9c, 0xea, 0x02, 0xaf, 0xc4, 0x3f, 0x59, 0x4d, 0xa4,
-	0x50, 0x68, 0xf9, 0x6c, 0x64, 0x47, 0xe1, 0x07, 0x2c, 0x7c, 0xe4, 0xad, 0xd4, 0x09, 0x24, 0xeb,
-	0x0f, 0x58, 0xf6, 0xd0, 0xb1, 0x5c, 0x13, 0x50, 0x9c, 0x93, 0xe7, 0x7e, 0xb6, 0xaf, 0x39, 0x03,
-	0x3c, 0x4f, 0x02, 0x02, 0xcf, 0x8b, 0x69, 0x86, 0xb4, 0x03, 0xb1, 0xf2, 0xee, 0xe9, 0x95, 0x6c,
-	0x36, 0x97, 0x17, 0x26, 0x3f, 0x94, 0xa7, 0x37, 0xe5, 0x7d, 0xad, 0xf0, 0xdf, 0x11, 0x3a, 0x66,
-	0x09, 0x04, 0x88, 0x4d, 0x88, 0xc6, 0xec, 0xcd, 0x60, 0xcb, 0x8f, 0x52, 0x57, 0xd0, 0x64, 0x8f,
-	0x13, 0x21, 0xf9, 0x54, 0xd0, 0xf5, 0xcd, 0x2b, 0xd9, 0x83, 0x20, 0x37, 0x23, 0xff, 0xde, 0x6b,
-	0x2e, 0x6e, 0x74, 0xa3, 0xaf, 0x6c, 0x75, 0x68, 0x30, 0x0d, 0x78, 0xcd, 0xe1, 0xff, 0x2c, 0x87,
-	0xce, 0x4b, 0x46, 0xb4, 0xdd, 0x73, 0x43, 0xe6, 0xc1, 0x82, 0xa1, 0x88, 0x0e, 0xb5, 0xed, 0xa6,
-	0xeb, 0x3b, 0x37, 0xfc, 0xc8, 0xed, 0xbb, 0x61, 0x24, 0x52, 0xf6, 0x75, 0x51, 0xc4, 0xb2, 0x8d,
-	0xf1, 0xa2, 0x3a, 0xc2, 0x99, 0x42, 0x03, 0x6c, 0xf8, 0xb3, 0xa5, 0xdb, 0x91, 0xf0, 0x90, 0x57,
-	0xcf, 0x32, 0x5e, 0x88, 0x75, 0xb8, 0x97, 0xea, 0x1b, 0x2f, 0x2a, 0x7e, 0x62, 0xc8, 0x23, 0x7c,
-	0x43, 0x2c, 0xaa, 0x7c, 0x33, 0x60, 0x74, 0xe5, 0x18, 0x49, 0x35, 0xc6, 0x41, 0x40, 0x5e, 0xbc,
-	0x3d, 0x87, 0x46, 0x19, 0x0c, 0xfc, 0xba, 0x86, 0x50, 0xf2, 0x8c, 0x84, 0x17, 0xa4, 0x03, 0x15,
-	0x1e, 0xb7, 0xf4, 0xc5, 0xca, 0x7e, 0x9c, 0x10, 0x21, 0x5f, 0x7b, 0xf7, 0x9f, 0x6f, 0x0f, 0x4f,
-	0x63, 0xdd, 0x28, 0x7b, 0xed, 0x0b, 0xf1, 0xd7, 0x35, 0xf4, 0x58, 0x6c, 0x8a, 0xe7, 0xd5, 0xae,
-	0x05, 0x82, 0x85, 0xaa, 0x6e, 0x00, 0x60, 0x91, 0x01, 0x98, 0xc5, 0xcd, 0x72, 0x00, 0xc6, 0x4e,
-	0xd7, 0xd9, 0xc5, 0x3f, 0xd5, 0xe0, 0xd6, 0x93, 0x15, 0xef, 0xb1, 0x51, 0x3e, 0x90, 0xf4, 0xd5,
-	0x48, 0xbf, 0x50, 0xdf, 0x00, 0x30, 0x5e, 0x62, 0x18, 0x97, 0xf1, 0xb9, 0x72, 0x8c, 0xed, 0xce,
-	0x76, 0x9b, 0x1d, 0xb3, 0x8d, 0x1d, 0xf6, 0xcf, 0x2e, 0xfe, 0x93, 0xfc, 0xed, 0x0a, 0x0e, 0xb8,
-	0xf8, 0xa3, 0x75, 0x51, 0x64, 0x4f, 0xfb, 0xfa, 0xc7, 0x06, 0xb6, 0x03, 0x12, 0x26, 0x23, 0xf1,
-	0x2c, 0x7e, 0xa6, 0x06, 0x89, 0x36, 0xcd, 0x46, 0xc1, 0xc4, 0xd8, 0x81, 0x02, 0xb4, 0x8b, 0x6f,
-	0x6b, 0x68, 0x0c, 0xe4, 0x56, 0x45, 0x86, 0x65, 0xe4, 0x68, 0xfd, 0x6c, 0x75, 0x47, 0x40, 0x78,
-	0x86, 0x21, 0x3c, 0x8d, 0xa7, 0x8c, 0xf2, 0x97, 0xe5, 0x64, 0x41, 0xf0, 0xb7, 0x80, 0x05, 0x95,
-	0xf7, 0xe4, 0x49, 0x44, 0x5f, 0xac, 0xec, 0x57, 0x6b, 0x41, 0xb0, 0x07, 0x89, 0x64, 0x41, 0x50,
-	0x53, 0xd5, 0x82, 0x48, 0xbd, 0x20, 0xe8, 0x0b, 0x55, 0xdd, 0x6a, 0x2d, 0x08, 0x06, 0x80, 0x2f,
-	0x88, 0x9f, 0x69, 0x68, 0x52, 0x2a, 0xc4, 0xe3, 0x15, 0x45, 0x8e, 0xc8, 0x1f, 0x0d, 0xf4, 0x8b,
-	0x83, 0x98, 0x00, 0x52, 0x83, 0x21, 0x5d, 0xc2, 0x8b, 0x52, 0xa4, 0x45, 0x3d, 0x1a, 0xff, 0x5c,
-	0x48, 0x35, 0x39, 0x97, 0xf8, 0x42, 0xed, 0xd1, 0x05, 0xde, 0x95, 0x01, 0x2c, 0x6a, 0xad, 0xe2,
-	0x02, 0x5c, 0x1e, 0xe4, 0x24, 0xdd, 0x98, 0xd8, 0xac, 0x9a, 0xc4, 0x94, 0xa2, 0xae, 0x4c, 0xb7,
-	0xb4, 0x08, 0x5e, 0x95, 0x6e, 0x6c, 0xd0, 0x24, 0xdd, 0x68, 0xe1, 0x98, 0x57, 0xbb, 0xae, 0x93,
-	0x6e, 0xe9, 0xb2, 0x50, 0x91, 0x6e, 0x14, 0x00, 0x8f, 0xc4, 0x6f, 0x34, 0x71, 0x6b, 0x91, 0x88,
-	0xaf, 0x4f, 0x55, 0x4c, 0x87, 0x54, 0xb9, 0xd6, 0x9f, 0x1e, 0xd0, 0x6a, 0x80, 0x89, 0xcc, 0x8b,
-	0xc6, 0xf8, 0x8f, 0x1a, 0x1c, 0xe7, 0x8b, 0x9e, 0xf1, 0xa5, 0x41, 0x70, 0x08, 0xf0, 0x4f, 0x0d,
-	0x66, 0x04, 0xd8, 0x5f, 0x60, 0xd8, 0x4d, 0x7c, 0x65, 0x00, 0xec, 0xc6, 0x8e, 0x38, 0x30, 0xa6,
-	0x6b, 0xf1, 0x37, 0x34, 0x74, 0x38, 0xad, 0x7b, 0x62, 0x45, 0xa1, 0xcd, 0x2a, 0xb2, 0xfa, 0x52,
-	0x8d, 0x9e, 0x80, 0x77, 0x8e, 0xe1, 0x6d, 0xe0, 0x69, 0x29, 0x5e, 0xa1, 0xa8, 0x7c, 0x53, 0x43,
-	0x87, 0x52, 0xe6, 0xaa, 0xcd, 0x21, 0xa3, 0x6c, 0xea, 0x67, 0xab, 0x3b, 0x02, 0x90, 0x25, 0x06,
-	0xe4, 0x0c, 0x9e, 0x55, 0x01, 0xe1, 0x99, 0xfa, 0x8b, 0xb8, 0x30, 0xe6, 0xc4, 0x25, 0x65, 0x61,
-	0x94, 0x4b, 0x9c, 0xca, 0xc2, 0x58, 0xa2, 0x36, 0x92, 0xa7, 0x19, 0x56, 0x03, 0x2f, 0xab, 0xb0,
-	0x16, 0x4f, 0x0c, 0xef, 0x97, 0xc9, 0xad, 0xe2, 0xcc, 0xf0, 0xf1, 0xfa, 0x58, 0x72, 0xa7, 0x86,
-	0xcb, 0x1f, 0xc0, 0x12, 0xc8, 0x5c, 0x63, 0x64, 0x3e, 0x81, 0x9f, 0xad, 0x45, 0xa6, 0xec, 0xe4,
-	0xf0, 0x07, 0xb1, 0xfc, 0x8a, 0x6a, 0x90, 0x6a, 0xf9, 0x95, 0x4a, 0x68, 0xaa, 0xe5, 0x57, 0xae,
-	0x7b, 0x91, 0xe7, 0x19, 0x99, 0xab, 0xf8, 0x93, 0xa5, 0xd5, 0xae, 0xa0, 0x60, 0xc9, 0x57, 0xdf,
-	0x7b, 0x62, 0xae, 0x4a, 0x34, 0x1e, 0xd5, 0x5c, 0xa9, 0x55, 0x2f, 0xd5, 0x5c, 0x55, 0x88, 0x5d,
-	0x15, 0x67, 0xbc, 0x72, 0xad, 0x2a, 0xc5, 0x11, 0xbf, 0x23, 0x5e, 0x6b, 0x33, 0x3a, 0x11, 0x5e,
-	0x56, 0x64, 0x50, 0x51, 0xc6, 0xd2, 0x5b, 0x75, 0xbb, 0xd7, 0xab, 0xe9, 0xdc, 0x84, 0x1f, 0x23,
-	0x8c, 0x1d, 0x2e, 0x90, 0xed, 0xe2, 0x9f, 0x08, 0xa8, 0x19, 0x49, 0x07, 0x2b, 0xc6, 0x96, 0xe9,
-	0x56, 0xba, 0x51, 0xbb, 0x7f, 0xad, 0xf5, 0x9d, 0x15, 0x9c, 0x52, 0x39, 0xf3, 0x5d, 0x0d, 0x1d,
-	0xcb, 0x5d, 0xdb, 0xf1, 0xb9, 0xf2, 0xb1, 0x0b, 0x8a, 0x94, 0x7e, 0xbe, 0x5e, 0x67, 0x40, 0xb9,
-	0xcc, 0x50, 0x2e, 0xe2, 0x79, 0x29, 0xca, 0xbc, 0xfc, 0x84, 0x7f, 0x2c, 0xee, 0x57, 0x59, 0x55,
-	0x47, 0x75, 0xbf, 0x92, 0x0a, 0x53, 0xaa, 0xfb, 0x95, 0x5c, 0xaa, 0x22, 0x2b, 0x0c, 0xe9, 0x39,
-	0xbc, 0x54, 0x8e, 0x34, 0x27, 0x3e, 0xe1, 0x5f, 0xc6, 0x47, 0xc9, 0xac, 0x3c, 0x83, 0xd5, 0xb7,
-	0x3b, 0x89, 0x26, 0xa5, 0xaf, 0x0c, 0x60, 0x01, 0x80, 0x2f, 0x33, 0xc0, 0x97, 0xf0, 0x8a, 0x3c,
-	0x5b, 0xf3, 0x2a, 0x52, 0x7a, 0x79, 0xfd, 0x55, 0x4b, 0xa9, 0x7a, 0x05, 0xed, 0x46, 0x75, 0x2d,
-	0x54, 0xe9, 0x4d, 0xaa, 0x6b, 0xa1, 0x52, 0x24, 0x22, 0x37, 0x19, 0x95, 0xe7, 0xf0, 0xb5, 0xca,
-	0x2c, 0xa1, 0x35, 0x9e, 0xf3, 0xe0, 0x35, 0x5e, 0x56, 0x16, 0xbf, 0xa7, 0xa5, 0xfe, 0x1e, 0x54,
-	0xc8, 0x19, 0xaa, 0xda, 0x21, 0x91, 0x89, 0x54, 0xb5, 0x43, 0x26, 0x1c, 0x91, 0x27, 0x19, 0x85,
-	0x39, 0x4c, 0x4a, 0x6f, 0xb6, 0xb1, 0xd0, 0x82, 0x7f, 0xa4, 0x65, 0xde, 0x4e, 0x63, 0x88, 0x46,
-	0xd5, 0x41, 0x24, 0x0f, 0xf2, 0x42, 0x7d, 0x03, 0x80, 0x79, 0x9e, 0xc1, 0x5c, 0xc0, 0x73, 0x8a,
-	0x8d, 0x34, 0x06, 0x6a, 0xae, 0xdd, 0xf9, 0x47, 0x63, 0xe8, 0x9d, 0x7b, 0x8d, 0xa1, 0x3b, 0xf7,
-	0x1a, 0xda, 0xdd, 0x7b, 0x0d, 0xed, 0xef, 0xf7, 0x1a, 0xda, 0x5b, 0xf7, 0x1b, 0x43, 0x77, 0xef,
-	0x37, 0x86, 0xfe, 0x7c, 0xbf, 0x31, 0xf4, 0x85, 0x56, 0x46, 0x9a, 0xa3, 0x1e, 0x97, 0x83, 0x5b,
-	0xb7, 0xba, 0xeb, 0x5d, 0xdb, 0x13, 0x23, 0xc0, 0x18, 0x4c, 0xa6, 0xeb, 0x8c, 0xb1, 0x3f, 0xba,
-	0xbe, 0xf4, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x36, 0x5e, 0x88, 0x6e, 0x2e, 0x00, 0x00,
+func (m *QueryEModeCategoriesRequest) Reset()         { *m = QueryEModeCategoriesRequest{} }
+func (m *QueryEModeCategoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEModeCategoriesRequest) ProtoMessage()    {}
+func (*QueryEModeCategoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{54}
+}
+func (m *QueryEModeCategoriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEModeCategoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEModeCategoriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEModeCategoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEModeCategoriesRequest.Merge(m, src)
+}
+func (m *QueryEModeCategoriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEModeCategoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEModeCategoriesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryEModeCategoriesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	QueryLends(ctx context.Context, in *QueryLendsRequest, opts ...grpc.CallOption) (*QueryLendsResponse, error)
-	QueryLend(ctx context.Context, in *QueryLendRequest, opts ...grpc.CallOption) (*QueryLendResponse, error)
-	QueryAllLendByOwner(ctx context.Context, in *QueryAllLendByOwnerRequest, opts ...grpc.CallOption) (*QueryAllLendByOwnerResponse, error)
-	QueryAllLendByOwnerAndPool(ctx context.Context, in *QueryAllLendByOwnerAndPoolRequest, opts ...grpc.CallOption) (*QueryAllLendByOwnerAndPoolResponse, error)
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	QueryPairs(ctx context.Context, in *QueryPairsRequest, opts ...grpc.CallOption) (*QueryPairsResponse, error)
-	QueryPair(ctx context.Context, in *QueryPairRequest, opts ...grpc.CallOption) (*QueryPairResponse, error)
-	QueryAssetRatesParams(ctx context.Context, in *QueryAssetRatesParamsRequest, opts ...grpc.CallOption) (*QueryAssetRatesParamsResponse, error)
-	QueryAssetRatesParam(ctx context.Context, in *QueryAssetRatesParamRequest, opts ...grpc.CallOption) (*QueryAssetRatesParamResponse, error)
-	QueryPools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
-	QueryPool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
-	QueryAssetToPairMappings(ctx context.Context, in *QueryAssetToPairMappingsRequest, opts ...grpc.CallOption) (*QueryAssetToPairMappingsResponse, error)
-	QueryAssetToPairMapping(ctx context.Context, in *QueryAssetToPairMappingRequest, opts ...grpc.CallOption) (*QueryAssetToPairMappingResponse, error)
-	QueryBorrows(ctx context.Context, in *QueryBorrowsRequest, opts ...grpc.CallOption) (*QueryBorrowsResponse, error)
-	QueryBorrow(ctx context.Context, in *QueryBorrowRequest, opts ...grpc.CallOption) (*QueryBorrowResponse, error)
-	QueryAllBorrowByOwner(ctx context.Context, in *QueryAllBorrowByOwnerRequest, opts ...grpc.CallOption) (*QueryAllBorrowByOwnerResponse, error)
-	QueryAllBorrowByOwnerAndPool(ctx context.Context, in *QueryAllBorrowByOwnerAndPoolRequest, opts ...grpc.CallOption) (*QueryAllBorrowByOwnerAndPoolResponse, error)
-	QueryPoolAssetLBMapping(ctx context.Context, in *QueryPoolAssetLBMappingRequest, opts ...grpc.CallOption) (*QueryPoolAssetLBMappingResponse, error)
-	QueryReserveBuybackAssetData(ctx context.Context, in *QueryReserveBuybackAssetDataRequest, opts ...grpc.CallOption) (*QueryReserveBuybackAssetDataResponse, error)
-	QueryAuctionParams(ctx context.Context, in *QueryAuctionParamRequest, opts ...grpc.CallOption) (*QueryAuctionParamResponse, error)
-	QueryModuleBalance(ctx context.Context, in *QueryModuleBalanceRequest, opts ...grpc.CallOption) (*QueryModuleBalanceResponse, error)
-	QueryFundModBal(ctx context.Context, in *QueryFundModBalRequest, opts ...grpc.CallOption) (*QueryFundModBalResponse, error)
-	QueryFundReserveBal(ctx context.Context, in *QueryFundReserveBalRequest, opts ...grpc.CallOption) (*QueryFundReserveBalResponse, error)
-	QueryAllReserveStats(ctx context.Context, in *QueryAllReserveStatsRequest, opts ...grpc.CallOption) (*QueryAllReserveStatsResponse, error)
-	QueryFundModBalByAssetPool(ctx context.Context, in *QueryFundModBalByAssetPoolRequest, opts ...grpc.CallOption) (*QueryFundModBalByAssetPoolResponse, error)
-	QueryLendInterest(ctx context.Context, in *QueryLendInterestRequest, opts ...grpc.CallOption) (*QueryLendInterestResponse, error)
-	QueryBorrowInterest(ctx context.Context, in *QueryBorrowInterestRequest, opts ...grpc.CallOption) (*QueryBorrowInterestResponse, error)
+type QueryEModeCategoriesResponse struct {
+	Categories []EModeCategory `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories" yaml:"categories"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryEModeCategoriesResponse) Reset()         { *m = QueryEModeCategoriesResponse{} }
+func (m *QueryEModeCategoriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEModeCategoriesResponse) ProtoMessage()    {}
+func (*QueryEModeCategoriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{55}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryEModeCategoriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) QueryLends(ctx context.Context, in *QueryLendsRequest, opts ...grpc.CallOption) (*QueryLendsResponse, error) {
-	out := new(QueryLendsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryLends", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEModeCategoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEModeCategoriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) QueryLend(ctx context.Context, in *QueryLendRequest, opts ...grpc.CallOption) (*QueryLendResponse, error) {
-	out := new(QueryLendResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryLend", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryEModeCategoriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEModeCategoriesResponse.Merge(m, src)
 }
-
-func (c *queryClient) QueryAllLendByOwner(ctx context.Context, in *QueryAllLendByOwnerRequest, opts ...grpc.CallOption) (*QueryAllLendByOwnerResponse, error) {
-	out := new(QueryAllLendByOwnerResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAllLendByOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryEModeCategoriesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) QueryAllLendByOwnerAndPool(ctx context.Context, in *QueryAllLendByOwnerAndPoolRequest, opts ...grpc.CallOption) (*QueryAllLendByOwnerAndPoolResponse, error) {
-	out := new(QueryAllLendByOwnerAndPoolResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAllLendByOwnerAndPool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryEModeCategoriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEModeCategoriesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryEModeCategoriesResponse proto.InternalMessageInfo
+
+type QueryInterestRateProjectionRequest struct {
+	PoolId      uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	AssetId     uint64                                 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	Utilisation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=utilisation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"utilisation" yaml:"utilisation"`
 }
 
-func (c *queryClient) QueryPairs(ctx context.Context, in *QueryPairsRequest, opts ...grpc.CallOption) (*QueryPairsResponse, error) {
-	out := new(QueryPairsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryPairs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryInterestRateProjectionRequest) Reset()         { *m = QueryInterestRateProjectionRequest{} }
+func (m *QueryInterestRateProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterestRateProjectionRequest) ProtoMessage()    {}
+func (*QueryInterestRateProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{56}
+}
+func (m *QueryInterestRateProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterestRateProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterestRateProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryInterestRateProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterestRateProjectionRequest.Merge(m, src)
+}
+func (m *QueryInterestRateProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterestRateProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterestRateProjectionRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryPair(ctx context.Context, in *QueryPairRequest, opts ...grpc.CallOption) (*QueryPairResponse, error) {
-	out := new(QueryPairResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryPair", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryInterestRateProjectionRequest proto.InternalMessageInfo
+
+// InterestRates are the rates of an asset in a pool at a utilisation.
+type InterestRates struct {
+	Utilisation     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=utilisation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"utilisation" yaml:"utilisation"`
+	LendApr         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=lend_apr,json=lendApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lend_apr" yaml:"lend_apr"`
+	BorrowApr       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=borrow_apr,json=borrowApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"borrow_apr" yaml:"borrow_apr"`
+	StableBorrowApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=stable_borrow_apr,json=stableBorrowApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stable_borrow_apr" yaml:"stable_borrow_apr"`
 }
 
-func (c *queryClient) QueryAssetRatesParams(ctx context.Context, in *QueryAssetRatesParamsRequest, opts ...grpc.CallOption) (*QueryAssetRatesParamsResponse, error) {
-	out := new(QueryAssetRatesParamsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAssetRatesParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *InterestRates) Reset()         { *m = InterestRates{} }
+func (m *InterestRates) String() string { return proto.CompactTextString(m) }
+func (*InterestRates) ProtoMessage()    {}
+func (*InterestRates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{57}
+}
+func (m *InterestRates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterestRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterestRates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *InterestRates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterestRates.Merge(m, src)
+}
+func (m *InterestRates) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterestRates) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterestRates.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryAssetRatesParam(ctx context.Context, in *QueryAssetRatesParamRequest, opts ...grpc.CallOption) (*QueryAssetRatesParamResponse, error) {
-	out := new(QueryAssetRatesParamResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAssetRatesParam", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_InterestRates proto.InternalMessageInfo
+
+type QueryInterestRateProjectionResponse struct {
+	InterestRateModelID uint64        `protobuf:"varint,1,opt,name=interest_rate_model_id,json=interestRateModelId,proto3" json:"interest_rate_model_id,omitempty" yaml:"interest_rate_model_id"`
+	Current             InterestRates `protobuf:"bytes,2,opt,name=current,proto3" json:"current" yaml:"current"`
+	Projected           InterestRates `protobuf:"bytes,3,opt,name=projected,proto3" json:"projected" yaml:"projected"`
 }
 
-func (c *queryClient) QueryPools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
-	out := new(QueryPoolsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryInterestRateProjectionResponse) Reset()         { *m = QueryInterestRateProjectionResponse{} }
+func (m *QueryInterestRateProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterestRateProjectionResponse) ProtoMessage()    {}
+func (*QueryInterestRateProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{58}
+}
+func (m *QueryInterestRateProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterestRateProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterestRateProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryInterestRateProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterestRateProjectionResponse.Merge(m, src)
+}
+func (m *QueryInterestRateProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterestRateProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterestRateProjectionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryPool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryPool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryInterestRateProjectionResponse proto.InternalMessageInfo
+
+type QueryBadDebtWaterfallRequest struct {
 }
 
-func (c *queryClient) QueryAssetToPairMappings(ctx context.Context, in *QueryAssetToPairMappingsRequest, opts ...grpc.CallOption) (*QueryAssetToPairMappingsResponse, error) {
-	out := new(QueryAssetToPairMappingsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAssetToPairMappings", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBadDebtWaterfallRequest) Reset()         { *m = QueryBadDebtWaterfallRequest{} }
+func (m *QueryBadDebtWaterfallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBadDebtWaterfallRequest) ProtoMessage()    {}
+func (*QueryBadDebtWaterfallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{59}
 }
-
-func (c *queryClient) QueryAssetToPairMapping(ctx context.Context, in *QueryAssetToPairMappingRequest, opts ...grpc.CallOption) (*QueryAssetToPairMappingResponse, error) {
-	out := new(QueryAssetToPairMappingResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAssetToPairMapping", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBadDebtWaterfallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) QueryBorrows(ctx context.Context, in *QueryBorrowsRequest, opts ...grpc.CallOption) (*QueryBorrowsResponse, error) {
-	out := new(QueryBorrowsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryBorrows", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBadDebtWaterfallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBadDebtWaterfallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) QueryBorrow(ctx context.Context, in *QueryBorrowRequest, opts ...grpc.CallOption) (*QueryBorrowResponse, error) {
-	out := new(QueryBorrowResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryBorrow", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBadDebtWaterfallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBadDebtWaterfallRequest.Merge(m, src)
 }
-
-func (c *queryClient) QueryAllBorrowByOwner(ctx context.Context, in *QueryAllBorrowByOwnerRequest, opts ...grpc.CallOption) (*QueryAllBorrowByOwnerResponse, error) {
-	out := new(QueryAllBorrowByOwnerResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAllBorrowByOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBadDebtWaterfallRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) QueryAllBorrowByOwnerAndPool(ctx context.Context, in *QueryAllBorrowByOwnerAndPoolRequest, opts ...grpc.CallOption) (*QueryAllBorrowByOwnerAndPoolResponse, error) {
-	out := new(QueryAllBorrowByOwnerAndPoolResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAllBorrowByOwnerAndPool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBadDebtWaterfallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBadDebtWaterfallRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryPoolAssetLBMapping(ctx context.Context, in *QueryPoolAssetLBMappingRequest, opts ...grpc.CallOption) (*QueryPoolAssetLBMappingResponse, error) {
-	out := new(QueryPoolAssetLBMappingResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryPoolAssetLBMapping", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryBadDebtWaterfallRequest proto.InternalMessageInfo
 
-func (c *queryClient) QueryReserveBuybackAssetData(ctx context.Context, in *QueryReserveBuybackAssetDataRequest, opts ...grpc.CallOption) (*QueryReserveBuybackAssetDataResponse, error) {
-	out := new(QueryReserveBuybackAssetDataResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryReserveBuybackAssetData", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryBadDebtWaterfallResponse struct {
+	Waterfall     BadDebtWaterfall     `protobuf:"bytes,1,opt,name=waterfall,proto3" json:"waterfall" yaml:"waterfall"`
+	ExchangeRates []CTokenExchangeRate `protobuf:"bytes,2,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates" yaml:"exchange_rates"`
 }
 
-func (c *queryClient) QueryAuctionParams(ctx context.Context, in *QueryAuctionParamRequest, opts ...grpc.CallOption) (*QueryAuctionParamResponse, error) {
-	out := new(QueryAuctionParamResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAuctionParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBadDebtWaterfallResponse) Reset()         { *m = QueryBadDebtWaterfallResponse{} }
+func (m *QueryBadDebtWaterfallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBadDebtWaterfallResponse) ProtoMessage()    {}
+func (*QueryBadDebtWaterfallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{60}
 }
-
-func (c *queryClient) QueryModuleBalance(ctx context.Context, in *QueryModuleBalanceRequest, opts ...grpc.CallOption) (*QueryModuleBalanceResponse, error) {
-	out := new(QueryModuleBalanceResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryModuleBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBadDebtWaterfallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) QueryFundModBal(ctx context.Context, in *QueryFundModBalRequest, opts ...grpc.CallOption) (*QueryFundModBalResponse, error) {
-	out := new(QueryFundModBalResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryFundModBal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBadDebtWaterfallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBadDebtWaterfallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) QueryFundReserveBal(ctx context.Context, in *QueryFundReserveBalRequest, opts ...grpc.CallOption) (*QueryFundReserveBalResponse, error) {
-	out := new(QueryFundReserveBalResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryFundReserveBal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBadDebtWaterfallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBadDebtWaterfallResponse.Merge(m, src)
 }
-
-func (c *queryClient) QueryAllReserveStats(ctx context.Context, in *QueryAllReserveStatsRequest, opts ...grpc.CallOption) (*QueryAllReserveStatsResponse, error) {
-	out := new(QueryAllReserveStatsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAllReserveStats", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBadDebtWaterfallResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) QueryFundModBalByAssetPool(ctx context.Context, in *QueryFundModBalByAssetPoolRequest, opts ...grpc.CallOption) (*QueryFundModBalByAssetPoolResponse, error) {
-	out := new(QueryFundModBalByAssetPoolResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryFundModBalByAssetPool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBadDebtWaterfallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBadDebtWaterfallResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryLendInterest(ctx context.Context, in *QueryLendInterestRequest, opts ...grpc.CallOption) (*QueryLendInterestResponse, error) {
-	out := new(QueryLendInterestResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryLendInterest", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryBadDebtWaterfallResponse proto.InternalMessageInfo
 
-func (c *queryClient) QueryBorrowInterest(ctx context.Context, in *QueryBorrowInterestRequest, opts ...grpc.CallOption) (*QueryBorrowInterestResponse, error) {
-	out := new(QueryBorrowInterestResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryBorrowInterest", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryCrossCollateralAccountRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	QueryLends(context.Context, *QueryLendsRequest) (*QueryLendsResponse, error)
-	QueryLend(context.Context, *QueryLendRequest) (*QueryLendResponse, error)
-	QueryAllLendByOwner(context.Context, *QueryAllLendByOwnerRequest) (*QueryAllLendByOwnerResponse, error)
-	QueryAllLendByOwnerAndPool(context.Context, *QueryAllLendByOwnerAndPoolRequest) (*QueryAllLendByOwnerAndPoolResponse, error)
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	QueryPairs(context.Context, *QueryPairsRequest) (*QueryPairsResponse, error)
-	QueryPair(context.Context, *QueryPairRequest) (*QueryPairResponse, error)
-	QueryAssetRatesParams(context.Context, *QueryAssetRatesParamsRequest) (*QueryAssetRatesParamsResponse, error)
-	QueryAssetRatesParam(context.Context, *QueryAssetRatesParamRequest) (*QueryAssetRatesParamResponse, error)
-	QueryPools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
-	QueryPool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
-	QueryAssetToPairMappings(context.Context, *QueryAssetToPairMappingsRequest) (*QueryAssetToPairMappingsResponse, error)
-	QueryAssetToPairMapping(context.Context, *QueryAssetToPairMappingRequest) (*QueryAssetToPairMappingResponse, error)
-	QueryBorrows(context.Context, *QueryBorrowsRequest) (*QueryBorrowsResponse, error)
-	QueryBorrow(context.Context, *QueryBorrowRequest) (*QueryBorrowResponse, error)
-	QueryAllBorrowByOwner(context.Context, *QueryAllBorrowByOwnerRequest) (*QueryAllBorrowByOwnerResponse, error)
-	QueryAllBorrowByOwnerAndPool(context.Context, *QueryAllBorrowByOwnerAndPoolRequest) (*QueryAllBorrowByOwnerAndPoolResponse, error)
-	QueryPoolAssetLBMapping(context.Context, *QueryPoolAssetLBMappingRequest) (*QueryPoolAssetLBMappingResponse, error)
-	QueryReserveBuybackAssetData(context.Context, *QueryReserveBuybackAssetDataRequest) (*QueryReserveBuybackAssetDataResponse, error)
-	QueryAuctionParams(context.Context, *QueryAuctionParamRequest) (*QueryAuctionParamResponse, error)
-	QueryModuleBalance(context.Context, *QueryModuleBalanceRequest) (*QueryModuleBalanceResponse, error)
-	QueryFundModBal(context.Context, *QueryFundModBalRequest) (*QueryFundModBalResponse, error)
-	QueryFundReserveBal(context.Context, *QueryFundReserveBalRequest) (*QueryFundReserveBalResponse, error)
-	QueryAllReserveStats(context.Context, *QueryAllReserveStatsRequest) (*QueryAllReserveStatsResponse, error)
-	QueryFundModBalByAssetPool(context.Context, *QueryFundModBalByAssetPoolRequest) (*QueryFundModBalByAssetPoolResponse, error)
-	QueryLendInterest(context.Context, *QueryLendInterestRequest) (*QueryLendInterestResponse, error)
-	QueryBorrowInterest(context.Context, *QueryBorrowInterestRequest) (*QueryBorrowInterestResponse, error)
+func (m *QueryCrossCollateralAccountRequest) Reset()         { *m = QueryCrossCollateralAccountRequest{} }
+func (m *QueryCrossCollateralAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossCollateralAccountRequest) ProtoMessage()    {}
+func (*QueryCrossCollateralAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{61}
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryCrossCollateralAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedQueryServer) QueryLends(ctx context.Context, req *QueryLendsRequest) (*QueryLendsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryLends not implemented")
+func (m *QueryCrossCollateralAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossCollateralAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryLend(ctx context.Context, req *QueryLendRequest) (*QueryLendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryLend not implemented")
+func (m *QueryCrossCollateralAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossCollateralAccountRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryAllLendByOwner(ctx context.Context, req *QueryAllLendByOwnerRequest) (*QueryAllLendByOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAllLendByOwner not implemented")
+func (m *QueryCrossCollateralAccountRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryAllLendByOwnerAndPool(ctx context.Context, req *QueryAllLendByOwnerAndPoolRequest) (*QueryAllLendByOwnerAndPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAllLendByOwnerAndPool not implemented")
+func (m *QueryCrossCollateralAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossCollateralAccountRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+
+var xxx_messageInfo_QueryCrossCollateralAccountRequest proto.InternalMessageInfo
+
+type QueryCrossCollateralAccountResponse struct {
+	Account CrossCollateralAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account" yaml:"account"`
+	Health  AccountHealth          `protobuf:"bytes,2,opt,name=health,proto3" json:"health" yaml:"health"`
 }
-func (*UnimplementedQueryServer) QueryPairs(ctx context.Context, req *QueryPairsRequest) (*QueryPairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPairs not implemented")
+
+func (m *QueryCrossCollateralAccountResponse) Reset()         { *m = QueryCrossCollateralAccountResponse{} }
+func (m *QueryCrossCollateralAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossCollateralAccountResponse) ProtoMessage()    {}
+func (*QueryCrossCollateralAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{62}
 }
-func (*UnimplementedQueryServer) QueryPair(ctx context.Context, req *QueryPairRequest) (*QueryPairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPair not implemented")
+func (m *QueryCrossCollateralAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryAssetRatesParams(ctx context.Context, req *QueryAssetRatesParamsRequest) (*QueryAssetRatesParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetRatesParams not implemented")
+func (m *QueryCrossCollateralAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossCollateralAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryAssetRatesParam(ctx context.Context, req *QueryAssetRatesParamRequest) (*QueryAssetRatesParamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetRatesParam not implemented")
+func (m *QueryCrossCollateralAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossCollateralAccountResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryPools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPools not implemented")
+func (m *QueryCrossCollateralAccountResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryPool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPool not implemented")
+func (m *QueryCrossCollateralAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossCollateralAccountResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryAssetToPairMappings(ctx context.Context, req *QueryAssetToPairMappingsRequest) (*QueryAssetToPairMappingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetToPairMappings not implemented")
+
+var xxx_messageInfo_QueryCrossCollateralAccountResponse proto.InternalMessageInfo
+
+type QueryFixedTermMarketsRequest struct {
 }
-func (*UnimplementedQueryServer) QueryAssetToPairMapping(ctx context.Context, req *QueryAssetToPairMappingRequest) (*QueryAssetToPairMappingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetToPairMapping not implemented")
+
+func (m *QueryFixedTermMarketsRequest) Reset()         { *m = QueryFixedTermMarketsRequest{} }
+func (m *QueryFixedTermMarketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFixedTermMarketsRequest) ProtoMessage()    {}
+func (*QueryFixedTermMarketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{63}
 }
-func (*UnimplementedQueryServer) QueryBorrows(ctx context.Context, req *QueryBorrowsRequest) (*QueryBorrowsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryBorrows not implemented")
+func (m *QueryFixedTermMarketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryBorrow(ctx context.Context, req *QueryBorrowRequest) (*QueryBorrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryBorrow not implemented")
+func (m *QueryFixedTermMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFixedTermMarketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryAllBorrowByOwner(ctx context.Context, req *QueryAllBorrowByOwnerRequest) (*QueryAllBorrowByOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAllBorrowByOwner not implemented")
+func (m *QueryFixedTermMarketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFixedTermMarketsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryAllBorrowByOwnerAndPool(ctx context.Context, req *QueryAllBorrowByOwnerAndPoolRequest) (*QueryAllBorrowByOwnerAndPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAllBorrowByOwnerAndPool not implemented")
+func (m *QueryFixedTermMarketsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryPoolAssetLBMapping(ctx context.Context, req *QueryPoolAssetLBMappingRequest) (*QueryPoolAssetLBMappingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPoolAssetLBMapping not implemented")
+func (m *QueryFixedTermMarketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFixedTermMarketsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryReserveBuybackAssetData(ctx context.Context, req *QueryReserveBuybackAssetDataRequest) (*QueryReserveBuybackAssetDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryReserveBuybackAssetData not implemented")
+
+var xxx_messageInfo_QueryFixedTermMarketsRequest proto.InternalMessageInfo
+
+type QueryFixedTermMarketsResponse struct {
+	Markets []FixedTermMarket `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets" yaml:"markets"`
 }
-func (*UnimplementedQueryServer) QueryAuctionParams(ctx context.Context, req *QueryAuctionParamRequest) (*QueryAuctionParamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAuctionParams not implemented")
+
+func (m *QueryFixedTermMarketsResponse) Reset()         { *m = QueryFixedTermMarketsResponse{} }
+func (m *QueryFixedTermMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFixedTermMarketsResponse) ProtoMessage()    {}
+func (*QueryFixedTermMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{64}
 }
-func (*UnimplementedQueryServer) QueryModuleBalance(ctx context.Context, req *QueryModuleBalanceRequest) (*QueryModuleBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryModuleBalance not implemented")
-}
-func (*UnimplementedQueryServer) QueryFundModBal(ctx context.Context, req *QueryFundModBalRequest) (*QueryFundModBalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryFundModBal not implemented")
-}
-func (*UnimplementedQueryServer) QueryFundReserveBal(ctx context.Context, req *QueryFundReserveBalRequest) (*QueryFundReserveBalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryFundReserveBal not implemented")
+func (m *QueryFixedTermMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryAllReserveStats(ctx context.Context, req *QueryAllReserveStatsRequest) (*QueryAllReserveStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAllReserveStats not implemented")
+func (m *QueryFixedTermMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFixedTermMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryFundModBalByAssetPool(ctx context.Context, req *QueryFundModBalByAssetPoolRequest) (*QueryFundModBalByAssetPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryFundModBalByAssetPool not implemented")
+func (m *QueryFixedTermMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFixedTermMarketsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryLendInterest(ctx context.Context, req *QueryLendInterestRequest) (*QueryLendInterestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryLendInterest not implemented")
+func (m *QueryFixedTermMarketsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryBorrowInterest(ctx context.Context, req *QueryBorrowInterestRequest) (*QueryBorrowInterestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryBorrowInterest not implemented")
+func (m *QueryFixedTermMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFixedTermMarketsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryFixedTermMarketsResponse proto.InternalMessageInfo
 
-func _Query_QueryLends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLendsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryLends(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryLends",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryLends(ctx, req.(*QueryLendsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryFixedTermPositionsRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 }
 
-func _Query_QueryLend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLendRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryLend(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryLend",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryLend(ctx, req.(*QueryLendRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryFixedTermPositionsRequest) Reset()         { *m = QueryFixedTermPositionsRequest{} }
+func (m *QueryFixedTermPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFixedTermPositionsRequest) ProtoMessage()    {}
+func (*QueryFixedTermPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{65}
 }
-
-func _Query_QueryAllLendByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllLendByOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAllLendByOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryAllLendByOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAllLendByOwner(ctx, req.(*QueryAllLendByOwnerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryFixedTermPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_QueryAllLendByOwnerAndPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllLendByOwnerAndPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAllLendByOwnerAndPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryAllLendByOwnerAndPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAllLendByOwnerAndPool(ctx, req.(*QueryAllLendByOwnerAndPoolRequest))
+func (m *QueryFixedTermPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFixedTermPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFixedTermPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFixedTermPositionsRequest.Merge(m, src)
+}
+func (m *QueryFixedTermPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFixedTermPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFixedTermPositionsRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryFixedTermPositionsRequest proto.InternalMessageInfo
+
+type QueryFixedTermPositionsResponse struct {
+	Deposits []FixedTermDeposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits" yaml:"deposits"`
+	Loans    []FixedTermLoan    `protobuf:"bytes,2,rep,name=loans,proto3" json:"loans" yaml:"loans"`
 }
 
-func _Query_QueryPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPairsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPairs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryPairs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPairs(ctx, req.(*QueryPairsRequest))
+func (m *QueryFixedTermPositionsResponse) Reset()         { *m = QueryFixedTermPositionsResponse{} }
+func (m *QueryFixedTermPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFixedTermPositionsResponse) ProtoMessage()    {}
+func (*QueryFixedTermPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{66}
+}
+func (m *QueryFixedTermPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFixedTermPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFixedTermPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFixedTermPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFixedTermPositionsResponse.Merge(m, src)
+}
+func (m *QueryFixedTermPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFixedTermPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFixedTermPositionsResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPairRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryPair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPair(ctx, req.(*QueryPairRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryFixedTermPositionsResponse proto.InternalMessageInfo
+
+type QueryPositionManagerGrantsRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 }
 
-func _Query_QueryAssetRatesParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAssetRatesParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAssetRatesParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryAssetRatesParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAssetRatesParams(ctx, req.(*QueryAssetRatesParamsRequest))
+func (m *QueryPositionManagerGrantsRequest) Reset()         { *m = QueryPositionManagerGrantsRequest{} }
+func (m *QueryPositionManagerGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionManagerGrantsRequest) ProtoMessage()    {}
+func (*QueryPositionManagerGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{67}
+}
+func (m *QueryPositionManagerGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionManagerGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionManagerGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPositionManagerGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionManagerGrantsRequest.Merge(m, src)
+}
+func (m *QueryPositionManagerGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionManagerGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionManagerGrantsRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryAssetRatesParam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAssetRatesParamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAssetRatesParam(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryAssetRatesParam",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAssetRatesParam(ctx, req.(*QueryAssetRatesParamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryPositionManagerGrantsRequest proto.InternalMessageInfo
+
+type QueryPositionManagerGrantsResponse struct {
+	Grants []PositionManagerGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants" yaml:"grants"`
 }
 
-func _Query_QueryPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPools(ctx, req.(*QueryPoolsRequest))
+func (m *QueryPositionManagerGrantsResponse) Reset()         { *m = QueryPositionManagerGrantsResponse{} }
+func (m *QueryPositionManagerGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionManagerGrantsResponse) ProtoMessage()    {}
+func (*QueryPositionManagerGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_462bf3f1a3eff175, []int{68}
+}
+func (m *QueryPositionManagerGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionManagerGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionManagerGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPositionManagerGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionManagerGrantsResponse.Merge(m, src)
+}
+func (m *QueryPositionManagerGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionManagerGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionManagerGrantsResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPool(ctx, req.(*QueryPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryPositionManagerGrantsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "comdex.lend.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "comdex.lend.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryLendsRequest)(nil), "comdex.lend.v1beta1.QueryLendsRequest")
+	proto.RegisterType((*QueryLendsResponse)(nil), "comdex.lend.v1beta1.QueryLendsResponse")
+	proto.RegisterType((*QueryLendRequest)(nil), "comdex.lend.v1beta1.QueryLendRequest")
+	proto.RegisterType((*QueryLendResponse)(nil), "comdex.lend.v1beta1.QueryLendResponse")
+	proto.RegisterType((*QueryAllLendByOwnerRequest)(nil), "comdex.lend.v1beta1.QueryAllLendByOwnerRequest")
+	proto.RegisterType((*QueryAllLendByOwnerResponse)(nil), "comdex.lend.v1beta1.QueryAllLendByOwnerResponse")
+	proto.RegisterType((*QueryAllLendByOwnerAndPoolRequest)(nil), "comdex.lend.v1beta1.QueryAllLendByOwnerAndPoolRequest")
+	proto.RegisterType((*QueryAllLendByOwnerAndPoolResponse)(nil), "comdex.lend.v1beta1.QueryAllLendByOwnerAndPoolResponse")
+	proto.RegisterType((*QueryPairsRequest)(nil), "comdex.lend.v1beta1.QueryPairsRequest")
+	proto.RegisterType((*QueryPairsResponse)(nil), "comdex.lend.v1beta1.QueryPairsResponse")
+	proto.RegisterType((*QueryPairRequest)(nil), "comdex.lend.v1beta1.QueryPairRequest")
+	proto.RegisterType((*QueryPairResponse)(nil), "comdex.lend.v1beta1.QueryPairResponse")
+	proto.RegisterType((*QueryAssetRatesParamsRequest)(nil), "comdex.lend.v1beta1.QueryAssetRatesParamsRequest")
+	proto.RegisterType((*QueryAssetRatesParamsResponse)(nil), "comdex.lend.v1beta1.QueryAssetRatesParamsResponse")
+	proto.RegisterType((*QueryAssetRatesParamRequest)(nil), "comdex.lend.v1beta1.QueryAssetRatesParamRequest")
+	proto.RegisterType((*QueryAssetRatesParamResponse)(nil), "comdex.lend.v1beta1.QueryAssetRatesParamResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "comdex.lend.v1beta1.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "comdex.lend.v1beta1.QueryPoolsResponse")
+	proto.RegisterType((*QueryPoolRequest)(nil), "comdex.lend.v1beta1.QueryPoolRequest")
+	proto.RegisterType((*QueryPoolResponse)(nil), "comdex.lend.v1beta1.QueryPoolResponse")
+	proto.RegisterType((*QueryAssetToPairMappingsRequest)(nil), "comdex.lend.v1beta1.QueryAssetToPairMappingsRequest")
+	proto.RegisterType((*QueryAssetToPairMappingsResponse)(nil), "comdex.lend.v1beta1.QueryAssetToPairMappingsResponse")
+	proto.RegisterType((*QueryAssetToPairMappingRequest)(nil), "comdex.lend.v1beta1.QueryAssetToPairMappingRequest")
+	proto.RegisterType((*QueryAssetToPairMappingResponse)(nil), "comdex.lend.v1beta1.QueryAssetToPairMappingResponse")
+	proto.RegisterType((*QueryBorrowsRequest)(nil), "comdex.lend.v1beta1.QueryBorrowsRequest")
+	proto.RegisterType((*QueryBorrowsResponse)(nil), "comdex.lend.v1beta1.QueryBorrowsResponse")
+	proto.RegisterType((*QueryBorrowRequest)(nil), "comdex.lend.v1beta1.QueryBorrowRequest")
+	proto.RegisterType((*QueryBorrowResponse)(nil), "comdex.lend.v1beta1.QueryBorrowResponse")
+	proto.RegisterType((*QueryAllBorrowByOwnerRequest)(nil), "comdex.lend.v1beta1.QueryAllBorrowByOwnerRequest")
+	proto.RegisterType((*QueryAllBorrowByOwnerResponse)(nil), "comdex.lend.v1beta1.QueryAllBorrowByOwnerResponse")
+	proto.RegisterType((*QueryAllBorrowByOwnerAndPoolRequest)(nil), "comdex.lend.v1beta1.QueryAllBorrowByOwnerAndPoolRequest")
+	proto.RegisterType((*QueryAllBorrowByOwnerAndPoolResponse)(nil), "comdex.lend.v1beta1.QueryAllBorrowByOwnerAndPoolResponse")
+	proto.RegisterType((*QueryPoolAssetLBMappingRequest)(nil), "comdex.lend.v1beta1.QueryPoolAssetLBMappingRequest")
+	proto.RegisterType((*QueryPoolAssetLBMappingResponse)(nil), "comdex.lend.v1beta1.QueryPoolAssetLBMappingResponse")
+	proto.RegisterType((*QueryReserveBuybackAssetDataRequest)(nil), "comdex.lend.v1beta1.QueryReserveBuybackAssetDataRequest")
+	proto.RegisterType((*QueryReserveBuybackAssetDataResponse)(nil), "comdex.lend.v1beta1.QueryReserveBuybackAssetDataResponse")
+	proto.RegisterType((*QueryAuctionParamRequest)(nil), "comdex.lend.v1beta1.QueryAuctionParamRequest")
+	proto.RegisterType((*QueryAuctionParamResponse)(nil), "comdex.lend.v1beta1.QueryAuctionParamResponse")
+	proto.RegisterType((*QueryModuleBalanceRequest)(nil), "comdex.lend.v1beta1.QueryModuleBalanceRequest")
+	proto.RegisterType((*QueryModuleBalanceResponse)(nil), "comdex.lend.v1beta1.QueryModuleBalanceResponse")
+	proto.RegisterType((*QueryFundModBalRequest)(nil), "comdex.lend.v1beta1.QueryFundModBalRequest")
+	proto.RegisterType((*QueryFundModBalResponse)(nil), "comdex.lend.v1beta1.QueryFundModBalResponse")
+	proto.RegisterType((*QueryFundReserveBalRequest)(nil), "comdex.lend.v1beta1.QueryFundReserveBalRequest")
+	proto.RegisterType((*QueryFundReserveBalResponse)(nil), "comdex.lend.v1beta1.QueryFundReserveBalResponse")
+	proto.RegisterType((*QueryAllReserveStatsRequest)(nil), "comdex.lend.v1beta1.QueryAllReserveStatsRequest")
+	proto.RegisterType((*QueryAllReserveStatsResponse)(nil), "comdex.lend.v1beta1.QueryAllReserveStatsResponse")
+	proto.RegisterType((*QueryFundModBalByAssetPoolRequest)(nil), "comdex.lend.v1beta1.QueryFundModBalByAssetPoolRequest")
+	proto.RegisterType((*QueryFundModBalByAssetPoolResponse)(nil), "comdex.lend.v1beta1.QueryFundModBalByAssetPoolResponse")
+	proto.RegisterType((*QueryLendInterestRequest)(nil), "comdex.lend.v1beta1.QueryLendInterestRequest")
+	proto.RegisterType((*QueryLendInterestResponse)(nil), "comdex.lend.v1beta1.QueryLendInterestResponse")
+	proto.RegisterType((*QueryBorrowInterestRequest)(nil), "comdex.lend.v1beta1.QueryBorrowInterestRequest")
+	proto.RegisterType((*QueryBorrowInterestResponse)(nil), "comdex.lend.v1beta1.QueryBorrowInterestResponse")
+	proto.RegisterType((*QueryEModeCategoriesRequest)(nil), "comdex.lend.v1beta1.QueryEModeCategoriesRequest")
+	proto.RegisterType((*QueryEModeCategoriesResponse)(nil), "comdex.lend.v1beta1.QueryEModeCategoriesResponse")
+	proto.RegisterType((*QueryInterestRateProjectionRequest)(nil), "comdex.lend.v1beta1.QueryInterestRateProjectionRequest")
+	proto.RegisterType((*InterestRates)(nil), "comdex.lend.v1beta1.InterestRates")
+	proto.RegisterType((*QueryInterestRateProjectionResponse)(nil), "comdex.lend.v1beta1.QueryInterestRateProjectionResponse")
+	proto.RegisterType((*QueryBadDebtWaterfallRequest)(nil), "comdex.lend.v1beta1.QueryBadDebtWaterfallRequest")
+	proto.RegisterType((*QueryBadDebtWaterfallResponse)(nil), "comdex.lend.v1beta1.QueryBadDebtWaterfallResponse")
+	proto.RegisterType((*QueryCrossCollateralAccountRequest)(nil), "comdex.lend.v1beta1.QueryCrossCollateralAccountRequest")
+	proto.RegisterType((*QueryCrossCollateralAccountResponse)(nil), "comdex.lend.v1beta1.QueryCrossCollateralAccountResponse")
+	proto.RegisterType((*QueryFixedTermMarketsRequest)(nil), "comdex.lend.v1beta1.QueryFixedTermMarketsRequest")
+	proto.RegisterType((*QueryFixedTermMarketsResponse)(nil), "comdex.lend.v1beta1.QueryFixedTermMarketsResponse")
+	proto.RegisterType((*QueryFixedTermPositionsRequest)(nil), "comdex.lend.v1beta1.QueryFixedTermPositionsRequest")
+	proto.RegisterType((*QueryFixedTermPositionsResponse)(nil), "comdex.lend.v1beta1.QueryFixedTermPositionsResponse")
+	proto.RegisterType((*QueryPositionManagerGrantsRequest)(nil), "comdex.lend.v1beta1.QueryPositionManagerGrantsRequest")
+	proto.RegisterType((*QueryPositionManagerGrantsResponse)(nil), "comdex.lend.v1beta1.QueryPositionManagerGrantsResponse")
 }
 
-func _Query_QueryAssetToPairMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAssetToPairMappingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAssetToPairMappings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryAssetToPairMappings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAssetToPairMappings(ctx, req.(*QueryAssetToPairMappingsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() { proto.RegisterFile("comdex/lend/v1beta1/query.proto", fileDescriptor_462bf3f1a3eff175) }
+
+var fileDescriptor_462bf3f1a3eff175 = []byte{
+	// 3194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0x4d, 0x8c, 0x1c, 0x47,
+	0xf5, 0x77, 0xaf, 0xed, 0xdd, 0x6c, 0xf9, 0xbb, 0xd6, 0x1f, 0xeb, 0xf6, 0x7a, 0xc7, 0x2e, 0xaf,
+	0x77, 0xed, 0x38, 0x3b, 0xe3, 0x5d, 0x3b, 0x89, 0x1d, 0x45, 0xff, 0x7f, 0xb6, 0xbd, 0x4e, 0xec,
+	0xe0, 0x25, 0x9b, 0xc6, 0x22, 0x28, 0x4a, 0x18, 0xf5, 0x4c, 0xf7, 0x8e, 0x07, 0xcf, 0x4c, 0x4f,
+	0xba, 0x7b, 0x63, 0xaf, 0xac, 0x85, 0x04, 0x92, 0x03, 0xe2, 0x12, 0x14, 0x2e, 0x08, 0x09, 0x21,
+	0x21, 0x50, 0xa4, 0x80, 0x80, 0x03, 0x1c, 0x00, 0x09, 0x4e, 0x28, 0x02, 0x82, 0x82, 0x72, 0x08,
+	0x09, 0x64, 0x45, 0x1c, 0x2e, 0x1c, 0xb8, 0x58, 0xe2, 0xc2, 0x09, 0x55, 0xd5, 0xab, 0xfe, 0x9a,
+	0xea, 0x9a, 0x6e, 0x2b, 0xbb, 0x60, 0x4e, 0x1e, 0x77, 0xbd, 0x8f, 0xdf, 0x7b, 0xf5, 0xea, 0xd5,
+	0xab, 0x7a, 0xb5, 0xa8, 0x54, 0x77, 0xdb, 0xb6, 0x73, 0xb3, 0xd2, 0x72, 0x3a, 0x76, 0xe5, 0xc5,
+	0x99, 0x9a, 0x13, 0x58, 0x33, 0x95, 0x17, 0x96, 0x1d, 0x6f, 0xa5, 0xdc, 0xf5, 0xdc, 0xc0, 0xc5,
+	0x23, 0x9c, 0xa0, 0x4c, 0x09, 0xca, 0x40, 0xa0, 0xdf, 0x5f, 0x77, 0xfd, 0xb6, 0xeb, 0x57, 0x6a,
+	0x96, 0xef, 0x70, 0xea, 0x90, 0xb7, 0x6b, 0x35, 0x9a, 0x1d, 0x2b, 0x68, 0xba, 0x1d, 0x2e, 0x40,
+	0xdf, 0xdb, 0x70, 0x1b, 0x2e, 0xfb, 0x59, 0xa1, 0xbf, 0xe0, 0xeb, 0x58, 0xc3, 0x75, 0x1b, 0x2d,
+	0xa7, 0x62, 0x75, 0x9b, 0x15, 0xab, 0xd3, 0x71, 0x03, 0xc6, 0xe2, 0xc3, 0xe8, 0xb8, 0x0c, 0x15,
+	0x43, 0xc0, 0xc7, 0x8f, 0xc8, 0xc6, 0xbb, 0x96, 0x67, 0xb5, 0x63, 0x12, 0x22, 0x84, 0x82, 0xa2,
+	0xee, 0x36, 0x01, 0x15, 0xd9, 0x8b, 0xf0, 0xd3, 0x14, 0xf7, 0x22, 0x63, 0x32, 0x9d, 0x17, 0x96,
+	0x1d, 0x3f, 0x20, 0x8b, 0x68, 0x24, 0xf1, 0xd5, 0xef, 0xba, 0x1d, 0xdf, 0xc1, 0xe7, 0xd1, 0x20,
+	0x17, 0x3e, 0xaa, 0x1d, 0xd1, 0x4e, 0x6c, 0x9b, 0x3d, 0x54, 0x96, 0x38, 0xa5, 0xcc, 0x99, 0x8c,
+	0x2d, 0x6f, 0xad, 0x95, 0x36, 0x99, 0xc0, 0x40, 0x3c, 0xb4, 0x87, 0x49, 0xbc, 0xe2, 0x74, 0x6c,
+	0xa1, 0x06, 0x3f, 0x8f, 0x50, 0xe4, 0x26, 0x90, 0x39, 0x59, 0xe6, 0x88, 0xcb, 0x14, 0x71, 0x99,
+	0xcf, 0x40, 0x24, 0xb9, 0xe1, 0x00, 0xaf, 0xb1, 0xef, 0xce, 0x5a, 0x69, 0xcf, 0x8a, 0xd5, 0x6e,
+	0x3d, 0x42, 0x22, 0x19, 0xc4, 0x8c, 0x09, 0x24, 0xbf, 0xd6, 0x10, 0x8e, 0x2b, 0x05, 0x2b, 0x9e,
+	0x44, 0x5b, 0x29, 0x5e, 0x6a, 0xc4, 0xe6, 0x13, 0xdb, 0x66, 0xc7, 0xa5, 0x46, 0x50, 0x96, 0x39,
+	0xdf, 0x77, 0x02, 0x63, 0x2f, 0xb5, 0xe3, 0xce, 0x5a, 0x69, 0x3b, 0x57, 0xc6, 0x58, 0x89, 0xc9,
+	0x45, 0xe0, 0xcf, 0x27, 0x2c, 0x18, 0x60, 0x16, 0x4c, 0xf5, 0xb5, 0x80, 0x03, 0xc9, 0x63, 0x02,
+	0x41, 0xbb, 0x43, 0x0b, 0x84, 0xd7, 0x76, 0xa2, 0x81, 0xa6, 0xcd, 0xbc, 0xb5, 0xc5, 0x1c, 0x68,
+	0xda, 0xe4, 0xb9, 0x98, 0x6b, 0x43, 0x23, 0x9f, 0x40, 0x5b, 0x28, 0x42, 0x70, 0x6a, 0x3f, 0x1b,
+	0x47, 0xc0, 0xc6, 0x6d, 0x91, 0x8d, 0xc4, 0x64, 0x02, 0xc8, 0x77, 0x35, 0xa4, 0x33, 0xf1, 0x73,
+	0xad, 0x16, 0x65, 0x30, 0x56, 0x9e, 0xba, 0xd1, 0x71, 0x3c, 0x01, 0x66, 0x12, 0x6d, 0x75, 0xe9,
+	0xff, 0x99, 0xa2, 0x61, 0x63, 0x77, 0xe4, 0x28, 0xf6, 0x99, 0x98, 0x7c, 0x18, 0x3f, 0x2f, 0x71,
+	0xd4, 0x27, 0x38, 0xd5, 0xbf, 0xd7, 0xd0, 0x21, 0x29, 0x4a, 0x70, 0xc7, 0x42, 0xb1, 0x39, 0x3f,
+	0x00, 0xfe, 0xd8, 0x15, 0xf9, 0xa3, 0xda, 0xdc, 0xc0, 0x69, 0x7f, 0x4f, 0x43, 0x47, 0x25, 0xe6,
+	0xcc, 0x75, 0xec, 0x45, 0xd7, 0x6d, 0x15, 0xf5, 0xfd, 0x29, 0x34, 0xd4, 0x75, 0xdd, 0x56, 0xb5,
+	0x69, 0x33, 0xa8, 0x5b, 0x0c, 0x7c, 0x67, 0xad, 0xb4, 0x13, 0x10, 0xf0, 0x01, 0x62, 0x0e, 0xd2,
+	0x5f, 0x97, 0xed, 0xd4, 0x44, 0x6d, 0xfe, 0xa4, 0x27, 0xea, 0x1d, 0x0d, 0x11, 0x95, 0x65, 0xf7,
+	0xe0, 0x1a, 0x15, 0xa9, 0x6d, 0xd1, 0x6a, 0x7a, 0x1b, 0x95, 0xda, 0x3e, 0xd4, 0x10, 0x8e, 0x2b,
+	0x05, 0xb7, 0x35, 0xd0, 0x0e, 0xe7, 0x66, 0xe0, 0x74, 0x6c, 0xc7, 0x66, 0x03, 0xe0, 0x3e, 0x22,
+	0x75, 0xdf, 0x45, 0xa0, 0xac, 0x52, 0x52, 0xe3, 0x30, 0xb8, 0x70, 0x1f, 0x57, 0x2c, 0xc4, 0x54,
+	0xbb, 0x54, 0x0e, 0x31, 0x93, 0x72, 0x37, 0x2c, 0xef, 0x51, 0x6d, 0x59, 0x79, 0xef, 0x23, 0x2d,
+	0xe6, 0xf8, 0xd0, 0x05, 0x36, 0xda, 0x7e, 0x31, 0x06, 0x15, 0x5c, 0x9f, 0xc7, 0x03, 0x63, 0xe0,
+	0x81, 0xbd, 0x12, 0x0f, 0x10, 0x33, 0x21, 0x15, 0x2f, 0xa1, 0x5d, 0x4e, 0xb5, 0xed, 0xda, 0x4e,
+	0xb5, 0x6e, 0x05, 0x4e, 0xc3, 0xf5, 0x56, 0x46, 0x07, 0x54, 0x8a, 0x16, 0x5c, 0xdb, 0xb9, 0x00,
+	0x94, 0x86, 0x7e, 0x67, 0xad, 0xb4, 0x1f, 0x94, 0x24, 0x85, 0x50, 0x3f, 0xc7, 0x49, 0xc9, 0x2a,
+	0x1a, 0xe3, 0xab, 0x85, 0x86, 0xb9, 0x69, 0x05, 0x8e, 0x9f, 0xd8, 0xa8, 0xd7, 0x3b, 0xcc, 0xfe,
+	0xa9, 0xa1, 0xc3, 0x19, 0xfa, 0xc1, 0xdd, 0x01, 0xda, 0x9d, 0x1e, 0x83, 0xa0, 0x3b, 0x2e, 0xf5,
+	0x44, 0x9a, 0xd8, 0x38, 0x0a, 0x5e, 0x3f, 0xc8, 0x91, 0x58, 0x74, 0xbc, 0xea, 0x51, 0x82, 0x2a,
+	0x94, 0x0e, 0x66, 0x8f, 0x86, 0x75, 0x0f, 0xbf, 0x69, 0xb1, 0x9b, 0x24, 0x15, 0x67, 0x45, 0xe2,
+	0x37, 0x34, 0xf9, 0x34, 0xc9, 0xbd, 0x94, 0x28, 0xa1, 0xd6, 0xc5, 0x4b, 0xa9, 0x9a, 0x8b, 0x66,
+	0xd6, 0x8d, 0x8a, 0x98, 0x5f, 0x84, 0x89, 0x89, 0x2b, 0x05, 0x07, 0x5c, 0x44, 0x5b, 0xe9, 0xfe,
+	0x22, 0x62, 0xe3, 0xa0, 0xbc, 0x70, 0x74, 0xdd, 0x56, 0x3a, 0x95, 0x33, 0x2e, 0x62, 0x72, 0xee,
+	0x8d, 0x4b, 0x3b, 0xb1, 0x5d, 0x36, 0x3d, 0xd9, 0xcf, 0xc4, 0xbc, 0x1a, 0xda, 0x67, 0xa0, 0x2d,
+	0x14, 0x21, 0xf8, 0x53, 0x61, 0x5e, 0xaa, 0xd2, 0xa2, 0x4c, 0xc4, 0x64, 0xbc, 0xe4, 0x25, 0x0d,
+	0x95, 0xa2, 0x28, 0xba, 0xea, 0xd2, 0x44, 0xb3, 0x60, 0x75, 0xbb, 0xcd, 0x4e, 0x63, 0xa3, 0x66,
+	0xef, 0xd5, 0x01, 0x74, 0x24, 0x1b, 0x02, 0xd8, 0xfa, 0x92, 0x86, 0x46, 0xac, 0xde, 0x71, 0x98,
+	0xda, 0xa9, 0xec, 0x80, 0x4e, 0xd0, 0x1b, 0xc7, 0xc1, 0x13, 0x87, 0xe3, 0x21, 0x1d, 0xb8, 0x2c,
+	0xdd, 0x56, 0xdb, 0x20, 0x94, 0x98, 0x32, 0x55, 0xeb, 0x1e, 0x07, 0xab, 0x68, 0x3c, 0xc3, 0x0d,
+	0x62, 0x22, 0xca, 0xe8, 0x3e, 0x8e, 0x58, 0xc4, 0x86, 0x31, 0x12, 0xd5, 0x8b, 0x62, 0x84, 0x98,
+	0x43, 0xec, 0xe7, 0x65, 0xbb, 0x50, 0x0d, 0x46, 0xbe, 0x93, 0x1d, 0x09, 0xe1, 0x2c, 0xac, 0x22,
+	0xdc, 0x3b, 0x3a, 0xaa, 0x85, 0xae, 0xc8, 0x35, 0x07, 0x13, 0x30, 0x07, 0x63, 0x8a, 0x39, 0x20,
+	0xa6, 0x44, 0x11, 0x09, 0xe0, 0x84, 0x68, 0xb8, 0x9e, 0xe7, 0xde, 0xd8, 0xa8, 0xf8, 0xfc, 0xad,
+	0x86, 0xf6, 0x26, 0xd5, 0x82, 0x37, 0x4c, 0x34, 0x54, 0xe3, 0x9f, 0x20, 0x0c, 0x8f, 0x48, 0x5d,
+	0xc0, 0xd9, 0x78, 0xcd, 0xb8, 0x1f, 0x6c, 0x87, 0x49, 0x00, 0x76, 0x62, 0x0a, 0x41, 0xeb, 0x1e,
+	0x64, 0x13, 0x90, 0x29, 0x39, 0xa8, 0xac, 0x74, 0xb3, 0x94, 0x70, 0x74, 0x68, 0xf0, 0x53, 0x68,
+	0x90, 0xe3, 0x04, 0x27, 0xf7, 0xb7, 0x77, 0x1f, 0xd8, 0xbb, 0x23, 0x6e, 0x2f, 0x31, 0x41, 0x0c,
+	0xf9, 0x5e, 0xb8, 0x87, 0xb5, 0x5a, 0x9c, 0xed, 0xbf, 0xf3, 0xa4, 0xf7, 0x6e, 0x58, 0x92, 0xf4,
+	0xe0, 0xbc, 0x87, 0x63, 0xe1, 0x7d, 0x0d, 0x1d, 0x93, 0x5a, 0xf5, 0x3f, 0x70, 0xe4, 0xfb, 0x40,
+	0x43, 0x13, 0x6a, 0xdb, 0xee, 0xe1, 0x89, 0x13, 0x3b, 0x05, 0x35, 0x84, 0x41, 0xba, 0x62, 0xfc,
+	0x47, 0x76, 0x0a, 0x99, 0xfe, 0x68, 0xa7, 0xe8, 0x1d, 0x55, 0xee, 0x14, 0xbd, 0xe4, 0xe9, 0x9d,
+	0x82, 0x01, 0xe1, 0xe0, 0x5b, 0xb5, 0xd8, 0x4e, 0xd1, 0xcb, 0x49, 0x1e, 0x83, 0xc8, 0x36, 0x1d,
+	0xdf, 0xf1, 0x5e, 0x74, 0x8c, 0xe5, 0x95, 0x9a, 0x55, 0xbf, 0xce, 0x88, 0xe6, 0xad, 0xc0, 0x12,
+	0x6e, 0x3a, 0x98, 0x76, 0x53, 0xe8, 0x11, 0xf2, 0x73, 0x11, 0x40, 0x99, 0x22, 0xc0, 0xd2, 0xaf,
+	0x6b, 0xe8, 0x40, 0x06, 0x0d, 0xd8, 0xfb, 0x80, 0xd4, 0xde, 0x0c, 0x1e, 0xe3, 0x24, 0x18, 0x7d,
+	0x94, 0x1b, 0xed, 0x71, 0xb2, 0x6a, 0x8d, 0xd3, 0x81, 0xfd, 0xb6, 0x15, 0x58, 0xc4, 0xcc, 0xd2,
+	0x4b, 0x66, 0xd0, 0x28, 0x0f, 0xfe, 0xe5, 0x3a, 0x0d, 0x98, 0xc4, 0x39, 0x62, 0x1f, 0x1a, 0xb4,
+	0xba, 0xdd, 0xc8, 0xe2, 0xad, 0x56, 0xb7, 0x7b, 0xd9, 0x26, 0xaf, 0x68, 0xe8, 0xa0, 0x84, 0x27,
+	0x3a, 0xe3, 0x5b, 0xb1, 0xef, 0xbe, 0xf2, 0x84, 0x1b, 0x97, 0xe0, 0xa7, 0xcf, 0xf8, 0x20, 0x26,
+	0x3c, 0x41, 0x24, 0xe5, 0x92, 0x4b, 0x80, 0x62, 0xc1, 0xb5, 0x97, 0x5b, 0x8e, 0x61, 0xb5, 0xac,
+	0x4e, 0x5d, 0xac, 0xfb, 0x78, 0x94, 0x6a, 0x7d, 0xa3, 0xf4, 0x55, 0x71, 0x87, 0x98, 0x12, 0x15,
+	0x59, 0x94, 0x18, 0x50, 0x5a, 0x94, 0xa0, 0x4c, 0x5b, 0xd4, 0x66, 0x83, 0xd5, 0x1a, 0x1f, 0x25,
+	0x66, 0x52, 0x2e, 0x19, 0x45, 0xfb, 0x19, 0x8c, 0xc7, 0x97, 0x3b, 0xf6, 0x82, 0x6b, 0x1b, 0x96,
+	0xc8, 0xab, 0xe4, 0x4b, 0xe8, 0x40, 0xcf, 0x48, 0x78, 0xa1, 0xb0, 0x33, 0xfa, 0x1a, 0x83, 0x77,
+	0x28, 0x0b, 0x9e, 0x61, 0xb5, 0x8c, 0x12, 0xe0, 0x3a, 0xc0, 0x71, 0x2d, 0x2d, 0x77, 0x6c, 0x7a,
+	0xd2, 0x8f, 0x90, 0xa5, 0x64, 0x92, 0x31, 0xf0, 0x10, 0xfd, 0x2c, 0x42, 0x29, 0x82, 0xf7, 0xba,
+	0xb8, 0xde, 0x4c, 0x0f, 0x87, 0xe7, 0x4b, 0x9c, 0x1c, 0x89, 0xe1, 0x2c, 0x29, 0x43, 0xde, 0x6a,
+	0x19, 0xc7, 0x00, 0xeb, 0xa1, 0x18, 0xd6, 0x30, 0xd4, 0x05, 0x5e, 0x89, 0x7c, 0xb2, 0x10, 0xdd,
+	0xb9, 0xc2, 0xc8, 0x67, 0x02, 0x2b, 0xf0, 0xef, 0x32, 0xf1, 0x91, 0xd7, 0x62, 0x15, 0x48, 0x52,
+	0x1e, 0x58, 0xd9, 0x45, 0xbb, 0x52, 0x43, 0x60, 0xe2, 0x84, 0x3c, 0xf6, 0x93, 0xb4, 0xc6, 0x11,
+	0xb0, 0x73, 0x14, 0x10, 0xb4, 0x5a, 0xa1, 0x99, 0x3e, 0x25, 0x20, 0x66, 0x5a, 0x3c, 0x79, 0x49,
+	0xdc, 0xc3, 0x46, 0xb3, 0x65, 0xf0, 0xa2, 0x3c, 0xbe, 0x29, 0xaf, 0x6b, 0x86, 0xff, 0xa6, 0xb8,
+	0x30, 0xcd, 0x80, 0x00, 0xbe, 0xf1, 0xd1, 0xa0, 0xd5, 0x76, 0x97, 0x3b, 0x41, 0xec, 0x08, 0x1a,
+	0xed, 0x71, 0xc2, 0x25, 0x17, 0xdc, 0x66, 0xc7, 0x78, 0x2c, 0x59, 0x08, 0x72, 0x36, 0xf2, 0xaf,
+	0xb5, 0xd2, 0x54, 0xa3, 0x19, 0x5c, 0x5b, 0xae, 0x51, 0x67, 0x56, 0x38, 0x37, 0xfc, 0x33, 0xed,
+	0xdb, 0xd7, 0x2b, 0xc1, 0x4a, 0xd7, 0xf1, 0x99, 0x04, 0x13, 0x54, 0x11, 0x1d, 0x72, 0x1b, 0xbd,
+	0x88, 0xbd, 0xdc, 0x09, 0x1c, 0xcf, 0xf1, 0x03, 0x11, 0xb2, 0x2f, 0x8b, 0x24, 0x96, 0x1c, 0x0c,
+	0x17, 0xd5, 0x0e, 0x6e, 0x29, 0x0c, 0xc0, 0x86, 0x7f, 0x34, 0x73, 0x3b, 0x12, 0x12, 0xd2, 0xb7,
+	0x74, 0x09, 0x29, 0xc4, 0xdc, 0xde, 0x8d, 0xd1, 0x86, 0x8b, 0x8a, 0x57, 0x0c, 0x69, 0x84, 0xaf,
+	0x88, 0x45, 0x95, 0x1e, 0x06, 0x8c, 0x8e, 0x1c, 0x23, 0xe9, 0x8f, 0xb1, 0x10, 0xc8, 0xc3, 0x80,
+	0x22, 0x7e, 0x47, 0xd8, 0x74, 0xc2, 0x56, 0x9c, 0xb8, 0x01, 0xec, 0x19, 0x06, 0x94, 0xcf, 0x23,
+	0x54, 0x0f, 0xbf, 0xaa, 0xef, 0x7b, 0x13, 0x97, 0x90, 0x07, 0x01, 0x22, 0xd4, 0x37, 0x91, 0x0c,
+	0x62, 0xc6, 0x04, 0x92, 0xbf, 0x8b, 0xf0, 0x0b, 0xdd, 0x63, 0x05, 0xce, 0xa2, 0xe7, 0x7e, 0xc1,
+	0x61, 0x5b, 0xc5, 0xdd, 0x6c, 0x07, 0x89, 0xf5, 0x32, 0x90, 0x63, 0xbd, 0x2c, 0xa1, 0x6d, 0xcb,
+	0x41, 0xb3, 0xd5, 0xf4, 0xa3, 0x02, 0x75, 0xd8, 0x98, 0xa7, 0xf8, 0x3f, 0x58, 0x2b, 0x4d, 0xe6,
+	0x08, 0xda, 0x79, 0xa7, 0x7e, 0x67, 0xad, 0x84, 0xb9, 0x82, 0x98, 0x28, 0x62, 0xc6, 0x05, 0x93,
+	0x9f, 0x6c, 0x46, 0x3b, 0xe2, 0x66, 0xfa, 0x69, 0xcd, 0xda, 0x3a, 0x69, 0xc6, 0xcf, 0xa1, 0xfb,
+	0x58, 0x8f, 0xc9, 0xea, 0x7a, 0xcc, 0x23, 0xc3, 0xc6, 0x5c, 0x61, 0x25, 0xf1, 0x5e, 0x95, 0xd5,
+	0xf5, 0x88, 0x39, 0x44, 0x7f, 0xce, 0x75, 0x3d, 0x5c, 0x43, 0x88, 0x97, 0xc3, 0x4c, 0x3e, 0x77,
+	0xdf, 0x85, 0xc2, 0xf2, 0xf7, 0xc4, 0x4b, 0x6c, 0xae, 0x61, 0x98, 0xff, 0x87, 0xea, 0x78, 0x11,
+	0xed, 0xf1, 0x03, 0xab, 0x46, 0x37, 0xdf, 0x48, 0xd5, 0x16, 0xa6, 0xea, 0xc9, 0xc2, 0xaa, 0x20,
+	0x43, 0xf7, 0x08, 0x24, 0xe6, 0x2e, 0xfe, 0xcd, 0x10, 0x7a, 0xc9, 0x6f, 0x06, 0xa0, 0xbc, 0xcc,
+	0x8a, 0x4f, 0x58, 0x26, 0x6d, 0xb4, 0x5f, 0x2c, 0x40, 0x76, 0x6f, 0xca, 0xee, 0xdd, 0x63, 0xf1,
+	0x7a, 0xee, 0xf6, 0x5a, 0x69, 0x24, 0x2e, 0x83, 0x2e, 0x96, 0xd6, 0xe5, 0xf9, 0xe8, 0x82, 0x4a,
+	0xce, 0x4e, 0xcc, 0x91, 0x66, 0x0f, 0x97, 0x8d, 0xaf, 0xa2, 0xa1, 0xfa, 0xb2, 0xe7, 0x39, 0x9d,
+	0x40, 0xd9, 0x17, 0x48, 0x44, 0x5b, 0xfa, 0x30, 0x03, 0x02, 0x88, 0x29, 0x44, 0xe1, 0x67, 0xd1,
+	0x70, 0x97, 0x9b, 0xe6, 0xd8, 0xa3, 0x9b, 0x73, 0xcb, 0x1d, 0x05, 0xb9, 0xbb, 0x61, 0x3d, 0x0a,
+	0x11, 0xc4, 0x8c, 0xc4, 0x91, 0x71, 0xc8, 0x33, 0x86, 0x65, 0xcf, 0x3b, 0xb5, 0xe0, 0x19, 0x2b,
+	0x70, 0xbc, 0x25, 0xab, 0x15, 0x96, 0x20, 0xff, 0x10, 0xe7, 0xee, 0x5e, 0x82, 0x30, 0x13, 0x0d,
+	0xdf, 0x10, 0x1f, 0x95, 0xb7, 0xdb, 0x69, 0x09, 0x69, 0x80, 0xa1, 0x14, 0x62, 0x46, 0x12, 0x71,
+	0x1b, 0xed, 0x74, 0x6e, 0xd6, 0xaf, 0x59, 0x9d, 0x86, 0xc3, 0xa6, 0xc0, 0x1f, 0x1d, 0x50, 0x5c,
+	0x38, 0x5e, 0xb8, 0xea, 0x5e, 0x77, 0x3a, 0x17, 0x81, 0x81, 0xfa, 0xa1, 0xb7, 0xc3, 0x15, 0x17,
+	0xc6, 0x3a, 0x5c, 0x11, 0xb1, 0x4f, 0x56, 0x20, 0xef, 0x5d, 0xf0, 0x5c, 0xdf, 0xbf, 0xe0, 0xb6,
+	0x5a, 0x14, 0x88, 0xd5, 0x9a, 0xab, 0xd7, 0xe9, 0xd6, 0xb7, 0x9e, 0xe7, 0x71, 0xda, 0xfd, 0x3d,
+	0xa6, 0xd4, 0x1d, 0x3a, 0x7c, 0xc8, 0xe2, 0x9f, 0xc0, 0xdd, 0xa7, 0xe4, 0xae, 0x90, 0x4a, 0x49,
+	0x47, 0x1b, 0x48, 0xa2, 0x69, 0x97, 0xff, 0xc2, 0x4f, 0xa3, 0xc1, 0x6b, 0x8e, 0xd5, 0x0a, 0xae,
+	0x29, 0x43, 0x18, 0xc4, 0x5d, 0x62, 0x94, 0xe9, 0x4b, 0x26, 0xce, 0x4f, 0x4c, 0x10, 0x14, 0x06,
+	0xd9, 0xe3, 0xcd, 0x9b, 0x8e, 0x7d, 0xd5, 0xf1, 0xda, 0x0b, 0x96, 0x77, 0xdd, 0x09, 0x4b, 0x46,
+	0x72, 0x03, 0x1d, 0xce, 0x18, 0x07, 0x93, 0x3f, 0x8b, 0x86, 0xda, 0xfc, 0x13, 0x6c, 0x75, 0xf2,
+	0xd2, 0x2f, 0xc5, 0x9f, 0xb6, 0x15, 0x44, 0x10, 0x53, 0x08, 0x23, 0x97, 0xe0, 0x18, 0x1f, 0x32,
+	0x2e, 0xba, 0x7e, 0x93, 0xbd, 0xc4, 0x29, 0x38, 0xd3, 0xe4, 0x6d, 0x71, 0x22, 0x97, 0x89, 0x02,
+	0x2b, 0x9e, 0x45, 0xf7, 0xd9, 0x4e, 0x97, 0x7e, 0x56, 0x37, 0xcb, 0x42, 0x11, 0xf3, 0x9c, 0x3a,
+	0xfd, 0x2e, 0x41, 0x08, 0x21, 0x66, 0x28, 0x0f, 0x7f, 0x1a, 0x6d, 0x6d, 0xb9, 0x56, 0x47, 0xac,
+	0x0e, 0xa2, 0x16, 0x7c, 0xc5, 0xb5, 0x3a, 0x3d, 0xdd, 0x73, 0xca, 0x4e, 0xbb, 0xe7, 0xec, 0xdf,
+	0x4f, 0x41, 0x05, 0x2c, 0xac, 0x58, 0xb0, 0x3a, 0x56, 0xc3, 0xf1, 0x9e, 0xf0, 0xac, 0x4e, 0x50,
+	0xd8, 0x39, 0x5f, 0x44, 0x44, 0x25, 0x0c, 0xdc, 0xf3, 0x39, 0x34, 0xd8, 0x60, 0x5f, 0xc0, 0x39,
+	0x27, 0x33, 0x2a, 0xae, 0x5e, 0x19, 0xe9, 0xf8, 0xe3, 0x62, 0x88, 0x09, 0xf2, 0x66, 0x3f, 0x2c,
+	0xa3, 0xad, 0x0c, 0x00, 0x7e, 0x59, 0x43, 0x28, 0x7a, 0x1b, 0x84, 0x27, 0xa5, 0x2a, 0x7a, 0x5e,
+	0x2c, 0xe9, 0x53, 0x7d, 0xe9, 0xb8, 0x0d, 0x84, 0x7c, 0xf9, 0xdd, 0xbf, 0xbd, 0x3e, 0x30, 0x86,
+	0xf5, 0x4a, 0xd6, 0x13, 0x2e, 0x1f, 0x7f, 0x45, 0x43, 0xc3, 0x21, 0x2b, 0x3e, 0xae, 0x16, 0x2d,
+	0x10, 0x4c, 0xf6, 0x23, 0x03, 0x00, 0x53, 0x0c, 0xc0, 0x51, 0x5c, 0xca, 0x06, 0x50, 0xb9, 0xd5,
+	0xb4, 0x57, 0xf1, 0x0f, 0x35, 0x34, 0x22, 0x79, 0x91, 0x81, 0x2b, 0xd9, 0x8a, 0xa4, 0x4f, 0x81,
+	0xf4, 0xd3, 0xf9, 0x19, 0x00, 0xe3, 0x19, 0x86, 0x71, 0x1a, 0x9f, 0xca, 0xc6, 0x58, 0xad, 0xad,
+	0x54, 0x59, 0xec, 0x54, 0x6e, 0xb1, 0x7f, 0x56, 0xf1, 0x1f, 0xe5, 0x0f, 0x92, 0xe0, 0x32, 0x11,
+	0x3f, 0x94, 0x17, 0x45, 0xf2, 0x66, 0x55, 0x7f, 0xb8, 0x30, 0x1f, 0x18, 0x61, 0x30, 0x23, 0x1e,
+	0xc5, 0x8f, 0xe4, 0x30, 0xa2, 0x4a, 0x33, 0xbc, 0xb0, 0xa4, 0x72, 0x0b, 0x32, 0xff, 0x2a, 0x6d,
+	0xa9, 0x0d, 0x42, 0x6b, 0x5b, 0x11, 0x61, 0x89, 0xd6, 0xbf, 0x7e, 0xa2, 0x3f, 0x21, 0x20, 0x3c,
+	0xc6, 0x10, 0x1e, 0xc6, 0x87, 0x2a, 0xd9, 0xcf, 0x05, 0xa3, 0x05, 0xc1, 0x1f, 0x78, 0x4c, 0xaa,
+	0xa4, 0x37, 0xbd, 0x3c, 0x0b, 0x22, 0xf1, 0x34, 0xa5, 0xcf, 0x82, 0x60, 0xaf, 0x4c, 0xa2, 0x05,
+	0x41, 0x59, 0x55, 0x0b, 0x22, 0xf6, 0x2c, 0x44, 0x9f, 0xec, 0x47, 0x96, 0x6b, 0x41, 0x30, 0x00,
+	0x7c, 0x41, 0xfc, 0x48, 0x43, 0xfb, 0xa4, 0x8f, 0x1e, 0xf0, 0x8c, 0x22, 0x46, 0xe4, 0x0f, 0x34,
+	0xf4, 0xd9, 0x22, 0x2c, 0x80, 0xb4, 0xc2, 0x90, 0x9e, 0xc4, 0x53, 0x52, 0xa4, 0xbd, 0xbd, 0x7f,
+	0xfc, 0x63, 0xd1, 0x16, 0x4b, 0x89, 0xc4, 0xa7, 0x73, 0x6b, 0x17, 0x78, 0x67, 0x0a, 0x70, 0xe4,
+	0x5a, 0xc5, 0x3d, 0x70, 0xb9, 0x93, 0xa3, 0x70, 0x63, 0x8d, 0x7d, 0xd5, 0x24, 0xc6, 0x5e, 0x2f,
+	0xe8, 0x53, 0x7d, 0xe9, 0xf2, 0x85, 0x1b, 0x53, 0x1a, 0x85, 0x1b, 0x4d, 0x1c, 0xc7, 0xd5, 0xa2,
+	0xf3, 0x84, 0x5b, 0x3c, 0x2d, 0xf4, 0x09, 0x37, 0x0a, 0x80, 0x7b, 0xe2, 0x97, 0x9a, 0xb8, 0x21,
+	0x96, 0x34, 0xba, 0xcf, 0xf6, 0x99, 0x0e, 0xe9, 0x2b, 0x01, 0xfd, 0xc1, 0x82, 0x5c, 0x05, 0x26,
+	0x32, 0xdd, 0xa0, 0xc7, 0x7f, 0xd0, 0xd0, 0x81, 0x0c, 0xc9, 0xf8, 0x4c, 0x11, 0x1c, 0x02, 0xfc,
+	0xd9, 0x62, 0x4c, 0x80, 0xfd, 0x12, 0xc3, 0x6e, 0xe0, 0xc7, 0x0a, 0x60, 0xaf, 0xdc, 0x12, 0x97,
+	0x0d, 0xf1, 0x5c, 0xfc, 0x55, 0x0d, 0x6d, 0x8f, 0xf7, 0x98, 0xb1, 0x22, 0xd1, 0x26, 0xbb, 0xdf,
+	0xfa, 0xc9, 0x1c, 0x94, 0x80, 0x77, 0x82, 0xe1, 0x1d, 0xc7, 0x63, 0x52, 0xbc, 0xa2, 0x7b, 0xf5,
+	0x35, 0x0d, 0x6d, 0x8b, 0xb1, 0xab, 0x36, 0x87, 0x44, 0x17, 0x59, 0x3f, 0xd1, 0x9f, 0x10, 0x80,
+	0x9c, 0x64, 0x40, 0x8e, 0xe1, 0xa3, 0x2a, 0x20, 0x3c, 0x52, 0x7f, 0x1a, 0x26, 0xc6, 0x54, 0x23,
+	0x4f, 0x99, 0x18, 0xe5, 0xed, 0x64, 0x7d, 0xb6, 0x08, 0x0b, 0x60, 0x7d, 0x90, 0x61, 0xad, 0xe0,
+	0x69, 0x15, 0xd6, 0xde, 0x8a, 0xe1, 0xfd, 0xac, 0xd6, 0xb6, 0xa8, 0x19, 0xce, 0xe5, 0xc7, 0x92,
+	0xaa, 0x1a, 0xce, 0xdf, 0x05, 0x27, 0x18, 0x33, 0xcf, 0x8c, 0xf9, 0x3f, 0xfc, 0x68, 0x2e, 0x63,
+	0xb2, 0x2a, 0x87, 0xb7, 0xc5, 0xf2, 0xeb, 0xed, 0xbc, 0xa9, 0x96, 0x5f, 0x66, 0xbb, 0x52, 0x3f,
+	0x5b, 0x8c, 0x09, 0x8c, 0x79, 0x82, 0x19, 0x33, 0x87, 0xff, 0x3f, 0x33, 0xdb, 0xf5, 0x74, 0x0b,
+	0xe5, 0xab, 0xef, 0x3d, 0x31, 0x57, 0x19, 0xfd, 0x34, 0xd5, 0x5c, 0xa9, 0x3b, 0x8c, 0xfa, 0xf9,
+	0xbb, 0xe0, 0xcc, 0x55, 0xe3, 0x65, 0xf7, 0x05, 0x63, 0x36, 0xe2, 0x37, 0xc4, 0xcb, 0xb8, 0x44,
+	0x4f, 0x0e, 0x4f, 0x2b, 0x22, 0xa8, 0xb7, 0x65, 0xa8, 0x97, 0xf3, 0x92, 0xe7, 0xcb, 0xe9, 0x9c,
+	0x85, 0x97, 0x11, 0x95, 0x5b, 0xbc, 0x19, 0xb9, 0x8a, 0x7f, 0x20, 0xa0, 0x26, 0xda, 0x67, 0x58,
+	0xa1, 0x5b, 0xd6, 0x23, 0xd4, 0x2b, 0xb9, 0xe9, 0x73, 0xad, 0xef, 0x64, 0x73, 0x2f, 0x16, 0x33,
+	0xdf, 0xd2, 0xd0, 0xae, 0x54, 0x8b, 0x04, 0x9f, 0xca, 0xd6, 0xdd, 0xd3, 0xfd, 0xd3, 0x1f, 0xc8,
+	0x47, 0x0c, 0x28, 0xa7, 0x19, 0xca, 0x29, 0x7c, 0x5c, 0x8a, 0x32, 0xdd, 0xea, 0xc3, 0x6f, 0x8a,
+	0xf3, 0x55, 0xb2, 0x83, 0xa6, 0x3a, 0x5f, 0x49, 0x9b, 0x80, 0xfa, 0xe9, 0xfc, 0x0c, 0x80, 0x74,
+	0x86, 0x21, 0x3d, 0x85, 0x4f, 0x66, 0x23, 0x4d, 0x35, 0xfa, 0xf0, 0xcf, 0xc2, 0x52, 0x32, 0xd9,
+	0x0a, 0xc3, 0xea, 0xd3, 0x9d, 0xa4, 0xff, 0xa7, 0xcf, 0x14, 0xe0, 0x00, 0xc0, 0xe7, 0x19, 0xe0,
+	0x33, 0x78, 0x46, 0x1e, 0xad, 0xe9, 0x8e, 0x5d, 0x7c, 0x79, 0xfd, 0x59, 0x43, 0x7a, 0x6a, 0xc6,
+	0x62, 0x7d, 0x32, 0xd5, 0xb1, 0x50, 0xd5, 0xdb, 0xd3, 0x1f, 0x2e, 0xcc, 0x07, 0xa6, 0x5c, 0x61,
+	0xa6, 0x3c, 0x8e, 0xe7, 0xfb, 0x46, 0x09, 0xcd, 0xf1, 0xdc, 0x0e, 0x9e, 0xe3, 0x65, 0x69, 0xf1,
+	0xdb, 0x5a, 0xec, 0x8f, 0x7c, 0xc4, 0xed, 0xae, 0x2a, 0x77, 0x48, 0x5a, 0x72, 0x7a, 0x39, 0x2f,
+	0x39, 0x98, 0x70, 0x3f, 0x33, 0x61, 0x02, 0x93, 0xcc, 0x93, 0x6d, 0xd8, 0xd4, 0xc2, 0xdf, 0xd7,
+	0x12, 0xef, 0xd4, 0x42, 0x88, 0x95, 0x7e, 0x85, 0x48, 0x1a, 0xe4, 0xe9, 0xfc, 0x0c, 0x00, 0xf3,
+	0x01, 0x06, 0x73, 0x12, 0x4f, 0x28, 0x36, 0xd2, 0x08, 0xe8, 0xef, 0x44, 0x9c, 0x48, 0xef, 0xa0,
+	0x54, 0x71, 0xa2, 0xba, 0x01, 0xd3, 0x1f, 0x2e, 0xcc, 0x07, 0xe8, 0x1f, 0x65, 0xe8, 0x1f, 0xc2,
+	0x67, 0x33, 0x76, 0x4e, 0xce, 0x5b, 0x6d, 0x73, 0xe6, 0x2a, 0xbf, 0xc8, 0x0a, 0x4b, 0x9b, 0x37,
+	0xc5, 0x72, 0x4d, 0xb5, 0x07, 0x55, 0xcb, 0x55, 0xde, 0x68, 0xd4, 0x67, 0x0a, 0x70, 0x00, 0xf6,
+	0x32, 0xc3, 0x7e, 0x02, 0x4f, 0x4a, 0xb1, 0x27, 0xff, 0xb6, 0x81, 0x82, 0xfa, 0x8b, 0xe8, 0xb8,
+	0xca, 0x9b, 0x35, 0x58, 0xe1, 0x44, 0x65, 0xfb, 0x51, 0x3f, 0x57, 0x9c, 0x11, 0x4c, 0x78, 0x92,
+	0x99, 0x30, 0x8f, 0x0d, 0xa9, 0x09, 0xc9, 0x9e, 0x4f, 0x37, 0x64, 0x8f, 0x56, 0x66, 0x3c, 0x05,
+	0x85, 0x17, 0x07, 0xe9, 0x06, 0x87, 0xaa, 0x3e, 0xce, 0xe8, 0xb7, 0xe8, 0xb3, 0x45, 0x58, 0x72,
+	0x5d, 0x1c, 0xd4, 0x2c, 0xbb, 0x6a, 0x3b, 0xb5, 0xa0, 0x1a, 0xf5, 0x54, 0xde, 0x17, 0x13, 0x22,
+	0xef, 0x11, 0xa8, 0x26, 0x44, 0xd9, 0x17, 0xd1, 0xcf, 0x15, 0x67, 0xcc, 0x55, 0x49, 0xd6, 0x29,
+	0x73, 0xb5, 0x1e, 0x72, 0x57, 0xa1, 0x59, 0x21, 0xa9, 0x8c, 0xc3, 0xd9, 0x48, 0x37, 0x13, 0x54,
+	0xb3, 0x91, 0xd1, 0x98, 0xd0, 0x67, 0x8b, 0xb0, 0xe4, 0x9a, 0x8d, 0x25, 0xca, 0x56, 0x0d, 0x1c,
+	0xaf, 0x5d, 0x85, 0x26, 0x04, 0xfe, 0x95, 0xa8, 0xe5, 0x7b, 0x5b, 0x07, 0xaa, 0x5a, 0x3e, 0xb3,
+	0x67, 0xa1, 0x9f, 0x2d, 0xc6, 0x94, 0x6b, 0x13, 0x8e, 0xe1, 0x16, 0xc9, 0x29, 0x4c, 0x47, 0xc6,
+	0xe2, 0x5b, 0x1f, 0x8d, 0x6f, 0x7a, 0xe3, 0xf6, 0xf8, 0xa6, 0xb7, 0x6e, 0x8f, 0x6b, 0xef, 0xdc,
+	0x1e, 0xd7, 0xfe, 0x7a, 0x7b, 0x5c, 0x7b, 0xed, 0xe3, 0xf1, 0x4d, 0xef, 0x7c, 0x3c, 0xbe, 0xe9,
+	0x4f, 0x1f, 0x8f, 0x6f, 0x7a, 0xb6, 0x9c, 0x68, 0x02, 0x53, 0xf1, 0xd3, 0xee, 0xd2, 0x52, 0xb3,
+	0xde, 0xb4, 0x5a, 0x42, 0x1d, 0x28, 0x64, 0x0d, 0xe1, 0xda, 0x20, 0xfb, 0x33, 0xe5, 0x33, 0xff,
+	0x1e, 0x00, 0xbc, 0x8a, 0xba, 0xb6, 0xa0, 0x3d, 0x00, 0x00,
 }
 
-func _Query_QueryAssetToPairMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAssetToPairMappingRequest)
-	if err := dec(in); err != nil {
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	QueryLends(ctx context.Context, in *QueryLendsRequest, opts ...grpc.CallOption) (*QueryLendsResponse, error)
+	QueryLend(ctx context.Context, in *QueryLendRequest, opts ...grpc.CallOption) (*QueryLendResponse, error)
+	QueryAllLendByOwner(ctx context.Context, in *QueryAllLendByOwnerRequest, opts ...grpc.CallOption) (*QueryAllLendByOwnerResponse, error)
+	QueryAllLendByOwnerAndPool(ctx context.Context, in *QueryAllLendByOwnerAndPoolRequest, opts ...grpc.CallOption) (*QueryAllLendByOwnerAndPoolResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	QueryPairs(ctx context.Context, in *QueryPairsRequest, opts ...grpc.CallOption) (*QueryPairsResponse, error)
+	QueryPair(ctx context.Context, in *QueryPairRequest, opts ...grpc.CallOption) (*QueryPairResponse, error)
+	QueryAssetRatesParams(ctx context.Context, in *QueryAssetRatesParamsRequest, opts ...grpc.CallOption) (*QueryAssetRatesParamsResponse, error)
+	QueryAssetRatesParam(ctx context.Context, in *QueryAssetRatesParamRequest, opts ...grpc.CallOption) (*QueryAssetRatesParamResponse, error)
+	QueryPools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	QueryPool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	QueryAssetToPairMappings(ctx context.Context, in *QueryAssetToPairMappingsRequest, opts ...grpc.CallOption) (*QueryAssetToPairMappingsResponse, error)
+	QueryAssetToPairMapping(ctx context.Context, in *QueryAssetToPairMappingRequest, opts ...grpc.CallOption) (*QueryAssetToPairMappingResponse, error)
+	QueryBorrows(ctx context.Context, in *QueryBorrowsRequest, opts ...grpc.CallOption) (*QueryBorrowsResponse, error)
+	QueryBorrow(ctx context.Context, in *QueryBorrowRequest, opts ...grpc.CallOption) (*QueryBorrowResponse, error)
+	QueryAllBorrowByOwner(ctx context.Context, in *QueryAllBorrowByOwnerRequest, opts ...grpc.CallOption) (*QueryAllBorrowByOwnerResponse, error)
+	QueryAllBorrowByOwnerAndPool(ctx context.Context, in *QueryAllBorrowByOwnerAndPoolRequest, opts ...grpc.CallOption) (*QueryAllBorrowByOwnerAndPoolResponse, error)
+	QueryPoolAssetLBMapping(ctx context.Context, in *QueryPoolAssetLBMappingRequest, opts ...grpc.CallOption) (*QueryPoolAssetLBMappingResponse, error)
+	QueryReserveBuybackAssetData(ctx context.Context, in *QueryReserveBuybackAssetDataRequest, opts ...grpc.CallOption) (*QueryReserveBuybackAssetDataResponse, error)
+	QueryAuctionParams(ctx context.Context, in *QueryAuctionParamRequest, opts ...grpc.CallOption) (*QueryAuctionParamResponse, error)
+	QueryModuleBalance(ctx context.Context, in *QueryModuleBalanceRequest, opts ...grpc.CallOption) (*QueryModuleBalanceResponse, error)
+	QueryFundModBal(ctx context.Context, in *QueryFundModBalRequest, opts ...grpc.CallOption) (*QueryFundModBalResponse, error)
+	QueryFundReserveBal(ctx context.Context, in *QueryFundReserveBalRequest, opts ...grpc.CallOption) (*QueryFundReserveBalResponse, error)
+	QueryAllReserveStats(ctx context.Context, in *QueryAllReserveStatsRequest, opts ...grpc.CallOption) (*QueryAllReserveStatsResponse, error)
+	QueryFundModBalByAssetPool(ctx context.Context, in *QueryFundModBalByAssetPoolRequest, opts ...grpc.CallOption) (*QueryFundModBalByAssetPoolResponse, error)
+	QueryLendInterest(ctx context.Context, in *QueryLendInterestRequest, opts ...grpc.CallOption) (*QueryLendInterestResponse, error)
+	QueryBorrowInterest(ctx context.Context, in *QueryBorrowInterestRequest, opts ...grpc.CallOption) (*QueryBorrowInterestResponse, error)
+	QueryPositionManagerGrants(ctx context.Context, in *QueryPositionManagerGrantsRequest, opts ...grpc.CallOption) (*QueryPositionManagerGrantsResponse, error)
+	QueryEModeCategories(ctx context.Context, in *QueryEModeCategoriesRequest, opts ...grpc.CallOption) (*QueryEModeCategoriesResponse, error)
+	QueryInterestRateProjection(ctx context.Context, in *QueryInterestRateProjectionRequest, opts ...grpc.CallOption) (*QueryInterestRateProjectionResponse, error)
+	QueryBadDebtWaterfall(ctx context.Context, in *QueryBadDebtWaterfallRequest, opts ...grpc.CallOption) (*QueryBadDebtWaterfallResponse, error)
+	QueryCrossCollateralAccount(ctx context.Context, in *QueryCrossCollateralAccountRequest, opts ...grpc.CallOption) (*QueryCrossCollateralAccountResponse, error)
+	QueryFixedTermMarkets(ctx context.Context, in *QueryFixedTermMarketsRequest, opts ...grpc.CallOption) (*QueryFixedTermMarketsResponse, error)
+	QueryFixedTermPositions(ctx context.Context, in *QueryFixedTermPositionsRequest, opts ...grpc.CallOption) (*QueryFixedTermPositionsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryLends(ctx context.Context, in *QueryLendsRequest, opts ...grpc.CallOption) (*QueryLendsResponse, error) {
+	out := new(QueryLendsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryLends", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAssetToPairMapping(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryAssetToPairMapping",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAssetToPairMapping(ctx, req.(*QueryAssetToPairMappingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryBorrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBorrowsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryLend(ctx context.Context, in *QueryLendRequest, opts ...grpc.CallOption) (*QueryLendResponse, error) {
+	out := new(QueryLendResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryLend", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryBorrows(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryBorrows",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryBorrows(ctx, req.(*QueryBorrowsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBorrowRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryAllLendByOwner(ctx context.Context, in *QueryAllLendByOwnerRequest, opts ...grpc.CallOption) (*QueryAllLendByOwnerResponse, error) {
+	out := new(QueryAllLendByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAllLendByOwner", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryBorrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryBorrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryBorrow(ctx, req.(*QueryBorrowRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryAllBorrowByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBorrowByOwnerRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryAllLendByOwnerAndPool(ctx context.Context, in *QueryAllLendByOwnerAndPoolRequest, opts ...grpc.CallOption) (*QueryAllLendByOwnerAndPoolResponse, error) {
+	out := new(QueryAllLendByOwnerAndPoolResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAllLendByOwnerAndPool", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAllBorrowByOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryAllBorrowByOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAllBorrowByOwner(ctx, req.(*QueryAllBorrowByOwnerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryAllBorrowByOwnerAndPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBorrowByOwnerAndPoolRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAllBorrowByOwnerAndPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryAllBorrowByOwnerAndPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAllBorrowByOwnerAndPool(ctx, req.(*QueryAllBorrowByOwnerAndPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryPoolAssetLBMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolAssetLBMappingRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryPairs(ctx context.Context, in *QueryPairsRequest, opts ...grpc.CallOption) (*QueryPairsResponse, error) {
+	out := new(QueryPairsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryPairs", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPoolAssetLBMapping(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryPoolAssetLBMapping",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPoolAssetLBMapping(ctx, req.(*QueryPoolAssetLBMappingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryReserveBuybackAssetData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReserveBuybackAssetDataRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryPair(ctx context.Context, in *QueryPairRequest, opts ...grpc.CallOption) (*QueryPairResponse, error) {
+	out := new(QueryPairResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryPair", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryReserveBuybackAssetData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryReserveBuybackAssetData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryReserveBuybackAssetData(ctx, req.(*QueryReserveBuybackAssetDataRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryAuctionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAuctionParamRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryAssetRatesParams(ctx context.Context, in *QueryAssetRatesParamsRequest, opts ...grpc.CallOption) (*QueryAssetRatesParamsResponse, error) {
+	out := new(QueryAssetRatesParamsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAssetRatesParams", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAuctionParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryAuctionParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAuctionParams(ctx, req.(*QueryAuctionParamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryModuleBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryModuleBalanceRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryAssetRatesParam(ctx context.Context, in *QueryAssetRatesParamRequest, opts ...grpc.CallOption) (*QueryAssetRatesParamResponse, error) {
+	out := new(QueryAssetRatesParamResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAssetRatesParam", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryModuleBalance(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) QueryPools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryPools", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryModuleBalance",
+	return out, nil
+}
+
+func (c *queryClient) QueryPool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryModuleBalance(ctx, req.(*QueryModuleBalanceRequest))
+	return out, nil
+}
+
+func (c *queryClient) QueryAssetToPairMappings(ctx context.Context, in *QueryAssetToPairMappingsRequest, opts ...grpc.CallOption) (*QueryAssetToPairMappingsResponse, error) {
+	out := new(QueryAssetToPairMappingsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAssetToPairMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryFundModBal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFundModBalRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryAssetToPairMapping(ctx context.Context, in *QueryAssetToPairMappingRequest, opts ...grpc.CallOption) (*QueryAssetToPairMappingResponse, error) {
+	out := new(QueryAssetToPairMappingResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAssetToPairMapping", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryFundModBal(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) QueryBorrows(ctx context.Context, in *QueryBorrowsRequest, opts ...grpc.CallOption) (*QueryBorrowsResponse, error) {
+	out := new(QueryBorrowsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryBorrows", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryFundModBal",
+	return out, nil
+}
+
+func (c *queryClient) QueryBorrow(ctx context.Context, in *QueryBorrowRequest, opts ...grpc.CallOption) (*QueryBorrowResponse, error) {
+	out := new(QueryBorrowResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryBorrow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryFundModBal(ctx, req.(*QueryFundModBalRequest))
+	return out, nil
+}
+
+func (c *queryClient) QueryAllBorrowByOwner(ctx context.Context, in *QueryAllBorrowByOwnerRequest, opts ...grpc.CallOption) (*QueryAllBorrowByOwnerResponse, error) {
+	out := new(QueryAllBorrowByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAllBorrowByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryFundReserveBal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFundReserveBalRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryAllBorrowByOwnerAndPool(ctx context.Context, in *QueryAllBorrowByOwnerAndPoolRequest, opts ...grpc.CallOption) (*QueryAllBorrowByOwnerAndPoolResponse, error) {
+	out := new(QueryAllBorrowByOwnerAndPoolResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAllBorrowByOwnerAndPool", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryFundReserveBal(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) QueryPoolAssetLBMapping(ctx context.Context, in *QueryPoolAssetLBMappingRequest, opts ...grpc.CallOption) (*QueryPoolAssetLBMappingResponse, error) {
+	out := new(QueryPoolAssetLBMappingResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryPoolAssetLBMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryFundReserveBal",
+	return out, nil
+}
+
+func (c *queryClient) QueryReserveBuybackAssetData(ctx context.Context, in *QueryReserveBuybackAssetDataRequest, opts ...grpc.CallOption) (*QueryReserveBuybackAssetDataResponse, error) {
+	out := new(QueryReserveBuybackAssetDataResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryReserveBuybackAssetData", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryFundReserveBal(ctx, req.(*QueryFundReserveBalRequest))
+	return out, nil
+}
+
+func (c *queryClient) QueryAuctionParams(ctx context.Context, in *QueryAuctionParamRequest, opts ...grpc.CallOption) (*QueryAuctionParamResponse, error) {
+	out := new(QueryAuctionParamResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAuctionParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryAllReserveStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllReserveStatsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryModuleBalance(ctx context.Context, in *QueryModuleBalanceRequest, opts ...grpc.CallOption) (*QueryModuleBalanceResponse, error) {
+	out := new(QueryModuleBalanceResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryModuleBalance", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAllReserveStats(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) QueryFundModBal(ctx context.Context, in *QueryFundModBalRequest, opts ...grpc.CallOption) (*QueryFundModBalResponse, error) {
+	out := new(QueryFundModBalResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryFundModBal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryAllReserveStats",
+	return out, nil
+}
+
+func (c *queryClient) QueryFundReserveBal(ctx context.Context, in *QueryFundReserveBalRequest, opts ...grpc.CallOption) (*QueryFundReserveBalResponse, error) {
+	out := new(QueryFundReserveBalResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryFundReserveBal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAllReserveStats(ctx, req.(*QueryAllReserveStatsRequest))
+	return out, nil
+}
+
+func (c *queryClient) QueryAllReserveStats(ctx context.Context, in *QueryAllReserveStatsRequest, opts ...grpc.CallOption) (*QueryAllReserveStatsResponse, error) {
+	out := new(QueryAllReserveStatsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryAllReserveStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryFundModBalByAssetPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFundModBalByAssetPoolRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryFundModBalByAssetPool(ctx context.Context, in *QueryFundModBalByAssetPoolRequest, opts ...grpc.CallOption) (*QueryFundModBalByAssetPoolResponse, error) {
+	out := new(QueryFundModBalByAssetPoolResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryFundModBalByAssetPool", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryFundModBalByAssetPool(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) QueryLendInterest(ctx context.Context, in *QueryLendInterestRequest, opts ...grpc.CallOption) (*QueryLendInterestResponse, error) {
+	out := new(QueryLendInterestResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryLendInterest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryFundModBalByAssetPool",
+	return out, nil
+}
+
+func (c *queryClient) QueryBorrowInterest(ctx context.Context, in *QueryBorrowInterestRequest, opts ...grpc.CallOption) (*QueryBorrowInterestResponse, error) {
+	out := new(QueryBorrowInterestResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryBorrowInterest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryFundModBalByAssetPool(ctx, req.(*QueryFundModBalByAssetPoolRequest))
+	return out, nil
+}
+
+func (c *queryClient) QueryPositionManagerGrants(ctx context.Context, in *QueryPositionManagerGrantsRequest, opts ...grpc.CallOption) (*QueryPositionManagerGrantsResponse, error) {
+	out := new(QueryPositionManagerGrantsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryPositionManagerGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryLendInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLendInterestRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryEModeCategories(ctx context.Context, in *QueryEModeCategoriesRequest, opts ...grpc.CallOption) (*QueryEModeCategoriesResponse, error) {
+	out := new(QueryEModeCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryEModeCategories", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryLendInterest(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) QueryInterestRateProjection(ctx context.Context, in *QueryInterestRateProjectionRequest, opts ...grpc.CallOption) (*QueryInterestRateProjectionResponse, error) {
+	out := new(QueryInterestRateProjectionResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryInterestRateProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryLendInterest",
+	return out, nil
+}
+
+func (c *queryClient) QueryBadDebtWaterfall(ctx context.Context, in *QueryBadDebtWaterfallRequest, opts ...grpc.CallOption) (*QueryBadDebtWaterfallResponse, error) {
+	out := new(QueryBadDebtWaterfallResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryBadDebtWaterfall", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryLendInterest(ctx, req.(*QueryLendInterestRequest))
+	return out, nil
+}
+
+func (c *queryClient) QueryCrossCollateralAccount(ctx context.Context, in *QueryCrossCollateralAccountRequest, opts ...grpc.CallOption) (*QueryCrossCollateralAccountResponse, error) {
+	out := new(QueryCrossCollateralAccountResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryCrossCollateralAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryBorrowInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBorrowInterestRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryFixedTermMarkets(ctx context.Context, in *QueryFixedTermMarketsRequest, opts ...grpc.CallOption) (*QueryFixedTermMarketsResponse, error) {
+	out := new(QueryFixedTermMarketsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryFixedTermMarkets", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryBorrowInterest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Query/QueryBorrowInterest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryBorrowInterest(ctx, req.(*QueryBorrowInterestRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "comdex.lend.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryLends",
-			Handler:    _Query_QueryLends_Handler,
-		},
-		{
-			MethodName: "QueryLend",
-			Handler:    _Query_QueryLend_Handler,
-		},
-		{
-			MethodName: "QueryAllLendByOwner",
-			Handler:    _Query_QueryAllLendByOwner_Handler,
-		},
-		{
-			MethodName: "QueryAllLendByOwnerAndPool",
-			Handler:    _Query_QueryAllLendByOwnerAndPool_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "QueryPairs",
-			Handler:    _Query_QueryPairs_Handler,
-		},
-		{
-			MethodName: "QueryPair",
-			Handler:    _Query_QueryPair_Handler,
-		},
-		{
-			MethodName: "QueryAssetRatesParams",
-			Handler:    _Query_QueryAssetRatesParams_Handler,
-		},
-		{
-			MethodName: "QueryAssetRatesParam",
-			Handler:    _Query_QueryAssetRatesParam_Handler,
-		},
-		{
-			MethodName: "QueryPools",
-			Handler:    _Query_QueryPools_Handler,
-		},
-		{
-			MethodName: "QueryPool",
-			Handler:    _Query_QueryPool_Handler,
-		},
-		{
-			MethodName: "QueryAssetToPairMappings",
-			Handler:    _Query_QueryAssetToPairMappings_Handler,
-		},
-		{
-			MethodName: "QueryAssetToPairMapping",
-			Handler:    _Query_QueryAssetToPairMapping_Handler,
-		},
-		{
-			MethodName: "QueryBorrows",
-			Handler:    _Query_QueryBorrows_Handler,
-		},
-		{
-			MethodName: "QueryBorrow",
-			Handler:    _Query_QueryBorrow_Handler,
-		},
-		{
-			MethodName: "QueryAllBorrowByOwner",
-			Handler:    _Query_QueryAllBorrowByOwner_Handler,
-		},
-		{
-			MethodName: "QueryAllBorrowByOwnerAndPool",
-			Handler:    _Query_QueryAllBorrowByOwnerAndPool_Handler,
-		},
-		{
-			MethodName: "QueryPoolAssetLBMapping",
-			Handler:    _Query_QueryPoolAssetLBMapping_Handler,
-		},
-		{
-			MethodName: "QueryReserveBuybackAssetData",
-			Handler:    _Query_QueryReserveBuybackAssetData_Handler,
-		},
-		{
-			MethodName: "QueryAuctionParams",
-			Handler:    _Query_QueryAuctionParams_Handler,
-		},
-		{
-			MethodName: "QueryModuleBalance",
-			Handler:    _Query_QueryModuleBalance_Handler,
-		},
-		{
-			MethodName: "QueryFundModBal",
-			Handler:    _Query_QueryFundModBal_Handler,
-		},
-		{
-			MethodName: "QueryFundReserveBal",
-			Handler:    _Query_QueryFundReserveBal_Handler,
-		},
-		{
-			MethodName: "QueryAllReserveStats",
-			Handler:    _Query_QueryAllReserveStats_Handler,
-		},
-		{
-			MethodName: "QueryFundModBalByAssetPool",
-			Handler:    _Query_QueryFundModBalByAssetPool_Handler,
-		},
-		{
-			MethodName: "QueryLendInterest",
-			Handler:    _Query_QueryLendInterest_Handler,
-		},
-		{
-			MethodName: "QueryBorrowInterest",
-			Handler:    _Query_QueryBorrowInterest_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "comdex/lend/v1beta1/query.proto",
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryFixedTermPositions(ctx context.Context, in *QueryFixedTermPositionsRequest, opts ...grpc.CallOption) (*QueryFixedTermPositionsResponse, error) {
+	out := new(QueryFixedTermPositionsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Query/QueryFixedTermPositions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	QueryLends(context.Context, *QueryLendsRequest) (*QueryLendsResponse, error)
+	QueryLend(context.Context, *QueryLendRequest) (*QueryLendResponse, error)
+	QueryAllLendByOwner(context.Context, *QueryAllLendByOwnerRequest) (*QueryAllLendByOwnerResponse, error)
+	QueryAllLendByOwnerAndPool(context.Context, *QueryAllLendByOwnerAndPoolRequest) (*QueryAllLendByOwnerAndPoolResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	QueryPairs(context.Context, *QueryPairsRequest) (*QueryPairsResponse, error)
+	QueryPair(context.Context, *QueryPairRequest) (*QueryPairResponse, error)
+	QueryAssetRatesParams(context.Context, *QueryAssetRatesParamsRequest) (*QueryAssetRatesParamsResponse, error)
+	QueryAssetRatesParam(context.Context, *QueryAssetRatesParamRequest) (*QueryAssetRatesParamResponse, error)
+	QueryPools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	QueryPool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	QueryAssetToPairMappings(context.Context, *QueryAssetToPairMappingsRequest) (*QueryAssetToPairMappingsResponse, error)
+	QueryAssetToPairMapping(context.Context, *QueryAssetToPairMappingRequest) (*QueryAssetToPairMappingResponse, error)
+	QueryBorrows(context.Context, *QueryBorrowsRequest) (*QueryBorrowsResponse, error)
+	QueryBorrow(context.Context, *QueryBorrowRequest) (*QueryBorrowResponse, error)
+	QueryAllBorrowByOwner(context.Context, *QueryAllBorrowByOwnerRequest) (*QueryAllBorrowByOwnerResponse, error)
+	QueryAllBorrowByOwnerAndPool(context.Context, *QueryAllBorrowByOwnerAndPoolRequest) (*QueryAllBorrowByOwnerAndPoolResponse, error)
+	QueryPoolAssetLBMapping(context.Context, *QueryPoolAssetLBMappingRequest) (*QueryPoolAssetLBMappingResponse, error)
+	QueryReserveBuybackAssetData(context.Context, *QueryReserveBuybackAssetDataRequest) (*QueryReserveBuybackAssetDataResponse, error)
+	QueryAuctionParams(context.Context, *QueryAuctionParamRequest) (*QueryAuctionParamResponse, error)
+	QueryModuleBalance(context.Context, *QueryModuleBalanceRequest) (*QueryModuleBalanceResponse, error)
+	QueryFundModBal(context.Context, *QueryFundModBalRequest) (*QueryFundModBalResponse, error)
+	QueryFundReserveBal(context.Context, *QueryFundReserveBalRequest) (*QueryFundReserveBalResponse, error)
+	QueryAllReserveStats(context.Context, *QueryAllReserveStatsRequest) (*QueryAllReserveStatsResponse, error)
+	QueryFundModBalByAssetPool(context.Context, *QueryFundModBalByAssetPoolRequest) (*QueryFundModBalByAssetPoolResponse, error)
+	QueryLendInterest(context.Context, *QueryLendInterestRequest) (*QueryLendInterestResponse, error)
+	QueryBorrowInterest(context.Context, *QueryBorrowInterestRequest) (*QueryBorrowInterestResponse, error)
+	QueryPositionManagerGrants(context.Context, *QueryPositionManagerGrantsRequest) (*QueryPositionManagerGrantsResponse, error)
+	QueryEModeCategories(context.Context, *QueryEModeCategoriesRequest) (*QueryEModeCategoriesResponse, error)
+	QueryInterestRateProjection(context.Context, *QueryInterestRateProjectionRequest) (*QueryInterestRateProjectionResponse, error)
+	QueryBadDebtWaterfall(context.Context, *QueryBadDebtWaterfallRequest) (*QueryBadDebtWaterfallResponse, error)
+	QueryCrossCollateralAccount(context.Context, *QueryCrossCollateralAccountRequest) (*QueryCrossCollateralAccountResponse, error)
+	QueryFixedTermMarkets(context.Context, *QueryFixedTermMarketsRequest) (*QueryFixedTermMarketsResponse, error)
+	QueryFixedTermPositions(context.Context, *QueryFixedTermPositionsRequest) (*QueryFixedTermPositionsResponse, error)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) QueryLends(ctx context.Context, req *QueryLendsRequest) (*QueryLendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryLends not implemented")
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) QueryLend(ctx context.Context, req *QueryLendRequest) (*QueryLendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryLend not implemented")
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) QueryAllLendByOwner(ctx context.Context, req *QueryAllLendByOwnerRequest) (*QueryAllLendByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllLendByOwner not implemented")
 }
-
-func (m *QueryLendsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) QueryAllLendByOwnerAndPool(ctx context.Context, req *QueryAllLendByOwnerAndPoolRequest) (*QueryAllLendByOwnerAndPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllLendByOwnerAndPool not implemented")
 }
-
-func (m *QueryLendsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryLendsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) QueryPairs(ctx context.Context, req *QueryPairsRequest) (*QueryPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPairs not implemented")
 }
-
-func (m *QueryLendsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) QueryPair(ctx context.Context, req *QueryPairRequest) (*QueryPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPair not implemented")
+}
+func (*UnimplementedQueryServer) QueryAssetRatesParams(ctx context.Context, req *QueryAssetRatesParamsRequest) (*QueryAssetRatesParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetRatesParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryAssetRatesParam(ctx context.Context, req *QueryAssetRatesParamRequest) (*QueryAssetRatesParamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetRatesParam not implemented")
+}
+func (*UnimplementedQueryServer) QueryPools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPools not implemented")
+}
+func (*UnimplementedQueryServer) QueryPool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPool not implemented")
+}
+func (*UnimplementedQueryServer) QueryAssetToPairMappings(ctx context.Context, req *QueryAssetToPairMappingsRequest) (*QueryAssetToPairMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetToPairMappings not implemented")
+}
+func (*UnimplementedQueryServer) QueryAssetToPairMapping(ctx context.Context, req *QueryAssetToPairMappingRequest) (*QueryAssetToPairMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetToPairMapping not implemented")
+}
+func (*UnimplementedQueryServer) QueryBorrows(ctx context.Context, req *QueryBorrowsRequest) (*QueryBorrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBorrows not implemented")
+}
+func (*UnimplementedQueryServer) QueryBorrow(ctx context.Context, req *QueryBorrowRequest) (*QueryBorrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBorrow not implemented")
+}
+func (*UnimplementedQueryServer) QueryAllBorrowByOwner(ctx context.Context, req *QueryAllBorrowByOwnerRequest) (*QueryAllBorrowByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllBorrowByOwner not implemented")
+}
+func (*UnimplementedQueryServer) QueryAllBorrowByOwnerAndPool(ctx context.Context, req *QueryAllBorrowByOwnerAndPoolRequest) (*QueryAllBorrowByOwnerAndPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllBorrowByOwnerAndPool not implemented")
+}
+func (*UnimplementedQueryServer) QueryPoolAssetLBMapping(ctx context.Context, req *QueryPoolAssetLBMappingRequest) (*QueryPoolAssetLBMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPoolAssetLBMapping not implemented")
+}
+func (*UnimplementedQueryServer) QueryReserveBuybackAssetData(ctx context.Context, req *QueryReserveBuybackAssetDataRequest) (*QueryReserveBuybackAssetDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryReserveBuybackAssetData not implemented")
+}
+func (*UnimplementedQueryServer) QueryAuctionParams(ctx context.Context, req *QueryAuctionParamRequest) (*QueryAuctionParamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuctionParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryModuleBalance(ctx context.Context, req *QueryModuleBalanceRequest) (*QueryModuleBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryModuleBalance not implemented")
+}
+func (*UnimplementedQueryServer) QueryFundModBal(ctx context.Context, req *QueryFundModBalRequest) (*QueryFundModBalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFundModBal not implemented")
+}
+func (*UnimplementedQueryServer) QueryFundReserveBal(ctx context.Context, req *QueryFundReserveBalRequest) (*QueryFundReserveBalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFundReserveBal not implemented")
+}
+func (*UnimplementedQueryServer) QueryAllReserveStats(ctx context.Context, req *QueryAllReserveStatsRequest) (*QueryAllReserveStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllReserveStats not implemented")
+}
+func (*UnimplementedQueryServer) QueryFundModBalByAssetPool(ctx context.Context, req *QueryFundModBalByAssetPoolRequest) (*QueryFundModBalByAssetPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFundModBalByAssetPool not implemented")
+}
+func (*UnimplementedQueryServer) QueryLendInterest(ctx context.Context, req *QueryLendInterestRequest) (*QueryLendInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryLendInterest not implemented")
+}
+func (*UnimplementedQueryServer) QueryBorrowInterest(ctx context.Context, req *QueryBorrowInterestRequest) (*QueryBorrowInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBorrowInterest not implemented")
+}
+func (*UnimplementedQueryServer) QueryPositionManagerGrants(ctx context.Context, req *QueryPositionManagerGrantsRequest) (*QueryPositionManagerGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPositionManagerGrants not implemented")
+}
+func (*UnimplementedQueryServer) QueryEModeCategories(ctx context.Context, req *QueryEModeCategoriesRequest) (*QueryEModeCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEModeCategories not implemented")
+}
+func (*UnimplementedQueryServer) QueryInterestRateProjection(ctx context.Context, req *QueryInterestRateProjectionRequest) (*QueryInterestRateProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryInterestRateProjection not implemented")
+}
+func (*UnimplementedQueryServer) QueryBadDebtWaterfall(ctx context.Context, req *QueryBadDebtWaterfallRequest) (*QueryBadDebtWaterfallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBadDebtWaterfall not implemented")
+}
+func (*UnimplementedQueryServer) QueryCrossCollateralAccount(ctx context.Context, req *QueryCrossCollateralAccountRequest) (*QueryCrossCollateralAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCrossCollateralAccount not implemented")
+}
+func (*UnimplementedQueryServer) QueryFixedTermMarkets(ctx context.Context, req *QueryFixedTermMarketsRequest) (*QueryFixedTermMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFixedTermMarkets not implemented")
+}
+func (*UnimplementedQueryServer) QueryFixedTermPositions(ctx context.Context, req *QueryFixedTermPositionsRequest) (*QueryFixedTermPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFixedTermPositions not implemented")
 }
 
-func (m *QueryLendsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryLendsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_QueryLends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Lends) > 0 {
-		for iNdEx := len(m.Lends) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Lends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryLends(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryLends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryLends(ctx, req.(*QueryLendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLendRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryLend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLendRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLendRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryLendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).QueryLend(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryLend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryLend(ctx, req.(*QueryLendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryAllLendByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLendByOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryLendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Lend.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllLendByOwner(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryAllLendByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllLendByOwner(ctx, req.(*QueryAllLendByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllLendByOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryAllLendByOwnerAndPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLendByOwnerAndPoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllLendByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllLendByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllLendByOwnerAndPool(ctx, in)
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryAllLendByOwnerAndPool",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllLendByOwnerAndPool(ctx, req.(*QueryAllLendByOwnerAndPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllLendByOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllLendByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllLendByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if len(m.Lends) > 0 {
-		for iNdEx := len(m.Lends) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Lends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/Params",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllLendByOwnerAndPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPairsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllLendByOwnerAndPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllLendByOwnerAndPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPairs(ctx, in)
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryPairs",
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPairs(ctx, req.(*QueryPairsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllLendByOwnerAndPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPairRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllLendByOwnerAndPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPair(ctx, req.(*QueryPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllLendByOwnerAndPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_QueryAssetRatesParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetRatesParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Lends) > 0 {
-		for iNdEx := len(m.Lends) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Lends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAssetRatesParams(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryAssetRatesParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAssetRatesParams(ctx, req.(*QueryAssetRatesParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPairsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryAssetRatesParam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetRatesParamRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAssetRatesParam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryAssetRatesParam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAssetRatesParam(ctx, req.(*QueryAssetRatesParamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPairsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_QueryPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPairsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryAssetToPairMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetToPairMappingsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAssetToPairMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryAssetToPairMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAssetToPairMappings(ctx, req.(*QueryAssetToPairMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPairsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_QueryAssetToPairMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetToPairMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ExtendedPairs) > 0 {
-		for iNdEx := len(m.ExtendedPairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExtendedPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAssetToPairMapping(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryAssetToPairMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAssetToPairMapping(ctx, req.(*QueryAssetToPairMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPairRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryBorrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBorrowsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPairRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBorrows(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryBorrows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBorrows(ctx, req.(*QueryBorrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBorrowRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ExtendedPair.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBorrow(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryBorrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBorrow(ctx, req.(*QueryBorrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAssetRatesParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryAllBorrowByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBorrowByOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAssetRatesParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAssetRatesParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllBorrowByOwner(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryAllBorrowByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllBorrowByOwner(ctx, req.(*QueryAllBorrowByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAssetRatesParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryAllBorrowByOwnerAndPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBorrowByOwnerAndPoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAssetRatesParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllBorrowByOwnerAndPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryAllBorrowByOwnerAndPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllBorrowByOwnerAndPool(ctx, req.(*QueryAllBorrowByOwnerAndPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAssetRatesParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_QueryPoolAssetLBMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolAssetLBMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.AssetRatesParams) > 0 {
-		for iNdEx := len(m.AssetRatesParams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AssetRatesParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPoolAssetLBMapping(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryPoolAssetLBMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPoolAssetLBMapping(ctx, req.(*QueryPoolAssetLBMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAssetRatesParamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryReserveBuybackAssetData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReserveBuybackAssetDataRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryReserveBuybackAssetData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryReserveBuybackAssetData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryReserveBuybackAssetData(ctx, req.(*QueryReserveBuybackAssetDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAssetRatesParamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAssetRatesParamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func _Query_QueryAuctionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionParamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAuctionParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryAuctionParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAuctionParams(ctx, req.(*QueryAuctionParamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAssetRatesParamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryModuleBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleBalanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAssetRatesParamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAssetRatesParamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AssetRatesparams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryModuleBalance(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryModuleBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryModuleBalance(ctx, req.(*QueryModuleBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryFundModBal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundModBalRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFundModBal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryFundModBal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFundModBal(ctx, req.(*QueryFundModBalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryFundReserveBal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundReserveBalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFundReserveBal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryFundReserveBal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFundReserveBal(ctx, req.(*QueryFundReserveBalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_QueryAllReserveStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllReserveStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllReserveStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryAllReserveStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllReserveStats(ctx, req.(*QueryAllReserveStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryFundModBalByAssetPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundModBalByAssetPoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFundModBalByAssetPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryFundModBalByAssetPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFundModBalByAssetPool(ctx, req.(*QueryFundModBalByAssetPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryLendInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLendInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryLendInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryLendInterest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryLendInterest(ctx, req.(*QueryLendInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_QueryBorrowInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBorrowInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBorrowInterest(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryBorrowInterest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBorrowInterest(ctx, req.(*QueryBorrowInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPositionManagerGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionManagerGrantsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPositionManagerGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryPositionManagerGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPositionManagerGrants(ctx, req.(*QueryPositionManagerGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryEModeCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEModeCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryEModeCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryEModeCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryEModeCategories(ctx, req.(*QueryEModeCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+func _Query_QueryInterestRateProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterestRateProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryInterestRateProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryInterestRateProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryInterestRateProjection(ctx, req.(*QueryInterestRateProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryBadDebtWaterfall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBadDebtWaterfallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBadDebtWaterfall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryBadDebtWaterfall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBadDebtWaterfall(ctx, req.(*QueryBadDebtWaterfallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCrossCollateralAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrossCollateralAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCrossCollateralAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryCrossCollateralAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCrossCollateralAccount(ctx, req.(*QueryCrossCollateralAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryFixedTermMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFixedTermMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFixedTermMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryFixedTermMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFixedTermMarkets(ctx, req.(*QueryFixedTermMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryFixedTermPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFixedTermPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFixedTermPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Query/QueryFixedTermPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFixedTermPositions(ctx, req.(*QueryFixedTermPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "comdex.lend.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryLends",
+			Handler:    _Query_QueryLends_Handler,
+		},
+		{
+			MethodName: "QueryLend",
+			Handler:    _Query_QueryLend_Handler,
+		},
+		{
+			MethodName: "QueryAllLendByOwner",
+			Handler:    _Query_QueryAllLendByOwner_Handler,
+		},
+		{
+			MethodName: "QueryAllLendByOwnerAndPool",
+			Handler:    _Query_QueryAllLendByOwnerAndPool_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "QueryPairs",
+			Handler:    _Query_QueryPairs_Handler,
+		},
+		{
+			MethodName: "QueryPair",
+			Handler:    _Query_QueryPair_Handler,
+		},
+		{
+			MethodName: "QueryAssetRatesParams",
+			Handler:    _Query_QueryAssetRatesParams_Handler,
+		},
+		{
+			MethodName: "QueryAssetRatesParam",
+			Handler:    _Query_QueryAssetRatesParam_Handler,
+		},
+		{
+			MethodName: "QueryPools",
+			Handler:    _Query_QueryPools_Handler,
+		},
+		{
+			MethodName: "QueryPool",
+			Handler:    _Query_QueryPool_Handler,
+		},
+		{
+			MethodName: "QueryAssetToPairMappings",
+			Handler:    _Query_QueryAssetToPairMappings_Handler,
+		},
+		{
+			MethodName: "QueryAssetToPairMapping",
+			Handler:    _Query_QueryAssetToPairMapping_Handler,
+		},
+		{
+			MethodName: "QueryBorrows",
+			Handler:    _Query_QueryBorrows_Handler,
+		},
+		{
+			MethodName: "QueryBorrow",
+			Handler:    _Query_QueryBorrow_Handler,
+		},
+		{
+			MethodName: "QueryAllBorrowByOwner",
+			Handler:    _Query_QueryAllBorrowByOwner_Handler,
+		},
+		{
+			MethodName: "QueryAllBorrowByOwnerAndPool",
+			Handler:    _Query_QueryAllBorrowByOwnerAndPool_Handler,
+		},
+		{
+			MethodName: "QueryPoolAssetLBMapping",
+			Handler:    _Query_QueryPoolAssetLBMapping_Handler,
+		},
+		{
+			MethodName: "QueryReserveBuybackAssetData",
+			Handler:    _Query_QueryReserveBuybackAssetData_Handler,
+		},
+		{
+			MethodName: "QueryAuctionParams",
+			Handler:    _Query_QueryAuctionParams_Handler,
+		},
+		{
+			MethodName: "QueryModuleBalance",
+			Handler:    _Query_QueryModuleBalance_Handler,
+		},
+		{
+			MethodName: "QueryFundModBal",
+			Handler:    _Query_QueryFundModBal_Handler,
+		},
+		{
+			MethodName: "QueryFundReserveBal",
+			Handler:    _Query_QueryFundReserveBal_Handler,
+		},
+		{
+			MethodName: "QueryAllReserveStats",
+			Handler:    _Query_QueryAllReserveStats_Handler,
+		},
+		{
+			MethodName: "QueryFundModBalByAssetPool",
+			Handler:    _Query_QueryFundModBalByAssetPool_Handler,
+		},
+		{
+			MethodName: "QueryLendInterest",
+			Handler:    _Query_QueryLendInterest_Handler,
+		},
+		{
+			MethodName: "QueryBorrowInterest",
+			Handler:    _Query_QueryBorrowInterest_Handler,
+		},
+		{
+			MethodName: "QueryPositionManagerGrants",
+			Handler:    _Query_QueryPositionManagerGrants_Handler,
+		},
+		{
+			MethodName: "QueryEModeCategories",
+			Handler:    _Query_QueryEModeCategories_Handler,
+		},
+		{
+			MethodName: "QueryInterestRateProjection",
+			Handler:    _Query_QueryInterestRateProjection_Handler,
+		},
+		{
+			MethodName: "QueryBadDebtWaterfall",
+			Handler:    _Query_QueryBadDebtWaterfall_Handler,
+		},
+		{
+			MethodName: "QueryCrossCollateralAccount",
+			Handler:    _Query_QueryCrossCollateralAccount_Handler,
+		},
+		{
+			MethodName: "QueryFixedTermMarkets",
+			Handler:    _Query_QueryFixedTermMarkets_Handler,
+		},
+		{
+			MethodName: "QueryFixedTermPositions",
+			Handler:    _Query_QueryFixedTermPositions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comdex/lend/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4072,18 +4192,18 @@ func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4095,7 +4215,7 @@ func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetToPairMappingsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryLendsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4105,12 +4225,12 @@ func (m *QueryAssetToPairMappingsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAssetToPairMappingsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLendsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAssetToPairMappingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLendsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4130,7 +4250,7 @@ func (m *QueryAssetToPairMappingsRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetToPairMappingsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryLendsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4140,12 +4260,12 @@ func (m *QueryAssetToPairMappingsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAssetToPairMappingsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLendsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAssetToPairMappingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLendsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4162,10 +4282,10 @@ func (m *QueryAssetToPairMappingsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.AssetToPairMappings) > 0 {
-		for iNdEx := len(m.AssetToPairMappings) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Lends) > 0 {
+		for iNdEx := len(m.Lends) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AssetToPairMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Lends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4179,7 +4299,7 @@ func (m *QueryAssetToPairMappingsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetToPairMappingRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryLendRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4189,30 +4309,25 @@ func (m *QueryAssetToPairMappingRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAssetToPairMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLendRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAssetToPairMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.AssetId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetToPairMappingResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryLendResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4222,18 +4337,18 @@ func (m *QueryAssetToPairMappingResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAssetToPairMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLendResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAssetToPairMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.AssetToPairMapping.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Lend.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4245,7 +4360,7 @@ func (m *QueryAssetToPairMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBorrowsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllLendByOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4255,12 +4370,12 @@ func (m *QueryBorrowsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBorrowsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllLendByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBorrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllLendByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4275,12 +4390,19 @@ func (m *QueryBorrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBorrowsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllLendByOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4290,12 +4412,12 @@ func (m *QueryBorrowsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBorrowsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllLendByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBorrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllLendByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4312,10 +4434,10 @@ func (m *QueryBorrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Borrows) > 0 {
-		for iNdEx := len(m.Borrows) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Lends) > 0 {
+		for iNdEx := len(m.Lends) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Borrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Lends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4329,68 +4451,7 @@ func (m *QueryBorrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBorrowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBorrowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBorrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryBorrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Borrow.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryAllBorrowByOwnerRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllLendByOwnerAndPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4400,12 +4461,12 @@ func (m *QueryAllBorrowByOwnerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBorrowByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllLendByOwnerAndPoolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBorrowByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllLendByOwnerAndPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4420,7 +4481,12 @@ func (m *QueryAllBorrowByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
@@ -4432,7 +4498,7 @@ func (m *QueryAllBorrowByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBorrowByOwnerResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllLendByOwnerAndPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4442,12 +4508,12 @@ func (m *QueryAllBorrowByOwnerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBorrowByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllLendByOwnerAndPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBorrowByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllLendByOwnerAndPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4464,10 +4530,10 @@ func (m *QueryAllBorrowByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Borrows) > 0 {
-		for iNdEx := len(m.Borrows) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Lends) > 0 {
+		for iNdEx := len(m.Lends) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Borrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Lends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4481,7 +4547,7 @@ func (m *QueryAllBorrowByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBorrowByOwnerAndPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPairsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4491,12 +4557,12 @@ func (m *QueryAllBorrowByOwnerAndPoolRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBorrowByOwnerAndPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPairsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBorrowByOwnerAndPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4511,24 +4577,12 @@ func (m *QueryAllBorrowByOwnerAndPoolRequest) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBorrowByOwnerAndPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPairsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4538,12 +4592,12 @@ func (m *QueryAllBorrowByOwnerAndPoolResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBorrowByOwnerAndPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPairsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBorrowByOwnerAndPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4560,10 +4614,10 @@ func (m *QueryAllBorrowByOwnerAndPoolResponse) MarshalToSizedBuffer(dAtA []byte)
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Borrows) > 0 {
-		for iNdEx := len(m.Borrows) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ExtendedPairs) > 0 {
+		for iNdEx := len(m.ExtendedPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Borrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ExtendedPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4577,7 +4631,7 @@ func (m *QueryAllBorrowByOwnerAndPoolResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolAssetLBMappingRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPairRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4587,30 +4641,25 @@ func (m *QueryPoolAssetLBMappingRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPoolAssetLBMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPairRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolAssetLBMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.AssetId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolAssetLBMappingResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPairResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4620,18 +4669,30 @@ func (m *QueryPoolAssetLBMappingResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPoolAssetLBMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPairResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolAssetLBMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.EModeCategory != nil {
+		{
+			size, err := m.EModeCategory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.PoolAssetLBMapping.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ExtendedPair.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4643,7 +4704,7 @@ func (m *QueryPoolAssetLBMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReserveBuybackAssetDataRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAssetRatesParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4653,25 +4714,32 @@ func (m *QueryReserveBuybackAssetDataRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryReserveBuybackAssetDataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAssetRatesParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReserveBuybackAssetDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAssetRatesParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AssetId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReserveBuybackAssetDataResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAssetRatesParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4681,30 +4749,46 @@ func (m *QueryReserveBuybackAssetDataResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryReserveBuybackAssetDataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAssetRatesParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReserveBuybackAssetDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAssetRatesParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.ReserveBuybackAssetData.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssetRatesParams) > 0 {
+		for iNdEx := len(m.AssetRatesParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetRatesParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuctionParamRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAssetRatesParamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4714,25 +4798,25 @@ func (m *QueryAuctionParamRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAuctionParamRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAssetRatesParamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuctionParamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAssetRatesParamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AppId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuctionParamResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAssetRatesParamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4742,18 +4826,18 @@ func (m *QueryAuctionParamResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAuctionParamResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAssetRatesParamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuctionParamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAssetRatesParamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.AuctionParams.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.AssetRatesparams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4765,7 +4849,7 @@ func (m *QueryAuctionParamResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleBalanceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4775,25 +4859,32 @@ func (m *QueryModuleBalanceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryModuleBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModuleBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleBalanceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4803,30 +4894,46 @@ func (m *QueryModuleBalanceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryModuleBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModuleBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.ModuleBalance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFundModBalRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4836,20 +4943,25 @@ func (m *QueryFundModBalRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFundModBalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFundModBalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFundModBalResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4859,18 +4971,18 @@ func (m *QueryFundModBalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFundModBalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFundModBalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.FundModBalance.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4882,7 +4994,7 @@ func (m *QueryFundModBalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFundReserveBalRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAssetToPairMappingsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4892,20 +5004,32 @@ func (m *QueryFundReserveBalRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFundReserveBalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAssetToPairMappingsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFundReserveBalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAssetToPairMappingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFundReserveBalResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAssetToPairMappingsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4915,30 +5039,46 @@ func (m *QueryFundReserveBalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFundReserveBalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAssetToPairMappingsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFundReserveBalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAssetToPairMappingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.FundReserveBalance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssetToPairMappings) > 0 {
+		for iNdEx := len(m.AssetToPairMappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetToPairMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllReserveStatsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAssetToPairMappingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4948,16 +5088,21 @@ func (m *QueryAllReserveStatsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllReserveStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAssetToPairMappingRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllReserveStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAssetToPairMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.AssetId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
 		i--
@@ -4966,7 +5111,7 @@ func (m *QueryAllReserveStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllReserveStatsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAssetToPairMappingResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4976,18 +5121,18 @@ func (m *QueryAllReserveStatsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllReserveStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAssetToPairMappingResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllReserveStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAssetToPairMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.AllReserveStats.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.AssetToPairMapping.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4999,7 +5144,7 @@ func (m *QueryAllReserveStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFundModBalByAssetPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBorrowsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5009,30 +5154,32 @@ func (m *QueryFundModBalByAssetPoolRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFundModBalByAssetPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBorrowsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFundModBalByAssetPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBorrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.AssetId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFundModBalByAssetPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBorrowsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5042,30 +5189,46 @@ func (m *QueryFundModBalByAssetPoolResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryFundModBalByAssetPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBorrowsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFundModBalByAssetPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBorrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Borrows) > 0 {
+		for iNdEx := len(m.Borrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Borrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLendInterestRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBorrowRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5075,20 +5238,25 @@ func (m *QueryLendInterestRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLendInterestRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBorrowRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLendInterestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBorrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLendInterestResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBorrowResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5098,34 +5266,30 @@ func (m *QueryLendInterestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLendInterestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLendInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PoolInterest) > 0 {
-		for iNdEx := len(m.PoolInterest) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolInterest[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Borrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBorrowInterestRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllBorrowByOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5135,20 +5299,39 @@ func (m *QueryBorrowInterestRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBorrowInterestRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllBorrowByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBorrowInterestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllBorrowByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBorrowInterestResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllBorrowByOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5158,20 +5341,32 @@ func (m *QueryBorrowInterestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBorrowInterestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllBorrowByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBorrowInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllBorrowByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PoolInterest) > 0 {
-		for iNdEx := len(m.PoolInterest) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Borrows) > 0 {
+		for iNdEx := len(m.Borrows) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PoolInterest[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Borrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5185,759 +5380,3838 @@ func (m *QueryBorrowInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBorrowByOwnerAndPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllBorrowByOwnerAndPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLendsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllBorrowByOwnerAndPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLendsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBorrowByOwnerAndPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBorrowByOwnerAndPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBorrowByOwnerAndPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Lends) > 0 {
-		for _, e := range m.Lends {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Borrows) > 0 {
+		for iNdEx := len(m.Borrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Borrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLendRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryPoolAssetLBMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLendResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Lend.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPoolAssetLBMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllLendByOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolAssetLBMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.AssetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLendByOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Lends) > 0 {
-		for _, e := range m.Lends {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolAssetLBMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllLendByOwnerAndPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolAssetLBMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolAssetLBMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.PoolAssetLBMapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLendByOwnerAndPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Lends) > 0 {
-		for _, e := range m.Lends {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryReserveBuybackAssetDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryReserveBuybackAssetDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserveBuybackAssetDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.AssetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReserveBuybackAssetDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReserveBuybackAssetDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserveBuybackAssetDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExtendedPairs) > 0 {
-		for _, e := range m.ExtendedPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.ReserveBuybackAssetData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryAuctionParamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ExtendedPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAuctionParamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAssetRatesParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAuctionParamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.AppId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetRatesParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuctionParamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionParamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionParamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AssetRatesParams) > 0 {
-		for _, e := range m.AssetRatesParams {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.AuctionParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetRatesParamRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModuleBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetRatesParamResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModuleBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AssetRatesparams.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryModuleBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.ModuleBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundModBalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundModBalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundModBalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundModBalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundModBalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundModBalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	{
+		size, err := m.FundModBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundReserveBalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAssetToPairMappingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFundReserveBalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundReserveBalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetToPairMappingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundReserveBalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundReserveBalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundReserveBalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AssetToPairMappings) > 0 {
-		for _, e := range m.AssetToPairMappings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.FundReserveBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetToPairMappingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllReserveStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllReserveStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllReserveStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.AssetId != 0 {
-		n += 1 + sovQuery(uint64(m.AssetId))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAssetToPairMappingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllReserveStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AssetToPairMapping.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBorrowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllReserveStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBorrowsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllReserveStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Borrows) > 0 {
-		for _, e := range m.Borrows {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.AllReserveStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBorrowRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryFundModBalByAssetPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBorrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Borrow.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryFundModBalByAssetPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBorrowByOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFundModBalByAssetPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.AssetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBorrowByOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Borrows) > 0 {
-		for _, e := range m.Borrows {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFundModBalByAssetPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllBorrowByOwnerAndPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryFundModBalByAssetPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBorrowByOwnerAndPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFundModBalByAssetPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Borrows) > 0 {
-		for _, e := range m.Borrows {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolAssetLBMappingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AssetId != 0 {
-		n += 1 + sovQuery(uint64(m.AssetId))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *QueryLendInterestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolAssetLBMappingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PoolAssetLBMapping.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryLendInterestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReserveBuybackAssetDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLendInterestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AssetId != 0 {
-		n += 1 + sovQuery(uint64(m.AssetId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReserveBuybackAssetDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLendInterestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ReserveBuybackAssetData.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAuctionParamRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AppId != 0 {
-		n += 1 + sovQuery(uint64(m.AppId))
-	}
-	return n
+func (m *QueryLendInterestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuctionParamResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLendInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.AuctionParams.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.PoolInterest) > 0 {
+		for iNdEx := len(m.PoolInterest) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolInterest[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *QueryBorrowInterestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryModuleBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ModuleBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryBorrowInterestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFundModBalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBorrowInterestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFundModBalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBorrowInterestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.FundModBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFundReserveBalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryBorrowInterestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFundReserveBalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBorrowInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.FundReserveBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryAllReserveStatsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PoolInterest) > 0 {
+		for iNdEx := len(m.PoolInterest) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolInterest[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEModeCategoriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEModeCategoriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEModeCategoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AssetId != 0 {
-		n += 1 + sovQuery(uint64(m.AssetId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllReserveStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEModeCategoriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEModeCategoriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEModeCategoriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.AllReserveStats.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Categories) > 0 {
+		for iNdEx := len(m.Categories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Categories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFundModBalByAssetPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInterestRateProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterestRateProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterestRateProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Utilisation.Size()
+		i -= size
+		if _, err := m.Utilisation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.AssetId != 0 {
-		n += 1 + sovQuery(uint64(m.AssetId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFundModBalByAssetPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InterestRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InterestRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterestRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size := m.StableBorrowApr.Size()
+		i -= size
+		if _, err := m.StableBorrowApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BorrowApr.Size()
+		i -= size
+		if _, err := m.BorrowApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LendApr.Size()
+		i -= size
+		if _, err := m.LendApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Utilisation.Size()
+		i -= size
+		if _, err := m.Utilisation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLendInterestRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInterestRateProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterestRateProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterestRateProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size, err := m.Projected.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.InterestRateModelID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InterestRateModelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLendInterestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBadDebtWaterfallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBadDebtWaterfallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBadDebtWaterfallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PoolInterest) > 0 {
-		for _, e := range m.PoolInterest {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBadDebtWaterfallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBadDebtWaterfallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBadDebtWaterfallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Waterfall.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossCollateralAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossCollateralAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossCollateralAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossCollateralAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossCollateralAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossCollateralAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFixedTermMarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFixedTermMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFixedTermMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFixedTermMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFixedTermMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFixedTermMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFixedTermPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFixedTermPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFixedTermPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFixedTermPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFixedTermPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFixedTermPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Loans) > 0 {
+		for iNdEx := len(m.Loans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Loans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionManagerGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionManagerGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionManagerGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionManagerGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionManagerGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionManagerGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLendsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLendsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lends) > 0 {
+		for _, e := range m.Lends {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryLendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Lend.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllLendByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLendByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lends) > 0 {
+		for _, e := range m.Lends {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLendByOwnerAndPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLendByOwnerAndPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lends) > 0 {
+		for _, e := range m.Lends {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExtendedPairs) > 0 {
+		for _, e := range m.ExtendedPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExtendedPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EModeCategory != nil {
+		l = m.EModeCategory.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetRatesParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetRatesParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AssetRatesParams) > 0 {
+		for _, e := range m.AssetRatesParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetRatesParamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryAssetRatesParamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AssetRatesparams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAssetToPairMappingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetToPairMappingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AssetToPairMappings) > 0 {
+		for _, e := range m.AssetToPairMappings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetToPairMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetId != 0 {
+		n += 1 + sovQuery(uint64(m.AssetId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryAssetToPairMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AssetToPairMapping.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBorrowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBorrowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Borrows) > 0 {
+		for _, e := range m.Borrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBorrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryBorrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Borrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBorrowByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBorrowByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Borrows) > 0 {
+		for _, e := range m.Borrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBorrowByOwnerAndPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBorrowByOwnerAndPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Borrows) > 0 {
+		for _, e := range m.Borrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolAssetLBMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetId != 0 {
+		n += 1 + sovQuery(uint64(m.AssetId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolAssetLBMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolAssetLBMapping.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReserveBuybackAssetDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetId != 0 {
+		n += 1 + sovQuery(uint64(m.AssetId))
+	}
+	return n
+}
+
+func (m *QueryReserveBuybackAssetDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReserveBuybackAssetData.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuctionParamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	return n
+}
+
+func (m *QueryAuctionParamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuctionParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModuleBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryModuleBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ModuleBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFundModBalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFundModBalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FundModBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFundReserveBalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFundReserveBalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FundReserveBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllReserveStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetId != 0 {
+		n += 1 + sovQuery(uint64(m.AssetId))
+	}
+	return n
+}
+
+func (m *QueryAllReserveStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllReserveStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFundModBalByAssetPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetId != 0 {
+		n += 1 + sovQuery(uint64(m.AssetId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryFundModBalByAssetPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLendInterestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLendInterestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolInterest) > 0 {
+		for _, e := range m.PoolInterest {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBorrowInterestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBorrowInterestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolInterest) > 0 {
+		for _, e := range m.PoolInterest {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEModeCategoriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEModeCategoriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Categories) > 0 {
+		for _, e := range m.Categories {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryInterestRateProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovQuery(uint64(m.AssetId))
+	}
+	l = m.Utilisation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *InterestRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Utilisation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LendApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BorrowApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StableBorrowApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInterestRateProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InterestRateModelID != 0 {
+		n += 1 + sovQuery(uint64(m.InterestRateModelID))
+	}
+	l = m.Current.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Projected.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBadDebtWaterfallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBadDebtWaterfallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Waterfall.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCrossCollateralAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryCrossCollateralAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Account.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Health.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFixedTermMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFixedTermMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFixedTermPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFixedTermPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Loans) > 0 {
+		for _, e := range m.Loans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionManagerGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionManagerGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLendsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLendsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLendsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLendsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLendsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLendsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lends = append(m.Lends, LendAsset{})
+			if err := m.Lends[len(m.Lends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLendByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLendByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLendByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLendByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLendByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLendByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lends = append(m.Lends, LendAsset{})
+			if err := m.Lends[len(m.Lends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLendByOwnerAndPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLendByOwnerAndPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLendByOwnerAndPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLendByOwnerAndPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLendByOwnerAndPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLendByOwnerAndPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lends = append(m.Lends, LendAsset{})
+			if err := m.Lends[len(m.Lends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedPairs = append(m.ExtendedPairs, Extended_Pair{})
+			if err := m.ExtendedPairs[len(m.ExtendedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExtendedPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EModeCategory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EModeCategory == nil {
+				m.EModeCategory = &EModeCategory{}
+			}
+			if err := m.EModeCategory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryBorrowInterestRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *QueryAssetRatesParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetRatesParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetRatesParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryBorrowInterestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.PoolInterest) > 0 {
-		for _, e := range m.PoolInterest {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryAssetRatesParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetRatesParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetRatesParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRatesParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetRatesParams = append(m.AssetRatesParams, AssetRatesParams{})
+			if err := m.AssetRatesParams[len(m.AssetRatesParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAssetRatesParamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5960,12 +9234,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssetRatesParamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssetRatesParamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5987,7 +9280,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAssetRatesParamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6010,15 +9303,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssetRatesParamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssetRatesParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRatesparams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6045,7 +9338,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AssetRatesparams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6070,7 +9363,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLendsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6093,10 +9386,10 @@ func (m *QueryLendsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLendsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLendsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6156,7 +9449,7 @@ func (m *QueryLendsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLendsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6179,15 +9472,15 @@ func (m *QueryLendsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLendsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLendsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lends", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6214,8 +9507,8 @@ func (m *QueryLendsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lends = append(m.Lends, LendAsset{})
-			if err := m.Lends[len(m.Lends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6276,7 +9569,7 @@ func (m *QueryLendsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLendRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6299,10 +9592,10 @@ func (m *QueryLendRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLendRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6345,7 +9638,7 @@ func (m *QueryLendRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLendResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6368,15 +9661,15 @@ func (m *QueryLendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6403,7 +9696,7 @@ func (m *QueryLendResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Lend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6428,7 +9721,7 @@ func (m *QueryLendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLendByOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAssetToPairMappingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6451,17 +9744,17 @@ func (m *QueryAllLendByOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLendByOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssetToPairMappingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLendByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssetToPairMappingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6471,23 +9764,111 @@ func (m *QueryAllLendByOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetToPairMappingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetToPairMappingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetToPairMappingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetToPairMappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetToPairMappings = append(m.AssetToPairMappings, AssetToPairMapping{})
+			if err := m.AssetToPairMappings[len(m.AssetToPairMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -6503,28 +9884,116 @@ func (m *QueryAllLendByOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetToPairMappingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetToPairMappingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetToPairMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6546,7 +10015,7 @@ func (m *QueryAllLendByOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLendByOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAssetToPairMappingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6569,49 +10038,15 @@ func (m *QueryAllLendByOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLendByOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssetToPairMappingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLendByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssetToPairMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lends", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Lends = append(m.Lends, LendAsset{})
-			if err := m.Lends[len(m.Lends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetToPairMapping", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6638,10 +10073,7 @@ func (m *QueryAllLendByOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AssetToPairMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6666,7 +10098,7 @@ func (m *QueryAllLendByOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLendByOwnerAndPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6689,64 +10121,13 @@ func (m *QueryAllLendByOwnerAndPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLendByOwnerAndPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBorrowsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLendByOwnerAndPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBorrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6803,7 +10184,7 @@ func (m *QueryAllLendByOwnerAndPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLendByOwnerAndPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6826,15 +10207,15 @@ func (m *QueryAllLendByOwnerAndPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLendByOwnerAndPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBorrowsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLendByOwnerAndPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBorrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lends", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6861,8 +10242,8 @@ func (m *QueryAllLendByOwnerAndPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lends = append(m.Lends, LendAsset{})
-			if err := m.Lends[len(m.Lends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Borrows = append(m.Borrows, BorrowAsset{})
+			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6923,7 +10304,7 @@ func (m *QueryAllLendByOwnerAndPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBorrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6946,17 +10327,17 @@ func (m *QueryPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBorrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBorrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6966,28 +10347,11 @@ func (m *QueryPairsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7009,7 +10373,7 @@ func (m *QueryPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBorrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7032,49 +10396,15 @@ func (m *QueryPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBorrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExtendedPairs = append(m.ExtendedPairs, Extended_Pair{})
-			if err := m.ExtendedPairs[len(m.ExtendedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7101,10 +10431,7 @@ func (m *QueryPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Borrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7129,7 +10456,7 @@ func (m *QueryPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBorrowByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7152,17 +10479,49 @@ func (m *QueryPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBorrowByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBorrowByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7172,11 +10531,28 @@ func (m *QueryPairRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7198,7 +10574,7 @@ func (m *QueryPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPairResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBorrowByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7221,15 +10597,15 @@ func (m *QueryPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBorrowByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBorrowByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7256,7 +10632,44 @@ func (m *QueryPairResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExtendedPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Borrows = append(m.Borrows, BorrowAsset{})
+			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7281,7 +10694,7 @@ func (m *QueryPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetRatesParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBorrowByOwnerAndPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7304,13 +10717,64 @@ func (m *QueryAssetRatesParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetRatesParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBorrowByOwnerAndPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetRatesParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBorrowByOwnerAndPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7367,7 +10831,7 @@ func (m *QueryAssetRatesParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetRatesParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBorrowByOwnerAndPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7390,15 +10854,15 @@ func (m *QueryAssetRatesParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetRatesParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBorrowByOwnerAndPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetRatesParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBorrowByOwnerAndPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetRatesParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7425,8 +10889,8 @@ func (m *QueryAssetRatesParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetRatesParams = append(m.AssetRatesParams, AssetRatesParams{})
-			if err := m.AssetRatesParams[len(m.AssetRatesParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Borrows = append(m.Borrows, BorrowAsset{})
+			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7487,7 +10951,7 @@ func (m *QueryAssetRatesParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetRatesParamRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolAssetLBMappingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7510,17 +10974,17 @@ func (m *QueryAssetRatesParamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetRatesParamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolAssetLBMappingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetRatesParamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolAssetLBMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
 			}
-			m.Id = 0
+			m.AssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7530,7 +10994,26 @@ func (m *QueryAssetRatesParamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7556,7 +11039,7 @@ func (m *QueryAssetRatesParamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetRatesParamResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolAssetLBMappingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7579,15 +11062,15 @@ func (m *QueryAssetRatesParamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetRatesParamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolAssetLBMappingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetRatesParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolAssetLBMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetRatesparams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssetLBMapping", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7614,7 +11097,7 @@ func (m *QueryAssetRatesParamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AssetRatesparams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolAssetLBMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7639,7 +11122,7 @@ func (m *QueryAssetRatesParamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReserveBuybackAssetDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7662,17 +11145,17 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReserveBuybackAssetDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReserveBuybackAssetDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
 			}
-			var msglen int
+			m.AssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7682,28 +11165,11 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7725,7 +11191,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReserveBuybackAssetDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7744,53 +11210,19 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pools = append(m.Pools, Pool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReserveBuybackAssetDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReserveBuybackAssetDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveBuybackAssetData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7817,10 +11249,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReserveBuybackAssetData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7845,7 +11274,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionParamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7868,17 +11297,17 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionParamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionParamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			m.Id = 0
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7888,7 +11317,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7914,7 +11343,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionParamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7937,15 +11366,15 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionParamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7972,7 +11401,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuctionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7997,7 +11426,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetToPairMappingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8020,17 +11449,17 @@ func (m *QueryAssetToPairMappingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetToPairMappingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetToPairMappingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8040,28 +11469,11 @@ func (m *QueryAssetToPairMappingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8083,7 +11495,7 @@ func (m *QueryAssetToPairMappingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetToPairMappingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8106,49 +11518,15 @@ func (m *QueryAssetToPairMappingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetToPairMappingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetToPairMappingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetToPairMappings", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AssetToPairMappings = append(m.AssetToPairMappings, AssetToPairMapping{})
-			if err := m.AssetToPairMappings[len(m.AssetToPairMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8175,10 +11553,7 @@ func (m *QueryAssetToPairMappingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ModuleBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8203,7 +11578,7 @@ func (m *QueryAssetToPairMappingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetToPairMappingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFundModBalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8226,50 +11601,12 @@ func (m *QueryAssetToPairMappingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetToPairMappingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundModBalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetToPairMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundModBalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
-			}
-			m.AssetId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8291,7 +11628,7 @@ func (m *QueryAssetToPairMappingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetToPairMappingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFundModBalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8314,15 +11651,15 @@ func (m *QueryAssetToPairMappingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetToPairMappingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundModBalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetToPairMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundModBalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetToPairMapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundModBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8349,7 +11686,7 @@ func (m *QueryAssetToPairMappingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AssetToPairMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundModBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8374,7 +11711,7 @@ func (m *QueryAssetToPairMappingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFundReserveBalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8397,48 +11734,12 @@ func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBorrowsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundReserveBalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBorrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundReserveBalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8460,7 +11761,7 @@ func (m *QueryBorrowsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFundReserveBalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8483,49 +11784,15 @@ func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBorrowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundReserveBalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBorrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundReserveBalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Borrows = append(m.Borrows, BorrowAsset{})
-			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundReserveBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8552,10 +11819,7 @@ func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundReserveBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8580,7 +11844,7 @@ func (m *QueryBorrowsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBorrowRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllReserveStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8603,17 +11867,17 @@ func (m *QueryBorrowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBorrowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllReserveStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBorrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllReserveStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
 			}
-			m.Id = 0
+			m.AssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8623,7 +11887,7 @@ func (m *QueryBorrowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.AssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8649,7 +11913,7 @@ func (m *QueryBorrowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBorrowResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllReserveStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8672,15 +11936,15 @@ func (m *QueryBorrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBorrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllReserveStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllReserveStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllReserveStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8707,7 +11971,7 @@ func (m *QueryBorrowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Borrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllReserveStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8732,7 +11996,7 @@ func (m *QueryBorrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBorrowByOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFundModBalByAssetPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8755,17 +12019,17 @@ func (m *QueryAllBorrowByOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBorrowByOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundModBalByAssetPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBorrowByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundModBalByAssetPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
 			}
-			var stringLen uint64
+			m.AssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8775,29 +12039,16 @@ func (m *QueryAllBorrowByOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8807,28 +12058,11 @@ func (m *QueryAllBorrowByOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8850,7 +12084,7 @@ func (m *QueryAllBorrowByOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBorrowByOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFundModBalByAssetPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8873,15 +12107,15 @@ func (m *QueryAllBorrowByOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBorrowByOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundModBalByAssetPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBorrowByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundModBalByAssetPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8908,47 +12142,60 @@ func (m *QueryAllBorrowByOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrows = append(m.Borrows, BorrowAsset{})
-			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLendInterestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLendInterestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLendInterestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8970,7 +12217,7 @@ func (m *QueryAllBorrowByOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBorrowByOwnerAndPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLendInterestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8993,17 +12240,17 @@ func (m *QueryAllBorrowByOwnerAndPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBorrowByOwnerAndPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLendInterestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBorrowByOwnerAndPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLendInterestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolInterest", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9013,79 +12260,76 @@ func (m *QueryAllBorrowByOwnerAndPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			m.PoolInterest = append(m.PoolInterest, PoolInterest{})
+			if err := m.PoolInterest[len(m.PoolInterest)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBorrowInterestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBorrowInterestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBorrowInterestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9107,7 +12351,7 @@ func (m *QueryAllBorrowByOwnerAndPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBorrowByOwnerAndPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBorrowInterestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9130,49 +12374,15 @@ func (m *QueryAllBorrowByOwnerAndPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBorrowByOwnerAndPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBorrowInterestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBorrowByOwnerAndPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBorrowInterestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrows", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Borrows = append(m.Borrows, BorrowAsset{})
-			if err := m.Borrows[len(m.Borrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolInterest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9199,10 +12409,8 @@ func (m *QueryAllBorrowByOwnerAndPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolInterest = append(m.PoolInterest, PoolInterestB{})
+			if err := m.PoolInterest[len(m.PoolInterest)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9227,7 +12435,7 @@ func (m *QueryAllBorrowByOwnerAndPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolAssetLBMappingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEModeCategoriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9250,50 +12458,12 @@ func (m *QueryPoolAssetLBMappingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolAssetLBMappingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEModeCategoriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolAssetLBMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEModeCategoriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
-			}
-			m.AssetId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9315,7 +12485,7 @@ func (m *QueryPoolAssetLBMappingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolAssetLBMappingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEModeCategoriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9338,15 +12508,15 @@ func (m *QueryPoolAssetLBMappingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolAssetLBMappingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEModeCategoriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolAssetLBMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEModeCategoriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssetLBMapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Categories", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9373,7 +12543,8 @@ func (m *QueryPoolAssetLBMappingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolAssetLBMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Categories = append(m.Categories, EModeCategory{})
+			if err := m.Categories[len(m.Categories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9398,7 +12569,7 @@ func (m *QueryPoolAssetLBMappingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReserveBuybackAssetDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInterestRateProjectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9421,13 +12592,32 @@ func (m *QueryReserveBuybackAssetDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReserveBuybackAssetDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterestRateProjectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReserveBuybackAssetDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterestRateProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
 			}
@@ -9446,6 +12636,40 @@ func (m *QueryReserveBuybackAssetDataRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilisation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Utilisation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9467,7 +12691,7 @@ func (m *QueryReserveBuybackAssetDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReserveBuybackAssetDataResponse) Unmarshal(dAtA []byte) error {
+func (m *InterestRates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9490,17 +12714,17 @@ func (m *QueryReserveBuybackAssetDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReserveBuybackAssetDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterestRates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReserveBuybackAssetDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterestRates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveBuybackAssetData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilisation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9510,80 +12734,65 @@ func (m *QueryReserveBuybackAssetDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveBuybackAssetData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Utilisation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendApr", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAuctionParamRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionParamRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionParamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
-			}
-			m.AppId = 0
+			if err := m.LendApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowApr", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9593,66 +12802,31 @@ func (m *QueryAuctionParamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAuctionParamResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BorrowApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionParamResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableBorrowApr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9662,22 +12836,23 @@ func (m *QueryAuctionParamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuctionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StableBorrowApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9702,7 +12877,7 @@ func (m *QueryAuctionParamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInterestRateProjectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9725,17 +12900,17 @@ func (m *QueryModuleBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterestRateProjectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterestRateProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModelID", wireType)
 			}
-			m.PoolId = 0
+			m.InterestRateModelID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9745,64 +12920,47 @@ func (m *QueryModuleBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.InterestRateModelID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryModuleBalanceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleBalanceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projected", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9829,7 +12987,7 @@ func (m *QueryModuleBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ModuleBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Projected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9854,7 +13012,7 @@ func (m *QueryModuleBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundModBalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBadDebtWaterfallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9877,10 +13035,10 @@ func (m *QueryFundModBalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundModBalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBadDebtWaterfallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundModBalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBadDebtWaterfallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9904,7 +13062,7 @@ func (m *QueryFundModBalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundModBalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBadDebtWaterfallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9927,15 +13085,15 @@ func (m *QueryFundModBalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundModBalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBadDebtWaterfallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundModBalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBadDebtWaterfallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundModBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waterfall", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9959,116 +13117,16 @@ func (m *QueryFundModBalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FundModBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFundReserveBalRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundReserveBalRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundReserveBalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFundReserveBalResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundReserveBalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundReserveBalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Waterfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundReserveBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10095,7 +13153,8 @@ func (m *QueryFundReserveBalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundReserveBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExchangeRates = append(m.ExchangeRates, CTokenExchangeRate{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10120,7 +13179,7 @@ func (m *QueryFundReserveBalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllReserveStatsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCrossCollateralAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10143,17 +13202,49 @@ func (m *QueryAllReserveStatsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllReserveStatsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCrossCollateralAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllReserveStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCrossCollateralAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.AssetId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10163,7 +13254,7 @@ func (m *QueryAllReserveStatsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10189,7 +13280,7 @@ func (m *QueryAllReserveStatsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllReserveStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCrossCollateralAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10212,15 +13303,15 @@ func (m *QueryAllReserveStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllReserveStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCrossCollateralAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllReserveStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCrossCollateralAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllReserveStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10247,7 +13338,40 @@ func (m *QueryAllReserveStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllReserveStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10272,7 +13396,7 @@ func (m *QueryAllReserveStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundModBalByAssetPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFixedTermMarketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10295,50 +13419,12 @@ func (m *QueryFundModBalByAssetPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundModBalByAssetPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFixedTermMarketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundModBalByAssetPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFixedTermMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
-			}
-			m.AssetId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10360,7 +13446,7 @@ func (m *QueryFundModBalByAssetPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundModBalByAssetPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFixedTermMarketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10383,15 +13469,15 @@ func (m *QueryFundModBalByAssetPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundModBalByAssetPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFixedTermMarketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundModBalByAssetPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFixedTermMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10418,7 +13504,8 @@ func (m *QueryFundModBalByAssetPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Markets = append(m.Markets, FixedTermMarket{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10443,7 +13530,7 @@ func (m *QueryFundModBalByAssetPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLendInterestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFixedTermPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10466,12 +13553,44 @@ func (m *QueryLendInterestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLendInterestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFixedTermPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLendInterestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFixedTermPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10493,7 +13612,7 @@ func (m *QueryLendInterestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLendInterestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFixedTermPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10516,15 +13635,15 @@ func (m *QueryLendInterestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLendInterestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFixedTermPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLendInterestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFixedTermPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolInterest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10551,8 +13670,42 @@ func (m *QueryLendInterestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolInterest = append(m.PoolInterest, PoolInterest{})
-			if err := m.PoolInterest[len(m.PoolInterest)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposits = append(m.Deposits, FixedTermDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Loans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Loans = append(m.Loans, FixedTermLoan{})
+			if err := m.Loans[len(m.Loans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10577,7 +13730,7 @@ func (m *QueryLendInterestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBorrowInterestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionManagerGrantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10600,12 +13753,44 @@ func (m *QueryBorrowInterestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBorrowInterestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionManagerGrantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBorrowInterestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionManagerGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10627,7 +13812,7 @@ func (m *QueryBorrowInterestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBorrowInterestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionManagerGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10650,15 +13835,15 @@ func (m *QueryBorrowInterestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBorrowInterestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionManagerGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBorrowInterestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionManagerGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolInterest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10685,8 +13870,8 @@ func (m *QueryBorrowInterestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolInterest = append(m.PoolInterest, PoolInterestB{})
-			if err := m.PoolInterest[len(m.PoolInterest)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Grants = append(m.Grants, PositionManagerGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
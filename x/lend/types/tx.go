@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func NewMsgLend(lender string, assetID uint64, amount sdk.Coin, poolID, appID uint64) *MsgLend {
@@ -547,3 +548,431 @@ func (msg *MsgFundReserveAccounts) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
+
+func NewMsgGrantPositionManager(owner, manager string, permissions []PositionPermission) *MsgGrantPositionManager {
+	return &MsgGrantPositionManager{
+		Owner:       owner,
+		Manager:     manager,
+		Permissions: permissions,
+	}
+}
+
+func (msg MsgGrantPositionManager) Route() string { return ModuleName }
+func (msg MsgGrantPositionManager) Type() string  { return TypeGrantPositionManagerRequest }
+
+func (msg *MsgGrantPositionManager) ValidateBasic() error {
+	if err := validateOwnerAndManager(msg.Owner, msg.Manager); err != nil {
+		return err
+	}
+	return ValidatePositionPermissions(msg.Permissions)
+}
+
+func (msg *MsgGrantPositionManager) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes get the bytes for the message signer to sign on.
+func (msg *MsgGrantPositionManager) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgRevokePositionManager(owner, manager string) *MsgRevokePositionManager {
+	return &MsgRevokePositionManager{
+		Owner:   owner,
+		Manager: manager,
+	}
+}
+
+func (msg MsgRevokePositionManager) Route() string { return ModuleName }
+func (msg MsgRevokePositionManager) Type() string  { return TypeRevokePositionManagerRequest }
+
+func (msg *MsgRevokePositionManager) ValidateBasic() error {
+	return validateOwnerAndManager(msg.Owner, msg.Manager)
+}
+
+func (msg *MsgRevokePositionManager) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes get the bytes for the message signer to sign on.
+func (msg *MsgRevokePositionManager) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func validateOwnerAndManager(owner, manager string) error {
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(manager); err != nil {
+		return sdkerrors.Wrap(ErrorInvalidManager, err.Error())
+	}
+	if owner == manager {
+		return sdkerrors.Wrap(ErrorInvalidManager, "manager cannot be the owner")
+	}
+	return nil
+}
+
+func NewMsgDepositAccountCollateral(owner string, lendID uint64, amount sdk.Coin) *MsgDepositAccountCollateral {
+	return &MsgDepositAccountCollateral{
+		Owner:  owner,
+		LendId: lendID,
+		Amount: amount,
+	}
+}
+
+func (msg MsgDepositAccountCollateral) Route() string { return ModuleName }
+func (msg MsgDepositAccountCollateral) Type() string  { return TypeDepositAccountCollateralRequest }
+
+func (msg *MsgDepositAccountCollateral) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		return err
+	}
+
+	if msg.LendId == 0 {
+		return fmt.Errorf("lend id should not be 0: %d ", msg.LendId)
+	}
+	if msg.Amount.Amount.IsNegative() || msg.Amount.Amount.IsZero() {
+		return fmt.Errorf("invalid coin amount: %s < 0", msg.Amount.Amount)
+	}
+
+	return nil
+}
+
+func (msg *MsgDepositAccountCollateral) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.GetOwner())
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes get the bytes for the message signer to sign on.
+func (msg *MsgDepositAccountCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgWithdrawAccountCollateral(owner string, lendID uint64, amount sdk.Coin) *MsgWithdrawAccountCollateral {
+	return &MsgWithdrawAccountCollateral{
+		Owner:  owner,
+		LendId: lendID,
+		Amount: amount,
+	}
+}
+
+func (msg MsgWithdrawAccountCollateral) Route() string { return ModuleName }
+func (msg MsgWithdrawAccountCollateral) Type() string  { return TypeWithdrawAccountCollateralRequest }
+
+func (msg *MsgWithdrawAccountCollateral) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		return err
+	}
+
+	if msg.LendId == 0 {
+		return fmt.Errorf("lend id should not be 0: %d ", msg.LendId)
+	}
+	if msg.Amount.Amount.IsNegative() || msg.Amount.Amount.IsZero() {
+		return fmt.Errorf("invalid coin amount: %s < 0", msg.Amount.Amount)
+	}
+
+	return nil
+}
+
+func (msg *MsgWithdrawAccountCollateral) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.GetOwner())
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes get the bytes for the message signer to sign on.
+func (msg *MsgWithdrawAccountCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgAccountBorrow(owner string, poolID, assetID uint64, amount sdk.Coin) *MsgAccountBorrow {
+	return &MsgAccountBorrow{
+		Owner:   owner,
+		PoolId:  poolID,
+		AssetId: assetID,
+		Amount:  amount,
+	}
+}
+
+func (msg MsgAccountBorrow) Route() string { return ModuleName }
+func (msg MsgAccountBorrow) Type() string  { return TypeAccountBorrowRequest }
+
+func (msg *MsgAccountBorrow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		return err
+	}
+
+	if msg.PoolId == 0 {
+		return fmt.Errorf("pool id should not be 0: %d ", msg.PoolId)
+	}
+	if msg.AssetId == 0 {
+		return fmt.Errorf("asset id should not be 0: %d ", msg.AssetId)
+	}
+	if msg.Amount.Amount.IsNegative() || msg.Amount.Amount.IsZero() {
+		return fmt.Errorf("invalid coin amount: %s < 0", msg.Amount.Amount)
+	}
+
+	return nil
+}
+
+func (msg *MsgAccountBorrow) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.GetOwner())
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes get the bytes for the message signer to sign on.
+func (msg *MsgAccountBorrow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgAccountRepay(owner string, poolID uint64, amount sdk.Coin) *MsgAccountRepay {
+	return &MsgAccountRepay{
+		Owner:  owner,
+		PoolId: poolID,
+		Amount: amount,
+	}
+}
+
+func (msg MsgAccountRepay) Route() string { return ModuleName }
+func (msg MsgAccountRepay) Type() string  { return TypeAccountRepayRequest }
+
+func (msg *MsgAccountRepay) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		return err
+	}
+
+	if msg.PoolId == 0 {
+		return fmt.Errorf("pool id should not be 0: %d ", msg.PoolId)
+	}
+	if msg.Amount.Amount.IsNegative() || msg.Amount.Amount.IsZero() {
+		return fmt.Errorf("invalid coin amount: %s < 0", msg.Amount.Amount)
+	}
+
+	return nil
+}
+
+func (msg *MsgAccountRepay) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.GetOwner())
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes get the bytes for the message signer to sign on.
+func (msg *MsgAccountRepay) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgLiquidateAccount(liquidator, owner string, poolID uint64, amount sdk.Coin) *MsgLiquidateAccount {
+	return &MsgLiquidateAccount{
+		Liquidator: liquidator,
+		Owner:      owner,
+		PoolId:     poolID,
+		Amount:     amount,
+	}
+}
+
+func (msg MsgLiquidateAccount) Route() string { return ModuleName }
+func (msg MsgLiquidateAccount) Type() string  { return TypeLiquidateAccountRequest }
+
+func (msg *MsgLiquidateAccount) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetLiquidator())
+	if err != nil {
+		return err
+	}
+	_, err = sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		return err
+	}
+
+	if msg.PoolId == 0 {
+		return fmt.Errorf("pool id should not be 0: %d ", msg.PoolId)
+	}
+	if msg.Amount.Amount.IsNegative() || msg.Amount.Amount.IsZero() {
+		return fmt.Errorf("invalid coin amount: %s < 0", msg.Amount.Amount)
+	}
+
+	return nil
+}
+
+func (msg *MsgLiquidateAccount) GetSigners() []sdk.AccAddress {
+	liquidator, _ := sdk.AccAddressFromBech32(msg.GetLiquidator())
+	return []sdk.AccAddress{liquidator}
+}
+
+// GetSignBytes get the bytes for the message signer to sign on.
+func (msg *MsgLiquidateAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgFixedTermCommit(lender string, marketID uint64, amount sdk.Coin) *MsgFixedTermCommit {
+	return &MsgFixedTermCommit{
+		Lender:   lender,
+		MarketId: marketID,
+		Amount:   amount,
+	}
+}
+
+func (msg MsgFixedTermCommit) Route() string { return ModuleName }
+func (msg MsgFixedTermCommit) Type() string  { return TypeFixedTermCommitRequest }
+
+func (msg *MsgFixedTermCommit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetLender())
+	if err != nil {
+		return err
+	}
+
+	if msg.MarketId == 0 {
+		return fmt.Errorf("market id should not be 0: %d ", msg.MarketId)
+	}
+	if msg.Amount.Amount.IsNegative() || msg.Amount.Amount.IsZero() {
+		return fmt.Errorf("invalid coin amount: %s < 0", msg.Amount.Amount)
+	}
+
+	return nil
+}
+
+func (msg *MsgFixedTermCommit) GetSigners() []sdk.AccAddress {
+	lender, _ := sdk.AccAddressFromBech32(msg.GetLender())
+	return []sdk.AccAddress{lender}
+}
+
+// GetSignBytes get the bytes for the message signer to sign on.
+func (msg *MsgFixedTermCommit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgFixedTermRedeem(lender string, depositID uint64) *MsgFixedTermRedeem {
+	return &MsgFixedTermRedeem{
+		Lender:    lender,
+		DepositId: depositID,
+	}
+}
+
+func (msg MsgFixedTermRedeem) Route() string { return ModuleName }
+func (msg MsgFixedTermRedeem) Type() string  { return TypeFixedTermRedeemRequest }
+
+func (msg *MsgFixedTermRedeem) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetLender())
+	if err != nil {
+		return err
+	}
+
+	if msg.DepositId == 0 {
+		return fmt.Errorf("deposit id should not be 0: %d ", msg.DepositId)
+	}
+
+	return nil
+}
+
+func (msg *MsgFixedTermRedeem) GetSigners() []sdk.AccAddress {
+	lender, _ := sdk.AccAddressFromBech32(msg.GetLender())
+	return []sdk.AccAddress{lender}
+}
+
+// GetSignBytes get the bytes for the message signer to sign on.
+func (msg *MsgFixedTermRedeem) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgFixedTermBorrow(borrower string, marketID, lendID, pairID uint64, amountIn, amountOut sdk.Coin) *MsgFixedTermBorrow {
+	return &MsgFixedTermBorrow{
+		Borrower:  borrower,
+		MarketId:  marketID,
+		LendId:    lendID,
+		PairId:    pairID,
+		AmountIn:  amountIn,
+		AmountOut: amountOut,
+	}
+}
+
+func (msg MsgFixedTermBorrow) Route() string { return ModuleName }
+func (msg MsgFixedTermBorrow) Type() string  { return TypeFixedTermBorrowRequest }
+
+func (msg *MsgFixedTermBorrow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetBorrower())
+	if err != nil {
+		return err
+	}
+
+	if msg.MarketId == 0 {
+		return fmt.Errorf("market id should not be 0: %d ", msg.MarketId)
+	}
+	if msg.LendId == 0 {
+		return fmt.Errorf("lend id should not be 0: %d ", msg.LendId)
+	}
+	if msg.PairId == 0 {
+		return fmt.Errorf("pair id should not be 0: %d ", msg.PairId)
+	}
+	if msg.AmountIn.Amount.IsNegative() || msg.AmountIn.Amount.IsZero() {
+		return fmt.Errorf("invalid coin amount: %s < 0", msg.AmountIn.Amount)
+	}
+	if msg.AmountOut.Amount.IsNegative() || msg.AmountOut.Amount.IsZero() {
+		return fmt.Errorf("invalid coin amount: %s < 0", msg.AmountOut.Amount)
+	}
+
+	return nil
+}
+
+func (msg *MsgFixedTermBorrow) GetSigners() []sdk.AccAddress {
+	borrower, _ := sdk.AccAddressFromBech32(msg.GetBorrower())
+	return []sdk.AccAddress{borrower}
+}
+
+// GetSignBytes get the bytes for the message signer to sign on.
+func (msg *MsgFixedTermBorrow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgFixedTermRepay(borrower string, loanID uint64) *MsgFixedTermRepay {
+	return &MsgFixedTermRepay{
+		Borrower: borrower,
+		LoanId:   loanID,
+	}
+}
+
+func (msg MsgFixedTermRepay) Route() string { return ModuleName }
+func (msg MsgFixedTermRepay) Type() string  { return TypeFixedTermRepayRequest }
+
+func (msg *MsgFixedTermRepay) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetBorrower())
+	if err != nil {
+		return err
+	}
+
+	if msg.LoanId == 0 {
+		return fmt.Errorf("loan id should not be 0: %d ", msg.LoanId)
+	}
+
+	return nil
+}
+
+func (msg *MsgFixedTermRepay) GetSigners() []sdk.AccAddress {
+	borrower, _ := sdk.AccAddressFromBech32(msg.GetBorrower())
+	return []sdk.AccAddress{borrower}
+}
+
+// GetSignBytes get the bytes for the message signer to sign on.
+func (msg *MsgFixedTermRepay) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
@@ -865,21 +865,24 @@ func (m *MsgFundReserveAccounts) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
-type MsgLendResponse struct {
+type MsgGrantPositionManager struct {
+	Owner       string               `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Manager     string               `protobuf:"bytes,2,opt,name=manager,proto3" json:"manager,omitempty"`
+	Permissions []PositionPermission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=comdex.lend.v1beta1.PositionPermission" json:"permissions,omitempty"`
 }
 
-func (m *MsgLendResponse) Reset()         { *m = MsgLendResponse{} }
-func (m *MsgLendResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgLendResponse) ProtoMessage()    {}
-func (*MsgLendResponse) Descriptor() ([]byte, []int) {
+func (m *MsgGrantPositionManager) Reset()         { *m = MsgGrantPositionManager{} }
+func (m *MsgGrantPositionManager) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantPositionManager) ProtoMessage()    {}
+func (*MsgGrantPositionManager) Descriptor() ([]byte, []int) {
 	return fileDescriptor_957d64b59d60594d, []int{13}
 }
-func (m *MsgLendResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgGrantPositionManager) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgLendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrantPositionManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgLendResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrantPositionManager.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -889,33 +892,56 @@ func (m *MsgLendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgLendResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgLendResponse.Merge(m, src)
+func (m *MsgGrantPositionManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantPositionManager.Merge(m, src)
 }
-func (m *MsgLendResponse) XXX_Size() int {
+func (m *MsgGrantPositionManager) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgLendResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgLendResponse.DiscardUnknown(m)
+func (m *MsgGrantPositionManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantPositionManager.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgLendResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgGrantPositionManager proto.InternalMessageInfo
 
-type MsgWithdrawResponse struct {
+func (m *MsgGrantPositionManager) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
-func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawResponse) ProtoMessage()    {}
-func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
+func (m *MsgGrantPositionManager) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
+}
+
+func (m *MsgGrantPositionManager) GetPermissions() []PositionPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type MsgRevokePositionManager struct {
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Manager string `protobuf:"bytes,2,opt,name=manager,proto3" json:"manager,omitempty"`
+}
+
+func (m *MsgRevokePositionManager) Reset()         { *m = MsgRevokePositionManager{} }
+func (m *MsgRevokePositionManager) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePositionManager) ProtoMessage()    {}
+func (*MsgRevokePositionManager) Descriptor() ([]byte, []int) {
 	return fileDescriptor_957d64b59d60594d, []int{14}
 }
-func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRevokePositionManager) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRevokePositionManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRevokePositionManager.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -925,33 +951,50 @@ func (m *MsgWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawResponse.Merge(m, src)
+func (m *MsgRevokePositionManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePositionManager.Merge(m, src)
 }
-func (m *MsgWithdrawResponse) XXX_Size() int {
+func (m *MsgRevokePositionManager) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawResponse.DiscardUnknown(m)
+func (m *MsgRevokePositionManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePositionManager.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRevokePositionManager proto.InternalMessageInfo
 
-type MsgDepositResponse struct {
+func (m *MsgRevokePositionManager) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
-func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDepositResponse) ProtoMessage()    {}
-func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRevokePositionManager) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
+}
+
+type MsgDepositAccountCollateral struct {
+	Owner  string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	LendId uint64     `protobuf:"varint,2,opt,name=lend_id,json=lendId,proto3" json:"lend_id,omitempty"`
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgDepositAccountCollateral) Reset()         { *m = MsgDepositAccountCollateral{} }
+func (m *MsgDepositAccountCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositAccountCollateral) ProtoMessage()    {}
+func (*MsgDepositAccountCollateral) Descriptor() ([]byte, []int) {
 	return fileDescriptor_957d64b59d60594d, []int{15}
 }
-func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDepositAccountCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDepositAccountCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDepositResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDepositAccountCollateral.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -961,33 +1004,57 @@ func (m *MsgDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgDepositResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDepositResponse.Merge(m, src)
+func (m *MsgDepositAccountCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositAccountCollateral.Merge(m, src)
 }
-func (m *MsgDepositResponse) XXX_Size() int {
+func (m *MsgDepositAccountCollateral) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDepositResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDepositResponse.DiscardUnknown(m)
+func (m *MsgDepositAccountCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositAccountCollateral.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDepositAccountCollateral proto.InternalMessageInfo
 
-type MsgCloseLendResponse struct {
+func (m *MsgDepositAccountCollateral) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgCloseLendResponse) Reset()         { *m = MsgCloseLendResponse{} }
-func (m *MsgCloseLendResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCloseLendResponse) ProtoMessage()    {}
-func (*MsgCloseLendResponse) Descriptor() ([]byte, []int) {
+func (m *MsgDepositAccountCollateral) GetLendId() uint64 {
+	if m != nil {
+		return m.LendId
+	}
+	return 0
+}
+
+func (m *MsgDepositAccountCollateral) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+type MsgWithdrawAccountCollateral struct {
+	Owner  string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	LendId uint64     `protobuf:"varint,2,opt,name=lend_id,json=lendId,proto3" json:"lend_id,omitempty"`
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgWithdrawAccountCollateral) Reset()         { *m = MsgWithdrawAccountCollateral{} }
+func (m *MsgWithdrawAccountCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAccountCollateral) ProtoMessage()    {}
+func (*MsgWithdrawAccountCollateral) Descriptor() ([]byte, []int) {
 	return fileDescriptor_957d64b59d60594d, []int{16}
 }
-func (m *MsgCloseLendResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawAccountCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCloseLendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawAccountCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCloseLendResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawAccountCollateral.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -997,33 +1064,58 @@ func (m *MsgCloseLendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgCloseLendResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCloseLendResponse.Merge(m, src)
+func (m *MsgWithdrawAccountCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAccountCollateral.Merge(m, src)
 }
-func (m *MsgCloseLendResponse) XXX_Size() int {
+func (m *MsgWithdrawAccountCollateral) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCloseLendResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCloseLendResponse.DiscardUnknown(m)
+func (m *MsgWithdrawAccountCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAccountCollateral.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCloseLendResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawAccountCollateral proto.InternalMessageInfo
 
-type MsgBorrowResponse struct {
+func (m *MsgWithdrawAccountCollateral) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgBorrowResponse) Reset()         { *m = MsgBorrowResponse{} }
-func (m *MsgBorrowResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBorrowResponse) ProtoMessage()    {}
-func (*MsgBorrowResponse) Descriptor() ([]byte, []int) {
+func (m *MsgWithdrawAccountCollateral) GetLendId() uint64 {
+	if m != nil {
+		return m.LendId
+	}
+	return 0
+}
+
+func (m *MsgWithdrawAccountCollateral) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+type MsgAccountBorrow struct {
+	Owner   string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PoolId  uint64     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	AssetId uint64     `protobuf:"varint,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgAccountBorrow) Reset()         { *m = MsgAccountBorrow{} }
+func (m *MsgAccountBorrow) String() string { return proto.CompactTextString(m) }
+func (*MsgAccountBorrow) ProtoMessage()    {}
+func (*MsgAccountBorrow) Descriptor() ([]byte, []int) {
 	return fileDescriptor_957d64b59d60594d, []int{17}
 }
-func (m *MsgBorrowResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAccountBorrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAccountBorrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBorrowResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAccountBorrow.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1033,33 +1125,64 @@ func (m *MsgBorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgBorrowResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBorrowResponse.Merge(m, src)
+func (m *MsgAccountBorrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAccountBorrow.Merge(m, src)
 }
-func (m *MsgBorrowResponse) XXX_Size() int {
+func (m *MsgAccountBorrow) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBorrowResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBorrowResponse.DiscardUnknown(m)
+func (m *MsgAccountBorrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAccountBorrow.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBorrowResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAccountBorrow proto.InternalMessageInfo
 
-type MsgRepayResponse struct {
+func (m *MsgAccountBorrow) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgRepayResponse) Reset()         { *m = MsgRepayResponse{} }
-func (m *MsgRepayResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRepayResponse) ProtoMessage()    {}
-func (*MsgRepayResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAccountBorrow) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgAccountBorrow) GetAssetId() uint64 {
+	if m != nil {
+		return m.AssetId
+	}
+	return 0
+}
+
+func (m *MsgAccountBorrow) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+type MsgAccountRepay struct {
+	Owner  string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PoolId uint64     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgAccountRepay) Reset()         { *m = MsgAccountRepay{} }
+func (m *MsgAccountRepay) String() string { return proto.CompactTextString(m) }
+func (*MsgAccountRepay) ProtoMessage()    {}
+func (*MsgAccountRepay) Descriptor() ([]byte, []int) {
 	return fileDescriptor_957d64b59d60594d, []int{18}
 }
-func (m *MsgRepayResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAccountRepay) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRepayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAccountRepay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRepayResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAccountRepay.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1069,33 +1192,58 @@ func (m *MsgRepayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgRepayResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRepayResponse.Merge(m, src)
+func (m *MsgAccountRepay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAccountRepay.Merge(m, src)
 }
-func (m *MsgRepayResponse) XXX_Size() int {
+func (m *MsgAccountRepay) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRepayResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRepayResponse.DiscardUnknown(m)
+func (m *MsgAccountRepay) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAccountRepay.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRepayResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAccountRepay proto.InternalMessageInfo
 
-type MsgDepositBorrowResponse struct {
+func (m *MsgAccountRepay) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgDepositBorrowResponse) Reset()         { *m = MsgDepositBorrowResponse{} }
-func (m *MsgDepositBorrowResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDepositBorrowResponse) ProtoMessage()    {}
-func (*MsgDepositBorrowResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAccountRepay) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgAccountRepay) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+type MsgLiquidateAccount struct {
+	Liquidator string     `protobuf:"bytes,1,opt,name=liquidator,proto3" json:"liquidator,omitempty"`
+	Owner      string     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	PoolId     uint64     `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgLiquidateAccount) Reset()         { *m = MsgLiquidateAccount{} }
+func (m *MsgLiquidateAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidateAccount) ProtoMessage()    {}
+func (*MsgLiquidateAccount) Descriptor() ([]byte, []int) {
 	return fileDescriptor_957d64b59d60594d, []int{19}
 }
-func (m *MsgDepositBorrowResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgLiquidateAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDepositBorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgLiquidateAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDepositBorrowResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgLiquidateAccount.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1105,33 +1253,64 @@ func (m *MsgDepositBorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgDepositBorrowResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDepositBorrowResponse.Merge(m, src)
+func (m *MsgLiquidateAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidateAccount.Merge(m, src)
 }
-func (m *MsgDepositBorrowResponse) XXX_Size() int {
+func (m *MsgLiquidateAccount) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDepositBorrowResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDepositBorrowResponse.DiscardUnknown(m)
+func (m *MsgLiquidateAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidateAccount.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDepositBorrowResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgLiquidateAccount proto.InternalMessageInfo
 
-type MsgDrawResponse struct {
+func (m *MsgLiquidateAccount) GetLiquidator() string {
+	if m != nil {
+		return m.Liquidator
+	}
+	return ""
 }
 
-func (m *MsgDrawResponse) Reset()         { *m = MsgDrawResponse{} }
-func (m *MsgDrawResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDrawResponse) ProtoMessage()    {}
-func (*MsgDrawResponse) Descriptor() ([]byte, []int) {
+func (m *MsgLiquidateAccount) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgLiquidateAccount) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgLiquidateAccount) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+type MsgFixedTermCommit struct {
+	Lender   string     `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	MarketId uint64     `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgFixedTermCommit) Reset()         { *m = MsgFixedTermCommit{} }
+func (m *MsgFixedTermCommit) String() string { return proto.CompactTextString(m) }
+func (*MsgFixedTermCommit) ProtoMessage()    {}
+func (*MsgFixedTermCommit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_957d64b59d60594d, []int{20}
 }
-func (m *MsgDrawResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgFixedTermCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFixedTermCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDrawResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFixedTermCommit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1141,33 +1320,56 @@ func (m *MsgDrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgDrawResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDrawResponse.Merge(m, src)
+func (m *MsgFixedTermCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFixedTermCommit.Merge(m, src)
 }
-func (m *MsgDrawResponse) XXX_Size() int {
+func (m *MsgFixedTermCommit) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDrawResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDrawResponse.DiscardUnknown(m)
+func (m *MsgFixedTermCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFixedTermCommit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDrawResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgFixedTermCommit proto.InternalMessageInfo
 
-type MsgCloseBorrowResponse struct {
+func (m *MsgFixedTermCommit) GetLender() string {
+	if m != nil {
+		return m.Lender
+	}
+	return ""
 }
 
-func (m *MsgCloseBorrowResponse) Reset()         { *m = MsgCloseBorrowResponse{} }
-func (m *MsgCloseBorrowResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCloseBorrowResponse) ProtoMessage()    {}
-func (*MsgCloseBorrowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_957d64b59d60594d, []int{21}
-}
-func (m *MsgCloseBorrowResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *MsgFixedTermCommit) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
-func (m *MsgCloseBorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+func (m *MsgFixedTermCommit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+type MsgFixedTermRedeem struct {
+	Lender    string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	DepositId uint64 `protobuf:"varint,2,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+}
+
+func (m *MsgFixedTermRedeem) Reset()         { *m = MsgFixedTermRedeem{} }
+func (m *MsgFixedTermRedeem) String() string { return proto.CompactTextString(m) }
+func (*MsgFixedTermRedeem) ProtoMessage()    {}
+func (*MsgFixedTermRedeem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{21}
+}
+func (m *MsgFixedTermRedeem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFixedTermRedeem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCloseBorrowResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFixedTermRedeem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1177,33 +1379,53 @@ func (m *MsgCloseBorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgCloseBorrowResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCloseBorrowResponse.Merge(m, src)
+func (m *MsgFixedTermRedeem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFixedTermRedeem.Merge(m, src)
 }
-func (m *MsgCloseBorrowResponse) XXX_Size() int {
+func (m *MsgFixedTermRedeem) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCloseBorrowResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCloseBorrowResponse.DiscardUnknown(m)
+func (m *MsgFixedTermRedeem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFixedTermRedeem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCloseBorrowResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgFixedTermRedeem proto.InternalMessageInfo
 
-type MsgBorrowAlternateResponse struct {
+func (m *MsgFixedTermRedeem) GetLender() string {
+	if m != nil {
+		return m.Lender
+	}
+	return ""
 }
 
-func (m *MsgBorrowAlternateResponse) Reset()         { *m = MsgBorrowAlternateResponse{} }
-func (m *MsgBorrowAlternateResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBorrowAlternateResponse) ProtoMessage()    {}
-func (*MsgBorrowAlternateResponse) Descriptor() ([]byte, []int) {
+func (m *MsgFixedTermRedeem) GetDepositId() uint64 {
+	if m != nil {
+		return m.DepositId
+	}
+	return 0
+}
+
+type MsgFixedTermBorrow struct {
+	Borrower  string     `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	MarketId  uint64     `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	LendId    uint64     `protobuf:"varint,3,opt,name=lend_id,json=lendId,proto3" json:"lend_id,omitempty"`
+	PairId    uint64     `protobuf:"varint,4,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	AmountIn  types.Coin `protobuf:"bytes,5,opt,name=amount_in,json=amountIn,proto3" json:"amount_in"`
+	AmountOut types.Coin `protobuf:"bytes,6,opt,name=amount_out,json=amountOut,proto3" json:"amount_out"`
+}
+
+func (m *MsgFixedTermBorrow) Reset()         { *m = MsgFixedTermBorrow{} }
+func (m *MsgFixedTermBorrow) String() string { return proto.CompactTextString(m) }
+func (*MsgFixedTermBorrow) ProtoMessage()    {}
+func (*MsgFixedTermBorrow) Descriptor() ([]byte, []int) {
 	return fileDescriptor_957d64b59d60594d, []int{22}
 }
-func (m *MsgBorrowAlternateResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgFixedTermBorrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBorrowAlternateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFixedTermBorrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBorrowAlternateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFixedTermBorrow.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1213,33 +1435,77 @@ func (m *MsgBorrowAlternateResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgBorrowAlternateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBorrowAlternateResponse.Merge(m, src)
+func (m *MsgFixedTermBorrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFixedTermBorrow.Merge(m, src)
 }
-func (m *MsgBorrowAlternateResponse) XXX_Size() int {
+func (m *MsgFixedTermBorrow) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBorrowAlternateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBorrowAlternateResponse.DiscardUnknown(m)
+func (m *MsgFixedTermBorrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFixedTermBorrow.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBorrowAlternateResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgFixedTermBorrow proto.InternalMessageInfo
 
-type MsgFundModuleAccountsResponse struct {
+func (m *MsgFixedTermBorrow) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
 }
 
-func (m *MsgFundModuleAccountsResponse) Reset()         { *m = MsgFundModuleAccountsResponse{} }
-func (m *MsgFundModuleAccountsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgFundModuleAccountsResponse) ProtoMessage()    {}
-func (*MsgFundModuleAccountsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgFixedTermBorrow) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MsgFixedTermBorrow) GetLendId() uint64 {
+	if m != nil {
+		return m.LendId
+	}
+	return 0
+}
+
+func (m *MsgFixedTermBorrow) GetPairId() uint64 {
+	if m != nil {
+		return m.PairId
+	}
+	return 0
+}
+
+func (m *MsgFixedTermBorrow) GetAmountIn() types.Coin {
+	if m != nil {
+		return m.AmountIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgFixedTermBorrow) GetAmountOut() types.Coin {
+	if m != nil {
+		return m.AmountOut
+	}
+	return types.Coin{}
+}
+
+type MsgFixedTermRepay struct {
+	Borrower string `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	LoanId   uint64 `protobuf:"varint,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (m *MsgFixedTermRepay) Reset()         { *m = MsgFixedTermRepay{} }
+func (m *MsgFixedTermRepay) String() string { return proto.CompactTextString(m) }
+func (*MsgFixedTermRepay) ProtoMessage()    {}
+func (*MsgFixedTermRepay) Descriptor() ([]byte, []int) {
 	return fileDescriptor_957d64b59d60594d, []int{23}
 }
-func (m *MsgFundModuleAccountsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgFixedTermRepay) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFundModuleAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFixedTermRepay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFundModuleAccountsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFixedTermRepay.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1249,35 +1515,47 @@ func (m *MsgFundModuleAccountsResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgFundModuleAccountsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFundModuleAccountsResponse.Merge(m, src)
+func (m *MsgFixedTermRepay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFixedTermRepay.Merge(m, src)
 }
-func (m *MsgFundModuleAccountsResponse) XXX_Size() int {
+func (m *MsgFixedTermRepay) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFundModuleAccountsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFundModuleAccountsResponse.DiscardUnknown(m)
+func (m *MsgFixedTermRepay) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFixedTermRepay.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFundModuleAccountsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgFixedTermRepay proto.InternalMessageInfo
 
-type MsgCalculateInterestAndRewardsResponse struct {
+func (m *MsgFixedTermRepay) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
 }
 
-func (m *MsgCalculateInterestAndRewardsResponse) Reset() {
-	*m = MsgCalculateInterestAndRewardsResponse{}
+func (m *MsgFixedTermRepay) GetLoanId() uint64 {
+	if m != nil {
+		return m.LoanId
+	}
+	return 0
 }
-func (m *MsgCalculateInterestAndRewardsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCalculateInterestAndRewardsResponse) ProtoMessage()    {}
-func (*MsgCalculateInterestAndRewardsResponse) Descriptor() ([]byte, []int) {
+
+type MsgLendResponse struct {
+}
+
+func (m *MsgLendResponse) Reset()         { *m = MsgLendResponse{} }
+func (m *MsgLendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLendResponse) ProtoMessage()    {}
+func (*MsgLendResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_957d64b59d60594d, []int{24}
 }
-func (m *MsgCalculateInterestAndRewardsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgLendResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCalculateInterestAndRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgLendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCalculateInterestAndRewardsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgLendResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1287,33 +1565,33 @@ func (m *MsgCalculateInterestAndRewardsResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *MsgCalculateInterestAndRewardsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCalculateInterestAndRewardsResponse.Merge(m, src)
+func (m *MsgLendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLendResponse.Merge(m, src)
 }
-func (m *MsgCalculateInterestAndRewardsResponse) XXX_Size() int {
+func (m *MsgLendResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCalculateInterestAndRewardsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCalculateInterestAndRewardsResponse.DiscardUnknown(m)
+func (m *MsgLendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLendResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCalculateInterestAndRewardsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgLendResponse proto.InternalMessageInfo
 
-type MsgFundReserveAccountsResponse struct {
+type MsgWithdrawResponse struct {
 }
 
-func (m *MsgFundReserveAccountsResponse) Reset()         { *m = MsgFundReserveAccountsResponse{} }
-func (m *MsgFundReserveAccountsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgFundReserveAccountsResponse) ProtoMessage()    {}
-func (*MsgFundReserveAccountsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
+func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawResponse) ProtoMessage()    {}
+func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_957d64b59d60594d, []int{25}
 }
-func (m *MsgFundReserveAccountsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFundReserveAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFundReserveAccountsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1323,1953 +1601,6160 @@ func (m *MsgFundReserveAccountsResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgFundReserveAccountsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFundReserveAccountsResponse.Merge(m, src)
+func (m *MsgWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawResponse.Merge(m, src)
 }
-func (m *MsgFundReserveAccountsResponse) XXX_Size() int {
+func (m *MsgWithdrawResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFundReserveAccountsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFundReserveAccountsResponse.DiscardUnknown(m)
+func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFundReserveAccountsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgLend)(nil), "comdex.lend.v1beta1.MsgLend")
-	proto.RegisterType((*MsgWithdraw)(nil), "comdex.lend.v1beta1.MsgWithdraw")
-	proto.RegisterType((*MsgDeposit)(nil), "comdex.lend.v1beta1.MsgDeposit")
-	proto.RegisterType((*MsgCloseLend)(nil), "comdex.lend.v1beta1.MsgCloseLend")
-	proto.RegisterType((*MsgBorrow)(nil), "comdex.lend.v1beta1.MsgBorrow")
-	proto.RegisterType((*MsgRepay)(nil), "comdex.lend.v1beta1.MsgRepay")
-	proto.RegisterType((*MsgDepositBorrow)(nil), "comdex.lend.v1beta1.MsgDepositBorrow")
-	proto.RegisterType((*MsgDraw)(nil), "comdex.lend.v1beta1.MsgDraw")
-	proto.RegisterType((*MsgCloseBorrow)(nil), "comdex.lend.v1beta1.MsgCloseBorrow")
-	proto.RegisterType((*MsgBorrowAlternate)(nil), "comdex.lend.v1beta1.MsgBorrowAlternate")
-	proto.RegisterType((*MsgFundModuleAccounts)(nil), "comdex.lend.v1beta1.MsgFundModuleAccounts")
-	proto.RegisterType((*MsgCalculateInterestAndRewards)(nil), "comdex.lend.v1beta1.MsgCalculateInterestAndRewards")
-	proto.RegisterType((*MsgFundReserveAccounts)(nil), "comdex.lend.v1beta1.MsgFundReserveAccounts")
-	proto.RegisterType((*MsgLendResponse)(nil), "comdex.lend.v1beta1.MsgLendResponse")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "comdex.lend.v1beta1.MsgWithdrawResponse")
-	proto.RegisterType((*MsgDepositResponse)(nil), "comdex.lend.v1beta1.MsgDepositResponse")
-	proto.RegisterType((*MsgCloseLendResponse)(nil), "comdex.lend.v1beta1.MsgCloseLendResponse")
-	proto.RegisterType((*MsgBorrowResponse)(nil), "comdex.lend.v1beta1.MsgBorrowResponse")
-	proto.RegisterType((*MsgRepayResponse)(nil), "comdex.lend.v1beta1.MsgRepayResponse")
-	proto.RegisterType((*MsgDepositBorrowResponse)(nil), "comdex.lend.v1beta1.MsgDepositBorrowResponse")
-	proto.RegisterType((*MsgDrawResponse)(nil), "comdex.lend.v1beta1.MsgDrawResponse")
-	proto.RegisterType((*MsgCloseBorrowResponse)(nil), "comdex.lend.v1beta1.MsgCloseBorrowResponse")
-	proto.RegisterType((*MsgBorrowAlternateResponse)(nil), "comdex.lend.v1beta1.MsgBorrowAlternateResponse")
-	proto.RegisterType((*MsgFundModuleAccountsResponse)(nil), "comdex.lend.v1beta1.MsgFundModuleAccountsResponse")
-	proto.RegisterType((*MsgCalculateInterestAndRewardsResponse)(nil), "comdex.lend.v1beta1.MsgCalculateInterestAndRewardsResponse")
-	proto.RegisterType((*MsgFundReserveAccountsResponse)(nil), "comdex.lend.v1beta1.MsgFundReserveAccountsResponse")
+type MsgDepositResponse struct {
 }
 
-func init() { proto.RegisterFile("comdex/lend/v1beta1/tx.proto", fileDescriptor_957d64b59d60594d) }
-
-var fileDescriptor_957d64b59d60594d = []byte{
-	// 972 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x8f, 0xdb, 0x44,
-	0x14, 0x5f, 0x6f, 0xb2, 0xf9, 0x78, 0x0b, 0xfd, 0xf0, 0x7e, 0x34, 0x75, 0xb7, 0xde, 0x10, 0x68,
-	0x1b, 0xa8, 0xea, 0xa8, 0xbb, 0x07, 0x0e, 0x54, 0xa0, 0xdd, 0xae, 0x10, 0xa9, 0xb0, 0x40, 0xae,
-	0x04, 0x82, 0x4b, 0x34, 0x89, 0xa7, 0xae, 0x85, 0xd7, 0x63, 0x79, 0x26, 0x9b, 0x56, 0x70, 0x41,
-	0x70, 0x87, 0x33, 0x77, 0xfe, 0x07, 0xfe, 0x84, 0x1e, 0x7b, 0xe4, 0x84, 0xd0, 0xee, 0xbf, 0xc1,
-	0x01, 0xcd, 0x78, 0x3c, 0x76, 0xb6, 0xb1, 0x93, 0x74, 0xd5, 0xde, 0x66, 0xe6, 0xfd, 0xde, 0xc7,
-	0xbc, 0xf7, 0x9b, 0xf7, 0x6c, 0xd8, 0x19, 0x91, 0x63, 0x17, 0x3f, 0xeb, 0x05, 0x38, 0x74, 0x7b,
-	0x27, 0xf7, 0x87, 0x98, 0xa1, 0xfb, 0x3d, 0xf6, 0xcc, 0x8a, 0x62, 0xc2, 0x88, 0xbe, 0x91, 0x48,
-	0x2d, 0x2e, 0xb5, 0xa4, 0xd4, 0x30, 0x47, 0x84, 0x1e, 0x13, 0xda, 0x1b, 0x22, 0x8a, 0x95, 0xca,
-	0x88, 0xf8, 0x61, 0xa2, 0x64, 0x6c, 0x7a, 0xc4, 0x23, 0x62, 0xd9, 0xe3, 0xab, 0xe4, 0xb4, 0xf3,
-	0xa7, 0x06, 0x75, 0x9b, 0x7a, 0x5f, 0xe2, 0xd0, 0xd5, 0xb7, 0xa1, 0xc6, 0x2d, 0xe2, 0xb8, 0xa5,
-	0xb5, 0xb5, 0x6e, 0xd3, 0x91, 0x3b, 0xfd, 0x3a, 0x34, 0x10, 0xa5, 0x98, 0x0d, 0x7c, 0xb7, 0xb5,
-	0xda, 0xd6, 0xba, 0x55, 0xa7, 0x2e, 0xf6, 0x7d, 0x57, 0xff, 0x18, 0x6a, 0xe8, 0x98, 0x8c, 0x43,
-	0xd6, 0xaa, 0xb4, 0xb5, 0xee, 0xfa, 0xde, 0x75, 0x2b, 0x89, 0xc2, 0xe2, 0x51, 0xa4, 0xa1, 0x59,
-	0x0f, 0x89, 0x1f, 0x1e, 0x56, 0x5f, 0xfc, 0xb3, 0xbb, 0xe2, 0x48, 0xb8, 0x7e, 0x0d, 0xea, 0x11,
-	0x21, 0x01, 0x37, 0x59, 0x15, 0x26, 0x6b, 0x7c, 0xdb, 0x77, 0xf5, 0x2d, 0xa8, 0xa1, 0x28, 0xe2,
-	0xe7, 0x6b, 0xe2, 0x7c, 0x0d, 0x45, 0x51, 0xdf, 0xed, 0x4c, 0x60, 0xdd, 0xa6, 0xde, 0xb7, 0x3e,
-	0x7b, 0xea, 0xc6, 0x68, 0x52, 0x18, 0xea, 0x35, 0xa8, 0xf3, 0x55, 0x16, 0xa9, 0x10, 0x5c, 0x20,
-	0xd0, 0xce, 0x09, 0x80, 0x4d, 0xbd, 0x23, 0x1c, 0x11, 0xea, 0xb3, 0xb7, 0xe8, 0xf7, 0x33, 0x78,
-	0xc7, 0xa6, 0xde, 0xc3, 0x80, 0x50, 0x5c, 0x5a, 0x9c, 0x22, 0xcf, 0x9d, 0x9f, 0x57, 0xa1, 0x69,
-	0x53, 0xef, 0x90, 0xc4, 0x31, 0x99, 0xe8, 0x06, 0x34, 0x86, 0x62, 0xa5, 0x0c, 0xa8, 0x7d, 0x71,
-	0xf0, 0xbc, 0x48, 0xc8, 0x8f, 0xb9, 0xa0, 0x22, 0x8b, 0x84, 0xfc, 0xb8, 0xef, 0xea, 0x5d, 0xb8,
-	0xe2, 0xd3, 0x01, 0x65, 0x68, 0x18, 0xe0, 0x41, 0x62, 0x47, 0x94, 0xb1, 0xe1, 0x5c, 0xf2, 0xe9,
-	0x63, 0x71, 0x2c, 0xfd, 0x3e, 0x80, 0x66, 0x72, 0xa1, 0x81, 0x1f, 0x8a, 0x8a, 0x2e, 0x90, 0x82,
-	0x46, 0xa2, 0xd1, 0x0f, 0xf5, 0x4f, 0x01, 0xa4, 0x36, 0x19, 0xb3, 0x56, 0x6d, 0x31, 0x75, 0xe9,
-	0xf0, 0xab, 0x31, 0xeb, 0xfc, 0x04, 0x0d, 0x9b, 0x7a, 0x0e, 0x8e, 0xd0, 0xf3, 0xd2, 0x0c, 0xdc,
-	0x80, 0x66, 0xb2, 0xce, 0x72, 0x20, 0x85, 0x17, 0x29, 0xe1, 0xaf, 0x1a, 0x5c, 0xc9, 0xb8, 0xb3,
-	0x40, 0x21, 0xde, 0x4c, 0x18, 0x3f, 0x8a, 0x17, 0x7e, 0xc4, 0x9f, 0xcd, 0xdb, 0x77, 0xde, 0x87,
-	0x4b, 0x29, 0x8d, 0x2f, 0x98, 0x80, 0xce, 0x5f, 0xab, 0xa0, 0x2b, 0x42, 0x1f, 0x04, 0x0c, 0xc7,
-	0x21, 0x62, 0xf8, 0x75, 0xba, 0x56, 0xae, 0xf9, 0x54, 0xa6, 0x9a, 0xcf, 0x14, 0x5b, 0xab, 0xcb,
-	0xb2, 0x35, 0xf7, 0x5c, 0xd6, 0xe6, 0x3e, 0x97, 0xda, 0xcc, 0xe7, 0x32, 0x4d, 0xf8, 0xfa, 0xb2,
-	0x84, 0xcf, 0x75, 0xcf, 0x46, 0xbe, 0x7b, 0xfe, 0xa1, 0xc1, 0x96, 0x4d, 0xbd, 0xcf, 0xc7, 0xa1,
-	0x6b, 0x13, 0x77, 0x1c, 0xe0, 0x83, 0xd1, 0x88, 0xab, 0x50, 0x9e, 0xbd, 0xe4, 0xee, 0x22, 0x7b,
-	0x59, 0x26, 0x5a, 0x90, 0x66, 0xeb, 0x7c, 0xf2, 0xb2, 0x7c, 0x57, 0xa6, 0xf2, 0x9d, 0x51, 0xa4,
-	0xba, 0x1c, 0x45, 0x1e, 0x80, 0xc9, 0x29, 0x82, 0x82, 0xd1, 0x38, 0x40, 0x0c, 0xf7, 0x43, 0x86,
-	0x63, 0x4c, 0xd9, 0x41, 0xe8, 0x3a, 0x78, 0x82, 0x62, 0x97, 0x96, 0x51, 0xa6, 0xf3, 0x8b, 0x06,
-	0xdb, 0xf2, 0x6a, 0x0e, 0xa6, 0x38, 0x3e, 0xc9, 0xee, 0x96, 0xbb, 0x83, 0x56, 0x74, 0x87, 0xd5,
-	0x82, 0x3b, 0x2c, 0x49, 0xf3, 0xab, 0x70, 0x59, 0x4e, 0x51, 0x07, 0xd3, 0x88, 0x84, 0x14, 0x77,
-	0xb6, 0x60, 0x23, 0x37, 0xb1, 0xd4, 0xf1, 0xa6, 0x20, 0xb1, 0xec, 0x09, 0xea, 0x74, 0x1b, 0x36,
-	0xf3, 0xdd, 0x5e, 0x9d, 0x6f, 0xc0, 0x55, 0x45, 0x79, 0x75, 0xa8, 0x8b, 0xb6, 0x22, 0xba, 0x9a,
-	0x3a, 0x33, 0xa0, 0x75, 0xbe, 0xd5, 0x28, 0x59, 0x12, 0xdc, 0x51, 0x3e, 0x8a, 0x96, 0x48, 0x5a,
-	0xee, 0x59, 0x2a, 0xc9, 0x0e, 0x18, 0xaf, 0x3e, 0x32, 0x25, 0xdd, 0x85, 0x9b, 0x33, 0x79, 0xa4,
-	0x00, 0x5d, 0xb8, 0x5d, 0x5e, 0x4c, 0x85, 0x6c, 0x8b, 0xb2, 0xcf, 0xa8, 0x5b, 0x8a, 0xd8, 0xfb,
-	0xaf, 0x09, 0x15, 0x9b, 0x7a, 0xfa, 0x23, 0xa8, 0x8a, 0x11, 0xb8, 0x63, 0xcd, 0xf8, 0xee, 0xb1,
-	0x64, 0xde, 0x8d, 0x0f, 0xca, 0xa4, 0xa9, 0x4d, 0xfd, 0x1b, 0x68, 0xa8, 0x8f, 0x88, 0x76, 0x91,
-	0x46, 0x8a, 0x30, 0xba, 0xf3, 0x10, 0xca, 0xee, 0x63, 0xa8, 0xa7, 0xdf, 0x08, 0xbb, 0x45, 0x4a,
-	0x12, 0x60, 0xdc, 0x99, 0x03, 0x50, 0x46, 0xbf, 0x83, 0x66, 0xf6, 0x01, 0xf0, 0x5e, 0x91, 0x96,
-	0x82, 0x18, 0x1f, 0xce, 0x85, 0x28, 0xd3, 0x5f, 0x43, 0x4d, 0xb6, 0x1c, 0xb3, 0x48, 0x29, 0x91,
-	0x1b, 0xb7, 0xcb, 0xe5, 0xca, 0xa2, 0x0d, 0x6b, 0xc9, 0xa0, 0xbd, 0x59, 0xa4, 0x20, 0xc4, 0xc6,
-	0xad, 0x52, 0xb1, 0x32, 0x87, 0xe1, 0xdd, 0xe9, 0xc1, 0x79, 0x6b, 0x4e, 0xd6, 0x64, 0xb8, 0xf7,
-	0x16, 0x82, 0x29, 0x37, 0x8f, 0xa0, 0x2a, 0x26, 0x63, 0x21, 0xb7, 0xb8, 0xb4, 0x98, 0x5b, 0xf9,
-	0x47, 0xa5, 0x0f, 0x60, 0x3d, 0x3f, 0xe8, 0xde, 0x2f, 0xad, 0x86, 0x0c, 0xf7, 0xee, 0x02, 0x20,
-	0xe5, 0xe0, 0x07, 0xb8, 0x7c, 0x7e, 0xfa, 0xdd, 0x29, 0xaf, 0x8e, 0x02, 0x1a, 0xbd, 0x05, 0x81,
-	0xca, 0x19, 0x03, 0x7d, 0xc6, 0xbc, 0xf8, 0xa8, 0xc8, 0xcc, 0xab, 0x58, 0x63, 0x6f, 0x71, 0xac,
-	0xf2, 0xfa, 0x9b, 0x06, 0x37, 0xca, 0x46, 0xc1, 0x7e, 0x61, 0xbe, 0x8a, 0x95, 0x8c, 0x4f, 0x5e,
-	0x43, 0x49, 0x45, 0x34, 0x81, 0x8d, 0x59, 0xc3, 0xe5, 0x6e, 0xd9, 0xe5, 0xce, 0x81, 0x8d, 0xfd,
-	0x25, 0xc0, 0xa9, 0xe3, 0xc3, 0x2f, 0x5e, 0x9c, 0x9a, 0xda, 0xcb, 0x53, 0x53, 0xfb, 0xf7, 0xd4,
-	0xd4, 0x7e, 0x3f, 0x33, 0x57, 0x5e, 0x9e, 0x99, 0x2b, 0x7f, 0x9f, 0x99, 0x2b, 0xdf, 0x5b, 0x9e,
-	0xcf, 0x9e, 0x8e, 0x87, 0xdc, 0x68, 0x2f, 0x31, 0x7c, 0x8f, 0x3c, 0x79, 0xe2, 0x8f, 0x7c, 0x14,
-	0xc8, 0x7d, 0x4f, 0xfe, 0x3a, 0xb2, 0xe7, 0x11, 0xa6, 0xc3, 0x9a, 0xf8, 0xd7, 0xdb, 0xff, 0x3f,
-	0x00, 0x00, 0xff, 0xff, 0xd8, 0x0b, 0x02, 0x5e, 0x56, 0x0e, 0x00, 0x00,
+func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
+func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositResponse) ProtoMessage()    {}
+func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{26}
+}
+func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositResponse.Merge(m, src)
+}
+func (m *MsgDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// LendAsset defines a method for lending coins to the ModuleAccount.
-	Lend(ctx context.Context, in *MsgLend, opts ...grpc.CallOption) (*MsgLendResponse, error)
-	// WithdrawAsset defines a method for withdrawing previously loaned coins from
-	// the ModuleAccount.
-	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
-	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
-	CloseLend(ctx context.Context, in *MsgCloseLend, opts ...grpc.CallOption) (*MsgCloseLendResponse, error)
-	// BorrowAsset defines a method for borrowing coins from the ModuleAccount.
-	Borrow(ctx context.Context, in *MsgBorrow, opts ...grpc.CallOption) (*MsgBorrowResponse, error)
-	// RepayAsset defines a method for repaying borrowed coins to the ModuleAccount.
-	Repay(ctx context.Context, in *MsgRepay, opts ...grpc.CallOption) (*MsgRepayResponse, error)
-	DepositBorrow(ctx context.Context, in *MsgDepositBorrow, opts ...grpc.CallOption) (*MsgDepositBorrowResponse, error)
-	Draw(ctx context.Context, in *MsgDraw, opts ...grpc.CallOption) (*MsgDrawResponse, error)
-	CloseBorrow(ctx context.Context, in *MsgCloseBorrow, opts ...grpc.CallOption) (*MsgCloseBorrowResponse, error)
-	BorrowAlternate(ctx context.Context, in *MsgBorrowAlternate, opts ...grpc.CallOption) (*MsgBorrowAlternateResponse, error)
-	// FundModuleAccounts funds an existing module account
-	FundModuleAccounts(ctx context.Context, in *MsgFundModuleAccounts, opts ...grpc.CallOption) (*MsgFundModuleAccountsResponse, error)
-	CalculateInterestAndRewards(ctx context.Context, in *MsgCalculateInterestAndRewards, opts ...grpc.CallOption) (*MsgCalculateInterestAndRewardsResponse, error)
-	FundReserveAccounts(ctx context.Context, in *MsgFundReserveAccounts, opts ...grpc.CallOption) (*MsgFundReserveAccountsResponse, error)
+type MsgCloseLendResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCloseLendResponse) Reset()         { *m = MsgCloseLendResponse{} }
+func (m *MsgCloseLendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseLendResponse) ProtoMessage()    {}
+func (*MsgCloseLendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{27}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCloseLendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) Lend(ctx context.Context, in *MsgLend, opts ...grpc.CallOption) (*MsgLendResponse, error) {
-	out := new(MsgLendResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/Lend", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCloseLendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseLendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/Withdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCloseLendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseLendResponse.Merge(m, src)
 }
-
-func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
-	out := new(MsgDepositResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCloseLendResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) CloseLend(ctx context.Context, in *MsgCloseLend, opts ...grpc.CallOption) (*MsgCloseLendResponse, error) {
-	out := new(MsgCloseLendResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/CloseLend", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCloseLendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseLendResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Borrow(ctx context.Context, in *MsgBorrow, opts ...grpc.CallOption) (*MsgBorrowResponse, error) {
-	out := new(MsgBorrowResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/Borrow", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgCloseLendResponse proto.InternalMessageInfo
 
-func (c *msgClient) Repay(ctx context.Context, in *MsgRepay, opts ...grpc.CallOption) (*MsgRepayResponse, error) {
-	out := new(MsgRepayResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/Repay", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgBorrowResponse struct {
 }
 
-func (c *msgClient) DepositBorrow(ctx context.Context, in *MsgDepositBorrow, opts ...grpc.CallOption) (*MsgDepositBorrowResponse, error) {
-	out := new(MsgDepositBorrowResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/DepositBorrow", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgBorrowResponse) Reset()         { *m = MsgBorrowResponse{} }
+func (m *MsgBorrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBorrowResponse) ProtoMessage()    {}
+func (*MsgBorrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{28}
 }
-
-func (c *msgClient) Draw(ctx context.Context, in *MsgDraw, opts ...grpc.CallOption) (*MsgDrawResponse, error) {
-	out := new(MsgDrawResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/Draw", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgBorrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) CloseBorrow(ctx context.Context, in *MsgCloseBorrow, opts ...grpc.CallOption) (*MsgCloseBorrowResponse, error) {
-	out := new(MsgCloseBorrowResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/CloseBorrow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBorrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) BorrowAlternate(ctx context.Context, in *MsgBorrowAlternate, opts ...grpc.CallOption) (*MsgBorrowAlternateResponse, error) {
-	out := new(MsgBorrowAlternateResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/BorrowAlternate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgBorrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBorrowResponse.Merge(m, src)
 }
-
-func (c *msgClient) FundModuleAccounts(ctx context.Context, in *MsgFundModuleAccounts, opts ...grpc.CallOption) (*MsgFundModuleAccountsResponse, error) {
-	out := new(MsgFundModuleAccountsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/FundModuleAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgBorrowResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) CalculateInterestAndRewards(ctx context.Context, in *MsgCalculateInterestAndRewards, opts ...grpc.CallOption) (*MsgCalculateInterestAndRewardsResponse, error) {
-	out := new(MsgCalculateInterestAndRewardsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/CalculateInterestAndRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgBorrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBorrowResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) FundReserveAccounts(ctx context.Context, in *MsgFundReserveAccounts, opts ...grpc.CallOption) (*MsgFundReserveAccountsResponse, error) {
-	out := new(MsgFundReserveAccountsResponse)
-	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/FundReserveAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgBorrowResponse proto.InternalMessageInfo
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// LendAsset defines a method for lending coins to the ModuleAccount.
-	Lend(context.Context, *MsgLend) (*MsgLendResponse, error)
-	// WithdrawAsset defines a method for withdrawing previously loaned coins from
-	// the ModuleAccount.
-	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
-	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
-	CloseLend(context.Context, *MsgCloseLend) (*MsgCloseLendResponse, error)
-	// BorrowAsset defines a method for borrowing coins from the ModuleAccount.
-	Borrow(context.Context, *MsgBorrow) (*MsgBorrowResponse, error)
-	// RepayAsset defines a method for repaying borrowed coins to the ModuleAccount.
-	Repay(context.Context, *MsgRepay) (*MsgRepayResponse, error)
-	DepositBorrow(context.Context, *MsgDepositBorrow) (*MsgDepositBorrowResponse, error)
-	Draw(context.Context, *MsgDraw) (*MsgDrawResponse, error)
-	CloseBorrow(context.Context, *MsgCloseBorrow) (*MsgCloseBorrowResponse, error)
-	BorrowAlternate(context.Context, *MsgBorrowAlternate) (*MsgBorrowAlternateResponse, error)
-	// FundModuleAccounts funds an existing module account
-	FundModuleAccounts(context.Context, *MsgFundModuleAccounts) (*MsgFundModuleAccountsResponse, error)
-	CalculateInterestAndRewards(context.Context, *MsgCalculateInterestAndRewards) (*MsgCalculateInterestAndRewardsResponse, error)
-	FundReserveAccounts(context.Context, *MsgFundReserveAccounts) (*MsgFundReserveAccountsResponse, error)
+type MsgRepayResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRepayResponse) Reset()         { *m = MsgRepayResponse{} }
+func (m *MsgRepayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRepayResponse) ProtoMessage()    {}
+func (*MsgRepayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{29}
 }
-
-func (*UnimplementedMsgServer) Lend(ctx context.Context, req *MsgLend) (*MsgLendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lend not implemented")
+func (m *MsgRepayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+func (m *MsgRepayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *MsgRepayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepayResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CloseLend(ctx context.Context, req *MsgCloseLend) (*MsgCloseLendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseLend not implemented")
+func (m *MsgRepayResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Borrow(ctx context.Context, req *MsgBorrow) (*MsgBorrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Borrow not implemented")
+func (m *MsgRepayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepayResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) Repay(ctx context.Context, req *MsgRepay) (*MsgRepayResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Repay not implemented")
+
+var xxx_messageInfo_MsgRepayResponse proto.InternalMessageInfo
+
+type MsgDepositBorrowResponse struct {
 }
-func (*UnimplementedMsgServer) DepositBorrow(ctx context.Context, req *MsgDepositBorrow) (*MsgDepositBorrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositBorrow not implemented")
+
+func (m *MsgDepositBorrowResponse) Reset()         { *m = MsgDepositBorrowResponse{} }
+func (m *MsgDepositBorrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositBorrowResponse) ProtoMessage()    {}
+func (*MsgDepositBorrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{30}
 }
-func (*UnimplementedMsgServer) Draw(ctx context.Context, req *MsgDraw) (*MsgDrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Draw not implemented")
+func (m *MsgDepositBorrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CloseBorrow(ctx context.Context, req *MsgCloseBorrow) (*MsgCloseBorrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseBorrow not implemented")
+func (m *MsgDepositBorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositBorrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) BorrowAlternate(ctx context.Context, req *MsgBorrowAlternate) (*MsgBorrowAlternateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BorrowAlternate not implemented")
+func (m *MsgDepositBorrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositBorrowResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) FundModuleAccounts(ctx context.Context, req *MsgFundModuleAccounts) (*MsgFundModuleAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundModuleAccounts not implemented")
+func (m *MsgDepositBorrowResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CalculateInterestAndRewards(ctx context.Context, req *MsgCalculateInterestAndRewards) (*MsgCalculateInterestAndRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculateInterestAndRewards not implemented")
+func (m *MsgDepositBorrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositBorrowResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) FundReserveAccounts(ctx context.Context, req *MsgFundReserveAccounts) (*MsgFundReserveAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundReserveAccounts not implemented")
+
+var xxx_messageInfo_MsgDepositBorrowResponse proto.InternalMessageInfo
+
+type MsgDrawResponse struct {
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgDrawResponse) Reset()         { *m = MsgDrawResponse{} }
+func (m *MsgDrawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDrawResponse) ProtoMessage()    {}
+func (*MsgDrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{31}
 }
-
-func _Msg_Lend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLend)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Lend(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/Lend",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Lend(ctx, req.(*MsgLend))
+func (m *MsgDrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDrawResponse.Merge(m, src)
+}
+func (m *MsgDrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDrawResponse.DiscardUnknown(m)
 }
 
-func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdraw)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/Withdraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgDrawResponse proto.InternalMessageInfo
+
+type MsgCloseBorrowResponse struct {
 }
 
-func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/Deposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+func (m *MsgCloseBorrowResponse) Reset()         { *m = MsgCloseBorrowResponse{} }
+func (m *MsgCloseBorrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseBorrowResponse) ProtoMessage()    {}
+func (*MsgCloseBorrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{32}
+}
+func (m *MsgCloseBorrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseBorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseBorrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCloseBorrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseBorrowResponse.Merge(m, src)
+}
+func (m *MsgCloseBorrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseBorrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseBorrowResponse.DiscardUnknown(m)
 }
 
-func _Msg_CloseLend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCloseLend)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CloseLend(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/CloseLend",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CloseLend(ctx, req.(*MsgCloseLend))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCloseBorrowResponse proto.InternalMessageInfo
+
+type MsgBorrowAlternateResponse struct {
 }
 
-func _Msg_Borrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBorrow)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Borrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/Borrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Borrow(ctx, req.(*MsgBorrow))
+func (m *MsgBorrowAlternateResponse) Reset()         { *m = MsgBorrowAlternateResponse{} }
+func (m *MsgBorrowAlternateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBorrowAlternateResponse) ProtoMessage()    {}
+func (*MsgBorrowAlternateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{33}
+}
+func (m *MsgBorrowAlternateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBorrowAlternateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBorrowAlternateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgBorrowAlternateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBorrowAlternateResponse.Merge(m, src)
+}
+func (m *MsgBorrowAlternateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBorrowAlternateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBorrowAlternateResponse.DiscardUnknown(m)
 }
 
-func _Msg_Repay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRepay)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Repay(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/Repay",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Repay(ctx, req.(*MsgRepay))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgBorrowAlternateResponse proto.InternalMessageInfo
+
+type MsgFundModuleAccountsResponse struct {
 }
 
-func _Msg_DepositBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositBorrow)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositBorrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/DepositBorrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositBorrow(ctx, req.(*MsgDepositBorrow))
+func (m *MsgFundModuleAccountsResponse) Reset()         { *m = MsgFundModuleAccountsResponse{} }
+func (m *MsgFundModuleAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundModuleAccountsResponse) ProtoMessage()    {}
+func (*MsgFundModuleAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{34}
+}
+func (m *MsgFundModuleAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundModuleAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundModuleAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgFundModuleAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundModuleAccountsResponse.Merge(m, src)
+}
+func (m *MsgFundModuleAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundModuleAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundModuleAccountsResponse.DiscardUnknown(m)
 }
 
-func _Msg_Draw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDraw)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Draw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/Draw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Draw(ctx, req.(*MsgDraw))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgFundModuleAccountsResponse proto.InternalMessageInfo
+
+type MsgCalculateInterestAndRewardsResponse struct {
 }
 
-func _Msg_CloseBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCloseBorrow)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CloseBorrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/CloseBorrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CloseBorrow(ctx, req.(*MsgCloseBorrow))
+func (m *MsgCalculateInterestAndRewardsResponse) Reset() {
+	*m = MsgCalculateInterestAndRewardsResponse{}
+}
+func (m *MsgCalculateInterestAndRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCalculateInterestAndRewardsResponse) ProtoMessage()    {}
+func (*MsgCalculateInterestAndRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{35}
+}
+func (m *MsgCalculateInterestAndRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCalculateInterestAndRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCalculateInterestAndRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCalculateInterestAndRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCalculateInterestAndRewardsResponse.Merge(m, src)
+}
+func (m *MsgCalculateInterestAndRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCalculateInterestAndRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCalculateInterestAndRewardsResponse.DiscardUnknown(m)
 }
 
-func _Msg_BorrowAlternate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBorrowAlternate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BorrowAlternate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/BorrowAlternate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BorrowAlternate(ctx, req.(*MsgBorrowAlternate))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCalculateInterestAndRewardsResponse proto.InternalMessageInfo
+
+type MsgFundReserveAccountsResponse struct {
 }
 
-func _Msg_FundModuleAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundModuleAccounts)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundModuleAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/FundModuleAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundModuleAccounts(ctx, req.(*MsgFundModuleAccounts))
+func (m *MsgFundReserveAccountsResponse) Reset()         { *m = MsgFundReserveAccountsResponse{} }
+func (m *MsgFundReserveAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundReserveAccountsResponse) ProtoMessage()    {}
+func (*MsgFundReserveAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{36}
+}
+func (m *MsgFundReserveAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundReserveAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundReserveAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgFundReserveAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundReserveAccountsResponse.Merge(m, src)
+}
+func (m *MsgFundReserveAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundReserveAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundReserveAccountsResponse.DiscardUnknown(m)
 }
 
-func _Msg_CalculateInterestAndRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCalculateInterestAndRewards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CalculateInterestAndRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/CalculateInterestAndRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CalculateInterestAndRewards(ctx, req.(*MsgCalculateInterestAndRewards))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgFundReserveAccountsResponse proto.InternalMessageInfo
+
+type MsgGrantPositionManagerResponse struct {
 }
 
-func _Msg_FundReserveAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundReserveAccounts)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundReserveAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.lend.v1beta1.Msg/FundReserveAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundReserveAccounts(ctx, req.(*MsgFundReserveAccounts))
+func (m *MsgGrantPositionManagerResponse) Reset()         { *m = MsgGrantPositionManagerResponse{} }
+func (m *MsgGrantPositionManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantPositionManagerResponse) ProtoMessage()    {}
+func (*MsgGrantPositionManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{37}
+}
+func (m *MsgGrantPositionManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantPositionManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantPositionManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGrantPositionManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantPositionManagerResponse.Merge(m, src)
+}
+func (m *MsgGrantPositionManagerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantPositionManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantPositionManagerResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "comdex.lend.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Lend",
-			Handler:    _Msg_Lend_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "CloseLend",
-			Handler:    _Msg_CloseLend_Handler,
-		},
-		{
-			MethodName: "Borrow",
-			Handler:    _Msg_Borrow_Handler,
-		},
-		{
-			MethodName: "Repay",
-			Handler:    _Msg_Repay_Handler,
-		},
-		{
-			MethodName: "DepositBorrow",
-			Handler:    _Msg_DepositBorrow_Handler,
-		},
-		{
-			MethodName: "Draw",
-			Handler:    _Msg_Draw_Handler,
-		},
-		{
-			MethodName: "CloseBorrow",
-			Handler:    _Msg_CloseBorrow_Handler,
-		},
-		{
-			MethodName: "BorrowAlternate",
-			Handler:    _Msg_BorrowAlternate_Handler,
-		},
-		{
-			MethodName: "FundModuleAccounts",
-			Handler:    _Msg_FundModuleAccounts_Handler,
-		},
-		{
-			MethodName: "CalculateInterestAndRewards",
-			Handler:    _Msg_CalculateInterestAndRewards_Handler,
-		},
-		{
-			MethodName: "FundReserveAccounts",
-			Handler:    _Msg_FundReserveAccounts_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "comdex/lend/v1beta1/tx.proto",
+var xxx_messageInfo_MsgGrantPositionManagerResponse proto.InternalMessageInfo
+
+type MsgRevokePositionManagerResponse struct {
 }
 
-func (m *MsgLend) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokePositionManagerResponse) Reset()         { *m = MsgRevokePositionManagerResponse{} }
+func (m *MsgRevokePositionManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePositionManagerResponse) ProtoMessage()    {}
+func (*MsgRevokePositionManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{38}
+}
+func (m *MsgRevokePositionManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePositionManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePositionManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevokePositionManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePositionManagerResponse.Merge(m, src)
+}
+func (m *MsgRevokePositionManagerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePositionManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePositionManagerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokePositionManagerResponse proto.InternalMessageInfo
+
+type MsgDepositAccountCollateralResponse struct {
 }
 
-func (m *MsgLend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgDepositAccountCollateralResponse) Reset()         { *m = MsgDepositAccountCollateralResponse{} }
+func (m *MsgDepositAccountCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositAccountCollateralResponse) ProtoMessage()    {}
+func (*MsgDepositAccountCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{39}
+}
+func (m *MsgDepositAccountCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositAccountCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositAccountCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.AssetId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AssetId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Lender) > 0 {
-		i -= len(m.Lender)
-		copy(dAtA[i:], m.Lender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgDepositAccountCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositAccountCollateralResponse.Merge(m, src)
+}
+func (m *MsgDepositAccountCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositAccountCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositAccountCollateralResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDepositAccountCollateralResponse proto.InternalMessageInfo
+
+type MsgWithdrawAccountCollateralResponse struct {
 }
 
-func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgWithdrawAccountCollateralResponse) Reset()         { *m = MsgWithdrawAccountCollateralResponse{} }
+func (m *MsgWithdrawAccountCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAccountCollateralResponse) ProtoMessage()    {}
+func (*MsgWithdrawAccountCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{40}
+}
+func (m *MsgWithdrawAccountCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAccountCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAccountCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.LendId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LendId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Lender) > 0 {
-		i -= len(m.Lender)
-		copy(dAtA[i:], m.Lender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgWithdrawAccountCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAccountCollateralResponse.Merge(m, src)
+}
+func (m *MsgWithdrawAccountCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAccountCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAccountCollateralResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgWithdrawAccountCollateralResponse proto.InternalMessageInfo
+
+type MsgAccountBorrowResponse struct {
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgAccountBorrowResponse) Reset()         { *m = MsgAccountBorrowResponse{} }
+func (m *MsgAccountBorrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAccountBorrowResponse) ProtoMessage()    {}
+func (*MsgAccountBorrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{41}
+}
+func (m *MsgAccountBorrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAccountBorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAccountBorrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.LendId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LendId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Lender) > 0 {
-		i -= len(m.Lender)
-		copy(dAtA[i:], m.Lender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAccountBorrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAccountBorrowResponse.Merge(m, src)
+}
+func (m *MsgAccountBorrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAccountBorrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAccountBorrowResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCloseLend) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAccountBorrowResponse proto.InternalMessageInfo
+
+type MsgAccountRepayResponse struct {
+}
+
+func (m *MsgAccountRepayResponse) Reset()         { *m = MsgAccountRepayResponse{} }
+func (m *MsgAccountRepayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAccountRepayResponse) ProtoMessage()    {}
+func (*MsgAccountRepayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{42}
+}
+func (m *MsgAccountRepayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAccountRepayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAccountRepayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAccountRepayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAccountRepayResponse.Merge(m, src)
+}
+func (m *MsgAccountRepayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAccountRepayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAccountRepayResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCloseLend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAccountRepayResponse proto.InternalMessageInfo
+
+type MsgLiquidateAccountResponse struct {
 }
 
-func (m *MsgCloseLend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LendId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LendId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Lender) > 0 {
-		i -= len(m.Lender)
-		copy(dAtA[i:], m.Lender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgLiquidateAccountResponse) Reset()         { *m = MsgLiquidateAccountResponse{} }
+func (m *MsgLiquidateAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidateAccountResponse) ProtoMessage()    {}
+func (*MsgLiquidateAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{43}
+}
+func (m *MsgLiquidateAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidateAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidateAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgLiquidateAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidateAccountResponse.Merge(m, src)
+}
+func (m *MsgLiquidateAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidateAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidateAccountResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBorrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgLiquidateAccountResponse proto.InternalMessageInfo
+
+type MsgFixedTermCommitResponse struct {
+}
+
+func (m *MsgFixedTermCommitResponse) Reset()         { *m = MsgFixedTermCommitResponse{} }
+func (m *MsgFixedTermCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFixedTermCommitResponse) ProtoMessage()    {}
+func (*MsgFixedTermCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{44}
+}
+func (m *MsgFixedTermCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFixedTermCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFixedTermCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgFixedTermCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFixedTermCommitResponse.Merge(m, src)
+}
+func (m *MsgFixedTermCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFixedTermCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFixedTermCommitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBorrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgFixedTermCommitResponse proto.InternalMessageInfo
+
+type MsgFixedTermRedeemResponse struct {
 }
 
-func (m *MsgBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgFixedTermRedeemResponse) Reset()         { *m = MsgFixedTermRedeemResponse{} }
+func (m *MsgFixedTermRedeemResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFixedTermRedeemResponse) ProtoMessage()    {}
+func (*MsgFixedTermRedeemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{45}
+}
+func (m *MsgFixedTermRedeemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFixedTermRedeemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFixedTermRedeemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgFixedTermRedeemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFixedTermRedeemResponse.Merge(m, src)
+}
+func (m *MsgFixedTermRedeemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFixedTermRedeemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFixedTermRedeemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFixedTermRedeemResponse proto.InternalMessageInfo
+
+type MsgFixedTermBorrowResponse struct {
+}
+
+func (m *MsgFixedTermBorrowResponse) Reset()         { *m = MsgFixedTermBorrowResponse{} }
+func (m *MsgFixedTermBorrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFixedTermBorrowResponse) ProtoMessage()    {}
+func (*MsgFixedTermBorrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{46}
+}
+func (m *MsgFixedTermBorrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFixedTermBorrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFixedTermBorrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.IsStableBorrow {
-		i--
-		if m.IsStableBorrow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *MsgFixedTermBorrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFixedTermBorrowResponse.Merge(m, src)
+}
+func (m *MsgFixedTermBorrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFixedTermBorrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFixedTermBorrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFixedTermBorrowResponse proto.InternalMessageInfo
+
+type MsgFixedTermRepayResponse struct {
+}
+
+func (m *MsgFixedTermRepayResponse) Reset()         { *m = MsgFixedTermRepayResponse{} }
+func (m *MsgFixedTermRepayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFixedTermRepayResponse) ProtoMessage()    {}
+func (*MsgFixedTermRepayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d64b59d60594d, []int{47}
+}
+func (m *MsgFixedTermRepayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFixedTermRepayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFixedTermRepayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.PairId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PairId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LendId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LendId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Borrower) > 0 {
-		i -= len(m.Borrower)
-		copy(dAtA[i:], m.Borrower)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgFixedTermRepayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFixedTermRepayResponse.Merge(m, src)
+}
+func (m *MsgFixedTermRepayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFixedTermRepayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFixedTermRepayResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRepay) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgFixedTermRepayResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgLend)(nil), "comdex.lend.v1beta1.MsgLend")
+	proto.RegisterType((*MsgWithdraw)(nil), "comdex.lend.v1beta1.MsgWithdraw")
+	proto.RegisterType((*MsgDeposit)(nil), "comdex.lend.v1beta1.MsgDeposit")
+	proto.RegisterType((*MsgCloseLend)(nil), "comdex.lend.v1beta1.MsgCloseLend")
+	proto.RegisterType((*MsgBorrow)(nil), "comdex.lend.v1beta1.MsgBorrow")
+	proto.RegisterType((*MsgRepay)(nil), "comdex.lend.v1beta1.MsgRepay")
+	proto.RegisterType((*MsgDepositBorrow)(nil), "comdex.lend.v1beta1.MsgDepositBorrow")
+	proto.RegisterType((*MsgDraw)(nil), "comdex.lend.v1beta1.MsgDraw")
+	proto.RegisterType((*MsgCloseBorrow)(nil), "comdex.lend.v1beta1.MsgCloseBorrow")
+	proto.RegisterType((*MsgBorrowAlternate)(nil), "comdex.lend.v1beta1.MsgBorrowAlternate")
+	proto.RegisterType((*MsgFundModuleAccounts)(nil), "comdex.lend.v1beta1.MsgFundModuleAccounts")
+	proto.RegisterType((*MsgCalculateInterestAndRewards)(nil), "comdex.lend.v1beta1.MsgCalculateInterestAndRewards")
+	proto.RegisterType((*MsgFundReserveAccounts)(nil), "comdex.lend.v1beta1.MsgFundReserveAccounts")
+	proto.RegisterType((*MsgGrantPositionManager)(nil), "comdex.lend.v1beta1.MsgGrantPositionManager")
+	proto.RegisterType((*MsgRevokePositionManager)(nil), "comdex.lend.v1beta1.MsgRevokePositionManager")
+	proto.RegisterType((*MsgDepositAccountCollateral)(nil), "comdex.lend.v1beta1.MsgDepositAccountCollateral")
+	proto.RegisterType((*MsgWithdrawAccountCollateral)(nil), "comdex.lend.v1beta1.MsgWithdrawAccountCollateral")
+	proto.RegisterType((*MsgAccountBorrow)(nil), "comdex.lend.v1beta1.MsgAccountBorrow")
+	proto.RegisterType((*MsgAccountRepay)(nil), "comdex.lend.v1beta1.MsgAccountRepay")
+	proto.RegisterType((*MsgLiquidateAccount)(nil), "comdex.lend.v1beta1.MsgLiquidateAccount")
+	proto.RegisterType((*MsgFixedTermCommit)(nil), "comdex.lend.v1beta1.MsgFixedTermCommit")
+	proto.RegisterType((*MsgFixedTermRedeem)(nil), "comdex.lend.v1beta1.MsgFixedTermRedeem")
+	proto.RegisterType((*MsgFixedTermBorrow)(nil), "comdex.lend.v1beta1.MsgFixedTermBorrow")
+	proto.RegisterType((*MsgFixedTermRepay)(nil), "comdex.lend.v1beta1.MsgFixedTermRepay")
+	proto.RegisterType((*MsgLendResponse)(nil), "comdex.lend.v1beta1.MsgLendResponse")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "comdex.lend.v1beta1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgDepositResponse)(nil), "comdex.lend.v1beta1.MsgDepositResponse")
+	proto.RegisterType((*MsgCloseLendResponse)(nil), "comdex.lend.v1beta1.MsgCloseLendResponse")
+	proto.RegisterType((*MsgBorrowResponse)(nil), "comdex.lend.v1beta1.MsgBorrowResponse")
+	proto.RegisterType((*MsgRepayResponse)(nil), "comdex.lend.v1beta1.MsgRepayResponse")
+	proto.RegisterType((*MsgDepositBorrowResponse)(nil), "comdex.lend.v1beta1.MsgDepositBorrowResponse")
+	proto.RegisterType((*MsgDrawResponse)(nil), "comdex.lend.v1beta1.MsgDrawResponse")
+	proto.RegisterType((*MsgCloseBorrowResponse)(nil), "comdex.lend.v1beta1.MsgCloseBorrowResponse")
+	proto.RegisterType((*MsgBorrowAlternateResponse)(nil), "comdex.lend.v1beta1.MsgBorrowAlternateResponse")
+	proto.RegisterType((*MsgFundModuleAccountsResponse)(nil), "comdex.lend.v1beta1.MsgFundModuleAccountsResponse")
+	proto.RegisterType((*MsgCalculateInterestAndRewardsResponse)(nil), "comdex.lend.v1beta1.MsgCalculateInterestAndRewardsResponse")
+	proto.RegisterType((*MsgFundReserveAccountsResponse)(nil), "comdex.lend.v1beta1.MsgFundReserveAccountsResponse")
+	proto.RegisterType((*MsgGrantPositionManagerResponse)(nil), "comdex.lend.v1beta1.MsgGrantPositionManagerResponse")
+	proto.RegisterType((*MsgRevokePositionManagerResponse)(nil), "comdex.lend.v1beta1.MsgRevokePositionManagerResponse")
+	proto.RegisterType((*MsgDepositAccountCollateralResponse)(nil), "comdex.lend.v1beta1.MsgDepositAccountCollateralResponse")
+	proto.RegisterType((*MsgWithdrawAccountCollateralResponse)(nil), "comdex.lend.v1beta1.MsgWithdrawAccountCollateralResponse")
+	proto.RegisterType((*MsgAccountBorrowResponse)(nil), "comdex.lend.v1beta1.MsgAccountBorrowResponse")
+	proto.RegisterType((*MsgAccountRepayResponse)(nil), "comdex.lend.v1beta1.MsgAccountRepayResponse")
+	proto.RegisterType((*MsgLiquidateAccountResponse)(nil), "comdex.lend.v1beta1.MsgLiquidateAccountResponse")
+	proto.RegisterType((*MsgFixedTermCommitResponse)(nil), "comdex.lend.v1beta1.MsgFixedTermCommitResponse")
+	proto.RegisterType((*MsgFixedTermRedeemResponse)(nil), "comdex.lend.v1beta1.MsgFixedTermRedeemResponse")
+	proto.RegisterType((*MsgFixedTermBorrowResponse)(nil), "comdex.lend.v1beta1.MsgFixedTermBorrowResponse")
+	proto.RegisterType((*MsgFixedTermRepayResponse)(nil), "comdex.lend.v1beta1.MsgFixedTermRepayResponse")
+}
+
+func init() { proto.RegisterFile("comdex/lend/v1beta1/tx.proto", fileDescriptor_957d64b59d60594d) }
+
+var fileDescriptor_957d64b59d60594d = []byte{
+	// 1493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x8e, 0x63, 0xbf, 0x94, 0xb4, 0xdd, 0x7c, 0x39, 0x9b, 0xc4, 0x71, 0xb7, 0x69,
+	0x6a, 0x68, 0x63, 0xb7, 0x29, 0x08, 0x10, 0x15, 0xa8, 0x4d, 0x55, 0xea, 0x82, 0x45, 0xe5, 0x22,
+	0x10, 0x5c, 0xa2, 0xb1, 0x77, 0xba, 0x5d, 0xd5, 0xde, 0x31, 0x3b, 0xeb, 0xa4, 0xe5, 0xe3, 0xc0,
+	0x57, 0x25, 0x4e, 0x70, 0x80, 0x0b, 0x12, 0x47, 0xfe, 0x07, 0xfe, 0x84, 0x1e, 0x7b, 0xe4, 0x84,
+	0x50, 0xfb, 0x4f, 0x70, 0x41, 0x42, 0xbb, 0x3b, 0x3b, 0xfb, 0xe1, 0x9d, 0xf5, 0xba, 0x01, 0x7a,
+	0xf3, 0xce, 0xfb, 0xbd, 0xf7, 0x7e, 0xf3, 0xe6, 0xbd, 0x37, 0xf3, 0x64, 0x58, 0xef, 0x92, 0xbe,
+	0x86, 0xef, 0x37, 0x7a, 0xd8, 0xd4, 0x1a, 0x07, 0x17, 0x3b, 0xd8, 0x46, 0x17, 0x1b, 0xf6, 0xfd,
+	0xfa, 0xc0, 0x22, 0x36, 0x91, 0x17, 0x3c, 0x69, 0xdd, 0x91, 0xd6, 0x99, 0x54, 0xa9, 0x74, 0x09,
+	0xed, 0x13, 0xda, 0xe8, 0x20, 0x8a, 0xb9, 0x4a, 0x97, 0x18, 0xa6, 0xa7, 0xa4, 0x2c, 0xea, 0x44,
+	0x27, 0xee, 0xcf, 0x86, 0xf3, 0x8b, 0xad, 0x56, 0x92, 0x1c, 0xb9, 0x76, 0x5d, 0xb9, 0xfa, 0xab,
+	0x04, 0xb3, 0x2d, 0xaa, 0xbf, 0x8b, 0x4d, 0x4d, 0x5e, 0x86, 0x82, 0x23, 0xc1, 0x56, 0x59, 0xaa,
+	0x4a, 0xb5, 0x52, 0x9b, 0x7d, 0xc9, 0xab, 0x50, 0x44, 0x94, 0x62, 0x7b, 0xdf, 0xd0, 0xca, 0xd3,
+	0x55, 0xa9, 0x96, 0x6f, 0xcf, 0xba, 0xdf, 0x4d, 0x4d, 0x7e, 0x15, 0x0a, 0xa8, 0x4f, 0x86, 0xa6,
+	0x5d, 0xce, 0x55, 0xa5, 0xda, 0xdc, 0xee, 0x6a, 0xdd, 0x63, 0x59, 0x77, 0x58, 0xfa, 0xd4, 0xeb,
+	0x7b, 0xc4, 0x30, 0xaf, 0xe6, 0x1f, 0xfd, 0xb1, 0x39, 0xd5, 0x66, 0x70, 0x79, 0x05, 0x66, 0x07,
+	0x84, 0xf4, 0x1c, 0x93, 0x79, 0xd7, 0x64, 0xc1, 0xf9, 0x6c, 0x6a, 0xf2, 0x12, 0x14, 0xd0, 0x60,
+	0xe0, 0xac, 0xcf, 0xb8, 0xeb, 0x33, 0x68, 0x30, 0x68, 0x6a, 0xea, 0x21, 0xcc, 0xb5, 0xa8, 0xfe,
+	0xa1, 0x61, 0xdf, 0xd5, 0x2c, 0x74, 0x28, 0xa4, 0xba, 0x02, 0xb3, 0xce, 0xaf, 0x80, 0xa9, 0x2b,
+	0x38, 0x02, 0x51, 0xf5, 0x00, 0xa0, 0x45, 0xf5, 0x6b, 0x78, 0x40, 0xa8, 0x61, 0xff, 0x8f, 0x7e,
+	0xdf, 0x82, 0x63, 0x2d, 0xaa, 0xef, 0xf5, 0x08, 0xc5, 0xa9, 0x87, 0x23, 0xf2, 0xac, 0x7e, 0x39,
+	0x0d, 0xa5, 0x16, 0xd5, 0xaf, 0x12, 0xcb, 0x22, 0x87, 0xb2, 0x02, 0xc5, 0x8e, 0xfb, 0x8b, 0x1b,
+	0xe0, 0xdf, 0x62, 0xf2, 0xce, 0x21, 0x21, 0xc3, 0x72, 0x04, 0x39, 0x76, 0x48, 0xc8, 0xb0, 0x9a,
+	0x9a, 0x5c, 0x83, 0x13, 0x06, 0xdd, 0xa7, 0x36, 0xea, 0xf4, 0xf0, 0xbe, 0x67, 0xc7, 0x3d, 0xc6,
+	0x62, 0x7b, 0xde, 0xa0, 0xb7, 0xdd, 0x65, 0xe6, 0xf7, 0x32, 0x94, 0xbc, 0x0d, 0xed, 0x1b, 0x66,
+	0x79, 0x26, 0x5b, 0x08, 0x8a, 0x9e, 0x46, 0xd3, 0x94, 0xdf, 0x04, 0x60, 0xda, 0x64, 0x68, 0x97,
+	0x0b, 0xd9, 0xd4, 0x99, 0xc3, 0xf7, 0x86, 0xb6, 0xfa, 0x39, 0x14, 0x5b, 0x54, 0x6f, 0xe3, 0x01,
+	0x7a, 0x90, 0x1a, 0x81, 0x35, 0x28, 0x79, 0xbf, 0x83, 0x18, 0x30, 0xe1, 0x51, 0x8e, 0xf0, 0x1b,
+	0x09, 0x4e, 0x04, 0xb9, 0x93, 0xe1, 0x20, 0xfe, 0x1b, 0x1a, 0x9f, 0xb9, 0x15, 0x7e, 0xcd, 0x42,
+	0xcf, 0xc3, 0x79, 0x13, 0xe6, 0xfd, 0x34, 0x3e, 0x62, 0x00, 0xd4, 0xdf, 0xa6, 0x41, 0xe6, 0x09,
+	0x7d, 0xa5, 0x67, 0x63, 0xcb, 0x44, 0x36, 0x7e, 0x96, 0xae, 0x15, 0x6a, 0x3e, 0xb9, 0x48, 0xf3,
+	0x89, 0x64, 0x6b, 0x7e, 0xd2, 0x6c, 0x0d, 0x95, 0xcb, 0xcc, 0xd8, 0x72, 0x29, 0x24, 0x96, 0x4b,
+	0x34, 0xe1, 0x67, 0x27, 0x4d, 0xf8, 0x50, 0xf7, 0x2c, 0x86, 0xbb, 0xe7, 0xcf, 0x12, 0x2c, 0xb5,
+	0xa8, 0x7e, 0x7d, 0x68, 0x6a, 0x2d, 0xa2, 0x0d, 0x7b, 0xf8, 0x4a, 0xb7, 0xeb, 0xa8, 0x50, 0x27,
+	0x7a, 0xde, 0xde, 0xcb, 0x12, 0xa3, 0xec, 0x45, 0xa2, 0x0c, 0x7e, 0xb4, 0xe2, 0xc1, 0x0b, 0xe2,
+	0x9d, 0x8b, 0xc4, 0x3b, 0x48, 0x91, 0xfc, 0x64, 0x29, 0x72, 0x19, 0x2a, 0x4e, 0x8a, 0xa0, 0x5e,
+	0x77, 0xd8, 0x43, 0x36, 0x6e, 0x9a, 0x36, 0xb6, 0x30, 0xb5, 0xaf, 0x98, 0x5a, 0x1b, 0x1f, 0x22,
+	0x4b, 0xa3, 0x69, 0x29, 0xa3, 0x7e, 0x2d, 0xc1, 0x32, 0xdb, 0x5a, 0x1b, 0x53, 0x6c, 0x1d, 0x04,
+	0x7b, 0x0b, 0xed, 0x41, 0x12, 0xed, 0x61, 0x5a, 0xb0, 0x87, 0x09, 0xd3, 0xfc, 0x47, 0x09, 0x56,
+	0x5a, 0x54, 0x7f, 0xdb, 0x42, 0xa6, 0x7d, 0xcb, 0xa9, 0x76, 0x83, 0x98, 0x2d, 0x64, 0x22, 0x1d,
+	0x5b, 0xf2, 0x22, 0xcc, 0x90, 0x43, 0x93, 0x53, 0xf7, 0x3e, 0x1c, 0x72, 0x7d, 0x0f, 0xc0, 0x38,
+	0xf8, 0x9f, 0x72, 0x13, 0xe6, 0x06, 0xd8, 0xea, 0x1b, 0x94, 0x1a, 0xc4, 0xa4, 0xe5, 0x5c, 0x35,
+	0x57, 0x9b, 0xdf, 0x3d, 0x5b, 0x4f, 0x78, 0x13, 0xd4, 0x7d, 0x57, 0xb7, 0x38, 0xbe, 0x1d, 0xd6,
+	0x55, 0x6f, 0x42, 0xd9, 0xed, 0x7f, 0x07, 0xe4, 0x1e, 0x3e, 0x22, 0x2d, 0xf5, 0x5b, 0x09, 0xd6,
+	0x82, 0x6e, 0xc6, 0x82, 0xbc, 0x47, 0x7a, 0xce, 0x99, 0x59, 0xa8, 0x27, 0xb0, 0xf7, 0xef, 0x5f,
+	0x8c, 0x0f, 0x25, 0x58, 0x0f, 0x3d, 0x05, 0x9e, 0x1f, 0x91, 0x9f, 0xbc, 0xf6, 0xce, 0x08, 0xb0,
+	0x02, 0x16, 0x3a, 0xf7, 0x1b, 0xce, 0x74, 0xa4, 0xcc, 0xc2, 0x4d, 0x2a, 0x27, 0x7a, 0x5a, 0x4d,
+	0x58, 0x4f, 0x0f, 0xe0, 0x78, 0x40, 0xcb, 0xbb, 0xfb, 0x26, 0x64, 0xf5, 0xcc, 0x21, 0xf9, 0x45,
+	0x82, 0x05, 0xe7, 0x35, 0x69, 0x7c, 0x32, 0x34, 0x34, 0x64, 0xfb, 0xa5, 0x28, 0x57, 0x00, 0x7a,
+	0x6c, 0x8d, 0xf8, 0x24, 0x42, 0x2b, 0x01, 0xbf, 0x69, 0x01, 0xbf, 0x9c, 0x80, 0xdf, 0x84, 0xa1,
+	0xf9, 0x4a, 0x72, 0xaf, 0x90, 0xeb, 0xc6, 0x7d, 0xac, 0xbd, 0x8f, 0xad, 0xfe, 0x1e, 0xe9, 0xf7,
+	0x53, 0x5e, 0x75, 0x6b, 0x50, 0xea, 0x23, 0xeb, 0x5e, 0xf8, 0x0e, 0x29, 0x7a, 0x0b, 0x47, 0x09,
+	0xd2, 0x3b, 0x51, 0x0e, 0x6d, 0xac, 0x61, 0xdc, 0x17, 0x72, 0xd8, 0x00, 0xd0, 0xbc, 0x92, 0x0b,
+	0x48, 0x94, 0xd8, 0x4a, 0x53, 0x53, 0xff, 0x8e, 0xed, 0x28, 0xdb, 0x25, 0x2b, 0xde, 0x55, 0xa8,
+	0x4c, 0x72, 0xa2, 0xb7, 0x60, 0x3e, 0x72, 0xb9, 0x3d, 0xdf, 0x17, 0xde, 0x0d, 0x38, 0x19, 0x0d,
+	0xe6, 0xb8, 0xa7, 0x9e, 0xb3, 0x41, 0x82, 0xcc, 0x70, 0x1f, 0x20, 0xc8, 0x6c, 0x6a, 0xea, 0x49,
+	0xb7, 0x6c, 0x9c, 0xb7, 0x76, 0x1b, 0xd3, 0x01, 0x31, 0x29, 0x56, 0x97, 0x60, 0x21, 0xd4, 0x69,
+	0xf8, 0xf2, 0x22, 0xc8, 0x41, 0x23, 0xe4, 0xab, 0xcb, 0xb0, 0x18, 0x7e, 0xb0, 0xf3, 0xf5, 0x05,
+	0x97, 0xa1, 0x77, 0x2e, 0x7c, 0x51, 0x76, 0x5b, 0x87, 0xcb, 0x96, 0xaf, 0x29, 0x50, 0x0e, 0xcc,
+	0xc6, 0xf0, 0x1e, 0xb9, 0x6b, 0x61, 0x16, 0x65, 0x58, 0xf6, 0xfd, 0xc5, 0xc0, 0xeb, 0xa0, 0x8c,
+	0xbe, 0x93, 0xb8, 0x74, 0x13, 0x36, 0x12, 0x9f, 0x02, 0x1c, 0x50, 0x83, 0xed, 0xf4, 0xfb, 0x98,
+	0x23, 0xab, 0x50, 0x61, 0xa6, 0x62, 0x57, 0x2f, 0x47, 0x9c, 0x82, 0x4d, 0xc1, 0xb5, 0xc8, 0x21,
+	0x2a, 0x54, 0x45, 0x77, 0x14, 0xc7, 0x9c, 0x81, 0xd3, 0x29, 0x57, 0x0f, 0x87, 0x6d, 0xc3, 0x56,
+	0xda, 0xcd, 0x10, 0x8b, 0x74, 0xa4, 0x71, 0x73, 0xd9, 0x2a, 0xac, 0x04, 0xb2, 0xe8, 0x01, 0x6d,
+	0xc0, 0x5a, 0x42, 0x73, 0x8b, 0x85, 0x3d, 0xd6, 0x5b, 0x44, 0x52, 0xaf, 0xea, 0x45, 0xd2, 0x18,
+	0xa7, 0x35, 0x58, 0x1d, 0x49, 0x72, 0x5f, 0xb8, 0xfb, 0xd7, 0x02, 0xe4, 0x5a, 0x54, 0x97, 0x6f,
+	0x42, 0xde, 0x1d, 0x14, 0xd7, 0x13, 0x5f, 0x0a, 0x2c, 0xb5, 0x95, 0xad, 0x34, 0xa9, 0x6f, 0x53,
+	0xfe, 0x00, 0x8a, 0x7c, 0xd4, 0xae, 0x8a, 0x34, 0x7c, 0x84, 0x52, 0x1b, 0x87, 0xe0, 0x76, 0x6f,
+	0xc3, 0xac, 0x3f, 0x49, 0x6f, 0x8a, 0x94, 0x18, 0x40, 0x39, 0x3b, 0x06, 0xc0, 0x8d, 0x7e, 0x04,
+	0xa5, 0x60, 0x4c, 0x3e, 0x25, 0xd2, 0xe2, 0x10, 0xe5, 0xc5, 0xb1, 0x10, 0x6e, 0xfa, 0x16, 0x14,
+	0x58, 0x43, 0xad, 0x88, 0x94, 0x3c, 0xb9, 0xb2, 0x9d, 0x2e, 0xe7, 0x16, 0x5b, 0x30, 0xe3, 0xf5,
+	0xa8, 0x0d, 0x91, 0x82, 0x2b, 0x56, 0xce, 0xa4, 0x8a, 0xb9, 0x39, 0x0c, 0x2f, 0x44, 0xc7, 0xcb,
+	0x33, 0x63, 0xa2, 0xc6, 0xe8, 0xee, 0x64, 0x82, 0x71, 0x37, 0x37, 0x21, 0xef, 0xce, 0x8f, 0xc2,
+	0xdc, 0x72, 0xa4, 0xca, 0x56, 0x9a, 0x94, 0xdb, 0xda, 0x87, 0xb9, 0xf0, 0x38, 0x78, 0x3a, 0xf5,
+	0x34, 0x18, 0xdd, 0x73, 0x19, 0x40, 0xdc, 0xc1, 0x3d, 0x38, 0x1e, 0x9f, 0x11, 0xcf, 0xa6, 0x9f,
+	0x0e, 0x07, 0x2a, 0x8d, 0x8c, 0x40, 0xee, 0xcc, 0x06, 0x39, 0x61, 0xaa, 0x7a, 0x49, 0x64, 0x66,
+	0x14, 0xab, 0xec, 0x66, 0xc7, 0x72, 0xaf, 0xdf, 0x4b, 0xb0, 0x96, 0x36, 0x30, 0x5d, 0x12, 0xc6,
+	0x4b, 0xac, 0xa4, 0xbc, 0xf1, 0x0c, 0x4a, 0x9c, 0xd1, 0x21, 0x2c, 0x24, 0x8d, 0x60, 0xe7, 0xd2,
+	0x36, 0x17, 0x03, 0x2b, 0x97, 0x26, 0x00, 0x73, 0xc7, 0x9f, 0xc2, 0x62, 0xe2, 0xd4, 0x75, 0x5e,
+	0x64, 0x2c, 0x09, 0xad, 0xbc, 0x3c, 0x09, 0x9a, 0xfb, 0xfe, 0x02, 0x96, 0x92, 0x67, 0xab, 0x1d,
+	0x71, 0xf5, 0x26, 0xc0, 0x95, 0x57, 0x26, 0x82, 0x73, 0xf7, 0x0f, 0x25, 0x28, 0x0b, 0xc7, 0xb1,
+	0x0b, 0x63, 0x2a, 0x7c, 0x44, 0x43, 0x79, 0x6d, 0x52, 0x0d, 0x4e, 0xe4, 0x3b, 0x09, 0x56, 0xc5,
+	0xf3, 0xd8, 0xc5, 0x71, 0xd7, 0xc3, 0x28, 0x95, 0xd7, 0x27, 0x56, 0x09, 0x77, 0xc4, 0xe8, 0x44,
+	0x26, 0xec, 0x88, 0x11, 0x98, 0xb2, 0x93, 0x09, 0xc6, 0xdd, 0x74, 0xe0, 0x58, 0x64, 0xc2, 0xda,
+	0x1a, 0xa3, 0xee, 0x75, 0xf5, 0xf3, 0x59, 0x50, 0xdc, 0x87, 0x09, 0x27, 0x46, 0x26, 0x29, 0xe1,
+	0x5d, 0x1b, 0x47, 0x2a, 0x17, 0xb2, 0x22, 0xc3, 0x8d, 0x33, 0x3e, 0x19, 0x09, 0x1b, 0x67, 0x0c,
+	0xa8, 0x34, 0x32, 0x02, 0x13, 0x9d, 0xb1, 0x11, 0x68, 0xbc, 0x33, 0x0f, 0xa8, 0x34, 0x32, 0x02,
+	0x13, 0x9d, 0xb1, 0xb4, 0x18, 0xef, 0x8c, 0x25, 0x46, 0x23, 0x23, 0x90, 0x3b, 0xbb, 0x0b, 0xf3,
+	0xb1, 0x79, 0x64, 0x3b, 0x03, 0x5f, 0x27, 0x3d, 0xea, 0xd9, 0x70, 0xbe, 0xa7, 0xab, 0x37, 0x1e,
+	0x3d, 0xa9, 0x48, 0x8f, 0x9f, 0x54, 0xa4, 0x3f, 0x9f, 0x54, 0xa4, 0x1f, 0x9e, 0x56, 0xa6, 0x1e,
+	0x3f, 0xad, 0x4c, 0xfd, 0xfe, 0xb4, 0x32, 0xf5, 0x71, 0x5d, 0x37, 0xec, 0xbb, 0xc3, 0x8e, 0x63,
+	0xaf, 0xe1, 0xd9, 0xdc, 0x21, 0x77, 0xee, 0x18, 0x5d, 0x03, 0xf5, 0xd8, 0x77, 0x83, 0xfd, 0x27,
+	0x64, 0x3f, 0x18, 0x60, 0xda, 0x29, 0xb8, 0xff, 0x06, 0x5d, 0xfa, 0x67, 0x00, 0x2e, 0x36, 0xd0,
+	0xb5, 0x98, 0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// LendAsset defines a method for lending coins to the ModuleAccount.
+	Lend(ctx context.Context, in *MsgLend, opts ...grpc.CallOption) (*MsgLendResponse, error)
+	// WithdrawAsset defines a method for withdrawing previously loaned coins from
+	// the ModuleAccount.
+	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	CloseLend(ctx context.Context, in *MsgCloseLend, opts ...grpc.CallOption) (*MsgCloseLendResponse, error)
+	// BorrowAsset defines a method for borrowing coins from the ModuleAccount.
+	Borrow(ctx context.Context, in *MsgBorrow, opts ...grpc.CallOption) (*MsgBorrowResponse, error)
+	// RepayAsset defines a method for repaying borrowed coins to the ModuleAccount.
+	Repay(ctx context.Context, in *MsgRepay, opts ...grpc.CallOption) (*MsgRepayResponse, error)
+	DepositBorrow(ctx context.Context, in *MsgDepositBorrow, opts ...grpc.CallOption) (*MsgDepositBorrowResponse, error)
+	Draw(ctx context.Context, in *MsgDraw, opts ...grpc.CallOption) (*MsgDrawResponse, error)
+	CloseBorrow(ctx context.Context, in *MsgCloseBorrow, opts ...grpc.CallOption) (*MsgCloseBorrowResponse, error)
+	BorrowAlternate(ctx context.Context, in *MsgBorrowAlternate, opts ...grpc.CallOption) (*MsgBorrowAlternateResponse, error)
+	// FundModuleAccounts funds an existing module account
+	FundModuleAccounts(ctx context.Context, in *MsgFundModuleAccounts, opts ...grpc.CallOption) (*MsgFundModuleAccountsResponse, error)
+	CalculateInterestAndRewards(ctx context.Context, in *MsgCalculateInterestAndRewards, opts ...grpc.CallOption) (*MsgCalculateInterestAndRewardsResponse, error)
+	FundReserveAccounts(ctx context.Context, in *MsgFundReserveAccounts, opts ...grpc.CallOption) (*MsgFundReserveAccountsResponse, error)
+	// GrantPositionManager lets a manager deposit, repay or withdraw to owner on
+	// the positions of the sender.
+	GrantPositionManager(ctx context.Context, in *MsgGrantPositionManager, opts ...grpc.CallOption) (*MsgGrantPositionManagerResponse, error)
+	RevokePositionManager(ctx context.Context, in *MsgRevokePositionManager, opts ...grpc.CallOption) (*MsgRevokePositionManagerResponse, error)
+	// DepositAccountCollateral locks cTokens of a lend position as collateral of
+	// the cross-collateral account of the owner in the pool of the position.
+	DepositAccountCollateral(ctx context.Context, in *MsgDepositAccountCollateral, opts ...grpc.CallOption) (*MsgDepositAccountCollateralResponse, error)
+	WithdrawAccountCollateral(ctx context.Context, in *MsgWithdrawAccountCollateral, opts ...grpc.CallOption) (*MsgWithdrawAccountCollateralResponse, error)
+	// AccountBorrow borrows an asset of the pool against all the collateral of
+	// the cross-collateral account.
+	AccountBorrow(ctx context.Context, in *MsgAccountBorrow, opts ...grpc.CallOption) (*MsgAccountBorrowResponse, error)
+	AccountRepay(ctx context.Context, in *MsgAccountRepay, opts ...grpc.CallOption) (*MsgAccountRepayResponse, error)
+	// LiquidateAccount repays debt of a cross-collateral account whose health
+	// factor fell below one in exchange for its collateral.
+	LiquidateAccount(ctx context.Context, in *MsgLiquidateAccount, opts ...grpc.CallOption) (*MsgLiquidateAccountResponse, error)
+	// FixedTermCommit commits liquidity to a fixed-term market until maturity.
+	FixedTermCommit(ctx context.Context, in *MsgFixedTermCommit, opts ...grpc.CallOption) (*MsgFixedTermCommitResponse, error)
+	// FixedTermRedeem pays a lender its share of a matured fixed-term market.
+	FixedTermRedeem(ctx context.Context, in *MsgFixedTermRedeem, opts ...grpc.CallOption) (*MsgFixedTermRedeemResponse, error)
+	// FixedTermBorrow borrows from a fixed-term market at its rate until
+	// maturity.
+	FixedTermBorrow(ctx context.Context, in *MsgFixedTermBorrow, opts ...grpc.CallOption) (*MsgFixedTermBorrowResponse, error)
+	FixedTermRepay(ctx context.Context, in *MsgFixedTermRepay, opts ...grpc.CallOption) (*MsgFixedTermRepayResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Lend(ctx context.Context, in *MsgLend, opts ...grpc.CallOption) (*MsgLendResponse, error) {
+	out := new(MsgLendResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/Lend", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRepay) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRepay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.BorrowId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BorrowId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Borrower) > 0 {
-		i -= len(m.Borrower)
-		copy(dAtA[i:], m.Borrower)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDepositBorrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CloseLend(ctx context.Context, in *MsgCloseLend, opts ...grpc.CallOption) (*MsgCloseLendResponse, error) {
+	out := new(MsgCloseLendResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/CloseLend", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDepositBorrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Borrow(ctx context.Context, in *MsgBorrow, opts ...grpc.CallOption) (*MsgBorrowResponse, error) {
+	out := new(MsgBorrowResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/Borrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDepositBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.BorrowId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BorrowId))
-		i--
-		dAtA[i] = 0x10
+func (c *msgClient) Repay(ctx context.Context, in *MsgRepay, opts ...grpc.CallOption) (*MsgRepayResponse, error) {
+	out := new(MsgRepayResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/Repay", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Borrower) > 0 {
-		i -= len(m.Borrower)
-		copy(dAtA[i:], m.Borrower)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) DepositBorrow(ctx context.Context, in *MsgDepositBorrow, opts ...grpc.CallOption) (*MsgDepositBorrowResponse, error) {
+	out := new(MsgDepositBorrowResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/DepositBorrow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Draw(ctx context.Context, in *MsgDraw, opts ...grpc.CallOption) (*MsgDrawResponse, error) {
+	out := new(MsgDrawResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/Draw", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CloseBorrow(ctx context.Context, in *MsgCloseBorrow, opts ...grpc.CallOption) (*MsgCloseBorrowResponse, error) {
+	out := new(MsgCloseBorrowResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/CloseBorrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.BorrowId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BorrowId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Borrower) > 0 {
-		i -= len(m.Borrower)
-		copy(dAtA[i:], m.Borrower)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) BorrowAlternate(ctx context.Context, in *MsgBorrowAlternate, opts ...grpc.CallOption) (*MsgBorrowAlternateResponse, error) {
+	out := new(MsgBorrowAlternateResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/BorrowAlternate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCloseBorrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FundModuleAccounts(ctx context.Context, in *MsgFundModuleAccounts, opts ...grpc.CallOption) (*MsgFundModuleAccountsResponse, error) {
+	out := new(MsgFundModuleAccountsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/FundModuleAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCloseBorrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CalculateInterestAndRewards(ctx context.Context, in *MsgCalculateInterestAndRewards, opts ...grpc.CallOption) (*MsgCalculateInterestAndRewardsResponse, error) {
+	out := new(MsgCalculateInterestAndRewardsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/CalculateInterestAndRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCloseBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BorrowId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BorrowId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Borrower) > 0 {
-		i -= len(m.Borrower)
-		copy(dAtA[i:], m.Borrower)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) FundReserveAccounts(ctx context.Context, in *MsgFundReserveAccounts, opts ...grpc.CallOption) (*MsgFundReserveAccountsResponse, error) {
+	out := new(MsgFundReserveAccountsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/FundReserveAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgBorrowAlternate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GrantPositionManager(ctx context.Context, in *MsgGrantPositionManager, opts ...grpc.CallOption) (*MsgGrantPositionManagerResponse, error) {
+	out := new(MsgGrantPositionManagerResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/GrantPositionManager", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgBorrowAlternate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevokePositionManager(ctx context.Context, in *MsgRevokePositionManager, opts ...grpc.CallOption) (*MsgRevokePositionManagerResponse, error) {
+	out := new(MsgRevokePositionManagerResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/RevokePositionManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgBorrowAlternate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x40
-	}
-	{
-		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.IsStableBorrow {
-		i--
-		if m.IsStableBorrow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.PairId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PairId))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AssetId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AssetId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Lender) > 0 {
-		i -= len(m.Lender)
-		copy(dAtA[i:], m.Lender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DepositAccountCollateral(ctx context.Context, in *MsgDepositAccountCollateral, opts ...grpc.CallOption) (*MsgDepositAccountCollateralResponse, error) {
+	out := new(MsgDepositAccountCollateralResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/DepositAccountCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgFundModuleAccounts) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawAccountCollateral(ctx context.Context, in *MsgWithdrawAccountCollateral, opts ...grpc.CallOption) (*MsgWithdrawAccountCollateralResponse, error) {
+	out := new(MsgWithdrawAccountCollateralResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/WithdrawAccountCollateral", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFundModuleAccounts) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AccountBorrow(ctx context.Context, in *MsgAccountBorrow, opts ...grpc.CallOption) (*MsgAccountBorrowResponse, error) {
+	out := new(MsgAccountBorrowResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/AccountBorrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFundModuleAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Lender) > 0 {
-		i -= len(m.Lender)
-		copy(dAtA[i:], m.Lender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.AssetId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AssetId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) AccountRepay(ctx context.Context, in *MsgAccountRepay, opts ...grpc.CallOption) (*MsgAccountRepayResponse, error) {
+	out := new(MsgAccountRepayResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/AccountRepay", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCalculateInterestAndRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LiquidateAccount(ctx context.Context, in *MsgLiquidateAccount, opts ...grpc.CallOption) (*MsgLiquidateAccountResponse, error) {
+	out := new(MsgLiquidateAccountResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/LiquidateAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCalculateInterestAndRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FixedTermCommit(ctx context.Context, in *MsgFixedTermCommit, opts ...grpc.CallOption) (*MsgFixedTermCommitResponse, error) {
+	out := new(MsgFixedTermCommitResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/FixedTermCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCalculateInterestAndRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Borrower) > 0 {
-		i -= len(m.Borrower)
-		copy(dAtA[i:], m.Borrower)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) FixedTermRedeem(ctx context.Context, in *MsgFixedTermRedeem, opts ...grpc.CallOption) (*MsgFixedTermRedeemResponse, error) {
+	out := new(MsgFixedTermRedeemResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/FixedTermRedeem", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgFundReserveAccounts) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FixedTermBorrow(ctx context.Context, in *MsgFixedTermBorrow, opts ...grpc.CallOption) (*MsgFixedTermBorrowResponse, error) {
+	out := new(MsgFixedTermBorrowResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/FixedTermBorrow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFundReserveAccounts) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FixedTermRepay(ctx context.Context, in *MsgFixedTermRepay, opts ...grpc.CallOption) (*MsgFixedTermRepayResponse, error) {
+	out := new(MsgFixedTermRepayResponse)
+	err := c.cc.Invoke(ctx, "/comdex.lend.v1beta1.Msg/FixedTermRepay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFundReserveAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Lender) > 0 {
-		i -= len(m.Lender)
-		copy(dAtA[i:], m.Lender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.AssetId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AssetId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgLendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// LendAsset defines a method for lending coins to the ModuleAccount.
+	Lend(context.Context, *MsgLend) (*MsgLendResponse, error)
+	// WithdrawAsset defines a method for withdrawing previously loaned coins from
+	// the ModuleAccount.
+	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	CloseLend(context.Context, *MsgCloseLend) (*MsgCloseLendResponse, error)
+	// BorrowAsset defines a method for borrowing coins from the ModuleAccount.
+	Borrow(context.Context, *MsgBorrow) (*MsgBorrowResponse, error)
+	// RepayAsset defines a method for repaying borrowed coins to the ModuleAccount.
+	Repay(context.Context, *MsgRepay) (*MsgRepayResponse, error)
+	DepositBorrow(context.Context, *MsgDepositBorrow) (*MsgDepositBorrowResponse, error)
+	Draw(context.Context, *MsgDraw) (*MsgDrawResponse, error)
+	CloseBorrow(context.Context, *MsgCloseBorrow) (*MsgCloseBorrowResponse, error)
+	BorrowAlternate(context.Context, *MsgBorrowAlternate) (*MsgBorrowAlternateResponse, error)
+	// FundModuleAccounts funds an existing module account
+	FundModuleAccounts(context.Context, *MsgFundModuleAccounts) (*MsgFundModuleAccountsResponse, error)
+	CalculateInterestAndRewards(context.Context, *MsgCalculateInterestAndRewards) (*MsgCalculateInterestAndRewardsResponse, error)
+	FundReserveAccounts(context.Context, *MsgFundReserveAccounts) (*MsgFundReserveAccountsResponse, error)
+	// GrantPositionManager lets a manager deposit, repay or withdraw to owner on
+	// the positions of the sender.
+	GrantPositionManager(context.Context, *MsgGrantPositionManager) (*MsgGrantPositionManagerResponse, error)
+	RevokePositionManager(context.Context, *MsgRevokePositionManager) (*MsgRevokePositionManagerResponse, error)
+	// DepositAccountCollateral locks cTokens of a lend position as collateral of
+	// the cross-collateral account of the owner in the pool of the position.
+	DepositAccountCollateral(context.Context, *MsgDepositAccountCollateral) (*MsgDepositAccountCollateralResponse, error)
+	WithdrawAccountCollateral(context.Context, *MsgWithdrawAccountCollateral) (*MsgWithdrawAccountCollateralResponse, error)
+	// AccountBorrow borrows an asset of the pool against all the collateral of
+	// the cross-collateral account.
+	AccountBorrow(context.Context, *MsgAccountBorrow) (*MsgAccountBorrowResponse, error)
+	AccountRepay(context.Context, *MsgAccountRepay) (*MsgAccountRepayResponse, error)
+	// LiquidateAccount repays debt of a cross-collateral account whose health
+	// factor fell below one in exchange for its collateral.
+	LiquidateAccount(context.Context, *MsgLiquidateAccount) (*MsgLiquidateAccountResponse, error)
+	// FixedTermCommit commits liquidity to a fixed-term market until maturity.
+	FixedTermCommit(context.Context, *MsgFixedTermCommit) (*MsgFixedTermCommitResponse, error)
+	// FixedTermRedeem pays a lender its share of a matured fixed-term market.
+	FixedTermRedeem(context.Context, *MsgFixedTermRedeem) (*MsgFixedTermRedeemResponse, error)
+	// FixedTermBorrow borrows from a fixed-term market at its rate until
+	// maturity.
+	FixedTermBorrow(context.Context, *MsgFixedTermBorrow) (*MsgFixedTermBorrowResponse, error)
+	FixedTermRepay(context.Context, *MsgFixedTermRepay) (*MsgFixedTermRepayResponse, error)
 }
 
-func (m *MsgLendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgLendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) Lend(ctx context.Context, req *MsgLend) (*MsgLendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lend not implemented")
 }
-
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
-
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
-
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) CloseLend(ctx context.Context, req *MsgCloseLend) (*MsgCloseLendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseLend not implemented")
 }
-
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) Borrow(ctx context.Context, req *MsgBorrow) (*MsgBorrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Borrow not implemented")
 }
-
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) Repay(ctx context.Context, req *MsgRepay) (*MsgRepayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Repay not implemented")
 }
-
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) DepositBorrow(ctx context.Context, req *MsgDepositBorrow) (*MsgDepositBorrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositBorrow not implemented")
 }
-
-func (m *MsgCloseLendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) Draw(ctx context.Context, req *MsgDraw) (*MsgDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Draw not implemented")
 }
-
-func (m *MsgCloseLendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) CloseBorrow(ctx context.Context, req *MsgCloseBorrow) (*MsgCloseBorrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseBorrow not implemented")
 }
-
-func (m *MsgCloseLendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) BorrowAlternate(ctx context.Context, req *MsgBorrowAlternate) (*MsgBorrowAlternateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BorrowAlternate not implemented")
 }
-
-func (m *MsgBorrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) FundModuleAccounts(ctx context.Context, req *MsgFundModuleAccounts) (*MsgFundModuleAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundModuleAccounts not implemented")
 }
-
-func (m *MsgBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) CalculateInterestAndRewards(ctx context.Context, req *MsgCalculateInterestAndRewards) (*MsgCalculateInterestAndRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateInterestAndRewards not implemented")
 }
-
-func (m *MsgBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) FundReserveAccounts(ctx context.Context, req *MsgFundReserveAccounts) (*MsgFundReserveAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundReserveAccounts not implemented")
 }
-
-func (m *MsgRepayResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) GrantPositionManager(ctx context.Context, req *MsgGrantPositionManager) (*MsgGrantPositionManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPositionManager not implemented")
 }
-
-func (m *MsgRepayResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) RevokePositionManager(ctx context.Context, req *MsgRevokePositionManager) (*MsgRevokePositionManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePositionManager not implemented")
 }
-
-func (m *MsgRepayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) DepositAccountCollateral(ctx context.Context, req *MsgDepositAccountCollateral) (*MsgDepositAccountCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositAccountCollateral not implemented")
 }
-
-func (m *MsgDepositBorrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) WithdrawAccountCollateral(ctx context.Context, req *MsgWithdrawAccountCollateral) (*MsgWithdrawAccountCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAccountCollateral not implemented")
 }
-
-func (m *MsgDepositBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) AccountBorrow(ctx context.Context, req *MsgAccountBorrow) (*MsgAccountBorrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountBorrow not implemented")
 }
-
-func (m *MsgDepositBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) AccountRepay(ctx context.Context, req *MsgAccountRepay) (*MsgAccountRepayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRepay not implemented")
 }
-
-func (m *MsgDrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) LiquidateAccount(ctx context.Context, req *MsgLiquidateAccount) (*MsgLiquidateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidateAccount not implemented")
 }
-
-func (m *MsgDrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) FixedTermCommit(ctx context.Context, req *MsgFixedTermCommit) (*MsgFixedTermCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FixedTermCommit not implemented")
 }
-
-func (m *MsgDrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) FixedTermRedeem(ctx context.Context, req *MsgFixedTermRedeem) (*MsgFixedTermRedeemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FixedTermRedeem not implemented")
 }
-
-func (m *MsgCloseBorrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) FixedTermBorrow(ctx context.Context, req *MsgFixedTermBorrow) (*MsgFixedTermBorrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FixedTermBorrow not implemented")
 }
-
-func (m *MsgCloseBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) FixedTermRepay(ctx context.Context, req *MsgFixedTermRepay) (*MsgFixedTermRepayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FixedTermRepay not implemented")
 }
 
-func (m *MsgCloseBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgBorrowAlternateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Lend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLend)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBorrowAlternateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgBorrowAlternateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgFundModuleAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(MsgServer).Lend(ctx, in)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgFundModuleAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgFundModuleAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/Lend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Lend(ctx, req.(*MsgLend))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCalculateInterestAndRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdraw)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCalculateInterestAndRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCalculateInterestAndRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFundReserveAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgFundReserveAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgFundReserveAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgLend) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/Deposit",
 	}
-	var l int
-	_ = l
-	l = len(m.Lender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
 	}
-	if m.AssetId != 0 {
-		n += 1 + sovTx(uint64(m.AssetId))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseLend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseLend)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(MsgServer).CloseLend(ctx, in)
 	}
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/CloseLend",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseLend(ctx, req.(*MsgCloseLend))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Borrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBorrow)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Lender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Borrow(ctx, in)
 	}
-	if m.LendId != 0 {
-		n += 1 + sovTx(uint64(m.LendId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/Borrow",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Borrow(ctx, req.(*MsgBorrow))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Repay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepay)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Lender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Repay(ctx, in)
 	}
-	if m.LendId != 0 {
-		n += 1 + sovTx(uint64(m.LendId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/Repay",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Repay(ctx, req.(*MsgRepay))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseLend) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DepositBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositBorrow)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Lender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DepositBorrow(ctx, in)
 	}
-	if m.LendId != 0 {
-		n += 1 + sovTx(uint64(m.LendId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/DepositBorrow",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositBorrow(ctx, req.(*MsgDepositBorrow))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBorrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_Draw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDraw)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.LendId != 0 {
-		n += 1 + sovTx(uint64(m.LendId))
+	if interceptor == nil {
+		return srv.(MsgServer).Draw(ctx, in)
 	}
-	if m.PairId != 0 {
-		n += 1 + sovTx(uint64(m.PairId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/Draw",
 	}
-	if m.IsStableBorrow {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Draw(ctx, req.(*MsgDraw))
 	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.AmountOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRepay) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CloseBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseBorrow)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CloseBorrow(ctx, in)
 	}
-	if m.BorrowId != 0 {
-		n += 1 + sovTx(uint64(m.BorrowId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/CloseBorrow",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseBorrow(ctx, req.(*MsgCloseBorrow))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositBorrow) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BorrowAlternate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBorrowAlternate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).BorrowAlternate(ctx, in)
 	}
-	if m.BorrowId != 0 {
-		n += 1 + sovTx(uint64(m.BorrowId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/BorrowAlternate",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BorrowAlternate(ctx, req.(*MsgBorrowAlternate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDraw) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FundModuleAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundModuleAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).FundModuleAccounts(ctx, in)
 	}
-	if m.BorrowId != 0 {
-		n += 1 + sovTx(uint64(m.BorrowId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/FundModuleAccounts",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundModuleAccounts(ctx, req.(*MsgFundModuleAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseBorrow) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CalculateInterestAndRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCalculateInterestAndRewards)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CalculateInterestAndRewards(ctx, in)
 	}
-	if m.BorrowId != 0 {
-		n += 1 + sovTx(uint64(m.BorrowId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/CalculateInterestAndRewards",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CalculateInterestAndRewards(ctx, req.(*MsgCalculateInterestAndRewards))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBorrowAlternate) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FundReserveAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundReserveAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Lender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).FundReserveAccounts(ctx, in)
 	}
-	if m.AssetId != 0 {
-		n += 1 + sovTx(uint64(m.AssetId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/FundReserveAccounts",
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundReserveAccounts(ctx, req.(*MsgFundReserveAccounts))
 	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.PairId != 0 {
-		n += 1 + sovTx(uint64(m.PairId))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantPositionManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantPositionManager)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.IsStableBorrow {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).GrantPositionManager(ctx, in)
 	}
-	l = m.AmountOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/GrantPositionManager",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantPositionManager(ctx, req.(*MsgGrantPositionManager))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFundModuleAccounts) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RevokePositionManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokePositionManager)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(MsgServer).RevokePositionManager(ctx, in)
 	}
-	if m.AssetId != 0 {
-		n += 1 + sovTx(uint64(m.AssetId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/RevokePositionManager",
 	}
-	l = len(m.Lender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokePositionManager(ctx, req.(*MsgRevokePositionManager))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCalculateInterestAndRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DepositAccountCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositAccountCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DepositAccountCollateral(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/DepositAccountCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositAccountCollateral(ctx, req.(*MsgDepositAccountCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFundReserveAccounts) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WithdrawAccountCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAccountCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.AssetId != 0 {
-		n += 1 + sovTx(uint64(m.AssetId))
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAccountCollateral(ctx, in)
 	}
-	l = len(m.Lender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/WithdrawAccountCollateral",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAccountCollateral(ctx, req.(*MsgWithdrawAccountCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AccountBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAccountBorrow)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AccountBorrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/AccountBorrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AccountBorrow(ctx, req.(*MsgAccountBorrow))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AccountRepay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAccountRepay)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AccountRepay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/AccountRepay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AccountRepay(ctx, req.(*MsgAccountRepay))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LiquidateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidateAccount)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/LiquidateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidateAccount(ctx, req.(*MsgLiquidateAccount))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseLendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FixedTermCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFixedTermCommit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgBorrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).FixedTermCommit(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/FixedTermCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FixedTermCommit(ctx, req.(*MsgFixedTermCommit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRepayResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FixedTermRedeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFixedTermRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).FixedTermRedeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/FixedTermRedeem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FixedTermRedeem(ctx, req.(*MsgFixedTermRedeem))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositBorrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FixedTermBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFixedTermBorrow)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).FixedTermBorrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/FixedTermBorrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FixedTermBorrow(ctx, req.(*MsgFixedTermBorrow))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FixedTermRepay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFixedTermRepay)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).FixedTermRepay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.lend.v1beta1.Msg/FixedTermRepay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FixedTermRepay(ctx, req.(*MsgFixedTermRepay))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseBorrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "comdex.lend.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Lend",
+			Handler:    _Msg_Lend_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "CloseLend",
+			Handler:    _Msg_CloseLend_Handler,
+		},
+		{
+			MethodName: "Borrow",
+			Handler:    _Msg_Borrow_Handler,
+		},
+		{
+			MethodName: "Repay",
+			Handler:    _Msg_Repay_Handler,
+		},
+		{
+			MethodName: "DepositBorrow",
+			Handler:    _Msg_DepositBorrow_Handler,
+		},
+		{
+			MethodName: "Draw",
+			Handler:    _Msg_Draw_Handler,
+		},
+		{
+			MethodName: "CloseBorrow",
+			Handler:    _Msg_CloseBorrow_Handler,
+		},
+		{
+			MethodName: "BorrowAlternate",
+			Handler:    _Msg_BorrowAlternate_Handler,
+		},
+		{
+			MethodName: "FundModuleAccounts",
+			Handler:    _Msg_FundModuleAccounts_Handler,
+		},
+		{
+			MethodName: "CalculateInterestAndRewards",
+			Handler:    _Msg_CalculateInterestAndRewards_Handler,
+		},
+		{
+			MethodName: "FundReserveAccounts",
+			Handler:    _Msg_FundReserveAccounts_Handler,
+		},
+		{
+			MethodName: "GrantPositionManager",
+			Handler:    _Msg_GrantPositionManager_Handler,
+		},
+		{
+			MethodName: "RevokePositionManager",
+			Handler:    _Msg_RevokePositionManager_Handler,
+		},
+		{
+			MethodName: "DepositAccountCollateral",
+			Handler:    _Msg_DepositAccountCollateral_Handler,
+		},
+		{
+			MethodName: "WithdrawAccountCollateral",
+			Handler:    _Msg_WithdrawAccountCollateral_Handler,
+		},
+		{
+			MethodName: "AccountBorrow",
+			Handler:    _Msg_AccountBorrow_Handler,
+		},
+		{
+			MethodName: "AccountRepay",
+			Handler:    _Msg_AccountRepay_Handler,
+		},
+		{
+			MethodName: "LiquidateAccount",
+			Handler:    _Msg_LiquidateAccount_Handler,
+		},
+		{
+			MethodName: "FixedTermCommit",
+			Handler:    _Msg_FixedTermCommit_Handler,
+		},
+		{
+			MethodName: "FixedTermRedeem",
+			Handler:    _Msg_FixedTermRedeem_Handler,
+		},
+		{
+			MethodName: "FixedTermBorrow",
+			Handler:    _Msg_FixedTermBorrow_Handler,
+		},
+		{
+			MethodName: "FixedTermRepay",
+			Handler:    _Msg_FixedTermRepay_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comdex/lend/v1beta1/tx.proto",
 }
 
-func (m *MsgBorrowAlternateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgLend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgFundModuleAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgLend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCalculateInterestAndRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgLend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.AssetId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundReserveAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LendId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LendId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LendId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LendId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseLend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseLend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseLend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LendId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LendId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBorrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBorrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.IsStableBorrow {
+		i--
+		if m.IsStableBorrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LendId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LendId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BorrowId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BorrowId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositBorrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositBorrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BorrowId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BorrowId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BorrowId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BorrowId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseBorrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseBorrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BorrowId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BorrowId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBorrowAlternate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBorrowAlternate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBorrowAlternate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.IsStableBorrow {
+		i--
+		if m.IsStableBorrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssetId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundModuleAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundModuleAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundModuleAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AssetId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCalculateInterestAndRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCalculateInterestAndRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCalculateInterestAndRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundReserveAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundReserveAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundReserveAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AssetId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantPositionManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantPositionManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantPositionManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		dAtA14 := make([]byte, len(m.Permissions)*10)
+		var j13 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTx(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePositionManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePositionManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePositionManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositAccountCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositAccountCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositAccountCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LendId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LendId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAccountCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAccountCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAccountCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LendId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LendId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAccountBorrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAccountBorrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAccountBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AssetId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAccountRepay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAccountRepay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAccountRepay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidateAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidateAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidateAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Liquidator) > 0 {
+		i -= len(m.Liquidator)
+		copy(dAtA[i:], m.Liquidator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Liquidator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFixedTermCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFixedTermCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFixedTermCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFixedTermRedeem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFixedTermRedeem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFixedTermRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DepositId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DepositId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFixedTermBorrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFixedTermBorrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFixedTermBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LendId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LendId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFixedTermRepay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFixedTermRepay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFixedTermRepay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LoanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LoanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseLendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseLendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseLendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBorrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositBorrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseBorrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBorrowAlternateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBorrowAlternateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBorrowAlternateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundModuleAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundModuleAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundModuleAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCalculateInterestAndRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCalculateInterestAndRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCalculateInterestAndRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundReserveAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundReserveAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundReserveAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantPositionManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantPositionManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantPositionManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePositionManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePositionManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePositionManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositAccountCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositAccountCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositAccountCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAccountCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAccountCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAccountCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAccountBorrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAccountBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAccountBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAccountRepayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAccountRepayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAccountRepayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidateAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidateAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidateAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFixedTermCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFixedTermCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFixedTermCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFixedTermRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFixedTermRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFixedTermRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFixedTermBorrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFixedTermBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFixedTermBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFixedTermRepayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFixedTermRepayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFixedTermRepayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovTx(uint64(m.AssetId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LendId != 0 {
+		n += 1 + sovTx(uint64(m.LendId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LendId != 0 {
+		n += 1 + sovTx(uint64(m.LendId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCloseLend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LendId != 0 {
+		n += 1 + sovTx(uint64(m.LendId))
+	}
+	return n
+}
+
+func (m *MsgBorrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LendId != 0 {
+		n += 1 + sovTx(uint64(m.LendId))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovTx(uint64(m.PairId))
+	}
+	if m.IsStableBorrow {
+		n += 2
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRepay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BorrowId != 0 {
+		n += 1 + sovTx(uint64(m.BorrowId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositBorrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BorrowId != 0 {
+		n += 1 + sovTx(uint64(m.BorrowId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BorrowId != 0 {
+		n += 1 + sovTx(uint64(m.BorrowId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCloseBorrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BorrowId != 0 {
+		n += 1 + sovTx(uint64(m.BorrowId))
+	}
+	return n
+}
+
+func (m *MsgBorrowAlternate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovTx(uint64(m.AssetId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PairId != 0 {
+		n += 1 + sovTx(uint64(m.PairId))
+	}
+	if m.IsStableBorrow {
+		n += 2
+	}
+	l = m.AmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	return n
+}
+
+func (m *MsgFundModuleAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovTx(uint64(m.AssetId))
+	}
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCalculateInterestAndRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundReserveAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetId != 0 {
+		n += 1 + sovTx(uint64(m.AssetId))
+	}
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGrantPositionManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgRevokePositionManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositAccountCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LendId != 0 {
+		n += 1 + sovTx(uint64(m.LendId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawAccountCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LendId != 0 {
+		n += 1 + sovTx(uint64(m.LendId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAccountBorrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovTx(uint64(m.AssetId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAccountRepay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidateAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Liquidator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFixedTermCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFixedTermRedeem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DepositId != 0 {
+		n += 1 + sovTx(uint64(m.DepositId))
+	}
+	return n
+}
+
+func (m *MsgFixedTermBorrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if m.LendId != 0 {
+		n += 1 + sovTx(uint64(m.LendId))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovTx(uint64(m.PairId))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFixedTermRepay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LoanId != 0 {
+		n += 1 + sovTx(uint64(m.LoanId))
+	}
+	return n
+}
+
+func (m *MsgLendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseLendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBorrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRepayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositBorrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseBorrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBorrowAlternateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundModuleAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCalculateInterestAndRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundReserveAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantPositionManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokePositionManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositAccountCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawAccountCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAccountBorrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAccountRepayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidateAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFixedTermCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFixedTermRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFixedTermBorrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFixedTermRepayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgLend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendId", wireType)
+			}
+			m.LendId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LendId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendId", wireType)
+			}
+			m.LendId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LendId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseLend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseLend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseLend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendId", wireType)
+			}
+			m.LendId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LendId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBorrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendId", wireType)
+			}
+			m.LendId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LendId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsStableBorrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsStableBorrow = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowId", wireType)
+			}
+			m.BorrowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorrowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositBorrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositBorrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowId", wireType)
+			}
+			m.BorrowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorrowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowId", wireType)
+			}
+			m.BorrowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorrowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseBorrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseBorrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowId", wireType)
+			}
+			m.BorrowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorrowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBorrowAlternate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBorrowAlternate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBorrowAlternate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsStableBorrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsStableBorrow = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundModuleAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundModuleAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundModuleAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCalculateInterestAndRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCalculateInterestAndRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCalculateInterestAndRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundReserveAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundReserveAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundReserveAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgLend) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantPositionManager) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3292,15 +7777,15 @@ func (m *MsgLend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantPositionManager: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantPositionManager: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3328,13 +7813,13 @@ func (m *MsgLend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lender = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
 			}
-			m.AssetId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3344,16 +7829,148 @@ func (m *MsgLend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType == 0 {
+				var v PositionPermission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PositionPermission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]PositionPermission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PositionPermission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PositionPermission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokePositionManager) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokePositionManager: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokePositionManager: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3363,30 +7980,143 @@ func (m *MsgLend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositAccountCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositAccountCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositAccountCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LendId", wireType)
 			}
-			m.PoolId = 0
+			m.LendId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3396,16 +8126,16 @@ func (m *MsgLend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.LendId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.AppId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3415,11 +8145,25 @@ func (m *MsgLend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3441,7 +8185,7 @@ func (m *MsgLend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAccountCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3464,15 +8208,15 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAccountCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAccountCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3500,7 +8244,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lender = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3575,7 +8319,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgAccountBorrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3598,15 +8342,15 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAccountBorrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAccountBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3634,13 +8378,32 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LendId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
 			}
-			m.LendId = 0
+			m.AssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3650,12 +8413,12 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LendId |= uint64(b&0x7F) << shift
+				m.AssetId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -3709,7 +8472,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseLend) Unmarshal(dAtA []byte) error {
+func (m *MsgAccountRepay) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3732,15 +8495,15 @@ func (m *MsgCloseLend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseLend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAccountRepay: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseLend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAccountRepay: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3768,13 +8531,13 @@ func (m *MsgCloseLend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lender = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LendId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.LendId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3784,11 +8547,44 @@ func (m *MsgCloseLend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LendId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3810,7 +8606,7 @@ func (m *MsgCloseLend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3833,15 +8629,15 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3869,13 +8665,13 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.Liquidator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LendId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.LendId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3885,55 +8681,29 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LendId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
-			}
-			m.PairId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PairId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsStableBorrow", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.IsStableBorrow = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3943,28 +8713,14 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3991,7 +8747,7 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4016,7 +8772,7 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepay) Unmarshal(dAtA []byte) error {
+func (m *MsgFixedTermCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4039,15 +8795,15 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepay: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFixedTermCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepay: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFixedTermCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4075,13 +8831,13 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.Lender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.BorrowId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4091,7 +8847,7 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BorrowId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4150,7 +8906,7 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositBorrow) Unmarshal(dAtA []byte) error {
+func (m *MsgFixedTermRedeem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4173,15 +8929,15 @@ func (m *MsgDepositBorrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositBorrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFixedTermRedeem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFixedTermRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4209,32 +8965,13 @@ func (m *MsgDepositBorrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.Lender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowId", wireType)
-			}
-			m.BorrowId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BorrowId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositId", wireType)
 			}
-			var msglen int
+			m.DepositId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4244,25 +8981,11 @@ func (m *MsgDepositBorrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DepositId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4284,7 +9007,7 @@ func (m *MsgDepositBorrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDraw) Unmarshal(dAtA []byte) error {
+func (m *MsgFixedTermBorrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4307,10 +9030,10 @@ func (m *MsgDraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFixedTermBorrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFixedTermBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4343,13 +9066,70 @@ func (m *MsgDraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowId", wireType)
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LendId", wireType)
+			}
+			m.LendId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LendId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
 			}
-			m.BorrowId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4359,14 +9139,28 @@ func (m *MsgDraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BorrowId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4393,7 +9187,7 @@ func (m *MsgDraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4418,7 +9212,7 @@ func (m *MsgDraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseBorrow) Unmarshal(dAtA []byte) error {
+func (m *MsgFixedTermRepay) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4441,10 +9235,10 @@ func (m *MsgCloseBorrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseBorrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFixedTermRepay: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFixedTermRepay: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4481,9 +9275,9 @@ func (m *MsgCloseBorrow) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanId", wireType)
 			}
-			m.BorrowId = 0
+			m.LoanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4493,7 +9287,7 @@ func (m *MsgCloseBorrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BorrowId |= uint64(b&0x7F) << shift
+				m.LoanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4519,7 +9313,7 @@ func (m *MsgCloseBorrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBorrowAlternate) Unmarshal(dAtA []byte) error {
+func (m *MsgLendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4542,206 +9336,162 @@ func (m *MsgBorrowAlternate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrowAlternate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrowAlternate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
-			}
-			m.AssetId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
-			}
-			m.PairId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PairId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsStableBorrow", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsStableBorrow = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseLendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.AppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseLendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseLendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4763,7 +9513,7 @@ func (m *MsgBorrowAlternate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundModuleAccounts) Unmarshal(dAtA []byte) error {
+func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4786,115 +9536,112 @@ func (m *MsgFundModuleAccounts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundModuleAccounts: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBorrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundModuleAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.AssetId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositBorrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositBorrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4916,7 +9663,7 @@ func (m *MsgFundModuleAccounts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCalculateInterestAndRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgDrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4939,44 +9686,62 @@ func (m *MsgCalculateInterestAndRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCalculateInterestAndRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCalculateInterestAndRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseBorrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseBorrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4998,7 +9763,7 @@ func (m *MsgCalculateInterestAndRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundReserveAccounts) Unmarshal(dAtA []byte) error {
+func (m *MsgBorrowAlternateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5021,96 +9786,62 @@ func (m *MsgFundReserveAccounts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundReserveAccounts: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBorrowAlternateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundReserveAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBorrowAlternateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
-			}
-			m.AssetId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundModuleAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundModuleAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundModuleAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5132,7 +9863,7 @@ func (m *MsgFundReserveAccounts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCalculateInterestAndRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5155,10 +9886,10 @@ func (m *MsgLendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCalculateInterestAndRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCalculateInterestAndRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5182,7 +9913,7 @@ func (m *MsgLendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundReserveAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5205,10 +9936,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundReserveAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundReserveAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5232,7 +9963,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantPositionManagerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5255,10 +9986,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantPositionManagerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantPositionManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5282,7 +10013,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseLendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokePositionManagerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5305,10 +10036,10 @@ func (m *MsgCloseLendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseLendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokePositionManagerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseLendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokePositionManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5332,7 +10063,7 @@ func (m *MsgCloseLendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositAccountCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5355,10 +10086,10 @@ func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositAccountCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositAccountCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5382,7 +10113,7 @@ func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAccountCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5405,10 +10136,10 @@ func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAccountCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAccountCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5432,7 +10163,7 @@ func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositBorrowResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAccountBorrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5455,10 +10186,10 @@ func (m *MsgDepositBorrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositBorrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAccountBorrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAccountBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5482,7 +10213,7 @@ func (m *MsgDepositBorrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAccountRepayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5505,10 +10236,10 @@ func (m *MsgDrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAccountRepayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAccountRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5532,7 +10263,7 @@ func (m *MsgDrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseBorrowResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5555,10 +10286,10 @@ func (m *MsgCloseBorrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseBorrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5582,7 +10313,7 @@ func (m *MsgCloseBorrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBorrowAlternateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFixedTermCommitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5605,10 +10336,10 @@ func (m *MsgBorrowAlternateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrowAlternateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFixedTermCommitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrowAlternateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFixedTermCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5632,7 +10363,7 @@ func (m *MsgBorrowAlternateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundModuleAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFixedTermRedeemResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5655,10 +10386,10 @@ func (m *MsgFundModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundModuleAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFixedTermRedeemResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundModuleAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFixedTermRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5682,7 +10413,7 @@ func (m *MsgFundModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCalculateInterestAndRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFixedTermBorrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5705,10 +10436,10 @@ func (m *MsgCalculateInterestAndRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCalculateInterestAndRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFixedTermBorrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCalculateInterestAndRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFixedTermBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5732,7 +10463,7 @@ func (m *MsgCalculateInterestAndRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundReserveAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFixedTermRepayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5755,10 +10486,10 @@ func (m *MsgFundReserveAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundReserveAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFixedTermRepayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundReserveAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFixedTermRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
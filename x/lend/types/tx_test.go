@@ -820,3 +820,57 @@ func TestNewMsgFundModuleAccounts(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMsgGrantPositionManager(t *testing.T) {
+	owner := "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"
+	manager := sdk.AccAddress([]byte("addr2_______________")).String()
+	deposit := []types.PositionPermission{types.PositionPermissionDeposit}
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgGrantPositionManager
+		isErrExp bool
+	}{
+		{
+			name:     "empty manager",
+			msg:      types.NewMsgGrantPositionManager(owner, "", deposit),
+			isErrExp: true,
+		},
+		{
+			name:     "manager same as owner",
+			msg:      types.NewMsgGrantPositionManager(owner, owner, deposit),
+			isErrExp: true,
+		},
+		{
+			name:     "no permissions",
+			msg:      types.NewMsgGrantPositionManager(owner, manager, nil),
+			isErrExp: true,
+		},
+		{
+			name:     "unspecified permission",
+			msg:      types.NewMsgGrantPositionManager(owner, manager, []types.PositionPermission{types.PositionPermissionUnspecified}),
+			isErrExp: true,
+		},
+		{
+			name:     "valid case",
+			msg:      types.NewMsgGrantPositionManager(owner, manager, []types.PositionPermission{types.PositionPermissionRepay, types.PositionPermissionWithdrawToOwner}),
+			isErrExp: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.msg.Route(), types.RouterKey)
+			require.Equal(t, tc.msg.Type(), types.TypeGrantPositionManagerRequest)
+
+			err := tc.msg.ValidateBasic()
+
+			if tc.isErrExp {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 
 type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, name string) authtypes.ModuleAccountI
+	GetModuleAddress(name string) sdk.AccAddress
 }
 
 type BankKeeper interface {
@@ -28,14 +29,17 @@ type BankKeeper interface {
 
 type AssetKeeper interface {
 	GetAsset(ctx sdk.Context, id uint64) (assettypes.Asset, bool)
+	GetAssetForDenom(ctx sdk.Context, denom string) (assettypes.Asset, bool)
 	GetPair(ctx sdk.Context, id uint64) (assettypes.Pair, bool)
 	GetApps(ctx sdk.Context) (apps []assettypes.AppData, found bool)
 	GetPairsVault(ctx sdk.Context, id uint64) (pairs assettypes.ExtendedPairVault, found bool)
+	GetVaultCollateralAsset(ctx sdk.Context, extendedPairVaultID, assetID uint64) (assettypes.VaultCollateralAsset, bool)
+	DelistingMinCr(ctx sdk.Context, extendedPairVault assettypes.ExtendedPairVault) (sdk.Dec, bool)
 }
 
 type VaultKeeper interface {
 	GetAppMappingData(ctx sdk.Context, appMappingID uint64) (appExtendedPairVaultData []types.AppExtendedPairVaultMappingData, found bool)
-	CalculateCollateralizationRatio(ctx sdk.Context, extendedPairVaultID uint64, amountIn sdk.Int, amountOut sdk.Int) (sdk.Dec, error)
+	CalculateVaultCollateralizationRatio(ctx sdk.Context, vault types.Vault, amountIn sdk.Int, amountOut sdk.Int) (sdk.Dec, error)
 	GetVault(ctx sdk.Context, id uint64) (vault types.Vault, found bool)
 	GetVaults(ctx sdk.Context) (vaults []types.Vault)
 	GetIDForVault(ctx sdk.Context) uint64
@@ -45,7 +49,7 @@ type VaultKeeper interface {
 	UpdateAppExtendedPairVaultMappingDataOnMsgCreate(ctx sdk.Context, vaultData types.Vault)
 	UpdateCollateralLockedAmountLockerMapping(ctx sdk.Context, appMappingID uint64, extendedPairID uint64, amount sdk.Int, changeType bool)
 	UpdateTokenMintedAmountLockerMapping(ctx sdk.Context, appMappingID uint64, extendedPairID uint64, amount sdk.Int, changeType bool)
-	DeleteUserVaultExtendedPairMapping(ctx sdk.Context, address string, appID uint64, pairVaultID uint64)
+	DeleteUserVaultExtendedPairMapping(ctx sdk.Context, address string, appID uint64, pairVaultID uint64, vaultID uint64)
 	DeleteAddressFromAppExtendedPairVaultMapping(ctx sdk.Context, extendedPairID uint64, userVaultID uint64, appMappingID uint64)
 	SetVault(ctx sdk.Context, vault types.Vault)
 }
@@ -71,8 +75,11 @@ type LendKeeper interface {
 	GetBorrow(ctx sdk.Context, id uint64) (borrow lendtypes.BorrowAsset, found bool)
 	GetLendPair(ctx sdk.Context, id uint64) (pair lendtypes.Extended_Pair, found bool)
 	GetAssetRatesParams(ctx sdk.Context, assetID uint64) (assetRatesStats lendtypes.AssetRatesParams, found bool)
+	GetAssetRatesParamsForPair(ctx sdk.Context, pair lendtypes.Extended_Pair) (assetRatesStats lendtypes.AssetRatesParams, found bool)
 	VerifyCollateralizationRatio(ctx sdk.Context, amountIn sdk.Int, assetIn assettypes.Asset, amountOut sdk.Int, assetOut assettypes.Asset, liquidationThreshold sdk.Dec) error
 	CalculateCollateralizationRatio(ctx sdk.Context, amountIn sdk.Int, assetIn assettypes.Asset, amountOut sdk.Int, assetOut assettypes.Asset) (sdk.Dec, error)
+	CTokenCollateral(ctx sdk.Context, lendID uint64, amount sdk.Int) sdk.Int
+	CTokenBurnAmount(ctx sdk.Context, poolID, assetID uint64, amount sdk.Int) sdk.Int
 	GetLend(ctx sdk.Context, id uint64) (lend lendtypes.LendAsset, found bool)
 	CreteNewBorrow(ctx sdk.Context, liqBorrow liquidationtypes.LockedVault)
 	GetPool(ctx sdk.Context, id uint64) (pool lendtypes.Pool, found bool)
@@ -100,3 +107,8 @@ type RewardsKeeper interface {
 	CalculateVaultInterest(ctx sdk.Context, appID, assetID, lockerID uint64, NetBalance sdk.Int, blockHeight int64, lockerBlockTime int64) error
 	DeleteVaultInterestTracker(ctx sdk.Context, vault rewardstypes.VaultInterestTracker)
 }
+
+type LiquidityKeeper interface {
+	PoolCoinUnderlyingDenoms(ctx sdk.Context, poolCoinDenom string) ([]string, error)
+	UnwindPoolCoin(ctx sdk.Context, holder sdk.AccAddress, poolCoin sdk.Coin, demandCoinDenom string) (sdk.Coin, error)
+}
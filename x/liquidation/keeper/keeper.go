@@ -27,6 +27,7 @@ type Keeper struct {
 	esm        expected.EsmKeeper
 	rewards    expected.RewardsKeeper
 	lend       expected.LendKeeper
+	liquidity  expected.LiquidityKeeper
 }
 
 func NewKeeper(
@@ -43,6 +44,7 @@ func NewKeeper(
 	esm expected.EsmKeeper,
 	rewards expected.RewardsKeeper,
 	lend expected.LendKeeper,
+	liquidity expected.LiquidityKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -63,6 +65,7 @@ func NewKeeper(
 		esm:        esm,
 		rewards:    rewards,
 		lend:       lend,
+		liquidity:  liquidity,
 	}
 }
 
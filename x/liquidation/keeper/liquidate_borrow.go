@@ -75,7 +75,7 @@ func (k Keeper) LiquidateBorrows(ctx sdk.Context) error {
 				}
 			}
 
-			liqThreshold, _ := k.lend.GetAssetRatesParams(ctx, lendPair.AssetIn)
+			liqThreshold, _ := k.lend.GetAssetRatesParamsForPair(ctx, lendPair)
 			liqThresholdBridgedAssetOne, _ := k.lend.GetAssetRatesParams(ctx, firstTransitAssetID)
 			liqThresholdBridgedAssetTwo, _ := k.lend.GetAssetRatesParams(ctx, secondTransitAssetID)
 			firstBridgedAsset, _ := k.asset.GetAsset(ctx, firstTransitAssetID)
@@ -85,7 +85,7 @@ func (k Keeper) LiquidateBorrows(ctx sdk.Context) error {
 			//  b. if borrow is from first transit asset
 			//  c. if borrow is from second transit asset
 			if borrowPos.BridgedAssetAmount.Amount.Equal(sdk.ZeroInt()) { // first condition
-				currentCollateralizationRatio, err = k.lend.CalculateCollateralizationRatio(ctx, borrowPos.AmountIn.Amount, assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()), assetOut)
+				currentCollateralizationRatio, err = k.lend.CalculateCollateralizationRatio(ctx, k.lend.CTokenCollateral(ctx, borrowPos.LendingID, borrowPos.AmountIn.Amount), assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()), assetOut)
 				if err != nil {
 					return err
 				}
@@ -108,7 +108,7 @@ func (k Keeper) LiquidateBorrows(ctx sdk.Context) error {
 				}
 			} else {
 				if borrowPos.BridgedAssetAmount.Denom == firstBridgedAsset.Denom {
-					currentCollateralizationRatio, err = k.lend.CalculateCollateralizationRatio(ctx, borrowPos.AmountIn.Amount, assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()), assetOut)
+					currentCollateralizationRatio, err = k.lend.CalculateCollateralizationRatio(ctx, k.lend.CTokenCollateral(ctx, borrowPos.LendingID, borrowPos.AmountIn.Amount), assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()), assetOut)
 					if err != nil {
 						return err
 					}
@@ -128,7 +128,7 @@ func (k Keeper) LiquidateBorrows(ctx sdk.Context) error {
 						k.lend.UpdateBorrowStats(ctx, pair, borrowPos.IsStableBorrow, borrowPos.AmountOut.Amount, false)
 					}
 				} else {
-					currentCollateralizationRatio, err = k.lend.CalculateCollateralizationRatio(ctx, borrowPos.AmountIn.Amount, assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()), assetOut)
+					currentCollateralizationRatio, err = k.lend.CalculateCollateralizationRatio(ctx, k.lend.CTokenCollateral(ctx, borrowPos.LendingID, borrowPos.AmountIn.Amount), assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()), assetOut)
 					if err != nil {
 						return err
 					}
@@ -214,7 +214,7 @@ func (k Keeper) UpdateLockedBorrows(ctx sdk.Context, updatedLockedVault types.Lo
 		firstBridgeAsset, _ := k.asset.GetAsset(ctx, firstTransitAssetID)
 		firstBridgeAssetStats, _ := k.lend.GetAssetRatesParams(ctx, firstTransitAssetID)
 		secondBridgeAssetStats, _ := k.lend.GetAssetRatesParams(ctx, secondTransitAssetID)
-		liqThreshold, _ := k.lend.GetAssetRatesParams(ctx, pair.AssetIn)
+		liqThreshold, _ := k.lend.GetAssetRatesParamsForPair(ctx, pair)
 
 		// finding unLiquidate Point percentage
 		if !borrowMetaData.BridgedAssetAmount.Amount.Equal(sdk.ZeroInt()) { // if bridged asset is being used for borrow (inter-pool borrow)
@@ -227,18 +227,20 @@ func (k Keeper) UpdateLockedBorrows(ctx sdk.Context, updatedLockedVault types.Lo
 			unliquidatePointPercentage = liqThreshold.LiquidationThreshold
 		}
 
-		assetRatesStats, _ := k.lend.GetAssetRatesParams(ctx, pair.AssetIn)
+		assetRatesStats, _ := k.lend.GetAssetRatesParamsForPair(ctx, pair)
 		// Checking required flags
 		if (!updatedLockedVault.IsAuctionInProgress && !updatedLockedVault.IsAuctionComplete) || (updatedLockedVault.IsAuctionComplete && updatedLockedVault.CurrentCollaterlisationRatio.GTE(unliquidatePointPercentage)) {
 			assetIn, _ := k.asset.GetAsset(ctx, pair.AssetIn)
 			assetOut, _ := k.asset.GetAsset(ctx, pair.AssetOut)
-			collateralizationRatio, err := k.lend.CalculateCollateralizationRatio(ctx, updatedLockedVault.AmountIn, assetIn, updatedLockedVault.UpdatedAmountOut, assetOut)
+			// the locked cTokens are worth what they redeem for in the pool
+			collateral := k.lend.CTokenCollateral(ctx, lendPos.ID, updatedLockedVault.AmountIn)
+			collateralizationRatio, err := k.lend.CalculateCollateralizationRatio(ctx, collateral, assetIn, updatedLockedVault.UpdatedAmountOut, assetOut)
 			if err != nil {
 				// ctx.Logger().Error("Error Calculating CR in Liquidation, liquidate_borrow.go for locked vault ID %d", lockedVault.LockedVaultId)
 				return err
 			}
 
-			assetInTotal, _ := k.market.CalcAssetPrice(ctx, assetIn.Id, updatedLockedVault.AmountIn)
+			assetInTotal, _ := k.market.CalcAssetPrice(ctx, assetIn.Id, collateral)
 			assetOutTotal, _ := k.market.CalcAssetPrice(ctx, assetOut.Id, updatedLockedVault.UpdatedAmountOut)
 
 			deductionPercentage, _ := sdk.NewDecFromStr("1.0")
@@ -269,10 +271,18 @@ func (k Keeper) UpdateLockedBorrows(ctx sdk.Context, updatedLockedVault types.Lo
 			bonusToBidderAmount := (selloffAmount.Mul(assetRatesStats.LiquidationBonus)).Quo(aip)
 			penaltyToReserveAmount := (selloffAmount.Mul(assetRatesStats.LiquidationPenalty)).Quo(aip)
 			sellOffAmt := selloffAmount.Quo(aip)
-			err = k.bank.SendCoinsFromModuleToModule(ctx, pool.ModuleName, auctiontypes.ModuleName, sdk.NewCoins(sdk.NewCoin(assetIn.Denom, bonusToBidderAmount.Add(sellOffAmt).TruncateInt())))
+			auctionedCollateral := sdk.NewCoin(assetIn.Denom, bonusToBidderAmount.Add(sellOffAmt).TruncateInt())
+			err = k.bank.SendCoinsFromModuleToModule(ctx, pool.ModuleName, auctiontypes.ModuleName, sdk.NewCoins(auctionedCollateral))
 			if err != nil {
 				return err
 			}
+			// pool coin collateral is withdrawn into one of the pool's assets
+			// before being auctioned
+			unwoundCollateral, _, err := k.UnwindPoolCoinCollateral(ctx, auctiontypes.ModuleName, auctionedCollateral, assetOut.Denom)
+			if err != nil {
+				return err
+			}
+			updatedLockedVault.UnwoundCollateral = unwoundCollateral
 			err = k.lend.UpdateReserveBalances(ctx, pair.AssetIn, pool.ModuleName, sdk.NewCoin(assetIn.Denom, penaltyToReserveAmount.TruncateInt()), true)
 			if err != nil {
 				return err
@@ -294,7 +304,7 @@ func (k Keeper) UpdateLockedBorrows(ctx sdk.Context, updatedLockedVault types.Lo
 
 			cAsset, _ := k.asset.GetAsset(ctx, assetRatesStats.CAssetID)
 			// totalDeduction is the sum of liquidationDeductionAmount and selloffAmount
-			totalDeduction := liquidationDeductionAmount.Add(sellOffAmt).TruncateInt() // Total deduction from amountIn also reduce to lend Position amountIn
+			totalDeduction := k.lend.CTokenBurnAmount(ctx, lendPos.PoolID, lendPos.AssetID, liquidationDeductionAmount.Add(sellOffAmt).TruncateInt()) // Total deduction from amountIn also reduce to lend Position amountIn
 			borrowPos, _ := k.lend.GetBorrow(ctx, updatedLockedVault.OriginalVaultId)
 			borrowPos.IsLiquidated = true
 			if totalDeduction.GTE(updatedLockedVault.AmountIn) { // rare case only
@@ -425,7 +435,7 @@ func (k Keeper) UnLiquidateLockedBorrows(ctx sdk.Context, appID, id uint64, dutc
 
 			if borrowMetadata.BridgedAssetAmount.IsZero() {
 				// also calculate the current collaterlization ratio to ensure there is no sudden changes
-				liqThreshold, _ := k.lend.GetAssetRatesParams(ctx, pair.AssetIn)
+				liqThreshold, _ := k.lend.GetAssetRatesParamsForPair(ctx, pair)
 				unliquidatePointPercentage := liqThreshold.LiquidationThreshold
 
 				if lockedVault.AmountOut.IsZero() {
@@ -451,7 +461,7 @@ func (k Keeper) UnLiquidateLockedBorrows(ctx sdk.Context, appID, id uint64, dutc
 					k.lend.DeleteBorrowInterestTracker(ctx, lockedVault.OriginalVaultId)
 					return nil
 				}
-				newCalculatedCollateralizationRatio, _ := k.lend.CalculateCollateralizationRatio(ctx, lockedVault.AmountIn, assetIn, lockedVault.UpdatedAmountOut, assetOut)
+				newCalculatedCollateralizationRatio, _ := k.lend.CalculateCollateralizationRatio(ctx, k.lend.CTokenCollateral(ctx, borrowMetadata.LendingId, lockedVault.AmountIn), assetIn, lockedVault.UpdatedAmountOut, assetOut)
 				if newCalculatedCollateralizationRatio.GT(unliquidatePointPercentage) {
 					updatedLockedVault := lockedVault
 					updatedLockedVault.CurrentCollaterlisationRatio = newCalculatedCollateralizationRatio
@@ -509,7 +519,7 @@ func (k Keeper) UnLiquidateLockedBorrows(ctx sdk.Context, appID, id uint64, dutc
 						k.lend.DeleteBorrowInterestTracker(ctx, lockedVault.OriginalVaultId)
 						return nil
 					}
-					newCalculatedCollateralizationRatio, _ := k.lend.CalculateCollateralizationRatio(ctx, lockedVault.AmountIn, assetIn, lockedVault.UpdatedAmountOut, assetOut)
+					newCalculatedCollateralizationRatio, _ := k.lend.CalculateCollateralizationRatio(ctx, k.lend.CTokenCollateral(ctx, borrowMetadata.LendingId, lockedVault.AmountIn), assetIn, lockedVault.UpdatedAmountOut, assetOut)
 					if newCalculatedCollateralizationRatio.GT(unliquidatePointPercentage) {
 						updatedLockedVault := lockedVault
 						updatedLockedVault.CurrentCollaterlisationRatio = newCalculatedCollateralizationRatio
@@ -566,7 +576,7 @@ func (k Keeper) UnLiquidateLockedBorrows(ctx sdk.Context, appID, id uint64, dutc
 						k.lend.DeleteBorrowInterestTracker(ctx, lockedVault.OriginalVaultId)
 						return nil
 					}
-					newCalculatedCollateralizationRatio, _ := k.lend.CalculateCollateralizationRatio(ctx, lockedVault.AmountIn, assetIn, lockedVault.UpdatedAmountOut, assetOut)
+					newCalculatedCollateralizationRatio, _ := k.lend.CalculateCollateralizationRatio(ctx, k.lend.CTokenCollateral(ctx, borrowMetadata.LendingId, lockedVault.AmountIn), assetIn, lockedVault.UpdatedAmountOut, assetOut)
 					if newCalculatedCollateralizationRatio.GT(unliquidatePointPercentage) {
 						updatedLockedVault := lockedVault
 						updatedLockedVault.CurrentCollaterlisationRatio = newCalculatedCollateralizationRatio
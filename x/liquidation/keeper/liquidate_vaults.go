@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	protobuftypes "github.com/gogo/protobuf/types"
@@ -63,13 +64,14 @@ func (k Keeper) LiquidateVaults(ctx sdk.Context) error {
 				}
 				totalIn := totalRate
 
-				liqRatio := extPair.MinCr
+				// a delisted pair raises the ratio until its vaults are closed out
+				liqRatio, closeOut := k.asset.DelistingMinCr(ctx, extPair)
 				totalOut := vault.AmountOut.Add(vault.InterestAccumulated).Add(vault.ClosingFeeAccumulated)
-				collateralizationRatio, err := k.vault.CalculateCollateralizationRatio(ctx, vault.ExtendedPairVaultID, vault.AmountIn, totalOut)
+				collateralizationRatio, err := k.vault.CalculateVaultCollateralizationRatio(ctx, vault, vault.AmountIn, totalOut)
 				if err != nil {
 					return fmt.Errorf("error Calculating CR in Liquidation, liquidate_vaults.go for vault ID %d", vault.Id)
 				}
-				if collateralizationRatio.LT(liqRatio) {
+				if (closeOut && totalOut.IsPositive()) || collateralizationRatio.LT(liqRatio) {
 					// calculate interest and update vault
 					totalDebt := vault.AmountOut.Add(vault.InterestAccumulated)
 					err1 := k.rewards.CalculateVaultInterest(ctx, vault.AppId, vault.ExtendedPairVaultID, vault.Id, totalDebt, vault.BlockHeight, vault.BlockTime.Unix())
@@ -79,7 +81,7 @@ func (k Keeper) LiquidateVaults(ctx sdk.Context) error {
 					vault, _ := k.vault.GetVault(ctx, vault.Id)
 					totalFees := vault.InterestAccumulated.Add(vault.ClosingFeeAccumulated)
 					totalOut := vault.AmountOut.Add(vault.InterestAccumulated).Add(vault.ClosingFeeAccumulated)
-					collateralizationRatio, err := k.vault.CalculateCollateralizationRatio(ctx, vault.ExtendedPairVaultID, vault.AmountIn, totalOut)
+					collateralizationRatio, err := k.vault.CalculateVaultCollateralizationRatio(ctx, vault, vault.AmountIn, totalOut)
 					if err != nil {
 						return fmt.Errorf("error Calculating CR in Liquidation, liquidate_vaults.go for vaultID %d", vault.Id)
 					}
@@ -106,6 +108,21 @@ func (k Keeper) LiquidateVaults(ctx sdk.Context) error {
 func (k Keeper) CreateLockedVault(ctx sdk.Context, vault vaulttypes.Vault, totalIn sdk.Dec, collateralizationRatio sdk.Dec, appID uint64, totalFees sdk.Int) error {
 	lockedVaultID := k.GetLockedVaultID(ctx)
 
+	// pool coin collateral is withdrawn into one of the pool's assets
+	// before being auctioned
+	extPair, _ := k.asset.GetPairsVault(ctx, vault.ExtendedPairVaultID)
+	pair, _ := k.asset.GetPair(ctx, extPair.PairId)
+	assetIn, _ := k.asset.GetAsset(ctx, pair.AssetIn)
+	assetOut, _ := k.asset.GetAsset(ctx, pair.AssetOut)
+	unwoundCollateral, _, err := k.UnwindPoolCoinCollateral(ctx, vaulttypes.ModuleName, sdk.NewCoin(assetIn.Denom, vault.AmountIn), assetOut.Denom)
+	if err != nil {
+		return err
+	}
+	collateralQueue, basketValue, err := k.CollateralQueue(ctx, vault)
+	if err != nil {
+		return err
+	}
+
 	value := types.LockedVault{
 		LockedVaultId:           lockedVaultID + 1,
 		AppId:                   appID,
@@ -119,23 +136,64 @@ func (k Keeper) CreateLockedVault(ctx sdk.Context, vault vaulttypes.Vault, total
 		IsAuctionComplete:       false,
 		IsAuctionInProgress:     false,
 		CrAtLiquidation:         collateralizationRatio,
-		CollateralToBeAuctioned: totalIn,
+		CollateralToBeAuctioned: totalIn.Add(basketValue),
 		LiquidationTimestamp:    ctx.BlockTime(),
 		InterestAccumulated:     totalFees,
 		Kind:                    nil,
+		UnwoundCollateral:       unwoundCollateral,
+		CollateralQueue:         collateralQueue,
+		DebtRecovered:           sdk.ZeroInt(),
 	}
 
 	k.SetLockedVault(ctx, value)
 	k.SetLockedVaultID(ctx, value.LockedVaultId)
 	length := k.vault.GetLengthOfVault(ctx)
 	k.vault.SetLengthOfVault(ctx, length-1)
-	err := k.auction.DutchActivator(ctx, value)
+	err = k.auction.DutchActivator(ctx, value)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// CollateralQueue returns the basket collateral of vault in the order it is
+// auctioned, by ascending liquidation priority with delisted assets last,
+// along with the value of the listed ones.
+func (k Keeper) CollateralQueue(ctx sdk.Context, vault vaulttypes.Vault) ([]sdk.Coin, sdk.Dec, error) {
+	var (
+		queue      []sdk.Coin
+		priorities = make(map[string]uint64)
+		delisted   = make(map[string]bool)
+		value      = sdk.ZeroDec()
+	)
+	for _, coin := range vault.Collaterals {
+		asset, found := k.asset.GetAssetForDenom(ctx, coin.Denom)
+		if !found {
+			return nil, sdk.ZeroDec(), fmt.Errorf("asset not found for denom %s", coin.Denom)
+		}
+		queue = append(queue, coin)
+		collateral, found := k.asset.GetVaultCollateralAsset(ctx, vault.ExtendedPairVaultID, asset.Id)
+		factor := collateral.ValueFactor(ctx.BlockTime())
+		if !found || !factor.IsPositive() {
+			delisted[coin.Denom] = true
+			continue
+		}
+		priorities[coin.Denom] = collateral.LiquidationPriority
+		price, err := k.market.CalcAssetPrice(ctx, asset.Id, coin.Amount)
+		if err != nil {
+			return nil, sdk.ZeroDec(), err
+		}
+		value = value.Add(price.Mul(factor))
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		if delisted[queue[i].Denom] != delisted[queue[j].Denom] {
+			return !delisted[queue[i].Denom]
+		}
+		return priorities[queue[i].Denom] < priorities[queue[j].Denom]
+	})
+	return queue, value, nil
+}
+
 func (k Keeper) GetModAccountBalances(ctx sdk.Context, accountName string, denom string) sdk.Int {
 	macc := k.account.GetModuleAccount(ctx, accountName)
 	return k.bank.GetBalance(ctx, macc.GetAddress(), denom).Amount
@@ -188,6 +246,9 @@ func (k Keeper) SetLockedVaultHistory(ctx sdk.Context, lockedVault types.LockedV
 		value = k.cdc.MustMarshal(&lockedVault)
 	)
 	store.Set(key, value)
+	if lockedVault.Kind == nil {
+		store.Set(types.LockedVaultHistoryByVaultKey(lockedVault.AppId, lockedVault.OriginalVaultId, id), sdk.Uint64ToBigEndian(id))
+	}
 }
 
 func (k Keeper) GetLockedVaultHistory(ctx sdk.Context, appID, id uint64) (lockedVault types.LockedVault, found bool) {
@@ -205,6 +266,36 @@ func (k Keeper) GetLockedVaultHistory(ctx sdk.Context, appID, id uint64) (locked
 	return lockedVault, true
 }
 
+// UpdateLockedVaultHistoryOwner moves the history of the vault's past
+// liquidations to the vault's new owner, after the vault is transferred.
+func (k Keeper) UpdateLockedVaultHistoryOwner(ctx sdk.Context, appID, vaultID uint64, owner, newOwner string) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.LockedVaultHistoryByVaultKeyPrefix(appID, vaultID))
+		ids   []uint64
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iter.Value()))
+	}
+
+	for _, id := range ids {
+		lockedVault, found := k.GetLockedVaultHistory(ctx, appID, id)
+		if !found || lockedVault.Owner != owner {
+			continue
+		}
+		lockedVault.Owner = newOwner
+		k.SetLockedVaultHistory(ctx, lockedVault, id)
+	}
+}
+
 // locked vaults kvs
 
 func (k Keeper) SetLockedVaultID(ctx sdk.Context, id uint64) {
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"strings"
+
 	"github.com/comdex-official/comdex/app/wasm/bindings"
 	assetTypes "github.com/comdex-official/comdex/x/asset/types"
 	liquidationTypes "github.com/comdex-official/comdex/x/liquidation/types"
@@ -313,3 +315,76 @@ func (s *KeeperTestSuite) TestDeleteLockedVault() {
 	_, found = liquidationKeeper.GetLockedVault(*ctx, 1, 1)
 	s.Require().False(found)
 }
+
+func (s *KeeperTestSuite) TestLiquidateBasketVault() {
+	liquidationKeeper, ctx := &s.liquidationKeeper, &s.ctx
+	owner, _ := sdk.AccAddressFromBech32("cosmos1q7q90qsl9g0gl2zz0njxwv2a649yqrtyxtnv3v")
+	s.CreateVault()
+	s.AddAuctionParams()
+
+	// uosmo is auctioned before uatom, both count for half their value
+	for i, denom := range []string{"uatom", "uosmo"} {
+		err := s.assetKeeper.AddAssetRecords(*ctx, assetTypes.Asset{
+			Name:                  strings.ToUpper(denom[1:]),
+			Denom:                 denom,
+			Decimals:              sdk.NewInt(1000000),
+			IsOnChain:             true,
+			IsOraclePriceRequired: true,
+		})
+		s.Require().NoError(err)
+		asset, _ := s.assetKeeper.GetAssetForDenom(*ctx, denom)
+		s.SetOraclePrice(asset.Id, 1000000)
+		err = s.assetKeeper.AddVaultCollateralAsset(*ctx, assetTypes.VaultCollateralAsset{
+			AppId:                1,
+			ExtendedPairVaultId:  1,
+			AssetId:              asset.Id,
+			LiquidationThreshold: sdk.MustNewDecFromStr("3"),
+			LiquidationPriority:  uint64(2 - i),
+		})
+		s.Require().NoError(err)
+	}
+	for _, coin := range []sdk.Coin{sdk.NewCoin("uatom", sdk.NewInt(1000000)), sdk.NewCoin("uosmo", sdk.NewInt(500000))} {
+		s.fundAddr(owner, coin)
+		_, err := s.vaultMsgServer.MsgDepositCollateral(sdk.WrapSDKContext(*ctx), vaultTypes.NewMsgDepositCollateralRequest(owner, 1, 1, 1, coin))
+		s.Require().NoError(err)
+	}
+
+	// cr = (0.5 + 0.5 * 1.5 / 3 + 1 * 1.5 / 3) / 1 = 1.25
+	s.SetOraclePrice(1, 500000)
+	err := liquidationKeeper.LiquidateVaults(*ctx)
+	s.Require().NoError(err)
+	lockedVault, found := liquidationKeeper.GetLockedVault(*ctx, 1, 1)
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), lockedVault.OriginalVaultId)
+	s.Require().True(lockedVault.IsBasketAuction)
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin("uatom", sdk.NewInt(1000000))}, lockedVault.CollateralQueue)
+	s.Require().Equal(sdk.NewDec(2000000), lockedVault.CollateralToBeAuctioned)
+
+	bidder := sdk.AccAddress("bidder______________")
+	s.fundAddr(bidder, sdk.NewCoin("ucmst", sdk.NewInt(10000000)))
+	placeBid := func(denom string) {
+		for _, auction := range s.app.AuctionKeeper.GetDutchAuctions(*ctx, 1) {
+			if auction.LockedVaultId == lockedVault.LockedVaultId {
+				s.Require().Equal(denom, auction.OutflowTokenCurrentAmount.Denom)
+				err := s.app.AuctionKeeper.PlaceDutchAuctionBid(*ctx, 1, auction.AuctionMappingId, auction.AuctionId, bidder, auction.OutflowTokenCurrentAmount)
+				s.Require().NoError(err)
+				return
+			}
+		}
+		s.Fail("auction not found")
+	}
+
+	// selling all uosmo leaves debt, the uatom auction starts for the rest
+	placeBid("uosmo")
+	lockedVault, found = liquidationKeeper.GetLockedVault(*ctx, 1, 1)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(600000), lockedVault.DebtRecovered)
+	s.Require().Empty(lockedVault.CollateralQueue)
+
+	// the uatom auction raises the rest, the pair collateral goes back to the owner
+	placeBid("uatom")
+	_, found = liquidationKeeper.GetLockedVault(*ctx, 1, 1)
+	s.Require().False(found)
+	s.Require().Equal(sdk.NewInt(1000000), s.app.BankKeeper.GetBalance(*ctx, owner, "ucmdx").Amount)
+	s.Require().True(s.app.BankKeeper.GetBalance(*ctx, owner, "uatom").Amount.IsPositive())
+}
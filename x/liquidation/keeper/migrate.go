@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/liquidation/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	//  Vault transfers move the history of the vault's liquidations to the
+	//  new owner, so the existing history is indexed by original vault id.
+	var (
+		store = m.keeper.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.LockedVaultDataKeyHistory)
+		keys  [][]byte
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var lockedVault types.LockedVault
+		m.keeper.cdc.MustUnmarshal(iter.Value(), &lockedVault)
+		if lockedVault.Kind != nil {
+			continue
+		}
+		id := sdk.BigEndianToUint64(iter.Key()[len(types.LockedVaultDataKeyHistory)+8:])
+		keys = append(keys, types.LockedVaultHistoryByVaultKey(lockedVault.AppId, lockedVault.OriginalVaultId, id))
+	}
+
+	for _, key := range keys {
+		store.Set(key, key[len(key)-8:])
+	}
+	return nil
+}
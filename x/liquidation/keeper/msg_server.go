@@ -55,13 +55,13 @@ func (k msgServer) MsgLiquidateVault(c context.Context, msg *types.MsgLiquidateV
 	}
 	totalIn := totalRate
 
-	liqRatio := extPair.MinCr
+	liqRatio, closeOut := k.asset.DelistingMinCr(ctx, extPair)
 	totalOut := vault.AmountOut.Add(vault.InterestAccumulated).Add(vault.ClosingFeeAccumulated)
-	collateralizationRatio, err := k.vault.CalculateCollateralizationRatio(ctx, vault.ExtendedPairVaultID, vault.AmountIn, totalOut)
+	collateralizationRatio, err := k.vault.CalculateVaultCollateralizationRatio(ctx, vault, vault.AmountIn, totalOut)
 	if err != nil {
 		return nil, err
 	}
-	if collateralizationRatio.LT(liqRatio) {
+	if (closeOut && totalOut.IsPositive()) || collateralizationRatio.LT(liqRatio) {
 		// calculate interest and update vault
 		totalDebt := vault.AmountOut.Add(vault.InterestAccumulated)
 		err1 := k.rewards.CalculateVaultInterest(ctx, vault.AppId, vault.ExtendedPairVaultID, vault.Id, totalDebt, vault.BlockHeight, vault.BlockTime.Unix())
@@ -71,7 +71,7 @@ func (k msgServer) MsgLiquidateVault(c context.Context, msg *types.MsgLiquidateV
 		vault, _ := k.vault.GetVault(ctx, vault.Id)
 		totalFees := vault.InterestAccumulated.Add(vault.ClosingFeeAccumulated)
 		totalOut := vault.AmountOut.Add(vault.InterestAccumulated).Add(vault.ClosingFeeAccumulated)
-		collateralizationRatio, err := k.vault.CalculateCollateralizationRatio(ctx, vault.ExtendedPairVaultID, vault.AmountIn, totalOut)
+		collateralizationRatio, err := k.vault.CalculateVaultCollateralizationRatio(ctx, vault, vault.AmountIn, totalOut)
 		if err != nil {
 			return nil, err
 		}
@@ -137,7 +137,7 @@ func (k msgServer) MsgLiquidateBorrow(c context.Context, msg *types.MsgLiquidate
 		}
 	}
 
-	liqThreshold, _ := k.lend.GetAssetRatesParams(ctx, lendPair.AssetIn)
+	liqThreshold, _ := k.lend.GetAssetRatesParamsForPair(ctx, lendPair)
 	liqThresholdBridgedAssetOne, _ := k.lend.GetAssetRatesParams(ctx, firstTransitAssetID)
 	liqThresholdBridgedAssetTwo, _ := k.lend.GetAssetRatesParams(ctx, secondTransitAssetID)
 	firstBridgedAsset, _ := k.asset.GetAsset(ctx, firstTransitAssetID)
@@ -146,7 +146,7 @@ func (k msgServer) MsgLiquidateBorrow(c context.Context, msg *types.MsgLiquidate
 	//  b. if borrow is from first transit asset
 	//  c. if borrow is from second transit asset
 	if borrowPos.BridgedAssetAmount.Amount.Equal(sdk.ZeroInt()) { // first condition
-		currentCollateralizationRatio, err = k.lend.CalculateCollateralizationRatio(ctx, borrowPos.AmountIn.Amount, assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()), assetOut)
+		currentCollateralizationRatio, err = k.lend.CalculateCollateralizationRatio(ctx, k.lend.CTokenCollateral(ctx, borrowPos.LendingID, borrowPos.AmountIn.Amount), assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()), assetOut)
 		if err != nil {
 			return nil, err
 		}
@@ -166,7 +166,7 @@ func (k msgServer) MsgLiquidateBorrow(c context.Context, msg *types.MsgLiquidate
 		}
 	} else {
 		if borrowPos.BridgedAssetAmount.Denom == firstBridgedAsset.Denom {
-			currentCollateralizationRatio, _ = k.lend.CalculateCollateralizationRatio(ctx, borrowPos.AmountIn.Amount, assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()), assetOut)
+			currentCollateralizationRatio, _ = k.lend.CalculateCollateralizationRatio(ctx, k.lend.CTokenCollateral(ctx, borrowPos.LendingID, borrowPos.AmountIn.Amount), assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()), assetOut)
 			if sdk.Dec.GT(currentCollateralizationRatio, liqThreshold.LiquidationThreshold.Mul(liqThresholdBridgedAssetOne.LiquidationThreshold)) {
 				lockedVault, err := k.CreateLockedBorrow(ctx, borrowPos, currentCollateralizationRatio, lendPos.AppID)
 				if err != nil {
@@ -180,7 +180,7 @@ func (k msgServer) MsgLiquidateBorrow(c context.Context, msg *types.MsgLiquidate
 				}
 			}
 		} else {
-			currentCollateralizationRatio, _ = k.lend.CalculateCollateralizationRatio(ctx, borrowPos.AmountIn.Amount, assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()), assetOut)
+			currentCollateralizationRatio, _ = k.lend.CalculateCollateralizationRatio(ctx, k.lend.CTokenCollateral(ctx, borrowPos.LendingID, borrowPos.AmountIn.Amount), assetIn, borrowPos.AmountOut.Amount.Add(borrowPos.InterestAccumulated.TruncateInt()), assetOut)
 
 			if sdk.Dec.GT(currentCollateralizationRatio, liqThreshold.LiquidationThreshold.Mul(liqThresholdBridgedAssetTwo.LiquidationThreshold)) {
 				lockedVault, err := k.CreateLockedBorrow(ctx, borrowPos, currentCollateralizationRatio, lendPos.AppID)
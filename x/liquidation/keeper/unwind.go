@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/liquidation/types"
+	liquiditytypes "github.com/comdex-official/comdex/x/liquidity/types"
+)
+
+// UnwindPoolCoinCollateral withdraws pool coin collateral held by the module
+// account into one of the pool's assets, so that the collateral can be
+// auctioned at that asset's oracle price.
+// The first pool asset with an active price which isn't the debt asset is
+// chosen. isPoolCoin is false when the collateral isn't a pool coin, in which
+// case nothing is done.
+func (k Keeper) UnwindPoolCoinCollateral(ctx sdk.Context, moduleName string, collateral sdk.Coin, debtDenom string) (unwound sdk.Coin, isPoolCoin bool, err error) {
+	if _, _, err := liquiditytypes.ParsePoolCoinDenom(collateral.Denom); err != nil {
+		return sdk.Coin{}, false, nil
+	}
+	denoms, err := k.liquidity.PoolCoinUnderlyingDenoms(ctx, collateral.Denom)
+	if err != nil {
+		return sdk.Coin{}, true, err
+	}
+	for _, denom := range denoms {
+		if denom == debtDenom {
+			continue
+		}
+		asset, found := k.asset.GetAssetForDenom(ctx, denom)
+		if !found {
+			continue
+		}
+		twa, found := k.market.GetTwa(ctx, asset.Id)
+		if !found || !twa.IsPriceActive {
+			continue
+		}
+		unwound, err = k.liquidity.UnwindPoolCoin(ctx, k.account.GetModuleAddress(moduleName), collateral, denom)
+		return unwound, true, err
+	}
+	return sdk.Coin{}, true, types.ErrNoUnwindableCollateral
+}
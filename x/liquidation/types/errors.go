@@ -15,4 +15,5 @@ var (
 	ErrAppIDInvalid            = sdkerrors.Register(ModuleName, 706, "App Id invalid")
 	ErrVaultIDInvalid          = sdkerrors.Register(ModuleName, 707, "Vault Id invalid")
 	ErrorUnknownMsgType        = sdkerrors.Register(ModuleName, 708, "Unknown msg type")
+	ErrNoUnwindableCollateral  = sdkerrors.Register(ModuleName, 709, "no priced asset to unwind pool coin collateral into")
 )
@@ -22,6 +22,7 @@ var (
 	AppIdsKeyPrefix                  = []byte{0x15}
 	LiquidationOffsetHolderKeyPrefix = []byte{0x16}
 	LockedVaultDataKeyHistory        = []byte{0x17}
+	LockedVaultHistoryByVaultPrefix  = []byte{0x18}
 )
 
 // LengthPrefixString returns length-prefixed bytes representation
@@ -43,10 +44,24 @@ func LockedVaultKeyByApp(appID uint64) []byte {
 	return append(LockedVaultKeyPrefix, sdk.Uint64ToBigEndian(appID)...)
 }
 
+func LockedVaultHistoryKeyByApp(appID uint64) []byte {
+	return append(LockedVaultDataKeyHistory, sdk.Uint64ToBigEndian(appID)...)
+}
+
 func LockedVaultHistoryKey(appID, lockedVaultID uint64) []byte {
 	return append(append(LockedVaultDataKeyHistory, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(lockedVaultID)...)
 }
 
+// LockedVaultHistoryByVaultKey indexes the history of a vault's
+// liquidations by the original vault id.
+func LockedVaultHistoryByVaultKey(appID, vaultID, lockedVaultID uint64) []byte {
+	return append(LockedVaultHistoryByVaultKeyPrefix(appID, vaultID), sdk.Uint64ToBigEndian(lockedVaultID)...)
+}
+
+func LockedVaultHistoryByVaultKeyPrefix(appID, vaultID uint64) []byte {
+	return append(append(LockedVaultHistoryByVaultPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(vaultID)...)
+}
+
 // GetLiquidationOffsetHolderKey returns the index key to look offset value for liquidation.
 func GetLiquidationOffsetHolderKey(appID uint64, liquidatonForPrefix string) []byte {
 	return append(append(LiquidationOffsetHolderKeyPrefix, sdk.Uint64ToBigEndian(appID)...), LengthPrefixString(liquidatonForPrefix)...)
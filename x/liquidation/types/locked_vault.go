@@ -1 +1,18 @@
 package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// IsCollateralUnwound returns whether the locked vault's pool coin collateral
+// was withdrawn into one of its underlying assets.
+func (m LockedVault) IsCollateralUnwound() bool {
+	return m.UnwoundCollateral.Denom != ""
+}
+
+// RecoveredDebt returns the debt raised by the finished basket auctions of
+// the locked vault.
+func (m LockedVault) RecoveredDebt() sdk.Int {
+	if m.DebtRecovered.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return m.DebtRecovered
+}
@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -52,6 +52,17 @@ type LockedVault struct {
 	// Types that are valid to be assigned to Kind:
 	//	*LockedVault_BorrowMetaData
 	Kind isLockedVault_Kind `protobuf_oneof:"kind"`
+	// unwound_collateral is the underlying asset the pool coin collateral was
+	// withdrawn into before being auctioned, empty for other collaterals.
+	UnwoundCollateral github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,19,opt,name=unwound_collateral,json=unwoundCollateral,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"unwound_collateral" yaml:"unwound_collateral"`
+	// collateral_queue holds the basket collateral of the vault not auctioned
+	// yet, in the order it is auctioned. The pair's own collateral follows it.
+	CollateralQueue []types.Coin `protobuf:"bytes,20,rep,name=collateral_queue,json=collateralQueue,proto3" json:"collateral_queue" yaml:"collateral_queue"`
+	// debt_recovered is the debt raised by the finished basket auctions of the
+	// vault, held by the auction module until the last auction is closed.
+	DebtRecovered github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,21,opt,name=debt_recovered,json=debtRecovered,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_recovered" yaml:"debt_recovered"`
+	// is_basket_auction is set while one of the basket collaterals is auctioned.
+	IsBasketAuction bool `protobuf:"varint,22,opt,name=is_basket_auction,json=isBasketAuction,proto3" json:"is_basket_auction,omitempty" yaml:"is_basket_auction"`
 }
 
 func (m *LockedVault) Reset()         { *m = LockedVault{} }
@@ -170,77 +181,85 @@ func init() {
 }
 
 var fileDescriptor_6e1145b6fa4b74d3 = []byte{
-	// 1109 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xa6, 0x49, 0x88, 0x27, 0xad, 0x3f, 0x26, 0x21, 0xdd, 0x98, 0xc6, 0x63, 0xf6, 0x50,
-	0x2c, 0x44, 0xd6, 0x6a, 0x2b, 0x24, 0x04, 0x17, 0xec, 0x14, 0xa9, 0x16, 0x29, 0x89, 0xb6, 0x1f,
-	0x48, 0x48, 0x68, 0x34, 0xde, 0x1d, 0x3b, 0xa3, 0xec, 0xee, 0x98, 0xdd, 0xd9, 0x36, 0xb9, 0x71,
-	0xe5, 0x80, 0x54, 0xce, 0x1c, 0xb8, 0x22, 0xf1, 0x4f, 0x70, 0xcc, 0xb1, 0x47, 0xc4, 0x61, 0x01,
-	0xe7, 0x3f, 0xd8, 0x23, 0x27, 0xb4, 0x33, 0xe3, 0xaf, 0xc4, 0x45, 0xf5, 0x25, 0x8e, 0xdf, 0xfb,
-	0x7d, 0xbc, 0x99, 0x37, 0x33, 0xcf, 0x60, 0xdf, 0xe5, 0x81, 0x47, 0xcf, 0x5a, 0x3e, 0xfb, 0x2e,
-	0x61, 0x1e, 0x11, 0x8c, 0x87, 0xad, 0x17, 0xf7, 0x7a, 0x54, 0x90, 0x7b, 0x2d, 0x9f, 0xbb, 0xa7,
-	0xd4, 0xc3, 0x2f, 0x48, 0xe2, 0x0b, 0x7b, 0x18, 0x71, 0xc1, 0x61, 0x4d, 0xc1, 0xed, 0x19, 0xb8,
-	0xad, 0xe1, 0xb5, 0xed, 0x01, 0x1f, 0x70, 0x09, 0x6b, 0xe5, 0xff, 0x29, 0x46, 0x0d, 0x0d, 0x38,
-	0x1f, 0xf8, 0xb4, 0x25, 0xbf, 0xf5, 0x92, 0x7e, 0x4b, 0xb0, 0x80, 0xc6, 0x82, 0x04, 0x43, 0x0d,
-	0xa8, 0xbb, 0x3c, 0x0e, 0x78, 0xdc, 0xea, 0x91, 0x98, 0x4e, 0xac, 0x5d, 0xce, 0x42, 0x95, 0xb7,
-	0x7e, 0x29, 0x83, 0xcd, 0x43, 0x59, 0xc9, 0xf3, 0xbc, 0x10, 0x68, 0x83, 0x15, 0xe6, 0x99, 0x46,
-	0xc3, 0x68, 0xae, 0x76, 0xea, 0xa3, 0x14, 0xdd, 0x9a, 0x49, 0x76, 0xbd, 0x2c, 0x45, 0xc5, 0x73,
-	0x12, 0xf8, 0x9f, 0x5a, 0xcc, 0xb3, 0x9c, 0x15, 0xe6, 0xc1, 0x7d, 0xb0, 0x4e, 0x86, 0x43, 0xcc,
-	0x3c, 0x73, 0x45, 0x72, 0x76, 0x46, 0x29, 0x5a, 0x6b, 0x0f, 0x87, 0x57, 0xb1, 0x6b, 0x24, 0x8f,
-	0xc1, 0x43, 0x50, 0xe5, 0x11, 0x1b, 0xb0, 0x90, 0xf8, 0x6a, 0xe5, 0x39, 0xf3, 0x86, 0x64, 0x36,
-	0x46, 0x29, 0x2a, 0x1f, 0xe9, 0xe4, 0x42, 0xbf, 0x32, 0x9f, 0xcf, 0xc2, 0x13, 0xb0, 0x43, 0xcf,
-	0x04, 0x0d, 0x3d, 0xea, 0xe1, 0x21, 0x61, 0xd1, 0x54, 0x72, 0x55, 0x4a, 0x3e, 0x18, 0xa5, 0xa8,
-	0xf4, 0x85, 0x46, 0x1c, 0x13, 0x16, 0x49, 0xc5, 0x3d, 0xa5, 0xb8, 0x98, 0x69, 0x39, 0x5b, 0x74,
-	0x86, 0x30, 0x76, 0x6a, 0x81, 0x35, 0xfe, 0x32, 0xa4, 0x91, 0xb9, 0xd6, 0x30, 0x9a, 0xc5, 0xce,
-	0x6e, 0xbe, 0xca, 0xa3, 0x3c, 0x90, 0xa5, 0xe8, 0xa6, 0xd2, 0x93, 0x79, 0xcb, 0x51, 0x38, 0x78,
-	0x0a, 0x8a, 0x24, 0xe0, 0x49, 0x28, 0x30, 0x0b, 0xcd, 0x75, 0x49, 0xfa, 0xea, 0x22, 0x45, 0x85,
-	0x3f, 0x53, 0x74, 0x77, 0xc0, 0xc4, 0x49, 0xd2, 0xb3, 0x5d, 0x1e, 0xb4, 0x74, 0x77, 0xd4, 0xc7,
-	0x7e, 0xec, 0x9d, 0xb6, 0xc4, 0xf9, 0x90, 0xc6, 0x76, 0x37, 0x14, 0xa3, 0x14, 0x6d, 0xb4, 0xa5,
-	0x44, 0x37, 0xcc, 0x52, 0x54, 0x51, 0x2e, 0x13, 0x51, 0xcb, 0xd9, 0x20, 0x3a, 0x0b, 0x39, 0x00,
-	0x3a, 0xce, 0x13, 0x61, 0xbe, 0x23, 0xdd, 0x8e, 0x97, 0x76, 0x2b, 0x2a, 0xb7, 0xa3, 0x44, 0x64,
-	0x29, 0xaa, 0xce, 0xd9, 0xf1, 0x44, 0x58, 0x8e, 0x5e, 0xd0, 0x51, 0x22, 0xe0, 0x8f, 0x06, 0x80,
-	0xc9, 0xd0, 0x23, 0x82, 0x7a, 0x78, 0xc6, 0x79, 0x43, 0x3a, 0xe3, 0xa5, 0x9d, 0x2b, 0xcf, 0x94,
-	0xd6, 0x6c, 0x01, 0xbb, 0xaa, 0x80, 0xeb, 0x2e, 0x96, 0x53, 0x49, 0xae, 0xc0, 0xe1, 0x67, 0xa0,
-	0xc8, 0x42, 0x26, 0x18, 0x11, 0x3c, 0x32, 0x8b, 0xb2, 0x8a, 0xbd, 0x7c, 0x45, 0xdd, 0x71, 0x70,
-	0xda, 0x26, 0xe2, 0x05, 0xf9, 0xe6, 0x4d, 0xf1, 0xd0, 0x05, 0x5b, 0x2c, 0xc6, 0x24, 0x71, 0xf3,
-	0xfb, 0x86, 0x5d, 0x1e, 0x0c, 0x7d, 0x2a, 0xa8, 0x09, 0x1a, 0x46, 0x73, 0x43, 0x1e, 0xa1, 0x6a,
-	0x37, 0x6e, 0xab, 0xec, 0x81, 0x4e, 0x66, 0x29, 0xaa, 0xe9, 0x73, 0x79, 0x9d, 0x69, 0x39, 0x55,
-	0x76, 0x95, 0x00, 0x03, 0xb0, 0x33, 0x03, 0x65, 0x21, 0x1e, 0x46, 0x7c, 0x10, 0xd1, 0x38, 0x36,
-	0x37, 0xa5, 0xcf, 0x27, 0xa3, 0x14, 0x6d, 0x4d, 0x7c, 0xba, 0xe1, 0xb1, 0x4e, 0x4f, 0xcf, 0xeb,
-	0x62, 0xba, 0xe5, 0x6c, 0xb1, 0xeb, 0x2c, 0xf8, 0x83, 0x01, 0xaa, 0x6e, 0x84, 0x89, 0xc0, 0x33,
-	0x6f, 0x89, 0x79, 0x53, 0xee, 0xcc, 0xb7, 0x4b, 0xf4, 0xe7, 0x21, 0x75, 0xf3, 0x6b, 0x79, 0x10,
-	0xb5, 0xc5, 0xe1, 0x54, 0x28, 0x4b, 0x91, 0xa9, 0x8a, 0xba, 0xe6, 0x61, 0x39, 0x65, 0x77, 0x1e,
-	0x0c, 0x7f, 0x37, 0x00, 0x72, 0x93, 0x28, 0xa2, 0xa1, 0xc0, 0x2e, 0xf7, 0x7d, 0x22, 0x68, 0x44,
-	0x7c, 0x16, 0xcb, 0x2c, 0x8e, 0xf2, 0x0f, 0xf3, 0x96, 0xac, 0xec, 0x6c, 0xe9, 0xca, 0xee, 0x1c,
-	0x28, 0xe1, 0x03, 0xad, 0x3b, 0x96, 0x75, 0xf2, 0xbf, 0x59, 0x8a, 0xee, 0xea, 0x32, 0xff, 0xdf,
-	0xde, 0x72, 0xf6, 0xdc, 0x79, 0x1d, 0x32, 0x27, 0x04, 0x7f, 0x33, 0x40, 0x6d, 0xca, 0xc5, 0x82,
-	0xe3, 0x1e, 0x1d, 0x77, 0x83, 0x7a, 0x66, 0x49, 0x56, 0x1f, 0x2e, 0x5d, 0xfd, 0xed, 0xa9, 0xdd,
-	0x53, 0xde, 0xa1, 0xed, 0xb1, 0x60, 0x96, 0xa2, 0xf7, 0x75, 0xe1, 0x6f, 0x34, 0xb5, 0x9c, 0xdb,
-	0xee, 0x62, 0x36, 0xfc, 0xc9, 0x00, 0xef, 0xce, 0xb4, 0x04, 0x4f, 0x66, 0x82, 0x59, 0x6e, 0x18,
-	0xcd, 0xcd, 0xfb, 0x35, 0x5b, 0x4d, 0x0d, 0x7b, 0x3c, 0x35, 0xec, 0xa7, 0x63, 0x44, 0xe7, 0xf3,
-	0x7c, 0x11, 0xa3, 0x14, 0x6d, 0xcf, 0x74, 0x70, 0x92, 0xcd, 0x52, 0x74, 0x47, 0xd5, 0xb5, 0x50,
-	0xde, 0x7a, 0xf5, 0x17, 0x32, 0x9c, 0x6d, 0x7f, 0x01, 0x13, 0x3e, 0x03, 0xe5, 0x98, 0xfa, 0x3e,
-	0xef, 0xf7, 0xf1, 0x09, 0x8b, 0x05, 0x8f, 0xce, 0xcd, 0x4a, 0xe3, 0x46, 0xb3, 0xd8, 0xf9, 0x28,
-	0x7f, 0xa3, 0x9f, 0x50, 0xdf, 0x3f, 0xea, 0xf7, 0x1f, 0xa9, 0x4c, 0x96, 0xa2, 0x1d, 0x65, 0x73,
-	0x85, 0x62, 0x39, 0x25, 0x1d, 0xd1, 0x48, 0xf8, 0xbd, 0x01, 0xb6, 0x59, 0x28, 0x68, 0x44, 0x63,
-	0x81, 0x89, 0xeb, 0x26, 0x41, 0x92, 0x6f, 0x89, 0x67, 0x56, 0x65, 0x4b, 0x1e, 0x2f, 0xf7, 0x14,
-	0x65, 0x29, 0x7a, 0x4f, 0x5f, 0xb6, 0x05, 0x9a, 0xf9, 0x55, 0xd3, 0xe1, 0xf6, 0x34, 0x0a, 0x9f,
-	0x83, 0x4a, 0x8f, 0x47, 0x11, 0x7f, 0x89, 0x03, 0x2a, 0x08, 0xf6, 0x88, 0x20, 0x26, 0x94, 0xfb,
-	0xfc, 0xa1, 0xfd, 0xe6, 0x79, 0x6e, 0x77, 0x24, 0xe7, 0x31, 0x15, 0xe4, 0x21, 0x11, 0xe4, 0x51,
-	0xc1, 0x29, 0xf5, 0xe6, 0x22, 0x9d, 0x75, 0xb0, 0x7a, 0xca, 0x42, 0xcf, 0xca, 0x56, 0x40, 0x69,
-	0x1e, 0x0c, 0xf7, 0x00, 0xf0, 0x69, 0xe8, 0xb1, 0x70, 0x80, 0xc7, 0xc3, 0xda, 0x29, 0xea, 0x48,
-	0xd7, 0x83, 0x4d, 0x50, 0x61, 0x31, 0x8e, 0x05, 0xe9, 0xf9, 0x14, 0x2b, 0x55, 0x39, 0x9d, 0x37,
-	0x9c, 0x12, 0x8b, 0x9f, 0xc8, 0xb0, 0x12, 0x84, 0xe7, 0x00, 0xce, 0xc1, 0xf2, 0xeb, 0x40, 0xe5,
-	0x3c, 0x2e, 0x76, 0xbe, 0x5c, 0xee, 0x38, 0x4f, 0x9f, 0xec, 0xeb, 0x8a, 0x96, 0x53, 0x89, 0x67,
-	0x6c, 0x1d, 0x22, 0x28, 0xfc, 0xd9, 0x00, 0xdb, 0xbd, 0x88, 0x79, 0x83, 0xfc, 0x71, 0x8f, 0x63,
-	0x2a, 0xf4, 0x13, 0x2f, 0x47, 0xf7, 0xe6, 0xfd, 0x5d, 0x5b, 0x99, 0xd8, 0xf9, 0x0f, 0x97, 0xc9,
-	0xa6, 0x1d, 0x70, 0x16, 0xaa, 0x39, 0x3a, 0x6d, 0xd5, 0x22, 0x11, 0xeb, 0xdf, 0x14, 0x7d, 0xf0,
-	0x16, 0x75, 0xe7, 0x7a, 0x0e, 0xd4, 0x0a, 0xed, 0x5c, 0x40, 0xcd, 0x94, 0xce, 0xd7, 0x17, 0xff,
-	0xd4, 0x0b, 0xbf, 0x8e, 0xea, 0x85, 0x8b, 0x51, 0xdd, 0x78, 0x3d, 0xaa, 0x1b, 0x7f, 0x8f, 0xea,
-	0xc6, 0xab, 0xcb, 0x7a, 0xe1, 0xf5, 0x65, 0xbd, 0xf0, 0xc7, 0x65, 0xbd, 0xf0, 0xcd, 0xc7, 0x73,
-	0xf2, 0x79, 0x9b, 0xf7, 0x79, 0xbf, 0xcf, 0x5c, 0x46, 0x7c, 0xfd, 0xbd, 0x35, 0xff, 0xbb, 0x4f,
-	0x3a, 0xf6, 0xd6, 0xe5, 0x9d, 0x7b, 0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x71, 0x21, 0x19,
-	0x41, 0x1a, 0x0a, 0x00, 0x00,
+	// 1239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xa6, 0x4d, 0xbe, 0xf1, 0xb4, 0x75, 0xec, 0x49, 0x9a, 0x6e, 0xf3, 0x6d, 0x3c, 0x66,
+	0x0f, 0xc5, 0x42, 0x64, 0xad, 0x52, 0x21, 0x21, 0xb8, 0x60, 0xa7, 0x88, 0x5a, 0xa4, 0x4d, 0xd9,
+	0xfe, 0x40, 0x42, 0x42, 0xa3, 0xf1, 0xee, 0xd8, 0x1d, 0x65, 0xbd, 0xe3, 0xee, 0xce, 0xb6, 0xcd,
+	0x8d, 0x2b, 0x07, 0xa4, 0x72, 0xe1, 0xc2, 0x3f, 0x80, 0xc4, 0x3f, 0xc1, 0xb1, 0xc7, 0x1e, 0x81,
+	0xc3, 0x02, 0xee, 0x7f, 0xb0, 0x47, 0x4e, 0x68, 0x7e, 0xd8, 0x5e, 0xc7, 0x6e, 0x85, 0x2f, 0x71,
+	0xf6, 0xbd, 0xf7, 0xf9, 0x7c, 0xde, 0xcc, 0x7b, 0xf3, 0x66, 0xc0, 0x81, 0xcf, 0x87, 0x01, 0x7d,
+	0xde, 0x0a, 0xd9, 0x93, 0x94, 0x05, 0x44, 0x30, 0x1e, 0xb5, 0x9e, 0xde, 0xe8, 0x51, 0x41, 0x6e,
+	0xb4, 0x42, 0xee, 0x9f, 0xd0, 0x00, 0x3f, 0x25, 0x69, 0x28, 0xdc, 0x51, 0xcc, 0x05, 0x87, 0x7b,
+	0x3a, 0xdc, 0x2d, 0x84, 0xbb, 0x26, 0x7c, 0x6f, 0x67, 0xc0, 0x07, 0x5c, 0x85, 0xb5, 0xe4, 0x7f,
+	0x1a, 0xb1, 0x87, 0x06, 0x9c, 0x0f, 0x42, 0xda, 0x52, 0x5f, 0xbd, 0xb4, 0xdf, 0x12, 0x6c, 0x48,
+	0x13, 0x41, 0x86, 0x23, 0x13, 0x50, 0xf7, 0x79, 0x32, 0xe4, 0x49, 0xab, 0x47, 0x12, 0x3a, 0x95,
+	0xf6, 0x39, 0x8b, 0xb4, 0xdf, 0xf9, 0x1d, 0x82, 0x0b, 0x47, 0x2a, 0x93, 0x47, 0x32, 0x11, 0xe8,
+	0x82, 0x35, 0x16, 0xd8, 0x56, 0xc3, 0x6a, 0x9e, 0xef, 0xd4, 0xc7, 0x19, 0xba, 0x54, 0x70, 0x76,
+	0x83, 0x3c, 0x43, 0xe5, 0x53, 0x32, 0x0c, 0x3f, 0x76, 0x58, 0xe0, 0x78, 0x6b, 0x2c, 0x80, 0x07,
+	0x60, 0x83, 0x8c, 0x46, 0x98, 0x05, 0xf6, 0x9a, 0xc2, 0xec, 0x8e, 0x33, 0xb4, 0xde, 0x1e, 0x8d,
+	0xce, 0xc6, 0xae, 0x13, 0x69, 0x83, 0x47, 0xa0, 0xc6, 0x63, 0x36, 0x60, 0x11, 0x09, 0xf5, 0xca,
+	0x25, 0xf2, 0x9c, 0x42, 0x36, 0xc6, 0x19, 0xda, 0x3a, 0x36, 0xce, 0xa5, 0x7a, 0x5b, 0x7c, 0xde,
+	0x0b, 0x1f, 0x83, 0x5d, 0xfa, 0x5c, 0xd0, 0x28, 0xa0, 0x01, 0x1e, 0x11, 0x16, 0xcf, 0x28, 0xcf,
+	0x2b, 0xca, 0x9b, 0xe3, 0x0c, 0x55, 0x3e, 0x33, 0x11, 0xf7, 0x08, 0x8b, 0x15, 0xe3, 0xbe, 0x66,
+	0x5c, 0x8e, 0x74, 0xbc, 0x6d, 0x5a, 0x00, 0x4c, 0x94, 0x5a, 0x60, 0x9d, 0x3f, 0x8b, 0x68, 0x6c,
+	0xaf, 0x37, 0xac, 0x66, 0xb9, 0x73, 0x55, 0xae, 0xf2, 0x58, 0x1a, 0xf2, 0x0c, 0x5d, 0xd4, 0x7c,
+	0xca, 0xef, 0x78, 0x3a, 0x0e, 0x9e, 0x80, 0x32, 0x19, 0xf2, 0x34, 0x12, 0x98, 0x45, 0xf6, 0x86,
+	0x02, 0xdd, 0x7d, 0x99, 0xa1, 0xd2, 0x1f, 0x19, 0xba, 0x3e, 0x60, 0xe2, 0x71, 0xda, 0x73, 0x7d,
+	0x3e, 0x6c, 0x99, 0xea, 0xe8, 0x9f, 0x83, 0x24, 0x38, 0x69, 0x89, 0xd3, 0x11, 0x4d, 0xdc, 0x6e,
+	0x24, 0xc6, 0x19, 0xda, 0x6c, 0x2b, 0x8a, 0x6e, 0x94, 0x67, 0xa8, 0xaa, 0x55, 0xa6, 0xa4, 0x8e,
+	0xb7, 0x49, 0x8c, 0x17, 0x72, 0x00, 0x8c, 0x9d, 0xa7, 0xc2, 0xfe, 0x9f, 0x52, 0xbb, 0xb7, 0xb2,
+	0x5a, 0x59, 0xab, 0x1d, 0xa7, 0x22, 0xcf, 0x50, 0x6d, 0x4e, 0x8e, 0xa7, 0xc2, 0xf1, 0xcc, 0x82,
+	0x8e, 0x53, 0x01, 0xbf, 0xb7, 0x00, 0x4c, 0x47, 0x01, 0x11, 0x34, 0xc0, 0x05, 0xe5, 0x4d, 0xa5,
+	0x8c, 0x57, 0x56, 0xae, 0x3e, 0xd4, 0x5c, 0xc5, 0x04, 0xae, 0xea, 0x04, 0x16, 0x55, 0x1c, 0xaf,
+	0x9a, 0x9e, 0x09, 0x87, 0x9f, 0x80, 0x32, 0x8b, 0x98, 0x60, 0x44, 0xf0, 0xd8, 0x2e, 0xab, 0x2c,
+	0xf6, 0xe5, 0x8a, 0xba, 0x13, 0xe3, 0xac, 0x4c, 0x24, 0x18, 0xca, 0xcd, 0x9b, 0xc5, 0x43, 0x1f,
+	0x6c, 0xb3, 0x04, 0x93, 0xd4, 0x97, 0xe7, 0x0d, 0xfb, 0x7c, 0x38, 0x0a, 0xa9, 0xa0, 0x36, 0x68,
+	0x58, 0xcd, 0x4d, 0xd5, 0x42, 0xb5, 0x6e, 0xd2, 0xd6, 0xde, 0x43, 0xe3, 0xcc, 0x33, 0xb4, 0x67,
+	0xfa, 0x72, 0x11, 0xe9, 0x78, 0x35, 0x76, 0x16, 0x00, 0x87, 0x60, 0xb7, 0x10, 0xca, 0x22, 0x3c,
+	0x8a, 0xf9, 0x20, 0xa6, 0x49, 0x62, 0x5f, 0x50, 0x3a, 0x1f, 0x8d, 0x33, 0xb4, 0x3d, 0xd5, 0xe9,
+	0x46, 0xf7, 0x8c, 0x7b, 0xd6, 0xaf, 0xcb, 0xe1, 0x8e, 0xb7, 0xcd, 0x16, 0x51, 0xf0, 0x3b, 0x0b,
+	0xd4, 0xfc, 0x18, 0x13, 0x81, 0x0b, 0xb3, 0xc4, 0xbe, 0xa8, 0x76, 0xe6, 0x9b, 0x15, 0xea, 0x73,
+	0x8b, 0xfa, 0xf2, 0x58, 0x1e, 0xc6, 0x6d, 0x71, 0x34, 0x23, 0xca, 0x33, 0x64, 0xeb, 0xa4, 0x16,
+	0x34, 0x1c, 0x6f, 0xcb, 0x9f, 0x0f, 0x86, 0xbf, 0x5a, 0x00, 0xf9, 0x69, 0x1c, 0xd3, 0x48, 0x60,
+	0x9f, 0x87, 0x21, 0x11, 0x34, 0x26, 0x21, 0x4b, 0x94, 0x17, 0xc7, 0xf2, 0xc7, 0xbe, 0xa4, 0x32,
+	0x7b, 0xbe, 0x72, 0x66, 0xd7, 0x0e, 0x35, 0xf1, 0xa1, 0xe1, 0x9d, 0xd0, 0x7a, 0xf2, 0x6f, 0x9e,
+	0xa1, 0xeb, 0x26, 0xcd, 0xb7, 0xcb, 0x3b, 0xde, 0xbe, 0x3f, 0xcf, 0x43, 0xe6, 0x88, 0xe0, 0x2f,
+	0x16, 0xd8, 0x9b, 0x61, 0xb1, 0xe0, 0xb8, 0x47, 0x27, 0xd5, 0xa0, 0x81, 0x5d, 0x51, 0xd9, 0x47,
+	0x2b, 0x67, 0x7f, 0x65, 0x26, 0xf7, 0x80, 0x77, 0x68, 0x7b, 0x42, 0x98, 0x67, 0xe8, 0x1d, 0x93,
+	0xf8, 0x1b, 0x45, 0x1d, 0xef, 0x8a, 0xbf, 0x1c, 0x0d, 0x7f, 0xb0, 0xc0, 0xe5, 0x42, 0x49, 0xf0,
+	0xf4, 0x4e, 0xb0, 0xb7, 0x1a, 0x56, 0xf3, 0xc2, 0x07, 0x7b, 0xae, 0xbe, 0x35, 0xdc, 0xc9, 0xad,
+	0xe1, 0x3e, 0x98, 0x44, 0x74, 0x3e, 0x95, 0x8b, 0x18, 0x67, 0x68, 0xa7, 0x50, 0xc1, 0xa9, 0x37,
+	0xcf, 0xd0, 0x35, 0x9d, 0xd7, 0x52, 0x7a, 0xe7, 0xc5, 0x9f, 0xc8, 0xf2, 0x76, 0xc2, 0x25, 0x48,
+	0xf8, 0x10, 0x6c, 0x25, 0x34, 0x0c, 0x79, 0xbf, 0x8f, 0x1f, 0xb3, 0x44, 0xf0, 0xf8, 0xd4, 0xae,
+	0x36, 0xce, 0x35, 0xcb, 0x9d, 0xf7, 0xe5, 0x8c, 0xbe, 0x4f, 0xc3, 0xf0, 0xb8, 0xdf, 0xbf, 0xad,
+	0x3d, 0x79, 0x86, 0x76, 0xb5, 0xcc, 0x19, 0x88, 0xe3, 0x55, 0x8c, 0xc5, 0x44, 0xc2, 0x6f, 0x2d,
+	0xb0, 0xc3, 0x22, 0x41, 0x63, 0x9a, 0x08, 0x4c, 0x7c, 0x3f, 0x1d, 0xa6, 0x72, 0x4b, 0x02, 0xbb,
+	0xa6, 0x4a, 0x72, 0x67, 0xb5, 0x51, 0x94, 0x67, 0xe8, 0xff, 0xe6, 0xb0, 0x2d, 0xe1, 0x94, 0x47,
+	0xcd, 0x98, 0xdb, 0x33, 0x2b, 0x7c, 0x04, 0xaa, 0x3d, 0x1e, 0xc7, 0xfc, 0x19, 0x1e, 0x52, 0x41,
+	0x70, 0x40, 0x04, 0xb1, 0xa1, 0xda, 0xe7, 0xf7, 0xdc, 0x37, 0xdf, 0xe7, 0x6e, 0x47, 0x61, 0xee,
+	0x50, 0x41, 0x6e, 0x11, 0x41, 0x6e, 0x97, 0xbc, 0x4a, 0x6f, 0xce, 0x02, 0x7f, 0x94, 0x33, 0x36,
+	0x7a, 0xc6, 0xd3, 0x28, 0x28, 0xf4, 0xad, 0xbd, 0xad, 0xa8, 0xaf, 0xba, 0x3a, 0x7f, 0x57, 0xde,
+	0xeb, 0x53, 0xce, 0x43, 0xce, 0xa2, 0xce, 0x91, 0x5c, 0x73, 0x61, 0x80, 0x2e, 0x50, 0x38, 0xff,
+	0x64, 0xe8, 0xdd, 0xff, 0xb0, 0x21, 0x92, 0xcd, 0xab, 0x19, 0xfc, 0xac, 0x53, 0x21, 0x05, 0xd5,
+	0x42, 0x5b, 0x3e, 0x49, 0x69, 0x4a, 0xed, 0x9d, 0xc6, 0xb9, 0xb7, 0x67, 0x85, 0x4c, 0x56, 0x57,
+	0x16, 0xfa, 0x5a, 0x11, 0xc8, 0xb1, 0x31, 0x35, 0x7d, 0x29, 0x2d, 0x30, 0x02, 0x95, 0x80, 0xf6,
+	0x04, 0x8e, 0xa9, 0xcf, 0x9f, 0xd2, 0x98, 0x06, 0xf6, 0x65, 0x55, 0xd3, 0xcf, 0x57, 0xae, 0xe9,
+	0x65, 0xad, 0x39, 0xcf, 0xe6, 0x78, 0x97, 0xa4, 0xc1, 0x9b, 0x7c, 0xc3, 0xdb, 0xa0, 0xc6, 0x12,
+	0xdc, 0x23, 0xc9, 0x09, 0x15, 0x93, 0x63, 0x66, 0xef, 0xaa, 0xe1, 0x7c, 0x6d, 0x36, 0xf0, 0x16,
+	0x42, 0x1c, 0x6f, 0x8b, 0x25, 0x1d, 0x65, 0x32, 0x27, 0xb0, 0xb3, 0x01, 0xce, 0x9f, 0xb0, 0x28,
+	0x70, 0xf2, 0x35, 0x50, 0x99, 0x2f, 0x33, 0xdc, 0x07, 0x20, 0xa4, 0x51, 0xc0, 0xa2, 0x01, 0x9e,
+	0x3c, 0xb3, 0xbc, 0xb2, 0xb1, 0x74, 0x03, 0xd8, 0x04, 0x55, 0x96, 0xe0, 0x44, 0x90, 0x5e, 0x48,
+	0xb1, 0xee, 0x07, 0xf5, 0xae, 0xda, 0xf4, 0x2a, 0x2c, 0xb9, 0xaf, 0xcc, 0x9a, 0x10, 0x9e, 0x02,
+	0x38, 0x17, 0x26, 0x07, 0x19, 0x55, 0x2f, 0xa9, 0x72, 0xe7, 0x8b, 0xd5, 0x06, 0xd1, 0xac, 0x57,
+	0x16, 0x19, 0x1d, 0xaf, 0x9a, 0x14, 0x64, 0x3d, 0x22, 0x28, 0xfc, 0xc9, 0x02, 0x3b, 0xbd, 0x98,
+	0x05, 0x03, 0x79, 0x2d, 0x27, 0x89, 0xdc, 0x0a, 0x75, 0x11, 0xab, 0x47, 0xd7, 0x5b, 0x9b, 0xe0,
+	0xae, 0x69, 0x02, 0x73, 0xc8, 0x96, 0x91, 0xac, 0xd4, 0x9c, 0xd0, 0x30, 0xb4, 0x25, 0x81, 0x7e,
+	0x0d, 0x74, 0xbe, 0x7a, 0xf9, 0x77, 0xbd, 0xf4, 0xf3, 0xb8, 0x5e, 0x7a, 0x39, 0xae, 0x5b, 0xaf,
+	0xc6, 0x75, 0xeb, 0xaf, 0x71, 0xdd, 0x7a, 0xf1, 0xba, 0x5e, 0x7a, 0xf5, 0xba, 0x5e, 0xfa, 0xed,
+	0x75, 0xbd, 0xf4, 0xf5, 0x87, 0x73, 0xf4, 0xf2, 0x80, 0x1e, 0xf0, 0x7e, 0x9f, 0xf9, 0x8c, 0x84,
+	0xe6, 0xbb, 0x35, 0xff, 0x62, 0x57, 0x8a, 0xbd, 0x0d, 0x35, 0x2d, 0x6f, 0xfe, 0x3b, 0x00, 0xa2,
+	0x25, 0x7e, 0x3d, 0xd4, 0x0b, 0x00, 0x00,
 }
 
 func (m *LockedVault) Marshal() (dAtA []byte, err error) {
@@ -263,6 +282,58 @@ func (m *LockedVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsBasketAuction {
+		i--
+		if m.IsBasketAuction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size := m.DebtRecovered.Size()
+		i -= size
+		if _, err := m.DebtRecovered.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLockedVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.CollateralQueue) > 0 {
+		for iNdEx := len(m.CollateralQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollateralQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockedVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	{
+		size, err := m.UnwoundCollateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLockedVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
 	if m.Kind != nil {
 		{
 			size := m.Kind.Size()
@@ -295,12 +366,12 @@ func (m *LockedVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x82
 		}
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LiquidationTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LiquidationTimestamp):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LiquidationTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LiquidationTimestamp):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintLockedVault(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintLockedVault(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x7a
 	{
@@ -569,6 +640,19 @@ func (m *LockedVault) Size() (n int) {
 	if m.Kind != nil {
 		n += m.Kind.Size()
 	}
+	l = m.UnwoundCollateral.Size()
+	n += 2 + l + sovLockedVault(uint64(l))
+	if len(m.CollateralQueue) > 0 {
+		for _, e := range m.CollateralQueue {
+			l = e.Size()
+			n += 2 + l + sovLockedVault(uint64(l))
+		}
+	}
+	l = m.DebtRecovered.Size()
+	n += 2 + l + sovLockedVault(uint64(l))
+	if m.IsBasketAuction {
+		n += 3
+	}
 	return n
 }
 
@@ -1156,6 +1240,127 @@ func (m *LockedVault) Unmarshal(dAtA []byte) error {
 			}
 			m.Kind = &LockedVault_BorrowMetaData{v}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnwoundCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockedVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockedVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockedVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnwoundCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockedVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockedVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockedVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralQueue = append(m.CollateralQueue, types.Coin{})
+			if err := m.CollateralQueue[len(m.CollateralQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtRecovered", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockedVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockedVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockedVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtRecovered.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBasketAuction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockedVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBasketAuction = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLockedVault(dAtA[iNdEx:])
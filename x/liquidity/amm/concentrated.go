@@ -0,0 +1,413 @@
+package amm
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/comdex-official/comdex/types"
+)
+
+var _ Pool = (*ConcentratedPool)(nil)
+
+// ConcentratedLiquidity is a liquidity provided within a price range
+// [MinPrice, MaxPrice] by a single position of a concentrated pool.
+type ConcentratedLiquidity struct {
+	Liquidity          sdk.Dec
+	MinPrice, MaxPrice sdk.Dec
+}
+
+// ConcentratedPool is the pool type which aggregates liquidities of
+// positions with their own price ranges.
+// The pool's price is kept in the pool's state and moves along the liquidity
+// curve of the positions only as the pool pays out its reserve, so coins sent
+// to the reserve directly don't move it.
+type ConcentratedPool struct {
+	rx, ry  sdk.Int
+	feeRate sdk.Dec
+	// totalLiquidity is the sum of all positions' liquidity.
+	totalLiquidity sdk.Dec
+	// sqrtPrices are the sorted boundaries of the liquidity curve in
+	// sqrt price, and liquidities[i] is the active liquidity between
+	// sqrtPrices[i] and sqrtPrices[i+1].
+	sqrtPrices  []sdk.Dec
+	liquidities []sdk.Dec
+	// xs[i] and ys[i] are the amount of x/y coin the positions hold
+	// when the pool price is at sqrtPrices[i].
+	xs, ys    []sdk.Dec
+	sqrtPrice sdk.Dec
+}
+
+// NewConcentratedPool returns a new ConcentratedPool at the price
+// sqrtPrice^2.
+func NewConcentratedPool(rx, ry sdk.Int, liquidities []ConcentratedLiquidity, feeRate, sqrtPrice sdk.Dec) *ConcentratedPool {
+	type boundary struct {
+		sqrtPrice sdk.Dec
+		delta     sdk.Dec
+	}
+	totalLiquidity := sdk.ZeroDec()
+	var boundaries []boundary
+	for _, l := range liquidities {
+		if !l.Liquidity.IsPositive() {
+			continue
+		}
+		totalLiquidity = totalLiquidity.Add(l.Liquidity)
+		boundaries = append(boundaries,
+			boundary{utils.DecApproxSqrt(l.MinPrice), l.Liquidity},
+			boundary{utils.DecApproxSqrt(l.MaxPrice), l.Liquidity.Neg()})
+	}
+	sort.SliceStable(boundaries, func(i, j int) bool {
+		return boundaries[i].sqrtPrice.LT(boundaries[j].sqrtPrice)
+	})
+
+	pool := &ConcentratedPool{
+		rx:             rx,
+		ry:             ry,
+		feeRate:        feeRate,
+		totalLiquidity: totalLiquidity,
+		sqrtPrice:      sqrtPrice,
+	}
+	active := sdk.ZeroDec()
+	for _, b := range boundaries {
+		if n := len(pool.sqrtPrices); n > 0 && pool.sqrtPrices[n-1].Equal(b.sqrtPrice) {
+			active = active.Add(b.delta)
+			pool.liquidities[n-1] = active
+			continue
+		}
+		active = active.Add(b.delta)
+		pool.sqrtPrices = append(pool.sqrtPrices, b.sqrtPrice)
+		pool.liquidities = append(pool.liquidities, active)
+	}
+
+	n := len(pool.sqrtPrices)
+	pool.xs = make([]sdk.Dec, n)
+	pool.ys = make([]sdk.Dec, n)
+	if n > 0 {
+		pool.xs[0] = sdk.ZeroDec()
+		for i := 1; i < n; i++ {
+			// x += L * (sqrt(P_i) - sqrt(P_{i-1}))
+			pool.xs[i] = pool.xs[i-1].Add(pool.liquidities[i-1].Mul(pool.sqrtPrices[i].Sub(pool.sqrtPrices[i-1])))
+		}
+		pool.ys[n-1] = sdk.ZeroDec()
+		for i := n - 2; i >= 0; i-- {
+			// y += L * (1/sqrt(P_i) - 1/sqrt(P_{i+1}))
+			pool.ys[i] = pool.ys[i+1].Add(pool.liquidities[i].Mul(inv(pool.sqrtPrices[i]).Sub(inv(pool.sqrtPrices[i+1]))))
+		}
+	}
+	return pool
+}
+
+// ValidateConcentratedRange validates the price range of a concentrated
+// pool's position.
+func ValidateConcentratedRange(minPrice, maxPrice sdk.Dec) error {
+	if minPrice.LT(MinPoolPrice) {
+		return fmt.Errorf("min price must not be lower than %s", MinPoolPrice)
+	}
+	if maxPrice.GT(MaxPoolPrice) {
+		return fmt.Errorf("max price must not be higher than %s", MaxPoolPrice)
+	}
+	if !maxPrice.GT(minPrice) {
+		return fmt.Errorf("max price must be higher than min price")
+	}
+	if maxPrice.Sub(minPrice).Quo(minPrice).LT(MinRangedPoolPriceGapRatio) {
+		return fmt.Errorf("min price and max price are too close")
+	}
+	return nil
+}
+
+// ConcentratedLiquidityAmounts returns the amount of x and y coin the
+// liquidity within [minPrice, maxPrice] holds when the pool price is price.
+func ConcentratedLiquidityAmounts(liquidity, minPrice, maxPrice, price sdk.Dec) (x, y sdk.Dec) {
+	sqrtM := utils.DecApproxSqrt(minPrice)
+	sqrtL := utils.DecApproxSqrt(maxPrice)
+	sqrtP := sdk.MinDec(sdk.MaxDec(utils.DecApproxSqrt(price), sqrtM), sqrtL)
+	x = liquidity.Mul(sqrtP.Sub(sqrtM))           // L * (sqrt(P) - sqrt(M))
+	y = liquidity.Mul(inv(sqrtP).Sub(inv(sqrtL))) // L * (1/sqrt(P) - 1/sqrt(L))
+	return
+}
+
+// ConcentratedDeposit returns the liquidity minted and the accepted x and y
+// coin amount when someone deposits at most x and y coins into the price
+// range [minPrice, maxPrice] while the pool price is price.
+func ConcentratedDeposit(x, y sdk.Int, minPrice, maxPrice, price sdk.Dec) (liquidity sdk.Dec, ax, ay sdk.Int) {
+	liquidity, ax, ay = sdk.ZeroDec(), zeroInt, zeroInt
+	utils.SafeMath(func() {
+		sqrtM := utils.DecApproxSqrt(minPrice)
+		sqrtL := utils.DecApproxSqrt(maxPrice)
+		sqrtP := sdk.MinDec(sdk.MaxDec(utils.DecApproxSqrt(price), sqrtM), sqrtL)
+		dx := sqrtP.Sub(sqrtM)           // sqrt(P) - sqrt(M)
+		dy := inv(sqrtP).Sub(inv(sqrtL)) // 1/sqrt(P) - 1/sqrt(L)
+		switch {
+		case !dx.IsPositive(): // single y asset position
+			liquidity = y.ToDec().QuoTruncate(dy)
+		case !dy.IsPositive(): // single x asset position
+			liquidity = x.ToDec().QuoTruncate(dx)
+		default:
+			liquidity = sdk.MinDec(x.ToDec().QuoTruncate(dx), y.ToDec().QuoTruncate(dy))
+		}
+		if dx.IsPositive() {
+			ax = sdk.MinInt(liquidity.Mul(dx).Ceil().TruncateInt(), x)
+		}
+		if dy.IsPositive() {
+			ay = sdk.MinInt(liquidity.Mul(dy).Ceil().TruncateInt(), y)
+		}
+	}, func() {
+		liquidity, ax, ay = sdk.ZeroDec(), zeroInt, zeroInt
+	})
+	return
+}
+
+// ConcentratedWithdraw returns the withdrawn x and y coin amount when
+// someone withdraws liquidity from the price range [minPrice, maxPrice]
+// while the pool price is price.
+func ConcentratedWithdraw(liquidity, minPrice, maxPrice, price sdk.Dec) (x, y sdk.Int) {
+	xDec, yDec := ConcentratedLiquidityAmounts(liquidity, minPrice, maxPrice, price)
+	return xDec.TruncateInt(), yDec.TruncateInt()
+}
+
+// ConcentratedInRange returns whether the price range [minPrice, maxPrice]
+// is active when the pool price is sqrtPrice^2.
+func ConcentratedInRange(minPrice, maxPrice, sqrtPrice sdk.Dec) bool {
+	return utils.DecApproxSqrt(minPrice).LTE(sqrtPrice) && sqrtPrice.LT(utils.DecApproxSqrt(maxPrice))
+}
+
+// Balances returns the balances of the pool.
+func (pool *ConcentratedPool) Balances() (rx, ry sdk.Int) {
+	return pool.rx, pool.ry
+}
+
+// SetBalances sets ConcentratedPool's balances.
+// The pool price moves along the liquidity curve by the amount of x or y
+// coin the pool paid out, while an increase of the balances doesn't move it.
+func (pool *ConcentratedPool) SetBalances(rx, ry sdk.Int, _ bool) {
+	switch {
+	case rx.LT(pool.rx):
+		pool.Swap(Buy, pool.rx.Sub(rx))
+	case ry.LT(pool.ry):
+		pool.Swap(Sell, pool.ry.Sub(ry))
+	}
+	pool.rx = rx
+	pool.ry = ry
+}
+
+// Swap moves the pool price along the liquidity curve as the pool pays out
+// paid amount of x coin when it buys, or y coin when it sells, and returns
+// the amount of the opposite coin the curve takes in return.
+// What the pool receives beyond the returned amount is the swap fee.
+func (pool *ConcentratedPool) Swap(dir OrderDirection, paid sdk.Int) (required sdk.Int) {
+	if len(pool.sqrtPrices) == 0 || !paid.IsPositive() {
+		return zeroInt
+	}
+	x, y := pool.amountsAt(pool.sqrtPrice)
+	switch dir {
+	case Buy:
+		pool.sqrtPrice = pool.sqrtPriceForX(sdk.MaxDec(x.Sub(paid.ToDec()), sdk.ZeroDec()))
+		_, newY := pool.amountsAt(pool.sqrtPrice)
+		required = newY.Sub(y).Ceil().TruncateInt()
+	case Sell:
+		pool.sqrtPrice = pool.sqrtPriceForY(sdk.MaxDec(y.Sub(paid.ToDec()), sdk.ZeroDec()))
+		newX, _ := pool.amountsAt(pool.sqrtPrice)
+		required = newX.Sub(x).Ceil().TruncateInt()
+	}
+	if required.IsNegative() {
+		return zeroInt
+	}
+	return required
+}
+
+// SqrtPrice returns the square root of the pool price.
+func (pool *ConcentratedPool) SqrtPrice() sdk.Dec {
+	return pool.sqrtPrice
+}
+
+// ActiveLiquidity returns the liquidity of the positions in range at the
+// current pool price.
+func (pool *ConcentratedPool) ActiveLiquidity() sdk.Dec {
+	n := len(pool.sqrtPrices)
+	if n == 0 || pool.sqrtPrice.LT(pool.sqrtPrices[0]) || pool.sqrtPrice.GTE(pool.sqrtPrices[n-1]) {
+		return sdk.ZeroDec()
+	}
+	i := sort.Search(n, func(i int) bool {
+		return pool.sqrtPrices[i].GT(pool.sqrtPrice)
+	}) - 1
+	return pool.liquidities[i]
+}
+
+// sqrtPriceForX returns the sqrt price where the positions hold x amount of
+// x coin in total.
+// When there's a price range without liquidity matching x, the lowest price
+// of the range is returned.
+func (pool *ConcentratedPool) sqrtPriceForX(x sdk.Dec) sdk.Dec {
+	n := len(pool.sqrtPrices)
+	if n == 0 {
+		return sdk.Dec{}
+	}
+	i := sort.Search(n, func(i int) bool {
+		return pool.xs[i].GTE(x)
+	})
+	switch {
+	case i == 0:
+		return pool.sqrtPrices[0]
+	case i == n:
+		return pool.sqrtPrices[n-1]
+	}
+	// sqrt(P) = sqrt(P_k) + (x - x_k) / L
+	return pool.sqrtPrices[i-1].Add(x.Sub(pool.xs[i-1]).Quo(pool.liquidities[i-1]))
+}
+
+// sqrtPriceForY returns the sqrt price where the positions hold y amount of
+// y coin in total.
+// When there's a price range without liquidity matching y, the lowest price
+// of the range is returned.
+func (pool *ConcentratedPool) sqrtPriceForY(y sdk.Dec) sdk.Dec {
+	n := len(pool.sqrtPrices)
+	if n == 0 {
+		return sdk.Dec{}
+	}
+	i := sort.Search(n, func(i int) bool {
+		return pool.ys[i].LTE(y)
+	})
+	if i == 0 {
+		return pool.sqrtPrices[0]
+	}
+	// 1/sqrt(P) = 1/sqrt(P_k) + (y - y_k) / L
+	return inv(inv(pool.sqrtPrices[i]).Add(y.Sub(pool.ys[i]).Quo(pool.liquidities[i-1])))
+}
+
+// amountsAt returns the amount of x and y coin all positions hold in total
+// when the pool price is sqrtPrice^2.
+func (pool *ConcentratedPool) amountsAt(sqrtPrice sdk.Dec) (x, y sdk.Dec) {
+	n := len(pool.sqrtPrices)
+	if n == 0 {
+		return sdk.ZeroDec(), sdk.ZeroDec()
+	}
+	if sqrtPrice.LTE(pool.sqrtPrices[0]) {
+		return sdk.ZeroDec(), pool.ys[0]
+	}
+	if sqrtPrice.GTE(pool.sqrtPrices[n-1]) {
+		return pool.xs[n-1], sdk.ZeroDec()
+	}
+	i := sort.Search(n, func(i int) bool {
+		return pool.sqrtPrices[i].GT(sqrtPrice)
+	}) - 1
+	l := pool.liquidities[i]
+	x = pool.xs[i].Add(l.Mul(sqrtPrice.Sub(pool.sqrtPrices[i])))
+	y = pool.ys[i+1].Add(l.Mul(inv(sqrtPrice).Sub(inv(pool.sqrtPrices[i+1]))))
+	return
+}
+
+// PoolCoinSupply returns the total liquidity of the pool's positions, since
+// a concentrated pool doesn't have a fungible pool coin.
+func (pool *ConcentratedPool) PoolCoinSupply() sdk.Int {
+	return pool.totalLiquidity.TruncateInt()
+}
+
+// FeeRate returns the fee rate of the pool, which is the spread the pool
+// takes from its curve when placing orders.
+func (pool *ConcentratedPool) FeeRate() sdk.Dec {
+	return pool.feeRate
+}
+
+// Price returns the pool price.
+func (pool *ConcentratedPool) Price() sdk.Dec {
+	if pool.IsDepleted() {
+		panic("pool price is not defined for a depleted pool")
+	}
+	return pool.sqrtPrice.Power(2)
+}
+
+// IsDepleted returns whether the pool is depleted or not.
+func (pool *ConcentratedPool) IsDepleted() bool {
+	return !pool.totalLiquidity.IsPositive() || pool.sqrtPrice.IsNil() || !pool.sqrtPrice.IsPositive() ||
+		(pool.rx.IsZero() && pool.ry.IsZero())
+}
+
+// HighestBuyPrice returns the highest buy price of the pool.
+func (pool *ConcentratedPool) HighestBuyPrice() (price sdk.Dec, found bool) {
+	return pool.Price().Quo(oneDec.Add(pool.feeRate)), true
+}
+
+// LowestSellPrice returns the lowest sell price of the pool.
+func (pool *ConcentratedPool) LowestSellPrice() (price sdk.Dec, found bool) {
+	return pool.Price().Mul(oneDec.Add(pool.feeRate)), true
+}
+
+// BuyAmountOver returns the amount of buy orders for price greater than
+// or equal to given price.
+// The pool buys only the amount its curve would buy at price * (1 + feeRate).
+func (pool *ConcentratedPool) BuyAmountOver(price sdk.Dec, _ bool) (amt sdk.Int) {
+	curvePrice := price.Mul(oneDec.Add(pool.feeRate))
+	if curvePrice.GTE(pool.Price()) {
+		return zeroInt
+	}
+	heldX, _ := pool.amountsAt(pool.sqrtPrice)
+	x, _ := pool.amountsAt(utils.DecApproxSqrt(curvePrice))
+	dx := heldX.Sub(x)
+	if !dx.IsPositive() {
+		return zeroInt
+	} else if dx.GT(pool.rx.ToDec()) {
+		dx = pool.rx.ToDec()
+	}
+	utils.SafeMath(func() {
+		amt = dx.QuoTruncate(price).TruncateInt() // dy = dx / P
+		if amt.GT(MaxCoinAmount) {
+			amt = MaxCoinAmount
+		}
+	}, func() {
+		amt = MaxCoinAmount
+	})
+	return
+}
+
+// SellAmountUnder returns the amount of sell orders for price less than
+// or equal to given price.
+// The pool sells only the amount its curve would sell at price / (1 + feeRate).
+func (pool *ConcentratedPool) SellAmountUnder(price sdk.Dec, _ bool) (amt sdk.Int) {
+	curvePrice := price.Quo(oneDec.Add(pool.feeRate))
+	if curvePrice.LTE(pool.Price()) {
+		return zeroInt
+	}
+	_, heldY := pool.amountsAt(pool.sqrtPrice)
+	_, y := pool.amountsAt(utils.DecApproxSqrt(curvePrice))
+	amt = heldY.Sub(y).TruncateInt()
+	if amt.GT(pool.ry) {
+		amt = pool.ry
+	}
+	if !amt.IsPositive() {
+		return zeroInt
+	}
+	return
+}
+
+// BuyAmountTo returns the amount of buy orders of the pool for price,
+// where BuyAmountTo is used when the pool price is higher than the highest
+// price of the order book.
+// Since BuyAmountOver already follows the pool's curve, they are the same.
+func (pool *ConcentratedPool) BuyAmountTo(price sdk.Dec) sdk.Int {
+	return pool.BuyAmountOver(price, true)
+}
+
+// SellAmountTo returns the amount of sell orders of the pool for price,
+// where SellAmountTo is used when the pool price is lower than the lowest
+// price of the order book.
+// Since SellAmountUnder already follows the pool's curve, they are the same.
+func (pool *ConcentratedPool) SellAmountTo(price sdk.Dec) sdk.Int {
+	return pool.SellAmountUnder(price, true)
+}
+
+func (pool *ConcentratedPool) Clone() Pool {
+	// The liquidity curve is never modified after construction,
+	// so it is safe to share it between clones.
+	return &ConcentratedPool{
+		rx:             pool.rx,
+		ry:             pool.ry,
+		feeRate:        pool.feeRate,
+		totalLiquidity: pool.totalLiquidity,
+		sqrtPrices:     pool.sqrtPrices,
+		liquidities:    pool.liquidities,
+		xs:             pool.xs,
+		ys:             pool.ys,
+		sqrtPrice:      pool.sqrtPrice,
+	}
+}
@@ -0,0 +1,198 @@
+package amm_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/comdex-official/comdex/types"
+	"github.com/comdex-official/comdex/x/liquidity/amm"
+)
+
+func TestValidateConcentratedRange(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		minPrice sdk.Dec
+		maxPrice sdk.Dec
+		expErr   string
+	}{
+		{"happy case", utils.ParseDec("0.5"), utils.ParseDec("2.0"), ""},
+		{"too low min price", utils.ParseDec("0.0000000000000001"), utils.ParseDec("2.0"), "min price must not be lower than 0.000000000000001000"},
+		{"too high max price", utils.ParseDec("0.5"), utils.ParseDec("1000000000000000000000"), "max price must not be higher than 100000000000000000000.000000000000000000"},
+		{"min price higher than max price", utils.ParseDec("2.0"), utils.ParseDec("0.5"), "max price must be higher than min price"},
+		{"too close prices", utils.ParseDec("1.0"), utils.ParseDec("1.0001"), "min price and max price are too close"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := amm.ValidateConcentratedRange(tc.minPrice, tc.maxPrice)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestConcentratedDeposit(t *testing.T) {
+	for _, tc := range []struct {
+		name               string
+		x, y               sdk.Int
+		minPrice, maxPrice sdk.Dec
+		price              sdk.Dec
+		ax, ay             sdk.Int
+	}{
+		{
+			"in range",
+			sdk.NewInt(1000000), sdk.NewInt(1000000),
+			utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"),
+			sdk.NewInt(1000000), sdk.NewInt(1000000),
+		},
+		{
+			"price below range",
+			sdk.NewInt(1000000), sdk.NewInt(1000000),
+			utils.ParseDec("2.0"), utils.ParseDec("4.0"), utils.ParseDec("1.0"),
+			sdk.ZeroInt(), sdk.NewInt(1000000),
+		},
+		{
+			"price above range",
+			sdk.NewInt(1000000), sdk.NewInt(1000000),
+			utils.ParseDec("0.25"), utils.ParseDec("0.5"), utils.ParseDec("1.0"),
+			sdk.NewInt(1000000), sdk.ZeroInt(),
+		},
+		{
+			"limited by y",
+			sdk.NewInt(1000000), sdk.NewInt(500000),
+			utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"),
+			sdk.NewInt(500000), sdk.NewInt(500000),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			liquidity, ax, ay := amm.ConcentratedDeposit(tc.x, tc.y, tc.minPrice, tc.maxPrice, tc.price)
+			require.True(t, liquidity.IsPositive())
+			require.True(sdk.IntEq(t, tc.ax, ax))
+			require.True(sdk.IntEq(t, tc.ay, ay))
+
+			// Withdrawing the whole liquidity right away never gives out more
+			// than deposited.
+			x, y := amm.ConcentratedWithdraw(liquidity, tc.minPrice, tc.maxPrice, tc.price)
+			require.True(t, x.LTE(ax))
+			require.True(t, y.LTE(ay))
+			require.True(t, x.GTE(ax.SubRaw(1)))
+			require.True(t, y.GTE(ay.SubRaw(1)))
+		})
+	}
+}
+
+func TestConcentratedPool_Price(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 100; i++ {
+		price := utils.RandomDec(r, utils.ParseDec("0.5"), utils.ParseDec("2.0"))
+		var liquidities []amm.ConcentratedLiquidity
+		rx, ry := sdk.ZeroInt(), sdk.ZeroInt()
+		for j := 0; j < 5; j++ {
+			minPrice := utils.RandomDec(r, utils.ParseDec("0.1"), utils.ParseDec("1.0"))
+			maxPrice := utils.RandomDec(r, minPrice.Mul(utils.ParseDec("1.01")), utils.ParseDec("10.0"))
+			liquidity, ax, ay := amm.ConcentratedDeposit(
+				sdk.NewInt(100_000000), sdk.NewInt(100_000000), minPrice, maxPrice, price)
+			liquidities = append(liquidities, amm.ConcentratedLiquidity{
+				Liquidity: liquidity,
+				MinPrice:  minPrice,
+				MaxPrice:  maxPrice,
+			})
+			rx, ry = rx.Add(ax), ry.Add(ay)
+		}
+		pool := amm.NewConcentratedPool(rx, ry, liquidities, sdk.ZeroDec(), utils.DecApproxSqrt(price))
+		require.False(t, pool.IsDepleted())
+		require.True(t, utils.DecApproxEqual(price, pool.Price()))
+
+		// Coins sent to the reserve don't move the price.
+		pool.SetBalances(rx.MulRaw(2), ry.MulRaw(2), true)
+		require.True(t, utils.DecApproxEqual(price, pool.Price()))
+
+		// Paying out x coin moves the price down along the curve, and paying
+		// back the y coin it took moves it back.
+		required := pool.Swap(amm.Buy, rx.QuoRaw(10))
+		require.True(t, required.IsPositive())
+		require.True(t, pool.Price().LT(price))
+		pool.Swap(amm.Sell, required)
+		require.True(t, utils.DecApproxEqual(price, pool.Price()))
+	}
+}
+
+func TestConcentratedPool_IsDepleted(t *testing.T) {
+	pool := amm.NewConcentratedPool(sdk.ZeroInt(), sdk.ZeroInt(), nil, sdk.ZeroDec(), sdk.OneDec())
+	require.True(t, pool.IsDepleted())
+
+	liquidity, ax, ay := amm.ConcentratedDeposit(
+		sdk.NewInt(1000000), sdk.NewInt(1000000), utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"))
+	pool = amm.NewConcentratedPool(ax, ay, []amm.ConcentratedLiquidity{
+		{Liquidity: liquidity, MinPrice: utils.ParseDec("0.5"), MaxPrice: utils.ParseDec("2.0")},
+	}, sdk.ZeroDec(), sdk.OneDec())
+	require.False(t, pool.IsDepleted())
+	require.True(sdk.DecEq(t, liquidity, pool.ActiveLiquidity()))
+	require.True(sdk.DecEq(t, liquidity.TruncateDec(), pool.PoolCoinSupply().ToDec()))
+}
+
+func TestConcentratedPool_FeeRate(t *testing.T) {
+	minPrice, maxPrice := utils.ParseDec("0.5"), utils.ParseDec("2.0")
+	liquidity, ax, ay := amm.ConcentratedDeposit(
+		sdk.NewInt(1_000000000), sdk.NewInt(1_000000000), minPrice, maxPrice, utils.ParseDec("1.0"))
+	liquidities := []amm.ConcentratedLiquidity{
+		{Liquidity: liquidity, MinPrice: minPrice, MaxPrice: maxPrice},
+	}
+	noFeePool := amm.NewConcentratedPool(ax, ay, liquidities, sdk.ZeroDec(), sdk.OneDec())
+	feePool := amm.NewConcentratedPool(ax, ay, liquidities, utils.ParseDec("0.003"), sdk.OneDec())
+
+	highest, found := feePool.HighestBuyPrice()
+	require.True(t, found)
+	require.True(t, highest.LT(feePool.Price()))
+	lowest, found := feePool.LowestSellPrice()
+	require.True(t, found)
+	require.True(t, lowest.GT(feePool.Price()))
+
+	price := utils.ParseDec("0.9")
+	require.True(t, feePool.BuyAmountOver(price, true).LT(noFeePool.BuyAmountOver(price, true)))
+	price = utils.ParseDec("1.1")
+	require.True(t, feePool.SellAmountUnder(price, true).LT(noFeePool.SellAmountUnder(price, true)))
+
+	// Prices within the spread don't produce orders.
+	require.True(t, feePool.BuyAmountOver(utils.ParseDec("0.999"), true).IsZero())
+	require.True(t, feePool.SellAmountUnder(utils.ParseDec("1.001"), true).IsZero())
+}
+
+func TestConcentratedPool_exhaust(t *testing.T) {
+	minPrice, maxPrice := utils.ParseDec("0.5"), utils.ParseDec("2.0")
+	price := utils.ParseDec("1.0")
+	var liquidities []amm.ConcentratedLiquidity
+	rx, ry := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, r := range []struct{ minPrice, maxPrice sdk.Dec }{
+		{minPrice, maxPrice},
+		{utils.ParseDec("0.8"), utils.ParseDec("1.25")},
+		{utils.ParseDec("1.5"), maxPrice},
+	} {
+		liquidity, ax, ay := amm.ConcentratedDeposit(
+			sdk.NewInt(1_000000000), sdk.NewInt(1_000000000), r.minPrice, r.maxPrice, price)
+		liquidities = append(liquidities, amm.ConcentratedLiquidity{
+			Liquidity: liquidity,
+			MinPrice:  r.minPrice,
+			MaxPrice:  r.maxPrice,
+		})
+		rx, ry = rx.Add(ax), ry.Add(ay)
+	}
+	pool := amm.NewConcentratedPool(rx, ry, liquidities, sdk.ZeroDec(), sdk.OneDec())
+
+	orders := amm.PoolSellOrders(pool, amm.DefaultOrderer, minPrice, maxPrice, 4)
+	amt := amm.TotalAmount(orders)
+	require.True(t, amt.LTE(ry))
+	require.True(t, amt.GTE(ry.ToDec().Mul(utils.ParseDec("0.99")).TruncateInt()))
+	orders = amm.PoolBuyOrders(pool, amm.DefaultOrderer, minPrice, maxPrice, 4)
+	x := sdk.ZeroInt()
+	for _, order := range orders {
+		x = x.Add(order.GetPrice().MulInt(order.GetAmount()).TruncateInt())
+	}
+	require.True(t, x.LTE(rx))
+	require.True(t, x.GTE(rx.ToDec().Mul(utils.ParseDec("0.99")).TruncateInt()))
+}
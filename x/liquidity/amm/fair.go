@@ -0,0 +1,69 @@
+package amm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/comdex-official/comdex/types"
+)
+
+// FairReserves returns the reserves the pool would hold if its price were
+// moved to price along its own curve, as arbitrageurs would do when price is
+// the market price.
+// Unlike the pool's actual reserves, the fair reserves depend only on the
+// pool's invariant and the given price, so trading against the pool can't
+// move the value they represent.
+// A RangedPool's price is capped within the pool's price range.
+func FairReserves(pool Pool, price sdk.Dec) (rx, ry sdk.Dec) {
+	var transX, transY sdk.Dec
+	switch pool := pool.(type) {
+	case *RangedPool:
+		transX, transY = pool.Translation()
+		if price.LT(pool.MinPrice()) {
+			price = pool.MinPrice()
+		} else if price.GT(pool.MaxPrice()) {
+			price = pool.MaxPrice()
+		}
+	default:
+		transX, transY = sdk.ZeroDec(), sdk.ZeroDec()
+	}
+
+	x, y := compositeReserves(pool)
+	utils.SafeMath(func() {
+		// k = x * y, x' = sqrt(k * P), y' = sqrt(k / P)
+		k := x.Mul(y)
+		rx = utils.DecApproxSqrt(k.Mul(price)).Sub(transX)
+		ry = utils.DecApproxSqrt(k.Quo(price)).Sub(transY)
+		if rx.IsNegative() {
+			rx = sdk.ZeroDec()
+		}
+		if ry.IsNegative() {
+			ry = sdk.ZeroDec()
+		}
+	}, func() {
+		rx, ry = sdk.ZeroDec(), sdk.ZeroDec()
+	})
+	return
+}
+
+// WeightedFairValue returns the value of a weighted pool with balances bs and
+// weights ws, when an asset's unit value is given in values.
+// It is the value of the pool once its balances are aligned with the values,
+// that is, k * prod((v_i * W / w_i) ^ (w_i / W)) where k is the pool's
+// invariant prod(b_i ^ (w_i / W)).
+func WeightedFairValue(bs []sdk.Int, ws []uint64, values []sdk.Dec) (value sdk.Dec) {
+	totalWeight := uint64(0)
+	for _, w := range ws {
+		totalWeight += w
+	}
+	utils.SafeMath(func() {
+		value = oneDec
+		for i, b := range bs {
+			// (b_i * v_i * W / w_i) ^ (w_i / W)
+			base := b.ToDec().Mul(values[i]).MulInt64(int64(totalWeight)).QuoInt64(int64(ws[i]))
+			value = value.Mul(DecPowFrac(base, ws[i], totalWeight))
+		}
+	}, func() {
+		value = sdk.ZeroDec()
+	})
+	return
+}
@@ -0,0 +1,68 @@
+package amm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/comdex-official/comdex/types"
+	"github.com/comdex-official/comdex/x/liquidity/amm"
+)
+
+func TestFairReserves(t *testing.T) {
+	pool := amm.NewBasicPool(sdk.NewInt(1000_000000), sdk.NewInt(1000_000000), sdk.ZeroInt())
+
+	// At the pool's own price, the fair reserves are the actual reserves.
+	rx, ry := amm.FairReserves(pool, utils.ParseDec("1.0"))
+	require.True(t, utils.DecApproxEqual(utils.ParseDec("1000000000"), rx))
+	require.True(t, utils.DecApproxEqual(utils.ParseDec("1000000000"), ry))
+
+	// The invariant is kept and the ratio follows the given price.
+	rx, ry = amm.FairReserves(pool, utils.ParseDec("4.0"))
+	require.True(t, utils.DecApproxEqual(utils.ParseDec("2000000000"), rx))
+	require.True(t, utils.DecApproxEqual(utils.ParseDec("500000000"), ry))
+
+	// Manipulating the pool's spot price doesn't change the fair reserves.
+	manipulated := amm.NewBasicPool(sdk.NewInt(4000_000000), sdk.NewInt(250_000000), sdk.ZeroInt())
+	rx, ry = amm.FairReserves(manipulated, utils.ParseDec("1.0"))
+	require.True(t, utils.DecApproxEqual(utils.ParseDec("1000000000"), rx))
+	require.True(t, utils.DecApproxEqual(utils.ParseDec("1000000000"), ry))
+}
+
+func TestFairReserves_RangedPool(t *testing.T) {
+	pool, err := amm.CreateRangedPool(
+		sdk.NewInt(1000_000000), sdk.NewInt(1000_000000),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"))
+	require.NoError(t, err)
+	rx, ry := pool.Balances()
+
+	fx, fy := amm.FairReserves(pool, pool.Price())
+	require.True(t, utils.DecApproxEqual(rx.ToDec(), fx))
+	require.True(t, utils.DecApproxEqual(ry.ToDec(), fy))
+
+	// Beyond the price range, the pool holds only one side.
+	fx, fy = amm.FairReserves(pool, utils.ParseDec("10.0"))
+	require.True(t, fx.IsPositive())
+	require.True(t, fy.IsZero() || fy.LT(utils.ParseDec("1")))
+	fx, fy = amm.FairReserves(pool, utils.ParseDec("0.1"))
+	require.True(t, fx.IsZero() || fx.LT(utils.ParseDec("1")))
+	require.True(t, fy.IsPositive())
+}
+
+func TestWeightedFairValue(t *testing.T) {
+	// A balanced 80/20 pool is valued at its balances.
+	value := amm.WeightedFairValue(
+		[]sdk.Int{sdk.NewInt(4000_000000), sdk.NewInt(1000_000000)},
+		[]uint64{80, 20},
+		[]sdk.Dec{utils.ParseDec("1.0"), utils.ParseDec("1.0")})
+	require.True(t, utils.DecApproxEqual(utils.ParseDec("5000000000"), value))
+
+	// An unbalanced pool is worth less than its balances at the given values.
+	value = amm.WeightedFairValue(
+		[]sdk.Int{sdk.NewInt(1000_000000), sdk.NewInt(1000_000000)},
+		[]uint64{50, 50},
+		[]sdk.Dec{utils.ParseDec("4.0"), utils.ParseDec("1.0")})
+	require.True(t, utils.DecApproxEqual(utils.ParseDec("4000000000"), value))
+}
@@ -0,0 +1,333 @@
+package amm
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/comdex-official/comdex/types"
+)
+
+var _ Pool = (*WeightedPool)(nil)
+
+var (
+	// MaxWeightedPoolInRatio is the maximum ratio of a single coin deposit to
+	// the pool's balance of the coin.
+	MaxWeightedPoolInRatio = sdk.NewDecWithPrec(5, 1) // 0.5
+	// MaxWeightedPoolOutRatio is the maximum ratio of a single coin withdrawal
+	// to the pool's balance of the coin.
+	MaxWeightedPoolOutRatio = sdk.NewDecWithPrec(3, 1) // 0.3
+)
+
+const (
+	// MinWeightedPoolAssets is the minimum number of assets of a weighted pool.
+	MinWeightedPoolAssets = 2
+	// MaxWeightedPoolAssets is the maximum number of assets of a weighted pool.
+	MaxWeightedPoolAssets = 8
+	// MaxWeightedPoolWeight is the maximum weight of a weighted pool asset.
+	MaxWeightedPoolWeight = 100
+)
+
+// WeightedPool is the view of two assets of a weighted pool as a pool of
+// a pair.
+// The pool keeps rx^wx * ry^wy constant while trading, which makes the pool
+// price (rx / wx) / (ry / wy).
+type WeightedPool struct {
+	// rx and ry are the pool's reserve balance of each x/y coin.
+	// In perspective of a pair, x coin is the quote coin and
+	// y coin is the base coin.
+	rx, ry sdk.Int
+	// wx and wy are the weights of x/y coin.
+	wx, wy uint64
+	// ps is the pool's pool coin supply.
+	ps sdk.Int
+}
+
+// NewWeightedPool returns a new WeightedPool.
+func NewWeightedPool(rx, ry sdk.Int, wx, wy uint64, ps sdk.Int) *WeightedPool {
+	return &WeightedPool{
+		rx: rx,
+		ry: ry,
+		wx: wx,
+		wy: wy,
+		ps: ps,
+	}
+}
+
+// ValidateWeightedPoolWeight validates the weight of a weighted pool asset.
+func ValidateWeightedPoolWeight(weight uint64) error {
+	if weight == 0 {
+		return fmt.Errorf("weight must be positive")
+	}
+	if weight > MaxWeightedPoolWeight {
+		return fmt.Errorf("weight must not be greater than %d: %d", MaxWeightedPoolWeight, weight)
+	}
+	return nil
+}
+
+// Balances returns the balances of the pool.
+func (pool *WeightedPool) Balances() (rx, ry sdk.Int) {
+	return pool.rx, pool.ry
+}
+
+func (pool *WeightedPool) SetBalances(rx, ry sdk.Int, _ bool) {
+	pool.rx = rx
+	pool.ry = ry
+}
+
+// PoolCoinSupply returns the pool coin supply.
+func (pool *WeightedPool) PoolCoinSupply() sdk.Int {
+	return pool.ps
+}
+
+// Weights returns the weights of x/y coin.
+func (pool *WeightedPool) Weights() (wx, wy uint64) {
+	return pool.wx, pool.wy
+}
+
+// Price returns the pool price.
+// P = (rx / wx) / (ry / wy)
+func (pool *WeightedPool) Price() sdk.Dec {
+	if pool.rx.IsZero() || pool.ry.IsZero() {
+		panic("pool price is not defined for a depleted pool")
+	}
+	return pool.rx.ToDec().MulInt64(int64(pool.wy)).Quo(pool.ry.ToDec().MulInt64(int64(pool.wx)))
+}
+
+// IsDepleted returns whether the pool is depleted or not.
+func (pool *WeightedPool) IsDepleted() bool {
+	return pool.ps.IsZero() || pool.rx.IsZero() || pool.ry.IsZero()
+}
+
+// HighestBuyPrice returns the highest buy price of the pool.
+func (pool *WeightedPool) HighestBuyPrice() (price sdk.Dec, found bool) {
+	return pool.Price(), true
+}
+
+// LowestSellPrice returns the lowest sell price of the pool.
+func (pool *WeightedPool) LowestSellPrice() (price sdk.Dec, found bool) {
+	return pool.Price(), true
+}
+
+// BuyAmountOver returns the amount of buy orders for price greater than
+// or equal to given price.
+func (pool *WeightedPool) BuyAmountOver(price sdk.Dec, _ bool) (amt sdk.Int) {
+	origPrice := price
+	if price.LT(MinPoolPrice) {
+		price = MinPoolPrice
+	}
+	if price.GTE(pool.Price()) {
+		return zeroInt
+	}
+	// dx = rx - P * ry * wx / wy
+	dx := pool.rx.ToDec().Sub(price.MulInt(pool.ry).MulInt64(int64(pool.wx)).QuoInt64(int64(pool.wy)))
+	if !dx.IsPositive() {
+		return zeroInt
+	}
+	utils.SafeMath(func() {
+		amt = dx.QuoTruncate(origPrice).TruncateInt()
+		if amt.GT(MaxCoinAmount) {
+			amt = MaxCoinAmount
+		}
+	}, func() {
+		amt = MaxCoinAmount
+	})
+	return
+}
+
+// SellAmountUnder returns the amount of sell orders for price less than
+// or equal to given price.
+func (pool *WeightedPool) SellAmountUnder(price sdk.Dec, _ bool) (amt sdk.Int) {
+	if price.GT(MaxPoolPrice) {
+		price = MaxPoolPrice
+	}
+	if price.LTE(pool.Price()) {
+		return zeroInt
+	}
+	// dy = ry - rx * wy / (wx * P)
+	amt = pool.ry.ToDec().Sub(pool.rx.ToDec().MulInt64(int64(pool.wy)).QuoRoundUp(price.MulInt64(int64(pool.wx)))).TruncateInt()
+	if !amt.IsPositive() {
+		return zeroInt
+	}
+	return
+}
+
+// BuyAmountTo returns the amount of buy orders of the pool for price,
+// where BuyAmountTo is used when the pool price is higher than the highest
+// price of the order book.
+// The pool keeps rx^wx * ry^wy constant while trading, so it pays
+// dx = rx - rx * (P' / P)^(wy / (wx + wy)) to move its price from P to P'.
+func (pool *WeightedPool) BuyAmountTo(price sdk.Dec) (amt sdk.Int) {
+	origPrice := price
+	if price.LT(MinPoolPrice) {
+		price = MinPoolPrice
+	}
+	poolPrice := pool.Price()
+	if price.GTE(poolPrice) {
+		return zeroInt
+	}
+	utils.SafeMath(func() {
+		x := pool.rx.ToDec().Mul(DecPowFrac(price.Quo(poolPrice), pool.wy, pool.wx+pool.wy))
+		dx := pool.rx.ToDec().Sub(x)
+		if !dx.IsPositive() {
+			amt = zeroInt
+			return
+		}
+		amt = dx.QuoTruncate(origPrice).TruncateInt() // dy = dx / P
+		if amt.GT(MaxCoinAmount) {
+			amt = MaxCoinAmount
+		}
+	}, func() {
+		amt = MaxCoinAmount
+	})
+	return
+}
+
+// SellAmountTo returns the amount of sell orders of the pool for price,
+// where SellAmountTo is used when the pool price is lower than the lowest
+// price of the order book.
+// The pool sells dy = ry - ry * (P / P')^(wx / (wx + wy)) to move its price
+// from P to P'.
+func (pool *WeightedPool) SellAmountTo(price sdk.Dec) (amt sdk.Int) {
+	if price.GT(MaxPoolPrice) {
+		price = MaxPoolPrice
+	}
+	poolPrice := pool.Price()
+	if price.LTE(poolPrice) {
+		return zeroInt
+	}
+	utils.SafeMath(func() {
+		y := pool.ry.ToDec().Mul(DecPowFrac(poolPrice.Quo(price), pool.wx, pool.wx+pool.wy))
+		amt = pool.ry.ToDec().Sub(y).TruncateInt()
+		if !amt.IsPositive() {
+			amt = zeroInt
+		}
+	}, func() {
+		amt = zeroInt
+	})
+	return
+}
+
+func (pool *WeightedPool) Clone() Pool {
+	return NewWeightedPool(pool.rx, pool.ry, pool.wx, pool.wy, pool.ps)
+}
+
+// DecPowFrac returns base^(num/den).
+func DecPowFrac(base sdk.Dec, num, den uint64) sdk.Dec {
+	g := gcd(num, den)
+	num, den = num/g, den/g
+	root, err := base.ApproxRoot(den)
+	if err != nil {
+		panic(err)
+	}
+	return root.Power(num)
+}
+
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// WeightedDeposit returns accepted amounts and minted pool coin amount
+// when someone deposits amts to a weighted pool with balances bs, in the
+// pool's ratio.
+// Note that we take as many coins as possible(by ceiling numbers)
+// from depositor and mint as little coins as possible.
+func WeightedDeposit(bs []sdk.Int, ps sdk.Int, amts []sdk.Int) (accepted []sdk.Int, pc sdk.Int) {
+	utils.SafeMath(func() {
+		// pc = floor(ps * min(amt_i / b_i))
+		var ratio sdk.Dec
+		for i, b := range bs {
+			r := amts[i].ToDec().QuoTruncate(b.ToDec())
+			if i == 0 || r.LT(ratio) {
+				ratio = r
+			}
+		}
+		pc = ps.ToDec().MulTruncate(ratio).TruncateInt()
+
+		mintProportion := pc.ToDec().Quo(ps.ToDec()) // pc / ps
+		accepted = make([]sdk.Int, len(bs))
+		for i, b := range bs {
+			accepted[i] = b.ToDec().Mul(mintProportion).Ceil().TruncateInt() // ceil(b_i * mintProportion)
+		}
+	}, func() {
+		accepted, pc = nil, sdk.ZeroInt()
+	})
+	return
+}
+
+// WeightedSingleDeposit returns minted pool coin amount when someone
+// deposits amt of a coin with balance b and weight w to a weighted pool
+// with total weight totalWeight.
+// The part of the deposit which is implicitly swapped into the pool's
+// other assets is charged the swap fee rate.
+// pc = ps * ((1 + amt * (1 - feeRate * (1 - w / W)) / b)^(w / W) - 1)
+func WeightedSingleDeposit(b, ps, amt sdk.Int, w, totalWeight uint64, feeRate sdk.Dec) (pc sdk.Int) {
+	utils.SafeMath(func() {
+		swappedRatio := oneDec.Sub(sdk.NewDec(int64(w)).QuoInt64(int64(totalWeight)))
+		amtAfterFee := amt.ToDec().Mul(oneDec.Sub(feeRate.Mul(swappedRatio)))
+		ratio := DecPowFrac(oneDec.Add(amtAfterFee.QuoTruncate(b.ToDec())), w, totalWeight)
+		pc = ps.ToDec().MulTruncate(ratio.Sub(oneDec)).TruncateInt()
+		if pc.IsNegative() {
+			pc = sdk.ZeroInt()
+		}
+	}, func() {
+		pc = sdk.ZeroInt()
+	})
+	return
+}
+
+// WeightedWithdraw returns withdrawn amounts of a weighted pool with
+// balances bs when someone withdraws pc pool coin.
+// WeightedWithdraw also takes care of the fee rate.
+func WeightedWithdraw(bs []sdk.Int, ps, pc sdk.Int, feeRate sdk.Dec) (amts []sdk.Int) {
+	if pc.Equal(ps) {
+		// Redeeming the last pool coin - give all remaining balances.
+		return bs
+	}
+
+	utils.SafeMath(func() {
+		proportion := pc.ToDec().QuoTruncate(ps.ToDec()) // pc / ps
+		multiplier := oneDec.Sub(feeRate)                // 1 - feeRate
+		amts = make([]sdk.Int, len(bs))
+		for i, b := range bs {
+			amts[i] = b.ToDec().MulTruncate(proportion).MulTruncate(multiplier).TruncateInt() // floor(b_i * proportion * multiplier)
+		}
+	}, func() {
+		amts = make([]sdk.Int, len(bs))
+		for i := range amts {
+			amts[i] = sdk.ZeroInt()
+		}
+	})
+	return
+}
+
+// WeightedSingleWithdraw returns withdrawn amount of a coin with balance b
+// and weight w of a weighted pool with total weight totalWeight when someone
+// withdraws pc pool coin in the single coin.
+// The part of the withdrawal which is implicitly swapped from the pool's
+// other assets is charged the swap fee rate, then the withdraw fee rate
+// is applied.
+// amt = b * (1 - (1 - pc / ps)^(W / w)) * (1 - swapFeeRate * (1 - w / W)) * (1 - withdrawFeeRate)
+func WeightedSingleWithdraw(b, ps, pc sdk.Int, w, totalWeight uint64, swapFeeRate, withdrawFeeRate sdk.Dec) (amt sdk.Int) {
+	if pc.GTE(ps) {
+		return sdk.ZeroInt()
+	}
+	utils.SafeMath(func() {
+		remaining := DecPowFrac(oneDec.Sub(pc.ToDec().QuoRoundUp(ps.ToDec())), totalWeight, w)
+		swappedRatio := oneDec.Sub(sdk.NewDec(int64(w)).QuoInt64(int64(totalWeight)))
+		amt = b.ToDec().
+			MulTruncate(oneDec.Sub(remaining)).
+			MulTruncate(oneDec.Sub(swapFeeRate.Mul(swappedRatio))).
+			MulTruncate(oneDec.Sub(withdrawFeeRate)).
+			TruncateInt()
+		if amt.IsNegative() {
+			amt = sdk.ZeroInt()
+		}
+	}, func() {
+		amt = sdk.ZeroInt()
+	})
+	return
+}
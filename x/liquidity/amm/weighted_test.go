@@ -0,0 +1,104 @@
+package amm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/comdex-official/comdex/types"
+	"github.com/comdex-official/comdex/x/liquidity/amm"
+)
+
+func TestWeightedPool_Price(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		rx, ry sdk.Int
+		wx, wy uint64
+		price  sdk.Dec
+	}{
+		{"equal weights", sdk.NewInt(1000000), sdk.NewInt(2000000), 50, 50, utils.ParseDec("0.5")},
+		{"80/20", sdk.NewInt(4000000), sdk.NewInt(1000000), 80, 20, utils.ParseDec("1.0")},
+		{"20/80", sdk.NewInt(1000000), sdk.NewInt(1000000), 20, 80, utils.ParseDec("4.0")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pool := amm.NewWeightedPool(tc.rx, tc.ry, tc.wx, tc.wy, sdk.NewInt(1000000))
+			require.True(sdk.DecEq(t, tc.price, pool.Price()))
+		})
+	}
+}
+
+func TestWeightedPool_BuySellAmount(t *testing.T) {
+	pool := amm.NewWeightedPool(sdk.NewInt(1000000000), sdk.NewInt(1000000000), 50, 50, sdk.NewInt(1000000))
+
+	require.True(t, pool.BuyAmountOver(utils.ParseDec("1.0"), true).IsZero())
+	require.True(t, pool.SellAmountUnder(utils.ParseDec("1.0"), true).IsZero())
+
+	// With equal weights, the pool behaves like a basic pool.
+	basicPool := amm.NewBasicPool(sdk.NewInt(1000000000), sdk.NewInt(1000000000), sdk.ZeroInt())
+	for _, price := range []sdk.Dec{utils.ParseDec("0.9"), utils.ParseDec("0.5")} {
+		require.True(sdk.IntEq(t, basicPool.BuyAmountOver(price, true), pool.BuyAmountOver(price, true)))
+		require.True(t, utils.DecApproxEqual(
+			basicPool.BuyAmountTo(price).ToDec(), pool.BuyAmountTo(price).ToDec()))
+	}
+	for _, price := range []sdk.Dec{utils.ParseDec("1.1"), utils.ParseDec("2.0")} {
+		require.True(sdk.IntEq(t, basicPool.SellAmountUnder(price, true), pool.SellAmountUnder(price, true)))
+		require.True(t, utils.DecApproxEqual(
+			basicPool.SellAmountTo(price).ToDec(), pool.SellAmountTo(price).ToDec()))
+	}
+
+	// Moving the balances by the sell amount moves the price to the target.
+	pool = amm.NewWeightedPool(sdk.NewInt(4000000000), sdk.NewInt(1000000000), 80, 20, sdk.NewInt(1000000))
+	target := utils.ParseDec("1.2")
+	amt := pool.SellAmountTo(target)
+	require.True(t, amt.IsPositive())
+	rx, ry := pool.Balances()
+	// The pool's invariant rx^wx * ry^wy stays the same.
+	newRy := ry.Sub(amt)
+	newRx := rx.ToDec().Mul(amm.DecPowFrac(ry.ToDec().Quo(newRy.ToDec()), 20, 80)).TruncateInt()
+	pool.SetBalances(newRx, newRy, true)
+	require.True(t, utils.DecApproxEqual(target, pool.Price()))
+}
+
+func TestWeightedDeposit(t *testing.T) {
+	bs := []sdk.Int{sdk.NewInt(1000000), sdk.NewInt(2000000), sdk.NewInt(3000000)}
+	accepted, pc := amm.WeightedDeposit(bs, sdk.NewInt(1000000), []sdk.Int{sdk.NewInt(100000), sdk.NewInt(100000), sdk.NewInt(300000)})
+	require.True(sdk.IntEq(t, sdk.NewInt(50000), pc))
+	require.True(sdk.IntEq(t, sdk.NewInt(50000), accepted[0]))
+	require.True(sdk.IntEq(t, sdk.NewInt(100000), accepted[1]))
+	require.True(sdk.IntEq(t, sdk.NewInt(150000), accepted[2]))
+}
+
+func TestWeightedSingleDepositWithdraw(t *testing.T) {
+	b, ps := sdk.NewInt(1000000000), sdk.NewInt(1000000000000)
+
+	// Depositing with no fee in a pool of a single asset mints proportionally.
+	pc := amm.WeightedSingleDeposit(b, ps, sdk.NewInt(100000000), 50, 50, sdk.ZeroDec())
+	require.True(sdk.IntEq(t, sdk.NewInt(100000000000), pc))
+
+	// A single coin deposit followed by a single coin withdrawal of the same
+	// coin returns about the same amount when there's no fee.
+	amt := sdk.NewInt(100000000)
+	pc = amm.WeightedSingleDeposit(b, ps, amt, 30, 100, sdk.ZeroDec())
+	require.True(t, pc.IsPositive())
+	out := amm.WeightedSingleWithdraw(b.Add(amt), ps.Add(pc), pc, 30, 100, sdk.ZeroDec(), sdk.ZeroDec())
+	require.True(t, utils.DecApproxEqual(amt.ToDec(), out.ToDec()))
+
+	// Fees reduce the minted amount and the withdrawn amount.
+	pcWithFee := amm.WeightedSingleDeposit(b, ps, amt, 30, 100, utils.ParseDec("0.003"))
+	require.True(t, pcWithFee.LT(pc))
+	outWithFee := amm.WeightedSingleWithdraw(b.Add(amt), ps.Add(pc), pc, 30, 100, utils.ParseDec("0.003"), utils.ParseDec("0.003"))
+	require.True(t, outWithFee.LT(out))
+}
+
+func TestWeightedWithdraw(t *testing.T) {
+	bs := []sdk.Int{sdk.NewInt(1000000), sdk.NewInt(2000000)}
+	amts := amm.WeightedWithdraw(bs, sdk.NewInt(1000000), sdk.NewInt(100000), sdk.ZeroDec())
+	require.True(sdk.IntEq(t, sdk.NewInt(100000), amts[0]))
+	require.True(sdk.IntEq(t, sdk.NewInt(200000), amts[1]))
+
+	amts = amm.WeightedWithdraw(bs, sdk.NewInt(1000000), sdk.NewInt(1000000), utils.ParseDec("0.003"))
+	require.True(sdk.IntEq(t, bs[0], amts[0]))
+	require.True(sdk.IntEq(t, bs[1], amts[1]))
+}
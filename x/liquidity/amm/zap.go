@@ -0,0 +1,134 @@
+package amm
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/comdex-official/comdex/types"
+)
+
+// compositeReserves returns the reserves which define the pool's swap curve.
+// For a BasicPool they are the actual reserves and for a RangedPool they are
+// the reserves translated by the pool's price range.
+func compositeReserves(pool Pool) (x, y sdk.Dec) {
+	rx, ry := pool.Balances()
+	switch pool := pool.(type) {
+	case *RangedPool:
+		transX, transY := pool.Translation()
+		return rx.ToDec().Add(transX), ry.ToDec().Add(transY)
+	default:
+		return rx.ToDec(), ry.ToDec()
+	}
+}
+
+// SwapOut returns the amount of the opposite coin the pool gives out when
+// offerAmt of x coin(or y coin if isOfferX is false) is swapped against
+// the pool's curve.
+// The result never exceeds the pool's actual reserve of the demand coin.
+func SwapOut(pool Pool, isOfferX bool, offerAmt sdk.Int) (out sdk.Int) {
+	if !offerAmt.IsPositive() {
+		return zeroInt
+	}
+	rx, ry := pool.Balances()
+	x, y := compositeReserves(pool)
+	utils.SafeMath(func() {
+		if isOfferX {
+			// dy = y * dx / (x + dx)
+			out = y.MulInt(offerAmt).QuoTruncate(x.Add(offerAmt.ToDec())).TruncateInt()
+			if out.GT(ry) {
+				out = ry
+			}
+		} else {
+			// dx = x * dy / (y + dy)
+			out = x.MulInt(offerAmt).QuoTruncate(y.Add(offerAmt.ToDec())).TruncateInt()
+			if out.GT(rx) {
+				out = rx
+			}
+		}
+	}, func() {
+		out = zeroInt
+	})
+	return
+}
+
+// ZapDeposit returns how a single-sided deposit of amt x coin(or y coin if
+// isOfferX is false) is split.
+// swapAmt of the offer coin is swapped against the pool, paying swapFee on
+// top of it, and receivedAmt of the opposite coin is received.
+// The remaining offer coin and the received coin are then in the ratio of
+// the post-swap pool reserves, so that they can be deposited with minimal
+// refund.
+func ZapDeposit(pool Pool, isOfferX bool, amt sdk.Int, feeRate sdk.Dec) (swapAmt, swapFee, receivedAmt sdk.Int) {
+	swapAmt, swapFee, receivedAmt = zeroInt, zeroInt, zeroInt
+	rx, ry := pool.Balances()
+	offerReserve, demandReserve := rx, ry
+	if !isOfferX {
+		offerReserve, demandReserve = ry, rx
+	}
+	// A pool without any demand coin reserve can only take the offer coin
+	// as it is.
+	if !demandReserve.IsPositive() {
+		return
+	}
+
+	feeOf := func(s sdk.Int) sdk.Int {
+		return s.ToDec().MulTruncate(feeRate).TruncateInt()
+	}
+	// excessive reports whether the offer coin side is still excessive
+	// after swapping s, that is,
+	// (amt - s - fee(s)) * (demandReserve - out) >= out * (offerReserve + s).
+	excessive := func(s sdk.Int) bool {
+		out := SwapOut(pool, isOfferX, s)
+		remaining := amt.Sub(s).Sub(feeOf(s))
+		if remaining.IsNegative() {
+			return false
+		}
+		lhs := new(big.Int).Mul(remaining.BigInt(), demandReserve.Sub(out).BigInt())
+		rhs := new(big.Int).Mul(out.BigInt(), offerReserve.Add(s).BigInt())
+		return lhs.Cmp(rhs) >= 0
+	}
+
+	// Binary search the largest swap amount which keeps the offer coin side
+	// excessive.
+	lo, hi := zeroInt, amt.ToDec().Quo(oneDec.Add(feeRate)).TruncateInt()
+	for lo.LT(hi) {
+		mid := lo.Add(hi).AddRaw(1).QuoRaw(2)
+		if excessive(mid) {
+			lo = mid
+		} else {
+			hi = mid.SubRaw(1)
+		}
+	}
+
+	swapAmt = lo
+	swapFee = feeOf(swapAmt)
+	receivedAmt = SwapOut(pool, isOfferX, swapAmt)
+	if receivedAmt.IsZero() {
+		swapAmt, swapFee = zeroInt, zeroInt
+	}
+	return
+}
+
+// ZapWithdraw returns the amount of x and y coin withdrawn when pc pool coin
+// is withdrawn from the pool, and the amount of demand coin received when
+// the withdrawn coin on the other side is swapped against the
+// post-withdrawal pool.
+// isDemandX specifies whether the demand coin is x coin or y coin.
+// The swap fee is deducted from the withdrawn coin on the other side
+// before the swap.
+func ZapWithdraw(pool Pool, pc sdk.Int, isDemandX bool, withdrawFeeRate, swapFeeRate sdk.Dec) (x, y, swapFee, swappedOut sdk.Int) {
+	rx, ry := pool.Balances()
+	x, y = Withdraw(rx, ry, pool.PoolCoinSupply(), pc, withdrawFeeRate)
+
+	postPool := pool.Clone()
+	postPool.SetBalances(rx.Sub(x), ry.Sub(y), false)
+
+	offerAmt := y
+	if !isDemandX {
+		offerAmt = x
+	}
+	swapFee = offerAmt.ToDec().MulTruncate(swapFeeRate).TruncateInt()
+	swappedOut = SwapOut(postPool, !isDemandX, offerAmt.Sub(swapFee))
+	return
+}
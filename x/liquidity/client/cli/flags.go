@@ -3,19 +3,26 @@ package cli
 // DONTCOVER
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	flag "github.com/spf13/pflag"
+
+	"github.com/comdex-official/comdex/x/liquidity/types"
 )
 
 const (
-	FlagPairID         = "pair-id"
-	FlagDisabled       = "disabled"
-	FlagPoolCoinDenom  = "pool-coin-denom"
-	FlagReserveAddress = "reserve-address"
-	FlagDenoms         = "denoms"
-	FlagOrderLifespan  = "order-lifespan"
-	FlagNumTicks       = "num-ticks"
+	FlagPairID          = "pair-id"
+	FlagDisabled        = "disabled"
+	FlagPoolCoinDenom   = "pool-coin-denom"
+	FlagReserveAddress  = "reserve-address"
+	FlagDenoms          = "denoms"
+	FlagOrderLifespan   = "order-lifespan"
+	FlagNumTicks        = "num-ticks"
+	FlagLockDuration    = "lock-duration"
+	FlagOwner           = "owner"
+	FlagDemandCoinDenom = "demand-coin-denom"
 )
 
 func flagSetPools() *flag.FlagSet {
@@ -36,6 +43,14 @@ func flagSetPool() *flag.FlagSet {
 	return fs
 }
 
+func flagSetPositions() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagOwner, "", "The bech-32 encoded address of the position owner")
+
+	return fs
+}
+
 func flagSetPairs() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -60,6 +75,40 @@ func flagSetOrder() *flag.FlagSet {
 	return fs
 }
 
+func flagSetFarm() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Duration(FlagLockDuration, 0, "Duration the farmed pool coin is locked for a boosted reward; must be one of the app's farming lock durations; valid time units are ns|us|ms|s|m|h")
+
+	return fs
+}
+
+func flagSetExitWeightedPool() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagDemandCoinDenom, "", "The single coin to receive; all assets of the pool are withdrawn proportionally if empty")
+
+	return fs
+}
+
+// ParseWeightedPoolAssets parses weighted pool assets from a string of
+// comma separated denom:weight pairs, e.g. "uatom:40,ucmdx:60".
+func ParseWeightedPoolAssets(s string) ([]types.WeightedPoolAsset, error) {
+	assets := []types.WeightedPoolAsset{}
+	for _, assetStr := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(assetStr), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid weighted pool asset: %s", assetStr)
+		}
+		weight, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse weight of %s: %w", parts[0], err)
+		}
+		assets = append(assets, types.WeightedPoolAsset{Denom: parts[0], Weight: weight})
+	}
+	return assets, nil
+}
+
 func ParseStringSliceFromString(s string, separator string) ([]string, error) {
 	stringSlice := strings.Split(s, separator)
 
@@ -43,6 +43,8 @@ func GetQueryCmd() *cobra.Command {
 		NewQueryPoolIncentivesCmd(),
 		NewQueryFarmedPoolCoinCmd(),
 		NewQueryTotalActiveAndQueuedPoolCoinCmd(),
+		NewQueryPositionsCmd(),
+		NewQueryPositionCmd(),
 	)
 
 	return cmd
@@ -1068,3 +1070,124 @@ $ %s query %s total-farmed-coin 1
 
 	return cmd
 }
+
+// NewQueryPositionsCmd implements the positions query command.
+func NewQueryPositionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions [app-id] [pool-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query for all positions in the concentrated pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all positions in the concentrated pool.
+Optionally restrict the results to the positions of an owner.
+Example:
+$ %s query %s positions 1 1
+$ %s query %s positions 1 1 --owner=cosmos1...
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse app id: %w", err)
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pool id: %w", err)
+			}
+
+			owner, _ := cmd.Flags().GetString(FlagOwner)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Positions(
+				cmd.Context(),
+				&types.QueryPositionsRequest{
+					AppId:      appID,
+					PoolId:     poolID,
+					Owner:      owner,
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetPositions())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryPositionCmd implements the position query command.
+func NewQueryPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position [app-id] [pool-id] [position-id]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query details of the specific position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details of the specific position.
+Example:
+$ %s query %s position 1 1 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse app id: %w", err)
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pool id: %w", err)
+			}
+
+			positionID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse position id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Position(
+				cmd.Context(),
+				&types.QueryPositionRequest{
+					AppId:      appID,
+					PoolId:     poolID,
+					PositionId: positionID,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -42,6 +42,15 @@ func GetTxCmd() *cobra.Command {
 		NewCancelMMOrderCmd(),
 		NewFarmCmd(),
 		NewUnfarmCmd(),
+		NewZapDepositCmd(),
+		NewZapWithdrawCmd(),
+		NewCreateConcentratedPoolCmd(),
+		NewOpenPositionCmd(),
+		NewAddLiquidityCmd(),
+		NewRemoveLiquidityCmd(),
+		NewCollectFeesCmd(),
+		NewJoinWeightedPoolCmd(),
+		NewExitWeightedPoolCmd(),
 	)
 
 	return cmd
@@ -309,6 +318,124 @@ $ %s tx %s withdraw 1 1 10000pool1 --from mykey
 	return cmd
 }
 
+func NewZapDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "zap-deposit [app-id] [pool-id] [deposit-coin] [min-pool-coin-amount]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Deposit a single coin to a liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit a single coin to a liquidity pool.
+The optimal portion of the deposit coin is swapped against the pool within the batch,
+and the rest is deposited along with the swapped coin.
+The request fails if the minted pool coin amount is less than min-pool-coin-amount.
+Example:
+$ %s tx %s zap-deposit 1 1 1000000000uatom 90000 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse app id: %w", err)
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id: %w", err)
+			}
+
+			depositCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid deposit coin: %w", err)
+			}
+
+			minPoolCoinAmt, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid min pool coin amount: %s", args[3])
+			}
+
+			msg := types.NewMsgZapDeposit(appID, clientCtx.GetFromAddress(), poolID, depositCoin, minPoolCoinAmt)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewZapWithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "zap-withdraw [app-id] [pool-id] [pool-coin] [demand-coin-denom] [min-demand-coin-amount]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Withdraw coins from the specified liquidity pool into a single coin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw coins from the specified liquidity pool into a single coin.
+The withdrawn coin on the other side of the demand coin is swapped against the pool within the batch.
+The request fails if the received demand coin amount is less than min-demand-coin-amount.
+Example:
+$ %s tx %s zap-withdraw 1 1 10000pool1 uatom 9000 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse app id: %w", err)
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			poolCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			minDemandCoinAmt, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid min demand coin amount: %s", args[4])
+			}
+
+			msg := types.NewMsgZapWithdraw(
+				appID,
+				clientCtx.GetFromAddress(),
+				poolID,
+				poolCoin,
+				args[3],
+				minDemandCoinAmt,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewLimitOrderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "limit-order [app-id] [pair-id] [direction] [offer-coin] [demand-coin-denom] [price] [amount]",
@@ -743,8 +870,12 @@ func NewFarmCmd() *cobra.Command {
 			fmt.Sprintf(`farm pool coins to be eligible for incentivizations 
 Example:
 $ %s tx %s farm 1 1 10000pool1 --from mykey
+$ %s tx %s farm 1 1 10000pool1 --lock-duration=720h --from mykey
+
+[lock-duration]: optionally lock the pool coins until the duration passes for a boosted reward
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -768,12 +899,15 @@ $ %s tx %s farm 1 1 10000pool1 --from mykey
 				return err
 			}
 
+			lockDuration, _ := cmd.Flags().GetDuration(FlagLockDuration)
+
 			msg := types.NewMsgFarm(
 				appID,
 				poolID,
 				clientCtx.GetFromAddress(),
 				farmedPoolCoin,
 			)
+			msg.LockDuration = lockDuration
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -782,6 +916,7 @@ $ %s tx %s farm 1 1 10000pool1 --from mykey
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetFarm())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -987,3 +1122,521 @@ func NewCmdCreateNewLiquidityPairProposal() *cobra.Command {
 
 	return cmd
 }
+
+func NewCreateConcentratedPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-concentrated-pool [app-id] [pair-id] [fee-rate] [deposit-coins] [min-price] [max-price] [initial-price]",
+		Args:  cobra.ExactArgs(7),
+		Short: "Create a concentrated liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a concentrated liquidity pool with the creator's first position.
+The position provides liquidity only within [min-price, max-price].
+
+Example:
+$ %s tx %s create-concentrated-pool 1 1 0.003 1000000000uatom,10000000000stake 0.5 2.0 1.0 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse app id: %w", err)
+			}
+
+			pairID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			feeRate, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid fee rate: %w", err)
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid deposit coins: %w", err)
+			}
+
+			minPrice, err := sdk.NewDecFromStr(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid min price: %w", err)
+			}
+
+			maxPrice, err := sdk.NewDecFromStr(args[5])
+			if err != nil {
+				return fmt.Errorf("invalid max price: %w", err)
+			}
+
+			initialPrice, err := sdk.NewDecFromStr(args[6])
+			if err != nil {
+				return fmt.Errorf("invalid initial price: %w", err)
+			}
+
+			msg := types.NewMsgCreateConcentratedPool(
+				appID,
+				clientCtx.GetFromAddress(),
+				pairID,
+				feeRate,
+				depositCoins,
+				minPrice,
+				maxPrice,
+				initialPrice,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewOpenPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-position [app-id] [pool-id] [min-price] [max-price] [deposit-coins]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Open a position in a concentrated liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Open a position in a concentrated liquidity pool.
+The deposit coins are accepted in the ratio of the price range at the current pool price.
+
+Example:
+$ %s tx %s open-position 1 1 0.8 1.25 1000000000uatom,10000000000stake --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse app id: %w", err)
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pool id: %w", err)
+			}
+
+			minPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid min price: %w", err)
+			}
+
+			maxPrice, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid max price: %w", err)
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid deposit coins: %w", err)
+			}
+
+			msg := types.NewMsgOpenPosition(appID, clientCtx.GetFromAddress(), poolID, minPrice, maxPrice, depositCoins)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddLiquidityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-liquidity [app-id] [pool-id] [position-id] [deposit-coins]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Add liquidity to a position in a concentrated liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add liquidity to a position in a concentrated liquidity pool.
+
+Example:
+$ %s tx %s add-liquidity 1 1 1 1000000000uatom,10000000000stake --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse app id: %w", err)
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pool id: %w", err)
+			}
+
+			positionID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse position id: %w", err)
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid deposit coins: %w", err)
+			}
+
+			msg := types.NewMsgAddLiquidity(appID, clientCtx.GetFromAddress(), poolID, positionID, depositCoins)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRemoveLiquidityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-liquidity [app-id] [pool-id] [position-id] [liquidity]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Remove liquidity from a position in a concentrated liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove liquidity from a position in a concentrated liquidity pool.
+The fees accrued to the position are collected as well.
+The position is closed when its whole liquidity is removed.
+
+Example:
+$ %s tx %s remove-liquidity 1 1 1 1000000.5 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse app id: %w", err)
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pool id: %w", err)
+			}
+
+			positionID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse position id: %w", err)
+			}
+
+			liquidity, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid liquidity: %w", err)
+			}
+
+			msg := types.NewMsgRemoveLiquidity(appID, clientCtx.GetFromAddress(), poolID, positionID, liquidity)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCollectFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collect-fees [app-id] [pool-id] [position-id]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Collect the fees accrued to a position in a concentrated liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Collect the fees accrued to a position in a concentrated liquidity pool.
+
+Example:
+$ %s tx %s collect-fees 1 1 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse app id: %w", err)
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pool id: %w", err)
+			}
+
+			positionID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse position id: %w", err)
+			}
+
+			msg := types.NewMsgCollectFees(appID, clientCtx.GetFromAddress(), poolID, positionID)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewJoinWeightedPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "join-weighted-pool [app-id] [pool-id] [deposit-coins] [min-pool-coin-amount]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Join a weighted pool with one or more of its assets",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Join a weighted pool with one or more of its assets.
+Depositing every asset of the pool mints pool coins in proportion to the reserves, and the excess is refunded.
+Depositing a single asset pays the swap fee on the part that is implicitly swapped into the other assets.
+The request fails if the minted pool coin amount is less than min-pool-coin-amount.
+
+Example:
+$ %s tx %s join-weighted-pool 1 1 1000000uatom,5000000ucmdx,2000000ucmst 0 --from mykey
+$ %s tx %s join-weighted-pool 1 1 1000000uatom 90000 --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse app id: %w", err)
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pool id: %w", err)
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			minPoolCoinAmt, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid min pool coin amount: %s", args[3])
+			}
+
+			msg := types.NewMsgJoinWeightedPool(
+				appID,
+				clientCtx.GetFromAddress(),
+				poolID,
+				depositCoins,
+				minPoolCoinAmt,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewExitWeightedPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exit-weighted-pool [app-id] [pool-id] [pool-coin] [min-demand-coin-amount]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Exit a weighted pool into all of its assets or a single asset",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Exit a weighted pool into all of its assets or a single asset.
+Without --demand-coin-denom, all assets of the pool are withdrawn in proportion to the reserves.
+With --demand-coin-denom, only the given asset is withdrawn and the swap fee is paid on the implicit swap.
+The request fails if the withdrawn demand coin amount is less than min-demand-coin-amount;
+it is ignored when withdrawing proportionally.
+
+Example:
+$ %s tx %s exit-weighted-pool 1 1 10000pool1-1 0 --from mykey
+$ %s tx %s exit-weighted-pool 1 1 10000pool1-1 9000 --demand-coin-denom=uatom --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse app id: %w", err)
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pool id: %w", err)
+			}
+
+			poolCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			minDemandCoinAmt, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid min demand coin amount: %s", args[3])
+			}
+
+			demandCoinDenom, err := cmd.Flags().GetString(FlagDemandCoinDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExitWeightedPool(
+				appID,
+				clientCtx.GetFromAddress(),
+				poolID,
+				poolCoin,
+				demandCoinDenom,
+				minDemandCoinAmt,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetExitWeightedPool())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCmdCreateWeightedPoolProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-weighted-pool [app-id] [assets]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Create new weighted pool in given app",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create new weighted pool in given app.
+The assets are given as comma separated denom:weight pairs.
+
+Example:
+$ %s tx gov submit-proposal create-weighted-pool 1 uatom:40,ucmdx:40,ucmst:20 --title=... --description=... --deposit=10000000ucmdx --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse app id: %w", err)
+			}
+
+			assets, err := ParseWeightedPoolAssets(args[1])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCreateWeightedPoolProposal(
+				title,
+				description,
+				from,
+				appID,
+				assets,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
@@ -10,4 +10,5 @@ import (
 var LiquidityProposalHandler = []govclient.ProposalHandler{
 	govclient.NewProposalHandler(cli.NewCmdUpdateGenericParamsProposal, rest.UpdateGenericParamsProposalRESTHandler),
 	govclient.NewProposalHandler(cli.NewCmdCreateNewLiquidityPairProposal, rest.CreateNewLiquidityPairProposalRESTHandler),
+	govclient.NewProposalHandler(cli.NewCmdCreateWeightedPoolProposal, rest.CreateWeightedPoolProposalRESTHandler),
 }
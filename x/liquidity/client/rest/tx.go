@@ -33,6 +33,15 @@ type CreateNewLiquidityPairRequest struct {
 	QuoteCoinDenom string       `json:"quote_coin_denom" yaml:"quote_coin_denom"`
 }
 
+type CreateWeightedPoolRequest struct {
+	BaseReq     rest.BaseReq              `json:"base_req" yaml:"base_req"`
+	Title       string                    `json:"title" yaml:"title"`
+	Description string                    `json:"description" yaml:"description"`
+	Deposit     sdk.Coins                 `json:"deposit" yaml:"deposit"`
+	AppID       uint64                    `json:"app_id" yaml:"app_id"`
+	Assets      []types.WeightedPoolAsset `json:"assets" yaml:"assets"`
+}
+
 func UpdateGenericParamsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 	return govrest.ProposalRESTHandler{
 		SubRoute: "liquidity-param-change",
@@ -47,6 +56,13 @@ func CreateNewLiquidityPairProposalRESTHandler(clientCtx client.Context) govrest
 	}
 }
 
+func CreateWeightedPoolProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "create-weighted-pool",
+		Handler:  CreateWeightedPoolRESTHandler(clientCtx),
+	}
+}
+
 func UpdateGenericParamsRESTHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req UpdateGenericParamsRequest
@@ -119,3 +135,39 @@ func CreateNewLiquidityPairRESTHandler(clientCtx client.Context) http.HandlerFun
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+func CreateWeightedPoolRESTHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateWeightedPoolRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewCreateWeightedPoolProposal(
+			req.Title,
+			req.Description,
+			fromAddr,
+			req.AppID,
+			req.Assets,
+		)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -39,6 +39,7 @@ type AssetKeeper interface {
 	GetApp(ctx sdk.Context, id uint64) (app assettypes.AppData, found bool)
 	GetApps(ctx sdk.Context) (apps []assettypes.AppData, found bool)
 	GetAsset(ctx sdk.Context, id uint64) (asset assettypes.Asset, found bool)
+	IsAssetDelisting(ctx sdk.Context, assetID uint64) bool
 }
 
 type MarketKeeper interface {
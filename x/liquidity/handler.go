@@ -57,6 +57,33 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgUnfarm:
 			res, err := msgServer.Unfarm(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgZapDeposit:
+			res, err := msgServer.ZapDeposit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgZapWithdraw:
+			res, err := msgServer.ZapWithdraw(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateConcentratedPool:
+			res, err := msgServer.CreateConcentratedPool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgOpenPosition:
+			res, err := msgServer.OpenPosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddLiquidity:
+			res, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveLiquidity:
+			res, err := msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCollectFees:
+			res, err := msgServer.CollectFees(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgJoinWeightedPool:
+			res, err := msgServer.JoinWeightedPool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgExitWeightedPool:
+			res, err := msgServer.ExitWeightedPool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -70,6 +97,8 @@ func NewLiquidityProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return k.HandelUpdateGenericParamsProposal(ctx, c)
 		case *types.CreateNewLiquidityPairProposal:
 			return k.HandelCreateNewLiquidityPairProposal(ctx, c)
+		case *types.CreateWeightedPoolProposal:
+			return k.HandelCreateWeightedPoolProposal(ctx, c)
 		default:
 			return errors.Wrapf(types.ErrorUnknownProposalType, "%T", c)
 		}
@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
+	"github.com/comdex-official/comdex/x/liquidity/amm"
+	"github.com/comdex-official/comdex/x/liquidity/types"
+	markettypes "github.com/comdex-official/comdex/x/market/types"
+)
+
+// getCollateralPool returns the pool of the pool coin denom, which must be a
+// pool whose pool coin can be valued and unwound.
+func (k Keeper) getCollateralPool(ctx sdk.Context, poolCoinDenom string) (types.Pool, error) {
+	appID, poolID, err := types.ParsePoolCoinDenom(poolCoinDenom)
+	if err != nil {
+		return types.Pool{}, sdkerrors.Wrap(types.ErrWrongPoolCoinDenom, err.Error())
+	}
+	pool, found := k.GetPool(ctx, appID, poolID)
+	if !found {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrInvalidPoolID, "pool %d is invalid", poolID)
+	}
+	if pool.Disabled {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrDisabledPool, "pool %d is disabled", poolID)
+	}
+	if pool.Type == types.PoolTypeConcentrated {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is a concentrated pool without pool coin", poolID)
+	}
+	if k.GetPoolCoinSupply(ctx, pool).IsZero() {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrDepletedPool, "pool %d is depleted", poolID)
+	}
+	return pool, nil
+}
+
+// unitPrice returns the oracle value of the smallest unit of the denom.
+// Only active oracle prices are used.
+func (k Keeper) unitPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	asset, found := k.assetKeeper.GetAssetForDenom(ctx, denom)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(assettypes.ErrorAssetDoesNotExist, "asset for denom %s", denom)
+	}
+	twa, found := k.marketKeeper.GetTwa(ctx, asset.Id)
+	if !found || !twa.IsPriceActive || twa.Twa == 0 {
+		return sdk.Dec{}, sdkerrors.Wrapf(markettypes.ErrorPriceNotActive, "price of %s", denom)
+	}
+	return sdk.NewDecFromInt(sdk.NewIntFromUint64(twa.Twa)).QuoInt(asset.Decimals), nil
+}
+
+// PoolCoinFairValue returns the oracle value of amount pool coins.
+// The pool is valued at its fair reserves, the reserves it would hold if its
+// price matched the oracle prices of its assets, instead of at its spot
+// reserves, so that the value can't be moved by trading against the pool.
+// All assets of the pool must have an active oracle price.
+func (k Keeper) PoolCoinFairValue(ctx sdk.Context, poolCoinDenom string, amount sdk.Int) (sdk.Dec, error) {
+	pool, err := k.getCollateralPool(ctx, poolCoinDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	if amount.GT(ps) {
+		return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool coin amount is greater than the pool coin supply")
+	}
+
+	var value sdk.Dec
+	if pool.Type == types.PoolTypeWeighted {
+		values := make([]sdk.Dec, len(pool.WeightedAssets))
+		ws := make([]uint64, len(pool.WeightedAssets))
+		for i, asset := range pool.WeightedAssets {
+			if values[i], err = k.unitPrice(ctx, asset.Denom); err != nil {
+				return sdk.Dec{}, err
+			}
+			ws[i] = asset.Weight
+		}
+		value = amm.WeightedFairValue(k.getWeightedPoolBalances(ctx, pool), ws, values)
+	} else {
+		pair, _ := k.GetPair(ctx, pool.AppId, pool.PairId)
+		vq, err := k.unitPrice(ctx, pair.QuoteCoinDenom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		vb, err := k.unitPrice(ctx, pair.BaseCoinDenom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		rx, ry := k.getPoolBalances(ctx, pool, pair)
+		ammPool := k.GetAMMPool(ctx, pool, rx.Amount, ry.Amount, ps)
+		// The pool's price is in quote coin per base coin.
+		fx, fy := amm.FairReserves(ammPool, vb.Quo(vq))
+		value = fx.Mul(vq).Add(fy.Mul(vb))
+	}
+	if !value.IsPositive() {
+		return sdk.Dec{}, types.ErrSupplyValueCalculationInvalid
+	}
+	return value.MulInt(amount).QuoInt(ps), nil
+}
+
+// PoolCoinUnderlyingDenoms returns the denoms of the assets the pool coin
+// can be withdrawn into.
+func (k Keeper) PoolCoinUnderlyingDenoms(ctx sdk.Context, poolCoinDenom string) ([]string, error) {
+	pool, err := k.getCollateralPool(ctx, poolCoinDenom)
+	if err != nil {
+		return nil, err
+	}
+	if pool.Type == types.PoolTypeWeighted {
+		denoms := make([]string, len(pool.WeightedAssets))
+		for i, asset := range pool.WeightedAssets {
+			denoms[i] = asset.Denom
+		}
+		return denoms, nil
+	}
+	pair, _ := k.GetPair(ctx, pool.AppId, pool.PairId)
+	return []string{pair.QuoteCoinDenom, pair.BaseCoinDenom}, nil
+}
+
+// unwindMinDemandAmount returns the least amount of demandCoinDenom the pool
+// coin may be unwound into, its fair value less UnwindPoolCoinMaxSlippage, so
+// that moving the pool's spot price ahead of the unwind can't drain the
+// collateral.
+func (k Keeper) unwindMinDemandAmount(ctx sdk.Context, poolCoin sdk.Coin, demandCoinDenom string) (sdk.Int, error) {
+	value, err := k.PoolCoinFairValue(ctx, poolCoin.Denom, poolCoin.Amount)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	price, err := k.unitPrice(ctx, demandCoinDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return value.Quo(price).Mul(sdk.OneDec().Sub(types.UnwindPoolCoinMaxSlippage)).TruncateInt(), nil
+}
+
+// UnwindPoolCoin withdraws the holder's pool coin into demandCoinDenom
+// immediately, swapping the other assets withdrawn against the pool.
+// It is used to turn pool coin collateral into one of its underlying assets
+// before the collateral is liquidated, and fails when the pool would pay out
+// less than the pool coin's fair value allows.
+func (k Keeper) UnwindPoolCoin(ctx sdk.Context, holder sdk.AccAddress, poolCoin sdk.Coin, demandCoinDenom string) (sdk.Coin, error) {
+	pool, err := k.getCollateralPool(ctx, poolCoin.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if pool.Type == types.PoolTypeWeighted {
+		minDemandAmt, err := k.unwindMinDemandAmount(ctx, poolCoin, demandCoinDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		withdrawnCoins, err := k.ExitWeightedPool(ctx, types.NewMsgExitWeightedPool(
+			pool.AppId, holder, pool.Id, poolCoin, demandCoinDenom, minDemandAmt))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		return sdk.NewCoin(demandCoinDenom, withdrawnCoins.AmountOf(demandCoinDenom)), nil
+	}
+
+	params, err := k.GetGenericParams(ctx, pool.AppId)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(err, "params retreval failed")
+	}
+	pair, _ := k.GetPair(ctx, pool.AppId, pool.PairId)
+	if demandCoinDenom != pair.QuoteCoinDenom && demandCoinDenom != pair.BaseCoinDenom {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pool", demandCoinDenom)
+	}
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	if poolCoin.Amount.GTE(ps) {
+		return sdk.Coin{}, types.ErrWithdrawAllPoolCoin
+	}
+	ammPool := k.GetAMMPool(ctx, pool, rx.Amount, ry.Amount, ps)
+
+	isDemandX := demandCoinDenom == pair.QuoteCoinDenom
+	offerCoinDenom := pair.QuoteCoinDenom
+	if isDemandX {
+		offerCoinDenom = pair.BaseCoinDenom
+	}
+
+	x, y, swapFeeAmt, swappedOutAmt := amm.ZapWithdraw(ammPool, poolCoin.Amount, isDemandX, params.WithdrawFeeRate, params.SwapFeeRate)
+	withdrawnDemandAmt, offerAmt := x, y
+	if !isDemandX {
+		withdrawnDemandAmt, offerAmt = y, x
+	}
+	demandAmt := withdrawnDemandAmt.Add(swappedOutAmt)
+	if demandAmt.IsZero() || (offerAmt.Sub(swapFeeAmt).IsPositive() && swappedOutAmt.IsZero()) {
+		return sdk.Coin{}, types.ErrCalculatedPoolAmountIsZero
+	}
+	minDemandAmt, err := k.unwindMinDemandAmount(ctx, poolCoin, demandCoinDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if demandAmt.LT(minDemandAmt) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrTooSmallDemandCoinAmount, "%s%s is less than %s%s", demandAmt, demandCoinDenom, minDemandAmt, demandCoinDenom)
+	}
+
+	withdrawnCoin := sdk.NewCoin(demandCoinDenom, demandAmt)
+	burningCoins := sdk.NewCoins(poolCoin)
+	swapFeeCoin := sdk.NewCoin(offerCoinDenom, swapFeeAmt)
+
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(holder, k.accountKeeper.GetModuleAddress(types.ModuleName), burningCoins)
+	bulkOp.QueueSendCoins(pool.GetReserveAddress(), holder, sdk.NewCoins(withdrawnCoin))
+	bulkOp.QueueSendCoins(pool.GetReserveAddress(), pair.GetSwapFeeCollectorAddress(), sdk.NewCoins(swapFeeCoin))
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burningCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnwindPoolCoin,
+			sdk.NewAttribute(types.AttributeKeyHolder, holder.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolCoin, poolCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawnCoins, withdrawnCoin.String()),
+			sdk.NewAttribute(types.AttributeKeySwapFeeCoin, swapFeeCoin.String()),
+		),
+	})
+
+	return withdrawnCoin, nil
+}
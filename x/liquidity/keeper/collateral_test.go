@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/comdex-official/comdex/types"
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
+	"github.com/comdex-official/comdex/x/liquidity/types"
+	markettypes "github.com/comdex-official/comdex/x/market/types"
+)
+
+func (s *KeeperTestSuite) TestPoolCoinFairValue() {
+	creator := s.addr(0)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, creator, asset2.Denom, asset1.Denom)
+	pool := s.CreateNewLiquidityPool(appID1, pair.Id, creator, "1000000000uasset1,500000000uasset2")
+	ps := s.keeper.GetPoolCoinSupply(s.ctx, pool)
+
+	// The pool is balanced at the oracle prices, so it's valued at its reserves.
+	value, err := s.keeper.PoolCoinFairValue(s.ctx, pool.PoolCoinDenom, ps)
+	s.Require().NoError(err)
+	s.Require().True(utils.DecApproxEqual(utils.ParseDec("2000000000"), value))
+
+	value, err = s.keeper.PoolCoinFairValue(s.ctx, pool.PoolCoinDenom, ps.QuoRaw(4))
+	s.Require().NoError(err)
+	s.Require().True(utils.DecApproxEqual(utils.ParseDec("500000000"), value))
+
+	// Pool coins registered as assets are priced by the market module at
+	// their fair value.
+	err = s.app.AssetKeeper.AddAssetRecords(s.ctx, assettypes.Asset{
+		Name:      "POOLCOIN",
+		Denom:     pool.PoolCoinDenom,
+		Decimals:  sdk.NewInt(1000000000000),
+		IsOnChain: true,
+	})
+	s.Require().NoError(err)
+	poolCoinAsset, found := s.app.AssetKeeper.GetAssetForDenom(s.ctx, pool.PoolCoinDenom)
+	s.Require().True(found)
+	value, err = s.app.MarketKeeper.CalcAssetPrice(s.ctx, poolCoinAsset.Id, ps.QuoRaw(4))
+	s.Require().NoError(err)
+	s.Require().True(utils.DecApproxEqual(utils.ParseDec("500000000"), value))
+
+	// When the oracle price moves away from the pool price, the pool is valued
+	// at the reserves arbitrageurs would leave, which is less than the spot
+	// reserves' value of 5000000000.
+	s.app.MarketKeeper.SetTwa(s.ctx, markettypes.TimeWeightedAverage{
+		AssetID:       asset2.Id,
+		Twa:           8000000,
+		IsPriceActive: true,
+		PriceValue:    []uint64{8000000},
+	})
+	value, err = s.keeper.PoolCoinFairValue(s.ctx, pool.PoolCoinDenom, ps)
+	s.Require().NoError(err)
+	s.Require().True(utils.DecApproxEqual(utils.ParseDec("4000000000"), value))
+
+	// All assets of the pool must be priced.
+	s.app.MarketKeeper.SetTwa(s.ctx, markettypes.TimeWeightedAverage{
+		AssetID:       asset2.Id,
+		Twa:           8000000,
+		IsPriceActive: false,
+		PriceValue:    []uint64{8000000},
+	})
+	_, err = s.keeper.PoolCoinFairValue(s.ctx, pool.PoolCoinDenom, ps)
+	s.Require().ErrorIs(err, markettypes.ErrorPriceNotActive)
+
+	_, err = s.keeper.PoolCoinFairValue(s.ctx, asset1.Denom, ps)
+	s.Require().ErrorIs(err, types.ErrWrongPoolCoinDenom)
+
+	_, err = s.keeper.PoolCoinFairValue(s.ctx, types.PoolCoinDenom(appID1, 69), ps)
+	s.Require().ErrorIs(err, types.ErrInvalidPoolID)
+}
+
+func (s *KeeperTestSuite) TestWeightedPoolCoinFairValue() {
+	addr1 := s.addr(1)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+	asset3 := s.CreateNewAsset("ASSETTHREE", "uasset3", 1000000)
+
+	pool := s.CreateNewWeightedPool(
+		appID1, addr1, weightedAssets(asset1.Denom, 40, asset2.Denom, 40, asset3.Denom, 20),
+		"1000000000uasset1,1000000000uasset2,500000000uasset3")
+	ps := s.keeper.GetPoolCoinSupply(s.ctx, pool)
+
+	value, err := s.keeper.PoolCoinFairValue(s.ctx, pool.PoolCoinDenom, ps)
+	s.Require().NoError(err)
+	s.Require().True(utils.DecApproxEqual(utils.ParseDec("2500000000"), value))
+
+	denoms, err := s.keeper.PoolCoinUnderlyingDenoms(s.ctx, pool.PoolCoinDenom)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{asset1.Denom, asset2.Denom, asset3.Denom}, denoms)
+}
+
+func (s *KeeperTestSuite) TestUnwindPoolCoin() {
+	creator := s.addr(0)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, creator, asset2.Denom, asset1.Denom)
+	pool := s.CreateNewLiquidityPool(appID1, pair.Id, creator, "1000000000uasset1,500000000uasset2")
+	ps := s.keeper.GetPoolCoinSupply(s.ctx, pool)
+
+	denoms, err := s.keeper.PoolCoinUnderlyingDenoms(s.ctx, pool.PoolCoinDenom)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{asset1.Denom, asset2.Denom}, denoms)
+
+	_, err = s.keeper.UnwindPoolCoin(s.ctx, creator, sdk.NewCoin(pool.PoolCoinDenom, ps.QuoRaw(10)), "uasset3")
+	s.Require().ErrorIs(err, types.ErrInvalidCoinDenom)
+
+	_, err = s.keeper.UnwindPoolCoin(s.ctx, creator, sdk.NewCoin(pool.PoolCoinDenom, ps), asset1.Denom)
+	s.Require().ErrorIs(err, types.ErrWithdrawAllPoolCoin)
+
+	balanceBefore := s.getBalance(creator, asset1.Denom)
+	poolCoin := sdk.NewCoin(pool.PoolCoinDenom, ps.QuoRaw(10))
+	withdrawn, err := s.keeper.UnwindPoolCoin(s.ctx, creator, poolCoin, asset1.Denom)
+	s.Require().NoError(err)
+	s.Require().Equal(asset1.Denom, withdrawn.Denom)
+	// A tenth of the pool is worth 200000000uasset1, minus fees and slippage.
+	s.Require().True(withdrawn.Amount.GT(sdk.NewInt(180000000)))
+	s.Require().True(withdrawn.Amount.LT(sdk.NewInt(200000000)))
+	s.Require().True(s.getBalance(creator, asset1.Denom).Sub(balanceBefore).IsEqual(withdrawn))
+	s.Require().True(ps.Sub(poolCoin.Amount).Equal(s.keeper.GetPoolCoinSupply(s.ctx, pool)))
+
+	// The pool paying out well below the pool coin's fair value, as when its
+	// spot price was moved away from the oracle price, fails the unwind.
+	s.app.MarketKeeper.SetTwa(s.ctx, markettypes.TimeWeightedAverage{
+		AssetID:       asset2.Id,
+		Twa:           8000000,
+		IsPriceActive: true,
+		PriceValue:    []uint64{8000000},
+	})
+	_, err = s.keeper.UnwindPoolCoin(s.ctx, creator, poolCoin, asset1.Denom)
+	s.Require().ErrorIs(err, types.ErrTooSmallDemandCoinAmount)
+}
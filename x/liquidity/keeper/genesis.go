@@ -29,7 +29,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		for _, pool := range appState.Pools {
 			k.SetPool(ctx, pool)
 			k.SetPoolByReserveIndex(ctx, pool)
-			k.SetPoolsByPairIndex(ctx, pool)
+			if pool.Type != types.PoolTypeWeighted {
+				k.SetPoolsByPairIndex(ctx, pool)
+			}
 		}
 
 		for _, req := range appState.DepositRequests {
@@ -58,6 +60,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		for _, queuedFarmer := range appState.QueuedFarmers {
 			k.SetQueuedFarmer(ctx, queuedFarmer)
 		}
+
+		for _, lockedFarmer := range appState.LockedFarmers {
+			k.SetLockedFarmer(ctx, lockedFarmer)
+		}
+
+		for _, position := range appState.Positions {
+			k.SetPosition(ctx, position)
+		}
 	}
 }
 
@@ -90,6 +100,14 @@ func (k Keeper) GetActiveAndQueuedFarmersForGenesis(ctx sdk.Context, appID uint6
 	return allActiveFarmers, allQueuedFarmers
 }
 
+func (k Keeper) GetLockedFarmersForGenesis(ctx sdk.Context, appID uint64) []types.LockedFarmer {
+	allLockedFarmers := []types.LockedFarmer{}
+	for _, pool := range k.GetAllPools(ctx, appID) {
+		allLockedFarmers = append(allLockedFarmers, k.GetAllLockedFarmers(ctx, appID, pool.Id)...)
+	}
+	return allLockedFarmers
+}
+
 // ExportGenesis returns the capability module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	allApps, found := k.assetKeeper.GetApps(ctx)
@@ -116,6 +134,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 				ActiveFarmers:            allActiveFarmers,
 				QueuedFarmers:            allQueuedFarmers,
 				MarketMakingOrderIndexes: k.GetAllMMOrderIndexes(ctx, app.Id),
+				LockedFarmers:            k.GetLockedFarmersForGenesis(ctx, app.Id),
+				Positions:                k.GetAllPositions(ctx, app.Id),
 			})
 		}
 	}
@@ -41,6 +41,10 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 
 	s.Farm(appID1, pool.Id, s.addr(4), "4440000pool1-1")
 
+	err := k.UpdateGenericParams(s.ctx, appID1, []string{"FarmingLockBoosts"}, []string{"720h:1.5"})
+	s.Require().NoError(err)
+	s.LockedFarm(appID1, pool.Id, s.addr(5), "5550000pool1-1", 720*time.Hour)
+
 	s.LimitOrder(appID1, s.addr(2), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), sdk.NewInt(10000), 0)
 	s.nextBlock()
 
@@ -52,6 +56,8 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	pool, _ = k.GetPool(ctx, pool.AppId, pool.Id)
 	allActiveFarmers := k.GetAllActiveFarmers(ctx, appID1, pool.Id)
 	allQueuedFarmers := k.GetAllQueuedFarmers(ctx, appID1, pool.Id)
+	allLockedFarmers := k.GetAllLockedFarmers(ctx, appID1, pool.Id)
+	s.Require().Len(allLockedFarmers, 1)
 
 	genState := k.ExportGenesis(ctx)
 
@@ -93,6 +99,9 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	importedAllQueuedFarmers := k.GetAllQueuedFarmers(ctx, appID1, pool.Id)
 	s.Require().Equal(len(allQueuedFarmers), len(importedAllQueuedFarmers))
 	s.Require().Equal(allQueuedFarmers, importedAllQueuedFarmers)
+
+	importedAllLockedFarmers := k.GetAllLockedFarmers(ctx, appID1, pool.Id)
+	s.Require().Equal(allLockedFarmers, importedAllLockedFarmers)
 }
 
 func (s *KeeperTestSuite) TestImportExportGenesisEmpty() {
@@ -15,3 +15,8 @@ func (k Keeper) HandelCreateNewLiquidityPairProposal(ctx sdk.Context, p *types.C
 	_, err := k.CreatePair(ctx, msg, true)
 	return err
 }
+
+func (k Keeper) HandelCreateWeightedPoolProposal(ctx sdk.Context, p *types.CreateWeightedPoolProposal) error {
+	_, err := k.CreateWeightedPool(ctx, p.AppId, sdk.MustAccAddressFromBech32(p.From), p.Assets)
+	return err
+}
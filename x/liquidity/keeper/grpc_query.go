@@ -111,10 +111,7 @@ func (k Querier) Pools(c context.Context, req *types.QueryPoolsRequest) (*types.
 		}
 
 		if accumulate {
-			pair := pairGetter(pool.PairId)
-			rx, ry := k.getPoolBalances(ctx, pool, pair)
-			ps := k.GetPoolCoinSupply(ctx, pool)
-			poolsRes = append(poolsRes, types.NewPoolResponse(pool, rx, ry, ps))
+			poolsRes = append(poolsRes, k.newPoolResponse(ctx, pool, pairGetter(pool.PairId)))
 		}
 
 		return true, nil
@@ -126,6 +123,17 @@ func (k Querier) Pools(c context.Context, req *types.QueryPoolsRequest) (*types.
 	return &types.QueryPoolsResponse{Pools: poolsRes, Pagination: pageRes}, nil
 }
 
+// newPoolResponse returns the pool response of the pool.
+func (k Querier) newPoolResponse(ctx sdk.Context, pool types.Pool, pair types.Pair) types.PoolResponse {
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	if pool.Type == types.PoolTypeWeighted {
+		return types.NewWeightedPoolResponse(pool, k.GetWeightedPoolBalances(ctx, pool), ps)
+	}
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	ammPool := k.GetAMMPool(ctx, pool, rx.Amount, ry.Amount, ps)
+	return types.NewPoolResponse(pool, ammPool, rx, ry, ps)
+}
+
 // Pool queries the specific pool.
 func (k Querier) Pool(c context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
 	if req == nil {
@@ -147,10 +155,9 @@ func (k Querier) Pool(c context.Context, req *types.QueryPoolRequest) (*types.Qu
 		return nil, status.Errorf(codes.NotFound, "pool %d doesn't exist", req.PoolId)
 	}
 
-	rx, ry := k.GetPoolBalances(ctx, pool)
-	ps := k.GetPoolCoinSupply(ctx, pool)
+	pair, _ := k.GetPair(ctx, pool.AppId, pool.PairId)
 
-	return &types.QueryPoolResponse{Pool: types.NewPoolResponse(pool, rx, ry, ps)}, nil
+	return &types.QueryPoolResponse{Pool: k.newPoolResponse(ctx, pool, pair)}, nil
 }
 
 // PoolByReserveAddress queries the specific pool by the reserve account address.
@@ -179,10 +186,9 @@ func (k Querier) PoolByReserveAddress(c context.Context, req *types.QueryPoolByR
 		return nil, status.Errorf(codes.NotFound, "pool by %s doesn't exist", req.ReserveAddress)
 	}
 
-	rx, ry := k.GetPoolBalances(ctx, pool)
-	ps := k.GetPoolCoinSupply(ctx, pool)
+	pair, _ := k.GetPair(ctx, pool.AppId, pool.PairId)
 
-	return &types.QueryPoolResponse{Pool: types.NewPoolResponse(pool, rx, ry, ps)}, nil
+	return &types.QueryPoolResponse{Pool: k.newPoolResponse(ctx, pool, pair)}, nil
 }
 
 // PoolByPoolCoinDenom queries the specific pool by the pool coin denomination.
@@ -215,10 +221,9 @@ func (k Querier) PoolByPoolCoinDenom(c context.Context, req *types.QueryPoolByPo
 		return nil, status.Errorf(codes.NotFound, "pool %d doesn't exist", poolID)
 	}
 
-	rx, ry := k.GetPoolBalances(ctx, pool)
-	ps := k.GetPoolCoinSupply(ctx, pool)
+	pair, _ := k.GetPair(ctx, pool.AppId, pool.PairId)
 
-	return &types.QueryPoolResponse{Pool: types.NewPoolResponse(pool, rx, ry, ps)}, nil
+	return &types.QueryPoolResponse{Pool: k.newPoolResponse(ctx, pool, pair)}, nil
 }
 
 // Pairs queries all pairs.
@@ -592,7 +597,7 @@ func (k Querier) Farmer(c context.Context, req *types.QueryFarmerRequest) (*type
 	queuedFarmer, qfound := k.GetQueuedFarmer(ctx, req.AppId, req.PoolId, farmer)
 
 	if !afound && !qfound {
-		return &types.QueryFarmerResponse{ActivePoolCoin: sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(0)), QueuedPoolCoin: []types.QueuedPoolCoin{}}, nil
+		return &types.QueryFarmerResponse{ActivePoolCoin: sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(0)), QueuedPoolCoin: []types.QueuedPoolCoin{}, LockedPoolCoin: []types.LockedCoin{}}, nil
 	}
 
 	availableLiquidityGauges := k.rewardsKeeper.GetAllGaugesByGaugeTypeID(ctx, rewardstypes.LiquidityGaugeTypeID)
@@ -613,7 +618,15 @@ func (k Querier) Farmer(c context.Context, req *types.QueryFarmerRequest) (*type
 		activePoolCoin.Amount = activePoolCoin.Amount.Add(activeFarmer.FarmedPoolCoin.Amount)
 	}
 
-	return &types.QueryFarmerResponse{ActivePoolCoin: activePoolCoin, QueuedPoolCoin: queuedCoins}, nil
+	lockedCoins := []types.LockedCoin{}
+	lockedFarmer, lfound := k.GetLockedFarmer(ctx, req.AppId, req.PoolId, farmer)
+	if lfound {
+		for _, lockedCoin := range lockedFarmer.UnmaturedLockedCoins(ctx.BlockTime()) {
+			lockedCoins = append(lockedCoins, *lockedCoin)
+		}
+	}
+
+	return &types.QueryFarmerResponse{ActivePoolCoin: activePoolCoin, QueuedPoolCoin: queuedCoins, LockedPoolCoin: lockedCoins}, nil
 }
 
 // DeserializePoolCoin splits poolcoin amount into actual assets provided by depositor.
@@ -841,10 +854,13 @@ func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest
 				return false, nil
 			}
 			rx, ry := k.getPoolBalances(ctx, pool, pair)
-			ammPool := pool.AMMPool(rx.Amount, ry.Amount, sdk.Int{})
+			ammPool := k.GetAMMPool(ctx, pool, rx.Amount, ry.Amount, sdk.Int{})
 			ob.AddOrder(amm.PoolOrders(ammPool, amm.DefaultOrderer, lowestPrice, highestPrice, int(tickPrec))...)
 			return false, nil
 		})
+		for _, orderer := range k.weightedPoolOrderers(ctx, pair) {
+			ob.AddOrder(amm.PoolOrders(orderer.Pool, amm.DefaultOrderer, lowestPrice, highestPrice, int(tickPrec))...)
+		}
 
 		ov := ob.MakeView()
 		ov.Match()
@@ -866,3 +882,98 @@ func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest
 		Pairs: pairs,
 	}, nil
 }
+
+// Positions queries all positions in a concentrated pool.
+func (k Querier) Positions(c context.Context, req *types.QueryPositionsRequest) (*types.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.AppId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "app id cannot be 0")
+	}
+
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id cannot be 0")
+	}
+
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, found := k.GetPool(ctx, req.AppId, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pool %d not found", req.PoolId)
+	}
+
+	pair, _ := k.GetPair(ctx, req.AppId, pool.PairId)
+	var price *sdk.Dec
+	if p, err := k.concentratedPoolPrice(pool); err == nil {
+		price = &p
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	positionStore := prefix.NewStore(store, types.GetPositionsByPoolKeyPrefix(req.AppId, req.PoolId))
+
+	var positionsRes []types.PositionResponse
+	pageRes, err := query.FilteredPaginate(positionStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		position, err := types.UnmarshalPosition(k.cdc, value)
+		if err != nil {
+			return false, err
+		}
+
+		if req.Owner != "" && position.Owner != req.Owner {
+			return false, nil
+		}
+
+		if accumulate {
+			positionsRes = append(positionsRes, types.NewPositionResponse(position, pair, price))
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPositionsResponse{Positions: positionsRes, Pagination: pageRes}, nil
+}
+
+// Position queries the specific position.
+func (k Querier) Position(c context.Context, req *types.QueryPositionRequest) (*types.QueryPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.AppId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "app id cannot be 0")
+	}
+
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id cannot be 0")
+	}
+
+	if req.PositionId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "position id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	position, found := k.GetPosition(ctx, req.AppId, req.PoolId, req.PositionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "position %d in pool %d not found", req.PositionId, req.PoolId)
+	}
+
+	pool, _ := k.GetPool(ctx, req.AppId, req.PoolId)
+	pair, _ := k.GetPair(ctx, req.AppId, pool.PairId)
+	var price *sdk.Dec
+	if p, err := k.concentratedPoolPrice(pool); err == nil {
+		price = &p
+	}
+
+	return &types.QueryPositionResponse{Position: types.NewPositionResponse(position, pair, price)}, nil
+}
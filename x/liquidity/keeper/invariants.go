@@ -173,8 +173,15 @@ func PoolStatusInvariant(k Keeper) sdk.Invariant {
 				msg   string
 			)
 			_ = k.IterateAllPools(ctx, app.Id, func(pool types.Pool) (stop bool, err error) {
-				if !pool.Disabled {
+				// An empty weighted pool stays active until its first join.
+				if !pool.Disabled && pool.Type != types.PoolTypeWeighted {
 					ps := k.GetPoolCoinSupply(ctx, pool)
+					if pool.Type == types.PoolTypeConcentrated {
+						// Concentrated pools have no pool coin, so use the liquidity instead.
+						pair, _ := k.GetPair(ctx, pool.AppId, pool.PairId)
+						rx, ry := k.getPoolBalances(ctx, pool, pair)
+						ps = k.GetAMMPool(ctx, pool, rx.Amount, ry.Amount, ps).PoolCoinSupply()
+					}
 					if ps.IsZero() {
 						count++
 						msg += fmt.Sprintf("\tpool %d should be disabled, but not\n", pool.Id)
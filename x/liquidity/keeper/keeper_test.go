@@ -209,6 +209,49 @@ func (s *KeeperTestSuite) CreateNewLiquidityRangedPool(appID, pairID uint64, cre
 	return pool
 }
 
+func (s *KeeperTestSuite) CreateNewConcentratedPool(appID, pairID uint64, creator sdk.AccAddress, feeRate sdk.Dec, depositCoins string, minPrice, maxPrice, initialPrice sdk.Dec) types.Pool {
+	params, err := s.keeper.GetGenericParams(s.ctx, appID)
+	s.Require().NoError(err)
+
+	parsedDepositCoins := utils.ParseCoins(depositCoins)
+
+	s.fundAddr(creator, params.PoolCreationFee)
+	s.fundAddr(creator, parsedDepositCoins)
+	msg := types.NewMsgCreateConcentratedPool(appID, creator, pairID, feeRate, parsedDepositCoins, minPrice, maxPrice, initialPrice)
+	pool, err := s.keeper.CreateConcentratedPool(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().IsType(types.Pool{}, pool)
+
+	return pool
+}
+
+func (s *KeeperTestSuite) OpenPosition(appID, poolID uint64, depositor sdk.AccAddress, minPrice, maxPrice sdk.Dec, depositCoins string) types.Position {
+	msg := types.NewMsgOpenPosition(
+		appID, depositor, poolID, minPrice, maxPrice, utils.ParseCoins(depositCoins),
+	)
+	s.fundAddr(depositor, msg.DepositCoins)
+	position, err := s.keeper.OpenPosition(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().IsType(types.Position{}, position)
+	return position
+}
+
+func (s *KeeperTestSuite) CreateNewWeightedPool(appID uint64, creator sdk.AccAddress, assets []types.WeightedPoolAsset, depositCoins string) types.Pool {
+	pool, err := s.keeper.CreateWeightedPool(s.ctx, appID, creator, assets)
+	s.Require().NoError(err)
+	s.JoinWeightedPool(appID, pool.Id, creator, depositCoins, sdk.ZeroInt())
+	pool, _ = s.keeper.GetPool(s.ctx, appID, pool.Id)
+	return pool
+}
+
+func (s *KeeperTestSuite) JoinWeightedPool(appID, poolID uint64, depositor sdk.AccAddress, depositCoins string, minPoolCoinAmt sdk.Int) sdk.Coin {
+	msg := types.NewMsgJoinWeightedPool(appID, depositor, poolID, utils.ParseCoins(depositCoins), minPoolCoinAmt)
+	s.fundAddr(depositor, msg.DepositCoins)
+	poolCoin, err := s.keeper.JoinWeightedPool(s.ctx, msg)
+	s.Require().NoError(err)
+	return poolCoin
+}
+
 func (s *KeeperTestSuite) Deposit(appID, poolID uint64, depositor sdk.AccAddress, depositCoins string) types.DepositRequest {
 	msg := types.NewMsgDeposit(
 		appID, depositor, poolID, utils.ParseCoins(depositCoins),
@@ -230,6 +273,27 @@ func (s *KeeperTestSuite) Withdraw(appID, poolID uint64, withdrawer sdk.AccAddre
 	return req
 }
 
+func (s *KeeperTestSuite) ZapDeposit(appID, poolID uint64, depositor sdk.AccAddress, depositCoin string, minPoolCoinAmt sdk.Int) types.DepositRequest {
+	msg := types.NewMsgZapDeposit(
+		appID, depositor, poolID, utils.ParseCoin(depositCoin), minPoolCoinAmt,
+	)
+	s.fundAddr(depositor, sdk.NewCoins(msg.DepositCoin))
+	req, err := s.keeper.ZapDeposit(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().IsType(types.DepositRequest{}, req)
+	return req
+}
+
+func (s *KeeperTestSuite) ZapWithdraw(appID, poolID uint64, withdrawer sdk.AccAddress, poolCoin sdk.Coin, demandCoinDenom string, minDemandCoinAmt sdk.Int) types.WithdrawRequest {
+	msg := types.NewMsgZapWithdraw(
+		appID, withdrawer, poolID, poolCoin, demandCoinDenom, minDemandCoinAmt,
+	)
+	req, err := s.keeper.ZapWithdraw(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().IsType(types.WithdrawRequest{}, req)
+	return req
+}
+
 func (s *KeeperTestSuite) LimitOrder(
 	appID uint64,
 	orderer sdk.AccAddress,
@@ -372,3 +436,13 @@ func (s *KeeperTestSuite) Farm(appID, poolID uint64, farmer sdk.AccAddress, farm
 	err := s.keeper.Farm(s.ctx, msg)
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) LockedFarm(appID, poolID uint64, farmer sdk.AccAddress, farmingCoin string, lockDuration time.Duration) {
+	msg := types.NewMsgFarm(
+		appID, poolID, farmer, utils.ParseCoin(farmingCoin),
+	)
+	msg.LockDuration = lockDuration
+	s.fundAddr(farmer, sdk.NewCoins(msg.FarmingPoolCoin))
+	err := s.keeper.Farm(s.ctx, msg)
+	s.Require().NoError(err)
+}
@@ -159,3 +159,102 @@ func (m msgServer) Unfarm(goCtx context.Context, msg *types.MsgUnfarm) (*types.M
 
 	return &types.MsgUnfarmResponse{}, nil
 }
+
+// ZapDeposit defines a method to deposit a single coin to the pool.
+func (m msgServer) ZapDeposit(goCtx context.Context, msg *types.MsgZapDeposit) (*types.MsgZapDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.ZapDeposit(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgZapDepositResponse{}, nil
+}
+
+// ZapWithdraw defines a method to withdraw pool coin from the pool into a single coin.
+func (m msgServer) ZapWithdraw(goCtx context.Context, msg *types.MsgZapWithdraw) (*types.MsgZapWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.ZapWithdraw(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgZapWithdrawResponse{}, nil
+}
+
+// CreateConcentratedPool defines a method to create a concentrated liquidity pool.
+func (m msgServer) CreateConcentratedPool(goCtx context.Context, msg *types.MsgCreateConcentratedPool) (*types.MsgCreateConcentratedPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.CreateConcentratedPool(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateConcentratedPoolResponse{}, nil
+}
+
+// OpenPosition defines a method to open a position in a concentrated pool.
+func (m msgServer) OpenPosition(goCtx context.Context, msg *types.MsgOpenPosition) (*types.MsgOpenPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.OpenPosition(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgOpenPositionResponse{}, nil
+}
+
+// AddLiquidity defines a method to add liquidity to a position.
+func (m msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidity) (*types.MsgAddLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.AddLiquidity(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddLiquidityResponse{}, nil
+}
+
+// RemoveLiquidity defines a method to remove liquidity from a position.
+func (m msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLiquidity) (*types.MsgRemoveLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.RemoveLiquidity(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveLiquidityResponse{}, nil
+}
+
+// CollectFees defines a method to collect the fees accrued to a position.
+func (m msgServer) CollectFees(goCtx context.Context, msg *types.MsgCollectFees) (*types.MsgCollectFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.CollectFees(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCollectFeesResponse{}, nil
+}
+
+// JoinWeightedPool defines a method to deposit coins to a weighted pool.
+func (m msgServer) JoinWeightedPool(goCtx context.Context, msg *types.MsgJoinWeightedPool) (*types.MsgJoinWeightedPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.JoinWeightedPool(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgJoinWeightedPoolResponse{}, nil
+}
+
+// ExitWeightedPool defines a method to withdraw coins from a weighted pool.
+func (m msgServer) ExitWeightedPool(goCtx context.Context, msg *types.MsgExitWeightedPool) (*types.MsgExitWeightedPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.ExitWeightedPool(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExitWeightedPoolResponse{}, nil
+}
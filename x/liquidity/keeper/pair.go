@@ -39,12 +39,27 @@ func (k Keeper) ValidateMsgCreatePair(ctx sdk.Context, msg *types.MsgCreatePair)
 		return sdkerrors.Wrapf(types.ErrAssetNotWhiteListed, "asset with denom  %s is not white listed", msg.QuoteCoinDenom)
 	}
 
+	if err := k.validateDenomsListed(ctx, msg.BaseCoinDenom, msg.QuoteCoinDenom); err != nil {
+		return err
+	}
+
 	if _, found := k.GetPairByDenoms(ctx, msg.AppId, msg.BaseCoinDenom, msg.QuoteCoinDenom); found {
 		return types.ErrPairAlreadyExists
 	}
 	return nil
 }
 
+// validateDenomsListed refuses new pairs and liquidity in assets being delisted.
+func (k Keeper) validateDenomsListed(ctx sdk.Context, denoms ...string) error {
+	for _, denom := range denoms {
+		asset, found := k.assetKeeper.GetAssetForDenom(ctx, denom)
+		if found && k.assetKeeper.IsAssetDelisting(ctx, asset.Id) {
+			return sdkerrors.Wrapf(types.ErrAssetDelisted, "asset with denom %s is being delisted", denom)
+		}
+	}
+	return nil
+}
+
 // CreatePair handles types.MsgCreatePair and creates a pair.
 func (k Keeper) CreatePair(ctx sdk.Context, msg *types.MsgCreatePair, isViaProp bool) (types.Pair, error) {
 	if err := k.ValidateMsgCreatePair(ctx, msg); err != nil {
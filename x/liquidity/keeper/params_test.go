@@ -65,11 +65,18 @@ func (s *KeeperTestSuite) TestUpdateGenericParams() {
 			Values: []string{"10b"},
 			ExpErr: fmt.Errorf("time: unknown unit \"b\" in duration \"10b\""),
 		},
+		{
+			Name:   "error invalid farming lock boosts",
+			appID:  appID1,
+			Keys:   []string{"FarmingLockBoosts"},
+			Values: []string{"720h"},
+			ExpErr: fmt.Errorf("invalid farming lock boost \"720h\", expected duration:multiplier"),
+		},
 		{
 			Name:   "success valid case 1",
 			appID:  appID1,
-			Keys:   []string{"BatchSize", "MinInitialPoolCoinSupply", "PairCreationFee", "PoolCreationFee", "MinInitialDepositAmount", "MaxPriceLimitRatio", "MaxOrderLifespan", "SwapFeeRate", "WithdrawFeeRate", "DepositExtraGas", "WithdrawExtraGas", "OrderExtraGas", "SwapFeeDistrDenom", "SwapFeeBurnRate"},
-			Values: []string{"69", "1000000000000000000", "10000000000dummy1", "10000000000dummy2", "10000", "0.1", "10h", "0.2", "0.4", "11", "12", "13", "loltoken", "0.8"},
+			Keys:   []string{"BatchSize", "MinInitialPoolCoinSupply", "PairCreationFee", "PoolCreationFee", "MinInitialDepositAmount", "MaxPriceLimitRatio", "MaxOrderLifespan", "SwapFeeRate", "WithdrawFeeRate", "DepositExtraGas", "WithdrawExtraGas", "OrderExtraGas", "SwapFeeDistrDenom", "SwapFeeBurnRate", "FarmingLockBoosts"},
+			Values: []string{"69", "1000000000000000000", "10000000000dummy1", "10000000000dummy2", "10000", "0.1", "10h", "0.2", "0.4", "11", "12", "13", "loltoken", "0.8", "720h:1.5,2160h:2"},
 			ExpErr: nil,
 		},
 	}
@@ -99,6 +106,10 @@ func (s *KeeperTestSuite) TestUpdateGenericParams() {
 				s.Require().Equal(params.OrderExtraGas, uint64(13))
 				s.Require().Equal(params.SwapFeeDistrDenom, "loltoken")
 				s.Require().Equal(params.SwapFeeBurnRate, utils.ParseDec("0.8"))
+				s.Require().Equal(params.FarmingLockBoosts, []types.FarmingLockBoost{
+					{LockDuration: 720 * time.Hour, BoostMultiplier: utils.ParseDec("1.5")},
+					{LockDuration: 2160 * time.Hour, BoostMultiplier: utils.ParseDec("2")},
+				})
 			}
 		})
 	}
@@ -63,6 +63,15 @@ func (k Keeper) GetPoolCoinSupply(ctx sdk.Context, pool types.Pool) sdk.Int {
 	return k.bankKeeper.GetSupply(ctx, pool.PoolCoinDenom).Amount
 }
 
+// GetAMMPool returns the amm.Pool of the pool with given reserve balances.
+// A concentrated pool's liquidity curve is built from its positions.
+func (k Keeper) GetAMMPool(ctx sdk.Context, pool types.Pool, rx, ry, ps sdk.Int) amm.Pool {
+	if pool.Type == types.PoolTypeConcentrated {
+		return pool.ConcentratedAMMPool(rx, ry, k.GetPositionsByPool(ctx, pool.AppId, pool.Id))
+	}
+	return pool.AMMPool(rx, ry, ps)
+}
+
 // MarkPoolAsDisabled marks a pool as disabled.
 func (k Keeper) MarkPoolAsDisabled(ctx sdk.Context, pool types.Pool) {
 	pool.Disabled = true
@@ -81,6 +90,10 @@ func (k Keeper) ValidateMsgCreatePool(ctx sdk.Context, msg *types.MsgCreatePool)
 		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
 	}
 
+	if err := k.validateDenomsListed(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom); err != nil {
+		return err
+	}
+
 	params, err := k.GetGenericParams(ctx, msg.AppId)
 	if err != nil {
 		return sdkerrors.Wrap(err, "params retreval failed")
@@ -244,6 +257,10 @@ func (k Keeper) ValidateMsgCreateRangedPool(ctx sdk.Context, msg *types.MsgCreat
 		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
 	}
 
+	if err := k.validateDenomsListed(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom); err != nil {
+		return err
+	}
+
 	for _, coin := range msg.DepositCoins {
 		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
 			return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", coin.Denom)
@@ -374,8 +391,17 @@ func (k Keeper) ValidateMsgDeposit(ctx sdk.Context, msg *types.MsgDeposit) error
 	if pool.Disabled {
 		return types.ErrDisabledPool
 	}
+	if pool.Type == types.PoolTypeConcentrated {
+		return sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is a concentrated pool, use its positions instead", pool.Id)
+	}
+	if pool.Type == types.PoolTypeWeighted {
+		return sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is a weighted pool, join or exit the pool instead", pool.Id)
+	}
 
 	pair, _ := k.GetPair(ctx, msg.AppId, pool.PairId)
+	if err := k.validateDenomsListed(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom); err != nil {
+		return err
+	}
 
 	for _, coin := range msg.DepositCoins {
 		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
@@ -444,6 +470,12 @@ func (k Keeper) ValidateMsgWithdraw(ctx sdk.Context, msg *types.MsgWithdraw) err
 	if pool.Disabled {
 		return types.ErrDisabledPool
 	}
+	if pool.Type == types.PoolTypeConcentrated {
+		return sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is a concentrated pool, use its positions instead", pool.Id)
+	}
+	if pool.Type == types.PoolTypeWeighted {
+		return sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is a weighted pool, join or exit the pool instead", pool.Id)
+	}
 
 	if msg.PoolCoin.Denom != pool.PoolCoinDenom {
 		return types.ErrWrongPoolCoinDenom
@@ -488,6 +520,162 @@ func (k Keeper) Withdraw(ctx sdk.Context, msg *types.MsgWithdraw) (types.Withdra
 	return req, nil
 }
 
+// ValidateMsgZapDeposit validates types.MsgZapDeposit.
+func (k Keeper) ValidateMsgZapDeposit(ctx sdk.Context, msg *types.MsgZapDeposit) error {
+	_, found := k.assetKeeper.GetApp(ctx, msg.AppId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidAppID, "app id %d not found", msg.AppId)
+	}
+
+	pool, found := k.GetPool(ctx, msg.AppId, msg.PoolId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", msg.PoolId)
+	}
+	if pool.Disabled {
+		return types.ErrDisabledPool
+	}
+	if pool.Type == types.PoolTypeConcentrated {
+		return sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is a concentrated pool, use its positions instead", pool.Id)
+	}
+	if pool.Type == types.PoolTypeWeighted {
+		return sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is a weighted pool, join or exit the pool instead", pool.Id)
+	}
+
+	pair, _ := k.GetPair(ctx, msg.AppId, pool.PairId)
+	if err := k.validateDenomsListed(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom); err != nil {
+		return err
+	}
+
+	if msg.DepositCoin.Denom != pair.BaseCoinDenom && msg.DepositCoin.Denom != pair.QuoteCoinDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", msg.DepositCoin.Denom)
+	}
+
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	for _, r := range []sdk.Coin{rx, ry} {
+		if r.Denom == msg.DepositCoin.Denom && r.Amount.Add(msg.DepositCoin.Amount).GT(amm.MaxCoinAmount) {
+			return types.ErrTooLargePool
+		}
+	}
+
+	return nil
+}
+
+// ZapDeposit handles types.MsgZapDeposit and stores the request.
+// The request is executed within the batch along with other deposit requests.
+func (k Keeper) ZapDeposit(ctx sdk.Context, msg *types.MsgZapDeposit) (types.DepositRequest, error) {
+	if err := k.ValidateMsgZapDeposit(ctx, msg); err != nil {
+		return types.DepositRequest{}, err
+	}
+
+	params, err := k.GetGenericParams(ctx, msg.AppId)
+	if err != nil {
+		return types.DepositRequest{}, sdkerrors.Wrap(err, "params retreval failed")
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetDepositor(), types.GlobalEscrowAddress, sdk.NewCoins(msg.DepositCoin)); err != nil {
+		return types.DepositRequest{}, err
+	}
+
+	pool, _ := k.GetPool(ctx, msg.AppId, msg.PoolId)
+	requestID := k.getNextDepositRequestIDWithUpdate(ctx, pool)
+	req := types.NewZapDepositRequest(msg, pool, requestID, ctx.BlockHeight())
+	k.SetDepositRequest(ctx, req)
+	k.SetDepositRequestIndex(ctx, req)
+
+	ctx.GasMeter().ConsumeGas(params.DepositExtraGas, "DepositExtraGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeZapDeposit,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoin, msg.DepositCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyMinPoolCoinAmount, msg.MinPoolCoinAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyRequestID, strconv.FormatUint(req.Id, 10)),
+		),
+	})
+
+	return req, nil
+}
+
+// ValidateMsgZapWithdraw validates types.MsgZapWithdraw.
+func (k Keeper) ValidateMsgZapWithdraw(ctx sdk.Context, msg *types.MsgZapWithdraw) error {
+	_, found := k.assetKeeper.GetApp(ctx, msg.AppId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidAppID, "app id %d not found", msg.AppId)
+	}
+
+	pool, found := k.GetPool(ctx, msg.AppId, msg.PoolId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", msg.PoolId)
+	}
+	if pool.Disabled {
+		return types.ErrDisabledPool
+	}
+	if pool.Type == types.PoolTypeConcentrated {
+		return sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is a concentrated pool, use its positions instead", pool.Id)
+	}
+	if pool.Type == types.PoolTypeWeighted {
+		return sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is a weighted pool, join or exit the pool instead", pool.Id)
+	}
+
+	if msg.PoolCoin.Denom != pool.PoolCoinDenom {
+		return types.ErrWrongPoolCoinDenom
+	}
+
+	pair, _ := k.GetPair(ctx, msg.AppId, pool.PairId)
+	if msg.DemandCoinDenom != pair.BaseCoinDenom && msg.DemandCoinDenom != pair.QuoteCoinDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", msg.DemandCoinDenom)
+	}
+
+	// The other side of the withdrawn coins is swapped against the remaining
+	// pool, so the pool must not be emptied by the withdrawal.
+	if msg.PoolCoin.Amount.GTE(k.GetPoolCoinSupply(ctx, pool)) {
+		return types.ErrWithdrawAllPoolCoin
+	}
+
+	return nil
+}
+
+// ZapWithdraw handles types.MsgZapWithdraw and stores the request.
+// The request is executed within the batch along with other withdraw requests.
+func (k Keeper) ZapWithdraw(ctx sdk.Context, msg *types.MsgZapWithdraw) (types.WithdrawRequest, error) {
+	if err := k.ValidateMsgZapWithdraw(ctx, msg); err != nil {
+		return types.WithdrawRequest{}, err
+	}
+
+	params, err := k.GetGenericParams(ctx, msg.AppId)
+	if err != nil {
+		return types.WithdrawRequest{}, sdkerrors.Wrap(err, "params retreval failed")
+	}
+
+	pool, _ := k.GetPool(ctx, msg.AppId, msg.PoolId)
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetWithdrawer(), types.GlobalEscrowAddress, sdk.NewCoins(msg.PoolCoin)); err != nil {
+		return types.WithdrawRequest{}, err
+	}
+
+	requestID := k.getNextWithdrawRequestIDWithUpdate(ctx, pool)
+	req := types.NewZapWithdrawRequest(msg, requestID, ctx.BlockHeight())
+	k.SetWithdrawRequest(ctx, req)
+	k.SetWithdrawRequestIndex(ctx, req)
+
+	ctx.GasMeter().ConsumeGas(params.WithdrawExtraGas, "WithdrawExtraGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeZapWithdraw,
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, msg.Withdrawer),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolCoin, msg.PoolCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
+			sdk.NewAttribute(types.AttributeKeyMinDemandCoinAmount, msg.MinDemandCoinAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyRequestID, strconv.FormatUint(req.Id, 10)),
+		),
+	})
+
+	return req, nil
+}
+
 // ExecuteDepositRequest executes a deposit request.
 func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest) error {
 	pool, _ := k.GetPool(ctx, req.AppId, req.PoolId)
@@ -501,7 +689,7 @@ func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest)
 	pair, _ := k.GetPair(ctx, req.AppId, pool.PairId)
 	rx, ry := k.getPoolBalances(ctx, pool, pair)
 	ps := k.GetPoolCoinSupply(ctx, pool)
-	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
+	ammPool := k.GetAMMPool(ctx, pool, rx.Amount, ry.Amount, ps)
 	if ammPool.IsDepleted() {
 		k.MarkPoolAsDisabled(ctx, pool)
 		if err := k.FinishDepositRequest(ctx, req, types.RequestStatusFailed); err != nil {
@@ -510,6 +698,10 @@ func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest)
 		return nil
 	}
 
+	if req.SingleAsset {
+		return k.executeZapDepositRequest(ctx, req, pool, pair, ammPool)
+	}
+
 	ax, ay, pc := amm.Deposit(rx.Amount, ry.Amount, ps, req.DepositCoins.AmountOf(pair.QuoteCoinDenom), req.DepositCoins.AmountOf(pair.BaseCoinDenom))
 
 	if pc.IsZero() {
@@ -542,6 +734,82 @@ func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest)
 	return nil
 }
 
+// executeZapDepositRequest executes a single-sided deposit request.
+// The optimal portion of the deposit coin is swapped against the pool first,
+// then the rest of the deposit coin and the swapped coin are deposited.
+// Unused swapped coin is returned to the depositor, and the request fails
+// as a whole if the minted pool coin is less than the requested minimum.
+func (k Keeper) executeZapDepositRequest(ctx sdk.Context, req types.DepositRequest, pool types.Pool, pair types.Pair, ammPool amm.Pool) error {
+	params, err := k.GetGenericParams(ctx, req.AppId)
+	if err != nil {
+		return sdkerrors.Wrap(err, "params retreval failed")
+	}
+
+	depositCoin := req.DepositCoins[0]
+	isOfferX := depositCoin.Denom == pair.QuoteCoinDenom
+	demandCoinDenom := pair.BaseCoinDenom
+	if !isOfferX {
+		demandCoinDenom = pair.QuoteCoinDenom
+	}
+
+	swapAmt, swapFeeAmt, receivedAmt := amm.ZapDeposit(ammPool, isOfferX, depositCoin.Amount, params.SwapFeeRate)
+	remainingAmt := depositCoin.Amount.Sub(swapAmt).Sub(swapFeeAmt)
+
+	rx, ry := ammPool.Balances()
+	x, y := remainingAmt, receivedAmt
+	if isOfferX {
+		rx, ry = rx.Add(swapAmt), ry.Sub(receivedAmt)
+	} else {
+		rx, ry = rx.Sub(receivedAmt), ry.Add(swapAmt)
+		x, y = receivedAmt, remainingAmt
+	}
+	ax, ay, pc := amm.Deposit(rx, ry, ammPool.PoolCoinSupply(), x, y)
+	if pc.IsZero() || pc.LT(req.MinPoolCoinAmount) {
+		return k.FinishDepositRequest(ctx, req, types.RequestStatusFailed)
+	}
+
+	acceptedOfferAmt, acceptedDemandAmt := ax, ay
+	if !isOfferX {
+		acceptedOfferAmt, acceptedDemandAmt = ay, ax
+	}
+
+	mintedPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, pc)
+	mintingCoins := sdk.NewCoins(mintedPoolCoin)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, mintingCoins); err != nil {
+		return err
+	}
+
+	swappedCoin := sdk.NewCoin(depositCoin.Denom, swapAmt)
+	swapFeeCoin := sdk.NewCoin(depositCoin.Denom, swapFeeAmt)
+	receivedCoin := sdk.NewCoin(demandCoinDenom, receivedAmt)
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(types.GlobalEscrowAddress, pool.GetReserveAddress(), sdk.NewCoins(sdk.NewCoin(depositCoin.Denom, swapAmt.Add(acceptedOfferAmt))))
+	bulkOp.QueueSendCoins(types.GlobalEscrowAddress, pair.GetSwapFeeCollectorAddress(), sdk.NewCoins(swapFeeCoin))
+	// The swapped coin which is not accepted by the deposit is returned.
+	bulkOp.QueueSendCoins(pool.GetReserveAddress(), req.GetDepositor(), sdk.NewCoins(sdk.NewCoin(demandCoinDenom, receivedAmt.Sub(acceptedDemandAmt))))
+	bulkOp.QueueSendCoins(k.accountKeeper.GetModuleAddress(types.ModuleName), req.GetDepositor(), mintingCoins)
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeZapSwap,
+			sdk.NewAttribute(types.AttributeKeyRequestID, strconv.FormatUint(req.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositor, req.Depositor),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(req.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPaidCoin, swappedCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyReceivedCoin, receivedCoin.String()),
+			sdk.NewAttribute(types.AttributeKeySwapFeeCoin, swapFeeCoin.String()),
+		),
+	})
+
+	req.AcceptedCoins = sdk.NewCoins(sdk.NewCoin(depositCoin.Denom, swapAmt.Add(swapFeeAmt).Add(acceptedOfferAmt)))
+	req.MintedPoolCoin = mintedPoolCoin
+	req.SwappedCoin = &swappedCoin
+	return k.FinishDepositRequest(ctx, req, types.RequestStatusSucceeded)
+}
+
 // FinishDepositRequest refunds unhandled deposit coins and set request status.
 func (k Keeper) FinishDepositRequest(ctx sdk.Context, req types.DepositRequest, status types.RequestStatus) error {
 	if req.Status != types.RequestStatusNotExecuted { // sanity check
@@ -592,7 +860,7 @@ func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawReques
 	pair, _ := k.GetPair(ctx, req.AppId, pool.PairId)
 	rx, ry := k.getPoolBalances(ctx, pool, pair)
 	ps := k.GetPoolCoinSupply(ctx, pool)
-	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
+	ammPool := k.GetAMMPool(ctx, pool, rx.Amount, ry.Amount, ps)
 	if ammPool.IsDepleted() {
 		k.MarkPoolAsDisabled(ctx, pool)
 		if err := k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed); err != nil {
@@ -601,6 +869,10 @@ func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawReques
 		return nil
 	}
 
+	if req.IsSingleAsset() {
+		return k.executeZapWithdrawRequest(ctx, req, params, pool, pair, ammPool)
+	}
+
 	x, y := amm.Withdraw(rx.Amount, ry.Amount, ps, req.PoolCoin.Amount, params.WithdrawFeeRate)
 	if x.IsZero() && y.IsZero() {
 		if err := k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed); err != nil {
@@ -635,6 +907,64 @@ func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawReques
 	return nil
 }
 
+// executeZapWithdrawRequest executes a single asset withdraw request.
+// The withdrawn coin on the other side of the demand coin is swapped against
+// the pool right after the withdrawal, and the request fails as a whole if
+// the total demand coin received is less than the requested minimum.
+func (k Keeper) executeZapWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest, params types.GenericParams, pool types.Pool, pair types.Pair, ammPool amm.Pool) error {
+	if req.PoolCoin.Amount.GTE(ammPool.PoolCoinSupply()) {
+		return k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed)
+	}
+
+	isDemandX := req.DemandCoinDenom == pair.QuoteCoinDenom
+	offerCoinDenom := pair.QuoteCoinDenom
+	if isDemandX {
+		offerCoinDenom = pair.BaseCoinDenom
+	}
+
+	x, y, swapFeeAmt, swappedOutAmt := amm.ZapWithdraw(ammPool, req.PoolCoin.Amount, isDemandX, params.WithdrawFeeRate, params.SwapFeeRate)
+	withdrawnDemandAmt, offerAmt := x, y
+	if !isDemandX {
+		withdrawnDemandAmt, offerAmt = y, x
+	}
+	demandAmt := withdrawnDemandAmt.Add(swappedOutAmt)
+	if demandAmt.IsZero() || demandAmt.LT(req.MinDemandCoinAmount) ||
+		(offerAmt.Sub(swapFeeAmt).IsPositive() && swappedOutAmt.IsZero()) {
+		return k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed)
+	}
+
+	withdrawnCoins := sdk.NewCoins(sdk.NewCoin(req.DemandCoinDenom, demandAmt))
+	burningCoins := sdk.NewCoins(req.PoolCoin)
+	swapFeeCoin := sdk.NewCoin(offerCoinDenom, swapFeeAmt)
+
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(types.GlobalEscrowAddress, k.accountKeeper.GetModuleAddress(types.ModuleName), burningCoins)
+	bulkOp.QueueSendCoins(pool.GetReserveAddress(), req.GetWithdrawer(), withdrawnCoins)
+	bulkOp.QueueSendCoins(pool.GetReserveAddress(), pair.GetSwapFeeCollectorAddress(), sdk.NewCoins(swapFeeCoin))
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burningCoins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeZapSwap,
+			sdk.NewAttribute(types.AttributeKeyRequestID, strconv.FormatUint(req.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, req.Withdrawer),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(req.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPaidCoin, sdk.NewCoin(offerCoinDenom, offerAmt.Sub(swapFeeAmt)).String()),
+			sdk.NewAttribute(types.AttributeKeyReceivedCoin, sdk.NewCoin(req.DemandCoinDenom, swappedOutAmt).String()),
+			sdk.NewAttribute(types.AttributeKeySwapFeeCoin, swapFeeCoin.String()),
+		),
+	})
+
+	req.WithdrawnCoins = withdrawnCoins
+	return k.FinishWithdrawRequest(ctx, req, types.RequestStatusSucceeded)
+}
+
 // FinishWithdrawRequest refunds unhandled pool coin and set request status.
 func (k Keeper) FinishWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest, status types.RequestStatus) error {
 	if req.Status != types.RequestStatusNotExecuted { // sanity check
@@ -463,15 +463,16 @@ func (s *KeeperTestSuite) TestDeposit() {
 			),
 			ExpErr: nil,
 			ExpResp: &types.DepositRequest{
-				Id:             1,
-				PoolId:         1,
-				MsgHeight:      0,
-				Depositor:      addr1.String(),
-				DepositCoins:   sdk.NewCoins(sdk.NewCoin(app1Pair.BaseCoinDenom, sdk.NewInt(100000000)), sdk.NewCoin(app1Pair.QuoteCoinDenom, sdk.NewInt(100000000))),
-				AcceptedCoins:  nil,
-				MintedPoolCoin: sdk.NewCoin(app1Pool.PoolCoinDenom, sdk.NewInt(0)),
-				Status:         types.RequestStatusNotExecuted,
-				AppId:          appID1,
+				Id:                1,
+				PoolId:            1,
+				MsgHeight:         0,
+				Depositor:         addr1.String(),
+				DepositCoins:      sdk.NewCoins(sdk.NewCoin(app1Pair.BaseCoinDenom, sdk.NewInt(100000000)), sdk.NewCoin(app1Pair.QuoteCoinDenom, sdk.NewInt(100000000))),
+				AcceptedCoins:     nil,
+				MintedPoolCoin:    sdk.NewCoin(app1Pool.PoolCoinDenom, sdk.NewInt(0)),
+				Status:            types.RequestStatusNotExecuted,
+				AppId:             appID1,
+				MinPoolCoinAmount: sdk.ZeroInt(),
 			},
 			QueryResponseIndex: 0,
 			QueryResponse:      nil,
@@ -484,15 +485,16 @@ func (s *KeeperTestSuite) TestDeposit() {
 			),
 			ExpErr: nil,
 			ExpResp: &types.DepositRequest{
-				Id:             2,
-				PoolId:         1,
-				MsgHeight:      0,
-				Depositor:      addr1.String(),
-				DepositCoins:   sdk.NewCoins(sdk.NewCoin(app1Pair.BaseCoinDenom, sdk.NewInt(300000000)), sdk.NewCoin(app1Pair.QuoteCoinDenom, sdk.NewInt(300000000))),
-				AcceptedCoins:  nil,
-				MintedPoolCoin: sdk.NewCoin(app1Pool.PoolCoinDenom, sdk.NewInt(0)),
-				Status:         types.RequestStatusNotExecuted,
-				AppId:          appID1,
+				Id:                2,
+				PoolId:            1,
+				MsgHeight:         0,
+				Depositor:         addr1.String(),
+				DepositCoins:      sdk.NewCoins(sdk.NewCoin(app1Pair.BaseCoinDenom, sdk.NewInt(300000000)), sdk.NewCoin(app1Pair.QuoteCoinDenom, sdk.NewInt(300000000))),
+				AcceptedCoins:     nil,
+				MintedPoolCoin:    sdk.NewCoin(app1Pool.PoolCoinDenom, sdk.NewInt(0)),
+				Status:            types.RequestStatusNotExecuted,
+				AppId:             appID1,
+				MinPoolCoinAmount: sdk.ZeroInt(),
 			},
 			QueryResponseIndex: 0,
 			QueryResponse:      nil,
@@ -505,15 +507,16 @@ func (s *KeeperTestSuite) TestDeposit() {
 			),
 			ExpErr: nil,
 			ExpResp: &types.DepositRequest{
-				Id:             1,
-				PoolId:         1,
-				MsgHeight:      0,
-				Depositor:      addr1.String(),
-				DepositCoins:   sdk.NewCoins(sdk.NewCoin(app2Pair.BaseCoinDenom, sdk.NewInt(100000000)), sdk.NewCoin(app2Pair.QuoteCoinDenom, sdk.NewInt(100000000))),
-				AcceptedCoins:  nil,
-				MintedPoolCoin: sdk.NewCoin(app2Pool.PoolCoinDenom, sdk.NewInt(0)),
-				Status:         types.RequestStatusNotExecuted,
-				AppId:          appID2,
+				Id:                1,
+				PoolId:            1,
+				MsgHeight:         0,
+				Depositor:         addr1.String(),
+				DepositCoins:      sdk.NewCoins(sdk.NewCoin(app2Pair.BaseCoinDenom, sdk.NewInt(100000000)), sdk.NewCoin(app2Pair.QuoteCoinDenom, sdk.NewInt(100000000))),
+				AcceptedCoins:     nil,
+				MintedPoolCoin:    sdk.NewCoin(app2Pool.PoolCoinDenom, sdk.NewInt(0)),
+				Status:            types.RequestStatusNotExecuted,
+				AppId:             appID2,
+				MinPoolCoinAmount: sdk.ZeroInt(),
 			},
 			QueryResponseIndex: 0,
 			QueryResponse:      nil,
@@ -526,15 +529,16 @@ func (s *KeeperTestSuite) TestDeposit() {
 			),
 			ExpErr: nil,
 			ExpResp: &types.DepositRequest{
-				Id:             2,
-				PoolId:         1,
-				MsgHeight:      0,
-				Depositor:      addr1.String(),
-				DepositCoins:   sdk.NewCoins(sdk.NewCoin(app2Pair.BaseCoinDenom, sdk.NewInt(700000000)), sdk.NewCoin(app2Pair.QuoteCoinDenom, sdk.NewInt(700000000))),
-				AcceptedCoins:  nil,
-				MintedPoolCoin: sdk.NewCoin(app2Pool.PoolCoinDenom, sdk.NewInt(0)),
-				Status:         types.RequestStatusNotExecuted,
-				AppId:          appID2,
+				Id:                2,
+				PoolId:            1,
+				MsgHeight:         0,
+				Depositor:         addr1.String(),
+				DepositCoins:      sdk.NewCoins(sdk.NewCoin(app2Pair.BaseCoinDenom, sdk.NewInt(700000000)), sdk.NewCoin(app2Pair.QuoteCoinDenom, sdk.NewInt(700000000))),
+				AcceptedCoins:     nil,
+				MintedPoolCoin:    sdk.NewCoin(app2Pool.PoolCoinDenom, sdk.NewInt(0)),
+				Status:            types.RequestStatusNotExecuted,
+				AppId:             appID2,
+				MinPoolCoinAmount: sdk.ZeroInt(),
 			},
 			QueryResponseIndex: 0,
 			QueryResponse:      nil,
@@ -754,14 +758,15 @@ func (s *KeeperTestSuite) TestWithdraw() {
 			),
 			ExpErr: nil,
 			ExpResp: &types.WithdrawRequest{
-				Id:             1,
-				PoolId:         1,
-				MsgHeight:      0,
-				Withdrawer:     addr1.String(),
-				PoolCoin:       availablePoolBalance,
-				WithdrawnCoins: nil,
-				Status:         types.RequestStatusNotExecuted,
-				AppId:          1,
+				Id:                  1,
+				PoolId:              1,
+				MsgHeight:           0,
+				Withdrawer:          addr1.String(),
+				PoolCoin:            availablePoolBalance,
+				WithdrawnCoins:      nil,
+				Status:              types.RequestStatusNotExecuted,
+				AppId:               1,
+				MinDemandCoinAmount: sdk.ZeroInt(),
 			},
 			AvailableBalance: sdk.NewCoins(),
 		},
@@ -908,6 +913,184 @@ func (s *KeeperTestSuite) TestWithdrawRequestsByWithdrawer() {
 	s.Require().Equal(req2.Id, reqs[1].Id)
 }
 
+func (s *KeeperTestSuite) TestZapDeposit() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, addr1, asset1.Denom, asset2.Denom)
+	pool := s.CreateNewLiquidityPool(appID1, pair.Id, addr1, "1000000000uasset1,2000000000uasset2")
+	ps := s.keeper.GetPoolCoinSupply(s.ctx, pool)
+
+	req := s.ZapDeposit(appID1, pool.Id, addr2, "10000000uasset2", sdk.ZeroInt())
+	s.Require().True(req.SingleAsset)
+	s.Require().True(utils.ParseCoins("10000000uasset2").IsEqual(s.getBalances(types.GlobalEscrowAddress)))
+
+	liquidity.EndBlocker(s.ctx, s.keeper, s.app.AssetKeeper)
+	req, found := s.keeper.GetDepositRequest(s.ctx, appID1, req.PoolId, req.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+	s.Require().True(req.SwappedCoin.IsPositive())
+
+	// Almost all of the deposit coin is used, so the depositor gets nearly
+	// half the pool coin of a balanced deposit of the same value, minus the swap fee.
+	pc := s.getBalance(addr2, pool.PoolCoinDenom)
+	s.Require().True(req.MintedPoolCoin.IsEqual(pc))
+	s.Require().True(pc.Amount.GT(ps.QuoRaw(400).MulRaw(994).QuoRaw(1000)))
+	s.Require().True(pc.Amount.LT(ps.QuoRaw(400)))
+	s.Require().True(s.getBalance(addr2, "uasset1").Amount.LTE(sdk.NewInt(10)))
+	s.Require().True(s.getBalance(addr2, "uasset2").Amount.LTE(sdk.NewInt(10)))
+	s.Require().True(s.getBalances(types.GlobalEscrowAddress).IsZero())
+
+	// The swap fee goes to the pair's swap fee collector.
+	params, err := s.keeper.GetGenericParams(s.ctx, appID1)
+	s.Require().NoError(err)
+	swapFee := req.SwappedCoin.Amount.ToDec().MulTruncate(params.SwapFeeRate).TruncateInt()
+	s.Require().True(intEq(swapFee, s.getBalance(pair.GetSwapFeeCollectorAddress(), "uasset2").Amount))
+}
+
+func (s *KeeperTestSuite) TestZapDepositRefundTooSmallMintedPoolCoin() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, addr1, asset1.Denom, asset2.Denom)
+	pool := s.CreateNewLiquidityPool(appID1, pair.Id, addr1, "1000000000uasset1,2000000000uasset2")
+	ps := s.keeper.GetPoolCoinSupply(s.ctx, pool)
+	rx, ry := s.keeper.GetPoolBalances(s.ctx, pool)
+
+	// Minimum pool coin amount equal to a balanced deposit of the same value
+	// cannot be satisfied.
+	req := s.ZapDeposit(appID1, pool.Id, addr2, "10000000uasset2", ps.QuoRaw(200))
+	liquidity.EndBlocker(s.ctx, s.keeper, s.app.AssetKeeper)
+	req, _ = s.keeper.GetDepositRequest(s.ctx, appID1, req.PoolId, req.Id)
+	s.Require().Equal(types.RequestStatusFailed, req.Status)
+	s.Require().True(req.DepositCoins.IsEqual(s.getBalances(addr2)))
+
+	// The pool is left untouched.
+	rx2, ry2 := s.keeper.GetPoolBalances(s.ctx, pool)
+	s.Require().True(rx.IsEqual(rx2))
+	s.Require().True(ry.IsEqual(ry2))
+}
+
+func (s *KeeperTestSuite) TestZapDepositValidation() {
+	addr1 := s.addr(1)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, addr1, asset1.Denom, asset2.Denom)
+	pool := s.CreateNewLiquidityPool(appID1, pair.Id, addr1, "1000000uasset1,1000000uasset2")
+
+	_, err := s.keeper.ZapDeposit(s.ctx, types.NewMsgZapDeposit(appID1, addr1, pool.Id, utils.ParseCoin("1000uasset3"), sdk.ZeroInt()))
+	s.Require().ErrorIs(err, types.ErrInvalidCoinDenom)
+
+	_, err = s.keeper.ZapDeposit(s.ctx, types.NewMsgZapDeposit(appID1, addr1, pool.Id, utils.ParseCoin("10000000000000000000000000000000000000000uasset1"), sdk.ZeroInt()))
+	s.Require().ErrorIs(err, types.ErrTooLargePool)
+
+	_, err = s.keeper.ZapDeposit(s.ctx, types.NewMsgZapDeposit(appID1, addr1, 2, utils.ParseCoin("1000uasset1"), sdk.ZeroInt()))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
+
+func (s *KeeperTestSuite) TestZapDepositRangedPool() {
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "denom1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "denom2", 1000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, s.addr(0), asset1.Denom, asset2.Denom)
+	pool := s.CreateNewLiquidityRangedPool(appID1, pair.Id, s.addr(1), "1000000000denom1,1000000000denom2", utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"))
+
+	req := s.ZapDeposit(appID1, pool.Id, s.addr(2), "1000000denom1", sdk.ZeroInt())
+	liquidity.EndBlocker(s.ctx, s.keeper, s.app.AssetKeeper)
+	req, _ = s.keeper.GetDepositRequest(s.ctx, appID1, req.PoolId, req.Id)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+
+	s.Require().True(s.getBalance(s.addr(2), pool.PoolCoinDenom).IsPositive())
+	s.Require().True(s.getBalance(s.addr(2), "denom1").Amount.LTE(sdk.NewInt(10)))
+	s.Require().True(s.getBalance(s.addr(2), "denom2").Amount.LTE(sdk.NewInt(10)))
+}
+
+func (s *KeeperTestSuite) TestZapWithdraw() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+	addr3 := s.addr(3)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, addr1, asset1.Denom, asset2.Denom)
+	pool := s.CreateNewLiquidityPool(appID1, pair.Id, addr1, "1000000000uasset1,1000000000uasset2")
+
+	s.Deposit(appID1, pool.Id, addr2, "1000000uasset1,1000000uasset2")
+	s.Deposit(appID1, pool.Id, addr3, "1000000uasset1,1000000uasset2")
+	s.nextBlock()
+
+	// addr2 withdraws normally and addr3 withdraws into uasset1 only.
+	s.Withdraw(appID1, pool.Id, addr2, s.getBalance(addr2, pool.PoolCoinDenom))
+	s.nextBlock()
+	req := s.ZapWithdraw(appID1, pool.Id, addr3, s.getBalance(addr3, pool.PoolCoinDenom), "uasset1", sdk.ZeroInt())
+	liquidity.EndBlocker(s.ctx, s.keeper, s.app.AssetKeeper)
+
+	req, found := s.keeper.GetWithdrawRequest(s.ctx, appID1, req.PoolId, req.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+
+	balances := s.getBalances(addr3)
+	s.Require().Len(balances, 1)
+	s.Require().True(req.WithdrawnCoins.IsEqual(balances))
+
+	// The single asset withdrawal gives nearly twice the uasset1 of a normal
+	// withdrawal, minus the swap fee.
+	normal := s.getBalance(addr2, "uasset1").Amount
+	zapped := balances.AmountOf("uasset1")
+	s.Require().True(zapped.GT(normal.MulRaw(2).MulRaw(997).QuoRaw(1000)))
+	s.Require().True(zapped.LT(normal.MulRaw(2)))
+	s.Require().True(s.getBalance(pair.GetSwapFeeCollectorAddress(), "uasset2").IsPositive())
+}
+
+func (s *KeeperTestSuite) TestZapWithdrawRefund() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, addr1, asset1.Denom, asset2.Denom)
+	pool := s.CreateNewLiquidityPool(appID1, pair.Id, addr1, "1000000000uasset1,1000000000uasset2")
+
+	s.Deposit(appID1, pool.Id, addr2, "1000000uasset1,1000000uasset2")
+	s.nextBlock()
+	poolCoin := s.getBalance(addr2, pool.PoolCoinDenom)
+
+	// The minimum demand coin amount cannot be satisfied.
+	req := s.ZapWithdraw(appID1, pool.Id, addr2, poolCoin, "uasset2", sdk.NewInt(2000000))
+	liquidity.EndBlocker(s.ctx, s.keeper, s.app.AssetKeeper)
+	req, _ = s.keeper.GetWithdrawRequest(s.ctx, appID1, req.PoolId, req.Id)
+	s.Require().Equal(types.RequestStatusFailed, req.Status)
+	s.Require().True(sdk.NewCoins(poolCoin).IsEqual(s.getBalances(addr2)))
+
+	_, err := s.keeper.ZapWithdraw(s.ctx, types.NewMsgZapWithdraw(appID1, addr2, pool.Id, poolCoin, "uasset3", sdk.ZeroInt()))
+	s.Require().ErrorIs(err, types.ErrInvalidCoinDenom)
+
+	_, err = s.keeper.ZapWithdraw(s.ctx, types.NewMsgZapWithdraw(appID1, addr1, pool.Id, s.getBalance(addr1, pool.PoolCoinDenom).Add(poolCoin), "uasset2", sdk.ZeroInt()))
+	s.Require().ErrorIs(err, types.ErrWithdrawAllPoolCoin)
+}
+
 func (s *KeeperTestSuite) TestCreateRangedPool() {
 	addr1 := s.addr(1)
 	dummyAddr := s.addr(696969)
@@ -0,0 +1,561 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/comdex-official/comdex/x/liquidity/amm"
+	"github.com/comdex-official/comdex/x/liquidity/types"
+)
+
+// getNextPositionIDWithUpdate increments the pool's last position id and
+// returns it.
+func (k Keeper) getNextPositionIDWithUpdate(ctx sdk.Context, pool types.Pool) uint64 {
+	id := pool.LastPositionId + 1
+	pool.LastPositionId = id
+	k.SetPool(ctx, pool)
+	return id
+}
+
+// validatePositionPriceRange validates that the position's price range is
+// on ticks.
+func (k Keeper) validatePositionPriceRange(minPrice, maxPrice sdk.Dec, params types.GenericParams) error {
+	tickPrec := int(params.TickPrecision)
+	if !amm.PriceToDownTick(minPrice, tickPrec).Equal(minPrice) {
+		return sdkerrors.Wrap(types.ErrPriceNotOnTicks, "min price is not on ticks")
+	}
+	if !amm.PriceToDownTick(maxPrice, tickPrec).Equal(maxPrice) {
+		return sdkerrors.Wrap(types.ErrPriceNotOnTicks, "max price is not on ticks")
+	}
+	lowestTick := amm.LowestTick(tickPrec)
+	if minPrice.LT(lowestTick) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min price must not be less than %s", lowestTick)
+	}
+	return nil
+}
+
+// validateDepositCoinDenoms validates that the deposit coins are in the pair.
+func validateDepositCoinDenoms(depositCoins sdk.Coins, pair types.Pair) error {
+	for _, coin := range depositCoins {
+		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
+			return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// getConcentratedPool returns an active concentrated pool.
+func (k Keeper) getConcentratedPool(ctx sdk.Context, appID, poolID uint64) (types.Pool, error) {
+	pool, found := k.GetPool(ctx, appID, poolID)
+	if !found {
+		return types.Pool{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", poolID)
+	}
+	if pool.Type != types.PoolTypeConcentrated {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is not a concentrated pool", poolID)
+	}
+	return pool, nil
+}
+
+// getOwnedPosition returns the position if it's owned by owner.
+func (k Keeper) getOwnedPosition(ctx sdk.Context, appID, poolID, positionID uint64, owner string) (types.Position, error) {
+	position, found := k.GetPosition(ctx, appID, poolID, positionID)
+	if !found {
+		return types.Position{}, sdkerrors.Wrapf(types.ErrPositionNotFound, "position %d not found in pool %d", positionID, poolID)
+	}
+	if position.Owner != owner {
+		return types.Position{}, types.ErrNotPositionOwner
+	}
+	return position, nil
+}
+
+// concentratedPoolPrice returns the current price of a concentrated pool,
+// which is kept in the pool's state.
+func (k Keeper) concentratedPoolPrice(pool types.Pool) (sdk.Dec, error) {
+	if pool.Type != types.PoolTypeConcentrated {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is not a concentrated pool", pool.Id)
+	}
+	if pool.SqrtPrice == nil || !pool.SqrtPrice.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrDepletedPool, "pool %d is depleted", pool.Id)
+	}
+	return pool.SqrtPrice.Power(2), nil
+}
+
+// addActiveLiquidity adds the liquidity change of a position to the active
+// liquidity of its pool when the position is in range at the pool price.
+func (k Keeper) addActiveLiquidity(ctx sdk.Context, position types.Position, delta sdk.Dec) {
+	pool, _ := k.GetPool(ctx, position.AppId, position.PoolId)
+	if !amm.ConcentratedInRange(position.MinPrice, position.MaxPrice, *pool.SqrtPrice) {
+		return
+	}
+	activeLiquidity := pool.ActiveLiquidity.Add(delta)
+	pool.ActiveLiquidity = &activeLiquidity
+	k.SetPool(ctx, pool)
+}
+
+// ValidateMsgCreateConcentratedPool validates types.MsgCreateConcentratedPool.
+func (k Keeper) ValidateMsgCreateConcentratedPool(ctx sdk.Context, msg *types.MsgCreateConcentratedPool) error {
+	_, found := k.assetKeeper.GetApp(ctx, msg.AppId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidAppID, "app id %d not found", msg.AppId)
+	}
+
+	params, err := k.GetGenericParams(ctx, msg.AppId)
+	if err != nil {
+		return sdkerrors.Wrap(err, "params retreval failed")
+	}
+
+	if err := k.validatePositionPriceRange(msg.MinPrice, msg.MaxPrice, params); err != nil {
+		return err
+	}
+	if !amm.PriceToDownTick(msg.InitialPrice, int(params.TickPrecision)).Equal(msg.InitialPrice) {
+		return sdkerrors.Wrap(types.ErrPriceNotOnTicks, "initial price is not on ticks")
+	}
+
+	pair, found := k.GetPair(ctx, msg.AppId, msg.PairId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
+	}
+
+	if err := k.validateDenomsListed(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom); err != nil {
+		return err
+	}
+
+	if err := validateDepositCoinDenoms(msg.DepositCoins, pair); err != nil {
+		return err
+	}
+
+	numActivePools := 0
+	_ = k.IteratePoolsByPair(ctx, pair.AppId, pair.Id, func(pool types.Pool) (stop bool, err error) {
+		if !pool.Disabled {
+			numActivePools++
+		}
+		return false, nil
+	})
+	if uint64(numActivePools) >= params.MaxNumActivePoolsPerPair {
+		return types.ErrTooManyPools
+	}
+
+	return nil
+}
+
+// CreateConcentratedPool handles types.MsgCreateConcentratedPool and creates
+// a concentrated pool with the creator's first position.
+func (k Keeper) CreateConcentratedPool(ctx sdk.Context, msg *types.MsgCreateConcentratedPool) (types.Pool, error) {
+	if err := k.ValidateMsgCreateConcentratedPool(ctx, msg); err != nil {
+		return types.Pool{}, err
+	}
+
+	params, err := k.GetGenericParams(ctx, msg.AppId)
+	if err != nil {
+		return types.Pool{}, sdkerrors.Wrap(err, "params retreval failed")
+	}
+
+	pair, _ := k.GetPair(ctx, msg.AppId, msg.PairId)
+
+	x, y := msg.DepositCoins.AmountOf(pair.QuoteCoinDenom), msg.DepositCoins.AmountOf(pair.BaseCoinDenom)
+	liquidity, ax, ay := amm.ConcentratedDeposit(x, y, msg.MinPrice, msg.MaxPrice, msg.InitialPrice)
+
+	minInitDepositAmt := params.MinInitialDepositAmount
+	if !liquidity.IsPositive() || (ax.LT(minInitDepositAmt) && ay.LT(minInitDepositAmt)) {
+		return types.Pool{}, types.ErrInsufficientDepositAmount
+	}
+
+	creator := msg.GetCreator()
+
+	// Create and save the new pool object.
+	poolID := k.getNextPoolIDWithUpdate(ctx, msg.AppId)
+	pool := types.NewConcentratedPool(msg.AppId, poolID, pair.Id, creator, msg.FeeRate, msg.InitialPrice)
+	pool.LastPositionId = 1
+	k.SetPool(ctx, pool)
+	k.SetPoolByReserveIndex(ctx, pool)
+	k.SetPoolsByPairIndex(ctx, pool)
+
+	position := types.NewPosition(msg.AppId, poolID, pool.LastPositionId, creator, msg.MinPrice, msg.MaxPrice, liquidity)
+	k.SetPosition(ctx, position)
+	k.addActiveLiquidity(ctx, position, liquidity)
+
+	// Send deposit coins to the pool's reserve account.
+	acceptedCoins := sdk.NewCoins(
+		sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
+	if err := k.bankKeeper.SendCoins(ctx, creator, pool.GetReserveAddress(), acceptedCoins); err != nil {
+		return types.Pool{}, err
+	}
+
+	// Send the pool creation fee to the fee collector.
+	feeCollectorAddr, _ := sdk.AccAddressFromBech32(params.FeeCollectorAddress)
+	if err := k.bankKeeper.SendCoins(ctx, creator, feeCollectorAddr, params.PoolCreationFee); err != nil {
+		return types.Pool{}, sdkerrors.Wrap(err, "insufficient pool creation fee")
+	}
+
+	ctx.GasMeter().ConsumeGas(types.CreatePoolGas, "CreatePoolGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateConcentratedPool,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyPairID, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFeeRate, msg.FeeRate.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyAcceptedCoins, acceptedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionID, strconv.FormatUint(position.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyReserveAddress, pool.ReserveAddress),
+			sdk.NewAttribute(types.AttributeKeyLiquidity, liquidity.String()),
+		),
+	})
+
+	return pool, nil
+}
+
+// depositToPosition deposits coins to the position's price range at the
+// current pool price and returns the minted liquidity and accepted coins.
+func (k Keeper) depositToPosition(
+	ctx sdk.Context, pool types.Pool, pair types.Pair, depositor sdk.AccAddress,
+	minPrice, maxPrice sdk.Dec, depositCoins sdk.Coins,
+) (sdk.Dec, sdk.Coins, error) {
+	price, err := k.concentratedPoolPrice(pool)
+	if err != nil {
+		return sdk.Dec{}, nil, err
+	}
+
+	x, y := depositCoins.AmountOf(pair.QuoteCoinDenom), depositCoins.AmountOf(pair.BaseCoinDenom)
+	liquidity, ax, ay := amm.ConcentratedDeposit(x, y, minPrice, maxPrice, price)
+	if !liquidity.IsPositive() || (ax.IsZero() && ay.IsZero()) {
+		return sdk.Dec{}, nil, types.ErrInsufficientDepositAmount
+	}
+
+	acceptedCoins := sdk.NewCoins(
+		sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
+	if err := k.bankKeeper.SendCoins(ctx, depositor, pool.GetReserveAddress(), acceptedCoins); err != nil {
+		return sdk.Dec{}, nil, err
+	}
+	return liquidity, acceptedCoins, nil
+}
+
+// ValidateMsgOpenPosition validates types.MsgOpenPosition.
+func (k Keeper) ValidateMsgOpenPosition(ctx sdk.Context, msg *types.MsgOpenPosition) error {
+	_, found := k.assetKeeper.GetApp(ctx, msg.AppId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidAppID, "app id %d not found", msg.AppId)
+	}
+
+	params, err := k.GetGenericParams(ctx, msg.AppId)
+	if err != nil {
+		return sdkerrors.Wrap(err, "params retreval failed")
+	}
+
+	pool, err := k.getConcentratedPool(ctx, msg.AppId, msg.PoolId)
+	if err != nil {
+		return err
+	}
+	if pool.Disabled {
+		return types.ErrDisabledPool
+	}
+
+	if err := k.validatePositionPriceRange(msg.MinPrice, msg.MaxPrice, params); err != nil {
+		return err
+	}
+
+	pair, _ := k.GetPair(ctx, msg.AppId, pool.PairId)
+	if err := k.validateDenomsListed(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom); err != nil {
+		return err
+	}
+	return validateDepositCoinDenoms(msg.DepositCoins, pair)
+}
+
+// OpenPosition handles types.MsgOpenPosition and opens a new position in
+// a concentrated pool.
+func (k Keeper) OpenPosition(ctx sdk.Context, msg *types.MsgOpenPosition) (types.Position, error) {
+	if err := k.ValidateMsgOpenPosition(ctx, msg); err != nil {
+		return types.Position{}, err
+	}
+
+	pool, _ := k.GetPool(ctx, msg.AppId, msg.PoolId)
+	pair, _ := k.GetPair(ctx, msg.AppId, pool.PairId)
+	depositor := msg.GetDepositor()
+
+	liquidity, acceptedCoins, err := k.depositToPosition(
+		ctx, pool, pair, depositor, msg.MinPrice, msg.MaxPrice, msg.DepositCoins)
+	if err != nil {
+		return types.Position{}, err
+	}
+
+	id := k.getNextPositionIDWithUpdate(ctx, pool)
+	position := types.NewPosition(msg.AppId, pool.Id, id, depositor, msg.MinPrice, msg.MaxPrice, liquidity)
+	k.SetPosition(ctx, position)
+	k.addActiveLiquidity(ctx, position, liquidity)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeOpenPosition,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionID, strconv.FormatUint(position.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMinPrice, msg.MinPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxPrice, msg.MaxPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyAcceptedCoins, acceptedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidity, liquidity.String()),
+		),
+	})
+
+	return position, nil
+}
+
+// ValidateMsgAddLiquidity validates types.MsgAddLiquidity.
+func (k Keeper) ValidateMsgAddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity) error {
+	_, found := k.assetKeeper.GetApp(ctx, msg.AppId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidAppID, "app id %d not found", msg.AppId)
+	}
+
+	pool, err := k.getConcentratedPool(ctx, msg.AppId, msg.PoolId)
+	if err != nil {
+		return err
+	}
+	if pool.Disabled {
+		return types.ErrDisabledPool
+	}
+
+	if _, err := k.getOwnedPosition(ctx, msg.AppId, msg.PoolId, msg.PositionId, msg.Depositor); err != nil {
+		return err
+	}
+
+	pair, _ := k.GetPair(ctx, msg.AppId, pool.PairId)
+	if err := k.validateDenomsListed(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom); err != nil {
+		return err
+	}
+	return validateDepositCoinDenoms(msg.DepositCoins, pair)
+}
+
+// AddLiquidity handles types.MsgAddLiquidity and deposits coins to
+// an existing position.
+func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity) (types.Position, error) {
+	if err := k.ValidateMsgAddLiquidity(ctx, msg); err != nil {
+		return types.Position{}, err
+	}
+
+	pool, _ := k.GetPool(ctx, msg.AppId, msg.PoolId)
+	pair, _ := k.GetPair(ctx, msg.AppId, pool.PairId)
+	position, _ := k.GetPosition(ctx, msg.AppId, msg.PoolId, msg.PositionId)
+
+	liquidity, acceptedCoins, err := k.depositToPosition(
+		ctx, pool, pair, msg.GetDepositor(), position.MinPrice, position.MaxPrice, msg.DepositCoins)
+	if err != nil {
+		return types.Position{}, err
+	}
+
+	position.Liquidity = position.Liquidity.Add(liquidity)
+	k.SetPosition(ctx, position)
+	k.addActiveLiquidity(ctx, position, liquidity)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddLiquidity,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionID, strconv.FormatUint(position.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyAcceptedCoins, acceptedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidity, liquidity.String()),
+		),
+	})
+
+	return position, nil
+}
+
+// ValidateMsgRemoveLiquidity validates types.MsgRemoveLiquidity.
+func (k Keeper) ValidateMsgRemoveLiquidity(ctx sdk.Context, msg *types.MsgRemoveLiquidity) error {
+	_, found := k.assetKeeper.GetApp(ctx, msg.AppId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidAppID, "app id %d not found", msg.AppId)
+	}
+
+	if _, err := k.getConcentratedPool(ctx, msg.AppId, msg.PoolId); err != nil {
+		return err
+	}
+
+	position, err := k.getOwnedPosition(ctx, msg.AppId, msg.PoolId, msg.PositionId, msg.Withdrawer)
+	if err != nil {
+		return err
+	}
+	if msg.Liquidity.GT(position.Liquidity) {
+		return sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "%s is greater than the position's liquidity %s", msg.Liquidity, position.Liquidity)
+	}
+	return nil
+}
+
+// RemoveLiquidity handles types.MsgRemoveLiquidity and withdraws liquidity
+// from a position along with the fees accrued to it.
+// The position is removed when its whole liquidity is withdrawn.
+func (k Keeper) RemoveLiquidity(ctx sdk.Context, msg *types.MsgRemoveLiquidity) (sdk.Coins, error) {
+	if err := k.ValidateMsgRemoveLiquidity(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	pool, _ := k.GetPool(ctx, msg.AppId, msg.PoolId)
+	pair, _ := k.GetPair(ctx, msg.AppId, pool.PairId)
+	position, _ := k.GetPosition(ctx, msg.AppId, msg.PoolId, msg.PositionId)
+	withdrawer := msg.GetWithdrawer()
+
+	price, err := k.concentratedPoolPrice(pool)
+	if err != nil {
+		return nil, err
+	}
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+
+	x, y := amm.ConcentratedWithdraw(msg.Liquidity, position.MinPrice, position.MaxPrice, price)
+	withdrawnCoins := sdk.NewCoins(
+		sdk.NewCoin(pair.QuoteCoinDenom, sdk.MinInt(x, rx.Amount)),
+		sdk.NewCoin(pair.BaseCoinDenom, sdk.MinInt(y, ry.Amount)))
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetReserveAddress(), withdrawer, withdrawnCoins); err != nil {
+		return nil, err
+	}
+
+	collectedFees := position.AccruedFees
+	if !collectedFees.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetFeeCollectorAddress(), withdrawer, collectedFees); err != nil {
+			return nil, err
+		}
+	}
+
+	k.addActiveLiquidity(ctx, position, msg.Liquidity.Neg())
+	position.Liquidity = position.Liquidity.Sub(msg.Liquidity)
+	position.AccruedFees = sdk.Coins{}
+	if position.Liquidity.IsZero() {
+		k.DeletePosition(ctx, position)
+	} else {
+		k.SetPosition(ctx, position)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveLiquidity,
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, msg.Withdrawer),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionID, strconv.FormatUint(position.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyLiquidity, msg.Liquidity.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawnCoins, withdrawnCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyCollectedFees, collectedFees.String()),
+		),
+	})
+
+	return withdrawnCoins.Add(collectedFees...), nil
+}
+
+// CollectFees handles types.MsgCollectFees and sends the fees accrued to
+// a position to its owner.
+func (k Keeper) CollectFees(ctx sdk.Context, msg *types.MsgCollectFees) (sdk.Coins, error) {
+	_, found := k.assetKeeper.GetApp(ctx, msg.AppId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAppID, "app id %d not found", msg.AppId)
+	}
+
+	pool, err := k.getConcentratedPool(ctx, msg.AppId, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := k.getOwnedPosition(ctx, msg.AppId, msg.PoolId, msg.PositionId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	collectedFees := position.AccruedFees
+	if collectedFees.IsZero() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no fees accrued to the position")
+	}
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetFeeCollectorAddress(), msg.GetOwner(), collectedFees); err != nil {
+		return nil, err
+	}
+
+	position.AccruedFees = sdk.Coins{}
+	k.SetPosition(ctx, position)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCollectFees,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionID, strconv.FormatUint(position.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCollectedFees, collectedFees.String()),
+		),
+	})
+
+	return collectedFees, nil
+}
+
+// ApplyConcentratedPoolSwap moves the price of a concentrated pool along
+// its liquidity curve by the coin the pool paid in a swap, and accrues the
+// received coin beyond what the curve takes in return, which is the swap
+// fee, to the positions that were in range pro rata to their liquidity.
+func (k Keeper) ApplyConcentratedPoolSwap(
+	ctx sdk.Context, pool types.Pool, pair types.Pair, dir types.OrderDirection, paidCoin, receivedCoin sdk.Coin,
+) error {
+	positions := k.GetPositionsByPool(ctx, pool.AppId, pool.Id)
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	ammPool := pool.ConcentratedAMMPool(rx.Amount, ry.Amount, positions)
+	if ammPool.IsDepleted() {
+		return nil
+	}
+
+	prevSqrtPrice, prevActiveLiquidity := ammPool.SqrtPrice(), *pool.ActiveLiquidity
+	ammDir := amm.Sell
+	if dir == types.OrderDirectionBuy {
+		ammDir = amm.Buy
+	}
+	required := ammPool.Swap(ammDir, paidCoin.Amount)
+	sqrtPrice, activeLiquidity := ammPool.SqrtPrice(), ammPool.ActiveLiquidity()
+	pool.SqrtPrice = &sqrtPrice
+	pool.ActiveLiquidity = &activeLiquidity
+	k.SetPool(ctx, pool)
+
+	fee := receivedCoin.Amount.Sub(required)
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	// The fee goes to the positions in range where the swap started, or to
+	// the ones in range where it ended when the swap started from a price
+	// range without liquidity.
+	shareSqrtPrice, shareBase := prevSqrtPrice, prevActiveLiquidity
+	if !shareBase.IsPositive() {
+		shareSqrtPrice, shareBase = sqrtPrice, activeLiquidity
+	}
+	if !shareBase.IsPositive() {
+		return nil
+	}
+	accruedAmt := sdk.ZeroInt()
+	for _, position := range positions {
+		if !amm.ConcentratedInRange(position.MinPrice, position.MaxPrice, shareSqrtPrice) {
+			continue
+		}
+		amt := fee.ToDec().Mul(position.Liquidity).QuoTruncate(shareBase).TruncateInt()
+		if !amt.IsPositive() {
+			continue
+		}
+		position.AccruedFees = position.AccruedFees.Add(sdk.NewCoin(receivedCoin.Denom, amt))
+		k.SetPosition(ctx, position)
+		accruedAmt = accruedAmt.Add(amt)
+	}
+	if accruedAmt.IsZero() {
+		return nil
+	}
+	accruedFees := sdk.NewCoins(sdk.NewCoin(receivedCoin.Denom, accruedAmt))
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetReserveAddress(), pool.GetFeeCollectorAddress(), accruedFees); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAccrueFees,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPrice, sqrtPrice.Power(2).String()),
+			sdk.NewAttribute(types.AttributeKeyAccruedFees, accruedFees.String()),
+		),
+	})
+
+	return nil
+}
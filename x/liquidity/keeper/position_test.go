@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"time"
+
+	utils "github.com/comdex-official/comdex/types"
+	"github.com/comdex-official/comdex/x/liquidity"
+	"github.com/comdex-official/comdex/x/liquidity/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	_ "github.com/stretchr/testify/suite"
+)
+
+func (s *KeeperTestSuite) TestCreateConcentratedPool() {
+	addr1 := s.addr(1)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, addr1, asset1.Denom, asset2.Denom)
+	pool := s.CreateNewConcentratedPool(
+		appID1, pair.Id, addr1, utils.ParseDec("0.003"), "1000000000uasset1,1000000000uasset2",
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"))
+	s.Require().Equal(types.PoolTypeConcentrated, pool.Type)
+	s.Require().Equal(uint64(1), pool.LastPositionId)
+
+	position, found := s.keeper.GetPosition(s.ctx, appID1, pool.Id, 1)
+	s.Require().True(found)
+	s.Require().Equal(addr1.String(), position.Owner)
+	s.Require().True(position.Liquidity.IsPositive())
+
+	// No pool coin is minted for a concentrated pool.
+	s.Require().True(s.keeper.GetPoolCoinSupply(s.ctx, pool).IsZero())
+	s.Require().True(utils.ParseCoins("1000000000uasset1,1000000000uasset2").IsEqual(s.getBalances(pool.GetReserveAddress())))
+
+	resp, err := s.querier.Pool(sdk.WrapSDKContext(s.ctx), &types.QueryPoolRequest{AppId: appID1, PoolId: pool.Id})
+	s.Require().NoError(err)
+	s.Require().NotNil(resp.Pool.Price)
+	s.Require().True(utils.DecApproxEqual(utils.ParseDec("1.0"), *resp.Pool.Price))
+	s.Require().True(decEq(utils.ParseDec("0.003"), *resp.Pool.FeeRate))
+
+	// Concentrated pools don't accept batch deposits.
+	_, err = s.keeper.Deposit(s.ctx, types.NewMsgDeposit(appID1, addr1, pool.Id, utils.ParseCoins("1000000uasset1,1000000uasset2")))
+	s.Require().ErrorIs(err, types.ErrWrongPoolType)
+}
+
+func (s *KeeperTestSuite) TestOpenPositionAndRemoveLiquidity() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, addr1, asset1.Denom, asset2.Denom)
+	pool := s.CreateNewConcentratedPool(
+		appID1, pair.Id, addr1, utils.ParseDec("0.003"), "1000000000uasset1,1000000000uasset2",
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"))
+
+	// A range above the current price accepts the base coin only.
+	position := s.OpenPosition(appID1, pool.Id, addr2, utils.ParseDec("1.5"), utils.ParseDec("3.0"), "1000000uasset1,1000000uasset2")
+	s.Require().Equal(uint64(2), position.Id)
+	s.Require().True(utils.ParseCoins("1000000uasset2").IsEqual(s.getBalances(addr2)))
+
+	resp, err := s.querier.Positions(sdk.WrapSDKContext(s.ctx), &types.QueryPositionsRequest{
+		AppId: appID1, PoolId: pool.Id, Owner: addr2.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Positions, 1)
+	s.Require().Equal(position.Id, resp.Positions[0].Position.Id)
+	s.Require().True(resp.Positions[0].Balances.QuoteCoin.IsZero())
+
+	_, err = s.keeper.RemoveLiquidity(s.ctx, types.NewMsgRemoveLiquidity(appID1, addr1, pool.Id, position.Id, position.Liquidity))
+	s.Require().ErrorIs(err, types.ErrNotPositionOwner)
+	_, err = s.keeper.RemoveLiquidity(s.ctx, types.NewMsgRemoveLiquidity(appID1, addr2, pool.Id, position.Id, position.Liquidity.MulInt64(2)))
+	s.Require().ErrorIs(err, types.ErrInsufficientLiquidity)
+
+	// Removing half of the liquidity keeps the position.
+	half := position.Liquidity.QuoInt64(2)
+	_, err = s.keeper.RemoveLiquidity(s.ctx, types.NewMsgRemoveLiquidity(appID1, addr2, pool.Id, position.Id, half))
+	s.Require().NoError(err)
+	position, found := s.keeper.GetPosition(s.ctx, appID1, pool.Id, position.Id)
+	s.Require().True(found)
+
+	_, err = s.keeper.RemoveLiquidity(s.ctx, types.NewMsgRemoveLiquidity(appID1, addr2, pool.Id, position.Id, position.Liquidity))
+	s.Require().NoError(err)
+	_, found = s.keeper.GetPosition(s.ctx, appID1, pool.Id, position.Id)
+	s.Require().False(found)
+	s.Require().True(s.getBalance(addr2, "uasset1").Amount.GTE(sdk.NewInt(999998)))
+	s.Require().True(s.getBalance(addr2, "uasset1").Amount.LTE(sdk.NewInt(1000000)))
+
+	_, err = s.keeper.AddLiquidity(s.ctx, types.NewMsgAddLiquidity(appID1, addr2, pool.Id, position.Id, utils.ParseCoins("1000uasset1")))
+	s.Require().ErrorIs(err, types.ErrPositionNotFound)
+}
+
+func (s *KeeperTestSuite) TestConcentratedPoolAccruesFees() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+	addr3 := s.addr(3)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, addr1, asset1.Denom, asset2.Denom)
+	pool := s.CreateNewConcentratedPool(
+		appID1, pair.Id, addr1, utils.ParseDec("0.003"), "1000000000uasset1,1000000000uasset2",
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"))
+	// This position is out of range, so it doesn't earn fees.
+	outOfRange := s.OpenPosition(appID1, pool.Id, addr2, utils.ParseDec("1.5"), utils.ParseDec("3.0"), "1000000000uasset1")
+
+	// Coins sent to the reserve directly don't move the pool price.
+	s.fundAddr(addr3, utils.ParseCoins("100000000uasset2"))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, addr3, pool.GetReserveAddress(), utils.ParseCoins("100000000uasset2")))
+	pool, _ = s.keeper.GetPool(s.ctx, appID1, pool.Id)
+	s.Require().True(utils.DecApproxEqual(utils.ParseDec("1.0"), pool.SqrtPrice.Power(2)))
+	position, _ := s.keeper.GetPosition(s.ctx, appID1, pool.Id, 1)
+	s.Require().True(decEq(position.Liquidity, *pool.ActiveLiquidity))
+
+	s.LimitOrder(appID1, addr3, pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.05"), sdk.NewInt(10000000), time.Hour)
+	liquidity.EndBlocker(s.ctx, s.keeper, s.app.AssetKeeper)
+	s.Require().True(s.getBalance(addr3, "uasset1").IsPositive())
+
+	// The pool sold the base coin, so its price moved up and the fee is
+	// accrued in the quote coin.
+	pool, _ = s.keeper.GetPool(s.ctx, appID1, pool.Id)
+	s.Require().True(pool.SqrtPrice.Power(2).GT(utils.ParseDec("1.0")))
+	position, _ = s.keeper.GetPosition(s.ctx, appID1, pool.Id, 1)
+	s.Require().True(position.AccruedFees.AmountOf("uasset2").IsPositive())
+	s.Require().True(position.AccruedFees.IsEqual(s.getBalances(pool.GetFeeCollectorAddress())))
+	outOfRange, _ = s.keeper.GetPosition(s.ctx, appID1, pool.Id, outOfRange.Id)
+	s.Require().True(outOfRange.AccruedFees.IsZero())
+
+	_, err := s.keeper.CollectFees(s.ctx, types.NewMsgCollectFees(appID1, addr2, pool.Id, outOfRange.Id))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	balancesBefore := s.getBalances(addr1)
+	collected, err := s.keeper.CollectFees(s.ctx, types.NewMsgCollectFees(appID1, addr1, pool.Id, position.Id))
+	s.Require().NoError(err)
+	s.Require().True(collected.IsEqual(position.AccruedFees))
+	s.Require().True(balancesBefore.Add(collected...).IsEqual(s.getBalances(addr1)))
+	s.Require().True(s.getBalances(pool.GetFeeCollectorAddress()).IsZero())
+}
@@ -37,10 +37,16 @@ func (k Keeper) GetPoolTokenDesrializerKit(ctx sdk.Context, appID, poolID uint64
 	if pool.Disabled {
 		return types.PoolTokenDeserializerKit{}, sdkerrors.Wrapf(types.ErrDisabledPool, "pool %d is disabled", poolID)
 	}
+	if pool.Type == types.PoolTypeConcentrated {
+		return types.PoolTokenDeserializerKit{}, sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is a concentrated pool without pool coin", poolID)
+	}
+	if pool.Type == types.PoolTypeWeighted {
+		return types.PoolTokenDeserializerKit{}, sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is a weighted pool of more than a pair", poolID)
+	}
 	pair, _ := k.GetPair(ctx, pool.AppId, pool.PairId)
 	rx, ry := k.getPoolBalances(ctx, pool, pair)
 	ps := k.GetPoolCoinSupply(ctx, pool)
-	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
+	ammPool := k.GetAMMPool(ctx, pool, rx.Amount, ry.Amount, ps)
 	if ammPool.IsDepleted() {
 		return types.PoolTokenDeserializerKit{}, sdkerrors.Wrapf(types.ErrDepletedPool, "pool %d is depleted", poolID)
 	}
@@ -67,6 +73,14 @@ func (k Keeper) CalculateXYFromPoolCoin(ctx sdk.Context, deserializerKit types.P
 }
 
 func (k Keeper) DeserializePoolCoinHelper(ctx sdk.Context, appID, poolID, poolCoinAmount uint64) (sdk.Coins, error) {
+	pool, found := k.GetPool(ctx, appID, poolID)
+	if found && pool.Type == types.PoolTypeWeighted {
+		if pool.Disabled {
+			return nil, sdkerrors.Wrapf(types.ErrDisabledPool, "pool %d is disabled", poolID)
+		}
+		return k.DeserializeWeightedPoolCoin(ctx, pool, sdk.NewIntFromUint64(poolCoinAmount))
+	}
+
 	deserializerKit, err := k.GetPoolTokenDesrializerKit(ctx, appID, poolID)
 	if err != nil {
 		return nil, err
@@ -112,17 +126,56 @@ func (k Keeper) GetAssetWhoseOraclePriceExists(ctx sdk.Context, quoteCoinDenom,
 	return asset, nil
 }
 
-func (k Keeper) GetAggregatedChildPoolContributions(ctx sdk.Context, appID uint64, poolIds []uint64, masterPoolSupplyAddresses []sdk.AccAddress) map[string]sdk.Dec {
-	poolSupplyData := make(map[string]sdk.Dec)
+// poolCoinValueFunc returns the pool and a function which values the pool's
+// pool coin with the oracle prices of the pool's assets.
+func (k Keeper) poolCoinValueFunc(ctx sdk.Context, appID, poolID uint64) (types.Pool, func(poolCoin sdk.Coin) (sdk.Dec, error), error) {
+	pool, found := k.GetPool(ctx, appID, poolID)
+	if found && pool.Type == types.PoolTypeWeighted {
+		if pool.Disabled {
+			return types.Pool{}, nil, sdkerrors.Wrapf(types.ErrDisabledPool, "pool %d is disabled", poolID)
+		}
+		return pool, func(poolCoin sdk.Coin) (sdk.Dec, error) {
+			return k.weightedPoolCoinValue(ctx, pool, poolCoin.Amount)
+		}, nil
+	}
 
-	for _, poolID := range poolIds {
-		deserializerKit, err := k.GetPoolTokenDesrializerKit(ctx, appID, poolID)
+	deserializerKit, err := k.GetPoolTokenDesrializerKit(ctx, appID, poolID)
+	if err != nil {
+		return types.Pool{}, nil, err
+	}
+	pair := deserializerKit.Pair
+
+	asset, err := k.GetAssetWhoseOraclePriceExists(ctx, pair.QuoteCoinDenom, pair.BaseCoinDenom)
+	if err != nil {
+		return types.Pool{}, nil, err
+	}
+
+	return deserializerKit.Pool, func(poolCoin sdk.Coin) (sdk.Dec, error) {
+		x, y, err := k.CalculateXYFromPoolCoin(ctx, deserializerKit, poolCoin)
 		if err != nil {
-			continue
+			return sdk.Dec{}, err
 		}
-		pair := deserializerKit.Pair
+		quoteCoin := sdk.NewCoin(pair.QuoteCoinDenom, x)
+		baseCoin := sdk.NewCoin(pair.BaseCoinDenom, y)
 
-		asset, err := k.GetAssetWhoseOraclePriceExists(ctx, pair.QuoteCoinDenom, pair.BaseCoinDenom)
+		var assetAmount sdk.Int
+
+		if pair.QuoteCoinDenom == asset.Denom {
+			assetAmount = quoteCoin.Amount
+		} else {
+			assetAmount = baseCoin.Amount
+		}
+		value, _ := k.CalcAssetPrice(ctx, asset.Id, assetAmount)
+		value = value.Mul(sdk.NewDec(2)) // multiplying the calculated value of sigle asset with 2, since we have 50-50 pools.
+		return value, nil
+	}, nil
+}
+
+func (k Keeper) GetAggregatedChildPoolContributions(ctx sdk.Context, appID uint64, poolIds []uint64, masterPoolSupplyAddresses []sdk.AccAddress) map[string]sdk.Dec {
+	poolSupplyData := make(map[string]sdk.Dec)
+
+	for _, poolID := range poolIds {
+		_, poolCoinValue, err := k.poolCoinValueFunc(ctx, appID, poolID)
 		if err != nil {
 			continue
 		}
@@ -132,22 +185,10 @@ func (k Keeper) GetAggregatedChildPoolContributions(ctx sdk.Context, appID uint6
 			if !found {
 				continue
 			}
-			x, y, err := k.CalculateXYFromPoolCoin(ctx, deserializerKit, activeFarmer.FarmedPoolCoin)
+			value, err := poolCoinValue(activeFarmer.FarmedPoolCoin)
 			if err != nil {
 				continue
 			}
-			quoteCoin := sdk.NewCoin(pair.QuoteCoinDenom, x)
-			baseCoin := sdk.NewCoin(pair.BaseCoinDenom, y)
-
-			var assetAmount sdk.Int
-
-			if pair.QuoteCoinDenom == asset.Denom {
-				assetAmount = quoteCoin.Amount
-			} else {
-				assetAmount = baseCoin.Amount
-			}
-			value, _ := k.CalcAssetPrice(ctx, asset.Id, assetAmount)
-			value = value.Mul(sdk.NewDec(2)) // multiplying the calculated value of sigle asset with 2, since we have 50-50 pools.
 			_, found = poolSupplyData[address.String()]
 			if !found {
 				poolSupplyData[address.String()] = value
@@ -159,16 +200,51 @@ func (k Keeper) GetAggregatedChildPoolContributions(ctx sdk.Context, appID uint6
 	return poolSupplyData
 }
 
-func (k Keeper) GetFarmingRewardsData(ctx sdk.Context, appID uint64, coinsToDistribute sdk.Coin, liquidityGaugeData rewardstypes.LiquidtyGaugeMetaData) ([]rewardstypes.RewardDistributionDataCollector, error) {
-	deserializerKit, err := k.GetPoolTokenDesrializerKit(ctx, appID, liquidityGaugeData.PoolId)
-	if err != nil {
-		return nil, err
+// GetFarmingBoostFactor returns the reward weight multiplier of the active farmer.
+// Pool coins locked with a lock duration are weighted by their boost multiplier
+// until they are unlocked.
+func (k Keeper) GetFarmingBoostFactor(ctx sdk.Context, activeFarmer types.ActiveFarmer) sdk.Dec {
+	boostFactor := sdk.OneDec()
+	activeAmount := activeFarmer.FarmedPoolCoin.Amount
+	if !activeAmount.IsPositive() {
+		return boostFactor
+	}
+	lockedFarmer, found := k.GetLockedFarmer(ctx, activeFarmer.AppId, activeFarmer.PoolId, sdk.MustAccAddressFromBech32(activeFarmer.Farmer))
+	if !found {
+		return boostFactor
 	}
 
-	pair := deserializerKit.Pair
-	pool := deserializerKit.Pool
+	lockedAmount := sdk.ZeroInt()
+	boostedExtra := sdk.ZeroDec()
+	for _, lockedCoin := range lockedFarmer.UnmaturedLockedCoins(ctx.BlockTime()) {
+		lockedAmount = lockedAmount.Add(lockedCoin.FarmedPoolCoin.Amount)
+		boostedExtra = boostedExtra.Add(lockedCoin.FarmedPoolCoin.Amount.ToDec().Mul(lockedCoin.BoostMultiplier.Sub(sdk.OneDec())))
+	}
+	if lockedAmount.IsZero() {
+		return boostFactor
+	}
+	// locked pool coins which are still in the farming queue are not boosted yet.
+	if lockedAmount.GT(activeAmount) {
+		boostedExtra = boostedExtra.MulInt(activeAmount).QuoInt(lockedAmount)
+	}
+	return boostFactor.Add(boostedExtra.QuoInt(activeAmount))
+}
 
-	asset, err := k.GetAssetWhoseOraclePriceExists(ctx, pair.QuoteCoinDenom, pair.BaseCoinDenom)
+// GetFarmingLockBoostMultiplier returns the boost multiplier of the given lock duration for the app.
+func (k Keeper) GetFarmingLockBoostMultiplier(ctx sdk.Context, appID uint64, lockDuration time.Duration) (sdk.Dec, error) {
+	params, err := k.GetGenericParams(ctx, appID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	multiplier, found := params.FarmingLockBoostMultiplier(lockDuration)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidLockDuration, "lock duration %s is not allowed for app %d", lockDuration, appID)
+	}
+	return multiplier, nil
+}
+
+func (k Keeper) GetFarmingRewardsData(ctx sdk.Context, appID uint64, coinsToDistribute sdk.Coin, liquidityGaugeData rewardstypes.LiquidtyGaugeMetaData) ([]rewardstypes.RewardDistributionDataCollector, error) {
+	pool, poolCoinValue, err := k.poolCoinValueFunc(ctx, appID, liquidityGaugeData.PoolId)
 	if err != nil {
 		return nil, err
 	}
@@ -182,22 +258,11 @@ func (k Keeper) GetFarmingRewardsData(ctx sdk.Context, appID uint64, coinsToDist
 		if err != nil {
 			continue
 		}
-		x, y, err := k.CalculateXYFromPoolCoin(ctx, deserializerKit, activeFarmer.FarmedPoolCoin)
+		value, err := poolCoinValue(activeFarmer.FarmedPoolCoin)
 		if err != nil {
 			continue
 		}
-		quoteCoin := sdk.NewCoin(pair.QuoteCoinDenom, x)
-		baseCoin := sdk.NewCoin(pair.BaseCoinDenom, y)
-
-		var assetAmount sdk.Int
-
-		if pair.QuoteCoinDenom == asset.Denom {
-			assetAmount = quoteCoin.Amount
-		} else {
-			assetAmount = baseCoin.Amount
-		}
-		value, _ := k.CalcAssetPrice(ctx, asset.Id, assetAmount)
-		value = value.Mul(sdk.NewDec(2)) // multiplying the calculated value of sigle asset with 2, since we have 50-50 pools.
+		value = value.Mul(k.GetFarmingBoostFactor(ctx, activeFarmer))
 		lpAddresses = append(lpAddresses, addr)
 		lpSupplies = append(lpSupplies, value)
 	}
@@ -314,6 +379,11 @@ func (k Keeper) ValidateMsgFarm(ctx sdk.Context, msg *types.MsgFarm) (sdk.AccAdd
 	if !msg.FarmingPoolCoin.Amount.IsPositive() {
 		return nil, sdkerrors.Wrapf(types.ErrorNotPositiveAmont, "pool coin amount should be positive")
 	}
+	if msg.LockDuration != 0 {
+		if _, err := k.GetFarmingLockBoostMultiplier(ctx, msg.AppId, msg.LockDuration); err != nil {
+			return nil, err
+		}
+	}
 	return farmer, nil
 }
 
@@ -343,6 +413,28 @@ func (k Keeper) Farm(ctx sdk.Context, msg *types.MsgFarm) error {
 	)
 	k.SetQueuedFarmer(ctx, queuedFarmer)
 
+	if msg.LockDuration != 0 {
+		boostMultiplier, err := k.GetFarmingLockBoostMultiplier(ctx, msg.AppId, msg.LockDuration)
+		if err != nil {
+			return err
+		}
+		lockedFarmer, found := k.GetLockedFarmer(ctx, msg.AppId, msg.PoolId, farmer)
+		if !found {
+			lockedFarmer = types.NewLockedFarmer(msg.AppId, msg.PoolId, farmer)
+		}
+		lockedFarmer.LockedCoins = append(
+			lockedFarmer.LockedCoins,
+			&types.LockedCoin{
+				FarmedPoolCoin:  msg.FarmingPoolCoin,
+				LockDuration:    msg.LockDuration,
+				BoostMultiplier: boostMultiplier,
+				LockedAt:        ctx.BlockTime(),
+				UnlockAt:        ctx.BlockTime().Add(msg.LockDuration),
+			},
+		)
+		k.SetLockedFarmer(ctx, lockedFarmer)
+	}
+
 	ctx.GasMeter().ConsumeGas(types.FarmGas, "FarmGas")
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -352,6 +444,7 @@ func (k Keeper) Farm(ctx sdk.Context, msg *types.MsgFarm) error {
 			sdk.NewAttribute(types.AttributeKeyAppID, strconv.FormatUint(msg.AppId, 10)),
 			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(msg.PoolId, 10)),
 			sdk.NewAttribute(types.AttributeKeyPoolCoin, msg.FarmingPoolCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyLockDuration, msg.LockDuration.String()),
 			sdk.NewAttribute(types.AttributeKeyTimeStamp, ctx.BlockTime().String()),
 		),
 	})
@@ -413,6 +506,14 @@ func (k Keeper) Unfarm(ctx sdk.Context, msg *types.MsgUnfarm) error {
 		return sdkerrors.Wrapf(types.ErrInvalidUnfarmAmount, "farmed pool coin amount %d%s smaller than requested unfarming pool coin amount %d%s", farmedCoinAmount.Int64(), msg.UnfarmingPoolCoin.Denom, msg.UnfarmingPoolCoin.Amount.Int64(), msg.UnfarmingPoolCoin.Denom)
 	}
 
+	lockedFarmer, found := k.GetLockedFarmer(ctx, msg.AppId, msg.PoolId, farmer)
+	if found {
+		lockedAmount := lockedFarmer.LockedAmount(ctx.BlockTime())
+		if farmedCoinAmount.Sub(lockedAmount).LT(msg.UnfarmingPoolCoin.Amount) {
+			return sdkerrors.Wrapf(types.ErrPoolCoinLocked, "%s%s of farmed pool coin is locked, unfarmable amount %s%s is smaller than requested unfarming pool coin amount %s", lockedAmount, msg.UnfarmingPoolCoin.Denom, farmedCoinAmount.Sub(lockedAmount), msg.UnfarmingPoolCoin.Denom, msg.UnfarmingPoolCoin)
+		}
+	}
+
 	unFarmingCoin := msg.UnfarmingPoolCoin
 	queuedCoins := queuedFarmer.QueudCoins
 	if qfound {
@@ -526,6 +627,21 @@ func (k Keeper) ProcessQueuedFarmers(ctx sdk.Context, appID uint64) {
 				k.SetQueuedFarmer(ctx, queuedFarmer)
 			}
 		}
+
+		// matured locks are released, the pool coins stay farmed without the boost.
+		lockedFarmers := k.GetAllLockedFarmers(ctx, pool.AppId, pool.Id)
+		for _, lockedFarmer := range lockedFarmers {
+			unmaturedLockedCoins := lockedFarmer.UnmaturedLockedCoins(ctx.BlockTime())
+			if len(unmaturedLockedCoins) == len(lockedFarmer.LockedCoins) {
+				continue
+			}
+			if len(unmaturedLockedCoins) == 0 {
+				k.DeleteLockedFarmer(ctx, lockedFarmer)
+			} else {
+				lockedFarmer.LockedCoins = unmaturedLockedCoins
+				k.SetLockedFarmer(ctx, lockedFarmer)
+			}
+		}
 	}
 }
 
@@ -538,6 +654,12 @@ func (k Keeper) GetAmountFarmedForAssetID(ctx sdk.Context, appID, assetID uint64
 	allPools := k.GetAllPools(ctx, appID)
 	requiredAssetPoolIds := []uint64{}
 	for _, pool := range allPools {
+		if pool.Type == types.PoolTypeWeighted {
+			if _, found := pool.WeightedAsset(asset.Denom); found {
+				requiredAssetPoolIds = append(requiredAssetPoolIds, pool.Id)
+			}
+			continue
+		}
 		rx, ry := k.GetPoolBalances(ctx, pool)
 		if types.ItemExists([]string{rx.Denom, ry.Denom}, asset.Denom) {
 			requiredAssetPoolIds = append(requiredAssetPoolIds, pool.Id)
@@ -1336,3 +1336,141 @@ func (s *KeeperTestSuite) TestOraclePricForRewardDistrbution() {
 	s.Require().Equal(uint64(0), price)
 	s.Require().False(found)
 }
+
+func (s *KeeperTestSuite) TestFarmLocked() {
+	creator := s.addr(0)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, creator, asset1.Denom, asset2.Denom)
+	pool := s.CreateNewLiquidityPool(appID1, pair.Id, creator, "1000000000000uasset1,1000000000000uasset2")
+
+	liquidityProvider1 := s.addr(1)
+	s.Deposit(appID1, pool.Id, liquidityProvider1, "1000000000uasset1,1000000000uasset2")
+	s.nextBlock()
+	s.Require().True(utils.ParseCoins("10000000000pool1-1").IsEqual(s.getBalances(liquidityProvider1)))
+
+	// no lock durations are allowed by default
+	msg := types.NewMsgFarm(appID1, pool.Id, liquidityProvider1, utils.ParseCoin("5000000000pool1-1"))
+	msg.LockDuration = 720 * time.Hour
+	err := s.keeper.Farm(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidLockDuration)
+
+	err = s.keeper.UpdateGenericParams(s.ctx, appID1, []string{"FarmingLockBoosts"}, []string{"720h:1.5,2160h:2"})
+	s.Require().NoError(err)
+
+	msg.LockDuration = time.Hour
+	err = s.keeper.Farm(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidLockDuration)
+
+	msg.LockDuration = 720 * time.Hour
+	err = s.keeper.Farm(s.ctx, msg)
+	s.Require().NoError(err)
+	msg = types.NewMsgFarm(appID1, pool.Id, liquidityProvider1, utils.ParseCoin("5000000000pool1-1"))
+	err = s.keeper.Farm(s.ctx, msg)
+	s.Require().NoError(err)
+
+	lockedFarmer, found := s.keeper.GetLockedFarmer(s.ctx, appID1, pool.Id, liquidityProvider1)
+	s.Require().True(found)
+	s.Require().Len(lockedFarmer.LockedCoins, 1)
+	s.Require().Equal(utils.ParseDec("1.5"), lockedFarmer.LockedCoins[0].BoostMultiplier)
+	s.Require().Equal(s.ctx.BlockTime().Add(720*time.Hour), lockedFarmer.LockedCoins[0].UnlockAt)
+
+	resp, err := s.querier.Farmer(sdk.WrapSDKContext(s.ctx), &types.QueryFarmerRequest{AppId: appID1, PoolId: pool.Id, Farmer: liquidityProvider1.String()})
+	s.Require().NoError(err)
+	s.Require().Len(resp.LockedPoolCoin, 1)
+	s.Require().True(utils.ParseCoin("5000000000pool1-1").IsEqual(resp.LockedPoolCoin[0].FarmedPoolCoin))
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(types.DefaultFarmingQueueDuration).Add(time.Minute * 10))
+	s.nextBlock()
+
+	// locked pool coins cannot be unfarmed
+	err = s.keeper.Unfarm(s.ctx, types.NewMsgUnfarm(appID1, pool.Id, liquidityProvider1, utils.ParseCoin("5000000001pool1-1")))
+	s.Require().ErrorIs(err, types.ErrPoolCoinLocked)
+
+	err = s.keeper.Unfarm(s.ctx, types.NewMsgUnfarm(appID1, pool.Id, liquidityProvider1, utils.ParseCoin("5000000000pool1-1")))
+	s.Require().NoError(err)
+	s.Require().True(utils.ParseCoins("5000000000pool1-1").IsEqual(s.getBalances(liquidityProvider1)))
+
+	err = s.keeper.Unfarm(s.ctx, types.NewMsgUnfarm(appID1, pool.Id, liquidityProvider1, utils.ParseCoin("1pool1-1")))
+	s.Require().ErrorIs(err, types.ErrPoolCoinLocked)
+
+	// the lock is released once matured
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(720 * time.Hour))
+	s.nextBlock()
+	_, found = s.keeper.GetLockedFarmer(s.ctx, appID1, pool.Id, liquidityProvider1)
+	s.Require().False(found)
+
+	err = s.keeper.Unfarm(s.ctx, types.NewMsgUnfarm(appID1, pool.Id, liquidityProvider1, utils.ParseCoin("5000000000pool1-1")))
+	s.Require().NoError(err)
+	s.Require().True(utils.ParseCoins("10000000000pool1-1").IsEqual(s.getBalances(liquidityProvider1)))
+}
+
+func (s *KeeperTestSuite) TestGetFarmingRewardsDataBoostedLPs() {
+	creator := s.addr(0)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, creator, asset1.Denom, asset2.Denom)
+	pool := s.CreateNewLiquidityPool(appID1, pair.Id, creator, "1000000000000uasset1,1000000000000uasset2")
+
+	err := s.keeper.UpdateGenericParams(s.ctx, appID1, []string{"FarmingLockBoosts"}, []string{"720h:1.5,2160h:2"})
+	s.Require().NoError(err)
+
+	liquidityProvider1 := s.addr(1)
+	s.Deposit(appID1, pool.Id, liquidityProvider1, "1000000000uasset1,1000000000uasset2")
+	s.nextBlock()
+	msg := types.NewMsgFarm(appID1, pool.Id, liquidityProvider1, utils.ParseCoin("10000000000pool1-1"))
+	msg.LockDuration = 2160 * time.Hour
+	err = s.keeper.Farm(s.ctx, msg)
+	s.Require().NoError(err)
+
+	liquidityProvider2 := s.addr(2)
+	s.Deposit(appID1, pool.Id, liquidityProvider2, "1000000000uasset1,1000000000uasset2")
+	s.nextBlock()
+	msg = types.NewMsgFarm(appID1, pool.Id, liquidityProvider2, utils.ParseCoin("9999999999pool1-1"))
+	err = s.keeper.Farm(s.ctx, msg)
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(types.DefaultFarmingQueueDuration).Add(time.Minute * 10))
+	s.nextBlock()
+
+	liquidityGauge := rewardtypes.LiquidtyGaugeMetaData{
+		PoolId:       pool.Id,
+		IsMasterPool: false,
+		ChildPoolIds: []uint64{},
+	}
+
+	rewardDistrData, err := s.keeper.GetFarmingRewardsData(s.ctx, appID1, sdk.NewCoin("ucmdx", newInt(3000000000)), liquidityGauge)
+	s.Require().NoError(err)
+
+	mappedResp := map[string]sdk.Coin{}
+	for _, d := range rewardDistrData {
+		mappedResp[d.RewardReceiver.String()] = d.RewardCoin
+	}
+
+	// the locked pool coins are weighted twice
+	s.Require().True(utils.ParseCoin("2000000000ucmdx").IsEqual(mappedResp[liquidityProvider1.String()]))
+	s.Require().True(utils.ParseCoin("999999999ucmdx").IsEqual(mappedResp[liquidityProvider2.String()]))
+
+	// the boost ends once the lock is matured
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2160 * time.Hour))
+	s.nextBlock()
+
+	rewardDistrData, err = s.keeper.GetFarmingRewardsData(s.ctx, appID1, sdk.NewCoin("ucmdx", newInt(3000000000)), liquidityGauge)
+	s.Require().NoError(err)
+
+	mappedResp = map[string]sdk.Coin{}
+	for _, d := range rewardDistrData {
+		mappedResp[d.RewardReceiver.String()] = d.RewardCoin
+	}
+
+	s.Require().True(utils.ParseCoin("1500000000ucmdx").IsEqual(mappedResp[liquidityProvider1.String()]))
+	s.Require().True(utils.ParseCoin("1499999999ucmdx").IsEqual(mappedResp[liquidityProvider2.String()]))
+}
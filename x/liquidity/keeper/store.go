@@ -802,3 +802,153 @@ func (k Keeper) GetAllCMSTPools(ctx sdk.Context, appID uint64) (pools []types.Po
 	}
 	return cmstPools
 }
+
+// SetLockedFarmer stores the locked farmer.
+func (k Keeper) SetLockedFarmer(ctx sdk.Context, lockedFarmer types.LockedFarmer) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalLockedFarmer(k.cdc, lockedFarmer)
+	store.Set(types.GetLockedFarmerKey(lockedFarmer.AppId, lockedFarmer.PoolId, sdk.MustAccAddressFromBech32(lockedFarmer.Farmer)), bz)
+}
+
+// DeleteLockedFarmer deletes a locked farmer from store.
+func (k Keeper) DeleteLockedFarmer(ctx sdk.Context, lockedFarmer types.LockedFarmer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLockedFarmerKey(lockedFarmer.AppId, lockedFarmer.PoolId, sdk.MustAccAddressFromBech32(lockedFarmer.Farmer)))
+}
+
+// GetLockedFarmer returns locked farmer object for the given app id, pool id and farmer.
+func (k Keeper) GetLockedFarmer(ctx sdk.Context, appID, poolID uint64, farmer sdk.AccAddress) (lockedFarmer types.LockedFarmer, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLockedFarmerKey(appID, poolID, farmer))
+	if bz == nil {
+		return
+	}
+	lockedFarmer = types.MustUnmarshalLockedFarmer(k.cdc, bz)
+	return lockedFarmer, true
+}
+
+// IterateAllLockedFarmers iterates over all the stored locked farmers and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateAllLockedFarmers(ctx sdk.Context, appID, poolID uint64, cb func(lockedFarmer types.LockedFarmer) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetAllLockedFarmersKey(appID, poolID))
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+	for ; iter.Valid(); iter.Next() {
+		lockedFarmer := types.MustUnmarshalLockedFarmer(k.cdc, iter.Value())
+		stop, err := cb(lockedFarmer)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllLockedFarmers returns all locked farmers in the store.
+func (k Keeper) GetAllLockedFarmers(ctx sdk.Context, appID, poolID uint64) (lockedFarmers []types.LockedFarmer) {
+	lockedFarmers = []types.LockedFarmer{}
+	_ = k.IterateAllLockedFarmers(ctx, appID, poolID, func(lockedFarmer types.LockedFarmer) (stop bool, err error) {
+		lockedFarmers = append(lockedFarmers, lockedFarmer)
+		return false, nil
+	})
+	return lockedFarmers
+}
+
+// SetPosition stores the position.
+func (k Keeper) SetPosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalPosition(k.cdc, position)
+	store.Set(types.GetPositionKey(position.AppId, position.PoolId, position.Id), bz)
+}
+
+// DeletePosition deletes a position from store.
+func (k Keeper) DeletePosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPositionKey(position.AppId, position.PoolId, position.Id))
+}
+
+// GetPosition returns position object for the given app id, pool id and position id.
+func (k Keeper) GetPosition(ctx sdk.Context, appID, poolID, positionID uint64) (position types.Position, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPositionKey(appID, poolID, positionID))
+	if bz == nil {
+		return
+	}
+	position = types.MustUnmarshalPosition(k.cdc, bz)
+	return position, true
+}
+
+// IteratePositionsByPool iterates over all the stored positions of a pool and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IteratePositionsByPool(ctx sdk.Context, appID, poolID uint64, cb func(position types.Position) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPositionsByPoolKeyPrefix(appID, poolID))
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+	for ; iter.Valid(); iter.Next() {
+		position := types.MustUnmarshalPosition(k.cdc, iter.Value())
+		stop, err := cb(position)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetPositionsByPool returns all positions of a pool in the store.
+func (k Keeper) GetPositionsByPool(ctx sdk.Context, appID, poolID uint64) (positions []types.Position) {
+	positions = []types.Position{}
+	_ = k.IteratePositionsByPool(ctx, appID, poolID, func(position types.Position) (stop bool, err error) {
+		positions = append(positions, position)
+		return false, nil
+	})
+	return positions
+}
+
+// IterateAllPositions iterates over all the stored positions of an app and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateAllPositions(ctx sdk.Context, appID uint64, cb func(position types.Position) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetAllPositionsKey(appID))
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+	for ; iter.Valid(); iter.Next() {
+		position := types.MustUnmarshalPosition(k.cdc, iter.Value())
+		stop, err := cb(position)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllPositions returns all positions of an app in the store.
+func (k Keeper) GetAllPositions(ctx sdk.Context, appID uint64) (positions []types.Position) {
+	positions = []types.Position{}
+	_ = k.IterateAllPositions(ctx, appID, func(position types.Position) (stop bool, err error) {
+		positions = append(positions, position)
+		return false, nil
+	})
+	return positions
+}
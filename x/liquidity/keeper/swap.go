@@ -643,7 +643,7 @@ func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
 		rx, ry := k.getPoolBalances(ctx, pool, pair)
 		ps := k.GetPoolCoinSupply(ctx, pool)
 		ammPool := types.NewPoolOrderer(
-			pool.AMMPool(rx.Amount, ry.Amount, ps),
+			k.GetAMMPool(ctx, pool, rx.Amount, ry.Amount, ps),
 			pool.Id, pool.GetReserveAddress(), pair.BaseCoinDenom, pair.QuoteCoinDenom)
 		if ammPool.IsDepleted() {
 			k.MarkPoolAsDisabled(ctx, pool)
@@ -652,6 +652,7 @@ func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
 		pools = append(pools, ammPool)
 		return false, nil
 	})
+	pools = append(pools, k.weightedPoolOrderers(ctx, pair)...)
 
 	matchPrice, quoteCoinDiff, matched := k.Match(ctx, params, ob, pools, pair.LastPrice)
 	if matched {
@@ -817,6 +818,12 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 				sdk.NewAttribute(types.AttributeKeyReceivedCoin, r.ReceivedCoin.String()),
 			),
 		})
+		pool, _ := k.GetPool(ctx, pair.AppId, r.PoolID)
+		if pool.Type == types.PoolTypeConcentrated {
+			if err := k.ApplyConcentratedPoolSwap(ctx, pool, pair, r.OrderDirection, r.PaidCoin, r.ReceivedCoin); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -1029,3 +1036,77 @@ func (k Keeper) ConvertAccumulatedSwapFeesWithSwapDistrToken(ctx sdk.Context, ap
 		}
 	}
 }
+
+// SwapExactAmountIn swaps offerCoin into demandCoinDenom immediately against
+// the pool of the pair giving out the most, instead of placing an order
+// which waits for the batch.
+// The swap fee is taken from offerCoin and sent to the pair's swap fee
+// collector. It fails if less than minDemandAmt would be received.
+func (k Keeper) SwapExactAmountIn(ctx sdk.Context, appID uint64, trader sdk.AccAddress, offerCoin sdk.Coin, demandCoinDenom string, minDemandAmt sdk.Int) (sdk.Coin, error) {
+	params, err := k.GetGenericParams(ctx, appID)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(err, "params retreval failed")
+	}
+	pair, found := k.GetPairByDenoms(ctx, appID, offerCoin.Denom, demandCoinDenom)
+	if !found {
+		pair, found = k.GetPairByDenoms(ctx, appID, demandCoinDenom, offerCoin.Denom)
+		if !found {
+			return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair of %s and %s not found", offerCoin.Denom, demandCoinDenom)
+		}
+	}
+	isOfferX := offerCoin.Denom == pair.QuoteCoinDenom
+
+	swapFeeAmt := offerCoin.Amount.ToDec().Mul(params.SwapFeeRate).Ceil().TruncateInt()
+	swapAmt := offerCoin.Amount.Sub(swapFeeAmt)
+	if !swapAmt.IsPositive() {
+		return sdk.Coin{}, types.ErrTooSmallOrder
+	}
+
+	var (
+		bestPool types.Pool
+		outAmt   = sdk.ZeroInt()
+	)
+	for _, pool := range k.GetPoolsByPair(ctx, appID, pair.Id) {
+		if pool.Disabled || pool.Type == types.PoolTypeConcentrated {
+			continue
+		}
+		rx, ry := k.getPoolBalances(ctx, pool, pair)
+		ps := k.GetPoolCoinSupply(ctx, pool)
+		if ps.IsZero() {
+			continue
+		}
+		if out := amm.SwapOut(k.GetAMMPool(ctx, pool, rx.Amount, ry.Amount, ps), isOfferX, swapAmt); out.GT(outAmt) {
+			bestPool, outAmt = pool, out
+		}
+	}
+	if !outAmt.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "no pool of pair %d can take the swap", pair.Id)
+	}
+	if outAmt.LT(minDemandAmt) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrTooSmallSwapOutAmount, "%s%s is less than %s%s", outAmt, demandCoinDenom, minDemandAmt, demandCoinDenom)
+	}
+
+	receivedCoin := sdk.NewCoin(demandCoinDenom, outAmt)
+	swapFeeCoin := sdk.NewCoin(offerCoin.Denom, swapFeeAmt)
+
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(trader, bestPool.GetReserveAddress(), sdk.NewCoins(sdk.NewCoin(offerCoin.Denom, swapAmt)))
+	bulkOp.QueueSendCoins(trader, pair.GetSwapFeeCollectorAddress(), sdk.NewCoins(swapFeeCoin))
+	bulkOp.QueueSendCoins(bestPool.GetReserveAddress(), trader, sdk.NewCoins(receivedCoin))
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSwapExactAmountIn,
+			sdk.NewAttribute(types.AttributeKeyTrader, trader.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(bestPool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOfferCoin, offerCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyReceivedCoin, receivedCoin.String()),
+			sdk.NewAttribute(types.AttributeKeySwapFeeCoin, swapFeeCoin.String()),
+		),
+	})
+
+	return receivedCoin, nil
+}
@@ -2475,3 +2475,49 @@ func (s *KeeperTestSuite) TestSwap_edgecase4() {
 	s.Require().True(utils.ParseCoins("50150000denom1,97291000denom2").IsEqual(s.getBalances(addr2)))
 
 }
+
+func (s *KeeperTestSuite) TestSwapExactAmountIn() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+	asset3 := s.CreateNewAsset("ASSETTHREE", "uasset3", 1000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, addr1, asset1.Denom, asset2.Denom)
+	pool := s.CreateNewLiquidityPool(appID1, pair.Id, addr1, "1000000000000uasset1,1000000000000uasset2")
+
+	_, err := s.keeper.SwapExactAmountIn(s.ctx, appID1, addr2, utils.ParseCoin("1000000uasset1"), asset3.Denom, sdk.ZeroInt())
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	_, err = s.keeper.SwapExactAmountIn(s.ctx, appID1, addr2, utils.ParseCoin("1uasset1"), asset2.Denom, sdk.ZeroInt())
+	s.Require().ErrorIs(err, types.ErrTooSmallOrder)
+
+	s.fundAddr(addr2, utils.ParseCoins("1000000uasset1"))
+	_, err = s.keeper.SwapExactAmountIn(s.ctx, appID1, addr2, utils.ParseCoin("1000000uasset1"), asset2.Denom, sdk.NewInt(1000000))
+	s.Require().ErrorIs(err, types.ErrTooSmallSwapOutAmount)
+
+	// Swapping base coin for quote coin and the other way round both work.
+	received, err := s.keeper.SwapExactAmountIn(s.ctx, appID1, addr2, utils.ParseCoin("1000000uasset1"), asset2.Denom, sdk.NewInt(990000))
+	s.Require().NoError(err)
+	s.Require().Equal(asset2.Denom, received.Denom)
+	s.Require().True(received.Amount.LT(sdk.NewInt(1000000)))
+	s.Require().True(s.getBalance(addr2, asset1.Denom).IsZero())
+	s.Require().True(received.IsEqual(s.getBalance(addr2, asset2.Denom)))
+
+	params, err := s.keeper.GetGenericParams(s.ctx, appID1)
+	s.Require().NoError(err)
+	swapFee := sdk.NewDec(1000000).Mul(params.SwapFeeRate).Ceil().TruncateInt()
+	s.Require().True(swapFee.Equal(s.getBalance(pair.GetSwapFeeCollectorAddress(), asset1.Denom).Amount))
+
+	rx, ry := s.keeper.GetPoolBalances(s.ctx, pool)
+	s.Require().True(sdk.NewInt(1000000000000).Sub(received.Amount).Equal(rx.Amount))
+	s.Require().True(sdk.NewInt(1000000000000).Add(sdk.NewInt(1000000)).Sub(swapFee).Equal(ry.Amount))
+
+	received, err = s.keeper.SwapExactAmountIn(s.ctx, appID1, addr2, received, asset1.Denom, sdk.ZeroInt())
+	s.Require().NoError(err)
+	s.Require().Equal(asset1.Denom, received.Denom)
+	s.Require().True(received.IsPositive())
+}
@@ -0,0 +1,408 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/comdex-official/comdex/x/liquidity/amm"
+	"github.com/comdex-official/comdex/x/liquidity/types"
+)
+
+// getWeightedPool returns an active weighted pool.
+func (k Keeper) getWeightedPool(ctx sdk.Context, appID, poolID uint64) (types.Pool, error) {
+	pool, found := k.GetPool(ctx, appID, poolID)
+	if !found {
+		return types.Pool{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", poolID)
+	}
+	if pool.Type != types.PoolTypeWeighted {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is not a weighted pool", poolID)
+	}
+	if pool.Disabled {
+		return types.Pool{}, types.ErrDisabledPool
+	}
+	return pool, nil
+}
+
+// getWeightedPoolBalances returns the balances of the weighted pool's assets,
+// in the order of the pool's assets.
+func (k Keeper) getWeightedPoolBalances(ctx sdk.Context, pool types.Pool) []sdk.Int {
+	spendable := k.bankKeeper.SpendableCoins(ctx, pool.GetReserveAddress())
+	bs := make([]sdk.Int, len(pool.WeightedAssets))
+	for i, asset := range pool.WeightedAssets {
+		bs[i] = spendable.AmountOf(asset.Denom)
+	}
+	return bs
+}
+
+// GetWeightedPoolBalances returns the balances of the weighted pool's assets.
+func (k Keeper) GetWeightedPoolBalances(ctx sdk.Context, pool types.Pool) sdk.Coins {
+	balances := sdk.Coins{}
+	for i, b := range k.getWeightedPoolBalances(ctx, pool) {
+		balances = balances.Add(sdk.NewCoin(pool.WeightedAssets[i].Denom, b))
+	}
+	return balances
+}
+
+// weightedPoolOrderers returns the pool orderers of the active weighted
+// pools which hold both coins of the pair.
+// Each weighted pool takes part in the pair's batch matching through its
+// two-asset view.
+func (k Keeper) weightedPoolOrderers(ctx sdk.Context, pair types.Pair) (orderers []*types.PoolOrderer) {
+	_ = k.IterateAllPools(ctx, pair.AppId, func(pool types.Pool) (stop bool, err error) {
+		if pool.Type != types.PoolTypeWeighted || pool.Disabled {
+			return false, nil
+		}
+		spendable := k.bankKeeper.SpendableCoins(ctx, pool.GetReserveAddress())
+		baseCoin := sdk.NewCoin(pair.BaseCoinDenom, spendable.AmountOf(pair.BaseCoinDenom))
+		quoteCoin := sdk.NewCoin(pair.QuoteCoinDenom, spendable.AmountOf(pair.QuoteCoinDenom))
+		ammPool, found := pool.WeightedAMMPool(baseCoin, quoteCoin, k.GetPoolCoinSupply(ctx, pool))
+		if !found || ammPool.IsDepleted() {
+			return false, nil
+		}
+		orderers = append(orderers, types.NewPoolOrderer(
+			ammPool, pool.Id, pool.GetReserveAddress(), pair.BaseCoinDenom, pair.QuoteCoinDenom))
+		return false, nil
+	})
+	return orderers
+}
+
+// ValidateCreateWeightedPool validates the creation of a weighted pool.
+func (k Keeper) ValidateCreateWeightedPool(ctx sdk.Context, appID uint64, assets []types.WeightedPoolAsset) error {
+	_, found := k.assetKeeper.GetApp(ctx, appID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidAppID, "app id %d not found", appID)
+	}
+
+	if err := types.ValidateWeightedPoolAssets(assets); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	for _, asset := range assets {
+		if !k.assetKeeper.HasAssetForDenom(ctx, asset.Denom) {
+			return sdkerrors.Wrapf(types.ErrAssetNotWhiteListed, "asset with denom  %s is not white listed", asset.Denom)
+		}
+		if err := k.validateDenomsListed(ctx, asset.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateWeightedPool creates an empty weighted pool with the given assets and
+// weights. Weighted pools are created via governance only, and the first join
+// into the pool sets its initial balances.
+func (k Keeper) CreateWeightedPool(ctx sdk.Context, appID uint64, creator sdk.AccAddress, assets []types.WeightedPoolAsset) (types.Pool, error) {
+	if err := k.ValidateCreateWeightedPool(ctx, appID, assets); err != nil {
+		return types.Pool{}, err
+	}
+
+	poolID := k.getNextPoolIDWithUpdate(ctx, appID)
+	pool := types.NewWeightedPool(appID, poolID, creator, assets)
+	k.SetPool(ctx, pool)
+	k.SetPoolByReserveIndex(ctx, pool)
+
+	assetStrs := make([]string, 0, len(assets))
+	for _, asset := range assets {
+		assetStrs = append(assetStrs, asset.Denom+":"+strconv.FormatUint(asset.Weight, 10))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateWeightedPool,
+			sdk.NewAttribute(types.AttributeKeyCreator, pool.Creator),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyWeightedAssets, strings.Join(assetStrs, ",")),
+			sdk.NewAttribute(types.AttributeKeyReserveAddress, pool.ReserveAddress),
+		),
+	})
+
+	return pool, nil
+}
+
+// ValidateMsgJoinWeightedPool validates types.MsgJoinWeightedPool.
+func (k Keeper) ValidateMsgJoinWeightedPool(ctx sdk.Context, msg *types.MsgJoinWeightedPool) error {
+	_, found := k.assetKeeper.GetApp(ctx, msg.AppId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidAppID, "app id %d not found", msg.AppId)
+	}
+
+	pool, err := k.getWeightedPool(ctx, msg.AppId, msg.PoolId)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range msg.DepositCoins {
+		if _, found := pool.WeightedAsset(coin.Denom); !found {
+			return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pool", coin.Denom)
+		}
+		if err := k.validateDenomsListed(ctx, coin.Denom); err != nil {
+			return err
+		}
+	}
+	if len(msg.DepositCoins) != 1 && len(msg.DepositCoins) != len(pool.WeightedAssets) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "deposit coins must be either a single coin or all assets of the pool")
+	}
+
+	for i, b := range k.getWeightedPoolBalances(ctx, pool) {
+		if b.Add(msg.DepositCoins.AmountOf(pool.WeightedAssets[i].Denom)).GT(amm.MaxCoinAmount) {
+			return types.ErrTooLargePool
+		}
+	}
+
+	return nil
+}
+
+// JoinWeightedPool handles types.MsgJoinWeightedPool and deposits coins to
+// the weighted pool immediately.
+// Depositing all assets of the pool deposits them in the pool's ratio,
+// and depositing a single coin deposits it following the pool's weights,
+// charging the swap fee rate on the part implicitly swapped into the others.
+func (k Keeper) JoinWeightedPool(ctx sdk.Context, msg *types.MsgJoinWeightedPool) (sdk.Coin, error) {
+	if err := k.ValidateMsgJoinWeightedPool(ctx, msg); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	params, err := k.GetGenericParams(ctx, msg.AppId)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(err, "params retreval failed")
+	}
+
+	pool, _ := k.GetPool(ctx, msg.AppId, msg.PoolId)
+	bs := k.getWeightedPoolBalances(ctx, pool)
+	ps := k.GetPoolCoinSupply(ctx, pool)
+
+	var acceptedCoins sdk.Coins
+	var pc sdk.Int
+	switch {
+	case ps.IsZero():
+		// The first join into the pool must provide all of its assets.
+		if len(msg.DepositCoins) != len(pool.WeightedAssets) {
+			return sdk.Coin{}, sdkerrors.Wrap(types.ErrDepletedPool, "the first deposit must include all assets of the pool")
+		}
+		for _, coin := range msg.DepositCoins {
+			minDepositCoin := sdk.NewCoin(coin.Denom, params.MinInitialDepositAmount)
+			if coin.IsLT(minDepositCoin) {
+				return sdk.Coin{}, sdkerrors.Wrapf(
+					types.ErrInsufficientDepositAmount, "%s is smaller than %s", coin, minDepositCoin)
+			}
+		}
+		acceptedCoins = msg.DepositCoins
+		pc = params.MinInitialPoolCoinSupply
+	case len(msg.DepositCoins) == len(pool.WeightedAssets):
+		amts := make([]sdk.Int, len(pool.WeightedAssets))
+		for i, asset := range pool.WeightedAssets {
+			amts[i] = msg.DepositCoins.AmountOf(asset.Denom)
+		}
+		var accepted []sdk.Int
+		accepted, pc = amm.WeightedDeposit(bs, ps, amts)
+		acceptedCoins = sdk.Coins{}
+		for i, amt := range accepted {
+			acceptedCoins = acceptedCoins.Add(sdk.NewCoin(pool.WeightedAssets[i].Denom, amt))
+		}
+	default:
+		depositCoin := msg.DepositCoins[0]
+		asset, _ := pool.WeightedAsset(depositCoin.Denom)
+		var b sdk.Int
+		for i, a := range pool.WeightedAssets {
+			if a.Denom == asset.Denom {
+				b = bs[i]
+			}
+		}
+		if depositCoin.Amount.ToDec().GT(b.ToDec().Mul(amm.MaxWeightedPoolInRatio)) {
+			return sdk.Coin{}, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "deposit coin must not exceed %s of the pool's balance", amm.MaxWeightedPoolInRatio)
+		}
+		acceptedCoins = sdk.NewCoins(depositCoin)
+		pc = amm.WeightedSingleDeposit(b, ps, depositCoin.Amount, asset.Weight, pool.TotalWeight(), params.SwapFeeRate)
+	}
+
+	if !pc.IsPositive() {
+		return sdk.Coin{}, types.ErrInsufficientDepositAmount
+	}
+	if pc.LT(msg.MinPoolCoinAmount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrTooSmallPoolCoinAmount, "%s is smaller than %s", pc, msg.MinPoolCoinAmount)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetDepositor(), pool.GetReserveAddress(), acceptedCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+	mintedPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, pc)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedPoolCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, msg.GetDepositor(), sdk.NewCoins(mintedPoolCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.GasMeter().ConsumeGas(params.DepositExtraGas, "DepositExtraGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeJoinWeightedPool,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyAcceptedCoins, acceptedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, mintedPoolCoin.String()),
+		),
+	})
+
+	return mintedPoolCoin, nil
+}
+
+// ValidateMsgExitWeightedPool validates types.MsgExitWeightedPool.
+func (k Keeper) ValidateMsgExitWeightedPool(ctx sdk.Context, msg *types.MsgExitWeightedPool) error {
+	_, found := k.assetKeeper.GetApp(ctx, msg.AppId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidAppID, "app id %d not found", msg.AppId)
+	}
+
+	pool, err := k.getWeightedPool(ctx, msg.AppId, msg.PoolId)
+	if err != nil {
+		return err
+	}
+
+	if msg.PoolCoin.Denom != pool.PoolCoinDenom {
+		return types.ErrWrongPoolCoinDenom
+	}
+
+	if msg.DemandCoinDenom != "" {
+		if _, found := pool.WeightedAsset(msg.DemandCoinDenom); !found {
+			return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pool", msg.DemandCoinDenom)
+		}
+		// The other assets are implicitly swapped into the demand coin,
+		// so the pool must not be emptied by the withdrawal.
+		if msg.PoolCoin.Amount.GTE(k.GetPoolCoinSupply(ctx, pool)) {
+			return types.ErrWithdrawAllPoolCoin
+		}
+	}
+
+	return nil
+}
+
+// ExitWeightedPool handles types.MsgExitWeightedPool and withdraws coins from
+// the weighted pool immediately.
+// Without a demand coin denom, all assets of the pool are withdrawn in the
+// pool's ratio. Otherwise only the demand coin is withdrawn, following the
+// pool's weights.
+func (k Keeper) ExitWeightedPool(ctx sdk.Context, msg *types.MsgExitWeightedPool) (sdk.Coins, error) {
+	if err := k.ValidateMsgExitWeightedPool(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	params, err := k.GetGenericParams(ctx, msg.AppId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "params retreval failed")
+	}
+
+	pool, _ := k.GetPool(ctx, msg.AppId, msg.PoolId)
+	bs := k.getWeightedPoolBalances(ctx, pool)
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	if msg.PoolCoin.Amount.GT(ps) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is greater than the pool coin supply", msg.PoolCoin)
+	}
+
+	withdrawnCoins := sdk.Coins{}
+	if msg.DemandCoinDenom == "" {
+		for i, amt := range amm.WeightedWithdraw(bs, ps, msg.PoolCoin.Amount, params.WithdrawFeeRate) {
+			withdrawnCoins = withdrawnCoins.Add(sdk.NewCoin(pool.WeightedAssets[i].Denom, amt))
+		}
+	} else {
+		var b sdk.Int
+		var asset types.WeightedPoolAsset
+		for i, a := range pool.WeightedAssets {
+			if a.Denom == msg.DemandCoinDenom {
+				asset, b = a, bs[i]
+			}
+		}
+		amt := amm.WeightedSingleWithdraw(
+			b, ps, msg.PoolCoin.Amount, asset.Weight, pool.TotalWeight(), params.SwapFeeRate, params.WithdrawFeeRate)
+		if amt.ToDec().GT(b.ToDec().Mul(amm.MaxWeightedPoolOutRatio)) {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "withdrawn coin must not exceed %s of the pool's balance", amm.MaxWeightedPoolOutRatio)
+		}
+		if amt.LT(msg.MinDemandCoinAmount) {
+			return nil, sdkerrors.Wrapf(types.ErrTooSmallDemandCoinAmount, "%s is smaller than %s", amt, msg.MinDemandCoinAmount)
+		}
+		withdrawnCoins = withdrawnCoins.Add(sdk.NewCoin(msg.DemandCoinDenom, amt))
+	}
+	if withdrawnCoins.IsZero() {
+		return nil, types.ErrCalculatedPoolAmountIsZero
+	}
+
+	burningCoins := sdk.NewCoins(msg.PoolCoin)
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(msg.GetWithdrawer(), k.accountKeeper.GetModuleAddress(types.ModuleName), burningCoins)
+	bulkOp.QueueSendCoins(pool.GetReserveAddress(), msg.GetWithdrawer(), withdrawnCoins)
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burningCoins); err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(params.WithdrawExtraGas, "WithdrawExtraGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeExitWeightedPool,
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, msg.Withdrawer),
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolCoin, msg.PoolCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
+			sdk.NewAttribute(types.AttributeKeyWithdrawnCoins, withdrawnCoins.String()),
+		),
+	})
+
+	return withdrawnCoins, nil
+}
+
+// DeserializeWeightedPoolCoin splits the weighted pool's pool coin amount into
+// the pool's assets, in the pool's ratio.
+func (k Keeper) DeserializeWeightedPoolCoin(ctx sdk.Context, pool types.Pool, poolCoinAmount sdk.Int) (sdk.Coins, error) {
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	if ps.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrDepletedPool, "pool %d is depleted", pool.Id)
+	}
+	if poolCoinAmount.GT(ps) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool coin amount is greater than the pool coin supply")
+	}
+	coins := sdk.Coins{}
+	for i, amt := range amm.WeightedWithdraw(k.getWeightedPoolBalances(ctx, pool), ps, poolCoinAmount, sdk.ZeroDec()) {
+		coins = coins.Add(sdk.NewCoin(pool.WeightedAssets[i].Denom, amt))
+	}
+	return coins, nil
+}
+
+// weightedPoolCoinValue returns the oracle value of the weighted pool's pool
+// coin amount.
+// Assets without an oracle price are valued by the weights of the priced
+// assets, the same way a single priced asset values a 50-50 pool.
+func (k Keeper) weightedPoolCoinValue(ctx sdk.Context, pool types.Pool, poolCoinAmount sdk.Int) (sdk.Dec, error) {
+	coins, err := k.DeserializeWeightedPoolCoin(ctx, pool, poolCoinAmount)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	value := sdk.ZeroDec()
+	pricedWeight := uint64(0)
+	for _, asset := range pool.WeightedAssets {
+		_, found, oracleAsset := k.OraclePrice(ctx, asset.Denom)
+		if !found {
+			continue
+		}
+		assetValue, err := k.CalcAssetPrice(ctx, oracleAsset.Id, coins.AmountOf(asset.Denom))
+		if err != nil {
+			continue
+		}
+		value = value.Add(assetValue)
+		pricedWeight += asset.Weight
+	}
+	if pricedWeight == 0 {
+		return sdk.Dec{}, types.ErrOraclePricesNotFound
+	}
+	return value.MulInt64(int64(pool.TotalWeight())).QuoInt64(int64(pricedWeight)), nil
+}
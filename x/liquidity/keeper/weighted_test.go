@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/comdex-official/comdex/types"
+	"github.com/comdex-official/comdex/x/liquidity"
+	"github.com/comdex-official/comdex/x/liquidity/types"
+)
+
+func weightedAssets(denomWeights ...interface{}) []types.WeightedPoolAsset {
+	assets := []types.WeightedPoolAsset{}
+	for i := 0; i < len(denomWeights); i += 2 {
+		assets = append(assets, types.WeightedPoolAsset{
+			Denom:  denomWeights[i].(string),
+			Weight: uint64(denomWeights[i+1].(int)),
+		})
+	}
+	return assets
+}
+
+func (s *KeeperTestSuite) TestCreateWeightedPool() {
+	addr1 := s.addr(1)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+	asset3 := s.CreateNewAsset("ASSETTHREE", "uasset3", 1000000)
+
+	testCases := []struct {
+		Name   string
+		AppID  uint64
+		Assets []types.WeightedPoolAsset
+		ExpErr error
+	}{
+		{
+			Name:   "error app id invalid",
+			AppID:  69,
+			Assets: weightedAssets(asset1.Denom, 50, asset2.Denom, 50),
+			ExpErr: sdkerrors.Wrapf(types.ErrInvalidAppID, "app id %d not found", 69),
+		},
+		{
+			Name:   "error single asset",
+			AppID:  appID1,
+			Assets: weightedAssets(asset1.Denom, 50),
+			ExpErr: sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "number of assets must be between %d and %d: %d", 2, 8, 1),
+		},
+		{
+			Name:   "error asset not whitelisted",
+			AppID:  appID1,
+			Assets: weightedAssets(asset1.Denom, 50, "uasset4", 50),
+			ExpErr: sdkerrors.Wrapf(types.ErrAssetNotWhiteListed, "asset with denom  %s is not white listed", "uasset4"),
+		},
+		{
+			Name:   "success",
+			AppID:  appID1,
+			Assets: weightedAssets(asset1.Denom, 40, asset2.Denom, 40, asset3.Denom, 20),
+			ExpErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			pool, err := s.keeper.CreateWeightedPool(s.ctx, tc.AppID, addr1, tc.Assets)
+			if tc.ExpErr != nil {
+				s.Require().Error(err)
+				s.Require().EqualError(err, tc.ExpErr.Error())
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(types.PoolTypeWeighted, pool.Type)
+				s.Require().Equal(uint64(0), pool.PairId)
+				s.Require().Equal(uint64(100), pool.TotalWeight())
+
+				storedPool, found := s.keeper.GetPool(s.ctx, tc.AppID, pool.Id)
+				s.Require().True(found)
+				s.Require().Equal(pool, storedPool)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestJoinExitWeightedPool() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+	asset3 := s.CreateNewAsset("ASSETTHREE", "uasset3", 1000000)
+
+	pool, err := s.keeper.CreateWeightedPool(
+		s.ctx, appID1, addr1, weightedAssets(asset1.Denom, 40, asset2.Denom, 40, asset3.Denom, 20))
+	s.Require().NoError(err)
+
+	// The first join must provide all assets of the pool.
+	msg := types.NewMsgJoinWeightedPool(appID1, addr1, pool.Id, utils.ParseCoins("1000000000uasset1"), sdk.ZeroInt())
+	s.fundAddr(addr1, msg.DepositCoins)
+	_, err = s.keeper.JoinWeightedPool(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrDepletedPool)
+
+	params, err := s.keeper.GetGenericParams(s.ctx, appID1)
+	s.Require().NoError(err)
+
+	poolCoin := s.JoinWeightedPool(appID1, pool.Id, addr1, "1000000000uasset1,1000000000uasset2,500000000uasset3", sdk.ZeroInt())
+	s.Require().True(params.MinInitialPoolCoinSupply.Equal(poolCoin.Amount))
+
+	// A proportional join only takes the coins in the pool's ratio.
+	poolCoin = s.JoinWeightedPool(appID1, pool.Id, addr2, "100000000uasset1,200000000uasset2,50000000uasset3", sdk.ZeroInt())
+	s.Require().True(params.MinInitialPoolCoinSupply.QuoRaw(10).Equal(poolCoin.Amount))
+	s.Require().True(utils.ParseCoins("100000000uasset2").IsEqual(s.getBalances(addr2).Sub(sdk.NewCoins(poolCoin))))
+	s.Require().True(utils.ParseCoins("1100000000uasset1,1100000000uasset2,550000000uasset3").IsEqual(
+		s.keeper.GetWeightedPoolBalances(s.ctx, pool)))
+
+	// A single coin join mints less than the proportional share, due to the swap fee.
+	msg = types.NewMsgJoinWeightedPool(appID1, addr2, pool.Id, utils.ParseCoins("110000000uasset3"), sdk.ZeroInt())
+	s.fundAddr(addr2, msg.DepositCoins)
+	singlePoolCoin, err := s.keeper.JoinWeightedPool(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().True(singlePoolCoin.IsPositive())
+	// 20% more uasset3, which weighs 20%, is worth 4% of the supply, minus the implicit swap's slippage.
+	s.Require().True(singlePoolCoin.Amount.LT(params.MinInitialPoolCoinSupply.MulRaw(11).QuoRaw(10).MulRaw(4).QuoRaw(100)))
+
+	// The minimum pool coin amount is respected.
+	msg = types.NewMsgJoinWeightedPool(appID1, addr2, pool.Id, utils.ParseCoins("1000000uasset1"), sdk.NewInt(1000000000000))
+	s.fundAddr(addr2, msg.DepositCoins)
+	_, err = s.keeper.JoinWeightedPool(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrTooSmallPoolCoinAmount)
+
+	// Too large single coin joins are rejected.
+	msg = types.NewMsgJoinWeightedPool(appID1, addr2, pool.Id, utils.ParseCoins("1000000000uasset3"), sdk.ZeroInt())
+	s.fundAddr(addr2, msg.DepositCoins)
+	_, err = s.keeper.JoinWeightedPool(s.ctx, msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// A single coin exit.
+	balanceBefore := s.getBalance(addr2, asset3.Denom)
+	withdrawn, err := s.keeper.ExitWeightedPool(s.ctx, types.NewMsgExitWeightedPool(
+		appID1, addr2, pool.Id, singlePoolCoin, asset3.Denom, sdk.ZeroInt()))
+	s.Require().NoError(err)
+	s.Require().Len(withdrawn, 1)
+	s.Require().True(withdrawn.AmountOf(asset3.Denom).LT(sdk.NewInt(110000000)))
+	s.Require().True(balanceBefore.Amount.Add(withdrawn.AmountOf(asset3.Denom)).Equal(s.getBalance(addr2, asset3.Denom).Amount))
+
+	smallPoolCoin := sdk.NewCoin(poolCoin.Denom, poolCoin.Amount.QuoRaw(10))
+	_, err = s.keeper.ExitWeightedPool(s.ctx, types.NewMsgExitWeightedPool(
+		appID1, addr2, pool.Id, smallPoolCoin, asset3.Denom, sdk.NewInt(100000000)))
+	s.Require().ErrorIs(err, types.ErrTooSmallDemandCoinAmount)
+
+	// Too large single coin exits are rejected.
+	_, err = s.keeper.ExitWeightedPool(s.ctx, types.NewMsgExitWeightedPool(
+		appID1, addr2, pool.Id, poolCoin, asset3.Denom, sdk.ZeroInt()))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// A proportional exit.
+	withdrawn, err = s.keeper.ExitWeightedPool(s.ctx, types.NewMsgExitWeightedPool(
+		appID1, addr2, pool.Id, poolCoin, "", sdk.ZeroInt()))
+	s.Require().NoError(err)
+	s.Require().Len(withdrawn, 3)
+	s.Require().True(s.getBalance(addr2, pool.PoolCoinDenom).IsZero())
+
+	// Exiting with every pool coin into a single coin is not allowed.
+	_, err = s.keeper.ExitWeightedPool(s.ctx, types.NewMsgExitWeightedPool(
+		appID1, addr1, pool.Id, s.getBalance(addr1, pool.PoolCoinDenom), asset1.Denom, sdk.ZeroInt()))
+	s.Require().ErrorIs(err, types.ErrWithdrawAllPoolCoin)
+
+	// Batch deposits into a weighted pool are rejected.
+	_, err = s.keeper.Deposit(s.ctx, types.NewMsgDeposit(appID1, addr1, pool.Id, utils.ParseCoins("1000000uasset1,1000000uasset2")))
+	s.Require().ErrorIs(err, types.ErrWrongPoolType)
+}
+
+func (s *KeeperTestSuite) TestWeightedPoolMatching() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+
+	asset1 := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asset2 := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+	asset3 := s.CreateNewAsset("ASSETTHREE", "uasset3", 1000000)
+
+	pair := s.CreateNewLiquidityPair(appID1, addr1, asset1.Denom, asset2.Denom)
+	pool := s.CreateNewWeightedPool(
+		appID1, addr1, weightedAssets(asset1.Denom, 40, asset2.Denom, 40, asset3.Denom, 20),
+		"1000000000uasset1,1000000000uasset2,500000000uasset3")
+
+	resp, err := s.querier.Pool(sdk.WrapSDKContext(s.ctx), &types.QueryPoolRequest{AppId: appID1, PoolId: pool.Id})
+	s.Require().NoError(err)
+	s.Require().Nil(resp.Pool.Price)
+	s.Require().Len(resp.Pool.WeightedAssets, 3)
+	s.Require().True(utils.ParseCoins("1000000000uasset1,1000000000uasset2,500000000uasset3").IsEqual(resp.Pool.WeightedBalances))
+
+	// The weighted pool's uasset1/uasset2 view takes the other side of the order.
+	order := s.LimitOrder(appID1, addr2, pair.Id, types.OrderDirectionSell, utils.ParseDec("0.99"), sdk.NewInt(1000000), 0)
+	liquidity.EndBlocker(s.ctx, s.keeper, s.app.AssetKeeper)
+
+	order, found := s.keeper.GetOrder(s.ctx, appID1, order.PairId, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusCompleted, order.Status)
+	s.Require().True(s.getBalance(addr2, asset2.Denom).IsPositive())
+
+	balances := s.keeper.GetWeightedPoolBalances(s.ctx, pool)
+	s.Require().True(balances.AmountOf(asset1.Denom).GT(sdk.NewInt(1000000000)))
+	s.Require().True(balances.AmountOf(asset2.Denom).LT(sdk.NewInt(1000000000)))
+	s.Require().True(balances.AmountOf(asset3.Denom).Equal(sdk.NewInt(500000000)))
+
+	// The pool coin can be deserialized into the pool's assets.
+	coins, err := s.keeper.DeserializeWeightedPoolCoin(s.ctx, pool, s.getBalance(addr1, pool.PoolCoinDenom).Amount.QuoRaw(10))
+	s.Require().NoError(err)
+	s.Require().True(utils.DecApproxEqual(sdk.NewDec(50000000), coins.AmountOf(asset3.Denom).ToDec()))
+}
@@ -25,8 +25,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCancelMMOrder{}, "comdex/liquidity/MsgCancelMMOrder", nil)
 	cdc.RegisterConcrete(&MsgFarm{}, "comdex/liquidity/MsgFarm", nil)
 	cdc.RegisterConcrete(&MsgUnfarm{}, "comdex/liquidity/MsgUnfarm", nil)
+	cdc.RegisterConcrete(&MsgZapDeposit{}, "comdex/liquidity/MsgZapDeposit", nil)
+	cdc.RegisterConcrete(&MsgZapWithdraw{}, "comdex/liquidity/MsgZapWithdraw", nil)
+	cdc.RegisterConcrete(&MsgCreateConcentratedPool{}, "comdex/liquidity/MsgCreateConcentratedPool", nil)
+	cdc.RegisterConcrete(&MsgOpenPosition{}, "comdex/liquidity/MsgOpenPosition", nil)
+	cdc.RegisterConcrete(&MsgAddLiquidity{}, "comdex/liquidity/MsgAddLiquidity", nil)
+	cdc.RegisterConcrete(&MsgRemoveLiquidity{}, "comdex/liquidity/MsgRemoveLiquidity", nil)
+	cdc.RegisterConcrete(&MsgCollectFees{}, "comdex/liquidity/MsgCollectFees", nil)
+	cdc.RegisterConcrete(&MsgJoinWeightedPool{}, "comdex/liquidity/MsgJoinWeightedPool", nil)
+	cdc.RegisterConcrete(&MsgExitWeightedPool{}, "comdex/liquidity/MsgExitWeightedPool", nil)
 	cdc.RegisterConcrete(&UpdateGenericParamsProposal{}, "comdex/liquidity/UpdateGenericParamsProposal", nil)
 	cdc.RegisterConcrete(&CreateNewLiquidityPairProposal{}, "comdex/liquidity/CreateNewLiquidityPairProposal", nil)
+	cdc.RegisterConcrete(&CreateWeightedPoolProposal{}, "comdex/liquidity/CreateWeightedPoolProposal", nil)
 }
 
 // RegisterInterfaces registers the x/liquidity interfaces types with the
@@ -36,6 +46,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*govtypes.Content)(nil),
 		&UpdateGenericParamsProposal{},
 		&CreateNewLiquidityPairProposal{},
+		&CreateWeightedPoolProposal{},
 	)
 
 	registry.RegisterImplementations(
@@ -53,6 +64,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCancelMMOrder{},
 		&MsgFarm{},
 		&MsgUnfarm{},
+		&MsgZapDeposit{},
+		&MsgZapWithdraw{},
+		&MsgCreateConcentratedPool{},
+		&MsgOpenPosition{},
+		&MsgAddLiquidity{},
+		&MsgRemoveLiquidity{},
+		&MsgCollectFees{},
+		&MsgJoinWeightedPool{},
+		&MsgExitWeightedPool{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
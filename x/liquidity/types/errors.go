@@ -39,4 +39,15 @@ var (
 	ErrorNotPositiveAmont              = sdkerrors.Register(ModuleName, 830, "amount should be positive")
 	ErrTooManyPools                    = sdkerrors.Register(ModuleName, 831, "too many pools in the pair")
 	ErrPriceNotOnTicks                 = sdkerrors.Register(ModuleName, 832, "price is not on ticks")
+	ErrWithdrawAllPoolCoin             = sdkerrors.Register(ModuleName, 833, "cannot withdraw whole pool coin supply into a single asset")
+	ErrInvalidLockDuration             = sdkerrors.Register(ModuleName, 834, "invalid farming lock duration")
+	ErrPoolCoinLocked                  = sdkerrors.Register(ModuleName, 835, "farmed pool coin is locked")
+	ErrWrongPoolType                   = sdkerrors.Register(ModuleName, 836, "wrong pool type")
+	ErrPositionNotFound                = sdkerrors.Register(ModuleName, 837, "position not found")
+	ErrNotPositionOwner                = sdkerrors.Register(ModuleName, 838, "not the owner of the position")
+	ErrInsufficientLiquidity           = sdkerrors.Register(ModuleName, 839, "insufficient liquidity")
+	ErrTooSmallPoolCoinAmount          = sdkerrors.Register(ModuleName, 840, "minted pool coin amount is less than the minimum")
+	ErrTooSmallDemandCoinAmount        = sdkerrors.Register(ModuleName, 841, "withdrawn demand coin amount is less than the minimum")
+	ErrTooSmallSwapOutAmount           = sdkerrors.Register(ModuleName, 842, "swapped out demand coin amount is less than the minimum")
+	ErrAssetDelisted                   = sdkerrors.Register(ModuleName, 843, "asset is being delisted")
 )
@@ -20,6 +20,21 @@ const (
 	EventTypePoolOrderMatched = "pool_order_matched"
 	EventTypeFarm             = "farm"
 	EventTypeUnfarm           = "unfarm"
+	EventTypeZapDeposit       = "zap_deposit"
+	EventTypeZapWithdraw      = "zap_withdraw"
+	EventTypeZapSwap          = "zap_swap"
+
+	EventTypeCreateConcentratedPool = "create_concentrated_pool"
+	EventTypeOpenPosition           = "open_position"
+	EventTypeAddLiquidity           = "add_liquidity"
+	EventTypeRemoveLiquidity        = "remove_liquidity"
+	EventTypeCollectFees            = "collect_fees"
+	EventTypeAccrueFees             = "accrue_fees"
+	EventTypeCreateWeightedPool     = "create_weighted_pool"
+	EventTypeJoinWeightedPool       = "join_weighted_pool"
+	EventTypeExitWeightedPool       = "exit_weighted_pool"
+	EventTypeUnwindPoolCoin         = "unwind_pool_coin"
+	EventTypeSwapExactAmountIn      = "swap_exact_amount_in"
 
 	AttributeKeyCreator                 = "creator"
 	AttributeKeyDepositor               = "depositor"
@@ -59,4 +74,22 @@ const (
 	AttributeKeyTimeStamp               = "timestamp"
 	AttributeKeyMatchedAmount           = "matched_amount"
 	AttributeKeyPaidCoin                = "paid_coin"
+	AttributeKeyDepositCoin             = "deposit_coin"
+	AttributeKeyMinPoolCoinAmount       = "min_pool_coin_amount"
+	AttributeKeyMinDemandCoinAmount     = "min_demand_coin_amount"
+	AttributeKeySwapFeeCoin             = "swap_fee_coin"
+	AttributeKeyLockDuration            = "lock_duration"
+	AttributeKeyBoostMultiplier         = "boost_multiplier"
+	AttributeKeyUnlockAt                = "unlock_at"
+	AttributeKeyOwner                   = "owner"
+	AttributeKeyPositionID              = "position_id"
+	AttributeKeyMinPrice                = "min_price"
+	AttributeKeyMaxPrice                = "max_price"
+	AttributeKeyFeeRate                 = "fee_rate"
+	AttributeKeyLiquidity               = "liquidity"
+	AttributeKeyCollectedFees           = "collected_fees"
+	AttributeKeyAccruedFees             = "accrued_fees"
+	AttributeKeyWeightedAssets          = "weighted_assets"
+	AttributeKeyHolder                  = "holder"
+	AttributeKeyTrader                  = "trader"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -106,3 +107,84 @@ func UnmarshalQueuedFarmer(cdc codec.BinaryCodec, value []byte) (queuedFarmer Qu
 	err = cdc.Unmarshal(value, &queuedFarmer)
 	return queuedFarmer, err
 }
+
+// NewLockedFarmer returns a new locked farmer object.
+func NewLockedFarmer(appID, poolID uint64, farmer sdk.AccAddress) LockedFarmer {
+	return LockedFarmer{
+		AppId:       appID,
+		PoolId:      poolID,
+		Farmer:      farmer.String(),
+		LockedCoins: []*LockedCoin{},
+	}
+}
+
+// Validate validates LockedFarmer.
+func (lockedFarmer LockedFarmer) Validate() error {
+	if lockedFarmer.AppId == 0 {
+		return fmt.Errorf("app id must not be 0")
+	}
+	if lockedFarmer.PoolId == 0 {
+		return fmt.Errorf("pool id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(lockedFarmer.Farmer); err != nil {
+		return fmt.Errorf("invalid farmer address %s: %w", lockedFarmer.Farmer, err)
+	}
+	for i, lockedCoin := range lockedFarmer.LockedCoins {
+		if err := lockedCoin.FarmedPoolCoin.Validate(); err != nil {
+			return fmt.Errorf("invalid locked coin at index %d: %w", i, err)
+		}
+		if lockedCoin.LockDuration <= 0 {
+			return fmt.Errorf("lock duration of locked coin at index %d must be positive: %s", i, lockedCoin.LockDuration)
+		}
+		if lockedCoin.BoostMultiplier.IsNil() || lockedCoin.BoostMultiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("boost multiplier of locked coin at index %d must not be less than 1: %s", i, lockedCoin.BoostMultiplier)
+		}
+		if !lockedCoin.UnlockAt.Equal(lockedCoin.LockedAt.Add(lockedCoin.LockDuration)) {
+			return fmt.Errorf("unlock time of locked coin at index %d does not match its lock duration", i)
+		}
+	}
+	return nil
+}
+
+// UnmaturedLockedCoins returns the locked coins which are not unlocked yet at the given time.
+func (lockedFarmer LockedFarmer) UnmaturedLockedCoins(now time.Time) []*LockedCoin {
+	lockedCoins := []*LockedCoin{}
+	for _, lockedCoin := range lockedFarmer.LockedCoins {
+		if now.Before(lockedCoin.UnlockAt) {
+			lockedCoins = append(lockedCoins, lockedCoin)
+		}
+	}
+	return lockedCoins
+}
+
+// LockedAmount returns the total amount of pool coin which is not unlocked yet at the given time.
+func (lockedFarmer LockedFarmer) LockedAmount(now time.Time) sdk.Int {
+	amount := sdk.ZeroInt()
+	for _, lockedCoin := range lockedFarmer.UnmaturedLockedCoins(now) {
+		amount = amount.Add(lockedCoin.FarmedPoolCoin.Amount)
+	}
+	return amount
+}
+
+// MustMarshalLockedFarmer returns the locked farmer bytes.
+// It throws panic if it fails.
+func MustMarshalLockedFarmer(cdc codec.BinaryCodec, lockedFarmer LockedFarmer) []byte {
+	return cdc.MustMarshal(&lockedFarmer)
+}
+
+// MustUnmarshalLockedFarmer return the unmarshalled locked farmer from bytes.
+// It throws panic if it fails.
+func MustUnmarshalLockedFarmer(cdc codec.BinaryCodec, value []byte) LockedFarmer {
+	lockedFarmer, err := UnmarshalLockedFarmer(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return lockedFarmer
+}
+
+// UnmarshalLockedFarmer returns the locked farmer from bytes.
+func UnmarshalLockedFarmer(cdc codec.BinaryCodec, value []byte) (lockedFarmer LockedFarmer, err error) {
+	err = cdc.Unmarshal(value, &lockedFarmer)
+	return lockedFarmer, err
+}
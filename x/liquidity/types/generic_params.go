@@ -56,6 +56,7 @@ var (
 	SwapFeeBurnRate              = "SwapFeeBurnRate"
 	MaxNumMarketMakingOrderTicks = "MaxNumMarketMakingOrderTicks"
 	MaxNumActivePoolsPerPair     = "MaxNumActivePoolsPerPair"
+	FarmingLockBoosts            = "FarmingLockBoosts"
 )
 
 var UpdatableKeys = []string{
@@ -75,6 +76,7 @@ var UpdatableKeys = []string{
 	SwapFeeBurnRate,
 	MaxNumMarketMakingOrderTicks,
 	MaxNumActivePoolsPerPair,
+	FarmingLockBoosts,
 }
 
 // DeriveFeeCollectorAddress returns a unique address of the fee collector.
@@ -141,6 +143,7 @@ func KeyParseValidateFuncMap() map[string][]interface{} {
 		SwapFeeBurnRate:              {ParseStringToDec, validateSwapFeeBurnRate},
 		MaxNumMarketMakingOrderTicks: {ParseStringToUint, validateMaxNumMarketMakingOrderTicks},
 		MaxNumActivePoolsPerPair:     {ParseStringToUint, validateMaxNumActivePoolsPerPair},
+		FarmingLockBoosts:            {ParseStringToFarmingLockBoosts, validateFarmingLockBoosts},
 	}
 }
 
@@ -170,6 +173,7 @@ func (genericParams GenericParams) Validate() error {
 		{genericParams.SwapFeeBurnRate, validateSwapFeeBurnRate},
 		{genericParams.MaxNumMarketMakingOrderTicks, validateMaxNumMarketMakingOrderTicks},
 		{genericParams.MaxNumActivePoolsPerPair, validateMaxNumActivePoolsPerPair},
+		{genericParams.FarmingLockBoosts, validateFarmingLockBoosts},
 	} {
 		if err := field.validateFunc(field.val); err != nil {
 			return err
@@ -206,6 +210,32 @@ func ParseStringToDuration(value string) (interface{}, error) {
 	return time.ParseDuration(value)
 }
 
+// ParseStringToFarmingLockBoosts parses comma separated duration:multiplier
+// pairs, e.g. "720h:1.5,2160h:2". An empty value clears the lock boosts.
+func ParseStringToFarmingLockBoosts(value string) (interface{}, error) {
+	boosts := []FarmingLockBoost{}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return boosts, nil
+	}
+	for _, item := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid farming lock boost %q, expected duration:multiplier", item)
+		}
+		lockDuration, err := time.ParseDuration(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		multiplier, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		boosts = append(boosts, FarmingLockBoost{LockDuration: lockDuration, BoostMultiplier: multiplier})
+	}
+	return boosts, nil
+}
+
 func ParseStringToGas(value string) (interface{}, error) {
 	gas, err := ParseStringToUint(value)
 	if err != nil {
@@ -446,3 +476,36 @@ func validateMaxNumActivePoolsPerPair(i interface{}) error {
 	}
 	return nil
 }
+
+func validateFarmingLockBoosts(i interface{}) error {
+	v, ok := i.([]FarmingLockBoost)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	durations := map[time.Duration]struct{}{}
+	for _, boost := range v {
+		if boost.LockDuration <= 0 {
+			return fmt.Errorf("farming lock duration must be positive: %s", boost.LockDuration)
+		}
+		if _, ok := durations[boost.LockDuration]; ok {
+			return fmt.Errorf("duplicate farming lock duration: %s", boost.LockDuration)
+		}
+		durations[boost.LockDuration] = struct{}{}
+		if boost.BoostMultiplier.IsNil() || boost.BoostMultiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("farming lock boost multiplier must not be less than 1: %s", boost.BoostMultiplier)
+		}
+	}
+
+	return nil
+}
+
+// FarmingLockBoostMultiplier returns the boost multiplier for the given lock duration.
+func (genericParams GenericParams) FarmingLockBoostMultiplier(lockDuration time.Duration) (sdk.Dec, bool) {
+	for _, boost := range genericParams.FarmingLockBoosts {
+		if boost.LockDuration == lockDuration {
+			return boost.BoostMultiplier, true
+		}
+	}
+	return sdk.Dec{}, false
+}
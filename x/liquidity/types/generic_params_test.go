@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -140,6 +141,40 @@ func TestGenericParamsValidate(t *testing.T) {
 			},
 			"swap fee burn rate cannot exceed 1 i.e 100 perc. : 2.000000000000000000",
 		},
+		{
+			"valid FarmingLockBoosts",
+			func(params *types.GenericParams) {
+				params.FarmingLockBoosts = []types.FarmingLockBoost{
+					{LockDuration: 720 * time.Hour, BoostMultiplier: sdk.NewDecWithPrec(15, 1)},
+					{LockDuration: 2160 * time.Hour, BoostMultiplier: sdk.NewDec(2)},
+				}
+			},
+			"",
+		},
+		{
+			"zero FarmingLockBoosts duration",
+			func(params *types.GenericParams) {
+				params.FarmingLockBoosts = []types.FarmingLockBoost{{LockDuration: 0, BoostMultiplier: sdk.NewDec(2)}}
+			},
+			"farming lock duration must be positive: 0s",
+		},
+		{
+			"duplicate FarmingLockBoosts duration",
+			func(params *types.GenericParams) {
+				params.FarmingLockBoosts = []types.FarmingLockBoost{
+					{LockDuration: time.Hour, BoostMultiplier: sdk.NewDec(2)},
+					{LockDuration: time.Hour, BoostMultiplier: sdk.NewDec(3)},
+				}
+			},
+			"duplicate farming lock duration: 1h0m0s",
+		},
+		{
+			"too small FarmingLockBoosts multiplier",
+			func(params *types.GenericParams) {
+				params.FarmingLockBoosts = []types.FarmingLockBoost{{LockDuration: time.Hour, BoostMultiplier: sdk.NewDecWithPrec(5, 1)}}
+			},
+			"farming lock boost multiplier must not be less than 1: 0.500000000000000000",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultGenericParams(1)
@@ -45,7 +45,7 @@ func (genState GenesisState) Validate() error {
 			if pool.Id > appState.LastPoolId {
 				return fmt.Errorf("pool at index %d has an id greater than last pool id: %d", i, pool.Id)
 			}
-			if _, ok := pairMap[pool.PairId]; !ok {
+			if _, ok := pairMap[pool.PairId]; !ok && pool.Type != PoolTypeWeighted {
 				return fmt.Errorf("pool at index %d has unknown pair id: %d", i, pool.PairId)
 			}
 			if _, ok := poolMap[pool.Id]; ok {
@@ -158,6 +158,31 @@ func (genState GenesisState) Validate() error {
 				return fmt.Errorf("active farmer at index %d has unknown pool id: %d", i, queuedFarmer.PoolId)
 			}
 		}
+
+		for i, lockedFarmer := range appState.LockedFarmers {
+			if err := lockedFarmer.Validate(); err != nil {
+				return fmt.Errorf("invalid locked farmer at index %d: %w", i, err)
+			}
+			if _, ok := poolMap[lockedFarmer.PoolId]; !ok {
+				return fmt.Errorf("locked farmer at index %d has unknown pool id: %d", i, lockedFarmer.PoolId)
+			}
+		}
+
+		for i, position := range appState.Positions {
+			if err := position.Validate(); err != nil {
+				return fmt.Errorf("invalid position at index %d: %w", i, err)
+			}
+			pool, ok := poolMap[position.PoolId]
+			if !ok {
+				return fmt.Errorf("position at index %d has unknown pool id: %d", i, position.PoolId)
+			}
+			if pool.Type != PoolTypeConcentrated {
+				return fmt.Errorf("position at index %d is in a non-concentrated pool: %d", i, position.PoolId)
+			}
+			if position.Id > pool.LastPositionId {
+				return fmt.Errorf("position at index %d has an id greater than last position id: %d", i, position.Id)
+			}
+		}
 	}
 
 	return nil
@@ -36,6 +36,8 @@ type AppGenesisState struct {
 	ActiveFarmers            []ActiveFarmer    `protobuf:"bytes,10,rep,name=active_farmers,json=activeFarmers,proto3" json:"active_farmers"`
 	QueuedFarmers            []QueuedFarmer    `protobuf:"bytes,11,rep,name=queued_farmers,json=queuedFarmers,proto3" json:"queued_farmers"`
 	MarketMakingOrderIndexes []MMOrderIndex    `protobuf:"bytes,12,rep,name=market_making_order_indexes,json=marketMakingOrderIndexes,proto3" json:"market_making_order_indexes"`
+	LockedFarmers            []LockedFarmer    `protobuf:"bytes,13,rep,name=locked_farmers,json=lockedFarmers,proto3" json:"locked_farmers"`
+	Positions                []Position        `protobuf:"bytes,14,rep,name=positions,proto3" json:"positions"`
 }
 
 func (m *AppGenesisState) Reset()         { *m = AppGenesisState{} }
@@ -120,42 +122,45 @@ func init() {
 }
 
 var fileDescriptor_f213b60d5f11ba59 = []byte{
-	// 559 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xdd, 0x6a, 0x1a, 0x4d,
-	0x18, 0xc7, 0xdd, 0x44, 0xf7, 0x7d, 0x3b, 0xda, 0x7c, 0x0c, 0x2d, 0x2c, 0x16, 0x36, 0x12, 0x68,
-	0x6a, 0x0f, 0xea, 0x92, 0xe4, 0xac, 0xd0, 0x42, 0x4a, 0x69, 0xf0, 0x40, 0x9a, 0x98, 0x42, 0xe9,
-	0x07, 0x2c, 0xa3, 0x33, 0x6e, 0x06, 0x57, 0x67, 0x9c, 0x19, 0x63, 0x72, 0x17, 0xbd, 0x8f, 0xde,
-	0x88, 0x3d, 0xcb, 0x61, 0x8f, 0x4a, 0xab, 0x37, 0x52, 0xe6, 0x23, 0xba, 0x06, 0xb6, 0x9e, 0xe9,
-	0xb3, 0xbf, 0xff, 0xef, 0x99, 0x81, 0xe7, 0x19, 0x70, 0xd0, 0x65, 0x03, 0x4c, 0xae, 0xa3, 0x94,
-	0x8e, 0xc6, 0x14, 0x53, 0x75, 0x13, 0x5d, 0x1d, 0x76, 0x88, 0x42, 0x87, 0x51, 0x42, 0x86, 0x44,
-	0x52, 0xd9, 0xe0, 0x82, 0x29, 0x06, 0x03, 0xcb, 0x35, 0x16, 0x5c, 0xc3, 0x71, 0xd5, 0x47, 0x09,
-	0x4b, 0x98, 0x81, 0x22, 0xfd, 0xcb, 0xf2, 0xd5, 0xa7, 0xb9, 0x5e, 0x8e, 0x04, 0x1a, 0x38, 0x6d,
-	0xb5, 0x9e, 0x8b, 0x2d, 0x1b, 0x19, 0x72, 0xff, 0x87, 0x0f, 0xb6, 0x4f, 0x38, 0x3f, 0xb5, 0xa7,
-	0xba, 0x50, 0x48, 0x11, 0xf8, 0x18, 0xf8, 0x88, 0xf3, 0x98, 0xe2, 0xc0, 0xab, 0x79, 0xf5, 0x62,
-	0xbb, 0x84, 0x38, 0x6f, 0x62, 0xf8, 0x01, 0x6c, 0xe9, 0xc3, 0x0b, 0xda, 0x8d, 0x6d, 0xb3, 0x60,
-	0xa3, 0xe6, 0xd5, 0xcb, 0x47, 0xcf, 0x1a, 0x79, 0x97, 0x68, 0x9c, 0x5a, 0xfe, 0xcc, 0xe0, 0x6f,
-	0x8a, 0xd3, 0x5f, 0x7b, 0x85, 0xf6, 0xc3, 0x24, 0x5b, 0x84, 0x35, 0x50, 0x49, 0x91, 0x54, 0x31,
-	0x47, 0x54, 0xe8, 0x96, 0x9b, 0xa6, 0x25, 0xd0, 0xb5, 0x33, 0x44, 0x45, 0x13, 0x2f, 0x09, 0xc6,
-	0x52, 0x4d, 0x14, 0x33, 0x04, 0x63, 0x69, 0x13, 0xc3, 0x97, 0xa0, 0xa4, 0xe3, 0x32, 0x28, 0xd5,
-	0x36, 0xeb, 0xe5, 0xa3, 0x30, 0xff, 0x40, 0x5a, 0xe9, 0xce, 0x61, 0x23, 0x26, 0xcb, 0x58, 0x2a,
-	0x03, 0x7f, 0x6d, 0x96, 0xb1, 0x74, 0x91, 0xd5, 0x11, 0xf8, 0x09, 0xec, 0x60, 0xc2, 0x99, 0xa4,
-	0x2a, 0x16, 0x64, 0x34, 0x26, 0x52, 0xc9, 0xe0, 0x3f, 0xa3, 0xa9, 0xe7, 0x6b, 0xde, 0xda, 0x44,
-	0xdb, 0x06, 0x9c, 0x70, 0x1b, 0xaf, 0x54, 0x25, 0xfc, 0x0a, 0x76, 0x27, 0x54, 0x5d, 0x62, 0x81,
-	0x26, 0x4b, 0xf7, 0xff, 0xc6, 0xfd, 0x3c, 0xdf, 0xfd, 0xd1, 0x45, 0x56, 0xe5, 0x3b, 0x93, 0xd5,
-	0xb2, 0x84, 0xaf, 0x80, 0xcf, 0x04, 0x26, 0x42, 0x06, 0x0f, 0x8c, 0x72, 0x2f, 0x5f, 0xf9, 0x5e,
-	0x73, 0x4e, 0xe4, 0x42, 0xf0, 0x02, 0x6c, 0xa1, 0xae, 0xa2, 0x57, 0x24, 0xee, 0x21, 0x31, 0xd0,
-	0x1a, 0x60, 0x34, 0x07, 0xf9, 0x9a, 0x13, 0xc3, 0xbf, 0x33, 0xf8, 0xdd, 0x20, 0xa0, 0x4c, 0xcd,
-	0x48, 0x47, 0x63, 0x32, 0x26, 0x78, 0x21, 0x2d, 0xaf, 0x93, 0x9e, 0x1b, 0x7e, 0x55, 0x3a, 0xca,
-	0xd4, 0x24, 0xec, 0x83, 0x27, 0x03, 0x24, 0xfa, 0x44, 0xc5, 0x03, 0xd4, 0xa7, 0xc3, 0x24, 0x36,
-	0x37, 0x88, 0xe9, 0x10, 0x93, 0x6b, 0x22, 0x83, 0xca, 0xba, 0x0e, 0xad, 0x96, 0xb9, 0x7f, 0x53,
-	0xf3, 0xae, 0x43, 0x60, 0x85, 0x2d, 0xe3, 0x5b, 0x7e, 0x25, 0x72, 0xff, 0xbb, 0x07, 0x2a, 0x2b,
-	0x8b, 0xf4, 0x1a, 0xf8, 0x6e, 0x53, 0x3c, 0xb3, 0x29, 0xb5, 0x7f, 0x0d, 0x66, 0x66, 0x45, 0x5c,
-	0x0a, 0x7e, 0x01, 0xbb, 0x7a, 0x11, 0xdd, 0x93, 0x11, 0x4b, 0x2d, 0x0d, 0x36, 0xd6, 0x0d, 0xc1,
-	0xbd, 0x75, 0xbe, 0x9b, 0x30, 0x74, 0xaf, 0x7c, 0x3e, 0xfd, 0x13, 0x16, 0xa6, 0xb3, 0xd0, 0xbb,
-	0x9d, 0x85, 0xde, 0xef, 0x59, 0xe8, 0x7d, 0x9b, 0x87, 0x85, 0xdb, 0x79, 0x58, 0xf8, 0x39, 0x0f,
-	0x0b, 0x9f, 0x8f, 0x13, 0xaa, 0x2e, 0xc7, 0x1d, 0xdd, 0x25, 0xb2, 0x9d, 0x5e, 0xb0, 0x5e, 0x8f,
-	0x76, 0x29, 0x4a, 0xdd, 0xff, 0x28, 0xfb, 0xbc, 0xa8, 0x1b, 0x4e, 0x64, 0xc7, 0x37, 0x6f, 0xca,
-	0xf1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x78, 0x85, 0xfa, 0x43, 0xfe, 0x04, 0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xdf, 0x6a, 0x1a, 0x4f,
+	0x14, 0xc7, 0xdd, 0x24, 0x9a, 0x5f, 0x46, 0x63, 0x92, 0xe1, 0x57, 0x58, 0x2c, 0x6c, 0x44, 0x68,
+	0x6a, 0x2f, 0xea, 0x92, 0xe4, 0xae, 0xd0, 0x42, 0x4a, 0x49, 0x10, 0x2a, 0x4d, 0x4c, 0xa1, 0xf4,
+	0x0f, 0x2c, 0xa3, 0x33, 0x6e, 0x06, 0x57, 0x67, 0xdd, 0x19, 0x63, 0xf2, 0x16, 0x7d, 0x8f, 0xbe,
+	0x88, 0x97, 0xb9, 0xec, 0x55, 0x69, 0x15, 0xfa, 0x1c, 0x65, 0xce, 0x4e, 0x74, 0x37, 0xb0, 0xf1,
+	0x4e, 0xcf, 0x7e, 0xbe, 0x9f, 0x33, 0x07, 0x66, 0x0e, 0x3a, 0xe8, 0x8a, 0x01, 0x65, 0x37, 0x6e,
+	0xc0, 0x47, 0x63, 0x4e, 0xb9, 0xba, 0x75, 0xaf, 0x0f, 0x3b, 0x4c, 0x91, 0x43, 0xd7, 0x67, 0x43,
+	0x26, 0xb9, 0x6c, 0x84, 0x91, 0x50, 0x02, 0xdb, 0x31, 0xd7, 0x58, 0x70, 0x0d, 0xc3, 0x55, 0xfe,
+	0xf7, 0x85, 0x2f, 0x00, 0x72, 0xf5, 0xaf, 0x98, 0xaf, 0x3c, 0xcb, 0xf4, 0x86, 0x24, 0x22, 0x03,
+	0xa3, 0xad, 0xd4, 0x33, 0xb1, 0x65, 0x23, 0x20, 0x6b, 0x7f, 0x37, 0xd1, 0xce, 0x49, 0x18, 0x9e,
+	0xc5, 0xa7, 0xba, 0x54, 0x44, 0x31, 0xfc, 0x04, 0x15, 0x48, 0x18, 0x7a, 0x9c, 0xda, 0x56, 0xd5,
+	0xaa, 0x6f, 0xb4, 0xf3, 0x24, 0x0c, 0x9b, 0x14, 0x7f, 0x44, 0x65, 0x7d, 0xf8, 0x88, 0x77, 0xbd,
+	0xb8, 0x99, 0xbd, 0x56, 0xb5, 0xea, 0xc5, 0xa3, 0xe7, 0x8d, 0xac, 0x21, 0x1a, 0x67, 0x31, 0x7f,
+	0x0e, 0xf8, 0xdb, 0x8d, 0xe9, 0xaf, 0xfd, 0x5c, 0x7b, 0xdb, 0x4f, 0x16, 0x71, 0x15, 0x95, 0x02,
+	0x22, 0x95, 0x17, 0x12, 0x1e, 0xe9, 0x96, 0xeb, 0xd0, 0x12, 0xe9, 0xda, 0x39, 0xe1, 0x51, 0x93,
+	0x2e, 0x09, 0x21, 0x02, 0x4d, 0x6c, 0x24, 0x08, 0x21, 0x82, 0x26, 0xc5, 0xaf, 0x50, 0x5e, 0xc7,
+	0xa5, 0x9d, 0xaf, 0xae, 0xd7, 0x8b, 0x47, 0x4e, 0xf6, 0x81, 0xb4, 0xd2, 0x9c, 0x23, 0x8e, 0x40,
+	0x56, 0x88, 0x40, 0xda, 0x85, 0x95, 0x59, 0x21, 0x82, 0x45, 0x56, 0x47, 0xf0, 0x67, 0xb4, 0x4b,
+	0x59, 0x28, 0x24, 0x57, 0x5e, 0xc4, 0x46, 0x63, 0x26, 0x95, 0xb4, 0x37, 0x41, 0x53, 0xcf, 0xd6,
+	0xbc, 0x8b, 0x13, 0xed, 0x38, 0x60, 0x84, 0x3b, 0x34, 0x55, 0x95, 0xf8, 0x1b, 0xda, 0x9b, 0x70,
+	0x75, 0x45, 0x23, 0x32, 0x59, 0xba, 0xff, 0x03, 0xf7, 0x8b, 0x6c, 0xf7, 0x27, 0x13, 0x49, 0xcb,
+	0x77, 0x27, 0xe9, 0xb2, 0xc4, 0xaf, 0x51, 0x41, 0x44, 0x94, 0x45, 0xd2, 0xde, 0x02, 0xe5, 0x7e,
+	0xb6, 0xf2, 0x83, 0xe6, 0x8c, 0xc8, 0x84, 0xf0, 0x25, 0x2a, 0x93, 0xae, 0xe2, 0xd7, 0xcc, 0xeb,
+	0x91, 0x68, 0xa0, 0x35, 0x08, 0x34, 0x07, 0xd9, 0x9a, 0x13, 0xe0, 0x4f, 0x01, 0xbf, 0xbf, 0x08,
+	0x24, 0x51, 0x03, 0xe9, 0x68, 0xcc, 0xc6, 0x8c, 0x2e, 0xa4, 0xc5, 0x55, 0xd2, 0x0b, 0xe0, 0xd3,
+	0xd2, 0x51, 0xa2, 0x26, 0x71, 0x1f, 0x3d, 0x1d, 0x90, 0xa8, 0xcf, 0x94, 0x37, 0x20, 0x7d, 0x3e,
+	0xf4, 0x3d, 0x98, 0xc0, 0xe3, 0x43, 0xca, 0x6e, 0x98, 0xb4, 0x4b, 0xab, 0x3a, 0xb4, 0x5a, 0x30,
+	0x7f, 0x53, 0xf3, 0xa6, 0x83, 0x1d, 0x0b, 0x5b, 0xe0, 0x5b, 0x7e, 0x65, 0x30, 0x41, 0x20, 0xba,
+	0xfd, 0xc4, 0x04, 0xdb, 0xab, 0xfc, 0xef, 0x81, 0x4f, 0x4f, 0x10, 0x24, 0x6a, 0x12, 0x9f, 0xa2,
+	0x2d, 0xb8, 0x19, 0x5c, 0x0c, 0xa5, 0x5d, 0x06, 0x5f, 0xed, 0xb1, 0x3b, 0x1a, 0xa3, 0xc6, 0xb5,
+	0x8c, 0xd6, 0x7e, 0x58, 0xa8, 0x94, 0x7a, 0xe5, 0x6f, 0x50, 0xc1, 0x3c, 0x63, 0x0b, 0x9e, 0x71,
+	0xf5, 0xb1, 0x57, 0x93, 0x78, 0xbf, 0x26, 0x85, 0xbf, 0xa2, 0x3d, 0xbd, 0x25, 0xcc, 0x3e, 0xf3,
+	0xa4, 0x96, 0xda, 0x6b, 0xab, 0x6e, 0xe8, 0x83, 0x5d, 0x73, 0x7f, 0xfd, 0xc9, 0x83, 0xf2, 0xc5,
+	0xf4, 0x8f, 0x93, 0x9b, 0xce, 0x1c, 0xeb, 0x6e, 0xe6, 0x58, 0xbf, 0x67, 0x8e, 0xf5, 0x7d, 0xee,
+	0xe4, 0xee, 0xe6, 0x4e, 0xee, 0xe7, 0xdc, 0xc9, 0x7d, 0x39, 0xf6, 0xb9, 0xba, 0x1a, 0x77, 0x74,
+	0x17, 0x37, 0xee, 0xf4, 0x52, 0xf4, 0x7a, 0xbc, 0xcb, 0x49, 0x60, 0xfe, 0xbb, 0xc9, 0xdd, 0xa7,
+	0x6e, 0x43, 0x26, 0x3b, 0x05, 0x58, 0x78, 0xc7, 0xff, 0x06, 0x00, 0x54, 0x55, 0x8e, 0x3d, 0x9b,
+	0x05, 0x00, 0x00,
 }
 
 func (m *AppGenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +183,34 @@ func (m *AppGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.LockedFarmers) > 0 {
+		for iNdEx := len(m.LockedFarmers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedFarmers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.MarketMakingOrderIndexes) > 0 {
 		for iNdEx := len(m.MarketMakingOrderIndexes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -441,6 +474,18 @@ func (m *AppGenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LockedFarmers) > 0 {
+		for _, e := range m.LockedFarmers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -858,6 +903,74 @@ func (m *AppGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedFarmers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedFarmers = append(m.LockedFarmers, LockedFarmer{})
+			if err := m.LockedFarmers[len(m.LockedFarmers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
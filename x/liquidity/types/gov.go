@@ -4,24 +4,29 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
 	ProposalUpdateGenericParams    = "UpdateGenericParams"
 	ProposalCreateNewLiquidityPair = "CreateNewLiquidityPair"
+	ProposalCreateWeightedPool     = "CreateWeightedPool"
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalUpdateGenericParams)
 	govtypes.RegisterProposalType(ProposalCreateNewLiquidityPair)
+	govtypes.RegisterProposalType(ProposalCreateWeightedPool)
 	govtypes.RegisterProposalTypeCodec(&UpdateGenericParamsProposal{}, "comdex/UpdateGenericParams")
 	govtypes.RegisterProposalTypeCodec(&CreateNewLiquidityPairProposal{}, "comdex/CreateNewLiquidityPair")
+	govtypes.RegisterProposalTypeCodec(&CreateWeightedPoolProposal{}, "comdex/CreateWeightedPool")
 }
 
 var (
 	_ govtypes.Content = &UpdateGenericParamsProposal{}
 	_ govtypes.Content = &CreateNewLiquidityPairProposal{}
+	_ govtypes.Content = &CreateWeightedPoolProposal{}
 )
 
 func NewUpdateGenericParamsProposal(
@@ -121,3 +126,50 @@ func (p *CreateNewLiquidityPairProposal) ValidateBasic() error {
 
 	return nil
 }
+
+func NewCreateWeightedPoolProposal(
+	title, description string,
+	from sdk.AccAddress,
+	appID uint64,
+	assets []WeightedPoolAsset,
+) govtypes.Content {
+	return &CreateWeightedPoolProposal{
+		Title:       title,
+		Description: description,
+		AppId:       appID,
+		Assets:      assets,
+		From:        from.String(),
+	}
+}
+
+func (p *CreateWeightedPoolProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p *CreateWeightedPoolProposal) GetDescription() string {
+	return p.Description
+}
+func (p *CreateWeightedPoolProposal) ProposalRoute() string { return RouterKey }
+
+func (p *CreateWeightedPoolProposal) ProposalType() string { return ProposalCreateWeightedPool }
+
+func (p *CreateWeightedPoolProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.AppId <= 0 {
+		return ErrInvalidAppID
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.From); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address: %v", err)
+	}
+
+	if err := ValidateWeightedPoolAssets(p.Assets); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
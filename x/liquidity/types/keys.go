@@ -43,6 +43,9 @@ var (
 
 	ActiveFarmerKeyPrefix = []byte{0xb4}
 	QueuedFarmerKeyPrefix = []byte{0xb5}
+	LockedFarmerKeyPrefix = []byte{0xb8}
+
+	PositionKeyPrefix = []byte{0xb9}
 
 	GenericParamsKey = []byte{0xb6}
 )
@@ -306,3 +309,28 @@ func GetQueuedFarmerKey(appID, poolID uint64, farmer sdk.AccAddress) []byte {
 func GetAllQueuedFarmersKey(appID, poolID uint64) []byte {
 	return append(append(QueuedFarmerKeyPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(poolID)...)
 }
+
+// GetLockedFarmerKey returns the store key to retrieve locked farmer object from the app id, pool id and farmer address.
+func GetLockedFarmerKey(appID, poolID uint64, farmer sdk.AccAddress) []byte {
+	return append(append(append(LockedFarmerKeyPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(poolID)...), address.MustLengthPrefix(farmer)...)
+}
+
+// GetAllLockedFarmersKey returns the store key to retrieve all locked farmers.
+func GetAllLockedFarmersKey(appID, poolID uint64) []byte {
+	return append(append(LockedFarmerKeyPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// GetPositionKey returns the store key to retrieve position object from the app id, pool id and position id.
+func GetPositionKey(appID, poolID, positionID uint64) []byte {
+	return append(append(append(PositionKeyPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(poolID)...), sdk.Uint64ToBigEndian(positionID)...)
+}
+
+// GetPositionsByPoolKeyPrefix returns the store key to retrieve all positions of a pool.
+func GetPositionsByPoolKeyPrefix(appID, poolID uint64) []byte {
+	return append(append(PositionKeyPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// GetAllPositionsKey returns the store key to retrieve all positions.
+func GetAllPositionsKey(appID uint64) []byte {
+	return append(PositionKeyPrefix, sdk.Uint64ToBigEndian(appID)...)
+}
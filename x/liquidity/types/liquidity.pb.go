@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
@@ -39,18 +40,28 @@ const (
 	PoolTypeBasic PoolType = 1
 	// POOL_TYPE_RANGED specifies the ranged pool type
 	PoolTypeRanged PoolType = 2
+	// POOL_TYPE_CONCENTRATED specifies the concentrated pool type, where each
+	// liquidity provider holds a position with its own price range
+	PoolTypeConcentrated PoolType = 3
+	// POOL_TYPE_WEIGHTED specifies the weighted pool type, which holds multiple
+	// assets with governance-approved weights
+	PoolTypeWeighted PoolType = 4
 )
 
 var PoolType_name = map[int32]string{
 	0: "POOL_TYPE_UNSPECIFIED",
 	1: "POOL_TYPE_BASIC",
 	2: "POOL_TYPE_RANGED",
+	3: "POOL_TYPE_CONCENTRATED",
+	4: "POOL_TYPE_WEIGHTED",
 }
 
 var PoolType_value = map[string]int32{
-	"POOL_TYPE_UNSPECIFIED": 0,
-	"POOL_TYPE_BASIC":       1,
-	"POOL_TYPE_RANGED":      2,
+	"POOL_TYPE_UNSPECIFIED":  0,
+	"POOL_TYPE_BASIC":        1,
+	"POOL_TYPE_RANGED":       2,
+	"POOL_TYPE_CONCENTRATED": 3,
+	"POOL_TYPE_WEIGHTED":     4,
 }
 
 func (x PoolType) String() string {
@@ -301,6 +312,18 @@ type Pool struct {
 	Creator               string                                  `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
 	MinPrice              *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=min_price,json=minPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price,omitempty"`
 	MaxPrice              *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price,omitempty"`
+	// fee_rate specifies the rate of the spread a concentrated pool takes from
+	// its curve, which is accrued to its positions.
+	FeeRate        *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=fee_rate,json=feeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_rate,omitempty"`
+	LastPositionId uint64                                  `protobuf:"varint,14,opt,name=last_position_id,json=lastPositionId,proto3" json:"last_position_id,omitempty"`
+	// weighted_assets specifies the assets of a weighted pool and their weights
+	WeightedAssets []WeightedPoolAsset `protobuf:"bytes,15,rep,name=weighted_assets,json=weightedAssets,proto3" json:"weighted_assets"`
+	// sqrt_price specifies the square root of a concentrated pool's price,
+	// which is moved only by the pool's swaps
+	SqrtPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=sqrt_price,json=sqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sqrt_price,omitempty"`
+	// active_liquidity specifies the liquidity of a concentrated pool's
+	// positions in range at its current price
+	ActiveLiquidity *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=active_liquidity,json=activeLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"active_liquidity,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -353,6 +376,14 @@ type DepositRequest struct {
 	MintedPoolCoin types.Coin                               `protobuf:"bytes,7,opt,name=minted_pool_coin,json=mintedPoolCoin,proto3" json:"minted_pool_coin"`
 	Status         RequestStatus                            `protobuf:"varint,8,opt,name=status,proto3,enum=comdex.liquidity.v1beta1.RequestStatus" json:"status,omitempty"`
 	AppId          uint64                                   `protobuf:"varint,9,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// single_asset specifies whether the request is a single-sided(zap) deposit
+	SingleAsset bool `protobuf:"varint,10,opt,name=single_asset,json=singleAsset,proto3" json:"single_asset,omitempty"`
+	// min_pool_coin_amount specifies the minimum pool coin amount to be minted
+	// for a single-sided deposit
+	MinPoolCoinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=min_pool_coin_amount,json=minPoolCoinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_pool_coin_amount"`
+	// swapped_coin specifies the amount of deposit coin swapped against the pool
+	// for a single-sided deposit, excluding the swap fee
+	SwappedCoin *types.Coin `protobuf:"bytes,12,opt,name=swapped_coin,json=swappedCoin,proto3" json:"swapped_coin,omitempty"`
 }
 
 func (m *DepositRequest) Reset()         { *m = DepositRequest{} }
@@ -404,6 +435,12 @@ type WithdrawRequest struct {
 	WithdrawnCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=withdrawn_coins,json=withdrawnCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_coins"`
 	Status         RequestStatus                            `protobuf:"varint,7,opt,name=status,proto3,enum=comdex.liquidity.v1beta1.RequestStatus" json:"status,omitempty"`
 	AppId          uint64                                   `protobuf:"varint,8,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// demand_coin_denom specifies the denom of the single asset to withdraw into,
+	// empty for a normal withdrawal
+	DemandCoinDenom string `protobuf:"bytes,9,opt,name=demand_coin_denom,json=demandCoinDenom,proto3" json:"demand_coin_denom,omitempty"`
+	// min_demand_coin_amount specifies the minimum amount of demand coin to be
+	// received for a single asset withdrawal
+	MinDemandCoinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=min_demand_coin_amount,json=minDemandCoinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_demand_coin_amount"`
 }
 
 func (m *WithdrawRequest) Reset()         { *m = WithdrawRequest{} }
@@ -662,6 +699,176 @@ func (m *QueuedFarmer) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueuedFarmer proto.InternalMessageInfo
 
+// LockedCoin defines pool coins which are farmed with a lock duration.
+// The locked pool coins cannot be unfarmed until unlock_at, and their
+// reward weight is boosted by boost_multiplier until then.
+type LockedCoin struct {
+	FarmedPoolCoin  github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=farmed_pool_coin,json=farmedPoolCoin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"farmed_pool_coin"`
+	LockDuration    time.Duration                           `protobuf:"bytes,2,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration" yaml:"lock_duration"`
+	BoostMultiplier github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,3,opt,name=boost_multiplier,json=boostMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"boost_multiplier" yaml:"boost_multiplier"`
+	LockedAt        time.Time                               `protobuf:"bytes,4,opt,name=locked_at,json=lockedAt,proto3,stdtime" json:"locked_at" yaml:"locked_at"`
+	UnlockAt        time.Time                               `protobuf:"bytes,5,opt,name=unlock_at,json=unlockAt,proto3,stdtime" json:"unlock_at" yaml:"unlock_at"`
+}
+
+func (m *LockedCoin) Reset()         { *m = LockedCoin{} }
+func (m *LockedCoin) String() string { return proto.CompactTextString(m) }
+func (*LockedCoin) ProtoMessage()    {}
+func (*LockedCoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579dcc42096fa86d, []int{9}
+}
+func (m *LockedCoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedCoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedCoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockedCoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedCoin.Merge(m, src)
+}
+func (m *LockedCoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedCoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedCoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedCoin proto.InternalMessageInfo
+
+type LockedFarmer struct {
+	AppId       uint64        `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	PoolId      uint64        `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Farmer      string        `protobuf:"bytes,3,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	LockedCoins []*LockedCoin `protobuf:"bytes,4,rep,name=locked_coins,json=lockedCoins,proto3" json:"locked_coins,omitempty"`
+}
+
+func (m *LockedFarmer) Reset()         { *m = LockedFarmer{} }
+func (m *LockedFarmer) String() string { return proto.CompactTextString(m) }
+func (*LockedFarmer) ProtoMessage()    {}
+func (*LockedFarmer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579dcc42096fa86d, []int{10}
+}
+func (m *LockedFarmer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedFarmer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedFarmer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockedFarmer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedFarmer.Merge(m, src)
+}
+func (m *LockedFarmer) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedFarmer) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedFarmer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedFarmer proto.InternalMessageInfo
+
+// Position defines a liquidity position of a concentrated pool.
+type Position struct {
+	Id        uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PoolId    uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	AppId     uint64                                 `protobuf:"varint,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Owner     string                                 `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	MinPrice  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_price,json=minPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price"`
+	MaxPrice  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price"`
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity"`
+	// accrued_fees specifies the fees accrued to the position which are not
+	// collected yet
+	AccruedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=accrued_fees,json=accruedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accrued_fees"`
+}
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579dcc42096fa86d, []int{11}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+// WeightedPoolAsset defines an asset of a weighted pool and its weight.
+type WeightedPoolAsset struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *WeightedPoolAsset) Reset()         { *m = WeightedPoolAsset{} }
+func (m *WeightedPoolAsset) String() string { return proto.CompactTextString(m) }
+func (*WeightedPoolAsset) ProtoMessage()    {}
+func (*WeightedPoolAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579dcc42096fa86d, []int{12}
+}
+func (m *WeightedPoolAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedPoolAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedPoolAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedPoolAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedPoolAsset.Merge(m, src)
+}
+func (m *WeightedPoolAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedPoolAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedPoolAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedPoolAsset proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("comdex.liquidity.v1beta1.PoolType", PoolType_name, PoolType_value)
 	proto.RegisterEnum("comdex.liquidity.v1beta1.OrderType", OrderType_name, OrderType_value)
@@ -678,6 +885,10 @@ func init() {
 	proto.RegisterType((*ActiveFarmer)(nil), "comdex.liquidity.v1beta1.ActiveFarmer")
 	proto.RegisterType((*QueuedCoin)(nil), "comdex.liquidity.v1beta1.QueuedCoin")
 	proto.RegisterType((*QueuedFarmer)(nil), "comdex.liquidity.v1beta1.QueuedFarmer")
+	proto.RegisterType((*LockedCoin)(nil), "comdex.liquidity.v1beta1.LockedCoin")
+	proto.RegisterType((*LockedFarmer)(nil), "comdex.liquidity.v1beta1.LockedFarmer")
+	proto.RegisterType((*Position)(nil), "comdex.liquidity.v1beta1.Position")
+	proto.RegisterType((*WeightedPoolAsset)(nil), "comdex.liquidity.v1beta1.WeightedPoolAsset")
 }
 
 func init() {
@@ -685,123 +896,155 @@ func init() {
 }
 
 var fileDescriptor_579dcc42096fa86d = []byte{
-	// 1850 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x73, 0x1a, 0xc9,
-	0x15, 0xd7, 0x00, 0x42, 0xf0, 0x90, 0x10, 0x6e, 0x4b, 0x36, 0xc2, 0x6b, 0x44, 0x91, 0xac, 0x4d,
-	0xb9, 0x6a, 0xc1, 0xc6, 0x49, 0x9c, 0x8f, 0xdd, 0x4d, 0xf1, 0x31, 0xda, 0x9d, 0x0a, 0x08, 0x3c,
-	0xa0, 0x8a, 0x9d, 0x0b, 0x35, 0x9a, 0x69, 0xa1, 0xae, 0x05, 0x66, 0x3c, 0xd3, 0xd8, 0xd2, 0x2d,
-	0x97, 0x54, 0x52, 0x9c, 0xf6, 0x94, 0x1b, 0x97, 0xe4, 0x96, 0xbf, 0x20, 0xd7, 0xdc, 0x7c, 0xc8,
-	0x61, 0x4f, 0xa9, 0x54, 0x2a, 0xa5, 0x4d, 0xec, 0xff, 0x20, 0xc7, 0x54, 0xaa, 0x92, 0xea, 0xee,
-	0x99, 0x61, 0x06, 0x4b, 0x91, 0xbc, 0x6b, 0x9f, 0xa4, 0x7e, 0xfd, 0x7e, 0xef, 0xfb, 0xf5, 0x7b,
-	0x03, 0x94, 0x74, 0x73, 0x6c, 0xe0, 0x93, 0xca, 0x88, 0x3c, 0x9b, 0x12, 0x83, 0xd0, 0xd3, 0xca,
-	0xf3, 0x07, 0x87, 0x98, 0x6a, 0x0f, 0x16, 0x94, 0xb2, 0x65, 0x9b, 0xd4, 0x44, 0x59, 0xc1, 0x59,
-	0x5e, 0xd0, 0x5d, 0xce, 0xdc, 0xd6, 0xd0, 0x1c, 0x9a, 0x9c, 0xa9, 0xc2, 0xfe, 0x13, 0xfc, 0xb9,
-	0xbc, 0x6e, 0x3a, 0x63, 0xd3, 0xa9, 0x1c, 0x6a, 0x0e, 0xf6, 0x85, 0xea, 0x26, 0x99, 0xb8, 0xf7,
-	0xbb, 0x43, 0xd3, 0x1c, 0x8e, 0x70, 0x85, 0x9f, 0x0e, 0xa7, 0x47, 0x15, 0x4a, 0xc6, 0xd8, 0xa1,
-	0xda, 0xd8, 0x12, 0x0c, 0xc5, 0xff, 0x44, 0x20, 0xd6, 0xd5, 0x88, 0x8d, 0xd2, 0x10, 0x21, 0x46,
-	0x56, 0x2a, 0x48, 0xa5, 0x98, 0x1a, 0x21, 0x06, 0xba, 0x03, 0x9b, 0x4c, 0xe8, 0x80, 0x09, 0x1b,
-	0x18, 0x78, 0x62, 0x8e, 0xb3, 0x91, 0x82, 0x54, 0x4a, 0xaa, 0x1b, 0x8c, 0xdc, 0x30, 0xc9, 0xa4,
-	0xc9, 0x88, 0xa8, 0x04, 0x99, 0x67, 0x53, 0x93, 0x86, 0x18, 0xa3, 0x9c, 0x31, 0xcd, 0xe9, 0x0b,
-	0xce, 0x0f, 0x21, 0x8d, 0x1d, 0xdd, 0x36, 0x5f, 0x0c, 0x34, 0xc3, 0xb0, 0xb1, 0xe3, 0x64, 0x63,
-	0x42, 0xa0, 0xa0, 0xd6, 0x04, 0x11, 0x15, 0x61, 0x63, 0xa4, 0x39, 0x74, 0x60, 0xda, 0x06, 0xb6,
-	0x07, 0xc4, 0xc8, 0xae, 0x72, 0x9b, 0x52, 0x8c, 0xd8, 0x61, 0x34, 0xc5, 0x40, 0x0a, 0x00, 0xe7,
-	0xb1, 0x6c, 0xa2, 0xe3, 0x6c, 0x9c, 0x89, 0xa9, 0xdf, 0xfb, 0xdb, 0xd9, 0xee, 0x9d, 0x21, 0xa1,
-	0xc7, 0xd3, 0xc3, 0xb2, 0x6e, 0x8e, 0x2b, 0x6e, 0x64, 0xc4, 0x9f, 0x8f, 0x1c, 0xe3, 0x8b, 0x0a,
-	0x3d, 0xb5, 0xb0, 0x53, 0x6e, 0x62, 0x5d, 0x4d, 0x32, 0x74, 0x97, 0x81, 0x99, 0xfd, 0xfa, 0xd4,
-	0xb6, 0xf1, 0x84, 0x0e, 0x0e, 0x35, 0xaa, 0x1f, 0x33, 0x8d, 0x6b, 0x5c, 0x63, 0xda, 0xa5, 0xd7,
-	0x19, 0x59, 0x31, 0xd0, 0x4f, 0x20, 0xe7, 0xbc, 0xd0, 0xac, 0xc1, 0x11, 0x66, 0xce, 0x8e, 0x46,
-	0x58, 0xa7, 0xa6, 0xed, 0xfb, 0x92, 0xe0, 0xbe, 0xdc, 0x64, 0x1c, 0x7b, 0x18, 0x37, 0xbc, 0x7b,
-	0xcf, 0xab, 0x6d, 0x88, 0x6b, 0x96, 0xc5, 0x84, 0x27, 0xb9, 0xf0, 0x55, 0xcd, 0xb2, 0x14, 0xa3,
-	0xf8, 0xeb, 0x18, 0xc4, 0xba, 0xa6, 0x39, 0x7a, 0x23, 0xfc, 0x37, 0x61, 0xcd, 0xd2, 0x08, 0xf7,
-	0x3f, 0xc2, 0x89, 0x71, 0x76, 0x54, 0x0c, 0x74, 0x17, 0x36, 0x6d, 0xec, 0x60, 0xfb, 0x39, 0xf6,
-	0x55, 0xbb, 0xe1, 0x76, 0xc9, 0x9e, 0xc6, 0x3b, 0xb0, 0x69, 0x99, 0xe6, 0x28, 0x98, 0x17, 0x37,
-	0xde, 0x8c, 0xbc, 0x48, 0xcb, 0xf7, 0xe1, 0x26, 0x8f, 0xa5, 0x81, 0x2d, 0xd3, 0x21, 0x74, 0x60,
-	0xe3, 0x67, 0x53, 0xec, 0xd0, 0x45, 0xe4, 0xb7, 0xd8, 0x75, 0x53, 0xdc, 0xaa, 0xe2, 0x52, 0x31,
-	0xd0, 0x23, 0xc8, 0x72, 0xd8, 0x0b, 0x42, 0x8f, 0x0d, 0x5b, 0x7b, 0x11, 0xc4, 0xc5, 0x39, 0x6e,
-	0x9b, 0xdd, 0xff, 0xdc, 0xbd, 0x5e, 0x00, 0x73, 0x90, 0x30, 0x88, 0xa3, 0x1d, 0x8e, 0xb0, 0x08,
-	0x74, 0x42, 0xf5, 0xcf, 0x81, 0x28, 0x25, 0x02, 0x51, 0x42, 0x3f, 0x80, 0x18, 0xcb, 0x1d, 0x0f,
-	0x5d, 0xba, 0x5a, 0x2c, 0x5f, 0xd4, 0x24, 0x65, 0x16, 0xca, 0xfe, 0xa9, 0x85, 0x55, 0xce, 0x8f,
-	0xb2, 0xb0, 0xa6, 0xdb, 0x58, 0xa3, 0xa6, 0x9d, 0x05, 0xee, 0xba, 0x77, 0x44, 0x9f, 0x41, 0x72,
-	0x4c, 0x26, 0x6e, 0xfd, 0xa4, 0xde, 0xba, 0x7e, 0x12, 0x63, 0x32, 0x11, 0xe5, 0xc3, 0x04, 0x69,
-	0x27, 0xae, 0xa0, 0xf5, 0x6f, 0x20, 0x48, 0x3b, 0xe1, 0x82, 0x8a, 0xff, 0x8d, 0x42, 0x3a, 0x1c,
-	0xe4, 0x73, 0x6b, 0x82, 0x65, 0x34, 0x50, 0x13, 0xa6, 0x39, 0x52, 0x0c, 0x74, 0x1b, 0x60, 0xec,
-	0x0c, 0x07, 0xc7, 0x98, 0x0c, 0x8f, 0x29, 0x2f, 0x87, 0xa8, 0x9a, 0x1c, 0x3b, 0xc3, 0xcf, 0x39,
-	0x01, 0x7d, 0x00, 0x49, 0x37, 0xb9, 0xa6, 0xed, 0xd6, 0xc0, 0x82, 0x80, 0x2c, 0xd8, 0xf0, 0x52,
-	0xcf, 0x4a, 0xc5, 0xc9, 0xae, 0x16, 0xa2, 0xa5, 0x54, 0x75, 0xa7, 0x2c, 0x0c, 0x2e, 0xb3, 0x76,
-	0xf7, 0x03, 0xcc, 0xca, 0xa6, 0x7e, 0xff, 0xe5, 0xd9, 0xee, 0xca, 0x1f, 0xbe, 0xde, 0x2d, 0x5d,
-	0xc1, 0x49, 0x06, 0x70, 0xd4, 0x75, 0x57, 0x03, 0x3f, 0x21, 0x1b, 0xd2, 0x9a, 0xae, 0x63, 0x8b,
-	0x62, 0xc3, 0x55, 0x19, 0x7f, 0xf7, 0x2a, 0x37, 0x3c, 0x15, 0x42, 0xa7, 0x02, 0x99, 0x31, 0x99,
-	0x30, 0x8d, 0x7e, 0x53, 0xf0, 0xea, 0xfb, 0xbf, 0x5a, 0x63, 0x4c, 0xab, 0x9a, 0x16, 0xc0, 0xae,
-	0xdb, 0x35, 0xe8, 0xa7, 0x10, 0x77, 0xa8, 0x46, 0xa7, 0xa2, 0xe7, 0xd3, 0xd5, 0xbb, 0x17, 0xd7,
-	0xa3, 0x9b, 0xc9, 0x1e, 0x67, 0x57, 0x5d, 0xd8, 0x45, 0x6f, 0xc1, 0xaf, 0xa2, 0xb0, 0xb9, 0xd4,
-	0x2e, 0xef, 0xac, 0x04, 0xf2, 0x00, 0x5e, 0xa3, 0x62, 0xaf, 0x06, 0x02, 0x14, 0xf4, 0x31, 0x24,
-	0x17, 0x71, 0x59, 0xbd, 0x5a, 0x5c, 0x12, 0xde, 0x3b, 0x82, 0x28, 0x6c, 0x7a, 0xb2, 0x26, 0xef,
-	0x2f, 0xa3, 0x69, 0x5f, 0x87, 0x48, 0xe9, 0x22, 0x0f, 0x6b, 0xdf, 0x36, 0x0f, 0xc1, 0xd7, 0xa6,
-	0xf8, 0x97, 0x38, 0xac, 0xf2, 0x41, 0x73, 0xf5, 0x47, 0xf9, 0x92, 0xe8, 0x67, 0x61, 0x8d, 0x4f,
-	0x33, 0x3f, 0xf4, 0xde, 0x11, 0xed, 0x41, 0xd2, 0x20, 0x36, 0xd6, 0x29, 0x31, 0x45, 0xdc, 0xd3,
-	0xd5, 0xd2, 0xc5, 0x6e, 0x70, 0xab, 0x9a, 0x1e, 0xbf, 0xba, 0x80, 0xa2, 0x4f, 0x01, 0xcc, 0xa3,
-	0x23, 0x6c, 0x8b, 0x04, 0xc6, 0xaf, 0x96, 0xc0, 0x24, 0x87, 0xf0, 0x0c, 0x3e, 0x86, 0x2d, 0x1b,
-	0x8f, 0x35, 0x32, 0x21, 0x93, 0xe1, 0x20, 0x20, 0xe9, 0x8a, 0x2d, 0x82, 0x7c, 0x70, 0xc7, 0x17,
-	0xd9, 0x84, 0x0d, 0x1b, 0xeb, 0x98, 0x3c, 0x77, 0xbb, 0x9c, 0x07, 0xf9, 0x0a, 0xb2, 0xd6, 0x3d,
-	0x94, 0x2b, 0x65, 0x55, 0xbc, 0xad, 0x49, 0xfe, 0xb6, 0x96, 0x19, 0xcb, 0x5b, 0xbc, 0xaf, 0x02,
-	0x8c, 0xf6, 0x20, 0xae, 0x8d, 0xcd, 0xe9, 0x84, 0x8a, 0x39, 0xf0, 0x56, 0x62, 0x94, 0x09, 0x55,
-	0x5d, 0x34, 0xea, 0x40, 0xca, 0xb4, 0xf0, 0x64, 0xe0, 0x0a, 0x4b, 0x7d, 0x23, 0x61, 0xc0, 0x44,
-	0xd4, 0x84, 0xc0, 0x1d, 0x48, 0xf8, 0x5b, 0xc7, 0x3a, 0x2f, 0xa9, 0xb5, 0x43, 0x77, 0xdd, 0xa8,
-	0x41, 0x12, 0x9f, 0x58, 0xc4, 0xc6, 0x03, 0x8d, 0x66, 0x37, 0x78, 0xec, 0x72, 0x65, 0xb1, 0xce,
-	0x95, 0xbd, 0x75, 0xae, 0xdc, 0xf7, 0xd6, 0xb9, 0x7a, 0x82, 0x59, 0xf1, 0xe5, 0xd7, 0xbb, 0x92,
-	0x9a, 0x10, 0xb0, 0x1a, 0x45, 0x9f, 0xf8, 0x1d, 0x92, 0xe6, 0xa5, 0xf5, 0xe1, 0x25, 0xa5, 0x75,
-	0x61, 0x7f, 0x6c, 0x06, 0xa7, 0xf1, 0x23, 0x77, 0x1a, 0x67, 0xb8, 0xcc, 0xef, 0x5c, 0x22, 0x73,
-	0x31, 0x8e, 0x8b, 0x53, 0x58, 0x6f, 0xb7, 0xc5, 0x0a, 0x37, 0x31, 0xf0, 0x49, 0xb0, 0x2d, 0xa4,
-	0x70, 0x5b, 0x2c, 0x34, 0x47, 0x82, 0x9a, 0x03, 0xfd, 0x17, 0x0d, 0xf5, 0xdf, 0x2d, 0x48, 0x7a,
-	0xeb, 0x22, 0xdb, 0x2a, 0xa3, 0xa5, 0x98, 0x9a, 0x30, 0xc5, 0xae, 0xe8, 0x14, 0xff, 0x2c, 0xc1,
-	0x7a, 0x4d, 0xa7, 0xe4, 0x39, 0xde, 0xd3, 0xec, 0x71, 0x48, 0xba, 0xb4, 0x2c, 0xfd, 0xdc, 0xb7,
-	0xf5, 0x06, 0xc4, 0x8f, 0x38, 0xd2, 0xdd, 0xb4, 0xdc, 0x13, 0xa2, 0x90, 0xe1, 0xff, 0x05, 0x67,
-	0x4a, 0xec, 0xb2, 0x22, 0xaf, 0xb0, 0x3c, 0xfd, 0xfb, 0x6c, 0xf7, 0xee, 0x15, 0xdf, 0x3d, 0x35,
-	0x2d, 0x74, 0x78, 0xe3, 0xa7, 0xf8, 0x77, 0x09, 0xe0, 0xf1, 0x14, 0x4f, 0xdd, 0x06, 0x39, 0xcf,
-	0x08, 0xe9, 0x7d, 0x1b, 0x81, 0x9e, 0x00, 0xf0, 0x55, 0x0a, 0x1b, 0xac, 0x3a, 0x23, 0x97, 0x56,
-	0xe7, 0x6d, 0xa6, 0xf0, 0x5f, 0x67, 0xbb, 0xd7, 0x4e, 0xb5, 0xf1, 0xe8, 0xc7, 0xc5, 0x05, 0xb6,
-	0xc8, 0x4b, 0x36, 0xe9, 0x12, 0x6a, 0xb4, 0x38, 0x97, 0x60, 0x5d, 0xb8, 0xf7, 0x8e, 0xb3, 0x25,
-	0x43, 0xea, 0xd9, 0x14, 0x4f, 0xbd, 0x95, 0x23, 0xc6, 0x07, 0xd4, 0x77, 0x2f, 0xae, 0xde, 0x45,
-	0x8c, 0x55, 0xe0, 0x40, 0x3e, 0x75, 0xee, 0xfd, 0x56, 0x82, 0x84, 0xb7, 0x66, 0xa2, 0x2a, 0x6c,
-	0x77, 0x3b, 0x9d, 0xd6, 0xa0, 0xff, 0xb4, 0x2b, 0x0f, 0x0e, 0xf6, 0x7b, 0x5d, 0xb9, 0xa1, 0xec,
-	0x29, 0x72, 0x33, 0xb3, 0x92, 0xbb, 0x39, 0x9b, 0x17, 0xae, 0x7b, 0x8c, 0x07, 0x13, 0xc7, 0xc2,
-	0x3a, 0x39, 0x22, 0x98, 0x7f, 0x58, 0x2d, 0x30, 0xf5, 0x5a, 0x4f, 0x69, 0x64, 0xa4, 0xdc, 0xb5,
-	0xd9, 0xbc, 0xb0, 0xe1, 0x71, 0xd7, 0x35, 0x87, 0xe8, 0xec, 0xc3, 0x64, 0xc1, 0xa7, 0xd6, 0xf6,
-	0x3f, 0x93, 0x9b, 0x99, 0x48, 0x0e, 0xcd, 0xe6, 0x85, 0xb4, 0xbf, 0xe6, 0x6a, 0x93, 0x21, 0x36,
-	0x72, 0xb1, 0xdf, 0xfc, 0x3e, 0xbf, 0x72, 0xef, 0x4f, 0x12, 0x24, 0xfd, 0x8e, 0x43, 0xdf, 0x83,
-	0x1b, 0x1d, 0xb5, 0x29, 0xab, 0xe7, 0x99, 0x96, 0x9d, 0xcd, 0x0b, 0x5b, 0x3e, 0x6b, 0xd0, 0xb6,
-	0x12, 0x64, 0x02, 0xa8, 0x96, 0xd2, 0x56, 0xfa, 0x19, 0x49, 0xe8, 0xf4, 0xf9, 0x5b, 0x64, 0x4c,
-	0x28, 0xba, 0x07, 0xd7, 0x02, 0x9c, 0xed, 0x9a, 0xfa, 0x33, 0xb9, 0x9f, 0x89, 0xe4, 0xae, 0xcf,
-	0xe6, 0x85, 0x4d, 0x9f, 0xb5, 0xad, 0xd9, 0x5f, 0x60, 0xca, 0xbe, 0xe8, 0x82, 0xbc, 0xed, 0x4c,
-	0x34, 0xb7, 0x39, 0x9b, 0x17, 0x52, 0x0b, 0xbe, 0xb6, 0xeb, 0xc3, 0x1f, 0x25, 0x48, 0x87, 0x87,
-	0x1c, 0xfa, 0x14, 0x6e, 0x09, 0x70, 0x53, 0x51, 0xe5, 0x46, 0x5f, 0xe9, 0xec, 0x2f, 0x79, 0x73,
-	0x7b, 0x36, 0x2f, 0xec, 0x84, 0x41, 0x41, 0x97, 0xca, 0x70, 0x7d, 0x19, 0x5f, 0x3f, 0x78, 0x9a,
-	0x91, 0x72, 0xdb, 0xb3, 0x79, 0xe1, 0x5a, 0x18, 0x57, 0x9f, 0x9e, 0xa2, 0xfb, 0xb0, 0xb5, 0xcc,
-	0xdf, 0x93, 0x5b, 0xad, 0x4c, 0x24, 0x77, 0x63, 0x36, 0x2f, 0xa0, 0x30, 0xa0, 0x87, 0x47, 0x23,
-	0xd7, 0xf4, 0x5f, 0x46, 0x60, 0x23, 0xb4, 0x66, 0xa0, 0x8f, 0x21, 0xa7, 0xca, 0x8f, 0x0f, 0xe4,
-	0x5e, 0x7f, 0xd0, 0xeb, 0xd7, 0xfa, 0x07, 0xbd, 0x25, 0xc3, 0x3f, 0x98, 0xcd, 0x0b, 0xd9, 0x10,
-	0x24, 0x68, 0xf7, 0x27, 0x70, 0x6b, 0x09, 0xbd, 0xdf, 0xe9, 0x0f, 0xe4, 0x27, 0x72, 0xe3, 0xa0,
-	0x2f, 0x37, 0x33, 0xd2, 0x39, 0xf0, 0x7d, 0x93, 0xca, 0x27, 0x58, 0x9f, 0x52, 0x6c, 0xa0, 0x1f,
-	0x42, 0x76, 0x09, 0xde, 0x3b, 0x68, 0x34, 0x64, 0xb9, 0xc9, 0xab, 0x28, 0x37, 0x9b, 0x17, 0x6e,
-	0x84, 0xb0, 0xbd, 0xa9, 0xae, 0x63, 0x6c, 0x60, 0x83, 0xd5, 0xf4, 0x12, 0x72, 0xaf, 0xa6, 0xb4,
-	0xe4, 0x66, 0x26, 0x2a, 0x6a, 0x3a, 0x04, 0xdb, 0xd3, 0xc8, 0xc8, 0xaf, 0xc0, 0xdf, 0x45, 0x21,
-	0x15, 0x98, 0x23, 0xcc, 0x06, 0x11, 0xca, 0x73, 0xdd, 0xe7, 0x36, 0x04, 0xd8, 0x83, 0xce, 0xff,
-	0x08, 0x76, 0x42, 0xc8, 0x25, 0xd7, 0x97, 0xa1, 0x41, 0xc7, 0x1f, 0x2d, 0x29, 0x65, 0xd0, 0x76,
-	0xad, 0xdf, 0xf8, 0x9c, 0x3b, 0xbe, 0x33, 0x9b, 0x17, 0xb6, 0xc3, 0xc8, 0x36, 0x9b, 0xb7, 0xd8,
-	0x40, 0x0d, 0xc8, 0x87, 0x80, 0xdd, 0x9a, 0xda, 0x57, 0x6a, 0xad, 0xd6, 0x53, 0x1f, 0x1e, 0xcd,
-	0xed, 0xce, 0xe6, 0x85, 0x5b, 0x01, 0x78, 0x57, 0xb3, 0x29, 0xd1, 0x46, 0xa3, 0x53, 0x4f, 0x88,
-	0xdf, 0x76, 0xae, 0x90, 0x46, 0xa7, 0xdd, 0x6d, 0xc9, 0xcc, 0xea, 0x58, 0xa0, 0xed, 0x04, 0xb8,
-	0x61, 0x8e, 0xad, 0x11, 0xa6, 0x22, 0xe4, 0x61, 0x54, 0x6d, 0xbf, 0x21, 0xb3, 0x90, 0xaf, 0x8a,
-	0x90, 0x07, 0x41, 0xda, 0x44, 0xc7, 0xec, 0x53, 0xd9, 0xaf, 0x53, 0x17, 0x23, 0x3f, 0xe9, 0x2a,
-	0xaa, 0xdc, 0xcc, 0xc4, 0x03, 0x75, 0x2a, 0x20, 0x32, 0x5f, 0x07, 0xbc, 0x24, 0x9d, 0x42, 0xca,
-	0xfd, 0x85, 0x80, 0xbf, 0x13, 0x0f, 0x60, 0xbb, 0xd6, 0x6c, 0xaa, 0x72, 0xaf, 0x27, 0xba, 0xf3,
-	0x61, 0x75, 0x50, 0x7f, 0xda, 0x97, 0x7b, 0x99, 0x15, 0x21, 0x27, 0xc0, 0xfb, 0xb0, 0x5a, 0x3f,
-	0xa5, 0xd8, 0x79, 0x03, 0x52, 0xbd, 0xef, 0x42, 0xa4, 0x37, 0x20, 0xd5, 0xfb, 0x1c, 0x22, 0x54,
-	0xd7, 0x1f, 0xbf, 0xfc, 0x67, 0x7e, 0xe5, 0xe5, 0xab, 0xbc, 0xf4, 0xd5, 0xab, 0xbc, 0xf4, 0x8f,
-	0x57, 0x79, 0xe9, 0xcb, 0xd7, 0xf9, 0x95, 0xaf, 0x5e, 0xe7, 0x57, 0xfe, 0xfa, 0x3a, 0xbf, 0xf2,
-	0x8b, 0x87, 0xa1, 0x59, 0xc4, 0x1e, 0xe5, 0x8f, 0xcc, 0xa3, 0x23, 0xa2, 0x13, 0x6d, 0xe4, 0x9e,
-	0x2b, 0xc1, 0x5f, 0xd0, 0xf8, 0x70, 0x3a, 0x8c, 0xf3, 0x59, 0xf3, 0xf0, 0x7f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x8d, 0x52, 0xec, 0x37, 0x62, 0x13, 0x00, 0x00,
+	// 2354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4b, 0x53, 0x1b, 0xd9,
+	0xf5, 0xa7, 0x41, 0x08, 0xe9, 0x48, 0x48, 0xe2, 0x1a, 0x6c, 0x21, 0xcf, 0x80, 0xfe, 0xfa, 0x67,
+	0x6c, 0xca, 0xc9, 0x08, 0x1b, 0x27, 0x71, 0x1e, 0x9e, 0x49, 0xe9, 0xd1, 0xd8, 0x5d, 0x16, 0x20,
+	0xb7, 0x44, 0xd9, 0x9e, 0x8d, 0xd2, 0x74, 0x5f, 0xe4, 0x2e, 0xb7, 0xba, 0xe5, 0xee, 0x2b, 0x03,
+	0xbb, 0x2c, 0xa7, 0xb4, 0x9a, 0x55, 0x2a, 0x1b, 0x55, 0xaa, 0x92, 0x5d, 0x3e, 0xc1, 0x6c, 0x67,
+	0xe7, 0x45, 0x16, 0xb3, 0x4a, 0xa5, 0x52, 0x29, 0x4f, 0x62, 0x7f, 0x83, 0x59, 0x64, 0x31, 0x95,
+	0x45, 0xea, 0x3e, 0xfa, 0x21, 0x01, 0x01, 0x6c, 0x4f, 0x65, 0x85, 0xee, 0xe9, 0xf3, 0x3b, 0xe7,
+	0x9e, 0x73, 0x7e, 0xe7, 0xbe, 0x80, 0x35, 0xdd, 0xe9, 0x19, 0xf8, 0x70, 0xdd, 0x32, 0x9f, 0x0f,
+	0x4c, 0xc3, 0x24, 0x47, 0xeb, 0x2f, 0x6e, 0xed, 0x61, 0xa2, 0xdd, 0x0a, 0x25, 0xe5, 0xbe, 0xeb,
+	0x10, 0x07, 0xe5, 0xb9, 0x66, 0x39, 0x94, 0x0b, 0xcd, 0xc2, 0x62, 0xd7, 0xe9, 0x3a, 0x4c, 0x69,
+	0x9d, 0xfe, 0xe2, 0xfa, 0x85, 0x15, 0xdd, 0xf1, 0x7a, 0x8e, 0xb7, 0xbe, 0xa7, 0x79, 0x38, 0x30,
+	0xaa, 0x3b, 0xa6, 0x2d, 0xbe, 0xaf, 0x76, 0x1d, 0xa7, 0x6b, 0xe1, 0x75, 0x36, 0xda, 0x1b, 0xec,
+	0xaf, 0x13, 0xb3, 0x87, 0x3d, 0xa2, 0xf5, 0xfa, 0xbe, 0x81, 0x49, 0x05, 0x63, 0xe0, 0x6a, 0xc4,
+	0x74, 0x84, 0x81, 0xd2, 0xbf, 0xa7, 0x21, 0xd6, 0xd4, 0x4c, 0x17, 0x65, 0x60, 0xda, 0x34, 0xf2,
+	0x52, 0x51, 0x5a, 0x8b, 0xa9, 0xd3, 0xa6, 0x81, 0xae, 0x41, 0x96, 0x3a, 0xed, 0x50, 0x67, 0x1d,
+	0x03, 0xdb, 0x4e, 0x2f, 0x3f, 0x5d, 0x94, 0xd6, 0x92, 0xea, 0x3c, 0x15, 0xd7, 0x1c, 0xd3, 0xae,
+	0x53, 0x21, 0x5a, 0x83, 0xdc, 0xf3, 0x81, 0x43, 0xc6, 0x14, 0x67, 0x98, 0x62, 0x86, 0xc9, 0x43,
+	0xcd, 0x8f, 0x20, 0x83, 0x3d, 0xdd, 0x75, 0x0e, 0x3a, 0x9a, 0x61, 0xb8, 0xd8, 0xf3, 0xf2, 0x31,
+	0x6e, 0x90, 0x4b, 0x2b, 0x5c, 0x88, 0x4a, 0x30, 0x6f, 0x69, 0x1e, 0xe9, 0x38, 0xae, 0x81, 0xdd,
+	0x8e, 0x69, 0xe4, 0x67, 0xd9, 0x9c, 0x52, 0x54, 0xb8, 0x43, 0x65, 0x8a, 0x81, 0x14, 0x00, 0xa6,
+	0xd3, 0x77, 0x4d, 0x1d, 0xe7, 0xe3, 0xd4, 0x4c, 0xf5, 0xc6, 0xdf, 0x5e, 0xad, 0x5e, 0xeb, 0x9a,
+	0xe4, 0xe9, 0x60, 0xaf, 0xac, 0x3b, 0xbd, 0x75, 0x91, 0x39, 0xfe, 0xe7, 0x63, 0xcf, 0x78, 0xb6,
+	0x4e, 0x8e, 0xfa, 0xd8, 0x2b, 0xd7, 0xb1, 0xae, 0x26, 0x29, 0xba, 0x49, 0xc1, 0x74, 0xfe, 0xfa,
+	0xc0, 0x75, 0xb1, 0x4d, 0x3a, 0x7b, 0x1a, 0xd1, 0x9f, 0x52, 0x8f, 0x73, 0xcc, 0x63, 0x46, 0xc8,
+	0xab, 0x54, 0xac, 0x18, 0xe8, 0x97, 0x50, 0xf0, 0x0e, 0xb4, 0x7e, 0x67, 0x1f, 0xd3, 0x60, 0x2d,
+	0x0b, 0xeb, 0xc4, 0x71, 0x83, 0x58, 0x12, 0x2c, 0x96, 0x2b, 0x54, 0x63, 0x13, 0xe3, 0x9a, 0xff,
+	0xdd, 0x8f, 0x6a, 0x09, 0xe2, 0x5a, 0xbf, 0x4f, 0x8d, 0x27, 0x99, 0xf1, 0x59, 0xad, 0xdf, 0x57,
+	0x8c, 0xd2, 0xbf, 0xe2, 0x10, 0x6b, 0x3a, 0x8e, 0x75, 0x2c, 0xfd, 0x57, 0x60, 0xae, 0xaf, 0x99,
+	0x2c, 0xfe, 0x69, 0x26, 0x8c, 0xd3, 0xa1, 0x62, 0xa0, 0xeb, 0x90, 0x75, 0xb1, 0x87, 0xdd, 0x17,
+	0x38, 0x70, 0x2d, 0xd2, 0x2d, 0xc4, 0xbe, 0xc7, 0x6b, 0x90, 0xed, 0x3b, 0x8e, 0x15, 0xad, 0x8b,
+	0xc8, 0x37, 0x15, 0x87, 0x65, 0xf9, 0x09, 0x5c, 0x61, 0xb9, 0x34, 0x70, 0xdf, 0xf1, 0x4c, 0xd2,
+	0x71, 0xf1, 0xf3, 0x01, 0xf6, 0x48, 0x98, 0xf9, 0x45, 0xfa, 0xb9, 0xce, 0xbf, 0xaa, 0xfc, 0xa3,
+	0x62, 0xa0, 0x3b, 0x90, 0x67, 0xb0, 0x03, 0x93, 0x3c, 0x35, 0x5c, 0xed, 0x20, 0x8a, 0x8b, 0x33,
+	0xdc, 0x12, 0xfd, 0xfe, 0x48, 0x7c, 0x0e, 0x81, 0x05, 0x48, 0x18, 0xa6, 0xa7, 0xed, 0x59, 0x98,
+	0x27, 0x3a, 0xa1, 0x06, 0xe3, 0x48, 0x96, 0x12, 0x91, 0x2c, 0xa1, 0x9f, 0x42, 0x8c, 0xd6, 0x8e,
+	0xa5, 0x2e, 0xb3, 0x51, 0x2a, 0x9f, 0xd6, 0x44, 0x65, 0x9a, 0xca, 0xf6, 0x51, 0x1f, 0xab, 0x4c,
+	0x1f, 0xe5, 0x61, 0x4e, 0x77, 0xb1, 0x46, 0x1c, 0x37, 0x0f, 0x2c, 0x74, 0x7f, 0x88, 0xee, 0x41,
+	0xb2, 0x67, 0xda, 0x82, 0x3f, 0xa9, 0x0b, 0xf3, 0x27, 0xd1, 0x33, 0x6d, 0x4e, 0x1f, 0x6a, 0x48,
+	0x3b, 0x14, 0x86, 0xd2, 0x6f, 0x61, 0x48, 0x3b, 0xe4, 0x86, 0x64, 0x48, 0x50, 0x62, 0xb9, 0x1a,
+	0xc1, 0xf9, 0xf9, 0x0b, 0xdb, 0x99, 0xdb, 0xc7, 0x58, 0xd5, 0x08, 0xa3, 0x33, 0xef, 0x0c, 0x5a,
+	0x2d, 0xd3, 0xb1, 0x69, 0x2e, 0x33, 0x9c, 0xce, 0x8c, 0xf3, 0x42, 0xac, 0x18, 0xe8, 0x33, 0xc8,
+	0x1e, 0x60, 0xb3, 0xfb, 0x94, 0x60, 0xa3, 0xa3, 0x79, 0x1e, 0x26, 0x5e, 0x3e, 0x5b, 0x9c, 0x59,
+	0x4b, 0x6d, 0xfc, 0xf0, 0xf4, 0xfc, 0x3e, 0x12, 0x00, 0x9a, 0xe7, 0x0a, 0xc5, 0x54, 0x63, 0x2f,
+	0x5f, 0xad, 0x4e, 0xa9, 0x19, 0xdf, 0x12, 0x13, 0x7a, 0xb4, 0x3f, 0xbd, 0xe7, 0xae, 0xdf, 0x9f,
+	0xb9, 0x8b, 0xf7, 0x27, 0x45, 0xf3, 0xbc, 0xec, 0x42, 0x4e, 0xd3, 0x89, 0xf9, 0x02, 0x77, 0x82,
+	0xe9, 0xe4, 0x17, 0x2e, 0x6c, 0x30, 0xcb, 0x6d, 0x34, 0x7c, 0x13, 0xa5, 0xaf, 0x66, 0x21, 0x33,
+	0xce, 0xe9, 0x13, 0x5b, 0x90, 0x36, 0x50, 0xa4, 0x05, 0x1d, 0xc7, 0x52, 0x0c, 0xf4, 0x21, 0x40,
+	0xcf, 0xeb, 0x76, 0x9e, 0xb2, 0x98, 0x59, 0xf7, 0xcd, 0xa8, 0xc9, 0x9e, 0xd7, 0xbd, 0xcf, 0x04,
+	0xe8, 0x03, 0x48, 0x8a, 0x5e, 0x72, 0x5c, 0xd1, 0x72, 0xa1, 0x00, 0xf5, 0x61, 0x5e, 0x0c, 0x58,
+	0x67, 0x7a, 0xf9, 0x59, 0x96, 0xf4, 0xe5, 0x32, 0x9f, 0x77, 0x99, 0xae, 0xae, 0x41, 0xbe, 0x69,
+	0x97, 0x56, 0x6f, 0xd2, 0x14, 0xff, 0xe9, 0x9b, 0xd5, 0xb5, 0x73, 0xc4, 0x4a, 0x01, 0x9e, 0x9a,
+	0x16, 0x1e, 0xd8, 0x08, 0xb9, 0x90, 0xd1, 0x74, 0x1d, 0xf7, 0x69, 0xa1, 0xb9, 0xcb, 0xf8, 0xfb,
+	0x77, 0x39, 0xef, 0xbb, 0xe0, 0x3e, 0x15, 0xc8, 0xf5, 0x4c, 0x9b, 0x7a, 0x0c, 0xd6, 0x20, 0xd6,
+	0xec, 0xff, 0xd5, 0xab, 0xe0, 0x12, 0x07, 0x36, 0xc5, 0x22, 0x85, 0x7e, 0x05, 0x71, 0x8f, 0x68,
+	0x64, 0xc0, 0x97, 0xd8, 0xcc, 0xc6, 0xf5, 0xd3, 0xe9, 0x29, 0x2a, 0xd9, 0x62, 0xea, 0xaa, 0x80,
+	0x9d, 0xb2, 0xf4, 0xa2, 0xff, 0x83, 0xb4, 0x67, 0xda, 0x5d, 0x0b, 0x73, 0xf6, 0xb3, 0x15, 0x22,
+	0xa1, 0xa6, 0xb8, 0x8c, 0xf1, 0x18, 0x75, 0x60, 0x91, 0xad, 0x12, 0xc1, 0x32, 0xaa, 0xf5, 0x9c,
+	0x81, 0x4d, 0xc4, 0x82, 0x51, 0xa6, 0xd3, 0x3d, 0x27, 0x07, 0x15, 0x9b, 0xa8, 0x0b, 0x74, 0xd1,
+	0x10, 0x51, 0x55, 0x98, 0x21, 0x74, 0x17, 0xd2, 0x74, 0xc3, 0xe8, 0x8b, 0xca, 0xe4, 0xd3, 0x67,
+	0xa4, 0x48, 0x4d, 0x09, 0x75, 0x3a, 0x28, 0xfd, 0x36, 0x06, 0xd9, 0x89, 0xf5, 0xf5, 0xbd, 0x91,
+	0x78, 0x05, 0xc0, 0x5f, 0xd9, 0xb1, 0xcf, 0xe2, 0x88, 0x04, 0xdd, 0x85, 0x64, 0x58, 0xd9, 0xd9,
+	0xf3, 0x55, 0x36, 0xe1, 0x6f, 0x3c, 0x88, 0x40, 0xd6, 0xb7, 0x65, 0x7f, 0x7f, 0x9c, 0xcc, 0x04,
+	0x3e, 0x38, 0x29, 0x43, 0x26, 0xcd, 0xbd, 0x2b, 0x93, 0xc6, 0xb6, 0xa7, 0x1b, 0xb0, 0x60, 0xe0,
+	0x9e, 0x66, 0x1b, 0xd1, 0xbd, 0x36, 0xc9, 0x52, 0x96, 0xe5, 0x1f, 0xc2, 0xdd, 0x56, 0x87, 0xcb,
+	0x3d, 0xa6, 0x13, 0xea, 0x0b, 0x52, 0xc1, 0x5b, 0x91, 0xea, 0x52, 0x8f, 0x5a, 0xf6, 0x7d, 0x70,
+	0x5a, 0x95, 0xfe, 0x12, 0x87, 0x59, 0x76, 0x54, 0x3a, 0xff, 0xb1, 0xe2, 0x0c, 0x3a, 0xe4, 0x61,
+	0x8e, 0x9d, 0xc7, 0x02, 0x2e, 0xf8, 0x43, 0xb4, 0x09, 0x49, 0xc3, 0x74, 0xb1, 0x4e, 0x77, 0x15,
+	0x46, 0x84, 0xcc, 0xc6, 0xda, 0xe9, 0x79, 0x65, 0xb3, 0xaa, 0xfb, 0xfa, 0x6a, 0x08, 0x45, 0x9f,
+	0x02, 0x38, 0xfb, 0xfb, 0xd8, 0xe5, 0x8c, 0x8a, 0x9f, 0x8f, 0x51, 0x49, 0x06, 0x61, 0x94, 0x7a,
+	0x08, 0x8b, 0x2e, 0xee, 0x69, 0xa6, 0x6d, 0xda, 0xdd, 0x4e, 0xc4, 0xd2, 0x39, 0x57, 0x1d, 0x14,
+	0x80, 0x77, 0x02, 0x93, 0x75, 0x98, 0x77, 0xb1, 0x8e, 0xcd, 0x17, 0x7e, 0x7b, 0x26, 0xce, 0x67,
+	0x2b, 0xed, 0xa3, 0x84, 0x95, 0x59, 0xbe, 0x0d, 0x26, 0x2f, 0x5c, 0x60, 0xba, 0x73, 0x71, 0x30,
+	0xda, 0x84, 0xf8, 0x3b, 0xf1, 0x44, 0xa0, 0xd1, 0x0e, 0xa4, 0x9c, 0x3e, 0x7e, 0xc7, 0x95, 0x0c,
+	0xa8, 0x09, 0xb1, 0x84, 0x2d, 0x43, 0x22, 0x38, 0x37, 0xa7, 0x19, 0xa5, 0xe6, 0xf6, 0xc4, 0x81,
+	0xb9, 0x02, 0x49, 0x7c, 0xd8, 0x37, 0x5d, 0xdc, 0xd1, 0x08, 0x3b, 0xd3, 0xa4, 0x36, 0x0a, 0x65,
+	0x7e, 0x1f, 0x29, 0xfb, 0xf7, 0x91, 0x72, 0xdb, 0xbf, 0xb0, 0x54, 0x13, 0x74, 0x16, 0x5f, 0x7c,
+	0xb3, 0x2a, 0xa9, 0x09, 0x0e, 0xab, 0x10, 0xf4, 0x49, 0xd0, 0xb2, 0x19, 0x46, 0xad, 0x8f, 0xce,
+	0xa0, 0xd6, 0xa9, 0x0d, 0x9b, 0x8d, 0x36, 0xec, 0x1d, 0x71, 0x9e, 0xcc, 0x31, 0x9b, 0xff, 0x7f,
+	0x86, 0xcd, 0xf0, 0x40, 0x59, 0x1a, 0x40, 0x7a, 0x6b, 0x8b, 0x09, 0x15, 0xdb, 0xc0, 0x87, 0xd1,
+	0xb6, 0x90, 0xc6, 0xdb, 0x22, 0xf4, 0x3c, 0x1d, 0xf5, 0x1c, 0xe9, 0xbf, 0x99, 0xb1, 0xfe, 0xbb,
+	0x0a, 0x49, 0xff, 0xc2, 0x43, 0xef, 0x45, 0x33, 0x6b, 0x31, 0x35, 0xc1, 0x04, 0x8a, 0xe1, 0x95,
+	0xfe, 0x2c, 0x41, 0xba, 0xc2, 0x0e, 0x30, 0x9b, 0x9a, 0xdb, 0x1b, 0xb3, 0x2e, 0x4d, 0x5a, 0x3f,
+	0x71, 0xb1, 0xbf, 0x0c, 0xf1, 0x7d, 0x86, 0x14, 0x77, 0x05, 0x31, 0x42, 0x04, 0x72, 0xec, 0x57,
+	0x74, 0x9b, 0x8e, 0x9d, 0x45, 0xf2, 0x75, 0x5a, 0xa7, 0xef, 0x5e, 0xad, 0x5e, 0x3f, 0xe7, 0x42,
+	0xac, 0x66, 0xb8, 0x0f, 0x7f, 0xef, 0x2b, 0xfd, 0x5d, 0x02, 0x78, 0x38, 0xc0, 0x03, 0xd1, 0x20,
+	0x27, 0x4d, 0x42, 0xfa, 0xbe, 0x27, 0x81, 0x1e, 0x03, 0xb0, 0xcb, 0x00, 0x3d, 0xfd, 0x92, 0xfc,
+	0xf4, 0x99, 0xec, 0xfc, 0x90, 0x3a, 0xfc, 0xf6, 0xd5, 0xea, 0xc2, 0x91, 0xd6, 0xb3, 0x7e, 0x51,
+	0x0a, 0xb1, 0x25, 0x46, 0xd9, 0xa4, 0x10, 0x54, 0x48, 0x69, 0x24, 0x41, 0x9a, 0x87, 0xf7, 0x9e,
+	0xab, 0x25, 0x43, 0xea, 0xf9, 0x00, 0x0f, 0xfc, 0x53, 0x5c, 0x8c, 0xed, 0x98, 0x3f, 0x38, 0x9d,
+	0xbd, 0x61, 0x8e, 0x55, 0x60, 0x40, 0xfa, 0xd3, 0x2b, 0x7d, 0x1e, 0x03, 0x68, 0x38, 0xfa, 0xb3,
+	0xff, 0x69, 0xfa, 0x7f, 0x0d, 0xf3, 0x96, 0xa3, 0x3f, 0xeb, 0xf8, 0xcf, 0x11, 0xa2, 0x02, 0xcb,
+	0xc7, 0x2a, 0x50, 0x17, 0x0a, 0xd5, 0xa2, 0x28, 0xc0, 0x22, 0x2f, 0xc0, 0x18, 0xba, 0xf4, 0x3b,
+	0x5a, 0x83, 0x34, 0x95, 0xf9, 0xfa, 0x34, 0xae, 0x3d, 0xc7, 0xf1, 0x48, 0xa7, 0x37, 0xb0, 0x88,
+	0xd9, 0xb7, 0x4c, 0x3f, 0x9f, 0x55, 0xe5, 0x62, 0x4b, 0xf0, 0xb7, 0xaf, 0x56, 0xaf, 0x70, 0x9f,
+	0x93, 0xf6, 0x4a, 0x6a, 0x96, 0x89, 0xb6, 0x02, 0x09, 0xda, 0x85, 0xa4, 0xc5, 0x72, 0x4b, 0x59,
+	0x15, 0x3b, 0x93, 0x55, 0x1f, 0x88, 0xa0, 0x72, 0x61, 0x50, 0x11, 0x52, 0x25, 0xf8, 0xb8, 0x42,
+	0xa8, 0xd9, 0x81, 0xcd, 0x42, 0xd6, 0x48, 0x7e, 0xf6, 0xa2, 0x66, 0x03, 0xa8, 0x30, 0xcb, 0xc7,
+	0x15, 0x52, 0xfa, 0xbd, 0x04, 0x69, 0x4e, 0x85, 0xf7, 0x4c, 0xd5, 0x7b, 0x90, 0x16, 0xb1, 0x9c,
+	0x93, 0xab, 0x21, 0x21, 0xd5, 0x94, 0x15, 0xfc, 0xf6, 0x4a, 0x5f, 0xce, 0x40, 0xc2, 0xbf, 0xb4,
+	0x9e, 0xff, 0x70, 0x1b, 0x86, 0x31, 0x13, 0x0d, 0x63, 0x11, 0x66, 0x9d, 0x03, 0x3b, 0x38, 0xc3,
+	0xf0, 0x01, 0x7a, 0x10, 0x7d, 0x0b, 0x98, 0x7d, 0xab, 0x4d, 0x3a, 0x7c, 0x0f, 0x78, 0x10, 0x7d,
+	0x0f, 0x88, 0xbf, 0xa5, 0x31, 0xff, 0x4d, 0xa0, 0x01, 0xc9, 0xf0, 0xd2, 0x3b, 0xf7, 0x56, 0xc6,
+	0x42, 0x03, 0xc8, 0x86, 0xb4, 0xa6, 0xeb, 0xee, 0x00, 0x1b, 0xf4, 0x09, 0x8b, 0x5e, 0xa7, 0xde,
+	0xfb, 0x89, 0x3b, 0x25, 0x1c, 0x6c, 0x62, 0xec, 0x95, 0x2a, 0xb0, 0x70, 0xec, 0xbd, 0x80, 0x96,
+	0x80, 0x9f, 0x8f, 0xf9, 0x7e, 0xc9, 0x07, 0x94, 0x46, 0xfc, 0x05, 0xc1, 0xaf, 0x23, 0x1f, 0xdd,
+	0xf8, 0x4e, 0x82, 0x84, 0xff, 0xa6, 0x83, 0x36, 0x60, 0xa9, 0xb9, 0xb3, 0xd3, 0xe8, 0xb4, 0x9f,
+	0x34, 0xe5, 0xce, 0xee, 0x76, 0xab, 0x29, 0xd7, 0x94, 0x4d, 0x45, 0xae, 0xe7, 0xa6, 0x0a, 0x57,
+	0x86, 0xa3, 0xe2, 0x25, 0x5f, 0x71, 0xd7, 0xf6, 0xfa, 0x58, 0x37, 0xf7, 0x4d, 0xcc, 0x5e, 0x31,
+	0x43, 0x4c, 0xb5, 0xd2, 0x52, 0x6a, 0x39, 0xa9, 0xb0, 0x30, 0x1c, 0x15, 0xe7, 0x7d, 0xed, 0xaa,
+	0xe6, 0x99, 0x3a, 0x7d, 0x36, 0x09, 0xf5, 0xd4, 0xca, 0xf6, 0x3d, 0xb9, 0x9e, 0x9b, 0x2e, 0xa0,
+	0xe1, 0xa8, 0x98, 0xf1, 0x15, 0x55, 0xcd, 0xee, 0x62, 0x03, 0xfd, 0x18, 0x2e, 0x87, 0x9a, 0xb5,
+	0x9d, 0xed, 0x9a, 0xbc, 0xdd, 0x56, 0x2b, 0x6d, 0xb9, 0x9e, 0x9b, 0x29, 0xe4, 0x87, 0xa3, 0xe2,
+	0xa2, 0xaf, 0x5f, 0x73, 0x6c, 0x1d, 0xdb, 0x84, 0x3e, 0xe8, 0x18, 0xe8, 0x47, 0x80, 0x42, 0xd4,
+	0x23, 0x59, 0xb9, 0x77, 0x9f, 0x22, 0x62, 0x85, 0xc5, 0xe1, 0xa8, 0x98, 0xf3, 0x11, 0x7e, 0xb6,
+	0x0a, 0xb1, 0xcf, 0xff, 0xb8, 0x32, 0x75, 0xe3, 0x2b, 0x09, 0x92, 0xc1, 0x01, 0x84, 0xfa, 0xdd,
+	0x51, 0xeb, 0xb2, 0x7a, 0x52, 0xf8, 0xcc, 0x6f, 0xa0, 0x1a, 0x8d, 0x7f, 0x0d, 0x72, 0x11, 0x54,
+	0x43, 0xd9, 0x52, 0xda, 0x39, 0x89, 0xc7, 0x15, 0xe8, 0x37, 0xcc, 0x9e, 0x49, 0xe8, 0x25, 0x26,
+	0xa2, 0xb9, 0x55, 0x51, 0x1f, 0xc8, 0xed, 0xdc, 0x74, 0xe1, 0xd2, 0x70, 0x54, 0xcc, 0x06, 0xaa,
+	0x5b, 0x9a, 0xfb, 0x0c, 0x13, 0xfa, 0x44, 0x1b, 0xd5, 0xdd, 0xca, 0xcd, 0x14, 0xb2, 0xc3, 0x51,
+	0x31, 0x15, 0xea, 0x6d, 0x89, 0x18, 0xbe, 0x94, 0x20, 0x33, 0x7e, 0xe6, 0x47, 0x9f, 0xc2, 0x55,
+	0x0e, 0xae, 0x2b, 0xaa, 0x5c, 0x6b, 0x2b, 0x3b, 0xdb, 0x13, 0xd1, 0x7c, 0x38, 0x1c, 0x15, 0x97,
+	0xc7, 0x41, 0xd1, 0x90, 0xca, 0x70, 0x69, 0x12, 0x5f, 0xdd, 0x7d, 0x92, 0x93, 0x0a, 0x4b, 0xc3,
+	0x51, 0x71, 0x61, 0x1c, 0x57, 0x1d, 0x1c, 0xa1, 0x9b, 0xb0, 0x38, 0xa9, 0xdf, 0x92, 0x1b, 0x8d,
+	0xdc, 0x74, 0xe1, 0xf2, 0x70, 0x54, 0x44, 0xe3, 0x80, 0x16, 0xb6, 0x2c, 0x31, 0xf5, 0xdf, 0x4c,
+	0xc3, 0xfc, 0xd8, 0x35, 0x10, 0xdd, 0x85, 0x82, 0x2a, 0x3f, 0xdc, 0x95, 0x5b, 0xed, 0x4e, 0xab,
+	0x5d, 0x69, 0xef, 0xb6, 0x26, 0x26, 0xfe, 0xc1, 0x70, 0x54, 0xcc, 0x8f, 0x41, 0xa2, 0xf3, 0xfe,
+	0x04, 0xae, 0x4e, 0xa0, 0xb7, 0x77, 0xda, 0x1d, 0xf9, 0xb1, 0x5c, 0xdb, 0xa5, 0x5c, 0x90, 0x4e,
+	0x80, 0x6f, 0x3b, 0x44, 0x3e, 0xc4, 0xfa, 0x80, 0x32, 0xe8, 0x67, 0x90, 0x9f, 0x80, 0xb7, 0x76,
+	0x6b, 0x35, 0x59, 0xae, 0x33, 0xa6, 0x16, 0x86, 0xa3, 0xe2, 0xe5, 0x31, 0x6c, 0x6b, 0xa0, 0xeb,
+	0x18, 0x1b, 0xd8, 0xa0, 0x7d, 0x33, 0x81, 0xdc, 0xac, 0x28, 0x0d, 0x46, 0x58, 0xd6, 0x37, 0x63,
+	0xb0, 0x4d, 0xcd, 0xb4, 0x02, 0x06, 0xfe, 0x61, 0x06, 0x52, 0x91, 0x63, 0x35, 0x9d, 0x03, 0x4f,
+	0xe5, 0x89, 0xe1, 0xb3, 0x39, 0x44, 0xd4, 0xa3, 0xc1, 0xff, 0x1c, 0x96, 0xc7, 0x90, 0x13, 0xa1,
+	0x4f, 0x42, 0xa3, 0x81, 0xdf, 0x81, 0xfc, 0x31, 0xe8, 0x56, 0xa5, 0x5d, 0xbb, 0xcf, 0x02, 0x5f,
+	0x1e, 0x8e, 0x8a, 0x4b, 0xe3, 0xc8, 0x2d, 0x7a, 0xfd, 0xc0, 0x06, 0xaa, 0xc1, 0xca, 0x18, 0xb0,
+	0x59, 0x51, 0xdb, 0x4a, 0xa5, 0xd1, 0x78, 0x12, 0xc0, 0x67, 0x0a, 0xab, 0xc3, 0x51, 0xf1, 0x6a,
+	0x04, 0xde, 0xd4, 0x5c, 0x62, 0x6a, 0x96, 0x75, 0xe4, 0x1b, 0x09, 0xda, 0x4e, 0x18, 0xa9, 0xed,
+	0x6c, 0x35, 0x1b, 0x32, 0x6f, 0xde, 0xb0, 0xed, 0x38, 0xb8, 0xe6, 0xf4, 0xfa, 0x16, 0x26, 0x3c,
+	0xe5, 0xe3, 0xa8, 0xca, 0x76, 0x4d, 0xa6, 0x29, 0x9f, 0xe5, 0x29, 0x8f, 0x82, 0x34, 0x5b, 0xc7,
+	0xf4, 0xed, 0x3b, 0xe0, 0xa9, 0xc0, 0xc8, 0x8f, 0x9b, 0x8a, 0x2a, 0xd7, 0x73, 0xf1, 0x08, 0x4f,
+	0x39, 0x44, 0x66, 0xb7, 0x23, 0xbf, 0x48, 0x47, 0x90, 0x12, 0x4f, 0xfe, 0x6c, 0x9d, 0xb8, 0x05,
+	0x4b, 0x95, 0x7a, 0x5d, 0x95, 0x5b, 0x2d, 0xde, 0x9d, 0xb7, 0x37, 0x3a, 0xd5, 0x27, 0x6d, 0xb9,
+	0x95, 0x9b, 0xe2, 0x76, 0x22, 0xba, 0xb7, 0x37, 0xaa, 0x47, 0x04, 0x7b, 0xc7, 0x20, 0x1b, 0x37,
+	0x05, 0x44, 0x3a, 0x06, 0xd9, 0xb8, 0xc9, 0x20, 0xdc, 0x75, 0xf5, 0xe1, 0xcb, 0x7f, 0xae, 0x4c,
+	0xbd, 0x7c, 0xbd, 0x22, 0x7d, 0xfd, 0x7a, 0x45, 0xfa, 0xc7, 0xeb, 0x15, 0xe9, 0x8b, 0x37, 0x2b,
+	0x53, 0x5f, 0xbf, 0x59, 0x99, 0xfa, 0xeb, 0x9b, 0x95, 0xa9, 0xcf, 0x6e, 0x8f, 0x6d, 0x1b, 0x74,
+	0xdf, 0xff, 0xd8, 0xd9, 0xdf, 0x37, 0x75, 0x53, 0xb3, 0xc4, 0x78, 0x3d, 0xfa, 0x2f, 0x33, 0xb6,
+	0x8f, 0xec, 0xc5, 0xd9, 0x69, 0xe6, 0xf6, 0x7f, 0x06, 0x00, 0xb9, 0x9e, 0x4d, 0x88, 0x53, 0x1b,
+	0x00, 0x00,
 }
 
 func (m *Pair) Marshal() (dAtA []byte, err error) {
@@ -907,6 +1150,65 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ActiveLiquidity != nil {
+		{
+			size := m.ActiveLiquidity.Size()
+			i -= size
+			if _, err := m.ActiveLiquidity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.SqrtPrice != nil {
+		{
+			size := m.SqrtPrice.Size()
+			i -= size
+			if _, err := m.SqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.WeightedAssets) > 0 {
+		for iNdEx := len(m.WeightedAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.LastPositionId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.LastPositionId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.FeeRate != nil {
+		{
+			size := m.FeeRate.Size()
+			i -= size
+			if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.MaxPrice != nil {
 		{
 			size := m.MaxPrice.Size()
@@ -1015,6 +1317,38 @@ func (m *DepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SwappedCoin != nil {
+		{
+			size, err := m.SwappedCoin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size := m.MinPoolCoinAmount.Size()
+		i -= size
+		if _, err := m.MinPoolCoinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.SingleAsset {
+		i--
+		if m.SingleAsset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.AppId != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.AppId))
 		i--
@@ -1108,6 +1442,23 @@ func (m *WithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinDemandCoinAmount.Size()
+		i -= size
+		if _, err := m.MinDemandCoinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.DemandCoinDenom) > 0 {
+		i -= len(m.DemandCoinDenom)
+		copy(dAtA[i:], m.DemandCoinDenom)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.DemandCoinDenom)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.AppId != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.AppId))
 		i--
@@ -1204,12 +1555,12 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x70
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintLiquidity(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintLiquidity(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x6a
 	if m.BatchId != 0 {
@@ -1328,20 +1679,20 @@ func (m *MMOrderIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.OrderIds) > 0 {
-		dAtA8 := make([]byte, len(m.OrderIds)*10)
-		var j7 int
+		dAtA9 := make([]byte, len(m.OrderIds)*10)
+		var j8 int
 		for _, num := range m.OrderIds {
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j8++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintLiquidity(dAtA, i, uint64(j7))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintLiquidity(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1435,12 +1786,12 @@ func (m *QueuedCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err10 != nil {
-		return 0, err10
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n10
-	i = encodeVarintLiquidity(dAtA, i, uint64(n10))
+	i -= n11
+	i = encodeVarintLiquidity(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -1510,67 +1861,312 @@ func (m *QueuedFarmer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LockedCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Pair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *LockedCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
-	}
-	l = len(m.BaseCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnlockAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockAt):])
+	if err13 != nil {
+		return 0, err13
 	}
-	l = len(m.QuoteCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	l = len(m.EscrowAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	if m.LastOrderId != 0 {
-		n += 1 + sovLiquidity(uint64(m.LastOrderId))
-	}
-	if m.LastPrice != nil {
-		l = m.LastPrice.Size()
-		n += 1 + l + sovLiquidity(uint64(l))
+	i -= n13
+	i = encodeVarintLiquidity(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x2a
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LockedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LockedAt):])
+	if err14 != nil {
+		return 0, err14
 	}
-	if m.CurrentBatchId != 0 {
-		n += 1 + sovLiquidity(uint64(m.CurrentBatchId))
+	i -= n14
+	i = encodeVarintLiquidity(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BoostMultiplier.Size()
+		i -= size
+		if _, err := m.BoostMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = len(m.SwapFeeCollectorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration):])
+	if err15 != nil {
+		return 0, err15
 	}
-	if m.AppId != 0 {
-		n += 1 + sovLiquidity(uint64(m.AppId))
+	i -= n15
+	i = encodeVarintLiquidity(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FarmedPoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LockedFarmer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedFarmer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedFarmer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
-	}
+	if len(m.LockedCoins) > 0 {
+		for iNdEx := len(m.LockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccruedFees) > 0 {
+		for iNdEx := len(m.AccruedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinPrice.Size()
+		i -= size
+		if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AppId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedPoolAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedPoolAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedPoolAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	l = len(m.BaseCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.QuoteCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.LastOrderId != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastOrderId))
+	}
+	if m.LastPrice != nil {
+		l = m.LastPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.CurrentBatchId != 0 {
+		n += 1 + sovLiquidity(uint64(m.CurrentBatchId))
+	}
+	l = len(m.SwapFeeCollectorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovLiquidity(uint64(m.AppId))
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
 	if m.PairId != 0 {
 		n += 1 + sovLiquidity(uint64(m.PairId))
 	}
@@ -1609,6 +2205,27 @@ func (m *Pool) Size() (n int) {
 		l = m.MaxPrice.Size()
 		n += 1 + l + sovLiquidity(uint64(l))
 	}
+	if m.FeeRate != nil {
+		l = m.FeeRate.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.LastPositionId != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastPositionId))
+	}
+	if len(m.WeightedAssets) > 0 {
+		for _, e := range m.WeightedAssets {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if m.SqrtPrice != nil {
+		l = m.SqrtPrice.Size()
+		n += 2 + l + sovLiquidity(uint64(l))
+	}
+	if m.ActiveLiquidity != nil {
+		l = m.ActiveLiquidity.Size()
+		n += 2 + l + sovLiquidity(uint64(l))
+	}
 	return n
 }
 
@@ -1651,6 +2268,15 @@ func (m *DepositRequest) Size() (n int) {
 	if m.AppId != 0 {
 		n += 1 + sovLiquidity(uint64(m.AppId))
 	}
+	if m.SingleAsset {
+		n += 2
+	}
+	l = m.MinPoolCoinAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.SwappedCoin != nil {
+		l = m.SwappedCoin.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
 	return n
 }
 
@@ -1687,6 +2313,12 @@ func (m *WithdrawRequest) Size() (n int) {
 	if m.AppId != 0 {
 		n += 1 + sovLiquidity(uint64(m.AppId))
 	}
+	l = len(m.DemandCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.MinDemandCoinAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
 	return n
 }
 
@@ -1826,23 +2458,117 @@ func (m *QueuedFarmer) Size() (n int) {
 	return n
 }
 
-func sovLiquidity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *LockedCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FarmedPoolCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.BoostMultiplier.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LockedAt)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockAt)
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
 }
-func sozLiquidity(x uint64) (n int) {
-	return sovLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *LockedFarmer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovLiquidity(uint64(m.AppId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.LockedCoins) > 0 {
+		for _, e := range m.LockedCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
 }
-func (m *Pair) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidity
-			}
-			if iNdEx >= l {
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovLiquidity(uint64(m.AppId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.MinPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.AccruedFees) > 0 {
+		for _, e := range m.AccruedFees {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedPoolAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovLiquidity(uint64(m.Weight))
+	}
+	return n
+}
+
+func sovLiquidity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidity(x uint64) (n int) {
+	return sovLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -2453,6 +3179,167 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.FeeRate = &v
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPositionId", wireType)
+			}
+			m.LastPositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedAssets = append(m.WeightedAssets, WeightedPoolAsset{})
+			if err := m.WeightedAssets[len(m.WeightedAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SqrtPrice = &v
+			if err := m.SqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ActiveLiquidity = &v
+			if err := m.ActiveLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2731,23 +3618,113 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidity(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleAsset", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SingleAsset = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
+			if err := m.MinPoolCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwappedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwappedCoin == nil {
+				m.SwappedCoin = &types.Coin{}
+			}
+			if err := m.SwappedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
@@ -2975,6 +3952,72 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDemandCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDemandCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -3660,7 +4703,646 @@ func (m *MMOrderIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveFarmer) Unmarshal(dAtA []byte) error {
+func (m *ActiveFarmer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveFarmer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveFarmer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmedPoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FarmedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmedPoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FarmedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedFarmer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedFarmer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedFarmer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueudCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueudCoins = append(m.QueudCoins, &QueuedCoin{})
+			if err := m.QueudCoins[len(m.QueudCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmedPoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FarmedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BoostMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LockedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnlockAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedFarmer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3683,10 +5365,10 @@ func (m *ActiveFarmer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveFarmer: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockedFarmer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveFarmer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockedFarmer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3761,7 +5443,7 @@ func (m *ActiveFarmer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmedPoolCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3788,7 +5470,8 @@ func (m *ActiveFarmer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FarmedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LockedCoins = append(m.LockedCoins, &LockedCoin{})
+			if err := m.LockedCoins[len(m.LockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3813,7 +5496,7 @@ func (m *ActiveFarmer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuedCoin) Unmarshal(dAtA []byte) error {
+func (m *Position) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3836,17 +5519,74 @@ func (m *QueuedCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmedPoolCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3856,28 +5596,129 @@ func (m *QueuedCoin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FarmedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3904,7 +5745,8 @@ func (m *QueuedCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.AccruedFees = append(m.AccruedFees, types.Coin{})
+			if err := m.AccruedFees[len(m.AccruedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3929,7 +5771,7 @@ func (m *QueuedCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuedFarmer) Unmarshal(dAtA []byte) error {
+func (m *WeightedPoolAsset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3952,53 +5794,15 @@ func (m *QueuedFarmer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedFarmer: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedPoolAsset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedFarmer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedPoolAsset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
-			}
-			m.AppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4026,13 +5830,13 @@ func (m *QueuedFarmer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueudCoins", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -4042,26 +5846,11 @@ func (m *QueuedFarmer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Weight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.QueudCoins = append(m.QueudCoins, &QueuedCoin{})
-			if err := m.QueudCoins[len(m.QueudCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
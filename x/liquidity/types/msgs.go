@@ -23,23 +23,41 @@ var (
 	_ sdk.Msg = (*MsgCancelMMOrder)(nil)
 	_ sdk.Msg = (*MsgFarm)(nil)
 	_ sdk.Msg = (*MsgUnfarm)(nil)
+	_ sdk.Msg = (*MsgZapDeposit)(nil)
+	_ sdk.Msg = (*MsgZapWithdraw)(nil)
+	_ sdk.Msg = (*MsgCreateConcentratedPool)(nil)
+	_ sdk.Msg = (*MsgOpenPosition)(nil)
+	_ sdk.Msg = (*MsgAddLiquidity)(nil)
+	_ sdk.Msg = (*MsgRemoveLiquidity)(nil)
+	_ sdk.Msg = (*MsgCollectFees)(nil)
+	_ sdk.Msg = (*MsgJoinWeightedPool)(nil)
+	_ sdk.Msg = (*MsgExitWeightedPool)(nil)
 )
 
 // Message types for the liquidity module.
 const (
-	TypeMsgCreatePair       = "create_pair"
-	TypeMsgCreatePool       = "create_pool"
-	TypeMsgCreateRangedPool = "create_ranged_pool"
-	TypeMsgDeposit          = "deposit"
-	TypeMsgWithdraw         = "withdraw"
-	TypeMsgLimitOrder       = "limit_order"
-	TypeMsgMarketOrder      = "market_order"
-	TypeMsgMMOrder          = "mm_order"
-	TypeMsgCancelOrder      = "cancel_order"
-	TypeMsgCancelAllOrders  = "cancel_all_orders"
-	TypeMsgCancelMMOrder    = "cancel_mm_order"
-	TypeMsgFarm             = "farm"
-	TypeMsgUnfarm           = "unfarm"
+	TypeMsgCreatePair             = "create_pair"
+	TypeMsgCreatePool             = "create_pool"
+	TypeMsgCreateRangedPool       = "create_ranged_pool"
+	TypeMsgDeposit                = "deposit"
+	TypeMsgWithdraw               = "withdraw"
+	TypeMsgLimitOrder             = "limit_order"
+	TypeMsgMarketOrder            = "market_order"
+	TypeMsgMMOrder                = "mm_order"
+	TypeMsgCancelOrder            = "cancel_order"
+	TypeMsgCancelAllOrders        = "cancel_all_orders"
+	TypeMsgCancelMMOrder          = "cancel_mm_order"
+	TypeMsgFarm                   = "farm"
+	TypeMsgUnfarm                 = "unfarm"
+	TypeMsgZapDeposit             = "zap_deposit"
+	TypeMsgZapWithdraw            = "zap_withdraw"
+	TypeMsgCreateConcentratedPool = "create_concentrated_pool"
+	TypeMsgOpenPosition           = "open_position"
+	TypeMsgAddLiquidity           = "add_liquidity"
+	TypeMsgRemoveLiquidity        = "remove_liquidity"
+	TypeMsgCollectFees            = "collect_fees"
+	TypeMsgJoinWeightedPool       = "join_weighted_pool"
+	TypeMsgExitWeightedPool       = "exit_weighted_pool"
 )
 
 // NewMsgCreatePair returns a new MsgCreatePair.
@@ -806,6 +824,9 @@ func (msg MsgFarm) ValidateBasic() error {
 	if !msg.FarmingPoolCoin.IsPositive() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "coin must be positive")
 	}
+	if msg.LockDuration < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lock duration must not be negative: %s", msg.LockDuration)
+	}
 	return nil
 }
 
@@ -887,3 +908,560 @@ func (msg MsgUnfarm) GetFarmer() sdk.AccAddress {
 	}
 	return addr
 }
+
+// NewMsgZapDeposit creates a new MsgZapDeposit.
+func NewMsgZapDeposit(
+	appID uint64,
+
+	depositor sdk.AccAddress,
+	poolID uint64,
+	depositCoin sdk.Coin,
+	minPoolCoinAmt sdk.Int,
+) *MsgZapDeposit {
+	return &MsgZapDeposit{
+		AppId:             appID,
+		Depositor:         depositor.String(),
+		PoolId:            poolID,
+		DepositCoin:       depositCoin,
+		MinPoolCoinAmount: minPoolCoinAmt,
+	}
+}
+
+func (msg MsgZapDeposit) Route() string { return RouterKey }
+
+func (msg MsgZapDeposit) Type() string { return TypeMsgZapDeposit }
+
+func (msg MsgZapDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := msg.DepositCoin.Validate(); err != nil {
+		return err
+	}
+	if !msg.DepositCoin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "deposit coin must be positive")
+	}
+	if msg.MinPoolCoinAmount.IsNil() || msg.MinPoolCoinAmount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min pool coin amount must not be negative")
+	}
+	return nil
+}
+
+func (msg MsgZapDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgZapDeposit) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgZapDeposit) GetDepositor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgZapWithdraw creates a new MsgZapWithdraw.
+func NewMsgZapWithdraw(
+	appID uint64,
+
+	withdrawer sdk.AccAddress,
+	poolID uint64,
+	poolCoin sdk.Coin,
+	demandCoinDenom string,
+	minDemandCoinAmt sdk.Int,
+) *MsgZapWithdraw {
+	return &MsgZapWithdraw{
+		AppId:               appID,
+		Withdrawer:          withdrawer.String(),
+		PoolId:              poolID,
+		PoolCoin:            poolCoin,
+		DemandCoinDenom:     demandCoinDenom,
+		MinDemandCoinAmount: minDemandCoinAmt,
+	}
+}
+
+func (msg MsgZapWithdraw) Route() string { return RouterKey }
+
+func (msg MsgZapWithdraw) Type() string { return TypeMsgZapWithdraw }
+
+func (msg MsgZapWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Withdrawer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := msg.PoolCoin.Validate(); err != nil {
+		return err
+	}
+	if !msg.PoolCoin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool coin must be positive")
+	}
+	if err := sdk.ValidateDenom(msg.DemandCoinDenom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.MinDemandCoinAmount.IsNil() || msg.MinDemandCoinAmount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min demand coin amount must not be negative")
+	}
+	return nil
+}
+
+func (msg MsgZapWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgZapWithdraw) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Withdrawer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgZapWithdraw) GetWithdrawer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Withdrawer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCreateConcentratedPool creates a new MsgCreateConcentratedPool.
+func NewMsgCreateConcentratedPool(
+	appID uint64,
+	creator sdk.AccAddress,
+	pairID uint64,
+	feeRate sdk.Dec,
+	depositCoins sdk.Coins,
+	minPrice sdk.Dec,
+	maxPrice sdk.Dec,
+	initialPrice sdk.Dec,
+) *MsgCreateConcentratedPool {
+	return &MsgCreateConcentratedPool{
+		AppId:        appID,
+		Creator:      creator.String(),
+		PairId:       pairID,
+		FeeRate:      feeRate,
+		DepositCoins: depositCoins,
+		MinPrice:     minPrice,
+		MaxPrice:     maxPrice,
+		InitialPrice: initialPrice,
+	}
+}
+
+func (msg MsgCreateConcentratedPool) Route() string { return RouterKey }
+
+func (msg MsgCreateConcentratedPool) Type() string { return TypeMsgCreateConcentratedPool }
+
+func (msg MsgCreateConcentratedPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if err := ValidateConcentratedPoolFeeRate(msg.FeeRate); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := validatePositionDepositCoins(msg.DepositCoins); err != nil {
+		return err
+	}
+	if err := amm.ValidateRangedPoolParams(msg.MinPrice, msg.MaxPrice, msg.InitialPrice); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgCreateConcentratedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateConcentratedPool) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateConcentratedPool) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// validatePositionDepositCoins validates the deposit coins of messages
+// depositing to concentrated pool positions.
+func validatePositionDepositCoins(depositCoins sdk.Coins) error {
+	if err := depositCoins.Validate(); err != nil {
+		return err
+	}
+	if len(depositCoins) == 0 || len(depositCoins) > 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(depositCoins))
+	}
+	for _, coin := range depositCoins {
+		if coin.Amount.GT(amm.MaxCoinAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deposit coin %s is bigger than the max amount %s", coin, amm.MaxCoinAmount)
+		}
+	}
+	return nil
+}
+
+// NewMsgOpenPosition creates a new MsgOpenPosition.
+func NewMsgOpenPosition(
+	appID uint64,
+	depositor sdk.AccAddress,
+	poolID uint64,
+	minPrice sdk.Dec,
+	maxPrice sdk.Dec,
+	depositCoins sdk.Coins,
+) *MsgOpenPosition {
+	return &MsgOpenPosition{
+		AppId:        appID,
+		Depositor:    depositor.String(),
+		PoolId:       poolID,
+		MinPrice:     minPrice,
+		MaxPrice:     maxPrice,
+		DepositCoins: depositCoins,
+	}
+}
+
+func (msg MsgOpenPosition) Route() string { return RouterKey }
+
+func (msg MsgOpenPosition) Type() string { return TypeMsgOpenPosition }
+
+func (msg MsgOpenPosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := amm.ValidateConcentratedRange(msg.MinPrice, msg.MaxPrice); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return validatePositionDepositCoins(msg.DepositCoins)
+}
+
+func (msg MsgOpenPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgOpenPosition) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgOpenPosition) GetDepositor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgAddLiquidity creates a new MsgAddLiquidity.
+func NewMsgAddLiquidity(
+	appID uint64,
+	depositor sdk.AccAddress,
+	poolID uint64,
+	positionID uint64,
+	depositCoins sdk.Coins,
+) *MsgAddLiquidity {
+	return &MsgAddLiquidity{
+		AppId:        appID,
+		Depositor:    depositor.String(),
+		PoolId:       poolID,
+		PositionId:   positionID,
+		DepositCoins: depositCoins,
+	}
+}
+
+func (msg MsgAddLiquidity) Route() string { return RouterKey }
+
+func (msg MsgAddLiquidity) Type() string { return TypeMsgAddLiquidity }
+
+func (msg MsgAddLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	return validatePositionDepositCoins(msg.DepositCoins)
+}
+
+func (msg MsgAddLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddLiquidity) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgAddLiquidity) GetDepositor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgRemoveLiquidity creates a new MsgRemoveLiquidity.
+func NewMsgRemoveLiquidity(
+	appID uint64,
+	withdrawer sdk.AccAddress,
+	poolID uint64,
+	positionID uint64,
+	liquidity sdk.Dec,
+) *MsgRemoveLiquidity {
+	return &MsgRemoveLiquidity{
+		AppId:      appID,
+		Withdrawer: withdrawer.String(),
+		PoolId:     poolID,
+		PositionId: positionID,
+		Liquidity:  liquidity,
+	}
+}
+
+func (msg MsgRemoveLiquidity) Route() string { return RouterKey }
+
+func (msg MsgRemoveLiquidity) Type() string { return TypeMsgRemoveLiquidity }
+
+func (msg MsgRemoveLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Withdrawer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	if msg.Liquidity.IsNil() || !msg.Liquidity.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "liquidity must be positive")
+	}
+	return nil
+}
+
+func (msg MsgRemoveLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveLiquidity) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Withdrawer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRemoveLiquidity) GetWithdrawer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Withdrawer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCollectFees creates a new MsgCollectFees.
+func NewMsgCollectFees(
+	appID uint64,
+	owner sdk.AccAddress,
+	poolID uint64,
+	positionID uint64,
+) *MsgCollectFees {
+	return &MsgCollectFees{
+		AppId:      appID,
+		Owner:      owner.String(),
+		PoolId:     poolID,
+		PositionId: positionID,
+	}
+}
+
+func (msg MsgCollectFees) Route() string { return RouterKey }
+
+func (msg MsgCollectFees) Type() string { return TypeMsgCollectFees }
+
+func (msg MsgCollectFees) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	return nil
+}
+
+func (msg MsgCollectFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCollectFees) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCollectFees) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgJoinWeightedPool creates a new MsgJoinWeightedPool.
+func NewMsgJoinWeightedPool(
+	appID uint64,
+	depositor sdk.AccAddress,
+	poolID uint64,
+	depositCoins sdk.Coins,
+	minPoolCoinAmt sdk.Int,
+) *MsgJoinWeightedPool {
+	return &MsgJoinWeightedPool{
+		AppId:             appID,
+		Depositor:         depositor.String(),
+		PoolId:            poolID,
+		DepositCoins:      depositCoins,
+		MinPoolCoinAmount: minPoolCoinAmt,
+	}
+}
+
+func (msg MsgJoinWeightedPool) Route() string { return RouterKey }
+
+func (msg MsgJoinWeightedPool) Type() string { return TypeMsgJoinWeightedPool }
+
+func (msg MsgJoinWeightedPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := msg.DepositCoins.Validate(); err != nil {
+		return err
+	}
+	if len(msg.DepositCoins) == 0 || len(msg.DepositCoins) > amm.MaxWeightedPoolAssets {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(msg.DepositCoins))
+	}
+	if msg.MinPoolCoinAmount.IsNil() || msg.MinPoolCoinAmount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min pool coin amount must not be negative")
+	}
+	return nil
+}
+
+func (msg MsgJoinWeightedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgJoinWeightedPool) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgJoinWeightedPool) GetDepositor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgExitWeightedPool creates a new MsgExitWeightedPool.
+// An empty demandCoinDenom withdraws all assets of the pool proportionally.
+func NewMsgExitWeightedPool(
+	appID uint64,
+	withdrawer sdk.AccAddress,
+	poolID uint64,
+	poolCoin sdk.Coin,
+	demandCoinDenom string,
+	minDemandCoinAmt sdk.Int,
+) *MsgExitWeightedPool {
+	return &MsgExitWeightedPool{
+		AppId:               appID,
+		Withdrawer:          withdrawer.String(),
+		PoolId:              poolID,
+		PoolCoin:            poolCoin,
+		DemandCoinDenom:     demandCoinDenom,
+		MinDemandCoinAmount: minDemandCoinAmt,
+	}
+}
+
+func (msg MsgExitWeightedPool) Route() string { return RouterKey }
+
+func (msg MsgExitWeightedPool) Type() string { return TypeMsgExitWeightedPool }
+
+func (msg MsgExitWeightedPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Withdrawer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := msg.PoolCoin.Validate(); err != nil {
+		return err
+	}
+	if !msg.PoolCoin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool coin must be positive")
+	}
+	if msg.DemandCoinDenom != "" {
+		if err := sdk.ValidateDenom(msg.DemandCoinDenom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	if msg.MinDemandCoinAmount.IsNil() || msg.MinDemandCoinAmount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min demand coin amount must not be negative")
+	}
+	return nil
+}
+
+func (msg MsgExitWeightedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgExitWeightedPool) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Withdrawer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgExitWeightedPool) GetWithdrawer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Withdrawer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
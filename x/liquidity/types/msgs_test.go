@@ -256,6 +256,122 @@ func TestMsgWithdraw(t *testing.T) {
 	}
 }
 
+func TestMsgZapDeposit(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgZapDeposit)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgZapDeposit) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid depositor",
+			func(msg *types.MsgZapDeposit) {
+				msg.Depositor = "invalidaddr"
+			},
+			"invalid depositor address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgZapDeposit) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"zero deposit coin",
+			func(msg *types.MsgZapDeposit) {
+				msg.DepositCoin = utils.ParseCoin("0denom1")
+			},
+			"deposit coin must be positive: invalid request",
+		},
+		{
+			"negative min pool coin amount",
+			func(msg *types.MsgZapDeposit) {
+				msg.MinPoolCoinAmount = sdk.NewInt(-1)
+			},
+			"min pool coin amount must not be negative: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgZapDeposit(1, testAddr, 1, utils.ParseCoin("1000000denom1"), sdk.ZeroInt())
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgZapDeposit, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetDepositor(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgZapWithdraw(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgZapWithdraw)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgZapWithdraw) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid withdrawer",
+			func(msg *types.MsgZapWithdraw) {
+				msg.Withdrawer = "invalidaddr"
+			},
+			"invalid withdrawer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgZapWithdraw) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"invalid pool coin",
+			func(msg *types.MsgZapWithdraw) {
+				msg.PoolCoin = utils.ParseCoin("0pool1")
+			},
+			"pool coin must be positive: invalid request",
+		},
+		{
+			"negative min demand coin amount",
+			func(msg *types.MsgZapWithdraw) {
+				msg.MinDemandCoinAmount = sdk.NewInt(-1)
+			},
+			"min demand coin amount must not be negative: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgZapWithdraw(1, testAddr, 1, utils.ParseCoin("1000000pool1"), "denom1", sdk.ZeroInt())
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgZapWithdraw, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetWithdrawer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestMsgLimitOrder(t *testing.T) {
 	orderLifespan := 20 * time.Second
 	for _, tc := range []struct {
@@ -614,6 +730,13 @@ func TestMsgFarm(t *testing.T) {
 			},
 			"coin must be positive: invalid request",
 		},
+		{
+			"negative lock duration",
+			func(msg *types.MsgFarm) {
+				msg.LockDuration = -time.Hour
+			},
+			"lock duration must not be negative: -1h0m0s: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := types.NewMsgFarm(1, 1, sdk.AccAddress(crypto.AddressHash([]byte("orderer"))), utils.ParseCoin("123pool1-1"))
@@ -683,3 +806,251 @@ func TestMsgUnfarm(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgCreateConcentratedPool(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgCreateConcentratedPool)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgCreateConcentratedPool) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid creator",
+			func(msg *types.MsgCreateConcentratedPool) {
+				msg.Creator = "invalidaddr"
+			},
+			"invalid creator address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgCreateConcentratedPool) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"too high fee rate",
+			func(msg *types.MsgCreateConcentratedPool) {
+				msg.FeeRate = sdk.OneDec()
+			},
+			"fee rate must be less than 1: 1.000000000000000000: invalid request",
+		},
+		{
+			"negative fee rate",
+			func(msg *types.MsgCreateConcentratedPool) {
+				msg.FeeRate = utils.ParseDec("-0.1")
+			},
+			"fee rate must not be negative: -0.100000000000000000: invalid request",
+		},
+		{
+			"initial price out of range",
+			func(msg *types.MsgCreateConcentratedPool) {
+				msg.InitialPrice = utils.ParseDec("3.0")
+			},
+			"initial price must not be higher than max price: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCreateConcentratedPool(
+				1, testAddr, 1, utils.ParseDec("0.003"), utils.ParseCoins("1000000denom1,1000000denom2"),
+				utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgCreateConcentratedPool, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetCreator(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgRemoveLiquidity(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgRemoveLiquidity)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgRemoveLiquidity) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid withdrawer",
+			func(msg *types.MsgRemoveLiquidity) {
+				msg.Withdrawer = "invalidaddr"
+			},
+			"invalid withdrawer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid position id",
+			func(msg *types.MsgRemoveLiquidity) {
+				msg.PositionId = 0
+			},
+			"position id must not be 0: invalid request",
+		},
+		{
+			"zero liquidity",
+			func(msg *types.MsgRemoveLiquidity) {
+				msg.Liquidity = sdk.ZeroDec()
+			},
+			"liquidity must be positive: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgRemoveLiquidity(1, testAddr, 1, 1, utils.ParseDec("1000000"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgRemoveLiquidity, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetWithdrawer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgJoinWeightedPool(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgJoinWeightedPool)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgJoinWeightedPool) {},
+			"", // empty means no error expected
+		},
+		{
+			"single coin",
+			func(msg *types.MsgJoinWeightedPool) {
+				msg.DepositCoins = utils.ParseCoins("1000000denom1")
+			},
+			"",
+		},
+		{
+			"invalid depositor",
+			func(msg *types.MsgJoinWeightedPool) {
+				msg.Depositor = "invalidaddr"
+			},
+			"invalid depositor address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgJoinWeightedPool) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"no deposit coins",
+			func(msg *types.MsgJoinWeightedPool) {
+				msg.DepositCoins = sdk.Coins{}
+			},
+			"wrong number of deposit coins: 0: invalid request",
+		},
+		{
+			"negative min pool coin amount",
+			func(msg *types.MsgJoinWeightedPool) {
+				msg.MinPoolCoinAmount = sdk.NewInt(-1)
+			},
+			"min pool coin amount must not be negative: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgJoinWeightedPool(1, testAddr, 1, utils.ParseCoins("1000000denom1,1000000denom2,1000000denom3"), sdk.ZeroInt())
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgJoinWeightedPool, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetDepositor(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgExitWeightedPool(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgExitWeightedPool)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgExitWeightedPool) {},
+			"", // empty means no error expected
+		},
+		{
+			"proportional exit",
+			func(msg *types.MsgExitWeightedPool) {
+				msg.DemandCoinDenom = ""
+			},
+			"",
+		},
+		{
+			"invalid withdrawer",
+			func(msg *types.MsgExitWeightedPool) {
+				msg.Withdrawer = "invalidaddr"
+			},
+			"invalid withdrawer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgExitWeightedPool) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"invalid pool coin",
+			func(msg *types.MsgExitWeightedPool) {
+				msg.PoolCoin = utils.ParseCoin("0pool1")
+			},
+			"pool coin must be positive: invalid request",
+		},
+		{
+			"negative min demand coin amount",
+			func(msg *types.MsgExitWeightedPool) {
+				msg.MinDemandCoinAmount = sdk.NewInt(-1)
+			},
+			"min demand coin amount must not be negative: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgExitWeightedPool(1, testAddr, 1, utils.ParseCoin("1000000pool1"), "denom1", sdk.ZeroInt())
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgExitWeightedPool, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetWithdrawer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ const (
 	FeeCollectorAddressPrefix = "FeeCollectorAddress"
 
 	PoolReserveAddressPrefix          = "PoolReserveAddress"
+	PoolFeeCollectorAddressPrefix     = "PoolFeeCollectorAddress"
 	PairSwapFeeCollectorAddressPrefix = "PairSwapFeeCollectorAddress"
 	PairEscrowAddressPrefix           = "PairEscrowAddress"
 	ModuleAddressNameSplitter         = "|"
@@ -26,6 +27,10 @@ const (
 	UnfarmGas          = sdk.Gas(69000)
 )
 
+// UnwindPoolCoinMaxSlippage is how much less than the fair value of a pool
+// coin, fees included, unwinding the pool coin may withdraw.
+var UnwindPoolCoinMaxSlippage = sdk.NewDecWithPrec(10, 2)
+
 // GlobalEscrowAddress is an escrow for deposit/withdraw requests.
 var GlobalEscrowAddress = DeriveAddress(AddressType32Bytes, ModuleName, "GlobalEscrow")
 
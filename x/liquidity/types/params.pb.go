@@ -88,6 +88,7 @@ type GenericParams struct {
 	AppId                        uint64                                   `protobuf:"varint,18,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
 	MaxNumMarketMakingOrderTicks uint64                                   `protobuf:"varint,19,opt,name=max_num_market_making_order_ticks,json=maxNumMarketMakingOrderTicks,proto3" json:"max_num_market_making_order_ticks,omitempty"`
 	MaxNumActivePoolsPerPair     uint64                                   `protobuf:"varint,20,opt,name=max_num_active_pools_per_pair,json=maxNumActivePoolsPerPair,proto3" json:"max_num_active_pools_per_pair,omitempty"`
+	FarmingLockBoosts            []FarmingLockBoost                       `protobuf:"bytes,21,rep,name=farming_lock_boosts,json=farmingLockBoosts,proto3" json:"farming_lock_boosts"`
 }
 
 func (m *GenericParams) Reset()         { *m = GenericParams{} }
@@ -123,9 +124,50 @@ func (m *GenericParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericParams proto.InternalMessageInfo
 
+// FarmingLockBoost defines the reward boost multiplier for pool coins farmed
+// with the lock duration.
+type FarmingLockBoost struct {
+	LockDuration    time.Duration                          `protobuf:"bytes,1,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration"`
+	BoostMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=boost_multiplier,json=boostMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"boost_multiplier"`
+}
+
+func (m *FarmingLockBoost) Reset()         { *m = FarmingLockBoost{} }
+func (m *FarmingLockBoost) String() string { return proto.CompactTextString(m) }
+func (*FarmingLockBoost) ProtoMessage()    {}
+func (*FarmingLockBoost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_babec35f52b1356c, []int{2}
+}
+func (m *FarmingLockBoost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FarmingLockBoost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FarmingLockBoost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FarmingLockBoost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FarmingLockBoost.Merge(m, src)
+}
+func (m *FarmingLockBoost) XXX_Size() int {
+	return m.Size()
+}
+func (m *FarmingLockBoost) XXX_DiscardUnknown() {
+	xxx_messageInfo_FarmingLockBoost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FarmingLockBoost proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "comdex.liquidity.v1beta1.Params")
 	proto.RegisterType((*GenericParams)(nil), "comdex.liquidity.v1beta1.GenericParams")
+	proto.RegisterType((*FarmingLockBoost)(nil), "comdex.liquidity.v1beta1.FarmingLockBoost")
 }
 
 func init() {
@@ -133,59 +175,64 @@ func init() {
 }
 
 var fileDescriptor_babec35f52b1356c = []byte{
-	// 821 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x6d, 0xda, 0x86, 0x64, 0x52, 0xd7, 0xc9, 0x34, 0x81, 0x21, 0x6a, 0x9d, 0x50, 0xa9,
-	0x28, 0x97, 0xee, 0xd2, 0x96, 0x3b, 0x8a, 0x63, 0x1a, 0x45, 0x6a, 0xc1, 0xdd, 0x22, 0x0e, 0x05,
-	0x69, 0x34, 0xde, 0x7d, 0x76, 0x9e, 0xbc, 0xb3, 0x33, 0xcc, 0xcc, 0x36, 0x4e, 0x3f, 0x05, 0x47,
-	0x3e, 0x03, 0x77, 0xbe, 0x43, 0x8e, 0x3d, 0x22, 0x0e, 0x2d, 0x24, 0x5f, 0x04, 0xcd, 0xec, 0xae,
-	0x63, 0x04, 0x07, 0x6a, 0x89, 0x93, 0xbd, 0xf3, 0xde, 0xfb, 0xfd, 0x77, 0xde, 0x7f, 0xe6, 0x2d,
-	0xb9, 0x9f, 0x2a, 0x99, 0xc1, 0x2c, 0xce, 0xf1, 0xc7, 0x12, 0x33, 0x74, 0x67, 0xf1, 0xab, 0x87,
-	0x23, 0x70, 0xe2, 0x61, 0xac, 0x85, 0x11, 0xd2, 0x46, 0xda, 0x28, 0xa7, 0x28, 0xab, 0xd2, 0xa2,
-	0x79, 0x5a, 0x54, 0xa7, 0xed, 0x6c, 0x4d, 0xd4, 0x44, 0x85, 0xa4, 0xd8, 0xff, 0xab, 0xf2, 0x77,
-	0x7a, 0xa9, 0xb2, 0x52, 0xd9, 0x78, 0x24, 0x2c, 0xcc, 0x89, 0xa9, 0xc2, 0xa2, 0x89, 0x4f, 0x94,
-	0x9a, 0xe4, 0x10, 0x87, 0xa7, 0x51, 0x39, 0x8e, 0xb3, 0xd2, 0x08, 0x87, 0xaa, 0x8e, 0xdf, 0x5b,
-	0x25, 0x2b, 0xc3, 0xa0, 0x7f, 0xef, 0xd7, 0x9b, 0xa4, 0x73, 0x04, 0x05, 0x18, 0x4c, 0xab, 0x15,
-	0x7a, 0x97, 0x90, 0x91, 0x70, 0xe9, 0x09, 0xb7, 0xf8, 0x1a, 0x58, 0x7b, 0xaf, 0xbd, 0x7f, 0x3d,
-	0x59, 0x0b, 0x2b, 0x2f, 0xf0, 0x35, 0xd0, 0xfb, 0xe4, 0x96, 0xc3, 0x74, 0xca, 0xb5, 0x81, 0x14,
-	0x2d, 0xaa, 0x82, 0x7d, 0x10, 0x52, 0x3a, 0x7e, 0x75, 0xd8, 0x2c, 0xd2, 0x47, 0x64, 0x7b, 0x0c,
-	0xc0, 0x53, 0x95, 0xe7, 0x90, 0x3a, 0x65, 0xb8, 0xc8, 0x32, 0x03, 0xd6, 0xb2, 0x6b, 0x7b, 0xed,
-	0xfd, 0xb5, 0xe4, 0xf6, 0x18, 0xe0, 0xb0, 0x89, 0x1d, 0x54, 0x21, 0xfa, 0x05, 0xf9, 0x28, 0x2b,
-	0xad, 0xfb, 0x97, 0xa2, 0xeb, 0xa1, 0x68, 0xcb, 0x47, 0xff, 0x51, 0x55, 0x90, 0x3b, 0x12, 0x0b,
-	0x8e, 0x05, 0x3a, 0x14, 0x39, 0xd7, 0x4a, 0xe5, 0xdc, 0xb7, 0x82, 0xdb, 0x52, 0xeb, 0xfc, 0x8c,
-	0xdd, 0xf0, 0xb5, 0xfd, 0xe8, 0xfc, 0xed, 0x6e, 0xeb, 0xf7, 0xb7, 0xbb, 0x9f, 0x4d, 0xd0, 0x9d,
-	0x94, 0xa3, 0x28, 0x55, 0x32, 0xae, 0x9b, 0x58, 0xfd, 0x3c, 0xb0, 0xd9, 0x34, 0x76, 0x67, 0x1a,
-	0x6c, 0x74, 0x5c, 0xb8, 0x84, 0x49, 0x2c, 0x8e, 0x2b, 0xe4, 0x50, 0xa9, 0xfc, 0x50, 0x61, 0xf1,
-	0x22, 0xf0, 0xe8, 0x29, 0xd9, 0xd4, 0x02, 0x0d, 0x4f, 0x0d, 0x84, 0x96, 0xf2, 0x31, 0x00, 0x5b,
-	0xd9, 0xbb, 0xb6, 0xbf, 0xfe, 0xe8, 0x93, 0xa8, 0x62, 0x45, 0xde, 0x97, 0xc6, 0xc2, 0xc8, 0xd7,
-	0xf6, 0x3f, 0xf7, 0xfa, 0xbf, 0xbc, 0xdb, 0xdd, 0xff, 0x0f, 0xfa, 0xbe, 0xc0, 0x26, 0x5d, 0xaf,
-	0x72, 0x58, 0x8b, 0x3c, 0x01, 0x08, 0xc2, 0x61, 0x73, 0x8b, 0xc2, 0x1f, 0xfe, 0x1f, 0xc2, 0x7e,
-	0xc3, 0x0b, 0xc2, 0x53, 0xb2, 0xb3, 0xd8, 0xe1, 0x0c, 0xb4, 0xb2, 0xe8, 0xb8, 0x90, 0xaa, 0x2c,
-	0x1c, 0x5b, 0x5d, 0xaa, 0xbf, 0x1f, 0x5f, 0xf5, 0x77, 0x50, 0xf1, 0x0e, 0x02, 0x8e, 0x0a, 0xb2,
-	0x2d, 0xc5, 0x8c, 0x6b, 0x83, 0x29, 0xf0, 0x1c, 0x25, 0x3a, 0x1e, 0x8e, 0x2e, 0x5b, 0x7b, 0x6f,
-	0x9d, 0x01, 0xa4, 0x09, 0x95, 0x62, 0x36, 0xf4, 0xac, 0xa7, 0x1e, 0x95, 0x78, 0x12, 0x7d, 0x4e,
-	0xfc, 0x2a, 0x57, 0x26, 0x03, 0xc3, 0x73, 0x1c, 0x83, 0xd5, 0xa2, 0x60, 0x64, 0xaf, 0x1d, 0x3a,
-	0x59, 0x5d, 0x9d, 0xa8, 0xb9, 0x3a, 0xd1, 0xa0, 0xbe, 0x3a, 0xfd, 0x55, 0x2f, 0xfd, 0xf3, 0xbb,
-	0xdd, 0x76, 0xb2, 0x21, 0xc5, 0xec, 0x1b, 0x5f, 0xfd, 0xb4, 0x2e, 0xa6, 0x09, 0xe9, 0xd8, 0x53,
-	0xa1, 0xbd, 0x25, 0xfe, 0x75, 0x81, 0xad, 0x2f, 0xf5, 0xb6, 0xeb, 0x1e, 0xf2, 0x04, 0x20, 0x11,
-	0x0e, 0xe8, 0x4b, 0xb2, 0x79, 0x8a, 0xee, 0x24, 0x33, 0xe2, 0xf4, 0x8a, 0x7b, 0x73, 0x29, 0x6e,
-	0xb7, 0x01, 0x2d, 0xb0, 0x1b, 0x1b, 0x61, 0xe6, 0x8c, 0xe0, 0x13, 0x61, 0x59, 0xc7, 0x5f, 0xe4,
-	0xf7, 0x62, 0x1f, 0x09, 0x9b, 0x74, 0x6b, 0xd0, 0x57, 0x9e, 0x73, 0x24, 0x2c, 0xfd, 0x81, 0xd0,
-	0xf9, 0x7b, 0x5f, 0xc1, 0x6f, 0x2d, 0x05, 0xdf, 0x68, 0x48, 0x73, 0xfa, 0x77, 0xa4, 0x5b, 0x19,
-	0x77, 0x85, 0xee, 0x2e, 0x85, 0xee, 0x04, 0xcc, 0x9c, 0x1b, 0x93, 0xad, 0xb9, 0x83, 0x19, 0x5a,
-	0x67, 0x78, 0x06, 0x85, 0x92, 0x6c, 0x23, 0x8c, 0x9e, 0xcd, 0xda, 0x98, 0x81, 0x8f, 0x0c, 0x7c,
-	0x80, 0x7e, 0x4f, 0xe8, 0xbc, 0x60, 0x54, 0x9a, 0xa2, 0xf2, 0x67, 0x73, 0x39, 0x7f, 0x6a, 0x7c,
-	0xbf, 0x34, 0x45, 0xf0, 0x67, 0x9b, 0xac, 0x08, 0xad, 0x39, 0x66, 0x8c, 0x86, 0xe9, 0x7a, 0x43,
-	0x68, 0x7d, 0x9c, 0xd1, 0x23, 0xf2, 0xa9, 0x3f, 0xb9, 0x45, 0x29, 0xb9, 0x14, 0x66, 0x0a, 0x8e,
-	0x4b, 0x31, 0xc5, 0x62, 0x52, 0x9f, 0x65, 0x3f, 0x82, 0x2d, 0xbb, 0x1d, 0x2a, 0xee, 0x48, 0x31,
-	0xfb, 0xba, 0x94, 0xcf, 0x42, 0xda, 0xb3, 0x90, 0x15, 0x8e, 0xec, 0xb7, 0x3e, 0x87, 0x7e, 0x49,
-	0xee, 0x36, 0x20, 0x91, 0x3a, 0x7c, 0x05, 0x61, 0x6e, 0x5a, 0xae, 0xc1, 0x70, 0x3f, 0x77, 0xd8,
-	0x56, 0x80, 0xb0, 0x0a, 0x72, 0x10, 0x52, 0xfc, 0x1c, 0xb4, 0x43, 0x30, 0x43, 0x81, 0xa6, 0xff,
-	0xfc, 0xfc, 0xcf, 0x5e, 0xeb, 0xfc, 0xa2, 0xd7, 0x7e, 0x73, 0xd1, 0x6b, 0xff, 0x71, 0xd1, 0x6b,
-	0xff, 0x74, 0xd9, 0x6b, 0xbd, 0xb9, 0xec, 0xb5, 0x7e, 0xbb, 0xec, 0xb5, 0x5e, 0x3e, 0xfe, 0xdb,
-	0xbe, 0xfd, 0xa7, 0xed, 0x81, 0x1a, 0x8f, 0x31, 0x45, 0x91, 0xd7, 0xcf, 0xf1, 0xe2, 0x37, 0x31,
-	0x34, 0x62, 0xb4, 0x12, 0xae, 0xdc, 0xe3, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x51, 0xea, 0x47,
-	0xa8, 0x34, 0x07, 0x00, 0x00,
+	// 907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xd1, 0x6e, 0x1b, 0x45,
+	0x14, 0x86, 0xbd, 0x6d, 0x1a, 0x92, 0x49, 0x5d, 0xdb, 0x93, 0x04, 0x86, 0xa8, 0x75, 0x42, 0xa4,
+	0xa2, 0x08, 0xa9, 0xbb, 0xb4, 0xe5, 0x1e, 0xc5, 0x31, 0x09, 0x91, 0x12, 0x70, 0xb7, 0x08, 0x89,
+	0x82, 0x34, 0x8c, 0x77, 0xcf, 0x3a, 0x23, 0xef, 0xec, 0x2c, 0x33, 0xb3, 0x8d, 0xd3, 0xa7, 0xe0,
+	0x0e, 0x9e, 0x81, 0x17, 0xe0, 0x15, 0x72, 0xd9, 0x4b, 0xc4, 0x45, 0x0b, 0xc9, 0x8b, 0xa0, 0x99,
+	0xdd, 0x75, 0x4c, 0x01, 0xa9, 0xb1, 0xc4, 0x55, 0xb2, 0x73, 0xce, 0xff, 0xfd, 0xb3, 0xe7, 0xcc,
+	0x9c, 0x35, 0xba, 0x1f, 0x49, 0x11, 0xc3, 0x24, 0x48, 0xf9, 0x0f, 0x05, 0x8f, 0xb9, 0x39, 0x0b,
+	0x9e, 0x3f, 0x1c, 0x82, 0x61, 0x0f, 0x83, 0x9c, 0x29, 0x26, 0xb4, 0x9f, 0x2b, 0x69, 0x24, 0x26,
+	0x65, 0x9a, 0x3f, 0x4d, 0xf3, 0xab, 0xb4, 0x8d, 0xb5, 0x91, 0x1c, 0x49, 0x97, 0x14, 0xd8, 0xff,
+	0xca, 0xfc, 0x8d, 0x6e, 0x24, 0xb5, 0x90, 0x3a, 0x18, 0x32, 0x0d, 0x53, 0x62, 0x24, 0x79, 0x56,
+	0xc7, 0x47, 0x52, 0x8e, 0x52, 0x08, 0xdc, 0xd3, 0xb0, 0x48, 0x82, 0xb8, 0x50, 0xcc, 0x70, 0x59,
+	0xc5, 0xb7, 0x97, 0xd0, 0xe2, 0xc0, 0xf9, 0x6f, 0xff, 0xd4, 0x44, 0xcd, 0x03, 0xc8, 0x40, 0xf1,
+	0xa8, 0x5c, 0xc1, 0xf7, 0x10, 0x1a, 0x32, 0x13, 0x9d, 0x50, 0xcd, 0x5f, 0x00, 0xf1, 0xb6, 0xbc,
+	0x9d, 0x85, 0x70, 0xd9, 0xad, 0x3c, 0xe5, 0x2f, 0x00, 0xdf, 0x47, 0x77, 0x0c, 0x8f, 0xc6, 0x34,
+	0x57, 0x10, 0x71, 0xcd, 0x65, 0x46, 0x6e, 0xb8, 0x94, 0xa6, 0x5d, 0x1d, 0xd4, 0x8b, 0xf8, 0x11,
+	0x5a, 0x4f, 0x00, 0x68, 0x24, 0xd3, 0x14, 0x22, 0x23, 0x15, 0x65, 0x71, 0xac, 0x40, 0x6b, 0x72,
+	0x73, 0xcb, 0xdb, 0x59, 0x0e, 0x57, 0x13, 0x80, 0xbd, 0x3a, 0xb6, 0x5b, 0x86, 0xf0, 0x27, 0xe8,
+	0xdd, 0xb8, 0xd0, 0xe6, 0x5f, 0x44, 0x0b, 0x4e, 0xb4, 0x66, 0xa3, 0xff, 0x50, 0x65, 0xe8, 0xae,
+	0xe0, 0x19, 0xe5, 0x19, 0x37, 0x9c, 0xa5, 0x34, 0x97, 0x32, 0xa5, 0xb6, 0x14, 0x54, 0x17, 0x79,
+	0x9e, 0x9e, 0x91, 0x5b, 0x56, 0xdb, 0xf3, 0xcf, 0x5f, 0x6d, 0x36, 0x7e, 0x7f, 0xb5, 0xf9, 0xe1,
+	0x88, 0x9b, 0x93, 0x62, 0xe8, 0x47, 0x52, 0x04, 0x55, 0x11, 0xcb, 0x3f, 0x0f, 0x74, 0x3c, 0x0e,
+	0xcc, 0x59, 0x0e, 0xda, 0x3f, 0xcc, 0x4c, 0x48, 0x04, 0xcf, 0x0e, 0x4b, 0xe4, 0x40, 0xca, 0x74,
+	0x4f, 0xf2, 0xec, 0xa9, 0xe3, 0xe1, 0x53, 0xd4, 0xc9, 0x19, 0x57, 0x34, 0x52, 0xe0, 0x4a, 0x4a,
+	0x13, 0x00, 0xb2, 0xb8, 0x75, 0x73, 0x67, 0xe5, 0xd1, 0xfb, 0x7e, 0xc9, 0xf2, 0x6d, 0x5f, 0xea,
+	0x16, 0xfa, 0x56, 0xdb, 0xfb, 0xd8, 0xfa, 0xff, 0xf2, 0x7a, 0x73, 0xe7, 0x2d, 0xfc, 0xad, 0x40,
+	0x87, 0x2d, 0xeb, 0xb2, 0x57, 0x99, 0xec, 0x03, 0x38, 0x63, 0xf7, 0x72, 0xb3, 0xc6, 0xef, 0xfc,
+	0x1f, 0xc6, 0xf6, 0x85, 0x67, 0x8c, 0xc7, 0x68, 0x63, 0xb6, 0xc2, 0x31, 0xe4, 0x52, 0x73, 0x43,
+	0x99, 0x90, 0x45, 0x66, 0xc8, 0xd2, 0x5c, 0xf5, 0x7d, 0xef, 0xaa, 0xbe, 0xfd, 0x92, 0xb7, 0xeb,
+	0x70, 0x98, 0xa1, 0x75, 0xc1, 0x26, 0x34, 0x57, 0x3c, 0x02, 0x9a, 0x72, 0xc1, 0x0d, 0x75, 0x47,
+	0x97, 0x2c, 0x5f, 0xdb, 0xa7, 0x0f, 0x51, 0x88, 0x05, 0x9b, 0x0c, 0x2c, 0xeb, 0xc8, 0xa2, 0x42,
+	0x4b, 0xc2, 0x4f, 0x90, 0x5d, 0xa5, 0x52, 0xc5, 0xa0, 0x68, 0xca, 0x13, 0xd0, 0x39, 0xcb, 0x08,
+	0xda, 0xf2, 0x5c, 0x25, 0xcb, 0xab, 0xe3, 0xd7, 0x57, 0xc7, 0xef, 0x57, 0x57, 0xa7, 0xb7, 0x64,
+	0xad, 0x7f, 0x7e, 0xbd, 0xe9, 0x85, 0x6d, 0xc1, 0x26, 0x5f, 0x5a, 0xf5, 0x51, 0x25, 0xc6, 0x21,
+	0x6a, 0xea, 0x53, 0x96, 0xdb, 0x96, 0xd8, 0xed, 0x02, 0x59, 0x99, 0x6b, 0xb7, 0x2b, 0x16, 0xb2,
+	0x0f, 0x10, 0x32, 0x03, 0xf8, 0x19, 0xea, 0x9c, 0x72, 0x73, 0x12, 0x2b, 0x76, 0x7a, 0xc5, 0xbd,
+	0x3d, 0x17, 0xb7, 0x55, 0x83, 0x66, 0xd8, 0x75, 0x1b, 0x61, 0x62, 0x14, 0xa3, 0x23, 0xa6, 0x49,
+	0xd3, 0x5e, 0xe4, 0x6b, 0xb1, 0x0f, 0x98, 0x0e, 0x5b, 0x15, 0xe8, 0x33, 0xcb, 0x39, 0x60, 0x1a,
+	0x7f, 0x87, 0xf0, 0x74, 0xdf, 0x57, 0xf0, 0x3b, 0x73, 0xc1, 0xdb, 0x35, 0x69, 0x4a, 0xff, 0x1a,
+	0xb5, 0xca, 0xc6, 0x5d, 0xa1, 0x5b, 0x73, 0xa1, 0x9b, 0x0e, 0x33, 0xe5, 0x06, 0x68, 0x6d, 0xda,
+	0xc1, 0x98, 0x6b, 0xa3, 0x68, 0x0c, 0x99, 0x14, 0xa4, 0xed, 0x46, 0x4f, 0xa7, 0x6a, 0x4c, 0xdf,
+	0x46, 0xfa, 0x36, 0x80, 0xbf, 0x45, 0x78, 0x2a, 0x18, 0x16, 0x2a, 0x2b, 0xfb, 0xd3, 0x99, 0xaf,
+	0x3f, 0x15, 0xbe, 0x57, 0xa8, 0xcc, 0xf5, 0x67, 0x1d, 0x2d, 0xb2, 0x3c, 0xa7, 0x3c, 0x26, 0xd8,
+	0x4d, 0xd7, 0x5b, 0x2c, 0xcf, 0x0f, 0x63, 0x7c, 0x80, 0x3e, 0xb0, 0x27, 0x37, 0x2b, 0x04, 0x15,
+	0x4c, 0x8d, 0xc1, 0x50, 0xc1, 0xc6, 0x3c, 0x1b, 0x55, 0x67, 0xd9, 0x8e, 0x60, 0x4d, 0x56, 0x9d,
+	0xe2, 0xae, 0x60, 0x93, 0x2f, 0x0a, 0x71, 0xec, 0xd2, 0x8e, 0x5d, 0x96, 0x3b, 0xb2, 0x5f, 0xd9,
+	0x1c, 0xfc, 0x29, 0xba, 0x57, 0x83, 0x58, 0x64, 0xf8, 0x73, 0x70, 0x73, 0x53, 0xd3, 0x1c, 0x14,
+	0xb5, 0x73, 0x87, 0xac, 0x39, 0x08, 0x29, 0x21, 0xbb, 0x2e, 0xc5, 0xce, 0x41, 0x3d, 0x00, 0x35,
+	0x60, 0x5c, 0xe1, 0xef, 0xd1, 0x6a, 0xc2, 0x94, 0xb0, 0xde, 0xa9, 0x8c, 0xc6, 0x74, 0x28, 0xa5,
+	0x36, 0x9a, 0xac, 0xbb, 0x71, 0xf4, 0x91, 0xff, 0x5f, 0xdf, 0x33, 0x7f, 0xbf, 0x14, 0x1d, 0xc9,
+	0x68, 0xdc, 0xb3, 0x92, 0xde, 0x82, 0x2d, 0x55, 0xd8, 0x49, 0xde, 0x58, 0xd7, 0xdb, 0xbf, 0x7a,
+	0xa8, 0xfd, 0x66, 0x36, 0xfe, 0x1c, 0x35, 0x9d, 0x5d, 0xfd, 0x3d, 0x23, 0xde, 0xdb, 0xdf, 0xda,
+	0xdb, 0x56, 0x59, 0xaf, 0xe3, 0x6f, 0x50, 0xdb, 0xed, 0x99, 0x8a, 0x22, 0x35, 0x3c, 0x4f, 0x39,
+	0x28, 0x72, 0x63, 0xbe, 0xe6, 0x39, 0xce, 0xf1, 0x14, 0xd3, 0x7b, 0x72, 0xfe, 0x67, 0xb7, 0x71,
+	0x7e, 0xd1, 0xf5, 0x5e, 0x5e, 0x74, 0xbd, 0x3f, 0x2e, 0xba, 0xde, 0x8f, 0x97, 0xdd, 0xc6, 0xcb,
+	0xcb, 0x6e, 0xe3, 0xb7, 0xcb, 0x6e, 0xe3, 0xd9, 0xe3, 0xbf, 0x61, 0x6d, 0x99, 0x1e, 0xc8, 0x24,
+	0xe1, 0x11, 0x67, 0x69, 0xf5, 0x1c, 0xcc, 0xfe, 0x5e, 0x70, 0x3e, 0xc3, 0x45, 0xf7, 0x62, 0x8f,
+	0xff, 0x1a, 0x00, 0xd9, 0xda, 0x49, 0xc9, 0x50, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -231,6 +278,22 @@ func (m *GenericParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FarmingLockBoosts) > 0 {
+		for iNdEx := len(m.FarmingLockBoosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FarmingLockBoosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
 	if m.MaxNumActivePoolsPerPair != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxNumActivePoolsPerPair))
 		i--
@@ -401,6 +464,47 @@ func (m *GenericParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FarmingLockBoost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FarmingLockBoost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FarmingLockBoost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BoostMultiplier.Size()
+		i -= size
+		if _, err := m.BoostMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -489,6 +593,25 @@ func (m *GenericParams) Size() (n int) {
 	if m.MaxNumActivePoolsPerPair != 0 {
 		n += 2 + sovParams(uint64(m.MaxNumActivePoolsPerPair))
 	}
+	if len(m.FarmingLockBoosts) > 0 {
+		for _, e := range m.FarmingLockBoosts {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FarmingLockBoost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.BoostMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1130,6 +1253,157 @@ func (m *GenericParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingLockBoosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingLockBoosts = append(m.FarmingLockBoosts, FarmingLockBoost{})
+			if err := m.FarmingLockBoosts[len(m.FarmingLockBoosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FarmingLockBoost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FarmingLockBoost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FarmingLockBoost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BoostMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	utils "github.com/comdex-official/comdex/types"
 	"github.com/comdex-official/comdex/x/liquidity/amm"
 )
 
@@ -41,6 +42,16 @@ func PoolReserveAddress(appID, poolID uint64) sdk.AccAddress {
 	)
 }
 
+// PoolFeeCollectorAddress returns a unique address for each concentrated pool
+// where the fees accrued to the pool's positions are kept.
+func PoolFeeCollectorAddress(appID, poolID uint64) sdk.AccAddress {
+	return DeriveAddress(
+		AddressType32Bytes,
+		ModuleName,
+		strings.Join([]string{PoolFeeCollectorAddressPrefix, strconv.FormatUint(appID, 10), strconv.FormatUint(poolID, 10)}, ModuleAddressNameSplitter),
+	)
+}
+
 // PoolCoinDenom returns a unique pool coin denom for a pool.
 func PoolCoinDenom(appID, poolID uint64) string {
 	return fmt.Sprintf("pool%d-%d", appID, poolID)
@@ -97,6 +108,70 @@ func NewRangedPool(appID, id, pairID uint64, creator sdk.AccAddress, minPrice, m
 	}
 }
 
+// NewConcentratedPool returns a new concentrated pool object at the
+// initial price.
+func NewConcentratedPool(appID, id, pairID uint64, creator sdk.AccAddress, feeRate, initialPrice sdk.Dec) Pool {
+	sqrtPrice := utils.DecApproxSqrt(initialPrice)
+	activeLiquidity := sdk.ZeroDec()
+	return Pool{
+		Type:                  PoolTypeConcentrated,
+		Id:                    id,
+		PairId:                pairID,
+		Creator:               creator.String(),
+		ReserveAddress:        PoolReserveAddress(appID, id).String(),
+		PoolCoinDenom:         PoolCoinDenom(appID, id),
+		FeeRate:               &feeRate,
+		SqrtPrice:             &sqrtPrice,
+		ActiveLiquidity:       &activeLiquidity,
+		LastDepositRequestId:  0,
+		LastWithdrawRequestId: 0,
+		LastPositionId:        0,
+		Disabled:              false,
+		AppId:                 appID,
+	}
+}
+
+// NewWeightedPool returns a new weighted pool object.
+// A weighted pool isn't bound to a pair.
+func NewWeightedPool(appID, id uint64, creator sdk.AccAddress, assets []WeightedPoolAsset) Pool {
+	return Pool{
+		Type:                  PoolTypeWeighted,
+		Id:                    id,
+		PairId:                0,
+		Creator:               creator.String(),
+		ReserveAddress:        PoolReserveAddress(appID, id).String(),
+		PoolCoinDenom:         PoolCoinDenom(appID, id),
+		LastDepositRequestId:  0,
+		LastWithdrawRequestId: 0,
+		Disabled:              false,
+		AppId:                 appID,
+		WeightedAssets:        assets,
+	}
+}
+
+// ValidateWeightedPoolAssets validates the assets of a weighted pool.
+func ValidateWeightedPoolAssets(assets []WeightedPoolAsset) error {
+	if len(assets) < amm.MinWeightedPoolAssets || len(assets) > amm.MaxWeightedPoolAssets {
+		return fmt.Errorf(
+			"number of assets must be between %d and %d: %d",
+			amm.MinWeightedPoolAssets, amm.MaxWeightedPoolAssets, len(assets))
+	}
+	denoms := make(map[string]struct{})
+	for _, asset := range assets {
+		if err := sdk.ValidateDenom(asset.Denom); err != nil {
+			return fmt.Errorf("invalid asset denom: %w", err)
+		}
+		if _, ok := denoms[asset.Denom]; ok {
+			return fmt.Errorf("duplicate asset denom: %s", asset.Denom)
+		}
+		denoms[asset.Denom] = struct{}{}
+		if err := amm.ValidateWeightedPoolWeight(asset.Weight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (pool Pool) GetCreator() sdk.AccAddress {
 	if pool.Creator == "" {
 		return nil
@@ -121,7 +196,14 @@ func (pool Pool) Validate() error {
 	if pool.Id == 0 {
 		return fmt.Errorf("pool id must not be 0")
 	}
-	if pool.PairId == 0 {
+	if pool.Type == PoolTypeWeighted {
+		if pool.PairId != 0 {
+			return fmt.Errorf("pair id must be 0 for a weighted pool")
+		}
+		if err := ValidateWeightedPoolAssets(pool.WeightedAssets); err != nil {
+			return err
+		}
+	} else if pool.PairId == 0 {
 		return fmt.Errorf("pair id must not be 0")
 	}
 	if _, err := sdk.AccAddressFromBech32(pool.ReserveAddress); err != nil {
@@ -130,9 +212,29 @@ func (pool Pool) Validate() error {
 	if err := sdk.ValidateDenom(pool.PoolCoinDenom); err != nil {
 		return fmt.Errorf("invalid pool coin denom: %w", err)
 	}
+	if pool.Type == PoolTypeConcentrated {
+		if pool.FeeRate == nil {
+			return fmt.Errorf("fee rate must not be nil for a concentrated pool")
+		}
+		if err := ValidateConcentratedPoolFeeRate(*pool.FeeRate); err != nil {
+			return err
+		}
+		if pool.SqrtPrice == nil || !pool.SqrtPrice.IsPositive() {
+			return fmt.Errorf("sqrt price must be positive for a concentrated pool")
+		}
+		if pool.ActiveLiquidity == nil || pool.ActiveLiquidity.IsNegative() {
+			return fmt.Errorf("active liquidity must not be negative for a concentrated pool")
+		}
+	}
 	return nil
 }
 
+// GetFeeCollectorAddress returns the address where the fees accrued to
+// the pool's positions are kept.
+func (pool Pool) GetFeeCollectorAddress() sdk.AccAddress {
+	return PoolFeeCollectorAddress(pool.AppId, pool.Id)
+}
+
 // AMMPool constructs amm.Pool interface from Pool.
 func (pool Pool) AMMPool(rx, ry, ps sdk.Int) amm.Pool {
 	switch pool.Type {
@@ -145,6 +247,52 @@ func (pool Pool) AMMPool(rx, ry, ps sdk.Int) amm.Pool {
 	}
 }
 
+// ConcentratedAMMPool constructs amm.ConcentratedPool from Pool and
+// its positions at the price kept in the pool's state.
+func (pool Pool) ConcentratedAMMPool(rx, ry sdk.Int, positions []Position) *amm.ConcentratedPool {
+	liquidities := make([]amm.ConcentratedLiquidity, 0, len(positions))
+	for _, position := range positions {
+		liquidities = append(liquidities, position.ConcentratedLiquidity())
+	}
+	sqrtPrice := sdk.Dec{}
+	if pool.SqrtPrice != nil {
+		sqrtPrice = *pool.SqrtPrice
+	}
+	return amm.NewConcentratedPool(rx, ry, liquidities, *pool.FeeRate, sqrtPrice)
+}
+
+// TotalWeight returns the sum of the weights of a weighted pool's assets.
+func (pool Pool) TotalWeight() (total uint64) {
+	for _, asset := range pool.WeightedAssets {
+		total += asset.Weight
+	}
+	return
+}
+
+// WeightedAsset returns the weighted pool asset of the denom.
+func (pool Pool) WeightedAsset(denom string) (asset WeightedPoolAsset, found bool) {
+	for _, asset := range pool.WeightedAssets {
+		if asset.Denom == denom {
+			return asset, true
+		}
+	}
+	return
+}
+
+// WeightedAMMPool constructs the amm.WeightedPool view of a weighted pool
+// for a pair with the given base and quote coin balances.
+func (pool Pool) WeightedAMMPool(baseCoin, quoteCoin sdk.Coin, ps sdk.Int) (*amm.WeightedPool, bool) {
+	baseAsset, found := pool.WeightedAsset(baseCoin.Denom)
+	if !found {
+		return nil, false
+	}
+	quoteAsset, found := pool.WeightedAsset(quoteCoin.Denom)
+	if !found {
+		return nil, false
+	}
+	return amm.NewWeightedPool(quoteCoin.Amount, baseCoin.Amount, quoteAsset.Weight, baseAsset.Weight, ps), true
+}
+
 type PoolOrderer struct {
 	amm.Pool
 	ID                            uint64
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/liquidity/amm"
+)
+
+// NewPosition returns a new position object.
+func NewPosition(appID, poolID, id uint64, owner sdk.AccAddress, minPrice, maxPrice, liquidity sdk.Dec) Position {
+	return Position{
+		Id:          id,
+		PoolId:      poolID,
+		AppId:       appID,
+		Owner:       owner.String(),
+		MinPrice:    minPrice,
+		MaxPrice:    maxPrice,
+		Liquidity:   liquidity,
+		AccruedFees: sdk.Coins{},
+	}
+}
+
+// ValidateConcentratedPoolFeeRate validates the fee rate of a concentrated pool.
+func ValidateConcentratedPoolFeeRate(feeRate sdk.Dec) error {
+	if feeRate.IsNil() {
+		return fmt.Errorf("fee rate must not be nil")
+	}
+	if feeRate.IsNegative() {
+		return fmt.Errorf("fee rate must not be negative: %s", feeRate)
+	}
+	if feeRate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("fee rate must be less than 1: %s", feeRate)
+	}
+	return nil
+}
+
+func (position Position) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(position.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// ConcentratedLiquidity returns the liquidity the position provides to
+// the pool's curve.
+func (position Position) ConcentratedLiquidity() amm.ConcentratedLiquidity {
+	return amm.ConcentratedLiquidity{
+		Liquidity: position.Liquidity,
+		MinPrice:  position.MinPrice,
+		MaxPrice:  position.MaxPrice,
+	}
+}
+
+// Validate validates Position for genesis.
+func (position Position) Validate() error {
+	if position.Id == 0 {
+		return fmt.Errorf("position id must not be 0")
+	}
+	if position.PoolId == 0 {
+		return fmt.Errorf("pool id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(position.Owner); err != nil {
+		return fmt.Errorf("invalid owner address %s: %w", position.Owner, err)
+	}
+	if err := amm.ValidateConcentratedRange(position.MinPrice, position.MaxPrice); err != nil {
+		return err
+	}
+	if !position.Liquidity.IsPositive() {
+		return fmt.Errorf("liquidity must be positive: %s", position.Liquidity)
+	}
+	if err := position.AccruedFees.Validate(); err != nil {
+		return fmt.Errorf("invalid accrued fees: %w", err)
+	}
+	return nil
+}
+
+// MustMarshalPosition returns the position bytes.
+// It throws panic if it fails.
+func MustMarshalPosition(cdc codec.BinaryCodec, position Position) []byte {
+	return cdc.MustMarshal(&position)
+}
+
+// MustUnmarshalPosition return the unmarshalled position from bytes.
+// It throws panic if it fails.
+func MustUnmarshalPosition(cdc codec.BinaryCodec, value []byte) Position {
+	position, err := UnmarshalPosition(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return position
+}
+
+// UnmarshalPosition returns the position from bytes.
+func UnmarshalPosition(cdc codec.BinaryCodec, value []byte) (position Position, err error) {
+	err = cdc.Unmarshal(value, &position)
+	return position, err
+}
@@ -1476,6 +1476,234 @@ func (m *QueryOrdersByOrdererRequest) GetAppId() uint64 {
 	return 0
 }
 
+// QueryPositionsRequest is request type for the Query/Positions RPC method.
+type QueryPositionsRequest struct {
+	AppId      uint64             `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	PoolId     uint64             `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Owner      string             `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPositionsRequest) Reset()         { *m = QueryPositionsRequest{} }
+func (m *QueryPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionsRequest) ProtoMessage()    {}
+func (*QueryPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d297ec7fcddea2d4, []int{27}
+}
+func (m *QueryPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionsRequest.Merge(m, src)
+}
+func (m *QueryPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionsRequest proto.InternalMessageInfo
+
+func (m *QueryPositionsRequest) GetAppId() uint64 {
+	if m != nil {
+		return m.AppId
+	}
+	return 0
+}
+
+func (m *QueryPositionsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryPositionsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryPositionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPositionsResponse is response type for the Query/Positions RPC method.
+type QueryPositionsResponse struct {
+	Positions  []PositionResponse  `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPositionsResponse) Reset()         { *m = QueryPositionsResponse{} }
+func (m *QueryPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionsResponse) ProtoMessage()    {}
+func (*QueryPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d297ec7fcddea2d4, []int{28}
+}
+func (m *QueryPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionsResponse.Merge(m, src)
+}
+func (m *QueryPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionsResponse proto.InternalMessageInfo
+
+func (m *QueryPositionsResponse) GetPositions() []PositionResponse {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *QueryPositionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPositionRequest is request type for the Query/Position RPC method.
+type QueryPositionRequest struct {
+	AppId      uint64 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	PoolId     uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	PositionId uint64 `protobuf:"varint,3,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+}
+
+func (m *QueryPositionRequest) Reset()         { *m = QueryPositionRequest{} }
+func (m *QueryPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionRequest) ProtoMessage()    {}
+func (*QueryPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d297ec7fcddea2d4, []int{29}
+}
+func (m *QueryPositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionRequest.Merge(m, src)
+}
+func (m *QueryPositionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionRequest proto.InternalMessageInfo
+
+func (m *QueryPositionRequest) GetAppId() uint64 {
+	if m != nil {
+		return m.AppId
+	}
+	return 0
+}
+
+func (m *QueryPositionRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryPositionRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+// QueryPositionResponse is response type for the Query/Position RPC method.
+type QueryPositionResponse struct {
+	Position PositionResponse `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
+}
+
+func (m *QueryPositionResponse) Reset()         { *m = QueryPositionResponse{} }
+func (m *QueryPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionResponse) ProtoMessage()    {}
+func (*QueryPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d297ec7fcddea2d4, []int{30}
+}
+func (m *QueryPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionResponse.Merge(m, src)
+}
+func (m *QueryPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionResponse proto.InternalMessageInfo
+
+func (m *QueryPositionResponse) GetPosition() PositionResponse {
+	if m != nil {
+		return m.Position
+	}
+	return PositionResponse{}
+}
+
 // PoolResponse defines a custom pool response message.
 type PoolResponse struct {
 	Id                    uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1493,13 +1721,17 @@ type PoolResponse struct {
 	MaxPrice              *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price,omitempty"`
 	Price                 *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price,omitempty"`
 	Disabled              bool                                    `protobuf:"varint,15,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	FeeRate               *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=fee_rate,json=feeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_rate,omitempty"`
+	WeightedAssets        []WeightedPoolAsset                     `protobuf:"bytes,17,rep,name=weighted_assets,json=weightedAssets,proto3" json:"weighted_assets"`
+	// weighted_balances specifies the reserve balances of a weighted pool
+	WeightedBalances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,18,rep,name=weighted_balances,json=weightedBalances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"weighted_balances"`
 }
 
 func (m *PoolResponse) Reset()         { *m = PoolResponse{} }
 func (m *PoolResponse) String() string { return proto.CompactTextString(m) }
 func (*PoolResponse) ProtoMessage()    {}
 func (*PoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{27}
+	return fileDescriptor_d297ec7fcddea2d4, []int{31}
 }
 func (m *PoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1605,6 +1837,20 @@ func (m *PoolResponse) GetDisabled() bool {
 	return false
 }
 
+func (m *PoolResponse) GetWeightedAssets() []WeightedPoolAsset {
+	if m != nil {
+		return m.WeightedAssets
+	}
+	return nil
+}
+
+func (m *PoolResponse) GetWeightedBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WeightedBalances
+	}
+	return nil
+}
+
 type PoolBalances struct {
 	BaseCoin  types.Coin `protobuf:"bytes,1,opt,name=base_coin,json=baseCoin,proto3" json:"base_coin"`
 	QuoteCoin types.Coin `protobuf:"bytes,2,opt,name=quote_coin,json=quoteCoin,proto3" json:"quote_coin"`
@@ -1614,7 +1860,7 @@ func (m *PoolBalances) Reset()         { *m = PoolBalances{} }
 func (m *PoolBalances) String() string { return proto.CompactTextString(m) }
 func (*PoolBalances) ProtoMessage()    {}
 func (*PoolBalances) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{28}
+	return fileDescriptor_d297ec7fcddea2d4, []int{32}
 }
 func (m *PoolBalances) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1657,6 +1903,61 @@ func (m *PoolBalances) GetQuoteCoin() types.Coin {
 	return types.Coin{}
 }
 
+// PositionResponse defines a custom position response message.
+type PositionResponse struct {
+	Position Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
+	// balances specifies the amount of coins the position holds at the
+	// current pool price
+	Balances PoolBalances `protobuf:"bytes,2,opt,name=balances,proto3" json:"balances"`
+}
+
+func (m *PositionResponse) Reset()         { *m = PositionResponse{} }
+func (m *PositionResponse) String() string { return proto.CompactTextString(m) }
+func (*PositionResponse) ProtoMessage()    {}
+func (*PositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d297ec7fcddea2d4, []int{33}
+}
+func (m *PositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionResponse.Merge(m, src)
+}
+func (m *PositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionResponse proto.InternalMessageInfo
+
+func (m *PositionResponse) GetPosition() Position {
+	if m != nil {
+		return m.Position
+	}
+	return Position{}
+}
+
+func (m *PositionResponse) GetBalances() PoolBalances {
+	if m != nil {
+		return m.Balances
+	}
+	return PoolBalances{}
+}
+
 // QueryFarmerRequest is request type for the Query/Farmer RPC method.
 type QueryFarmerRequest struct {
 	AppId  uint64 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
@@ -1668,7 +1969,7 @@ func (m *QueryFarmerRequest) Reset()         { *m = QueryFarmerRequest{} }
 func (m *QueryFarmerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFarmerRequest) ProtoMessage()    {}
 func (*QueryFarmerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{29}
+	return fileDescriptor_d297ec7fcddea2d4, []int{34}
 }
 func (m *QueryFarmerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1727,7 +2028,7 @@ func (m *QueuedPoolCoin) Reset()         { *m = QueuedPoolCoin{} }
 func (m *QueuedPoolCoin) String() string { return proto.CompactTextString(m) }
 func (*QueuedPoolCoin) ProtoMessage()    {}
 func (*QueuedPoolCoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{30}
+	return fileDescriptor_d297ec7fcddea2d4, []int{35}
 }
 func (m *QueuedPoolCoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1774,13 +2075,14 @@ func (m *QueuedPoolCoin) GetDequeAt() time.Time {
 type QueryFarmerResponse struct {
 	ActivePoolCoin types.Coin       `protobuf:"bytes,1,opt,name=active_pool_coin,json=activePoolCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"active_pool_coin"`
 	QueuedPoolCoin []QueuedPoolCoin `protobuf:"bytes,2,rep,name=queued_pool_coin,json=queuedPoolCoin,proto3" json:"queued_pool_coin"`
+	LockedPoolCoin []LockedCoin     `protobuf:"bytes,3,rep,name=locked_pool_coin,json=lockedPoolCoin,proto3" json:"locked_pool_coin"`
 }
 
 func (m *QueryFarmerResponse) Reset()         { *m = QueryFarmerResponse{} }
 func (m *QueryFarmerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFarmerResponse) ProtoMessage()    {}
 func (*QueryFarmerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{31}
+	return fileDescriptor_d297ec7fcddea2d4, []int{36}
 }
 func (m *QueryFarmerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1823,6 +2125,13 @@ func (m *QueryFarmerResponse) GetQueuedPoolCoin() []QueuedPoolCoin {
 	return nil
 }
 
+func (m *QueryFarmerResponse) GetLockedPoolCoin() []LockedCoin {
+	if m != nil {
+		return m.LockedPoolCoin
+	}
+	return nil
+}
+
 // QueryDeserializePoolCoinRequest is request type for the Query/DeserializePoolCoin RPC method.
 type QueryDeserializePoolCoinRequest struct {
 	PoolId         uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
@@ -1834,7 +2143,7 @@ func (m *QueryDeserializePoolCoinRequest) Reset()         { *m = QueryDeserializ
 func (m *QueryDeserializePoolCoinRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDeserializePoolCoinRequest) ProtoMessage()    {}
 func (*QueryDeserializePoolCoinRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{32}
+	return fileDescriptor_d297ec7fcddea2d4, []int{37}
 }
 func (m *QueryDeserializePoolCoinRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1893,7 +2202,7 @@ func (m *QueryDeserializePoolCoinResponse) Reset()         { *m = QueryDeseriali
 func (m *QueryDeserializePoolCoinResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDeserializePoolCoinResponse) ProtoMessage()    {}
 func (*QueryDeserializePoolCoinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{33}
+	return fileDescriptor_d297ec7fcddea2d4, []int{38}
 }
 func (m *QueryDeserializePoolCoinResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1938,7 +2247,7 @@ func (m *QueryPoolsIncentivesRequest) Reset()         { *m = QueryPoolsIncentive
 func (m *QueryPoolsIncentivesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolsIncentivesRequest) ProtoMessage()    {}
 func (*QueryPoolsIncentivesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{34}
+	return fileDescriptor_d297ec7fcddea2d4, []int{39}
 }
 func (m *QueryPoolsIncentivesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1992,7 +2301,7 @@ func (m *PoolIncentive) Reset()         { *m = PoolIncentive{} }
 func (m *PoolIncentive) String() string { return proto.CompactTextString(m) }
 func (*PoolIncentive) ProtoMessage()    {}
 func (*PoolIncentive) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{35}
+	return fileDescriptor_d297ec7fcddea2d4, []int{40}
 }
 func (m *PoolIncentive) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2107,7 +2416,7 @@ func (m *QueryPoolIncentivesResponse) Reset()         { *m = QueryPoolIncentives
 func (m *QueryPoolIncentivesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolIncentivesResponse) ProtoMessage()    {}
 func (*QueryPoolIncentivesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{36}
+	return fileDescriptor_d297ec7fcddea2d4, []int{41}
 }
 func (m *QueryPoolIncentivesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2152,7 +2461,7 @@ func (m *QueryFarmedPoolCoinRequest) Reset()         { *m = QueryFarmedPoolCoinR
 func (m *QueryFarmedPoolCoinRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFarmedPoolCoinRequest) ProtoMessage()    {}
 func (*QueryFarmedPoolCoinRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{37}
+	return fileDescriptor_d297ec7fcddea2d4, []int{42}
 }
 func (m *QueryFarmedPoolCoinRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2203,7 +2512,7 @@ func (m *QueryFarmedPoolCoinResponse) Reset()         { *m = QueryFarmedPoolCoin
 func (m *QueryFarmedPoolCoinResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFarmedPoolCoinResponse) ProtoMessage()    {}
 func (*QueryFarmedPoolCoinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{38}
+	return fileDescriptor_d297ec7fcddea2d4, []int{43}
 }
 func (m *QueryFarmedPoolCoinResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2560,7 @@ func (m *QueryOrderBooksRequest) Reset()         { *m = QueryOrderBooksRequest{}
 func (m *QueryOrderBooksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOrderBooksRequest) ProtoMessage()    {}
 func (*QueryOrderBooksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{39}
+	return fileDescriptor_d297ec7fcddea2d4, []int{44}
 }
 func (m *QueryOrderBooksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2317,7 +2626,7 @@ func (m *QueryOrderBooksResponse) Reset()         { *m = QueryOrderBooksResponse
 func (m *QueryOrderBooksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOrderBooksResponse) ProtoMessage()    {}
 func (*QueryOrderBooksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{40}
+	return fileDescriptor_d297ec7fcddea2d4, []int{45}
 }
 func (m *QueryOrderBooksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2672,7 @@ func (m *OrderBookPairResponse) Reset()         { *m = OrderBookPairResponse{} }
 func (m *OrderBookPairResponse) String() string { return proto.CompactTextString(m) }
 func (*OrderBookPairResponse) ProtoMessage()    {}
 func (*OrderBookPairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{41}
+	return fileDescriptor_d297ec7fcddea2d4, []int{46}
 }
 func (m *OrderBookPairResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2416,7 +2725,7 @@ func (m *OrderBookResponse) Reset()         { *m = OrderBookResponse{} }
 func (m *OrderBookResponse) String() string { return proto.CompactTextString(m) }
 func (*OrderBookResponse) ProtoMessage()    {}
 func (*OrderBookResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{42}
+	return fileDescriptor_d297ec7fcddea2d4, []int{47}
 }
 func (m *OrderBookResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2469,7 +2778,7 @@ func (m *OrderBookTickResponse) Reset()         { *m = OrderBookTickResponse{} }
 func (m *OrderBookTickResponse) String() string { return proto.CompactTextString(m) }
 func (*OrderBookTickResponse) ProtoMessage()    {}
 func (*OrderBookTickResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{43}
+	return fileDescriptor_d297ec7fcddea2d4, []int{48}
 }
 func (m *OrderBookTickResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2817,7 @@ func (m *TotalActiveAndQueuedPoolCoins) Reset()         { *m = TotalActiveAndQue
 func (m *TotalActiveAndQueuedPoolCoins) String() string { return proto.CompactTextString(m) }
 func (*TotalActiveAndQueuedPoolCoins) ProtoMessage()    {}
 func (*TotalActiveAndQueuedPoolCoins) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{44}
+	return fileDescriptor_d297ec7fcddea2d4, []int{49}
 }
 func (m *TotalActiveAndQueuedPoolCoins) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2566,7 +2875,7 @@ func (m *QueryAllFarmedPoolCoinsRequest) Reset()         { *m = QueryAllFarmedPo
 func (m *QueryAllFarmedPoolCoinsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllFarmedPoolCoinsRequest) ProtoMessage()    {}
 func (*QueryAllFarmedPoolCoinsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{45}
+	return fileDescriptor_d297ec7fcddea2d4, []int{50}
 }
 func (m *QueryAllFarmedPoolCoinsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2611,7 +2920,7 @@ func (m *QueryAllFarmedPoolCoinsResponse) Reset()         { *m = QueryAllFarmedP
 func (m *QueryAllFarmedPoolCoinsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllFarmedPoolCoinsResponse) ProtoMessage()    {}
 func (*QueryAllFarmedPoolCoinsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d297ec7fcddea2d4, []int{46}
+	return fileDescriptor_d297ec7fcddea2d4, []int{51}
 }
 func (m *QueryAllFarmedPoolCoinsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2682,8 +2991,13 @@ func init() {
 	proto.RegisterType((*QueryOrderRequest)(nil), "comdex.liquidity.v1beta1.QueryOrderRequest")
 	proto.RegisterType((*QueryOrderResponse)(nil), "comdex.liquidity.v1beta1.QueryOrderResponse")
 	proto.RegisterType((*QueryOrdersByOrdererRequest)(nil), "comdex.liquidity.v1beta1.QueryOrdersByOrdererRequest")
+	proto.RegisterType((*QueryPositionsRequest)(nil), "comdex.liquidity.v1beta1.QueryPositionsRequest")
+	proto.RegisterType((*QueryPositionsResponse)(nil), "comdex.liquidity.v1beta1.QueryPositionsResponse")
+	proto.RegisterType((*QueryPositionRequest)(nil), "comdex.liquidity.v1beta1.QueryPositionRequest")
+	proto.RegisterType((*QueryPositionResponse)(nil), "comdex.liquidity.v1beta1.QueryPositionResponse")
 	proto.RegisterType((*PoolResponse)(nil), "comdex.liquidity.v1beta1.PoolResponse")
 	proto.RegisterType((*PoolBalances)(nil), "comdex.liquidity.v1beta1.PoolBalances")
+	proto.RegisterType((*PositionResponse)(nil), "comdex.liquidity.v1beta1.PositionResponse")
 	proto.RegisterType((*QueryFarmerRequest)(nil), "comdex.liquidity.v1beta1.QueryFarmerRequest")
 	proto.RegisterType((*QueuedPoolCoin)(nil), "comdex.liquidity.v1beta1.QueuedPoolCoin")
 	proto.RegisterType((*QueryFarmerResponse)(nil), "comdex.liquidity.v1beta1.QueryFarmerResponse")
@@ -2709,179 +3023,196 @@ func init() {
 }
 
 var fileDescriptor_d297ec7fcddea2d4 = []byte{
-	// 2752 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6c, 0x1c, 0x49,
-	0xf5, 0x4f, 0x8f, 0x67, 0x1c, 0xfb, 0xd9, 0x9e, 0xb1, 0x2b, 0x5f, 0x93, 0x49, 0x32, 0xe3, 0x7f,
-	0xff, 0x43, 0x32, 0xe4, 0x63, 0x26, 0x71, 0xbe, 0xbd, 0xbb, 0x51, 0x3c, 0xeb, 0x24, 0xeb, 0x4d,
-	0x22, 0x92, 0x4e, 0xa2, 0x5d, 0xa2, 0xc0, 0xd0, 0x33, 0x5d, 0x76, 0x5a, 0xe9, 0xe9, 0x6e, 0x77,
-	0xf7, 0xc4, 0xf1, 0x5a, 0xd6, 0x4a, 0x48, 0x5c, 0x10, 0x12, 0x2b, 0x2d, 0x8b, 0x40, 0x88, 0x03,
-	0x08, 0x09, 0x01, 0x07, 0x0e, 0xc0, 0x65, 0x2f, 0x48, 0x20, 0xa1, 0x3d, 0xb0, 0xda, 0x5d, 0xe5,
-	0x82, 0x38, 0x64, 0x21, 0x81, 0x0b, 0xc7, 0x95, 0xb8, 0x70, 0x42, 0xf5, 0xd1, 0x3d, 0xdd, 0xed,
-	0x6e, 0x77, 0x8f, 0x35, 0xe6, 0xe2, 0x71, 0x57, 0xd5, 0x7b, 0xef, 0xf7, 0x3e, 0xea, 0xd5, 0xab,
-	0x7a, 0x70, 0xb8, 0x6d, 0x74, 0x14, 0xfc, 0xb4, 0xae, 0xa9, 0xcb, 0x5d, 0x55, 0x51, 0x9d, 0xd5,
-	0xfa, 0x93, 0xd3, 0x2d, 0xec, 0xc8, 0xa7, 0xeb, 0xcb, 0x5d, 0x6c, 0xad, 0xd6, 0x4c, 0xcb, 0x70,
-	0x0c, 0x54, 0x64, 0xab, 0x6a, 0xde, 0xaa, 0x1a, 0x5f, 0x55, 0xda, 0xbd, 0x64, 0x2c, 0x19, 0x74,
-	0x51, 0x9d, 0xfc, 0xc7, 0xd6, 0x97, 0x0e, 0x2e, 0x19, 0xc6, 0x92, 0x86, 0xeb, 0xb2, 0xa9, 0xd6,
-	0x65, 0x5d, 0x37, 0x1c, 0xd9, 0x51, 0x0d, 0xdd, 0xe6, 0xb3, 0xe5, 0xb6, 0x61, 0x77, 0x0c, 0xbb,
-	0xde, 0x92, 0x6d, 0xec, 0x89, 0x6b, 0x1b, 0xaa, 0xce, 0xe7, 0x8f, 0xf9, 0xe7, 0x29, 0x0c, 0x6f,
-	0x95, 0x29, 0x2f, 0xa9, 0x3a, 0x65, 0xc6, 0xd7, 0x56, 0x63, 0xf1, 0xf7, 0xb0, 0xb2, 0x95, 0x5f,
-	0x8a, 0x5d, 0x69, 0xca, 0x96, 0xdc, 0x71, 0xc1, 0x55, 0x38, 0x74, 0xfa, 0xd5, 0xea, 0x2e, 0xd6,
-	0x1d, 0xb5, 0x83, 0x6d, 0x47, 0xee, 0x98, 0x2e, 0xfa, 0xf0, 0x02, 0xa5, 0x6b, 0xf9, 0x10, 0x89,
-	0xbb, 0x01, 0xdd, 0x21, 0x98, 0x6f, 0x53, 0xae, 0x12, 0x5e, 0xee, 0x62, 0xdb, 0x11, 0xef, 0xc3,
-	0xae, 0xc0, 0xa8, 0x6d, 0x1a, 0xba, 0x8d, 0xd1, 0x65, 0x18, 0x66, 0xd2, 0x8b, 0xc2, 0xb4, 0x50,
-	0x1d, 0x9b, 0x99, 0xae, 0xc5, 0x59, 0xba, 0xc6, 0x28, 0x1b, 0xd9, 0x8f, 0x9e, 0x57, 0x76, 0x48,
-	0x9c, 0x4a, 0x9c, 0x81, 0xfd, 0x94, 0xed, 0x75, 0xac, 0x63, 0x4b, 0x6d, 0x07, 0x64, 0xa2, 0x3d,
-	0x30, 0x2c, 0x9b, 0x66, 0x53, 0x55, 0x28, 0xf3, 0xac, 0x94, 0x93, 0x4d, 0x73, 0x41, 0x11, 0xdb,
-	0x50, 0x8a, 0xa2, 0xe1, 0x88, 0xae, 0x86, 0x10, 0x1d, 0x8d, 0x47, 0x14, 0x60, 0x10, 0x02, 0xf6,
-	0x73, 0x01, 0xa6, 0x98, 0xc2, 0x86, 0xa1, 0x79, 0x88, 0xf6, 0xc1, 0x4e, 0x53, 0x56, 0xad, 0x1e,
-	0xa4, 0x61, 0xf2, 0xb9, 0xa0, 0xa0, 0x12, 0x8c, 0x28, 0xaa, 0x2d, 0xb7, 0x34, 0xac, 0x14, 0x33,
-	0xd3, 0x42, 0x75, 0x54, 0xf2, 0xbe, 0xd1, 0x35, 0x80, 0x9e, 0xdb, 0x8b, 0x43, 0x14, 0xd5, 0x91,
-	0x1a, 0x8b, 0x91, 0x1a, 0x89, 0x91, 0x1a, 0x0b, 0xd5, 0x9e, 0xa1, 0x96, 0x30, 0x17, 0x28, 0xf9,
-	0x28, 0x7d, 0xe6, 0xc8, 0xfa, 0xcd, 0xf1, 0x13, 0xc1, 0x75, 0x18, 0x43, 0xca, 0xed, 0xd0, 0x80,
-	0x9c, 0x49, 0x06, 0x8a, 0xc2, 0xf4, 0x10, 0x17, 0x18, 0xe7, 0x18, 0xc3, 0xd0, 0x5c, 0x32, 0x6e,
-	0x05, 0x46, 0x8a, 0xae, 0x07, 0x90, 0x67, 0x3c, 0x7b, 0x6e, 0x8e, 0x9c, 0x71, 0xf2, 0x43, 0x17,
-	0x1b, 0x30, 0xe9, 0x41, 0xf4, 0xdb, 0xd2, 0x30, 0x34, 0xbf, 0x2d, 0x0d, 0x43, 0x5b, 0x50, 0x7c,
-	0x7a, 0x66, 0xfc, 0x7a, 0xde, 0xf7, 0x39, 0xc4, 0xd3, 0xf2, 0x0a, 0x64, 0x09, 0x15, 0xf7, 0x75,
-	0x7f, 0x4a, 0x52, 0x4a, 0xb1, 0x05, 0xd3, 0x1e, 0xdb, 0xc6, 0xaa, 0x84, 0x6d, 0x6c, 0x3d, 0xc1,
-	0x73, 0x8a, 0x62, 0x61, 0xdb, 0x73, 0xfb, 0x51, 0x28, 0x58, 0x6c, 0xa2, 0x29, 0xb3, 0x19, 0x2a,
-	0x70, 0x54, 0xca, 0x5b, 0x81, 0xf5, 0x71, 0xd0, 0xbf, 0x01, 0x15, 0x9f, 0x0c, 0xf2, 0xf7, 0x75,
-	0x43, 0xd5, 0xe7, 0xb1, 0x6e, 0x74, 0x5c, 0x11, 0x47, 0xa0, 0x40, 0xad, 0x41, 0xd2, 0x48, 0x53,
-	0x21, 0x33, 0x5c, 0xc4, 0x84, 0xe9, 0x5f, 0x1e, 0x27, 0xe1, 0xdb, 0x5e, 0xb8, 0xca, 0xaa, 0xe5,
-	0xe1, 0xde, 0x0b, 0xc3, 0x94, 0x15, 0x0b, 0x82, 0x51, 0x89, 0x7f, 0x85, 0x22, 0x32, 0x33, 0x80,
-	0x88, 0x1c, 0xf2, 0x83, 0xf9, 0xa1, 0x17, 0x91, 0x0c, 0x0c, 0xf7, 0xd5, 0x2c, 0xe4, 0xc8, 0x6e,
-	0x71, 0x23, 0xb2, 0xbc, 0x59, 0xaa, 0x50, 0x2d, 0x2f, 0x12, 0x09, 0xc9, 0x36, 0x44, 0xa2, 0xac,
-	0x5a, 0x89, 0xbb, 0x3a, 0xc6, 0xd8, 0xb7, 0x7c, 0xb6, 0xf6, 0xb4, 0xbb, 0x08, 0x59, 0x42, 0xc5,
-	0x23, 0x31, 0x9d, 0x72, 0x94, 0x42, 0xfc, 0x40, 0x80, 0x03, 0x94, 0xdf, 0x3c, 0x36, 0x0d, 0x5b,
-	0x75, 0x38, 0x2c, 0x7b, 0x8b, 0x1b, 0x65, 0x50, 0xf9, 0x46, 0xfc, 0x83, 0x00, 0x07, 0xa3, 0x71,
-	0x71, 0x95, 0xbf, 0x0a, 0x93, 0x0a, 0x9b, 0x6a, 0x5a, 0x7c, 0x8e, 0xfb, 0xb6, 0x1a, 0xaf, 0x7e,
-	0x90, 0x19, 0x37, 0x44, 0x41, 0x09, 0x8a, 0x18, 0x9c, 0xbf, 0x1f, 0xf2, 0xc3, 0x22, 0x28, 0x36,
-	0xd1, 0xb4, 0x79, 0xc8, 0x78, 0x66, 0xcd, 0xa8, 0x4a, 0x5c, 0xa4, 0x3f, 0x89, 0xf4, 0x9c, 0x67,
-	0xa0, 0xb7, 0xa0, 0x10, 0x32, 0x10, 0x0f, 0x8f, 0x7e, 0xed, 0x93, 0x0f, 0xda, 0x47, 0xfc, 0xbe,
-	0xeb, 0x9a, 0xb7, 0x54, 0xe7, 0x91, 0x62, 0xc9, 0x2b, 0xa9, 0x63, 0x66, 0x9b, 0xb7, 0xfe, 0x9f,
-	0x04, 0x38, 0x14, 0x03, 0x8c, 0xdb, 0xe4, 0x21, 0x4c, 0xad, 0xf0, 0xb9, 0x70, 0xd4, 0x7c, 0x39,
-	0xde, 0x2a, 0x21, 0x76, 0xdc, 0x2c, 0x93, 0x2b, 0x21, 0x29, 0x83, 0x8b, 0x9b, 0xaf, 0x71, 0xcf,
-	0x86, 0x04, 0x0f, 0x2a, 0x70, 0xde, 0x89, 0xf6, 0x9f, 0x67, 0xa5, 0x07, 0x30, 0x19, 0xb6, 0x12,
-	0x0f, 0x9d, 0xbe, 0x8d, 0x54, 0x08, 0x19, 0x49, 0xfc, 0x8e, 0x9b, 0x9e, 0xbf, 0x62, 0x29, 0xd8,
-	0x4a, 0xae, 0x6d, 0xb6, 0x39, 0x64, 0x7e, 0x2c, 0xf0, 0xd2, 0xd2, 0x85, 0xc3, 0x4d, 0xf0, 0x1a,
-	0x0c, 0x1b, 0x74, 0x84, 0x47, 0x47, 0x25, 0x5e, 0x71, 0x4a, 0xe9, 0x16, 0x70, 0x8c, 0x68, 0x70,
-	0x91, 0x70, 0x97, 0x67, 0x7b, 0x2a, 0x24, 0xd1, 0x58, 0x29, 0xfd, 0x7f, 0xc7, 0xef, 0x02, 0x4f,
-	0xe5, 0x57, 0x20, 0x47, 0xd1, 0x73, 0x57, 0xa7, 0xd4, 0x98, 0xd1, 0x88, 0xbf, 0x76, 0x8f, 0x11,
-	0x66, 0xc7, 0x06, 0xfb, 0xed, 0x41, 0x2e, 0xc2, 0x4e, 0x83, 0x8d, 0xf0, 0xca, 0xc2, 0xfd, 0xf4,
-	0x2b, 0x93, 0xd9, 0xc4, 0xf3, 0x03, 0xaf, 0x5c, 0xff, 0x9d, 0x83, 0xf1, 0x40, 0x35, 0xc7, 0x8c,
-	0x27, 0x78, 0xc6, 0x8b, 0x05, 0x16, 0x51, 0x90, 0x0d, 0x45, 0x16, 0x64, 0x11, 0x65, 0x55, 0x36,
-	0xaa, 0xac, 0x7a, 0x03, 0x46, 0x5a, 0xb2, 0x26, 0xeb, 0x6d, 0x6c, 0x17, 0x73, 0x69, 0x6a, 0xc9,
-	0x06, 0x5f, 0xcd, 0x7d, 0xe0, 0x51, 0xa3, 0x73, 0xb0, 0x4f, 0x93, 0x6d, 0xa7, 0x19, 0x4a, 0xfc,
-	0x44, 0x87, 0x61, 0xaa, 0xc3, 0x6e, 0x32, 0x1d, 0xcc, 0xf2, 0x0b, 0x0a, 0xba, 0x00, 0x45, 0x4a,
-	0x16, 0xde, 0xf5, 0x84, 0x6e, 0x27, 0xa5, 0xdb, 0x43, 0xe6, 0x43, 0x5b, 0x3c, 0x50, 0x04, 0x8c,
-	0xf8, 0x8b, 0x80, 0xf3, 0x90, 0x75, 0x56, 0x4d, 0x5c, 0x1c, 0x9d, 0x16, 0xaa, 0xf9, 0x19, 0x71,
-	0x73, 0x65, 0xee, 0xad, 0x9a, 0x58, 0xa2, 0xeb, 0x49, 0x94, 0xb4, 0x2d, 0x2c, 0x3b, 0x86, 0x55,
-	0x04, 0x16, 0x25, 0xfc, 0x13, 0xbd, 0x0d, 0x93, 0x3d, 0x53, 0xda, 0x5d, 0xd3, 0xd4, 0x56, 0x8b,
-	0x63, 0x64, 0x49, 0xa3, 0x46, 0x4c, 0xf0, 0xd7, 0xe7, 0x95, 0x23, 0x4b, 0xaa, 0xf3, 0xa8, 0xdb,
-	0x22, 0xb2, 0xea, 0xfc, 0x0a, 0xcc, 0x7e, 0x4e, 0xda, 0xca, 0xe3, 0x3a, 0x61, 0x6f, 0xd7, 0x16,
-	0x74, 0x47, 0xca, 0xbb, 0xb6, 0xbf, 0x4b, 0xb9, 0xa0, 0xeb, 0x30, 0xda, 0x51, 0xf5, 0xa6, 0x69,
-	0xa9, 0x6d, 0x5c, 0x1c, 0xa7, 0x2c, 0x8f, 0xa5, 0x64, 0x37, 0x8f, 0xdb, 0xd2, 0x48, 0x47, 0xd5,
-	0x6f, 0x13, 0x5a, 0xca, 0x48, 0x7e, 0xca, 0x19, 0x4d, 0x6c, 0x81, 0x91, 0xfc, 0x94, 0x31, 0xba,
-	0x02, 0x39, 0xc6, 0x24, 0xdf, 0x37, 0x13, 0x46, 0x18, 0xb8, 0x10, 0x16, 0xa6, 0x85, 0xea, 0x48,
-	0xef, 0x42, 0x48, 0x12, 0xf0, 0xb8, 0x3f, 0x86, 0xd0, 0xab, 0x30, 0x4a, 0x76, 0x13, 0x35, 0x2d,
-	0xdf, 0xfb, 0xfb, 0x03, 0xdb, 0xcc, 0x75, 0x16, 0x31, 0x5a, 0x2f, 0xe2, 0x6c, 0x4c, 0xbe, 0xd1,
-	0x65, 0x80, 0xe5, 0xae, 0xe1, 0x70, 0xf2, 0x4c, 0x3a, 0xf2, 0x51, 0x4a, 0x42, 0x06, 0xc4, 0x87,
-	0x3c, 0x17, 0x5d, 0x93, 0xad, 0x4e, 0x2f, 0x5d, 0x44, 0x5f, 0xbe, 0xfd, 0x07, 0x5f, 0x26, 0x70,
-	0xf0, 0xed, 0x85, 0xe1, 0x45, 0xca, 0x80, 0xef, 0x44, 0xfe, 0x25, 0x7e, 0x2c, 0x40, 0xfe, 0x4e,
-	0x17, 0x77, 0xb1, 0xe2, 0xde, 0x7b, 0xd0, 0x12, 0x8c, 0x7a, 0x91, 0x94, 0xac, 0x6e, 0x9d, 0xe0,
-	0xfd, 0xe5, 0xe7, 0x95, 0xa3, 0x29, 0x1c, 0x40, 0x08, 0xa4, 0x11, 0x37, 0xbc, 0x90, 0x04, 0x23,
-	0x0a, 0x51, 0xa7, 0x29, 0x3b, 0xdc, 0x2e, 0xa5, 0x1a, 0x7b, 0xfd, 0xa8, 0xb9, 0xaf, 0x1f, 0xb5,
-	0x7b, 0xee, 0xf3, 0x48, 0xe3, 0x00, 0x11, 0xf4, 0xc5, 0xf3, 0x4a, 0x61, 0x55, 0xee, 0x68, 0xb3,
-	0xa2, 0x4b, 0x29, 0xbe, 0xf7, 0x79, 0x45, 0x90, 0x76, 0xd2, 0xcf, 0x39, 0x47, 0xfc, 0xa7, 0x7b,
-	0x5c, 0xb9, 0xe6, 0xe2, 0xb9, 0xcb, 0x81, 0x49, 0xb9, 0xed, 0xa8, 0x4f, 0x70, 0x73, 0x3b, 0x75,
-	0xcb, 0x33, 0x19, 0x9e, 0x29, 0xdf, 0x86, 0xc9, 0x65, 0x6a, 0x5c, 0x9f, 0xd4, 0x4c, 0x52, 0x09,
-	0x1e, 0x74, 0x87, 0x5b, 0x62, 0x2e, 0x07, 0x46, 0xc5, 0x35, 0x7e, 0x67, 0x9d, 0x27, 0x09, 0x55,
-	0x95, 0x35, 0xf5, 0x1d, 0x4f, 0x6a, 0x62, 0x11, 0x54, 0xf5, 0xa7, 0x0a, 0xb9, 0x63, 0x74, 0x75,
-	0x87, 0x47, 0x8b, 0xb7, 0xf5, 0xe7, 0xe8, 0x68, 0xdc, 0xf1, 0xf8, 0x2d, 0x81, 0xdf, 0xca, 0x23,
-	0xa5, 0x73, 0x8b, 0xcb, 0x90, 0x23, 0x02, 0xdc, 0xfa, 0x60, 0x13, 0x33, 0x9f, 0xe2, 0x66, 0xae,
-	0xa6, 0x34, 0xb3, 0x2d, 0x31, 0xce, 0xe2, 0x59, 0x7e, 0xa4, 0xd2, 0xa7, 0x95, 0x05, 0xbd, 0x8d,
-	0x75, 0x62, 0xfd, 0xa4, 0x07, 0xaa, 0x3f, 0xe7, 0x60, 0x82, 0x50, 0x78, 0x04, 0xf1, 0x96, 0xaa,
-	0xc0, 0x58, 0x47, 0xb6, 0x1d, 0x6c, 0x51, 0xff, 0x51, 0x23, 0x8d, 0x48, 0xc0, 0x86, 0x08, 0x0b,
-	0x74, 0x18, 0xf2, 0xed, 0x47, 0xaa, 0xc6, 0xfd, 0xab, 0x2a, 0xe4, 0xa0, 0x1b, 0xaa, 0x66, 0xa5,
-	0x71, 0x3a, 0x4a, 0xa5, 0x28, 0x36, 0x32, 0x60, 0xc2, 0x31, 0x1c, 0x59, 0x6b, 0x5a, 0x78, 0x45,
-	0xb6, 0x14, 0x9b, 0x1e, 0x72, 0x83, 0x8d, 0xbc, 0x71, 0x2a, 0x40, 0x62, 0xfc, 0xd1, 0x1a, 0xec,
-	0x52, 0x54, 0xdb, 0xb1, 0xd4, 0x56, 0xd7, 0xc1, 0x8a, 0x27, 0x36, 0x37, 0x70, 0xb1, 0xc8, 0x27,
-	0xc6, 0x15, 0xfe, 0x7f, 0xc0, 0xc0, 0x34, 0xb1, 0x69, 0xb4, 0x1f, 0xd9, 0xfc, 0x5c, 0x1d, 0xa3,
-	0x63, 0x57, 0xe9, 0x10, 0xfa, 0x7f, 0x98, 0x58, 0x54, 0x35, 0x0d, 0x2b, 0xee, 0x1a, 0x76, 0x86,
-	0x8e, 0xb3, 0x41, 0xbe, 0xe8, 0x5d, 0xc8, 0xd3, 0xd9, 0xa6, 0xfb, 0x02, 0x4a, 0x8f, 0x50, 0x82,
-	0x3f, 0x9c, 0x24, 0xe6, 0xf9, 0x82, 0xc6, 0x6b, 0x04, 0xff, 0xbf, 0x9e, 0x57, 0x8a, 0x41, 0xc2,
-	0x13, 0x46, 0x47, 0x75, 0x70, 0xc7, 0x74, 0x56, 0xbf, 0x78, 0x5e, 0xd9, 0xc3, 0xf2, 0x47, 0x70,
-	0x85, 0xf8, 0x03, 0x92, 0x45, 0x26, 0xe8, 0xa0, 0xcb, 0x0d, 0x75, 0x60, 0x4a, 0xc7, 0x4f, 0x9d,
-	0xa6, 0xa7, 0x23, 0xc1, 0x30, 0x9a, 0x98, 0xa8, 0x0e, 0xf3, 0x44, 0x55, 0x64, 0x82, 0x36, 0xb0,
-	0x60, 0x19, 0x6b, 0x92, 0x8c, 0xcf, 0xfb, 0x86, 0x51, 0x19, 0xc6, 0x54, 0xbb, 0x69, 0xaf, 0xc8,
-	0x66, 0x73, 0x11, 0x63, 0x7a, 0xbe, 0x8f, 0x48, 0xa3, 0xaa, 0x7d, 0x77, 0x45, 0x36, 0xaf, 0x61,
-	0xec, 0x0b, 0xe7, 0x31, 0x7f, 0x38, 0x1b, 0xbe, 0x4d, 0xe0, 0xdf, 0x03, 0x7c, 0x1b, 0xde, 0xe6,
-	0x25, 0x96, 0xea, 0x4d, 0xf1, 0x0d, 0x79, 0x74, 0xf3, 0xa2, 0xc3, 0x63, 0xc5, 0x92, 0x42, 0x8f,
-	0xb3, 0x78, 0x93, 0xdf, 0xd9, 0x69, 0x86, 0x55, 0x52, 0x67, 0x9d, 0x98, 0xd7, 0x9a, 0x75, 0x0e,
-	0x3f, 0xcc, 0x8d, 0xc3, 0xff, 0x3a, 0x64, 0xb7, 0x29, 0x57, 0x53, 0xbe, 0xe2, 0xfb, 0x02, 0xec,
-	0xed, 0x95, 0xe5, 0x0d, 0xc3, 0x78, 0x9c, 0x90, 0x3e, 0xd0, 0x7e, 0x18, 0xe1, 0x55, 0xaf, 0x4d,
-	0x73, 0x79, 0x56, 0xda, 0xc9, 0xca, 0x5e, 0x1b, 0x1d, 0x83, 0x29, 0x5a, 0x5e, 0x34, 0xbb, 0xba,
-	0xea, 0x34, 0x4d, 0x63, 0x85, 0x5c, 0x8f, 0x48, 0x42, 0x98, 0x90, 0x0a, 0x74, 0xe2, 0xbe, 0xae,
-	0x3a, 0xb7, 0xe9, 0x30, 0x3a, 0x00, 0xa3, 0x7a, 0xb7, 0xd3, 0x74, 0xd4, 0xf6, 0x63, 0x96, 0x0f,
-	0x26, 0xa4, 0x11, 0xbd, 0xdb, 0xb9, 0x47, 0xbe, 0xc5, 0x45, 0xd8, 0xb7, 0x01, 0x14, 0x37, 0xc8,
-	0x0d, 0xf7, 0x99, 0x8e, 0x9d, 0x23, 0xf5, 0xa4, 0x4b, 0x88, 0x61, 0x3c, 0xf6, 0x3f, 0x84, 0x05,
-	0xde, 0xed, 0xc4, 0x67, 0x02, 0xec, 0x89, 0x5c, 0x16, 0x7f, 0x83, 0xba, 0x05, 0x40, 0x8b, 0x21,
-	0x56, 0x80, 0x65, 0xfa, 0xae, 0x30, 0x49, 0x11, 0x46, 0xcb, 0x29, 0x56, 0xca, 0x49, 0x30, 0x46,
-	0xef, 0x39, 0xcd, 0x16, 0xd1, 0x92, 0x1a, 0x6b, 0x6c, 0xe6, 0x78, 0x0a, 0xa5, 0x42, 0x0a, 0x81,
-	0xe1, 0x99, 0x4a, 0xfc, 0x8f, 0x00, 0x53, 0x1b, 0xd6, 0x11, 0xe0, 0x3d, 0xe7, 0xb0, 0x3b, 0x56,
-	0xff, 0xc0, 0x3d, 0x2f, 0x12, 0x3f, 0xd8, 0x58, 0xd3, 0xfa, 0xf1, 0x03, 0xf1, 0x6d, 0xd8, 0x0f,
-	0x94, 0x07, 0x5a, 0x80, 0x6c, 0xab, 0xbb, 0xea, 0xaa, 0xbf, 0x45, 0x5e, 0x94, 0x85, 0xf8, 0x41,
-	0xc6, 0xe7, 0x52, 0xff, 0x2a, 0x34, 0xef, 0x56, 0xcd, 0x5b, 0xd3, 0x9d, 0x57, 0xce, 0x0f, 0x60,
-	0xaa, 0x6b, 0x63, 0xab, 0xc9, 0xbc, 0xe6, 0xab, 0x1e, 0xfa, 0xbf, 0x68, 0x14, 0x08, 0x23, 0x8a,
-	0x95, 0x97, 0x1b, 0x0f, 0x60, 0x8a, 0xe6, 0x8e, 0x00, 0xef, 0xa1, 0xad, 0xf1, 0x26, 0x8c, 0x7c,
-	0xbc, 0xc5, 0x0f, 0x33, 0x70, 0xe8, 0x1e, 0x39, 0x82, 0xe6, 0x68, 0x89, 0x36, 0xa7, 0x2b, 0xc1,
-	0x3a, 0xcb, 0x8e, 0xcf, 0x5c, 0xef, 0xc2, 0x5e, 0x76, 0xa0, 0x6d, 0xa8, 0x20, 0x33, 0x03, 0xcf,
-	0x4a, 0xbb, 0x9c, 0x1e, 0x46, 0xaf, 0x8c, 0xf4, 0x00, 0x6c, 0x28, 0x26, 0x87, 0xb6, 0x09, 0x40,
-	0xd0, 0x36, 0xe2, 0x05, 0x28, 0xd3, 0x7c, 0x34, 0xa7, 0x69, 0xc1, 0x3c, 0x9d, 0x54, 0x6b, 0xfd,
-	0x46, 0xe0, 0x75, 0x6a, 0x14, 0x25, 0x8f, 0xcb, 0x98, 0x3c, 0xbb, 0x0a, 0x87, 0x02, 0x56, 0x97,
-	0x75, 0xc5, 0xd5, 0x9f, 0xd5, 0x95, 0x6c, 0xe3, 0x5d, 0x88, 0xdf, 0x2c, 0x9b, 0xba, 0x5b, 0xda,
-	0xef, 0x44, 0x4c, 0xd3, 0xa9, 0x99, 0x3f, 0x96, 0x21, 0x47, 0x51, 0xa3, 0xef, 0x0a, 0x30, 0xcc,
-	0x1a, 0x90, 0xe8, 0xc4, 0xa6, 0x15, 0x7b, 0xa8, 0x21, 0x5b, 0x3a, 0x99, 0x72, 0x35, 0xb3, 0x81,
-	0x58, 0xfd, 0xe6, 0xb3, 0x7f, 0xbc, 0x9f, 0x11, 0xd1, 0x74, 0x3d, 0xa1, 0x8d, 0x8c, 0x7e, 0x27,
-	0xc0, 0x44, 0xa0, 0x33, 0x8a, 0xce, 0x24, 0x88, 0x8a, 0x6a, 0xde, 0x96, 0xce, 0xf6, 0x47, 0xc4,
-	0x61, 0x5e, 0xa2, 0x30, 0xcf, 0xa0, 0xd3, 0xf1, 0x30, 0x97, 0x18, 0x61, 0x93, 0xc1, 0xad, 0xaf,
-	0x31, 0xd7, 0xae, 0xa3, 0xef, 0x09, 0x90, 0xa3, 0x75, 0x3a, 0x3a, 0x9e, 0x64, 0x1a, 0x5f, 0x4b,
-	0xb7, 0x74, 0x22, 0xdd, 0x62, 0x8e, 0xef, 0x14, 0xc5, 0x77, 0x0c, 0x55, 0x37, 0x31, 0x23, 0x21,
-	0xe8, 0xc1, 0xfa, 0x91, 0x00, 0x59, 0x5a, 0xc9, 0x1f, 0x4b, 0x21, 0xc8, 0x05, 0x75, 0x3c, 0xd5,
-	0x5a, 0x8e, 0x69, 0x96, 0x62, 0x3a, 0x8b, 0x66, 0xd2, 0x62, 0xaa, 0xaf, 0xf1, 0x34, 0xb4, 0x8e,
-	0x9e, 0x09, 0xb0, 0x3b, 0xaa, 0xf3, 0x89, 0x66, 0x53, 0x20, 0x88, 0x69, 0x97, 0xf6, 0x87, 0x5e,
-	0xa2, 0xe8, 0x6f, 0xa2, 0x37, 0x53, 0xa3, 0x0f, 0xbd, 0xfc, 0xd5, 0xd7, 0x42, 0x03, 0xeb, 0xe8,
-	0x33, 0x01, 0x76, 0x45, 0xf4, 0x5a, 0xd1, 0xa5, 0x54, 0x4a, 0x45, 0xf5, 0x67, 0xb7, 0x5b, 0xa7,
-	0xd0, 0x23, 0x25, 0xf7, 0x50, 0x6f, 0x80, 0x87, 0x37, 0xed, 0x85, 0x26, 0x42, 0xf1, 0xb5, 0x80,
-	0x93, 0xc3, 0xdb, 0xdf, 0xa2, 0x4d, 0x15, 0xde, 0x84, 0x20, 0x14, 0xde, 0xb2, 0x6a, 0x25, 0x87,
-	0x77, 0xaf, 0xe1, 0x5a, 0x3a, 0x9e, 0x6a, 0x6d, 0x1f, 0xe1, 0x1d, 0xc0, 0x54, 0x5f, 0xe3, 0x85,
-	0xe5, 0x3a, 0xfa, 0x58, 0x80, 0x42, 0xa8, 0x7b, 0x89, 0xce, 0x25, 0x08, 0x8f, 0xee, 0xc2, 0x96,
-	0xce, 0xf7, 0x4b, 0xc6, 0xe1, 0xdf, 0xa0, 0xf0, 0xaf, 0xa2, 0xd7, 0xfb, 0xdf, 0x9d, 0xf5, 0x70,
-	0x77, 0x15, 0x7d, 0x22, 0x40, 0x3e, 0x28, 0x08, 0x9d, 0xed, 0x0b, 0x97, 0xab, 0xcd, 0xb9, 0x3e,
-	0xa9, 0xb8, 0x32, 0xb7, 0xa9, 0x32, 0x6f, 0xa2, 0x37, 0x06, 0xa0, 0x4c, 0x7d, 0x8d, 0x78, 0xe8,
-	0x33, 0x01, 0x26, 0xc3, 0xbd, 0x42, 0x94, 0x64, 0xeb, 0x98, 0xae, 0x67, 0xe9, 0x42, 0xdf, 0x74,
-	0x5c, 0xaf, 0x9b, 0x54, 0xaf, 0x6b, 0x68, 0x7e, 0x0b, 0x7a, 0x6d, 0xe8, 0x66, 0x92, 0xa4, 0x5a,
-	0x08, 0x89, 0x4a, 0x8c, 0xba, 0xe8, 0x3e, 0x63, 0xe9, 0x7c, 0xbf, 0x64, 0x5c, 0xa1, 0x3b, 0x54,
-	0xa1, 0x1b, 0x68, 0x61, 0x10, 0x0a, 0x31, 0x4f, 0xfd, 0x54, 0x80, 0x61, 0xd6, 0x5a, 0x4a, 0xac,
-	0x54, 0x02, 0x8d, 0xc5, 0xc4, 0x4a, 0x25, 0xd8, 0xf7, 0x13, 0x5f, 0xa1, 0xd0, 0xcf, 0xa1, 0x33,
-	0xf1, 0xd0, 0x59, 0x8b, 0x2f, 0x6a, 0xc3, 0xff, 0x4c, 0x80, 0x1c, 0xe5, 0x97, 0x98, 0x25, 0xfd,
-	0xed, 0xbc, 0xd2, 0x89, 0x74, 0x8b, 0x39, 0xc2, 0x2b, 0x14, 0xe1, 0x2c, 0xba, 0xb8, 0x05, 0x84,
-	0xcc, 0x96, 0xbf, 0x15, 0xa0, 0x10, 0x6a, 0xd3, 0x25, 0x46, 0x48, 0x74, 0x5b, 0xef, 0x7f, 0x61,
-	0x5d, 0xde, 0x27, 0x5c, 0x47, 0xbf, 0x12, 0x60, 0x98, 0x3d, 0x7b, 0x27, 0x86, 0x40, 0xa0, 0x99,
-	0x90, 0x08, 0x32, 0xf8, 0x96, 0x2e, 0xce, 0x53, 0x90, 0x97, 0xd1, 0xab, 0xf1, 0x20, 0x59, 0x77,
-	0x21, 0x2a, 0x7c, 0xd7, 0xd8, 0xd4, 0x3a, 0xfa, 0xbb, 0x00, 0xbb, 0x22, 0xde, 0x8f, 0x13, 0xab,
-	0x80, 0xf8, 0x17, 0xef, 0xd2, 0xec, 0x56, 0x48, 0xb9, 0x52, 0x77, 0xa9, 0x52, 0xb7, 0xd0, 0x8d,
-	0x78, 0xa5, 0x94, 0x1e, 0x79, 0xa4, 0x66, 0xe1, 0x47, 0xf5, 0x75, 0xf4, 0xa1, 0x00, 0xf9, 0xe0,
-	0xbb, 0x5c, 0x62, 0x1c, 0x45, 0xbf, 0x65, 0x97, 0xd2, 0x90, 0x6d, 0x7c, 0xfd, 0x4b, 0x5b, 0x7c,
-	0xfa, 0x5e, 0x07, 0x7b, 0xb5, 0xc3, 0xef, 0x05, 0xc8, 0x07, 0xef, 0x6c, 0x89, 0xa7, 0x59, 0xe4,
-	0x93, 0x60, 0x22, 0xf6, 0xe8, 0xa7, 0xbf, 0x34, 0xfb, 0x98, 0xc6, 0x12, 0xbb, 0x0f, 0x46, 0x95,
-	0xcf, 0x9f, 0x08, 0x70, 0x70, 0xb3, 0x4b, 0x20, 0xba, 0x98, 0x80, 0x2c, 0xf6, 0xbe, 0x5b, 0xba,
-	0xb4, 0x05, 0xca, 0xf4, 0x3e, 0x91, 0x35, 0xad, 0x19, 0xa5, 0x1b, 0xfa, 0x85, 0x00, 0xd0, 0x7b,
-	0x14, 0x44, 0xa7, 0xd2, 0x64, 0x17, 0xff, 0xa3, 0x66, 0xe9, 0x74, 0x1f, 0x14, 0x1c, 0xef, 0x79,
-	0x8a, 0xf7, 0x14, 0xaa, 0x25, 0xe4, 0x24, 0xf6, 0x84, 0xe7, 0x61, 0x6d, 0xdc, 0xfa, 0xe8, 0x45,
-	0x59, 0xf8, 0xf4, 0x45, 0x59, 0xf8, 0xdb, 0x8b, 0xb2, 0xf0, 0xde, 0xcb, 0xf2, 0x8e, 0x4f, 0x5f,
-	0x96, 0x77, 0xfc, 0xe5, 0x65, 0x79, 0xc7, 0x83, 0x33, 0x81, 0xc7, 0x08, 0xc2, 0xf3, 0xa4, 0xb1,
-	0xb8, 0xa8, 0xb6, 0x55, 0x59, 0x73, 0x65, 0xf8, 0xa5, 0xd0, 0xd7, 0x89, 0xd6, 0x30, 0x7d, 0x6a,
-	0x3f, 0xf3, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xc2, 0x6a, 0x91, 0x53, 0x2e, 0x00, 0x00,
+	// 3013 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x5b, 0x6c, 0x1c, 0x57,
+	0xf9, 0xcf, 0xac, 0x77, 0x9d, 0xdd, 0xcf, 0xf1, 0xee, 0xfa, 0xd8, 0x4e, 0x37, 0x9b, 0xd6, 0xeb,
+	0xce, 0x3f, 0xff, 0xc6, 0x38, 0xc9, 0x6e, 0xee, 0x49, 0xdd, 0x36, 0xaa, 0x5d, 0x27, 0xad, 0x9b,
+	0x04, 0x92, 0x49, 0xaa, 0x96, 0xa8, 0xb0, 0x8c, 0x77, 0x8e, 0x9d, 0x51, 0x66, 0x67, 0xc6, 0x33,
+	0xb3, 0x71, 0x5c, 0xcb, 0xaa, 0x84, 0xc4, 0x0b, 0x42, 0xa2, 0xa2, 0x14, 0x81, 0x10, 0x0f, 0x54,
+	0x20, 0x04, 0x48, 0x5c, 0x04, 0x3c, 0x50, 0x1e, 0x90, 0x78, 0x40, 0x7d, 0xa0, 0x6a, 0xab, 0xbe,
+	0x20, 0x1e, 0x52, 0x68, 0x79, 0xe2, 0xb1, 0x8f, 0x3c, 0xa1, 0x73, 0x99, 0xd9, 0x99, 0xf1, 0xcc,
+	0xce, 0xec, 0xb2, 0xe6, 0x25, 0xce, 0x9c, 0xf3, 0x5d, 0x7e, 0xdf, 0xe5, 0x7c, 0xe7, 0x3b, 0x67,
+	0x0f, 0x1c, 0x69, 0x19, 0x6d, 0x05, 0x3f, 0x68, 0x68, 0xea, 0x46, 0x47, 0x55, 0x54, 0x67, 0xab,
+	0x71, 0xff, 0xd4, 0x2a, 0x76, 0xe4, 0x53, 0x8d, 0x8d, 0x0e, 0xb6, 0xb6, 0xea, 0xa6, 0x65, 0x38,
+	0x06, 0xaa, 0x30, 0xaa, 0xba, 0x47, 0x55, 0xe7, 0x54, 0xd5, 0xa9, 0x75, 0x63, 0xdd, 0xa0, 0x44,
+	0x0d, 0xf2, 0x3f, 0x46, 0x5f, 0x7d, 0x74, 0xdd, 0x30, 0xd6, 0x35, 0xdc, 0x90, 0x4d, 0xb5, 0x21,
+	0xeb, 0xba, 0xe1, 0xc8, 0x8e, 0x6a, 0xe8, 0x36, 0x9f, 0x9d, 0x69, 0x19, 0x76, 0xdb, 0xb0, 0x1b,
+	0xab, 0xb2, 0x8d, 0x3d, 0x75, 0x2d, 0x43, 0xd5, 0xf9, 0xfc, 0xbc, 0x7f, 0x9e, 0xc2, 0xf0, 0xa8,
+	0x4c, 0x79, 0x5d, 0xd5, 0xa9, 0x30, 0x4e, 0x3b, 0x17, 0x8b, 0xbf, 0x8b, 0x95, 0x51, 0xfe, 0x7f,
+	0x2c, 0xa5, 0x29, 0x5b, 0x72, 0xdb, 0x05, 0x57, 0xe3, 0xd0, 0xe9, 0xd7, 0x6a, 0x67, 0xad, 0xe1,
+	0xa8, 0x6d, 0x6c, 0x3b, 0x72, 0xdb, 0x74, 0xd1, 0x87, 0x09, 0x94, 0x8e, 0xe5, 0x43, 0x24, 0x4e,
+	0x01, 0xba, 0x49, 0x30, 0xdf, 0xa0, 0x52, 0x25, 0xbc, 0xd1, 0xc1, 0xb6, 0x23, 0xbe, 0x04, 0x93,
+	0x81, 0x51, 0xdb, 0x34, 0x74, 0x1b, 0xa3, 0x4b, 0x30, 0xca, 0xb4, 0x57, 0x84, 0x59, 0x61, 0x6e,
+	0xec, 0xf4, 0x6c, 0x3d, 0xce, 0xd3, 0x75, 0xc6, 0xb9, 0x94, 0x7d, 0xf7, 0x61, 0x6d, 0x9f, 0xc4,
+	0xb9, 0xc4, 0xd3, 0x70, 0x88, 0x8a, 0x7d, 0x1e, 0xeb, 0xd8, 0x52, 0x5b, 0x01, 0x9d, 0x68, 0x1a,
+	0x46, 0x65, 0xd3, 0x6c, 0xaa, 0x0a, 0x15, 0x9e, 0x95, 0x72, 0xb2, 0x69, 0xae, 0x28, 0x62, 0x0b,
+	0xaa, 0x51, 0x3c, 0x1c, 0xd1, 0xe5, 0x10, 0xa2, 0xa3, 0xf1, 0x88, 0x02, 0x02, 0x42, 0xc0, 0x7e,
+	0x22, 0xc0, 0x04, 0x33, 0xd8, 0x30, 0x34, 0x0f, 0xd1, 0x23, 0xb0, 0xdf, 0x94, 0x55, 0xab, 0x0b,
+	0x69, 0x94, 0x7c, 0xae, 0x28, 0xa8, 0x0a, 0x79, 0x45, 0xb5, 0xe5, 0x55, 0x0d, 0x2b, 0x95, 0xcc,
+	0xac, 0x30, 0x57, 0x90, 0xbc, 0x6f, 0x74, 0x05, 0xa0, 0x1b, 0xf6, 0xca, 0x08, 0x45, 0xf5, 0x44,
+	0x9d, 0xe5, 0x48, 0x9d, 0xe4, 0x48, 0x9d, 0xa5, 0x6a, 0xd7, 0x51, 0xeb, 0x98, 0x2b, 0x94, 0x7c,
+	0x9c, 0x3e, 0x77, 0x64, 0xfd, 0xee, 0xf8, 0xa1, 0x00, 0xc8, 0x8f, 0x94, 0xfb, 0x61, 0x09, 0x72,
+	0x26, 0x19, 0xa8, 0x08, 0xb3, 0x23, 0x5c, 0x61, 0x5c, 0x60, 0x0c, 0x43, 0x73, 0xd9, 0xb8, 0x17,
+	0x18, 0x2b, 0x7a, 0x3e, 0x80, 0x3c, 0xe3, 0xf9, 0xb3, 0x37, 0x72, 0x26, 0xc9, 0x0f, 0x5d, 0x5c,
+	0x82, 0xb2, 0x07, 0xd1, 0xef, 0x4b, 0xc3, 0xd0, 0xfc, 0xbe, 0x34, 0x0c, 0x6d, 0x45, 0xf1, 0xd9,
+	0x99, 0xf1, 0xdb, 0xf9, 0x92, 0x2f, 0x20, 0x9e, 0x95, 0xcf, 0x42, 0x96, 0x70, 0xf1, 0x58, 0xf7,
+	0x67, 0x24, 0xe5, 0x14, 0x57, 0x61, 0xd6, 0x13, 0xbb, 0xb4, 0x25, 0x61, 0x1b, 0x5b, 0xf7, 0xf1,
+	0xa2, 0xa2, 0x58, 0xd8, 0xf6, 0xc2, 0x7e, 0x14, 0x4a, 0x16, 0x9b, 0x68, 0xca, 0x6c, 0x86, 0x2a,
+	0x2c, 0x48, 0x45, 0x2b, 0x40, 0x1f, 0x07, 0xfd, 0x2b, 0x50, 0xf3, 0xe9, 0x20, 0xff, 0x3e, 0x67,
+	0xa8, 0xfa, 0x32, 0xd6, 0x8d, 0xb6, 0xab, 0xe2, 0x09, 0x28, 0x51, 0x6f, 0x90, 0x32, 0xd2, 0x54,
+	0xc8, 0x0c, 0x57, 0x31, 0x6e, 0xfa, 0xc9, 0xe3, 0x34, 0x7c, 0xdd, 0x4b, 0x57, 0x59, 0xb5, 0x3c,
+	0xdc, 0x07, 0x61, 0x94, 0x8a, 0x62, 0x49, 0x50, 0x90, 0xf8, 0x57, 0x28, 0x23, 0x33, 0x43, 0xc8,
+	0xc8, 0x11, 0x3f, 0x98, 0xef, 0x79, 0x19, 0xc9, 0xc0, 0xf0, 0x58, 0x2d, 0x40, 0x8e, 0xac, 0x16,
+	0x37, 0x23, 0x67, 0x7a, 0x95, 0x0a, 0xd5, 0xf2, 0x32, 0x91, 0xb0, 0xec, 0x41, 0x26, 0xca, 0xaa,
+	0x95, 0xb8, 0xaa, 0x63, 0x9c, 0x7d, 0xdd, 0xe7, 0x6b, 0xcf, 0xba, 0x8b, 0x90, 0x25, 0x5c, 0x3c,
+	0x13, 0xd3, 0x19, 0x47, 0x39, 0xc4, 0xb7, 0x04, 0x38, 0x4c, 0xe5, 0x2d, 0x63, 0xd3, 0xb0, 0x55,
+	0x87, 0xc3, 0xb2, 0x07, 0x5c, 0x28, 0xc3, 0xaa, 0x37, 0xe2, 0x9f, 0x04, 0x78, 0x34, 0x1a, 0x17,
+	0x37, 0xf9, 0x8b, 0x50, 0x56, 0xd8, 0x54, 0xd3, 0xe2, 0x73, 0x3c, 0xb6, 0x73, 0xf1, 0xe6, 0x07,
+	0x85, 0x71, 0x47, 0x94, 0x94, 0xa0, 0x8a, 0xe1, 0xc5, 0xfb, 0x55, 0xbe, 0x59, 0x04, 0xd5, 0x26,
+	0xba, 0xb6, 0x08, 0x19, 0xcf, 0xad, 0x19, 0x55, 0x89, 0xcb, 0xf4, 0xfb, 0x91, 0x91, 0xf3, 0x1c,
+	0xf4, 0x32, 0x94, 0x42, 0x0e, 0xe2, 0xe9, 0xd1, 0xaf, 0x7f, 0x8a, 0x41, 0xff, 0x88, 0xdf, 0x71,
+	0x43, 0xf3, 0xb2, 0xea, 0xdc, 0x55, 0x2c, 0x79, 0x33, 0x75, 0xce, 0xec, 0xf1, 0xd2, 0xff, 0xb3,
+	0x00, 0x8f, 0xc5, 0x00, 0xe3, 0x3e, 0x79, 0x15, 0x26, 0x36, 0xf9, 0x5c, 0x38, 0x6b, 0x3e, 0x17,
+	0xef, 0x95, 0x90, 0x38, 0xee, 0x96, 0xf2, 0x66, 0x48, 0xcb, 0xf0, 0xf2, 0xe6, 0x4b, 0x3c, 0xb2,
+	0x21, 0xc5, 0xc3, 0x4a, 0x9c, 0xd7, 0xa2, 0xe3, 0xe7, 0x79, 0xe9, 0x0e, 0x94, 0xc3, 0x5e, 0xe2,
+	0xa9, 0xd3, 0xb7, 0x93, 0x4a, 0x21, 0x27, 0x89, 0xdf, 0x70, 0xcb, 0xf3, 0x17, 0x2c, 0x05, 0x5b,
+	0xc9, 0xbd, 0xcd, 0x1e, 0xa7, 0xcc, 0x0f, 0x04, 0x98, 0x0c, 0xc0, 0xe1, 0x2e, 0x78, 0x06, 0x46,
+	0x0d, 0x3a, 0xc2, 0xb3, 0xa3, 0x16, 0x6f, 0x38, 0xe5, 0x74, 0x1b, 0x38, 0xc6, 0x34, 0xbc, 0x4c,
+	0xb8, 0xc5, 0xab, 0x3d, 0x55, 0x92, 0xe8, 0xac, 0x94, 0xf1, 0xbf, 0xe9, 0x0f, 0x81, 0x67, 0xf2,
+	0x53, 0x90, 0xa3, 0xe8, 0x79, 0xa8, 0x53, 0x5a, 0xcc, 0x78, 0xc4, 0x5f, 0xba, 0xdb, 0x08, 0x9d,
+	0xb3, 0x97, 0xd8, 0xdf, 0x2e, 0xe4, 0x0a, 0xec, 0x37, 0xd8, 0x08, 0xef, 0x2c, 0xdc, 0x4f, 0xbf,
+	0x31, 0x99, 0x1e, 0x91, 0x1f, 0x7a, 0xe7, 0xfa, 0x63, 0x01, 0xa6, 0x79, 0x5f, 0x64, 0xab, 0x84,
+	0x30, 0xa1, 0xf3, 0xf7, 0xaf, 0xba, 0x4c, 0x60, 0xd5, 0x4d, 0x41, 0xce, 0xd8, 0xd4, 0xb1, 0x45,
+	0x31, 0x16, 0x24, 0xf6, 0x11, 0x82, 0x9f, 0x1d, 0x78, 0x23, 0xfc, 0xb5, 0x00, 0x07, 0xc3, 0x38,
+	0x79, 0xc4, 0x3e, 0x0f, 0x05, 0xd3, 0x1d, 0xe4, 0x79, 0x3a, 0xdf, 0xab, 0x09, 0x65, 0xa4, 0xa1,
+	0x46, 0xb4, 0x2b, 0x62, 0x78, 0x59, 0xbb, 0x0e, 0x53, 0x01, 0xc8, 0x83, 0x7a, 0xb6, 0x06, 0x63,
+	0x2e, 0xba, 0x6e, 0x12, 0x83, 0x3b, 0xb4, 0xa2, 0x88, 0x38, 0x14, 0x43, 0xcf, 0x35, 0xd7, 0x20,
+	0xef, 0x92, 0xf1, 0x7c, 0xee, 0xdf, 0x33, 0x9e, 0x04, 0xf1, 0x5b, 0x79, 0x38, 0x10, 0xe8, 0xfc,
+	0xd9, 0x42, 0x13, 0xbc, 0x85, 0x16, 0x9b, 0xc4, 0x11, 0xcd, 0xfb, 0x48, 0x64, 0xf3, 0x1e, 0xd1,
+	0x82, 0x67, 0xa3, 0x5a, 0xf0, 0x17, 0x20, 0xbf, 0x2a, 0x6b, 0xb2, 0xde, 0xc2, 0x76, 0x25, 0x97,
+	0xe6, 0xdc, 0xb1, 0xc4, 0xa9, 0x5d, 0xa3, 0x5c, 0x6e, 0x74, 0x0e, 0x1e, 0xd1, 0x64, 0xdb, 0x69,
+	0x86, 0x9a, 0x04, 0x62, 0xc3, 0x28, 0xb5, 0x61, 0x8a, 0x4c, 0x07, 0x3b, 0x82, 0x15, 0x05, 0x5d,
+	0x80, 0x0a, 0x65, 0x0b, 0xef, 0x10, 0x84, 0x6f, 0x3f, 0xe5, 0x9b, 0x26, 0xf3, 0xa1, 0xed, 0x20,
+	0xd0, 0x30, 0xe6, 0xfd, 0xc1, 0x3f, 0x0f, 0x59, 0x67, 0xcb, 0xc4, 0x95, 0xc2, 0xac, 0x30, 0x57,
+	0x3c, 0x2d, 0xf6, 0x36, 0xe6, 0xf6, 0x96, 0x89, 0x25, 0x4a, 0x4f, 0x2a, 0x4a, 0xcb, 0xc2, 0xb2,
+	0x63, 0x58, 0x15, 0x60, 0x15, 0x85, 0x7f, 0xa2, 0x57, 0xa0, 0xdc, 0x75, 0xa5, 0xdd, 0x31, 0x4d,
+	0x6d, 0xab, 0x32, 0x46, 0x48, 0x96, 0xea, 0xc4, 0x05, 0x7f, 0x7b, 0x58, 0x7b, 0x62, 0x5d, 0x75,
+	0xee, 0x76, 0x56, 0x89, 0xae, 0x06, 0xbf, 0x2e, 0x61, 0x7f, 0x4e, 0xd8, 0xca, 0xbd, 0x06, 0x11,
+	0x6f, 0xd7, 0x57, 0x74, 0x47, 0x2a, 0xba, 0xbe, 0xbf, 0x45, 0xa5, 0xa0, 0xe7, 0xa1, 0xd0, 0x56,
+	0xf5, 0xa6, 0x69, 0xa9, 0x2d, 0x5c, 0x39, 0x40, 0x45, 0xce, 0xa7, 0x14, 0xb7, 0x8c, 0x5b, 0x52,
+	0xbe, 0xad, 0xea, 0x37, 0x08, 0x2f, 0x15, 0x24, 0x3f, 0xe0, 0x82, 0xc6, 0x07, 0x10, 0x24, 0x3f,
+	0x60, 0x82, 0x9e, 0x85, 0x1c, 0x13, 0x52, 0xec, 0x5b, 0x08, 0x63, 0x0c, 0x5c, 0x1e, 0x94, 0x66,
+	0x85, 0xb9, 0xbc, 0xef, 0xf2, 0xe0, 0x32, 0xe4, 0xd7, 0x30, 0x6e, 0x5a, 0xb2, 0x83, 0x2b, 0xe5,
+	0xbe, 0x15, 0xec, 0x5f, 0xc3, 0x58, 0x92, 0x1d, 0xd2, 0x4f, 0x94, 0x36, 0xb1, 0xba, 0x7e, 0xd7,
+	0xc1, 0x4a, 0x53, 0xb6, 0x6d, 0xec, 0xd8, 0x95, 0x09, 0x5a, 0xad, 0x8e, 0xf5, 0x68, 0x27, 0x38,
+	0x03, 0x89, 0xfa, 0x22, 0xe1, 0x71, 0x9b, 0x51, 0x57, 0x12, 0x1d, 0xb4, 0xd1, 0x03, 0x98, 0xf0,
+	0x64, 0x7b, 0x0b, 0x03, 0x51, 0xe9, 0x87, 0x02, 0xa5, 0xcb, 0x15, 0x4c, 0xc2, 0xb9, 0x74, 0x92,
+	0xc8, 0xfa, 0xd9, 0xc7, 0xb5, 0xb9, 0x14, 0xa6, 0x10, 0x06, 0x5b, 0x2a, 0xbb, 0x5a, 0xdc, 0xf5,
+	0x44, 0x3a, 0x99, 0x03, 0xfe, 0x05, 0x86, 0x9e, 0x86, 0x02, 0xd1, 0x44, 0xf3, 0x8e, 0x17, 0x9d,
+	0x1e, 0x10, 0xbc, 0xe5, 0x68, 0x63, 0xf2, 0x8d, 0x2e, 0x01, 0x6c, 0x74, 0x0c, 0x87, 0xb3, 0x67,
+	0xd2, 0xb1, 0x17, 0x28, 0x0b, 0x19, 0x10, 0xdf, 0x16, 0xa0, 0xbc, 0xab, 0x0c, 0x2e, 0xef, 0x2a,
+	0x83, 0x62, 0x72, 0x19, 0x0c, 0x97, 0xbf, 0x40, 0xcd, 0xc9, 0xfc, 0x37, 0x35, 0x47, 0x7c, 0x95,
+	0x77, 0x1e, 0x57, 0x64, 0xab, 0xdd, 0x6d, 0x0e, 0xfa, 0xdd, 0x16, 0x0e, 0xc2, 0xe8, 0x1a, 0x15,
+	0xc0, 0x6b, 0x29, 0xff, 0x12, 0xdf, 0x13, 0xa0, 0x78, 0xb3, 0x83, 0x3b, 0x2c, 0x6b, 0xa8, 0x57,
+	0xd7, 0xa1, 0xe0, 0xd5, 0x82, 0xe4, 0x98, 0x34, 0x78, 0x5a, 0x1c, 0x4d, 0x99, 0x16, 0xc4, 0x47,
+	0x5c, 0x91, 0x04, 0x79, 0x85, 0x98, 0xd3, 0x94, 0x1d, 0xee, 0xa3, 0x6a, 0x9d, 0xdd, 0x75, 0xd6,
+	0xdd, 0xbb, 0xce, 0xfa, 0x6d, 0xf7, 0x32, 0x74, 0xe9, 0x30, 0x51, 0xf4, 0xd9, 0xc3, 0x5a, 0x69,
+	0x4b, 0x6e, 0x6b, 0x0b, 0xa2, 0xcb, 0x29, 0xbe, 0xf1, 0x71, 0x4d, 0x90, 0xf6, 0xd3, 0xcf, 0x45,
+	0x47, 0xfc, 0x43, 0x06, 0x26, 0x03, 0xee, 0xe2, 0x51, 0x75, 0xa0, 0x2c, 0xb7, 0x1c, 0xf5, 0x3e,
+	0x6e, 0xee, 0xa5, 0x6d, 0x45, 0xa6, 0xc3, 0x73, 0xe5, 0x2b, 0x50, 0xde, 0xa0, 0xce, 0xf5, 0x69,
+	0xcd, 0x24, 0x1d, 0xb8, 0x83, 0xe1, 0x70, 0xd7, 0xf0, 0x46, 0x30, 0x48, 0xb7, 0xa1, 0xac, 0x19,
+	0xad, 0x7b, 0x01, 0xc9, 0x23, 0x54, 0xf2, 0x91, 0x78, 0xc9, 0xd7, 0x28, 0x87, 0x5f, 0x2a, 0x93,
+	0xe1, 0x4a, 0x15, 0xb7, 0xf9, 0xbd, 0xd7, 0x32, 0xd9, 0x68, 0x55, 0x59, 0x53, 0x5f, 0xf3, 0x6c,
+	0x49, 0x3c, 0x48, 0xcd, 0xf9, 0xb7, 0x10, 0xb9, 0x6d, 0x74, 0x74, 0x87, 0xe7, 0xa0, 0xb7, 0x25,
+	0x2c, 0xd2, 0xd1, 0xb8, 0x16, 0xfb, 0x6b, 0x02, 0xcc, 0xc6, 0x6b, 0xe7, 0x71, 0x94, 0x21, 0x47,
+	0x14, 0xb8, 0xbd, 0xdb, 0x50, 0xeb, 0x15, 0x93, 0x2c, 0x9e, 0xe5, 0x6d, 0x39, 0xd1, 0x6d, 0xaf,
+	0xe8, 0x2d, 0xac, 0x93, 0x98, 0x26, 0x5d, 0x72, 0xff, 0x25, 0x07, 0xe3, 0x84, 0xc3, 0x63, 0x88,
+	0xf7, 0x54, 0x0d, 0xc6, 0xda, 0xb2, 0xed, 0x60, 0x8b, 0xc6, 0x8e, 0x3a, 0x29, 0x2f, 0x01, 0x1b,
+	0x22, 0x22, 0xd0, 0x11, 0x28, 0xb6, 0xee, 0xaa, 0x1a, 0x8f, 0xad, 0xaa, 0xd8, 0x34, 0xb4, 0x59,
+	0xe9, 0x00, 0x1d, 0xa5, 0x5a, 0x14, 0x1b, 0x19, 0x30, 0xee, 0x18, 0x8e, 0xac, 0x35, 0x2d, 0xbc,
+	0x29, 0x5b, 0x8a, 0xcd, 0x1b, 0xe6, 0x61, 0xe6, 0xf3, 0x01, 0xaa, 0x40, 0x62, 0xf2, 0xd1, 0x36,
+	0x4c, 0x2a, 0xaa, 0xed, 0x58, 0xea, 0x6a, 0x87, 0x6c, 0x1d, 0xae, 0xda, 0xdc, 0xd0, 0xd5, 0x22,
+	0x9f, 0x1a, 0x57, 0xf9, 0xe3, 0xc0, 0xc0, 0x34, 0xb1, 0x69, 0xb4, 0xee, 0xda, 0xbc, 0xdf, 0x1a,
+	0xa3, 0x63, 0x97, 0xe9, 0x10, 0xfa, 0x3f, 0x18, 0x5f, 0x53, 0x35, 0x0d, 0x2b, 0x2e, 0x0d, 0xeb,
+	0xad, 0x0e, 0xb0, 0x41, 0x4e, 0xf4, 0x3a, 0x14, 0xe9, 0x6c, 0xd3, 0xfd, 0x15, 0xa5, 0x92, 0xe7,
+	0xf8, 0xc3, 0xa5, 0x67, 0x99, 0x13, 0x2c, 0x3d, 0x43, 0xf0, 0xff, 0xeb, 0x61, 0xad, 0x12, 0x64,
+	0x3c, 0x6e, 0xb4, 0x55, 0x07, 0xb7, 0x4d, 0x67, 0xeb, 0xb3, 0x87, 0xb5, 0x69, 0x56, 0x95, 0x82,
+	0x14, 0xe2, 0x77, 0x49, 0x6d, 0x1a, 0xa7, 0x83, 0xae, 0x34, 0xd4, 0x86, 0x09, 0x1d, 0x3f, 0x70,
+	0x9a, 0x9e, 0x8d, 0x04, 0x43, 0x21, 0xb1, 0xfc, 0x1d, 0xe1, 0xe5, 0xaf, 0xc2, 0x14, 0xed, 0x12,
+	0xc1, 0xea, 0x60, 0x99, 0x8c, 0x2f, 0xfb, 0x86, 0xd1, 0x0c, 0x8c, 0xa9, 0x76, 0xd3, 0xde, 0x94,
+	0xcd, 0xe6, 0x1a, 0xc6, 0xb4, 0xef, 0xcb, 0x4b, 0x05, 0xd5, 0xbe, 0xb5, 0x29, 0x9b, 0x57, 0x30,
+	0xf6, 0xa5, 0xf3, 0x98, 0x3f, 0x9d, 0x0d, 0xdf, 0x22, 0xf0, 0xaf, 0x01, 0xbe, 0x0c, 0x6f, 0xf0,
+	0xd6, 0x5b, 0xf5, 0xa6, 0xf8, 0x82, 0x3c, 0xda, 0x7b, 0x97, 0xf3, 0x44, 0xb1, 0xa2, 0xd0, 0x95,
+	0x2c, 0x5e, 0x83, 0x6a, 0xb7, 0x6e, 0x2b, 0xa9, 0xab, 0x4e, 0xcc, 0x8d, 0xef, 0x0e, 0x1c, 0x8e,
+	0x94, 0xc6, 0xe1, 0x7f, 0x19, 0xb2, 0x7b, 0xb4, 0x03, 0x50, 0xb9, 0xe2, 0x9b, 0xee, 0x01, 0x94,
+	0x1d, 0xfb, 0x0d, 0xe3, 0x5e, 0xd2, 0x49, 0xf9, 0x10, 0xe4, 0xf9, 0x69, 0xc8, 0xa6, 0x3b, 0x44,
+	0x56, 0xda, 0xcf, 0x8e, 0x43, 0x36, 0x9a, 0x87, 0x09, 0xda, 0x76, 0x36, 0x3b, 0xba, 0xea, 0x34,
+	0x4d, 0x63, 0x13, 0x5b, 0xac, 0x20, 0x8c, 0x4b, 0x25, 0x3a, 0xf1, 0x92, 0xae, 0x3a, 0x37, 0xe8,
+	0x30, 0x3a, 0x0c, 0x05, 0xbd, 0xd3, 0x6e, 0x3a, 0x6a, 0xeb, 0x1e, 0xab, 0x07, 0xe3, 0x52, 0x5e,
+	0xef, 0xb4, 0x6f, 0x93, 0x6f, 0x71, 0x0d, 0x1e, 0xd9, 0x05, 0x8a, 0x3b, 0xe4, 0xaa, 0x7b, 0xd5,
+	0xcf, 0x76, 0xa7, 0x46, 0xd2, 0x45, 0x86, 0x61, 0xdc, 0xf3, 0x5f, 0xa6, 0x07, 0xee, 0xfe, 0xc5,
+	0x8f, 0x04, 0x98, 0x8e, 0x24, 0x8b, 0xbf, 0x85, 0xb9, 0x0e, 0x40, 0xfb, 0x40, 0xd6, 0x98, 0x67,
+	0xfa, 0x3e, 0x79, 0x90, 0xde, 0x99, 0x76, 0x92, 0xac, 0xc5, 0x97, 0x60, 0x8c, 0xde, 0x95, 0x34,
+	0x57, 0x89, 0x95, 0x95, 0x91, 0xa4, 0xce, 0xd9, 0x43, 0x1b, 0x32, 0x08, 0x0c, 0x77, 0xc2, 0x16,
+	0xff, 0x2d, 0xc0, 0xc4, 0x2e, 0x3a, 0x02, 0xbc, 0x1b, 0x9c, 0x8a, 0x30, 0x18, 0x70, 0x2f, 0x8a,
+	0x24, 0x0e, 0x36, 0xd6, 0xb4, 0x7e, 0xe2, 0x40, 0x62, 0x1b, 0x8e, 0x03, 0x95, 0x81, 0x56, 0x20,
+	0xbb, 0xda, 0xd9, 0x72, 0xcd, 0x1f, 0x50, 0x16, 0x15, 0x21, 0xbe, 0x95, 0x81, 0xe9, 0x48, 0x2a,
+	0xb4, 0xec, 0x9e, 0xa6, 0x06, 0xb3, 0x9d, 0x31, 0xa3, 0x3b, 0x30, 0xd1, 0xb1, 0xb1, 0xd5, 0x64,
+	0x51, 0xf3, 0x75, 0x0f, 0xfd, 0x1f, 0x40, 0x4b, 0x44, 0x10, 0xc5, 0xca, 0xdb, 0x8d, 0x3b, 0x30,
+	0x41, 0x6b, 0x47, 0x40, 0xf6, 0xc8, 0x60, 0xb2, 0x89, 0x20, 0x9f, 0x6c, 0xf1, 0x9d, 0x0c, 0x3c,
+	0x76, 0x9b, 0x6c, 0x41, 0x8b, 0xb4, 0xf1, 0x5b, 0xd4, 0x95, 0x60, 0xf7, 0x66, 0xc7, 0x57, 0xae,
+	0xd7, 0xe1, 0x20, 0xdb, 0xd0, 0x76, 0xf5, 0xa5, 0x99, 0xa1, 0x57, 0xa5, 0x49, 0xa7, 0x8b, 0xd1,
+	0x6b, 0x21, 0x3d, 0x00, 0xbb, 0x5a, 0xd4, 0x91, 0x3d, 0x02, 0x10, 0xf4, 0x8d, 0x78, 0x01, 0x66,
+	0x68, 0x3d, 0x5a, 0xd4, 0xb4, 0x60, 0x9d, 0x4e, 0xea, 0xb5, 0x7e, 0x23, 0x40, 0x2d, 0x96, 0x93,
+	0xe7, 0x65, 0x4c, 0x9d, 0xdd, 0x82, 0xc7, 0x02, 0x5e, 0x97, 0x75, 0xc5, 0xb5, 0x9f, 0xf5, 0x95,
+	0x6c, 0xe1, 0x5d, 0x88, 0x5f, 0x2c, 0x3d, 0xc3, 0x2d, 0x1d, 0x72, 0x22, 0xa6, 0xe9, 0xd4, 0xe9,
+	0x5f, 0x3d, 0x0e, 0x39, 0x8a, 0x1a, 0x7d, 0x53, 0x80, 0x51, 0xf6, 0x88, 0x01, 0x1d, 0xef, 0x79,
+	0x0e, 0x08, 0x3d, 0xea, 0xa8, 0x9e, 0x48, 0x49, 0xcd, 0x7c, 0x20, 0xce, 0x7d, 0xf5, 0xa3, 0x7f,
+	0xbe, 0x99, 0x11, 0xd1, 0x6c, 0x23, 0xe1, 0x29, 0x0a, 0xfa, 0x9d, 0x00, 0xe3, 0x81, 0xd7, 0x15,
+	0xe8, 0x4c, 0x82, 0xaa, 0xa8, 0x07, 0x20, 0xd5, 0xb3, 0xfd, 0x31, 0x71, 0x98, 0x4f, 0x52, 0x98,
+	0x67, 0xd0, 0xa9, 0x78, 0x98, 0xeb, 0x8c, 0xb1, 0xc9, 0xe0, 0x36, 0xb6, 0x59, 0x68, 0x77, 0xd0,
+	0xb7, 0x05, 0xc8, 0xd1, 0x3e, 0x1d, 0x1d, 0x4b, 0x72, 0x8d, 0xef, 0x59, 0x48, 0xf5, 0x78, 0x3a,
+	0x62, 0x8e, 0xef, 0x24, 0xc5, 0x37, 0x8f, 0xe6, 0x7a, 0xb8, 0x91, 0x30, 0x74, 0x61, 0x7d, 0x5f,
+	0x80, 0x2c, 0xed, 0xe4, 0xe7, 0x53, 0x28, 0x72, 0x41, 0x1d, 0x4b, 0x45, 0xcb, 0x31, 0x2d, 0x50,
+	0x4c, 0x67, 0xd1, 0xe9, 0xb4, 0x98, 0x1a, 0xdb, 0xbc, 0x0c, 0xed, 0xa0, 0x8f, 0x04, 0x98, 0x8a,
+	0x7a, 0x3d, 0x81, 0x16, 0x52, 0x20, 0x88, 0x79, 0x72, 0xd1, 0x1f, 0x7a, 0x89, 0xa2, 0xbf, 0x86,
+	0x5e, 0x4c, 0x8d, 0x3e, 0x74, 0x23, 0xdc, 0xd8, 0x0e, 0x0d, 0xec, 0xa0, 0x0f, 0x05, 0x98, 0x8c,
+	0x78, 0xaf, 0x81, 0x9e, 0x4c, 0x65, 0x54, 0xd4, 0x1b, 0x8f, 0xbd, 0xb6, 0x29, 0x74, 0x79, 0xdd,
+	0xd8, 0x0e, 0x0d, 0xf0, 0xf4, 0xa6, 0xef, 0x29, 0x12, 0xa1, 0xf8, 0x9e, 0x91, 0x54, 0x8f, 0xa7,
+	0x23, 0xee, 0x23, 0xbd, 0x09, 0x43, 0x28, 0xbd, 0x65, 0xd5, 0x4a, 0x4e, 0xef, 0xee, 0xa3, 0x8d,
+	0xea, 0xb1, 0x54, 0xb4, 0x7d, 0xa4, 0x77, 0x00, 0x53, 0x63, 0x9b, 0x37, 0x96, 0x3b, 0xe8, 0x3d,
+	0x01, 0x4a, 0xa1, 0x17, 0x10, 0xe8, 0x5c, 0x82, 0xf2, 0xe8, 0x97, 0x1c, 0xd5, 0xf3, 0xfd, 0xb2,
+	0x71, 0xf8, 0x57, 0x29, 0xfc, 0xcb, 0xe8, 0xb9, 0xfe, 0x57, 0x67, 0x23, 0xfc, 0x42, 0x03, 0xbd,
+	0x2f, 0x40, 0x31, 0xa8, 0x08, 0x9d, 0xed, 0x0b, 0x97, 0x6b, 0xcd, 0xb9, 0x3e, 0xb9, 0xb8, 0x31,
+	0x37, 0xa8, 0x31, 0x2f, 0xa2, 0x17, 0x86, 0x60, 0x4c, 0x63, 0x9b, 0x44, 0xe8, 0x43, 0x01, 0xca,
+	0xe1, 0xf7, 0x06, 0x28, 0xc9, 0xd7, 0x31, 0x2f, 0x27, 0xaa, 0x17, 0xfa, 0xe6, 0xe3, 0x76, 0x5d,
+	0xa3, 0x76, 0x5d, 0x41, 0xcb, 0x03, 0xd8, 0xb5, 0xeb, 0x45, 0x04, 0x29, 0xaa, 0xa5, 0x90, 0xaa,
+	0xc4, 0xac, 0x8b, 0x7e, 0xab, 0x50, 0x3d, 0xdf, 0x2f, 0x1b, 0x37, 0xe8, 0x26, 0x35, 0xe8, 0x2a,
+	0x5a, 0x19, 0x86, 0x41, 0x2c, 0x52, 0x6f, 0x0b, 0x30, 0xca, 0x7e, 0x9e, 0x4e, 0xec, 0x54, 0x02,
+	0x8f, 0x13, 0xaa, 0x27, 0x52, 0x52, 0x73, 0xe8, 0x4f, 0x51, 0xe8, 0xe7, 0xd0, 0x99, 0x78, 0xe8,
+	0xec, 0x99, 0x40, 0xd4, 0x82, 0xff, 0x91, 0x00, 0x39, 0x2a, 0x2f, 0xb1, 0x4a, 0xfa, 0x9f, 0x04,
+	0x54, 0x8f, 0xa7, 0x23, 0xe6, 0x08, 0x9f, 0xa5, 0x08, 0x17, 0xd0, 0xc5, 0x01, 0x10, 0x32, 0x5f,
+	0xfe, 0x56, 0x80, 0x52, 0xe8, 0xa7, 0xfe, 0xc4, 0x0c, 0x89, 0x7e, 0x1a, 0xf0, 0xbf, 0xf0, 0xae,
+	0xc1, 0x34, 0xee, 0xa0, 0x9f, 0x0b, 0x30, 0xca, 0x2e, 0xd3, 0x13, 0x53, 0x20, 0xf0, 0x13, 0x45,
+	0xf5, 0x44, 0x4a, 0x6a, 0x0e, 0x72, 0x99, 0x82, 0xbc, 0x84, 0x9e, 0x8e, 0x07, 0xc9, 0x7e, 0xb3,
+	0x88, 0x4a, 0xdf, 0x6d, 0x36, 0xb5, 0x83, 0xfe, 0x21, 0xc0, 0x64, 0xc4, 0xfd, 0x71, 0x62, 0x17,
+	0x10, 0x7f, 0xe3, 0x5d, 0x5d, 0x18, 0x84, 0x95, 0x1b, 0x75, 0x8b, 0x1a, 0x75, 0x1d, 0x5d, 0x8d,
+	0x37, 0x4a, 0xe9, 0xb2, 0x47, 0x5a, 0x16, 0xbe, 0x54, 0xdf, 0x41, 0xef, 0x08, 0x50, 0x0c, 0xde,
+	0xcb, 0x25, 0xe6, 0x51, 0xf4, 0x5d, 0x76, 0x35, 0x0d, 0xdb, 0xee, 0xdb, 0xbf, 0xb4, 0xcd, 0xa7,
+	0xef, 0x76, 0xb0, 0xdb, 0x3b, 0xfc, 0x51, 0x80, 0x62, 0xf0, 0xcc, 0x96, 0xb8, 0x9b, 0x45, 0x5e,
+	0x09, 0x56, 0xcf, 0xf5, 0xc9, 0x95, 0x7e, 0x1d, 0xd3, 0x5c, 0x62, 0xe7, 0xc1, 0xa8, 0xf6, 0xf9,
+	0x7d, 0x01, 0x1e, 0xed, 0x75, 0x08, 0x44, 0x17, 0x13, 0x90, 0xc5, 0x9e, 0x77, 0xab, 0x4f, 0x0e,
+	0xc0, 0x99, 0x3e, 0x26, 0xb2, 0xa6, 0x35, 0xa3, 0x6c, 0x43, 0x3f, 0x15, 0x00, 0xba, 0x97, 0x82,
+	0xe8, 0x64, 0x9a, 0xea, 0xe2, 0xbf, 0xd4, 0xac, 0x9e, 0xea, 0x83, 0x83, 0xe3, 0x3d, 0x4f, 0xf1,
+	0x9e, 0x44, 0xf5, 0x84, 0x9a, 0xc4, 0xae, 0xf0, 0xba, 0x58, 0x7f, 0x21, 0x40, 0xc1, 0x7b, 0xd6,
+	0x83, 0x1a, 0x89, 0x09, 0x1c, 0x7c, 0xa8, 0x54, 0x3d, 0x99, 0x9e, 0x81, 0x03, 0xbd, 0x44, 0x81,
+	0x5e, 0x44, 0xe7, 0x7b, 0x25, 0x3b, 0x67, 0x8a, 0x4a, 0x97, 0xdf, 0x0b, 0x90, 0x77, 0xa5, 0xa2,
+	0x7a, 0x4a, 0xf5, 0x2e, 0xdc, 0x46, 0x6a, 0xfa, 0x7e, 0x9a, 0x9a, 0x78, 0xb4, 0x8d, 0x6d, 0x77,
+	0x96, 0x7c, 0x2d, 0x5d, 0x7f, 0xf7, 0x93, 0x19, 0xe1, 0x83, 0x4f, 0x66, 0x84, 0xbf, 0x7f, 0x32,
+	0x23, 0xbc, 0xf1, 0xe9, 0xcc, 0xbe, 0x0f, 0x3e, 0x9d, 0xd9, 0xf7, 0xd7, 0x4f, 0x67, 0xf6, 0xdd,
+	0x39, 0x13, 0xb8, 0xf9, 0x21, 0x9a, 0x4e, 0x18, 0x6b, 0x6b, 0x6a, 0x4b, 0x95, 0x35, 0x57, 0xb3,
+	0x5f, 0x37, 0xbd, 0x0a, 0x5a, 0x1d, 0xa5, 0xbf, 0x6b, 0x9c, 0xf9, 0xcf, 0x00, 0x34, 0x67, 0x29,
+	0xd2, 0x04, 0x34, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2937,6 +3268,10 @@ type QueryClient interface {
 	// TotalActiveAndQueuedPoolCoin returns the total number of active and queued farmed pool coins in each pool.
 	TotalActiveAndQueuedPoolCoin(ctx context.Context, in *QueryAllFarmedPoolCoinsRequest, opts ...grpc.CallOption) (*QueryAllFarmedPoolCoinsResponse, error)
 	OrderBooks(ctx context.Context, in *QueryOrderBooksRequest, opts ...grpc.CallOption) (*QueryOrderBooksResponse, error)
+	// Positions returns all positions of a concentrated pool.
+	Positions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error)
+	// Position returns the specific position of a concentrated pool.
+	Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
 }
 
 type queryClient struct {
@@ -3136,6 +3471,24 @@ func (c *queryClient) OrderBooks(ctx context.Context, in *QueryOrderBooksRequest
 	return out, nil
 }
 
+func (c *queryClient) Positions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error) {
+	out := new(QueryPositionsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.liquidity.v1beta1.Query/Positions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error) {
+	out := new(QueryPositionResponse)
+	err := c.cc.Invoke(ctx, "/comdex.liquidity.v1beta1.Query/Position", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns parameters of the module.
@@ -3179,6 +3532,10 @@ type QueryServer interface {
 	// TotalActiveAndQueuedPoolCoin returns the total number of active and queued farmed pool coins in each pool.
 	TotalActiveAndQueuedPoolCoin(context.Context, *QueryAllFarmedPoolCoinsRequest) (*QueryAllFarmedPoolCoinsResponse, error)
 	OrderBooks(context.Context, *QueryOrderBooksRequest) (*QueryOrderBooksResponse, error)
+	// Positions returns all positions of a concentrated pool.
+	Positions(context.Context, *QueryPositionsRequest) (*QueryPositionsResponse, error)
+	// Position returns the specific position of a concentrated pool.
+	Position(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -3248,6 +3605,12 @@ func (*UnimplementedQueryServer) TotalActiveAndQueuedPoolCoin(ctx context.Contex
 func (*UnimplementedQueryServer) OrderBooks(ctx context.Context, req *QueryOrderBooksRequest) (*QueryOrderBooksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OrderBooks not implemented")
 }
+func (*UnimplementedQueryServer) Positions(ctx context.Context, req *QueryPositionsRequest) (*QueryPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Positions not implemented")
+}
+func (*UnimplementedQueryServer) Position(ctx context.Context, req *QueryPositionRequest) (*QueryPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Position not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -3631,24 +3994,60 @@ func _Query_OrderBooks_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "comdex.liquidity.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GenericParams",
-			Handler:    _Query_GenericParams_Handler,
-		},
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "Pool",
+func _Query_Positions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Positions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.liquidity.v1beta1.Query/Positions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Positions(ctx, req.(*QueryPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Position_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Position(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.liquidity.v1beta1.Query/Position",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Position(ctx, req.(*QueryPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "comdex.liquidity.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GenericParams",
+			Handler:    _Query_GenericParams_Handler,
+		},
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "Pool",
 			Handler:    _Query_Pool_Handler,
 		},
 		{
@@ -3719,6 +4118,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OrderBooks",
 			Handler:    _Query_OrderBooks_Handler,
 		},
+		{
+			MethodName: "Positions",
+			Handler:    _Query_Positions_Handler,
+		},
+		{
+			MethodName: "Position",
+			Handler:    _Query_Position_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comdex/liquidity/v1beta1/query.proto",
@@ -4789,6 +5196,178 @@ func (m *QueryOrdersByOrdererRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4809,6 +5388,52 @@ func (m *PoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WeightedBalances) > 0 {
+		for iNdEx := len(m.WeightedBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.WeightedAssets) > 0 {
+		for iNdEx := len(m.WeightedAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.FeeRate != nil {
+		{
+			size := m.FeeRate.Size()
+			i -= size
+			if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.Disabled {
 		i--
 		if m.Disabled {
@@ -4972,7 +5597,7 @@ func (m *PoolBalances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFarmerRequest) Marshal() (dAtA []byte, err error) {
+func (m *PositionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4982,34 +5607,77 @@ func (m *QueryFarmerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFarmerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PositionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFarmerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.AppId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.Balances.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFarmerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFarmerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFarmerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *QueuedPoolCoin) Marshal() (dAtA []byte, err error) {
@@ -5032,12 +5700,12 @@ func (m *QueuedPoolCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DequeAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DequeAt):])
-	if err22 != nil {
-		return 0, err22
+	n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DequeAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DequeAt):])
+	if err27 != nil {
+		return 0, err27
 	}
-	i -= n22
-	i = encodeVarintQuery(dAtA, i, uint64(n22))
+	i -= n27
+	i = encodeVarintQuery(dAtA, i, uint64(n27))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -5073,6 +5741,20 @@ func (m *QueryFarmerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockedPoolCoin) > 0 {
+		for iNdEx := len(m.LockedPoolCoin) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedPoolCoin[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.QueuedPoolCoin) > 0 {
 		for iNdEx := len(m.QueuedPoolCoin) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5238,20 +5920,20 @@ func (m *PoolIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x50
 	}
-	n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextDistribution, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextDistribution):])
-	if err25 != nil {
-		return 0, err25
+	n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextDistribution, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextDistribution):])
+	if err30 != nil {
+		return 0, err30
 	}
-	i -= n25
-	i = encodeVarintQuery(dAtA, i, uint64(n25))
+	i -= n30
+	i = encodeVarintQuery(dAtA, i, uint64(n30))
 	i--
 	dAtA[i] = 0x4a
-	n26, err26 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochDuration):])
-	if err26 != nil {
-		return 0, err26
+	n31, err31 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochDuration):])
+	if err31 != nil {
+		return 0, err31
 	}
-	i -= n26
-	i = encodeVarintQuery(dAtA, i, uint64(n26))
+	i -= n31
+	i = encodeVarintQuery(dAtA, i, uint64(n31))
 	i--
 	dAtA[i] = 0x42
 	if m.FilledEpochs != 0 {
@@ -5285,20 +5967,20 @@ func (m *PoolIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x22
 	if len(m.ChildPoolIds) > 0 {
-		dAtA30 := make([]byte, len(m.ChildPoolIds)*10)
-		var j29 int
+		dAtA35 := make([]byte, len(m.ChildPoolIds)*10)
+		var j34 int
 		for _, num := range m.ChildPoolIds {
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j34++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA35[j34] = uint8(num)
+			j34++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintQuery(dAtA, i, uint64(j29))
+		i -= j34
+		copy(dAtA[i:], dAtA35[:j34])
+		i = encodeVarintQuery(dAtA, i, uint64(j34))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5449,38 +6131,38 @@ func (m *QueryOrderBooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x20
 	}
 	if len(m.PriceUnitPowers) > 0 {
-		dAtA33 := make([]byte, len(m.PriceUnitPowers)*10)
-		var j32 int
+		dAtA38 := make([]byte, len(m.PriceUnitPowers)*10)
+		var j37 int
 		for _, num := range m.PriceUnitPowers {
 			for num >= 1<<7 {
-				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j32++
+				j37++
 			}
-			dAtA33[j32] = uint8(num)
-			j32++
+			dAtA38[j37] = uint8(num)
+			j37++
 		}
-		i -= j32
-		copy(dAtA[i:], dAtA33[:j32])
-		i = encodeVarintQuery(dAtA, i, uint64(j32))
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintQuery(dAtA, i, uint64(j37))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.PairIds) > 0 {
-		dAtA35 := make([]byte, len(m.PairIds)*10)
-		var j34 int
+		dAtA40 := make([]byte, len(m.PairIds)*10)
+		var j39 int
 		for _, num := range m.PairIds {
 			for num >= 1<<7 {
-				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j34++
+				j39++
 			}
-			dAtA35[j34] = uint8(num)
-			j34++
+			dAtA40[j39] = uint8(num)
+			j39++
 		}
-		i -= j34
-		copy(dAtA[i:], dAtA35[:j34])
-		i = encodeVarintQuery(dAtA, i, uint64(j34))
+		i -= j39
+		copy(dAtA[i:], dAtA40[:j39])
+		i = encodeVarintQuery(dAtA, i, uint64(j39))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6258,6 +6940,77 @@ func (m *QueryOrdersByOrdererRequest) Size() (n int) {
 	return n
 }
 
+func (m *QueryPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovQuery(uint64(m.AppId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *QueryPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *PoolResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6313,6 +7066,22 @@ func (m *PoolResponse) Size() (n int) {
 	if m.Disabled {
 		n += 2
 	}
+	if m.FeeRate != nil {
+		l = m.FeeRate.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if len(m.WeightedAssets) > 0 {
+		for _, e := range m.WeightedAssets {
+			l = e.Size()
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WeightedBalances) > 0 {
+		for _, e := range m.WeightedBalances {
+			l = e.Size()
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6329,6 +7098,19 @@ func (m *PoolBalances) Size() (n int) {
 	return n
 }
 
+func (m *PositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balances.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryFarmerRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6375,6 +7157,12 @@ func (m *QueryFarmerResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.LockedPoolCoin) > 0 {
+		for _, e := range m.LockedPoolCoin {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9480,7 +10268,7 @@ func (m *QueryOrdersByOrdererRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9503,17 +10291,17 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			m.Id = 0
+			m.AppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9523,16 +10311,16 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.AppId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.PairId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9542,14 +10330,14 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PairId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9577,13 +10365,13 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9593,27 +10381,81 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9640,15 +10482,427 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balances.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, PositionResponse{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDepositRequestId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.LastDepositRequestId = 0
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balances.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDepositRequestId", wireType)
+			}
+			m.LastDepositRequestId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9914,6 +11168,110 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Disabled = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.FeeRate = &v
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedAssets = append(m.WeightedAssets, WeightedPoolAsset{})
+			if err := m.WeightedAssets[len(m.WeightedAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedBalances = append(m.WeightedBalances, types.Coin{})
+			if err := m.WeightedBalances[len(m.WeightedBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10051,6 +11409,122 @@ func (m *PoolBalances) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balances.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryFarmerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10383,6 +11857,40 @@ func (m *QueryFarmerResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedPoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedPoolCoin = append(m.LockedPoolCoin, LockedCoin{})
+			if err := m.LockedPoolCoin[len(m.LockedPoolCoin)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11,15 +11,34 @@ import (
 // NewDepositRequest returns a new DepositRequest.
 func NewDepositRequest(msg *MsgDeposit, pool Pool, id uint64, msgHeight int64) DepositRequest {
 	return DepositRequest{
-		Id:             id,
-		PoolId:         msg.PoolId,
-		MsgHeight:      msgHeight,
-		Depositor:      msg.Depositor,
-		DepositCoins:   msg.DepositCoins,
-		AcceptedCoins:  nil,
-		MintedPoolCoin: sdk.NewCoin(pool.PoolCoinDenom, sdk.ZeroInt()),
-		Status:         RequestStatusNotExecuted,
-		AppId:          msg.AppId,
+		Id:                id,
+		PoolId:            msg.PoolId,
+		MsgHeight:         msgHeight,
+		Depositor:         msg.Depositor,
+		DepositCoins:      msg.DepositCoins,
+		AcceptedCoins:     nil,
+		MintedPoolCoin:    sdk.NewCoin(pool.PoolCoinDenom, sdk.ZeroInt()),
+		Status:            RequestStatusNotExecuted,
+		AppId:             msg.AppId,
+		MinPoolCoinAmount: sdk.ZeroInt(),
+	}
+}
+
+// NewZapDepositRequest returns a new single-sided DepositRequest.
+func NewZapDepositRequest(msg *MsgZapDeposit, pool Pool, id uint64, msgHeight int64) DepositRequest {
+	return DepositRequest{
+		Id:                id,
+		PoolId:            msg.PoolId,
+		MsgHeight:         msgHeight,
+		Depositor:         msg.Depositor,
+		DepositCoins:      sdk.NewCoins(msg.DepositCoin),
+		AcceptedCoins:     nil,
+		MintedPoolCoin:    sdk.NewCoin(pool.PoolCoinDenom, sdk.ZeroInt()),
+		Status:            RequestStatusNotExecuted,
+		AppId:             msg.AppId,
+		SingleAsset:       true,
+		MinPoolCoinAmount: msg.MinPoolCoinAmount,
+		SwappedCoin:       &sdk.Coin{Denom: msg.DepositCoin.Denom, Amount: sdk.ZeroInt()},
 	}
 }
 
@@ -48,13 +67,26 @@ func (req DepositRequest) Validate() error {
 	if err := req.DepositCoins.Validate(); err != nil {
 		return fmt.Errorf("invalid deposit coins: %w", err)
 	}
-	if len(req.DepositCoins) != 2 {
+	if req.SingleAsset {
+		if len(req.DepositCoins) != 1 {
+			return fmt.Errorf("wrong number of deposit coins: %d", len(req.DepositCoins))
+		}
+		if req.MinPoolCoinAmount.IsNil() || req.MinPoolCoinAmount.IsNegative() {
+			return fmt.Errorf("min pool coin amount must not be negative")
+		}
+		if req.SwappedCoin == nil {
+			return fmt.Errorf("swapped coin must not be nil")
+		}
+		if err := req.SwappedCoin.Validate(); err != nil {
+			return fmt.Errorf("invalid swapped coin %s: %w", req.SwappedCoin, err)
+		}
+	} else if len(req.DepositCoins) != 2 {
 		return fmt.Errorf("wrong number of deposit coins: %d", len(req.DepositCoins))
 	}
 	if err := req.AcceptedCoins.Validate(); err != nil {
 		return fmt.Errorf("invalid accepted coins: %w", err)
 	}
-	if len(req.AcceptedCoins) != 0 && len(req.AcceptedCoins) != 2 {
+	if !req.SingleAsset && len(req.AcceptedCoins) != 0 && len(req.AcceptedCoins) != 2 {
 		return fmt.Errorf("wrong number of accepted coins: %d", len(req.AcceptedCoins))
 	}
 	for _, coin := range req.AcceptedCoins {
@@ -80,17 +112,39 @@ func (req *DepositRequest) SetStatus(status RequestStatus) {
 // NewWithdrawRequest returns a new WithdrawRequest.
 func NewWithdrawRequest(msg *MsgWithdraw, id uint64, msgHeight int64) WithdrawRequest {
 	return WithdrawRequest{
-		Id:             id,
-		PoolId:         msg.PoolId,
-		MsgHeight:      msgHeight,
-		Withdrawer:     msg.Withdrawer,
-		PoolCoin:       msg.PoolCoin,
-		WithdrawnCoins: nil,
-		Status:         RequestStatusNotExecuted,
-		AppId:          msg.AppId,
+		Id:                  id,
+		PoolId:              msg.PoolId,
+		MsgHeight:           msgHeight,
+		Withdrawer:          msg.Withdrawer,
+		PoolCoin:            msg.PoolCoin,
+		WithdrawnCoins:      nil,
+		Status:              RequestStatusNotExecuted,
+		AppId:               msg.AppId,
+		MinDemandCoinAmount: sdk.ZeroInt(),
 	}
 }
 
+// NewZapWithdrawRequest returns a new single asset WithdrawRequest.
+func NewZapWithdrawRequest(msg *MsgZapWithdraw, id uint64, msgHeight int64) WithdrawRequest {
+	return WithdrawRequest{
+		Id:                  id,
+		PoolId:              msg.PoolId,
+		MsgHeight:           msgHeight,
+		Withdrawer:          msg.Withdrawer,
+		PoolCoin:            msg.PoolCoin,
+		WithdrawnCoins:      nil,
+		Status:              RequestStatusNotExecuted,
+		AppId:               msg.AppId,
+		DemandCoinDenom:     msg.DemandCoinDenom,
+		MinDemandCoinAmount: msg.MinDemandCoinAmount,
+	}
+}
+
+// IsSingleAsset returns whether the request withdraws into a single asset.
+func (req WithdrawRequest) IsSingleAsset() bool {
+	return req.DemandCoinDenom != ""
+}
+
 func (req WithdrawRequest) GetWithdrawer() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(req.Withdrawer)
 	if err != nil {
@@ -125,6 +179,14 @@ func (req WithdrawRequest) Validate() error {
 	if len(req.WithdrawnCoins) > 2 {
 		return fmt.Errorf("wrong number of withdrawn coins: %d", len(req.WithdrawnCoins))
 	}
+	if req.IsSingleAsset() {
+		if err := sdk.ValidateDenom(req.DemandCoinDenom); err != nil {
+			return fmt.Errorf("invalid demand coin denom: %w", err)
+		}
+		if req.MinDemandCoinAmount.IsNil() || req.MinDemandCoinAmount.IsNegative() {
+			return fmt.Errorf("min demand coin amount must not be negative")
+		}
+	}
 	if !req.Status.IsValid() {
 		return fmt.Errorf("invalid status: %s", req.Status)
 	}
@@ -69,10 +69,10 @@ func (op *BulkSendCoinsOperation) Run(ctx sdk.Context, bankKeeper expected.BankK
 }
 
 // NewPoolResponse returns a new PoolResponse from given information.
-func NewPoolResponse(pool Pool, rx, ry sdk.Coin, poolCoinSupply sdk.Int) PoolResponse {
+func NewPoolResponse(pool Pool, ammPool amm.Pool, rx, ry sdk.Coin, poolCoinSupply sdk.Int) PoolResponse {
 	var price *sdk.Dec
-	if !pool.Disabled {
-		p := pool.AMMPool(rx.Amount, ry.Amount, sdk.Int{}).Price()
+	if !pool.Disabled && !ammPool.IsDepleted() {
+		p := ammPool.Price()
 		price = &p
 	}
 	return PoolResponse{
@@ -93,10 +93,32 @@ func NewPoolResponse(pool Pool, rx, ry sdk.Coin, poolCoinSupply sdk.Int) PoolRes
 		MinPrice:              pool.MinPrice,
 		MaxPrice:              pool.MaxPrice,
 		Price:                 price,
+		FeeRate:               pool.FeeRate,
 		Disabled:              pool.Disabled,
 	}
 }
 
+// NewWeightedPoolResponse returns a new PoolResponse of a weighted pool.
+// A weighted pool has no single price, and its balances are reported for
+// each of its assets.
+func NewWeightedPoolResponse(pool Pool, balances sdk.Coins, poolCoinSupply sdk.Int) PoolResponse {
+	return PoolResponse{
+		Id:                    pool.Id,
+		PairId:                pool.PairId,
+		ReserveAddress:        pool.ReserveAddress,
+		PoolCoinDenom:         pool.PoolCoinDenom,
+		LastDepositRequestId:  pool.LastDepositRequestId,
+		LastWithdrawRequestId: pool.LastWithdrawRequestId,
+		AppId:                 pool.AppId,
+		Type:                  pool.Type,
+		Creator:               pool.Creator,
+		PoolCoinSupply:        poolCoinSupply,
+		Disabled:              pool.Disabled,
+		WeightedAssets:        pool.WeightedAssets,
+		WeightedBalances:      balances,
+	}
+}
+
 // IsTooSmallOrderAmount returns whether the order amount is too small for
 // matching, based on the order price.
 func IsTooSmallOrderAmount(amt sdk.Int, price sdk.Dec) bool {
@@ -288,3 +310,19 @@ func BfsShortestPath(undirectedGraph map[string][]string, start string, goal str
 	// return false, if no paths exists between start -> goal
 	return []string{}, false
 }
+
+// NewPositionResponse returns a new PositionResponse from given information.
+// The position's balances are zero if the pool price is nil.
+func NewPositionResponse(position Position, pair Pair, price *sdk.Dec) PositionResponse {
+	x, y := sdk.ZeroInt(), sdk.ZeroInt()
+	if price != nil {
+		x, y = amm.ConcentratedWithdraw(position.Liquidity, position.MinPrice, position.MaxPrice, *price)
+	}
+	return PositionResponse{
+		Position: position,
+		Balances: PoolBalances{
+			BaseCoin:  sdk.NewCoin(pair.BaseCoinDenom, y),
+			QuoteCoin: sdk.NewCoin(pair.QuoteCoinDenom, x),
+		},
+	}
+}
@@ -43,3 +43,7 @@ type BandOracleKeeper interface {
 	GetOracleValidationResult(ctx sdk.Context) bool
 	GetDiscardData(ctx sdk.Context) (disData types.DiscardData)
 }
+
+type LiquidityKeeper interface {
+	PoolCoinFairValue(ctx sdk.Context, poolCoinDenom string, amount sdk.Int) (sdk.Dec, error)
+}
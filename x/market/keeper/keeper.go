@@ -16,9 +16,10 @@ type Keeper struct {
 	scoped           expected.ScopedKeeper
 	assetKeeper      assetkeeper.Keeper
 	bandoraclekeeper expected.BandOracleKeeper
+	liquidityKeeper  expected.LiquidityKeeper
 }
 
-func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, params paramstypes.Subspace, scoped expected.ScopedKeeper, assetKeeper assetkeeper.Keeper, bandoraclekeeper expected.BandOracleKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, params paramstypes.Subspace, scoped expected.ScopedKeeper, assetKeeper assetkeeper.Keeper, bandoraclekeeper expected.BandOracleKeeper, liquidityKeeper expected.LiquidityKeeper) Keeper {
 	return Keeper{
 		cdc:              cdc,
 		key:              key,
@@ -26,6 +27,7 @@ func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, params paramstypes.Subsp
 		scoped:           scoped,
 		assetKeeper:      assetKeeper,
 		bandoraclekeeper: bandoraclekeeper,
+		liquidityKeeper:  liquidityKeeper,
 	}
 }
 
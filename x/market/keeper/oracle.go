@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	assetTypes "github.com/comdex-official/comdex/x/asset/types"
+	liquiditytypes "github.com/comdex-official/comdex/x/liquidity/types"
 	"github.com/comdex-official/comdex/x/market/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -160,6 +161,11 @@ func (k Keeper) CalcAssetPrice(ctx sdk.Context, id uint64, amt sdk.Int) (price s
 	if !found {
 		return sdk.ZeroDec(), assetTypes.ErrorAssetDoesNotExist
 	}
+	// pool coins have no oracle price, they are valued at their pool's fair
+	// reserves using the oracle prices of the pool's assets
+	if _, _, err := liquiditytypes.ParsePoolCoinDenom(asset.Denom); err == nil {
+		return k.liquidityKeeper.PoolCoinFairValue(ctx, asset.Denom, amt)
+	}
 	twa, found := k.GetTwa(ctx, id)
 	if found && twa.IsPriceActive {
 		numerator := sdk.NewDecFromInt(amt).Mul(sdk.NewDecFromInt(sdk.NewIntFromUint64(twa.Twa)))
@@ -1,6 +1,8 @@
 package expected
 
 import (
+	"time"
+
 	lendtypes "github.com/comdex-official/comdex/x/lend/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -42,6 +44,7 @@ type AssetKeeper interface {
 	GetApps(ctx sdk.Context) (apps []assettypes.AppData, found bool)
 	GetPair(ctx sdk.Context, id uint64) (pair assettypes.Pair, found bool)
 	GetPairsVaults(ctx sdk.Context) (apps []assettypes.ExtendedPairVault, found bool)
+	GetStabilityFeeAdjustmentsSince(ctx sdk.Context, extendedPairVaultID uint64, since time.Time) []assettypes.StabilityFeeAdjustment
 }
 
 type MarketKeeper interface {
@@ -79,7 +82,7 @@ type VaultKeeper interface {
 	UpdateAppExtendedPairVaultMappingDataOnMsgCreate(ctx sdk.Context, vaultData vaulttypes.Vault)
 	UpdateCollateralLockedAmountLockerMapping(ctx sdk.Context, appMappingID uint64, extendedPairID uint64, amount sdk.Int, changeType bool)
 	UpdateTokenMintedAmountLockerMapping(ctx sdk.Context, appMappingID uint64, extendedPairID uint64, amount sdk.Int, changeType bool)
-	DeleteUserVaultExtendedPairMapping(ctx sdk.Context, address string, appID uint64, pairVaultID uint64)
+	DeleteUserVaultExtendedPairMapping(ctx sdk.Context, address string, appID uint64, pairVaultID uint64, vaultID uint64)
 	DeleteAddressFromAppExtendedPairVaultMapping(ctx sdk.Context, extendedPairID uint64, userVaultID uint64, appMappingID uint64)
 	SetVault(ctx sdk.Context, vault vaulttypes.Vault)
 	GetAppExtendedPairVaultMappingData(ctx sdk.Context, appMappingID uint64, pairVaultID uint64) (appExtendedPairVaultData vaulttypes.AppExtendedPairVaultMappingData, found bool)
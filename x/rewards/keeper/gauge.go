@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	liquiditytypes "github.com/comdex-official/comdex/x/liquidity/types"
 	"github.com/comdex-official/comdex/x/rewards/types"
 )
 
@@ -71,6 +72,17 @@ func (k Keeper) ValidateIfOraclePricesExists(ctx sdk.Context, appID, pairID uint
 	return nil
 }
 
+// ValidateIfWeightedPoolOraclePricesExists validates that at least one asset
+// of the weighted pool has an oracle price.
+func (k Keeper) ValidateIfWeightedPoolOraclePricesExists(ctx sdk.Context, pool liquiditytypes.Pool) error {
+	for _, asset := range pool.WeightedAssets {
+		if _, found := k.OraclePrice(ctx, asset.Denom); found {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(types.ErrPriceNotFound, "oracle price required for atleast one asset of pool %d but not found", pool.Id)
+}
+
 // ValidateMsgCreateGaugeLiquidityMetaData validates types.MsgCreateGauge_LiquidityMetaData.
 func (k Keeper) ValidateMsgCreateGaugeLiquidityMetaData(ctx sdk.Context, appID uint64, kind *types.MsgCreateGauge_LiquidityMetaData, forSwapFee bool) error {
 	_, found := k.asset.GetApp(ctx, appID)
@@ -84,7 +96,12 @@ func (k Keeper) ValidateMsgCreateGaugeLiquidityMetaData(ctx sdk.Context, appID u
 	}
 
 	if !forSwapFee {
-		err := k.ValidateIfOraclePricesExists(ctx, appID, pool.PairId)
+		var err error
+		if pool.Type == liquiditytypes.PoolTypeWeighted {
+			err = k.ValidateIfWeightedPoolOraclePricesExists(ctx, pool)
+		} else {
+			err = k.ValidateIfOraclePricesExists(ctx, appID, pool.PairId)
+		}
 		if err != nil {
 			return err
 		}
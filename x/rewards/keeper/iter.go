@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
 	esmtypes "github.com/comdex-official/comdex/x/esm/types"
 	"github.com/comdex-official/comdex/x/rewards/types"
 )
@@ -206,6 +208,46 @@ func (k Keeper) CalculationOfRewards(
 	return newAm, nil
 }
 
+// CalculationOfVaultInterest returns the interest accrued on amount of debt of
+// the extended pair vault since bTime, compounding each stability fee over the
+// period it was in force.
+func (k Keeper) CalculationOfVaultInterest(
+	ctx sdk.Context,
+	extPair assettypes.ExtendedPairVault, amount sdk.Int, bTime int64,
+) (sdk.Dec, error) {
+	currentTime := ctx.BlockTime().Unix()
+
+	secondsElapsed := currentTime - bTime
+	if secondsElapsed < types.Int64Zero {
+		return sdk.ZeroDec(), sdkerrors.Wrap(types.ErrNegativeTimeElapsed, fmt.Sprintf("%d seconds", secondsElapsed))
+	}
+
+	factor := types.Float64One
+	compound := func(fee sdk.Dec, from, to int64) {
+		if to <= from {
+			return
+		}
+		yearsElapsed := sdk.NewDec(to - from).QuoInt64(types.SecondsPerYear)
+		factor *= math.Pow(sdk.OneDec().Add(fee).MustFloat64(), yearsElapsed.MustFloat64())
+	}
+
+	from := bTime
+	for _, adjustment := range k.asset.GetStabilityFeeAdjustmentsSince(ctx, extPair.Id, time.Unix(bTime, 0)) {
+		to := adjustment.Time.Unix()
+		compound(adjustment.PreviousFee, from, to)
+		from = to
+	}
+	compound(extPair.StabilityFee, from, currentTime)
+
+	newAmount := (factor - types.Float64One) * amount.ToDec().MustFloat64()
+	s := strconv.FormatFloat(newAmount, 'f', 18, 64)
+	newAm, err := sdk.NewDecFromStr(s)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return newAm, nil
+}
+
 func (k Keeper) OraclePriceForRewards(ctx sdk.Context, id uint64, amt sdk.Int) (sdk.Dec, bool) {
 	asset, found := k.asset.GetAsset(ctx, id)
 	if !found {
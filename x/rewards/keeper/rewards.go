@@ -655,7 +655,7 @@ func (k Keeper) CalculateVaultInterest(ctx sdk.Context, appID, extendedPairID, v
 	if blockHeight == 0 {
 		blockTime = extPairVaultBTime
 	}
-	interest, err := k.CalculationOfRewards(ctx, totalDebt, ExtPairVaultData.StabilityFee, blockTime)
+	interest, err := k.CalculationOfVaultInterest(ctx, ExtPairVaultData, totalDebt, blockTime)
 	if err != nil {
 		return err
 	}
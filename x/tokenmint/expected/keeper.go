@@ -25,4 +25,5 @@ type AssetKeeper interface {
 	GetAsset(ctx sdk.Context, ID uint64) (assettypes.Asset, bool)
 	GetAssetForDenom(ctx sdk.Context, denom string) (assettypes.Asset, bool)
 	GetMintGenesisTokenData(ctx sdk.Context, appID, assetID uint64) (assettypes.MintGenesisToken, bool)
+	CheckAppRoleIfSet(ctx sdk.Context, appID uint64, address string, role assettypes.AppRole) error
 }
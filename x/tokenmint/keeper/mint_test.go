@@ -410,3 +410,20 @@ func (s *KeeperTestSuite) TestBurnGovTokensForApp() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgMintNewTokensAppRole() {
+	treasury := sdk.AccAddress("treasury____________").String()
+	ctx := &s.ctx
+	wctx := sdk.WrapSDKContext(*ctx)
+	s.AddAppAsset()
+	server := keeper.NewMsgServer(s.tokenmintKeeper)
+
+	// Once the roles of the app are set, only the treasury mints.
+	s.Require().NoError(s.assetKeeper.SetAppRoles(*ctx, 2, assetTypes.AppRoles{Treasury: treasury}))
+	msg := tokenmintTypes.MsgMintNewTokensRequest{From: "cosmos1q7q90qsl9g0gl2zz0njxwv2a649yqrtyxtnv3v", AppId: 2, AssetId: 3}
+	_, err := server.MsgMintNewTokens(wctx, &msg)
+	s.Require().ErrorIs(err, assetTypes.ErrorUnauthorizedAppRole)
+	msg.From = treasury
+	_, err = server.MsgMintNewTokens(wctx, &msg)
+	s.Require().NoError(err)
+}
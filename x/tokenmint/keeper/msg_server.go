@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
 	"github.com/comdex-official/comdex/x/tokenmint/types"
 )
 
@@ -30,6 +31,9 @@ func (k msgServer) MsgMintNewTokens(c context.Context, msg *types.MsgMintNewToke
 	if !found {
 		return nil, types.ErrorAppMappingDoesNotExists
 	}
+	if err := k.asset.CheckAppRoleIfSet(ctx, msg.AppId, msg.From, assettypes.AppRoleTreasury); err != nil {
+		return nil, err
+	}
 	// Checking if asset exists in the app
 
 	assetDataInApp, found := k.asset.GetMintGenesisTokenData(ctx, appMappingData.Id, assetData.Id)
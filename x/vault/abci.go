@@ -0,0 +1,18 @@
+package vault
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/comdex-official/comdex/x/vault/keeper"
+	"github.com/comdex-official/comdex/x/vault/types"
+)
+
+// BeginBlocker executes vault protections ahead of the liquidation module
+// scanning for vaults to liquidate.
+func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, ctx.BlockTime(), telemetry.MetricKeyBeginBlocker)
+
+	k.ExecuteVaultProtections(ctx)
+}
@@ -48,6 +48,8 @@ func GetQueryCmd() *cobra.Command {
 		QueryUserExtendedPairTotalData(),
 		QueryPairsLockedAndMintedStatisticByApp(),
 		QueryAllStableMintVaultRewards(),
+		QueryPositionManagerGrants(),
+		QueryVaultProtection(),
 	)
 
 	return cmd
@@ -985,3 +987,61 @@ func QueryAllStableMintVaultRewards() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "stable_mint_vault_rewards")
 	return cmd
 }
+
+func QueryPositionManagerGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position_manager_grants [owner]",
+		Short: "Query position managers granted by an owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryPositionManagerGrants(cmd.Context(), &types.QueryPositionManagerGrantsRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func QueryVaultProtection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault_protection [userVaultid]",
+		Short: "Query the protection set on a vault",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userVaultid, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryVaultProtection(cmd.Context(), &types.QueryVaultProtectionRequest{
+				UserVaultId: userVaultid,
+			})
+			if err != nil {
+				return err
+			}
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -36,6 +36,16 @@ func GetTxCmd() *cobra.Command {
 		DepositStableMint(),
 		WithdrawStableMint(),
 		CalculateInterest(),
+		TransferVault(),
+		LeverageVault(),
+		DeleverageVault(),
+		Redeem(),
+		GrantPositionManager(),
+		RevokePositionManager(),
+		SetVaultProtection(),
+		RemoveVaultProtection(),
+		DepositCollateral(),
+		WithdrawCollateral(),
 	)
 
 	return cmd
@@ -500,3 +510,417 @@ func CalculateInterest() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func TransferVault() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [appID] [extendedPairVaultID] [userVaultid] [to]",
+		Short: "transfer the ownership of a vault to another address",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			extendedPairVaultID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			userVaultid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			to, err := sdk.AccAddressFromBech32(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferVaultRequest(ctx.FromAddress, appID, extendedPairVaultID, userVaultid, to)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func LeverageVault() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leverage [appID] [extendedPairVaultID] [userVaultid] [targetCR] [maxSlippage]",
+		Short: "draw, swap into collateral and deposit until the vault reaches the target collateralization ratio",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			extendedPairVaultID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			userVaultid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			targetCR, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			maxSlippage, err := sdk.NewDecFromStr(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLeverageVaultRequest(ctx.FromAddress, appID, extendedPairVaultID, userVaultid, targetCR, maxSlippage)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func DeleverageVault() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deleverage [appID] [extendedPairVaultID] [userVaultid] [targetCR] [maxSlippage]",
+		Short: "sell collateral to repay debt until the vault reaches the target collateralization ratio",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			extendedPairVaultID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			userVaultid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			targetCR, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			maxSlippage, err := sdk.NewDecFromStr(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleverageVaultRequest(ctx.FromAddress, appID, extendedPairVaultID, userVaultid, targetCR, maxSlippage)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func Redeem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem [appID] [extendedPairVaultID] [amount] [maxFeeRate]",
+		Short: "redeem minted tokens for collateral of the lowest collateralized vaults",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			extendedPairVaultID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return types.ErrorInvalidAmount
+			}
+
+			maxFeeRate, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemRequest(ctx.FromAddress, appID, extendedPairVaultID, amount, maxFeeRate)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GrantPositionManager() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-position-manager [manager] [permissions]",
+		Short: "allow a manager to deposit, repay or withdraw to owner on all of your vaults",
+		Long:  "permissions is a comma separated list of deposit, repay and withdraw_to_owner",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			manager, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			permissions, err := types.ParsePositionPermissions(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantPositionManagerRequest(ctx.FromAddress, manager, permissions)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func RevokePositionManager() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-position-manager [manager]",
+		Short: "revoke all permissions granted to a position manager",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			manager, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokePositionManagerRequest(ctx.FromAddress, manager)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func SetVaultProtection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-vault-protection [appID] [extendedPairVaultID] [userVaultid] [triggerCR] [targetCR] [action] [maxAmount] [maxSlippage]",
+		Short: "restore a vault to the target collateralization ratio once it falls to the trigger ratio",
+		Long:  "action is one of repay, deposit or sell_collateral",
+		Args:  cobra.ExactArgs(8),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			extendedPairVaultID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			userVaultid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			triggerCR, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			targetCR, err := sdk.NewDecFromStr(args[4])
+			if err != nil {
+				return err
+			}
+
+			action, err := types.ParseProtectionAction(args[5])
+			if err != nil {
+				return err
+			}
+
+			maxAmount, ok := sdk.NewIntFromString(args[6])
+			if !ok {
+				return types.ErrorInvalidAmount
+			}
+
+			maxSlippage, err := sdk.NewDecFromStr(args[7])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetVaultProtectionRequest(ctx.FromAddress, appID, extendedPairVaultID, userVaultid, triggerCR, targetCR, action, maxAmount, maxSlippage)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func RemoveVaultProtection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-vault-protection [userVaultid]",
+		Short: "remove the protection set on a vault",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			userVaultid, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveVaultProtectionRequest(ctx.FromAddress, userVaultid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func DepositCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-collateral [appID] [extendedPairVaultID] [userVaultid] [amount]",
+		Short: "deposit a whitelisted basket asset into a vault",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			extendedPairVaultID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			userVaultid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositCollateralRequest(ctx.FromAddress, appID, extendedPairVaultID, userVaultid, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func WithdrawCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-collateral [appID] [extendedPairVaultID] [userVaultid] [amount]",
+		Short: "withdraw a basket asset from a vault",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			extendedPairVaultID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			userVaultid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawCollateralRequest(ctx.FromAddress, appID, extendedPairVaultID, userVaultid, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(ctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
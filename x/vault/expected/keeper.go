@@ -26,6 +26,10 @@ type AssetKeeper interface {
 	GetPair(ctx sdk.Context, id uint64) (assettypes.Pair, bool)
 	GetApp(ctx sdk.Context, id uint64) (assettypes.AppData, bool)
 	GetPairsVault(ctx sdk.Context, pairID uint64) (assettypes.ExtendedPairVault, bool)
+	GetAssetForDenom(ctx sdk.Context, denom string) (assettypes.Asset, bool)
+	GetVaultCollateralAsset(ctx sdk.Context, extendedPairVaultID, assetID uint64) (assettypes.VaultCollateralAsset, bool)
+	IsPairDelisting(ctx sdk.Context, pairID uint64) bool
+	DelistingMinCr(ctx sdk.Context, extendedPairVault assettypes.ExtendedPairVault) (sdk.Dec, bool)
 }
 
 type MarketKeeper interface {
@@ -53,3 +57,11 @@ type RewardsKeeper interface {
 	GetExternalRewardStableVaultByApp(ctx sdk.Context, appID uint64) (VaultExternalRewards rewardstypes.StableVaultExternalRewards, found bool)
 	VerifyAppIDInRewards(ctx sdk.Context, appID uint64) bool
 }
+
+type LiquidationKeeper interface {
+	UpdateLockedVaultHistoryOwner(ctx sdk.Context, appID, vaultID uint64, owner, newOwner string)
+}
+
+type LiquidityKeeper interface {
+	SwapExactAmountIn(ctx sdk.Context, appID uint64, trader sdk.AccAddress, offerCoin sdk.Coin, demandCoinDenom string, minDemandAmt sdk.Int) (sdk.Coin, error)
+}
@@ -37,6 +37,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, state *types.GenesisState) {
 	k.SetIDForVault(ctx, vaultID)
 	k.SetIDForStableVault(ctx, stableVaultID)
 	k.SetLengthOfVault(ctx, state.LengthOfVaults)
+
+	for _, item := range state.RedemptionStates {
+		k.SetRedemptionState(ctx, item)
+	}
+
+	for _, item := range state.PositionManagerGrants {
+		k.SetPositionManagerGrant(ctx, item)
+	}
+
+	for _, item := range state.VaultProtections {
+		k.SetVaultProtection(ctx, item)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
@@ -46,5 +58,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		k.GetAllAppExtendedPairVaultMapping(ctx),
 		k.GetAllUserVaultExtendedPairMapping(ctx),
 		k.GetLengthOfVault(ctx),
+		k.GetRedemptionStates(ctx),
+		k.GetPositionManagerGrants(ctx),
+		k.GetVaultProtections(ctx),
 	)
 }
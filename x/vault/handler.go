@@ -48,6 +48,36 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgVaultInterestCalcRequest:
 			res, err := server.MsgVaultInterestCalc(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferVaultRequest:
+			res, err := server.MsgTransferVault(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgLeverageVaultRequest:
+			res, err := server.MsgLeverageVault(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDeleverageVaultRequest:
+			res, err := server.MsgDeleverageVault(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRedeemRequest:
+			res, err := server.MsgRedeem(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgGrantPositionManagerRequest:
+			res, err := server.MsgGrantPositionManager(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRevokePositionManagerRequest:
+			res, err := server.MsgRevokePositionManager(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetVaultProtectionRequest:
+			res, err := server.MsgSetVaultProtection(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveVaultProtectionRequest:
+			res, err := server.MsgRemoveVaultProtection(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDepositCollateralRequest:
+			res, err := server.MsgDepositCollateral(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawCollateralRequest:
+			res, err := server.MsgWithdrawCollateral(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, errors.Wrapf(types.ErrorUnknownMsgType, "%T", msg)
 		}
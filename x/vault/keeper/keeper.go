@@ -12,28 +12,32 @@ import (
 )
 
 type Keeper struct {
-	cdc       codec.BinaryCodec
-	key       sdk.StoreKey
-	bank      expected.BankKeeper
-	asset     expected.AssetKeeper
-	oracle    expected.MarketKeeper
-	collector expected.CollectorKeeper
-	esm       expected.EsmKeeper
-	tokenmint expected.TokenMintKeeper
-	rewards   expected.RewardsKeeper
+	cdc         codec.BinaryCodec
+	key         sdk.StoreKey
+	bank        expected.BankKeeper
+	asset       expected.AssetKeeper
+	oracle      expected.MarketKeeper
+	collector   expected.CollectorKeeper
+	esm         expected.EsmKeeper
+	tokenmint   expected.TokenMintKeeper
+	rewards     expected.RewardsKeeper
+	liquidation expected.LiquidationKeeper
+	liquidity   expected.LiquidityKeeper
 }
 
-func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, bank expected.BankKeeper, asset expected.AssetKeeper, oracle expected.MarketKeeper, collector expected.CollectorKeeper, esm expected.EsmKeeper, tokenmint expected.TokenMintKeeper, rewards expected.RewardsKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, bank expected.BankKeeper, asset expected.AssetKeeper, oracle expected.MarketKeeper, collector expected.CollectorKeeper, esm expected.EsmKeeper, tokenmint expected.TokenMintKeeper, rewards expected.RewardsKeeper, liquidation expected.LiquidationKeeper, liquidity expected.LiquidityKeeper) Keeper {
 	return Keeper{
-		cdc:       cdc,
-		key:       key,
-		bank:      bank,
-		asset:     asset,
-		oracle:    oracle,
-		collector: collector,
-		esm:       esm,
-		tokenmint: tokenmint,
-		rewards:   rewards,
+		cdc:         cdc,
+		key:         key,
+		bank:        bank,
+		asset:       asset,
+		oracle:      oracle,
+		collector:   collector,
+		esm:         esm,
+		tokenmint:   tokenmint,
+		rewards:     rewards,
+		liquidation: liquidation,
+		liquidity:   liquidity,
 	}
 }
 
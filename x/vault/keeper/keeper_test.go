@@ -15,7 +15,9 @@ import (
 	"github.com/comdex-official/comdex/x/vault/keeper"
 	"github.com/comdex-official/comdex/x/vault/types"
 
+	utils "github.com/comdex-official/comdex/types"
 	assettypes "github.com/comdex-official/comdex/x/asset/types"
+	liquiditytypes "github.com/comdex-official/comdex/x/liquidity/types"
 	markettypes "github.com/comdex-official/comdex/x/market/types"
 )
 
@@ -197,3 +199,19 @@ func (s *KeeperTestSuite) CreateNewExtendedVaultPair(
 	s.Require().NotZero(extendedVaultPairID)
 	return extendedVaultPairID
 }
+
+func (s *KeeperTestSuite) CreateNewLiquidityPool(appID uint64, creator sdk.AccAddress, baseCoinDenom, quoteCoinDenom, depositCoins string) liquiditytypes.Pool {
+	params, err := s.app.LiquidityKeeper.GetGenericParams(s.ctx, appID)
+	s.Require().NoError(err)
+
+	s.fundAddr(creator, params.PairCreationFee)
+	pair, err := s.app.LiquidityKeeper.CreatePair(s.ctx, liquiditytypes.NewMsgCreatePair(appID, creator, baseCoinDenom, quoteCoinDenom), false)
+	s.Require().NoError(err)
+
+	parsedDepositCoins := utils.ParseCoins(depositCoins)
+	s.fundAddr(creator, params.PoolCreationFee)
+	s.fundAddr(creator, parsedDepositCoins)
+	pool, err := s.app.LiquidityKeeper.CreatePool(s.ctx, liquiditytypes.NewMsgCreatePool(appID, creator, pair.Id, parsedDepositCoins))
+	s.Require().NoError(err)
+	return pool
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/vault/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return MigrateStore(ctx, m.keeper.key, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	//  Redemptions walk the vaults by collateralization ratio, so the
+	//  existing vaults are added to the index.
+	for _, vault := range m.keeper.GetVaults(ctx) {
+		m.keeper.setVaultCRIndex(ctx, vault)
+	}
+	return nil
+}
+
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	//  Owners can hold several vaults on an extended pair vault, so the
+	//  owner mapping keys now end with the vault id.
+	store := ctx.KVStore(storeKey)
+	return MigrateUserVaultExtendedPairMappings(store, cdc)
+}
+
+func MigrateUserVaultExtendedPairMappings(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	var (
+		iter     = sdk.KVStorePrefixIterator(store, types.UserVaultExtendedPairMappingKeyPrefix)
+		keys     [][]byte
+		mappings []types.OwnerAppExtendedPairVaultMappingData
+	)
+
+	for ; iter.Valid(); iter.Next() {
+		var mappingData types.OwnerAppExtendedPairVaultMappingData
+		cdc.MustUnmarshal(iter.Value(), &mappingData)
+		keys = append(keys, iter.Key())
+		mappings = append(mappings, mappingData)
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for i, mappingData := range mappings {
+		store.Delete(keys[i])
+		store.Set(
+			types.UserAppExtendedPairVaultMappingKey(mappingData.Owner, mappingData.AppId, mappingData.ExtendedPairId, mappingData.VaultId),
+			cdc.MustMarshal(&mappingData),
+		)
+	}
+
+	return nil
+}
@@ -3,8 +3,10 @@ package keeper
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	collectortypes "github.com/comdex-official/comdex/x/collector/types"
 	esmtypes "github.com/comdex-official/comdex/x/esm/types"
@@ -47,6 +49,9 @@ func (k msgServer) MsgCreate(c context.Context, msg *types.MsgCreateRequest) (*t
 	if !found {
 		return nil, types.ErrorPairDoesNotExist
 	}
+	if k.asset.IsPairDelisting(ctx, pairData.Id) {
+		return nil, types.ErrorPairDelisted
+	}
 	assetInData, found := k.asset.GetAsset(ctx, pairData.AssetIn)
 	if !found {
 		return nil, types.ErrorAssetDoesNotExist
@@ -78,14 +83,6 @@ func (k msgServer) MsgCreate(c context.Context, msg *types.MsgCreateRequest) (*t
 	if !extendedPairVault.IsVaultActive {
 		return nil, types.ErrorVaultCreationInactive
 	}
-	// if does then check app to extendedPair mapping has any vault key
-	// if it does throw error
-	_, userExists := k.GetUserAppExtendedPairMappingData(ctx, msg.From, msg.AppId, msg.ExtendedPairVaultId)
-
-	if userExists {
-		// _, alreadyExists := k.CheckUserAppToExtendedPairMapping(ctx, userVaultExtendedPairMapping, extendedPairVault.Id, appMapping.Id)
-		return nil, types.ErrorUserVaultAlreadyExists
-	}
 	// Call CheckAppExtendedPairVaultMapping function to get counter - it also initialised the kv store if appMapping_id does not exists, or extendedPairVault_id does not exists.
 	tokenMintedStatistics, _ := k.CheckAppExtendedPairVaultMapping(ctx, appMapping.Id, extendedPairVault.Id)
 	// Check debt Floor
@@ -267,8 +264,8 @@ func (k msgServer) MsgDeposit(c context.Context, msg *types.MsgDepositRequest) (
 	if !found {
 		return nil, types.ErrorVaultDoesNotExist
 	}
-	if userVault.Owner != msg.From {
-		return nil, types.ErrVaultAccessUnauthorised
+	if err := k.authorizeVaultAction(ctx, userVault, msg.From, types.PositionPermissionDeposit); err != nil {
+		return nil, err
 	}
 
 	if appMapping.Id != userVault.AppId {
@@ -337,11 +334,6 @@ func (k msgServer) MsgWithdraw(c context.Context, msg *types.MsgWithdrawRequest)
 		return nil, esmtypes.ErrCoolOffPeriodPassed
 	}
 
-	depositor, err := sdk.AccAddressFromBech32(msg.From)
-	if err != nil {
-		return nil, err
-	}
-
 	// checks if extended pair exists
 	extendedPairVault, found := k.asset.GetPairsVault(ctx, msg.ExtendedPairVaultId)
 	if !found {
@@ -374,8 +366,14 @@ func (k msgServer) MsgWithdraw(c context.Context, msg *types.MsgWithdrawRequest)
 	if !found {
 		return nil, types.ErrorVaultDoesNotExist
 	}
-	if userVault.Owner != msg.From {
-		return nil, types.ErrVaultAccessUnauthorised
+	if err := k.authorizeVaultAction(ctx, userVault, msg.From, types.PositionPermissionWithdrawToOwner); err != nil {
+		return nil, err
+	}
+
+	// Collateral is always released to the owner, also when a manager withdraws it.
+	owner, err := sdk.AccAddressFromBech32(userVault.Owner)
+	if err != nil {
+		return nil, err
 	}
 
 	if appMapping.Id != userVault.AppId {
@@ -407,11 +405,15 @@ func (k msgServer) MsgWithdraw(c context.Context, msg *types.MsgWithdrawRequest)
 	if esmStatus.Status {
 		totalDebtCalculation = userVault.AmountOut
 	}
-	if err := k.VerifyCollaterlizationRatio(ctx, extendedPairVault.Id, userVault.AmountIn, totalDebtCalculation, extendedPairVault.MinCr, status); err != nil {
+	minCr, closeOut := k.asset.DelistingMinCr(ctx, extendedPairVault)
+	if closeOut && totalDebtCalculation.IsPositive() {
+		return nil, types.ErrorPairDelisted
+	}
+	if err := k.VerifyVaultCollateralizationRatio(ctx, userVault, userVault.AmountIn, totalDebtCalculation, minCr, status); err != nil {
 		return nil, err
 	}
 	if msg.Amount.GT(sdk.ZeroInt()) {
-		if err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, sdk.NewCoins(sdk.NewCoin(assetInData.Denom, msg.Amount))); err != nil {
+		if err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(sdk.NewCoin(assetInData.Denom, msg.Amount))); err != nil {
 			return nil, err
 		}
 	}
@@ -438,6 +440,196 @@ func (k msgServer) MsgWithdraw(c context.Context, msg *types.MsgWithdrawRequest)
 	return &types.MsgWithdrawResponse{}, nil
 }
 
+// MsgDepositCollateral deposits a whitelisted basket asset into a vault.
+func (k msgServer) MsgDepositCollateral(c context.Context, msg *types.MsgDepositCollateralRequest) (*types.MsgDepositCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	esmStatus, found := k.esm.GetESMStatus(ctx, msg.AppId)
+	status := false
+	if found {
+		status = esmStatus.Status
+	}
+	if status {
+		return nil, esmtypes.ErrESMAlreadyExecuted
+	}
+	klwsParams, _ := k.esm.GetKillSwitchData(ctx, msg.AppId)
+	if klwsParams.BreakerEnable {
+		return nil, esmtypes.ErrCircuitBreakerEnabled
+	}
+	depositor, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedPairVault, found := k.asset.GetPairsVault(ctx, msg.ExtendedPairVaultId)
+	if !found {
+		return nil, types.ErrorExtendedPairVaultDoesNotExists
+	}
+	appMapping, found := k.asset.GetApp(ctx, msg.AppId)
+	if !found {
+		return nil, types.ErrorAppMappingDoesNotExist
+	}
+	if !extendedPairVault.IsVaultActive {
+		return nil, types.ErrorVaultInactive
+	}
+	if appMapping.Id != extendedPairVault.AppId {
+		return nil, types.ErrorAppMappingIDMismatch
+	}
+
+	userVault, found := k.GetVault(ctx, msg.UserVaultId)
+	if !found {
+		return nil, types.ErrorVaultDoesNotExist
+	}
+	if err := k.authorizeVaultAction(ctx, userVault, msg.From, types.PositionPermissionDeposit); err != nil {
+		return nil, err
+	}
+	if appMapping.Id != userVault.AppId {
+		return nil, types.ErrorInvalidAppMappingData
+	}
+	if extendedPairVault.Id != userVault.ExtendedPairVaultID {
+		return nil, types.ErrorInvalidExtendedPairMappingData
+	}
+
+	asset, found := k.asset.GetAssetForDenom(ctx, msg.Amount.Denom)
+	if !found {
+		return nil, types.ErrorAssetDoesNotExist
+	}
+	collateral, found := k.asset.GetVaultCollateralAsset(ctx, extendedPairVault.Id, asset.Id)
+	if !found {
+		return nil, types.ErrorCollateralNotWhitelisted
+	}
+	if collateral.IsDelisting() {
+		return nil, sdkerrors.Wrapf(types.ErrorCollateralDelisted, "asset %d", asset.Id)
+	}
+
+	if err := k.bank.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+	userVault.Collaterals = userVault.Collaterals.Add(msg.Amount)
+	k.SetVault(ctx, userVault)
+
+	ctx.GasMeter().ConsumeGas(types.DepositVaultGas, "DepositVaultGas")
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositCollateral,
+			sdk.NewAttribute(types.AttributeKeyVaultID, strconv.FormatUint(msg.UserVaultId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAppID, strconv.FormatUint(msg.AppId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExtendedPairID, strconv.FormatUint(msg.ExtendedPairVaultId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.From),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgDepositCollateralResponse{}, nil
+}
+
+// MsgWithdrawCollateral withdraws a basket asset from a vault to its owner.
+// Delisted assets can still be withdrawn.
+func (k msgServer) MsgWithdrawCollateral(c context.Context, msg *types.MsgWithdrawCollateralRequest) (*types.MsgWithdrawCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, msg.AppId)
+	if killSwitchParams.BreakerEnable {
+		return nil, esmtypes.ErrCircuitBreakerEnabled
+	}
+	esmStatus, found := k.esm.GetESMStatus(ctx, msg.AppId)
+	status := false
+	if found {
+		status = esmStatus.Status
+	}
+	if ctx.BlockTime().After(esmStatus.EndTime) && status {
+		return nil, esmtypes.ErrCoolOffPeriodPassed
+	}
+
+	extendedPairVault, found := k.asset.GetPairsVault(ctx, msg.ExtendedPairVaultId)
+	if !found {
+		return nil, types.ErrorExtendedPairVaultDoesNotExists
+	}
+	appMapping, found := k.asset.GetApp(ctx, msg.AppId)
+	if !found {
+		return nil, types.ErrorAppMappingDoesNotExist
+	}
+	if !extendedPairVault.IsVaultActive {
+		return nil, types.ErrorVaultInactive
+	}
+	if appMapping.Id != extendedPairVault.AppId {
+		return nil, types.ErrorAppMappingIDMismatch
+	}
+
+	userVault, found := k.GetVault(ctx, msg.UserVaultId)
+	if !found {
+		return nil, types.ErrorVaultDoesNotExist
+	}
+	if err := k.authorizeVaultAction(ctx, userVault, msg.From, types.PositionPermissionWithdrawToOwner); err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromBech32(userVault.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if appMapping.Id != userVault.AppId {
+		return nil, types.ErrorInvalidAppMappingData
+	}
+	if extendedPairVault.Id != userVault.ExtendedPairVaultID {
+		return nil, types.ErrorInvalidExtendedPairMappingData
+	}
+	asset, found := k.asset.GetAssetForDenom(ctx, msg.Amount.Denom)
+	if !found {
+		return nil, types.ErrorAssetDoesNotExist
+	}
+	// delisted collateral counts for nothing, so withdrawing it leaves the cr as is
+	collateral, found := k.asset.GetVaultCollateralAsset(ctx, extendedPairVault.Id, asset.Id)
+	isListed := found && collateral.ValueFactor(ctx.BlockTime()).IsPositive()
+
+	totalDebt := userVault.AmountOut.Add(userVault.InterestAccumulated)
+	err1 := k.rewards.CalculateVaultInterest(ctx, appMapping.Id, msg.ExtendedPairVaultId, msg.UserVaultId, totalDebt, userVault.BlockHeight, userVault.BlockTime.Unix())
+	if err1 != nil {
+		return nil, err1
+	}
+	userVault, found1 := k.GetVault(ctx, msg.UserVaultId)
+	if !found1 {
+		return nil, types.ErrorVaultDoesNotExist
+	}
+	collaterals, isNegative := userVault.Collaterals.SafeSub(sdk.NewCoins(msg.Amount))
+	if isNegative {
+		return nil, types.ErrorInvalidAmount
+	}
+	userVault.Collaterals = collaterals
+
+	totalDebtCalculation := userVault.AmountOut.Add(userVault.InterestAccumulated)
+	totalDebtCalculation = totalDebtCalculation.Add(userVault.ClosingFeeAccumulated)
+	if esmStatus.Status {
+		totalDebtCalculation = userVault.AmountOut
+	}
+	if isListed {
+		minCr, closeOut := k.asset.DelistingMinCr(ctx, extendedPairVault)
+		if closeOut && totalDebtCalculation.IsPositive() {
+			return nil, types.ErrorPairDelisted
+		}
+		if err := k.VerifyVaultCollateralizationRatio(ctx, userVault, userVault.AmountIn, totalDebtCalculation, minCr, status); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+	userVault.BlockHeight = ctx.BlockHeight()
+	userVault.BlockTime = ctx.BlockTime()
+	k.SetVault(ctx, userVault)
+
+	ctx.GasMeter().ConsumeGas(types.WithdrawVaultGas, "WithdrawVaultGas")
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawCollateral,
+			sdk.NewAttribute(types.AttributeKeyVaultID, strconv.FormatUint(msg.UserVaultId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAppID, strconv.FormatUint(msg.AppId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExtendedPairID, strconv.FormatUint(msg.ExtendedPairVaultId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.From),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgWithdrawCollateralResponse{}, nil
+}
+
 // MsgDraw To borrow more amount.
 func (k msgServer) MsgDraw(c context.Context, msg *types.MsgDrawRequest) (*types.MsgDrawResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -467,6 +659,9 @@ func (k msgServer) MsgDraw(c context.Context, msg *types.MsgDrawRequest) (*types
 	if !found {
 		return nil, types.ErrorPairDoesNotExist
 	}
+	if k.asset.IsPairDelisting(ctx, pairData.Id) {
+		return nil, types.ErrorPairDelisted
+	}
 	assetOutData, found := k.asset.GetAsset(ctx, pairData.AssetOut)
 	if !found {
 		return nil, types.ErrorAssetDoesNotExist
@@ -528,7 +723,7 @@ func (k msgServer) MsgDraw(c context.Context, msg *types.MsgDrawRequest) (*types
 		return nil, types.ErrorAmountOutGreaterThanDebtCeiling
 	}
 
-	if err := k.VerifyCollaterlizationRatio(ctx, extendedPairVault.Id, userVault.AmountIn, totalDebt, extendedPairVault.MinCr, status); err != nil {
+	if err := k.VerifyVaultCollateralizationRatio(ctx, userVault, userVault.AmountIn, totalDebt, extendedPairVault.MinCr, status); err != nil {
 		return nil, err
 	}
 
@@ -643,8 +838,8 @@ func (k msgServer) MsgRepay(c context.Context, msg *types.MsgRepayRequest) (*typ
 	if !found {
 		return nil, types.ErrorVaultDoesNotExist
 	}
-	if userVault.Owner != msg.From {
-		return nil, types.ErrVaultAccessUnauthorised
+	if err := k.authorizeVaultAction(ctx, userVault, msg.From, types.PositionPermissionRepay); err != nil {
+		return nil, err
 	}
 
 	if appMapping.Id != userVault.AppId {
@@ -870,6 +1065,11 @@ func (k msgServer) MsgClose(c context.Context, msg *types.MsgCloseRequest) (*typ
 			return nil, err
 		}
 	}
+	if !userVault.Collaterals.IsZero() {
+		if err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, userVault.Collaterals); err != nil {
+			return nil, err
+		}
+	}
 
 	// Update LookupTable minting Status
 	appExtendedPairVaultData, _ := k.GetAppExtendedPairVaultMappingData(ctx, appMapping.Id, msg.ExtendedPairVaultId)
@@ -881,7 +1081,7 @@ func (k msgServer) MsgClose(c context.Context, msg *types.MsgCloseRequest) (*typ
 	k.DeleteAddressFromAppExtendedPairVaultMapping(ctx, extendedPairVault.Id, userVault.Id, appMapping.Id)
 
 	// Remove user extendedPair to address field in UserLookupStruct
-	k.DeleteUserVaultExtendedPairMapping(ctx, msg.From, appMapping.Id, extendedPairVault.Id)
+	k.DeleteUserVaultExtendedPairMapping(ctx, msg.From, appMapping.Id, extendedPairVault.Id, userVault.Id)
 
 	// Delete Vault
 	k.DeleteVault(ctx, userVault.Id)
@@ -968,6 +1168,9 @@ func (k msgServer) MsgCreateStableMint(c context.Context, msg *types.MsgCreateSt
 	if !found {
 		return nil, types.ErrorPairDoesNotExist
 	}
+	if k.asset.IsPairDelisting(ctx, pairData.Id) {
+		return nil, types.ErrorPairDelisted
+	}
 	assetInData, found := k.asset.GetAsset(ctx, pairData.AssetIn)
 	if !found {
 		return nil, types.ErrorAssetDoesNotExist
@@ -1135,6 +1338,9 @@ func (k msgServer) MsgDepositStableMint(c context.Context, msg *types.MsgDeposit
 	if !found {
 		return nil, types.ErrorPairDoesNotExist
 	}
+	if k.asset.IsPairDelisting(ctx, pairData.Id) {
+		return nil, types.ErrorPairDelisted
+	}
 	assetInData, found := k.asset.GetAsset(ctx, pairData.AssetIn)
 	if !found {
 		return nil, types.ErrorAssetDoesNotExist
@@ -1451,3 +1657,669 @@ func (k msgServer) MsgVaultInterestCalc(c context.Context, msg *types.MsgVaultIn
 
 	return &types.MsgVaultInterestCalcResponse{}, nil
 }
+
+func (k msgServer) MsgTransferVault(c context.Context, msg *types.MsgTransferVaultRequest) (*types.MsgTransferVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	esmStatus, found := k.esm.GetESMStatus(ctx, msg.AppId)
+	status := false
+	if found {
+		status = esmStatus.Status
+	}
+	if status {
+		return nil, esmtypes.ErrESMAlreadyExecuted
+	}
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, msg.AppId)
+	if killSwitchParams.BreakerEnable {
+		return nil, esmtypes.ErrCircuitBreakerEnabled
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.To); err != nil {
+		return nil, err
+	}
+
+	userVault, found := k.GetVault(ctx, msg.UserVaultId)
+	if !found {
+		return nil, types.ErrorVaultDoesNotExist
+	}
+	if userVault.Owner != msg.From {
+		return nil, types.ErrVaultAccessUnauthorised
+	}
+	if msg.AppId != userVault.AppId {
+		return nil, types.ErrorInvalidAppMappingData
+	}
+	if msg.ExtendedPairVaultId != userVault.ExtendedPairVaultID {
+		return nil, types.ErrorInvalidExtendedPairMappingData
+	}
+
+	// Settle the interest accrued under the current owner before the vault changes hands.
+	totalDebt := userVault.AmountOut.Add(userVault.InterestAccumulated)
+	err1 := k.rewards.CalculateVaultInterest(ctx, userVault.AppId, userVault.ExtendedPairVaultID, userVault.Id, totalDebt, userVault.BlockHeight, userVault.BlockTime.Unix())
+	if err1 != nil {
+		return nil, err1
+	}
+
+	userVault, found1 := k.GetVault(ctx, msg.UserVaultId)
+	if !found1 {
+		return nil, types.ErrorVaultDoesNotExist
+	}
+
+	k.TransferVault(ctx, userVault, msg.To)
+
+	ctx.GasMeter().ConsumeGas(types.TransferVaultGas, "TransferVaultGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferVault,
+			sdk.NewAttribute(types.AttributeKeyVaultID, strconv.FormatUint(userVault.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAppID, strconv.FormatUint(msg.AppId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExtendedPairID, strconv.FormatUint(msg.ExtendedPairVaultId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.From),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.To),
+		),
+	})
+
+	return &types.MsgTransferVaultResponse{}, nil
+}
+
+func (k msgServer) MsgLeverageVault(c context.Context, msg *types.MsgLeverageVaultRequest) (*types.MsgLeverageVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	esmStatus, found := k.esm.GetESMStatus(ctx, msg.AppId)
+	status := false
+	if found {
+		status = esmStatus.Status
+	}
+	if status {
+		return nil, esmtypes.ErrESMAlreadyExecuted
+	}
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, msg.AppId)
+	if killSwitchParams.BreakerEnable {
+		return nil, esmtypes.ErrCircuitBreakerEnabled
+	}
+	depositor, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedPairVault, found := k.asset.GetPairsVault(ctx, msg.ExtendedPairVaultId)
+	if !found {
+		return nil, types.ErrorExtendedPairVaultDoesNotExists
+	}
+	pairData, found := k.asset.GetPair(ctx, extendedPairVault.PairId)
+	if !found {
+		return nil, types.ErrorPairDoesNotExist
+	}
+	if k.asset.IsPairDelisting(ctx, pairData.Id) {
+		return nil, types.ErrorPairDelisted
+	}
+	assetInData, found := k.asset.GetAsset(ctx, pairData.AssetIn)
+	if !found {
+		return nil, types.ErrorAssetDoesNotExist
+	}
+	assetOutData, found := k.asset.GetAsset(ctx, pairData.AssetOut)
+	if !found {
+		return nil, types.ErrorAssetDoesNotExist
+	}
+
+	userVault, found := k.GetVault(ctx, msg.UserVaultId)
+	if !found {
+		return nil, types.ErrorVaultDoesNotExist
+	}
+	if userVault.Owner != msg.From {
+		return nil, types.ErrVaultAccessUnauthorised
+	}
+	if msg.AppId != userVault.AppId {
+		return nil, types.ErrorInvalidAppMappingData
+	}
+	if extendedPairVault.Id != userVault.ExtendedPairVaultID {
+		return nil, types.ErrorInvalidExtendedPairMappingData
+	}
+	if msg.TargetCr.LT(extendedPairVault.MinCr) {
+		return nil, sdkerrors.Wrapf(types.ErrorInvalidTargetCR, "target_cr %s is lower than the min cr %s", msg.TargetCr, extendedPairVault.MinCr)
+	}
+
+	totalDebt := userVault.AmountOut.Add(userVault.InterestAccumulated)
+	err1 := k.rewards.CalculateVaultInterest(ctx, userVault.AppId, userVault.ExtendedPairVaultID, userVault.Id, totalDebt, userVault.BlockHeight, userVault.BlockTime.Unix())
+	if err1 != nil {
+		return nil, err1
+	}
+
+	// Each round draws debt up to the target cr at the current collateral,
+	// then deposits the swapped draw which lifts the cr above the target
+	// again, so the vault approaches the target from above.
+	var cr sdk.Dec
+	for i := 0; i < types.MaxLeverageIterations; i++ {
+		userVault, _ = k.GetVault(ctx, msg.UserVaultId)
+		totalDebt = userVault.AmountOut.Add(userVault.InterestAccumulated).Add(userVault.ClosingFeeAccumulated)
+		cr, err = k.CalculateVaultCollateralizationRatio(ctx, userVault, userVault.AmountIn, totalDebt)
+		if err != nil {
+			return nil, err
+		}
+		if cr.Quo(msg.TargetCr).Sub(sdk.OneDec()).LTE(types.LeverageCRTolerance) {
+			break
+		}
+
+		drawAmount := totalDebt.ToDec().Mul(cr.Quo(msg.TargetCr).Sub(sdk.OneDec())).TruncateInt()
+		if _, err := k.MsgDraw(c, &types.MsgDrawRequest{
+			From:                msg.From,
+			AppId:               msg.AppId,
+			ExtendedPairVaultId: msg.ExtendedPairVaultId,
+			UserVaultId:         msg.UserVaultId,
+			Amount:              drawAmount,
+		}); err != nil {
+			return nil, err
+		}
+		drawnAmount := drawAmount.Sub(sdk.NewDecFromInt(drawAmount).Mul(extendedPairVault.DrawDownFee).TruncateInt())
+
+		minAmountIn, err := k.minSwapOut(ctx, extendedPairVault.Id, drawnAmount, false, msg.MaxSlippage)
+		if err != nil {
+			return nil, err
+		}
+		swapped, err := k.liquidity.SwapExactAmountIn(ctx, msg.AppId, depositor, sdk.NewCoin(assetOutData.Denom, drawnAmount), assetInData.Denom, minAmountIn)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := k.MsgDeposit(c, &types.MsgDepositRequest{
+			From:                msg.From,
+			AppId:               msg.AppId,
+			ExtendedPairVaultId: msg.ExtendedPairVaultId,
+			UserVaultId:         msg.UserVaultId,
+			Amount:              swapped.Amount,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	userVault, _ = k.GetVault(ctx, msg.UserVaultId)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeLeverageVault,
+			sdk.NewAttribute(types.AttributeKeyVaultID, strconv.FormatUint(msg.UserVaultId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAppID, strconv.FormatUint(msg.AppId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExtendedPairID, strconv.FormatUint(msg.ExtendedPairVaultId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.From),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, userVault.AmountIn.String()),
+			sdk.NewAttribute(types.AttributeKeyAmountOut, userVault.AmountOut.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateralizationRatio, cr.String()),
+		),
+	})
+
+	return &types.MsgLeverageVaultResponse{}, nil
+}
+
+func (k msgServer) MsgDeleverageVault(c context.Context, msg *types.MsgDeleverageVaultRequest) (*types.MsgDeleverageVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	esmStatus, found := k.esm.GetESMStatus(ctx, msg.AppId)
+	status := false
+	if found {
+		status = esmStatus.Status
+	}
+	if status {
+		return nil, esmtypes.ErrESMAlreadyExecuted
+	}
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, msg.AppId)
+	if killSwitchParams.BreakerEnable {
+		return nil, esmtypes.ErrCircuitBreakerEnabled
+	}
+	depositor, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedPairVault, found := k.asset.GetPairsVault(ctx, msg.ExtendedPairVaultId)
+	if !found {
+		return nil, types.ErrorExtendedPairVaultDoesNotExists
+	}
+	pairData, found := k.asset.GetPair(ctx, extendedPairVault.PairId)
+	if !found {
+		return nil, types.ErrorPairDoesNotExist
+	}
+	assetInData, found := k.asset.GetAsset(ctx, pairData.AssetIn)
+	if !found {
+		return nil, types.ErrorAssetDoesNotExist
+	}
+	assetOutData, found := k.asset.GetAsset(ctx, pairData.AssetOut)
+	if !found {
+		return nil, types.ErrorAssetDoesNotExist
+	}
+
+	userVault, found := k.GetVault(ctx, msg.UserVaultId)
+	if !found {
+		return nil, types.ErrorVaultDoesNotExist
+	}
+	if userVault.Owner != msg.From {
+		return nil, types.ErrVaultAccessUnauthorised
+	}
+	if msg.AppId != userVault.AppId {
+		return nil, types.ErrorInvalidAppMappingData
+	}
+	if extendedPairVault.Id != userVault.ExtendedPairVaultID {
+		return nil, types.ErrorInvalidExtendedPairMappingData
+	}
+
+	totalDebt := userVault.AmountOut.Add(userVault.InterestAccumulated)
+	err1 := k.rewards.CalculateVaultInterest(ctx, userVault.AppId, userVault.ExtendedPairVaultID, userVault.Id, totalDebt, userVault.BlockHeight, userVault.BlockTime.Unix())
+	if err1 != nil {
+		return nil, err1
+	}
+
+	var cr sdk.Dec
+	for i := 0; i < types.MaxLeverageIterations; i++ {
+		userVault, _ = k.GetVault(ctx, msg.UserVaultId)
+		totalDebt = userVault.AmountOut.Add(userVault.InterestAccumulated).Add(userVault.ClosingFeeAccumulated)
+		cr, err = k.CalculateVaultCollateralizationRatio(ctx, userVault, userVault.AmountIn, totalDebt)
+		if err != nil {
+			return nil, err
+		}
+		if cr.GTE(msg.TargetCr) || sdk.OneDec().Sub(cr.Quo(msg.TargetCr)).LTE(types.LeverageCRTolerance) {
+			if i == 0 {
+				return nil, sdkerrors.Wrapf(types.ErrorInvalidTargetCR, "vault cr %s is already at or above target_cr %s", cr, msg.TargetCr)
+			}
+			break
+		}
+		if cr.LTE(sdk.OneDec()) {
+			return nil, sdkerrors.Wrapf(types.ErrorInvalidCollateralizationRatio, "vault cr %s is too low to be deleveraged", cr)
+		}
+
+		// Selling collateral worth x to repay x of the debt moves the cr to
+		// the target when x = debt * (target - cr) / (target - 1).
+		sellAmount := userVault.AmountIn.ToDec().
+			Mul(msg.TargetCr.Sub(cr)).
+			Quo(cr.Mul(msg.TargetCr.Sub(sdk.OneDec()))).Ceil().TruncateInt()
+		if sellAmount.GT(userVault.AmountIn) {
+			sellAmount = userVault.AmountIn
+		}
+
+		// The collateral leaves the vault before the debt is repaid, so the cr
+		// is only verified once the round is over.
+		userVault.AmountIn = userVault.AmountIn.Sub(sellAmount)
+		k.SetVault(ctx, userVault)
+		k.UpdateCollateralLockedAmountLockerMapping(ctx, userVault.AppId, userVault.ExtendedPairVaultID, sellAmount, false)
+		if err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, sdk.NewCoins(sdk.NewCoin(assetInData.Denom, sellAmount))); err != nil {
+			return nil, err
+		}
+
+		minAmountOut, err := k.minSwapOut(ctx, extendedPairVault.Id, sellAmount, true, msg.MaxSlippage)
+		if err != nil {
+			return nil, err
+		}
+		swapped, err := k.liquidity.SwapExactAmountIn(ctx, msg.AppId, depositor, sdk.NewCoin(assetInData.Denom, sellAmount), assetOutData.Denom, minAmountOut)
+		if err != nil {
+			return nil, err
+		}
+
+		closingFee := sdk.NewDecFromInt(swapped.Amount).Mul(extendedPairVault.ClosingFee).TruncateInt()
+		if closingFee.GT(sdk.ZeroInt()) {
+			if err := k.bank.SendCoinsFromAccountToModule(ctx, depositor, collectortypes.ModuleName, sdk.NewCoins(sdk.NewCoin(assetOutData.Denom, closingFee))); err != nil {
+				return nil, err
+			}
+			err := k.collector.UpdateCollector(ctx, userVault.AppId, pairData.AssetOut, sdk.ZeroInt(), closingFee, sdk.ZeroInt(), sdk.ZeroInt())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Proceeds above the debt stay with the owner.
+		repayAmount := sdk.MinInt(swapped.Amount.Sub(closingFee), userVault.AmountOut.Add(userVault.InterestAccumulated))
+		if repayAmount.GT(sdk.ZeroInt()) {
+			if _, err := k.MsgRepay(c, &types.MsgRepayRequest{
+				From:                msg.From,
+				AppId:               msg.AppId,
+				ExtendedPairVaultId: msg.ExtendedPairVaultId,
+				UserVaultId:         msg.UserVaultId,
+				Amount:              repayAmount,
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		userVault, _ = k.GetVault(ctx, msg.UserVaultId)
+		totalDebt = userVault.AmountOut.Add(userVault.InterestAccumulated).Add(userVault.ClosingFeeAccumulated)
+		if err := k.VerifyVaultCollateralizationRatio(ctx, userVault, userVault.AmountIn, totalDebt, extendedPairVault.MinCr, status); err != nil {
+			return nil, err
+		}
+	}
+
+	userVault, _ = k.GetVault(ctx, msg.UserVaultId)
+	cr, err = k.CalculateVaultCollateralizationRatio(ctx, userVault, userVault.AmountIn, totalDebt)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeleverageVault,
+			sdk.NewAttribute(types.AttributeKeyVaultID, strconv.FormatUint(msg.UserVaultId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAppID, strconv.FormatUint(msg.AppId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExtendedPairID, strconv.FormatUint(msg.ExtendedPairVaultId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.From),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, userVault.AmountIn.String()),
+			sdk.NewAttribute(types.AttributeKeyAmountOut, userVault.AmountOut.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateralizationRatio, cr.String()),
+		),
+	})
+
+	return &types.MsgDeleverageVaultResponse{}, nil
+}
+
+// MsgRedeem burns the redeemer's minted asset against the vaults of the
+// extended pair vault with the lowest collateralization ratio, paying out
+// their collateral at oracle price. Accumulated interest is redeemed first,
+// and a vault's debt is never redeemed below the debt floor. Vaults holding
+// basket collateral are not redeemed against.
+// The redemption fee is charged on top of the redeemed amount and sent to
+// the collector.
+func (k msgServer) MsgRedeem(c context.Context, msg *types.MsgRedeemRequest) (*types.MsgRedeemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	esmStatus, found := k.esm.GetESMStatus(ctx, msg.AppId)
+	status := false
+	if found {
+		status = esmStatus.Status
+	}
+	if status {
+		return nil, esmtypes.ErrESMAlreadyExecuted
+	}
+	killSwitchParams, _ := k.esm.GetKillSwitchData(ctx, msg.AppId)
+	if killSwitchParams.BreakerEnable {
+		return nil, esmtypes.ErrCircuitBreakerEnabled
+	}
+	redeemer, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedPairVault, found := k.asset.GetPairsVault(ctx, msg.ExtendedPairVaultId)
+	if !found {
+		return nil, types.ErrorExtendedPairVaultDoesNotExists
+	}
+	appMapping, found := k.asset.GetApp(ctx, msg.AppId)
+	if !found {
+		return nil, types.ErrorAppMappingDoesNotExist
+	}
+	if appMapping.Id != extendedPairVault.AppId {
+		return nil, types.ErrorAppMappingIDMismatch
+	}
+	pairData, found := k.asset.GetPair(ctx, extendedPairVault.PairId)
+	if !found {
+		return nil, types.ErrorPairDoesNotExist
+	}
+	assetInData, found := k.asset.GetAsset(ctx, pairData.AssetIn)
+	if !found {
+		return nil, types.ErrorAssetDoesNotExist
+	}
+	assetOutData, found := k.asset.GetAsset(ctx, pairData.AssetOut)
+	if !found {
+		return nil, types.ErrorAssetDoesNotExist
+	}
+
+	appExtendedPairVaultData, found := k.GetAppExtendedPairVaultMappingData(ctx, appMapping.Id, extendedPairVault.Id)
+	if !found || !appExtendedPairVaultData.TokenMintedAmount.IsPositive() {
+		return nil, types.ErrorNothingToRedeem
+	}
+	_, feeRate := k.RedemptionRates(ctx, appMapping.Id, extendedPairVault.Id, msg.Amount, appExtendedPairVaultData.TokenMintedAmount)
+	if feeRate.GT(msg.MaxFeeRate) {
+		return nil, sdkerrors.Wrapf(types.ErrorRedemptionFeeTooHigh, "%s is higher than %s", feeRate, msg.MaxFeeRate)
+	}
+
+	// price is the value of a unit of collateral in units of the minted asset.
+	price, err := k.CalculateCollateralizationRatio(ctx, extendedPairVault.Id, msg.Amount, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		remaining        = msg.Amount
+		totalInterest    = sdk.ZeroInt()
+		totalPrincipal   = sdk.ZeroInt()
+		totalCollateral  = sdk.ZeroInt()
+		redemptionEvents sdk.Events
+	)
+	// The index orders the vaults by the debt they last stored, so the
+	// candidates are ordered again once their interest is accrued.
+	var (
+		candidates     []types.Vault
+		redeemedVaults int
+	)
+	for _, vaultID := range k.GetVaultIDsByCR(ctx, appMapping.Id, extendedPairVault.Id, types.RedemptionCandidateVaults) {
+		userVault, _ := k.GetVault(ctx, vaultID)
+		totalDebt := userVault.AmountOut.Add(userVault.InterestAccumulated)
+		if err := k.rewards.CalculateVaultInterest(ctx, userVault.AppId, userVault.ExtendedPairVaultID, userVault.Id, totalDebt, userVault.BlockHeight, userVault.BlockTime.Unix()); err != nil {
+			return nil, err
+		}
+		userVault, _ = k.GetVault(ctx, vaultID)
+		candidates = append(candidates, userVault)
+	}
+	sortVaultsByCR(candidates)
+
+	for _, userVault := range candidates {
+		totalDebt := userVault.AmountOut.Add(userVault.InterestAccumulated)
+
+		// Vaults worth less than their debt are left to liquidation.
+		if sdk.NewDecFromInt(userVault.AmountIn).Mul(price).LT(sdk.NewDecFromInt(totalDebt)) {
+			continue
+		}
+
+		redeemable := userVault.InterestAccumulated
+		if userVault.AmountOut.GT(extendedPairVault.DebtFloor) {
+			redeemable = redeemable.Add(userVault.AmountOut.Sub(extendedPairVault.DebtFloor))
+		}
+		redeemed := sdk.MinInt(remaining, redeemable)
+		if !redeemed.IsPositive() {
+			continue
+		}
+		interest := sdk.MinInt(redeemed, userVault.InterestAccumulated)
+		principal := redeemed.Sub(interest)
+		collateral := sdk.NewDecFromInt(redeemed).Quo(price).TruncateInt()
+		collateral = sdk.MinInt(collateral, userVault.AmountIn)
+
+		userVault.InterestAccumulated = userVault.InterestAccumulated.Sub(interest)
+		userVault.AmountOut = userVault.AmountOut.Sub(principal)
+		userVault.AmountIn = userVault.AmountIn.Sub(collateral)
+		userVault.BlockHeight = ctx.BlockHeight()
+		userVault.BlockTime = ctx.BlockTime()
+		k.SetVault(ctx, userVault)
+		k.UpdateCollateralLockedAmountLockerMapping(ctx, appMapping.Id, extendedPairVault.Id, collateral, false)
+		k.UpdateTokenMintedAmountLockerMapping(ctx, appMapping.Id, extendedPairVault.Id, principal, false)
+
+		totalInterest = totalInterest.Add(interest)
+		totalPrincipal = totalPrincipal.Add(principal)
+		totalCollateral = totalCollateral.Add(collateral)
+		remaining = remaining.Sub(redeemed)
+
+		redemptionEvents = append(redemptionEvents, sdk.NewEvent(
+			types.EventTypeRedeemVault,
+			sdk.NewAttribute(types.AttributeKeyVaultID, strconv.FormatUint(userVault.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, userVault.Owner),
+			sdk.NewAttribute(types.AttributeKeyRedeemedAmount, redeemed.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateralAmount, collateral.String()),
+		))
+		redeemedVaults++
+		if remaining.IsZero() || redeemedVaults == types.MaxRedemptionVaults {
+			break
+		}
+	}
+
+	totalRedeemed := msg.Amount.Sub(remaining)
+	if !totalRedeemed.IsPositive() {
+		return nil, types.ErrorNothingToRedeem
+	}
+
+	// The base rate and the fee only account for what was actually redeemed.
+	baseRate, feeRate := k.RedemptionRates(ctx, appMapping.Id, extendedPairVault.Id, totalRedeemed, appExtendedPairVaultData.TokenMintedAmount)
+	fee := sdk.NewDecFromInt(totalRedeemed).Mul(feeRate).Ceil().TruncateInt()
+
+	if err := k.bank.SendCoinsFromAccountToModule(ctx, redeemer, types.ModuleName, sdk.NewCoins(sdk.NewCoin(assetOutData.Denom, totalRedeemed.Add(fee)))); err != nil {
+		return nil, err
+	}
+	if totalPrincipal.IsPositive() {
+		if err := k.bank.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(assetOutData.Denom, totalPrincipal))); err != nil {
+			return nil, err
+		}
+	}
+	//			SEND TO COLLECTOR----interest as stability fee & redemption fee as closing fee
+	if collected := totalInterest.Add(fee); collected.IsPositive() {
+		if err := k.bank.SendCoinsFromModuleToModule(ctx, types.ModuleName, collectortypes.ModuleName, sdk.NewCoins(sdk.NewCoin(assetOutData.Denom, collected))); err != nil {
+			return nil, err
+		}
+		if err := k.collector.UpdateCollector(ctx, appMapping.Id, pairData.AssetOut, totalInterest, fee, sdk.ZeroInt(), sdk.ZeroInt()); err != nil {
+			return nil, err
+		}
+	}
+	if totalCollateral.IsPositive() {
+		if err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, sdk.NewCoins(sdk.NewCoin(assetInData.Denom, totalCollateral))); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetRedemptionState(ctx, types.RedemptionState{
+		AppId:               appMapping.Id,
+		ExtendedPairVaultID: extendedPairVault.Id,
+		BaseRate:            baseRate,
+		LastRedemptionTime:  ctx.BlockTime(),
+	})
+
+	ctx.GasMeter().ConsumeGas(types.RedeemGas, "RedeemGas")
+
+	ctx.EventManager().EmitEvents(redemptionEvents)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeem,
+			sdk.NewAttribute(types.AttributeKeyRedeemer, msg.From),
+			sdk.NewAttribute(types.AttributeKeyAppID, strconv.FormatUint(msg.AppId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExtendedPairID, strconv.FormatUint(msg.ExtendedPairVaultId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRedeemedAmount, totalRedeemed.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateralAmount, totalCollateral.String()),
+			sdk.NewAttribute(types.AttributeKeyRedemptionFee, fee.String()),
+		),
+	})
+
+	return &types.MsgRedeemResponse{}, nil
+}
+
+func (k msgServer) MsgGrantPositionManager(c context.Context, msg *types.MsgGrantPositionManagerRequest) (*types.MsgGrantPositionManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	grant := types.PositionManagerGrant{
+		Owner:       msg.From,
+		Manager:     msg.Manager,
+		Permissions: msg.Permissions,
+	}
+	k.SetPositionManagerGrant(ctx, grant)
+
+	permissions := make([]string, 0, len(msg.Permissions))
+	for _, p := range msg.Permissions {
+		permissions = append(permissions, p.String())
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantPositionManager,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.From),
+			sdk.NewAttribute(types.AttributeKeyManager, msg.Manager),
+			sdk.NewAttribute(types.AttributeKeyPermissions, strings.Join(permissions, ",")),
+		),
+	})
+
+	return &types.MsgGrantPositionManagerResponse{}, nil
+}
+
+func (k msgServer) MsgRevokePositionManager(c context.Context, msg *types.MsgRevokePositionManagerRequest) (*types.MsgRevokePositionManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetPositionManagerGrant(ctx, owner, manager); !found {
+		return nil, types.ErrorPositionManagerGrantNotFound
+	}
+	k.DeletePositionManagerGrant(ctx, owner, manager)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokePositionManager,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.From),
+			sdk.NewAttribute(types.AttributeKeyManager, msg.Manager),
+		),
+	})
+
+	return &types.MsgRevokePositionManagerResponse{}, nil
+}
+
+func (k msgServer) MsgSetVaultProtection(c context.Context, msg *types.MsgSetVaultProtectionRequest) (*types.MsgSetVaultProtectionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	extendedPairVault, found := k.asset.GetPairsVault(ctx, msg.ExtendedPairVaultId)
+	if !found {
+		return nil, types.ErrorExtendedPairVaultDoesNotExists
+	}
+	userVault, found := k.GetVault(ctx, msg.UserVaultId)
+	if !found {
+		return nil, types.ErrorVaultDoesNotExist
+	}
+	if userVault.Owner != msg.From {
+		return nil, types.ErrVaultAccessUnauthorised
+	}
+	if msg.AppId != userVault.AppId {
+		return nil, types.ErrorInvalidAppMappingData
+	}
+	if extendedPairVault.Id != userVault.ExtendedPairVaultID {
+		return nil, types.ErrorInvalidExtendedPairMappingData
+	}
+	// A protection triggering at or below the min cr would only run after
+	// the vault can already be liquidated.
+	if msg.TriggerCr.LTE(extendedPairVault.MinCr) {
+		return nil, sdkerrors.Wrapf(types.ErrorInvalidTriggerCR, "trigger_cr must be greater than the min cr %s", extendedPairVault.MinCr)
+	}
+
+	k.SetVaultProtection(ctx, types.VaultProtection{
+		VaultID:     userVault.Id,
+		Owner:       userVault.Owner,
+		TriggerCr:   msg.TriggerCr,
+		TargetCr:    msg.TargetCr,
+		Action:      msg.Action,
+		MaxAmount:   msg.MaxAmount,
+		AmountUsed:  sdk.ZeroInt(),
+		MaxSlippage: msg.MaxSlippage,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetVaultProtection,
+			sdk.NewAttribute(types.AttributeKeyVaultID, strconv.FormatUint(msg.UserVaultId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.From),
+			sdk.NewAttribute(types.AttributeKeyTriggerCR, msg.TriggerCr.String()),
+			sdk.NewAttribute(types.AttributeKeyTargetCR, msg.TargetCr.String()),
+			sdk.NewAttribute(types.AttributeKeyAction, msg.Action.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.MaxAmount.String()),
+		),
+	})
+
+	return &types.MsgSetVaultProtectionResponse{}, nil
+}
+
+func (k msgServer) MsgRemoveVaultProtection(c context.Context, msg *types.MsgRemoveVaultProtectionRequest) (*types.MsgRemoveVaultProtectionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	protection, found := k.GetVaultProtection(ctx, msg.UserVaultId)
+	if !found {
+		return nil, types.ErrorVaultProtectionNotFound
+	}
+	if protection.Owner != msg.From {
+		return nil, types.ErrVaultAccessUnauthorised
+	}
+	k.DeleteVaultProtection(ctx, msg.UserVaultId)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveVaultProtection,
+			sdk.NewAttribute(types.AttributeKeyVaultID, strconv.FormatUint(msg.UserVaultId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.From),
+		),
+	})
+
+	return &types.MsgRemoveVaultProtectionResponse{}, nil
+}
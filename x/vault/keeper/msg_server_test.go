@@ -2,10 +2,19 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
+
 	utils "github.com/comdex-official/comdex/types"
+	assettypes "github.com/comdex-official/comdex/x/asset/types"
+	collectortypes "github.com/comdex-official/comdex/x/collector/types"
+	liquidationtypes "github.com/comdex-official/comdex/x/liquidation/types"
+	liquiditytypes "github.com/comdex-official/comdex/x/liquidity/types"
+	"github.com/comdex-official/comdex/x/vault"
 	"github.com/comdex-official/comdex/x/vault/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	_ "github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func (s *KeeperTestSuite) TestMsgCreate() {
@@ -180,17 +189,6 @@ func (s *KeeperTestSuite) TestMsgCreate() {
 			},
 			AvailableBalance: sdk.NewCoins(sdk.NewCoin("uasset2", newInt(198000000))),
 		},
-		{
-			Name: "error user vault already exists",
-			Msg: *types.NewMsgCreateRequest(
-				addr1, appID1, extendedVaultPairID1, newInt(1000000000), newInt(200000000),
-			),
-			ExpErr:             types.ErrorUserVaultAlreadyExists,
-			ExpResp:            nil,
-			QueryResponseIndex: 0,
-			QueryResponse:      nil,
-			AvailableBalance:   sdk.NewCoins(sdk.NewCoin("uasset2", newInt(0))),
-		},
 		{
 			Name: "success valid case app2 user1",
 			Msg: *types.NewMsgCreateRequest(
@@ -227,6 +225,24 @@ func (s *KeeperTestSuite) TestMsgCreate() {
 			},
 			AvailableBalance: sdk.NewCoins(sdk.NewCoin("uasset2", newInt(198000000*2))),
 		},
+		{
+			Name: "success second vault on the same extended pair app1 user1",
+			Msg: *types.NewMsgCreateRequest(
+				addr1, appID1, extendedVaultPairID1, newInt(1000000000), newInt(200000000),
+			),
+			ExpErr:             nil,
+			ExpResp:            &types.MsgCreateResponse{},
+			QueryResponseIndex: 4,
+			QueryResponse: &types.Vault{
+				Id:                  5,
+				AppId:               appID1,
+				ExtendedPairVaultID: extendedVaultPairID1,
+				Owner:               addr1.String(),
+				AmountIn:            newInt(1000000000),
+				AmountOut:           newInt(200000000),
+			},
+			AvailableBalance: sdk.NewCoins(sdk.NewCoin("uasset2", newInt(198000000*3))),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2121,3 +2137,653 @@ func (s *KeeperTestSuite) TestMsgVaultInterestCalc() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgTransferVault() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+	asseOneID := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asseTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+	pairID := s.CreateNewPair(addr1, asseOneID, asseTwoID)
+	extendedVaultPairID1 := s.CreateNewExtendedVaultPair("CMDX-C", appID1, pairID, false, true)
+
+	// addr1 opens two vaults on the same extended pair.
+	for i := 0; i < 2; i++ {
+		msg := types.NewMsgCreateRequest(addr1, appID1, extendedVaultPairID1, newInt(1000000000), newInt(200000000))
+		s.fundAddr(addr1, sdk.NewCoins(sdk.NewCoin("uasset1", msg.AmountIn)))
+		_, err := s.msgServer.MsgCreate(sdk.WrapSDKContext(s.ctx), msg)
+		s.Require().NoError(err)
+	}
+
+	res, err := s.querier.QueryVaultIDOfOwnerByExtendedPairAndApp(sdk.WrapSDKContext(s.ctx), &types.QueryVaultIDOfOwnerByExtendedPairAndAppRequest{
+		AppId: appID1, Owner: addr1.String(), ExtendedPairId: extendedVaultPairID1,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.Vault_Id)
+	s.Require().Equal([]uint64{1, 2}, res.VaultIds)
+
+	s.app.LiquidationKeeper.SetLockedVaultHistory(s.ctx, liquidationtypes.LockedVault{AppId: appID1, OriginalVaultId: 1, Owner: addr1.String()}, 1)
+	s.app.LiquidationKeeper.SetLockedVaultHistory(s.ctx, liquidationtypes.LockedVault{AppId: appID1, OriginalVaultId: 2, Owner: addr1.String()}, 2)
+
+	testCases := []struct {
+		Name   string
+		Msg    types.MsgTransferVaultRequest
+		ExpErr error
+	}{
+		{
+			Name:   "error vault does not exists",
+			Msg:    *types.NewMsgTransferVaultRequest(addr1, appID1, extendedVaultPairID1, 3, addr2),
+			ExpErr: types.ErrorVaultDoesNotExist,
+		},
+		{
+			Name:   "error access unauthorized",
+			Msg:    *types.NewMsgTransferVaultRequest(addr2, appID1, extendedVaultPairID1, 1, addr1),
+			ExpErr: types.ErrVaultAccessUnauthorised,
+		},
+		{
+			Name:   "error invalid extended pair",
+			Msg:    *types.NewMsgTransferVaultRequest(addr1, appID1, 2, 1, addr2),
+			ExpErr: types.ErrorInvalidExtendedPairMappingData,
+		},
+		{
+			Name:   "success valid case",
+			Msg:    *types.NewMsgTransferVaultRequest(addr1, appID1, extendedVaultPairID1, 1, addr2),
+			ExpErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.Name, func() {
+			resp, err := s.msgServer.MsgTransferVault(sdk.WrapSDKContext(s.ctx), &tc.Msg)
+			if tc.ExpErr != nil {
+				s.Require().Error(err)
+				s.Require().EqualError(err, tc.ExpErr.Error())
+				s.Require().Nil(resp)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(&types.MsgTransferVaultResponse{}, resp)
+			}
+		})
+	}
+
+	vault, found := s.keeper.GetVault(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(addr2.String(), vault.Owner)
+
+	ids, err := s.querier.QueryAllVaultIdsByAnOwner(sdk.WrapSDKContext(s.ctx), &types.QueryAllVaultIdsByAnOwnerRequest{Owner: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{2}, ids.VaultIds)
+	ids, err = s.querier.QueryAllVaultIdsByAnOwner(sdk.WrapSDKContext(s.ctx), &types.QueryAllVaultIdsByAnOwnerRequest{Owner: addr2.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1}, ids.VaultIds)
+
+	info, err := s.querier.QueryVaultInfoOfOwnerByApp(sdk.WrapSDKContext(s.ctx), &types.QueryVaultInfoOfOwnerByAppRequest{AppId: appID1, Owner: addr2.String()})
+	s.Require().NoError(err)
+	s.Require().Len(info.VaultsInfo, 1)
+	s.Require().Equal(uint64(1), info.VaultsInfo[0].Id)
+
+	lockedVault, found := s.app.LiquidationKeeper.GetLockedVaultHistory(s.ctx, appID1, 1)
+	s.Require().True(found)
+	s.Require().Equal(addr2.String(), lockedVault.Owner)
+	lockedVault, found = s.app.LiquidationKeeper.GetLockedVaultHistory(s.ctx, appID1, 2)
+	s.Require().True(found)
+	s.Require().Equal(addr1.String(), lockedVault.Owner)
+
+	// The new owner can close the transferred vault.
+	s.fundAddr(addr2, sdk.NewCoins(sdk.NewCoin("uasset2", newInt(200000000))))
+	_, err = s.msgServer.MsgClose(sdk.WrapSDKContext(s.ctx), types.NewMsgLiquidateRequest(addr2, appID1, extendedVaultPairID1, 1))
+	s.Require().NoError(err)
+	_, found = s.keeper.GetUserAppExtendedPairMappingData(s.ctx, addr2.String(), appID1, extendedVaultPairID1)
+	s.Require().False(found)
+	mappings, found := s.keeper.GetUserAppExtendedPairMappingData(s.ctx, addr1.String(), appID1, extendedVaultPairID1)
+	s.Require().True(found)
+	s.Require().Len(mappings, 1)
+	s.Require().Equal(uint64(2), mappings[0].VaultId)
+}
+
+func (s *KeeperTestSuite) TestMsgPositionManager() {
+	owner := s.addr(1)
+	manager := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+	asseOneID := s.CreateNewAsset("ASSETONE", "uasset1", 1000000)
+	asseTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 2000000)
+	pairID := s.CreateNewPair(owner, asseOneID, asseTwoID)
+	extendedVaultPairID1 := s.CreateNewExtendedVaultPair("CMDX-C", appID1, pairID, false, true)
+
+	msgCreate := types.NewMsgCreateRequest(owner, appID1, extendedVaultPairID1, newInt(1000000000), newInt(200000000))
+	s.fundAddr(owner, sdk.NewCoins(sdk.NewCoin("uasset1", msgCreate.AmountIn)))
+	_, err := s.msgServer.MsgCreate(sdk.WrapSDKContext(s.ctx), msgCreate)
+	s.Require().NoError(err)
+
+	deposit := types.NewMsgDepositRequest(manager, appID1, extendedVaultPairID1, 1, newInt(100000000))
+	s.fundAddr(manager, sdk.NewCoins(sdk.NewCoin("uasset1", newInt(100000000)), sdk.NewCoin("uasset2", newInt(10000000))))
+
+	// Without a grant the manager cannot touch the vault.
+	_, err = s.msgServer.MsgDeposit(sdk.WrapSDKContext(s.ctx), deposit)
+	s.Require().ErrorIs(err, types.ErrVaultAccessUnauthorised)
+
+	_, err = s.msgServer.MsgGrantPositionManager(sdk.WrapSDKContext(s.ctx), types.NewMsgGrantPositionManagerRequest(
+		owner, manager, []types.PositionPermission{types.PositionPermissionDeposit, types.PositionPermissionRepay},
+	))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.MsgDeposit(sdk.WrapSDKContext(s.ctx), deposit)
+	s.Require().NoError(err)
+	_, err = s.msgServer.MsgRepay(sdk.WrapSDKContext(s.ctx), types.NewMsgRepayRequest(manager, appID1, extendedVaultPairID1, 1, newInt(10000000)))
+	s.Require().NoError(err)
+	vault, found := s.keeper.GetVault(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(newInt(1100000000), vault.AmountIn)
+	s.Require().Equal(owner.String(), vault.Owner)
+
+	// Withdraw needs its own permission, and drawing debt is never delegated.
+	withdraw := types.NewMsgWithdrawRequest(manager, appID1, extendedVaultPairID1, 1, newInt(50000000))
+	_, err = s.msgServer.MsgWithdraw(sdk.WrapSDKContext(s.ctx), withdraw)
+	s.Require().ErrorIs(err, types.ErrVaultAccessUnauthorised)
+	_, err = s.msgServer.MsgDraw(sdk.WrapSDKContext(s.ctx), types.NewMsgDrawRequest(manager, appID1, extendedVaultPairID1, 1, newInt(1000000)))
+	s.Require().ErrorIs(err, types.ErrVaultAccessUnauthorised)
+
+	_, err = s.msgServer.MsgGrantPositionManager(sdk.WrapSDKContext(s.ctx), types.NewMsgGrantPositionManagerRequest(
+		owner, manager, []types.PositionPermission{types.PositionPermissionWithdrawToOwner},
+	))
+	s.Require().NoError(err)
+	_, err = s.msgServer.MsgDeposit(sdk.WrapSDKContext(s.ctx), deposit)
+	s.Require().ErrorIs(err, types.ErrVaultAccessUnauthorised)
+
+	// Withdrawn collateral goes to the owner, never to the manager.
+	_, err = s.msgServer.MsgWithdraw(sdk.WrapSDKContext(s.ctx), withdraw)
+	s.Require().NoError(err)
+	s.Require().Equal(newInt(50000000), s.getBalance(owner, "uasset1").Amount)
+	s.Require().True(s.getBalance(manager, "uasset1").Amount.IsZero())
+
+	res, err := s.querier.QueryPositionManagerGrants(sdk.WrapSDKContext(s.ctx), &types.QueryPositionManagerGrantsRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Grants, 1)
+	s.Require().Equal(manager.String(), res.Grants[0].Manager)
+
+	_, err = s.msgServer.MsgRevokePositionManager(sdk.WrapSDKContext(s.ctx), types.NewMsgRevokePositionManagerRequest(owner, manager))
+	s.Require().NoError(err)
+	_, err = s.msgServer.MsgRevokePositionManager(sdk.WrapSDKContext(s.ctx), types.NewMsgRevokePositionManagerRequest(owner, manager))
+	s.Require().ErrorIs(err, types.ErrorPositionManagerGrantNotFound)
+	_, err = s.msgServer.MsgWithdraw(sdk.WrapSDKContext(s.ctx), withdraw)
+	s.Require().ErrorIs(err, types.ErrVaultAccessUnauthorised)
+}
+
+func (s *KeeperTestSuite) TestMsgLeverageVault() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+	asseOneID := s.CreateNewAsset("ASSETONE", "uasset1", 2000000)
+	asseTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+	pairID := s.CreateNewPair(addr1, asseOneID, asseTwoID)
+	extendedVaultPairID1 := s.CreateNewExtendedVaultPair("CMDX-C", appID1, pairID, false, true)
+	s.CreateNewLiquidityPool(appID1, addr2, "uasset1", "uasset2", "1000000000000uasset1,2000000000000uasset2")
+
+	msg := types.NewMsgCreateRequest(addr1, appID1, extendedVaultPairID1, newInt(1000000000), newInt(200000000))
+	s.fundAddr(addr1, sdk.NewCoins(sdk.NewCoin("uasset1", msg.AmountIn)))
+	_, err := s.msgServer.MsgCreate(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		Name   string
+		Msg    types.MsgLeverageVaultRequest
+		ExpErr error
+	}{
+		{
+			Name:   "error access unauthorized",
+			Msg:    *types.NewMsgLeverageVaultRequest(addr2, appID1, extendedVaultPairID1, 1, utils.ParseDec("3"), utils.ParseDec("0.05")),
+			ExpErr: types.ErrVaultAccessUnauthorised,
+		},
+		{
+			Name:   "error target cr lower than min cr",
+			Msg:    *types.NewMsgLeverageVaultRequest(addr1, appID1, extendedVaultPairID1, 1, utils.ParseDec("2"), utils.ParseDec("0.05")),
+			ExpErr: sdkerrors.Wrapf(types.ErrorInvalidTargetCR, "target_cr %s is lower than the min cr %s", utils.ParseDec("2"), utils.ParseDec("2.3")),
+		},
+		{
+			Name:   "error slippage exceeded",
+			Msg:    *types.NewMsgLeverageVaultRequest(addr1, appID1, extendedVaultPairID1, 1, utils.ParseDec("3"), utils.ParseDec("0")),
+			ExpErr: liquiditytypes.ErrTooSmallSwapOutAmount,
+		},
+		{
+			Name:   "success valid case",
+			Msg:    *types.NewMsgLeverageVaultRequest(addr1, appID1, extendedVaultPairID1, 1, utils.ParseDec("3"), utils.ParseDec("0.05")),
+			ExpErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.Name, func() {
+			cacheCtx, _ := s.ctx.CacheContext()
+			resp, err := s.msgServer.MsgLeverageVault(sdk.WrapSDKContext(cacheCtx), &tc.Msg)
+			if tc.ExpErr != nil {
+				s.Require().ErrorIs(err, tc.ExpErr)
+				s.Require().Contains(err.Error(), tc.ExpErr.Error())
+				s.Require().Nil(resp)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(&types.MsgLeverageVaultResponse{}, resp)
+
+			vault, found := s.keeper.GetVault(cacheCtx, 1)
+			s.Require().True(found)
+			s.Require().True(vault.AmountIn.GT(newInt(1000000000)))
+			s.Require().True(vault.AmountOut.GT(newInt(200000000)))
+			cr, err := s.keeper.CalculateCollateralizationRatio(cacheCtx, extendedVaultPairID1, vault.AmountIn, vault.AmountOut)
+			s.Require().NoError(err)
+			s.Require().True(cr.GTE(utils.ParseDec("3")))
+			s.Require().True(cr.LT(utils.ParseDec("3.01")))
+			// Everything drawn went back into the vault.
+			s.Require().True(s.getBalance(addr1, "uasset2").Amount.Equal(newInt(198000000)))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgDeleverageVault() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+	asseOneID := s.CreateNewAsset("ASSETONE", "uasset1", 2000000)
+	asseTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+	pairID := s.CreateNewPair(addr1, asseOneID, asseTwoID)
+	extendedVaultPairID1 := s.CreateNewExtendedVaultPair("CMDX-C", appID1, pairID, false, true)
+	s.CreateNewLiquidityPool(appID1, addr2, "uasset1", "uasset2", "1000000000000uasset1,2000000000000uasset2")
+
+	msg := types.NewMsgCreateRequest(addr1, appID1, extendedVaultPairID1, newInt(1000000000), newInt(800000000))
+	s.fundAddr(addr1, sdk.NewCoins(sdk.NewCoin("uasset1", msg.AmountIn)))
+	_, err := s.msgServer.MsgCreate(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		Name   string
+		Msg    types.MsgDeleverageVaultRequest
+		ExpErr error
+	}{
+		{
+			Name:   "error access unauthorized",
+			Msg:    *types.NewMsgDeleverageVaultRequest(addr2, appID1, extendedVaultPairID1, 1, utils.ParseDec("4"), utils.ParseDec("0.05")),
+			ExpErr: types.ErrVaultAccessUnauthorised,
+		},
+		{
+			Name:   "error vault already above target cr",
+			Msg:    *types.NewMsgDeleverageVaultRequest(addr1, appID1, extendedVaultPairID1, 1, utils.ParseDec("2.4"), utils.ParseDec("0.05")),
+			ExpErr: types.ErrorInvalidTargetCR,
+		},
+		{
+			Name:   "error debt below debt floor",
+			Msg:    *types.NewMsgDeleverageVaultRequest(addr1, appID1, extendedVaultPairID1, 1, utils.ParseDec("50"), utils.ParseDec("0.05")),
+			ExpErr: types.ErrorAmountOutLessThanDebtFloor,
+		},
+		{
+			Name:   "success valid case",
+			Msg:    *types.NewMsgDeleverageVaultRequest(addr1, appID1, extendedVaultPairID1, 1, utils.ParseDec("4"), utils.ParseDec("0.05")),
+			ExpErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.Name, func() {
+			cacheCtx, _ := s.ctx.CacheContext()
+			resp, err := s.msgServer.MsgDeleverageVault(sdk.WrapSDKContext(cacheCtx), &tc.Msg)
+			if tc.ExpErr != nil {
+				s.Require().ErrorIs(err, tc.ExpErr)
+				s.Require().Nil(resp)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(&types.MsgDeleverageVaultResponse{}, resp)
+
+			vault, found := s.keeper.GetVault(cacheCtx, 1)
+			s.Require().True(found)
+			s.Require().True(vault.AmountIn.LT(newInt(1000000000)))
+			s.Require().True(vault.AmountOut.LT(newInt(800000000)))
+			cr, err := s.keeper.CalculateCollateralizationRatio(cacheCtx, extendedVaultPairID1, vault.AmountIn, vault.AmountOut)
+			s.Require().NoError(err)
+			s.Require().True(cr.GTE(utils.ParseDec("3.99")))
+			s.Require().True(cr.LT(utils.ParseDec("4.1")))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgRedeem() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+	addr3 := s.addr(3)
+
+	appID1 := s.CreateNewApp("appone")
+	appID2 := s.CreateNewApp("apptwo")
+	asseOneID := s.CreateNewAsset("ASSETONE", "uasset1", 2000000)
+	asseTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+	pairID := s.CreateNewPair(addr1, asseOneID, asseTwoID)
+	extendedVaultPairID1 := s.CreateNewExtendedVaultPair("CMDX-C", appID1, pairID, false, true)
+	extendedVaultPairID2 := s.CreateNewExtendedVaultPair("CMDX-D", appID1, pairID, false, true)
+
+	for _, v := range []struct {
+		owner     sdk.AccAddress
+		amountOut int64
+	}{
+		{addr1, 800000000},
+		{addr2, 600000000},
+		{addr1, 200000000},
+	} {
+		msg := types.NewMsgCreateRequest(v.owner, appID1, extendedVaultPairID1, newInt(1000000000), newInt(v.amountOut))
+		s.fundAddr(v.owner, sdk.NewCoins(sdk.NewCoin("uasset1", msg.AmountIn)))
+		_, err := s.msgServer.MsgCreate(sdk.WrapSDKContext(s.ctx), msg)
+		s.Require().NoError(err)
+	}
+	s.Require().Equal([]uint64{1, 2, 3}, s.keeper.GetVaultIDsByCR(s.ctx, appID1, extendedVaultPairID1, 10))
+
+	s.fundAddr(addr3, sdk.NewCoins(sdk.NewCoin("uasset2", newInt(2000000000))))
+
+	testCases := []struct {
+		Name   string
+		Msg    types.MsgRedeemRequest
+		ExpErr error
+	}{
+		{
+			Name:   "error app mismatch",
+			Msg:    *types.NewMsgRedeemRequest(addr3, appID2, extendedVaultPairID1, newInt(800000000), utils.ParseDec("0.5")),
+			ExpErr: types.ErrorAppMappingIDMismatch,
+		},
+		{
+			Name:   "error nothing minted",
+			Msg:    *types.NewMsgRedeemRequest(addr3, appID1, extendedVaultPairID2, newInt(800000000), utils.ParseDec("0.5")),
+			ExpErr: types.ErrorNothingToRedeem,
+		},
+		{
+			Name:   "error fee higher than max fee rate",
+			Msg:    *types.NewMsgRedeemRequest(addr3, appID1, extendedVaultPairID1, newInt(800000000), utils.ParseDec("0.1")),
+			ExpErr: types.ErrorRedemptionFeeTooHigh,
+		},
+		{
+			Name:   "error insufficient funds",
+			Msg:    *types.NewMsgRedeemRequest(addr1, appID1, extendedVaultPairID1, newInt(800000000), utils.ParseDec("0.5")),
+			ExpErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.Name, func() {
+			cacheCtx, _ := s.ctx.CacheContext()
+			resp, err := s.msgServer.MsgRedeem(sdk.WrapSDKContext(cacheCtx), &tc.Msg)
+			s.Require().ErrorIs(err, tc.ExpErr)
+			s.Require().Nil(resp)
+		})
+	}
+
+	collectorAddr := s.app.AccountKeeper.GetModuleAddress(collectortypes.ModuleName)
+	collectorBalance := s.getBalance(collectorAddr, "uasset2")
+
+	// The lowest collateralized vault is redeemed down to the debt floor,
+	// then the next one.
+	_, err := s.msgServer.MsgRedeem(sdk.WrapSDKContext(s.ctx), types.NewMsgRedeemRequest(addr3, appID1, extendedVaultPairID1, newInt(800000000), utils.ParseDec("0.5")))
+	s.Require().NoError(err)
+
+	vault, found := s.keeper.GetVault(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(newInt(650000000), vault.AmountIn)
+	s.Require().Equal(newInt(100000000), vault.AmountOut)
+	vault, found = s.keeper.GetVault(s.ctx, 2)
+	s.Require().True(found)
+	s.Require().Equal(newInt(950000000), vault.AmountIn)
+	s.Require().Equal(newInt(500000000), vault.AmountOut)
+	vault, found = s.keeper.GetVault(s.ctx, 3)
+	s.Require().True(found)
+	s.Require().Equal(newInt(1000000000), vault.AmountIn)
+	s.Require().Equal(newInt(200000000), vault.AmountOut)
+
+	// base rate 0.5 * 800 / 1600 = 0.25, fee rate 0.255
+	s.Require().Equal(newInt(400000000), s.getBalance(addr3, "uasset1").Amount)
+	s.Require().Equal(newInt(2000000000-800000000-204000000), s.getBalance(addr3, "uasset2").Amount)
+	s.Require().Equal(collectorBalance.Amount.Add(newInt(204000000)), s.getBalance(collectorAddr, "uasset2").Amount)
+
+	mappingData, found := s.keeper.GetAppExtendedPairVaultMappingData(s.ctx, appID1, extendedVaultPairID1)
+	s.Require().True(found)
+	s.Require().Equal(newInt(800000000), mappingData.TokenMintedAmount)
+	s.Require().Equal(newInt(2600000000), mappingData.CollateralLockedAmount)
+
+	state, found := s.keeper.GetRedemptionState(s.ctx, appID1, extendedVaultPairID1)
+	s.Require().True(found)
+	s.Require().Equal(utils.ParseDec("0.25"), state.BaseRate)
+
+	// The index follows the new collateralization ratios.
+	s.Require().Equal([]uint64{2, 3, 1}, s.keeper.GetVaultIDsByCR(s.ctx, appID1, extendedVaultPairID1, 10))
+
+	// Vaults holding basket collateral leave the index.
+	vault, found = s.keeper.GetVault(s.ctx, 2)
+	s.Require().True(found)
+	vault.Collaterals = sdk.NewCoins(sdk.NewCoin("uasset3", newInt(1000000)))
+	s.keeper.SetVault(s.ctx, vault)
+	s.Require().Equal([]uint64{3, 1}, s.keeper.GetVaultIDsByCR(s.ctx, appID1, extendedVaultPairID1, 10))
+	vault.Collaterals = nil
+	s.keeper.SetVault(s.ctx, vault)
+	s.Require().Equal([]uint64{2, 3, 1}, s.keeper.GetVaultIDsByCR(s.ctx, appID1, extendedVaultPairID1, 10))
+
+	// The base rate decays over time.
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(types.RedemptionBaseRateHalfLife))
+	baseRate, feeRate := s.keeper.RedemptionRates(s.ctx, appID1, extendedVaultPairID1, sdk.ZeroInt(), mappingData.TokenMintedAmount)
+	s.Require().Equal(utils.ParseDec("0.125"), baseRate)
+	s.Require().Equal(utils.ParseDec("0.13"), feeRate)
+}
+
+func (s *KeeperTestSuite) TestVaultCRIndexRatioAboveSortableRange() {
+	vault := types.Vault{
+		Id:                  1,
+		AppId:               1,
+		ExtendedPairVaultID: 1,
+		Owner:               s.addr(1).String(),
+		AmountIn:            sdk.NewIntWithDecimal(1, 20),
+		AmountOut:           newInt(1),
+		InterestAccumulated: sdk.ZeroInt(),
+	}
+	// Ratios above the sortable range are indexed at its top.
+	s.keeper.SetVault(s.ctx, vault)
+	s.Require().Equal([]uint64{1}, s.keeper.GetVaultIDsByCR(s.ctx, 1, 1, 10))
+
+	// Updating the vault drops its clamped key.
+	vault.AmountOut = sdk.NewIntWithDecimal(1, 20)
+	s.keeper.SetVault(s.ctx, vault)
+	s.Require().Equal([]uint64{1}, s.keeper.GetVaultIDsByCR(s.ctx, 1, 1, 10))
+	s.keeper.DeleteVault(s.ctx, vault.Id)
+	s.Require().Empty(s.keeper.GetVaultIDsByCR(s.ctx, 1, 1, 10))
+}
+
+func (s *KeeperTestSuite) TestMsgRedeemAccruesInterestFirst() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+	addr3 := s.addr(3)
+
+	appID1 := s.CreateNewApp("appone")
+	asseOneID := s.CreateNewAsset("ASSETONE", "uasset1", 2000000)
+	asseTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+	pairID := s.CreateNewPair(addr1, asseOneID, asseTwoID)
+	extendedVaultPairID1 := s.CreateNewExtendedVaultPair("CMDX-C", appID1, pairID, false, true)
+	s.Require().NoError(s.rewardsKeeper.WhitelistAppIDVault(s.ctx, appID1))
+
+	create := func(owner sdk.AccAddress, amountOut int64) {
+		msg := types.NewMsgCreateRequest(owner, appID1, extendedVaultPairID1, newInt(1000000000), newInt(amountOut))
+		s.fundAddr(owner, sdk.NewCoins(sdk.NewCoin("uasset1", msg.AmountIn)))
+		_, err := s.msgServer.MsgCreate(sdk.WrapSDKContext(s.ctx), msg)
+		s.Require().NoError(err)
+	}
+
+	// The first vault stores less debt, but accrues ten years of interest
+	// before the second is opened.
+	s.ctx = s.ctx.WithBlockHeight(10)
+	create(addr1, 495000000)
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().AddDate(10, 0, 0)).WithBlockHeight(20)
+	create(addr2, 500000000)
+	s.Require().Equal([]uint64{2, 1}, s.keeper.GetVaultIDsByCR(s.ctx, appID1, extendedVaultPairID1, 10))
+
+	s.fundAddr(addr3, sdk.NewCoins(sdk.NewCoin("uasset2", newInt(20000000))))
+	_, err := s.msgServer.MsgRedeem(sdk.WrapSDKContext(s.ctx), types.NewMsgRedeemRequest(addr3, appID1, extendedVaultPairID1, newInt(10000000), utils.ParseDec("0.5")))
+	s.Require().NoError(err)
+
+	// With its interest accrued the first vault is the riskiest and is redeemed.
+	vault, found := s.keeper.GetVault(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(newInt(995000000), vault.AmountIn)
+	vault, found = s.keeper.GetVault(s.ctx, 2)
+	s.Require().True(found)
+	s.Require().Equal(newInt(1000000000), vault.AmountIn)
+	s.Require().Equal(newInt(500000000), vault.AmountOut)
+	s.Require().Equal([]uint64{1, 2}, s.keeper.GetVaultIDsByCR(s.ctx, appID1, extendedVaultPairID1, 10))
+}
+
+func (s *KeeperTestSuite) TestVaultProtection() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+	asseOneID := s.CreateNewAsset("ASSETONE", "uasset1", 2000000)
+	asseTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+	pairID := s.CreateNewPair(addr1, asseOneID, asseTwoID)
+	extendedVaultPairID1 := s.CreateNewExtendedVaultPair("CMDX-C", appID1, pairID, false, true)
+
+	// cr = 2000 / 800 = 2.5
+	msg := types.NewMsgCreateRequest(addr1, appID1, extendedVaultPairID1, newInt(1000000000), newInt(800000000))
+	s.fundAddr(addr1, sdk.NewCoins(sdk.NewCoin("uasset1", msg.AmountIn)))
+	_, err := s.msgServer.MsgCreate(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.MsgSetVaultProtection(sdk.WrapSDKContext(s.ctx), types.NewMsgSetVaultProtectionRequest(
+		addr2, appID1, extendedVaultPairID1, 1, utils.ParseDec("2.6"), utils.ParseDec("3"), types.ProtectionActionRepay, newInt(1000000000), utils.ParseDec("0.05"),
+	))
+	s.Require().ErrorIs(err, types.ErrVaultAccessUnauthorised)
+	_, err = s.msgServer.MsgSetVaultProtection(sdk.WrapSDKContext(s.ctx), types.NewMsgSetVaultProtectionRequest(
+		addr1, appID1, extendedVaultPairID1, 1, utils.ParseDec("2.2"), utils.ParseDec("3"), types.ProtectionActionRepay, newInt(1000000000), utils.ParseDec("0.05"),
+	))
+	s.Require().ErrorIs(err, types.ErrorInvalidTriggerCR)
+	_, err = s.msgServer.MsgSetVaultProtection(sdk.WrapSDKContext(s.ctx), types.NewMsgSetVaultProtectionRequest(
+		addr1, appID1, extendedVaultPairID1, 1, utils.ParseDec("2.6"), utils.ParseDec("3"), types.ProtectionActionRepay, newInt(1000000000), utils.ParseDec("0.05"),
+	))
+	s.Require().NoError(err)
+
+	collectorAddr := s.app.AccountKeeper.GetModuleAddress(collectortypes.ModuleName)
+	collectorBalance := s.getBalance(collectorAddr, "uasset2")
+
+	vault.BeginBlocker(s.ctx, abci.RequestBeginBlock{}, s.keeper)
+
+	userVault, found := s.keeper.GetVault(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().True(userVault.AmountOut.LT(newInt(800000000)))
+	cr, err := s.keeper.CalculateCollateralizationRatio(s.ctx, extendedVaultPairID1, userVault.AmountIn, userVault.AmountOut)
+	s.Require().NoError(err)
+	s.Require().True(cr.GTE(utils.ParseDec("2.99")))
+	s.Require().True(cr.LT(utils.ParseDec("3.01")))
+	s.Require().True(s.getBalance(collectorAddr, "uasset2").Amount.GT(collectorBalance.Amount))
+
+	res, err := s.querier.QueryVaultProtection(sdk.WrapSDKContext(s.ctx), &types.QueryVaultProtectionRequest{UserVaultId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(newInt(800000000).Sub(userVault.AmountOut), res.Protection.AmountUsed)
+
+	// Above the trigger the protection stays idle.
+	vault.BeginBlocker(s.ctx, abci.RequestBeginBlock{}, s.keeper)
+	idleVault, _ := s.keeper.GetVault(s.ctx, 1)
+	s.Require().Equal(userVault.AmountOut, idleVault.AmountOut)
+
+	_, err = s.msgServer.MsgRemoveVaultProtection(sdk.WrapSDKContext(s.ctx), types.NewMsgRemoveVaultProtectionRequest(addr2, 1))
+	s.Require().ErrorIs(err, types.ErrVaultAccessUnauthorised)
+	_, err = s.msgServer.MsgRemoveVaultProtection(sdk.WrapSDKContext(s.ctx), types.NewMsgRemoveVaultProtectionRequest(addr1, 1))
+	s.Require().NoError(err)
+	_, err = s.msgServer.MsgRemoveVaultProtection(sdk.WrapSDKContext(s.ctx), types.NewMsgRemoveVaultProtectionRequest(addr1, 1))
+	s.Require().ErrorIs(err, types.ErrorVaultProtectionNotFound)
+}
+
+func (s *KeeperTestSuite) TestMsgCollateralBasket() {
+	addr1 := s.addr(1)
+	addr2 := s.addr(2)
+
+	appID1 := s.CreateNewApp("appone")
+	asseOneID := s.CreateNewAsset("ASSETONE", "uasset1", 2000000)
+	asseTwoID := s.CreateNewAsset("ASSETTWO", "uasset2", 1000000)
+	asseThreeID := s.CreateNewAsset("ASSETTHREE", "uasset3", 1000000)
+	pairID := s.CreateNewPair(addr1, asseOneID, asseTwoID)
+	extendedVaultPairID1 := s.CreateNewExtendedVaultPair("CMDX-C", appID1, pairID, false, true)
+
+	// cr = 2000 / 800 = 2.5
+	msg := types.NewMsgCreateRequest(addr1, appID1, extendedVaultPairID1, newInt(1000000000), newInt(800000000))
+	s.fundAddr(addr1, sdk.NewCoins(sdk.NewCoin("uasset1", msg.AmountIn)))
+	_, err := s.msgServer.MsgCreate(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	collateral := sdk.NewCoin("uasset3", newInt(800000000))
+	s.fundAddr(addr1, sdk.NewCoins(collateral))
+	_, err = s.msgServer.MsgDepositCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgDepositCollateralRequest(addr1, appID1, extendedVaultPairID1, 1, collateral))
+	s.Require().ErrorIs(err, types.ErrorCollateralNotWhitelisted)
+
+	// the basket asset counts for half its value, 2.3 / 4.6
+	err = s.app.AssetKeeper.AddVaultCollateralAsset(s.ctx, assettypes.VaultCollateralAsset{
+		AppId:                appID1,
+		ExtendedPairVaultId:  extendedVaultPairID1,
+		AssetId:              asseThreeID,
+		LiquidationThreshold: utils.ParseDec("4.6"),
+		LiquidationPriority:  1,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.MsgDepositCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgDepositCollateralRequest(addr2, appID1, extendedVaultPairID1, 1, collateral))
+	s.Require().ErrorIs(err, types.ErrVaultAccessUnauthorised)
+	_, err = s.msgServer.MsgDepositCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgDepositCollateralRequest(addr1, appID1, extendedVaultPairID1, 1, collateral))
+	s.Require().NoError(err)
+
+	// cr = (2000 + 400) / 800 = 3
+	userVault, found := s.keeper.GetVault(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoins(collateral), userVault.Collaterals)
+	cr, err := s.keeper.CalculateVaultCollateralizationRatio(s.ctx, userVault, userVault.AmountIn, userVault.AmountOut)
+	s.Require().NoError(err)
+	s.Require().Equal(utils.ParseDec("3"), cr)
+
+	// (1400 + 400) / 800 is below the min cr, (1600 + 400) / 800 isn't
+	_, err = s.msgServer.MsgWithdraw(sdk.WrapSDKContext(s.ctx), types.NewMsgWithdrawRequest(addr1, appID1, extendedVaultPairID1, 1, newInt(300000000)))
+	s.Require().ErrorIs(err, types.ErrorInvalidCollateralizationRatio)
+	_, err = s.msgServer.MsgWithdraw(sdk.WrapSDKContext(s.ctx), types.NewMsgWithdrawRequest(addr1, appID1, extendedVaultPairID1, 1, newInt(200000000)))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.MsgWithdrawCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgWithdrawCollateralRequest(addr1, appID1, extendedVaultPairID1, 1, sdk.NewCoin("uasset3", newInt(900000000))))
+	s.Require().ErrorIs(err, types.ErrorInvalidAmount)
+	_, err = s.msgServer.MsgWithdrawCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgWithdrawCollateralRequest(addr1, appID1, extendedVaultPairID1, 1, sdk.NewCoin("uasset3", newInt(400000000))))
+	s.Require().ErrorIs(err, types.ErrorInvalidCollateralizationRatio)
+	_, err = s.msgServer.MsgWithdrawCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgWithdrawCollateralRequest(addr1, appID1, extendedVaultPairID1, 1, sdk.NewCoin("uasset3", newInt(100000000))))
+	s.Require().NoError(err)
+	s.Require().Equal(newInt(100000000), s.getBalance(addr1, "uasset3").Amount)
+
+	// a delisted asset can't be deposited but keeps its value while frozen,
+	// (1600 + 350) / 800
+	s.Require().NoError(s.app.AssetKeeper.RemoveVaultCollateralAsset(s.ctx, extendedVaultPairID1, asseThreeID, 100*time.Second, 100*time.Second))
+	s.fundAddr(addr1, sdk.NewCoins(collateral))
+	_, err = s.msgServer.MsgDepositCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgDepositCollateralRequest(addr1, appID1, extendedVaultPairID1, 1, collateral))
+	s.Require().ErrorIs(err, types.ErrorCollateralDelisted)
+	userVault, _ = s.keeper.GetVault(s.ctx, 1)
+	cr, err = s.keeper.CalculateVaultCollateralizationRatio(s.ctx, userVault, userVault.AmountIn, userVault.AmountOut)
+	s.Require().NoError(err)
+	s.Require().Equal(utils.ParseDec("2.4375"), cr)
+
+	// halfway through the ramp it counts for half, (1600 + 175) / 800
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(150 * time.Second))
+	cr, err = s.keeper.CalculateVaultCollateralizationRatio(s.ctx, userVault, userVault.AmountIn, userVault.AmountOut)
+	s.Require().NoError(err)
+	s.Require().Equal(utils.ParseDec("2.21875"), cr)
+
+	// once closed out it counts for nothing and can always be withdrawn
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(50 * time.Second))
+	cr, err = s.keeper.CalculateVaultCollateralizationRatio(s.ctx, userVault, userVault.AmountIn, userVault.AmountOut)
+	s.Require().NoError(err)
+	s.Require().Equal(utils.ParseDec("2"), cr)
+	_, err = s.msgServer.MsgWithdrawCollateral(sdk.WrapSDKContext(s.ctx), types.NewMsgWithdrawCollateralRequest(addr1, appID1, extendedVaultPairID1, 1, sdk.NewCoin("uasset3", newInt(300000000))))
+	s.Require().NoError(err)
+
+	// closing the vault returns the rest of the basket
+	s.fundAddr(addr1, sdk.NewCoins(sdk.NewCoin("uasset2", newInt(100000000))))
+	_, err = s.msgServer.MsgClose(sdk.WrapSDKContext(s.ctx), types.NewMsgLiquidateRequest(addr1, appID1, extendedVaultPairID1, 1))
+	s.Require().NoError(err)
+	s.Require().Equal(collateral.Add(collateral), s.getBalance(addr1, "uasset3"))
+}
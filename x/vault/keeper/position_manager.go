@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/vault/types"
+)
+
+func (k Keeper) SetPositionManagerGrant(ctx sdk.Context, grant types.PositionManagerGrant) {
+	var (
+		store = k.Store(ctx)
+		key   = types.PositionManagerGrantKey(sdk.MustAccAddressFromBech32(grant.Owner), sdk.MustAccAddressFromBech32(grant.Manager))
+		value = k.cdc.MustMarshal(&grant)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetPositionManagerGrant(ctx sdk.Context, owner, manager sdk.AccAddress) (grant types.PositionManagerGrant, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.PositionManagerGrantKey(owner, manager)
+		value = store.Get(key)
+	)
+	if value == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshal(value, &grant)
+	return grant, true
+}
+
+func (k Keeper) DeletePositionManagerGrant(ctx sdk.Context, owner, manager sdk.AccAddress) {
+	store := k.Store(ctx)
+	store.Delete(types.PositionManagerGrantKey(owner, manager))
+}
+
+func (k Keeper) GetPositionManagerGrants(ctx sdk.Context) (grants []types.PositionManagerGrant) {
+	return k.getPositionManagerGrants(ctx, types.PositionManagerGrantKeyPrefix)
+}
+
+func (k Keeper) GetPositionManagerGrantsByOwner(ctx sdk.Context, owner sdk.AccAddress) (grants []types.PositionManagerGrant) {
+	return k.getPositionManagerGrants(ctx, types.PositionManagerGrantOwnerKey(owner))
+}
+
+func (k Keeper) getPositionManagerGrants(ctx sdk.Context, prefix []byte) (grants []types.PositionManagerGrant) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, prefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.PositionManagerGrant
+		k.cdc.MustUnmarshal(iter.Value(), &grant)
+		grants = append(grants, grant)
+	}
+	return grants
+}
+
+// authorizeVaultAction checks that sender may act on the vault with
+// permission, either as its owner or as a manager granted the permission by
+// the owner.
+func (k Keeper) authorizeVaultAction(ctx sdk.Context, vault types.Vault, sender string, permission types.PositionPermission) error {
+	if vault.Owner == sender {
+		return nil
+	}
+	owner, err := sdk.AccAddressFromBech32(vault.Owner)
+	if err != nil {
+		return err
+	}
+	manager, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+	grant, found := k.GetPositionManagerGrant(ctx, owner, manager)
+	if !found || !grant.HasPermission(permission) {
+		return types.ErrVaultAccessUnauthorised
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	protobuftypes "github.com/gogo/protobuf/types"
+
+	collectortypes "github.com/comdex-official/comdex/x/collector/types"
+	"github.com/comdex-official/comdex/x/vault/types"
+)
+
+func (k Keeper) SetVaultProtection(ctx sdk.Context, protection types.VaultProtection) {
+	var (
+		store = k.Store(ctx)
+		key   = types.VaultProtectionKey(protection.VaultID)
+		value = k.cdc.MustMarshal(&protection)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetVaultProtection(ctx sdk.Context, vaultID uint64) (protection types.VaultProtection, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.VaultProtectionKey(vaultID)
+		value = store.Get(key)
+	)
+	if value == nil {
+		return protection, false
+	}
+
+	k.cdc.MustUnmarshal(value, &protection)
+	return protection, true
+}
+
+func (k Keeper) DeleteVaultProtection(ctx sdk.Context, vaultID uint64) {
+	store := k.Store(ctx)
+	store.Delete(types.VaultProtectionKey(vaultID))
+}
+
+func (k Keeper) GetVaultProtections(ctx sdk.Context) (protections []types.VaultProtection) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.VaultProtectionKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var protection types.VaultProtection
+		k.cdc.MustUnmarshal(iter.Value(), &protection)
+		protections = append(protections, protection)
+	}
+	return protections
+}
+
+func (k Keeper) SetVaultProtectionCursor(ctx sdk.Context, vaultID uint64) {
+	var (
+		store = k.Store(ctx)
+		key   = types.VaultProtectionCursorKey
+		value = k.cdc.MustMarshal(
+			&protobuftypes.UInt64Value{
+				Value: vaultID,
+			},
+		)
+	)
+
+	store.Set(key, value)
+}
+
+func (k Keeper) GetVaultProtectionCursor(ctx sdk.Context) uint64 {
+	var (
+		store = k.Store(ctx)
+		key   = types.VaultProtectionCursorKey
+		value = store.Get(key)
+	)
+
+	if value == nil {
+		return 0
+	}
+
+	var id protobuftypes.UInt64Value
+	k.cdc.MustUnmarshal(value, &id)
+
+	return id.GetValue()
+}
+
+// nextVaultProtections returns up to limit protections of the vaults after
+// vaultID, wrapping around to the lowest vault ids.
+func (k Keeper) nextVaultProtections(ctx sdk.Context, vaultID uint64, limit int) (protections []types.VaultProtection) {
+	store := k.Store(ctx)
+	ranges := [][2][]byte{
+		{types.VaultProtectionKey(vaultID + 1), sdk.PrefixEndBytes(types.VaultProtectionKeyPrefix)},
+		{types.VaultProtectionKeyPrefix, types.VaultProtectionKey(vaultID + 1)},
+	}
+	for _, r := range ranges {
+		iter := store.Iterator(r[0], r[1])
+		for ; iter.Valid() && len(protections) < limit; iter.Next() {
+			var protection types.VaultProtection
+			k.cdc.MustUnmarshal(iter.Value(), &protection)
+			protections = append(protections, protection)
+		}
+		if err := iter.Close(); err != nil {
+			return protections
+		}
+	}
+	return protections
+}
+
+// ExecuteVaultProtections checks up to MaxVaultProtectionsPerBlock vault
+// protections and executes those whose vault fell to its trigger
+// collateralization ratio. A protection that fails leaves no trace except a
+// failure event.
+func (k Keeper) ExecuteVaultProtections(ctx sdk.Context) {
+	protections := k.nextVaultProtections(ctx, k.GetVaultProtectionCursor(ctx), types.MaxVaultProtectionsPerBlock)
+	for _, protection := range protections {
+		cacheCtx, writeCache := ctx.CacheContext()
+		executed, err := k.executeVaultProtection(cacheCtx, protection)
+		if err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeVaultProtectionFailed,
+					sdk.NewAttribute(types.AttributeKeyVaultID, strconv.FormatUint(protection.VaultID, 10)),
+					sdk.NewAttribute(types.AttributeKeyAction, protection.Action.String()),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+		if executed {
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	}
+	if len(protections) > 0 {
+		k.SetVaultProtectionCursor(ctx, protections[len(protections)-1].VaultID)
+	}
+}
+
+func (k Keeper) executeVaultProtection(ctx sdk.Context, protection types.VaultProtection) (bool, error) {
+	userVault, found := k.GetVault(ctx, protection.VaultID)
+	if !found || userVault.Owner != protection.Owner {
+		// The vault was closed, liquidated or transferred.
+		k.DeleteVaultProtection(ctx, protection.VaultID)
+		return true, nil
+	}
+	remaining := protection.MaxAmount.Sub(protection.AmountUsed)
+	if !remaining.IsPositive() {
+		return false, nil
+	}
+
+	extendedPairVault, found := k.asset.GetPairsVault(ctx, userVault.ExtendedPairVaultID)
+	if !found {
+		return false, types.ErrorExtendedPairVaultDoesNotExists
+	}
+	pairData, found := k.asset.GetPair(ctx, extendedPairVault.PairId)
+	if !found {
+		return false, types.ErrorPairDoesNotExist
+	}
+	assetInData, found := k.asset.GetAsset(ctx, pairData.AssetIn)
+	if !found {
+		return false, types.ErrorAssetDoesNotExist
+	}
+	assetOutData, found := k.asset.GetAsset(ctx, pairData.AssetOut)
+	if !found {
+		return false, types.ErrorAssetDoesNotExist
+	}
+	owner, err := sdk.AccAddressFromBech32(userVault.Owner)
+	if err != nil {
+		return false, err
+	}
+
+	totalDebt := userVault.AmountOut.Add(userVault.InterestAccumulated)
+	err = k.rewards.CalculateVaultInterest(ctx, userVault.AppId, userVault.ExtendedPairVaultID, userVault.Id, totalDebt, userVault.BlockHeight, userVault.BlockTime.Unix())
+	if err != nil {
+		return false, err
+	}
+	userVault, _ = k.GetVault(ctx, protection.VaultID)
+	totalDebt = userVault.AmountOut.Add(userVault.InterestAccumulated).Add(userVault.ClosingFeeAccumulated)
+	if !totalDebt.IsPositive() {
+		return false, nil
+	}
+	cr, err := k.CalculateVaultCollateralizationRatio(ctx, userVault, userVault.AmountIn, totalDebt)
+	if err != nil {
+		return false, err
+	}
+	if cr.GT(protection.TriggerCr) {
+		return false, nil
+	}
+
+	var (
+		server = NewMsgServer(k)
+		c      = sdk.WrapSDKContext(ctx)
+		// Repayments cannot take the debt below the debt floor.
+		maxRepay = userVault.AmountOut.Add(userVault.InterestAccumulated).Sub(extendedPairVault.DebtFloor)
+		amount   sdk.Int
+		fee      sdk.Int
+	)
+	switch protection.Action {
+	case types.ProtectionActionRepay:
+		// Repaying x moves the cr to the target when x = debt * (1 - cr / target).
+		amount = totalDebt.ToDec().Mul(sdk.OneDec().Sub(cr.Quo(protection.TargetCr))).Ceil().TruncateInt()
+		amount = sdk.MinInt(sdk.MinInt(amount, remaining), maxRepay)
+		amount = sdk.MinInt(amount, k.affordable(ctx, owner, assetOutData.Denom))
+		if !amount.IsPositive() {
+			return false, sdkerrors.Wrap(types.ErrorInvalidAmount, "nothing to repay")
+		}
+		if _, err := server.MsgRepay(c, types.NewMsgRepayRequest(owner, userVault.AppId, userVault.ExtendedPairVaultID, userVault.Id, amount)); err != nil {
+			return false, err
+		}
+		if fee, err = k.payVaultProtectionFee(ctx, owner, userVault.AppId, pairData.AssetOut, assetOutData.Denom, amount); err != nil {
+			return false, err
+		}
+	case types.ProtectionActionDeposit:
+		// Depositing x moves the cr to the target when x = collateral * (target / cr - 1).
+		amount = userVault.AmountIn.ToDec().Mul(protection.TargetCr.Quo(cr).Sub(sdk.OneDec())).Ceil().TruncateInt()
+		amount = sdk.MinInt(amount, remaining)
+		amount = sdk.MinInt(amount, k.affordable(ctx, owner, assetInData.Denom))
+		if !amount.IsPositive() {
+			return false, sdkerrors.Wrap(types.ErrorInvalidAmount, "nothing to deposit")
+		}
+		if _, err := server.MsgDeposit(c, types.NewMsgDepositRequest(owner, userVault.AppId, userVault.ExtendedPairVaultID, userVault.Id, amount)); err != nil {
+			return false, err
+		}
+		if fee, err = k.payVaultProtectionFee(ctx, owner, userVault.AppId, pairData.AssetIn, assetInData.Denom, amount); err != nil {
+			return false, err
+		}
+	case types.ProtectionActionSellCollateral:
+		if cr.LTE(sdk.OneDec()) {
+			return false, sdkerrors.Wrapf(types.ErrorInvalidCollateralizationRatio, "vault cr %s is too low to sell collateral", cr)
+		}
+		// Same as a deleverage round, see MsgDeleverageVault.
+		amount = userVault.AmountIn.ToDec().
+			Mul(protection.TargetCr.Sub(cr)).
+			Quo(cr.Mul(protection.TargetCr.Sub(sdk.OneDec()))).Ceil().TruncateInt()
+		amount = sdk.MinInt(sdk.MinInt(amount, remaining), userVault.AmountIn)
+		if !amount.IsPositive() {
+			return false, sdkerrors.Wrap(types.ErrorInvalidAmount, "nothing to sell")
+		}
+
+		userVault.AmountIn = userVault.AmountIn.Sub(amount)
+		k.SetVault(ctx, userVault)
+		k.UpdateCollateralLockedAmountLockerMapping(ctx, userVault.AppId, userVault.ExtendedPairVaultID, amount, false)
+		if err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(sdk.NewCoin(assetInData.Denom, amount))); err != nil {
+			return false, err
+		}
+		minAmountOut, err := k.minSwapOut(ctx, extendedPairVault.Id, amount, true, protection.MaxSlippage)
+		if err != nil {
+			return false, err
+		}
+		swapped, err := k.liquidity.SwapExactAmountIn(ctx, userVault.AppId, owner, sdk.NewCoin(assetInData.Denom, amount), assetOutData.Denom, minAmountOut)
+		if err != nil {
+			return false, err
+		}
+		if fee, err = k.payVaultProtectionFee(ctx, owner, userVault.AppId, pairData.AssetOut, assetOutData.Denom, swapped.Amount); err != nil {
+			return false, err
+		}
+
+		// Proceeds above what can be repaid stay with the owner.
+		repayAmount := sdk.MinInt(swapped.Amount.Sub(fee), maxRepay)
+		if repayAmount.IsPositive() {
+			if _, err := server.MsgRepay(c, types.NewMsgRepayRequest(owner, userVault.AppId, userVault.ExtendedPairVaultID, userVault.Id, repayAmount)); err != nil {
+				return false, err
+			}
+		}
+
+		userVault, _ = k.GetVault(ctx, protection.VaultID)
+		totalDebt = userVault.AmountOut.Add(userVault.InterestAccumulated).Add(userVault.ClosingFeeAccumulated)
+		if err := k.VerifyVaultCollateralizationRatio(ctx, userVault, userVault.AmountIn, totalDebt, extendedPairVault.MinCr, false); err != nil {
+			return false, err
+		}
+	default:
+		return false, types.ErrorInvalidProtectionAction
+	}
+
+	protection.AmountUsed = protection.AmountUsed.Add(amount)
+	k.SetVaultProtection(ctx, protection)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultProtectionExecuted,
+			sdk.NewAttribute(types.AttributeKeyVaultID, strconv.FormatUint(protection.VaultID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, protection.Owner),
+			sdk.NewAttribute(types.AttributeKeyAction, protection.Action.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateralizationRatio, cr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+	return true, nil
+}
+
+// affordable returns the largest amount of denom the owner can spend on a
+// protection while still paying the protection fee on it.
+func (k Keeper) affordable(ctx sdk.Context, owner sdk.AccAddress, denom string) sdk.Int {
+	balance := k.bank.SpendableCoins(ctx, owner).AmountOf(denom)
+	return balance.ToDec().Quo(sdk.OneDec().Add(types.VaultProtectionFeeRate)).TruncateInt()
+}
+
+// payVaultProtectionFee sends the protection fee on amount from the owner to
+// the collector.
+func (k Keeper) payVaultProtectionFee(ctx sdk.Context, owner sdk.AccAddress, appID, assetID uint64, denom string, amount sdk.Int) (sdk.Int, error) {
+	fee := amount.ToDec().Mul(types.VaultProtectionFeeRate).TruncateInt()
+	if !fee.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+	if err := k.bank.SendCoinsFromAccountToModule(ctx, owner, collectortypes.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, fee))); err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if err := k.collector.UpdateCollector(ctx, appID, assetID, sdk.ZeroInt(), fee, sdk.ZeroInt(), sdk.ZeroInt()); err != nil {
+		return sdk.ZeroInt(), err
+	}
+	return fee, nil
+}
@@ -117,7 +117,7 @@ func (q QueryServer) QueryVaultInfoByVaultID(c context.Context, req *types.Query
 		return &types.QueryVaultInfoByVaultIDResponse{}, nil
 	}
 
-	collateralizationRatio, err := q.CalculateCollateralizationRatio(ctx, vault.ExtendedPairVaultID, vault.AmountIn, vault.AmountOut)
+	collateralizationRatio, err := q.CalculateVaultCollateralizationRatio(ctx, vault, vault.AmountIn, vault.AmountOut)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +170,7 @@ func (q QueryServer) QueryVaultInfoOfOwnerByApp(c context.Context, req *types.Qu
 			continue
 		}
 
-		collateralizationRatio, err := q.CalculateCollateralizationRatio(ctx, vault.ExtendedPairVaultID, vault.AmountIn, vault.AmountOut)
+		collateralizationRatio, err := q.CalculateVaultCollateralizationRatio(ctx, vault, vault.AmountIn, vault.AmountOut)
 		if err != nil {
 			return nil, err
 		}
@@ -235,13 +235,19 @@ func (q QueryServer) QueryVaultIDOfOwnerByExtendedPairAndApp(c context.Context,
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	userVault, found := q.GetUserAppExtendedPairMappingData(ctx, req.Owner, req.AppId, req.ExtendedPairId)
+	userVaults, found := q.GetUserAppExtendedPairMappingData(ctx, req.Owner, req.AppId, req.ExtendedPairId)
 	if !found {
 		return &types.QueryVaultIDOfOwnerByExtendedPairAndAppResponse{}, nil
 	}
 
+	vaultIDs := make([]uint64, len(userVaults))
+	for i, userVault := range userVaults {
+		vaultIDs[i] = userVault.VaultId
+	}
+
 	return &types.QueryVaultIDOfOwnerByExtendedPairAndAppResponse{
-		Vault_Id: userVault.VaultId,
+		Vault_Id: vaultIDs[0],
+		VaultIds: vaultIDs,
 	}, nil
 }
 
@@ -743,3 +749,35 @@ func (q QueryServer) QueryAllStableMintVaultRewards(c context.Context, req *type
 		StableMintVaultRewards: stableRewardsData,
 	}, nil
 }
+
+func (q QueryServer) QueryPositionManagerGrants(c context.Context, req *types.QueryPositionManagerGrantsRequest) (*types.QueryPositionManagerGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPositionManagerGrantsResponse{
+		Grants: q.GetPositionManagerGrantsByOwner(ctx, owner),
+	}, nil
+}
+
+func (q QueryServer) QueryVaultProtection(c context.Context, req *types.QueryVaultProtectionRequest) (*types.QueryVaultProtectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	protection, found := q.GetVaultProtection(ctx, req.UserVaultId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "protection does not exist for vault %d", req.UserVaultId)
+	}
+
+	return &types.QueryVaultProtectionResponse{
+		Protection: protection,
+	}, nil
+}
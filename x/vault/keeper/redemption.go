@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/comdex-official/comdex/x/vault/types"
+)
+
+// vaultCollateralPerDebt returns the collateral of the vault per unit of its
+// debt, and false if the vault has no debt.
+func vaultCollateralPerDebt(vault types.Vault) (sdk.Dec, bool) {
+	if vault.AmountIn.IsNil() || vault.AmountOut.IsNil() {
+		return sdk.Dec{}, false
+	}
+	debt := vault.AmountOut
+	if !vault.InterestAccumulated.IsNil() {
+		debt = debt.Add(vault.InterestAccumulated)
+	}
+	if !debt.IsPositive() {
+		return sdk.Dec{}, false
+	}
+	return sdk.NewDecFromInt(vault.AmountIn).QuoInt(debt), true
+}
+
+// setVaultCRIndex indexes the vault for redemption. Vaults holding basket
+// collateral aren't redeemed against, as the index and the payout only account
+// for the pair collateral.
+func (k Keeper) setVaultCRIndex(ctx sdk.Context, vault types.Vault) {
+	if !vault.Collaterals.IsZero() {
+		return
+	}
+	ratio, ok := vaultCollateralPerDebt(vault)
+	if !ok {
+		return
+	}
+	store := k.Store(ctx)
+	store.Set(types.VaultCRIndexKey(vault.AppId, vault.ExtendedPairVaultID, ratio, vault.Id), sdk.Uint64ToBigEndian(vault.Id))
+}
+
+// sortVaultsByCR orders vaults from the lowest collateral per debt up, vaults
+// without debt last.
+func sortVaultsByCR(vaults []types.Vault) {
+	sort.SliceStable(vaults, func(i, j int) bool {
+		ri, oki := vaultCollateralPerDebt(vaults[i])
+		rj, okj := vaultCollateralPerDebt(vaults[j])
+		if oki != okj {
+			return oki
+		}
+		return oki && ri.LT(rj)
+	})
+}
+
+func (k Keeper) deleteVaultCRIndex(ctx sdk.Context, vault types.Vault) {
+	ratio, ok := vaultCollateralPerDebt(vault)
+	if !ok {
+		return
+	}
+	store := k.Store(ctx)
+	store.Delete(types.VaultCRIndexKey(vault.AppId, vault.ExtendedPairVaultID, ratio, vault.Id))
+}
+
+// GetVaultIDsByCR returns the ids of up to limit vaults of the extended pair
+// vault, from the lowest collateralization ratio up.
+func (k Keeper) GetVaultIDsByCR(ctx sdk.Context, appID, extendedPairVaultID uint64, limit int) (vaultIDs []uint64) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.VaultCRIndexPairKey(appID, extendedPairVaultID))
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid() && len(vaultIDs) < limit; iter.Next() {
+		vaultIDs = append(vaultIDs, sdk.BigEndianToUint64(iter.Value()))
+	}
+	return vaultIDs
+}
+
+func (k Keeper) SetRedemptionState(ctx sdk.Context, state types.RedemptionState) {
+	var (
+		store = k.Store(ctx)
+		key   = types.RedemptionStateKey(state.AppId, state.ExtendedPairVaultID)
+		value = k.cdc.MustMarshal(&state)
+	)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetRedemptionState(ctx sdk.Context, appID, extendedPairVaultID uint64) (state types.RedemptionState, found bool) {
+	var (
+		store = k.Store(ctx)
+		key   = types.RedemptionStateKey(appID, extendedPairVaultID)
+		value = store.Get(key)
+	)
+	if value == nil {
+		return state, false
+	}
+
+	k.cdc.MustUnmarshal(value, &state)
+	return state, true
+}
+
+func (k Keeper) GetRedemptionStates(ctx sdk.Context) (states []types.RedemptionState) {
+	var (
+		store = k.Store(ctx)
+		iter  = sdk.KVStorePrefixIterator(store, types.RedemptionStateKeyPrefix)
+	)
+
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var state types.RedemptionState
+		k.cdc.MustUnmarshal(iter.Value(), &state)
+		states = append(states, state)
+	}
+	return states
+}
+
+// RedemptionRates returns the redemption base rate of the extended pair vault
+// after redeeming amount out of the minted supply, and the fee rate charged
+// for it.
+func (k Keeper) RedemptionRates(ctx sdk.Context, appID, extendedPairVaultID uint64, amount, minted sdk.Int) (baseRate, feeRate sdk.Dec) {
+	state, _ := k.GetRedemptionState(ctx, appID, extendedPairVaultID)
+	baseRate = state.DecayBaseRate(ctx.BlockTime())
+	if minted.IsPositive() {
+		baseRate = baseRate.Add(sdk.NewDecFromInt(amount).QuoInt(minted).Mul(types.RedemptionBaseRateAlpha))
+	}
+	baseRate = sdk.MinDec(baseRate, sdk.OneDec())
+	feeRate = sdk.MinDec(types.RedemptionFeeFloor.Add(baseRate), sdk.OneDec())
+	return baseRate, feeRate
+}
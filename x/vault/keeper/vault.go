@@ -76,25 +76,37 @@ func (k Keeper) GetIDForVault(ctx sdk.Context) uint64 {
 func (k Keeper) SetUserAppExtendedPairMappingData(ctx sdk.Context, mappingData types.OwnerAppExtendedPairVaultMappingData) {
 	var (
 		store = k.Store(ctx)
-		key   = types.UserAppExtendedPairMappingKey(mappingData.Owner, mappingData.AppId, mappingData.ExtendedPairId)
+		key   = types.UserAppExtendedPairVaultMappingKey(mappingData.Owner, mappingData.AppId, mappingData.ExtendedPairId, mappingData.VaultId)
 		value = k.cdc.MustMarshal(&mappingData)
 	)
 
 	store.Set(key, value)
 }
 
-func (k Keeper) GetUserAppExtendedPairMappingData(ctx sdk.Context, address string, appID uint64, pairVaultID uint64) (mappingData types.OwnerAppExtendedPairVaultMappingData, found bool) {
+// GetUserAppExtendedPairMappingData returns the mappings of all vaults the address owns on the extended pair vault of the app, in the order of their vault ids.
+func (k Keeper) GetUserAppExtendedPairMappingData(ctx sdk.Context, address string, appID uint64, pairVaultID uint64) (mappingData []types.OwnerAppExtendedPairVaultMappingData, found bool) {
 	var (
 		store = k.Store(ctx)
 		key   = types.UserAppExtendedPairMappingKey(address, appID, pairVaultID)
-		value = store.Get(key)
+		iter  = sdk.KVStorePrefixIterator(store, key)
 	)
 
-	if value == nil {
-		return mappingData, false
+	defer func(iter sdk.Iterator) {
+		err := iter.Close()
+		if err != nil {
+			return
+		}
+	}(iter)
+
+	for ; iter.Valid(); iter.Next() {
+		var mapData types.OwnerAppExtendedPairVaultMappingData
+		k.cdc.MustUnmarshal(iter.Value(), &mapData)
+		mappingData = append(mappingData, mapData)
+	}
+	if mappingData == nil {
+		return nil, false
 	}
 
-	k.cdc.MustUnmarshal(value, &mappingData)
 	return mappingData, true
 }
 
@@ -124,10 +136,10 @@ func (k Keeper) GetUserAppMappingData(ctx sdk.Context, address string, appID uin
 	return mappingData, true
 }
 
-func (k Keeper) DeleteUserVaultExtendedPairMapping(ctx sdk.Context, address string, appID uint64, pairVaultID uint64) {
+func (k Keeper) DeleteUserVaultExtendedPairMapping(ctx sdk.Context, address string, appID uint64, pairVaultID uint64, vaultID uint64) {
 	var (
 		store = k.Store(ctx)
-		key   = types.UserAppExtendedPairMappingKey(address, appID, pairVaultID)
+		key   = types.UserAppExtendedPairVaultMappingKey(address, appID, pairVaultID, vaultID)
 	)
 
 	store.Delete(key)
@@ -298,6 +310,19 @@ func (k Keeper) UpdateAppExtendedPairVaultMappingDataOnMsgCreateStableMintVault(
 
 // CalculateCollateralizationRatio Calculate Collaterlization Ratio .
 func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, extendedPairVaultID uint64, amountIn sdk.Int, amountOut sdk.Int) (sdk.Dec, error) {
+	return k.calculateCollateralizationRatio(ctx, extendedPairVaultID, amountIn, nil, amountOut)
+}
+
+// CalculateVaultCollateralizationRatio returns the collateralization ratio of
+// the vault holding amountIn of the pair's collateral next to its basket
+// collateral. A basket asset counts for its value times the pair's min cr
+// over the asset's liquidation threshold, so the ratio compares to the pair's
+// min cr as for a vault without basket. Delisted assets count for nothing.
+func (k Keeper) CalculateVaultCollateralizationRatio(ctx sdk.Context, vault types.Vault, amountIn sdk.Int, amountOut sdk.Int) (sdk.Dec, error) {
+	return k.calculateCollateralizationRatio(ctx, vault.ExtendedPairVaultID, amountIn, vault.Collaterals, amountOut)
+}
+
+func (k Keeper) calculateCollateralizationRatio(ctx sdk.Context, extendedPairVaultID uint64, amountIn sdk.Int, collaterals sdk.Coins, amountOut sdk.Int) (sdk.Dec, error) {
 	extendedPairVault, found := k.asset.GetPairsVault(ctx, extendedPairVaultID)
 	if !found {
 		return sdk.ZeroDec(), types.ErrorExtendedPairVaultDoesNotExists
@@ -319,7 +344,7 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, extendedPairVau
 	if found {
 		statusEsm = esmStatus.Status
 	}
-	var assetInTotalPrice sdk.Dec
+	assetInTotalPrice := sdk.ZeroDec()
 	var err error
 	// check to get calc asset price from esm
 	if statusEsm && esmStatus.SnapshotStatus {
@@ -337,6 +362,13 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, extendedPairVau
 			return sdk.ZeroDec(), err
 		}
 	}
+	for _, coin := range collaterals {
+		value, err := k.basketCollateralValue(ctx, extendedPairVault, coin, statusEsm, esmStatus.SnapshotStatus)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+		assetInTotalPrice = assetInTotalPrice.Add(value)
+	}
 	var assetOutTotalPrice sdk.Dec
 
 	if extendedPairVault.AssetOutOraclePrice {
@@ -372,6 +404,37 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, extendedPairVau
 	return assetInTotalPrice.Quo(assetOutTotalPrice), nil
 }
 
+// basketCollateralValue returns the value coin adds to the collateral of a
+// vault of extendedPairVault.
+func (k Keeper) basketCollateralValue(ctx sdk.Context, extendedPairVault assettypes.ExtendedPairVault, coin sdk.Coin, statusEsm, snapshotStatus bool) (sdk.Dec, error) {
+	asset, found := k.asset.GetAssetForDenom(ctx, coin.Denom)
+	if !found {
+		return sdk.ZeroDec(), types.ErrorAssetDoesNotExist
+	}
+	collateral, found := k.asset.GetVaultCollateralAsset(ctx, extendedPairVault.Id, asset.Id)
+	if !found {
+		return sdk.ZeroDec(), nil
+	}
+
+	value := sdk.ZeroDec()
+	if statusEsm && snapshotStatus {
+		price, found := k.esm.GetSnapshotOfPrices(ctx, extendedPairVault.AppId, asset.Id)
+		if !found {
+			return sdk.ZeroDec(), types.ErrorPriceDoesNotExist
+		}
+		value = sdk.NewDecFromInt(coin.Amount).Mul(sdk.NewDecFromInt(sdk.NewIntFromUint64(price))).Quo(sdk.NewDecFromInt(asset.Decimals))
+	} else if !statusEsm {
+		var err error
+		value, err = k.oracle.CalcAssetPrice(ctx, asset.Id, coin.Amount)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+	// the value of a delisted asset ramps down to nothing
+	value = value.Mul(collateral.ValueFactor(ctx.BlockTime()))
+	return value.Mul(extendedPairVault.MinCr).Quo(collateral.LiquidationThreshold), nil
+}
+
 func (k Keeper) VerifyCollaterlizationRatio(
 	ctx sdk.Context,
 	extendedPairVaultID uint64,
@@ -384,6 +447,27 @@ func (k Keeper) VerifyCollaterlizationRatio(
 	if err != nil {
 		return err
 	}
+	return checkCollateralizationRatio(collaterlizationRatio, minCrRequired, statusEsm)
+}
+
+// VerifyVaultCollateralizationRatio is VerifyCollaterlizationRatio counting
+// the basket collateral of vault, see CalculateVaultCollateralizationRatio.
+func (k Keeper) VerifyVaultCollateralizationRatio(
+	ctx sdk.Context,
+	vault types.Vault,
+	amountIn sdk.Int,
+	amountOut sdk.Int,
+	minCrRequired sdk.Dec,
+	statusEsm bool,
+) error {
+	collaterlizationRatio, err := k.CalculateVaultCollateralizationRatio(ctx, vault, amountIn, amountOut)
+	if err != nil {
+		return err
+	}
+	return checkCollateralizationRatio(collaterlizationRatio, minCrRequired, statusEsm)
+}
+
+func checkCollateralizationRatio(collaterlizationRatio, minCrRequired sdk.Dec, statusEsm bool) error {
 	if collaterlizationRatio.LT(minCrRequired) && !statusEsm {
 		return types.ErrorInvalidCollateralizationRatio
 	} else if collaterlizationRatio.LT(sdk.MustNewDecFromStr("1")) && statusEsm {
@@ -398,7 +482,11 @@ func (k Keeper) SetVault(ctx sdk.Context, vault types.Vault) {
 		key   = types.VaultKey(vault.Id)
 		value = k.cdc.MustMarshal(&vault)
 	)
+	if oldVault, found := k.GetVault(ctx, vault.Id); found {
+		k.deleteVaultCRIndex(ctx, oldVault)
+	}
 	store.Set(key, value)
+	k.setVaultCRIndex(ctx, vault)
 }
 
 func (k Keeper) GetVault(ctx sdk.Context, id uint64) (vault types.Vault, found bool) {
@@ -456,6 +544,9 @@ func (k Keeper) DeleteVault(ctx sdk.Context, id uint64) {
 		key   = types.VaultKey(id)
 	)
 
+	if vault, found := k.GetVault(ctx, id); found {
+		k.deleteVaultCRIndex(ctx, vault)
+	}
 	store.Delete(key)
 }
 
@@ -605,6 +696,44 @@ func (k Keeper) CreateNewVault(ctx sdk.Context, From string, AppID uint64, Exten
 	return nil
 }
 
+// TransferVault reassigns the vault to newOwner, moving the vault's entry in
+// the owner mappings and its locked vault history along with it.
+func (k Keeper) TransferVault(ctx sdk.Context, vault types.Vault, newOwner string) {
+	owner := vault.Owner
+	k.DeleteUserVaultExtendedPairMapping(ctx, owner, vault.AppId, vault.ExtendedPairVaultID, vault.Id)
+
+	// Protections act with the owner's funds, so they do not follow the vault.
+	k.DeleteVaultProtection(ctx, vault.Id)
+
+	vault.Owner = newOwner
+	k.SetVault(ctx, vault)
+	k.SetUserAppExtendedPairMappingData(ctx, types.OwnerAppExtendedPairVaultMappingData{
+		Owner:          newOwner,
+		AppId:          vault.AppId,
+		ExtendedPairId: vault.ExtendedPairVaultID,
+		VaultId:        vault.Id,
+	})
+
+	k.liquidation.UpdateLockedVaultHistoryOwner(ctx, vault.AppId, vault.Id, owner, newOwner)
+}
+
+// minSwapOut returns the least amount a swap of amount of the vault's
+// collateral(or debt asset if isOfferAssetIn is false) must give out,
+// which is its oracle value in the other asset less maxSlippage.
+func (k Keeper) minSwapOut(ctx sdk.Context, extendedPairVaultID uint64, amount sdk.Int, isOfferAssetIn bool, maxSlippage sdk.Dec) (sdk.Int, error) {
+	// The collateralization ratio of equal amounts is the price of the
+	// collateral in the debt asset.
+	price, err := k.CalculateCollateralizationRatio(ctx, extendedPairVaultID, amount, amount)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	out := amount.ToDec().Quo(price)
+	if isOfferAssetIn {
+		out = amount.ToDec().Mul(price)
+	}
+	return out.Mul(sdk.OneDec().Sub(maxSlippage)).TruncateInt(), nil
+}
+
 func (k Keeper) calculateUserToken(userVault types.Vault, amountIn sdk.Int) (userToken sdk.Int) {
 	nume := userVault.AmountOut.Mul(amountIn)
 	deno := userVault.AmountIn
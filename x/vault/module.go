@@ -3,6 +3,7 @@ package vault
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -82,7 +83,7 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
 }
 
 func (a AppModule) ConsensusVersion() uint64 {
-	return 1
+	return 3
 }
 
 func (a AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, message json.RawMessage) []abcitypes.ValidatorUpdate {
@@ -110,11 +111,23 @@ func (a AppModule) QuerierRoute() string {
 func (a AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier { return nil }
 
 func (a AppModule) RegisterServices(configurator module.Configurator) {
+	migrator := keeper.NewMigrator(a.k)
+
+	// register v1 -> v2 migration
+	if err := configurator.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Errorf("failed to migrate %s to v2: %w", types.ModuleName, err))
+	}
+	if err := configurator.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Errorf("failed to migrate %s to v3: %w", types.ModuleName, err))
+	}
+
 	types.RegisterMsgServer(configurator.QueryServer(), keeper.NewMsgServer(a.k))
 	types.RegisterQueryServer(configurator.QueryServer(), keeper.NewQueryServer(a.k))
 }
 
-func (a AppModule) BeginBlock(_ sdk.Context, _ abcitypes.RequestBeginBlock) {}
+func (a AppModule) BeginBlock(ctx sdk.Context, req abcitypes.RequestBeginBlock) {
+	BeginBlocker(ctx, req, a.k)
+}
 
 func (a AppModule) EndBlock(_ sdk.Context, _ abcitypes.RequestEndBlock) []abcitypes.ValidatorUpdate {
 	return nil
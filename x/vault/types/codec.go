@@ -21,6 +21,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDepositStableMintRequest{}, "comdex/vault/MsgDepositStableMintRequest", nil)
 	cdc.RegisterConcrete(&MsgWithdrawStableMintRequest{}, "comdex/vault/MsgWithdrawStableMintRequest", nil)
 	cdc.RegisterConcrete(&MsgVaultInterestCalcRequest{}, "comdex/vault/MsgVaultInterestCalcRequest", nil)
+	cdc.RegisterConcrete(&MsgTransferVaultRequest{}, "comdex/vault/MsgTransferVaultRequest", nil)
+	cdc.RegisterConcrete(&MsgLeverageVaultRequest{}, "comdex/vault/MsgLeverageVaultRequest", nil)
+	cdc.RegisterConcrete(&MsgDeleverageVaultRequest{}, "comdex/vault/MsgDeleverageVaultRequest", nil)
+	cdc.RegisterConcrete(&MsgRedeemRequest{}, "comdex/vault/MsgRedeemRequest", nil)
+	cdc.RegisterConcrete(&MsgGrantPositionManagerRequest{}, "comdex/vault/MsgGrantPositionManagerRequest", nil)
+	cdc.RegisterConcrete(&MsgRevokePositionManagerRequest{}, "comdex/vault/MsgRevokePositionManagerRequest", nil)
+	cdc.RegisterConcrete(&MsgSetVaultProtectionRequest{}, "comdex/vault/MsgSetVaultProtectionRequest", nil)
+	cdc.RegisterConcrete(&MsgRemoveVaultProtectionRequest{}, "comdex/vault/MsgRemoveVaultProtectionRequest", nil)
+	cdc.RegisterConcrete(&MsgDepositCollateralRequest{}, "comdex/vault/MsgDepositCollateralRequest", nil)
+	cdc.RegisterConcrete(&MsgWithdrawCollateralRequest{}, "comdex/vault/MsgWithdrawCollateralRequest", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -37,6 +47,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDepositStableMintRequest{},
 		&MsgWithdrawStableMintRequest{},
 		&MsgVaultInterestCalcRequest{},
+		&MsgTransferVaultRequest{},
+		&MsgLeverageVaultRequest{},
+		&MsgDeleverageVaultRequest{},
+		&MsgRedeemRequest{},
+		&MsgGrantPositionManagerRequest{},
+		&MsgRevokePositionManagerRequest{},
+		&MsgSetVaultProtectionRequest{},
+		&MsgRemoveVaultProtectionRequest{},
+		&MsgDepositCollateralRequest{},
+		&MsgWithdrawCollateralRequest{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -32,4 +32,19 @@ var (
 	ErrorAppExtendedPairDataDoesNotExists = errors.Register(ModuleName, 1325, "App ExtendedPair Data Does Not Exists")
 	ErrorUnknownMsgType                   = errors.Register(ModuleName, 1326, "unknown message type")
 	ErrorCannotCreateStableMintVault      = errors.Register(ModuleName, 1327, "Cannot Create Stable Mint Vault, StableMint tx command")
+	ErrorInvalidTo                        = errors.Register(ModuleName, 1328, "invalid to")
+	ErrorInvalidTargetCR                  = errors.Register(ModuleName, 1329, "invalid target collateralization ratio")
+	ErrorInvalidMaxSlippage               = errors.Register(ModuleName, 1330, "invalid max slippage")
+	ErrorInvalidMaxFeeRate                = errors.Register(ModuleName, 1331, "invalid max fee rate")
+	ErrorRedemptionFeeTooHigh             = errors.Register(ModuleName, 1332, "redemption fee rate is higher than the max fee rate")
+	ErrorNothingToRedeem                  = errors.Register(ModuleName, 1333, "no vault debt can be redeemed")
+	ErrorInvalidManager                   = errors.Register(ModuleName, 1334, "invalid manager")
+	ErrorInvalidPermissions               = errors.Register(ModuleName, 1335, "invalid position permissions")
+	ErrorPositionManagerGrantNotFound     = errors.Register(ModuleName, 1336, "position manager grant not found")
+	ErrorInvalidTriggerCR                 = errors.Register(ModuleName, 1337, "invalid trigger collateralization ratio")
+	ErrorInvalidProtectionAction          = errors.Register(ModuleName, 1338, "invalid vault protection action")
+	ErrorVaultProtectionNotFound          = errors.Register(ModuleName, 1339, "vault protection not found")
+	ErrorCollateralNotWhitelisted         = errors.Register(ModuleName, 1340, "collateral is not whitelisted for the extended pair vault")
+	ErrorPairDelisted                     = errors.Register(ModuleName, 1341, "pair is being delisted")
+	ErrorCollateralDelisted               = errors.Register(ModuleName, 1342, "collateral is being delisted")
 )
@@ -2,20 +2,51 @@ package types
 
 // Event types for the Vault module.
 const (
-	EventTypeCreateVault   = "create_vault"
-	EventTypeDepositVault  = "deposit_vault"
-	EventTypeWithdrawVault = "withdraw_vault"
-	EventTypeDrawVault     = "draw_vault"
-	EventTypeRepayVault    = "repay_vault"
-	EventTypeCloseVault    = "close_vault"
+	EventTypeCreateVault     = "create_vault"
+	EventTypeDepositVault    = "deposit_vault"
+	EventTypeWithdrawVault   = "withdraw_vault"
+	EventTypeDrawVault       = "draw_vault"
+	EventTypeRepayVault      = "repay_vault"
+	EventTypeCloseVault      = "close_vault"
+	EventTypeTransferVault   = "transfer_vault"
+	EventTypeLeverageVault   = "leverage_vault"
+	EventTypeDeleverageVault = "deleverage_vault"
+	EventTypeRedeem          = "redeem"
+	EventTypeRedeemVault     = "redeem_vault"
 
-	AttributeKeyVaultID               = "vaultId"
-	AttributeKeyCreator               = "creator"
-	AttributeKeyAppID                 = "appId"
-	AttributeKeyExtendedPairID        = "extendedPairId"
-	AttributeKeyAmountIn              = "amountIn"
-	AttributeKeyAmountOut             = "amountOut"
-	AttributeKeyCreatedAt             = "createdAt"
-	AttributeKeyInterestAccumulated   = "interestAccumulated"
-	AttributeKeyClosingFeeAccumulated = "closingFeeAccumulated"
+	EventTypeGrantPositionManager  = "grant_position_manager"
+	EventTypeRevokePositionManager = "revoke_position_manager"
+
+	EventTypeSetVaultProtection      = "set_vault_protection"
+	EventTypeRemoveVaultProtection   = "remove_vault_protection"
+	EventTypeVaultProtectionExecuted = "vault_protection_executed"
+	EventTypeVaultProtectionFailed   = "vault_protection_failed"
+
+	EventTypeDepositCollateral  = "deposit_collateral"
+	EventTypeWithdrawCollateral = "withdraw_collateral"
+
+	AttributeKeyVaultID                = "vaultId"
+	AttributeKeyCreator                = "creator"
+	AttributeKeyOwner                  = "owner"
+	AttributeKeyNewOwner               = "newOwner"
+	AttributeKeyAppID                  = "appId"
+	AttributeKeyExtendedPairID         = "extendedPairId"
+	AttributeKeyAmountIn               = "amountIn"
+	AttributeKeyAmountOut              = "amountOut"
+	AttributeKeyCreatedAt              = "createdAt"
+	AttributeKeyInterestAccumulated    = "interestAccumulated"
+	AttributeKeyClosingFeeAccumulated  = "closingFeeAccumulated"
+	AttributeKeyCollateralizationRatio = "collateralizationRatio"
+	AttributeKeyRedeemer               = "redeemer"
+	AttributeKeyRedeemedAmount         = "redeemedAmount"
+	AttributeKeyCollateralAmount       = "collateralAmount"
+	AttributeKeyRedemptionFee          = "redemptionFee"
+	AttributeKeyManager                = "manager"
+	AttributeKeyPermissions            = "permissions"
+	AttributeKeyTriggerCR              = "triggerCr"
+	AttributeKeyTargetCR               = "targetCr"
+	AttributeKeyAction                 = "action"
+	AttributeKeyAmount                 = "amount"
+	AttributeKeyFee                    = "fee"
+	AttributeKeyError                  = "error"
 )
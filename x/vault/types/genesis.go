@@ -1,12 +1,15 @@
 package types
 
-func NewGenesisState(vaults []Vault, stableMintVault []StableMintVault, appExtendedPairVaultMapping []AppExtendedPairVaultMappingData, userVaultAssetMapping []OwnerAppExtendedPairVaultMappingData, lengthOfvaults uint64) *GenesisState {
+func NewGenesisState(vaults []Vault, stableMintVault []StableMintVault, appExtendedPairVaultMapping []AppExtendedPairVaultMappingData, userVaultAssetMapping []OwnerAppExtendedPairVaultMappingData, lengthOfvaults uint64, redemptionStates []RedemptionState, positionManagerGrants []PositionManagerGrant, vaultProtections []VaultProtection) *GenesisState {
 	return &GenesisState{
 		Vaults:                      vaults,
 		StableMintVault:             stableMintVault,
 		AppExtendedPairVaultMapping: appExtendedPairVaultMapping,
 		UserVaultAssetMapping:       userVaultAssetMapping,
 		LengthOfVaults:              lengthOfvaults,
+		RedemptionStates:            redemptionStates,
+		PositionManagerGrants:       positionManagerGrants,
+		VaultProtections:            vaultProtections,
 	}
 }
 
@@ -18,6 +21,9 @@ func DefaultGenesisState() *GenesisState {
 		[]AppExtendedPairVaultMappingData{},
 		[]OwnerAppExtendedPairVaultMappingData{},
 		length,
+		[]RedemptionState{},
+		[]PositionManagerGrant{},
+		[]VaultProtection{},
 	)
 }
 
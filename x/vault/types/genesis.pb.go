@@ -29,6 +29,9 @@ type GenesisState struct {
 	AppExtendedPairVaultMapping []AppExtendedPairVaultMappingData      `protobuf:"bytes,3,rep,name=appExtendedPairVaultMapping,proto3" json:"appExtendedPairVaultMapping" yaml:"appExtendedPairVaultMapping"`
 	UserVaultAssetMapping       []OwnerAppExtendedPairVaultMappingData `protobuf:"bytes,4,rep,name=userVaultAssetMapping,proto3" json:"userVaultAssetMapping" yaml:"userVaultAssetMapping"`
 	LengthOfVaults              uint64                                 `protobuf:"varint,5,opt,name=lengthOfVaults,proto3" json:"lengthOfVaults,omitempty" yaml:"lengthOfVaults"`
+	RedemptionStates            []RedemptionState                      `protobuf:"bytes,6,rep,name=redemptionStates,proto3" json:"redemptionStates" yaml:"redemptionStates"`
+	PositionManagerGrants       []PositionManagerGrant                 `protobuf:"bytes,7,rep,name=positionManagerGrants,proto3" json:"positionManagerGrants" yaml:"positionManagerGrants"`
+	VaultProtections            []VaultProtection                      `protobuf:"bytes,8,rep,name=vaultProtections,proto3" json:"vaultProtections" yaml:"vaultProtections"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,33 +76,39 @@ func init() {
 }
 
 var fileDescriptor_00ed468466e13f8a = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x4e, 0xea, 0x40,
-	0x14, 0xc6, 0xdb, 0x0b, 0x97, 0x45, 0xef, 0x1f, 0x93, 0x06, 0x4c, 0x05, 0x33, 0x25, 0x13, 0x4d,
-	0x88, 0x89, 0x6d, 0xd0, 0xb8, 0x61, 0x47, 0xa3, 0x71, 0x45, 0xd0, 0x62, 0x5c, 0xb8, 0x9b, 0xc2,
-	0x50, 0x9a, 0x94, 0xb6, 0x61, 0x06, 0x84, 0xb7, 0x70, 0xe5, 0x0b, 0xb8, 0xf1, 0x51, 0x58, 0xb2,
-	0xd3, 0x55, 0xa3, 0xe5, 0x0d, 0x78, 0x02, 0xd3, 0x99, 0x6a, 0x02, 0x19, 0x71, 0xd7, 0xd3, 0xf3,
-	0x9d, 0xdf, 0xf7, 0x9d, 0xcc, 0x51, 0x60, 0x37, 0x1c, 0xf6, 0xf0, 0xd4, 0x9c, 0xa0, 0xb1, 0x4f,
-	0xcd, 0x49, 0xdd, 0xc1, 0x14, 0xd5, 0x4d, 0x17, 0x07, 0x98, 0x78, 0xc4, 0x88, 0x46, 0x21, 0x0d,
-	0xd5, 0x22, 0xd7, 0x18, 0x4c, 0x63, 0x64, 0x9a, 0x72, 0xd1, 0x0d, 0xdd, 0x90, 0x09, 0xcc, 0xf4,
-	0x8b, 0x6b, 0xcb, 0x55, 0x21, 0x8f, 0x4f, 0x32, 0x05, 0x7c, 0xc9, 0x2b, 0x7f, 0x2f, 0x39, 0xbf,
-	0x43, 0x11, 0xc5, 0xea, 0x8d, 0x52, 0x60, 0x7d, 0xa2, 0xc9, 0xd5, 0x5c, 0xed, 0xcf, 0x49, 0xc5,
-	0x10, 0xf9, 0x19, 0xb7, 0x69, 0x65, 0xe9, 0xf3, 0x58, 0x97, 0x92, 0x58, 0x2f, 0xb0, 0x92, 0xac,
-	0x62, 0xfd, 0xdf, 0x0c, 0x0d, 0xfd, 0x06, 0xe4, 0x08, 0x68, 0x67, 0x2c, 0x35, 0x54, 0x76, 0x08,
-	0x45, 0x8e, 0x8f, 0x5b, 0x5e, 0x40, 0x99, 0x58, 0xfb, 0xc5, 0xf0, 0x87, 0x62, 0x7c, 0x67, 0x5d,
-	0x6c, 0x81, 0xd4, 0x68, 0x15, 0xeb, 0xbb, 0x1c, 0xbf, 0xc1, 0x82, 0xf6, 0x26, 0x5d, 0x7d, 0x92,
-	0x95, 0x0a, 0x8a, 0xa2, 0x8b, 0x29, 0xc5, 0x41, 0x0f, 0xf7, 0xae, 0x90, 0x37, 0x62, 0x8d, 0x16,
-	0x8a, 0x22, 0x2f, 0x70, 0xb5, 0x1c, 0x73, 0x3f, 0x13, 0xbb, 0x37, 0xbf, 0x1f, 0x3c, 0x47, 0x14,
-	0x59, 0x47, 0x59, 0x1a, 0xc8, 0xd3, 0x6c, 0xf1, 0x81, 0xf6, 0xb6, 0x14, 0xea, 0xa3, 0xac, 0x94,
-	0xc6, 0x04, 0xf3, 0x9f, 0x4d, 0x42, 0xf0, 0x57, 0xbe, 0x3c, 0xcb, 0xd7, 0x10, 0xe7, 0x6b, 0xdf,
-	0x07, 0x78, 0xf4, 0x53, 0xc8, 0x83, 0x2c, 0xe4, 0x3e, 0x0f, 0x29, 0xb4, 0x81, 0xb6, 0xd8, 0x5e,
-	0x6d, 0x2a, 0xff, 0x7d, 0x1c, 0xb8, 0x74, 0xd0, 0xee, 0xf3, 0xa7, 0xd5, 0x7e, 0x57, 0xe5, 0x5a,
-	0xde, 0xda, 0x5b, 0xc5, 0x7a, 0x89, 0x03, 0xd7, 0xfb, 0xd0, 0xde, 0x18, 0xb0, 0xae, 0xe7, 0xef,
-	0x40, 0x7a, 0x4e, 0x80, 0x34, 0x4f, 0x80, 0xbc, 0x48, 0x80, 0xfc, 0x96, 0x00, 0xf9, 0x61, 0x09,
-	0xa4, 0xc5, 0x12, 0x48, 0xaf, 0x4b, 0x20, 0xdd, 0x99, 0xae, 0x47, 0x07, 0x63, 0x27, 0xdd, 0xd1,
-	0xe4, 0x7b, 0x1e, 0x87, 0xfd, 0xbe, 0xd7, 0xf5, 0x90, 0x9f, 0xd5, 0xe6, 0xe7, 0xe9, 0xd2, 0x59,
-	0x84, 0x89, 0x53, 0x60, 0x37, 0x7b, 0xfa, 0x11, 0x00, 0x00, 0xff, 0xff, 0x43, 0xaa, 0xf1, 0xcc,
-	0x27, 0x03, 0x00, 0x00,
+	// 498 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x13, 0x36, 0x3a, 0x64, 0xfe, 0x2a, 0x5a, 0x21, 0x6c, 0xc8, 0xa9, 0x2c, 0x90, 0xa6,
+	0x49, 0x24, 0x1a, 0x88, 0xcb, 0x6e, 0x8d, 0x40, 0x3b, 0x55, 0x2b, 0x1e, 0xda, 0x81, 0x9b, 0xdb,
+	0xba, 0x99, 0xa5, 0xd4, 0x8e, 0x62, 0x77, 0x6c, 0x5f, 0x80, 0x33, 0x27, 0xbe, 0x00, 0x17, 0x3e,
+	0x4a, 0x8f, 0x3b, 0x72, 0x8a, 0x20, 0xfd, 0x06, 0xfd, 0x04, 0x28, 0x76, 0x40, 0x6a, 0x6a, 0xc2,
+	0x2d, 0xf6, 0xfb, 0xbc, 0xbf, 0xe7, 0x79, 0x63, 0xcb, 0x00, 0x8d, 0xc5, 0x6c, 0x42, 0xaf, 0xa2,
+	0x4b, 0x32, 0x4f, 0x55, 0x74, 0x79, 0x34, 0xa2, 0x8a, 0x1c, 0x45, 0x09, 0xe5, 0x54, 0x32, 0x19,
+	0x66, 0xb9, 0x50, 0xc2, 0xdb, 0x35, 0x9a, 0x50, 0x6b, 0xc2, 0x5a, 0xb3, 0xb7, 0x9b, 0x88, 0x44,
+	0x68, 0x41, 0x54, 0x7d, 0x19, 0xed, 0x5e, 0xcf, 0xca, 0x33, 0x9d, 0x5a, 0x81, 0x16, 0x3b, 0xe0,
+	0xde, 0x89, 0xe1, 0x9f, 0x29, 0xa2, 0xa8, 0xf7, 0x01, 0x74, 0x74, 0x5d, 0xfa, 0x6e, 0x6f, 0xeb,
+	0xe0, 0xee, 0xab, 0xfd, 0xd0, 0xe6, 0x17, 0x9e, 0x57, 0xab, 0x38, 0x58, 0x14, 0x81, 0x53, 0x16,
+	0x41, 0x47, 0x2f, 0xe5, 0xaa, 0x08, 0xee, 0x5f, 0x93, 0x59, 0x7a, 0x8c, 0x0c, 0x02, 0xe1, 0x9a,
+	0xe5, 0x09, 0xf0, 0x50, 0x2a, 0x32, 0x4a, 0xe9, 0x80, 0x71, 0xa5, 0xc5, 0xfe, 0x2d, 0x8d, 0x7f,
+	0x61, 0xc7, 0x9f, 0xad, 0x8b, 0x63, 0x58, 0x19, 0xad, 0x8a, 0xe0, 0xb1, 0xc1, 0x37, 0x58, 0x08,
+	0x37, 0xe9, 0xde, 0x37, 0x17, 0xec, 0x93, 0x2c, 0x7b, 0x77, 0xa5, 0x28, 0x9f, 0xd0, 0xc9, 0x90,
+	0xb0, 0x5c, 0x17, 0x06, 0x24, 0xcb, 0x18, 0x4f, 0xfc, 0x2d, 0xed, 0xfe, 0xc6, 0xee, 0xde, 0xff,
+	0x77, 0xe3, 0x5b, 0xa2, 0x48, 0x7c, 0x58, 0xa7, 0x41, 0x26, 0x4d, 0x8b, 0x0f, 0xc2, 0x6d, 0x29,
+	0xbc, 0xaf, 0x2e, 0xe8, 0xce, 0x25, 0x35, 0x9b, 0x7d, 0x29, 0xe9, 0xdf, 0x7c, 0xdb, 0x3a, 0xdf,
+	0xb1, 0x3d, 0xdf, 0xe9, 0x27, 0x4e, 0xf3, 0xff, 0x85, 0x7c, 0x5e, 0x87, 0x7c, 0x66, 0x42, 0x5a,
+	0x6d, 0x10, 0xb6, 0xdb, 0x7b, 0x7d, 0xf0, 0x20, 0xa5, 0x3c, 0x51, 0x17, 0xa7, 0x53, 0x73, 0xb4,
+	0xfe, 0xed, 0x9e, 0x7b, 0xb0, 0x1d, 0x3f, 0x5d, 0x15, 0x41, 0xd7, 0x00, 0xd7, 0xeb, 0x08, 0x37,
+	0x1a, 0xbc, 0x1c, 0x3c, 0xca, 0xe9, 0x84, 0xce, 0x32, 0xc5, 0x04, 0xd7, 0x77, 0x4b, 0xfa, 0x9d,
+	0xb6, 0x33, 0xc7, 0xeb, 0x6a, 0x73, 0xb9, 0x56, 0x45, 0xf0, 0xc4, 0xf8, 0x35, 0x61, 0x08, 0x6f,
+	0xf0, 0xbd, 0xcf, 0x2e, 0xe8, 0x66, 0x42, 0xb2, 0x6a, 0x6b, 0x40, 0x38, 0x49, 0x68, 0x7e, 0x92,
+	0x13, 0xae, 0xa4, 0xbf, 0xa3, 0x9d, 0x0f, 0xed, 0xce, 0x43, 0x4b, 0x4b, 0xf3, 0xff, 0x59, 0xb1,
+	0x08, 0xdb, 0xed, 0xaa, 0xe1, 0xb5, 0xc5, 0x30, 0x17, 0x8a, 0x8e, 0xab, 0xba, 0xf4, 0xef, 0xb4,
+	0x0d, 0x7f, 0xbe, 0xae, 0x6e, 0x0e, 0xdf, 0x84, 0x21, 0xbc, 0xc1, 0x8f, 0xdf, 0x2f, 0x7e, 0x41,
+	0xe7, 0x7b, 0x09, 0x9d, 0x45, 0x09, 0xdd, 0x9b, 0x12, 0xba, 0x3f, 0x4b, 0xe8, 0x7e, 0x59, 0x42,
+	0xe7, 0x66, 0x09, 0x9d, 0x1f, 0x4b, 0xe8, 0x7c, 0x8c, 0x12, 0xa6, 0x2e, 0xe6, 0xa3, 0x2a, 0x41,
+	0x64, 0x52, 0xbc, 0x14, 0xd3, 0x29, 0x1b, 0x33, 0x92, 0xd6, 0xeb, 0xe8, 0xcf, 0x5b, 0xa1, 0xae,
+	0x33, 0x2a, 0x47, 0x1d, 0xfd, 0x48, 0xbc, 0xfe, 0x3d, 0x00, 0x27, 0x3c, 0x17, 0x8b, 0x98, 0x04,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -122,6 +131,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VaultProtections) > 0 {
+		for iNdEx := len(m.VaultProtections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultProtections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PositionManagerGrants) > 0 {
+		for iNdEx := len(m.PositionManagerGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionManagerGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RedemptionStates) > 0 {
+		for iNdEx := len(m.RedemptionStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.LengthOfVaults != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LengthOfVaults))
 		i--
@@ -230,6 +281,24 @@ func (m *GenesisState) Size() (n int) {
 	if m.LengthOfVaults != 0 {
 		n += 1 + sovGenesis(uint64(m.LengthOfVaults))
 	}
+	if len(m.RedemptionStates) > 0 {
+		for _, e := range m.RedemptionStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PositionManagerGrants) > 0 {
+		for _, e := range m.PositionManagerGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VaultProtections) > 0 {
+		for _, e := range m.VaultProtections {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -423,6 +492,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionStates = append(m.RedemptionStates, RedemptionState{})
+			if err := m.RedemptionStates[len(m.RedemptionStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionManagerGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionManagerGrants = append(m.PositionManagerGrants, PositionManagerGrant{})
+			if err := m.PositionManagerGrants[len(m.PositionManagerGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultProtections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultProtections = append(m.VaultProtections, VaultProtection{})
+			if err := m.VaultProtections[len(m.VaultProtections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -23,6 +24,16 @@ var (
 	TypeMsgDepositStableMintRequest  = ModuleName + ":deposit_stablemint"
 	TypeMsgWithdrawStableMintRequest = ModuleName + ":withdraw_stablemint"
 	TypeMsgVaultInterestCalcRequest  = ModuleName + ":calculate_interest"
+	TypeMsgTransferVaultRequest      = ModuleName + ":transfer"
+	TypeMsgLeverageVaultRequest      = ModuleName + ":leverage"
+	TypeMsgDeleverageVaultRequest    = ModuleName + ":deleverage"
+	TypeMsgRedeemRequest             = ModuleName + ":redeem"
+	TypeMsgGrantPositionManager      = ModuleName + ":grant_position_manager"
+	TypeMsgRevokePositionManager     = ModuleName + ":revoke_position_manager"
+	TypeMsgSetVaultProtection        = ModuleName + ":set_vault_protection"
+	TypeMsgRemoveVaultProtection     = ModuleName + ":remove_vault_protection"
+	TypeMsgDepositCollateral         = ModuleName + ":deposit_collateral"
+	TypeMsgWithdrawCollateral        = ModuleName + ":withdraw_collateral"
 )
 
 var (
@@ -34,6 +45,11 @@ var (
 	StableVaultIDPrefix                   = []byte{0x16}
 	VaultLengthPrefix                     = []byte{0x17}
 	StableVaultRewardsKeyPrefix           = []byte{0x18}
+	VaultCRIndexKeyPrefix                 = []byte{0x19}
+	RedemptionStateKeyPrefix              = []byte{0x1A}
+	PositionManagerGrantKeyPrefix         = []byte{0x1B}
+	VaultProtectionKeyPrefix              = []byte{0x1C}
+	VaultProtectionCursorKey              = []byte{0x1D}
 )
 
 func VaultKey(vaultID uint64) []byte {
@@ -52,6 +68,10 @@ func AppMappingKey(appMappingID uint64) []byte {
 	return append(AppExtendedPairVaultMappingKeyPrefix, sdk.Uint64ToBigEndian(appMappingID)...)
 }
 
+func UserAppExtendedPairVaultMappingKey(address string, appID uint64, pairVaultID uint64, vaultID uint64) []byte {
+	return append(UserAppExtendedPairMappingKey(address, appID, pairVaultID), sdk.Uint64ToBigEndian(vaultID)...)
+}
+
 func UserAppExtendedPairMappingKey(address string, appID uint64, pairVaultID uint64) []byte {
 	return append(append(append(UserVaultExtendedPairMappingKeyPrefix, address...), sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(pairVaultID)...)
 }
@@ -75,3 +95,34 @@ func StableMintRewardsKey(appID uint64, address string) []byte {
 func StableMintRewardsAppKey(appID uint64) []byte {
 	return append(StableVaultRewardsKeyPrefix, sdk.Uint64ToBigEndian(appID)...)
 }
+
+// VaultCRIndexKey orders the vaults of an extended pair vault by their
+// collateral per unit of debt, which orders them by collateralization ratio
+// whatever the prices. Ratios above the sortable range share its top, those
+// vaults are too safe to be redeemed against anyway.
+func VaultCRIndexKey(appID, pairVaultID uint64, ratio sdk.Dec, vaultID uint64) []byte {
+	if ratio.GT(sdk.MaxSortableDec) {
+		ratio = sdk.MaxSortableDec
+	}
+	return append(append(VaultCRIndexPairKey(appID, pairVaultID), sdk.SortableDecBytes(ratio)...), sdk.Uint64ToBigEndian(vaultID)...)
+}
+
+func VaultCRIndexPairKey(appID, pairVaultID uint64) []byte {
+	return append(append(VaultCRIndexKeyPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(pairVaultID)...)
+}
+
+func RedemptionStateKey(appID, pairVaultID uint64) []byte {
+	return append(append(RedemptionStateKeyPrefix, sdk.Uint64ToBigEndian(appID)...), sdk.Uint64ToBigEndian(pairVaultID)...)
+}
+
+func PositionManagerGrantKey(owner, manager sdk.AccAddress) []byte {
+	return append(PositionManagerGrantOwnerKey(owner), address.MustLengthPrefix(manager)...)
+}
+
+func PositionManagerGrantOwnerKey(owner sdk.AccAddress) []byte {
+	return append(PositionManagerGrantKeyPrefix, address.MustLengthPrefix(owner)...)
+}
+
+func VaultProtectionKey(vaultID uint64) []byte {
+	return append(VaultProtectionKeyPrefix, sdk.Uint64ToBigEndian(vaultID)...)
+}
@@ -16,6 +16,9 @@ var (
 	_ sdk.Msg = (*MsgDepositStableMintRequest)(nil)
 	_ sdk.Msg = (*MsgWithdrawStableMintRequest)(nil)
 	_ sdk.Msg = (*MsgVaultInterestCalcRequest)(nil)
+	_ sdk.Msg = (*MsgTransferVaultRequest)(nil)
+	_ sdk.Msg = (*MsgLeverageVaultRequest)(nil)
+	_ sdk.Msg = (*MsgDeleverageVaultRequest)(nil)
 )
 
 func NewMsgCreateRequest(
@@ -616,3 +619,523 @@ func (m *MsgVaultInterestCalcRequest) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{from}
 }
+
+func NewMsgTransferVaultRequest(
+	from sdk.AccAddress,
+	appID uint64, extendedPairVaultID uint64, userVaultID uint64,
+	to sdk.AccAddress,
+) *MsgTransferVaultRequest {
+	return &MsgTransferVaultRequest{
+		From:                from.String(),
+		AppId:               appID,
+		ExtendedPairVaultId: extendedPairVaultID,
+		UserVaultId:         userVaultID,
+		To:                  to.String(),
+	}
+}
+
+func (m *MsgTransferVaultRequest) Route() string {
+	return RouterKey
+}
+
+func (m *MsgTransferVaultRequest) Type() string {
+	return TypeMsgTransferVaultRequest
+}
+
+func (m *MsgTransferVaultRequest) ValidateBasic() error {
+	if m.From == "" {
+		return errors.Wrap(ErrorInvalidFrom, "from cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return errors.Wrapf(ErrorInvalidFrom, "%s", err)
+	}
+	if m.To == "" {
+		return errors.Wrap(ErrorInvalidTo, "to cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.To); err != nil {
+		return errors.Wrapf(ErrorInvalidTo, "%s", err)
+	}
+	if m.From == m.To {
+		return errors.Wrap(ErrorInvalidTo, "to cannot be the same as from")
+	}
+	if m.UserVaultId == 0 {
+		return errors.Wrap(ErrorInvalidID, "id cannot be null")
+	}
+
+	return nil
+}
+
+func (m *MsgTransferVaultRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgTransferVaultRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgLeverageVaultRequest(
+	from sdk.AccAddress,
+	appID uint64, extendedPairVaultID uint64, userVaultID uint64,
+	targetCR sdk.Dec, maxSlippage sdk.Dec,
+) *MsgLeverageVaultRequest {
+	return &MsgLeverageVaultRequest{
+		From:                from.String(),
+		AppId:               appID,
+		ExtendedPairVaultId: extendedPairVaultID,
+		UserVaultId:         userVaultID,
+		TargetCr:            targetCR,
+		MaxSlippage:         maxSlippage,
+	}
+}
+
+func (m *MsgLeverageVaultRequest) Route() string {
+	return RouterKey
+}
+
+func (m *MsgLeverageVaultRequest) Type() string {
+	return TypeMsgLeverageVaultRequest
+}
+
+func (m *MsgLeverageVaultRequest) ValidateBasic() error {
+	if m.From == "" {
+		return errors.Wrap(ErrorInvalidFrom, "from cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return errors.Wrapf(ErrorInvalidFrom, "%s", err)
+	}
+	if m.UserVaultId == 0 {
+		return errors.Wrap(ErrorInvalidID, "id cannot be null")
+	}
+	return validateLeverageParams(m.TargetCr, m.MaxSlippage)
+}
+
+func (m *MsgLeverageVaultRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgLeverageVaultRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgDeleverageVaultRequest(
+	from sdk.AccAddress,
+	appID uint64, extendedPairVaultID uint64, userVaultID uint64,
+	targetCR sdk.Dec, maxSlippage sdk.Dec,
+) *MsgDeleverageVaultRequest {
+	return &MsgDeleverageVaultRequest{
+		From:                from.String(),
+		AppId:               appID,
+		ExtendedPairVaultId: extendedPairVaultID,
+		UserVaultId:         userVaultID,
+		TargetCr:            targetCR,
+		MaxSlippage:         maxSlippage,
+	}
+}
+
+func (m *MsgDeleverageVaultRequest) Route() string {
+	return RouterKey
+}
+
+func (m *MsgDeleverageVaultRequest) Type() string {
+	return TypeMsgDeleverageVaultRequest
+}
+
+func (m *MsgDeleverageVaultRequest) ValidateBasic() error {
+	if m.From == "" {
+		return errors.Wrap(ErrorInvalidFrom, "from cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return errors.Wrapf(ErrorInvalidFrom, "%s", err)
+	}
+	if m.UserVaultId == 0 {
+		return errors.Wrap(ErrorInvalidID, "id cannot be null")
+	}
+	return validateLeverageParams(m.TargetCr, m.MaxSlippage)
+}
+
+func (m *MsgDeleverageVaultRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgDeleverageVaultRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRedeemRequest(
+	from sdk.AccAddress,
+	appID uint64, extendedPairVaultID uint64,
+	amount sdk.Int, maxFeeRate sdk.Dec,
+) *MsgRedeemRequest {
+	return &MsgRedeemRequest{
+		From:                from.String(),
+		AppId:               appID,
+		ExtendedPairVaultId: extendedPairVaultID,
+		Amount:              amount,
+		MaxFeeRate:          maxFeeRate,
+	}
+}
+
+func (m *MsgRedeemRequest) Route() string {
+	return RouterKey
+}
+
+func (m *MsgRedeemRequest) Type() string {
+	return TypeMsgRedeemRequest
+}
+
+func (m *MsgRedeemRequest) ValidateBasic() error {
+	if m.From == "" {
+		return errors.Wrap(ErrorInvalidFrom, "from cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return errors.Wrapf(ErrorInvalidFrom, "%s", err)
+	}
+	if m.ExtendedPairVaultId == 0 {
+		return errors.Wrap(ErrorInvalidID, "extended_pair_vault_id cannot be null")
+	}
+	if m.Amount.IsNil() {
+		return errors.Wrap(ErrorInvalidAmount, "amount cannot be nil")
+	}
+	if !m.Amount.IsPositive() {
+		return errors.Wrap(ErrorInvalidAmount, "amount must be positive")
+	}
+	if m.MaxFeeRate.IsNil() || m.MaxFeeRate.IsNegative() || m.MaxFeeRate.GT(sdk.OneDec()) {
+		return errors.Wrap(ErrorInvalidMaxFeeRate, "max_fee_rate must be between 0 and 1")
+	}
+	return nil
+}
+
+func (m *MsgRedeemRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgRedeemRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgGrantPositionManagerRequest(from, manager sdk.AccAddress, permissions []PositionPermission) *MsgGrantPositionManagerRequest {
+	return &MsgGrantPositionManagerRequest{
+		From:        from.String(),
+		Manager:     manager.String(),
+		Permissions: permissions,
+	}
+}
+
+func (m *MsgGrantPositionManagerRequest) Route() string {
+	return RouterKey
+}
+
+func (m *MsgGrantPositionManagerRequest) Type() string {
+	return TypeMsgGrantPositionManager
+}
+
+func (m *MsgGrantPositionManagerRequest) ValidateBasic() error {
+	if err := validateOwnerAndManager(m.From, m.Manager); err != nil {
+		return err
+	}
+	return ValidatePositionPermissions(m.Permissions)
+}
+
+func (m *MsgGrantPositionManagerRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgGrantPositionManagerRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevokePositionManagerRequest(from, manager sdk.AccAddress) *MsgRevokePositionManagerRequest {
+	return &MsgRevokePositionManagerRequest{
+		From:    from.String(),
+		Manager: manager.String(),
+	}
+}
+
+func (m *MsgRevokePositionManagerRequest) Route() string {
+	return RouterKey
+}
+
+func (m *MsgRevokePositionManagerRequest) Type() string {
+	return TypeMsgRevokePositionManager
+}
+
+func (m *MsgRevokePositionManagerRequest) ValidateBasic() error {
+	return validateOwnerAndManager(m.From, m.Manager)
+}
+
+func (m *MsgRevokePositionManagerRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgRevokePositionManagerRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgSetVaultProtectionRequest(
+	from sdk.AccAddress,
+	appID uint64, extendedPairVaultID uint64, userVaultID uint64,
+	triggerCR, targetCR sdk.Dec, action ProtectionAction, maxAmount sdk.Int, maxSlippage sdk.Dec,
+) *MsgSetVaultProtectionRequest {
+	return &MsgSetVaultProtectionRequest{
+		From:                from.String(),
+		AppId:               appID,
+		ExtendedPairVaultId: extendedPairVaultID,
+		UserVaultId:         userVaultID,
+		TriggerCr:           triggerCR,
+		TargetCr:            targetCR,
+		Action:              action,
+		MaxAmount:           maxAmount,
+		MaxSlippage:         maxSlippage,
+	}
+}
+
+func (m *MsgSetVaultProtectionRequest) Route() string {
+	return RouterKey
+}
+
+func (m *MsgSetVaultProtectionRequest) Type() string {
+	return TypeMsgSetVaultProtection
+}
+
+func (m *MsgSetVaultProtectionRequest) ValidateBasic() error {
+	if m.From == "" {
+		return errors.Wrap(ErrorInvalidFrom, "from cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return errors.Wrapf(ErrorInvalidFrom, "%s", err)
+	}
+	if m.UserVaultId == 0 {
+		return errors.Wrap(ErrorInvalidID, "id cannot be null")
+	}
+	if m.TriggerCr.IsNil() || m.TriggerCr.LTE(sdk.OneDec()) {
+		return errors.Wrap(ErrorInvalidTriggerCR, "trigger_cr must be greater than 1")
+	}
+	if m.TargetCr.IsNil() || m.TargetCr.LTE(m.TriggerCr) {
+		return errors.Wrap(ErrorInvalidTargetCR, "target_cr must be greater than trigger_cr")
+	}
+	if _, ok := ProtectionAction_name[int32(m.Action)]; !ok || m.Action == ProtectionActionUnspecified {
+		return errors.Wrapf(ErrorInvalidProtectionAction, "unknown action %d", m.Action)
+	}
+	if m.MaxAmount.IsNil() || !m.MaxAmount.IsPositive() {
+		return errors.Wrap(ErrorInvalidAmount, "max_amount must be positive")
+	}
+	return validateLeverageParams(m.TargetCr, m.MaxSlippage)
+}
+
+func (m *MsgSetVaultProtectionRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgSetVaultProtectionRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRemoveVaultProtectionRequest(from sdk.AccAddress, userVaultID uint64) *MsgRemoveVaultProtectionRequest {
+	return &MsgRemoveVaultProtectionRequest{
+		From:        from.String(),
+		UserVaultId: userVaultID,
+	}
+}
+
+func (m *MsgRemoveVaultProtectionRequest) Route() string {
+	return RouterKey
+}
+
+func (m *MsgRemoveVaultProtectionRequest) Type() string {
+	return TypeMsgRemoveVaultProtection
+}
+
+func (m *MsgRemoveVaultProtectionRequest) ValidateBasic() error {
+	if m.From == "" {
+		return errors.Wrap(ErrorInvalidFrom, "from cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return errors.Wrapf(ErrorInvalidFrom, "%s", err)
+	}
+	if m.UserVaultId == 0 {
+		return errors.Wrap(ErrorInvalidID, "id cannot be null")
+	}
+	return nil
+}
+
+func (m *MsgRemoveVaultProtectionRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgRemoveVaultProtectionRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgDepositCollateralRequest(
+	from sdk.AccAddress,
+	appID uint64, extendedPairVaultID uint64, userVaultID uint64, amount sdk.Coin,
+) *MsgDepositCollateralRequest {
+	return &MsgDepositCollateralRequest{
+		From:                from.String(),
+		AppId:               appID,
+		ExtendedPairVaultId: extendedPairVaultID,
+		UserVaultId:         userVaultID,
+		Amount:              amount,
+	}
+}
+
+func (m *MsgDepositCollateralRequest) Route() string {
+	return RouterKey
+}
+
+func (m *MsgDepositCollateralRequest) Type() string {
+	return TypeMsgDepositCollateral
+}
+
+func (m *MsgDepositCollateralRequest) ValidateBasic() error {
+	if m.From == "" {
+		return errors.Wrap(ErrorInvalidFrom, "from cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return errors.Wrapf(ErrorInvalidFrom, "%s", err)
+	}
+	if m.UserVaultId == 0 {
+		return errors.Wrap(ErrorInvalidID, "id cannot be null")
+	}
+	if err := m.Amount.Validate(); err != nil {
+		return errors.Wrapf(ErrorInvalidAmount, "%s", err)
+	}
+	if !m.Amount.IsPositive() {
+		return errors.Wrap(ErrorInvalidAmount, "amount must be positive")
+	}
+
+	return nil
+}
+
+func (m *MsgDepositCollateralRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgDepositCollateralRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgWithdrawCollateralRequest(
+	from sdk.AccAddress,
+	appID uint64, extendedPairVaultID uint64, userVaultID uint64, amount sdk.Coin,
+) *MsgWithdrawCollateralRequest {
+	return &MsgWithdrawCollateralRequest{
+		From:                from.String(),
+		AppId:               appID,
+		ExtendedPairVaultId: extendedPairVaultID,
+		UserVaultId:         userVaultID,
+		Amount:              amount,
+	}
+}
+
+func (m *MsgWithdrawCollateralRequest) Route() string {
+	return RouterKey
+}
+
+func (m *MsgWithdrawCollateralRequest) Type() string {
+	return TypeMsgWithdrawCollateral
+}
+
+func (m *MsgWithdrawCollateralRequest) ValidateBasic() error {
+	if m.From == "" {
+		return errors.Wrap(ErrorInvalidFrom, "from cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return errors.Wrapf(ErrorInvalidFrom, "%s", err)
+	}
+	if m.UserVaultId == 0 {
+		return errors.Wrap(ErrorInvalidID, "id cannot be null")
+	}
+	if err := m.Amount.Validate(); err != nil {
+		return errors.Wrapf(ErrorInvalidAmount, "%s", err)
+	}
+	if !m.Amount.IsPositive() {
+		return errors.Wrap(ErrorInvalidAmount, "amount must be positive")
+	}
+
+	return nil
+}
+
+func (m *MsgWithdrawCollateralRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgWithdrawCollateralRequest) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+func validateOwnerAndManager(from, manager string) error {
+	if from == "" {
+		return errors.Wrap(ErrorInvalidFrom, "from cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(from); err != nil {
+		return errors.Wrapf(ErrorInvalidFrom, "%s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(manager); err != nil {
+		return errors.Wrapf(ErrorInvalidManager, "%s", err)
+	}
+	if from == manager {
+		return errors.Wrap(ErrorInvalidManager, "manager cannot be the owner")
+	}
+	return nil
+}
+
+func validateLeverageParams(targetCR, maxSlippage sdk.Dec) error {
+	if targetCR.IsNil() || targetCR.LTE(sdk.OneDec()) {
+		return errors.Wrap(ErrorInvalidTargetCR, "target_cr must be greater than 1")
+	}
+	if maxSlippage.IsNil() || maxSlippage.IsNegative() || maxSlippage.GTE(sdk.OneDec()) {
+		return errors.Wrap(ErrorInvalidMaxSlippage, "max_slippage must be between 0 and 1")
+	}
+	return nil
+}
@@ -582,3 +582,450 @@ func TestNewMsgWithdrawStableMintRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMsgTransferVaultRequest(t *testing.T) {
+	from := sdk.MustAccAddressFromBech32("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t")
+	to := sdk.AccAddress([]byte("addr2_______________"))
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgTransferVaultRequest
+		isErrExp bool
+	}{
+		{
+			name: "empty from",
+			msg: types.NewMsgTransferVaultRequest(
+				sdk.AccAddress([]byte("")), 1, 1, 1, to,
+			),
+			isErrExp: true,
+		},
+		{
+			name: "empty to",
+			msg: types.NewMsgTransferVaultRequest(
+				from, 1, 1, 1, sdk.AccAddress([]byte("")),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "to same as from",
+			msg: types.NewMsgTransferVaultRequest(
+				from, 1, 1, 1, from,
+			),
+			isErrExp: true,
+		},
+		{
+			name: "vaultID zero",
+			msg: types.NewMsgTransferVaultRequest(
+				from, 1, 1, 0, to,
+			),
+			isErrExp: true,
+		},
+		{
+			name: "valid case",
+			msg: types.NewMsgTransferVaultRequest(
+				from, 1, 1, 1, to,
+			),
+			isErrExp: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.msg.Route(), types.RouterKey)
+			require.Equal(t, tc.msg.Type(), types.TypeMsgTransferVaultRequest)
+
+			err := tc.msg.ValidateBasic()
+
+			if tc.isErrExp {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewMsgLeverageVaultRequest(t *testing.T) {
+	from := sdk.MustAccAddressFromBech32("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t")
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgLeverageVaultRequest
+		isErrExp bool
+	}{
+		{
+			name: "empty from",
+			msg: types.NewMsgLeverageVaultRequest(
+				sdk.AccAddress([]byte("")), 1, 1, 1, sdk.NewDec(3), sdk.NewDecWithPrec(5, 2),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "vaultID zero",
+			msg: types.NewMsgLeverageVaultRequest(
+				from, 1, 1, 0, sdk.NewDec(3), sdk.NewDecWithPrec(5, 2),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "target cr not greater than one",
+			msg: types.NewMsgLeverageVaultRequest(
+				from, 1, 1, 1, sdk.OneDec(), sdk.NewDecWithPrec(5, 2),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "negative max slippage",
+			msg: types.NewMsgLeverageVaultRequest(
+				from, 1, 1, 1, sdk.NewDec(3), sdk.NewDecWithPrec(-5, 2),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "max slippage of one",
+			msg: types.NewMsgLeverageVaultRequest(
+				from, 1, 1, 1, sdk.NewDec(3), sdk.OneDec(),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "valid case",
+			msg: types.NewMsgLeverageVaultRequest(
+				from, 1, 1, 1, sdk.NewDec(3), sdk.NewDecWithPrec(5, 2),
+			),
+			isErrExp: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.msg.Route(), types.RouterKey)
+			require.Equal(t, tc.msg.Type(), types.TypeMsgLeverageVaultRequest)
+
+			err := tc.msg.ValidateBasic()
+
+			if tc.isErrExp {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewMsgDeleverageVaultRequest(t *testing.T) {
+	from := sdk.MustAccAddressFromBech32("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t")
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgDeleverageVaultRequest
+		isErrExp bool
+	}{
+		{
+			name: "empty from",
+			msg: types.NewMsgDeleverageVaultRequest(
+				sdk.AccAddress([]byte("")), 1, 1, 1, sdk.NewDec(3), sdk.NewDecWithPrec(5, 2),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "vaultID zero",
+			msg: types.NewMsgDeleverageVaultRequest(
+				from, 1, 1, 0, sdk.NewDec(3), sdk.NewDecWithPrec(5, 2),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "target cr not greater than one",
+			msg: types.NewMsgDeleverageVaultRequest(
+				from, 1, 1, 1, sdk.OneDec(), sdk.NewDecWithPrec(5, 2),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "negative max slippage",
+			msg: types.NewMsgDeleverageVaultRequest(
+				from, 1, 1, 1, sdk.NewDec(3), sdk.NewDecWithPrec(-5, 2),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "max slippage of one",
+			msg: types.NewMsgDeleverageVaultRequest(
+				from, 1, 1, 1, sdk.NewDec(3), sdk.OneDec(),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "valid case",
+			msg: types.NewMsgDeleverageVaultRequest(
+				from, 1, 1, 1, sdk.NewDec(3), sdk.NewDecWithPrec(5, 2),
+			),
+			isErrExp: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.msg.Route(), types.RouterKey)
+			require.Equal(t, tc.msg.Type(), types.TypeMsgDeleverageVaultRequest)
+
+			err := tc.msg.ValidateBasic()
+
+			if tc.isErrExp {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewMsgRedeemRequest(t *testing.T) {
+	from := sdk.MustAccAddressFromBech32("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t")
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgRedeemRequest
+		isErrExp bool
+	}{
+		{
+			name: "empty from",
+			msg: types.NewMsgRedeemRequest(
+				sdk.AccAddress([]byte("")), 1, 1, sdk.NewInt(100), sdk.NewDecWithPrec(5, 2),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "extended pair vault id zero",
+			msg: types.NewMsgRedeemRequest(
+				from, 1, 0, sdk.NewInt(100), sdk.NewDecWithPrec(5, 2),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "amount zero",
+			msg: types.NewMsgRedeemRequest(
+				from, 1, 1, sdk.ZeroInt(), sdk.NewDecWithPrec(5, 2),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "negative max fee rate",
+			msg: types.NewMsgRedeemRequest(
+				from, 1, 1, sdk.NewInt(100), sdk.NewDecWithPrec(-5, 2),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "max fee rate greater than one",
+			msg: types.NewMsgRedeemRequest(
+				from, 1, 1, sdk.NewInt(100), sdk.NewDecWithPrec(11, 1),
+			),
+			isErrExp: true,
+		},
+		{
+			name: "valid case",
+			msg: types.NewMsgRedeemRequest(
+				from, 1, 1, sdk.NewInt(100), sdk.NewDecWithPrec(5, 2),
+			),
+			isErrExp: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.msg.Route(), types.RouterKey)
+			require.Equal(t, tc.msg.Type(), types.TypeMsgRedeemRequest)
+
+			err := tc.msg.ValidateBasic()
+
+			if tc.isErrExp {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewMsgGrantPositionManagerRequest(t *testing.T) {
+	from := sdk.MustAccAddressFromBech32("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t")
+	manager := sdk.AccAddress([]byte("addr2_______________"))
+	deposit := []types.PositionPermission{types.PositionPermissionDeposit}
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgGrantPositionManagerRequest
+		isErrExp bool
+	}{
+		{
+			name:     "empty manager",
+			msg:      types.NewMsgGrantPositionManagerRequest(from, sdk.AccAddress([]byte("")), deposit),
+			isErrExp: true,
+		},
+		{
+			name:     "manager same as owner",
+			msg:      types.NewMsgGrantPositionManagerRequest(from, from, deposit),
+			isErrExp: true,
+		},
+		{
+			name:     "no permissions",
+			msg:      types.NewMsgGrantPositionManagerRequest(from, manager, nil),
+			isErrExp: true,
+		},
+		{
+			name:     "unspecified permission",
+			msg:      types.NewMsgGrantPositionManagerRequest(from, manager, []types.PositionPermission{types.PositionPermissionUnspecified}),
+			isErrExp: true,
+		},
+		{
+			name:     "duplicate permission",
+			msg:      types.NewMsgGrantPositionManagerRequest(from, manager, []types.PositionPermission{types.PositionPermissionRepay, types.PositionPermissionRepay}),
+			isErrExp: true,
+		},
+		{
+			name: "valid case",
+			msg: types.NewMsgGrantPositionManagerRequest(from, manager, []types.PositionPermission{
+				types.PositionPermissionDeposit, types.PositionPermissionRepay, types.PositionPermissionWithdrawToOwner,
+			}),
+			isErrExp: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.msg.Route(), types.RouterKey)
+			require.Equal(t, tc.msg.Type(), types.TypeMsgGrantPositionManager)
+
+			err := tc.msg.ValidateBasic()
+
+			if tc.isErrExp {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	permissions, err := types.ParsePositionPermissions("deposit, withdraw_to_owner")
+	require.NoError(t, err)
+	require.Equal(t, []types.PositionPermission{types.PositionPermissionDeposit, types.PositionPermissionWithdrawToOwner}, permissions)
+	_, err = types.ParsePositionPermissions("draw")
+	require.Error(t, err)
+}
+
+func TestNewMsgSetVaultProtectionRequest(t *testing.T) {
+	from := sdk.MustAccAddressFromBech32("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t")
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgSetVaultProtectionRequest
+		isErrExp bool
+	}{
+		{
+			name:     "empty user vault id",
+			msg:      types.NewMsgSetVaultProtectionRequest(from, 1, 1, 0, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2"), types.ProtectionActionRepay, sdk.NewInt(100), sdk.MustNewDecFromStr("0.05")),
+			isErrExp: true,
+		},
+		{
+			name:     "trigger cr not greater than 1",
+			msg:      types.NewMsgSetVaultProtectionRequest(from, 1, 1, 1, sdk.MustNewDecFromStr("1"), sdk.MustNewDecFromStr("2"), types.ProtectionActionRepay, sdk.NewInt(100), sdk.MustNewDecFromStr("0.05")),
+			isErrExp: true,
+		},
+		{
+			name:     "target cr not greater than trigger cr",
+			msg:      types.NewMsgSetVaultProtectionRequest(from, 1, 1, 1, sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("2"), types.ProtectionActionRepay, sdk.NewInt(100), sdk.MustNewDecFromStr("0.05")),
+			isErrExp: true,
+		},
+		{
+			name:     "unspecified action",
+			msg:      types.NewMsgSetVaultProtectionRequest(from, 1, 1, 1, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2"), types.ProtectionActionUnspecified, sdk.NewInt(100), sdk.MustNewDecFromStr("0.05")),
+			isErrExp: true,
+		},
+		{
+			name:     "zero max amount",
+			msg:      types.NewMsgSetVaultProtectionRequest(from, 1, 1, 1, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2"), types.ProtectionActionDeposit, sdk.ZeroInt(), sdk.MustNewDecFromStr("0.05")),
+			isErrExp: true,
+		},
+		{
+			name:     "valid case",
+			msg:      types.NewMsgSetVaultProtectionRequest(from, 1, 1, 1, sdk.MustNewDecFromStr("1.6"), sdk.MustNewDecFromStr("2"), types.ProtectionActionSellCollateral, sdk.NewInt(100), sdk.MustNewDecFromStr("0.05")),
+			isErrExp: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.msg.Route(), types.RouterKey)
+			require.Equal(t, tc.msg.Type(), types.TypeMsgSetVaultProtection)
+
+			err := tc.msg.ValidateBasic()
+
+			if tc.isErrExp {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	action, err := types.ParseProtectionAction("sell_collateral")
+	require.NoError(t, err)
+	require.Equal(t, types.ProtectionActionSellCollateral, action)
+	_, err = types.ParseProtectionAction("unspecified")
+	require.Error(t, err)
+}
+
+func TestNewMsgDepositCollateralRequest(t *testing.T) {
+	from := sdk.MustAccAddressFromBech32("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t")
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgDepositCollateralRequest
+		isErrExp bool
+	}{
+		{
+			name:     "empty user vault id",
+			msg:      types.NewMsgDepositCollateralRequest(from, 1, 1, 0, sdk.NewCoin("uatom", sdk.NewInt(100))),
+			isErrExp: true,
+		},
+		{
+			name:     "zero amount",
+			msg:      types.NewMsgDepositCollateralRequest(from, 1, 1, 1, sdk.NewCoin("uatom", sdk.ZeroInt())),
+			isErrExp: true,
+		},
+		{
+			name:     "invalid denom",
+			msg:      types.NewMsgDepositCollateralRequest(from, 1, 1, 1, sdk.Coin{Denom: "1atom", Amount: sdk.NewInt(100)}),
+			isErrExp: true,
+		},
+		{
+			name:     "valid case",
+			msg:      types.NewMsgDepositCollateralRequest(from, 1, 1, 1, sdk.NewCoin("uatom", sdk.NewInt(100))),
+			isErrExp: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.msg.Route(), types.RouterKey)
+			require.Equal(t, tc.msg.Type(), types.TypeMsgDepositCollateral)
+
+			err := tc.msg.ValidateBasic()
+
+			if tc.isErrExp {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ParseProtectionAction parses an action name such as "repay", "deposit" or
+// "sell_collateral".
+func ParseProtectionAction(s string) (ProtectionAction, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	action, ok := ProtectionAction_value["PROTECTION_ACTION_"+name]
+	if !ok || ProtectionAction(action) == ProtectionActionUnspecified {
+		return ProtectionActionUnspecified, errors.Wrapf(ErrorInvalidProtectionAction, "unknown action %s", s)
+	}
+	return ProtectionAction(action), nil
+}
@@ -584,7 +584,9 @@ func (m *QueryVaultIDOfOwnerByExtendedPairAndAppRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryVaultIDOfOwnerByExtendedPairAndAppRequest proto.InternalMessageInfo
 
 type QueryVaultIDOfOwnerByExtendedPairAndAppResponse struct {
-	Vault_Id uint64 `protobuf:"varint,1,opt,name=vault_Id,json=vaultId,proto3" json:"vault_Id,omitempty" yaml:"vault_Id"`
+	// vault_Id is the owner's oldest vault on the extended pair.
+	Vault_Id uint64   `protobuf:"varint,1,opt,name=vault_Id,json=vaultId,proto3" json:"vault_Id,omitempty" yaml:"vault_Id"`
+	VaultIds []uint64 `protobuf:"varint,2,rep,packed,name=vault_ids,json=vaultIds,proto3" json:"vault_ids,omitempty" yaml:"vault_ids"`
 }
 
 func (m *QueryVaultIDOfOwnerByExtendedPairAndAppResponse) Reset() {
@@ -1972,6 +1974,154 @@ func (m *QueryUserExtendedPairTotalDataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryUserExtendedPairTotalDataResponse proto.InternalMessageInfo
 
+type QueryPositionManagerGrantsRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+}
+
+func (m *QueryPositionManagerGrantsRequest) Reset()         { *m = QueryPositionManagerGrantsRequest{} }
+func (m *QueryPositionManagerGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionManagerGrantsRequest) ProtoMessage()    {}
+func (*QueryPositionManagerGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d35126a97363346, []int{49}
+}
+func (m *QueryPositionManagerGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionManagerGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionManagerGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionManagerGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionManagerGrantsRequest.Merge(m, src)
+}
+func (m *QueryPositionManagerGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionManagerGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionManagerGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionManagerGrantsRequest proto.InternalMessageInfo
+
+type QueryPositionManagerGrantsResponse struct {
+	Grants []PositionManagerGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants" yaml:"grants"`
+}
+
+func (m *QueryPositionManagerGrantsResponse) Reset()         { *m = QueryPositionManagerGrantsResponse{} }
+func (m *QueryPositionManagerGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionManagerGrantsResponse) ProtoMessage()    {}
+func (*QueryPositionManagerGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d35126a97363346, []int{50}
+}
+func (m *QueryPositionManagerGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionManagerGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionManagerGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionManagerGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionManagerGrantsResponse.Merge(m, src)
+}
+func (m *QueryPositionManagerGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionManagerGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionManagerGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionManagerGrantsResponse proto.InternalMessageInfo
+
+type QueryVaultProtectionRequest struct {
+	UserVaultId uint64 `protobuf:"varint,1,opt,name=user_vault_id,json=userVaultId,proto3" json:"user_vault_id,omitempty" yaml:"user_vault_id"`
+}
+
+func (m *QueryVaultProtectionRequest) Reset()         { *m = QueryVaultProtectionRequest{} }
+func (m *QueryVaultProtectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultProtectionRequest) ProtoMessage()    {}
+func (*QueryVaultProtectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d35126a97363346, []int{51}
+}
+func (m *QueryVaultProtectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultProtectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultProtectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultProtectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultProtectionRequest.Merge(m, src)
+}
+func (m *QueryVaultProtectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultProtectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultProtectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultProtectionRequest proto.InternalMessageInfo
+
+type QueryVaultProtectionResponse struct {
+	Protection VaultProtection `protobuf:"bytes,1,opt,name=protection,proto3" json:"protection" yaml:"protection"`
+}
+
+func (m *QueryVaultProtectionResponse) Reset()         { *m = QueryVaultProtectionResponse{} }
+func (m *QueryVaultProtectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultProtectionResponse) ProtoMessage()    {}
+func (*QueryVaultProtectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d35126a97363346, []int{52}
+}
+func (m *QueryVaultProtectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultProtectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultProtectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultProtectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultProtectionResponse.Merge(m, src)
+}
+func (m *QueryVaultProtectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultProtectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultProtectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultProtectionResponse proto.InternalMessageInfo
+
 type QueryPairsLockedAndMintedStatisticByAppRequest struct {
 	AppId      uint64             `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty" yaml:"pagination"`
@@ -1985,7 +2135,7 @@ func (m *QueryPairsLockedAndMintedStatisticByAppRequest) String() string {
 }
 func (*QueryPairsLockedAndMintedStatisticByAppRequest) ProtoMessage() {}
 func (*QueryPairsLockedAndMintedStatisticByAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d35126a97363346, []int{49}
+	return fileDescriptor_8d35126a97363346, []int{53}
 }
 func (m *QueryPairsLockedAndMintedStatisticByAppRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2177,7 @@ func (m *QueryPairsLockedAndMintedStatisticByAppResponse) String() string {
 }
 func (*QueryPairsLockedAndMintedStatisticByAppResponse) ProtoMessage() {}
 func (*QueryPairsLockedAndMintedStatisticByAppResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d35126a97363346, []int{50}
+	return fileDescriptor_8d35126a97363346, []int{54}
 }
 func (m *QueryPairsLockedAndMintedStatisticByAppResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2064,7 +2214,7 @@ func (m *QueryAllStableMintVaultRewardsRequest) Reset()         { *m = QueryAllS
 func (m *QueryAllStableMintVaultRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllStableMintVaultRewardsRequest) ProtoMessage()    {}
 func (*QueryAllStableMintVaultRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d35126a97363346, []int{51}
+	return fileDescriptor_8d35126a97363346, []int{55}
 }
 func (m *QueryAllStableMintVaultRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2104,7 +2254,7 @@ func (m *QueryAllStableMintVaultRewardsResponse) Reset() {
 func (m *QueryAllStableMintVaultRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllStableMintVaultRewardsResponse) ProtoMessage()    {}
 func (*QueryAllStableMintVaultRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d35126a97363346, []int{52}
+	return fileDescriptor_8d35126a97363346, []int{56}
 }
 func (m *QueryAllStableMintVaultRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2183,6 +2333,10 @@ func init() {
 	proto.RegisterType((*QueryUserMyPositionByAppResponse)(nil), "comdex.vault.v1beta1.QueryUserMyPositionByAppResponse")
 	proto.RegisterType((*QueryUserExtendedPairTotalDataRequest)(nil), "comdex.vault.v1beta1.QueryUserExtendedPairTotalDataRequest")
 	proto.RegisterType((*QueryUserExtendedPairTotalDataResponse)(nil), "comdex.vault.v1beta1.QueryUserExtendedPairTotalDataResponse")
+	proto.RegisterType((*QueryPositionManagerGrantsRequest)(nil), "comdex.vault.v1beta1.QueryPositionManagerGrantsRequest")
+	proto.RegisterType((*QueryPositionManagerGrantsResponse)(nil), "comdex.vault.v1beta1.QueryPositionManagerGrantsResponse")
+	proto.RegisterType((*QueryVaultProtectionRequest)(nil), "comdex.vault.v1beta1.QueryVaultProtectionRequest")
+	proto.RegisterType((*QueryVaultProtectionResponse)(nil), "comdex.vault.v1beta1.QueryVaultProtectionResponse")
 	proto.RegisterType((*QueryPairsLockedAndMintedStatisticByAppRequest)(nil), "comdex.vault.v1beta1.QueryPairsLockedAndMintedStatisticByAppRequest")
 	proto.RegisterType((*QueryPairsLockedAndMintedStatisticByAppResponse)(nil), "comdex.vault.v1beta1.QueryPairsLockedAndMintedStatisticByAppResponse")
 	proto.RegisterType((*QueryAllStableMintVaultRewardsRequest)(nil), "comdex.vault.v1beta1.QueryAllStableMintVaultRewardsRequest")
@@ -2192,193 +2346,205 @@ func init() {
 func init() { proto.RegisterFile("comdex/vault/v1beta1/query.proto", fileDescriptor_8d35126a97363346) }
 
 var fileDescriptor_8d35126a97363346 = []byte{
-	// 2973 bytes of a gzipped FileDescriptorProto
+	// 3155 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x5d, 0x6c, 0x1c, 0x57,
-	0xf5, 0xf7, 0xac, 0xe3, 0x34, 0xbe, 0x8e, 0x1d, 0xfb, 0xc6, 0x75, 0xb6, 0xdb, 0x74, 0xd7, 0xbd,
-	0x49, 0x9d, 0xcf, 0xdd, 0xad, 0x9d, 0xcf, 0xe6, 0xcb, 0xd9, 0xb5, 0x9d, 0xfc, 0xf7, 0xdf, 0xa4,
-	0x76, 0x26, 0xc1, 0x69, 0x40, 0xe9, 0x32, 0xde, 0x19, 0xbb, 0xa3, 0xcc, 0xce, 0x6c, 0x77, 0xc6,
-	0x4e, 0x4d, 0x88, 0x84, 0xf2, 0x00, 0xa2, 0x88, 0xaa, 0x08, 0x84, 0xa0, 0x08, 0x78, 0x01, 0xd1,
-	0xaa, 0x2f, 0x45, 0x42, 0x95, 0x10, 0xbc, 0xe4, 0x05, 0x05, 0x09, 0xa4, 0x20, 0x82, 0x84, 0xfa,
-	0xb0, 0x2a, 0x4e, 0x9f, 0x78, 0x00, 0xb4, 0x42, 0x42, 0xe2, 0x43, 0x42, 0x73, 0xef, 0x99, 0x9d,
-	0x99, 0xf5, 0xec, 0x7c, 0xd8, 0x59, 0xea, 0x2a, 0x2f, 0xf6, 0xee, 0xcc, 0xbd, 0xe7, 0x9e, 0xdf,
-	0xef, 0xdc, 0x7b, 0xee, 0xb9, 0xf7, 0x9c, 0x45, 0xc3, 0x25, 0xad, 0x2c, 0x4a, 0xaf, 0x67, 0x97,
-	0x84, 0x45, 0xc5, 0xc8, 0x2e, 0x8d, 0xce, 0x49, 0x86, 0x30, 0x9a, 0x7d, 0x6d, 0x51, 0xaa, 0x2e,
-	0x67, 0x2a, 0x55, 0xcd, 0xd0, 0xf0, 0x20, 0x6b, 0x91, 0xa1, 0x2d, 0x32, 0xd0, 0x22, 0xb1, 0xbf,
-	0xa4, 0xe9, 0x65, 0x4d, 0xcf, 0xce, 0x09, 0xba, 0xc4, 0x9a, 0x37, 0x3a, 0x57, 0x84, 0x05, 0x59,
-	0x15, 0x0c, 0x59, 0x53, 0x99, 0x84, 0xc4, 0xe0, 0x82, 0xb6, 0xa0, 0xd1, 0x8f, 0x59, 0xf3, 0x13,
-	0x3c, 0xdd, 0xb9, 0xa0, 0x69, 0x0b, 0x8a, 0x94, 0x15, 0x2a, 0x72, 0x56, 0x50, 0x55, 0xcd, 0xa0,
-	0x5d, 0x74, 0x78, 0xeb, 0xad, 0x17, 0xd3, 0x81, 0xb6, 0x20, 0xff, 0xd8, 0x8c, 0xba, 0x67, 0xcd,
-	0xef, 0x05, 0x75, 0x5e, 0xc3, 0x7d, 0x28, 0x26, 0x8b, 0x71, 0x6e, 0x98, 0xdb, 0xbb, 0x89, 0x8f,
-	0xc9, 0x22, 0xbe, 0x8a, 0xfa, 0xa5, 0xd7, 0x0d, 0x49, 0x15, 0x25, 0xb1, 0x58, 0x11, 0xe4, 0x6a,
-	0x51, 0x16, 0xe3, 0x31, 0xf3, 0x6d, 0x3e, 0xbd, 0x52, 0x4b, 0xf5, 0x4d, 0xc1, 0xbb, 0x19, 0x41,
-	0xae, 0x16, 0x26, 0xeb, 0xb5, 0xd4, 0x8e, 0x65, 0xa1, 0xac, 0x9c, 0x20, 0xcd, 0x7d, 0x08, 0xdf,
-	0x27, 0x39, 0x9b, 0x8a, 0x78, 0x04, 0x75, 0x69, 0x37, 0x55, 0xa9, 0x1a, 0xef, 0x1c, 0xe6, 0xf6,
-	0x76, 0xe7, 0xfb, 0xeb, 0xb5, 0xd4, 0x56, 0xd6, 0x97, 0x3e, 0x26, 0x3c, 0x7b, 0x8d, 0x4b, 0x08,
-	0x95, 0x34, 0x45, 0x11, 0x0c, 0xa9, 0x2a, 0x28, 0xf1, 0x4d, 0xb4, 0xf1, 0xc4, 0xbd, 0x5a, 0xaa,
-	0xe3, 0xc3, 0x5a, 0x6a, 0x64, 0x41, 0x36, 0x5e, 0x5d, 0x9c, 0xcb, 0x94, 0xb4, 0x72, 0x16, 0x78,
-	0x64, 0xff, 0xd2, 0xba, 0x78, 0x23, 0x6b, 0x2c, 0x57, 0x24, 0x3d, 0x53, 0x50, 0x8d, 0x7a, 0x2d,
-	0x35, 0xc0, 0x44, 0xdb, 0x92, 0x08, 0xef, 0x10, 0x8b, 0x2f, 0xa1, 0x4d, 0xa2, 0x34, 0x67, 0xc4,
-	0xbb, 0xa8, 0xf8, 0xd3, 0x91, 0xc5, 0xf7, 0x30, 0xf1, 0xa6, 0x0c, 0xc2, 0x53, 0x51, 0xf8, 0xab,
-	0x1c, 0xda, 0x61, 0x8f, 0x20, 0x7f, 0x81, 0x5a, 0xa5, 0x58, 0x35, 0xff, 0xc5, 0x37, 0xd3, 0x61,
-	0x66, 0x22, 0x0c, 0x33, 0x29, 0x95, 0xea, 0xb5, 0x54, 0xb2, 0x19, 0x85, 0x4b, 0x2c, 0xe1, 0x87,
-	0x56, 0xbd, 0xe1, 0xcd, 0xbf, 0xf8, 0x45, 0x84, 0xdd, 0x06, 0x51, 0x85, 0xb2, 0x14, 0x7f, 0x82,
-	0x6a, 0xf1, 0x4c, 0xbd, 0x96, 0x7a, 0xca, 0xcb, 0x68, 0x66, 0x1b, 0xc2, 0xf7, 0x3b, 0xcd, 0xf6,
-	0x92, 0x50, 0x96, 0xf0, 0x0d, 0xd4, 0x2b, 0xab, 0x86, 0x54, 0x95, 0x74, 0xc3, 0x1c, 0x57, 0x8a,
-	0x6f, 0xa1, 0x72, 0xce, 0x45, 0x46, 0x33, 0xc8, 0x46, 0x75, 0x09, 0x23, 0xfc, 0x56, 0xeb, 0x3b,
-	0x2f, 0x18, 0x12, 0x1e, 0x47, 0x7d, 0x82, 0xae, 0x4b, 0x46, 0x51, 0x56, 0x8b, 0xa2, 0xa4, 0x6a,
-	0xe5, 0x78, 0x37, 0x1d, 0xed, 0xa9, 0x7a, 0x2d, 0xf5, 0x24, 0xeb, 0xef, 0x7e, 0x4f, 0xf8, 0xad,
-	0xf4, 0x41, 0x41, 0x9d, 0x34, 0xbf, 0xe2, 0x3c, 0xda, 0xc6, 0x1a, 0x68, 0x8b, 0x06, 0x48, 0x40,
-	0x54, 0x42, 0xa2, 0x5e, 0x4b, 0x0d, 0x39, 0x25, 0x34, 0x1a, 0x10, 0xbe, 0x97, 0x3e, 0x99, 0x5e,
-	0x34, 0x98, 0x8c, 0x59, 0xb4, 0xb9, 0x2c, 0xab, 0xc5, 0x52, 0x35, 0xde, 0x43, 0xbb, 0x8e, 0x47,
-	0x86, 0xda, 0xcb, 0x06, 0x62, 0x52, 0x08, 0xdf, 0x55, 0x96, 0xd5, 0x89, 0x2a, 0x19, 0x43, 0x03,
-	0x97, 0xcc, 0x15, 0x4f, 0x57, 0x1f, 0x2f, 0xbd, 0xb6, 0x28, 0xe9, 0x06, 0x7e, 0xc6, 0x5e, 0x80,
-	0xf9, 0xde, 0x7a, 0x2d, 0xd5, 0x0d, 0x2c, 0x89, 0xc4, 0x5c, 0x8f, 0xe4, 0x3a, 0xc2, 0xce, 0x3e,
-	0x7a, 0x45, 0x53, 0x75, 0x09, 0x9f, 0x47, 0x5d, 0x74, 0x49, 0xd3, 0x7e, 0x3d, 0x63, 0x4f, 0x67,
-	0xbc, 0x7c, 0x4d, 0x86, 0xf6, 0xc9, 0x0f, 0x9a, 0xda, 0xdb, 0xab, 0x8d, 0x36, 0x21, 0x3c, 0xeb,
-	0x4f, 0xc6, 0x51, 0xd2, 0x16, 0x6f, 0x3a, 0x84, 0x3c, 0x7c, 0x9c, 0x0c, 0xa9, 0xdf, 0xd7, 0x38,
-	0x94, 0x6a, 0x29, 0x01, 0xb4, 0x7d, 0x15, 0x21, 0x3a, 0x9a, 0x6e, 0xbe, 0x06, 0x95, 0x53, 0x3e,
-	0x2a, 0x53, 0x29, 0x7b, 0x4c, 0xb5, 0x57, 0x6a, 0x29, 0x34, 0xdb, 0xe8, 0x6a, 0xaf, 0x6b, 0x5b,
-	0x1c, 0xe1, 0x1d, 0xb2, 0xc9, 0x03, 0x0e, 0x3d, 0xeb, 0xd6, 0x66, 0x7a, 0x7e, 0xda, 0x74, 0x2b,
-	0xf9, 0xe5, 0x5c, 0xa5, 0x62, 0x41, 0xda, 0x8b, 0x36, 0x0b, 0x95, 0x4a, 0xb1, 0x01, 0x6b, 0xc0,
-	0xb6, 0x18, 0x7b, 0x4e, 0xf8, 0x2e, 0xa1, 0x52, 0x71, 0x3a, 0xad, 0x98, 0xbf, 0xd3, 0xba, 0x8e,
-	0x90, 0xed, 0xbd, 0xa9, 0x87, 0xeb, 0x19, 0x1b, 0xc9, 0xb0, 0xc9, 0x91, 0x31, 0x5d, 0x7d, 0x86,
-	0xed, 0x0c, 0x16, 0xcc, 0x19, 0x61, 0x41, 0x02, 0x6d, 0xf2, 0x4f, 0xda, 0xb0, 0x6c, 0x19, 0x84,
-	0x77, 0x08, 0x24, 0x7f, 0xe1, 0x10, 0xf1, 0x83, 0xd5, 0x82, 0xe7, 0xce, 0x76, 0xf1, 0x8c, 0x5f,
-	0x71, 0xe1, 0x8d, 0x51, 0xbc, 0x7b, 0x02, 0xf1, 0x32, 0x35, 0xc3, 0x00, 0x5e, 0x42, 0x4f, 0x52,
-	0xbc, 0x39, 0x45, 0x61, 0x6a, 0x59, 0xa6, 0x73, 0x13, 0xcd, 0x3d, 0x6a, 0xa2, 0xef, 0x72, 0x68,
-	0xa8, 0x79, 0xe0, 0xd5, 0x4b, 0xae, 0x73, 0x3d, 0x4b, 0xae, 0xed, 0xdc, 0xfd, 0x98, 0x43, 0x09,
-	0x37, 0x86, 0x35, 0x4e, 0xfe, 0xeb, 0x1e, 0x8a, 0x3e, 0x42, 0xae, 0x7f, 0xc5, 0xa1, 0xa7, 0x3d,
-	0xf5, 0xfc, 0xb4, 0x11, 0xfe, 0x2f, 0x0e, 0xed, 0xf3, 0x00, 0x92, 0x53, 0x45, 0x67, 0xb4, 0x14,
-	0x9d, 0xff, 0xa9, 0x96, 0xa1, 0xd8, 0xd3, 0x51, 0x02, 0xaf, 0x36, 0xfb, 0xa6, 0x3f, 0x70, 0x68,
-	0x7f, 0x18, 0xf4, 0x9f, 0x36, 0xab, 0xbe, 0x13, 0x43, 0x19, 0x87, 0xcf, 0x9d, 0x6c, 0x78, 0x5c,
-	0x27, 0xac, 0x9c, 0x2a, 0xb6, 0x75, 0x5f, 0xf1, 0x9a, 0x02, 0x9d, 0xeb, 0x9d, 0x02, 0x9b, 0x1e,
-	0xf5, 0x14, 0x10, 0x50, 0x36, 0x34, 0x53, 0x30, 0x0d, 0x32, 0x68, 0x0b, 0x35, 0x63, 0xb1, 0x60,
-	0x91, 0xb5, 0xbd, 0x5e, 0x4b, 0x6d, 0x73, 0x18, 0xba, 0x58, 0x10, 0x09, 0xff, 0x04, 0xfd, 0x58,
-	0x10, 0xc9, 0x07, 0x1c, 0x3a, 0xe0, 0x18, 0x43, 0x64, 0x93, 0xac, 0xa0, 0xe6, 0x14, 0xc5, 0x39,
-	0x8a, 0xbe, 0xe1, 0xbc, 0xdc, 0x5d, 0x0e, 0x1d, 0x0c, 0xa7, 0x38, 0x30, 0x33, 0x8a, 0xba, 0x19,
-	0x7e, 0x59, 0xd4, 0xe9, 0x22, 0xd9, 0x94, 0x1f, 0xac, 0xd7, 0x52, 0xfd, 0x4e, 0x6a, 0x64, 0x51,
-	0x27, 0xfc, 0x16, 0xe0, 0x46, 0x6f, 0xfb, 0x52, 0x78, 0x97, 0x43, 0xc3, 0xae, 0x25, 0xce, 0x60,
-	0xa8, 0xd4, 0xcc, 0x16, 0xe3, 0x8d, 0x29, 0xcd, 0x45, 0x09, 0x95, 0x1e, 0x39, 0xdf, 0x1f, 0x58,
-	0x11, 0xa0, 0xb7, 0xae, 0x1b, 0x97, 0xe4, 0xff, 0x58, 0x33, 0xfc, 0x8a, 0x76, 0x43, 0x52, 0x2f,
-	0x9a, 0xa7, 0x22, 0xf1, 0x71, 0xda, 0x47, 0xbe, 0x63, 0x2d, 0x94, 0x40, 0xfc, 0x8d, 0x68, 0x77,
-	0xab, 0x61, 0x36, 0x2d, 0x96, 0x69, 0x5b, 0x98, 0x77, 0x53, 0x91, 0xcf, 0xf2, 0xdb, 0x99, 0x5e,
-	0x4e, 0x59, 0x84, 0xef, 0x31, 0x6c, 0x2d, 0xc8, 0xfb, 0x1c, 0x7a, 0xae, 0x59, 0xb5, 0x9c, 0x79,
-	0x62, 0xbc, 0x2a, 0xeb, 0xd2, 0xc6, 0x0c, 0xae, 0xfe, 0xcc, 0xa1, 0x91, 0x20, 0x95, 0x81, 0xc7,
-	0xcf, 0xa3, 0x1e, 0x86, 0xba, 0x28, 0x0a, 0x86, 0x00, 0xfb, 0xf2, 0x2e, 0xef, 0x7d, 0x99, 0x09,
-	0x9a, 0x14, 0x0c, 0xe1, 0xa2, 0x50, 0xc9, 0x27, 0x60, 0x7f, 0xc6, 0x8d, 0xd3, 0xae, 0x25, 0x85,
-	0xf0, 0xa8, 0xdc, 0x68, 0xda, 0xf6, 0xa5, 0x73, 0x1e, 0x02, 0x49, 0xba, 0xde, 0x27, 0xb4, 0x45,
-	0xd5, 0x58, 0x9b, 0x51, 0xc8, 0x55, 0xb4, 0xd3, 0x5b, 0x10, 0x50, 0x75, 0x0c, 0xf5, 0x30, 0xdf,
-	0x50, 0x32, 0xdf, 0x81, 0xb8, 0x21, 0x9b, 0x01, 0xc7, 0x4b, 0xeb, 0xbc, 0x44, 0xa5, 0x90, 0x7f,
-	0x5b, 0x41, 0x52, 0x93, 0xe4, 0xc7, 0x64, 0x6d, 0xcf, 0x3b, 0x37, 0x6f, 0x1f, 0xf4, 0xeb, 0xa5,
-	0xf9, 0x4e, 0x0c, 0x3d, 0x0f, 0xb3, 0xde, 0x10, 0x94, 0x59, 0x41, 0x59, 0x94, 0x2e, 0x68, 0xa5,
-	0x1b, 0x8f, 0x97, 0x23, 0xbd, 0xc3, 0xa1, 0xd1, 0x08, 0x24, 0x00, 0xe7, 0x17, 0xd1, 0xd6, 0x25,
-	0xb3, 0x69, 0x51, 0xa1, 0x6d, 0xc1, 0x9b, 0xee, 0x0f, 0xef, 0x49, 0xf9, 0x9e, 0x25, 0x7b, 0x28,
-	0xf2, 0x9e, 0x15, 0x32, 0xb8, 0x2f, 0x8c, 0x37, 0xe8, 0x51, 0xf4, 0xb7, 0x56, 0xd0, 0xe0, 0xad,
-	0x2d, 0x50, 0xf4, 0x7f, 0x68, 0xa0, 0xd9, 0xb8, 0x56, 0xf0, 0xb0, 0xb3, 0x5e, 0x4b, 0xc5, 0xbd,
-	0xed, 0xaf, 0x13, 0x7e, 0x9b, 0x7b, 0x02, 0xb4, 0x3f, 0x96, 0x98, 0x07, 0xf2, 0x2f, 0x1b, 0xc2,
-	0x9c, 0x22, 0xb1, 0xc3, 0x54, 0xf3, 0xbd, 0x5e, 0x1e, 0x6d, 0xd3, 0xe9, 0xeb, 0xa2, 0x15, 0xee,
-	0x80, 0x15, 0x1c, 0x17, 0xa5, 0x4d, 0x0d, 0x08, 0xdf, 0xab, 0xdb, 0x12, 0x0b, 0x22, 0xf9, 0x96,
-	0xc5, 0x9b, 0xf7, 0x40, 0xc0, 0x9b, 0x86, 0x06, 0x40, 0x90, 0xb9, 0x27, 0x14, 0x9d, 0x17, 0x97,
-	0xcf, 0x79, 0x6f, 0x33, 0x4c, 0x9c, 0xb9, 0xd9, 0x30, 0x91, 0x0e, 0x7a, 0x57, 0x49, 0x22, 0x3c,
-	0xe0, 0x68, 0x34, 0x27, 0x3f, 0xe1, 0xc0, 0x8f, 0xbb, 0xd4, 0xda, 0x88, 0x13, 0xef, 0xef, 0x1c,
-	0x7a, 0xa6, 0x85, 0xa6, 0x40, 0x9e, 0xee, 0x4d, 0x5e, 0x67, 0x78, 0xf2, 0x86, 0x61, 0x97, 0x0e,
-	0x4f, 0x60, 0xdb, 0xe7, 0xe7, 0x0f, 0xac, 0x58, 0xb7, 0x19, 0xf6, 0x06, 0x71, 0xd1, 0xe4, 0x87,
-	0x56, 0x30, 0x1a, 0xa8, 0xe0, 0x27, 0x35, 0xc7, 0xdf, 0xe3, 0xd0, 0xf1, 0x55, 0x2e, 0x8b, 0xbe,
-	0xba, 0x28, 0x54, 0x2a, 0xb2, 0xba, 0xb0, 0xa1, 0xf8, 0x7c, 0xc0, 0xa1, 0x17, 0xd6, 0xa0, 0x2d,
-	0x90, 0xfb, 0x6d, 0x0e, 0xc5, 0xa5, 0x16, 0x1d, 0x81, 0xe4, 0x23, 0xde, 0x24, 0xe7, 0x2a, 0x95,
-	0x56, 0x23, 0x9a, 0x91, 0x69, 0x7e, 0x57, 0xbd, 0x96, 0x4a, 0xb9, 0x41, 0x34, 0xb7, 0x23, 0x7c,
-	0xcb, 0xb1, 0xc9, 0xcf, 0xac, 0x9b, 0x3f, 0x5f, 0x58, 0x1b, 0xce, 0xeb, 0xbc, 0x1b, 0x83, 0x68,
-	0x34, 0x40, 0x6d, 0xa0, 0xff, 0x6d, 0x7f, 0xfa, 0x3b, 0xd7, 0x4e, 0xff, 0x1e, 0x70, 0x4d, 0x6b,
-	0x37, 0x41, 0xdb, 0x5d, 0xd5, 0x4f, 0x1b, 0x07, 0xa9, 0xd9, 0x0b, 0x94, 0x96, 0xe9, 0xf9, 0x0d,
-	0x7f, 0xe7, 0xf4, 0x90, 0x43, 0x7b, 0x02, 0x75, 0x06, 0xe3, 0xbe, 0x8c, 0x9e, 0x30, 0x96, 0x14,
-	0xc7, 0xc9, 0x6f, 0xc4, 0xdb, 0x94, 0x57, 0x96, 0x14, 0x16, 0xda, 0x59, 0x87, 0xbf, 0x21, 0xb0,
-	0x5d, 0x1f, 0x1c, 0x9f, 0x99, 0x10, 0xc2, 0x5b, 0xe2, 0xda, 0x6e, 0x99, 0xb3, 0x68, 0xd0, 0x05,
-	0x32, 0xfa, 0x71, 0xef, 0x2d, 0x0e, 0xd2, 0x4c, 0xb6, 0x08, 0x60, 0xe5, 0x26, 0x1a, 0xb0, 0x53,
-	0xeb, 0xee, 0x90, 0xf8, 0xff, 0x23, 0x5f, 0x30, 0xc4, 0x9b, 0xb3, 0xf8, 0x20, 0x90, 0xf0, 0xfd,
-	0xf6, 0x33, 0x88, 0x9b, 0x75, 0xc8, 0xa6, 0x7e, 0x46, 0x97, 0xaa, 0x17, 0x97, 0x67, 0x34, 0x5d,
-	0x36, 0xb1, 0xb6, 0x37, 0x7b, 0x49, 0xfe, 0xda, 0x09, 0xf1, 0xa2, 0xe7, 0xa8, 0x9f, 0x30, 0x25,
-	0xb8, 0x88, 0xba, 0x0d, 0xf3, 0x24, 0x53, 0x14, 0x17, 0x25, 0x40, 0x92, 0x8f, 0x3c, 0x60, 0xbf,
-	0x75, 0xc9, 0x03, 0x82, 0x08, 0xbf, 0x85, 0x7e, 0x9e, 0x5c, 0x94, 0xf0, 0x17, 0xd1, 0x76, 0x61,
-	0x49, 0x90, 0x15, 0xba, 0xe9, 0x1a, 0x5a, 0x71, 0x4e, 0xab, 0x56, 0xb5, 0x9b, 0x50, 0xa7, 0x72,
-	0x21, 0xf2, 0x50, 0x09, 0xb0, 0xc5, 0x6a, 0x91, 0x84, 0x1f, 0x68, 0x3c, 0xbd, 0xa2, 0xe5, 0xe9,
-	0x33, 0xac, 0xa3, 0x7e, 0x61, 0x49, 0xaa, 0x0a, 0x0b, 0x52, 0xb1, 0x54, 0x85, 0x7a, 0x11, 0x56,
-	0xf5, 0x52, 0x88, 0x5c, 0x76, 0xb0, 0xc3, 0x1a, 0xda, 0x2d, 0x8f, 0xf0, 0x7d, 0xf0, 0x68, 0xa2,
-	0x4a, 0x0b, 0x44, 0xc8, 0x34, 0x5c, 0x68, 0x99, 0x06, 0x77, 0xfa, 0x05, 0x7a, 0x64, 0x34, 0x57,
-	0x78, 0xc4, 0x5b, 0x5d, 0x33, 0x1c, 0x19, 0x09, 0x92, 0x08, 0x13, 0xe9, 0x4b, 0x1c, 0xea, 0x5d,
-	0xd4, 0x25, 0xfb, 0x0d, 0x38, 0x9e, 0x13, 0xde, 0x8e, 0x87, 0x5e, 0xdc, 0x06, 0x6d, 0x24, 0x49,
-	0x70, 0x46, 0x70, 0x6e, 0x31, 0xc5, 0x17, 0xc1, 0xd6, 0xd4, 0x29, 0xb9, 0x07, 0x24, 0x3f, 0xe7,
-	0x20, 0xb7, 0x43, 0x7d, 0x21, 0x9b, 0x67, 0x39, 0x55, 0x64, 0xb7, 0x5b, 0x97, 0x0d, 0xc1, 0x90,
-	0x75, 0x43, 0x2e, 0x6d, 0xcc, 0xcd, 0xfb, 0x8d, 0x18, 0x64, 0x5b, 0xc2, 0xe8, 0x6e, 0xaf, 0x5d,
-	0x33, 0x34, 0x6b, 0xbc, 0x75, 0xb0, 0xbe, 0xc7, 0x9b, 0xf5, 0x99, 0xe6, 0xe6, 0xcd, 0xc7, 0x88,
-	0x55, 0xf2, 0x08, 0xbf, 0x7a, 0x8c, 0xb6, 0xef, 0x01, 0x5f, 0xb6, 0x6e, 0x66, 0x73, 0x8a, 0xd2,
-	0x14, 0x50, 0xf3, 0xd2, 0x4d, 0xa1, 0x2a, 0xfe, 0xaf, 0x0a, 0x07, 0xbe, 0x1b, 0x83, 0xf9, 0xef,
-	0xa3, 0x08, 0x18, 0xe3, 0x4d, 0x0e, 0x0d, 0xe9, 0x9e, 0x4d, 0xc0, 0x24, 0x07, 0x43, 0x1d, 0x18,
-	0xa0, 0x4f, 0x7e, 0x2f, 0xd8, 0x65, 0xb8, 0xc5, 0xd9, 0xa1, 0x58, 0x65, 0x0d, 0x09, 0xdf, 0x62,
-	0xd4, 0x76, 0x1b, 0x69, 0xec, 0xed, 0x0c, 0xea, 0xa2, 0xdc, 0xe0, 0x37, 0x38, 0x84, 0xec, 0x8b,
-	0x40, 0xdc, 0x62, 0xee, 0xad, 0xaa, 0x91, 0x4a, 0xec, 0x0d, 0x6e, 0xc8, 0xd4, 0x21, 0xfb, 0xee,
-	0xfc, 0xfe, 0xe3, 0x6f, 0xc6, 0x76, 0xe1, 0x67, 0xb3, 0xad, 0xeb, 0x20, 0xf5, 0xec, 0x2d, 0x59,
-	0xbc, 0x8d, 0x3f, 0xe2, 0xa0, 0xf6, 0xa9, 0xa5, 0xcb, 0xc2, 0x27, 0x7d, 0xc6, 0x0d, 0x72, 0x9d,
-	0x89, 0x53, 0x6b, 0xeb, 0x0c, 0x40, 0x26, 0x28, 0x90, 0xd3, 0xf8, 0xa4, 0x37, 0x10, 0xd3, 0x9f,
-	0xa5, 0xad, 0xa0, 0x38, 0x6d, 0x2e, 0xbc, 0x34, 0xf5, 0x77, 0x69, 0xd3, 0xdf, 0x65, 0x6f, 0x51,
-	0xa7, 0x7c, 0x1b, 0xdf, 0xe5, 0xd0, 0x8e, 0x16, 0xc5, 0x59, 0xf8, 0x70, 0x10, 0xa7, 0x5e, 0xd5,
-	0x60, 0x89, 0x23, 0x11, 0x7b, 0x01, 0x9a, 0x17, 0x28, 0x9a, 0x43, 0x78, 0xd4, 0xcf, 0x2c, 0x66,
-	0xd7, 0xf4, 0xdc, 0x72, 0x9a, 0x7e, 0x4b, 0xcb, 0x22, 0x33, 0xd3, 0x87, 0x56, 0x39, 0x8b, 0x67,
-	0xed, 0x13, 0x3e, 0x16, 0x46, 0x21, 0x8f, 0x22, 0xb0, 0xc4, 0xf1, 0xe8, 0x1d, 0x01, 0x4c, 0x81,
-	0x82, 0x99, 0xc0, 0xb9, 0x40, 0x30, 0xda, 0x7c, 0x9a, 0x5a, 0xc2, 0x44, 0x25, 0x54, 0x2a, 0xd9,
-	0x5b, 0x6c, 0xab, 0xb8, 0xdd, 0x30, 0xd0, 0x37, 0x38, 0xd4, 0xe7, 0x2e, 0x9e, 0xc0, 0x07, 0x7c,
-	0xf4, 0x6a, 0x2e, 0x87, 0x4a, 0x1c, 0x0c, 0xd7, 0x18, 0x14, 0xdf, 0x4d, 0x15, 0x4f, 0xe2, 0x9d,
-	0x7e, 0x8a, 0xe3, 0xf7, 0x39, 0xb4, 0xdd, 0xa3, 0xa0, 0x03, 0x3f, 0x1f, 0x66, 0x2c, 0x17, 0xc5,
-	0xa3, 0x11, 0x7a, 0x80, 0x8a, 0x87, 0xa9, 0x8a, 0x19, 0x7c, 0xd0, 0x4f, 0x45, 0x93, 0xce, 0x9c,
-	0x83, 0x4e, 0x7c, 0x27, 0x06, 0xf5, 0x71, 0xbe, 0x35, 0x28, 0x78, 0x3c, 0xb4, 0x3e, 0xde, 0xf7,
-	0x26, 0x89, 0xb3, 0x6b, 0x17, 0x00, 0xf8, 0xae, 0x51, 0x7c, 0x97, 0xf1, 0xa5, 0x20, 0x7c, 0x42,
-	0xa5, 0x92, 0x16, 0x54, 0xd1, 0xbd, 0xc6, 0x1d, 0x13, 0xa8, 0xf9, 0xce, 0xe5, 0x36, 0xfe, 0x51,
-	0x0c, 0x4e, 0x7d, 0xc1, 0x65, 0x18, 0x78, 0x32, 0x70, 0xf2, 0x87, 0xa8, 0x77, 0x49, 0x4c, 0xad,
-	0x53, 0x0a, 0x70, 0xa2, 0x50, 0x4e, 0xe6, 0xb1, 0xe8, 0xc3, 0x49, 0x5a, 0x16, 0x5d, 0xab, 0xc9,
-	0xed, 0xfa, 0x4c, 0xa2, 0xbc, 0xd6, 0x97, 0x17, 0x4d, 0xff, 0xe4, 0xd0, 0xee, 0x30, 0x05, 0x19,
-	0x38, 0x17, 0x88, 0x2e, 0xa8, 0x0a, 0x25, 0x91, 0x5f, 0x8f, 0x08, 0x60, 0xe7, 0x45, 0xca, 0xce,
-	0x14, 0x9e, 0xf0, 0x61, 0xa7, 0x20, 0x36, 0xe6, 0x8c, 0xac, 0xa6, 0x05, 0x45, 0x49, 0x3b, 0xef,
-	0x4b, 0x74, 0x7b, 0xa1, 0xfc, 0x9a, 0x43, 0x4f, 0xb5, 0xac, 0x8e, 0xc0, 0x47, 0x43, 0x4c, 0x6f,
-	0x8f, 0xd2, 0x8f, 0xc4, 0xb1, 0xc8, 0xfd, 0x00, 0xdb, 0x49, 0x8a, 0xed, 0x08, 0x3e, 0xe4, 0x6f,
-	0x79, 0x06, 0x4e, 0x65, 0xd6, 0x6f, 0xf8, 0xce, 0x37, 0x63, 0x60, 0xc8, 0x80, 0x82, 0x01, 0x5f,
-	0x43, 0x86, 0x2b, 0xb6, 0xf0, 0x35, 0x64, 0xc8, 0x7a, 0x05, 0x52, 0xa4, 0x60, 0xaf, 0xe1, 0xab,
-	0xde, 0x60, 0x69, 0xc1, 0x41, 0x9a, 0x65, 0xcd, 0xc1, 0xc1, 0x45, 0x74, 0x00, 0x2b, 0x56, 0x40,
-	0xd3, 0x32, 0xe7, 0xef, 0x1b, 0xd0, 0x04, 0x15, 0x37, 0xf8, 0x06, 0x34, 0x81, 0x65, 0x06, 0x64,
-	0x8a, 0xc2, 0x1f, 0xc7, 0xa7, 0x43, 0xc0, 0xa7, 0xe5, 0xf8, 0xe9, 0x9b, 0xb2, 0x2e, 0x35, 0xef,
-	0x9c, 0xf8, 0x17, 0x1c, 0x5c, 0xfb, 0x34, 0xe5, 0x92, 0xf1, 0x68, 0xd0, 0x5a, 0x5b, 0x55, 0x18,
-	0x90, 0x18, 0x8b, 0xd2, 0x05, 0x60, 0x9c, 0xa2, 0x30, 0x8e, 0xe2, 0xc3, 0x7e, 0x53, 0x96, 0xa6,
-	0xa6, 0x61, 0xd2, 0xba, 0x36, 0xaa, 0xaf, 0xc7, 0xd0, 0xae, 0x10, 0x99, 0x70, 0x7c, 0x36, 0xbc,
-	0x66, 0x2d, 0x66, 0x6c, 0x6e, 0x1d, 0x12, 0x00, 0xaa, 0x40, 0xa1, 0x7e, 0x0e, 0x5f, 0x8b, 0x00,
-	0x35, 0xe2, 0x94, 0xfd, 0x7e, 0x0c, 0x2e, 0xd0, 0xc3, 0xe4, 0xaa, 0xf1, 0x39, 0xdf, 0x09, 0x18,
-	0x3a, 0xe3, 0x9f, 0x38, 0xbf, 0x6e, 0x39, 0xe1, 0x96, 0x34, 0x4d, 0x88, 0xa7, 0xd9, 0xcd, 0xd6,
-	0xda, 0x96, 0xf4, 0x6f, 0x2c, 0x7f, 0xed, 0x95, 0x98, 0xf6, 0xf5, 0xd7, 0x3e, 0x79, 0x77, 0x5f,
-	0x7f, 0xed, 0x97, 0x01, 0x27, 0x67, 0x28, 0xde, 0xe3, 0xf8, 0xa8, 0x37, 0x5e, 0x53, 0xff, 0xaa,
-	0x2a, 0x28, 0x6c, 0x53, 0x96, 0xc5, 0x55, 0x71, 0xda, 0x7d, 0x0b, 0x8e, 0x57, 0xbe, 0xd8, 0x17,
-	0x8e, 0x4f, 0x26, 0xdb, 0x17, 0x8e, 0x5f, 0x62, 0x9a, 0xe4, 0x29, 0x9c, 0x53, 0xf8, 0x84, 0x37,
-	0x1c, 0x76, 0x5c, 0x86, 0xe3, 0xc8, 0xdc, 0x32, 0x3d, 0x91, 0x34, 0x65, 0xc4, 0x6f, 0xe3, 0x5f,
-	0x5a, 0x77, 0xc8, 0xcd, 0x99, 0x42, 0x3c, 0x16, 0x56, 0x2d, 0x87, 0x65, 0x0e, 0x45, 0xea, 0x03,
-	0x30, 0x4e, 0x50, 0x18, 0x87, 0xf1, 0x58, 0x38, 0x18, 0xcd, 0x0e, 0x69, 0x77, 0x98, 0x44, 0xa7,
-	0xef, 0x26, 0x1a, 0x2e, 0x8b, 0xeb, 0xbb, 0x89, 0x86, 0xcc, 0xb3, 0x92, 0x57, 0x28, 0xd6, 0x97,
-	0xf1, 0x6c, 0x68, 0xac, 0xae, 0x15, 0x37, 0x13, 0xb4, 0xe0, 0xee, 0xc6, 0xa0, 0x78, 0x26, 0x4a,
-	0xa2, 0x12, 0xbf, 0x14, 0x72, 0x41, 0x85, 0xcc, 0xcf, 0x26, 0xa6, 0x1f, 0x99, 0x3c, 0xa0, 0xed,
-	0x06, 0xa5, 0x4d, 0xc2, 0xa5, 0xd6, 0x0b, 0xd7, 0x25, 0x33, 0x5d, 0x66, 0x42, 0x9d, 0x1c, 0x4e,
-	0x85, 0xe5, 0xf0, 0x6f, 0xd6, 0xaf, 0x95, 0x7c, 0x55, 0xf5, 0x3d, 0x8d, 0x85, 0xc9, 0xa7, 0xfa,
-	0x9e, 0xc6, 0x42, 0x65, 0x36, 0xc9, 0x79, 0x4a, 0x4b, 0x0e, 0x8f, 0xaf, 0x8d, 0x16, 0x7b, 0x19,
-	0x7d, 0x6c, 0xfd, 0x0a, 0xae, 0x75, 0xc6, 0x0d, 0xfb, 0x86, 0x4f, 0x41, 0xc9, 0xc5, 0xc4, 0xe9,
-	0x35, 0xf6, 0x06, 0xa4, 0x93, 0x14, 0xe9, 0x19, 0x7c, 0xaa, 0x45, 0xf4, 0xb5, 0xa4, 0x34, 0x0e,
-	0x9d, 0x8e, 0xd3, 0x03, 0x75, 0xe4, 0x8e, 0xe3, 0xc3, 0xf7, 0x38, 0xd4, 0xeb, 0x1a, 0x11, 0xef,
-	0x0f, 0xa1, 0x96, 0x05, 0xe1, 0x40, 0xa8, 0xb6, 0xa0, 0xf0, 0x28, 0x55, 0xf8, 0x00, 0xde, 0x17,
-	0xa4, 0xb0, 0xad, 0xdd, 0xef, 0x38, 0x14, 0x6f, 0x95, 0xc6, 0xc2, 0x47, 0x02, 0x6e, 0xe3, 0xbc,
-	0x93, 0x6d, 0x89, 0xa3, 0x51, 0xbb, 0x81, 0xfa, 0xe7, 0xa8, 0xfa, 0x67, 0xf1, 0x19, 0x9f, 0xeb,
-	0xbb, 0xf2, 0x72, 0xba, 0x02, 0x9d, 0x5b, 0x5e, 0x10, 0x7d, 0xc5, 0x3a, 0xd4, 0x07, 0xdf, 0xf6,
-	0xfb, 0x1e, 0xea, 0x43, 0x27, 0x3a, 0x7c, 0x0f, 0xf5, 0xe1, 0x53, 0x0e, 0x64, 0x9a, 0x12, 0x50,
-	0xc0, 0xe7, 0xbd, 0x09, 0xa0, 0x13, 0xcb, 0x0a, 0x8d, 0x4c, 0xef, 0x02, 0x91, 0xbf, 0x6e, 0x49,
-	0x5b, 0x65, 0xdd, 0x07, 0xd6, 0xe9, 0xa6, 0xe5, 0x0d, 0xbb, 0xef, 0xe9, 0x26, 0x28, 0x41, 0xe0,
-	0x7b, 0xba, 0x09, 0xbc, 0xd4, 0x27, 0xc7, 0x28, 0xdc, 0x51, 0x9c, 0xf5, 0xdb, 0x97, 0x3c, 0x6e,
-	0xe5, 0xf3, 0x97, 0xee, 0xfd, 0x29, 0xd9, 0xf1, 0xce, 0x4a, 0xb2, 0xe3, 0xde, 0x4a, 0x92, 0xbb,
-	0xbf, 0x92, 0xe4, 0x3e, 0x5a, 0x49, 0x72, 0x6f, 0x3d, 0x4c, 0x76, 0xdc, 0x7f, 0x98, 0xec, 0xf8,
-	0xe3, 0xc3, 0x64, 0xc7, 0x67, 0xb3, 0xae, 0xf4, 0x9f, 0x29, 0x3c, 0xad, 0xcd, 0xcf, 0xcb, 0x25,
-	0x59, 0x50, 0xac, 0xc1, 0xac, 0xe1, 0x68, 0x2e, 0x70, 0x6e, 0x33, 0xfd, 0x9d, 0xff, 0xa1, 0xff,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x93, 0x7f, 0xea, 0xf2, 0xa3, 0x40, 0x00, 0x00,
+	0x15, 0xce, 0xac, 0x93, 0x34, 0xb9, 0x8e, 0x1d, 0xfb, 0xe6, 0x6f, 0x33, 0x4d, 0x77, 0xdd, 0x9b,
+	0x34, 0x49, 0x93, 0xec, 0x6e, 0xed, 0xfc, 0x38, 0x4d, 0x9d, 0x26, 0xbb, 0xb6, 0x1b, 0x96, 0x36,
+	0x75, 0x32, 0x2d, 0x49, 0x43, 0xd5, 0x2e, 0xe3, 0x9d, 0xb1, 0x3b, 0xca, 0xec, 0xcc, 0x76, 0x67,
+	0xec, 0xd4, 0x84, 0x88, 0x2a, 0x0f, 0x20, 0x8a, 0xa8, 0x8a, 0xa8, 0x10, 0x3f, 0x02, 0x5e, 0x40,
+	0xb4, 0xca, 0x4b, 0x91, 0x50, 0x25, 0x04, 0x3c, 0xe4, 0x05, 0x15, 0x09, 0x50, 0x11, 0x45, 0x42,
+	0x7d, 0x58, 0x15, 0xa7, 0x4f, 0x3c, 0x00, 0xb2, 0x90, 0x90, 0xf8, 0x91, 0xd0, 0xdc, 0x7b, 0x66,
+	0xe7, 0x67, 0x67, 0xe7, 0x67, 0x9d, 0xa5, 0xae, 0xfa, 0x92, 0xec, 0xcc, 0xdc, 0x73, 0xee, 0xf9,
+	0xbe, 0x73, 0xef, 0xb9, 0xf7, 0xdc, 0x7b, 0x8c, 0x46, 0xaa, 0x7a, 0x4d, 0x92, 0x5f, 0x2a, 0x2c,
+	0x8a, 0x0b, 0xaa, 0x59, 0x58, 0x1c, 0x9d, 0x95, 0x4d, 0x71, 0xb4, 0xf0, 0xe2, 0x82, 0xdc, 0x58,
+	0xca, 0xd7, 0x1b, 0xba, 0xa9, 0xe3, 0xed, 0xac, 0x45, 0x9e, 0xb6, 0xc8, 0x43, 0x0b, 0xfe, 0x50,
+	0x55, 0x37, 0x6a, 0xba, 0x51, 0x98, 0x15, 0x0d, 0x99, 0x35, 0x6f, 0x09, 0xd7, 0xc5, 0x79, 0x45,
+	0x13, 0x4d, 0x45, 0xd7, 0x98, 0x06, 0x7e, 0xfb, 0xbc, 0x3e, 0xaf, 0xd3, 0x9f, 0x05, 0xeb, 0x17,
+	0xbc, 0xdd, 0x33, 0xaf, 0xeb, 0xf3, 0xaa, 0x5c, 0x10, 0xeb, 0x4a, 0x41, 0xd4, 0x34, 0xdd, 0xa4,
+	0x22, 0x06, 0x7c, 0x0d, 0xb6, 0x8b, 0xd9, 0x40, 0x5b, 0x90, 0x7f, 0x6e, 0x44, 0x9b, 0x2f, 0x59,
+	0xcf, 0x65, 0x6d, 0x4e, 0xc7, 0x83, 0x28, 0xa5, 0x48, 0x69, 0x6e, 0x84, 0x3b, 0xb8, 0x5e, 0x48,
+	0x29, 0x12, 0xbe, 0x8c, 0x86, 0xe4, 0x97, 0x4c, 0x59, 0x93, 0x64, 0xa9, 0x52, 0x17, 0x95, 0x46,
+	0x45, 0x91, 0xd2, 0x29, 0xeb, 0x6b, 0x29, 0xb7, 0xdc, 0xcc, 0x0e, 0x4e, 0xc3, 0xb7, 0x0b, 0xa2,
+	0xd2, 0x28, 0x4f, 0xad, 0x34, 0xb3, 0xbb, 0x96, 0xc4, 0x9a, 0x7a, 0x8a, 0xf8, 0x65, 0x88, 0x30,
+	0x28, 0xbb, 0x9b, 0x4a, 0x78, 0x3f, 0xda, 0xa0, 0x5f, 0xd3, 0xe4, 0x46, 0xba, 0x6f, 0x84, 0x3b,
+	0xb8, 0xb9, 0x34, 0xb4, 0xd2, 0xcc, 0x6e, 0x61, 0xb2, 0xf4, 0x35, 0x11, 0xd8, 0x67, 0x5c, 0x45,
+	0xa8, 0xaa, 0xab, 0xaa, 0x68, 0xca, 0x0d, 0x51, 0x4d, 0xaf, 0xa7, 0x8d, 0x27, 0xdf, 0x69, 0x66,
+	0xd7, 0xbd, 0xdf, 0xcc, 0xee, 0x9f, 0x57, 0xcc, 0x17, 0x16, 0x66, 0xf3, 0x55, 0xbd, 0x56, 0x00,
+	0x1e, 0xd9, 0x7f, 0x39, 0x43, 0xba, 0x5a, 0x30, 0x97, 0xea, 0xb2, 0x91, 0x2f, 0x6b, 0xe6, 0x4a,
+	0x33, 0x3b, 0xcc, 0x54, 0x3b, 0x9a, 0x88, 0xe0, 0x52, 0x8b, 0x2f, 0xa2, 0xf5, 0x92, 0x3c, 0x6b,
+	0xa6, 0x37, 0x50, 0xf5, 0xa7, 0x13, 0xab, 0xef, 0x67, 0xea, 0x2d, 0x1d, 0x44, 0xa0, 0xaa, 0xf0,
+	0x57, 0x38, 0xb4, 0xcb, 0xe9, 0x41, 0xf9, 0x3c, 0xf5, 0x4a, 0xa5, 0x61, 0xfd, 0x97, 0xde, 0x48,
+	0xbb, 0xb9, 0x90, 0xa0, 0x9b, 0x29, 0xb9, 0xba, 0xd2, 0xcc, 0x66, 0xfc, 0x28, 0x3c, 0x6a, 0x89,
+	0xb0, 0xb3, 0xed, 0x8b, 0x60, 0xfd, 0x8b, 0x1f, 0x47, 0xd8, 0xeb, 0x10, 0x4d, 0xac, 0xc9, 0xe9,
+	0x7b, 0xa8, 0x15, 0xf7, 0xad, 0x34, 0xb3, 0xbb, 0x83, 0x9c, 0x66, 0xb5, 0x21, 0xc2, 0x90, 0xdb,
+	0x6d, 0x4f, 0x8a, 0x35, 0x19, 0x5f, 0x45, 0x03, 0x8a, 0x66, 0xca, 0x0d, 0xd9, 0x30, 0xad, 0x7e,
+	0xe5, 0xf4, 0x26, 0xaa, 0xe7, 0xb1, 0xc4, 0x68, 0xb6, 0xb3, 0x5e, 0x3d, 0xca, 0x88, 0xb0, 0xc5,
+	0x7e, 0x16, 0x44, 0x53, 0xc6, 0x67, 0xd0, 0xa0, 0x68, 0x18, 0xb2, 0x59, 0x51, 0xb4, 0x8a, 0x24,
+	0x6b, 0x7a, 0x2d, 0xbd, 0x99, 0xf6, 0xb6, 0x7b, 0xa5, 0x99, 0xdd, 0xc1, 0xe4, 0xbd, 0xdf, 0x89,
+	0xb0, 0x85, 0xbe, 0x28, 0x6b, 0x53, 0xd6, 0x23, 0x2e, 0xa1, 0xad, 0xac, 0x81, 0xbe, 0x60, 0x82,
+	0x06, 0x44, 0x35, 0xf0, 0x2b, 0xcd, 0xec, 0x4e, 0xb7, 0x86, 0x56, 0x03, 0x22, 0x0c, 0xd0, 0x37,
+	0x33, 0x0b, 0x26, 0xd3, 0x71, 0x09, 0x6d, 0xac, 0x29, 0x5a, 0xa5, 0xda, 0x48, 0xf7, 0x53, 0xd1,
+	0x33, 0x89, 0xa1, 0x0e, 0xb0, 0x8e, 0x98, 0x16, 0x22, 0x6c, 0xa8, 0x29, 0xda, 0x64, 0x83, 0x8c,
+	0xa1, 0xe1, 0x8b, 0xd6, 0x8c, 0xa7, 0xb3, 0x4f, 0x90, 0x5f, 0x5c, 0x90, 0x0d, 0x13, 0xdf, 0xe7,
+	0x4c, 0xc0, 0xd2, 0xc0, 0x4a, 0x33, 0xbb, 0x19, 0x58, 0x92, 0x88, 0x35, 0x1f, 0xc9, 0x73, 0x08,
+	0xbb, 0x65, 0x8c, 0xba, 0xae, 0x19, 0x32, 0x3e, 0x87, 0x36, 0xd0, 0x29, 0x4d, 0xe5, 0xfa, 0xc7,
+	0xee, 0xcd, 0x07, 0xc5, 0x9a, 0x3c, 0x95, 0x29, 0x6d, 0xb7, 0xac, 0x77, 0x66, 0x1b, 0x6d, 0x42,
+	0x04, 0x26, 0x4f, 0xce, 0xa0, 0x8c, 0xa3, 0xde, 0x0a, 0x08, 0x25, 0xf8, 0x39, 0x15, 0xd3, 0xbe,
+	0xaf, 0x72, 0x28, 0xdb, 0x51, 0x03, 0x58, 0xfb, 0x02, 0x42, 0xb4, 0x37, 0xc3, 0xfa, 0x0c, 0x26,
+	0x67, 0x43, 0x4c, 0xa6, 0x5a, 0x0e, 0x58, 0x66, 0x2f, 0x37, 0xb3, 0xe8, 0x52, 0x4b, 0xd4, 0x99,
+	0xd7, 0x8e, 0x3a, 0x22, 0xb8, 0x74, 0x93, 0xf7, 0x38, 0x74, 0xbf, 0xd7, 0x9a, 0x99, 0xb9, 0x19,
+	0x2b, 0xac, 0x94, 0x96, 0x8a, 0xf5, 0xba, 0x0d, 0xe9, 0x20, 0xda, 0x28, 0xd6, 0xeb, 0x95, 0x16,
+	0xac, 0x61, 0xc7, 0x63, 0xec, 0x3d, 0x11, 0x36, 0x88, 0xf5, 0xba, 0x3b, 0x68, 0xa5, 0xc2, 0x83,
+	0xd6, 0x73, 0x08, 0x39, 0xd1, 0x9b, 0x46, 0xb8, 0xfe, 0xb1, 0xfd, 0x79, 0x36, 0x38, 0xf2, 0x56,
+	0xa8, 0xcf, 0xb3, 0x95, 0xc1, 0x86, 0x79, 0x41, 0x9c, 0x97, 0xc1, 0x9a, 0xd2, 0x0e, 0x07, 0x96,
+	0xa3, 0x83, 0x08, 0x2e, 0x85, 0xe4, 0xaf, 0x1c, 0x22, 0x61, 0xb0, 0x3a, 0xf0, 0xdc, 0xd7, 0x2b,
+	0x9e, 0xf1, 0xf3, 0x1e, 0xbc, 0x29, 0x8a, 0xf7, 0x40, 0x24, 0x5e, 0x66, 0x66, 0x1c, 0xc0, 0x8b,
+	0x68, 0x07, 0xc5, 0x5b, 0x54, 0x55, 0x66, 0x96, 0xed, 0x3a, 0x2f, 0xd1, 0xdc, 0xdd, 0x26, 0xfa,
+	0x36, 0x87, 0x76, 0xfa, 0x3b, 0x6e, 0x9f, 0x72, 0x7d, 0xab, 0x99, 0x72, 0x3d, 0xe7, 0xee, 0x47,
+	0x1c, 0xe2, 0xbd, 0x18, 0xba, 0x1c, 0xfc, 0xcf, 0x05, 0x18, 0x7a, 0x17, 0xb9, 0xfe, 0x15, 0x87,
+	0xee, 0x0d, 0xb4, 0xf3, 0xe3, 0x46, 0xf8, 0xbf, 0x39, 0xf4, 0x60, 0x00, 0x90, 0xa2, 0x26, 0xb9,
+	0x77, 0x4b, 0xc9, 0xf9, 0x9f, 0xee, 0xb8, 0x15, 0xbb, 0x37, 0xc9, 0xc6, 0xab, 0xc7, 0xb1, 0xe9,
+	0x8f, 0x1c, 0x3a, 0x14, 0x07, 0xfd, 0xc7, 0xcd, 0xab, 0x6f, 0xa4, 0x50, 0xde, 0x15, 0x73, 0xa7,
+	0x5a, 0x11, 0xd7, 0x0d, 0xab, 0xa8, 0x49, 0x3d, 0x5d, 0x57, 0x82, 0x86, 0x40, 0xdf, 0x6a, 0x87,
+	0xc0, 0xfa, 0xbb, 0x3d, 0x04, 0x5e, 0xe7, 0x50, 0x21, 0x36, 0x55, 0x30, 0x0e, 0xf2, 0x68, 0x13,
+	0xf5, 0x63, 0xa5, 0x6c, 0xb3, 0xb5, 0x6d, 0xa5, 0x99, 0xdd, 0xea, 0xf2, 0x74, 0xa5, 0x2c, 0x11,
+	0xe1, 0x1e, 0xfa, 0xb3, 0x2c, 0xe1, 0x51, 0xb4, 0x99, 0xbd, 0x55, 0x24, 0x23, 0x9d, 0x1a, 0xe9,
+	0x3b, 0xb8, 0xbe, 0xb4, 0x7d, 0xa5, 0x99, 0x1d, 0x72, 0x0b, 0x28, 0x92, 0x41, 0x84, 0x4d, 0x20,
+	0x61, 0x90, 0xb7, 0x39, 0x74, 0xd8, 0x65, 0x96, 0xc4, 0x06, 0x66, 0x59, 0x2b, 0xaa, 0xaa, 0xdb,
+	0x30, 0x63, 0xcd, 0x45, 0xc6, 0xdb, 0x1c, 0x3a, 0x12, 0xcf, 0x70, 0x20, 0xd3, 0x43, 0x0e, 0x17,
+	0x87, 0x9c, 0x9e, 0x4f, 0x9f, 0x37, 0x39, 0x34, 0xe2, 0x09, 0x0b, 0x0c, 0x86, 0x46, 0x47, 0x86,
+	0xcd, 0x78, 0x6b, 0x1a, 0x70, 0x49, 0xb6, 0x57, 0x77, 0x9d, 0xef, 0xb7, 0xed, 0x5d, 0x63, 0xb0,
+	0xad, 0x6b, 0x97, 0xe4, 0xff, 0xda, 0x23, 0xfc, 0x69, 0xfd, 0xaa, 0xac, 0x9d, 0xb7, 0x32, 0x29,
+	0xe9, 0x93, 0xb4, 0xf6, 0x7c, 0xcb, 0x9e, 0x28, 0x91, 0xf8, 0x5b, 0x3b, 0xe4, 0x2d, 0xa6, 0xd5,
+	0xb4, 0x52, 0xa3, 0x6d, 0x61, 0xdc, 0x4d, 0x27, 0xce, 0xff, 0xb7, 0x31, 0xbb, 0xdc, 0xba, 0x88,
+	0xd0, 0x6f, 0x3a, 0x56, 0x90, 0xb7, 0x38, 0xf4, 0x80, 0xdf, 0xb4, 0xa2, 0x95, 0x65, 0x5e, 0x56,
+	0x0c, 0x79, 0x6d, 0x6e, 0xc8, 0xfe, 0xc2, 0xa1, 0xfd, 0x51, 0x26, 0x03, 0x8f, 0x9f, 0x43, 0xfd,
+	0x0c, 0x75, 0x45, 0x12, 0x4d, 0x11, 0xd6, 0xf2, 0xbd, 0xc1, 0x6b, 0x39, 0x53, 0x34, 0x25, 0x9a,
+	0xe2, 0x79, 0xb1, 0x5e, 0xe2, 0x61, 0x4d, 0xc7, 0xad, 0x0c, 0xd9, 0xd6, 0x42, 0x04, 0x54, 0x6b,
+	0x35, 0xed, 0xf9, 0xd4, 0x39, 0x07, 0x9b, 0x4f, 0x3a, 0xdf, 0x27, 0xf5, 0x05, 0xcd, 0xec, 0xce,
+	0x29, 0xe4, 0x32, 0xda, 0x13, 0xac, 0x08, 0xa8, 0x1a, 0x47, 0xfd, 0x2c, 0x36, 0x54, 0xad, 0x6f,
+	0xa0, 0x6e, 0xa7, 0xc3, 0x80, 0xeb, 0xa3, 0x9d, 0x63, 0x51, 0x2d, 0xe4, 0x3f, 0xf6, 0xc6, 0xca,
+	0xa7, 0xf9, 0x13, 0x32, 0xb7, 0xe7, 0xd0, 0xe1, 0x58, 0xe8, 0x57, 0x4b, 0xf3, 0xcd, 0x14, 0x7a,
+	0x08, 0x46, 0xbd, 0x29, 0xaa, 0x97, 0x44, 0x75, 0x41, 0x7e, 0x42, 0xaf, 0x5e, 0xfd, 0x64, 0x05,
+	0xd2, 0x9b, 0x1c, 0x1a, 0x4d, 0x40, 0x02, 0x70, 0x7e, 0x1e, 0x6d, 0x59, 0xb4, 0x9a, 0x56, 0x54,
+	0xda, 0x16, 0xa2, 0xe9, 0xa1, 0xf8, 0x91, 0x54, 0xe8, 0x5f, 0x74, 0xba, 0x22, 0xb7, 0xec, 0x2d,
+	0x83, 0xf7, 0x90, 0x79, 0x8d, 0xa6, 0xaf, 0xbf, 0xb5, 0x37, 0x0d, 0xc1, 0xd6, 0x02, 0x45, 0x9f,
+	0x42, 0xc3, 0x7e, 0xe7, 0xda, 0x9b, 0x87, 0x3d, 0x2b, 0xcd, 0x6c, 0x3a, 0xd8, 0xff, 0x06, 0x11,
+	0xb6, 0x7a, 0x07, 0x40, 0xef, 0xf7, 0x12, 0x73, 0x40, 0xfe, 0x53, 0xa6, 0x38, 0xab, 0xca, 0x2c,
+	0x01, 0xf3, 0x9f, 0x05, 0x96, 0xd0, 0x56, 0x83, 0x7e, 0xae, 0xd8, 0xdb, 0x1d, 0xf0, 0x82, 0xeb,
+	0x70, 0xd5, 0xd7, 0x80, 0x08, 0x03, 0x86, 0xa3, 0xb1, 0x2c, 0x91, 0xd7, 0x6d, 0xde, 0x82, 0x3b,
+	0x02, 0xde, 0x74, 0x34, 0x0c, 0x8a, 0xac, 0x35, 0xa1, 0xe2, 0x3e, 0xec, 0x7c, 0x20, 0x78, 0x99,
+	0x61, 0xea, 0xac, 0xc5, 0x86, 0xa9, 0x74, 0xd1, 0xdb, 0xa6, 0x89, 0x08, 0x5b, 0x0d, 0x6f, 0x73,
+	0xf2, 0x63, 0x0e, 0xe2, 0xb8, 0xc7, 0xac, 0xb5, 0x38, 0xf0, 0xfe, 0xc1, 0xa1, 0xfb, 0x3a, 0x58,
+	0x0a, 0xe4, 0x19, 0xc1, 0xe4, 0xf5, 0xc5, 0x27, 0x6f, 0x04, 0x56, 0xe9, 0xf8, 0x04, 0xf6, 0x7c,
+	0x7c, 0x7e, 0xdf, 0xde, 0xeb, 0xfa, 0x61, 0xaf, 0x91, 0x10, 0x4d, 0x7e, 0x60, 0x6f, 0x46, 0x23,
+	0x0d, 0xfc, 0xa8, 0xc6, 0xf8, 0x2d, 0x0e, 0x9d, 0x6c, 0x0b, 0x59, 0xf4, 0xd3, 0x79, 0xb1, 0x5e,
+	0x57, 0xb4, 0xf9, 0x35, 0xc5, 0xe7, 0x7b, 0x1c, 0x7a, 0xb8, 0x0b, 0x6b, 0x81, 0xdc, 0x6f, 0x72,
+	0x28, 0x2d, 0x77, 0x10, 0x04, 0x92, 0x8f, 0x07, 0x93, 0x5c, 0xac, 0xd7, 0x3b, 0xf5, 0x68, 0xed,
+	0x4c, 0x4b, 0x7b, 0x57, 0x9a, 0xd9, 0xac, 0x17, 0x84, 0xbf, 0x1d, 0x11, 0x3a, 0xf6, 0x4d, 0x7e,
+	0x6a, 0x9f, 0x16, 0x86, 0xc2, 0x5a, 0x73, 0x51, 0xe7, 0xcd, 0x14, 0x3a, 0x14, 0xc7, 0x6c, 0xa0,
+	0xff, 0x3b, 0xe1, 0xf4, 0xf7, 0x75, 0x4f, 0xff, 0x01, 0x08, 0x4d, 0xdd, 0xbb, 0xa0, 0xe7, 0xa1,
+	0xea, 0x27, 0xad, 0x44, 0xea, 0xd2, 0x13, 0x94, 0x96, 0x99, 0xb9, 0x35, 0x7f, 0xe6, 0x74, 0x87,
+	0x43, 0x07, 0x22, 0x6d, 0x06, 0xe7, 0x3e, 0x83, 0xee, 0x31, 0x17, 0x55, 0x57, 0xe6, 0xb7, 0x3f,
+	0xd8, 0x95, 0x4f, 0x2f, 0xaa, 0x6c, 0x6b, 0x67, 0x27, 0x7f, 0x3b, 0xc1, 0x77, 0x83, 0xcc, 0x16,
+	0x50, 0x42, 0x04, 0x5b, 0x5d, 0xcf, 0x3d, 0x73, 0x16, 0x6d, 0xf7, 0x80, 0x4c, 0x9e, 0xee, 0xbd,
+	0xc6, 0xa1, 0x1d, 0x3e, 0x15, 0xc0, 0xca, 0x35, 0x34, 0xec, 0x5c, 0xc7, 0x7b, 0xb7, 0xc4, 0x9f,
+	0x4e, 0x7c, 0xc0, 0x90, 0xf6, 0xdf, 0xfc, 0x83, 0x42, 0x22, 0x0c, 0x39, 0xef, 0x60, 0xdf, 0x6c,
+	0xc0, 0x0d, 0xec, 0x67, 0x0c, 0xb9, 0x71, 0x7e, 0xe9, 0x82, 0x6e, 0x28, 0x16, 0xd6, 0xde, 0xde,
+	0x78, 0x92, 0xbf, 0xf5, 0xa1, 0x91, 0xce, 0xbd, 0x7e, 0xc4, 0x94, 0xe0, 0x0a, 0xda, 0x6c, 0x5a,
+	0x99, 0x4c, 0x45, 0x5a, 0x90, 0x01, 0x49, 0x29, 0x71, 0x87, 0x43, 0xf6, 0x21, 0x0f, 0x28, 0x22,
+	0xc2, 0x26, 0xfa, 0x7b, 0x6a, 0x41, 0xc6, 0x5f, 0x40, 0xdb, 0xc4, 0x45, 0x51, 0x51, 0xe9, 0xa2,
+	0x6b, 0xea, 0x95, 0x59, 0xbd, 0xd1, 0xd0, 0xaf, 0x41, 0x6d, 0xcb, 0x13, 0x89, 0xbb, 0xe2, 0xc1,
+	0x17, 0xed, 0x2a, 0x89, 0x30, 0xdc, 0x7a, 0xfb, 0xb4, 0x5e, 0xa2, 0xef, 0xb0, 0x81, 0x86, 0xc4,
+	0x45, 0xb9, 0x21, 0xce, 0xcb, 0x95, 0x6a, 0x03, 0x6a, 0x4c, 0x58, 0xa5, 0x4c, 0x39, 0x71, 0xa9,
+	0xc2, 0x2e, 0xbb, 0x6b, 0xaf, 0x3e, 0x22, 0x0c, 0xc2, 0xab, 0xc9, 0x06, 0x2d, 0x2a, 0x21, 0x33,
+	0xe8, 0x81, 0x96, 0xc3, 0xdd, 0x71, 0x81, 0xa6, 0x8c, 0xd6, 0x0c, 0x4f, 0x78, 0xaa, 0x4b, 0x6e,
+	0xd9, 0x61, 0x32, 0x44, 0x23, 0x0c, 0xa4, 0x97, 0x39, 0x34, 0xb0, 0x60, 0xc8, 0xce, 0x17, 0x08,
+	0x3c, 0xa7, 0x82, 0x03, 0x0f, 0x3d, 0xb8, 0x8d, 0x5a, 0x48, 0x32, 0x10, 0x8c, 0x20, 0x6f, 0xb1,
+	0xd4, 0x57, 0xc0, 0xd7, 0x34, 0x28, 0x79, 0x3b, 0x24, 0x8f, 0x43, 0xda, 0x62, 0x8f, 0xf4, 0xf3,
+	0xa2, 0x26, 0xce, 0xcb, 0x8d, 0x73, 0x0d, 0x51, 0x33, 0x8d, 0xa4, 0xd0, 0xbf, 0x88, 0x48, 0x98,
+	0x32, 0x40, 0x7d, 0x05, 0x6d, 0x9c, 0xa7, 0x6f, 0x00, 0xed, 0xa1, 0x60, 0xb4, 0x41, 0x4a, 0x4a,
+	0x3b, 0x00, 0x1d, 0xcc, 0x72, 0xa6, 0x87, 0x08, 0xa0, 0x90, 0x3c, 0xeb, 0x3e, 0xfe, 0xba, 0xd0,
+	0xd0, 0x4d, 0xb9, 0x6a, 0xa9, 0xb0, 0x71, 0x4c, 0x30, 0xba, 0xfd, 0x69, 0x5e, 0xda, 0xa9, 0xe2,
+	0xf1, 0x7c, 0x26, 0x42, 0xbf, 0xf5, 0x6c, 0xa7, 0x78, 0x2f, 0x73, 0x68, 0x4f, 0xb0, 0xf6, 0xd6,
+	0xf1, 0x21, 0xaa, 0xb7, 0xde, 0x86, 0x6f, 0x79, 0x7d, 0x2a, 0x4a, 0xbb, 0x01, 0x97, 0x1d, 0xe8,
+	0x5b, 0x5f, 0xac, 0x40, 0xef, 0x3c, 0xfc, 0x8c, 0x83, 0xdb, 0x3b, 0xba, 0x72, 0xb1, 0xa8, 0x50,
+	0xd4, 0x24, 0x76, 0x16, 0xf9, 0x94, 0x29, 0x9a, 0x8a, 0x61, 0x2a, 0xd5, 0xb5, 0xb9, 0xd5, 0x7a,
+	0x25, 0x85, 0x0a, 0xb1, 0x6d, 0x77, 0x22, 0xad, 0xb5, 0x91, 0x6e, 0x7d, 0x75, 0xcd, 0x91, 0x03,
+	0x1d, 0x46, 0x8d, 0xbf, 0xb9, 0x3f, 0xe9, 0x6b, 0xd3, 0x47, 0x84, 0xf6, 0x3e, 0x7a, 0xbe, 0x62,
+	0x7f, 0xc9, 0x3e, 0x47, 0x2f, 0xaa, 0xaa, 0x2f, 0xfd, 0x11, 0xe4, 0x6b, 0x62, 0x43, 0xfa, 0x7f,
+	0x95, 0x86, 0x7c, 0x3b, 0x85, 0xf6, 0x47, 0x19, 0x02, 0xce, 0x78, 0x95, 0x43, 0x3b, 0x8d, 0xc0,
+	0x26, 0xe0, 0x92, 0x23, 0xb1, 0xd2, 0x3b, 0x90, 0x29, 0x1d, 0x04, 0xbf, 0x8c, 0x74, 0xc8, 0xf4,
+	0x2a, 0x0d, 0xd6, 0x90, 0x08, 0x1d, 0x7a, 0xed, 0xb5, 0x93, 0xc6, 0x6e, 0x8d, 0xa2, 0x0d, 0x94,
+	0x1b, 0xfc, 0x0a, 0x87, 0x90, 0x33, 0xf5, 0x71, 0x87, 0xb1, 0xd7, 0x56, 0x05, 0xc7, 0x1f, 0x8c,
+	0x6e, 0xc8, 0xcc, 0x21, 0x0f, 0xde, 0xfc, 0xc3, 0x87, 0xdf, 0x48, 0xed, 0xc5, 0xf7, 0x17, 0x3a,
+	0x57, 0xba, 0x1a, 0x85, 0xeb, 0x8a, 0x74, 0x03, 0x7f, 0xc0, 0xa1, 0x4c, 0xf8, 0x02, 0x83, 0x1f,
+	0x09, 0xe9, 0x37, 0x6a, 0xa1, 0xe3, 0x27, 0xba, 0x13, 0x06, 0x20, 0x93, 0x14, 0xc8, 0x69, 0xfc,
+	0x48, 0x30, 0x10, 0x2b, 0xa0, 0xe6, 0xec, 0x14, 0x26, 0x67, 0x4d, 0xbc, 0x1c, 0x5d, 0x9d, 0x72,
+	0xd6, 0xea, 0x54, 0xb8, 0x4e, 0xd7, 0x91, 0x1b, 0xf8, 0x36, 0x87, 0x76, 0x75, 0x28, 0xbf, 0xc3,
+	0xc7, 0xa2, 0x38, 0x0d, 0xaa, 0xf7, 0xe3, 0x8f, 0x27, 0x94, 0x02, 0x34, 0x0f, 0x53, 0x34, 0x47,
+	0xf1, 0x68, 0x98, 0x5b, 0x2c, 0xd1, 0xdc, 0xec, 0x52, 0x8e, 0x3e, 0xe5, 0x14, 0x89, 0xb9, 0xe9,
+	0x7d, 0xbb, 0x60, 0x29, 0xb0, 0xba, 0x0d, 0x8f, 0xc7, 0x31, 0x28, 0xa0, 0xcc, 0x8f, 0x3f, 0x99,
+	0x5c, 0x10, 0xc0, 0x94, 0x29, 0x98, 0x49, 0x5c, 0x8c, 0x04, 0xa3, 0xcf, 0xe5, 0xa8, 0x27, 0x2c,
+	0x54, 0x62, 0xbd, 0x5e, 0xb8, 0xce, 0x96, 0x8a, 0x1b, 0x2d, 0x07, 0x7d, 0x9d, 0x43, 0x83, 0xde,
+	0xf2, 0x18, 0x7c, 0x38, 0xc4, 0x2e, 0x7f, 0xc1, 0x1b, 0x7f, 0x24, 0x5e, 0x63, 0x30, 0x7c, 0x1f,
+	0x35, 0x3c, 0x83, 0xf7, 0x84, 0x19, 0x8e, 0xdf, 0xe2, 0xd0, 0xb6, 0x80, 0x92, 0x1d, 0xfc, 0x50,
+	0x9c, 0xbe, 0x3c, 0x14, 0x8f, 0x26, 0x90, 0x00, 0x13, 0x8f, 0x51, 0x13, 0xf3, 0xf8, 0x48, 0x98,
+	0x89, 0x16, 0x9d, 0x45, 0x17, 0x9d, 0xf8, 0x66, 0x0a, 0x91, 0x00, 0xad, 0xbe, 0xd3, 0x1f, 0x7c,
+	0x26, 0xb6, 0x3d, 0xc1, 0xa7, 0x5c, 0xfc, 0xd9, 0xee, 0x15, 0x00, 0xbe, 0x2b, 0x14, 0xdf, 0x53,
+	0xf8, 0x62, 0x14, 0x3e, 0xb1, 0x5e, 0xcf, 0x89, 0x9a, 0xe4, 0x9d, 0xe3, 0xae, 0x01, 0xe4, 0x3f,
+	0x21, 0xbb, 0x81, 0x7f, 0x98, 0x82, 0x1c, 0x3d, 0xba, 0xce, 0x06, 0x4f, 0x45, 0x0e, 0xfe, 0x18,
+	0x15, 0x4d, 0xfc, 0xf4, 0x2a, 0xb5, 0x00, 0x27, 0x2a, 0xe5, 0x64, 0x0e, 0x4b, 0x21, 0x9c, 0xe4,
+	0x14, 0xc9, 0x33, 0x9b, 0xbc, 0xa1, 0xcf, 0x22, 0x2a, 0x68, 0x7e, 0x05, 0xd1, 0xf4, 0x2f, 0x0e,
+	0xed, 0x8b, 0x53, 0x3e, 0x83, 0x8b, 0x91, 0xe8, 0xa2, 0x6a, 0x86, 0xf8, 0xd2, 0x6a, 0x54, 0x00,
+	0x3b, 0x8f, 0x53, 0x76, 0xa6, 0xf1, 0x64, 0x08, 0x3b, 0x65, 0xa9, 0x35, 0x66, 0x14, 0x2d, 0x27,
+	0xaa, 0x6a, 0xce, 0x7d, 0xba, 0x65, 0x38, 0x13, 0xe5, 0xd7, 0x1c, 0xda, 0xdd, 0xb1, 0x96, 0x05,
+	0x9f, 0x88, 0x31, 0xbc, 0x03, 0x0a, 0x75, 0xf8, 0xf1, 0xc4, 0x72, 0x80, 0xed, 0x11, 0x8a, 0xed,
+	0x38, 0x3e, 0x1a, 0xee, 0x79, 0x06, 0x4e, 0x63, 0xde, 0x6f, 0xc5, 0xce, 0x57, 0x53, 0x68, 0x9f,
+	0xbf, 0x20, 0x21, 0x70, 0xda, 0x87, 0x39, 0x32, 0x5e, 0x69, 0x0c, 0x5f, 0x5a, 0x8d, 0x0a, 0x00,
+	0x5b, 0xa1, 0x60, 0xaf, 0xe0, 0xcb, 0xc1, 0x60, 0x69, 0x79, 0x48, 0x8e, 0xd5, 0x38, 0x40, 0x80,
+	0x4b, 0x18, 0x00, 0x96, 0xed, 0x0d, 0x4d, 0xc7, 0x0a, 0x8d, 0xd0, 0x0d, 0x4d, 0x54, 0x29, 0x0a,
+	0x3f, 0xd1, 0x9d, 0x30, 0xc0, 0x9f, 0xa6, 0xf0, 0xcf, 0xe0, 0xd3, 0x31, 0xe0, 0xd3, 0x3f, 0xb8,
+	0xc8, 0x5d, 0x53, 0x0c, 0xd9, 0xbf, 0x72, 0xe2, 0x9f, 0x73, 0x70, 0x48, 0xe7, 0xbb, 0xf9, 0xc7,
+	0xa3, 0x51, 0x73, 0xad, 0xad, 0x8c, 0x83, 0x1f, 0x4b, 0x22, 0x02, 0x30, 0x26, 0x28, 0x8c, 0x13,
+	0xf8, 0x58, 0xd8, 0x90, 0xa5, 0x85, 0x04, 0x30, 0x68, 0x3d, 0x0b, 0xd5, 0xd7, 0x52, 0x68, 0x6f,
+	0x8c, 0xba, 0x05, 0x7c, 0x36, 0xbe, 0x65, 0x1d, 0x46, 0x6c, 0x71, 0x15, 0x1a, 0x00, 0xaa, 0x48,
+	0xa1, 0x3e, 0x8b, 0xaf, 0x24, 0x80, 0x9a, 0x70, 0xc8, 0x7e, 0x2f, 0x05, 0xd7, 0x1d, 0x71, 0x2a,
+	0x0b, 0xf0, 0x63, 0xa1, 0x03, 0x30, 0x76, 0x7d, 0x06, 0x7f, 0x6e, 0xd5, 0x7a, 0xe2, 0x4d, 0x69,
+	0x5a, 0xbe, 0x90, 0x63, 0xe7, 0x90, 0xdd, 0x4d, 0xe9, 0xdf, 0xd8, 0xf1, 0x3a, 0xa8, 0x8c, 0x20,
+	0x34, 0x5e, 0x87, 0x54, 0x49, 0xf0, 0xe3, 0x89, 0xe5, 0x00, 0xef, 0xa3, 0x14, 0xef, 0x49, 0x7c,
+	0x22, 0x18, 0xaf, 0x65, 0x7f, 0x43, 0x13, 0x55, 0xb6, 0x28, 0x2b, 0x52, 0xdb, 0x3e, 0xed, 0x5d,
+	0x1b, 0x4e, 0xd0, 0xed, 0x7e, 0x28, 0x9c, 0x90, 0xba, 0x03, 0x7e, 0x3c, 0xb1, 0x1c, 0xc0, 0x29,
+	0x51, 0x38, 0x13, 0xf8, 0x54, 0x30, 0x1c, 0x96, 0x2e, 0x43, 0x3a, 0x32, 0xbb, 0x44, 0x33, 0x12,
+	0x5f, 0xfd, 0xc2, 0x0d, 0xfc, 0x0b, 0xfb, 0xc4, 0xdf, 0x7f, 0xaf, 0x8b, 0xc7, 0xe2, 0x9a, 0xe5,
+	0xf2, 0xcc, 0xd1, 0x44, 0x32, 0x00, 0xe3, 0x14, 0x85, 0x71, 0x0c, 0x8f, 0xc5, 0x83, 0xe1, 0x0f,
+	0x48, 0xfb, 0xe2, 0x5c, 0x4b, 0x87, 0x2e, 0xa2, 0xf1, 0xee, 0xdc, 0xf9, 0xd2, 0x6a, 0x54, 0x00,
+	0xd6, 0xe7, 0x29, 0xd6, 0x67, 0xf0, 0xa5, 0xd8, 0x58, 0x3d, 0x33, 0xee, 0x42, 0xd4, 0x84, 0xbb,
+	0x9d, 0x82, 0x52, 0xa7, 0x24, 0xd7, 0xca, 0xf8, 0xc9, 0x98, 0x13, 0x2a, 0xe6, 0x6d, 0x3a, 0x3f,
+	0x73, 0xd7, 0xf4, 0x01, 0x6d, 0x57, 0x29, 0x6d, 0x32, 0xae, 0x76, 0x9e, 0xb8, 0x1e, 0x9d, 0xb9,
+	0x1a, 0x53, 0xea, 0xe6, 0x70, 0x3a, 0x2e, 0x87, 0x7f, 0xb7, 0xff, 0x1e, 0x2d, 0xd4, 0xd4, 0xd0,
+	0x6c, 0x2c, 0xce, 0xed, 0x37, 0x7f, 0xb6, 0x7b, 0x05, 0x40, 0xcb, 0x39, 0x4a, 0x4b, 0x11, 0x9f,
+	0xe9, 0x8e, 0x16, 0x67, 0x1a, 0x7d, 0x68, 0xff, 0x9d, 0x63, 0xe7, 0xfb, 0x51, 0x1c, 0xba, 0x7d,
+	0x8a, 0xba, 0x0a, 0xe6, 0x4f, 0x77, 0x29, 0x0d, 0x48, 0xa7, 0x28, 0xd2, 0x47, 0xf1, 0x44, 0x30,
+	0x52, 0x73, 0x51, 0x6d, 0x25, 0x9d, 0xae, 0xec, 0x21, 0x57, 0xf7, 0xa6, 0x0f, 0xdf, 0xe5, 0xd0,
+	0x80, 0xa7, 0x47, 0x7c, 0x28, 0x86, 0x59, 0x36, 0x84, 0xc3, 0xb1, 0xda, 0x82, 0xc1, 0xa3, 0xd4,
+	0xe0, 0xc3, 0xf8, 0xc1, 0x28, 0x83, 0x1d, 0xeb, 0x7e, 0xcf, 0xa1, 0x74, 0xa7, 0x4b, 0x47, 0x7c,
+	0x3c, 0xe2, 0x34, 0x2e, 0xf8, 0x6a, 0x94, 0x3f, 0x91, 0x54, 0x0c, 0xcc, 0x7f, 0x8c, 0x9a, 0x7f,
+	0x16, 0x3f, 0x1a, 0x72, 0x7c, 0x57, 0x5b, 0xca, 0xd5, 0x41, 0xb8, 0xe3, 0x01, 0xd1, 0x97, 0xed,
+	0xa4, 0x3e, 0xfa, 0xb4, 0x3f, 0x34, 0xa9, 0x8f, 0x7d, 0xd1, 0xc1, 0x4f, 0xaf, 0x52, 0x0b, 0x10,
+	0x30, 0x43, 0x09, 0x28, 0xe3, 0x73, 0xc1, 0x04, 0xd0, 0x81, 0x65, 0x6f, 0x8d, 0xac, 0xe8, 0x02,
+	0x3b, 0x7f, 0xc3, 0xd6, 0xd6, 0xe6, 0xdd, 0xf7, 0xec, 0xec, 0xa6, 0xe3, 0x09, 0x7b, 0x68, 0x76,
+	0x13, 0x75, 0x41, 0xc0, 0x4f, 0x74, 0x27, 0x0c, 0x70, 0xc7, 0x29, 0xdc, 0x51, 0x5c, 0x08, 0x5b,
+	0x97, 0x02, 0x4e, 0xe5, 0xf1, 0xef, 0xec, 0xe3, 0xcd, 0xc0, 0xcb, 0xbe, 0xd0, 0xe3, 0xcd, 0xb0,
+	0xbb, 0x46, 0xfe, 0x64, 0x72, 0x41, 0x80, 0x72, 0x9a, 0x42, 0x19, 0xc7, 0xc7, 0x3b, 0x78, 0x0e,
+	0x84, 0x2b, 0x35, 0x26, 0x5d, 0x61, 0x77, 0x86, 0xad, 0x11, 0xfb, 0x4b, 0x4f, 0x82, 0xe6, 0xdc,
+	0xcd, 0x45, 0x27, 0x68, 0x6d, 0x17, 0x8d, 0xfc, 0x58, 0x12, 0x91, 0x78, 0x7b, 0x54, 0xc6, 0xbe,
+	0x73, 0x17, 0x58, 0xb8, 0xee, 0xb9, 0xab, 0xbc, 0x51, 0xba, 0xf8, 0xce, 0x9f, 0x33, 0xeb, 0xde,
+	0x58, 0xce, 0xac, 0x7b, 0x67, 0x39, 0xc3, 0xbd, 0xbb, 0x9c, 0xe1, 0x3e, 0x58, 0xce, 0x70, 0xaf,
+	0xdd, 0xc9, 0xac, 0x7b, 0xf7, 0x4e, 0x66, 0xdd, 0x9f, 0xee, 0x64, 0xd6, 0x7d, 0xb6, 0xe0, 0xb9,
+	0x3d, 0xb7, 0xfa, 0xc8, 0xe9, 0x73, 0x73, 0x4a, 0x55, 0x11, 0x55, 0xbb, 0x4f, 0xbb, 0x57, 0x7a,
+	0x95, 0x3e, 0xbb, 0xd1, 0xea, 0x4e, 0x3f, 0xfa, 0xbf, 0x01, 0x00, 0x89, 0x42, 0x80, 0x9b, 0x16,
+	0x44, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2419,6 +2585,8 @@ type QueryClient interface {
 	QueryUserMyPositionByApp(ctx context.Context, in *QueryUserMyPositionByAppRequest, opts ...grpc.CallOption) (*QueryUserMyPositionByAppResponse, error)
 	QueryPairsLockedAndMintedStatisticByApp(ctx context.Context, in *QueryPairsLockedAndMintedStatisticByAppRequest, opts ...grpc.CallOption) (*QueryPairsLockedAndMintedStatisticByAppResponse, error)
 	QueryAllStableMintVaultRewards(ctx context.Context, in *QueryAllStableMintVaultRewardsRequest, opts ...grpc.CallOption) (*QueryAllStableMintVaultRewardsResponse, error)
+	QueryPositionManagerGrants(ctx context.Context, in *QueryPositionManagerGrantsRequest, opts ...grpc.CallOption) (*QueryPositionManagerGrantsResponse, error)
+	QueryVaultProtection(ctx context.Context, in *QueryVaultProtectionRequest, opts ...grpc.CallOption) (*QueryVaultProtectionResponse, error)
 }
 
 type queryClient struct {
@@ -2663,6 +2831,24 @@ func (c *queryClient) QueryAllStableMintVaultRewards(ctx context.Context, in *Qu
 	return out, nil
 }
 
+func (c *queryClient) QueryPositionManagerGrants(ctx context.Context, in *QueryPositionManagerGrantsRequest, opts ...grpc.CallOption) (*QueryPositionManagerGrantsResponse, error) {
+	out := new(QueryPositionManagerGrantsResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Query/QueryPositionManagerGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryVaultProtection(ctx context.Context, in *QueryVaultProtectionRequest, opts ...grpc.CallOption) (*QueryVaultProtectionResponse, error) {
+	out := new(QueryVaultProtectionResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Query/QueryVaultProtection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	QueryVault(context.Context, *QueryVaultRequest) (*QueryVaultResponse, error)
@@ -2691,6 +2877,8 @@ type QueryServer interface {
 	QueryUserMyPositionByApp(context.Context, *QueryUserMyPositionByAppRequest) (*QueryUserMyPositionByAppResponse, error)
 	QueryPairsLockedAndMintedStatisticByApp(context.Context, *QueryPairsLockedAndMintedStatisticByAppRequest) (*QueryPairsLockedAndMintedStatisticByAppResponse, error)
 	QueryAllStableMintVaultRewards(context.Context, *QueryAllStableMintVaultRewardsRequest) (*QueryAllStableMintVaultRewardsResponse, error)
+	QueryPositionManagerGrants(context.Context, *QueryPositionManagerGrantsRequest) (*QueryPositionManagerGrantsResponse, error)
+	QueryVaultProtection(context.Context, *QueryVaultProtectionRequest) (*QueryVaultProtectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2775,6 +2963,12 @@ func (*UnimplementedQueryServer) QueryPairsLockedAndMintedStatisticByApp(ctx con
 func (*UnimplementedQueryServer) QueryAllStableMintVaultRewards(ctx context.Context, req *QueryAllStableMintVaultRewardsRequest) (*QueryAllStableMintVaultRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAllStableMintVaultRewards not implemented")
 }
+func (*UnimplementedQueryServer) QueryPositionManagerGrants(ctx context.Context, req *QueryPositionManagerGrantsRequest) (*QueryPositionManagerGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPositionManagerGrants not implemented")
+}
+func (*UnimplementedQueryServer) QueryVaultProtection(ctx context.Context, req *QueryVaultProtectionRequest) (*QueryVaultProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVaultProtection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -3248,6 +3442,42 @@ func _Query_QueryAllStableMintVaultRewards_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryPositionManagerGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionManagerGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPositionManagerGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Query/QueryPositionManagerGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPositionManagerGrants(ctx, req.(*QueryPositionManagerGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVaultProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVaultProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Query/QueryVaultProtection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVaultProtection(ctx, req.(*QueryVaultProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "comdex.vault.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -3356,6 +3586,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryAllStableMintVaultRewards",
 			Handler:    _Query_QueryAllStableMintVaultRewards_Handler,
 		},
+		{
+			MethodName: "QueryPositionManagerGrants",
+			Handler:    _Query_QueryPositionManagerGrants_Handler,
+		},
+		{
+			MethodName: "QueryVaultProtection",
+			Handler:    _Query_QueryVaultProtection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comdex/vault/v1beta1/query.proto",
@@ -4029,6 +4267,24 @@ func (m *QueryVaultIDOfOwnerByExtendedPairAndAppResponse) MarshalToSizedBuffer(d
 	_ = i
 	var l int
 	_ = l
+	if len(m.VaultIds) > 0 {
+		dAtA13 := make([]byte, len(m.VaultIds)*10)
+		var j12 int
+		for _, num := range m.VaultIds {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintQuery(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Vault_Id != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Vault_Id))
 		i--
@@ -4110,20 +4366,20 @@ func (m *QueryVaultIdsByAppInAllExtendedPairsResponse) MarshalToSizedBuffer(dAtA
 		dAtA[i] = 0x12
 	}
 	if len(m.VaultIds) > 0 {
-		dAtA15 := make([]byte, len(m.VaultIds)*10)
-		var j14 int
+		dAtA17 := make([]byte, len(m.VaultIds)*10)
+		var j16 int
 		for _, num := range m.VaultIds {
 			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j14++
+				j16++
 			}
-			dAtA15[j14] = uint8(num)
-			j14++
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintQuery(dAtA, i, uint64(j14))
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintQuery(dAtA, i, uint64(j16))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4205,20 +4461,20 @@ func (m *QueryAllVaultIdsByAnOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		dAtA[i] = 0x12
 	}
 	if len(m.VaultIds) > 0 {
-		dAtA19 := make([]byte, len(m.VaultIds)*10)
-		var j18 int
+		dAtA21 := make([]byte, len(m.VaultIds)*10)
+		var j20 int
 		for _, num := range m.VaultIds {
 			for num >= 1<<7 {
-				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j18++
+				j20++
 			}
-			dAtA19[j18] = uint8(num)
-			j18++
+			dAtA21[j20] = uint8(num)
+			j20++
 		}
-		i -= j18
-		copy(dAtA[i:], dAtA19[:j18])
-		i = encodeVarintQuery(dAtA, i, uint64(j18))
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintQuery(dAtA, i, uint64(j20))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4674,20 +4930,20 @@ func (m *QueryExtendedPairIDsByAppResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		dAtA[i] = 0x12
 	}
 	if len(m.ExtendedPairIds) > 0 {
-		dAtA28 := make([]byte, len(m.ExtendedPairIds)*10)
-		var j27 int
+		dAtA30 := make([]byte, len(m.ExtendedPairIds)*10)
+		var j29 int
 		for _, num := range m.ExtendedPairIds {
 			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j27++
+				j29++
 			}
-			dAtA28[j27] = uint8(num)
-			j27++
+			dAtA30[j29] = uint8(num)
+			j29++
 		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintQuery(dAtA, i, uint64(j27))
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintQuery(dAtA, i, uint64(j29))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -5386,6 +5642,134 @@ func (m *QueryUserExtendedPairTotalDataResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPositionManagerGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionManagerGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionManagerGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionManagerGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionManagerGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionManagerGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultProtectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultProtectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultProtectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserVaultId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultProtectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultProtectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultProtectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Protection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryPairsLockedAndMintedStatisticByAppRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5833,6 +6217,13 @@ func (m *QueryVaultIDOfOwnerByExtendedPairAndAppResponse) Size() (n int) {
 	if m.Vault_Id != 0 {
 		n += 1 + sovQuery(uint64(m.Vault_Id))
 	}
+	if len(m.VaultIds) > 0 {
+		l = 0
+		for _, e := range m.VaultIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
 	return n
 }
 
@@ -6367,6 +6758,57 @@ func (m *QueryUserExtendedPairTotalDataResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPositionManagerGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionManagerGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVaultProtectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserVaultId != 0 {
+		n += 1 + sovQuery(uint64(m.UserVaultId))
+	}
+	return n
+}
+
+func (m *QueryVaultProtectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Protection.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryPairsLockedAndMintedStatisticByAppRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8266,6 +8708,82 @@ func (m *QueryVaultIDOfOwnerByExtendedPairAndAppResponse) Unmarshal(dAtA []byte)
 					break
 				}
 			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VaultIds = append(m.VaultIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VaultIds) == 0 {
+					m.VaultIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VaultIds = append(m.VaultIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11859,6 +12377,324 @@ func (m *QueryUserExtendedPairTotalDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPositionManagerGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionManagerGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionManagerGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionManagerGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionManagerGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionManagerGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, PositionManagerGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultProtectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultProtectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultProtectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
+			}
+			m.UserVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultProtectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultProtectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultProtectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Protection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryPairsLockedAndMintedStatisticByAppRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -872,1736 +873,6427 @@ func (m *MsgVaultInterestCalcResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVaultInterestCalcResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateRequest)(nil), "comdex.vault.v1beta1.MsgCreateRequest")
-	proto.RegisterType((*MsgCreateResponse)(nil), "comdex.vault.v1beta1.MsgCreateResponse")
-	proto.RegisterType((*MsgDepositRequest)(nil), "comdex.vault.v1beta1.MsgDepositRequest")
-	proto.RegisterType((*MsgDepositResponse)(nil), "comdex.vault.v1beta1.MsgDepositResponse")
-	proto.RegisterType((*MsgWithdrawRequest)(nil), "comdex.vault.v1beta1.MsgWithdrawRequest")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "comdex.vault.v1beta1.MsgWithdrawResponse")
-	proto.RegisterType((*MsgDrawRequest)(nil), "comdex.vault.v1beta1.MsgDrawRequest")
-	proto.RegisterType((*MsgDrawResponse)(nil), "comdex.vault.v1beta1.MsgDrawResponse")
-	proto.RegisterType((*MsgRepayRequest)(nil), "comdex.vault.v1beta1.MsgRepayRequest")
-	proto.RegisterType((*MsgRepayResponse)(nil), "comdex.vault.v1beta1.MsgRepayResponse")
-	proto.RegisterType((*MsgCloseRequest)(nil), "comdex.vault.v1beta1.MsgCloseRequest")
-	proto.RegisterType((*MsgCloseResponse)(nil), "comdex.vault.v1beta1.MsgCloseResponse")
-	proto.RegisterType((*MsgDepositAndDrawRequest)(nil), "comdex.vault.v1beta1.MsgDepositAndDrawRequest")
-	proto.RegisterType((*MsgDepositAndDrawResponse)(nil), "comdex.vault.v1beta1.MsgDepositAndDrawResponse")
-	proto.RegisterType((*MsgCreateStableMintRequest)(nil), "comdex.vault.v1beta1.MsgCreateStableMintRequest")
-	proto.RegisterType((*MsgCreateStableMintResponse)(nil), "comdex.vault.v1beta1.MsgCreateStableMintResponse")
-	proto.RegisterType((*MsgDepositStableMintRequest)(nil), "comdex.vault.v1beta1.MsgDepositStableMintRequest")
-	proto.RegisterType((*MsgDepositStableMintResponse)(nil), "comdex.vault.v1beta1.MsgDepositStableMintResponse")
-	proto.RegisterType((*MsgWithdrawStableMintRequest)(nil), "comdex.vault.v1beta1.MsgWithdrawStableMintRequest")
-	proto.RegisterType((*MsgWithdrawStableMintResponse)(nil), "comdex.vault.v1beta1.MsgWithdrawStableMintResponse")
-	proto.RegisterType((*MsgVaultInterestCalcRequest)(nil), "comdex.vault.v1beta1.MsgVaultInterestCalcRequest")
-	proto.RegisterType((*MsgVaultInterestCalcResponse)(nil), "comdex.vault.v1beta1.MsgVaultInterestCalcResponse")
+type MsgTransferVaultRequest struct {
+	From                string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	AppId               uint64 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	ExtendedPairVaultId uint64 `protobuf:"varint,3,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	UserVaultId         uint64 `protobuf:"varint,4,opt,name=user_vault_id,json=userVaultId,proto3" json:"user_vault_id,omitempty" yaml:"user_vault_id"`
+	To                  string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty" yaml:"to"`
 }
 
-func init() { proto.RegisterFile("comdex/vault/v1beta1/tx.proto", fileDescriptor_4b7a3c3b9b1a607e) }
-
-var fileDescriptor_4b7a3c3b9b1a607e = []byte{
-	// 891 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x98, 0xcf, 0x6e, 0xe2, 0x46,
-	0x18, 0xc0, 0xb1, 0x43, 0xd2, 0x30, 0x11, 0x4d, 0x98, 0x90, 0x88, 0x3a, 0xc5, 0x4e, 0xdd, 0x36,
-	0xa5, 0x87, 0xd8, 0x25, 0xb9, 0x55, 0x95, 0xaa, 0x40, 0x2f, 0x1c, 0xa2, 0xb6, 0xae, 0x94, 0xa8,
-	0x55, 0x25, 0x64, 0xf0, 0x84, 0x58, 0x05, 0x8f, 0x8b, 0xc7, 0x69, 0x52, 0xa9, 0x52, 0x1f, 0xa1,
-	0x0f, 0xd0, 0x07, 0x68, 0xf7, 0x49, 0x72, 0xda, 0xcd, 0xee, 0x29, 0xda, 0x83, 0xb5, 0x21, 0x6f,
-	0xc0, 0x61, 0x8f, 0xab, 0x15, 0xe3, 0x21, 0xd8, 0x60, 0x70, 0xd0, 0x92, 0x43, 0xb4, 0x9c, 0x80,
-	0x6f, 0xbe, 0x7f, 0xfe, 0x7d, 0xe3, 0x6f, 0xbe, 0x01, 0xe4, 0xeb, 0xb8, 0x65, 0xa0, 0x73, 0xf5,
-	0x4c, 0x77, 0x9b, 0x44, 0x3d, 0x2b, 0xd6, 0x10, 0xd1, 0x8b, 0x2a, 0x39, 0x57, 0xec, 0x36, 0x26,
-	0x18, 0x66, 0xfd, 0x65, 0x85, 0x2e, 0x2b, 0x6c, 0x59, 0xc8, 0x36, 0x70, 0x03, 0x53, 0x05, 0xb5,
-	0xf7, 0xcd, 0xd7, 0x95, 0xdf, 0xf0, 0x60, 0xed, 0xd0, 0x69, 0x94, 0xdb, 0x48, 0x27, 0x48, 0x43,
-	0xbf, 0xbb, 0xc8, 0x21, 0xf0, 0x53, 0x90, 0x3c, 0x69, 0xe3, 0x56, 0x8e, 0xdb, 0xe6, 0x0a, 0xa9,
-	0xd2, 0x6a, 0xd7, 0x93, 0x56, 0x2e, 0xf4, 0x56, 0xf3, 0x6b, 0xb9, 0x27, 0x95, 0x35, 0xba, 0x08,
-	0x0b, 0x60, 0x49, 0xb7, 0xed, 0xaa, 0x69, 0xe4, 0xf8, 0x6d, 0xae, 0x90, 0x2c, 0x65, 0xba, 0x9e,
-	0x94, 0xf6, 0xd5, 0x7c, 0xb9, 0xac, 0x2d, 0xea, 0xb6, 0x5d, 0x31, 0xe0, 0x11, 0xd8, 0x44, 0xe7,
-	0x04, 0x59, 0x06, 0x32, 0xaa, 0xb6, 0x6e, 0xb6, 0xab, 0x34, 0xb1, 0x9e, 0xe5, 0x02, 0xb5, 0xfc,
-	0xa4, 0xeb, 0x49, 0x79, 0xdf, 0x32, 0x5a, 0x4f, 0xd6, 0xd6, 0xfb, 0x0b, 0x3f, 0xe8, 0x66, 0xfb,
-	0xa8, 0x27, 0xae, 0x18, 0xb0, 0x0a, 0x52, 0x7a, 0x0b, 0xbb, 0x16, 0xa9, 0x9a, 0x56, 0x2e, 0x49,
-	0x73, 0x2d, 0x5d, 0x7a, 0x52, 0xe2, 0xa5, 0x27, 0xed, 0x34, 0x4c, 0x72, 0xea, 0xd6, 0x94, 0x3a,
-	0x6e, 0xa9, 0x75, 0xec, 0xb4, 0xb0, 0xc3, 0x3e, 0x76, 0x1d, 0xe3, 0x37, 0x95, 0x5c, 0xd8, 0xc8,
-	0x51, 0x2a, 0x16, 0xe9, 0x7a, 0xd2, 0x1a, 0x4b, 0xb9, 0xef, 0x48, 0xd6, 0x96, 0xfd, 0xef, 0x15,
-	0x0b, 0xd6, 0x00, 0x60, 0x72, 0xec, 0x92, 0xdc, 0x22, 0x8d, 0x50, 0x9e, 0x3a, 0x42, 0x26, 0x14,
-	0x01, 0xbb, 0x44, 0xd6, 0x58, 0xde, 0xdf, 0xbb, 0x44, 0x5e, 0x07, 0x99, 0x00, 0x7f, 0xc7, 0xc6,
-	0x96, 0x83, 0xe4, 0xe7, 0x3c, 0x95, 0x7e, 0x87, 0x6c, 0xec, 0x98, 0xe4, 0x91, 0x95, 0xe5, 0x1b,
-	0x90, 0x76, 0x1d, 0x14, 0x70, 0x97, 0xa4, 0xee, 0x72, 0x5d, 0x4f, 0xca, 0xfa, 0xee, 0x42, 0xcb,
-	0xb2, 0xb6, 0xd2, 0xfb, 0xdd, 0xb7, 0x3e, 0x06, 0x4b, 0x3e, 0x1c, 0xc6, 0xfb, 0xdb, 0xa9, 0x79,
-	0xa7, 0x83, 0xbc, 0x65, 0x8d, 0xb9, 0x93, 0xb3, 0x00, 0x06, 0x91, 0x32, 0xd2, 0x2f, 0x78, 0x2a,
-	0x3e, 0x36, 0xc9, 0xa9, 0xd1, 0xd6, 0xff, 0x98, 0xa3, 0x9e, 0x05, 0xea, 0x0d, 0xb0, 0x1e, 0x62,
-	0xca, 0x58, 0x3f, 0xe5, 0xc1, 0x87, 0xbd, 0x12, 0xcc, 0x39, 0xcf, 0x88, 0x73, 0x06, 0xac, 0xde,
-	0xf1, 0x64, 0x8c, 0x9f, 0xf1, 0x54, 0xa6, 0x21, 0x5b, 0xbf, 0x98, 0x43, 0x9e, 0x05, 0x64, 0x48,
-	0x0f, 0x48, 0x06, 0x94, 0x51, 0x7e, 0xcd, 0x51, 0xca, 0xe5, 0x26, 0x76, 0xd0, 0xfb, 0x44, 0x99,
-	0xc1, 0x60, 0xcf, 0xcd, 0x60, 0x5c, 0xf3, 0x20, 0x37, 0xe8, 0xac, 0x07, 0x96, 0x31, 0x7f, 0xc1,
-	0x67, 0xb5, 0xf7, 0xb6, 0xc0, 0x47, 0x11, 0x64, 0x19, 0xf7, 0x7f, 0x79, 0x20, 0xdc, 0x8d, 0x0e,
-	0x3f, 0x11, 0xbd, 0xd6, 0x44, 0x87, 0xa6, 0xf5, 0xd8, 0xa6, 0x85, 0x01, 0xbb, 0xe4, 0xbb, 0xb1,
-	0x3b, 0x18, 0x62, 0x97, 0x07, 0x5b, 0x91, 0x74, 0x18, 0xbd, 0x1b, 0x9e, 0xae, 0x33, 0xb6, 0x73,
-	0x7c, 0x43, 0x5b, 0x0f, 0x96, 0xc0, 0xaa, 0x43, 0xa1, 0x0c, 0x32, 0x5d, 0xa4, 0x99, 0x0a, 0x5d,
-	0x4f, 0xda, 0xf4, 0x6d, 0x86, 0x14, 0x64, 0x2d, 0xed, 0x4b, 0xfa, 0xdd, 0x42, 0x04, 0x1f, 0x47,
-	0x23, 0x66, 0x35, 0xe8, 0xf0, 0x54, 0xa1, 0x3f, 0x28, 0xcc, 0x8b, 0xf0, 0x10, 0x45, 0x90, 0x40,
-	0x7e, 0x0c, 0x63, 0x56, 0x85, 0x27, 0x1c, 0x7d, 0x13, 0x7c, 0x7d, 0x8b, 0xa0, 0x36, 0x72, 0x48,
-	0x59, 0x6f, 0xd6, 0x1f, 0xa8, 0x08, 0x23, 0xad, 0x76, 0x61, 0x9a, 0x03, 0xc8, 0xdf, 0x52, 0x11,
-	0xb9, 0xfa, 0x0f, 0xb3, 0xf7, 0x7f, 0x0a, 0x2c, 0x1c, 0x3a, 0x0d, 0xf8, 0x2b, 0x48, 0xdd, 0xbd,
-	0xfd, 0x70, 0x47, 0x89, 0xba, 0x11, 0x2b, 0xc3, 0xf7, 0x5e, 0xe1, 0x8b, 0x58, 0x3d, 0x3f, 0x0a,
-	0xac, 0x02, 0x30, 0xd8, 0xd8, 0x70, 0xbc, 0x59, 0xf8, 0x02, 0x27, 0x14, 0xe2, 0x15, 0x59, 0x80,
-	0x1a, 0x58, 0x09, 0x14, 0x0d, 0x8e, 0x37, 0x1c, 0xba, 0xb8, 0x08, 0x5f, 0xde, 0x43, 0x93, 0xc5,
-	0x38, 0x02, 0x1f, 0xb0, 0xe9, 0x11, 0x7e, 0x36, 0x3e, 0xb1, 0x80, 0xef, 0xcf, 0x63, 0xb4, 0x98,
-	0xdf, 0x9f, 0xc1, 0x72, 0x7f, 0x60, 0x82, 0xe3, 0x4d, 0x82, 0x13, 0xaa, 0xb0, 0x13, 0xa7, 0x16,
-	0x72, 0x4d, 0xc7, 0x8f, 0x09, 0xae, 0x83, 0x63, 0xd9, 0x04, 0xd7, 0xa1, 0x29, 0x06, 0x92, 0xe0,
-	0x8d, 0x9b, 0x1d, 0xb5, 0x50, 0x89, 0x2b, 0x58, 0x78, 0xda, 0x11, 0xd4, 0x7b, 0xeb, 0xb3, 0xa8,
-	0x7f, 0xd2, 0x9b, 0xd2, 0xf0, 0x21, 0x05, 0xbf, 0x8a, 0xd9, 0x88, 0x23, 0x9d, 0x52, 0x28, 0x4e,
-	0x61, 0xc1, 0x62, 0xff, 0x05, 0xb2, 0x51, 0xdd, 0x19, 0x16, 0xe3, 0x1e, 0x62, 0x34, 0xfa, 0xde,
-	0x34, 0x26, 0x2c, 0xfc, 0xdf, 0x1c, 0xd8, 0x88, 0x6c, 0x4c, 0x70, 0x2f, 0x76, 0x0f, 0x8f, 0x66,
-	0xb0, 0x3f, 0x95, 0x4d, 0x88, 0xc0, 0x48, 0x33, 0x99, 0x40, 0x60, 0x5c, 0x93, 0x9c, 0x40, 0x60,
-	0x6c, 0xaf, 0x2a, 0xfd, 0x78, 0x79, 0x23, 0x26, 0xfe, 0xeb, 0x88, 0x89, 0xcb, 0x8e, 0xc8, 0x5d,
-	0x75, 0x44, 0xee, 0x55, 0x47, 0xe4, 0xfe, 0xb9, 0x15, 0x13, 0x57, 0xb7, 0x62, 0xe2, 0xfa, 0x56,
-	0x4c, 0xfc, 0xa2, 0x86, 0x0e, 0x90, 0x9e, 0xff, 0x5d, 0x7c, 0x72, 0x62, 0xd6, 0x4d, 0xbd, 0xc9,
-	0x7e, 0xab, 0xfd, 0x7f, 0x01, 0xe9, 0x69, 0x52, 0x5b, 0xa2, 0xff, 0xea, 0xed, 0xbf, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0x50, 0x76, 0xdc, 0x27, 0x22, 0x14, 0x00, 0x00,
+func (m *MsgTransferVaultRequest) Reset()         { *m = MsgTransferVaultRequest{} }
+func (m *MsgTransferVaultRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferVaultRequest) ProtoMessage()    {}
+func (*MsgTransferVaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{22}
+}
+func (m *MsgTransferVaultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferVaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferVaultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferVaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferVaultRequest.Merge(m, src)
+}
+func (m *MsgTransferVaultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferVaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferVaultRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgTransferVaultRequest proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	MsgCreate(ctx context.Context, in *MsgCreateRequest, opts ...grpc.CallOption) (*MsgCreateResponse, error)
-	MsgDeposit(ctx context.Context, in *MsgDepositRequest, opts ...grpc.CallOption) (*MsgDepositResponse, error)
-	MsgWithdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
-	MsgDraw(ctx context.Context, in *MsgDrawRequest, opts ...grpc.CallOption) (*MsgDrawResponse, error)
-	MsgRepay(ctx context.Context, in *MsgRepayRequest, opts ...grpc.CallOption) (*MsgRepayResponse, error)
-	MsgClose(ctx context.Context, in *MsgCloseRequest, opts ...grpc.CallOption) (*MsgCloseResponse, error)
-	MsgDepositAndDraw(ctx context.Context, in *MsgDepositAndDrawRequest, opts ...grpc.CallOption) (*MsgDepositAndDrawResponse, error)
-	MsgCreateStableMint(ctx context.Context, in *MsgCreateStableMintRequest, opts ...grpc.CallOption) (*MsgCreateStableMintResponse, error)
-	MsgDepositStableMint(ctx context.Context, in *MsgDepositStableMintRequest, opts ...grpc.CallOption) (*MsgDepositStableMintResponse, error)
-	MsgWithdrawStableMint(ctx context.Context, in *MsgWithdrawStableMintRequest, opts ...grpc.CallOption) (*MsgWithdrawStableMintResponse, error)
-	MsgVaultInterestCalc(ctx context.Context, in *MsgVaultInterestCalcRequest, opts ...grpc.CallOption) (*MsgVaultInterestCalcResponse, error)
+type MsgTransferVaultResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTransferVaultResponse) Reset()         { *m = MsgTransferVaultResponse{} }
+func (m *MsgTransferVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferVaultResponse) ProtoMessage()    {}
+func (*MsgTransferVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{23}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgTransferVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) MsgCreate(ctx context.Context, in *MsgCreateRequest, opts ...grpc.CallOption) (*MsgCreateResponse, error) {
-	out := new(MsgCreateResponse)
-	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgCreate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) MsgDeposit(ctx context.Context, in *MsgDepositRequest, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
-	out := new(MsgDepositResponse)
-	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgTransferVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferVaultResponse.Merge(m, src)
 }
-
-func (c *msgClient) MsgWithdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgWithdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgTransferVaultResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) MsgDraw(ctx context.Context, in *MsgDrawRequest, opts ...grpc.CallOption) (*MsgDrawResponse, error) {
-	out := new(MsgDrawResponse)
-	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgDraw", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgTransferVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferVaultResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) MsgRepay(ctx context.Context, in *MsgRepayRequest, opts ...grpc.CallOption) (*MsgRepayResponse, error) {
-	out := new(MsgRepayResponse)
-	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgRepay", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgTransferVaultResponse proto.InternalMessageInfo
 
-func (c *msgClient) MsgClose(ctx context.Context, in *MsgCloseRequest, opts ...grpc.CallOption) (*MsgCloseResponse, error) {
-	out := new(MsgCloseResponse)
-	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgClose", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgLeverageVaultRequest draws debt, swaps it into collateral and deposits it,
+// repeatedly, until the vault reaches target_cr.
+type MsgLeverageVaultRequest struct {
+	From                string                                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	AppId               uint64                                 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	ExtendedPairVaultId uint64                                 `protobuf:"varint,3,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	UserVaultId         uint64                                 `protobuf:"varint,4,opt,name=user_vault_id,json=userVaultId,proto3" json:"user_vault_id,omitempty" yaml:"user_vault_id"`
+	TargetCr            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=target_cr,json=targetCr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_cr" yaml:"target_cr"`
+	MaxSlippage         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage" yaml:"max_slippage"`
 }
 
-func (c *msgClient) MsgDepositAndDraw(ctx context.Context, in *MsgDepositAndDrawRequest, opts ...grpc.CallOption) (*MsgDepositAndDrawResponse, error) {
-	out := new(MsgDepositAndDrawResponse)
-	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgDepositAndDraw", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgLeverageVaultRequest) Reset()         { *m = MsgLeverageVaultRequest{} }
+func (m *MsgLeverageVaultRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgLeverageVaultRequest) ProtoMessage()    {}
+func (*MsgLeverageVaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{24}
 }
-
-func (c *msgClient) MsgCreateStableMint(ctx context.Context, in *MsgCreateStableMintRequest, opts ...grpc.CallOption) (*MsgCreateStableMintResponse, error) {
-	out := new(MsgCreateStableMintResponse)
-	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgCreateStableMint", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgLeverageVaultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) MsgDepositStableMint(ctx context.Context, in *MsgDepositStableMintRequest, opts ...grpc.CallOption) (*MsgDepositStableMintResponse, error) {
-	out := new(MsgDepositStableMintResponse)
-	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgDepositStableMint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgLeverageVaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeverageVaultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) MsgWithdrawStableMint(ctx context.Context, in *MsgWithdrawStableMintRequest, opts ...grpc.CallOption) (*MsgWithdrawStableMintResponse, error) {
-	out := new(MsgWithdrawStableMintResponse)
-	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgWithdrawStableMint", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgLeverageVaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeverageVaultRequest.Merge(m, src)
 }
-
-func (c *msgClient) MsgVaultInterestCalc(ctx context.Context, in *MsgVaultInterestCalcRequest, opts ...grpc.CallOption) (*MsgVaultInterestCalcResponse, error) {
-	out := new(MsgVaultInterestCalcResponse)
-	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgVaultInterestCalc", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgLeverageVaultRequest) XXX_Size() int {
+	return m.Size()
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	MsgCreate(context.Context, *MsgCreateRequest) (*MsgCreateResponse, error)
-	MsgDeposit(context.Context, *MsgDepositRequest) (*MsgDepositResponse, error)
-	MsgWithdraw(context.Context, *MsgWithdrawRequest) (*MsgWithdrawResponse, error)
-	MsgDraw(context.Context, *MsgDrawRequest) (*MsgDrawResponse, error)
-	MsgRepay(context.Context, *MsgRepayRequest) (*MsgRepayResponse, error)
-	MsgClose(context.Context, *MsgCloseRequest) (*MsgCloseResponse, error)
-	MsgDepositAndDraw(context.Context, *MsgDepositAndDrawRequest) (*MsgDepositAndDrawResponse, error)
-	MsgCreateStableMint(context.Context, *MsgCreateStableMintRequest) (*MsgCreateStableMintResponse, error)
-	MsgDepositStableMint(context.Context, *MsgDepositStableMintRequest) (*MsgDepositStableMintResponse, error)
-	MsgWithdrawStableMint(context.Context, *MsgWithdrawStableMintRequest) (*MsgWithdrawStableMintResponse, error)
-	MsgVaultInterestCalc(context.Context, *MsgVaultInterestCalcRequest) (*MsgVaultInterestCalcResponse, error)
+func (m *MsgLeverageVaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeverageVaultRequest.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgLeverageVaultRequest proto.InternalMessageInfo
+
+type MsgLeverageVaultResponse struct {
 }
 
-func (*UnimplementedMsgServer) MsgCreate(ctx context.Context, req *MsgCreateRequest) (*MsgCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgCreate not implemented")
+func (m *MsgLeverageVaultResponse) Reset()         { *m = MsgLeverageVaultResponse{} }
+func (m *MsgLeverageVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLeverageVaultResponse) ProtoMessage()    {}
+func (*MsgLeverageVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{25}
 }
-func (*UnimplementedMsgServer) MsgDeposit(ctx context.Context, req *MsgDepositRequest) (*MsgDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgDeposit not implemented")
+func (m *MsgLeverageVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) MsgWithdraw(ctx context.Context, req *MsgWithdrawRequest) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgWithdraw not implemented")
+func (m *MsgLeverageVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeverageVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) MsgDraw(ctx context.Context, req *MsgDrawRequest) (*MsgDrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgDraw not implemented")
+func (m *MsgLeverageVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeverageVaultResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) MsgRepay(ctx context.Context, req *MsgRepayRequest) (*MsgRepayResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgRepay not implemented")
+func (m *MsgLeverageVaultResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) MsgClose(ctx context.Context, req *MsgCloseRequest) (*MsgCloseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgClose not implemented")
+func (m *MsgLeverageVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeverageVaultResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) MsgDepositAndDraw(ctx context.Context, req *MsgDepositAndDrawRequest) (*MsgDepositAndDrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgDepositAndDraw not implemented")
+
+var xxx_messageInfo_MsgLeverageVaultResponse proto.InternalMessageInfo
+
+// MsgDeleverageVaultRequest sells collateral and repays debt with the proceeds,
+// repeatedly, until the vault reaches target_cr.
+type MsgDeleverageVaultRequest struct {
+	From                string                                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	AppId               uint64                                 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	ExtendedPairVaultId uint64                                 `protobuf:"varint,3,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	UserVaultId         uint64                                 `protobuf:"varint,4,opt,name=user_vault_id,json=userVaultId,proto3" json:"user_vault_id,omitempty" yaml:"user_vault_id"`
+	TargetCr            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=target_cr,json=targetCr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_cr" yaml:"target_cr"`
+	MaxSlippage         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage" yaml:"max_slippage"`
 }
-func (*UnimplementedMsgServer) MsgCreateStableMint(ctx context.Context, req *MsgCreateStableMintRequest) (*MsgCreateStableMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgCreateStableMint not implemented")
+
+func (m *MsgDeleverageVaultRequest) Reset()         { *m = MsgDeleverageVaultRequest{} }
+func (m *MsgDeleverageVaultRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleverageVaultRequest) ProtoMessage()    {}
+func (*MsgDeleverageVaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{26}
 }
-func (*UnimplementedMsgServer) MsgDepositStableMint(ctx context.Context, req *MsgDepositStableMintRequest) (*MsgDepositStableMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgDepositStableMint not implemented")
+func (m *MsgDeleverageVaultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) MsgWithdrawStableMint(ctx context.Context, req *MsgWithdrawStableMintRequest) (*MsgWithdrawStableMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgWithdrawStableMint not implemented")
+func (m *MsgDeleverageVaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleverageVaultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) MsgVaultInterestCalc(ctx context.Context, req *MsgVaultInterestCalcRequest) (*MsgVaultInterestCalcResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgVaultInterestCalc not implemented")
+func (m *MsgDeleverageVaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleverageVaultRequest.Merge(m, src)
+}
+func (m *MsgDeleverageVaultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleverageVaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleverageVaultRequest.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgDeleverageVaultRequest proto.InternalMessageInfo
+
+type MsgDeleverageVaultResponse struct {
 }
 
-func _Msg_MsgCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MsgCreate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.vault.v1beta1.Msg/MsgCreate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MsgCreate(ctx, req.(*MsgCreateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgDeleverageVaultResponse) Reset()         { *m = MsgDeleverageVaultResponse{} }
+func (m *MsgDeleverageVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleverageVaultResponse) ProtoMessage()    {}
+func (*MsgDeleverageVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{27}
 }
-
-func _Msg_MsgDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MsgDeposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.vault.v1beta1.Msg/MsgDeposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MsgDeposit(ctx, req.(*MsgDepositRequest))
+func (m *MsgDeleverageVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleverageVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleverageVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeleverageVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleverageVaultResponse.Merge(m, src)
+}
+func (m *MsgDeleverageVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleverageVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleverageVaultResponse.DiscardUnknown(m)
 }
 
-func _Msg_MsgWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MsgWithdraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.vault.v1beta1.Msg/MsgWithdraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MsgWithdraw(ctx, req.(*MsgWithdrawRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgDeleverageVaultResponse proto.InternalMessageInfo
+
+// MsgRedeemRequest burns amount of the minted asset of the extended pair
+// vault against the lowest collateralized vaults for their collateral.
+type MsgRedeemRequest struct {
+	From                string                                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	AppId               uint64                                 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	ExtendedPairVaultId uint64                                 `protobuf:"varint,3,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	Amount              github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	MaxFeeRate          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_fee_rate,json=maxFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_fee_rate" yaml:"max_fee_rate"`
 }
 
-func _Msg_MsgDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDrawRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MsgDraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.vault.v1beta1.Msg/MsgDraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MsgDraw(ctx, req.(*MsgDrawRequest))
+func (m *MsgRedeemRequest) Reset()         { *m = MsgRedeemRequest{} }
+func (m *MsgRedeemRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemRequest) ProtoMessage()    {}
+func (*MsgRedeemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{28}
+}
+func (m *MsgRedeemRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRedeemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemRequest.Merge(m, src)
+}
+func (m *MsgRedeemRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemRequest.DiscardUnknown(m)
 }
 
-func _Msg_MsgRepay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRepayRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MsgRepay(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.vault.v1beta1.Msg/MsgRepay",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MsgRepay(ctx, req.(*MsgRepayRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRedeemRequest proto.InternalMessageInfo
+
+type MsgRedeemResponse struct {
 }
 
-func _Msg_MsgClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCloseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MsgClose(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.vault.v1beta1.Msg/MsgClose",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MsgClose(ctx, req.(*MsgCloseRequest))
+func (m *MsgRedeemResponse) Reset()         { *m = MsgRedeemResponse{} }
+func (m *MsgRedeemResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemResponse) ProtoMessage()    {}
+func (*MsgRedeemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{29}
+}
+func (m *MsgRedeemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRedeemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemResponse.Merge(m, src)
+}
+func (m *MsgRedeemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemResponse.DiscardUnknown(m)
 }
 
-func _Msg_MsgDepositAndDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositAndDrawRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MsgDepositAndDraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.vault.v1beta1.Msg/MsgDepositAndDraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MsgDepositAndDraw(ctx, req.(*MsgDepositAndDrawRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRedeemResponse proto.InternalMessageInfo
+
+// MsgGrantPositionManagerRequest lets manager act on the vaults of from
+// within permissions, replacing any previous grant to manager.
+type MsgGrantPositionManagerRequest struct {
+	From        string               `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	Manager     string               `protobuf:"bytes,2,opt,name=manager,proto3" json:"manager,omitempty" yaml:"manager"`
+	Permissions []PositionPermission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=comdex.vault.v1beta1.PositionPermission" json:"permissions,omitempty" yaml:"permissions"`
 }
 
-func _Msg_MsgCreateStableMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateStableMintRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MsgCreateStableMint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.vault.v1beta1.Msg/MsgCreateStableMint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MsgCreateStableMint(ctx, req.(*MsgCreateStableMintRequest))
+func (m *MsgGrantPositionManagerRequest) Reset()         { *m = MsgGrantPositionManagerRequest{} }
+func (m *MsgGrantPositionManagerRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantPositionManagerRequest) ProtoMessage()    {}
+func (*MsgGrantPositionManagerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{30}
+}
+func (m *MsgGrantPositionManagerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantPositionManagerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantPositionManagerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGrantPositionManagerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantPositionManagerRequest.Merge(m, src)
+}
+func (m *MsgGrantPositionManagerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantPositionManagerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantPositionManagerRequest.DiscardUnknown(m)
 }
 
-func _Msg_MsgDepositStableMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositStableMintRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MsgDepositStableMint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.vault.v1beta1.Msg/MsgDepositStableMint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MsgDepositStableMint(ctx, req.(*MsgDepositStableMintRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgGrantPositionManagerRequest proto.InternalMessageInfo
+
+type MsgGrantPositionManagerResponse struct {
 }
 
-func _Msg_MsgWithdrawStableMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawStableMintRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MsgWithdrawStableMint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.vault.v1beta1.Msg/MsgWithdrawStableMint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MsgWithdrawStableMint(ctx, req.(*MsgWithdrawStableMintRequest))
+func (m *MsgGrantPositionManagerResponse) Reset()         { *m = MsgGrantPositionManagerResponse{} }
+func (m *MsgGrantPositionManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantPositionManagerResponse) ProtoMessage()    {}
+func (*MsgGrantPositionManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{31}
+}
+func (m *MsgGrantPositionManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantPositionManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantPositionManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGrantPositionManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantPositionManagerResponse.Merge(m, src)
+}
+func (m *MsgGrantPositionManagerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantPositionManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantPositionManagerResponse.DiscardUnknown(m)
 }
 
-func _Msg_MsgVaultInterestCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVaultInterestCalcRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MsgVaultInterestCalc(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comdex.vault.v1beta1.Msg/MsgVaultInterestCalc",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MsgVaultInterestCalc(ctx, req.(*MsgVaultInterestCalcRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgGrantPositionManagerResponse proto.InternalMessageInfo
+
+type MsgRevokePositionManagerRequest struct {
+	From    string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	Manager string `protobuf:"bytes,2,opt,name=manager,proto3" json:"manager,omitempty" yaml:"manager"`
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "comdex.vault.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MsgCreate",
-			Handler:    _Msg_MsgCreate_Handler,
-		},
-		{
-			MethodName: "MsgDeposit",
-			Handler:    _Msg_MsgDeposit_Handler,
-		},
-		{
-			MethodName: "MsgWithdraw",
-			Handler:    _Msg_MsgWithdraw_Handler,
-		},
-		{
-			MethodName: "MsgDraw",
-			Handler:    _Msg_MsgDraw_Handler,
-		},
-		{
-			MethodName: "MsgRepay",
-			Handler:    _Msg_MsgRepay_Handler,
-		},
-		{
-			MethodName: "MsgClose",
-			Handler:    _Msg_MsgClose_Handler,
-		},
-		{
-			MethodName: "MsgDepositAndDraw",
-			Handler:    _Msg_MsgDepositAndDraw_Handler,
-		},
-		{
-			MethodName: "MsgCreateStableMint",
-			Handler:    _Msg_MsgCreateStableMint_Handler,
-		},
-		{
-			MethodName: "MsgDepositStableMint",
-			Handler:    _Msg_MsgDepositStableMint_Handler,
-		},
-		{
-			MethodName: "MsgWithdrawStableMint",
-			Handler:    _Msg_MsgWithdrawStableMint_Handler,
-		},
-		{
-			MethodName: "MsgVaultInterestCalc",
-			Handler:    _Msg_MsgVaultInterestCalc_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "comdex/vault/v1beta1/tx.proto",
+func (m *MsgRevokePositionManagerRequest) Reset()         { *m = MsgRevokePositionManagerRequest{} }
+func (m *MsgRevokePositionManagerRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePositionManagerRequest) ProtoMessage()    {}
+func (*MsgRevokePositionManagerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{32}
 }
-
-func (m *MsgCreateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokePositionManagerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePositionManagerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePositionManagerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevokePositionManagerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePositionManagerRequest.Merge(m, src)
+}
+func (m *MsgRevokePositionManagerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePositionManagerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePositionManagerRequest.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokePositionManagerRequest proto.InternalMessageInfo
+
+type MsgRevokePositionManagerResponse struct {
 }
 
-func (m *MsgCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.AmountOut.Size()
-		i -= size
-		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.AmountIn.Size()
-		i -= size
-		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgRevokePositionManagerResponse) Reset()         { *m = MsgRevokePositionManagerResponse{} }
+func (m *MsgRevokePositionManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePositionManagerResponse) ProtoMessage()    {}
+func (*MsgRevokePositionManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{33}
+}
+func (m *MsgRevokePositionManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePositionManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePositionManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.ExtendedPairVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokePositionManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePositionManagerResponse.Merge(m, src)
 }
-
-func (m *MsgCreateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokePositionManagerResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokePositionManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePositionManagerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDepositRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgRevokePositionManagerResponse proto.InternalMessageInfo
 
-func (m *MsgDepositRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetVaultProtectionRequest sets the protection of a vault, replacing any
+// previous one. max_slippage is only used to sell collateral.
+type MsgSetVaultProtectionRequest struct {
+	From                string                                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	AppId               uint64                                 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	ExtendedPairVaultId uint64                                 `protobuf:"varint,3,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	UserVaultId         uint64                                 `protobuf:"varint,4,opt,name=user_vault_id,json=userVaultId,proto3" json:"user_vault_id,omitempty" yaml:"user_vault_id"`
+	TriggerCr           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=trigger_cr,json=triggerCr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_cr" yaml:"trigger_cr"`
+	TargetCr            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=target_cr,json=targetCr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_cr" yaml:"target_cr"`
+	Action              ProtectionAction                       `protobuf:"varint,7,opt,name=action,proto3,enum=comdex.vault.v1beta1.ProtectionAction" json:"action,omitempty" yaml:"action"`
+	MaxAmount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=max_amount,json=maxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount" yaml:"max_amount"`
+	MaxSlippage         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage" yaml:"max_slippage"`
 }
 
-func (m *MsgDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetVaultProtectionRequest) Reset()         { *m = MsgSetVaultProtectionRequest{} }
+func (m *MsgSetVaultProtectionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVaultProtectionRequest) ProtoMessage()    {}
+func (*MsgSetVaultProtectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{34}
+}
+func (m *MsgSetVaultProtectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVaultProtectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVaultProtectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.UserVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExtendedPairVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetVaultProtectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVaultProtectionRequest.Merge(m, src)
 }
-
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetVaultProtectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVaultProtectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVaultProtectionRequest.DiscardUnknown(m)
 }
 
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetVaultProtectionRequest proto.InternalMessageInfo
+
+type MsgSetVaultProtectionResponse struct {
 }
 
-func (m *MsgWithdrawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetVaultProtectionResponse) Reset()         { *m = MsgSetVaultProtectionResponse{} }
+func (m *MsgSetVaultProtectionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVaultProtectionResponse) ProtoMessage()    {}
+func (*MsgSetVaultProtectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{35}
+}
+func (m *MsgSetVaultProtectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVaultProtectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVaultProtectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetVaultProtectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVaultProtectionResponse.Merge(m, src)
+}
+func (m *MsgSetVaultProtectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVaultProtectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVaultProtectionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.UserVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExtendedPairVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_MsgSetVaultProtectionResponse proto.InternalMessageInfo
 
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MsgRemoveVaultProtectionRequest struct {
+	From        string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	UserVaultId uint64 `protobuf:"varint,2,opt,name=user_vault_id,json=userVaultId,proto3" json:"user_vault_id,omitempty" yaml:"user_vault_id"`
 }
 
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveVaultProtectionRequest) Reset()         { *m = MsgRemoveVaultProtectionRequest{} }
+func (m *MsgRemoveVaultProtectionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveVaultProtectionRequest) ProtoMessage()    {}
+func (*MsgRemoveVaultProtectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{36}
 }
-
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveVaultProtectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgDrawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveVaultProtectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveVaultProtectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveVaultProtectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveVaultProtectionRequest.Merge(m, src)
+}
+func (m *MsgRemoveVaultProtectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveVaultProtectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveVaultProtectionRequest.DiscardUnknown(m)
 }
 
-func (m *MsgDrawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveVaultProtectionRequest proto.InternalMessageInfo
+
+type MsgRemoveVaultProtectionResponse struct {
 }
 
-func (m *MsgDrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgRemoveVaultProtectionResponse) Reset()         { *m = MsgRemoveVaultProtectionResponse{} }
+func (m *MsgRemoveVaultProtectionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveVaultProtectionResponse) ProtoMessage()    {}
+func (*MsgRemoveVaultProtectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{37}
+}
+func (m *MsgRemoveVaultProtectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveVaultProtectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveVaultProtectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.UserVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExtendedPairVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveVaultProtectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveVaultProtectionResponse.Merge(m, src)
 }
-
-func (m *MsgDrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveVaultProtectionResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgDrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveVaultProtectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveVaultProtectionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRepayRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgRemoveVaultProtectionResponse proto.InternalMessageInfo
 
-func (m *MsgRepayRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgDepositCollateralRequest struct {
+	From                string     `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	AppId               uint64     `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	ExtendedPairVaultId uint64     `protobuf:"varint,3,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	UserVaultId         uint64     `protobuf:"varint,4,opt,name=user_vault_id,json=userVaultId,proto3" json:"user_vault_id,omitempty" yaml:"user_vault_id"`
+	Amount              types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount" yaml:"amount"`
 }
 
-func (m *MsgRepayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgDepositCollateralRequest) Reset()         { *m = MsgDepositCollateralRequest{} }
+func (m *MsgDepositCollateralRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositCollateralRequest) ProtoMessage()    {}
+func (*MsgDepositCollateralRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{38}
+}
+func (m *MsgDepositCollateralRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositCollateralRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositCollateralRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.UserVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExtendedPairVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRepayResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgDepositCollateralRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositCollateralRequest.Merge(m, src)
 }
-
-func (m *MsgRepayResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDepositCollateralRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositCollateralRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositCollateralRequest.DiscardUnknown(m)
 }
 
-func (m *MsgRepayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgDepositCollateralRequest proto.InternalMessageInfo
+
+type MsgDepositCollateralResponse struct {
 }
 
-func (m *MsgCloseRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDepositCollateralResponse) Reset()         { *m = MsgDepositCollateralResponse{} }
+func (m *MsgDepositCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositCollateralResponse) ProtoMessage()    {}
+func (*MsgDepositCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{39}
+}
+func (m *MsgDepositCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDepositCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositCollateralResponse.Merge(m, src)
+}
+func (m *MsgDepositCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositCollateralResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCloseRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDepositCollateralResponse proto.InternalMessageInfo
+
+type MsgWithdrawCollateralRequest struct {
+	From                string     `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	AppId               uint64     `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	ExtendedPairVaultId uint64     `protobuf:"varint,3,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	UserVaultId         uint64     `protobuf:"varint,4,opt,name=user_vault_id,json=userVaultId,proto3" json:"user_vault_id,omitempty" yaml:"user_vault_id"`
+	Amount              types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount" yaml:"amount"`
 }
 
-func (m *MsgCloseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UserVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExtendedPairVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgWithdrawCollateralRequest) Reset()         { *m = MsgWithdrawCollateralRequest{} }
+func (m *MsgWithdrawCollateralRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawCollateralRequest) ProtoMessage()    {}
+func (*MsgWithdrawCollateralRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{40}
+}
+func (m *MsgWithdrawCollateralRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawCollateralRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawCollateralRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawCollateralRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawCollateralRequest.Merge(m, src)
+}
+func (m *MsgWithdrawCollateralRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawCollateralRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawCollateralRequest.DiscardUnknown(m)
 }
 
-func (m *MsgCloseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawCollateralRequest proto.InternalMessageInfo
+
+type MsgWithdrawCollateralResponse struct {
+}
+
+func (m *MsgWithdrawCollateralResponse) Reset()         { *m = MsgWithdrawCollateralResponse{} }
+func (m *MsgWithdrawCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawCollateralResponse) ProtoMessage()    {}
+func (*MsgWithdrawCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7a3c3b9b1a607e, []int{41}
+}
+func (m *MsgWithdrawCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgWithdrawCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawCollateralResponse.Merge(m, src)
+}
+func (m *MsgWithdrawCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawCollateralResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCloseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgWithdrawCollateralResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateRequest)(nil), "comdex.vault.v1beta1.MsgCreateRequest")
+	proto.RegisterType((*MsgCreateResponse)(nil), "comdex.vault.v1beta1.MsgCreateResponse")
+	proto.RegisterType((*MsgDepositRequest)(nil), "comdex.vault.v1beta1.MsgDepositRequest")
+	proto.RegisterType((*MsgDepositResponse)(nil), "comdex.vault.v1beta1.MsgDepositResponse")
+	proto.RegisterType((*MsgWithdrawRequest)(nil), "comdex.vault.v1beta1.MsgWithdrawRequest")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "comdex.vault.v1beta1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgDrawRequest)(nil), "comdex.vault.v1beta1.MsgDrawRequest")
+	proto.RegisterType((*MsgDrawResponse)(nil), "comdex.vault.v1beta1.MsgDrawResponse")
+	proto.RegisterType((*MsgRepayRequest)(nil), "comdex.vault.v1beta1.MsgRepayRequest")
+	proto.RegisterType((*MsgRepayResponse)(nil), "comdex.vault.v1beta1.MsgRepayResponse")
+	proto.RegisterType((*MsgCloseRequest)(nil), "comdex.vault.v1beta1.MsgCloseRequest")
+	proto.RegisterType((*MsgCloseResponse)(nil), "comdex.vault.v1beta1.MsgCloseResponse")
+	proto.RegisterType((*MsgDepositAndDrawRequest)(nil), "comdex.vault.v1beta1.MsgDepositAndDrawRequest")
+	proto.RegisterType((*MsgDepositAndDrawResponse)(nil), "comdex.vault.v1beta1.MsgDepositAndDrawResponse")
+	proto.RegisterType((*MsgCreateStableMintRequest)(nil), "comdex.vault.v1beta1.MsgCreateStableMintRequest")
+	proto.RegisterType((*MsgCreateStableMintResponse)(nil), "comdex.vault.v1beta1.MsgCreateStableMintResponse")
+	proto.RegisterType((*MsgDepositStableMintRequest)(nil), "comdex.vault.v1beta1.MsgDepositStableMintRequest")
+	proto.RegisterType((*MsgDepositStableMintResponse)(nil), "comdex.vault.v1beta1.MsgDepositStableMintResponse")
+	proto.RegisterType((*MsgWithdrawStableMintRequest)(nil), "comdex.vault.v1beta1.MsgWithdrawStableMintRequest")
+	proto.RegisterType((*MsgWithdrawStableMintResponse)(nil), "comdex.vault.v1beta1.MsgWithdrawStableMintResponse")
+	proto.RegisterType((*MsgVaultInterestCalcRequest)(nil), "comdex.vault.v1beta1.MsgVaultInterestCalcRequest")
+	proto.RegisterType((*MsgVaultInterestCalcResponse)(nil), "comdex.vault.v1beta1.MsgVaultInterestCalcResponse")
+	proto.RegisterType((*MsgTransferVaultRequest)(nil), "comdex.vault.v1beta1.MsgTransferVaultRequest")
+	proto.RegisterType((*MsgTransferVaultResponse)(nil), "comdex.vault.v1beta1.MsgTransferVaultResponse")
+	proto.RegisterType((*MsgLeverageVaultRequest)(nil), "comdex.vault.v1beta1.MsgLeverageVaultRequest")
+	proto.RegisterType((*MsgLeverageVaultResponse)(nil), "comdex.vault.v1beta1.MsgLeverageVaultResponse")
+	proto.RegisterType((*MsgDeleverageVaultRequest)(nil), "comdex.vault.v1beta1.MsgDeleverageVaultRequest")
+	proto.RegisterType((*MsgDeleverageVaultResponse)(nil), "comdex.vault.v1beta1.MsgDeleverageVaultResponse")
+	proto.RegisterType((*MsgRedeemRequest)(nil), "comdex.vault.v1beta1.MsgRedeemRequest")
+	proto.RegisterType((*MsgRedeemResponse)(nil), "comdex.vault.v1beta1.MsgRedeemResponse")
+	proto.RegisterType((*MsgGrantPositionManagerRequest)(nil), "comdex.vault.v1beta1.MsgGrantPositionManagerRequest")
+	proto.RegisterType((*MsgGrantPositionManagerResponse)(nil), "comdex.vault.v1beta1.MsgGrantPositionManagerResponse")
+	proto.RegisterType((*MsgRevokePositionManagerRequest)(nil), "comdex.vault.v1beta1.MsgRevokePositionManagerRequest")
+	proto.RegisterType((*MsgRevokePositionManagerResponse)(nil), "comdex.vault.v1beta1.MsgRevokePositionManagerResponse")
+	proto.RegisterType((*MsgSetVaultProtectionRequest)(nil), "comdex.vault.v1beta1.MsgSetVaultProtectionRequest")
+	proto.RegisterType((*MsgSetVaultProtectionResponse)(nil), "comdex.vault.v1beta1.MsgSetVaultProtectionResponse")
+	proto.RegisterType((*MsgRemoveVaultProtectionRequest)(nil), "comdex.vault.v1beta1.MsgRemoveVaultProtectionRequest")
+	proto.RegisterType((*MsgRemoveVaultProtectionResponse)(nil), "comdex.vault.v1beta1.MsgRemoveVaultProtectionResponse")
+	proto.RegisterType((*MsgDepositCollateralRequest)(nil), "comdex.vault.v1beta1.MsgDepositCollateralRequest")
+	proto.RegisterType((*MsgDepositCollateralResponse)(nil), "comdex.vault.v1beta1.MsgDepositCollateralResponse")
+	proto.RegisterType((*MsgWithdrawCollateralRequest)(nil), "comdex.vault.v1beta1.MsgWithdrawCollateralRequest")
+	proto.RegisterType((*MsgWithdrawCollateralResponse)(nil), "comdex.vault.v1beta1.MsgWithdrawCollateralResponse")
 }
 
-func (m *MsgCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("comdex/vault/v1beta1/tx.proto", fileDescriptor_4b7a3c3b9b1a607e) }
+
+var fileDescriptor_4b7a3c3b9b1a607e = []byte{
+	// 1581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0xe9, 0x8f, 0x58, 0xe3, 0x67, 0xc7, 0x5e, 0x3b, 0x89, 0xc2, 0xc4, 0x92, 0xc3, 0xf7,
+	0x9e, 0xe3, 0x07, 0xbc, 0x48, 0xb1, 0xdc, 0xe4, 0x50, 0x14, 0x28, 0x22, 0xa5, 0x1f, 0x06, 0x6a,
+	0xd4, 0x61, 0x8a, 0x04, 0x2d, 0x0a, 0x08, 0x2b, 0x69, 0xcd, 0x10, 0x11, 0x49, 0x85, 0x5c, 0x39,
+	0x4e, 0x81, 0x02, 0x3d, 0x14, 0x05, 0x0a, 0xf4, 0xd0, 0x3f, 0xa0, 0xbd, 0x17, 0xfd, 0x4b, 0x52,
+	0xf4, 0x2b, 0xed, 0xa5, 0x41, 0x0f, 0x42, 0xe3, 0x9c, 0x7a, 0xd5, 0xa1, 0x97, 0x02, 0x45, 0xc1,
+	0xdd, 0x95, 0x44, 0x8a, 0xa4, 0x64, 0xc2, 0x76, 0x81, 0xc0, 0xba, 0xd8, 0xe4, 0xee, 0x7c, 0xed,
+	0x6f, 0x66, 0x87, 0x3b, 0xb3, 0x82, 0xe5, 0xaa, 0x6d, 0xd6, 0xc8, 0x5e, 0x7e, 0x17, 0x37, 0xeb,
+	0x34, 0xbf, 0xbb, 0x5e, 0x21, 0x14, 0xaf, 0xe7, 0xe9, 0x5e, 0xae, 0xe1, 0xd8, 0xd4, 0x46, 0x4b,
+	0x7c, 0x3a, 0xc7, 0xa6, 0x73, 0x62, 0x5a, 0x59, 0xd2, 0x6d, 0xdd, 0x66, 0x04, 0x79, 0xef, 0x89,
+	0xd3, 0x2a, 0x2b, 0x91, 0xa2, 0x38, 0x27, 0xa7, 0xc8, 0x54, 0x6d, 0xd7, 0xb4, 0xdd, 0x7c, 0x05,
+	0xbb, 0xa4, 0x4b, 0x50, 0xb5, 0x0d, 0x8b, 0xcf, 0xab, 0x7f, 0xc9, 0x30, 0xbf, 0xe5, 0xea, 0x25,
+	0x87, 0x60, 0x4a, 0x34, 0xf2, 0xa0, 0x49, 0x5c, 0x8a, 0xfe, 0x0d, 0x13, 0x3b, 0x8e, 0x6d, 0xa6,
+	0xa5, 0x15, 0x69, 0x2d, 0x55, 0x3c, 0xdd, 0x6e, 0x65, 0x67, 0x1e, 0x61, 0xb3, 0xfe, 0xb2, 0xea,
+	0x8d, 0xaa, 0x1a, 0x9b, 0x44, 0x6b, 0x30, 0x85, 0x1b, 0x8d, 0xb2, 0x51, 0x4b, 0xcb, 0x2b, 0xd2,
+	0xda, 0x44, 0x71, 0xa1, 0xdd, 0xca, 0xce, 0x72, 0x32, 0x3e, 0xae, 0x6a, 0x93, 0xb8, 0xd1, 0xd8,
+	0xac, 0xa1, 0x3b, 0x70, 0x96, 0xec, 0x51, 0x62, 0xd5, 0x48, 0xad, 0xdc, 0xc0, 0x86, 0x53, 0x66,
+	0x06, 0x7a, 0x9c, 0xe3, 0x8c, 0xf3, 0x52, 0xbb, 0x95, 0x5d, 0xe6, 0x9c, 0xd1, 0x74, 0xaa, 0xb6,
+	0xd8, 0x99, 0xd8, 0xc6, 0x86, 0x73, 0xc7, 0x1b, 0xde, 0xac, 0xa1, 0x32, 0xa4, 0xb0, 0x69, 0x37,
+	0x2d, 0x5a, 0x36, 0xac, 0xf4, 0x04, 0xb3, 0xb5, 0xf8, 0xb8, 0x95, 0x1d, 0xfb, 0xb5, 0x95, 0x5d,
+	0xd5, 0x0d, 0x7a, 0xaf, 0x59, 0xc9, 0x55, 0x6d, 0x33, 0x2f, 0x10, 0xe0, 0xff, 0xae, 0xb8, 0xb5,
+	0xfb, 0x79, 0xfa, 0xa8, 0x41, 0xdc, 0xdc, 0xa6, 0x45, 0xdb, 0xad, 0xec, 0xbc, 0x30, 0xb9, 0x23,
+	0x48, 0xd5, 0xa6, 0xf9, 0xf3, 0xa6, 0x85, 0x2a, 0x00, 0x62, 0xdc, 0x6e, 0xd2, 0xf4, 0x24, 0xd3,
+	0x50, 0x4a, 0xac, 0x61, 0x21, 0xa0, 0xc1, 0x6e, 0x52, 0x55, 0x13, 0x76, 0xbf, 0xdd, 0xa4, 0xea,
+	0x22, 0x2c, 0xf8, 0xf0, 0x77, 0x1b, 0xb6, 0xe5, 0x12, 0xf5, 0x27, 0x99, 0x8d, 0xde, 0x24, 0x0d,
+	0xdb, 0x35, 0xe8, 0x0b, 0xe6, 0x96, 0x57, 0x60, 0xb6, 0xe9, 0x12, 0x9f, 0xb8, 0x09, 0x26, 0x2e,
+	0xdd, 0x6e, 0x65, 0x97, 0xb8, 0xb8, 0xc0, 0xb4, 0xaa, 0xcd, 0x78, 0xef, 0x1d, 0xee, 0xbb, 0x30,
+	0xc5, 0xc1, 0x11, 0x78, 0xbf, 0x9a, 0x18, 0xef, 0x59, 0x3f, 0xde, 0xaa, 0x26, 0xc4, 0xa9, 0x4b,
+	0x80, 0xfc, 0x90, 0x0a, 0xa4, 0x7f, 0x96, 0xd9, 0xf0, 0x5d, 0x83, 0xde, 0xab, 0x39, 0xf8, 0xe1,
+	0x08, 0xea, 0xa3, 0x80, 0xfa, 0x0c, 0x2c, 0x06, 0x30, 0x15, 0x58, 0xff, 0x20, 0xc3, 0x9c, 0xe7,
+	0x82, 0x11, 0xce, 0x47, 0x84, 0xf3, 0x02, 0x9c, 0xee, 0xe2, 0x29, 0x30, 0xfe, 0x51, 0x66, 0x63,
+	0x1a, 0x69, 0xe0, 0x47, 0x23, 0x90, 0x8f, 0x02, 0x64, 0x04, 0xf3, 0x3d, 0x40, 0x05, 0xca, 0x7f,
+	0x48, 0x0c, 0xe5, 0x52, 0xdd, 0x76, 0xc9, 0x49, 0x42, 0x59, 0x80, 0x21, 0xd6, 0x2d, 0xc0, 0x78,
+	0x2a, 0x43, 0xba, 0x97, 0x59, 0x6f, 0x58, 0xb5, 0xd1, 0x06, 0x3f, 0xaa, 0xd8, 0xbb, 0x00, 0xe7,
+	0x23, 0x90, 0x15, 0xb8, 0x7f, 0x21, 0x83, 0xd2, 0x3d, 0x3a, 0xdc, 0xa6, 0xb8, 0x52, 0x27, 0x5b,
+	0x86, 0xf5, 0xa2, 0x9d, 0x16, 0x7a, 0xd8, 0x4d, 0x1c, 0x0e, 0xbb, 0x1b, 0x7d, 0xd8, 0x2d, 0xc3,
+	0x85, 0x48, 0x74, 0x04, 0x7a, 0xcf, 0x64, 0xb8, 0xd0, 0xc3, 0x76, 0x04, 0x5f, 0x5f, 0xe8, 0xa1,
+	0x22, 0x9c, 0x76, 0x19, 0x28, 0x3d, 0x4b, 0x27, 0x99, 0xa5, 0x4a, 0xbb, 0x95, 0x3d, 0xcb, 0x79,
+	0xfa, 0x08, 0x54, 0x6d, 0x96, 0x8f, 0x74, 0xb2, 0x45, 0x06, 0x2e, 0x46, 0x43, 0x2c, 0x7c, 0xb0,
+	0x2f, 0xc3, 0x45, 0xdf, 0x41, 0x61, 0xe4, 0x84, 0xe3, 0x70, 0x42, 0x16, 0x96, 0x63, 0x30, 0x16,
+	0x5e, 0xf8, 0x5a, 0x62, 0x3b, 0x81, 0xd3, 0x5b, 0x94, 0x38, 0xc4, 0xa5, 0x25, 0x5c, 0xaf, 0x1e,
+	0x93, 0x13, 0x42, 0xa9, 0x76, 0x3c, 0xc9, 0x07, 0x88, 0x87, 0x54, 0x84, 0xad, 0x62, 0x31, 0x5f,
+	0xca, 0x70, 0x6e, 0xcb, 0xd5, 0xdf, 0x71, 0xb0, 0xe5, 0xee, 0x08, 0xb6, 0x13, 0xf5, 0x2d, 0x5a,
+	0x06, 0x99, 0xda, 0xe2, 0x3b, 0x34, 0xdb, 0x6e, 0x65, 0x53, 0x9c, 0x85, 0xda, 0xaa, 0x26, 0x53,
+	0x5b, 0x55, 0x20, 0x1d, 0x86, 0x47, 0x60, 0xf7, 0xcd, 0x38, 0xc3, 0xee, 0x2d, 0xb2, 0x4b, 0x1c,
+	0xac, 0x93, 0x93, 0x87, 0x5d, 0x19, 0x52, 0x14, 0x3b, 0x3a, 0xa1, 0xe5, 0xaa, 0x93, 0x9e, 0x4c,
+	0xdc, 0x50, 0xb8, 0x49, 0xaa, 0xbd, 0x86, 0x42, 0x57, 0x90, 0xaa, 0x4d, 0xf3, 0xe7, 0x92, 0x83,
+	0xee, 0xc1, 0xbf, 0x4c, 0xbc, 0x57, 0x76, 0xeb, 0x46, 0xa3, 0x81, 0x75, 0x92, 0x9e, 0x62, 0x3a,
+	0x5e, 0x4b, 0xac, 0x63, 0x91, 0xeb, 0xf0, 0xcb, 0x52, 0xb5, 0x19, 0x13, 0xef, 0xdd, 0xee, 0xbc,
+	0x71, 0x3f, 0xf7, 0xb9, 0x52, 0xf8, 0xf9, 0xdb, 0x71, 0x71, 0xac, 0xa8, 0x8f, 0x3c, 0xfd, 0xe2,
+	0x7b, 0xfa, 0x22, 0x28, 0x51, 0xce, 0x14, 0xbe, 0xfe, 0x53, 0x16, 0xe5, 0x4b, 0x8d, 0x10, 0x73,
+	0xf4, 0x59, 0x65, 0x0f, 0x48, 0xe7, 0xce, 0xd9, 0x21, 0xa4, 0xec, 0x60, 0x4a, 0xd2, 0x93, 0x87,
+	0x77, 0x4e, 0x47, 0x96, 0xaa, 0x81, 0x89, 0xf7, 0x5e, 0x27, 0x44, 0xf3, 0x5e, 0x78, 0x73, 0xaf,
+	0x03, 0xbe, 0x70, 0xc9, 0x2f, 0x12, 0x64, 0xb6, 0x5c, 0xfd, 0x0d, 0x07, 0x5b, 0x74, 0xdb, 0x3b,
+	0x19, 0x19, 0xb6, 0xb5, 0x85, 0x2d, 0xac, 0x13, 0x27, 0x91, 0x83, 0xfe, 0x0f, 0xa7, 0x4c, 0xce,
+	0xc6, 0x3c, 0x94, 0x2a, 0xa2, 0x76, 0x2b, 0x3b, 0xd7, 0x31, 0x89, 0x4d, 0xa8, 0x5a, 0x87, 0x04,
+	0x55, 0x60, 0xa6, 0x41, 0x1c, 0xd3, 0x70, 0x5d, 0xc3, 0xb6, 0xdc, 0xf4, 0xf8, 0xca, 0xf8, 0xda,
+	0x5c, 0x61, 0x2d, 0x17, 0xd5, 0x6c, 0xce, 0x75, 0xac, 0xda, 0xee, 0x32, 0x14, 0xcf, 0xb6, 0x5b,
+	0x59, 0xc4, 0x65, 0xfb, 0xc4, 0xa8, 0x9a, 0x5f, 0xa8, 0x7a, 0x09, 0xb2, 0xb1, 0x0b, 0x13, 0x8b,
+	0xa7, 0x8c, 0x44, 0x23, 0xbb, 0xf6, 0x7d, 0xf2, 0x8f, 0x2d, 0x5e, 0x55, 0x61, 0x25, 0x5e, 0xab,
+	0xb0, 0xec, 0xf7, 0x49, 0x76, 0xb4, 0xb8, 0x4d, 0x28, 0x8b, 0xbf, 0x6d, 0xc7, 0xa6, 0xa4, 0xea,
+	0x11, 0x9e, 0xa8, 0xc4, 0x58, 0x01, 0xa0, 0x8e, 0xa1, 0xeb, 0xc4, 0xe9, 0x65, 0xc6, 0x52, 0xe2,
+	0x8d, 0x21, 0x5a, 0xde, 0x3d, 0x49, 0xaa, 0x96, 0x12, 0x2f, 0x25, 0x27, 0x98, 0x7c, 0xa7, 0x8e,
+	0x21, 0xf9, 0xde, 0x82, 0x29, 0xcc, 0x5c, 0x97, 0x3e, 0xb5, 0x22, 0xad, 0xcd, 0x15, 0x56, 0x63,
+	0xc2, 0xbc, 0xeb, 0xe2, 0x1b, 0xec, 0x6f, 0xc0, 0x59, 0x6c, 0xc4, 0x4b, 0x19, 0xec, 0xc1, 0xc3,
+	0xc5, 0xdb, 0xe6, 0x22, 0x1f, 0x4d, 0x1f, 0xee, 0x2a, 0xa0, 0x27, 0x49, 0xd5, 0x52, 0x26, 0xde,
+	0xe3, 0xa5, 0x6b, 0xe8, 0x9b, 0x91, 0x3a, 0xb6, 0x6f, 0x06, 0xaf, 0x09, 0xa2, 0x42, 0x5d, 0x6c,
+	0x86, 0x8f, 0x25, 0xb1, 0x4f, 0x4d, 0x7b, 0x97, 0x1c, 0x66, 0x3f, 0x84, 0xa2, 0x51, 0x4e, 0x72,
+	0xda, 0xef, 0xec, 0xdb, 0x48, 0x2b, 0x84, 0xa9, 0xdf, 0x05, 0x0a, 0xf9, 0x92, 0x5d, 0xaf, 0x63,
+	0x4a, 0x1c, 0x5c, 0x3f, 0x51, 0xdb, 0xf6, 0xcd, 0x40, 0x07, 0x6a, 0xa6, 0x70, 0x3e, 0xc7, 0x63,
+	0x23, 0xe7, 0xdd, 0xfb, 0x75, 0x03, 0xbe, 0x64, 0x1b, 0x56, 0xf1, 0x8c, 0x17, 0x4f, 0xf1, 0x2d,
+	0xa7, 0x40, 0xcd, 0xee, 0x47, 0x53, 0xc0, 0xfd, 0x7d, 0xb0, 0x66, 0x1f, 0xe1, 0x7d, 0x48, 0xbc,
+	0x83, 0xe5, 0x79, 0x18, 0xf0, 0xc2, 0x67, 0x8b, 0x30, 0xbe, 0xe5, 0xea, 0xe8, 0x7d, 0x48, 0x75,
+	0xfb, 0x59, 0x28, 0x26, 0xa3, 0xf5, 0xdf, 0xe4, 0x2a, 0x97, 0x87, 0xd2, 0x71, 0x2d, 0xa8, 0x0c,
+	0xd0, 0x73, 0x3b, 0x8a, 0x67, 0x0b, 0x5e, 0x49, 0x2a, 0x6b, 0xc3, 0x09, 0x85, 0x82, 0x0a, 0xcc,
+	0xf8, 0xd6, 0x89, 0xe2, 0x19, 0xfb, 0xae, 0xe2, 0x94, 0xff, 0x1d, 0x80, 0x52, 0xe8, 0xb8, 0x03,
+	0xa7, 0xc4, 0x7d, 0x08, 0xfa, 0x4f, 0xbc, 0x61, 0x3e, 0xd9, 0xff, 0x1d, 0x42, 0x25, 0xe4, 0xbe,
+	0x0b, 0xd3, 0x9d, 0x2b, 0x00, 0x14, 0xcf, 0xe2, 0xbf, 0x73, 0x51, 0x56, 0x87, 0x91, 0x05, 0x44,
+	0xb3, 0x86, 0xfa, 0x00, 0xd1, 0xfe, 0x8b, 0x06, 0x65, 0x75, 0x18, 0x99, 0x10, 0x4d, 0x61, 0x21,
+	0xd4, 0x3c, 0x46, 0xb9, 0x61, 0x0e, 0x0b, 0xf6, 0xef, 0x95, 0xfc, 0x81, 0xe9, 0x85, 0xd6, 0x0f,
+	0x60, 0xb1, 0x1b, 0x5d, 0xbd, 0x66, 0x13, 0xba, 0x3a, 0x24, 0x10, 0x43, 0xbd, 0x3f, 0x65, 0x3d,
+	0x01, 0x87, 0xd0, 0xfd, 0x21, 0x2c, 0x45, 0xf5, 0x1b, 0xd1, 0xfa, 0xb0, 0x45, 0x84, 0xb5, 0x17,
+	0x92, 0xb0, 0x08, 0xf5, 0x1f, 0x49, 0x70, 0x26, 0xb2, 0xd5, 0x86, 0x0a, 0x43, 0x63, 0x38, 0x6c,
+	0xc1, 0x46, 0x22, 0x9e, 0x00, 0x02, 0xa1, 0xf6, 0xd8, 0x00, 0x04, 0xe2, 0xda, 0x7e, 0x4a, 0x21,
+	0x09, 0x8b, 0x50, 0xff, 0x00, 0xe6, 0xfb, 0xbb, 0x4b, 0xe8, 0x4a, 0xac, 0x9c, 0xa8, 0x26, 0x9d,
+	0x92, 0x3b, 0x28, 0x79, 0x40, 0x65, 0xa0, 0xd1, 0x31, 0x40, 0x65, 0x54, 0x6f, 0x4b, 0xc9, 0x1d,
+	0x94, 0x5c, 0xa8, 0x7c, 0x08, 0x28, 0x5c, 0x71, 0xa3, 0x41, 0x3b, 0x25, 0xaa, 0xd1, 0xa2, 0x5c,
+	0x3d, 0x38, 0x83, 0x50, 0xcc, 0x3f, 0x01, 0xbc, 0x9c, 0x44, 0x83, 0x32, 0x8c, 0xaf, 0xd8, 0x57,
+	0x2e, 0x0f, 0xa5, 0x13, 0xd2, 0x3f, 0x91, 0xe0, 0x5c, 0x4c, 0xf9, 0x86, 0x5e, 0x8a, 0x15, 0x32,
+	0xa0, 0x8c, 0x55, 0xae, 0x25, 0xe4, 0x12, 0x86, 0x7c, 0x2a, 0x41, 0x3a, 0xae, 0x5c, 0x43, 0xd7,
+	0x06, 0x2c, 0x27, 0xbe, 0xa8, 0x54, 0xae, 0x27, 0x65, 0x0b, 0xee, 0xe9, 0xf0, 0x51, 0x79, 0xc0,
+	0x9e, 0x8e, 0x2d, 0x21, 0x95, 0x8d, 0x44, 0x3c, 0xfd, 0x70, 0x44, 0x9c, 0x82, 0x07, 0xc2, 0x11,
+	0x7f, 0x76, 0x57, 0xae, 0x27, 0x65, 0x8b, 0xca, 0xb0, 0xbd, 0xc3, 0xca, 0xf0, 0x0c, 0x1b, 0x3a,
+	0x27, 0x2a, 0x85, 0x24, 0x2c, 0xd1, 0x19, 0xd6, 0x67, 0xc0, 0xf0, 0x0c, 0x1b, 0xb6, 0x60, 0x23,
+	0x11, 0x0f, 0x37, 0xa1, 0x78, 0xeb, 0xf1, 0xb3, 0xcc, 0xd8, 0x57, 0xfb, 0x99, 0xb1, 0xc7, 0xfb,
+	0x19, 0xe9, 0xc9, 0x7e, 0x46, 0xfa, 0x6d, 0x3f, 0x23, 0x7d, 0xfe, 0x3c, 0x33, 0xf6, 0xe4, 0x79,
+	0x66, 0xec, 0xe9, 0xf3, 0xcc, 0xd8, 0x7b, 0xf9, 0x40, 0xa1, 0xe6, 0x29, 0xb8, 0x62, 0xef, 0xec,
+	0x18, 0x55, 0x03, 0xd7, 0xc5, 0x7b, 0xbe, 0xf3, 0x8b, 0x3d, 0x56, 0xb5, 0x55, 0xa6, 0xd8, 0x4f,
+	0xf1, 0x36, 0xfe, 0x1e, 0x00, 0xd2, 0x9e, 0xbd, 0xc3, 0x19, 0x28, 0x00, 0x00,
 }
 
-func (m *MsgDepositAndDrawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	MsgCreate(ctx context.Context, in *MsgCreateRequest, opts ...grpc.CallOption) (*MsgCreateResponse, error)
+	MsgDeposit(ctx context.Context, in *MsgDepositRequest, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	MsgWithdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	MsgDraw(ctx context.Context, in *MsgDrawRequest, opts ...grpc.CallOption) (*MsgDrawResponse, error)
+	MsgRepay(ctx context.Context, in *MsgRepayRequest, opts ...grpc.CallOption) (*MsgRepayResponse, error)
+	MsgClose(ctx context.Context, in *MsgCloseRequest, opts ...grpc.CallOption) (*MsgCloseResponse, error)
+	MsgDepositAndDraw(ctx context.Context, in *MsgDepositAndDrawRequest, opts ...grpc.CallOption) (*MsgDepositAndDrawResponse, error)
+	MsgCreateStableMint(ctx context.Context, in *MsgCreateStableMintRequest, opts ...grpc.CallOption) (*MsgCreateStableMintResponse, error)
+	MsgDepositStableMint(ctx context.Context, in *MsgDepositStableMintRequest, opts ...grpc.CallOption) (*MsgDepositStableMintResponse, error)
+	MsgWithdrawStableMint(ctx context.Context, in *MsgWithdrawStableMintRequest, opts ...grpc.CallOption) (*MsgWithdrawStableMintResponse, error)
+	MsgVaultInterestCalc(ctx context.Context, in *MsgVaultInterestCalcRequest, opts ...grpc.CallOption) (*MsgVaultInterestCalcResponse, error)
+	MsgTransferVault(ctx context.Context, in *MsgTransferVaultRequest, opts ...grpc.CallOption) (*MsgTransferVaultResponse, error)
+	MsgLeverageVault(ctx context.Context, in *MsgLeverageVaultRequest, opts ...grpc.CallOption) (*MsgLeverageVaultResponse, error)
+	MsgDeleverageVault(ctx context.Context, in *MsgDeleverageVaultRequest, opts ...grpc.CallOption) (*MsgDeleverageVaultResponse, error)
+	MsgRedeem(ctx context.Context, in *MsgRedeemRequest, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
+	MsgGrantPositionManager(ctx context.Context, in *MsgGrantPositionManagerRequest, opts ...grpc.CallOption) (*MsgGrantPositionManagerResponse, error)
+	MsgRevokePositionManager(ctx context.Context, in *MsgRevokePositionManagerRequest, opts ...grpc.CallOption) (*MsgRevokePositionManagerResponse, error)
+	MsgSetVaultProtection(ctx context.Context, in *MsgSetVaultProtectionRequest, opts ...grpc.CallOption) (*MsgSetVaultProtectionResponse, error)
+	MsgRemoveVaultProtection(ctx context.Context, in *MsgRemoveVaultProtectionRequest, opts ...grpc.CallOption) (*MsgRemoveVaultProtectionResponse, error)
+	MsgDepositCollateral(ctx context.Context, in *MsgDepositCollateralRequest, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error)
+	MsgWithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateralRequest, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) MsgCreate(ctx context.Context, in *MsgCreateRequest, opts ...grpc.CallOption) (*MsgCreateResponse, error) {
+	out := new(MsgCreateResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgCreate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDepositAndDrawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MsgDeposit(ctx context.Context, in *MsgDepositRequest, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDepositAndDrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.UserVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExtendedPairVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x10
+func (c *msgClient) MsgWithdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) MsgDraw(ctx context.Context, in *MsgDrawRequest, opts ...grpc.CallOption) (*MsgDrawResponse, error) {
+	out := new(MsgDrawResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgDraw", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDepositAndDrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MsgRepay(ctx context.Context, in *MsgRepayRequest, opts ...grpc.CallOption) (*MsgRepayResponse, error) {
+	out := new(MsgRepayResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgRepay", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDepositAndDrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MsgClose(ctx context.Context, in *MsgCloseRequest, opts ...grpc.CallOption) (*MsgCloseResponse, error) {
+	out := new(MsgCloseResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgClose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDepositAndDrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) MsgDepositAndDraw(ctx context.Context, in *MsgDepositAndDrawRequest, opts ...grpc.CallOption) (*MsgDepositAndDrawResponse, error) {
+	out := new(MsgDepositAndDrawResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgDepositAndDraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateStableMintRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MsgCreateStableMint(ctx context.Context, in *MsgCreateStableMintRequest, opts ...grpc.CallOption) (*MsgCreateStableMintResponse, error) {
+	out := new(MsgCreateStableMintResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgCreateStableMint", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateStableMintRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MsgDepositStableMint(ctx context.Context, in *MsgDepositStableMintRequest, opts ...grpc.CallOption) (*MsgDepositStableMintResponse, error) {
+	out := new(MsgDepositStableMintResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgDepositStableMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateStableMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.ExtendedPairVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) MsgWithdrawStableMint(ctx context.Context, in *MsgWithdrawStableMintRequest, opts ...grpc.CallOption) (*MsgWithdrawStableMintResponse, error) {
+	out := new(MsgWithdrawStableMintResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgWithdrawStableMint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateStableMintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MsgVaultInterestCalc(ctx context.Context, in *MsgVaultInterestCalcRequest, opts ...grpc.CallOption) (*MsgVaultInterestCalcResponse, error) {
+	out := new(MsgVaultInterestCalcResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgVaultInterestCalc", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateStableMintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateStableMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDepositStableMintRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MsgTransferVault(ctx context.Context, in *MsgTransferVaultRequest, opts ...grpc.CallOption) (*MsgTransferVaultResponse, error) {
+	out := new(MsgTransferVaultResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgTransferVault", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDepositStableMintRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgDepositStableMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StableVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StableVaultId))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.ExtendedPairVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) MsgLeverageVault(ctx context.Context, in *MsgLeverageVaultRequest, opts ...grpc.CallOption) (*MsgLeverageVaultResponse, error) {
+	out := new(MsgLeverageVaultResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgLeverageVault", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDepositStableMintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MsgDeleverageVault(ctx context.Context, in *MsgDeleverageVaultRequest, opts ...grpc.CallOption) (*MsgDeleverageVaultResponse, error) {
+	out := new(MsgDeleverageVaultResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgDeleverageVault", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDepositStableMintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDepositStableMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgWithdrawStableMintRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MsgRedeem(ctx context.Context, in *MsgRedeemRequest, opts ...grpc.CallOption) (*MsgRedeemResponse, error) {
+	out := new(MsgRedeemResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgRedeem", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWithdrawStableMintRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgWithdrawStableMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StableVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StableVaultId))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.ExtendedPairVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) MsgGrantPositionManager(ctx context.Context, in *MsgGrantPositionManagerRequest, opts ...grpc.CallOption) (*MsgGrantPositionManagerResponse, error) {
+	out := new(MsgGrantPositionManagerResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgGrantPositionManager", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgWithdrawStableMintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MsgRevokePositionManager(ctx context.Context, in *MsgRevokePositionManagerRequest, opts ...grpc.CallOption) (*MsgRevokePositionManagerResponse, error) {
+	out := new(MsgRevokePositionManagerResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgRevokePositionManager", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWithdrawStableMintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWithdrawStableMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgVaultInterestCalcRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MsgSetVaultProtection(ctx context.Context, in *MsgSetVaultProtectionRequest, opts ...grpc.CallOption) (*MsgSetVaultProtectionResponse, error) {
+	out := new(MsgSetVaultProtectionResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgSetVaultProtection", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgVaultInterestCalcRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgVaultInterestCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UserVaultId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AppId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) MsgRemoveVaultProtection(ctx context.Context, in *MsgRemoveVaultProtectionRequest, opts ...grpc.CallOption) (*MsgRemoveVaultProtectionResponse, error) {
+	out := new(MsgRemoveVaultProtectionResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgRemoveVaultProtection", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgVaultInterestCalcResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MsgDepositCollateral(ctx context.Context, in *MsgDepositCollateralRequest, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error) {
+	out := new(MsgDepositCollateralResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgDepositCollateral", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgVaultInterestCalcResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgVaultInterestCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) MsgWithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateralRequest, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error) {
+	out := new(MsgWithdrawCollateralResponse)
+	err := c.cc.Invoke(ctx, "/comdex.vault.v1beta1.Msg/MsgWithdrawCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	MsgCreate(context.Context, *MsgCreateRequest) (*MsgCreateResponse, error)
+	MsgDeposit(context.Context, *MsgDepositRequest) (*MsgDepositResponse, error)
+	MsgWithdraw(context.Context, *MsgWithdrawRequest) (*MsgWithdrawResponse, error)
+	MsgDraw(context.Context, *MsgDrawRequest) (*MsgDrawResponse, error)
+	MsgRepay(context.Context, *MsgRepayRequest) (*MsgRepayResponse, error)
+	MsgClose(context.Context, *MsgCloseRequest) (*MsgCloseResponse, error)
+	MsgDepositAndDraw(context.Context, *MsgDepositAndDrawRequest) (*MsgDepositAndDrawResponse, error)
+	MsgCreateStableMint(context.Context, *MsgCreateStableMintRequest) (*MsgCreateStableMintResponse, error)
+	MsgDepositStableMint(context.Context, *MsgDepositStableMintRequest) (*MsgDepositStableMintResponse, error)
+	MsgWithdrawStableMint(context.Context, *MsgWithdrawStableMintRequest) (*MsgWithdrawStableMintResponse, error)
+	MsgVaultInterestCalc(context.Context, *MsgVaultInterestCalcRequest) (*MsgVaultInterestCalcResponse, error)
+	MsgTransferVault(context.Context, *MsgTransferVaultRequest) (*MsgTransferVaultResponse, error)
+	MsgLeverageVault(context.Context, *MsgLeverageVaultRequest) (*MsgLeverageVaultResponse, error)
+	MsgDeleverageVault(context.Context, *MsgDeleverageVaultRequest) (*MsgDeleverageVaultResponse, error)
+	MsgRedeem(context.Context, *MsgRedeemRequest) (*MsgRedeemResponse, error)
+	MsgGrantPositionManager(context.Context, *MsgGrantPositionManagerRequest) (*MsgGrantPositionManagerResponse, error)
+	MsgRevokePositionManager(context.Context, *MsgRevokePositionManagerRequest) (*MsgRevokePositionManagerResponse, error)
+	MsgSetVaultProtection(context.Context, *MsgSetVaultProtectionRequest) (*MsgSetVaultProtectionResponse, error)
+	MsgRemoveVaultProtection(context.Context, *MsgRemoveVaultProtectionRequest) (*MsgRemoveVaultProtectionResponse, error)
+	MsgDepositCollateral(context.Context, *MsgDepositCollateralRequest) (*MsgDepositCollateralResponse, error)
+	MsgWithdrawCollateral(context.Context, *MsgWithdrawCollateralRequest) (*MsgWithdrawCollateralResponse, error)
 }
-func (m *MsgCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
-	}
-	if m.ExtendedPairVaultId != 0 {
-		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
-	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.AmountOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgCreateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedMsgServer) MsgCreate(ctx context.Context, req *MsgCreateRequest) (*MsgCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgCreate not implemented")
+}
+func (*UnimplementedMsgServer) MsgDeposit(ctx context.Context, req *MsgDepositRequest) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDeposit not implemented")
+}
+func (*UnimplementedMsgServer) MsgWithdraw(ctx context.Context, req *MsgWithdrawRequest) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgWithdraw not implemented")
+}
+func (*UnimplementedMsgServer) MsgDraw(ctx context.Context, req *MsgDrawRequest) (*MsgDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDraw not implemented")
+}
+func (*UnimplementedMsgServer) MsgRepay(ctx context.Context, req *MsgRepayRequest) (*MsgRepayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgRepay not implemented")
+}
+func (*UnimplementedMsgServer) MsgClose(ctx context.Context, req *MsgCloseRequest) (*MsgCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgClose not implemented")
+}
+func (*UnimplementedMsgServer) MsgDepositAndDraw(ctx context.Context, req *MsgDepositAndDrawRequest) (*MsgDepositAndDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDepositAndDraw not implemented")
+}
+func (*UnimplementedMsgServer) MsgCreateStableMint(ctx context.Context, req *MsgCreateStableMintRequest) (*MsgCreateStableMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgCreateStableMint not implemented")
+}
+func (*UnimplementedMsgServer) MsgDepositStableMint(ctx context.Context, req *MsgDepositStableMintRequest) (*MsgDepositStableMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDepositStableMint not implemented")
+}
+func (*UnimplementedMsgServer) MsgWithdrawStableMint(ctx context.Context, req *MsgWithdrawStableMintRequest) (*MsgWithdrawStableMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgWithdrawStableMint not implemented")
+}
+func (*UnimplementedMsgServer) MsgVaultInterestCalc(ctx context.Context, req *MsgVaultInterestCalcRequest) (*MsgVaultInterestCalcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgVaultInterestCalc not implemented")
+}
+func (*UnimplementedMsgServer) MsgTransferVault(ctx context.Context, req *MsgTransferVaultRequest) (*MsgTransferVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgTransferVault not implemented")
+}
+func (*UnimplementedMsgServer) MsgLeverageVault(ctx context.Context, req *MsgLeverageVaultRequest) (*MsgLeverageVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgLeverageVault not implemented")
+}
+func (*UnimplementedMsgServer) MsgDeleverageVault(ctx context.Context, req *MsgDeleverageVaultRequest) (*MsgDeleverageVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDeleverageVault not implemented")
+}
+func (*UnimplementedMsgServer) MsgRedeem(ctx context.Context, req *MsgRedeemRequest) (*MsgRedeemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgRedeem not implemented")
+}
+func (*UnimplementedMsgServer) MsgGrantPositionManager(ctx context.Context, req *MsgGrantPositionManagerRequest) (*MsgGrantPositionManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgGrantPositionManager not implemented")
+}
+func (*UnimplementedMsgServer) MsgRevokePositionManager(ctx context.Context, req *MsgRevokePositionManagerRequest) (*MsgRevokePositionManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgRevokePositionManager not implemented")
+}
+func (*UnimplementedMsgServer) MsgSetVaultProtection(ctx context.Context, req *MsgSetVaultProtectionRequest) (*MsgSetVaultProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgSetVaultProtection not implemented")
+}
+func (*UnimplementedMsgServer) MsgRemoveVaultProtection(ctx context.Context, req *MsgRemoveVaultProtectionRequest) (*MsgRemoveVaultProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgRemoveVaultProtection not implemented")
+}
+func (*UnimplementedMsgServer) MsgDepositCollateral(ctx context.Context, req *MsgDepositCollateralRequest) (*MsgDepositCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDepositCollateral not implemented")
+}
+func (*UnimplementedMsgServer) MsgWithdrawCollateral(ctx context.Context, req *MsgWithdrawCollateralRequest) (*MsgWithdrawCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgWithdrawCollateral not implemented")
 }
 
-func (m *MsgDepositRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_MsgCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+	if interceptor == nil {
+		return srv.(MsgServer).MsgCreate(ctx, in)
 	}
-	if m.ExtendedPairVaultId != 0 {
-		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgCreate",
 	}
-	if m.UserVaultId != 0 {
-		n += 1 + sovTx(uint64(m.UserVaultId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgCreate(ctx, req.(*MsgCreateRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MsgDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).MsgDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgDeposit(ctx, req.(*MsgDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_MsgWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+	if interceptor == nil {
+		return srv.(MsgServer).MsgWithdraw(ctx, in)
 	}
-	if m.ExtendedPairVaultId != 0 {
-		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgWithdraw",
 	}
-	if m.UserVaultId != 0 {
-		n += 1 + sovTx(uint64(m.UserVaultId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgWithdraw(ctx, req.(*MsgWithdrawRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MsgDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).MsgDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgDraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgDraw(ctx, req.(*MsgDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_MsgRepay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+	if interceptor == nil {
+		return srv.(MsgServer).MsgRepay(ctx, in)
 	}
-	if m.ExtendedPairVaultId != 0 {
-		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgRepay",
 	}
-	if m.UserVaultId != 0 {
-		n += 1 + sovTx(uint64(m.UserVaultId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgRepay(ctx, req.(*MsgRepayRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MsgClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).MsgClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgClose(ctx, req.(*MsgCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRepayRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_MsgDepositAndDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositAndDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+	if interceptor == nil {
+		return srv.(MsgServer).MsgDepositAndDraw(ctx, in)
 	}
-	if m.ExtendedPairVaultId != 0 {
-		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgDepositAndDraw",
 	}
-	if m.UserVaultId != 0 {
-		n += 1 + sovTx(uint64(m.UserVaultId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgDepositAndDraw(ctx, req.(*MsgDepositAndDrawRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRepayResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MsgCreateStableMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStableMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).MsgCreateStableMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgCreateStableMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgCreateStableMint(ctx, req.(*MsgCreateStableMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_MsgDepositStableMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositStableMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+	if interceptor == nil {
+		return srv.(MsgServer).MsgDepositStableMint(ctx, in)
 	}
-	if m.ExtendedPairVaultId != 0 {
-		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgDepositStableMint",
 	}
-	if m.UserVaultId != 0 {
-		n += 1 + sovTx(uint64(m.UserVaultId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgDepositStableMint(ctx, req.(*MsgDepositStableMintRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MsgWithdrawStableMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawStableMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).MsgWithdrawStableMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgWithdrawStableMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgWithdrawStableMint(ctx, req.(*MsgWithdrawStableMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositAndDrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_MsgVaultInterestCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVaultInterestCalcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+	if interceptor == nil {
+		return srv.(MsgServer).MsgVaultInterestCalc(ctx, in)
 	}
-	if m.ExtendedPairVaultId != 0 {
-		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgVaultInterestCalc",
 	}
-	if m.UserVaultId != 0 {
-		n += 1 + sovTx(uint64(m.UserVaultId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgVaultInterestCalc(ctx, req.(*MsgVaultInterestCalcRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositAndDrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MsgTransferVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).MsgTransferVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgTransferVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgTransferVault(ctx, req.(*MsgTransferVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateStableMintRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MsgLeverageVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeverageVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).MsgLeverageVault(ctx, in)
 	}
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgLeverageVault",
 	}
-	if m.ExtendedPairVaultId != 0 {
-		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgLeverageVault(ctx, req.(*MsgLeverageVaultRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateStableMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MsgDeleverageVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleverageVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).MsgDeleverageVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgDeleverageVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgDeleverageVault(ctx, req.(*MsgDeleverageVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositStableMintRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MsgRedeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).MsgRedeem(ctx, in)
 	}
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgRedeem",
 	}
-	if m.ExtendedPairVaultId != 0 {
-		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgRedeem(ctx, req.(*MsgRedeemRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.StableVaultId != 0 {
-		n += 1 + sovTx(uint64(m.StableVaultId))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MsgGrantPositionManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantPositionManagerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).MsgGrantPositionManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgGrantPositionManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgGrantPositionManager(ctx, req.(*MsgGrantPositionManagerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositStableMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MsgRevokePositionManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokePositionManagerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).MsgRevokePositionManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgRevokePositionManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgRevokePositionManager(ctx, req.(*MsgRevokePositionManagerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawStableMintRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MsgSetVaultProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVaultProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).MsgSetVaultProtection(ctx, in)
 	}
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgSetVaultProtection",
 	}
-	if m.ExtendedPairVaultId != 0 {
-		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgSetVaultProtection(ctx, req.(*MsgSetVaultProtectionRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.StableVaultId != 0 {
-		n += 1 + sovTx(uint64(m.StableVaultId))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MsgRemoveVaultProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveVaultProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MsgRemoveVaultProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgRemoveVaultProtection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgRemoveVaultProtection(ctx, req.(*MsgRemoveVaultProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MsgDepositCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MsgDepositCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgDepositCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgDepositCollateral(ctx, req.(*MsgDepositCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MsgWithdrawCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MsgWithdrawCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comdex.vault.v1beta1.Msg/MsgWithdrawCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MsgWithdrawCollateral(ctx, req.(*MsgWithdrawCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "comdex.vault.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MsgCreate",
+			Handler:    _Msg_MsgCreate_Handler,
+		},
+		{
+			MethodName: "MsgDeposit",
+			Handler:    _Msg_MsgDeposit_Handler,
+		},
+		{
+			MethodName: "MsgWithdraw",
+			Handler:    _Msg_MsgWithdraw_Handler,
+		},
+		{
+			MethodName: "MsgDraw",
+			Handler:    _Msg_MsgDraw_Handler,
+		},
+		{
+			MethodName: "MsgRepay",
+			Handler:    _Msg_MsgRepay_Handler,
+		},
+		{
+			MethodName: "MsgClose",
+			Handler:    _Msg_MsgClose_Handler,
+		},
+		{
+			MethodName: "MsgDepositAndDraw",
+			Handler:    _Msg_MsgDepositAndDraw_Handler,
+		},
+		{
+			MethodName: "MsgCreateStableMint",
+			Handler:    _Msg_MsgCreateStableMint_Handler,
+		},
+		{
+			MethodName: "MsgDepositStableMint",
+			Handler:    _Msg_MsgDepositStableMint_Handler,
+		},
+		{
+			MethodName: "MsgWithdrawStableMint",
+			Handler:    _Msg_MsgWithdrawStableMint_Handler,
+		},
+		{
+			MethodName: "MsgVaultInterestCalc",
+			Handler:    _Msg_MsgVaultInterestCalc_Handler,
+		},
+		{
+			MethodName: "MsgTransferVault",
+			Handler:    _Msg_MsgTransferVault_Handler,
+		},
+		{
+			MethodName: "MsgLeverageVault",
+			Handler:    _Msg_MsgLeverageVault_Handler,
+		},
+		{
+			MethodName: "MsgDeleverageVault",
+			Handler:    _Msg_MsgDeleverageVault_Handler,
+		},
+		{
+			MethodName: "MsgRedeem",
+			Handler:    _Msg_MsgRedeem_Handler,
+		},
+		{
+			MethodName: "MsgGrantPositionManager",
+			Handler:    _Msg_MsgGrantPositionManager_Handler,
+		},
+		{
+			MethodName: "MsgRevokePositionManager",
+			Handler:    _Msg_MsgRevokePositionManager_Handler,
+		},
+		{
+			MethodName: "MsgSetVaultProtection",
+			Handler:    _Msg_MsgSetVaultProtection_Handler,
+		},
+		{
+			MethodName: "MsgRemoveVaultProtection",
+			Handler:    _Msg_MsgRemoveVaultProtection_Handler,
+		},
+		{
+			MethodName: "MsgDepositCollateral",
+			Handler:    _Msg_MsgDepositCollateral_Handler,
+		},
+		{
+			MethodName: "MsgWithdrawCollateral",
+			Handler:    _Msg_MsgWithdrawCollateral_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comdex/vault/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AmountOut.Size()
+		i -= size
+		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositAndDrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositAndDrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositAndDrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositAndDrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositAndDrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositAndDrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStableMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStableMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStableMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStableMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStableMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStableMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositStableMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositStableMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositStableMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StableVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StableVaultId))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositStableMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositStableMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositStableMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawStableMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawStableMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawStableMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StableVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StableVaultId))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawStableMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawStableMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawStableMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVaultInterestCalcRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVaultInterestCalcRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVaultInterestCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVaultInterestCalcResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVaultInterestCalcResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVaultInterestCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferVaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferVaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeverageVaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeverageVaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeverageVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TargetCr.Size()
+		i -= size
+		if _, err := m.TargetCr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeverageVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeverageVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeverageVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleverageVaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleverageVaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleverageVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TargetCr.Size()
+		i -= size
+		if _, err := m.TargetCr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleverageVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleverageVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleverageVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFeeRate.Size()
+		i -= size
+		if _, err := m.MaxFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantPositionManagerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantPositionManagerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantPositionManagerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		dAtA2 := make([]byte, len(m.Permissions)*10)
+		var j1 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantPositionManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantPositionManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantPositionManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePositionManagerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePositionManagerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePositionManagerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePositionManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePositionManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePositionManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVaultProtectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVaultProtectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVaultProtectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Action != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.TargetCr.Size()
+		i -= size
+		if _, err := m.TargetCr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TriggerCr.Size()
+		i -= size
+		if _, err := m.TriggerCr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVaultProtectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVaultProtectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVaultProtectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveVaultProtectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveVaultProtectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveVaultProtectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveVaultProtectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveVaultProtectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveVaultProtectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositCollateralRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawCollateralRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UserVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserVaultId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtendedPairVaultId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtendedPairVaultId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRepayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRepayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	return n
+}
+
+func (m *MsgCloseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositAndDrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositAndDrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateStableMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateStableMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositStableMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.StableVaultId != 0 {
+		n += 1 + sovTx(uint64(m.StableVaultId))
+	}
+	return n
+}
+
+func (m *MsgDepositStableMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawStableMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.StableVaultId != 0 {
+		n += 1 + sovTx(uint64(m.StableVaultId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawStableMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVaultInterestCalcRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	return n
+}
+
+func (m *MsgVaultInterestCalcResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferVaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLeverageVaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	l = m.TargetCr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLeverageVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleverageVaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	l = m.TargetCr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleverageVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxFeeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantPositionManagerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgGrantPositionManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokePositionManagerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokePositionManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetVaultProtectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	l = m.TriggerCr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TargetCr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Action != 0 {
+		n += 1 + sovTx(uint64(m.Action))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetVaultProtectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveVaultProtectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	return n
+}
+
+func (m *MsgRemoveVaultProtectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositCollateralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawCollateralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppId != 0 {
+		n += 1 + sovTx(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultId != 0 {
+		n += 1 + sovTx(uint64(m.ExtendedPairVaultId))
+	}
+	if m.UserVaultId != 0 {
+		n += 1 + sovTx(uint64(m.UserVaultId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
+			}
+			m.UserVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
+			}
+			m.UserVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
+			}
+			m.UserVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
+			}
+			m.UserVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
+			}
+			m.UserVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositAndDrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositAndDrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositAndDrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
+			}
+			m.UserVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositAndDrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositAndDrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositAndDrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStableMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStableMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStableMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStableMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStableMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStableMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositStableMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositStableMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositStableMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableVaultId", wireType)
+			}
+			m.StableVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StableVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositStableMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositStableMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositStableMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawStableMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawStableMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawStableMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableVaultId", wireType)
+			}
+			m.StableVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StableVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawStableMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawStableMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawStableMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVaultInterestCalcRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVaultInterestCalcRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVaultInterestCalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
+			}
+			m.UserVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgWithdrawStableMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgVaultInterestCalcRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AppId != 0 {
-		n += 1 + sovTx(uint64(m.AppId))
-	}
-	if m.UserVaultId != 0 {
-		n += 1 + sovTx(uint64(m.UserVaultId))
+func (m *MsgVaultInterestCalcResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVaultInterestCalcResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVaultInterestCalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgVaultInterestCalcResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferVaultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2624,10 +7316,10 @@ func (m *MsgCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferVaultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2701,10 +7393,10 @@ func (m *MsgCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
 			}
-			var stringLen uint64
+			m.UserVaultId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2714,29 +7406,14 @@ func (m *MsgCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UserVaultId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2764,9 +7441,7 @@ func (m *MsgCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2789,7 +7464,7 @@ func (m *MsgCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2812,10 +7487,10 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2839,7 +7514,7 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgLeverageVaultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2862,10 +7537,10 @@ func (m *MsgDepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeverageVaultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeverageVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2959,7 +7634,7 @@ func (m *MsgDepositRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2987,7 +7662,41 @@ func (m *MsgDepositRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetCr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3012,7 +7721,7 @@ func (m *MsgDepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLeverageVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3035,10 +7744,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeverageVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeverageVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3062,7 +7771,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleverageVaultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3085,10 +7794,10 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleverageVaultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleverageVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3182,7 +7891,7 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3210,7 +7919,41 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetCr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3235,7 +7978,7 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleverageVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3258,10 +8001,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleverageVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleverageVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3285,7 +8028,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDrawRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3308,10 +8051,10 @@ func (m *MsgDrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3385,10 +8128,10 @@ func (m *MsgDrawRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.UserVaultId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3398,14 +8141,29 @@ func (m *MsgDrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserVaultId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3433,7 +8191,7 @@ func (m *MsgDrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3458,7 +8216,7 @@ func (m *MsgDrawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3481,10 +8239,10 @@ func (m *MsgDrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3508,7 +8266,7 @@ func (m *MsgDrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantPositionManagerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3531,10 +8289,10 @@ func (m *MsgRepayRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantPositionManagerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantPositionManagerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3570,65 +8328,8 @@ func (m *MsgRepayRequest) Unmarshal(dAtA []byte) error {
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
-			}
-			m.AppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
-			}
-			m.ExtendedPairVaultId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
-			}
-			m.UserVaultId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UserVaultId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3656,10 +8357,77 @@ func (m *MsgRepayRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v PositionPermission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PositionPermission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]PositionPermission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PositionPermission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PositionPermission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3681,7 +8449,7 @@ func (m *MsgRepayRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantPositionManagerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3704,10 +8472,10 @@ func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantPositionManagerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantPositionManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3731,7 +8499,7 @@ func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokePositionManagerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3754,10 +8522,10 @@ func (m *MsgCloseRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokePositionManagerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokePositionManagerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3793,10 +8561,10 @@ func (m *MsgCloseRequest) Unmarshal(dAtA []byte) error {
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
 			}
-			m.AppId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3806,49 +8574,24 @@ func (m *MsgCloseRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
-			}
-			m.ExtendedPairVaultId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.UserVaultId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UserVaultId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3870,7 +8613,7 @@ func (m *MsgCloseRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokePositionManagerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3893,10 +8636,10 @@ func (m *MsgCloseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokePositionManagerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokePositionManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3920,7 +8663,7 @@ func (m *MsgCloseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositAndDrawRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetVaultProtectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3943,10 +8686,10 @@ func (m *MsgDepositAndDrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositAndDrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetVaultProtectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositAndDrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetVaultProtectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4040,7 +8783,7 @@ func (m *MsgDepositAndDrawRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerCr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4049,132 +8792,32 @@ func (m *MsgDepositAndDrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDepositAndDrawResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositAndDrawResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositAndDrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateStableMintRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TriggerCr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStableMintRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStableMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4202,13 +8845,15 @@ func (m *MsgCreateStableMintRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			if err := m.TargetCr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			m.AppId = 0
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4218,16 +8863,16 @@ func (m *MsgCreateStableMintRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
+				m.Action |= ProtectionAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
 			}
-			m.ExtendedPairVaultId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4237,14 +8882,29 @@ func (m *MsgCreateStableMintRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4272,7 +8932,7 @@ func (m *MsgCreateStableMintRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4297,7 +8957,7 @@ func (m *MsgCreateStableMintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateStableMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetVaultProtectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4320,10 +8980,10 @@ func (m *MsgCreateStableMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStableMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetVaultProtectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStableMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetVaultProtectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4347,7 +9007,7 @@ func (m *MsgCreateStableMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositStableMintRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveVaultProtectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4370,10 +9030,10 @@ func (m *MsgDepositStableMintRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositStableMintRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveVaultProtectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositStableMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveVaultProtectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4410,81 +9070,9 @@ func (m *MsgDepositStableMintRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
-			}
-			m.AppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
-			}
-			m.ExtendedPairVaultId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableVaultId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
 			}
-			m.StableVaultId = 0
+			m.UserVaultId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4494,7 +9082,7 @@ func (m *MsgDepositStableMintRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StableVaultId |= uint64(b&0x7F) << shift
+				m.UserVaultId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4520,7 +9108,7 @@ func (m *MsgDepositStableMintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositStableMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveVaultProtectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4543,10 +9131,10 @@ func (m *MsgDepositStableMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositStableMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveVaultProtectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositStableMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveVaultProtectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4570,7 +9158,7 @@ func (m *MsgDepositStableMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawStableMintRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCollateralRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4593,10 +9181,10 @@ func (m *MsgWithdrawStableMintRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawStableMintRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCollateralRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawStableMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4670,10 +9258,10 @@ func (m *MsgWithdrawStableMintRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
 			}
-			var stringLen uint64
+			m.UserVaultId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4683,31 +9271,16 @@ func (m *MsgWithdrawStableMintRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UserVaultId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableVaultId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.StableVaultId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4717,11 +9290,25 @@ func (m *MsgWithdrawStableMintRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StableVaultId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4743,7 +9330,7 @@ func (m *MsgWithdrawStableMintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawStableMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4766,10 +9353,10 @@ func (m *MsgWithdrawStableMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawStableMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawStableMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4793,7 +9380,7 @@ func (m *MsgWithdrawStableMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVaultInterestCalcRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawCollateralRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4816,10 +9403,10 @@ func (m *MsgVaultInterestCalcRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVaultInterestCalcRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawCollateralRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVaultInterestCalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4874,6 +9461,25 @@ func (m *MsgVaultInterestCalcRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultId", wireType)
+			}
+			m.ExtendedPairVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UserVaultId", wireType)
 			}
@@ -4892,6 +9498,39 @@ func (m *MsgVaultInterestCalcRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4913,7 +9552,7 @@ func (m *MsgVaultInterestCalcRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVaultInterestCalcResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4936,10 +9575,10 @@ func (m *MsgVaultInterestCalcResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVaultInterestCalcResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVaultInterestCalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,6 +19,49 @@ const (
 	CreateStableVaultGas   = sdk.Gas(36329)
 	DepositStableVaultGas  = sdk.Gas(23554)
 	WithdrawStableVaultGas = sdk.Gas(26473)
+	TransferVaultGas       = sdk.Gas(26329)
+	RedeemGas              = sdk.Gas(37559)
+)
+
+const (
+	// MaxLeverageIterations is the maximum number of swap rounds a
+	// leverage or deleverage runs to reach the target collateralization ratio.
+	MaxLeverageIterations = 10
+)
+
+const (
+	// MaxVaultProtectionsPerBlock is the maximum number of vault protections
+	// checked in a block. Protections are checked round robin across blocks.
+	MaxVaultProtectionsPerBlock = 20
+)
+
+// VaultProtectionFeeRate is the share of the amount used by an executed
+// vault protection that is paid to the collector.
+var VaultProtectionFeeRate = sdk.NewDecWithPrec(1, 3)
+
+// LeverageCRTolerance is how close, relative to the target, the
+// collateralization ratio must get for a leverage or deleverage to stop
+// early.
+var LeverageCRTolerance = sdk.NewDecWithPrec(1, 3)
+
+var (
+	// RedemptionFeeFloor is the redemption fee rate charged when the
+	// redemption base rate is zero.
+	RedemptionFeeFloor = sdk.NewDecWithPrec(5, 3)
+	// RedemptionBaseRateAlpha is the share of the redeemed fraction of the
+	// minted supply added to the redemption base rate by a redemption.
+	RedemptionBaseRateAlpha = sdk.NewDecWithPrec(5, 1)
+	// RedemptionBaseRateHalfLife is the time it takes the redemption base rate
+	// to decay to half its value.
+	RedemptionBaseRateHalfLife = 12 * time.Hour
+	// MaxRedemptionVaults is the maximum number of vaults a single
+	// redemption redeems against.
+	MaxRedemptionVaults = 50
+	// RedemptionCandidateVaults is how many vaults from the lowest indexed
+	// ratio up a redemption accrues the interest of before ordering them.
+	// The index ranks vaults by the debt they last stored, so a wider window
+	// finds those whose ratio fell through interest not yet accrued.
+	RedemptionCandidateVaults = 4 * MaxRedemptionVaults
 )
 
 func (m *Vault) Validate() error {
@@ -45,3 +89,23 @@ func (m *Vault) Validate() error {
 
 	return nil
 }
+
+// DecayBaseRate returns the base rate of the redemption state decayed until
+// now, halving it every RedemptionBaseRateHalfLife.
+func (m RedemptionState) DecayBaseRate(now time.Time) sdk.Dec {
+	if m.BaseRate.IsNil() || !m.BaseRate.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	elapsed := now.Sub(m.LastRedemptionTime)
+	if elapsed <= 0 {
+		return m.BaseRate
+	}
+	halvings := uint64(elapsed / RedemptionBaseRateHalfLife)
+	if halvings >= 64 {
+		return sdk.ZeroDec()
+	}
+	rate := m.BaseRate.Quo(sdk.NewDec(2).Power(halvings))
+	// Decay linearly within the current half-life.
+	rem := sdk.NewDec(int64(elapsed % RedemptionBaseRateHalfLife)).QuoInt64(int64(RedemptionBaseRateHalfLife))
+	return rate.Sub(rate.Mul(rem).QuoInt64(2))
+}
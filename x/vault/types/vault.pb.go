@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -28,6 +29,83 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PositionPermission enumerates what a position manager may do on behalf of
+// the owner of a vault.
+type PositionPermission int32
+
+const (
+	// POSITION_PERMISSION_UNSPECIFIED specifies no permission.
+	PositionPermissionUnspecified PositionPermission = 0
+	// POSITION_PERMISSION_DEPOSIT allows depositing the manager's collateral.
+	PositionPermissionDeposit PositionPermission = 1
+	// POSITION_PERMISSION_REPAY allows repaying debt with the manager's funds.
+	PositionPermissionRepay PositionPermission = 2
+	// POSITION_PERMISSION_WITHDRAW_TO_OWNER allows withdrawing collateral, which
+	// is always sent to the owner.
+	PositionPermissionWithdrawToOwner PositionPermission = 3
+)
+
+var PositionPermission_name = map[int32]string{
+	0: "POSITION_PERMISSION_UNSPECIFIED",
+	1: "POSITION_PERMISSION_DEPOSIT",
+	2: "POSITION_PERMISSION_REPAY",
+	3: "POSITION_PERMISSION_WITHDRAW_TO_OWNER",
+}
+
+var PositionPermission_value = map[string]int32{
+	"POSITION_PERMISSION_UNSPECIFIED":       0,
+	"POSITION_PERMISSION_DEPOSIT":           1,
+	"POSITION_PERMISSION_REPAY":             2,
+	"POSITION_PERMISSION_WITHDRAW_TO_OWNER": 3,
+}
+
+func (x PositionPermission) String() string {
+	return proto.EnumName(PositionPermission_name, int32(x))
+}
+
+func (PositionPermission) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_217d238efc540f4d, []int{0}
+}
+
+// ProtectionAction is what a vault protection does once the vault falls to
+// its trigger collateralization ratio.
+type ProtectionAction int32
+
+const (
+	// PROTECTION_ACTION_UNSPECIFIED specifies no action.
+	ProtectionActionUnspecified ProtectionAction = 0
+	// PROTECTION_ACTION_REPAY repays debt with the minted asset held by the
+	// owner.
+	ProtectionActionRepay ProtectionAction = 1
+	// PROTECTION_ACTION_DEPOSIT deposits collateral held by the owner.
+	ProtectionActionDeposit ProtectionAction = 2
+	// PROTECTION_ACTION_SELL_COLLATERAL sells collateral of the vault through
+	// the liquidity module and repays debt with the proceeds.
+	ProtectionActionSellCollateral ProtectionAction = 3
+)
+
+var ProtectionAction_name = map[int32]string{
+	0: "PROTECTION_ACTION_UNSPECIFIED",
+	1: "PROTECTION_ACTION_REPAY",
+	2: "PROTECTION_ACTION_DEPOSIT",
+	3: "PROTECTION_ACTION_SELL_COLLATERAL",
+}
+
+var ProtectionAction_value = map[string]int32{
+	"PROTECTION_ACTION_UNSPECIFIED":     0,
+	"PROTECTION_ACTION_REPAY":           1,
+	"PROTECTION_ACTION_DEPOSIT":         2,
+	"PROTECTION_ACTION_SELL_COLLATERAL": 3,
+}
+
+func (x ProtectionAction) String() string {
+	return proto.EnumName(ProtectionAction_name, int32(x))
+}
+
+func (ProtectionAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_217d238efc540f4d, []int{1}
+}
+
 // app_vault_type_id will be the key for  the KVStore for this value.
 type Vault struct {
 	Id                    uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -41,6 +119,9 @@ type Vault struct {
 	ClosingFeeAccumulated github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=closing_fee_accumulated,json=closingFeeAccumulated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"closing_fee_accumulated" yaml:"interest_accumulated"`
 	BlockHeight           int64                                  `protobuf:"varint,10,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
 	BlockTime             time.Time                              `protobuf:"bytes,11,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time" yaml:"block_time"`
+	// collaterals is the basket collateral held next to amount_in, out of the
+	// assets whitelisted for the extended pair vault.
+	Collaterals github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=collaterals,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collaterals" yaml:"collaterals"`
 }
 
 func (m *Vault) Reset()         { *m = Vault{} }
@@ -357,7 +438,139 @@ func (m *StableMintVaultRewards) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StableMintVaultRewards proto.InternalMessageInfo
 
+// RedemptionState holds the redemption base rate of an extended pair vault.
+// The base rate rises with each redemption and decays back over time.
+type RedemptionState struct {
+	AppId               uint64                                 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty" yaml:"app_id"`
+	ExtendedPairVaultID uint64                                 `protobuf:"varint,2,opt,name=extended_pair_vault_id,json=extendedPairVaultId,proto3" json:"extended_pair_vault_id,omitempty" yaml:"extended_pair_vault_id"`
+	BaseRate            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=base_rate,json=baseRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_rate" yaml:"base_rate"`
+	LastRedemptionTime  time.Time                              `protobuf:"bytes,4,opt,name=last_redemption_time,json=lastRedemptionTime,proto3,stdtime" json:"last_redemption_time" yaml:"last_redemption_time"`
+}
+
+func (m *RedemptionState) Reset()         { *m = RedemptionState{} }
+func (m *RedemptionState) String() string { return proto.CompactTextString(m) }
+func (*RedemptionState) ProtoMessage()    {}
+func (*RedemptionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_217d238efc540f4d, []int{8}
+}
+func (m *RedemptionState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionState.Merge(m, src)
+}
+func (m *RedemptionState) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionState proto.InternalMessageInfo
+
+// PositionManagerGrant lets manager act on the vaults of owner within the
+// granted permissions.
+type PositionManagerGrant struct {
+	Owner       string               `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Manager     string               `protobuf:"bytes,2,opt,name=manager,proto3" json:"manager,omitempty" yaml:"manager"`
+	Permissions []PositionPermission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=comdex.vault.v1beta1.PositionPermission" json:"permissions,omitempty" yaml:"permissions"`
+}
+
+func (m *PositionManagerGrant) Reset()         { *m = PositionManagerGrant{} }
+func (m *PositionManagerGrant) String() string { return proto.CompactTextString(m) }
+func (*PositionManagerGrant) ProtoMessage()    {}
+func (*PositionManagerGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_217d238efc540f4d, []int{9}
+}
+func (m *PositionManagerGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionManagerGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionManagerGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionManagerGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionManagerGrant.Merge(m, src)
+}
+func (m *PositionManagerGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionManagerGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionManagerGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionManagerGrant proto.InternalMessageInfo
+
+// VaultProtection brings a vault back to target_cr with action once its
+// collateralization ratio falls to trigger_cr, using at most max_amount over
+// the lifetime of the protection.
+type VaultProtection struct {
+	VaultID     uint64                                 `protobuf:"varint,1,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty" yaml:"vault_id"`
+	Owner       string                                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	TriggerCr   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=trigger_cr,json=triggerCr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_cr" yaml:"trigger_cr"`
+	TargetCr    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=target_cr,json=targetCr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_cr" yaml:"target_cr"`
+	Action      ProtectionAction                       `protobuf:"varint,5,opt,name=action,proto3,enum=comdex.vault.v1beta1.ProtectionAction" json:"action,omitempty" yaml:"action"`
+	MaxAmount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=max_amount,json=maxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount" yaml:"max_amount"`
+	AmountUsed  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=amount_used,json=amountUsed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount_used" yaml:"amount_used"`
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage" yaml:"max_slippage"`
+}
+
+func (m *VaultProtection) Reset()         { *m = VaultProtection{} }
+func (m *VaultProtection) String() string { return proto.CompactTextString(m) }
+func (*VaultProtection) ProtoMessage()    {}
+func (*VaultProtection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_217d238efc540f4d, []int{10}
+}
+func (m *VaultProtection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultProtection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultProtection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultProtection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultProtection.Merge(m, src)
+}
+func (m *VaultProtection) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultProtection) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultProtection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultProtection proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("comdex.vault.v1beta1.PositionPermission", PositionPermission_name, PositionPermission_value)
+	proto.RegisterEnum("comdex.vault.v1beta1.ProtectionAction", ProtectionAction_name, ProtectionAction_value)
 	proto.RegisterType((*Vault)(nil), "comdex.vault.v1beta1.Vault")
 	proto.RegisterType((*OwnerAppExtendedPairVaultMappingData)(nil), "comdex.vault.v1beta1.OwnerAppExtendedPairVaultMappingData")
 	proto.RegisterType((*AppExtendedPairVaultMappingData)(nil), "comdex.vault.v1beta1.AppExtendedPairVaultMappingData")
@@ -366,81 +579,126 @@ func init() {
 	proto.RegisterType((*StableMintVault)(nil), "comdex.vault.v1beta1.StableMintVault")
 	proto.RegisterType((*PairStatisticData)(nil), "comdex.vault.v1beta1.PairStatisticData")
 	proto.RegisterType((*StableMintVaultRewards)(nil), "comdex.vault.v1beta1.StableMintVaultRewards")
+	proto.RegisterType((*RedemptionState)(nil), "comdex.vault.v1beta1.RedemptionState")
+	proto.RegisterType((*PositionManagerGrant)(nil), "comdex.vault.v1beta1.PositionManagerGrant")
+	proto.RegisterType((*VaultProtection)(nil), "comdex.vault.v1beta1.VaultProtection")
 }
 
 func init() { proto.RegisterFile("comdex/vault/v1beta1/vault.proto", fileDescriptor_217d238efc540f4d) }
 
 var fileDescriptor_217d238efc540f4d = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x3b, 0x73, 0xdb, 0xc6,
-	0x13, 0x27, 0xf8, 0x90, 0xc4, 0x93, 0xa8, 0x07, 0x48, 0x49, 0xf8, 0xd3, 0x23, 0x1e, 0xe7, 0xfe,
-	0x19, 0x0f, 0x1b, 0x93, 0xa3, 0xa4, 0x48, 0xc6, 0x8d, 0x47, 0x8c, 0xe4, 0x09, 0x33, 0xd6, 0x28,
-	0x86, 0x3d, 0x79, 0x35, 0x98, 0x23, 0x71, 0xa2, 0x30, 0x22, 0x70, 0x18, 0xe2, 0x20, 0xd9, 0x33,
-	0x29, 0xd2, 0xa4, 0x4a, 0xe3, 0xaf, 0x90, 0x2e, 0x5f, 0x20, 0x6d, 0xd2, 0xaa, 0x74, 0x97, 0x47,
-	0x81, 0x24, 0x54, 0x93, 0x26, 0x0d, 0x3e, 0x41, 0xe6, 0x1e, 0x10, 0x08, 0x3d, 0xec, 0x30, 0x89,
-	0x5c, 0x89, 0xf7, 0xdb, 0xc5, 0xee, 0x9e, 0xf6, 0xf7, 0xdb, 0x05, 0x40, 0x73, 0x40, 0x5d, 0x9b,
-	0x3c, 0xeb, 0x9c, 0xe0, 0x70, 0xc4, 0x3a, 0x27, 0xdb, 0x7d, 0xc2, 0xf0, 0xb6, 0x3c, 0xb5, 0xfd,
-	0x31, 0x65, 0x54, 0xaf, 0x49, 0x8f, 0xb6, 0xc4, 0x94, 0x47, 0xbd, 0x36, 0xa4, 0x43, 0x2a, 0x1c,
-	0x3a, 0xfc, 0x97, 0xf4, 0xad, 0xc3, 0x21, 0xa5, 0xc3, 0x11, 0xe9, 0x88, 0x53, 0x3f, 0x3c, 0xec,
-	0x30, 0xc7, 0x25, 0x01, 0xc3, 0xae, 0x2f, 0x1d, 0xd0, 0x37, 0xf3, 0xa0, 0xf4, 0x31, 0x0f, 0xa4,
-	0x2f, 0x83, 0xbc, 0x63, 0x1b, 0x5a, 0x53, 0x6b, 0x15, 0xcd, 0xbc, 0x63, 0xeb, 0xdb, 0x60, 0x0e,
-	0xfb, 0xbe, 0xe5, 0xd8, 0x46, 0x9e, 0x63, 0xdd, 0xfa, 0x24, 0x82, 0xa5, 0x1d, 0xdf, 0xef, 0xd9,
-	0x71, 0x04, 0x2b, 0xcf, 0xb1, 0x3b, 0xba, 0x8f, 0xa4, 0x03, 0x32, 0x4b, 0x98, 0xe3, 0xba, 0x0b,
-	0x36, 0xc8, 0x33, 0x46, 0x3c, 0x9b, 0xd8, 0x96, 0x8f, 0x9d, 0xb1, 0x25, 0x4a, 0xe4, 0x21, 0x0a,
-	0x22, 0xc4, 0x7b, 0x93, 0x08, 0x56, 0xf7, 0x94, 0xc7, 0x47, 0xd8, 0x19, 0x8b, 0xcc, 0xbd, 0xdd,
-	0x38, 0x82, 0x5b, 0x32, 0xe0, 0xf5, 0x8f, 0x23, 0xb3, 0x4a, 0xae, 0x3c, 0x65, 0xeb, 0x77, 0x41,
-	0x89, 0x9e, 0x7a, 0x64, 0x6c, 0x14, 0x9b, 0x5a, 0xab, 0xdc, 0x5d, 0x8d, 0x23, 0xb8, 0x24, 0xc3,
-	0x08, 0x18, 0x99, 0xd2, 0xac, 0x5b, 0xa0, 0x8c, 0x5d, 0x1a, 0x7a, 0xcc, 0x72, 0x3c, 0xa3, 0x24,
-	0x7c, 0xbb, 0x67, 0x11, 0xcc, 0xfd, 0x12, 0xc1, 0xbb, 0x43, 0x87, 0x1d, 0x85, 0xfd, 0xf6, 0x80,
-	0xba, 0x9d, 0x01, 0x0d, 0x5c, 0x1a, 0xa8, 0x3f, 0xf7, 0x02, 0xfb, 0xb8, 0xc3, 0x9e, 0xfb, 0x24,
-	0x68, 0xf7, 0x3c, 0x16, 0x47, 0x70, 0x55, 0xdd, 0x38, 0x09, 0x84, 0xcc, 0x05, 0xf9, 0xbb, 0xe7,
-	0xe9, 0x7d, 0x00, 0x14, 0x4e, 0x43, 0x66, 0xcc, 0x89, 0x0c, 0xef, 0xcf, 0x9c, 0x61, 0x2d, 0x93,
-	0x81, 0x86, 0x0c, 0x99, 0xaa, 0xee, 0x83, 0x90, 0xe9, 0x9f, 0x02, 0x30, 0x18, 0x13, 0xcc, 0x88,
-	0x6d, 0x61, 0x66, 0xcc, 0x37, 0xb5, 0xd6, 0xe2, 0xdb, 0xf5, 0xb6, 0x6c, 0x6f, 0x3b, 0x69, 0x6f,
-	0xfb, 0x69, 0xd2, 0xde, 0xee, 0x16, 0xcf, 0x9f, 0x46, 0x4d, 0x9f, 0x45, 0x2f, 0x7e, 0x85, 0x9a,
-	0x59, 0x56, 0xc0, 0x0e, 0xd3, 0xbf, 0xd4, 0x40, 0xcd, 0xf1, 0x18, 0x19, 0x93, 0x80, 0x59, 0x78,
-	0x30, 0x08, 0xdd, 0x70, 0xc4, 0x4d, 0xc6, 0x82, 0xb8, 0xc8, 0xfe, 0xcc, 0x17, 0xb9, 0x23, 0x53,
-	0x5e, 0x17, 0x13, 0x99, 0xd5, 0x04, 0xde, 0x49, 0x51, 0xfd, 0x2b, 0x0d, 0x6c, 0x0e, 0x46, 0x34,
-	0x70, 0xbc, 0xa1, 0x75, 0x48, 0x48, 0xa6, 0x8a, 0xf2, 0x6d, 0x54, 0xb1, 0xae, 0xb2, 0x3d, 0x24,
-	0x64, 0xba, 0x8e, 0xfb, 0x60, 0xa9, 0x3f, 0xa2, 0x83, 0x63, 0xeb, 0x88, 0x38, 0xc3, 0x23, 0x66,
-	0x80, 0xa6, 0xd6, 0x2a, 0x74, 0x37, 0xe3, 0x08, 0x56, 0x65, 0xb4, 0x69, 0x2b, 0x32, 0x17, 0xc5,
-	0xf1, 0x03, 0x71, 0xe2, 0x0d, 0x92, 0x56, 0x2e, 0x31, 0x63, 0x71, 0xd6, 0x06, 0xa5, 0xcf, 0xaa,
-	0x06, 0x09, 0x80, 0xbb, 0xa3, 0x1f, 0x34, 0xf0, 0xd6, 0x01, 0x67, 0xf2, 0x8e, 0xef, 0x5f, 0x51,
-	0xcf, 0x3e, 0xf6, 0x7d, 0xc7, 0x1b, 0xee, 0x62, 0x86, 0x53, 0x41, 0x68, 0xaf, 0x16, 0xc4, 0x7a,
-	0x56, 0xda, 0x89, 0x7c, 0x5b, 0x60, 0x35, 0xab, 0xbf, 0x44, 0xb8, 0xe6, 0xf2, 0xb4, 0xfc, 0x7a,
-	0xb6, 0xde, 0x06, 0x0b, 0x17, 0xd2, 0x2e, 0x0a, 0x69, 0x57, 0xe3, 0x08, 0xae, 0xc8, 0x5c, 0xa9,
-	0x6a, 0xe7, 0x4f, 0xa4, 0x52, 0xd1, 0xf7, 0x05, 0x00, 0x5f, 0x57, 0x7c, 0x5a, 0x94, 0x36, 0x5d,
-	0xd4, 0xde, 0x35, 0x45, 0xc9, 0x81, 0x74, 0x27, 0x8e, 0xe0, 0xe6, 0x75, 0x63, 0x83, 0xa7, 0xbe,
-	0x5c, 0xf1, 0x36, 0x28, 0x27, 0x75, 0x05, 0x46, 0xa1, 0x59, 0x68, 0x15, 0xbb, 0xb5, 0x54, 0xd5,
-	0x17, 0x26, 0x64, 0x2e, 0xa8, 0x9a, 0x03, 0xfd, 0x0b, 0x50, 0x65, 0xf4, 0x98, 0x78, 0x96, 0xcb,
-	0x39, 0x64, 0x5b, 0x52, 0x8b, 0x6a, 0xd8, 0x3c, 0x9a, 0x99, 0x8f, 0x75, 0x99, 0xea, 0x9a, 0x90,
-	0xc8, 0x5c, 0x13, 0xe8, 0xbe, 0x00, 0x77, 0x04, 0xa6, 0x7f, 0xad, 0x01, 0x63, 0x40, 0x47, 0x9c,
-	0x97, 0x63, 0x3c, 0xb2, 0x38, 0x19, 0xd2, 0x1a, 0xe4, 0x10, 0x7b, 0x3c, 0x73, 0x0d, 0x50, 0x0d,
-	0x83, 0x1b, 0xe2, 0x22, 0x73, 0x23, 0x35, 0x3d, 0x12, 0x16, 0x59, 0x0d, 0xfa, 0x59, 0x03, 0xab,
-	0x4f, 0x4f, 0x14, 0xc6, 0xdb, 0xb5, 0x8f, 0x7d, 0xfd, 0x5d, 0xb0, 0x88, 0x83, 0x80, 0x30, 0xcb,
-	0x26, 0x1e, 0x75, 0x15, 0xe9, 0x36, 0xe2, 0x08, 0xea, 0x6a, 0x92, 0xa5, 0x46, 0x64, 0x02, 0x71,
-	0xda, 0xe5, 0x87, 0x57, 0xdf, 0x2d, 0xff, 0xa6, 0xef, 0xf6, 0x9d, 0x06, 0x2a, 0xf2, 0x5f, 0xff,
-	0xaf, 0x2f, 0x76, 0x0c, 0x2a, 0x59, 0xb2, 0xc8, 0xcb, 0x3c, 0x9c, 0xf9, 0x32, 0x35, 0x99, 0xe8,
-	0x12, 0x4d, 0x96, 0xdc, 0x29, 0x86, 0xa0, 0x3f, 0x0a, 0x60, 0xe5, 0x09, 0xc3, 0xfd, 0x11, 0xe1,
-	0xd5, 0xcb, 0x25, 0xbe, 0x95, 0x2e, 0xf1, 0x6e, 0x25, 0x8e, 0x60, 0x59, 0x0d, 0x41, 0x1b, 0x89,
-	0x9d, 0x9e, 0xd9, 0x84, 0xf9, 0x5b, 0xdf, 0x84, 0x85, 0x5b, 0xd9, 0x84, 0xad, 0x8b, 0x41, 0x21,
-	0x47, 0xcf, 0xda, 0x3f, 0x78, 0x1f, 0x29, 0xdd, 0xc6, 0xfb, 0x48, 0x76, 0x45, 0xcf, 0xfd, 0x77,
-	0x2b, 0x1a, 0xfd, 0x59, 0x00, 0x6b, 0x3c, 0xd3, 0x13, 0x86, 0x99, 0x13, 0x30, 0x67, 0x20, 0x26,
-	0xe6, 0x03, 0xb0, 0x2c, 0x99, 0xe8, 0x78, 0x19, 0xa6, 0xfe, 0x2f, 0x8e, 0xe0, 0xfa, 0x34, 0x53,
-	0x13, 0x3b, 0x32, 0x97, 0x04, 0xd0, 0xf3, 0x24, 0x5d, 0xbb, 0x60, 0x45, 0x3a, 0xd0, 0x30, 0xe1,
-	0xba, 0x24, 0x45, 0x3d, 0x8e, 0xe0, 0xc6, 0x74, 0x84, 0x0b, 0x07, 0x64, 0x56, 0x04, 0x72, 0x10,
-	0x2a, 0xca, 0x9f, 0x82, 0xb5, 0x29, 0xc9, 0x29, 0xda, 0xcb, 0xc6, 0x7f, 0x38, 0x73, 0xe3, 0x8d,
-	0x2b, 0x1a, 0x4e, 0xa8, 0xbf, 0x9a, 0x62, 0x6a, 0x40, 0x5e, 0xd1, 0x5a, 0xf1, 0xf6, 0xb4, 0xf6,
-	0x86, 0x99, 0x84, 0x7e, 0xcc, 0x83, 0x8d, 0x4b, 0xd2, 0x36, 0xc9, 0x29, 0x1e, 0xdb, 0xc1, 0x14,
-	0xfb, 0xb5, 0xd7, 0xb0, 0xff, 0x33, 0xb0, 0x19, 0x88, 0x18, 0xd6, 0x0d, 0x0b, 0x14, 0xc5, 0x11,
-	0x6c, 0xc8, 0x47, 0x6f, 0x70, 0x44, 0x66, 0x4d, 0x5a, 0xf6, 0xb2, 0xdb, 0xf4, 0xff, 0xa0, 0x18,
-	0x06, 0x64, 0xac, 0xfa, 0xbc, 0x12, 0x47, 0x70, 0x51, 0xc6, 0xe1, 0x28, 0x32, 0x85, 0xf1, 0xca,
-	0xcb, 0x94, 0x54, 0xeb, 0xdf, 0x7b, 0x99, 0xfa, 0x04, 0xcc, 0x65, 0x56, 0xdd, 0x83, 0x99, 0xbb,
-	0x5a, 0x99, 0x9e, 0x21, 0xc8, 0x54, 0xe1, 0xba, 0x8f, 0xcf, 0x7e, 0x6f, 0xe4, 0xbe, 0x9d, 0x34,
-	0x72, 0x67, 0x93, 0x86, 0xf6, 0x72, 0xd2, 0xd0, 0x7e, 0x9b, 0x34, 0xb4, 0x17, 0xe7, 0x8d, 0xdc,
-	0xcb, 0xf3, 0x46, 0xee, 0xa7, 0xf3, 0x46, 0xee, 0xf3, 0x4e, 0x26, 0x05, 0xff, 0xd2, 0xba, 0x47,
-	0x0f, 0x0f, 0x9d, 0x81, 0x83, 0x47, 0xea, 0xdc, 0x49, 0xbe, 0xce, 0x44, 0xbe, 0xfe, 0x9c, 0x90,
-	0xf6, 0x3b, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xaf, 0x01, 0x2c, 0xba, 0x0d, 0x00, 0x00,
+	// 1771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xbd, 0x6f, 0x1b, 0xc9,
+	0x15, 0xd7, 0x92, 0x94, 0x2c, 0x0e, 0xf5, 0x41, 0xad, 0x64, 0x89, 0xa2, 0x20, 0x2e, 0x6f, 0x92,
+	0x73, 0x88, 0x43, 0x8e, 0x84, 0x1d, 0x20, 0x1f, 0x2e, 0x72, 0xe0, 0x97, 0x72, 0x0c, 0x24, 0x93,
+	0x1e, 0xd2, 0x51, 0x2e, 0xcd, 0x62, 0xc8, 0x1d, 0x51, 0x0b, 0x73, 0x3f, 0xb0, 0x3b, 0xb4, 0x65,
+	0x20, 0x45, 0x90, 0x20, 0x40, 0xa0, 0x34, 0xd7, 0x07, 0xaa, 0x52, 0x24, 0x48, 0x95, 0x26, 0x6d,
+	0x82, 0x74, 0x2e, 0xdd, 0xe5, 0xa3, 0xd8, 0x4b, 0xe4, 0x26, 0x4d, 0x1a, 0xfe, 0x05, 0xc1, 0xcc,
+	0xec, 0x72, 0x97, 0x1f, 0xb2, 0xcd, 0x73, 0x74, 0x8d, 0xbd, 0xf3, 0x66, 0xde, 0xef, 0xbd, 0x79,
+	0xef, 0xf7, 0xde, 0x3c, 0x11, 0xe4, 0x7b, 0x96, 0xa1, 0x91, 0x8b, 0xd2, 0x33, 0x3c, 0x1c, 0xd0,
+	0xd2, 0xb3, 0xfb, 0x5d, 0x42, 0xf1, 0x7d, 0xb1, 0x2a, 0xda, 0x8e, 0x45, 0x2d, 0x79, 0x47, 0x9c,
+	0x28, 0x0a, 0x99, 0x7f, 0x22, 0xbb, 0xd3, 0xb7, 0xfa, 0x16, 0x3f, 0x50, 0x62, 0x5f, 0xe2, 0x6c,
+	0x56, 0xe9, 0x5b, 0x56, 0x7f, 0x40, 0x4a, 0x7c, 0xd5, 0x1d, 0x9e, 0x95, 0xa8, 0x6e, 0x10, 0x97,
+	0x62, 0xc3, 0xf6, 0x0f, 0xe4, 0x7a, 0x96, 0x6b, 0x58, 0x6e, 0xa9, 0x8b, 0x5d, 0x32, 0xb6, 0xd6,
+	0xb3, 0x74, 0x53, 0xec, 0xc3, 0xdf, 0xad, 0x82, 0xe5, 0x1f, 0x31, 0x43, 0xf2, 0x06, 0x88, 0xe9,
+	0x5a, 0x46, 0xca, 0x4b, 0x85, 0x04, 0x8a, 0xe9, 0x9a, 0x7c, 0x1f, 0xac, 0x60, 0xdb, 0x56, 0x75,
+	0x2d, 0x13, 0x63, 0xb2, 0x4a, 0xf6, 0xda, 0x53, 0x96, 0xcb, 0xb6, 0xdd, 0xd0, 0x46, 0x9e, 0xb2,
+	0xfe, 0x02, 0x1b, 0x83, 0x87, 0x50, 0x1c, 0x80, 0x68, 0x19, 0x33, 0xb9, 0x6c, 0x80, 0x5d, 0x72,
+	0x41, 0x89, 0xa9, 0x11, 0x4d, 0xb5, 0xb1, 0xee, 0xa8, 0xfc, 0x0a, 0x0c, 0x22, 0xce, 0x21, 0xbe,
+	0x7b, 0xed, 0x29, 0xdb, 0x75, 0xff, 0x44, 0x0b, 0xeb, 0x0e, 0xb7, 0xdc, 0xa8, 0x8d, 0x3c, 0xe5,
+	0x50, 0x00, 0xce, 0x57, 0x87, 0x68, 0x9b, 0xcc, 0x68, 0x69, 0xf2, 0x3d, 0xb0, 0x6c, 0x3d, 0x37,
+	0x89, 0x93, 0x49, 0xe4, 0xa5, 0x42, 0xb2, 0x92, 0x1e, 0x79, 0xca, 0x9a, 0x80, 0xe1, 0x62, 0x88,
+	0xc4, 0xb6, 0xac, 0x82, 0x24, 0x36, 0xac, 0xa1, 0x49, 0x55, 0xdd, 0xcc, 0x2c, 0xf3, 0xb3, 0x95,
+	0x97, 0x9e, 0xb2, 0xf4, 0x4f, 0x4f, 0xb9, 0xd7, 0xd7, 0xe9, 0xf9, 0xb0, 0x5b, 0xec, 0x59, 0x46,
+	0xc9, 0x8f, 0x94, 0xf8, 0xef, 0x63, 0x57, 0x7b, 0x5a, 0xa2, 0x2f, 0x6c, 0xe2, 0x16, 0x1b, 0x26,
+	0x1d, 0x79, 0x4a, 0xda, 0xbf, 0x71, 0x00, 0x04, 0xd1, 0xaa, 0xf8, 0x6e, 0x98, 0x72, 0x17, 0x00,
+	0x5f, 0x6e, 0x0d, 0x69, 0x66, 0x85, 0x5b, 0xa8, 0x2e, 0x6c, 0x61, 0x6b, 0xc2, 0x82, 0x35, 0xa4,
+	0x10, 0xf9, 0x7e, 0x37, 0x87, 0x54, 0xfe, 0x31, 0x00, 0x3d, 0x87, 0x60, 0x4a, 0x34, 0x15, 0xd3,
+	0xcc, 0x9d, 0xbc, 0x54, 0x48, 0x3d, 0xc8, 0x16, 0x45, 0xfa, 0x8b, 0x41, 0xfa, 0x8b, 0x9d, 0x20,
+	0xfd, 0x95, 0x43, 0x66, 0x3f, 0x44, 0x0d, 0x75, 0xe1, 0xe7, 0x5f, 0x28, 0x12, 0x4a, 0xfa, 0x82,
+	0x32, 0x95, 0x7f, 0x26, 0x81, 0x1d, 0xdd, 0xa4, 0xc4, 0x21, 0x2e, 0x55, 0x71, 0xaf, 0x37, 0x34,
+	0x86, 0x03, 0xb6, 0x95, 0x59, 0xe5, 0x17, 0x39, 0x59, 0xf8, 0x22, 0x07, 0xc2, 0xe4, 0x3c, 0x4c,
+	0x88, 0xb6, 0x03, 0x71, 0x39, 0x94, 0xca, 0xbf, 0x94, 0xc0, 0x5e, 0x6f, 0x60, 0xb9, 0xba, 0xd9,
+	0x57, 0xcf, 0x08, 0x99, 0xf0, 0x22, 0x79, 0x1b, 0x5e, 0xdc, 0xf5, 0xad, 0x1d, 0x11, 0x12, 0xf5,
+	0xe3, 0x21, 0x58, 0xeb, 0x0e, 0xac, 0xde, 0x53, 0xf5, 0x9c, 0xe8, 0xfd, 0x73, 0x9a, 0x01, 0x79,
+	0xa9, 0x10, 0xaf, 0xec, 0x8d, 0x3c, 0x65, 0x5b, 0xa0, 0x45, 0x77, 0x21, 0x4a, 0xf1, 0xe5, 0xa7,
+	0x7c, 0xc5, 0x12, 0x24, 0x76, 0x59, 0x09, 0x66, 0x52, 0x8b, 0x26, 0x28, 0xd4, 0xf5, 0x13, 0xc4,
+	0x05, 0xec, 0xb8, 0xfc, 0x0b, 0x09, 0xa4, 0x7a, 0xd6, 0x80, 0xb9, 0xe8, 0xe0, 0x81, 0x9b, 0x59,
+	0xcb, 0xc7, 0x0b, 0xa9, 0x07, 0xfb, 0x45, 0x71, 0xf1, 0x22, 0x2b, 0xed, 0xa0, 0x4d, 0x14, 0xab,
+	0x96, 0x6e, 0x56, 0x8e, 0x7c, 0x68, 0xd9, 0xcf, 0x7d, 0xa8, 0x0b, 0xff, 0xf0, 0x85, 0x52, 0x78,
+	0x87, 0x10, 0x32, 0x18, 0x17, 0x45, 0xad, 0xc2, 0xbf, 0x48, 0xe0, 0xeb, 0x4d, 0x56, 0x4f, 0x65,
+	0xdb, 0x9e, 0xa9, 0xe1, 0x13, 0x6c, 0xdb, 0xba, 0xd9, 0xaf, 0x61, 0x8a, 0xc3, 0xb2, 0x94, 0xde,
+	0x5c, 0x96, 0x77, 0x27, 0x1b, 0x4c, 0xd0, 0x44, 0x0a, 0x20, 0x3d, 0xd9, 0x05, 0x82, 0xf6, 0x81,
+	0x36, 0xa2, 0x4d, 0xa0, 0xa1, 0xc9, 0x45, 0xb0, 0x3a, 0x6e, 0x30, 0x09, 0xde, 0x60, 0xb6, 0x47,
+	0x9e, 0xb2, 0x29, 0x6c, 0x85, 0xbd, 0xe3, 0xce, 0x33, 0xd1, 0x2f, 0xe0, 0x9f, 0xe3, 0x40, 0x79,
+	0x9b, 0xf3, 0xa1, 0x53, 0x52, 0xd4, 0xa9, 0xfa, 0x1c, 0xa7, 0x44, 0x5b, 0x3c, 0x18, 0x79, 0xca,
+	0xde, 0xbc, 0xe6, 0xc5, 0x4c, 0x4f, 0x7b, 0x7c, 0x1f, 0x24, 0x03, 0xbf, 0xdc, 0x4c, 0x3c, 0x1f,
+	0x2f, 0x24, 0x2a, 0x3b, 0x61, 0x6f, 0x19, 0x6f, 0x41, 0xb4, 0xea, 0xfb, 0xec, 0xca, 0x3f, 0x05,
+	0xdb, 0xd4, 0x7a, 0x4a, 0x4c, 0xd5, 0x60, 0x4c, 0xd6, 0x54, 0xd1, 0x11, 0xfc, 0x96, 0x77, 0xbc,
+	0x70, 0x55, 0x64, 0x85, 0xa9, 0x39, 0x90, 0x10, 0x6d, 0x71, 0xe9, 0x09, 0x17, 0x96, 0xb9, 0x4c,
+	0xfe, 0xb5, 0x04, 0x32, 0x21, 0x09, 0x54, 0x46, 0xc9, 0xd0, 0x07, 0xd1, 0x4a, 0x1f, 0x2f, 0xec,
+	0x83, 0x32, 0x4d, 0xcb, 0x49, 0x5c, 0x88, 0x76, 0xc3, 0xad, 0x63, 0xbe, 0x23, 0xbc, 0x81, 0xff,
+	0x90, 0x40, 0xba, 0xf3, 0xcc, 0x97, 0xb1, 0x74, 0x9d, 0x60, 0x5b, 0xfe, 0x0e, 0x48, 0x61, 0xd7,
+	0x25, 0x54, 0xd5, 0x88, 0x69, 0x19, 0x3e, 0xe9, 0x76, 0x43, 0xf6, 0x47, 0x36, 0x21, 0x02, 0x7c,
+	0x55, 0x63, 0x8b, 0x37, 0xdf, 0x2d, 0xf6, 0x55, 0xdf, 0xed, 0x4f, 0x12, 0x58, 0x17, 0xa1, 0x7f,
+	0xef, 0x8b, 0x3d, 0x05, 0xeb, 0x93, 0x64, 0x11, 0x97, 0x39, 0x5a, 0xf8, 0x32, 0x3b, 0xc2, 0xd0,
+	0x14, 0x4d, 0xd6, 0x8c, 0x08, 0x43, 0xe0, 0x7f, 0xe2, 0x60, 0xb3, 0x4d, 0x71, 0x77, 0x40, 0x98,
+	0xf7, 0x62, 0x94, 0x38, 0x0c, 0x47, 0x89, 0xca, 0xfa, 0xc8, 0x53, 0x92, 0x7e, 0x2b, 0xd6, 0x20,
+	0x9f, 0x2c, 0x26, 0xde, 0xe3, 0xd8, 0xad, 0xbf, 0xc7, 0xf1, 0x5b, 0x79, 0x8f, 0x0b, 0xe3, 0x46,
+	0x21, 0x5a, 0xcf, 0xd6, 0x97, 0x98, 0x8a, 0x96, 0x6f, 0x63, 0x2a, 0x9a, 0x1c, 0x14, 0x56, 0xfe,
+	0x7f, 0x83, 0x02, 0xfc, 0x6f, 0x1c, 0x6c, 0x31, 0x4b, 0x6d, 0x8a, 0xa9, 0xee, 0x52, 0xbd, 0xc7,
+	0x3b, 0xe6, 0x27, 0x60, 0x43, 0x30, 0x51, 0x37, 0x27, 0x98, 0xba, 0x3f, 0xf2, 0x94, 0xbb, 0x51,
+	0xa6, 0x06, 0xfb, 0x10, 0xad, 0x71, 0x41, 0xc3, 0x14, 0x74, 0xad, 0x80, 0x4d, 0x71, 0xc0, 0x1a,
+	0x06, 0x5c, 0x17, 0xa4, 0xc8, 0x8e, 0x3c, 0x65, 0x37, 0x8a, 0x30, 0x3e, 0x00, 0xd1, 0x3a, 0x97,
+	0x34, 0x87, 0x3e, 0xe5, 0x9f, 0x83, 0xad, 0x48, 0xc9, 0xf9, 0xb4, 0x17, 0x89, 0xff, 0xe1, 0xc2,
+	0x89, 0xcf, 0xcc, 0xd4, 0x70, 0x40, 0xfd, 0x74, 0x28, 0xf3, 0x1b, 0xe4, 0x4c, 0xad, 0x25, 0x6e,
+	0xaf, 0xd6, 0xbe, 0x62, 0x26, 0xc1, 0xbf, 0xc5, 0xc0, 0xee, 0x54, 0x69, 0x23, 0xf2, 0x1c, 0x3b,
+	0x9a, 0x1b, 0x61, 0xbf, 0xf4, 0x16, 0xf6, 0x7f, 0x06, 0xf6, 0x5c, 0x8e, 0xa1, 0xde, 0xf0, 0x80,
+	0xc2, 0x91, 0xa7, 0xe4, 0x84, 0xea, 0x0d, 0x07, 0x21, 0xda, 0x11, 0x3b, 0xf5, 0xc9, 0xd7, 0xf4,
+	0x6b, 0x20, 0x31, 0x74, 0x89, 0xe3, 0xe7, 0x79, 0x73, 0xe4, 0x29, 0x29, 0x81, 0xc3, 0xa4, 0x10,
+	0xf1, 0xcd, 0x99, 0x91, 0x4e, 0x54, 0xeb, 0xbb, 0x8d, 0x74, 0xa7, 0x60, 0x65, 0xe2, 0xa9, 0xfb,
+	0x64, 0xe1, 0xac, 0xae, 0x47, 0x7b, 0x08, 0x44, 0x3e, 0x1c, 0xfc, 0x79, 0x1c, 0x6c, 0x22, 0xa2,
+	0x11, 0xc3, 0xa6, 0xba, 0x65, 0xb2, 0x7a, 0x22, 0x0b, 0x84, 0xf4, 0x66, 0x1a, 0xc4, 0x6e, 0xa3,
+	0xa1, 0xa8, 0x20, 0xc9, 0x46, 0x4c, 0xd5, 0xc1, 0x94, 0x64, 0xe2, 0x0b, 0xb7, 0xeb, 0x1a, 0xe9,
+	0x85, 0xed, 0x7a, 0x0c, 0x04, 0xd1, 0x2a, 0xfb, 0x46, 0xec, 0xe6, 0x43, 0xb0, 0x33, 0xc0, 0x2e,
+	0x55, 0x9d, 0x71, 0x44, 0xc4, 0x0c, 0x9d, 0x78, 0x6b, 0xef, 0xfa, 0x86, 0xdf, 0xbb, 0xfc, 0x59,
+	0x7f, 0x1e, 0x8a, 0xe8, 0x62, 0x32, 0xdb, 0x0a, 0x23, 0xce, 0x10, 0xe0, 0x2b, 0x09, 0xec, 0xb4,
+	0x2c, 0x57, 0x67, 0x82, 0x13, 0x6c, 0xe2, 0x3e, 0x71, 0x7e, 0xe0, 0x60, 0x93, 0xbe, 0xf3, 0x00,
+	0xfb, 0x4d, 0x70, 0xc7, 0x10, 0x7a, 0x7e, 0xc3, 0x92, 0x47, 0x9e, 0xb2, 0xe1, 0xd7, 0xb1, 0xd8,
+	0x80, 0x28, 0x38, 0x22, 0x77, 0x41, 0xca, 0x26, 0x8e, 0xa1, 0xbb, 0xae, 0x6e, 0x99, 0x62, 0xfa,
+	0xdb, 0x78, 0x50, 0x28, 0xce, 0xfb, 0x63, 0xbf, 0x18, 0xb8, 0xd5, 0x1a, 0x2b, 0x44, 0x1f, 0xfe,
+	0x08, 0x0c, 0x44, 0x51, 0x50, 0xf8, 0xd7, 0x65, 0xb0, 0xc9, 0xd3, 0xd6, 0x72, 0x2c, 0x4a, 0x7a,
+	0x0c, 0x42, 0xfe, 0x5e, 0x64, 0x4a, 0x16, 0xcc, 0xca, 0x5d, 0x7b, 0xca, 0x9d, 0x90, 0x13, 0x37,
+	0x0e, 0xcc, 0x61, 0x20, 0x62, 0x6f, 0x0e, 0x44, 0x17, 0x00, 0xea, 0xe8, 0xfd, 0x3e, 0x71, 0xd4,
+	0x9e, 0xf3, 0x25, 0xde, 0x5b, 0x41, 0x11, 0xff, 0x01, 0x0a, 0x91, 0x20, 0x4a, 0xfa, 0x8b, 0x2a,
+	0xff, 0x23, 0x9e, 0x62, 0xa7, 0x4f, 0x28, 0x33, 0x91, 0x78, 0x3f, 0x16, 0x8e, 0x81, 0x20, 0x5a,
+	0x15, 0xdf, 0x55, 0x47, 0x7e, 0x0c, 0x56, 0x30, 0x8f, 0x18, 0x2f, 0xf6, 0x8d, 0x07, 0xf7, 0x6e,
+	0x48, 0xcd, 0x38, 0xb2, 0x65, 0xfe, 0xef, 0x44, 0x9d, 0x72, 0x09, 0x2b, 0x73, 0x11, 0xfa, 0x2e,
+	0x00, 0x06, 0xbe, 0x08, 0x5e, 0x86, 0xf7, 0xfc, 0x5d, 0x20, 0x44, 0x82, 0x28, 0x69, 0xe0, 0x0b,
+	0xff, 0x4d, 0x20, 0x20, 0x25, 0xa4, 0xea, 0xd0, 0x25, 0x1a, 0xff, 0x61, 0x20, 0x59, 0xa9, 0x2d,
+	0x6c, 0x44, 0x9e, 0x18, 0x76, 0x18, 0x14, 0x9b, 0x29, 0xf9, 0xea, 0x89, 0x4b, 0x34, 0xf9, 0x1c,
+	0xac, 0x31, 0x07, 0xdc, 0x81, 0x6e, 0xdb, 0xb8, 0x4f, 0xfc, 0xdf, 0x06, 0xea, 0x0b, 0x67, 0x60,
+	0x3b, 0xbc, 0x4c, 0x80, 0x05, 0x51, 0xca, 0xc0, 0x17, 0x6d, 0x7f, 0xf5, 0xd1, 0x1f, 0x63, 0x40,
+	0x9e, 0xe5, 0xbf, 0x7c, 0x04, 0x94, 0x56, 0xb3, 0xdd, 0xe8, 0x34, 0x9a, 0x8f, 0xd4, 0x56, 0x1d,
+	0x9d, 0x34, 0xda, 0x6d, 0xf6, 0xf9, 0xe4, 0x51, 0xbb, 0x55, 0xaf, 0x36, 0x8e, 0x1a, 0xf5, 0x5a,
+	0x7a, 0x29, 0xfb, 0xc1, 0xe5, 0x55, 0xfe, 0x70, 0x56, 0xf9, 0x89, 0xe9, 0xda, 0xa4, 0xa7, 0x9f,
+	0xe9, 0x44, 0x93, 0xbf, 0x0f, 0x0e, 0xe6, 0xe1, 0xd4, 0xea, 0x5c, 0x9a, 0x96, 0xb2, 0x87, 0x97,
+	0x57, 0xf9, 0xfd, 0x59, 0x8c, 0x1a, 0xb1, 0x99, 0x4c, 0x7e, 0x08, 0xf6, 0xe7, 0xe9, 0xa3, 0x7a,
+	0xab, 0xfc, 0x59, 0x3a, 0x96, 0x3d, 0xb8, 0xbc, 0xca, 0xef, 0xcd, 0x6a, 0x23, 0x62, 0xe3, 0x17,
+	0x72, 0x0b, 0x7c, 0x38, 0x4f, 0xf7, 0xb4, 0xd1, 0xf9, 0xb4, 0x86, 0xca, 0xa7, 0x6a, 0xa7, 0xa9,
+	0x36, 0x4f, 0x1f, 0xd5, 0x51, 0x3a, 0x9e, 0xfd, 0xf0, 0xf2, 0x2a, 0xff, 0xc1, 0x2c, 0xce, 0xa9,
+	0x4e, 0xcf, 0x35, 0x07, 0x3f, 0xef, 0x58, 0xfc, 0x4f, 0xf1, 0x6c, 0xe2, 0x57, 0xbf, 0xcd, 0x2d,
+	0x7d, 0xf4, 0x9b, 0x18, 0x48, 0x4f, 0xf3, 0x52, 0xae, 0x80, 0xc3, 0x16, 0x6a, 0x76, 0xea, 0x55,
+	0x6e, 0xae, 0x5c, 0xed, 0xcc, 0x86, 0x4b, 0xb9, 0xbc, 0xca, 0x1f, 0x4c, 0x2b, 0x46, 0x83, 0xf5,
+	0x6d, 0xb0, 0x37, 0x8b, 0x21, 0xae, 0x2a, 0x65, 0xf7, 0x2f, 0xaf, 0xf2, 0x77, 0xa7, 0xb5, 0xc5,
+	0x45, 0x59, 0x90, 0x66, 0xf4, 0x82, 0x10, 0x07, 0x41, 0x9a, 0xd2, 0x0c, 0x02, 0xdc, 0x00, 0x1f,
+	0xcc, 0xea, 0xb6, 0xeb, 0xc7, 0xc7, 0x6a, 0xb5, 0x79, 0x7c, 0x5c, 0xee, 0xd4, 0x51, 0xf9, 0x38,
+	0x1d, 0xcf, 0xc2, 0xcb, 0xab, 0x7c, 0x6e, 0x1a, 0xa3, 0x4d, 0x06, 0x83, 0xea, 0x78, 0x44, 0x13,
+	0xd1, 0xa9, 0x3c, 0x7e, 0xf9, 0xef, 0xdc, 0xd2, 0xef, 0xaf, 0x73, 0x4b, 0x2f, 0xaf, 0x73, 0xd2,
+	0xab, 0xeb, 0x9c, 0xf4, 0xaf, 0xeb, 0x9c, 0xf4, 0xf9, 0xeb, 0xdc, 0xd2, 0xab, 0xd7, 0xb9, 0xa5,
+	0xbf, 0xbf, 0xce, 0x2d, 0xfd, 0xa4, 0x34, 0x41, 0x5f, 0x56, 0xf4, 0x1f, 0x5b, 0x67, 0x67, 0x7a,
+	0x4f, 0xc7, 0x03, 0x7f, 0x5d, 0x0a, 0x7e, 0xb0, 0xe5, 0x5c, 0xee, 0xae, 0xf0, 0xb7, 0xe8, 0x5b,
+	0xff, 0x1b, 0x00, 0x97, 0x2d, 0x7e, 0x44, 0xcd, 0x15, 0x00, 0x00,
 }
 
 func (m *Vault) Marshal() (dAtA []byte, err error) {
@@ -463,6 +721,20 @@ func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Collaterals) > 0 {
+		for iNdEx := len(m.Collaterals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collaterals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
 	if err1 != nil {
 		return 0, err1
@@ -928,6 +1200,202 @@ func (m *StableMintVaultRewards) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RedemptionState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRedemptionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRedemptionTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintVault(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BaseRate.Size()
+		i -= size
+		if _, err := m.BaseRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExtendedPairVaultID != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.ExtendedPairVaultID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppId != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.AppId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionManagerGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionManagerGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionManagerGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		dAtA8 := make([]byte, len(m.Permissions)*10)
+		var j7 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintVault(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultProtection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultProtection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultProtection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.AmountUsed.Size()
+		i -= size
+		if _, err := m.AmountUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Action != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.TargetCr.Size()
+		i -= size
+		if _, err := m.TargetCr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TriggerCr.Size()
+		i -= size
+		if _, err := m.TriggerCr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VaultID != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.VaultID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVault(v)
 	base := offset
@@ -973,6 +1441,12 @@ func (m *Vault) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
 	n += 1 + l + sovVault(uint64(l))
+	if len(m.Collaterals) > 0 {
+		for _, e := range m.Collaterals {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1126,8 +1600,80 @@ func (m *StableMintVaultRewards) Size() (n int) {
 	return n
 }
 
-func sovVault(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *RedemptionState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppId != 0 {
+		n += 1 + sovVault(uint64(m.AppId))
+	}
+	if m.ExtendedPairVaultID != 0 {
+		n += 1 + sovVault(uint64(m.ExtendedPairVaultID))
+	}
+	l = m.BaseRate.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRedemptionTime)
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *PositionManagerGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovVault(uint64(e))
+		}
+		n += 1 + sovVault(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *VaultProtection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VaultID != 0 {
+		n += 1 + sovVault(uint64(m.VaultID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.TriggerCr.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.TargetCr.Size()
+	n += 1 + l + sovVault(uint64(l))
+	if m.Action != 0 {
+		n += 1 + sovVault(uint64(m.Action))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.AmountUsed.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func sovVault(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozVault(x uint64) (n int) {
 	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -1471,6 +2017,40 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collaterals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collaterals = append(m.Collaterals, types.Coin{})
+			if err := m.Collaterals[len(m.Collaterals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVault(dAtA[iNdEx:])
@@ -2677,6 +3257,634 @@ func (m *StableMintVaultRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RedemptionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			m.AppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPairVaultID", wireType)
+			}
+			m.ExtendedPairVaultID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedPairVaultID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastRedemptionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionManagerGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionManagerGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionManagerGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v PositionPermission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVault
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PositionPermission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVault
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthVault
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthVault
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]PositionPermission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PositionPermission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVault
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PositionPermission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultProtection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultProtection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultProtection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultID", wireType)
+			}
+			m.VaultID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VaultID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerCr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerCr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetCr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= ProtectionAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVault(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0